@@ -0,0 +1,186 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"encoding/json"
+	"net"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/isbot"
+)
+
+// Access log formats for ParseAccessLog().
+const (
+	AccessLogCombined = "combined" // Apache and nginx "combined" format.
+	AccessLogCommon   = "common"   // Apache and nginx "common" format.
+	AccessLogCaddy    = "caddy"    // Caddy's JSON format.
+)
+
+// AccessLogFormats are all the formats ParseAccessLog() accepts.
+var AccessLogFormats = []string{AccessLogCombined, AccessLogCommon, AccessLogCaddy}
+
+// AccessLogLine is a request from a server access log.
+type AccessLogLine struct {
+	Time      time.Time
+	IP        string
+	Method    string
+	Host      string // Only for formats that include it.
+	Path      string
+	Query     string
+	Status    int
+	Ref       string
+	UserAgent string
+}
+
+var (
+	// host ident user [time] "request" status size "ref" "ua"
+	reAccessLog = regexp.MustCompile(
+		`^(\S+) \S+ \S+ \[([^\]]+)\] "((?:[^"\\]|\\.)*)" (\d{3}) \S+(?: "((?:[^"\\]|\\.)*)" "((?:[^"\\]|\\.)*)")?`)
+
+	// Extensions of files that are never counted as a pageview.
+	accessLogStatic = map[string]struct{}{
+		".css": {}, ".js": {}, ".mjs": {}, ".map": {}, ".json": {}, ".xml": {},
+		".txt": {}, ".ico": {}, ".png": {}, ".jpg": {}, ".jpeg": {}, ".gif": {},
+		".svg": {}, ".webp": {}, ".avif": {}, ".woff": {}, ".woff2": {},
+		".ttf": {}, ".otf": {}, ".eot": {}, ".mp4": {}, ".webm": {}, ".mp3": {},
+	}
+)
+
+// ParseAccessLog parses a single line from an access log in the given format;
+// see the AccessLog* constants.
+func ParseAccessLog(format, line string) (AccessLogLine, error) {
+	switch format {
+	case AccessLogCombined, AccessLogCommon:
+		return parseAccessLogText(format, line)
+	case AccessLogCaddy:
+		return parseAccessLogCaddy(line)
+	default:
+		return AccessLogLine{}, errors.Errorf("ParseAccessLog: unknown format: %q", format)
+	}
+}
+
+func parseAccessLogText(format, line string) (AccessLogLine, error) {
+	m := reAccessLog.FindStringSubmatch(line)
+	if m == nil {
+		return AccessLogLine{}, errors.Errorf("not a line in the %s log format: %q", format, line)
+	}
+	if format == AccessLogCombined && m[5] == "" && m[6] == "" && !strings.HasSuffix(line, `""`) {
+		return AccessLogLine{}, errors.Errorf("no referrer and User-Agent; is this the common log format? %q", line)
+	}
+
+	t, err := time.Parse("02/Jan/2006:15:04:05 -0700", m[2])
+	if err != nil {
+		return AccessLogLine{}, errors.Errorf("invalid time: %w", err)
+	}
+	status, _ := strconv.Atoi(m[4])
+
+	l := AccessLogLine{
+		Time:      t.UTC(),
+		IP:        m[1],
+		Status:    status,
+		Ref:       unescapeAccessLog(m[5]),
+		UserAgent: unescapeAccessLog(m[6]),
+	}
+	if l.Ref == "-" {
+		l.Ref = ""
+	}
+	if l.UserAgent == "-" {
+		l.UserAgent = ""
+	}
+
+	// "GET /path HTTP/1.1"; this may be just "-" or garbage for invalid
+	// requests.
+	req := strings.Fields(unescapeAccessLog(m[3]))
+	if len(req) >= 2 {
+		l.Method = req[0]
+		l.Path, l.Query = splitQuery(req[1])
+	}
+	return l, nil
+}
+
+// unescapeAccessLog removes the escaping of \" and \\ in quoted fields.
+func unescapeAccessLog(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(s)
+}
+
+func parseAccessLogCaddy(line string) (AccessLogLine, error) {
+	var c struct {
+		TS      float64 `json:"ts"`
+		Status  int     `json:"status"`
+		Request struct {
+			RemoteIP   string              `json:"remote_ip"`
+			RemoteAddr string              `json:"remote_addr"` // Older versions.
+			Method     string              `json:"method"`
+			Host       string              `json:"host"`
+			URI        string              `json:"uri"`
+			Headers    map[string][]string `json:"headers"`
+		} `json:"request"`
+	}
+	err := json.Unmarshal([]byte(line), &c)
+	if err != nil {
+		return AccessLogLine{}, errors.Errorf("not a line in the caddy log format: %w", err)
+	}
+	if c.Request.URI == "" {
+		return AccessLogLine{}, errors.Errorf("not a line in the caddy log format: no request.uri: %q", line)
+	}
+
+	sec, frac := int64(c.TS), c.TS-float64(int64(c.TS))
+	l := AccessLogLine{
+		Time:   time.Unix(sec, int64(frac*1e9)).UTC(),
+		IP:     c.Request.RemoteIP,
+		Method: c.Request.Method,
+		Host:   c.Request.Host,
+		Status: c.Status,
+	}
+	if l.IP == "" {
+		l.IP = c.Request.RemoteAddr
+		if h, _, err := net.SplitHostPort(l.IP); err == nil {
+			l.IP = h
+		}
+	}
+	l.Path, l.Query = splitQuery(c.Request.URI)
+	if h := c.Request.Headers["Referer"]; len(h) > 0 {
+		l.Ref = h[0]
+	}
+	if h := c.Request.Headers["User-Agent"]; len(h) > 0 {
+		l.UserAgent = h[0]
+	}
+	return l, nil
+}
+
+func splitQuery(uri string) (string, string) {
+	if i := strings.IndexByte(uri, '?'); i > -1 {
+		return uri[:i], uri[i+1:]
+	}
+	return uri, ""
+}
+
+// IsPageview reports if this request looks like a pageview: a successful GET
+// request for something that's not a static file.
+func (l AccessLogLine) IsPageview() bool {
+	if l.Method != "GET" || !strings.HasPrefix(l.Path, "/") {
+		return false
+	}
+	if (l.Status < 200 || l.Status > 299) && l.Status != 304 {
+		return false
+	}
+	_, static := accessLogStatic[strings.ToLower(path.Ext(l.Path))]
+	return !static
+}
+
+// IsBot reports if the User-Agent is a bot; requests without a User-Agent are
+// also considered a bot.
+func (l AccessLogLine) IsBot() bool {
+	return l.UserAgent == "" || isbot.Is(isbot.UserAgent(l.UserAgent))
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestParseAccessLog(t *testing.T) {
+	const ua = `Mozilla/5.0 (X11; Linux x86_64; rv:81.0) Gecko/20100101 Firefox/81.0`
+	tests := []struct {
+		format, in string
+		want       string
+		wantErr    string
+		pageview   bool
+		bot        bool
+	}{
+		{goatcounter.AccessLogCombined,
+			`1.2.3.4 - - [18/Oct/2020:13:55:36 +0200] "GET /blog/post?utm_source=x HTTP/1.1" 200 2326 "https://example.com/" "` + ua + `"`,
+			`2020-10-18 11:55:36 1.2.3.4 GET  /blog/post utm_source=x 200 https://example.com/`,
+			"", true, false},
+		{goatcounter.AccessLogCombined,
+			`1.2.3.4 - user [18/Oct/2020:13:55:36 +0000] "GET /style.css HTTP/1.1" 304 0 "-" "` + ua + `"`,
+			`2020-10-18 13:55:36 1.2.3.4 GET  /style.css  304 `,
+			"", false, false},
+		{goatcounter.AccessLogCombined,
+			`::1 - - [18/Oct/2020:13:55:36 +0000] "POST /form HTTP/1.1" 200 12 "-" "curl/7.72.0"`,
+			`2020-10-18 13:55:36 ::1 POST  /form  200 `,
+			"", false, true},
+		{goatcounter.AccessLogCombined,
+			`1.2.3.4 - - [18/Oct/2020:13:55:36 +0000] "GET /a\"b HTTP/1.1" 404 12 "-" "Googlebot/2.1 (+http://www.google.com/bot.html)"`,
+			`2020-10-18 13:55:36 1.2.3.4 GET  /a"b  404 `,
+			"", false, true},
+		{goatcounter.AccessLogCombined,
+			`1.2.3.4 - - [18/Oct/2020:13:55:36 +0000] "-" 400 0 "-" "-"`,
+			`2020-10-18 13:55:36 1.2.3.4     400 `,
+			"", false, true},
+		{goatcounter.AccessLogCombined,
+			`1.2.3.4 - - [18/Oct/2020:13:55:36 +0000] "GET / HTTP/1.1" 200 12`,
+			``, "common log format", false, false},
+		{goatcounter.AccessLogCommon,
+			`1.2.3.4 - - [18/Oct/2020:13:55:36 +0000] "GET / HTTP/1.1" 200 12`,
+			`2020-10-18 13:55:36 1.2.3.4 GET  /  200 `,
+			"", true, true},
+		{goatcounter.AccessLogCommon, `not a log`, ``, "not a line", false, false},
+
+		{goatcounter.AccessLogCaddy,
+			`{"level":"info","ts":1603029336.5,"logger":"http.log.access","msg":"handled request","request":{"remote_ip":"1.2.3.4","remote_port":"41342","method":"GET","host":"example.com","uri":"/x?a=b","headers":{"User-Agent":["` + ua + `"],"Referer":["https://example.net"]}},"status":200}`,
+			`2020-10-18 13:55:36 1.2.3.4 GET example.com /x a=b 200 https://example.net`,
+			"", true, false},
+		{goatcounter.AccessLogCaddy,
+			`{"ts":1603029336,"request":{"remote_addr":"1.2.3.4:5678","method":"GET","host":"example.com","uri":"/"},"status":200}`,
+			`2020-10-18 13:55:36 1.2.3.4 GET example.com /  200 `,
+			"", true, true},
+		{goatcounter.AccessLogCaddy, `{"msg":"not a request"}`, ``, "no request.uri", false, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			l, err := goatcounter.ParseAccessLog(tt.format, tt.in)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("wrong error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := fmt.Sprintf("%s %s %s %s %s %s %d %s", l.Time.Format("2006-01-02 15:04:05"),
+				l.IP, l.Method, l.Host, l.Path, l.Query, l.Status, l.Ref)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+			if l.IsPageview() != tt.pageview {
+				t.Errorf("IsPageview: %t", l.IsPageview())
+			}
+			if l.IsBot() != tt.bot {
+				t.Errorf("IsBot: %t", l.IsBot())
+			}
+		})
+	}
+}
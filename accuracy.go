@@ -0,0 +1,214 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+)
+
+// AccuracyTables are the tables compared in an AccuracyReport.
+var AccuracyTables = []string{"hit_counts", "ref_counts", "hit_stats"}
+
+// AccuracyReport compares the statistics stored for a day with the statistics
+// recreated from the pageviews; see NewAccuracyReport().
+type AccuracyReport struct {
+	// Day in UTC, as year-month-day.
+	Day string `json:"day"`
+
+	// Number of pageviews on this day, including bots.
+	Hits int `json:"hits"`
+
+	// Set if there are no differences in any of the tables.
+	OK bool `json:"ok"`
+
+	// Set if the pageviews for this day were removed while the statistics were
+	// kept, so the statistics can't be checked; OK is always false and Tables
+	// is empty.
+	Unchecked bool `json:"unchecked"`
+
+	// Differences for every table in AccuracyTables.
+	Tables []AccuracyTable `json:"tables"`
+}
+
+// AccuracyTable are the differences for a single table.
+type AccuracyTable struct {
+	Table    string         `json:"table"`
+	Stored   int            `json:"stored"`   // Number of stored rows.
+	Computed int            `json:"computed"` // Number of rows recreated from the pageviews.
+	Diffs    []AccuracyDiff `json:"diffs"`
+}
+
+// AccuracyDiff is a row that's different.
+type AccuracyDiff struct {
+	// Path, and the host, referrer, and hour for the tables that have it. The
+	// host is omitted if it's blank.
+	Key string `json:"key"`
+
+	// The stored and recreated values; this is the total and unique count
+	// separated by a "/", and is empty if there is no row.
+	Stored   string `json:"stored"`
+	Computed string `json:"computed"`
+}
+
+var errAccuracyRollback = errors.New("rollback")
+
+// NewAccuracyReport recreates the statistics for the site in the context on the
+// given day from the pageviews, and compares them with the stored statistics.
+//
+// This runs the reindex in a transaction that's always rolled back, so it uses
+// the same code as a reindex without changing anything. Pageviews that are
+// still in the memstore aren't in the statistics yet, so there may be some
+// differences for the current day.
+//
+// Days for which the pageviews were removed can't be recreated, and are
+// reported as unchecked.
+func NewAccuracyReport(ctx context.Context, day time.Time, reindex ReindexFunc) (AccuracyReport, error) {
+	site := MustGetSite(ctx)
+	day = truncDay(day)
+	r := AccuracyReport{Day: day.Format("2006-01-02")}
+	if day.Before(site.Settings.PageviewsRemovedBefore()) {
+		r.Unchecked = true
+		return r, nil
+	}
+
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		err := tx.GetContext(ctx, &r.Hits, `/* NewAccuracyReport */
+			select count(*) from hits where site=$1 and created_at>=$2 and created_at<=$3`,
+			site.ID, r.Day+" 00:00:00", r.Day+" 23:59:59")
+		if err != nil {
+			return err
+		}
+
+		stored := make([]map[string]string, len(AccuracyTables))
+		for i, t := range AccuracyTables {
+			stored[i], err = accuracyRows(ctx, t, site.ID, r.Day)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = reindex(ctx, *site, day, day, AccuracyTables)
+		if err != nil {
+			return err
+		}
+
+		r.OK = true
+		for i, t := range AccuracyTables {
+			computed, err := accuracyRows(ctx, t, site.ID, r.Day)
+			if err != nil {
+				return err
+			}
+			at := diffAccuracy(t, stored[i], computed)
+			r.OK = r.OK && len(at.Diffs) == 0
+			r.Tables = append(r.Tables, at)
+		}
+		return errAccuracyRollback
+	})
+	if err != nil && !errors.Is(err, errAccuracyRollback) {
+		return AccuracyReport{}, errors.Wrap(err, "NewAccuracyReport")
+	}
+	return r, nil
+}
+
+// accuracyRows gets all rows of the table for the day, as key → value.
+func accuracyRows(ctx context.Context, tbl string, siteID int64, day string) (map[string]string, error) {
+	var (
+		db    = zdb.MustGet(ctx)
+		rows  = make(map[string]string)
+		start = day + " 00:00:00"
+		end   = day + " 23:59:59"
+	)
+	switch tbl {
+	case "hit_counts":
+		var l []struct {
+			Path        string    `db:"path"`
+			Host        string    `db:"host"`
+			Hour        time.Time `db:"hour"`
+			Total       int       `db:"total"`
+			TotalUnique int       `db:"total_unique"`
+		}
+		err := db.SelectContext(ctx, &l, `/* accuracyRows */
+			select path, host, hour, total, total_unique from hit_counts
+			where site=$1 and hour>=$2 and hour<=$3`, siteID, start, end)
+		if err != nil {
+			return nil, errors.Errorf("%s: %w", tbl, err)
+		}
+		for _, r := range l {
+			rows[accuracyKey(r.Path, r.Host, r.Hour.Format("15:04"))] = fmt.Sprintf("%d/%d", r.Total, r.TotalUnique)
+		}
+
+	case "ref_counts":
+		var l []struct {
+			Path        string    `db:"path"`
+			Host        string    `db:"host"`
+			Ref         string    `db:"ref"`
+			Hour        time.Time `db:"hour"`
+			Total       int       `db:"total"`
+			TotalUnique int       `db:"total_unique"`
+		}
+		err := db.SelectContext(ctx, &l, `/* accuracyRows */
+			select path, host, ref, hour, total, total_unique from ref_counts
+			where site=$1 and hour>=$2 and hour<=$3`, siteID, start, end)
+		if err != nil {
+			return nil, errors.Errorf("%s: %w", tbl, err)
+		}
+		for _, r := range l {
+			rows[accuracyKey(r.Path, r.Host, r.Ref, r.Hour.Format("15:04"))] = fmt.Sprintf("%d/%d", r.Total, r.TotalUnique)
+		}
+
+	case "hit_stats":
+		var l []struct {
+			Path        string `db:"path"`
+			Stats       string `db:"stats"`
+			StatsUnique string `db:"stats_unique"`
+		}
+		err := db.SelectContext(ctx, &l, `/* accuracyRows */
+			select path, stats, stats_unique from hit_stats
+			where site=$1 and day=$2`, siteID, day)
+		if err != nil {
+			return nil, errors.Errorf("%s: %w", tbl, err)
+		}
+		for _, r := range l {
+			rows[r.Path] = r.Stats + "/" + r.StatsUnique
+		}
+
+	default:
+		return nil, errors.Errorf("accuracyRows: unknown table %q", tbl)
+	}
+	return rows, nil
+}
+
+func accuracyKey(path, host string, rest ...string) string {
+	k := path
+	if host != "" {
+		k += " " + host
+	}
+	return k + " " + strings.Join(rest, " ")
+}
+
+// diffAccuracy gets all rows that differ between stored and computed, sorted by
+// key.
+func diffAccuracy(tbl string, stored, computed map[string]string) AccuracyTable {
+	at := AccuracyTable{Table: tbl, Stored: len(stored), Computed: len(computed), Diffs: []AccuracyDiff{}}
+	for k, s := range stored {
+		if c := computed[k]; c != s {
+			at.Diffs = append(at.Diffs, AccuracyDiff{Key: k, Stored: s, Computed: c})
+		}
+	}
+	for k, c := range computed {
+		if _, ok := stored[k]; !ok {
+			at.Diffs = append(at.Diffs, AccuracyDiff{Key: k, Computed: c})
+		}
+	}
+	sort.Slice(at.Diffs, func(i, j int) bool { return at.Diffs[i].Key < at.Diffs[j].Key })
+	return at
+}
@@ -0,0 +1,93 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestAccuracyReport(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	day := time.Date(2020, 6, 18, 0, 0, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", FirstVisit: true, CreatedAt: day.Add(14 * time.Hour)},
+		goatcounter.Hit{Path: "/a", CreatedAt: day.Add(14 * time.Hour)},
+		goatcounter.Hit{Path: "/b", FirstVisit: true, CreatedAt: day.Add(16 * time.Hour)})
+
+	r, err := goatcounter.NewAccuracyReport(ctx, day, cron.ReindexRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK || r.Hits != 3 || len(r.Tables) != len(goatcounter.AccuracyTables) {
+		t.Fatalf("%#v", r)
+	}
+
+	_, err = zdb.MustGet(ctx).ExecContext(ctx,
+		`update hit_counts set total=5 where site=1 and path='/a'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = goatcounter.NewAccuracyReport(ctx, day, cron.ReindexRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.OK {
+		t.Fatal("OK is true")
+	}
+	d := r.Tables[0].Diffs
+	if len(d) != 1 || d[0].Key != "/a 14:00" || d[0].Stored != "5/1" || d[0].Computed != "2/1" {
+		t.Fatalf("%#v", d)
+	}
+
+	// Nothing was changed.
+	var total int
+	err = zdb.MustGet(ctx).GetContext(ctx, &total,
+		`select total from hit_counts where site=1 and path='/a'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 {
+		t.Errorf("total is %d", total)
+	}
+}
+
+func TestAccuracyReportRemoved(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+	defer gctest.SwapNow(t, "2020-06-18 12:00:00")()
+
+	site := goatcounter.MustGetSite(ctx)
+	site.Settings.DataRetention = 31
+	site.Settings.RetentionKeepStats = true
+	err := site.Update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := goatcounter.NewAccuracyReport(ctx, time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC), cron.ReindexRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.OK || !r.Unchecked || len(r.Tables) != 0 {
+		t.Fatalf("%#v", r)
+	}
+
+	r, err = goatcounter.NewAccuracyReport(ctx, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), cron.ReindexRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK || r.Unchecked {
+		t.Fatalf("%#v", r)
+	}
+}
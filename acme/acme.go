@@ -12,6 +12,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	crypto_acme "golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
@@ -113,9 +114,10 @@ func Setup(db zdb.DB, flag string) (*tls.Config, http.HandlerFunc, uint8) {
 			}
 
 			manager = &autocert.Manager{
-				Client: c,
-				Cache:  NewCache(dir),
-				Prompt: autocert.AcceptTOS,
+				Client:      c,
+				Cache:       NewCache(dir),
+				Prompt:      autocert.AcceptTOS,
+				RenewBefore: RenewBefore,
 				HostPolicy: func(ctx context.Context, host string) error {
 					var s goatcounter.Sites
 					ok, err := s.ContainsCNAME(zdb.With(ctx, db), host)
@@ -166,13 +168,24 @@ func Enabled() bool {
 	return manager != nil
 }
 
-// Make a new certificate for the domain.
-func Make(domain string) error {
+// RenewBefore is how long before the expiry a certificate is renewed.
+const RenewBefore = 30 * 24 * time.Hour
+
+// Make a new certificate for the domain, or renew it if it's about to expire.
+//
+// This returns the expiry of the certificate, or the zero time if the domain
+// doesn't forward to this server and no certificate was made.
+//
+// autocert renews certificates in the background and keeps returning the
+// cached certificate if that fails, so this returns an error (and the expiry)
+// if the certificate is still in the renewal window a day after it should have
+// been renewed.
+func Make(domain string) (time.Time, error) {
 	if manager == nil {
 		panic("acme.MakeCert: no manager, use Setup() first")
 	}
 	if !validForwarding(domain) {
-		return nil
+		return time.Time{}, nil
 	}
 
 	hello := &tls.ClientHelloInfo{
@@ -190,8 +203,26 @@ func Make(domain string) error {
 		},
 	}
 
-	_, err := manager.GetCertificate(hello)
-	return errors.Wrap(err, "acme.Make")
+	cert, err := manager.GetCertificate(hello)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "acme.Make")
+	}
+
+	leaf := cert.Leaf
+	if leaf == nil && len(cert.Certificate) > 0 {
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, "acme.Make")
+		}
+	}
+	if leaf == nil {
+		return time.Time{}, nil
+	}
+	if time.Until(leaf.NotAfter) < RenewBefore-24*time.Hour {
+		return leaf.NotAfter, errors.Errorf("acme.Make: certificate for %q expires at %s and wasn't renewed",
+			domain, leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return leaf.NotAfter, nil
 }
 
 var resolveSelf singleflight.Group
@@ -0,0 +1,256 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+)
+
+const (
+	// ACMERenewPeriod is how often the certificate of every custom domain is
+	// checked and renewed if needed.
+	ACMERenewPeriod = 24 * time.Hour
+
+	// ACMEAlertBefore and ACMEAlertFailures control when an alert is sent: if
+	// the certificate expires within ACMEAlertBefore and the renewal failed
+	// at least ACMEAlertFailures times in a row.
+	ACMEAlertBefore   = 7 * 24 * time.Hour
+	ACMEAlertFailures = 3
+)
+
+// ACMERenewal is the state of the ACME certificate renewal for a site's custom
+// domain.
+//
+// The renewals are staggered over ACMERenewPeriod, so they don't all run at
+// the same time. Failed renewals are retried with an exponential backoff, up
+// to ACMERenewPeriod.
+type ACMERenewal struct {
+	Cname string `db:"cname" json:"cname"`
+	Site  int64  `db:"site" json:"site"`
+
+	Failures    int        `db:"failures" json:"failures"`
+	LastError   *string    `db:"last_error" json:"last_error"`
+	LastErrorAt *time.Time `db:"last_error_at" json:"last_error_at"`
+
+	// Expiry of the certificate as of the last attempt; nil if it's not
+	// known.
+	ExpiresAt *time.Time `db:"expires_at" json:"expires_at"`
+
+	// Set when an alert was sent; cleared after a successful renewal.
+	AlertedAt *time.Time `db:"alerted_at" json:"alerted_at"`
+
+	NextAttemptAt time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+}
+
+// stagger gets the offset in ACMERenewPeriod at which the cname is renewed;
+// this is always the same for a cname.
+func (r ACMERenewal) stagger() time.Duration {
+	h := fnv.New32a()
+	h.Write([]byte(r.Cname))
+	return time.Duration(h.Sum32()%uint32(ACMERenewPeriod/time.Minute)) * time.Minute
+}
+
+// backoff gets the time to wait before the next attempt: 10 minutes after the
+// first failure, doubled for every failure after that up to ACMERenewPeriod.
+func (r ACMERenewal) backoff() time.Duration {
+	if r.Failures > 8 {
+		return ACMERenewPeriod
+	}
+	d := 10 * time.Minute << uint(r.Failures-1)
+	if d > ACMERenewPeriod {
+		return ACMERenewPeriod
+	}
+	return d
+}
+
+// Succeeded records a successful renewal; expires is the expiry of the
+// certificate, or the zero time if it's not known.
+func (r *ACMERenewal) Succeeded(ctx context.Context, expires time.Time) error {
+	r.Failures, r.LastError, r.LastErrorAt, r.AlertedAt = 0, nil, nil, nil
+	if !expires.IsZero() {
+		e := expires.UTC().Round(time.Second)
+		r.ExpiresAt = &e
+	}
+	r.NextAttemptAt = Now().Add(ACMERenewPeriod)
+
+	var exp interface{}
+	if r.ExpiresAt != nil {
+		exp = r.ExpiresAt.Format(zdb.Date)
+	}
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update acme_renewals set
+		failures=0, last_error=null, last_error_at=null, alerted_at=null,
+		expires_at=$1, next_attempt_at=$2 where cname=$3`,
+		exp, r.NextAttemptAt.Format(zdb.Date), r.Cname)
+	return errors.Wrap(err, "ACMERenewal.Succeeded")
+}
+
+// Failed records a failed renewal, and schedules the next attempt; expires is
+// the expiry of the current certificate, or the zero time if it's not known.
+func (r *ACMERenewal) Failed(ctx context.Context, renewErr error, expires time.Time) error {
+	now := Now()
+	errStr := renewErr.Error()
+	r.Failures++
+	r.LastError, r.LastErrorAt = &errStr, &now
+	r.NextAttemptAt = now.Add(r.backoff())
+	if !expires.IsZero() {
+		e := expires.UTC().Round(time.Second)
+		r.ExpiresAt = &e
+	}
+
+	var exp interface{}
+	if r.ExpiresAt != nil {
+		exp = r.ExpiresAt.Format(zdb.Date)
+	}
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update acme_renewals set
+		failures=$1, last_error=$2, last_error_at=$3, next_attempt_at=$4, expires_at=$5 where cname=$6`,
+		r.Failures, r.LastError, now.Format(zdb.Date), r.NextAttemptAt.Format(zdb.Date), exp, r.Cname)
+	return errors.Wrap(err, "ACMERenewal.Failed")
+}
+
+// NeedsAlert reports if an alert should be sent for this renewal: it failed at
+// least ACMEAlertFailures times in a row, the certificate expires within
+// ACMEAlertBefore, and no alert was sent yet for these failures.
+func (r ACMERenewal) NeedsAlert() bool {
+	return r.AlertedAt == nil && r.Failures >= ACMEAlertFailures &&
+		r.ExpiresAt != nil && r.ExpiresAt.Before(Now().Add(ACMEAlertBefore))
+}
+
+// SendAlert emails the site's user that the renewal keeps failing, and posts
+// the renewal as JSON to the -alert-webhook if it's set.
+//
+// The alert is marked as sent even if one of them fails, as the error is
+// returned and logged; otherwise it would be sent on every attempt.
+func (r *ACMERenewal) SendAlert(ctx context.Context) error {
+	now := Now()
+	r.AlertedAt = &now
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update acme_renewals set alerted_at=$1 where cname=$2`, now.Format(zdb.Date), r.Cname)
+	if err != nil {
+		return errors.Wrap(err, "ACMERenewal.SendAlert")
+	}
+
+	var (
+		errs = errors.NewGroup(2)
+		site Site
+		user User
+	)
+	err = site.ByID(ctx, r.Site)
+	if err == nil {
+		err = user.BySite(ctx, r.Site)
+	}
+	if err == nil {
+		err = SendEmail(WithSite(ctx, &site), "Your GoatCounter certificate for "+r.Cname+" can't be renewed",
+			"GoatCounter", user.Email, EmailTemplate("email_acme_failing.gotxt", struct {
+				Site    Site
+				Renewal ACMERenewal
+			}{site, *r}))
+	}
+	errs.Append(err)
+
+	if cfg.AlertWebhook != "" {
+		errs.Append(postWebhook(ctx, cfg.AlertWebhook, struct {
+			Kind string `json:"kind"`
+			ACMERenewal
+		}{"acme", *r}))
+	}
+	return errors.Wrap(errs.ErrorOrNil(), "ACMERenewal.SendAlert")
+}
+
+// ACMERenewals is a list of renewals.
+type ACMERenewals []ACMERenewal
+
+// Sync adds renewals for the cnames of all sites that don't have one yet, and
+// removes the renewals for cnames that are no longer used.
+func (r *ACMERenewals) Sync(ctx context.Context, sites Sites) error {
+	var have []string
+	db := zdb.MustGet(ctx)
+	err := db.SelectContext(ctx, &have, `select cname from acme_renewals`)
+	if err != nil {
+		return errors.Wrap(err, "ACMERenewals.Sync")
+	}
+
+	want := make(map[string]int64, len(sites))
+	for _, s := range sites {
+		if s.Cname != nil {
+			want[*s.Cname] = s.ID
+		}
+	}
+
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		for _, c := range have {
+			if _, ok := want[c]; ok {
+				delete(want, c)
+				continue
+			}
+			_, err := tx.ExecContext(ctx, `delete from acme_renewals where cname=$1`, c)
+			if err != nil {
+				return errors.Wrap(err, "ACMERenewals.Sync")
+			}
+		}
+
+		now := Now()
+		for c, siteID := range want {
+			n := ACMERenewal{Cname: c, Site: siteID}
+			n.NextAttemptAt = now.Truncate(ACMERenewPeriod).Add(n.stagger())
+			if n.NextAttemptAt.Before(now) {
+				n.NextAttemptAt = n.NextAttemptAt.Add(ACMERenewPeriod)
+			}
+			_, err := tx.ExecContext(ctx, `insert into acme_renewals (cname, site, next_attempt_at)
+				values ($1, $2, $3)`, n.Cname, n.Site, n.NextAttemptAt.Format(zdb.Date))
+			if err != nil {
+				return errors.Wrap(err, "ACMERenewals.Sync")
+			}
+		}
+		return nil
+	})
+}
+
+// ListDue lists at most limit renewals that are due, oldest first.
+func (r *ACMERenewals) ListDue(ctx context.Context, limit int) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, r, `/* ACMERenewals.ListDue */
+		select * from acme_renewals where next_attempt_at <= $1
+		order by next_attempt_at limit $2`,
+		Now().Format(zdb.Date), limit)
+	return errors.Wrap(err, "ACMERenewals.ListDue")
+}
+
+var webhookClient = http.Client{Timeout: 30 * time.Second}
+
+// postWebhook posts v as JSON to url.
+func postWebhook(ctx context.Context, url string, v interface{}) error {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return errors.Errorf("postWebhook: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(j))
+	if err != nil {
+		return errors.Errorf("postWebhook: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GoatCounter")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return errors.Errorf("postWebhook: %w", err)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("postWebhook: %s: unexpected response: %s", url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestACMERenewals(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 11, 9, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	a, b := "a.example.com", "b.example.com"
+	sites := goatcounter.Sites{{ID: 1, Cname: &a}, {ID: 1, Cname: &b}}
+
+	due := func() goatcounter.ACMERenewals {
+		t.Helper()
+		var r goatcounter.ACMERenewals
+		err := r.ListDue(ctx, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	var r goatcounter.ACMERenewals
+	err := r.Sync(ctx, sites)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing is due right away, and everything in the next period.
+	r = due()
+	if len(r) != 0 {
+		t.Fatalf("%d due: %v", len(r), r)
+	}
+	now = now.Add(goatcounter.ACMERenewPeriod)
+	r = due()
+	if len(r) != 2 {
+		t.Fatalf("%d due: %v", len(r), r)
+	}
+	if r[0].NextAttemptAt.Equal(r[1].NextAttemptAt) {
+		t.Errorf("not staggered: %s", r[0].NextAttemptAt)
+	}
+
+	// Backoff after failures.
+	ren := r[0]
+	expires := now.Add(5 * 24 * time.Hour)
+	err = ren.Succeeded(ctx, expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []time.Duration{10 * time.Minute, 20 * time.Minute, 40 * time.Minute} {
+		if ren.NeedsAlert() {
+			t.Fatalf("NeedsAlert after %d failures", i)
+		}
+		err = ren.Failed(ctx, errors.New("oh noes"), time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ren.NextAttemptAt.Sub(now); got != want {
+			t.Errorf("failure %d: next attempt in %s; want %s", i+1, got, want)
+		}
+	}
+	if !ren.NeedsAlert() {
+		t.Error("NeedsAlert is false")
+	}
+
+	r = due()
+	if len(r) != 1 || r[0].Cname == ren.Cname {
+		t.Fatalf("%v", r)
+	}
+
+	now = now.Add(time.Hour)
+	r = due()
+	var got goatcounter.ACMERenewal
+	for _, rr := range r {
+		if rr.Cname == ren.Cname {
+			got = rr
+		}
+	}
+	if got.Failures != 3 || got.LastError == nil || *got.LastError != "oh noes" ||
+		got.ExpiresAt == nil || !got.ExpiresAt.Equal(expires) {
+		t.Fatalf("%#v", got)
+	}
+
+	// The expiry of the certificate that wasn't renewed is recorded.
+	expires = expires.Add(-24 * time.Hour)
+	err = got.Failed(ctx, errors.New("not renewed"), expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ExpiresAt == nil || !got.ExpiresAt.Equal(expires) || !got.NeedsAlert() {
+		t.Errorf("%#v", got)
+	}
+
+	// Succeeding resets the failures.
+	err = got.Succeeded(ctx, expires.Add(90*24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Failures != 0 || got.LastError != nil || got.NeedsAlert() {
+		t.Errorf("%#v", got)
+	}
+
+	// Removed cnames are removed.
+	err = r.Sync(ctx, sites[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * goatcounter.ACMERenewPeriod)
+	r = due()
+	if len(r) != 1 || r[0].Cname != a {
+		t.Fatalf("%v", r)
+	}
+}
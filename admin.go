@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	"zgo.at/errors"
 	"zgo.at/goatcounter/bgrun"
 	"zgo.at/goatcounter/cfg"
@@ -35,12 +36,36 @@ type AdminStat struct {
 	Plan          string    `db:"plan"`
 	LastMonth     int       `db:"last_month"`
 	Total         int       `db:"total"`
+
+	// Total is an estimate of the number of rows in the hits table, rather
+	// than the sum of hit_counts.
+	Estimated bool `db:"-"`
 }
 
 type AdminStats []AdminStat
 
 // List stats for all sites, for all time.
-func (a *AdminStats) List(ctx context.Context) error {
+//
+// Summing the totals for all sites can take a very long time on large
+// instances, so the totals are estimated with EstimateSiteHits() unless exact
+// is set. Sites without an estimate are counted exactly.
+func (a *AdminStats) List(ctx context.Context, exact bool) error {
+	var est map[int64]int
+	if !exact {
+		var err error
+		est, err = EstimateSiteHits(ctx)
+		if err != nil {
+			return errors.Wrap(err, "AdminStats.List")
+		}
+	}
+
+	total := `coalesce((
+				select sum(hit_counts.total) from hit_counts where site=sites.id
+			), 0)`
+	if !exact {
+		total = `0`
+	}
+
 	err := zdb.MustGet(ctx).SelectContext(ctx, a, fmt.Sprintf(`/* AdminStats.List */
 		select
 			sites.id,
@@ -56,21 +81,32 @@ func (a *AdminStats) List(ctx context.Context) error {
 			stripe,
 			sites.link_domain,
 			(select email from users where site=sites.id or site=sites.parent) as email,
-			coalesce((
-				select sum(hit_counts.total) from hit_counts where site=sites.id
-			), 0) as total,
+			%s as total,
 			coalesce((
 				select sum(hit_counts.total) from hit_counts
 				where site=sites.id and hit_counts.hour >= %s
 			), 0) as last_month
 		from sites
-		order by last_month desc`, interval(30)))
+		order by last_month desc`, total, interval(30)))
 	if err != nil {
 		return errors.Wrap(err, "AdminStats.List")
 	}
 
-	// Add all the child plan counts to the parents.
 	aa := *a
+	if !exact {
+		for i := range aa {
+			if n, ok := est[aa[i].ID]; ok {
+				aa[i].Total, aa[i].Estimated = n, true
+				continue
+			}
+			aa[i].Total, err = countSiteHits(ctx, aa[i].ID)
+			if err != nil {
+				return errors.Wrap(err, "AdminStats.List")
+			}
+		}
+	}
+
+	// Add all the child plan counts to the parents.
 	for _, s := range aa {
 		if s.Plan != PlanChild {
 			continue
@@ -95,10 +131,14 @@ type AdminSiteStat struct {
 	CountTotal     int       `db:"count_total"`
 	CountLastMonth int       `db:"count_last_month"`
 	CountPrevMonth int       `db:"count_prev_month"`
+
+	// CountTotal is an estimate; see AdminStat.Estimated.
+	Estimated bool `db:"-"`
 }
 
-// ByID gets stats for a single site.
-func (a *AdminSiteStat) ByID(ctx context.Context, id int64) error {
+// ByID gets stats for a single site; CountTotal is estimated unless exact is
+// set, as with AdminStats.List().
+func (a *AdminSiteStat) ByID(ctx context.Context, id int64, exact bool) error {
 	err := a.Site.ByID(ctx, id)
 	if err != nil {
 		return err
@@ -109,29 +149,144 @@ func (a *AdminSiteStat) ByID(ctx context.Context, id int64) error {
 		return err
 	}
 
+	var est map[int64]int
+	if !exact {
+		est, err = EstimateSiteHits(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, "AdminSiteStats.ByID")
+		}
+	}
+	total := `coalesce((select sum(total) from hit_counts where site=$1), 0)`
+	if _, ok := est[id]; ok {
+		total = `0`
+	}
+
 	ival30 := interval(30)
 	ival60 := interval(30)
 	err = zdb.MustGet(ctx).GetContext(ctx, a, fmt.Sprintf(`/* *AdminSiteStat.ByID */
 		select
 			coalesce((select hour from hit_counts where site=$1 order by hour desc limit 1), '1970-01-01') as last_data,
-			coalesce((select sum(total) from hit_counts where site=$1), 0) as count_total,
+			%[3]s as count_total,
 			coalesce((select sum(total) from hit_counts where site=$1
 				and hour >= %[1]s), 0) as count_last_month,
 			coalesce((select sum(total) from hit_counts where site=$1
 				and hour >= %[2]s
 				and hour <= %[1]s
 			), 0) as count_prev_month
-		`, ival30, ival60), id)
-	return errors.Wrap(err, "AdminSiteStats.ByID")
+		`, ival30, ival60, total), id)
+	if err != nil {
+		return errors.Wrap(err, "AdminSiteStats.ByID")
+	}
+	if n, ok := est[id]; ok {
+		a.CountTotal, a.Estimated = n, true
+	}
+	return nil
 }
 
 // ByCode gets stats for a single site.
-func (a *AdminSiteStat) ByCode(ctx context.Context, code string) error {
+func (a *AdminSiteStat) ByCode(ctx context.Context, code string, exact bool) error {
 	err := a.Site.ByHost(ctx, code+"."+cfg.Domain)
 	if err != nil {
 		return err
 	}
-	return a.ByID(ctx, a.Site.ID)
+	return a.ByID(ctx, a.Site.ID, exact)
+}
+
+// EstimateHits estimates the number of rows in the hits table.
+//
+// This uses the planner statistics on PostgreSQL (pg_class.reltuples), and the
+// highest hit ID on SQLite. Both are fast on very large tables, but may be off
+// by quite a bit: reltuples is only updated by (auto)vacuum and analyze, and
+// the highest ID doesn't account for deleted rows.
+func EstimateHits(ctx context.Context) (int, error) {
+	db := zdb.MustGet(ctx)
+	if !cfg.PgSQL {
+		var n int
+		err := db.GetContext(ctx, &n, `/* EstimateHits */ select coalesce(max(id), 0) from hits`)
+		return n, errors.Wrap(err, "EstimateHits")
+	}
+
+	var n float64
+	err := db.GetContext(ctx, &n, `/* EstimateHits */
+		select reltuples from pg_class where oid = 'hits'::regclass`)
+	if err != nil {
+		return 0, errors.Wrap(err, "EstimateHits")
+	}
+	if n <= 0 { // Never analyzed.
+		err := db.GetContext(ctx, &n, `/* EstimateHits */ select coalesce(max(id), 0) from hits`)
+		if err != nil {
+			return 0, errors.Wrap(err, "EstimateHits")
+		}
+	}
+	return int(n), nil
+}
+
+// EstimateSiteHits estimates the total number of pageviews for the sites, or
+// all sites if siteIDs is empty.
+//
+// This is the number of pageviews in the last 30 days, scaled by the number of
+// days since the first pageview. Only the last 30 days of hit_counts are read,
+// which is fast even for very large sites, but the estimate will be off for
+// sites where the number of pageviews changed a lot over time.
+//
+// Sites without pageviews in the last 30 days aren't included, as there is
+// nothing to scale; use an exact count for those.
+func EstimateSiteHits(ctx context.Context, siteIDs ...int64) (map[int64]int, error) {
+	where, args := ``, []interface{}{}
+	if len(siteIDs) > 0 {
+		var err error
+		where, args, err = sqlx.In(` where id in (?)`, siteIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateSiteHits")
+		}
+	}
+
+	db := zdb.MustGet(ctx)
+	var st []struct {
+		ID         int64      `db:"id"`
+		CreatedAt  time.Time  `db:"created_at"`
+		FirstHitAt *time.Time `db:"first_hit_at"`
+		LastMonth  int        `db:"last_month"`
+	}
+	err := db.SelectContext(ctx, &st, db.Rebind(fmt.Sprintf(`/* EstimateSiteHits */
+		select
+			id, created_at, first_hit_at,
+			coalesce((
+				select sum(total) from hit_counts
+				where site=sites.id and hour >= %s
+			), 0) as last_month
+		from sites %s`, interval(30), where)), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "EstimateSiteHits")
+	}
+
+	var (
+		now = Now()
+		est = make(map[int64]int, len(st))
+	)
+	for _, s := range st {
+		if s.LastMonth == 0 {
+			continue
+		}
+		first := s.CreatedAt
+		if s.FirstHitAt != nil {
+			first = *s.FirstHitAt
+		}
+		days := now.Sub(first).Hours() / 24
+		if days < 30 {
+			days = 30
+		}
+		est[s.ID] = int(float64(s.LastMonth) * days / 30)
+	}
+	return est, nil
+}
+
+// countSiteHits counts the total number of pageviews for a site.
+func countSiteHits(ctx context.Context, siteID int64) (int, error) {
+	var n int
+	err := zdb.MustGet(ctx).GetContext(ctx, &n, `/* countSiteHits */
+		select coalesce(sum(total), 0) from hit_counts where site=$1`, siteID)
+	return n, errors.Wrap(err, "countSiteHits")
 }
 
 type AdminBotlog struct {
@@ -0,0 +1,90 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestEstimateHits(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a"},
+		goatcounter.Hit{Path: "/b"},
+		goatcounter.Hit{Path: "/c"})
+
+	n, err := goatcounter.EstimateHits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("EstimateHits: %d", n)
+	}
+
+	var exact goatcounter.AdminStats
+	err = exact.List(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exact) != 1 || exact[0].Total != 3 || exact[0].Estimated {
+		t.Errorf("wrong stats: %#v", exact)
+	}
+
+	// All pageviews are in the last 30 days.
+	var est goatcounter.AdminStats
+	err = est.List(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(est) != 1 || est[0].Total != 3 || !est[0].Estimated {
+		t.Errorf("wrong stats: %#v", est)
+	}
+
+	// Scaled by the number of days since the first pageview.
+	_, err = zdb.MustGet(ctx).ExecContext(ctx, `update sites set first_hit_at=$1 where id=1`,
+		goatcounter.Now().AddDate(0, 0, -90).Format(zdb.Date))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sites without pageviews in the last 30 days are counted exactly.
+	ctx2, site2 := gctest.Site(ctx, t, goatcounter.Site{})
+	gctest.StoreHits(ctx2, t, false,
+		goatcounter.Hit{Site: site2.ID, Path: "/a", CreatedAt: goatcounter.Now().AddDate(0, 0, -60)},
+		goatcounter.Hit{Site: site2.ID, Path: "/a", CreatedAt: goatcounter.Now().AddDate(0, 0, -60)})
+
+	e, err := goatcounter.EstimateSiteHits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e) != 1 || e[1] < 8 || e[1] > 10 {
+		t.Errorf("wrong estimate: %v", e)
+	}
+
+	est = goatcounter.AdminStats{}
+	err = est.List(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(est) != 2 || !est[0].Estimated || est[0].Total < 8 || est[0].Total > 10 ||
+		est[1].Estimated || est[1].Total != 2 {
+		t.Errorf("wrong stats: %#v", est)
+	}
+
+	var stat goatcounter.AdminSiteStat
+	err = stat.ByID(ctx, site2.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Estimated || stat.CountTotal != 2 {
+		t.Errorf("wrong stat: %d %t", stat.CountTotal, stat.Estimated)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+)
+
+// Audit log actions.
+const (
+	AuditImportReplace      = "import-replace"       // Removed all pageviews before an import.
+	AuditImportReplaceRange = "import-replace-range" // Removed pageviews in a date range before an import.
+	AuditTimezone           = "timezone"             // Changed the timezone; info is "old → new".
+)
+
+// AuditLog is a record of a destructive action on a site.
+type AuditLog struct {
+	ID        int64     `db:"audit_log_id" json:"id,readonly"`
+	Site      int64     `db:"site" json:"site,readonly"`
+	User      *int64    `db:"user_id" json:"user_id,readonly"`
+	Action    string    `db:"action" json:"action,readonly"`
+	Info      string    `db:"info" json:"info,readonly"`
+	CreatedAt time.Time `db:"created_at" json:"created_at,readonly"`
+}
+
+// Insert a new audit log record for the user in the context, and the site in
+// the context if Site isn't set.
+func (a *AuditLog) Insert(ctx context.Context) error {
+	if a.Site == 0 {
+		a.Site = MustGetSite(ctx).ID
+	}
+	if u := GetUser(ctx); u != nil && u.ID > 0 {
+		a.User = &u.ID
+	}
+	a.CreatedAt = Now()
+
+	var err error
+	a.ID, err = insertWithID(ctx, "audit_log_id",
+		`insert into audit_log (site, user_id, action, info, created_at) values ($1, $2, $3, $4, $5)`,
+		a.Site, a.User, a.Action, a.Info, a.CreatedAt.Format(zdb.Date))
+	return errors.Wrap(err, "AuditLog.Insert")
+}
+
+// AuditLogs is a list of audit log records.
+type AuditLogs []AuditLog
+
+// List all audit log records for the site in the context, newest first.
+func (a *AuditLogs) List(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, a,
+		`/* AuditLogs.List */ select * from audit_log where site=$1 order by created_at desc, audit_log_id desc`,
+		MustGetSite(ctx).ID)
+	return errors.Wrap(err, "AuditLogs.List")
+}
+
+// ListRecent lists the last limit audit log records for the site in the
+// context, newest first.
+func (a *AuditLogs) ListRecent(ctx context.Context, limit int) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, a, `/* AuditLogs.ListRecent */
+		select * from audit_log where site=$1
+		order by created_at desc, audit_log_id desc
+		limit $2`,
+		MustGetSite(ctx).ID, limit)
+	return errors.Wrap(err, "AuditLogs.ListRecent")
+}
+
+// TimezoneChange is a change of the site's timezone.
+type TimezoneChange struct {
+	From      string    // Location name, e.g. "Europe/Amsterdam".
+	To        string    // Location name, e.g. "Asia/Tokyo".
+	ChangedAt time.Time // In UTC.
+}
+
+// TimezoneChanges lists all timezone changes for the site in the context
+// between start and end, oldest first.
+//
+// All statistics are stored in UTC and displayed in the current timezone, so
+// after a change the statistics from before it are shown in the new timezone
+// too; this is used to tell people about that.
+func TimezoneChanges(ctx context.Context, start, end time.Time) ([]TimezoneChange, error) {
+	var logs AuditLogs
+	err := zdb.MustGet(ctx).SelectContext(ctx, &logs, `/* TimezoneChanges */
+		select * from audit_log
+		where site=$1 and action=$2 and created_at>=$3 and created_at<=$4
+		order by created_at asc, audit_log_id asc`,
+		MustGetSite(ctx).ID, AuditTimezone, start.Format(zdb.Date), end.Format(zdb.Date))
+	if err != nil {
+		return nil, errors.Wrap(err, "TimezoneChanges")
+	}
+
+	changes := make([]TimezoneChange, 0, len(logs))
+	for _, l := range logs {
+		ft := strings.SplitN(l.Info, " → ", 2)
+		if len(ft) != 2 {
+			continue
+		}
+		changes = append(changes, TimezoneChange{From: ft[0], To: ft[1], ChangedAt: l.CreatedAt})
+	}
+	return changes, nil
+}
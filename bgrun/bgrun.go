@@ -37,10 +37,19 @@ var (
 
 // Wait for all goroutines to finish for a maximum of maxWait.
 func Wait() error {
-	ctx, c := context.WithTimeout(context.Background(), maxWait)
-	defer c()
+	return errors.Wrap(wait(maxWait), "bgrun.Wait")
+}
+
+// WaitFor waits for all goroutines to finish for a maximum of d; use Running()
+// to get the goroutines that are still running if it returns an error.
+func WaitFor(d time.Duration) error {
+	return errors.Wrap(wait(d), "bgrun.WaitFor")
+}
 
-	return errors.Wrap(zsync.Wait(ctx, wg), "bgrun.Wait")
+func wait(d time.Duration) error {
+	ctx, c := context.WithTimeout(context.Background(), d)
+	defer c()
+	return zsync.Wait(ctx, wg)
 }
 
 // WaitProgress calls Wait() and prints which tasks it's waiting for.
@@ -153,3 +162,16 @@ func Add(name string) func() {
 		}()
 	}
 }
+
+// Running gets the names of all functions that are currently running, sorted
+// by name.
+func Running() []string {
+	working.Lock()
+	defer working.Unlock()
+	names := make([]string, 0, len(working.m))
+	for n := range working.m {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
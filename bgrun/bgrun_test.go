@@ -40,3 +40,24 @@ func TestWait(t *testing.T) {
 		t.Fatalf("wrong error; %#v", err)
 	}
 }
+
+func TestWaitFor(t *testing.T) {
+	WaitFor(10 * time.Second) // From previous tests.
+
+	Run("test waitfor", func() { time.Sleep(2 * time.Second) })
+	err := WaitFor(10 * time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wrong error; %#v", err)
+	}
+	if r := Running(); len(r) != 1 || r[0] != "test waitfor" {
+		t.Fatalf("running: %v", r)
+	}
+
+	err = WaitFor(10 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := Running(); len(r) != 0 {
+		t.Fatalf("running: %v", r)
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zstd/zint"
+	"zgo.at/zvalidate"
+)
+
+// BotSiteRule is the value for Hit.Bot if the hit matched one of the site's
+// BotRules.
+//
+// isbot uses small values for the backend detection, and values from 150 for
+// the detection in count.js (which is why lower values from count.js are
+// rejected); this is well above the values isbot currently uses for the
+// backend detection, and below the count.js range.
+const BotSiteRule = 100
+
+// BotScored is the value for Hit.Bot if the hit wasn't detected as a bot by
+// any single rule, but the BotScore is at or above the site's BotThreshold.
+const BotScored = 101
+
+// BotReasons is a bitmask of the reasons a pageview may be a bot.
+type BotReasons int
+
+// Bot reasons.
+const (
+	BotReasonUA         BotReasons = 1 << iota // User-Agent or request headers, or detected by count.js.
+	BotReasonRule                              // Matched one of the site's BotRules.
+	BotReasonRate                              // More than BotRate pageviews per minute in the session.
+	BotReasonDatacenter                        // IP address is in one of the DatacenterIPs.
+	BotReasonHoneypot                          // Requested a honeypot path.
+)
+
+// botReasons are the names and scores for all bot reasons; the score is the
+// likelihood that it's a bot from 0 to 100.
+var botReasons = []struct {
+	r     BotReasons
+	name  string
+	score int
+}{
+	{BotReasonUA, "ua", 100},
+	{BotReasonRule, "rule", 100},
+	{BotReasonRate, "rate", 50},
+	{BotReasonDatacenter, "datacenter", 40},
+	{BotReasonHoneypot, "honeypot", 100},
+}
+
+// BotRate is the number of pageviews per minute in a session above which it's
+// likely a bot; this is only checked once a session has at least this many
+// pageviews.
+const BotRate = 30
+
+// DatacenterIPs are IP addresses or CIDR ranges of datacenters, which are
+// likely to be bots; see BotReasonDatacenter. This is set from the
+// -datacenter-ips flag.
+var DatacenterIPs []string
+
+// Has reports if r has the reason.
+func (r BotReasons) Has(reason BotReasons) bool { return r&reason != 0 }
+
+// Score gets the bot score from 0 (not a bot) to 100 (certainly a bot); this
+// is the sum of the scores of all reasons.
+func (r BotReasons) Score() int {
+	var s int
+	for _, br := range botReasons {
+		if r.Has(br.r) {
+			s += br.score
+		}
+	}
+	if s > 100 {
+		return 100
+	}
+	return s
+}
+
+// Names gets the names of all reasons, e.g. "ua" or "rate".
+func (r BotReasons) Names() []string {
+	var n []string
+	for _, br := range botReasons {
+		if r.Has(br.r) {
+			n = append(n, br.name)
+		}
+	}
+	return n
+}
+
+func (r BotReasons) String() string { return strings.Join(r.Names(), ",") }
+
+// BotThresholdScore gets the score at or above which a pageview is counted as
+// a bot.
+func (ss SiteSettings) BotThresholdScore() int {
+	if ss.BotThreshold < 1 {
+		return 100
+	}
+	return ss.BotThreshold
+}
+
+// scoreBot sets the BotReasons and BotScore for the hit, and marks it as a bot
+// if the score is at or above the site's threshold.
+func (h *Hit) scoreBot(site *Site) {
+	if h.Bot > 0 && h.Bot != BotSiteRule && h.Bot != BotScored {
+		h.BotReasons |= BotReasonUA
+	}
+	if site.Settings.BotRules.Match(h) {
+		h.BotReasons |= BotReasonRule
+		if h.Bot == 0 {
+			h.Bot = BotSiteRule
+		}
+	}
+	if matchIP(DatacenterIPs, h.RemoteAddr, 128) {
+		h.BotReasons |= BotReasonDatacenter
+	}
+
+	h.BotScore = h.BotReasons.Score()
+	if h.Bot == 0 && h.BotScore > 0 && h.BotScore >= site.Settings.BotThresholdScore() {
+		h.Bot = BotScored
+	}
+}
+
+// addBotReason adds the reason to the hit after scoreBot(), and marks it as a
+// bot if the score is now at or above the site's threshold.
+func (h *Hit) addBotReason(site *Site, r BotReasons) {
+	h.BotReasons |= r
+	h.BotScore = h.BotReasons.Score()
+	if h.Bot == 0 && h.BotScore > 0 && h.BotScore >= site.Settings.BotThresholdScore() {
+		h.Bot = BotScored
+	}
+}
+
+// HoneypotSession is a session that requested the honeypot link; see
+// Memstore.Honeypot().
+type HoneypotSession struct {
+	Site    int64
+	Session zint.Uint128
+}
+
+// MarkStored adds BotReasonHoneypot to the pageviews of the session that were
+// stored before it requested the honeypot link.
+//
+// It returns the first and last day of the pageviews that are now counted as a
+// bot, as the statistics for these days need to be reindexed; both are zero if
+// there are none.
+func (s HoneypotSession) MarkStored(ctx context.Context, site *Site) (time.Time, time.Time, error) {
+	var first, last time.Time
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		var hits []Hit
+		err := tx.SelectContext(ctx, &hits, `/* HoneypotSession.MarkStored */
+			select id, created_at, bot, bot_score, bot_reasons from hits
+			where site=$1 and session2=$2`, s.Site, s.Session)
+		if err != nil {
+			return err
+		}
+
+		for _, h := range hits {
+			if h.BotReasons.Has(BotReasonHoneypot) {
+				continue
+			}
+			wasBot := h.Bot > 0
+			h.addBotReason(site, BotReasonHoneypot)
+			_, err := tx.ExecContext(ctx,
+				`update hits set bot=$1, bot_score=$2, bot_reasons=$3 where id=$4`,
+				h.Bot, h.BotScore, h.BotReasons, h.ID)
+			if err != nil {
+				return err
+			}
+
+			if !wasBot && h.Bot > 0 {
+				if first.IsZero() || h.CreatedAt.Before(first) {
+					first = h.CreatedAt
+				}
+				if h.CreatedAt.After(last) {
+					last = h.CreatedAt
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Wrap(err, "HoneypotSession.MarkStored")
+	}
+	return first, last, nil
+}
+
+// BotSummary is the number of pageviews for every bot score and reason.
+type BotSummary struct {
+	Total   int            // All pageviews.
+	Scores  map[int]int    // Score → pageviews with this score.
+	Reasons map[string]int // Reason name → pageviews with this reason.
+}
+
+// GetBotSummary gets the number of pageviews for every bot score and reason for
+// the site in the context since the given time.
+func GetBotSummary(ctx context.Context, since time.Time) (BotSummary, error) {
+	var rows []struct {
+		Score   int        `db:"bot_score"`
+		Reasons BotReasons `db:"bot_reasons"`
+		Count   int        `db:"count"`
+	}
+	err := zdb.MustGet(ctx).SelectContext(ctx, &rows, `/* GetBotSummary */
+		select bot_score, bot_reasons, count(*) as count from hits
+		where site=$1 and created_at >= $2
+		group by bot_score, bot_reasons`,
+		MustGetSite(ctx).ID, since.Format(zdb.Date))
+	if err != nil {
+		return BotSummary{}, errors.Wrap(err, "GetBotSummary")
+	}
+
+	b := BotSummary{Scores: make(map[int]int), Reasons: make(map[string]int)}
+	for _, r := range rows {
+		b.Total += r.Count
+		b.Scores[r.Score] += r.Count
+		for _, n := range r.Reasons.Names() {
+			b.Reasons[n] += r.Count
+		}
+	}
+	return b, nil
+}
+
+// AtThreshold gets the number of pageviews that are counted as a bot with
+// this threshold, because of their score.
+func (b BotSummary) AtThreshold(threshold int) int {
+	var n int
+	for s, c := range b.Scores {
+		if s > 0 && s >= threshold {
+			n += c
+		}
+	}
+	return n
+}
+
+// ApplyBotThreshold marks all stored pageviews of the site that are at or
+// above the site's BotThreshold as a bot, and the pageviews that were marked
+// as a bot only because of their score but are now below it as not a bot.
+//
+// This returns the number of pageviews that changed; the statistics need to be
+// reindexed after this.
+func (s Site) ApplyBotThreshold(ctx context.Context) (int64, error) {
+	threshold := s.Settings.BotThresholdScore()
+
+	var n int64
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		r, err := tx.ExecContext(ctx, `/* Site.ApplyBotThreshold */
+			update hits set bot=$1 where site=$2 and bot=0 and bot_score > 0 and bot_score >= $3`,
+			BotScored, s.ID, threshold)
+		if err != nil {
+			return err
+		}
+		m, _ := r.RowsAffected()
+		n += m
+
+		r, err = tx.ExecContext(ctx, `/* Site.ApplyBotThreshold */
+			update hits set bot=0 where site=$1 and bot=$2 and bot_score < $3`,
+			s.ID, BotScored, threshold)
+		if err != nil {
+			return err
+		}
+		m, _ = r.RowsAffected()
+		n += m
+		return nil
+	})
+	return n, errors.Wrap(err, "Site.ApplyBotThreshold")
+}
+
+// BotRules are custom rules to mark pageviews as a bot, for example to filter
+// uptime monitors or internal crawlers.
+//
+// A pageview is marked as a bot if any of the rules match.
+type BotRules struct {
+	// UserAgents are substrings of the User-Agent header; this is matched
+	// case-insensitive.
+	UserAgents zdb.Strings `json:"user_agents"`
+
+	// IPs are IP addresses or CIDR ranges, such as "10.0.0.0/8".
+	IPs zdb.Strings `json:"ips"`
+
+	// Paths are path patterns in the syntax of path.Match(), such as
+	// "/health" or "/internal/*".
+	Paths zdb.Strings `json:"paths"`
+}
+
+// Match reports if the hit matches any of the rules.
+func (r BotRules) Match(h *Hit) bool {
+	if len(r.UserAgents) > 0 {
+		ua := strings.ToLower(h.Browser)
+		for _, u := range r.UserAgents {
+			if u != "" && strings.Contains(ua, strings.ToLower(u)) {
+				return true
+			}
+		}
+	}
+
+	if matchIP(r.IPs, h.RemoteAddr, 128) {
+		return true
+	}
+
+	for _, p := range r.Paths {
+		if ok, _ := path.Match(p, h.Path); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate the rules, adding errors to v.
+func (r BotRules) validate(v *zvalidate.Validator) {
+	validateIPs(v, "settings.bot_rules.ips", r.IPs)
+
+	for _, p := range r.Paths {
+		if _, err := path.Match(p, ""); err != nil {
+			v.Append("settings.bot_rules.paths", fmt.Sprintf("invalid pattern: %q", p))
+		}
+	}
+}
+
+// matchIP reports if addr matches any of the IP addresses or CIDR ranges in
+// rules. The addr may contain a port.
+//
+// IPv6 addresses in rules match any address with the same network prefix of
+// v6prefix bits; use 128 to match only the exact address.
+func matchIP(rules []string, addr string, v6prefix int) bool {
+	if len(rules) == 0 || addr == "" {
+		return false
+	}
+
+	ip := parseAddr(addr)
+	if ip == nil {
+		return false
+	}
+	masked := maskIPv6(ip, v6prefix)
+
+	for _, rule := range rules {
+		if strings.Contains(rule, "/") {
+			_, n, err := net.ParseCIDR(rule)
+			if err == nil && n.Contains(ip) {
+				return true
+			}
+		} else if r := net.ParseIP(rule); r != nil && masked.Equal(maskIPv6(r, v6prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIPs validates that all entries in ips are an IP address or CIDR
+// range.
+func validateIPs(v *zvalidate.Validator, key string, ips []string) {
+	for _, ip := range ips {
+		if strings.Contains(ip, "/") {
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				v.Append(key, fmt.Sprintf("invalid CIDR range: %q", ip))
+			}
+			continue
+		}
+		v.IP(key, ip)
+	}
+}
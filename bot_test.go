@@ -0,0 +1,104 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestBotRulesMatch(t *testing.T) {
+	rules := goatcounter.BotRules{
+		UserAgents: []string{"UptimeRobot"},
+		IPs:        []string{"10.0.0.0/8", "2001:db8::1"},
+		Paths:      []string{"/health", "/internal/*"},
+	}
+
+	tests := []struct {
+		in   goatcounter.Hit
+		want bool
+	}{
+		{goatcounter.Hit{Path: "/"}, false},
+		{goatcounter.Hit{Path: "/", Browser: "Mozilla/5.0 (compatible; uptimerobot/2.0)"}, true},
+		{goatcounter.Hit{Path: "/", RemoteAddr: "10.1.2.3"}, true},
+		{goatcounter.Hit{Path: "/", RemoteAddr: "11.1.2.3"}, false},
+		{goatcounter.Hit{Path: "/", RemoteAddr: "[2001:db8::1]:80"}, true},
+		{goatcounter.Hit{Path: "/health"}, true},
+		{goatcounter.Hit{Path: "/healthy"}, false},
+		{goatcounter.Hit{Path: "/internal/x"}, true},
+		{goatcounter.Hit{Path: "/internal/x/y"}, false},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			got := rules.Match(&tt.in)
+			if got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBotReasons(t *testing.T) {
+	tests := []struct {
+		in        goatcounter.BotReasons
+		wantScore int
+		wantStr   string
+	}{
+		{0, 0, ""},
+		{goatcounter.BotReasonRate, 50, "rate"},
+		{goatcounter.BotReasonRate | goatcounter.BotReasonDatacenter, 90, "rate,datacenter"},
+		{goatcounter.BotReasonUA | goatcounter.BotReasonRate, 100, "ua,rate"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			if s := tt.in.Score(); s != tt.wantScore {
+				t.Errorf("score %d; want %d", s, tt.wantScore)
+			}
+			if s := tt.in.String(); s != tt.wantStr {
+				t.Errorf("string %q; want %q", s, tt.wantStr)
+			}
+		})
+	}
+}
+
+func TestBotThreshold(t *testing.T) {
+	goatcounter.DatacenterIPs = []string{"192.0.2.0/24"}
+	defer func() { goatcounter.DatacenterIPs = nil }()
+
+	tests := []struct {
+		threshold int
+		in        goatcounter.Hit
+		wantBot   int
+		wantScore int
+	}{
+		{0, goatcounter.Hit{Path: "/"}, 0, 0},
+		{0, goatcounter.Hit{Path: "/", Bot: 3}, 3, 100},
+		{0, goatcounter.Hit{Path: "/", RemoteAddr: "192.0.2.1"}, 0, 40},
+		{40, goatcounter.Hit{Path: "/", RemoteAddr: "192.0.2.1"}, goatcounter.BotScored, 40},
+		{50, goatcounter.Hit{Path: "/", RemoteAddr: "192.0.2.1"}, 0, 40},
+		{50, goatcounter.Hit{Path: "/health"}, goatcounter.BotSiteRule, 100},
+		{50, goatcounter.Hit{Path: "/health", Query: "\x01"}, goatcounter.BotSiteRule, 100},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			site := goatcounter.Site{ID: 1}
+			site.Settings.BotThreshold = tt.threshold
+			site.Settings.BotRules.Paths = []string{"/health"}
+			ctx := goatcounter.WithSite(context.Background(), &site)
+
+			tt.in.Defaults(ctx)
+			if tt.in.Bot != tt.wantBot || tt.in.BotScore != tt.wantScore {
+				t.Errorf("bot=%d score=%d; want bot=%d score=%d",
+					tt.in.Bot, tt.in.BotScore, tt.wantBot, tt.wantScore)
+			}
+		})
+	}
+}
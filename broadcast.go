@@ -0,0 +1,128 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+	"zgo.at/zstd/zcrypto"
+)
+
+// BroadcastChannel is the PostgreSQL channel used for broadcasts.
+const BroadcastChannel = "goatcounter"
+
+// Broadcast kinds.
+const (
+	BroadcastSite  = "site"  // Site was changed; clear the caches.
+	BroadcastSalt  = "salt"  // Session salt was rotated; use the new salt.
+	BroadcastPurge = "purge" // Pageviews were purged; drop them from the memstore.
+)
+
+// BroadcastMessage is a message to other instances.
+type BroadcastMessage struct {
+	Kind     string `json:"kind"`
+	Instance string `json:"instance"`
+
+	Site          int64  `json:"site,omitempty"`
+	Path          string `json:"path,omitempty"`
+	MatchTitle    bool   `json:"match_title,omitempty"`
+	CaseSensitive bool   `json:"case_sensitive,omitempty"`
+	CurSalt       []byte `json:"cur_salt,omitempty"`
+	PrevSalt      []byte `json:"prev_salt,omitempty"`
+}
+
+// instanceID identifies this process, so that it can ignore its own
+// broadcasts.
+var instanceID = zcrypto.Secret64()
+
+// Broadcast a message to all other instances that use the same database, with
+// PostgreSQL's NOTIFY. This is a no-op for SQLite, which can't be shared by
+// several servers.
+//
+// If the context has a transaction then the message is sent when it's
+// committed, and not at all if it's rolled back.
+//
+// Errors are logged, as the local state was already changed and the other
+// instances will catch up eventually (caches expire, salts are rotated).
+func Broadcast(ctx context.Context, msg BroadcastMessage) {
+	if !cfg.PgSQL {
+		return
+	}
+
+	msg.Instance = instanceID
+	j, err := json.Marshal(msg)
+	if err == nil {
+		_, err = zdb.MustGet(ctx).ExecContext(ctx,
+			`select pg_notify($1, $2)`, BroadcastChannel, string(j))
+	}
+	if err != nil {
+		zlog.Module("broadcast").Field("kind", msg.Kind).Error(err)
+	}
+}
+
+// HandleBroadcast handles a broadcast from another instance; payload is the
+// JSON-encoded BroadcastMessage.
+func HandleBroadcast(payload string) error {
+	var msg BroadcastMessage
+	err := json.Unmarshal([]byte(payload), &msg)
+	if err != nil {
+		return errors.Errorf("HandleBroadcast: %w", err)
+	}
+	if msg.Instance == instanceID {
+		return nil
+	}
+
+	switch msg.Kind {
+	case BroadcastSite:
+		clearSiteCache(msg.Site)
+	case BroadcastSalt:
+		if len(msg.CurSalt) == 0 || len(msg.PrevSalt) == 0 {
+			return errors.New("HandleBroadcast: salt: no salt")
+		}
+		Memstore.SetSalt(msg.CurSalt, msg.PrevSalt)
+	case BroadcastPurge:
+		Memstore.DropPath(msg.Site, msg.Path, msg.MatchTitle, msg.CaseSensitive)
+	default:
+		return errors.Errorf("HandleBroadcast: unknown kind %q", msg.Kind)
+	}
+	return nil
+}
+
+// likeMatch reports if s matches the SQL LIKE pattern.
+func likeMatch(pattern, s string, caseSensitive bool) bool {
+	if !caseSensitive {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+	p, r := []rune(pattern), []rune(s)
+
+	// Standard wildcard matching, backtracking to the last %.
+	var pi, ri, star, mark = 0, 0, -1, 0
+	for ri < len(r) {
+		switch {
+		case pi < len(p) && p[pi] == '\\' && pi+1 < len(p) && p[pi+1] == r[ri]:
+			pi, ri = pi+2, ri+1
+		case pi < len(p) && p[pi] != '\\' && p[pi] != '%' && (p[pi] == '_' || p[pi] == r[ri]):
+			pi, ri = pi+1, ri+1
+		case pi < len(p) && p[pi] == '%':
+			star, mark = pi, ri
+			pi++
+		case star > -1:
+			mark++
+			pi, ri = star+1, mark
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '%' {
+		pi++
+	}
+	return pi == len(p)
+}
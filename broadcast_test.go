@@ -0,0 +1,119 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestMemstoreDropPath(t *testing.T) {
+	tests := []struct {
+		pattern       string
+		caseSensitive bool
+		want          int
+	}{
+		{"/a", false, 1},
+		{"/A%", false, 3},
+		{"/A%", true, 0},
+		{"/a%", true, 3},
+		{"/_b", false, 2},
+		{"%b", false, 3},
+		{"/a\\_b", false, 1},
+		{"/a_b", false, 1},
+		{"/x", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s-%t", tt.pattern, tt.caseSensitive), func(t *testing.T) {
+			_, clean := gctest.DB(t)
+			defer clean()
+
+			for _, p := range []string{"/a", "/ab", "/a_b", "/bb"} {
+				goatcounter.Memstore.Append(goatcounter.Hit{Site: 1, Path: p, Session: goatcounter.TestSession})
+			}
+			goatcounter.Memstore.Append(goatcounter.Hit{Site: 2, Path: "/a", Session: goatcounter.TestSession})
+
+			got := goatcounter.Memstore.DropPath(1, tt.pattern, false, tt.caseSensitive)
+			if got != tt.want {
+				t.Errorf("dropped %d; want %d", got, tt.want)
+			}
+			if l := goatcounter.Memstore.Len(); l != 5-tt.want {
+				t.Errorf("memstore has %d hits", l)
+			}
+
+			// Don't leave anything for the next test.
+			goatcounter.Memstore.DropPath(1, "%", false, false)
+			goatcounter.Memstore.DropPath(2, "%", false, false)
+		})
+	}
+}
+
+func TestHandleBroadcast(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	t.Run("site", func(t *testing.T) {
+		var site goatcounter.Site
+		err := site.ByID(ctx, 1) // Cache it.
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = zdb.MustGet(ctx).ExecContext(ctx, `update sites set link_domain='example.com' where id=1`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = goatcounter.HandleBroadcast(`{"kind":"site","site":1}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		site = goatcounter.Site{}
+		err = site.ByID(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if site.LinkDomain != "example.com" {
+			t.Errorf("cache not cleared: %q", site.LinkDomain)
+		}
+	})
+
+	t.Run("salt", func(t *testing.T) {
+		// base64 for "cur" and "prev".
+		err := goatcounter.HandleBroadcast(`{"kind":"salt","cur_salt":"Y3Vy","prev_salt":"cHJldg=="}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotCur, gotPrev := goatcounter.Memstore.GetSalt()
+		if !bytes.Equal(gotCur, []byte("cur")) || !bytes.Equal(gotPrev, []byte("prev")) {
+			t.Errorf("salt not set: %q %q", gotCur, gotPrev)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		goatcounter.Memstore.Append(goatcounter.Hit{Site: 1, Path: "/purge", Session: goatcounter.TestSession})
+		err := goatcounter.HandleBroadcast(`{"kind":"purge","site":1,"path":"/purge"}`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l := goatcounter.Memstore.Len(); l != 0 {
+			t.Errorf("memstore has %d hits", l)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		for _, p := range []string{`{"kind":"nope"}`, `{"kind":"salt"}`, `nope`} {
+			err := goatcounter.HandleBroadcast(p)
+			if err == nil {
+				t.Errorf("no error for %s", p)
+			}
+		}
+	})
+}
@@ -18,6 +18,7 @@ type Cache interface {
 	Get(k string) (interface{}, bool)
 	Delete(k string)
 	Flush()
+	Items() map[string]zcache.Item
 }
 
 func New(defaultExpiration, cleanupInterval time.Duration) Cache {
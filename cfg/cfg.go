@@ -5,6 +5,8 @@
 // Package cfg contains global application configuration settings.
 package cfg
 
+import "time"
+
 // Configuration variables.
 var (
 	Domain         string
@@ -19,6 +21,57 @@ var (
 	Serve          bool
 	Port           string
 	EmailFrom      string
+	GeoDBURL       string
+	ExportDir      string
+
+	// ExportStorage is where finished exports are stored; see
+	// goatcounter.NewExportStorage() for the format.
+	ExportStorage string
+
+	// SessionIdle is the inactivity window after which a new session is
+	// started; SessionMax is the absolute maximum length of a session, or 0
+	// for no maximum.
+	SessionIdle = 4 * time.Hour
+	SessionMax  time.Duration
+
+	// SelfPing is the URL of the count endpoint to send a synthetic pageview
+	// to every few minutes; an error is logged if this fails or takes longer
+	// than SelfPingBudget.
+	SelfPing       string
+	SelfPingBudget = 2 * time.Second
+
+	// Diagnostics is the address to serve pprof and expvar on, without
+	// authentication; empty to not start the diagnostics server. They're
+	// always available on /debug/ for the admin site.
+	Diagnostics string
+
+	// AlertWebhook is an URL to POST alerts to as JSON, in addition to
+	// emailing the site's user; empty to only send email.
+	AlertWebhook string
+
+	// Ratelimit is the maximum number of pageviews per second from a single
+	// client. This can be changed while running, so use sync/atomic.
+	Ratelimit int64 = 4
+
+	// ImportRate is the maximum number of rows per second an import
+	// processes; 0 is no limit. This can be changed while running, so use
+	// sync/atomic.
+	ImportRate int64
+
+	// StatWorkers is the number of sites to update the statistics for in
+	// parallel after persisting the pageviews. This is always 1 on SQLite,
+	// which can only write from one connection at a time.
+	StatWorkers = 4
+
+	// Instance-level ceilings for the number of results. MaxHits is the
+	// maximum number of pageviews listed in one call to Hits.ListRange(),
+	// which is also the batch size for exports. MaxStats is the maximum
+	// number of rows for stats listers that accept a limit. MaxImportErrors
+	// is the maximum number of errors that are reported for an import;
+	// further errors are only counted.
+	MaxHits         = 5000
+	MaxStats        = 100
+	MaxImportErrors = 50
 
 	RunningTests bool
 )
@@ -0,0 +1,102 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"zgo.at/gadget"
+)
+
+// Map brand names from Sec-CH-UA to the names used for the User-Agent header.
+var brandNames = map[string]string{
+	"Google Chrome":  "Chrome",
+	"Microsoft Edge": "Edge",
+}
+
+var reBrand = regexp.MustCompile(`"([^"]*)"\s*;\s*v="([^"]*)"`)
+
+// BrowserInfo gets the browser name and version.
+//
+// This uses the Sec-CH-UA client hint if it's set, as Chrome no longer updates
+// the version in the User-Agent header, and falls back to the User-Agent
+// header otherwise.
+func (h Hit) BrowserInfo() (string, string) {
+	if name, version := parseBrands(h.UABrands); name != "" {
+		return name, version
+	}
+
+	ua := gadget.Parse(h.Browser)
+	return ua.BrowserName, ua.BrowserVersion
+}
+
+// SystemInfo gets the system name and version.
+//
+// This uses the Sec-CH-UA-Platform and Sec-CH-UA-Platform-Version client hints
+// if they're set, and falls back to the User-Agent header otherwise.
+func (h Hit) SystemInfo() (string, string) {
+	platform := strings.Trim(h.UAPlatform, `" `)
+	if platform == "" || platform == "Unknown" {
+		ua := gadget.Parse(h.Browser)
+		return ua.OSName, ua.OSVersion
+	}
+
+	return platform, platformVersion(platform, strings.Trim(h.UAPlatformVersion, `" `))
+}
+
+// parseBrands gets the most specific browser from a Sec-CH-UA header, such
+// as:
+//
+//	" Not A;Brand";v="99", "Chromium";v="88", "Google Chrome";v="88"
+//
+// The "GREASE" brands that are added to prevent naïve parsing are skipped, and
+// Chromium is only used if there is no other brand.
+func parseBrands(header string) (name, version string) {
+	for _, m := range reBrand.FindAllStringSubmatch(header, -1) {
+		b := strings.TrimSpace(m[1])
+		l := strings.ToLower(b)
+		if b == "" || (strings.Contains(l, "not") && strings.Contains(l, "brand")) {
+			continue
+		}
+		if n, ok := brandNames[b]; ok {
+			b = n
+		}
+
+		name, version = b, m[2]
+		if b != "Chromium" {
+			break
+		}
+	}
+	return name, version
+}
+
+// platformVersion converts the Sec-CH-UA-Platform-Version to the version we
+// get from the User-Agent, where possible.
+func platformVersion(platform, version string) string {
+	if version == "" {
+		return ""
+	}
+
+	// Windows sends the "UniversalApiContract" version: 1 to 10 is Windows 10,
+	// 13 and newer is Windows 11, and 0 is anything before Windows 10.
+	if platform == "Windows" {
+		major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+		switch {
+		case err != nil, major == 0:
+			return ""
+		case major >= 13:
+			return "11"
+		default:
+			return "10"
+		}
+	}
+
+	for strings.HasSuffix(version, ".0") {
+		version = strings.TrimSuffix(version, ".0")
+	}
+	return version
+}
@@ -0,0 +1,251 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+	"zgo.at/zstd/zcrypto"
+	"zgo.at/zstd/zint"
+	"zgo.at/zvalidate"
+)
+
+const usageBench = `
+Measure the performance of the ingest and dashboard code paths on a database
+seeded with generated pageviews.
+
+This creates a new site, generates -pageviews pageviews for it spread out over
+-paths paths and -days days, and reports how long the various steps take:
+
+    $ goatcounter bench -pageviews 500000 -paths 5000
+
+The same seed always generates the same pageviews, relative to the current
+hour, so the results of different versions can be compared. The Go benchmarks ("go test -bench .") are more
+precise for individual functions; this is intended to get an idea of the
+performance with a realistic amount of data.
+
+Flags:
+
+  -db          Database connection: "sqlite://<file>" or "postgres://<connect>"
+               See "goatcounter help db" for detailed documentation. Default
+               is a new SQLite database in a temporary directory, which is
+               removed afterwards. Use a new or scratch database, as the
+               generated data is not removed.
+
+  -debug       Modules to debug, comma-separated or 'all' for all modules.
+
+  -pageviews   Number of pageviews to generate. Default: 100000.
+
+  -paths       Number of paths. Default: 1000.
+
+  -days        Number of days to spread the pageviews over. Default: 30.
+
+  -runs        How often to run the dashboard queries. Default: 10.
+
+  -seed        Seed for the random data. Default: 1.
+`
+
+func bench() (int, error) {
+	dbConnect := CommandLine.String("db", "", "")
+	debug := flagDebug()
+	var nHits, nPaths, nDays, runs int
+	CommandLine.IntVar(&nHits, "pageviews", 100000, "")
+	CommandLine.IntVar(&nPaths, "paths", 1000, "")
+	CommandLine.IntVar(&nDays, "days", 30, "")
+	CommandLine.IntVar(&runs, "runs", 10, "")
+	seed := CommandLine.Int64("seed", 1, "")
+	err := CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		return 1, err
+	}
+
+	v := zvalidate.New()
+	v.Range("-pageviews", int64(nHits), 1, 100000000)
+	v.Range("-paths", int64(nPaths), 1, 1000000)
+	v.Range("-days", int64(nDays), 1, 3650)
+	v.Range("-runs", int64(runs), 1, 10000)
+	if v.HasErrors() {
+		return 1, v
+	}
+
+	zlog.Config.SetDebug(*debug)
+
+	if *dbConnect == "" {
+		dir, err := ioutil.TempDir("", "goatcounter-bench")
+		if err != nil {
+			return 2, err
+		}
+		defer os.RemoveAll(dir)
+		*dbConnect = "sqlite://" + filepath.Join(dir, "bench.sqlite3")
+	}
+
+	db, err := connectDB(*dbConnect, []string{"all"}, true)
+	if err != nil {
+		return 2, err
+	}
+	defer db.Close()
+	ctx := zdb.With(context.Background(), db)
+
+	site := goatcounter.Site{Code: "bench-" + zcrypto.Secret64()[:20], Plan: goatcounter.PlanPersonal}
+	err = site.Insert(ctx)
+	if err != nil {
+		return 2, err
+	}
+	ctx = goatcounter.WithSite(ctx, &site)
+
+	err = goatcounter.Memstore.Init(db)
+	if err != nil {
+		return 2, err
+	}
+
+	var (
+		end   = goatcounter.Now().Truncate(time.Hour)
+		start = end.Add(-time.Duration(nDays) * 24 * time.Hour)
+		hits  = benchHits(rand.New(rand.NewSource(*seed)), site.ID, nHits, nPaths, start, end)
+		res   []benchResult
+	)
+	fmt.Fprintf(stdout, "Generated %d pageviews for %d paths over %d days; database: %s\n\n",
+		nHits, nPaths, nDays, *dbConnect)
+
+	// Hit.Defaults() on a copy, as Memstore.Persist() calls it as well.
+	{
+		cp := make([]goatcounter.Hit, len(hits))
+		copy(cp, hits)
+		t := time.Now()
+		for i := range cp {
+			cp[i].Defaults(ctx)
+		}
+		res = append(res, benchResult{"Hit.Defaults", len(cp), time.Since(t)})
+	}
+
+	// Memstore and cron in batches, like the cron task does.
+	{
+		var (
+			tAppend, tPersist, tStats time.Duration
+			batch                     = 5000
+		)
+		for i := 0; i < len(hits); i += batch {
+			j := i + batch
+			if j > len(hits) {
+				j = len(hits)
+			}
+
+			t := time.Now()
+			goatcounter.Memstore.Append(hits[i:j]...)
+			tAppend += time.Since(t)
+
+			t = time.Now()
+			persisted, err := goatcounter.Memstore.Persist(ctx)
+			tPersist += time.Since(t)
+			if err != nil {
+				return 2, err
+			}
+
+			t = time.Now()
+			err = cron.UpdateStats(ctx, &site, site.ID, persisted, false)
+			tStats += time.Since(t)
+			if err != nil {
+				return 2, err
+			}
+		}
+		res = append(res,
+			benchResult{"Memstore.Append", len(hits), tAppend},
+			benchResult{"Memstore.Persist", len(hits), tPersist},
+			benchResult{"cron.UpdateStats", len(hits), tStats})
+	}
+
+	// Dashboard.
+	for _, daily := range []bool{false, true} {
+		name := map[bool]string{false: "hourly", true: "daily"}[daily]
+
+		t := time.Now()
+		for i := 0; i < runs; i++ {
+			var stats goatcounter.HitStats
+			_, _, _, _, err := stats.List(ctx, start, end, "", "", nil, daily)
+			if err != nil {
+				return 2, err
+			}
+		}
+		res = append(res, benchResult{"HitStats.List (" + name + ")", runs, time.Since(t)})
+
+		t = time.Now()
+		for i := 0; i < runs; i++ {
+			var total goatcounter.HitStat
+			_, err := total.Totals(ctx, start, end, "", "", daily)
+			if err != nil {
+				return 2, err
+			}
+		}
+		res = append(res, benchResult{"HitStat.Totals (" + name + ")", runs, time.Since(t)})
+	}
+
+	fmt.Fprintf(stdout, "%-28s %10s %14s %14s\n", "", "ops", "total", "per op")
+	for _, r := range res {
+		fmt.Fprintf(stdout, "%-28s %10d %14s %14s\n", r.name, r.ops,
+			r.total.Round(time.Microsecond), (r.total / time.Duration(r.ops)).Round(time.Nanosecond))
+	}
+	return 0, nil
+}
+
+type benchResult struct {
+	name  string
+	ops   int
+	total time.Duration
+}
+
+var (
+	benchBrowsers = []string{
+		"Mozilla/5.0 (X11; Linux x86_64; rv:81.0) Gecko/20100101 Firefox/81.0",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/86.0.4240.75 Safari/537.36",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.0 Safari/605.1.15",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 14_0_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.0 Mobile/15E148 Safari/604.1",
+		"Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/86.0.4240.75 Mobile Safari/537.36",
+	}
+	benchRefs      = []string{"", "", "", "https://news.ycombinator.com/", "https://www.google.com/", "https://lobste.rs/", "https://example.com/blog/post"}
+	benchLocations = []string{"", "US", "NL", "DE", "GB", "IN", "BR", "JP"}
+	benchSizes     = []zdb.Floats{{1920, 1080, 1}, {1440, 900, 2}, {375, 812, 3}, {412, 915, 2.6}}
+)
+
+// benchHits generates pageviews in order of creation; the paths have a skewed
+// distribution, like most sites. Every 20th pageview is an event.
+//
+// All random values, including the session IDs, are taken from rnd.
+func benchHits(rnd *rand.Rand, siteID int64, n, paths int, start, end time.Time) []goatcounter.Hit {
+	var (
+		hits = make([]goatcounter.Hit, n)
+		step = end.Sub(start) / time.Duration(n)
+		zipf = rand.NewZipf(rnd, 1.1, 1, uint64(paths-1))
+	)
+	for i := range hits {
+		h := goatcounter.Hit{
+			Site:       siteID,
+			Path:       fmt.Sprintf("/page-%d", zipf.Uint64()),
+			Title:      "A page",
+			Browser:    benchBrowsers[rnd.Intn(len(benchBrowsers))],
+			Ref:        benchRefs[rnd.Intn(len(benchRefs))],
+			Location:   benchLocations[rnd.Intn(len(benchLocations))],
+			Size:       benchSizes[rnd.Intn(len(benchSizes))],
+			CreatedAt:  start.Add(time.Duration(i) * step),
+			Session:    zint.Uint128{rnd.Uint64(), rnd.Uint64()},
+			FirstVisit: rnd.Intn(3) > 0,
+		}
+		if i%20 == 0 {
+			h.Event = true
+			h.Path = fmt.Sprintf("event-%d", rnd.Intn(10))
+		}
+		hits[i] = h
+	}
+	return hits
+}
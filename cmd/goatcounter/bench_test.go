@@ -0,0 +1,17 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"testing"
+)
+
+func TestBench(t *testing.T) {
+	_, dbc, clean := tmpdb(t)
+	defer clean()
+
+	run(t, 0, []string{"bench", "-db", dbc, "-pageviews", "500", "-paths", "10", "-days", "2", "-runs", "1"})
+	run(t, 1, []string{"bench", "-db", dbc, "-pageviews", "0"})
+}
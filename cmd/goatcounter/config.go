@@ -0,0 +1,375 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/zlog"
+)
+
+const usageConfig = `
+Check a configuration file for "goatcounter serve".
+
+    $ goatcounter config check -config /etc/goatcounter.toml
+
+This reports unknown settings, values that are of the wrong type, and invalid
+values; any other flags are used as well, just like with serve.
+
+Configuration file:
+
+  The -config flag for serve reads the settings from a file, in addition to the
+  flags. The names are the same as the flags, without the leading -. Flags on
+  the command line take precedence over the file.
+
+  The format is a subset of TOML: every line is a "key = value" pair, where the
+  value is a quoted string, a number, or true or false. Boolean flags must be
+  true or false. Tables and arrays are not supported. Comments start with #.
+
+      # Serve on port 8080 without TLS.
+      listen       = "localhost:8080"
+      tls          = "none"
+      db           = "postgresql://dbname=goatcounter"
+      session-idle = "2h"
+      automigrate  = true
+
+Reloading:
+
+  Send SIGHUP to reload these settings from the file while running; other
+  changes require a restart. Reloading isn't supported on Windows.
+
+      debug           Modules to debug.
+      ratelimit       Maximum number of pageviews per second from one client.
+      import-rate     Maximum number of rows per second for imports.
+      cron-interval   How often background tasks run.
+
+  A setting that's removed from the file is reset to the default, unless it was
+  set as a flag.
+
+Flags:
+
+  -config      Configuration file to check.
+`
+
+func config() (int, error) {
+	if len(os.Args) < 3 || os.Args[2] != "check" {
+		return 1, errors.New("need a subcommand: check")
+	}
+
+	loadedConfig.file = ""
+	_, err := flagsServeSelf(os.Args[3:])
+	if err != nil {
+		return 1, err
+	}
+	if loadedConfig.file == "" {
+		return 1, errors.New("-config is required")
+	}
+	fmt.Fprintf(stdout, "%s: OK\n", loadedConfig.file)
+	return 0, nil
+}
+
+// loadedConfig is the configuration file that was loaded with loadConfig().
+var loadedConfig struct {
+	sync.Mutex
+	file    string
+	cmdline map[string]struct{} // Flags that were set on the commandline.
+	values  map[string]string   // Values that are in effect, by key.
+}
+
+// loadConfig sets all flags in fs from the configuration file, except for
+// those already set on the commandline.
+func loadConfig(fs *flag.FlagSet, file string) error {
+	values, err := readConfig(fs, file)
+	if err != nil {
+		return err
+	}
+
+	loadedConfig.Lock()
+	defer loadedConfig.Unlock()
+	loadedConfig.file = file
+	loadedConfig.cmdline = make(map[string]struct{})
+	loadedConfig.values = make(map[string]string)
+	fs.Visit(func(f *flag.Flag) { loadedConfig.cmdline[f.Name] = struct{}{} })
+
+	for _, k := range sortedKeys(values) {
+		if _, ok := loadedConfig.cmdline[k]; ok {
+			continue
+		}
+		err := fs.Set(k, values[k])
+		if err != nil {
+			return errors.Errorf("%s: %s: %w", file, k, err)
+		}
+		loadedConfig.values[k] = values[k]
+	}
+	return nil
+}
+
+// readConfig reads the settings from a configuration file; see usageConfig for
+// the format.
+//
+// Every key must be a flag in fs, and the type of the value must match the
+// flag: boolean flags need true or false, and other flags a string or number.
+// The values are only checked and returned, not set.
+func readConfig(fs *flag.FlagSet, file string) (map[string]string, error) {
+	fp, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	var (
+		values = make(map[string]string)
+		seen   = make(map[string]int)
+		scan   = bufio.NewScanner(fp)
+	)
+	for n := 1; scan.Scan(); n++ {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' {
+			return nil, errors.Errorf("%s:%d: tables are not supported", file, n)
+		}
+
+		eq := strings.IndexByte(line, '=')
+		if eq == -1 {
+			return nil, errors.Errorf("%s:%d: not a key = value pair", file, n)
+		}
+		key, value := strings.TrimSpace(line[:eq]), strings.TrimSpace(line[eq+1:])
+		if key == "" {
+			return nil, errors.Errorf("%s:%d: no key", file, n)
+		}
+		f := fs.Lookup(key)
+		if key == "config" || f == nil {
+			return nil, errors.Errorf("%s:%d: unknown setting %q", file, n, key)
+		}
+		if prev, ok := seen[key]; ok {
+			return nil, errors.Errorf("%s:%d: %q is already set on line %d", file, n, key, prev)
+		}
+		seen[key] = n
+
+		value, isBool, err := parseConfigValue(value)
+		if err != nil {
+			return nil, errors.Errorf("%s:%d: %s: %w", file, n, key, err)
+		}
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			if !isBool {
+				return nil, errors.Errorf("%s:%d: %s: must be true or false", file, n, key)
+			}
+		} else if isBool {
+			return nil, errors.Errorf("%s:%d: %s: must be a string or number", file, n, key)
+		}
+		values[key] = value
+	}
+	return values, scan.Err()
+}
+
+// parseConfigValue parses a TOML value, which can be a quoted string, a number,
+// or true or false.
+func parseConfigValue(v string) (string, bool, error) {
+	switch {
+	case v == "":
+		return "", false, errors.New("no value")
+	case v[0] == '"':
+		// Basic string, with escapes.
+		end := 1
+		for ; end < len(v); end++ {
+			if v[end] == '\\' {
+				end++
+				continue
+			}
+			if v[end] == '"' {
+				break
+			}
+		}
+		if end >= len(v) {
+			return "", false, errors.New("unterminated string")
+		}
+		if err := configTrailing(v[end+1:]); err != nil {
+			return "", false, err
+		}
+		s, err := strconv.Unquote(v[:end+1])
+		return s, false, err
+	case v[0] == '\'':
+		// Literal string, without escapes.
+		end := strings.IndexByte(v[1:], '\'')
+		if end == -1 {
+			return "", false, errors.New("unterminated string")
+		}
+		if err := configTrailing(v[end+2:]); err != nil {
+			return "", false, err
+		}
+		return v[1 : end+1], false, nil
+	case v[0] == '[' || v[0] == '{':
+		return "", false, errors.New("arrays and tables are not supported")
+	}
+
+	// Bare values: strip comments.
+	if i := strings.IndexByte(v, '#'); i > -1 {
+		v = strings.TrimSpace(v[:i])
+	}
+	switch v {
+	case "true", "false":
+		return v, true, nil
+	}
+	if _, err := strconv.ParseFloat(strings.ReplaceAll(v, "_", ""), 64); err != nil {
+		return "", false, errors.Errorf("invalid value %q; strings must be quoted", v)
+	}
+	return strings.ReplaceAll(v, "_", ""), false, nil
+}
+
+func configTrailing(s string) error {
+	s = strings.TrimSpace(s)
+	if s != "" && s[0] != '#' {
+		return errors.Errorf("unexpected text after value: %q", s)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseCronInterval parses a list of cron task periods such as
+// "oldJobs=6h,sessions=30s".
+func parseCronInterval(s string) (map[string]time.Duration, error) {
+	p := make(map[string]time.Duration)
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("%q is not in the form task=duration", t)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, err
+		}
+		p[strings.TrimSpace(kv[0])] = d
+	}
+	return p, cron.CheckPeriods(p)
+}
+
+func setCronInterval(s string) error {
+	p, err := parseCronInterval(s)
+	if err != nil {
+		return err
+	}
+	return cron.SetPeriods(p)
+}
+
+// configReloadable are the settings that can be changed without a restart; the
+// check function is run for all settings before any are applied.
+var configReloadable = map[string]struct {
+	check func(string) error
+	apply func(string)
+}{
+	"debug": {
+		check: func(string) error { return nil },
+		apply: func(v string) { zlog.Config.SetDebug(v) },
+	},
+	"ratelimit": {
+		check: func(v string) error {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err == nil && n < 1 {
+				err = errors.New("must be at least 1")
+			}
+			return err
+		},
+		apply: func(v string) {
+			n, _ := strconv.ParseInt(v, 10, 64)
+			atomic.StoreInt64(&cfg.Ratelimit, n)
+		},
+	},
+	"import-rate": {
+		check: func(v string) error {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err == nil && n < 0 {
+				err = errors.New("must be 0 or more")
+			}
+			return err
+		},
+		apply: func(v string) {
+			n, _ := strconv.ParseInt(v, 10, 64)
+			atomic.StoreInt64(&cfg.ImportRate, n)
+		},
+	},
+	"cron-interval": {
+		check: func(v string) error { _, err := parseCronInterval(v); return err },
+		apply: func(v string) { setCronInterval(v) },
+	},
+}
+
+// reloadConfig applies the reloadable settings from the configuration file
+// that was loaded on startup. Nothing is changed if there are any errors.
+func reloadConfig(fs *flag.FlagSet) error {
+	loadedConfig.Lock()
+	defer loadedConfig.Unlock()
+
+	values, err := readConfig(fs, loadedConfig.file)
+	if err != nil {
+		return errors.Errorf("reloadConfig: %w", err)
+	}
+	for k := range loadedConfig.values {
+		if _, ok := values[k]; !ok {
+			values[k] = fs.Lookup(k).DefValue
+		}
+	}
+
+	var apply, changed []string
+	for _, k := range sortedKeys(values) {
+		if _, ok := loadedConfig.cmdline[k]; ok {
+			continue
+		}
+		old, ok := loadedConfig.values[k]
+		if !ok {
+			old = fs.Lookup(k).DefValue
+		}
+		if values[k] == old {
+			continue
+		}
+		r, ok := configReloadable[k]
+		if !ok {
+			changed = append(changed, k)
+			continue
+		}
+		err := r.check(values[k])
+		if err != nil {
+			return errors.Errorf("reloadConfig: %s: %w", k, err)
+		}
+		apply = append(apply, k)
+	}
+
+	for _, k := range apply {
+		configReloadable[k].apply(values[k])
+		loadedConfig.values[k] = values[k]
+	}
+
+	l := zlog.Module("config")
+	if len(changed) > 0 {
+		l.Printf("restart to apply the changes to: %s", strings.Join(changed, ", "))
+	}
+	l.Printf("reloaded %s; changed: %s", loadedConfig.file, strings.Join(apply, ", "))
+	return nil
+}
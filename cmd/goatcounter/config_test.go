@@ -0,0 +1,150 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"zgo.at/goatcounter/cfg"
+)
+
+func TestReadConfig(t *testing.T) {
+	tests := []struct {
+		in, want, wantErr string
+	}{
+		{"", "", ""},
+		{"# comment\n\n  listen = \":8080\"  # comment\n", "listen=:8080", ""},
+		{`db = "x\"y"` + "\nlisten = 'c:\\dir'\nmax-hits = 1_000\nautomigrate = true\ndev=false", `automigrate=true db=x"y dev=false listen=c:\dir max-hits=1000`, ""},
+
+		{"[serve]", "", ":1: tables are not supported"},
+		{"listen = b", "", `:1: listen: invalid value "b"; strings must be quoted`},
+		{"listen = \"b", "", ":1: listen: unterminated string"},
+		{"listen = 'b' c", "", `:1: listen: unexpected text after value: "c"`},
+		{"listen = [1]", "", ":1: listen: arrays and tables are not supported"},
+		{"listen", "", ":1: not a key = value pair"},
+		{"listen = \"a\"\nlisten = \"b\"", "", `:2: "listen" is already set on line 1`},
+		{"nope = 1", "", `:1: unknown setting "nope"`},
+		{"config = \"x\"", "", `:1: unknown setting "config"`},
+		{"automigrate = \"true\"", "", ":1: automigrate: must be true or false"},
+		{"listen = true", "", ":1: listen: must be a string or number"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			fs := flag.NewFlagSet("", flag.ContinueOnError)
+			fs.String("listen", "", "")
+			fs.String("db", "", "")
+			fs.String("config", "", "")
+			fs.Bool("automigrate", false, "")
+			fs.Bool("dev", false, "")
+			fs.Int("max-hits", 0, "")
+
+			f := configFile(t, tt.in)
+			defer os.Remove(f)
+			values, err := readConfig(fs, f)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("wrong error\ngot:  %v\nwant: %s", err, tt.wantErr)
+			}
+
+			var got []string
+			for _, k := range sortedKeys(values) {
+				got = append(got, k+"="+values[k])
+			}
+			if g := strings.Join(got, " "); g != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", g, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigCheck(t *testing.T) {
+	tests := []struct {
+		config   string
+		wantCode int
+	}{
+		{"listen = \"localhost:8081\"\ntls = \"none\"\nmax-hits = 200\n", 0},
+		{"nope = 1", 1},
+		{"max-hits = \"x\"", 1},
+		{"max-hits = 1", 1},
+		{"cron-interval = \"nope=1h\"", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			f := configFile(t, tt.config)
+			defer os.Remove(f)
+			run(t, tt.wantCode, []string{"config", "check", "-config", f})
+		})
+	}
+	run(t, 1, []string{"config", "check"})
+}
+
+func TestReloadConfig(t *testing.T) {
+	defer atomic.StoreInt64(&cfg.Ratelimit, cfg.Ratelimit)
+
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String("listen", "", "")
+	fs.String("debug", "", "")
+	fs.Int64("ratelimit", 4, "")
+	fs.String("cron-interval", "", "")
+
+	f := configFile(t, "listen = \":8080\"\nratelimit = 10\n")
+	defer os.Remove(f)
+	err := loadConfig(fs, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(f, []byte("listen = \":8081\"\nratelimit = 0\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = reloadConfig(fs)
+	if !errorContains(err, "ratelimit: must be at least 1") {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	err = ioutil.WriteFile(f, []byte("listen = \":8081\"\nratelimit = 20\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = reloadConfig(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := atomic.LoadInt64(&cfg.Ratelimit); r != 20 {
+		t.Errorf("ratelimit is %d", r)
+	}
+	if l := fs.Lookup("listen").Value.String(); l != ":8080" {
+		t.Errorf("listen was changed to %q", l)
+	}
+}
+
+func configFile(t *testing.T, content string) string {
+	t.Helper()
+	fp, err := ioutil.TempFile("", "goatcounter-config-*.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+	_, err = fmt.Fprint(fp, content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fp.Name()
+}
+
+func errorContains(err error, want string) bool {
+	if err == nil {
+		return want == ""
+	}
+	return want != "" && strings.Contains(err.Error(), want)
+}
@@ -0,0 +1,35 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+// +build !windows
+
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"zgo.at/zlog"
+)
+
+// reloadOnSignal reloads the configuration file on SIGHUP.
+//
+// zhttp.Serve() stops the server on SIGHUP, so this must be called once the
+// server is started; it removes the SIGHUP handler of zhttp.Serve().
+func reloadOnSignal(fs *flag.FlagSet) {
+	signal.Reset(syscall.SIGHUP)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	go func() {
+		defer zlog.Recover()
+		for range c {
+			err := reloadConfig(fs)
+			if err != nil {
+				zlog.Error(err)
+			}
+		}
+	}()
+}
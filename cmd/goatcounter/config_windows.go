@@ -0,0 +1,11 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import "flag"
+
+// reloadOnSignal does nothing, as signals such as SIGHUP can't be sent on
+// Windows.
+func reloadOnSignal(fs *flag.FlagSet) {}
@@ -5,22 +5,29 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
 
 	"zgo.at/errors"
+	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/pack"
 	"zgo.at/zdb"
 )
 
 const helpDatabase = `
-The db command accepts one of three commands;
+The db command accepts one of four commands;
 
     schema-sqlite      Print the SQLite database schema.
     schema-pgsql       Print the PostgreSQL database schema.
     test               Test if the database seems to exist; exits with 0 on
                        success, 2 if there's a DB connection error, and 1 on any
                        other error. This requires setting a -db flag.
+    restore-site ID    Restore a deleted site and its child sites with all
+                       their data; this is possible until they're removed after
+                       the -delete-grace of the serve command. This requires
+                       setting a -db flag.
 
 These are mostly useful for setting up new instances in scripts, e.g.:
 
@@ -89,6 +96,11 @@ PostgreSQL:
 
         createdb goatcounter
         psql goatcounter < ./db/schema.pgsql
+
+    You can run several instances with the same PostgreSQL database; changes
+    to sites, session salts, and purged paths are sent to the other instances
+    with LISTEN/NOTIFY on the "goatcounter" channel, so that they take effect
+    everywhere right away.
 `
 
 func database() (int, error) {
@@ -100,7 +112,7 @@ func database() (int, error) {
 	cmd := CommandLine.Args()
 
 	if len(os.Args) == 0 {
-		return 1, fmt.Errorf("need a subcommand: schema-sqlite, schema-pgsql, or test")
+		return 1, fmt.Errorf("need a subcommand: schema-sqlite, schema-pgsql, test, or restore-site")
 	}
 	switch cmd[0] {
 	default:
@@ -126,6 +138,32 @@ func database() (int, error) {
 			return 2, fmt.Errorf("select 1 from version: %w", err)
 		}
 		fmt.Println("DB seems okay")
+	case "restore-site":
+		if *dbConnect == "" {
+			return 1, errors.New("must add -db flag")
+		}
+		if len(cmd) != 2 {
+			return 1, errors.New("need a site ID")
+		}
+		id, err := strconv.ParseInt(cmd[1], 10, 64)
+		if err != nil {
+			return 1, fmt.Errorf("invalid site ID: %w", err)
+		}
+
+		db, err := connectDB(*dbConnect, nil, false)
+		if err != nil {
+			return 2, err
+		}
+		defer db.Close()
+
+		var sites goatcounter.Sites
+		err = sites.Restore(zdb.With(context.Background(), db), id)
+		if err != nil {
+			return 1, err
+		}
+		for _, s := range sites {
+			fmt.Printf("restored site %d (%s)\n", s.ID, s.Code)
+		}
 	}
 
 	return 0, nil
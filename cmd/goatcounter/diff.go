@@ -0,0 +1,168 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"zgo.at/goatcounter"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+const usageDiff = `
+Compare two exports of pageviews, or an export with the pageviews in the
+database, to verify backups and migrations between instances.
+
+The number of rows and a checksum of the rows is compared for every day (in
+UTC), and all days that are different are printed:
+
+    $ goatcounter diff old.csv.gz new.csv.gz
+    2020-06-18  rows 1042 → 1040
+    2020-06-19  rows 980 → 980   checksum differs
+    2 days are different
+
+With one export it's compared with the pageviews of the site in the database,
+for the days from the first to the last day in the export:
+
+    $ goatcounter diff -db sqlite://new.sqlite3 -site 1 old.csv.gz
+
+The ID and session aren't compared, as these are different after importing on
+another instance.
+
+The exit code is 0 if there are no differences, 3 if there are, and 1 or 2 on
+errors.
+
+Flags:
+
+  -db          Database connection: "sqlite://<file>" or "postgres://<connect>"
+               See "goatcounter help db" for detailed documentation. Default:
+               sqlite://db/goatcounter.sqlite3?_busy_timeout=200&_journal_mode=wal&cache=shared
+
+  -debug       Modules to debug, comma-separated or 'all' for all modules.
+
+  -site        Site ID to compare with, if only one export is given. Can be
+               omitted if there is only one site.
+`
+
+func diff() (int, error) {
+	dbConnect := flagDB()
+	debug := flagDebug()
+	siteID := CommandLine.Int64("site", 0, "")
+	err := CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		return 1, err
+	}
+
+	files := CommandLine.Args()
+	if len(files) == 0 {
+		return 1, fmt.Errorf("need a filename")
+	}
+	if len(files) > 2 {
+		return 1, fmt.Errorf("can specify at most two filenames")
+	}
+
+	zlog.Config.SetDebug(*debug)
+
+	a, err := diffRead(files[0])
+	if err != nil {
+		return 1, err
+	}
+
+	var (
+		b     goatcounter.ExportDigest
+		nameB = "database"
+	)
+	if len(files) == 2 {
+		nameB = files[1]
+		b, err = diffRead(files[1])
+		if err != nil {
+			return 1, err
+		}
+	} else {
+		b, err = diffDB(*dbConnect, *siteID, a)
+		if err != nil {
+			return 2, err
+		}
+	}
+
+	for _, n := range []struct {
+		name string
+		d    goatcounter.ExportDigest
+	}{{files[0], a}, {nameB, b}} {
+		if n.d.Invalid > 0 {
+			fmt.Fprintf(stdout, "%s: %d rows can't be read and are not compared\n", n.name, n.d.Invalid)
+		}
+	}
+
+	d := goatcounter.DiffExports(a, b)
+	for _, day := range d {
+		fmt.Fprintf(stdout, "%s  rows %d → %d", day.Day, day.A.Rows, day.B.Rows)
+		if day.A.Rows == day.B.Rows {
+			fmt.Fprint(stdout, "   checksum differs")
+		}
+		fmt.Fprintln(stdout)
+	}
+	if len(d) > 0 {
+		fmt.Fprintf(stdout, "%d days are different\n", len(d))
+		return 3, nil
+	}
+	fmt.Fprintf(stdout, "no differences in %d days\n", len(a.Days))
+	return 0, nil
+}
+
+func diffRead(file string) (goatcounter.ExportDigest, error) {
+	fp, err := os.Open(file)
+	if err != nil {
+		return goatcounter.ExportDigest{}, err
+	}
+	defer fp.Close()
+
+	d, err := goatcounter.ReadExportDigest(fp)
+	if err != nil {
+		return d, fmt.Errorf("%s: %w", file, err)
+	}
+	return d, nil
+}
+
+// diffDB gets the digest of the pageviews in the database, for the same days
+// as the export.
+func diffDB(dbConnect string, siteID int64, export goatcounter.ExportDigest) (goatcounter.ExportDigest, error) {
+	db, err := connectDB(dbConnect, nil, false)
+	if err != nil {
+		return goatcounter.ExportDigest{}, err
+	}
+	defer db.Close()
+	ctx := zdb.With(context.Background(), db)
+
+	var site goatcounter.Site
+	if siteID > 0 {
+		err = site.ByID(ctx, siteID)
+	} else {
+		var sites goatcounter.Sites
+		err = sites.UnscopedList(ctx)
+		if err == nil {
+			switch len(sites) {
+			case 0:
+				err = fmt.Errorf("there are no sites in the database")
+			case 1:
+				site = sites[0]
+			default:
+				err = fmt.Errorf("more than one site: use -site to specify which site to compare with")
+			}
+		}
+	}
+	if err != nil {
+		return goatcounter.ExportDigest{}, err
+	}
+
+	start, end := export.Range()
+	if start.IsZero() {
+		return goatcounter.ExportDigest{}, nil
+	}
+	return goatcounter.DBExportDigest(goatcounter.WithSite(ctx, &site), start, end)
+}
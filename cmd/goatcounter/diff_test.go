@@ -0,0 +1,47 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goatcounter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	header := strings.Join(goatcounter.ExportHeader(), ",") + "\n"
+	write := func(name string, rows ...string) string {
+		path := filepath.Join(dir, name)
+		err := ioutil.WriteFile(path, []byte(header+strings.Join(rows, "\n")+"\n"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	a := write("a.csv",
+		"/a,,false,0,1,true,,,,,,2020-06-18T12:00:00Z,1",
+		"/b,,false,0,1,true,,,,,,2020-06-19T12:00:00Z,2")
+	b := write("b.csv",
+		"/b,,false,0,2,true,,,,,,2020-06-19T12:00:00Z,6",
+		"/a,,false,0,2,true,,,,,,2020-06-18T12:00:00Z,5")
+	c := write("c.csv",
+		"/a,,false,0,1,true,,,,,,2020-06-18T12:00:00Z,1",
+		"/c,,false,0,1,true,,,,,,2020-06-19T12:00:00Z,2")
+
+	run(t, 0, []string{"diff", a, b})
+	run(t, 3, []string{"diff", a, c})
+	run(t, 1, []string{"diff", a, filepath.Join(dir, "nonexistent")})
+}
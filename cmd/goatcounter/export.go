@@ -0,0 +1,123 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+	"zgo.at/zvalidate"
+)
+
+const usageExport = `
+Export the pageviews or statistics of all sites on this instance, for backups
+or to migrate to another server.
+
+Every site is exported to a separate file, with a manifest, as if it was
+exported from the site's settings; the files are written to -export-storage,
+or -export-dir if that's not set:
+
+    $ goatcounter export -export-dir /var/backup/goatcounter
+
+The filename of every finished export is printed. Exports of pageviews can be
+imported on another instance with "goatcounter import", and you can use
+"goatcounter verify" to verify a copy of the file.
+
+Flags:
+
+  -db          Database connection: "sqlite://<file>" or "postgres://<connect>"
+               See "goatcounter help db" for detailed documentation. Default:
+               sqlite://db/goatcounter.sqlite3?_busy_timeout=200&_journal_mode=wal&cache=shared
+
+  -debug       Modules to debug, comma-separated or 'all' for all modules.
+
+  -kind        What to export: "hits" for all pageviews (default), or "stats"
+               for the aggregated statistics.
+
+  -site        Only export these site IDs, comma-separated. Default is to
+               export all sites.
+
+  -export-dir  Directory to write exports to. Default: the system's temporary
+               directory.
+
+  -export-storage
+               Where to store finished exports; see "goatcounter help serve".
+               Default: the -export-dir.
+
+  -quiet       Don't print the filenames.
+`
+
+func export() (int, error) {
+	dbConnect := flagDB()
+	debug := flagDebug()
+	kind := CommandLine.String("kind", goatcounter.ExportHits, "")
+	siteFlag := CommandLine.String("site", "", "")
+	quiet := CommandLine.Bool("quiet", false, "")
+	CommandLine.StringVar(&cfg.ExportDir, "export-dir", "", "")
+	CommandLine.StringVar(&cfg.ExportStorage, "export-storage", "", "")
+	err := CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		return 1, err
+	}
+
+	v := zvalidate.New()
+	v.Include("-kind", *kind, goatcounter.ExportKinds)
+	var siteIDs []int64
+	if *siteFlag != "" {
+		for _, s := range strings.Split(*siteFlag, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				v.Append("-site", fmt.Sprintf("not a site ID: %q", s))
+				continue
+			}
+			siteIDs = append(siteIDs, id)
+		}
+	}
+	if cfg.ExportDir != "" {
+		if st, err := os.Stat(cfg.ExportDir); err != nil || !st.IsDir() {
+			v.Append("-export-dir", "must be an existing directory")
+		}
+	}
+	if cfg.ExportStorage != "" {
+		if _, err := goatcounter.NewExportStorage(cfg.ExportStorage); err != nil {
+			v.Append("-export-storage", err.Error())
+		}
+	}
+	if v.HasErrors() {
+		return 1, v
+	}
+
+	zlog.Config.SetDebug(*debug)
+
+	db, err := connectDB(*dbConnect, nil, false)
+	if err != nil {
+		return 2, err
+	}
+	defer db.Close()
+	ctx := zdb.With(context.Background(), db)
+
+	var exports goatcounter.Exports
+	err = exports.CreateAll(ctx, *kind, siteIDs...)
+	if !*quiet {
+		for _, e := range exports {
+			rows := 0
+			if e.NumRows != nil {
+				rows = *e.NumRows
+			}
+			fmt.Fprintf(stdout, "site %d: %s (%d rows)\n", e.SiteID, e.Path, rows)
+		}
+	}
+	if err != nil {
+		return 2, err
+	}
+	return 0, nil
+}
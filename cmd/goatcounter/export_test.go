@@ -0,0 +1,54 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestExport(t *testing.T) {
+	ctx, dbc, clean := tmpdb(t)
+	defer clean()
+
+	run(t, 0, []string{"create",
+		"-email", "foo@foo.foo",
+		"-domain", "stats.stats",
+		"-password", "password",
+		"-db", dbc})
+
+	dir, err := ioutil.TempDir("", "goatcounter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	run(t, 0, []string{"export", "-db", dbc, "-export-dir", dir, "-quiet"})
+
+	var s goatcounter.Site
+	err = s.ByID(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exports goatcounter.Exports
+	err = exports.List(goatcounter.WithSite(ctx, &s), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exports) != 1 {
+		t.Fatalf("len(exports) = %d", len(exports))
+	}
+	for _, f := range []string{exports[0].Path, exports[0].ManifestPath()} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	run(t, 1, []string{"export", "-db", dbc, "-kind", "xxx"})
+}
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/csv"
@@ -30,11 +31,12 @@ import (
 )
 
 const usageImport = `
-Import pageviews from an export
+Import pageviews from an export or a server access log
 
 You must give one filename to import; use - to read from stdin:
 
     $ goatcounter import export.csv.gz
+    $ goatcounter import -format combined /var/log/nginx/access.log
 
 This requires a running GoatCounter instance; it's a front-end for the API
 rather than a tool to modify the database directly. If you're running this on
@@ -62,7 +64,49 @@ Flags:
 
   -format      File format; currently accepted values:
 
-                   csv   GoatCounter CSV export (default)
+                   csv        GoatCounter CSV export (default)
+                   plausible  Plausible CSV export of pages per day
+                   fathom     Fathom CSV export of pages per day
+                   matomo     Matomo JSON from the Live.getLastVisitsDetails API
+                   combined   Apache or nginx "combined" access log
+                   common     Apache or nginx "common" access log
+                   caddy      Caddy JSON access log
+
+               Exports from Plausible, Fathom, and Matomo are detected
+               automatically with the default of "csv". Plausible and Fathom
+               only export the number of pageviews and visitors per page per
+               day; a pageview is created for every counted pageview at 12:00
+               UTC, without referrer, browser, or location. For Plausible use
+               the imported_pages CSV file from the exported zip file. Matomo
+               includes the individual visits, which are imported as sessions.
+
+               Access logs only import successful GET requests for pages;
+               requests for static files (CSS, images, etc.) and requests from
+               bots are skipped. Visitors are grouped in sessions by IP and
+               User-Agent, with a new session after -session-idle of
+               inactivity. The "common" format has no User-Agent, so all
+               requests are imported as unknown browsers and nothing is
+               skipped as a bot.
+
+  -session-idle
+               Inactivity window for access logs, as a duration (e.g. "30m").
+               Default: 4h.
+
+  -transform   File with commands to change rows before they're imported, for
+               example when combining several sites in one. One command per
+               line; blank lines and lines starting with # are ignored:
+
+                   strip-domain        Remove the domain from paths, so
+                                       "example.com/page" becomes "/page".
+                   drop <pattern>      Don't import paths matching the
+                                       pattern; % matches any text and _ any
+                                       single character.
+                   prefix <from> <to>  Replace the path prefix <from> with
+                                       <to>; only the first match is used.
+                   title <title>       Use this title for all rows.
+
+               The commands are applied in this order, so drop patterns match
+               the original path.
 
 Environment:
 
@@ -84,6 +128,8 @@ func importCmd() (int, error) {
 	var format, siteFlag string
 	CommandLine.StringVar(&siteFlag, "site", "", "")
 	CommandLine.StringVar(&format, "format", "csv", "")
+	idle := CommandLine.Duration("session-idle", cfg.SessionIdle, "")
+	transform := CommandLine.String("transform", "", "")
 	CommandLine.BoolVar(&silent, "silent", false, "")
 	err := CommandLine.Parse(os.Args[2:])
 	if err != nil {
@@ -109,6 +155,18 @@ func importCmd() (int, error) {
 		defer fp.Close()
 	}
 
+	var tr goatcounter.ImportTransform
+	if *transform != "" {
+		conf, err := ioutil.ReadFile(*transform)
+		if err != nil {
+			return 1, err
+		}
+		tr, err = goatcounter.ParseImportTransform(string(conf))
+		if err != nil {
+			return 1, fmt.Errorf("-transform: %w", err)
+		}
+	}
+
 	zlog.Config.SetDebug(*debug)
 
 	url, key, clean, err := findSite(siteFlag, *dbConnect)
@@ -119,12 +177,28 @@ func importCmd() (int, error) {
 		defer clean()
 	}
 
-	var n int
+	var (
+		n      int
+		report goatcounter.ImportReport
+	)
 	switch format {
 	default:
 		return 1, fmt.Errorf("unknown -format value: %q", format)
-	case "csv":
-		n, err = importCSV(fp, url, key)
+	case goatcounter.ImportFormatGoatCounter, goatcounter.ImportFormatPlausible,
+		goatcounter.ImportFormatFathom, goatcounter.ImportFormatMatomo:
+		// Detect exports from other tools if no -format was given.
+		if format == goatcounter.ImportFormatGoatCounter {
+			format = ""
+		}
+		var conv io.ReadCloser
+		conv, _, err = goatcounter.ConvertImport(fp, format)
+		if err != nil {
+			return 1, err
+		}
+		defer conv.Close()
+		n, err = importCSV(conv, url, key, tr, &report)
+	case goatcounter.AccessLogCombined, goatcounter.AccessLogCommon, goatcounter.AccessLogCaddy:
+		n, err = importLog(fp, format, url, key, *idle, tr, &report)
 	}
 	if err != nil {
 		var gErr *errors.Group
@@ -137,10 +211,22 @@ func importCmd() (int, error) {
 	if !silent {
 		zli.EraseLine()
 		fmt.Printf("Done! Imported %d rows\n", n)
+		printReport("pageviews with an unknown browser", report.Browsers)
+		printReport("pageviews with an invalid location; imported as unknown", report.Locations)
 	}
 	return 0, nil
 }
 
+func printReport(msg string, u goatcounter.ImportUnknown) {
+	if u.Total() == 0 {
+		return
+	}
+	fmt.Printf("\n%d %s; most common:\n", u.Total(), msg)
+	for _, v := range u.Top(10) {
+		fmt.Printf("  %6d  %s\n", v.Count, zstring.ElideLeft(v.Value, 70))
+	}
+}
+
 var (
 	importClient = http.Client{Timeout: 5 * time.Second}
 	nSent        int
@@ -222,22 +308,21 @@ func importSend(url, key string, hits []handlers.APICountRequestHit) error {
 	return nil
 }
 
-func importCSV(fp io.Reader, url, key string) (int, error) {
+func importCSV(fp io.Reader, url, key string, tr goatcounter.ImportTransform, report *goatcounter.ImportReport) (int, error) {
 	c := csv.NewReader(fp)
 	header, err := c.Read()
 	if err != nil {
 		return 0, err
 	}
-	if len(header) == 0 || !strings.HasPrefix(header[0], goatcounter.ExportVersion) {
-		return 0, errors.Errorf(
-			"wrong version of CSV database: %s (expected: %s)",
-			header[0][:1], goatcounter.ExportVersion)
+	dec, err := goatcounter.NewExportDecoder(header)
+	if err != nil {
+		return 0, err
 	}
 
 	var (
 		n        = 0
-		sessions = make(map[zint.Uint128]zint.Uint128)
-		errs     = errors.NewGroup(50)
+		sessions = make(map[string]zint.Uint128)
+		errs     = errors.NewGroup(cfg.MaxImportErrors)
 		hits     = make([]handlers.APICountRequestHit, 0, 100)
 	)
 	for {
@@ -252,16 +337,19 @@ func importCSV(fp io.Reader, url, key string) (int, error) {
 			continue
 		}
 
-		var row goatcounter.ExportRow
-		if errs.Append(row.Read(line)) {
+		row, err := dec.Decode(line)
+		if errs.Append(err) {
 			if !silent {
 				zli.Errorf(err)
 			}
 			continue
 		}
+		if !tr.Apply(&row) {
+			continue
+		}
 
 		hit, err := row.Hit(0)
-		if errs.Append(row.Read(line)) {
+		if errs.Append(err) {
 			if !silent {
 				zli.Errorf(err)
 			}
@@ -269,12 +357,14 @@ func importCSV(fp io.Reader, url, key string) (int, error) {
 		}
 
 		// Map session IDs to new session IDs.
-		s, ok := sessions[hit.Session]
+		s, ok := sessions[row.Session]
 		if !ok {
-			sessions[hit.Session] = goatcounter.Memstore.SessionID()
+			s = goatcounter.Memstore.SessionID()
+			sessions[row.Session] = s
 		}
 		hit.Session = s
 
+		report.Check(&hit)
 		hits = append(hits, handlers.APICountRequestHit{
 			Path:      hit.Path,
 			Title:     hit.Title,
@@ -304,6 +394,122 @@ func importCSV(fp io.Reader, url, key string) (int, error) {
 	return n, errs.ErrorOrNil()
 }
 
+// logSession is a session synthesized from the IP and User-Agent in an access
+// log.
+type logSession struct {
+	id   string
+	last time.Time
+}
+
+func importLog(
+	fp io.Reader, format, url, key string, idle time.Duration,
+	tr goatcounter.ImportTransform, report *goatcounter.ImportReport,
+) (int, error) {
+	var (
+		n        = 0
+		skipped  = 0
+		bots     = 0
+		dropped  = 0
+		sessions = make(map[string]*logSession)
+		newest   time.Time // Newest line, to evict sessions that ended.
+		evicted  time.Time
+		errs     = errors.NewGroup(cfg.MaxImportErrors)
+		hits     = make([]handlers.APICountRequestHit, 0, 100)
+		scan     = bufio.NewScanner(fp)
+	)
+	scan.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scan.Scan() {
+		if len(bytes.TrimSpace(scan.Bytes())) == 0 {
+			continue
+		}
+
+		line, err := goatcounter.ParseAccessLog(format, scan.Text())
+		if errs.Append(err) {
+			if !silent {
+				zli.Errorf(err)
+			}
+			continue
+		}
+		if !line.IsPageview() {
+			skipped++
+			continue
+		}
+		if format != goatcounter.AccessLogCommon && line.IsBot() {
+			bots++
+			continue
+		}
+		row := goatcounter.ExportRow{Path: line.Path}
+		if !tr.Apply(&row) {
+			dropped++
+			continue
+		}
+
+		// New session if this IP and User-Agent were inactive for too long;
+		// the log is usually in order, but don't rely on it.
+		k := line.IP + "\x00" + line.UserAgent
+		s, ok := sessions[k]
+		if !ok || line.Time.Sub(s.last) > idle || s.last.Sub(line.Time) > idle {
+			s = &logSession{id: goatcounter.Memstore.SessionID().String()}
+			sessions[k] = s
+		}
+		if line.Time.After(s.last) {
+			s.last = line.Time
+		}
+
+		// Remove sessions that ended, so this doesn't keep every IP and
+		// User-Agent in the log in memory. Wait for twice the idle time, so
+		// lines that are a bit out of order still use the same session.
+		if line.Time.After(newest) {
+			newest = line.Time
+		}
+		if newest.Sub(evicted) > idle {
+			for k, ls := range sessions {
+				if newest.Sub(ls.last) > 2*idle {
+					delete(sessions, k)
+				}
+			}
+			evicted = newest
+		}
+
+		hit := goatcounter.Hit{Browser: line.UserAgent}
+		report.Check(&hit)
+		hits = append(hits, handlers.APICountRequestHit{
+			Path:      row.Path,
+			Title:     row.Title,
+			Query:     line.Query,
+			Host:      line.Host,
+			Ref:       line.Ref,
+			UserAgent: line.UserAgent,
+			IP:        line.IP,
+			CreatedAt: line.Time,
+			Session:   s.id,
+		})
+		if len(hits) >= 100 {
+			err := importSend(url, key, hits)
+			if errs.Append(err) {
+				if !silent {
+					zli.Errorf(err)
+				}
+			}
+			hits = make([]handlers.APICountRequestHit, 0, 100)
+		}
+		n++
+	}
+	errs.Append(scan.Err())
+	if len(hits) > 0 {
+		errs.Append(importSend(url, key, hits))
+	}
+
+	if !silent {
+		zli.EraseLine()
+		fmt.Printf("Skipped %d requests that aren't pageviews and %d requests from bots\n", skipped, bots)
+		if dropped > 0 {
+			fmt.Printf("Dropped %d requests with -transform\n", dropped)
+		}
+	}
+	return n, errs.ErrorOrNil()
+}
+
 func findSite(siteFlag, dbConnect string) (string, string, func(), error) {
 	var (
 		url, key string
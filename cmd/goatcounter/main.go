@@ -42,6 +42,11 @@ var usage = map[string]string{
 	"reindex": usageReindex,
 	"monitor": usageMonitor,
 	"import":  usageImport,
+	"verify":  usageVerify,
+	"diff":    usageDiff,
+	"export":  usageExport,
+	"bench":   usageBench,
+	"config":  usageConfig,
 
 	"database": helpDatabase,
 	"db":       helpDatabase,
@@ -67,10 +72,15 @@ Commands:
   create       Create a new site and user.
   serve        Start HTTP server.
   import       Import pageviews from export.
+  verify       Verify a downloaded export with its manifest.
+  diff         Compare two exports, or an export with the database.
+  export       Export all sites on this instance.
 
 Advanced commands:
   reindex      Recreate the index tables (*_stats, *_count) from the hits.
   monitor      Monitor for pageviews.
+  bench        Measure performance on a database with generated pageviews.
+  config       Check a configuration file.
   db           Print database information and detailed docs on the -db flag.
 
 Extra help topics:
@@ -119,6 +129,16 @@ func main() {
 		code, err = monitor()
 	case "import":
 		code, err = importCmd()
+	case "verify":
+		code, err = verify()
+	case "diff":
+		code, err = diff()
+	case "export":
+		code, err = export()
+	case "bench":
+		code, err = bench()
+	case "config":
+		code, err = config()
 	case "db", "database":
 		code, err = database()
 	}
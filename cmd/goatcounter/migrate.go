@@ -5,11 +5,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
 	"zgo.at/errors"
+	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/pack"
 	"zgo.at/zdb"
@@ -30,6 +32,14 @@ Flags:
 
   -debug       Modules to debug, comma-separated or 'all' for all modules.
 
+  -search-index
+               Create the optional search index to speed up filtering on the
+               path and title on sites with a large number of paths. This uses
+               pg_trgm on PostgreSQL, and FTS5 on SQLite (which must be
+               version 3.34 or newer, compiled with the sqlite_fts5 build tag).
+               This doesn't change what the filter matches. Run it again to
+               update an index created by an older version.
+
 Positional arguments are names of database migrations, either as just the name
 ("2020-01-05-2-foo") or as the file path ("./db/migrate/sqlite/2020-01-05-2-foo.sql").
 
@@ -42,14 +52,15 @@ on startup.
 
 func migrate() (int, error) {
 	if len(os.Args) == 2 {
-		return 1, errors.New("need a migration or command")
+		return 1, errors.New("need a migration, command, or -search-index")
 	}
 
 	dbConnect := flagDB()
 	debug := flagDebug()
 
-	var createdb bool
+	var createdb, searchIndex bool
 	CommandLine.BoolVar(&createdb, "createdb", false, "")
+	CommandLine.BoolVar(&searchIndex, "search-index", false, "")
 	err := CommandLine.Parse(os.Args[2:])
 	if err != nil {
 		return 1, err
@@ -63,6 +74,13 @@ func migrate() (int, error) {
 	}
 	defer db.Close()
 
+	if searchIndex {
+		err := goatcounter.CreateSearchIndex(zdb.With(context.Background(), db))
+		if err != nil {
+			return 1, err
+		}
+	}
+
 	if zstring.Contains(CommandLine.Args(), "show") {
 		m := zdb.NewMigrate(db, []string{"show"},
 			map[bool]map[string][]byte{true: pack.MigrationsPgSQL, false: pack.MigrationsSQLite}[cfg.PgSQL],
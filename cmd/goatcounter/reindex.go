@@ -11,8 +11,6 @@ import (
 	"strings"
 	"time"
 
-	nnow "github.com/jinzhu/now"
-	"github.com/jmoiron/sqlx"
 	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/cron"
 	"zgo.at/zdb"
@@ -27,7 +25,14 @@ generate charts. These are normally updated automatically in the background.
 This command recreates these tables. This is mostly for upgrades; you shouldn't
 have to run this in normal usage.
 
-This command may take a while to run on larger sites.
+This command may take a while to run on larger sites. The progress is recorded
+after every month; if the command is interrupted then "goatcounter serve"
+resumes the reindex in the background on startup. Reindexes can also be started,
+paused, and resumed from the API.
+
+The browser_stats and system_stats use the User-Agent Client Hints stored for
+pageviews if they're available; reindexing these tables will apply any
+improvements in the browser and system detection to older pageviews.
 
 Avoiding race conditions
 
@@ -49,17 +54,21 @@ Flags:
   -debug       Modules to debug, comma-separated or 'all' for all modules.
 
   -pause       Number of seconds to pause after each month, to give the server
-               some breathing room on large sites. Default: 0.
+               some breathing room on large sites. Reindexing also pauses
+               automatically when the database is busy. Default: 0.
 
   -since       Reindex only statistics since this date instead of all of them;
                as year-month-day in UTC.
 
+  -since-hit   Reindex only statistics since the day of the pageview with this
+               ID; this can't be used with -since.
+
   -to          Reindex only statistics up to and including this day; as
                year-month-day in UTC. The default is the current day.
 
   -table       Which tables to reindex: hit_stats, hit_counts, browser_stats,
-               system_stats, location_stats, ref_counts, size_stats, or all
-               (default).
+               system_stats, location_stats, ref_counts, size_stats,
+               host_stats, campaign_stats, or all (default).
 
   -site        Only reindex this site ID. Default is to reindex all.
 
@@ -70,6 +79,7 @@ func reindex() (int, error) {
 	dbConnect := flagDB()
 	debug := flagDebug()
 	since := CommandLine.String("since", "", "")
+	sinceHit := CommandLine.Int64("since-hit", 0, "")
 	to := CommandLine.String("to", "", "")
 	table := CommandLine.String("table", "all", "")
 	pause := CommandLine.Int("pause", 0, "")
@@ -84,13 +94,25 @@ func reindex() (int, error) {
 	tables := strings.Split(*table, ",")
 
 	v := zvalidate.New()
-	firstDay := v.Date("-since", *since, "2006-01-02")
-	lastDay := v.Date("-to", *to, "2006-01-02")
+	var firstDay, lastDay *time.Time
+	if *since != "" {
+		d := v.Date("-since", *since, "2006-01-02")
+		firstDay = &d
+	}
+	if *to != "" {
+		d := v.Date("-to", *to, "2006-01-02")
+		lastDay = &d
+	}
+	var hitID *int64
+	if *sinceHit > 0 {
+		hitID = sinceHit
+		if firstDay != nil {
+			v.Append("-since-hit", "can't be used with -since")
+		}
+	}
 
 	for _, t := range tables {
-		v.Include("-table", t, []string{"hit_stats", "hit_counts",
-			"browser_stats", "system_stats", "location_stats",
-			"ref_counts", "size_stats", "all"})
+		v.Include("-table", t, goatcounter.ReindexTables)
 	}
 	if v.HasErrors() {
 		return 1, v
@@ -105,160 +127,51 @@ func reindex() (int, error) {
 	defer db.Close()
 	ctx := zdb.With(context.Background(), db)
 
-	if *since == "" {
-		w := ""
-		if site > 0 {
-			w = fmt.Sprintf(" where site=%d ", site)
-		}
-
-		var first string
-		err := db.GetContext(ctx, &first, `select created_at from hits `+w+` order by created_at asc limit 1`)
-		if err != nil {
-			if zdb.ErrNoRows(err) {
-				return 0, nil
-			}
-			return 1, err
-		}
-
-		firstDay, err = time.Parse("2006-01-02", first[:10])
-		if err != nil {
-			return 1, err
-		}
-	}
-	if *to == "" {
-		lastDay = time.Now().UTC()
-	}
-
 	var sites goatcounter.Sites
 	err = sites.UnscopedList(ctx)
 	if err != nil {
 		return 1, err
 	}
 
+	if *pause > 0 {
+		goatcounter.RegisterJob(goatcounter.JobKind{
+			Name:   goatcounter.JobReindex,
+			Class:  goatcounter.JobClassMaintenance,
+			Pacing: goatcounter.JobPacing{Every: 1, Sleep: time.Duration(*pause) * time.Second, Max: 30 * time.Second},
+		})
+	}
+
 	for i, s := range sites {
 		if site > 0 && s.ID != site {
 			continue
 		}
-		err := dosite(ctx, s, tables, *pause, firstDay, lastDay, *quiet, len(sites), i+1)
-		if err != nil {
-			return 1, err
-		}
-	}
-
-	if !*quiet {
-		fmt.Fprintln(stdout, "")
-	}
-	return 0, nil
-}
 
-func dosite(
-	ctx context.Context, site goatcounter.Site, tables []string,
-	pause int, firstDay, lastDay time.Time, quiet bool,
-	nsites, isite int,
-) error {
-	db := zdb.MustGet(ctx).(*sqlx.DB)
-	siteID := site.ID
-
-	if firstDay.Before(site.CreatedAt) {
-		firstDay = site.CreatedAt
-	}
-
-	now := goatcounter.Now()
-	now = time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, time.UTC)
-
-	months := [][]time.Time{
-		{firstDay, nnow.With(firstDay).EndOfMonth()},
-	}
-
-	start := nnow.With(nnow.With(firstDay).EndOfMonth().Add(12 * time.Hour)).BeginningOfMonth()
-	for {
-		if start.After(now) {
-			break
-		}
-
-		end := nnow.With(start).EndOfMonth()
-		if end.After(lastDay) {
-			months = append(months, []time.Time{start, lastDay})
-			break
-		}
-
-		months = append(months, []time.Time{start, end})
-		start = nnow.With(end.Add(12 * time.Hour)).BeginningOfMonth()
-	}
-
-	// Insert paths.
-	query := `select * from hits where site=$1 and created_at >= $2 and created_at <= $3`
-
-	var pauses time.Duration
-	if pause > 0 {
-		pauses = time.Duration(pause) * time.Second
-	}
-
-	for _, month := range months {
-		var hits []goatcounter.Hit
-		err := db.SelectContext(ctx, &hits, query, siteID, dayStart(month[0]), dayEnd(month[1]))
+		siteCtx := goatcounter.WithSite(ctx, &s)
+		r, err := goatcounter.NewReindexJob(siteCtx, firstDay, lastDay, hitID, tables)
 		if err != nil {
-			return err
+			return 1, err
 		}
 
-		if !quiet {
-			fmt.Fprintf(stdout, "\r\x1b[0Ksite %d (%d/%d) %s → %d", siteID, isite, nsites, month[0].Format("2006-01"), len(hits))
+		progress := cron.ReindexRange
+		if !*quiet {
+			isite := i + 1
+			progress = func(ctx context.Context, site goatcounter.Site, start, end time.Time, tables []string) error {
+				fmt.Fprintf(stdout, "\r\x1b[0Ksite %d (%d/%d) %s %d%%", site.ID, isite, len(sites),
+					start.Format("2006-01"), r.Done*100/r.Total)
+				return cron.ReindexRange(ctx, site, start, end, tables)
+			}
 		}
 
-		clearMonth(db, tables, month[0].Format("2006-01"), siteID)
-
-		err = cron.ReindexStats(ctx, site, hits, tables)
+		err = goatcounter.RunJob(siteCtx, goatcounter.JobReindex, func(ctx context.Context) error {
+			return r.Run(ctx, progress)
+		})
 		if err != nil {
-			return err
-		}
-
-		if pauses > 0 {
-			time.Sleep(pauses)
+			return 1, err
 		}
 	}
 
-	return nil
-}
-
-func clearMonth(db *sqlx.DB, tables []string, month string, siteID int64) {
-	ctx := context.Background()
-
-	where := fmt.Sprintf(" where site=%d and cast(day as varchar) like '%s-__'", siteID, month)
-	for _, t := range tables {
-		switch t {
-		case "hit_stats":
-			db.MustExecContext(ctx, `delete from hit_stats`+where)
-		case "hit_counts":
-			db.MustExecContext(ctx, fmt.Sprintf(
-				`delete from hit_counts where site=%d and cast(hour as varchar) like '%s-%%'`,
-				siteID, month))
-		case "browser_stats":
-			db.MustExecContext(ctx, `delete from browser_stats`+where)
-		case "system_stats":
-			db.MustExecContext(ctx, `delete from system_stats`+where)
-		case "location_stats":
-			db.MustExecContext(ctx, `delete from location_stats`+where)
-		case "ref_counts":
-			db.MustExecContext(ctx, fmt.Sprintf(
-				`delete from ref_counts where site=%d and cast(hour as varchar) like '%s-%%'`,
-				siteID, month))
-		case "size_stats":
-			db.MustExecContext(ctx, `delete from size_stats`+where)
-		case "all":
-			db.MustExecContext(ctx, `delete from hit_stats`+where)
-			db.MustExecContext(ctx, `delete from browser_stats`+where)
-			db.MustExecContext(ctx, `delete from system_stats`+where)
-			db.MustExecContext(ctx, `delete from location_stats`+where)
-			db.MustExecContext(ctx, `delete from size_stats`+where)
-			db.MustExecContext(ctx, fmt.Sprintf(
-				`delete from hit_counts where site=%d and cast(hour as varchar) like '%s-%%'`,
-				siteID, month))
-			db.MustExecContext(ctx, fmt.Sprintf(
-				`delete from ref_counts where site=%d and cast(hour as varchar) like '%s-%%'`,
-				siteID, month))
-		}
+	if !*quiet {
+		fmt.Fprintln(stdout, "")
 	}
+	return 0, nil
 }
-
-func dayStart(t time.Time) string { return t.Format("2006-01-02") + " 00:00:00" }
-func dayEnd(t time.Time) string   { return t.Format("2006-01-02") + " 23:59:59" }
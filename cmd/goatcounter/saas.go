@@ -7,6 +7,7 @@ package main
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/go-chi/chi"
@@ -44,7 +45,7 @@ func saas() (int, error) {
 	CommandLine.StringVar(&domain, "domain", "goatcounter.localhost:8081,static.goatcounter.localhost:8081", "")
 	CommandLine.StringVar(&stripe, "stripe", "", "")
 	CommandLine.StringVar(&plan, "plan", goatcounter.PlanPersonal, "")
-	dbConnect, test, dev, automigrate, listen, flagTLS, from, err := flagsServe(&v)
+	dbConnect, test, dev, automigrate, listen, flagTLS, from, err := flagsServe(&v, os.Args[2:])
 	if err != nil {
 		return 1, err
 	}
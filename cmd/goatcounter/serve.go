@@ -8,6 +8,8 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/go-chi/chi"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/teamwork/reload"
 	"zgo.at/blackmail"
 	"zgo.at/errors"
@@ -96,56 +99,175 @@ Flags:
   -static      Serve static files from a different domain, such as a CDN or
                cookieless domain. Default: not set.
 
+  -geodb       Path to a MaxMind GeoIP2 or GeoLite2 database in the mmdb
+               format. The embedded database only has country information; a
+               City database is needed for sites that store locations with
+               region or city detail. Default: not set.
+
+  -geodb-url   URL to download updates of the -geodb database from; this is
+               checked once a day, and the database is replaced without a
+               restart if there's a newer version. Can be a .mmdb, .mmdb.gz,
+               or .tar.gz file. Default: not set.
+
+  -export-dir  Directory to write exports to; finished exports are moved to
+               -export-storage. Uploaded imports are also stored here until
+               they're finished, so they can be resumed after a restart.
+               Default: the system's temporary directory (see TMPDIR below).
+
+  -export-storage
+               Where to store finished exports. Default: the -export-dir
+               directory. This can be:
+
+                  dir:///path           Local directory.
+                  s3://bucket/prefix    Amazon S3, or S3-compatible storage.
+                  gs://bucket/prefix    Google Cloud Storage.
+
+               For S3 you can add ?region=.. (default us-east-1), and
+               ?endpoint=https://.. for S3-compatible storage such as MinIO.
+               The credentials are read from AWS_ACCESS_KEY_ID and
+               AWS_SECRET_ACCESS_KEY; for Google Cloud Storage this is a HMAC
+               key of a service account.
+
+  -export-retention
+               Remove exports after this many days. Default: 1.
+
+  -export-keep Always keep the last n exports of every site, even if they're
+               older than -export-retention. Default: 0.
+
+  -delete-grace
+               Keep deleted sites for this many days before removing them with
+               all their data; until then they can be restored with
+               "goatcounter db restore-site". Default: 7.
+
+  -max-hits    Maximum number of pageviews that are listed or exported in one
+               batch. Default: 5000.
+
+  -max-stats   Maximum number of rows that can be requested from the stats
+               listers that accept a limit. Default: 100.
+
+  -max-import-errors
+               Maximum number of errors that are reported for an import; an
+               import continues after this, but the errors are only counted.
+               Default: 50.
+
+  -session-idle
+               Start a new session after a visitor has been inactive for this
+               long, as a duration such as "30m" or "2h". Default: 4h.
+
+  -session-max Maximum length of a session, after which a new session is
+               started even if the visitor was active. Default: not set (no
+               maximum).
+
+               Sites can set a shorter inactivity window and maximum in their
+               settings, but not a longer one.
+
+  -selfping    URL of the public count endpoint to check every five minutes
+               (e.g. "https://stats.example.com/count"), to catch reverse proxy
+               or TLS problems that drop pageviews. This sends a synthetic
+               pageview which isn't stored; an error is logged (and reported
+               with -errors) if it fails. Default: not set.
+
+  -selfping-budget
+               Report an error if the -selfping check takes longer than this.
+               Default: 2s.
+
+  -diagnostics Serve pprof and expvar on this address, such as
+               "localhost:6060", for profiling a running instance. There is no
+               authentication, so don't make this publicly accessible. The
+               same handlers are available on /debug/pprof/ and /debug/vars
+               for the admin site. Default: not set.
+
+  -alert-webhook
+               URL to POST alerts to as JSON, such as when the TLS certificate
+               of a custom domain keeps failing to renew and is about to
+               expire. The site's user is always emailed. Default: not set.
+
+  -ratelimit   Maximum number of pageviews per second from a single client.
+               Default: 4.
+
+  -import-rate Maximum number of rows per second an import processes, so
+               that large imports don't slow down the collection of
+               pageviews. Imports also pause when the database or the queue
+               of pageviews waiting to be written is busy. Default: 0 (no
+               limit).
+
+  -import-policy
+               How imports run, as a comma-separated list of key=value pairs,
+               e.g. "throttle=off,email=combined,reindex=true":
+
+                  throttle   normal: pause if the database is busy, and stay
+                             below -import-rate. fast: only pause if the
+                             database is busy. off: never pause.
+                  email      each: email after every import. combined: one
+                             email once all queued imports for a site are
+                             finished. never: don't email.
+                  reindex    Recreate the statistics for the imported days
+                             once an import is finished; this is always done
+                             for imports of 10,000 pageviews or more.
+
+               The "off" throttle and combined email are useful when importing
+               the history of several sites to a new installation. Default:
+               "throttle=normal,email=each,reindex=false".
+
+  -stat-workers
+               Number of sites to update the statistics for in parallel
+               after writing new pageviews to the database. This only
+               applies to PostgreSQL; SQLite always uses 1. Default: 4.
+
+  -datacenter-ips
+               Path to a file with IP addresses or CIDR ranges of
+               datacenters and hosting providers, one per line; lines
+               starting with # are ignored. Pageviews from these addresses
+               get a higher bot score. Default: not set.
+
+  -cron-interval
+               Change how often background tasks run, as a comma-separated
+               list of task=duration, e.g. "oldJobs=6h,sessions=30s". Default:
+               not set (every task uses its own default).
+
+               If several instances use the same database then most tasks
+               run on only one instance at a time; tasks for the pageviews
+               in memory, the GeoIP database, and ACME certificates run on
+               every instance.
+
+               CompactStats merges duplicate rows in the statistics tables
+               and runs VACUUM; this is expensive on large databases, and
+               runs once a day by default.
+
+  -config      Read settings from this file; see "goatcounter help config" for
+               the format. Some settings can be reloaded without a restart.
+
   -dev         Start in "dev mode".
 
   -debug       Modules to debug, comma-separated or 'all' for all modules.
 
 Environment:
 
-  TMPDIR       Directory for temporary files; only used to store CSV exports at
-               the moment. On Windows it will use the first non-empty value of
-               %TMP%, %TEMP%, and %USERPROFILE%.
+  TMPDIR       Directory for temporary files; only used to store CSV exports
+               and imports if -export-dir isn't set at the moment. On Windows it will use
+               the first non-empty value of %TMP%, %TEMP%, and %USERPROFILE%.
+
+  AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN
+               Credentials for -export-storage with s3:// or gs://; the
+               session token is optional.
 `
 
 func serve() (int, error) {
-	v := zvalidate.New()
-
-	CommandLine.StringVar(&cfg.Port, "port", "", "")
-	CommandLine.StringVar(&cfg.DomainStatic, "static", "", "")
-	dbConnect, test, dev, automigrate, listen, flagTLS, from, err := flagsServe(&v)
+	opts, err := flagsServeSelf(os.Args[2:])
 	if err != nil {
 		return 1, err
 	}
-
-	cfg.Serve = true
-	if flagTLS == "" {
-		flagTLS = map[bool]string{true: "none", false: "acme,tls,rdr"}[dev]
-	}
-
-	if cfg.DomainStatic != "" {
-		if p := strings.Index(cfg.DomainStatic, ":"); p > -1 {
-			v.Domain("-static", cfg.DomainStatic[:p])
-		} else {
-			v.Domain("-static", cfg.DomainStatic)
+	if opts.geoDB != "" {
+		err := goatcounter.Geo.Load(opts.geoDB)
+		if err != nil {
+			return 1, err
 		}
-		cfg.URLStatic = "//" + cfg.DomainStatic
-		cfg.DomainCount = cfg.DomainStatic
-	}
-
-	if cfg.Port != "" {
-		cfg.Port = ":" + cfg.Port
-	}
-
-	flagFrom(from, &v)
-	if v.HasErrors() {
-		return 1, v
 	}
 
-	db, tlsc, acmeh, listenTLS, err := setupServe(dbConnect, flagTLS, automigrate)
+	db, tlsc, acmeh, listenTLS, err := setupServe(opts.dbConnect, opts.flagTLS, opts.automigrate)
 	if err != nil {
 		return 2, err
 	}
-
 	// Set up HTTP handler and servers.
 	hosts := map[string]http.Handler{
 		"*": handlers.NewBackend(db, acmeh),
@@ -153,7 +275,18 @@ func serve() (int, error) {
 	if cfg.DomainStatic != "" {
 		// May not be needed, but just in case the DomainStatic isn't an
 		// external CDN.
-		hosts[zhttp.RemovePort(cfg.DomainStatic)] = handlers.NewStatic(chi.NewRouter(), "./public", !dev)
+		hosts[zhttp.RemovePort(cfg.DomainStatic)] = handlers.NewStatic(chi.NewRouter(), "./public", !opts.dev)
+	}
+
+	if cfg.Diagnostics != "" {
+		go func() {
+			defer zlog.Recover()
+			zlog.Printf("serving diagnostics on %q", cfg.Diagnostics)
+			err := http.ListenAndServe(cfg.Diagnostics, handlers.NewDiagnostics())
+			if err != nil {
+				zlog.Errorf("diagnostics server: %s", err)
+			}
+		}()
 	}
 
 	cnames, err := lsSites(db)
@@ -161,10 +294,13 @@ func serve() (int, error) {
 		return 2, err
 	}
 
-	doServe(db, test, listen, listenTLS, tlsc, hosts, func() {
+	doServe(db, opts.test, opts.listen, listenTLS, tlsc, hosts, func() {
+		if loadedConfig.file != "" {
+			reloadOnSignal(CommandLine)
+		}
 		banner()
 		zlog.Printf("ready; serving %d sites on %q; dev=%t; sites: %s",
-			len(cnames), listen, dev, strings.Join(cnames, ", "))
+			len(cnames), opts.listen, opts.dev, strings.Join(cnames, ", "))
 		if len(cnames) == 0 {
 			zlog.Errorf("No sites yet; create a new site with:\n    goatcounter create -domain [..] -email [..]")
 		}
@@ -201,20 +337,180 @@ func doServe(db *sqlx.DB, test bool, listen string, listenTLS uint8, tlsc *tls.C
 		os.Exit(99)
 	}()
 
-	bgrun.Run("shutdown", func() {
-		err := cron.PersistAndStat(zdb.With(context.Background(), db))
-		if err != nil {
-			zlog.Error(err)
-		}
-		goatcounter.Memstore.StoreSessions(db)
-	})
 	zlog.Print("Waiting for background tasks to finish; send HUP, TERM, or INT twice to force kill (may lose data!)")
-	time.Sleep(10 * time.Millisecond)
-	bgrun.WaitProgressAndLog()
+	cron.Shutdown(db, 2*time.Minute)
 	db.Close()
 }
 
-func flagsServe(v *zvalidate.Validator) (string, bool, bool, bool, string, string, string, error) {
+type serveOpts struct {
+	dbConnect, listen, flagTLS, geoDB string
+	test, dev, automigrate            bool
+}
+
+// flagsServeSelf parses and validates the flags for serve.
+func flagsServeSelf(args []string) (serveOpts, error) {
+	v := zvalidate.New()
+
+	CommandLine.StringVar(&cfg.Port, "port", "", "")
+	CommandLine.StringVar(&cfg.DomainStatic, "static", "", "")
+	geoDB := CommandLine.String("geodb", "", "")
+	CommandLine.StringVar(&cfg.GeoDBURL, "geodb-url", "", "")
+	CommandLine.StringVar(&cfg.ExportDir, "export-dir", "", "")
+	CommandLine.StringVar(&cfg.ExportStorage, "export-storage", "", "")
+	exportRetention := CommandLine.Int("export-retention", 1, "")
+	deleteGrace := CommandLine.Int("delete-grace", 7, "")
+	CommandLine.IntVar(&goatcounter.ExportKeep, "export-keep", 0, "")
+	CommandLine.IntVar(&cfg.MaxHits, "max-hits", cfg.MaxHits, "")
+	CommandLine.IntVar(&cfg.MaxStats, "max-stats", cfg.MaxStats, "")
+	CommandLine.IntVar(&cfg.MaxImportErrors, "max-import-errors", cfg.MaxImportErrors, "")
+	CommandLine.DurationVar(&cfg.SessionIdle, "session-idle", cfg.SessionIdle, "")
+	CommandLine.DurationVar(&cfg.SessionMax, "session-max", 0, "")
+	CommandLine.StringVar(&cfg.SelfPing, "selfping", "", "")
+	CommandLine.DurationVar(&cfg.SelfPingBudget, "selfping-budget", cfg.SelfPingBudget, "")
+	CommandLine.StringVar(&cfg.Diagnostics, "diagnostics", "", "")
+	CommandLine.StringVar(&cfg.AlertWebhook, "alert-webhook", "", "")
+	CommandLine.Int64Var(&cfg.Ratelimit, "ratelimit", cfg.Ratelimit, "")
+	CommandLine.Int64Var(&cfg.ImportRate, "import-rate", 0, "")
+	CommandLine.IntVar(&cfg.StatWorkers, "stat-workers", cfg.StatWorkers, "")
+	importPolicy := CommandLine.String("import-policy", "", "")
+	datacenterIPs := CommandLine.String("datacenter-ips", "", "")
+	cronInterval := CommandLine.String("cron-interval", "", "")
+	dbConnect, test, dev, automigrate, listen, flagTLS, from, err := flagsServe(&v, args)
+	if err != nil {
+		return serveOpts{}, err
+	}
+
+	cfg.Serve = true
+	if flagTLS == "" {
+		flagTLS = map[bool]string{true: "none", false: "acme,tls,rdr"}[dev]
+	}
+
+	if cfg.DomainStatic != "" {
+		if p := strings.Index(cfg.DomainStatic, ":"); p > -1 {
+			v.Domain("-static", cfg.DomainStatic[:p])
+		} else {
+			v.Domain("-static", cfg.DomainStatic)
+		}
+		cfg.URLStatic = "//" + cfg.DomainStatic
+		cfg.DomainCount = cfg.DomainStatic
+	}
+
+	if cfg.Port != "" {
+		cfg.Port = ":" + cfg.Port
+	}
+
+	flagFrom(from, &v)
+	if v.HasErrors() {
+		return serveOpts{}, v
+	}
+
+	if cfg.GeoDBURL != "" {
+		v.URL("-geodb-url", cfg.GeoDBURL)
+		if *geoDB == "" {
+			v.Append("-geodb-url", "requires -geodb")
+		}
+	}
+	if cfg.ExportDir != "" {
+		if st, err := os.Stat(cfg.ExportDir); err != nil || !st.IsDir() {
+			v.Append("-export-dir", "must be an existing directory")
+		}
+	}
+	if cfg.ExportStorage != "" {
+		if _, err := goatcounter.NewExportStorage(cfg.ExportStorage); err != nil {
+			v.Append("-export-storage", err.Error())
+		}
+	}
+	if *exportRetention < 1 {
+		v.Append("-export-retention", "must be at least 1")
+	}
+	goatcounter.ExportRetention = time.Duration(*exportRetention) * 24 * time.Hour
+	if goatcounter.ExportKeep < 0 {
+		v.Append("-export-keep", "must be 0 or more")
+	}
+	if *deleteGrace < 0 {
+		v.Append("-delete-grace", "must be 0 or more")
+	}
+	goatcounter.DeleteGrace = time.Duration(*deleteGrace) * 24 * time.Hour
+	v.Range("-max-hits", int64(cfg.MaxHits), 100, 0)
+	v.Range("-max-stats", int64(cfg.MaxStats), 10, 0)
+	v.Range("-max-import-errors", int64(cfg.MaxImportErrors), 1, 0)
+	if cfg.SessionIdle < time.Minute {
+		v.Append("-session-idle", "must be at least one minute")
+	}
+	if cfg.SessionMax < 0 || (cfg.SessionMax > 0 && cfg.SessionMax < cfg.SessionIdle) {
+		v.Append("-session-max", "must be 0 or longer than -session-idle")
+	}
+	if cfg.SelfPing != "" {
+		v.URL("-selfping", cfg.SelfPing)
+	}
+	if cfg.SelfPingBudget <= 0 {
+		v.Append("-selfping-budget", "must be longer than 0")
+	}
+	if cfg.Diagnostics != "" {
+		if _, _, err := net.SplitHostPort(cfg.Diagnostics); err != nil {
+			v.Append("-diagnostics", "must be an address such as localhost:6060")
+		}
+	}
+	if cfg.AlertWebhook != "" {
+		v.URL("-alert-webhook", cfg.AlertWebhook)
+	}
+	if cfg.Ratelimit < 1 {
+		v.Append("-ratelimit", "must be at least 1")
+	}
+	if cfg.ImportRate < 0 {
+		v.Append("-import-rate", "must be 0 or more")
+	}
+	if p, err := goatcounter.ParseImportPolicy(*importPolicy); err != nil {
+		v.Append("-import-policy", err.Error())
+	} else {
+		goatcounter.SetImportPolicy(p, cron.ReindexRange)
+	}
+	if cfg.StatWorkers < 1 {
+		v.Append("-stat-workers", "must be at least 1")
+	}
+	if *datacenterIPs != "" {
+		goatcounter.DatacenterIPs, err = readDatacenterIPs(*datacenterIPs)
+		if err != nil {
+			v.Append("-datacenter-ips", err.Error())
+		}
+	}
+	if err := setCronInterval(*cronInterval); err != nil {
+		v.Append("-cron-interval", err.Error())
+	}
+	if v.HasErrors() {
+		return serveOpts{}, v
+	}
+	return serveOpts{dbConnect: dbConnect, listen: listen, flagTLS: flagTLS, geoDB: *geoDB,
+		test: test, dev: dev, automigrate: automigrate}, nil
+}
+
+// readDatacenterIPs reads the IP addresses and CIDR ranges from the file for
+// -datacenter-ips.
+func readDatacenterIPs(path string) ([]string, error) {
+	fp, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	for i, line := range strings.Split(string(fp), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if strings.Contains(line, "/") {
+			if _, _, err := net.ParseCIDR(line); err != nil {
+				return nil, fmt.Errorf("line %d: invalid CIDR range: %q", i+1, line)
+			}
+		} else if net.ParseIP(line) == nil {
+			return nil, fmt.Errorf("line %d: invalid IP address: %q", i+1, line)
+		}
+		ips = append(ips, line)
+	}
+	return ips, nil
+}
+
+func flagsServe(v *zvalidate.Validator, args []string) (string, bool, bool, bool, string, string, string, error) {
 	dbConnect := flagDB()
 	debug := flagDebug()
 
@@ -227,8 +523,12 @@ func flagsServe(v *zvalidate.Validator) (string, bool, bool, bool, string, strin
 	errors := CommandLine.String("errors", "", "")
 	from := CommandLine.String("email-from", "", "")
 	test := CommandLine.Bool("go-test-hook-do-not-use", false, "")
+	config := CommandLine.String("config", "", "")
 
-	err := CommandLine.Parse(os.Args[2:])
+	err := CommandLine.Parse(args)
+	if err == nil && *config != "" {
+		err = loadConfig(CommandLine, *config)
+	}
 	zlog.Config.SetDebug(*debug)
 	cfg.Prod = !dev
 	zhttp.LogUnknownFields = dev
@@ -268,6 +568,25 @@ func setupServe(dbConnect, flagTLS string, automigrate bool) (*sqlx.DB, *tls.Con
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
+	if cfg.PgSQL {
+		err = listenBroadcast(dbConnect)
+		if err != nil {
+			return nil, nil, nil, 0, err
+		}
+	}
+	err = goatcounter.LoadSearchIndex(zdb.With(context.Background(), db))
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	// Jobs don't survive a restart; imports and reindexes are resumed in a new
+	// job below. Jobs from other instances are left alone as long as they're
+	// still running, and jobs from the previous run of this instance are marked
+	// as failed by cron once their heartbeat stopped.
+	var jobs goatcounter.Jobs
+	err = jobs.Interrupted(zdb.With(context.Background(), db))
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
 
 	cron.RunBackground(db)
 	bgrun.Run("cron:start", func() { // Run all jobs on startup.
@@ -275,9 +594,66 @@ func setupServe(dbConnect, flagTLS string, automigrate bool) (*sqlx.DB, *tls.Con
 		cron.RunOnce(db)
 	})
 
+	var imports goatcounter.ImportJobs
+	err = imports.Resume(zdb.With(context.Background(), db))
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	var reindexes goatcounter.ReindexJobs
+	err = reindexes.Resume(zdb.With(context.Background(), db), cron.ReindexRange)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
 	return db, tlsc, acmeh, listenTLS, nil
 }
 
+// listenBroadcast listens for changes from other instances that use the same
+// PostgreSQL database; see goatcounter.Broadcast().
+func listenBroadcast(dbConnect string) error {
+	l := zlog.Module("broadcast")
+	ln := pq.NewListener(pqConnect(dbConnect), time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			l.Error(err)
+		}
+	})
+	err := ln.Listen(goatcounter.BroadcastChannel)
+	if err != nil {
+		return errors.Errorf("listenBroadcast: %w", err)
+	}
+
+	go func() {
+		defer zlog.Recover()
+		for n := range ln.Notify {
+			// nil is sent after reconnecting; we may have missed some
+			// changes.
+			if n == nil {
+				goatcounter.ResetCache()
+				continue
+			}
+
+			err := goatcounter.HandleBroadcast(n.Extra)
+			if err != nil {
+				l.Error(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// pqConnect converts the -db flag to a connection string for pq; the -db flag
+// is always prefixed with postgresql://, but may be in the key=value form.
+func pqConnect(dbConnect string) string {
+	c := dbConnect
+	for _, p := range []string{"postgresql://", "postgres://"} {
+		c = strings.TrimPrefix(c, p)
+	}
+	if i := strings.IndexAny(c, "=@/:"); i > -1 && c[i] == '=' {
+		return c
+	}
+	return "postgresql://" + c
+}
+
 func setupReload() {
 	if _, err := os.Stat("./tpl"); os.IsNotExist(err) {
 		return
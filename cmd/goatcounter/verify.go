@@ -0,0 +1,67 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"zgo.at/goatcounter"
+)
+
+const usageVerify = `
+Verify a downloaded export with the manifest of the export.
+
+Every export has a manifest with the size, SHA256 hash, and number of rows of
+the export file; you can download it from the list of exports in the settings
+or from /api/v0/export/{id}/manifest. Verify the file before removing any data
+from the GoatCounter instance:
+
+    $ goatcounter verify goatcounter-export-example-20200618T120000Z-0-1.csv.gz
+
+This doesn't need a database connection.
+
+Flags:
+
+  -manifest    Path to the manifest. Default: the filename of the export with
+               ".manifest.json" appended.
+`
+
+func verify() (int, error) {
+	manifest := CommandLine.String("manifest", "", "")
+	err := CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		return 1, err
+	}
+
+	files := CommandLine.Args()
+	if len(files) == 0 {
+		return 1, fmt.Errorf("need a filename")
+	}
+	if len(files) > 1 {
+		return 1, fmt.Errorf("can only specify one filename")
+	}
+	if *manifest == "" {
+		*manifest = files[0] + goatcounter.ExportManifestSuffix
+	}
+
+	fp, err := os.Open(*manifest)
+	if err != nil {
+		return 1, err
+	}
+	defer fp.Close()
+
+	m, err := goatcounter.ReadExportManifest(fp)
+	if err != nil {
+		return 1, err
+	}
+	err = m.Verify(files[0])
+	if err != nil {
+		return 1, err
+	}
+
+	fmt.Fprintf(stdout, "%s: OK; %d rows, sha256 %s\n", files[0], m.Rows, m.SHA256)
+	return 0, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package main
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goatcounter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "export.csv.gz")
+	fp, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz := gzip.NewWriter(fp)
+	gz.Write([]byte(strings.Join(goatcounter.ExportHeader(), ",") + "\n" +
+		"/a,,false,0,1,true,,,,,,2020-06-18T12:00:00Z,1\n"))
+	gz.Close()
+	fp.Close()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(data)
+	writeManifest := func(rows int) {
+		j, err := json.Marshal(goatcounter.ExportManifest{
+			Kind: goatcounter.ExportHits, File: "export.csv.gz", Rows: rows,
+			Size: int64(len(data)), SHA256: hex.EncodeToString(h[:]),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path+goatcounter.ExportManifestSuffix, j, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeManifest(1)
+	run(t, 0, []string{"verify", path})
+
+	writeManifest(2)
+	run(t, 1, []string{"verify", path})
+
+	run(t, 1, []string{"verify", "-manifest", filepath.Join(dir, "nonexistent"), path})
+}
@@ -0,0 +1,177 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+// TestCompat runs the model methods that have SQL which differs between SQLite
+// and PostgreSQL, and compares the results with the same golden file for both.
+//
+// The retention uses the database's time, so everything is relative to the
+// current day and days are written as "d-n" in the output.
+func TestCompat(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	var (
+		y, m, d = time.Now().UTC().Date()
+		today   = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		old     = today.Add(-20 * 24 * time.Hour)
+		recent  = today.Add(-1 * 24 * time.Hour)
+		start   = old
+		end     = recent.Add(24*time.Hour - time.Second)
+		s1      = goatcounter.TestSession
+		s2      = goatcounter.TestSeqSession
+		ref     = "http://example.org"
+	)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/a", Title: "A", Ref: ref, Session: s1, FirstVisit: true, CreatedAt: old.Add(10*time.Hour + time.Second)},
+		{Path: "/a", Session: s2, FirstVisit: true, CreatedAt: old.Add(10*time.Hour + 30*time.Minute)},
+		{Path: "/a", Title: "A", Ref: ref, Session: s1, CreatedAt: recent.Add(9 * time.Hour)},
+		{Path: "/b", Title: "B", Ref: ref, Session: s2, FirstVisit: true, CreatedAt: recent.Add(11 * time.Hour)},
+		{Path: "click", Event: true, Session: s1, FirstVisit: true, CreatedAt: recent.Add(11 * time.Hour)},
+	}...)
+
+	var out strings.Builder
+	section := func(name string) { fmt.Fprintf(&out, "== %s\n", name) }
+
+	section("HitStats.List")
+	out.WriteString(compatList(ctx, t, today, start, end))
+
+	section("HitStat.Totals")
+	out.WriteString(compatTotals(ctx, t, today, start, end))
+
+	section("Stats.ByRef")
+	{
+		var stats goatcounter.Stats
+		err := stats.ByRef(ctx, start, end, "example.org", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range stats.Stats {
+			fmt.Fprintf(&out, "%s count=%d unique=%d\n", s.Name, s.Count, s.CountUnique)
+		}
+	}
+
+	section("HitStats.ListPathsLike")
+	{
+		var stats goatcounter.HitStats
+		err := stats.ListPathsLike(ctx, "%a%", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range stats {
+			fmt.Fprintf(&out, "%s title=%q count=%d\n", s.Path, s.Title, s.Count)
+		}
+	}
+
+	section("Hits.Purge")
+	{
+		var hits goatcounter.Hits
+		err := hits.Purge(ctx, "/b", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out.WriteString(compatList(ctx, t, today, start, end))
+	}
+
+	section("cron.ReindexStats")
+	{
+		site := goatcounter.MustGetSite(ctx)
+		tables := []string{"hit_counts", "ref_counts", "hit_stats"}
+		for _, tbl := range tables {
+			_, err := zdb.MustGet(ctx).ExecContext(ctx, `delete from `+tbl+` where site=$1`, site.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var hits goatcounter.Hits
+		_, err := hits.List(ctx, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = cron.ReindexStats(ctx, *site, hits, tables)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out.WriteString(compatList(ctx, t, today, start, end))
+	}
+
+	section("Site.DeleteOlderThan")
+	{
+		err := goatcounter.MustGetSite(ctx).DeleteOlderThan(ctx, 14, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out.WriteString(compatList(ctx, t, today, start, end))
+		out.WriteString(compatTotals(ctx, t, today, start, end))
+	}
+
+	gctest.Golden(t, "compat.golden", out.String())
+}
+
+func compatList(ctx context.Context, t *testing.T, today, start, end time.Time) string {
+	t.Helper()
+
+	var stats goatcounter.HitStats
+	total, unique, more, other, err := stats.List(ctx, start, end, "", "", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "total=%d unique=%d more=%t other=%v\n", total, unique, more, other)
+	for _, s := range stats {
+		fmt.Fprintf(&b, "%s event=%t count=%d unique=%d title=%q\n",
+			s.Path, s.Event, s.Count, s.CountUnique, s.Title)
+		b.WriteString(compatDays(today, s.Stats))
+	}
+	return b.String()
+}
+
+func compatTotals(ctx context.Context, t *testing.T, today, start, end time.Time) string {
+	t.Helper()
+
+	var st goatcounter.HitStat
+	max, err := st.Totals(ctx, start, end, "", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("max=%d count=%d unique=%d\n", max, st.Count, st.CountUnique) +
+		compatDays(today, st.Stats)
+}
+
+// compatDays writes all days with pageviews as the day relative to today, the
+// total for the day, and the totals for every hour with pageviews.
+func compatDays(today time.Time, stats []goatcounter.Stat) string {
+	var b strings.Builder
+	for _, s := range stats {
+		if s.Daily == 0 {
+			continue
+		}
+		day, _ := time.Parse("2006-01-02", s.Day)
+		fmt.Fprintf(&b, "  d-%d %d/%d", int(today.Sub(day).Hours()/24), s.Daily, s.DailyUnique)
+		for h := range s.Hourly {
+			if s.Hourly[h] > 0 {
+				fmt.Fprintf(&b, " %d:%d/%d", h, s.Hourly[h], s.HourlyUnique[h])
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"zgo.at/errors"
-	"zgo.at/gadget"
 	"zgo.at/goatcounter"
 	"zgo.at/zdb"
 	"zgo.at/zdb/bulk"
@@ -30,7 +29,7 @@ func updateBrowserStats(ctx context.Context, hits []goatcounter.Hit, isReindex b
 				continue
 			}
 
-			browser, version := getBrowser(h.Browser)
+			browser, version := h.BrowserInfo()
 			if browser == "" {
 				continue
 			}
@@ -52,9 +51,9 @@ func updateBrowserStats(ctx context.Context, hits []goatcounter.Hit, isReindex b
 				}
 			}
 
-			v.count += 1
+			v.count += h.Weight()
 			if h.FirstVisit {
-				v.countUnique += 1
+				v.countUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
@@ -94,8 +93,3 @@ func existingBrowserStats(
 		siteID, day, browser, version)
 	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
 }
-
-func getBrowser(uaHeader string) (string, string) {
-	ua := gadget.Parse(uaHeader)
-	return ua.BrowserName, ua.BrowserVersion
-}
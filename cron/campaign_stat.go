@@ -0,0 +1,95 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/zdb"
+	"zgo.at/zdb/bulk"
+)
+
+func updateCampaignStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		// Group by day + source + medium + campaign.
+		type gt struct {
+			count       int
+			countUnique int
+			day         string
+			source      string
+			medium      string
+			campaign    string
+		}
+		grouped := map[string]gt{}
+		for _, h := range hits {
+			if h.Bot > 0 {
+				continue
+			}
+			if h.UTMSource == "" && h.UTMMedium == "" && h.UTMCampaign == "" {
+				continue
+			}
+
+			day := h.CreatedAt.Format("2006-01-02")
+			k := day + "\x00" + h.UTMSource + "\x00" + h.UTMMedium + "\x00" + h.UTMCampaign
+			v := grouped[k]
+			if v.count == 0 {
+				v.day = day
+				v.source = h.UTMSource
+				v.medium = h.UTMMedium
+				v.campaign = h.UTMCampaign
+				if !isReindex {
+					var err error
+					v.count, v.countUnique, err = existingCampaignStats(ctx, tx,
+						h.Site, day, v.source, v.medium, v.campaign)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			v.count += h.Weight()
+			if h.FirstVisit {
+				v.countUnique += h.Weight()
+			}
+			grouped[k] = v
+		}
+
+		siteID := goatcounter.MustGetSite(ctx).ID
+		ins := bulk.NewInsert(ctx, "campaign_stats", []string{"site", "day",
+			"source", "medium", "campaign", "count", "count_unique"})
+		for _, v := range grouped {
+			ins.Values(siteID, v.day, v.source, v.medium, v.campaign, v.count, v.countUnique)
+		}
+		return ins.Finish()
+	})
+}
+
+func existingCampaignStats(
+	txctx context.Context, tx zdb.DB, siteID int64,
+	day, source, medium, campaign string,
+) (int, int, error) {
+
+	var c []struct {
+		Count       int `db:"count"`
+		CountUnique int `db:"count_unique"`
+	}
+	err := tx.SelectContext(txctx, &c, `/* existingCampaignStats */
+		select count, count_unique from campaign_stats
+		where site=$1 and day=$2 and source=$3 and medium=$4 and campaign=$5 limit 1`,
+		siteID, day, source, medium, campaign)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "select")
+	}
+	if len(c) == 0 {
+		return 0, 0, nil
+	}
+
+	_, err = tx.ExecContext(txctx, `delete from campaign_stats where
+		site=$1 and day=$2 and source=$3 and medium=$4 and campaign=$5`,
+		siteID, day, source, medium, campaign)
+	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
+}
@@ -0,0 +1,54 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestCampaignStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Query: "utm_source=news&utm_medium=email&utm_campaign=sale"},
+		{Site: site.ID, CreatedAt: now, Query: "utm_source=news&utm_medium=email&utm_campaign=launch", FirstVisit: true},
+		{Site: site.ID, CreatedAt: now, Query: "utm_source=news&utm_medium=rss"},
+		{Site: site.ID, CreatedAt: now, Query: "utm_source=ads"},
+		{Site: site.ID, CreatedAt: now},
+	}...)
+
+	tests := []struct {
+		source, medium string
+		want           string
+	}{
+		{"", "", `{false [{news 3 1 <nil>} {ads 1 0 <nil>}]}`},
+		{"news", "", `{false [{email 2 1 <nil>} {rss 1 0 <nil>}]}`},
+		{"news", "email", `{false [{launch 1 1 <nil>} {sale 1 0 <nil>}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source+"/"+tt.medium, func(t *testing.T) {
+			var stats goatcounter.Stats
+			err := stats.ByCampaign(ctx, now, now, tt.source, tt.medium, 10, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out := fmt.Sprintf("%v", stats)
+			if tt.want != out {
+				t.Errorf("\nwant: %s\nout:  %s", tt.want, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+	"zgo.at/zstd/zjson"
+)
+
+// compactStatsPeriod is the default period for CompactStats().
+const compactStatsPeriod = 24 * time.Hour
+
+// mergeTables are the statistics tables without a unique constraint, which may
+// have more than one row for the same key; the key is the site, day, and the
+// listed columns.
+var mergeTables = []struct {
+	table string
+	key   []string
+}{
+	{"browser_stats", []string{"browser", "version"}},
+	{"system_stats", []string{"system", "version"}},
+	{"hit_stats", []string{"path"}},
+}
+
+// vacuumTables are vacuumed on PostgreSQL; SQLite always vacuums the entire
+// database.
+var vacuumTables = []string{"hit_stats", "system_stats", "browser_stats",
+	"location_stats", "size_stats", "host_stats", "campaign_stats",
+	"scroll_stats", "sessions_stats", "hit_counts", "ref_counts",
+	"hit_counts_daily", "hit_counts_monthly", "ref_counts_daily",
+	"ref_counts_monthly"}
+
+// CompactStats merges duplicate rows in the statistics tables, and reclaims
+// the space of rows deleted by reindexes and the data retention with VACUUM.
+//
+// All tasks also run on startup and shutdown; this is expensive on large
+// databases, so it only runs if the last compaction was at least the task's
+// period ago.
+func CompactStats(ctx context.Context) error {
+	if stopped.Value() == 1 {
+		return nil
+	}
+
+	db := zdb.MustGet(ctx)
+	var last string
+	err := db.GetContext(ctx, &last, `select value from store where key='compact_stats'`)
+	if err != nil && !zdb.ErrNoRows(err) {
+		return errors.Errorf("cron.CompactStats: %w", err)
+	}
+	now := goatcounter.Now()
+	if t, err := time.Parse(time.RFC3339, last); err == nil && t.Add(period("CompactStats", compactStatsPeriod)).After(now) {
+		return nil
+	}
+
+	_, err = db.ExecContext(ctx, `delete from store where key='compact_stats'`)
+	if err == nil {
+		_, err = db.ExecContext(ctx, `insert into store (key, value) values ('compact_stats', $1)`,
+			now.Format(time.RFC3339))
+	}
+	if err != nil {
+		return errors.Errorf("cron.CompactStats: %w", err)
+	}
+
+	merged := 0
+	for _, t := range mergeTables {
+		n, err := mergeStats(ctx, t.table, t.key)
+		if err != nil {
+			return errors.Errorf("cron.CompactStats: %w", err)
+		}
+		merged += n
+	}
+	addProcessed(ctx, merged)
+
+	before, err := dbSize(ctx)
+	if err != nil {
+		return errors.Errorf("cron.CompactStats: %w", err)
+	}
+	err = vacuum(ctx)
+	if err != nil {
+		return errors.Errorf("cron.CompactStats: %w", err)
+	}
+	after, err := dbSize(ctx)
+	if err != nil {
+		return errors.Errorf("cron.CompactStats: %w", err)
+	}
+
+	zlog.Module("cron-compact").Printf("merged %d duplicate rows; reclaimed %dKiB (%dKiB → %dKiB)",
+		merged, (before-after)/1024, before/1024, after/1024)
+	return nil
+}
+
+// mergeStats merges all rows in the table with the same site, day, and key
+// columns into a single row, returning the number of rows that were removed.
+func mergeStats(ctx context.Context, table string, key []string) (int, error) {
+	var (
+		cols   = strings.Join(key, ", ")
+		where  = "site=$1 and day=$2"
+		values = "$1, $2"
+	)
+	for i, k := range key {
+		where += " and " + k + "=$" + strconv.Itoa(i+3)
+		values += ", $" + strconv.Itoa(i+3)
+	}
+	values += ", $" + strconv.Itoa(len(key)+3) + ", $" + strconv.Itoa(len(key)+4)
+
+	var dups []struct {
+		Site int64     `db:"site"`
+		Day  time.Time `db:"day"`
+		K1   string    `db:"k1"`
+		K2   string    `db:"k2"`
+		N    int       `db:"n"`
+	}
+	k2 := "''"
+	if len(key) > 1 {
+		k2 = key[1]
+	}
+	err := zdb.MustGet(ctx).SelectContext(ctx, &dups, `/* mergeStats */
+		select site, day, `+key[0]+` as k1, `+k2+` as k2, count(*) as n from `+table+`
+		group by site, day, `+cols+`
+		having count(*) > 1
+		limit 1000`)
+	if err != nil {
+		return 0, errors.Errorf("%s: %w", table, err)
+	}
+
+	removed := 0
+	for _, d := range dups {
+		args := []interface{}{d.Site, d.Day.Format("2006-01-02"), d.K1}
+		if len(key) > 1 {
+			args = append(args, d.K2)
+		}
+
+		err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+			// Stats are updated from the pageviews in the meantime; make sure
+			// that they're not changed between reading and rewriting them.
+			err := goatcounter.LockStats(ctx, d.Site)
+			if err != nil {
+				return err
+			}
+
+			if table == "hit_stats" {
+				return mergeHitStats(ctx, tx, where, values, args)
+			}
+
+			var c struct {
+				Count       int `db:"count"`
+				CountUnique int `db:"count_unique"`
+			}
+			err = tx.GetContext(ctx, &c, `select sum(count) as count, sum(count_unique) as count_unique
+				from `+table+` where `+where, args...)
+			if err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, `delete from `+table+` where `+where, args...)
+			if err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, `insert into `+table+` (site, day, `+cols+`, count, count_unique)
+				values (`+values+`)`, append(args, c.Count, c.CountUnique)...)
+			return err
+		})
+		if err != nil {
+			return removed, errors.Errorf("%s: %w", table, err)
+		}
+		removed += d.N - 1
+	}
+	return removed, nil
+}
+
+// mergeHitStats merges the hit_stats rows matching where, adding up the hourly
+// counts; the title is taken from the last row that has one.
+func mergeHitStats(ctx context.Context, tx zdb.DB, where, values string, args []interface{}) error {
+	var rows []struct {
+		Title       string `db:"title"`
+		Stats       []byte `db:"stats"`
+		StatsUnique []byte `db:"stats_unique"`
+	}
+	err := tx.SelectContext(ctx, &rows, `select title, stats, stats_unique from hit_stats where `+where, args...)
+	if err != nil {
+		return err
+	}
+
+	var (
+		title              string
+		stats, statsUnique = make([]int, 24), make([]int, 24)
+	)
+	for _, r := range rows {
+		if r.Title != "" {
+			title = r.Title
+		}
+		var s, su []int
+		zjson.MustUnmarshal(r.Stats, &s)
+		zjson.MustUnmarshal(r.StatsUnique, &su)
+		for i := range s {
+			stats[i] += s[i]
+		}
+		for i := range su {
+			statsUnique[i] += su[i]
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `delete from hit_stats where `+where, args...)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `insert into hit_stats (site, day, path, stats, stats_unique, title)
+		values (`+values+`, $6)`,
+		append(args, zjson.MustMarshal(stats), zjson.MustMarshal(statsUnique), title)...)
+	return err
+}
+
+// vacuum reclaims the space of deleted rows and updates the statistics for
+// the query planner.
+//
+// On PostgreSQL this is a normal VACUUM, which makes the space available for
+// new rows but rarely returns it to the operating system; autovacuum usually
+// does the same, but may lag behind after large deletes.
+func vacuum(ctx context.Context) error {
+	db := zdb.MustGet(ctx)
+	if !cfg.PgSQL {
+		_, err := db.ExecContext(ctx, `vacuum`)
+		if err != nil {
+			return errors.Errorf("vacuum: %w", err)
+		}
+		_, err = db.ExecContext(ctx, `analyze`)
+		return errors.Wrap(err, "analyze")
+	}
+
+	for _, t := range vacuumTables {
+		_, err := db.ExecContext(ctx, `vacuum (analyze) `+t)
+		if err != nil {
+			return errors.Errorf("vacuum %s: %w", t, err)
+		}
+	}
+	return nil
+}
+
+// dbSize gets the size of the database in bytes.
+func dbSize(ctx context.Context) (int64, error) {
+	var (
+		db   = zdb.MustGet(ctx)
+		size int64
+	)
+	if cfg.PgSQL {
+		err := db.GetContext(ctx, &size, `select pg_database_size(current_database())`)
+		return size, errors.Wrap(err, "dbSize")
+	}
+
+	var pages, pageSize int64
+	err := db.GetContext(ctx, &pages, `pragma page_count`)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbSize")
+	}
+	err = db.GetContext(ctx, &pageSize, `pragma page_size`)
+	return pages * pageSize, errors.Wrap(err, "dbSize")
+}
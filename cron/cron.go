@@ -7,9 +7,12 @@ package cron
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/bgrun"
 	"zgo.at/zdb"
 	"zgo.at/zlog"
@@ -20,25 +23,193 @@ import (
 type task struct {
 	fun    func(context.Context) error
 	period time.Duration
+
+	// Run on every instance, rather than on just one instance if several
+	// instances use the same database; for tasks that work on the memstore or
+	// local files (GeoIP database, ACME certificates).
+	local bool
 }
 
 var tasks = []task{
-	{PersistAndStat, 10 * time.Second},
-	{DataRetention, 1 * time.Hour},
-	{renewACME, 2 * time.Hour},
-	{vacuumDeleted, 12 * time.Hour},
-	{oldExports, 1 * time.Hour},
-	{sessions, 1 * time.Minute},
+	{PersistAndStat, 10 * time.Second, true},
+	{DataRetention, 1 * time.Hour, false},
+	{AnonymizeHits, 1 * time.Hour, false},
+	{renewACME, 10 * time.Minute, true},
+	{vacuumDeleted, 12 * time.Hour, false},
+	{oldExports, 1 * time.Hour, false},
+	{oldJobs, 12 * time.Hour, false},
+	{lostJobs, 1 * time.Minute, false},
+	{scheduledExports, 1 * time.Hour, false},
+	{noData, 1 * time.Hour, false},
+	{pathWatches, 5 * time.Minute, false},
+	{selfPing, 5 * time.Minute, true},
+	{sessions, 1 * time.Minute, true},
+	{updateGeoDB, 24 * time.Hour, true},
+	{retryEmails, 1 * time.Minute, false},
+	{retryStats, 1 * time.Minute, false},
+	{rollups, 1 * time.Minute, false},
+	{CompactStats, compactStatsPeriod, false},
 }
 
 var stopped = zsync.NewAtomicInt(0)
 
+// name gets the task name, which is the function name without the package.
+func (t task) name() string {
+	return strings.Replace(zruntime.FuncName(t.fun), "zgo.at/goatcounter/cron.", "", 1)
+}
+
+var periods struct {
+	sync.Mutex
+	m map[string]time.Duration
+}
+
+// every gets how often the task runs, which is the default period unless it's
+// changed with SetPeriods().
+func (t task) every() time.Duration { return period(t.name(), t.period) }
+
+// period gets the period for the task name set with SetPeriods(), or def if
+// it's not set.
+func period(name string, def time.Duration) time.Duration {
+	periods.Lock()
+	defer periods.Unlock()
+	if p, ok := periods.m[name]; ok {
+		return p
+	}
+	return def
+}
+
+type processedKey struct{}
+
+// addProcessed adds n to the number of rows processed by the task, which is
+// shown in Status().
+func addProcessed(ctx context.Context, n int) {
+	if p, ok := ctx.Value(processedKey{}).(*int); ok {
+		*p += n
+	}
+}
+
+// run the task and record the status.
+//
+// Tasks that aren't local are only run if no other instance is running the
+// same task; it's skipped if another instance is. If scheduled is set they're
+// also skipped if any instance already ran the task in the current period, as
+// every instance runs the tasks on its own schedule.
+func (t task) run(ctx context.Context, scheduled bool) error {
+	var (
+		processed int
+		start     = time.Now()
+		s         = goatcounter.CronStatus{Task: t.name(), LastRun: goatcounter.Now()}
+	)
+	fun := func() error {
+		err := t.fun(context.WithValue(ctx, processedKey{}, &processed))
+		s.Duration, s.Processed = time.Since(start).Milliseconds(), processed
+		if sErr := s.Update(ctx, err); sErr != nil {
+			zlog.Module("cron").Error(sErr)
+		}
+		return err
+	}
+	if t.local {
+		return fun()
+	}
+
+	ran, err := goatcounter.WithLock(ctx, "cron:"+t.name(), func() error {
+		if scheduled {
+			var last goatcounter.CronStatus
+			err := last.ByTask(ctx, t.name())
+			if err != nil && !zdb.ErrNoRows(err) {
+				return err
+			}
+			// Allow some margin, as the instances don't start the tasks at
+			// exactly the same time; this is still just one run per period.
+			if err == nil && last.LastRun.After(s.LastRun.Add(-t.every()+t.every()/10)) {
+				zlog.Module("cron").Debugf("%s: already ran at %s", t.name(), last.LastRun)
+				return nil
+			}
+		}
+		return fun()
+	})
+	if !ran && err == nil {
+		zlog.Module("cron").Debugf("%s: running on another instance", t.name())
+	}
+	return err
+}
+
+// Status gets the status of the last run for all tasks; tasks that never ran
+// have a zero LastRun.
+func Status(ctx context.Context) (goatcounter.CronStatuses, error) {
+	var ran goatcounter.CronStatuses
+	err := ran.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make(goatcounter.CronStatuses, 0, len(tasks))
+	for _, t := range tasks {
+		s := goatcounter.CronStatus{Task: t.name()}
+		for _, r := range ran {
+			if r.Task == s.Task {
+				s = r
+				break
+			}
+		}
+		s.Period = int64(t.every() / time.Second)
+		status = append(status, s)
+	}
+	return status, nil
+}
+
+// TaskNames gets the names of all tasks.
+func TaskNames() []string {
+	names := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		names = append(names, t.name())
+	}
+	return names
+}
+
+// CheckPeriods checks if the periods are valid for SetPeriods().
+func CheckPeriods(p map[string]time.Duration) error {
+	for name, d := range p {
+		found := false
+		for _, t := range tasks {
+			if t.name() == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown task %q; valid tasks are: %s", name, strings.Join(TaskNames(), ", "))
+		}
+		if d < time.Second {
+			return fmt.Errorf("period for task %q must be at least 1s", name)
+		}
+	}
+	return nil
+}
+
+// SetPeriods sets how often tasks run, by task name; all tasks that aren't in
+// p run at the default period. The period must be at least one second.
+//
+// This can be changed while the tasks are running; the new period is used
+// after the next run.
+func SetPeriods(p map[string]time.Duration) error {
+	err := CheckPeriods(p)
+	if err != nil {
+		return err
+	}
+
+	periods.Lock()
+	defer periods.Unlock()
+	periods.m = p
+	return nil
+}
+
 // RunOnce runs all tasks once and returns.
 func RunOnce(db zdb.DB) {
 	ctx := zdb.With(context.Background(), db)
 	l := zlog.Module("cron")
 	for _, t := range tasks {
-		err := t.fun(ctx)
+		err := t.run(ctx, false)
 		if err != nil {
 			l.Error(err)
 		}
@@ -55,14 +226,13 @@ func RunBackground(db zdb.DB) {
 			defer zlog.Recover()
 
 			for {
-				time.Sleep(t.period)
+				time.Sleep(t.every())
 				if stopped.Value() == 1 {
 					return
 				}
 
-				f := strings.Replace(zruntime.FuncName(t.fun), "zgo.at/goatcounter/cron.", "", 1)
-				bgrun.Run("cron:"+f, func() {
-					err := t.fun(ctx)
+				bgrun.Run("cron:"+t.name(), func() {
+					err := t.run(ctx, true)
 					if err != nil {
 						l.Error(err)
 					}
@@ -73,13 +243,51 @@ func RunBackground(db zdb.DB) {
 	}
 }
 
+// Shutdown stops running tasks in the background, and stores everything that's
+// kept in memory.
+//
+// The pageviews in the memstore are stored first, then this waits for at most
+// timeout for all background jobs started with bgrun to finish (e.g. imports and
+// exports), after which the memstore is stored again for the pageviews these
+// jobs added. Jobs that are still running after the timeout are abandoned and
+// logged.
+func Shutdown(db zdb.DB, timeout time.Duration) {
+	stopped.Set(1)
+	var (
+		ctx = zdb.With(context.Background(), db)
+		l   = zlog.Module("cron")
+	)
+
+	err := PersistAndStat(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+
+	err = bgrun.WaitFor(timeout)
+	if err != nil {
+		running := bgrun.Running()
+		l.Errorf("shutdown: abandoned %d background jobs after waiting %s: %s",
+			len(running), timeout, strings.Join(running, ", "))
+	}
+
+	err = PersistAndStat(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+	goatcounter.Memstore.StoreSessions(db)
+
+	if n := goatcounter.Memstore.Len(); n > 0 {
+		l.Errorf("shutdown: %d pageviews weren't stored", n)
+	}
+}
+
 // Wait for all running tasks to finish and then run all tasks for consistency
 // on shutdown.
 func Wait(db zdb.DB) {
 	stopped.Set(1)
 	ctx := zdb.With(context.Background(), db)
 	for _, t := range tasks {
-		err := t.fun(ctx)
+		err := t.run(ctx, false)
 		if err != nil {
 			zlog.Module("cron").Error(err)
 		}
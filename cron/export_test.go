@@ -0,0 +1,15 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+	"time"
+)
+
+// RunScheduled runs fun as a task that isn't local, like RunBackground does.
+func RunScheduled(ctx context.Context, fun func(context.Context) error, period time.Duration) error {
+	return task{fun: fun, period: period}.run(ctx, true)
+}
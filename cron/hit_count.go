@@ -15,13 +15,14 @@ import (
 
 func updateHitCounts(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
 	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		// Group by day + path.
+		// Group by day + path + host.
 		type gt struct {
 			total       int
 			totalUnique int
 			hour        string
 			event       zdb.Bool
 			path        string
+			host        string
 			title       string
 		}
 		grouped := map[string]gt{}
@@ -31,11 +32,12 @@ func updateHitCounts(ctx context.Context, hits []goatcounter.Hit, isReindex bool
 			}
 
 			hour := h.CreatedAt.Format("2006-01-02 15:00:00")
-			k := hour + h.Path
+			k := hour + h.Path + "\x00" + h.Host
 			v := grouped[k]
 			if v.total == 0 {
 				v.hour = hour
 				v.path = h.Path
+				v.host = h.Host
 				v.event = h.Event
 			}
 
@@ -43,28 +45,28 @@ func updateHitCounts(ctx context.Context, hits []goatcounter.Hit, isReindex bool
 				v.title = h.Title
 			}
 
-			v.total += 1
+			v.total += h.Weight()
 			if h.FirstVisit {
-				v.totalUnique += 1
+				v.totalUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
 
 		siteID := goatcounter.MustGetSite(ctx).ID
 		ins := bulk.NewInsert(ctx, "hit_counts", []string{"site", "path",
-			"title", "event", "hour", "total", "total_unique"})
+			"host", "title", "event", "hour", "total", "total_unique"})
 		if cfg.PgSQL {
-			ins.OnConflict(`on conflict on constraint "hit_counts#site#path#hour" do update set
+			ins.OnConflict(`on conflict on constraint "hit_counts#site#path#host#hour" do update set
 				total=hit_counts.total + excluded.total,
 				total_unique=hit_counts.total_unique + excluded.total_unique`)
 		} else {
-			ins.OnConflict(`on conflict(site, path, hour) do update set
+			ins.OnConflict(`on conflict(site, path, host, hour) do update set
 				total=hit_counts.total + excluded.total,
 				total_unique=hit_counts.total_unique + excluded.total_unique`)
 		}
 
 		for _, v := range grouped {
-			ins.Values(siteID, v.path, v.title, v.event, v.hour, v.total, v.totalUnique)
+			ins.Values(siteID, v.path, v.host, v.title, v.event, v.hour, v.total, v.totalUnique)
 		}
 		return ins.Finish()
 	})
@@ -58,9 +58,9 @@ func updateHitStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool)
 			}
 
 			hour, _ := strconv.ParseInt(h.CreatedAt.Format("15"), 10, 8)
-			v.count[hour] += 1
+			v.count[hour] += h.Weight()
 			if h.FirstVisit {
-				v.countUnique[hour] += 1
+				v.countUnique[hour] += h.Weight()
 			}
 			grouped[k] = v
 		}
@@ -0,0 +1,33 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+)
+
+// markHoneypot marks the pageviews of sessions that requested the honeypot
+// link which were already stored, and reindexes the days for which this
+// changed the statistics.
+func markHoneypot(ctx context.Context, sessions []goatcounter.HoneypotSession) error {
+	errs := errors.NewGroup(20)
+	for _, s := range sessions {
+		var site goatcounter.Site
+		err := site.ByID(ctx, s.Site)
+		if errs.Append(errors.Wrap(err, "cron.markHoneypot")) {
+			continue
+		}
+
+		first, last, err := s.MarkStored(ctx, &site)
+		if errs.Append(errors.Wrap(err, "cron.markHoneypot")) || first.IsZero() {
+			continue
+		}
+		errs.Append(errors.Wrap(ReindexRange(ctx, site, first, last, []string{"all"}), "cron.markHoneypot"))
+	}
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,63 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestMarkHoneypot(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+	defer gctest.SwapNow(t, now)()
+
+	display := func() int {
+		t.Helper()
+		var stats goatcounter.HitStats
+		d, _, _, _, err := stats.List(ctx, now.Add(-24*time.Hour), now.Add(24*time.Hour), "", "", nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	// Stored and counted before the honeypot link was requested.
+	hit := goatcounter.Hit{Site: site.ID, Path: "/a", Browser: "test", RemoteAddr: "127.0.0.1", CreatedAt: now}
+	goatcounter.Memstore.Append(hit)
+	err := cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := display(); d != 1 {
+		t.Fatalf("display=%d before the honeypot", d)
+	}
+
+	goatcounter.Memstore.Honeypot(hit)
+	err = cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bots int
+	err = zdb.MustGet(ctx).GetContext(ctx, &bots, `select count(*) from hits where bot > 0`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bots != 1 {
+		t.Errorf("%d pageviews marked as bot", bots)
+	}
+	if d := display(); d != 0 {
+		t.Errorf("display=%d after the honeypot", d)
+	}
+}
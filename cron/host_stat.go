@@ -0,0 +1,88 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/zdb"
+	"zgo.at/zdb/bulk"
+)
+
+func updateHostStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		// Group by day + host.
+		type gt struct {
+			count       int
+			countUnique int
+			day         string
+			host        string
+		}
+		grouped := map[string]gt{}
+		for _, h := range hits {
+			if h.Bot > 0 {
+				continue
+			}
+
+			day := h.CreatedAt.Format("2006-01-02")
+			k := day + h.Host
+			v := grouped[k]
+			if v.count == 0 {
+				v.day = day
+				v.host = h.Host
+				if !isReindex {
+					var err error
+					v.count, v.countUnique, err = existingHostStats(ctx, tx,
+						h.Site, day, v.host)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			v.count += h.Weight()
+			if h.FirstVisit {
+				v.countUnique += h.Weight()
+			}
+			grouped[k] = v
+		}
+
+		siteID := goatcounter.MustGetSite(ctx).ID
+		ins := bulk.NewInsert(ctx, "host_stats", []string{"site", "day",
+			"host", "count", "count_unique"})
+		for _, v := range grouped {
+			ins.Values(siteID, v.day, v.host, v.count, v.countUnique)
+		}
+		return ins.Finish()
+	})
+}
+
+func existingHostStats(
+	txctx context.Context, tx zdb.DB, siteID int64,
+	day, host string,
+) (int, int, error) {
+
+	var c []struct {
+		Count       int `db:"count"`
+		CountUnique int `db:"count_unique"`
+	}
+	err := tx.SelectContext(txctx, &c, `/* existingHostStats */
+		select count, count_unique from host_stats
+		where site=$1 and day=$2 and host=$3 limit 1`,
+		siteID, day, host)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "select")
+	}
+	if len(c) == 0 {
+		return 0, 0, nil
+	}
+
+	_, err = tx.ExecContext(txctx, `delete from host_stats where
+		site=$1 and day=$2 and host=$3`,
+		siteID, day, host)
+	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
+}
@@ -0,0 +1,40 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestHostStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Host: "example.com"},
+		{Site: site.ID, CreatedAt: now, Host: "Example.com."},
+		{Site: site.ID, CreatedAt: now, Host: "example.org", FirstVisit: true},
+	}...)
+
+	var stats goatcounter.Stats
+	err := stats.ByHost(ctx, now, now, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{false [{example.com 2 0 <nil>} {example.org 1 1 <nil>}]}`
+	out := fmt.Sprintf("%v", stats)
+	if want != out {
+		t.Errorf("\nwant: %s\nout:  %s", want, out)
+	}
+}
@@ -15,12 +15,14 @@ import (
 
 func updateLocationStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
 	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		// Group by day + location.
+		// Group by day + location + region + city.
 		type gt struct {
 			count       int
 			countUnique int
 			day         string
 			location    string
+			region      string
+			city        string
 		}
 		grouped := map[string]gt{}
 		for _, h := range hits {
@@ -29,33 +31,35 @@ func updateLocationStats(ctx context.Context, hits []goatcounter.Hit, isReindex
 			}
 
 			day := h.CreatedAt.Format("2006-01-02")
-			k := day + h.Location
+			k := day + h.Location + "\x00" + h.Region + "\x00" + h.City
 			v := grouped[k]
 			if v.count == 0 {
 				v.day = day
 				v.location = h.Location
+				v.region = h.Region
+				v.city = h.City
 				if !isReindex {
 					var err error
 					v.count, v.countUnique, err = existingLocationStats(ctx, tx,
-						h.Site, day, v.location)
+						h.Site, day, v.location, v.region, v.city)
 					if err != nil {
 						return err
 					}
 				}
 			}
 
-			v.count += 1
+			v.count += h.Weight()
 			if h.FirstVisit {
-				v.countUnique += 1
+				v.countUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
 
 		siteID := goatcounter.MustGetSite(ctx).ID
 		ins := bulk.NewInsert(ctx, "location_stats", []string{"site", "day",
-			"location", "count", "count_unique"})
+			"location", "region", "city", "count", "count_unique"})
 		for _, v := range grouped {
-			ins.Values(siteID, v.day, v.location, v.count, v.countUnique)
+			ins.Values(siteID, v.day, v.location, v.region, v.city, v.count, v.countUnique)
 		}
 		return ins.Finish()
 	})
@@ -63,7 +67,7 @@ func updateLocationStats(ctx context.Context, hits []goatcounter.Hit, isReindex
 
 func existingLocationStats(
 	txctx context.Context, tx zdb.DB, siteID int64,
-	day, location string,
+	day, location, region, city string,
 ) (int, int, error) {
 
 	var c []struct {
@@ -72,8 +76,8 @@ func existingLocationStats(
 	}
 	err := tx.SelectContext(txctx, &c, `/* existingLocationStats */
 		select count, count_unique from location_stats
-		where site=$1 and day=$2 and location=$3 limit 1`,
-		siteID, day, location)
+		where site=$1 and day=$2 and location=$3 and region=$4 and city=$5 limit 1`,
+		siteID, day, location, region, city)
 	if err != nil {
 		return 0, 0, errors.Wrap(err, "select")
 	}
@@ -82,7 +86,7 @@ func existingLocationStats(
 	}
 
 	_, err = tx.ExecContext(txctx, `delete from location_stats where
-		site=$1 and day=$2 and location=$3`,
-		siteID, day, location)
+		site=$1 and day=$2 and location=$3 and region=$4 and city=$5`,
+		siteID, day, location, region, city)
 	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
 }
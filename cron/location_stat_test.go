@@ -61,3 +61,43 @@ func TestLocationStats(t *testing.T) {
 		t.Errorf("\nwant: %s\nout:  %s", want, out)
 	}
 }
+
+func TestLocationStatsRegionCity(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Location: "NZ", Region: "Wellington", City: "Wellington"},
+		{Site: site.ID, CreatedAt: now, Location: "NZ", Region: "Wellington", City: "Lower Hutt"},
+		{Site: site.ID, CreatedAt: now, Location: "NZ", Region: "Auckland", City: "Auckland", FirstVisit: true},
+		{Site: site.ID, CreatedAt: now, Location: "ID"},
+	}...)
+
+	tests := []struct {
+		list func(*goatcounter.Stats) error
+		want string
+	}{
+		{func(s *goatcounter.Stats) error { return s.ListLocations(ctx, now, now, 10, 0) },
+			`{false [{New Zealand 3 1 <nil>} {Indonesia 1 0 <nil>}]}`},
+		{func(s *goatcounter.Stats) error { return s.ByRegion(ctx, now, now, 10, 0) },
+			`{false [{Auckland, New Zealand 1 1 <nil>} {Wellington, New Zealand 2 0 <nil>}]}`},
+		{func(s *goatcounter.Stats) error { return s.ByCity(ctx, now, now, 10, 0) },
+			`{false [{Auckland, Auckland 1 1 <nil>} {Lower Hutt, Wellington 1 0 <nil>} {Wellington, Wellington 1 0 <nil>}]}`},
+	}
+
+	for _, tt := range tests {
+		var stats goatcounter.Stats
+		err := tt.list(&stats)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out := fmt.Sprintf("%v", stats)
+		if tt.want != out {
+			t.Errorf("\nwant: %s\nout:  %s", tt.want, out)
+		}
+	}
+}
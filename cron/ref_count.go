@@ -15,15 +15,18 @@ import (
 
 func updateRefCounts(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
 	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		// Group by day + path + ref.
+		// Group by day + path + ref + host.
 		type gt struct {
 			total       int
 			totalUnique int
 			hour        string
 			path        string
+			host        string
 			ref         string
 			refScheme   *string
+			refCategory string
 		}
+		site := goatcounter.MustGetSite(ctx)
 		grouped := map[string]gt{}
 		for _, h := range hits {
 			if h.Bot > 0 {
@@ -31,37 +34,38 @@ func updateRefCounts(ctx context.Context, hits []goatcounter.Hit, isReindex bool
 			}
 
 			hour := h.CreatedAt.Format("2006-01-02 15:00:00")
-			k := hour + h.Path + h.Ref
+			k := hour + h.Path + "\x00" + h.Ref + "\x00" + h.Host
 			v := grouped[k]
 			if v.total == 0 {
 				v.hour = hour
 				v.path = h.Path
+				v.host = h.Host
 				v.ref = h.Ref
 				v.refScheme = h.RefScheme
+				v.refCategory = site.Settings.RefCategory(h.Ref, h.RefScheme)
 			}
 
-			v.total += 1
+			v.total += h.Weight()
 			if h.FirstVisit {
-				v.totalUnique += 1
+				v.totalUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
 
-		siteID := goatcounter.MustGetSite(ctx).ID
 		ins := bulk.NewInsert(ctx, "ref_counts", []string{"site", "path",
-			"ref", "hour", "total", "total_unique", "ref_scheme"})
+			"host", "ref", "hour", "total", "total_unique", "ref_scheme", "ref_category"})
 		if cfg.PgSQL {
-			ins.OnConflict(`on conflict on constraint "ref_counts#site#path#ref#hour" do update set
+			ins.OnConflict(`on conflict on constraint "ref_counts#site#path#ref#host#hour" do update set
 				total = ref_counts.total + excluded.total,
 				total_unique = ref_counts.total_unique + excluded.total_unique`)
 		} else {
-			ins.OnConflict(`on conflict(site, path, ref, hour) do update set
+			ins.OnConflict(`on conflict(site, path, ref, host, hour) do update set
 				total = ref_counts.total + excluded.total,
 				total_unique = ref_counts.total_unique + excluded.total_unique`)
 		}
 
 		for _, v := range grouped {
-			ins.Values(siteID, v.path, v.ref, v.hour, v.total, v.totalUnique, v.refScheme)
+			ins.Values(site.ID, v.path, v.host, v.ref, v.hour, v.total, v.totalUnique, v.refScheme, v.refCategory)
 		}
 		return ins.Finish()
 	})
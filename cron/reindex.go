@@ -0,0 +1,129 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/zdb"
+)
+
+// ReindexRange removes the statistics in the tables from the start day up to
+// and including the end day, and recreates them from the pageviews.
+//
+// This is the goatcounter.ReindexFunc for goatcounter.ReindexJob.
+func ReindexRange(ctx context.Context, site goatcounter.Site, start, end time.Time, tables []string) error {
+	// The pageviews before this were removed but the statistics were kept, so
+	// they can't be recreated.
+	if keep := site.Settings.PageviewsRemovedBefore(); start.Before(keep) {
+		if end.Before(keep) {
+			return nil
+		}
+		start = keep
+	}
+
+	// The browser, system, and location were removed from the pageviews
+	// before this, so don't recreate those tables for these days.
+	anon := site.Settings.AnonymizedBefore()
+	if !start.Before(anon) {
+		return reindexRange(ctx, site, start, end, tables)
+	}
+
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		if t := withoutAnonymized(tables); len(t) > 0 {
+			e := anon.AddDate(0, 0, -1)
+			if end.Before(e) {
+				e = end
+			}
+			err := reindexRange(ctx, site, start, e, t)
+			if err != nil {
+				return err
+			}
+		}
+		if end.Before(anon) {
+			return nil
+		}
+		return reindexRange(ctx, site, anon, end, tables)
+	})
+}
+
+// anonymizedTables are the tables that can't be recreated from anonymized
+// pageviews.
+var anonymizedTables = map[string]struct{}{
+	"browser_stats": {}, "system_stats": {}, "location_stats": {}}
+
+// withoutAnonymized removes the anonymizedTables from tables, expanding "all"
+// to all other tables.
+func withoutAnonymized(tables []string) []string {
+	var t []string
+	for _, tbl := range tables {
+		if tbl == "all" {
+			t = append(t, "hit_counts", "ref_counts", "hit_stats", "size_stats",
+				"host_stats", "campaign_stats")
+			continue
+		}
+		if _, ok := anonymizedTables[tbl]; !ok {
+			t = append(t, tbl)
+		}
+	}
+	return t
+}
+
+func reindexRange(ctx context.Context, site goatcounter.Site, start, end time.Time, tables []string) error {
+	var (
+		first = start.Format("2006-01-02")
+		last  = end.Format("2006-01-02")
+	)
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		var hits []goatcounter.Hit
+		err := tx.SelectContext(ctx, &hits, `/* ReindexRange */
+			select * from hits where site=$1 and created_at >= $2 and created_at <= $3`,
+			site.ID, first+" 00:00:00", last+" 23:59:59")
+		if err != nil {
+			return errors.Wrap(err, "ReindexRange")
+		}
+
+		err = clearStats(ctx, tx, site.ID, first, last, tables)
+		if err != nil {
+			return errors.Wrap(err, "ReindexRange")
+		}
+
+		return ReindexStats(ctx, site, hits, tables)
+	})
+}
+
+// clearStats removes the statistics in the tables from the first day up to and
+// including the last day.
+func clearStats(ctx context.Context, tx zdb.DB, siteID int64, first, last string, tables []string) error {
+	var clear []string
+	for _, t := range tables {
+		if t == "all" {
+			clear = append(clear, "hit_stats", "browser_stats", "system_stats",
+				"location_stats", "size_stats", "host_stats", "campaign_stats",
+				"hit_counts", "ref_counts")
+			continue
+		}
+		clear = append(clear, t)
+	}
+
+	for _, t := range clear {
+		var err error
+		switch t {
+		case "hit_counts", "ref_counts":
+			_, err = tx.ExecContext(ctx, `delete from `+t+` where site=$1 and hour >= $2 and hour <= $3`,
+				siteID, first+" 00:00:00", last+" 23:59:59")
+		default:
+			_, err = tx.ExecContext(ctx, `delete from `+t+` where site=$1 and day >= $2 and day <= $3`,
+				siteID, first, last)
+		}
+		if err != nil {
+			return errors.Errorf("%s: %w", t, err)
+		}
+	}
+	return nil
+}
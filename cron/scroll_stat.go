@@ -0,0 +1,96 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/zdb"
+	"zgo.at/zdb/bulk"
+)
+
+// updateScrollStats aggregates the scroll depth events in the scroll_stats.
+//
+// Unlike the other stats these can't be re-created from the hits, so this isn't
+// part of UpdateStats or the reindex.
+func updateScrollStats(ctx context.Context, scrolls []goatcounter.ScrollDepth) error {
+	if len(scrolls) == 0 {
+		return nil
+	}
+
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		// Group by site + day + path.
+		type gk struct {
+			site int64
+			day  string
+			path string
+		}
+		grouped := map[gk]goatcounter.ScrollStat{}
+		for _, s := range scrolls {
+			k := gk{site: s.Site, day: s.CreatedAt.Format("2006-01-02"), path: s.Path}
+			v, ok := grouped[k]
+			if !ok {
+				var err error
+				v, err = existingScrollStats(ctx, tx, k.site, k.day, k.path)
+				if err != nil {
+					return err
+				}
+			}
+
+			v.Count++
+			v.Total += s.Depth
+			if s.Depth >= 25 {
+				v.Reached25++
+			}
+			if s.Depth >= 50 {
+				v.Reached50++
+			}
+			if s.Depth >= 75 {
+				v.Reached75++
+			}
+			if s.Depth >= 100 {
+				v.Reached100++
+			}
+			grouped[k] = v
+		}
+
+		ins := bulk.NewInsert(ctx, "scroll_stats", []string{"site", "day", "path",
+			"count", "total", "reached_25", "reached_50", "reached_75", "reached_100"})
+		for k, v := range grouped {
+			ins.Values(k.site, k.day, k.path, v.Count, v.Total,
+				v.Reached25, v.Reached50, v.Reached75, v.Reached100)
+		}
+		return errors.Wrap(ins.Finish(), "updateScrollStats scroll_stats")
+	})
+}
+
+func existingScrollStats(
+	txctx context.Context, tx zdb.DB, siteID int64,
+	day, path string,
+) (goatcounter.ScrollStat, error) {
+
+	var ex []goatcounter.ScrollStat
+	err := tx.SelectContext(txctx, &ex, `/* existingScrollStats */
+		select path, count, total, reached_25, reached_50, reached_75, reached_100
+		from scroll_stats
+		where site=$1 and day=$2 and path=$3 limit 1`,
+		siteID, day, path)
+	if err != nil {
+		return goatcounter.ScrollStat{}, errors.Wrap(err, "existingScrollStats")
+	}
+	if len(ex) == 0 {
+		return goatcounter.ScrollStat{}, nil
+	}
+
+	_, err = tx.ExecContext(txctx, `delete from scroll_stats where
+		site=$1 and day=$2 and path=$3`,
+		siteID, day, path)
+	if err != nil {
+		return goatcounter.ScrollStat{}, errors.Wrap(err, "delete")
+	}
+	return ex[0], nil
+}
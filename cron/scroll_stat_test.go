@@ -0,0 +1,113 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestScrollStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	for _, d := range []int{10, 50, 100} {
+		goatcounter.Memstore.AppendScroll(goatcounter.ScrollDepth{
+			Site: site.ID, Path: "/a?utm_source=x", Depth: d, CreatedAt: now})
+	}
+	err := cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := goatcounter.HitStats{{Path: "/a"}, {Path: "/b"}}
+	err = stats.LoadScroll(ctx, now, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats[1].Scroll != nil {
+		t.Errorf("scroll for /b: %v", stats[1].Scroll)
+	}
+	got := fmt.Sprintf("%+v", stats[0].Scroll)
+	want := "&{Path:/a Count:3 Total:160 Reached25:2 Reached50:2 Reached75:1 Reached100:1}"
+	if got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+
+	// Update existing.
+	goatcounter.Memstore.AppendScroll(goatcounter.ScrollDepth{
+		Site: site.ID, Path: "/a", Depth: 80, CreatedAt: now})
+	err = cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats = goatcounter.HitStats{{Path: "/a"}}
+	err = stats.LoadScroll(ctx, now, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, r := stats[0].Scroll.Average(), stats[0].Scroll.Reached(75); a != 60 || r != 50 {
+		t.Errorf("average: %d; reached 75%%: %d", a, r)
+	}
+}
+
+func TestScrollStatsIgnored(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	// The settings are checked when the events are persisted, as they may
+	// have changed since they were added.
+	for _, s := range []goatcounter.ScrollDepth{
+		{Browser: "Firefox", RemoteAddr: "127.0.0.1"},
+		{Browser: "Firefox", RemoteAddr: "127.0.0.2"},
+		{Browser: "uptime-monitor", RemoteAddr: "127.0.0.1"},
+	} {
+		s.Site, s.Path, s.Depth, s.CreatedAt = site.ID, "/a", 50, now
+		goatcounter.Memstore.AppendScroll(s)
+	}
+	site.Settings.IgnoreIPs = []string{"127.0.0.2"}
+	site.Settings.BotRules.UserAgents = []string{"uptime"}
+	err := site.Update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := goatcounter.HitStats{{Path: "/a"}}
+	err = stats.LoadScroll(ctx, now, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats[0].Scroll == nil || stats[0].Scroll.Count != 1 {
+		t.Errorf("wrong scroll stats: %+v", stats[0].Scroll)
+	}
+
+	// Events over the limit are dropped.
+	defer func(n int) { goatcounter.MaxScrollDepths = n }(goatcounter.MaxScrollDepths)
+	goatcounter.MaxScrollDepths = 1
+	s := goatcounter.ScrollDepth{Site: site.ID, Path: "/a", Depth: 50, CreatedAt: now}
+	if !goatcounter.Memstore.AppendScroll(s) {
+		t.Error("first event not added")
+	}
+	if goatcounter.Memstore.AppendScroll(s) {
+		t.Error("second event added")
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron
+
+import (
+	"context"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter"
+	"zgo.at/zdb/bulk"
+)
+
+// updateSessionStats stores the summaries of the sessions that ended in the
+// sessions_stats.
+//
+// Like the scroll_stats these can't be re-created from the hits, so this isn't
+// part of UpdateStats or the reindex.
+func updateSessionStats(ctx context.Context, sessions []goatcounter.SessionStat) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	ins := bulk.NewInsert(ctx, "sessions_stats", []string{"site", "day",
+		"pageviews", "duration", "entry_path", "exit_path"})
+	for _, s := range sessions {
+		ins.Values(s.Site, s.Day, s.Pageviews, s.Duration, s.EntryPath, s.ExitPath)
+	}
+	return errors.Wrap(ins.Finish(), "updateSessionStats")
+}
@@ -0,0 +1,81 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package cron_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cron"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestSessionStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+
+	hit := func(path, addr string, d time.Duration, event bool) {
+		t.Helper()
+		defer gctest.SwapNow(t, now.Add(d))()
+		goatcounter.Memstore.Append(goatcounter.Hit{Site: site.ID, Path: path,
+			Event: zdb.Bool(event), Browser: "test", RemoteAddr: addr, CreatedAt: now.Add(d)})
+		err := cron.PersistAndStat(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	hit("/a", "127.0.0.1", 0, false)
+	hit("/b", "127.0.0.1", time.Minute, false)
+	hit("click", "127.0.0.1", 2*time.Minute, true)
+	hit("/c", "127.0.0.1", 3*time.Minute, false)
+	hit("/x", "127.0.0.2", 0, false)
+	hit("event", "127.0.0.3", 0, true) // Only events: not recorded.
+
+	// Nothing is stored until the sessions are evicted.
+	var n int
+	err := zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from sessions_stats`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("%d rows before the sessions ended", n)
+	}
+
+	func() {
+		defer gctest.SwapNow(t, now.Add(24*time.Hour))()
+		goatcounter.Memstore.EvictSessions()
+	}()
+
+	// The ended sessions are kept on restart.
+	goatcounter.Memstore.StoreSessions(zdb.MustGet(ctx))
+	err = goatcounter.Memstore.TestInit(zdb.MustGet(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []goatcounter.SessionStat
+	err = zdb.MustGet(ctx).SelectContext(ctx, &got, `
+		select site, pageviews, duration, entry_path, exit_path from sessions_stats
+		where day=$1 order by pageviews`, "2019-08-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{Site:1 Day: Pageviews:1 Duration:0 EntryPath:/x ExitPath:/x} ` +
+		`{Site:1 Day: Pageviews:3 Duration:180 EntryPath:/a ExitPath:/c}]`
+	if g := fmt.Sprintf("%+v", got); g != want {
+		t.Errorf("\ngot:  %s\nwant: %s", g, want)
+	}
+}
@@ -16,12 +16,13 @@ import (
 
 func updateSizeStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool) error {
 	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		// Group by day + width.
+		// Group by day + width + device class.
 		type gt struct {
 			count       int
 			countUnique int
 			day         string
 			width       int
+			deviceClass string
 		}
 		grouped := map[string]gt{}
 		for _, h := range hits {
@@ -35,33 +36,35 @@ func updateSizeStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool
 			}
 
 			day := h.CreatedAt.Format("2006-01-02")
-			k := fmt.Sprintf("%s%d", day, width)
+			class := goatcounter.DeviceClass(h.Size)
+			k := fmt.Sprintf("%s%d%s", day, width, class)
 			v := grouped[k]
 			if v.count == 0 {
 				v.day = day
 				v.width = width
+				v.deviceClass = class
 				if !isReindex {
 					var err error
 					v.count, v.countUnique, err = existingSizeStats(ctx, tx, h.Site,
-						day, v.width)
+						day, v.width, v.deviceClass)
 					if err != nil {
 						return err
 					}
 				}
 			}
 
-			v.count += 1
+			v.count += h.Weight()
 			if h.FirstVisit {
-				v.countUnique += 1
+				v.countUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
 
 		siteID := goatcounter.MustGetSite(ctx).ID
 		ins := bulk.NewInsert(ctx, "size_stats", []string{"site", "day",
-			"width", "count", "count_unique"})
+			"width", "device_class", "count", "count_unique"})
 		for _, v := range grouped {
-			ins.Values(siteID, v.day, v.width, v.count, v.countUnique)
+			ins.Values(siteID, v.day, v.width, v.deviceClass, v.count, v.countUnique)
 		}
 		return ins.Finish()
 	})
@@ -69,7 +72,7 @@ func updateSizeStats(ctx context.Context, hits []goatcounter.Hit, isReindex bool
 
 func existingSizeStats(
 	txctx context.Context, tx zdb.DB, siteID int64,
-	day string, width int,
+	day string, width int, deviceClass string,
 ) (int, int, error) {
 
 	var c []struct {
@@ -78,8 +81,8 @@ func existingSizeStats(
 	}
 	err := tx.SelectContext(txctx, &c, `/* existingSizeStats */
 		select count, count_unique from size_stats
-		where site=$1 and day=$2 and width=$3 limit 1`,
-		siteID, day, width)
+		where site=$1 and day=$2 and width=$3 and device_class=$4 limit 1`,
+		siteID, day, width, deviceClass)
 	if err != nil {
 		return 0, 0, errors.Wrap(err, "select")
 	}
@@ -88,7 +91,7 @@ func existingSizeStats(
 	}
 
 	_, err = tx.ExecContext(txctx, `delete from size_stats where
-		site=$1 and day=$2 and width=$3`,
-		siteID, day, width)
+		site=$1 and day=$2 and width=$3 and device_class=$4`,
+		siteID, day, width, deviceClass)
 	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
 }
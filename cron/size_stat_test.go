@@ -72,4 +72,19 @@ func TestSizeStats(t *testing.T) {
 	if want != out {
 		t.Errorf("\nwant: %s\nout:  %s", want, out)
 	}
+
+	stats = goatcounter.Stats{}
+	err = stats.ByDeviceClass(ctx, now, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = `{false [{desktop 4 2 <nil>}
+{ 3 1 <nil>}
+{phone 1 0 <nil>}
+{tablet 3 0 <nil>}]}`
+	out = strings.ReplaceAll(fmt.Sprintf("%v", stats), "} ", "}\n")
+	if want != out {
+		t.Errorf("\nwant:\n%s\nout:\n%s", want, out)
+	}
 }
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"zgo.at/errors"
-	"zgo.at/gadget"
 	"zgo.at/goatcounter"
 	"zgo.at/zdb"
 	"zgo.at/zdb/bulk"
@@ -30,7 +29,7 @@ func updateSystemStats(ctx context.Context, hits []goatcounter.Hit, isReindex bo
 				continue
 			}
 
-			system, version := getSystem(h.Browser)
+			system, version := h.SystemInfo()
 			if system == "" {
 				continue
 			}
@@ -52,9 +51,9 @@ func updateSystemStats(ctx context.Context, hits []goatcounter.Hit, isReindex bo
 				}
 			}
 
-			v.count += 1
+			v.count += h.Weight()
 			if h.FirstVisit {
-				v.countUnique += 1
+				v.countUnique += h.Weight()
 			}
 			grouped[k] = v
 		}
@@ -94,8 +93,3 @@ func existingSystemStats(
 		siteID, day, system, version)
 	return c[0].Count, c[0].CountUnique, errors.Wrap(err, "delete")
 }
-
-func getSystem(uaHeader string) (string, string) {
-	ua := gadget.Parse(uaHeader)
-	return ua.OSName, ua.OSVersion
-}
@@ -8,51 +8,242 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
 	"sync"
 	"time"
 
 	"zgo.at/errors"
 	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/acme"
-	"zgo.at/goatcounter/bgrun"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/zdb"
 	"zgo.at/zlog"
+	"zgo.at/zstd/zsync"
 )
 
+// oldExports removes exports once the retention has passed; see
+// Exports.DeleteExpired().
 func oldExports(ctx context.Context) error {
-	tmp := os.TempDir()
-	d, err := os.Open(tmp)
+	var exports goatcounter.Exports
+	err := exports.DeleteExpired(ctx)
 	if err != nil {
 		return errors.Errorf("cron.oldExports: %w", err)
 	}
+	return nil
+}
 
-	files, err := d.Readdirnames(-1)
+// scheduledExports starts exports for sites with the export_schedule setting;
+// the site's user is emailed a download link when they're finished.
+func scheduledExports(ctx context.Context) error {
+	// Don't start new exports on shutdown.
+	if stopped.Value() == 1 {
+		return nil
+	}
+
+	var sites goatcounter.Sites
+	err := sites.UnscopedList(ctx)
 	if err != nil {
-		return errors.Errorf("cron.oldExports: %w", err)
+		return errors.Errorf("cron.scheduledExports: %w", err)
 	}
 
-	tmp += "/"
-	for _, f := range files {
-		if !strings.HasPrefix(f, "goatcounter-export-") {
+	l := zlog.Module("cron-export")
+	for _, s := range sites {
+		s := s
+		if s.Settings.ExportSchedule == goatcounter.ExportScheduleNone {
+			continue
+		}
+
+		ctx := goatcounter.WithSite(ctx, &s)
+		due, err := goatcounter.ScheduledExportDue(ctx)
+		if err != nil {
+			l.Field("site", s.ID).Error(err)
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		var user goatcounter.User
+		err = user.BySite(ctx, s.ID)
+		if err != nil {
+			l.Field("site", s.ID).Error(err)
 			continue
 		}
+		ctx = goatcounter.WithUser(ctx, &user)
 
-		f = tmp + f
-		st, err := os.Stat(f)
+		export := goatcounter.Export{Scheduled: true}
+		fp, err := export.Create(ctx, 0)
 		if err != nil {
-			zlog.Errorf("cron.oldExports: %s", err)
+			l.Field("site", s.ID).Error(err)
 			continue
 		}
+		_, err = goatcounter.StartJob(ctx, goatcounter.JobExport,
+			func(ctx context.Context) error { return export.Run(ctx, fp, true) })
+		if err != nil {
+			fp.Close()
+			os.Remove(fp.Name())
+			l.Field("site", s.ID).Error(err)
+		}
+	}
+	return nil
+}
 
-		if st.ModTime().Before(goatcounter.Now().Add(-24 * time.Hour)) {
-			err := os.Remove(f)
+// noData emails the site's user if a site isn't receiving pageviews.
+//
+// This is sent once if a new site didn't receive any pageviews in the first 48
+// hours, as the script is probably not installed correctly, and if a site that
+// received pageviews before didn't receive any for the no_data_alert setting;
+// this is sent once until pageviews are received again.
+func noData(ctx context.Context) error {
+	var sites goatcounter.Sites
+	err := sites.UnscopedList(ctx)
+	if err != nil {
+		return errors.Errorf("cron.noData: %w", err)
+	}
+
+	var (
+		l   = zlog.Module("cron-nodata")
+		now = goatcounter.Now()
+	)
+	for _, s := range sites {
+		s := s
+		ctx := goatcounter.WithSite(ctx, &s)
+
+		var (
+			tpl, subject string
+			last         *time.Time
+		)
+		switch {
+		case !s.ReceivedData:
+			// Only for new sites, so that existing sites which never received
+			// anything don't all get an email.
+			if s.NoDataSentAt != nil || s.CreatedAt.After(now.Add(-48*time.Hour)) ||
+				s.CreatedAt.Before(now.Add(-7*24*time.Hour)) {
+				continue
+			}
+			tpl, subject = "email_no_data_new.gotxt", "GoatCounter hasn't received any pageviews yet"
+
+		case s.Settings.NoDataAlert > 0:
+			last, err = s.LastData(ctx)
 			if err != nil {
-				zlog.Errorf("cron.oldExports: %s", err)
+				l.Field("site", s.ID).Error(err)
+				continue
+			}
+			if last == nil || last.After(now.Add(-time.Duration(s.Settings.NoDataAlert)*time.Hour)) {
+				continue
 			}
+			if s.NoDataSentAt != nil && s.NoDataSentAt.After(*last) {
+				continue
+			}
+			tpl, subject = "email_no_data.gotxt", "GoatCounter hasn't received any pageviews recently"
+
+		default:
+			continue
+		}
+
+		var user goatcounter.User
+		err = user.BySite(ctx, s.ID)
+		if err != nil {
+			l.Field("site", s.ID).Error(err)
+			continue
+		}
+
+		var data interface{} = struct{ Site goatcounter.Site }{s}
+		if last != nil {
+			data = struct {
+				Site goatcounter.Site
+				Last time.Time
+			}{s, *last}
+		}
+		err = goatcounter.SendEmail(ctx, subject, "GoatCounter", user.Email,
+			goatcounter.EmailTemplate(tpl, data))
+		if err != nil {
+			l.Field("site", s.ID).Error(err)
+			continue
+		}
+
+		err = s.UpdateNoDataSent(ctx)
+		if err != nil {
+			l.Field("site", s.ID).Error(err)
 		}
 	}
+	return nil
+}
 
+// pathWatches sends notifications for watched paths; see PathWatch.
+func pathWatches(ctx context.Context) error {
+	ids, err := goatcounter.PathWatchSites(ctx)
+	if err != nil {
+		return errors.Errorf("cron.pathWatches: %w", err)
+	}
+
+	l := zlog.Module("cron-watch")
+	for _, id := range ids {
+		var s goatcounter.Site
+		err := s.ByID(ctx, id)
+		if err != nil {
+			if !zdb.ErrNoRows(err) { // Deleted site.
+				l.Field("site", id).Error(err)
+			}
+			continue
+		}
+
+		var watches goatcounter.PathWatches
+		err = watches.Check(goatcounter.WithSite(ctx, &s))
+		if err != nil {
+			l.Field("site", id).Error(err)
+		}
+	}
+	return nil
+}
+
+// oldJobs removes finished jobs older than a week, operations that can no
+// longer be undone, and old fingerprints of imported rows.
+func oldJobs(ctx context.Context) error {
+	var jobs goatcounter.Jobs
+	err := jobs.DeleteOlderThan(ctx, 7)
+	if err != nil {
+		return errors.Errorf("cron.oldJobs: %w", err)
+	}
+	var imports goatcounter.ImportJobs
+	err = imports.DeleteOlderThan(ctx, 7)
+	if err != nil {
+		return errors.Errorf("cron.oldJobs: %w", err)
+	}
+	err = imports.DeleteOldFingerprints(ctx)
+	if err != nil {
+		return errors.Errorf("cron.oldJobs: %w", err)
+	}
+	var reindexes goatcounter.ReindexJobs
+	err = reindexes.DeleteOlderThan(ctx, 7)
+	if err != nil {
+		return errors.Errorf("cron.oldJobs: %w", err)
+	}
+	var ops goatcounter.Operations
+	err = ops.DeleteOlderThan(ctx, goatcounter.OperationKeepDays)
+	if err != nil {
+		return errors.Errorf("cron.oldJobs: %w", err)
+	}
+	return nil
+}
+
+// lostJobs marks jobs as failed if the instance that ran them is gone, and
+// resumes the imports and reindexes they were running.
+func lostJobs(ctx context.Context) error {
+	var jobs goatcounter.Jobs
+	err := jobs.Interrupted(ctx)
+	if err != nil {
+		return errors.Errorf("cron.lostJobs: %w", err)
+	}
+	var imports goatcounter.ImportJobs
+	err = imports.Resume(ctx)
+	if err != nil {
+		return errors.Errorf("cron.lostJobs: %w", err)
+	}
+	var reindexes goatcounter.ReindexJobs
+	err = reindexes.Resume(ctx, ReindexRange)
+	if err != nil {
+		return errors.Errorf("cron.lostJobs: %w", err)
+	}
 	return nil
 }
 
@@ -64,11 +255,34 @@ func DataRetention(ctx context.Context) error {
 	}
 
 	for _, s := range sites {
-		if s.Settings.DataRetention <= 0 {
+		if s.Settings.DataRetention <= 0 && s.Settings.EventRetentionDays() <= 0 && len(s.Settings.PathRetention) == 0 {
+			continue
+		}
+
+		err = s.ApplyRetention(ctx)
+		if err != nil {
+			zlog.Module("cron").Field("site", s.ID).Error(err)
+		}
+	}
+
+	return nil
+}
+
+// AnonymizeHits removes the session, browser, and location from pageviews
+// older than the site's AnonymizeAfter setting.
+func AnonymizeHits(ctx context.Context) error {
+	var sites goatcounter.Sites
+	err := sites.UnscopedList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sites {
+		if s.Settings.AnonymizeAfter <= 0 {
 			continue
 		}
 
-		err = s.DeleteOlderThan(ctx, s.Settings.DataRetention)
+		err = s.AnonymizeOlderThan(ctx, s.Settings.AnonymizeAfter)
 		if err != nil {
 			zlog.Module("cron").Field("site", s.ID).Error(err)
 		}
@@ -106,6 +320,7 @@ func PersistAndStat(ctx context.Context) error {
 	if len(hits) > 0 {
 		l = l.Since("memstore")
 	}
+	addProcessed(ctx, len(hits))
 
 	grouped := make(map[int64][]goatcounter.Hit)
 	for _, h := range hits {
@@ -114,23 +329,93 @@ func PersistAndStat(ctx context.Context) error {
 		}
 		grouped[h.Site] = append(grouped[h.Site], h)
 	}
+	failed := updateStatsParallel(ctx, grouped)
+
+	if len(hits) > 0 {
+		l.Since("stats").FieldsSince().Debugf("persisted %d hits", len(hits))
+	}
+
+	scrollErr := updateScrollStats(ctx, goatcounter.Memstore.PersistScroll(ctx))
+	if scrollErr != nil {
+		l.Error(scrollErr)
+	}
+	sessionErr := updateSessionStats(ctx, goatcounter.Memstore.PersistSessions())
+	if sessionErr != nil {
+		l.Error(sessionErr)
+	}
+	honeypotErr := markHoneypot(ctx, goatcounter.Memstore.PersistHoneypot())
+	if honeypotErr != nil {
+		l.Error(honeypotErr)
+	}
+	LastMemstore.Set(goatcounter.Now())
+
+	// The errors are already logged in updateStatsParallel(); this is just so
+	// it shows as failed in Status().
+	if failed > 0 {
+		return errors.Errorf("cron.PersistAndStat: updating the statistics failed for %d of %d sites",
+			failed, len(grouped))
+	}
+	return nil
+}
+
+// updateStatsParallel updates the statistics for every site in grouped, with
+// up to cfg.StatWorkers sites in parallel, and returns the number of sites for
+// which it failed.
+//
+// Every site is updated in its own transaction. The statistics tables for a
+// site aren't updated in parallel, as that would need a transaction for every
+// table; a failure would then leave some tables updated, and retrying the
+// site would count those pageviews twice.
+func updateStatsParallel(ctx context.Context, grouped map[int64][]goatcounter.Hit) int {
+	n := cfg.StatWorkers
+	if n < 1 || !cfg.PgSQL {
+		n = 1
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed int
+		sem    = make(chan struct{}, n)
+	)
 	for siteID, hits := range grouped {
-		err := UpdateStats(ctx, nil, siteID, hits, false)
-		if err != nil {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(siteID int64, hits []goatcounter.Hit) {
+			defer zlog.Recover()
+			defer func() { <-sem; wg.Done() }()
+
+			err := UpdateStats(ctx, nil, siteID, hits, false)
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			failed++
+			mu.Unlock()
+			l := zlog.Module("cron")
 			l.Fields(zlog.F{
 				"site":  siteID,
 				"paths": hits,
 			}).Error(err)
-		}
-	}
 
-	if len(hits) > 0 {
-		l.Since("stats").FieldsSince().Debugf("persisted %d hits", len(hits))
+			// Add to the queue so the hits aren't lost from the stats if it's a
+			// transient error; retryStats will pick it up.
+			retry := goatcounter.StatRetry{Site: siteID, Hits: hits}
+			err = retry.Insert(ctx, err)
+			if err != nil {
+				l.Field("site", siteID).Error(err)
+			}
+		}(siteID, hits)
 	}
-	LastMemstore.Set(goatcounter.Now())
-	return err
+	wg.Wait()
+	return failed
 }
 
+// UpdateStats updates all the statistics for the hits.
+//
+// This runs in a transaction, so that nothing is changed if it fails and it
+// can be safely retried.
 func UpdateStats(ctx context.Context, site *goatcounter.Site, siteID int64, hits []goatcounter.Hit, isReindex bool) error {
 	if site == nil {
 		site = new(goatcounter.Site)
@@ -141,34 +426,56 @@ func UpdateStats(ctx context.Context, site *goatcounter.Site, siteID int64, hits
 	}
 	ctx = goatcounter.WithSite(ctx, site)
 
-	funs := []func(context.Context, []goatcounter.Hit, bool) error{
-		updateHitCounts,
-		updateRefCounts,
-		updateHitStats,
-		updateBrowserStats,
-		updateSystemStats,
-		updateLocationStats,
-		updateSizeStats,
-	}
-
-	for _, f := range funs {
-		err := f(ctx, hits, isReindex)
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		err := goatcounter.LockStats(ctx, siteID)
 		if err != nil {
-			return errors.Wrapf(err, "site %d", siteID)
+			return err
 		}
-	}
 
-	if !site.ReceivedData {
-		err := site.UpdateReceivedData(ctx)
-		if err != nil {
-			return errors.Wrapf(err, "update received_data: site %d", siteID)
+		funs := []func(context.Context, []goatcounter.Hit, bool) error{
+			updateHitCounts,
+			updateRefCounts,
+			updateHitStats,
+			updateBrowserStats,
+			updateSystemStats,
+			updateLocationStats,
+			updateSizeStats,
+			updateHostStats,
+			updateCampaignStats,
 		}
-	}
-	return nil
+
+		for _, f := range funs {
+			err := f(ctx, hits, isReindex)
+			if err != nil {
+				return errors.Wrapf(err, "site %d", siteID)
+			}
+		}
+
+		if !site.ReceivedData && len(hits) > 0 {
+			err := site.UpdateReceivedData(ctx, hits[0])
+			if err != nil {
+				return errors.Wrapf(err, "update received_data: site %d", siteID)
+			}
+		}
+
+		var first time.Time
+		for _, h := range hits {
+			if h.Bot == 0 && (first.IsZero() || h.CreatedAt.Before(first)) {
+				first = h.CreatedAt
+			}
+		}
+		if !first.IsZero() {
+			err := site.UpdateFirstHitAt(ctx, first)
+			if err != nil {
+				return errors.Wrapf(err, "update first_hit_at: site %d", siteID)
+			}
+		}
+		return nil
+	})
 }
 
 // ReindexStats re-indexes all the statistics for the given tables; this is
-// intended to be run by the "goatcounter reindex" command.
+// intended to be run from ReindexRange().
 func ReindexStats(ctx context.Context, site goatcounter.Site, hits []goatcounter.Hit, tables []string) error {
 	if site.State != goatcounter.StateActive {
 		return nil
@@ -199,6 +506,10 @@ func ReindexStats(ctx context.Context, site goatcounter.Site, hits []goatcounter
 			err = updateLocationStats(ctx, hits, true)
 		case "size_stats":
 			err = updateSizeStats(ctx, hits, true)
+		case "host_stats":
+			err = updateHostStats(ctx, hits, true)
+		case "campaign_stats":
+			err = updateCampaignStats(ctx, hits, true)
 		}
 		if err != nil {
 			return err
@@ -208,6 +519,43 @@ func ReindexStats(ctx context.Context, site goatcounter.Site, hits []goatcounter
 	return nil
 }
 
+// RestoreStats adds the statistics for pageviews restored with
+// Operation.Undo(). The statistics for browsers, systems, etc. were only
+// removed if all is set, so they're only added back in that case.
+func RestoreStats(ctx context.Context, site goatcounter.Site, hits []goatcounter.Hit, all bool) error {
+	var keep []goatcounter.Hit
+	for _, h := range hits {
+		if h.Bot == 0 {
+			keep = append(keep, h)
+		}
+	}
+	if len(keep) == 0 {
+		return nil
+	}
+
+	if all {
+		return UpdateStats(ctx, &site, site.ID, keep, false)
+	}
+
+	ctx = goatcounter.WithSite(ctx, &site)
+	for _, f := range []func(context.Context, []goatcounter.Hit, bool) error{
+		updateHitCounts,
+		updateRefCounts,
+		updateHitStats,
+	} {
+		err := f(ctx, keep, false)
+		if err != nil {
+			return errors.Wrapf(err, "RestoreStats: site %d", site.ID)
+		}
+	}
+	return nil
+}
+
+// renewACME renews the certificates of the custom domains that are due.
+//
+// The renewals are staggered over goatcounter.ACMERenewPeriod, and failed
+// renewals are retried with a backoff; the site's user is alerted if the
+// renewal keeps failing and the certificate is about to expire.
 func renewACME(ctx context.Context) error {
 	if !acme.Enabled() {
 		return nil
@@ -224,24 +572,54 @@ func renewACME(ctx context.Context) error {
 		return err
 	}
 
+	var renewals goatcounter.ACMERenewals
+	err = renewals.Sync(ctx, sites)
+	if err != nil {
+		return err
+	}
+	err = renewals.ListDue(ctx, 20)
+	if err != nil {
+		return err
+	}
+	if len(renewals) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]goatcounter.Site, len(sites))
 	for _, s := range sites {
-		func(ctx context.Context, s goatcounter.Site) {
-			bgrun.Run("renewACME:"+*s.Cname, func() {
-				err := acme.Make(*s.Cname)
-				if err != nil {
-					zlog.Module("cron-acme").Error(err)
-					return
-				}
+		byID[s.ID] = s
+	}
 
-				err = s.UpdateCnameSetupAt(ctx)
-				if err != nil {
-					zlog.Module("cron-acme").Error(err)
+	_, err = goatcounter.StartJob(ctx, goatcounter.JobACME, func(ctx context.Context) error {
+		l := zlog.Module("cron-acme")
+		for i := range renewals {
+			r := &renewals[i]
+			expires, err := acme.Make(r.Cname)
+			if err != nil {
+				l.Field("domain", r.Cname).Field("failures", r.Failures+1).Error(err)
+				err = r.Failed(ctx, err, expires)
+				if err == nil && r.NeedsAlert() {
+					err = r.SendAlert(ctx)
 				}
-			})
-		}(ctx, s)
-	}
+			} else {
+				err = r.Succeeded(ctx, expires)
+				if s, ok := byID[r.Site]; ok && err == nil {
+					err = s.UpdateCnameSetupAt(ctx)
+				}
+			}
+			if err != nil {
+				l.Field("domain", r.Cname).Error(err)
+			}
 
-	return nil
+			goatcounter.JobProgress(ctx, i+1, len(renewals))
+			err = goatcounter.JobPace(ctx, i+1)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
 }
 
 func vacuumDeleted(ctx context.Context) error {
@@ -251,17 +629,31 @@ func vacuumDeleted(ctx context.Context) error {
 		return errors.Errorf("vacuumDeleted: %w", err)
 	}
 
+	addProcessed(ctx, len(sites))
 	for _, s := range sites {
 		zlog.Module("vacuum").Printf("vacuum site %s/%d", s.Code, s.ID)
 
 		err := zdb.TX(ctx, func(ctx context.Context, db zdb.DB) error {
-			for _, t := range []string{"browser_stats", "system_stats", "hit_stats", "hits", "location_stats", "size_stats", "users"} {
+			// Check again in the transaction, in case it was restored with
+			// Sites.Restore() after it was selected.
+			query := `select count(*) from sites where id=$1 and state=$2 and updated_at < $3`
+			if cfg.PgSQL {
+				query = `select count(*) from (select 1 from sites where id=$1 and state=$2 and updated_at < $3 for update) s`
+			}
+			var n int
+			err := db.GetContext(ctx, &n, query, s.ID, goatcounter.StateDeleted,
+				goatcounter.Now().Add(-goatcounter.DeleteGrace).Format(zdb.Date))
+			if err != nil || n == 0 {
+				return err
+			}
+
+			for _, t := range []string{"browser_stats", "system_stats", "hit_stats", "hits", "location_stats", "size_stats", "host_stats", "campaign_stats", "scroll_stats", "sessions_stats", "audit_log", "notifications", "path_watches", "jobs", "import_fingerprints", "imports", "reindexes", "operation_hits", "operations", "stat_retries", "hit_counts_daily", "hit_counts_monthly", "ref_counts_daily", "ref_counts_monthly", "rollup_dirty", "users"} {
 				_, err := db.ExecContext(ctx, fmt.Sprintf(`delete from %s where site=%d`, t, s.ID))
 				if err != nil {
 					return errors.Errorf("%s: %w", t, err)
 				}
 			}
-			_, err := db.ExecContext(ctx, `delete from sites where id=$1`, s.ID)
+			_, err = db.ExecContext(ctx, `delete from sites where id=$1`, s.ID)
 			return err
 		})
 		if err != nil {
@@ -271,8 +663,149 @@ func vacuumDeleted(ctx context.Context) error {
 	return nil
 }
 
+// retryEmails retries sending emails that previously failed.
+func retryEmails(ctx context.Context) error {
+	var emails goatcounter.Emails
+	err := emails.ListPending(ctx)
+	if err != nil {
+		return errors.Errorf("cron.retryEmails: %w", err)
+	}
+
+	addProcessed(ctx, len(emails))
+	l := zlog.Module("email")
+	for _, e := range emails {
+		sent, err := e.Retry(ctx)
+		if err != nil {
+			l.Field("email", e.ID).Error(err)
+			continue
+		}
+		if sent && e.Error != nil {
+			l.Printf("email %d to %s failed again (attempt %d): %s", e.ID, e.To, e.Attempts, *e.Error)
+		}
+	}
+	return nil
+}
+
+// retryStats retries updating the statistics for pageviews that previously
+// failed.
+func retryStats(ctx context.Context) error {
+	var retries goatcounter.StatRetries
+	err := retries.ListPending(ctx)
+	if err != nil {
+		return errors.Errorf("cron.retryStats: %w", err)
+	}
+
+	addProcessed(ctx, len(retries))
+	l := zlog.Module("cron")
+	for _, r := range retries {
+		err := r.Retry(ctx, func(ctx context.Context, siteID int64, hits []goatcounter.Hit) error {
+			return UpdateStats(ctx, nil, siteID, hits, false)
+		})
+		if err != nil {
+			l.Field("stat_retry", r.ID).Error(err)
+			continue
+		}
+		if r.Failed() {
+			l.Errorf("updating the statistics for %d pageviews of site %d failed %d times; giving up: %s",
+				len(r.Hits), r.Site, r.Attempts, *r.Error)
+		} else if r.Error != nil {
+			l.Printf("updating the statistics for %d pageviews of site %d failed again (attempt %d): %s",
+				len(r.Hits), r.Site, r.Attempts, *r.Error)
+		}
+	}
+	return nil
+}
+
+// rollups updates the daily and monthly rollups of the hit_counts and
+// ref_counts for the days that changed; see goatcounter.UpdateRollups().
+func rollups(ctx context.Context) error {
+	// Limit the number of days per transaction, so that e.g. a reindex of a
+	// few years doesn't lock the tables for a long time.
+	for {
+		n, err := goatcounter.UpdateRollups(ctx, 500)
+		if err != nil {
+			return errors.Errorf("cron.rollups: %w", err)
+		}
+		addProcessed(ctx, n)
+		if n < 500 || stopped.Value() == 1 {
+			return nil
+		}
+	}
+}
+
 func sessions(ctx context.Context) error {
 	goatcounter.Memstore.EvictSessions()
-	goatcounter.Memstore.RefreshSalt()
+
+	// This task runs on every instance, but only one should rotate the salt;
+	// the others get the new salt from the broadcast, which also resets the
+	// time it was rotated.
+	_, err := goatcounter.WithLock(ctx, "salt", func() error {
+		if goatcounter.Memstore.RefreshSalt() {
+			// Other instances need the same salt to find the sessions.
+			cur, prev := goatcounter.Memstore.GetSalt()
+			goatcounter.Broadcast(ctx, goatcounter.BroadcastMessage{
+				Kind: goatcounter.BroadcastSalt, CurSalt: cur, PrevSalt: prev})
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("cron.sessions: %w", err)
+	}
+	return nil
+}
+
+var selfPingFailing = zsync.NewAtomicInt(0)
+
+// selfPing checks that the count endpoint is reachable with the -selfping flag.
+//
+// Errors are logged only when the check starts failing, so that an outage
+// doesn't send an error report every few minutes.
+func selfPing(ctx context.Context) error {
+	if cfg.SelfPing == "" {
+		return nil
+	}
+	// Don't do this on shutdown as the HTTP server won't be available.
+	if stopped.Value() == 1 {
+		return nil
+	}
+
+	l := zlog.Module("selfping")
+	took, err := goatcounter.SelfPing(ctx, cfg.SelfPing, cfg.SelfPingBudget)
+	if err != nil {
+		if selfPingFailing.Value() == 0 {
+			selfPingFailing.Set(1)
+			return err
+		}
+		l.Printf("still failing: %s", err)
+		return nil
+	}
+
+	if selfPingFailing.Value() == 1 {
+		selfPingFailing.Set(0)
+		l.Printf("recovered; took %s", took.Round(time.Millisecond))
+	} else {
+		l.Debugf("took %s", took.Round(time.Millisecond))
+	}
+	return nil
+}
+
+func updateGeoDB(ctx context.Context) error {
+	if cfg.GeoDBURL == "" {
+		return nil
+	}
+
+	// Don't do this on shutdown.
+	if stopped.Value() == 1 {
+		return nil
+	}
+
+	updated, err := goatcounter.Geo.Update(ctx, cfg.GeoDBURL)
+	if err != nil {
+		return errors.Errorf("updateGeoDB: %w", err)
+	}
+	if updated {
+		zlog.Module("geodb").Printf("updated GeoIP database; build date is %s",
+			goatcounter.Geo.BuildDate().Format("2006-01-02"))
+	}
 	return nil
 }
@@ -5,6 +5,8 @@
 package cron_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -52,7 +54,7 @@ func TestDataRetention(t *testing.T) {
 	}
 
 	var stats goatcounter.HitStats
-	display, displayUnique, more, err := stats.List(ctx, past.Add(-1*24*time.Hour), now, "", nil, false)
+	display, displayUnique, more, _, err := stats.List(ctx, past.Add(-1*24*time.Hour), now, "", "", nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -63,3 +65,400 @@ func TestDataRetention(t *testing.T) {
 		t.Errorf("\ngot:  %s\nwant: %s", out, want)
 	}
 }
+
+func TestPersistAndStat(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Now().UTC()
+	sites := make([]goatcounter.Site, 5)
+	for i := range sites {
+		sites[i] = goatcounter.Site{Code: fmt.Sprintf("site%d", i), Plan: goatcounter.PlanPersonal}
+		err := sites[i].Insert(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j <= i; j++ {
+			goatcounter.Memstore.Append(goatcounter.Hit{Site: sites[i].ID, Path: "/a",
+				Session: goatcounter.TestSession, CreatedAt: now})
+		}
+	}
+
+	err := cron.PersistAndStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, site := range sites {
+		var stats goatcounter.HitStats
+		display, _, _, _, err := stats.List(goatcounter.WithSite(ctx, &site),
+			now.Add(-24*time.Hour), now.Add(24*time.Hour), "", "", nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if display != i+1 {
+			t.Errorf("site %d: got %d pageviews; want %d", site.ID, display, i+1)
+		}
+	}
+}
+
+func TestStatRetry(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	defer gctest.SwapNow(t, now)()
+	site := goatcounter.MustGetSite(ctx)
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Site: site.ID, Path: "/a", Browser: "Firefox/80", CreatedAt: now},
+		goatcounter.Hit{Site: site.ID, Path: "/a", Browser: "Firefox/80", CreatedAt: now})
+
+	// The site isn't stored with the hits, so Retry() needs to set it;
+	// otherwise the existing stats aren't found and the counts are wrong.
+	r := goatcounter.StatRetry{Site: site.ID, Hits: goatcounter.StatRetryHits{
+		{Path: "/a", Browser: "Firefox/80", CreatedAt: now},
+	}}
+	err := r.Insert(ctx, errors.New("oh noes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer gctest.SwapNow(t, now.Add(time.Minute))()
+	var l goatcounter.StatRetries
+	err = l.ListPending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 1 {
+		t.Fatalf("len %d", len(l))
+	}
+	err = l[0].Retry(ctx, func(ctx context.Context, siteID int64, hits []goatcounter.Hit) error {
+		return cron.UpdateStats(ctx, nil, siteID, hits, false)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, tbl := range []string{"hit_stats", "browser_stats"} {
+		var c []int
+		err := zdb.MustGet(ctx).SelectContext(ctx, &c, `select site from `+tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%s %v", tbl, c))
+	}
+	var count int
+	err = zdb.MustGet(ctx).GetContext(ctx, &count, `select count from browser_stats`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, fmt.Sprintf("count %d", count))
+
+	want := `[hit_stats [1] browser_stats [1] count 3]`
+	if g := fmt.Sprintf("%v", got); g != want {
+		t.Errorf("\ngot:  %s\nwant: %s", g, want)
+	}
+}
+
+func TestDataRetentionEvents(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.Site{Code: "bbbb", Plan: goatcounter.PlanPersonal,
+		Settings: goatcounter.SiteSettings{DataRetention: 30, EventRetention: -1}}
+	err := site.Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = goatcounter.WithSite(ctx, &site)
+
+	now := time.Now().UTC()
+	past := now.Add(-40 * 24 * time.Hour)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Path: "/a", FirstVisit: zdb.Bool(true)},
+		{Site: site.ID, CreatedAt: past, Path: "/a", FirstVisit: zdb.Bool(true)},
+		{Site: site.ID, CreatedAt: now, Path: "click", Event: zdb.Bool(true), FirstVisit: zdb.Bool(true)},
+		{Site: site.ID, CreatedAt: past, Path: "click", Event: zdb.Bool(true), FirstVisit: zdb.Bool(true)},
+	}...)
+
+	err = cron.DataRetention(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hits goatcounter.Hits
+	_, err = hits.List(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]int{}
+	for _, h := range hits {
+		got[h.Path]++
+	}
+	if got["/a"] != 1 || got["click"] != 2 {
+		t.Errorf("wrong hits: %v", got)
+	}
+
+	var stats goatcounter.HitStats
+	_, _, _, _, err = stats.List(ctx, past.Add(-1*24*time.Hour), now, "", "", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("len(stats) is %d", len(stats))
+	}
+	for _, s := range stats {
+		want := 1
+		if s.Path == "click" {
+			want = 2
+		}
+		if s.Count != want {
+			t.Errorf("%s: count %d; want %d", s.Path, s.Count, want)
+		}
+	}
+}
+
+func TestDataRetentionPaths(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.Site{Code: "bbbb", Plan: goatcounter.PlanPersonal,
+		Settings: goatcounter.SiteSettings{DataRetention: 30,
+			PathRetention:      zdb.Strings{"/keep/=-1", "/keep/not/=60"},
+			RetentionKeepStats: true}}
+	err := site.Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = goatcounter.WithSite(ctx, &site)
+
+	now := time.Now().UTC()
+	past := now.Add(-40 * 24 * time.Hour)
+	older := now.Add(-70 * 24 * time.Hour)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Path: "/a", FirstVisit: true},
+		{Site: site.ID, CreatedAt: past, Path: "/a", FirstVisit: true},
+		{Site: site.ID, CreatedAt: older, Path: "/keep/x", FirstVisit: true},
+		{Site: site.ID, CreatedAt: past, Path: "/keep/not/x", FirstVisit: true},
+		{Site: site.ID, CreatedAt: older, Path: "/keep/not/x", FirstVisit: true},
+	}...)
+
+	err = cron.DataRetention(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hits goatcounter.Hits
+	_, err = hits.List(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]int{}
+	for _, h := range hits {
+		got[h.Path]++
+	}
+	want := map[string]int{"/a": 1, "/keep/x": 1, "/keep/not/x": 1}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("wrong hits\ngot:  %v\nwant: %v", got, want)
+	}
+
+	// The statistics are kept.
+	var total int
+	err = zdb.MustGet(ctx).GetContext(ctx, &total,
+		`select sum(total) from hit_counts where site=$1`, site.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 5 {
+		t.Errorf("total in hit_counts is %d; want 5", total)
+	}
+}
+
+func TestAnonymizeHits(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.Site{Code: "bbbb", Plan: goatcounter.PlanPersonal,
+		Settings: goatcounter.SiteSettings{AnonymizeAfter: 7}}
+	err := site.Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = goatcounter.WithSite(ctx, &site)
+
+	now := time.Now().UTC()
+	past := now.Add(-10 * 24 * time.Hour)
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Site: site.ID, CreatedAt: now, Path: "/a", FirstVisit: true, Session: goatcounter.TestSession,
+			Browser: "Firefox/80.0", Location: "NZ"},
+		{Site: site.ID, CreatedAt: past, Path: "/a", FirstVisit: true, Session: goatcounter.TestSession,
+			Browser: "Firefox/80.0", Location: "NZ"},
+	}...)
+
+	err = cron.AnonymizeHits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hits goatcounter.Hits
+	_, err = hits.List(ctx, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("len(hits) is %d", len(hits))
+	}
+	for _, h := range hits {
+		anon := h.CreatedAt.Before(now.Add(-7 * 24 * time.Hour))
+		if anon != (h.Browser == "" && h.Location == "" && h.Session.IsZero()) {
+			t.Errorf("%s: browser=%q location=%q; want anonymized: %t", h.CreatedAt, h.Browser, h.Location, anon)
+		}
+		if !h.FirstVisit {
+			t.Errorf("first_visit is false for %s", h.CreatedAt)
+		}
+	}
+}
+
+func BenchmarkUpdateStats(b *testing.B) {
+	ctx, clean := gctest.DB(b)
+	defer clean()
+
+	var (
+		site  = goatcounter.MustGetSite(ctx)
+		start = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+		hits  = make([]goatcounter.Hit, 1000)
+	)
+	for i := range hits {
+		hits[i] = goatcounter.Hit{
+			Site:       site.ID,
+			Path:       fmt.Sprintf("/path-%d", i%100),
+			Ref:        "example.com",
+			Browser:    "Mozilla/5.0 (X11; Linux x86_64; rv:81.0) Gecko/20100101 Firefox/81.0",
+			Location:   "NL",
+			Size:       zdb.Floats{1920, 1080, 1},
+			CreatedAt:  start.Add(time.Duration(i) * time.Minute),
+			Session:    goatcounter.TestSession,
+			FirstVisit: i%2 == 0,
+		}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		err := cron.UpdateStats(ctx, site, site.ID, hits, false)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestCompactStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	db := zdb.MustGet(ctx)
+	for _, q := range []string{
+		`insert into browser_stats (site, day, browser, version, count, count_unique) values (1, '2020-06-18', 'Firefox', '79', 2, 1)`,
+		`insert into browser_stats (site, day, browser, version, count, count_unique) values (1, '2020-06-18', 'Firefox', '79', 3, 2)`,
+		`insert into browser_stats (site, day, browser, version, count, count_unique) values (1, '2020-06-18', 'Firefox', '80', 1, 1)`,
+		`insert into hit_stats (site, day, path, title, stats, stats_unique) values (1, '2020-06-18', '/a', 'A', '[0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,0,0,0,0,0,0,0,0,0]', '[0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,0,0,0,0,0,0,0,0,0]')`,
+		`insert into hit_stats (site, day, path, title, stats, stats_unique) values (1, '2020-06-18', '/a', '', '[0,0,0,0,0,0,0,0,0,0,0,0,0,0,2,0,0,0,0,0,0,0,0,0]', '[0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0]')`,
+	} {
+		_, err := db.ExecContext(ctx, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := cron.CompactStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var browsers []struct {
+		Version     string `db:"version"`
+		Count       int    `db:"count"`
+		CountUnique int    `db:"count_unique"`
+	}
+	err = db.SelectContext(ctx, &browsers, `select version, count, count_unique from browser_stats order by version`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := fmt.Sprintf("%v", browsers)
+	want := `[{79 5 3} {80 1 1}]`
+	if out != want {
+		t.Errorf("browser_stats\ngot:  %s\nwant: %s", out, want)
+	}
+
+	var hits []struct {
+		Title       string `db:"title"`
+		Stats       string `db:"stats"`
+		StatsUnique string `db:"stats_unique"`
+	}
+	err = db.SelectContext(ctx, &hits, `select title, stats, stats_unique from hit_stats`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = fmt.Sprintf("%v", hits)
+	want = `[{A [0,0,0,0,0,0,0,0,0,0,0,0,0,0,3,0,0,0,0,0,0,0,0,0] [0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,0,0,0,0,0,0,0,0,0]}]`
+	if out != want {
+		t.Errorf("hit_stats\ngot:  %s\nwant: %s", out, want)
+	}
+
+	// Doesn't run again until the period has passed.
+	_, err = db.ExecContext(ctx, `insert into browser_stats (site, day, browser, version, count, count_unique) values (1, '2020-06-18', 'Firefox', '80', 1, 1)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cron.CompactStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	err = db.GetContext(ctx, &n, `select count(*) from browser_stats`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("%d rows in browser_stats", n)
+	}
+}
+
+func TestRunScheduled(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	var n int
+	fun := func(context.Context) error { n++; return nil }
+
+	// Runs once per period, no matter how many instances run it.
+	for _, tt := range []struct {
+		add  time.Duration
+		want int
+	}{
+		{0, 1},
+		{0, 1},
+		{30 * time.Minute, 1},
+		{25 * time.Minute, 1},
+		{5 * time.Minute, 2},
+		{59 * time.Second, 2},
+	} {
+		now = now.Add(tt.add)
+		err := cron.RunScheduled(ctx, fun, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != tt.want {
+			t.Fatalf("at %s: ran %d times; want %d", now, n, tt.want)
+		}
+	}
+}
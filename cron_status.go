@@ -0,0 +1,97 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+)
+
+// CronStatus is the result of the last run of a cron task.
+//
+// This is shared by all instances using the same database, so it's the status
+// of whichever instance ran the task last.
+type CronStatus struct {
+	Task    string    `db:"task" json:"task,readonly"`
+	LastRun time.Time `db:"last_run" json:"last_run,readonly"`
+
+	// How long the last run took, in milliseconds.
+	Duration int64 `db:"duration" json:"duration,readonly"`
+
+	// Number of rows processed in the last run; what this means depends on the
+	// task (e.g. pageviews for PersistAndStat). Tasks that don't report this
+	// are always 0.
+	Processed int `db:"processed" json:"processed,readonly"`
+
+	// Error of the last run, or null if it succeeded.
+	Error *string `db:"error" json:"error,readonly"`
+
+	// When the task started failing; null if the last run succeeded.
+	FailingSince *time.Time `db:"failing_since" json:"failing_since,readonly"`
+
+	// How often the task runs, in seconds.
+	Period int64 `db:"-" json:"period,readonly"`
+}
+
+// ByTask gets the status of the last run of the task.
+func (s *CronStatus) ByTask(ctx context.Context, task string) error {
+	return errors.Wrapf(zdb.MustGet(ctx).GetContext(ctx, s,
+		`/* CronStatus.ByTask */ select * from cron_status where task=$1`, task),
+		"CronStatus.ByTask %s", task)
+}
+
+// Update the status after the task ran; runErr is the error the task returned,
+// if any. LastRun is set to the current time, unless it's already set.
+func (s *CronStatus) Update(ctx context.Context, runErr error) error {
+	if s.LastRun.IsZero() {
+		s.LastRun = Now()
+	}
+	s.Error, s.FailingSince = nil, nil
+
+	// The failing_since is kept if the task was already failing.
+	failing, update := `null`, `null`
+	if runErr != nil {
+		e := runErr.Error()
+		s.Error = &e
+		failing, update = `$2`, `coalesce(cron_status.failing_since, excluded.failing_since)`
+	}
+
+	db := zdb.MustGet(ctx)
+	_, err := db.ExecContext(ctx, `/* CronStatus.Update */
+		insert into cron_status (task, last_run, duration, processed, error, failing_since)
+		values ($1, $2, $3, $4, $5, `+failing+`)
+		on conflict (task) do update set
+			last_run=excluded.last_run, duration=excluded.duration,
+			processed=excluded.processed, error=excluded.error,
+			failing_since=`+update,
+		s.Task, s.LastRun.Format(zdb.Date), s.Duration, s.Processed, s.Error)
+	if err != nil {
+		return errors.Wrap(err, "CronStatus.Update")
+	}
+
+	if runErr != nil {
+		err = db.GetContext(ctx, &s.FailingSince,
+			`select failing_since from cron_status where task=$1`, s.Task)
+	}
+	return errors.Wrap(err, "CronStatus.Update")
+}
+
+// Failing reports if the task has been failing for at least d.
+func (s CronStatus) Failing(d time.Duration) bool {
+	return s.FailingSince != nil && Now().Sub(*s.FailingSince) >= d
+}
+
+// CronStatuses is a list of cron task statuses.
+type CronStatuses []CronStatus
+
+// List the status of all tasks that ran at least once.
+func (s *CronStatuses) List(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, s,
+		`/* CronStatuses.List */ select * from cron_status order by task`)
+	return errors.Wrap(err, "CronStatuses.List")
+}
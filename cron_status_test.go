@@ -0,0 +1,65 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestCronStatus(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 10, 30, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	update := func(runErr error) goatcounter.CronStatus {
+		t.Helper()
+		s := goatcounter.CronStatus{Task: "PersistAndStat", Duration: 42, Processed: 3}
+		err := s.Update(ctx, runErr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var list goatcounter.CronStatuses
+		err = list.List(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list) != 1 {
+			t.Fatalf("len %d: %+v", len(list), list)
+		}
+		return list[0]
+	}
+
+	s := update(nil)
+	if s.Error != nil || s.FailingSince != nil || s.Duration != 42 || s.Processed != 3 || !s.LastRun.Equal(now) {
+		t.Errorf("%+v", s)
+	}
+
+	// failing_since is kept while it keeps failing.
+	failedAt := now.Add(time.Minute)
+	now = failedAt
+	update(errors.New("oh noes"))
+	now = now.Add(time.Hour)
+	s = update(errors.New("oh noes again"))
+	if s.Error == nil || *s.Error != "oh noes again" || s.FailingSince == nil || !s.FailingSince.Equal(failedAt) {
+		t.Errorf("%+v", s)
+	}
+	if !s.Failing(time.Hour) || s.Failing(2*time.Hour) {
+		t.Error("wrong Failing()")
+	}
+
+	s = update(nil)
+	if s.Error != nil || s.FailingSince != nil || s.Failing(0) {
+		t.Errorf("%+v", s)
+	}
+}
@@ -0,0 +1,12 @@
+begin;
+	alter table hits add column region varchar not null default '';
+	alter table hits add column city   varchar not null default '';
+
+	alter table location_stats add column region varchar not null default '';
+	alter table location_stats add column city   varchar not null default '';
+	drop index "location_stats#site#day#location";
+	create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
+	alter table location_stats replica identity using index "location_stats#site#day#location";
+
+	insert into version values('2020-09-10-1-geo-region-city');
+commit;
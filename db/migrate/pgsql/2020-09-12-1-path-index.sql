@@ -0,0 +1,5 @@
+begin;
+	create index "hit_counts#site#lower_path" on hit_counts(site, lower(path) varchar_pattern_ops);
+
+	insert into version values('2020-09-12-1-path-index');
+commit;
@@ -0,0 +1,22 @@
+begin;
+	create table jobs (
+		job_id          serial         primary key,
+		site            integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		progress        integer        not null default 0,
+		total           integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		started_at      timestamp,
+		finished_at     timestamp,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "jobs#site#created_at" on jobs(site, created_at);
+
+	insert into version values('2020-09-30-1-jobs');
+commit;
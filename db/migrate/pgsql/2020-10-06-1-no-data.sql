@@ -0,0 +1,5 @@
+begin;
+	alter table sites add column no_data_sent_at timestamp null;
+
+	insert into version values('2020-10-06-1-no-data');
+commit;
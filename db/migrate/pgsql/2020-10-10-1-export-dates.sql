@@ -0,0 +1,6 @@
+begin;
+	alter table exports add column start_date timestamp null;
+	alter table exports add column end_date timestamp null;
+
+	insert into version values('2020-10-10-1-export-dates');
+commit;
@@ -0,0 +1,6 @@
+begin;
+	alter table exports add column path_like varchar null;
+	alter table exports add column paths varchar null;
+
+	insert into version values('2020-10-12-1-export-paths');
+commit;
@@ -0,0 +1,17 @@
+begin;
+	create table path_watches (
+		watch_id        serial         primary key,
+		site            integer        not null,
+
+		path            varchar        not null,
+		threshold       integer        not null default 0,
+		seen_at         timestamp,
+		alerted_day     varchar,
+		created_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "path_watches#site#path" on path_watches(site, path);
+
+	insert into version values('2020-10-18-1-path-watches');
+commit;
@@ -0,0 +1,25 @@
+begin;
+	create table imports (
+		import_id       serial         primary key,
+		site            integer        not null,
+		user_id         integer        not null,
+
+		mode            varchar        not null,
+		email           integer        not null default 0,
+		file            varchar        not null,
+		hash            varchar        not null,
+		state           varchar        not null,
+		rows_done       integer        not null default 0,
+		last_line       integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict,
+		foreign key (user_id) references users(id) on delete restrict on update restrict
+	);
+	create index "imports#state" on imports(state);
+
+	insert into version values('2020-10-20-1-imports');
+commit;
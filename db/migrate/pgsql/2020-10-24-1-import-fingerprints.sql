@@ -0,0 +1,17 @@
+begin;
+	create table import_fingerprints (
+		site            integer        not null,
+		fingerprint     varchar        not null,
+		import_id       integer        not null,
+		line            integer        not null,
+		created_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+	create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
+
+	alter table imports add column skipped integer not null default 0;
+
+	insert into version values('2020-10-24-1-import-fingerprints');
+commit;
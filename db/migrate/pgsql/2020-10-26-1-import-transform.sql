@@ -0,0 +1,6 @@
+begin;
+	alter table imports add column transform varchar not null default '';
+	alter table imports add column dropped   integer not null default 0;
+
+	insert into version values('2020-10-26-1-import-transform');
+commit;
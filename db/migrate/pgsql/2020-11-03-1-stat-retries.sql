@@ -0,0 +1,15 @@
+begin;
+	create table stat_retries (
+		stat_retry_id   serial         primary key,
+		site            integer        not null,
+		hits            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null,
+		created_at      timestamp      not null
+	);
+	create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+	insert into version values('2020-11-03-1-stat-retries');
+commit;
@@ -0,0 +1,27 @@
+begin;
+	create table reindexes (
+		reindex_id      serial         primary key,
+		site            integer        not null,
+		job_id          integer,
+
+		tables          varchar        not null,
+		since_hit       integer,
+		first_day       timestamp      not null,
+		last_day        timestamp      not null,
+		state           varchar        not null,
+		total           integer        not null default 0,
+		done            integer        not null default 0,
+		start_done      integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		started_at      timestamp,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "reindexes#state" on reindexes(state);
+	create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+	insert into version values('2020-11-05-1-reindexes');
+commit;
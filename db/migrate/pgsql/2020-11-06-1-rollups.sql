@@ -0,0 +1,89 @@
+begin;
+	create table hit_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		day           date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_daily#site#path#day" unique(site, path, day)
+	);
+	create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+	create table hit_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		month         date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_monthly#site#path#month" unique(site, path, month)
+	);
+	create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+	create table ref_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		day           date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day)
+	);
+	create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+	create table ref_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		month         date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month)
+	);
+	create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+	-- Days for which the rollups are outdated; n is incremented on every change
+	-- so the cron job can see if the day changed again while it was updating it.
+	create table rollup_dirty (
+		site          int        not null check(site>0),
+		tbl           varchar    not null,
+		day           date       not null,
+		n             int        not null default 1,
+
+		constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day)
+	);
+
+	create function rollup_dirty() returns trigger as $$
+	begin
+		if tg_op = 'DELETE' then
+			insert into rollup_dirty (site, tbl, day) values (old.site, tg_table_name, old.hour::date)
+				on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+			return old;
+		end if;
+		insert into rollup_dirty (site, tbl, day) values (new.site, tg_table_name, new.hour::date)
+			on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+		return new;
+	end;
+	$$ language plpgsql;
+	create trigger "hit_counts#rollup_dirty" after insert or update or delete on hit_counts
+		for each row execute procedure rollup_dirty();
+	create trigger "ref_counts#rollup_dirty" after insert or update or delete on ref_counts
+		for each row execute procedure rollup_dirty();
+
+	-- Create the rollups for all existing data on the next cron run.
+	insert into rollup_dirty (site, tbl, day) select distinct site, 'hit_counts', hour::date from hit_counts
+		on conflict do nothing;
+	insert into rollup_dirty (site, tbl, day) select distinct site, 'ref_counts', hour::date from ref_counts
+		on conflict do nothing;
+
+	insert into version values('2020-11-06-1-rollups');
+commit;
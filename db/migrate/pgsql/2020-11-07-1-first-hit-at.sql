@@ -0,0 +1,6 @@
+begin;
+	alter table sites add column first_hit_at timestamp null;
+	update sites set first_hit_at=(select min(hour) from hit_counts where hit_counts.site=sites.id);
+
+	insert into version values('2020-11-07-1-first-hit-at');
+commit;
@@ -0,0 +1,18 @@
+begin;
+	create table acme_renewals (
+		cname           varchar        not null,
+		site            integer        not null,
+
+		failures        integer        not null default 0,
+		last_error      varchar,
+		last_error_at   timestamp      null,
+		expires_at      timestamp      null,
+		alerted_at      timestamp      null,
+		next_attempt_at timestamp      not null
+	);
+	create unique index "acme_renewals#cname" on acme_renewals(cname);
+	create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+	alter table acme_renewals replica identity using index "acme_renewals#cname";
+
+	insert into version values('2020-11-09-1-acme-renewals');
+commit;
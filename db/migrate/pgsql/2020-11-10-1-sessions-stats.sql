@@ -0,0 +1,17 @@
+begin;
+	create table sessions_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null,
+		pageviews      int            not null,
+		duration       int            not null,
+		entry_path     varchar        not null,
+		exit_path      varchar        not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "sessions_stats#site#day" on sessions_stats(site, day);
+	alter table sessions_stats replica identity full;
+
+	insert into version values('2020-11-10-1-sessions-stats');
+commit;
@@ -0,0 +1,5 @@
+begin;
+	create index "hits#site#host#created_at" on hits(site, host, created_at);
+
+	insert into version values('2020-11-11-1-hits-host');
+commit;
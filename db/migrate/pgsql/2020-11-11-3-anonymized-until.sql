@@ -0,0 +1,7 @@
+begin;
+	alter table sites add column anonymized_until timestamp null;
+	update hits           set session2=null where length(session2) = 0;
+	update operation_hits set session2=null where length(session2) = 0;
+
+	insert into version values('2020-11-11-3-anonymized-until');
+commit;
@@ -0,0 +1,7 @@
+begin;
+	alter table jobs add column instance     varchar;
+	alter table jobs add column heartbeat_at timestamp;
+	alter table jobs add column cancel_at    timestamp;
+
+	insert into version values('2020-11-11-4-jobs-heartbeat');
+commit;
@@ -0,0 +1,13 @@
+begin;
+	-- The device class was set from the width only, but phones are classified
+	-- on the shortest side; recreate size_stats from the pageviews.
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'size_stats', min(day)::timestamp, current_date::timestamp, 'running',
+			current_date - min(day) + 1, now(), now()
+		from size_stats
+		where width >= 600 and site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-11-6-device-class-reindex');
+commit;
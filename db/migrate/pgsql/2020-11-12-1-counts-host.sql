@@ -0,0 +1,41 @@
+begin;
+	-- Store hit_counts and ref_counts per host, so the dashboard can be
+	-- filtered on the host.
+	alter table hit_counts add column host varchar not null default '';
+	alter table hit_counts replica identity default;
+	alter table hit_counts drop constraint "hit_counts#site#path#hour";
+	alter table hit_counts add constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour);
+	alter table hit_counts replica identity using index "hit_counts#site#path#host#hour";
+
+	alter table ref_counts add column host varchar not null default '';
+	alter table ref_counts replica identity default;
+	alter table ref_counts drop constraint "ref_counts#site#path#ref#hour";
+	alter table ref_counts add constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour);
+	alter table ref_counts replica identity using index "ref_counts#site#path#ref#host#hour";
+
+	-- The existing rows are stored without a host; recreate them from the
+	-- pageviews that have a host. There can only be one running reindex per
+	-- site, so add the tables to reindexes that haven't started yet.
+	update reindexes set
+		tables = case
+			when tables = 'all' or tables like '%hit_counts%' and tables like '%ref_counts%' then tables
+			when tables like '%hit_counts%' then tables || ',ref_counts'
+			when tables like '%ref_counts%' then tables || ',hit_counts'
+			else tables || ',hit_counts,ref_counts'
+		end,
+		first_day = least(first_day, (
+			select min(created_at)::date::timestamp from hits where hits.site=reindexes.site and host <> ''))
+		where state='running' and done=0 and job_id is null;
+	update reindexes set total = last_day::date - first_day::date + 1
+		where state='running' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'hit_counts,ref_counts', min(created_at)::date::timestamp, current_date::timestamp, 'running',
+			current_date - min(created_at)::date + 1, now(), now()
+		from hits
+		where host <> '' and site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-12-1-counts-host');
+commit;
@@ -0,0 +1,17 @@
+begin;
+	alter table hits add column host varchar not null default '';
+
+	create table host_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		host           varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+
+	insert into version values('2020-09-14-1-host');
+commit;
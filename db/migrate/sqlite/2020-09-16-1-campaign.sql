@@ -0,0 +1,21 @@
+begin;
+	alter table hits add column utm_source   varchar not null default '';
+	alter table hits add column utm_medium   varchar not null default '';
+	alter table hits add column utm_campaign varchar not null default '';
+
+	create table campaign_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		source         varchar        not null,
+		medium         varchar        not null,
+		campaign       varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
+
+	insert into version values('2020-09-16-1-campaign');
+commit;
@@ -0,0 +1,16 @@
+begin;
+	create table audit_log (
+		audit_log_id   integer        primary key autoincrement,
+		site           integer        not null,
+		user_id        integer,
+
+		action         varchar        not null,
+		info           varchar        not null default '',
+		created_at     timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "audit_log#site#created_at" on audit_log(site, created_at);
+
+	insert into version values('2020-09-18-1-audit-log');
+commit;
@@ -0,0 +1,15 @@
+begin;
+	alter table size_stats add column device_class varchar not null default '';
+	update size_stats set device_class = case
+		when width = 0     then ''
+		when width < 600   then 'phone'
+		when width <= 1024 then 'tablet'
+		when width <= 1920 then 'desktop'
+		else 'large'
+	end;
+
+	drop index "size_stats#site#day#width";
+	create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+
+	insert into version values('2020-09-20-1-device-class');
+commit;
@@ -0,0 +1,21 @@
+begin;
+	create table email_queue (
+		email_id        integer        primary key autoincrement,
+		site            integer,
+
+		subject         varchar        not null,
+		from_name       varchar        not null,
+		to_addr         varchar        not null,
+		body            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+		sent_at         timestamp                  check(sent_at is null or sent_at = strftime('%Y-%m-%d %H:%M:%S', sent_at)),
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+	);
+	create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+	create index "email_queue#created_at" on email_queue(created_at);
+
+	insert into version values('2020-09-22-1-email-queue');
+commit;
@@ -0,0 +1,7 @@
+begin;
+	alter table hits add column ua_brands           varchar not null default '';
+	alter table hits add column ua_platform         varchar not null default '';
+	alter table hits add column ua_platform_version varchar not null default '';
+
+	insert into version values('2020-09-24-1-client-hints');
+commit;
@@ -0,0 +1,19 @@
+begin;
+	create table scroll_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		path           varchar        not null,
+		count          int            not null,
+		total          int            not null,
+		reached_25     int            not null,
+		reached_50     int            not null,
+		reached_75     int            not null,
+		reached_100    int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
+
+	insert into version values('2020-09-26-1-scroll-stats');
+commit;
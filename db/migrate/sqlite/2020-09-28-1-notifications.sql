@@ -0,0 +1,17 @@
+begin;
+	create table notifications (
+		notification_id integer        primary key autoincrement,
+		site            integer        not null,
+
+		kind            varchar        not null,
+		message         varchar        not null,
+		url             varchar        not null default '',
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		read_at         timestamp,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "notifications#site#created_at" on notifications(site, created_at);
+
+	insert into version values('2020-09-28-1-notifications');
+commit;
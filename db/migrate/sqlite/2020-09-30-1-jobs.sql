@@ -0,0 +1,22 @@
+begin;
+	create table jobs (
+		job_id          integer        primary key autoincrement,
+		site            integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		progress        integer        not null default 0,
+		total           integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+		finished_at     timestamp                  check(finished_at = strftime('%Y-%m-%d %H:%M:%S', finished_at)),
+		updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "jobs#site#created_at" on jobs(site, created_at);
+
+	insert into version values('2020-09-30-1-jobs');
+commit;
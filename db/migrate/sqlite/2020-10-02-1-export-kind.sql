@@ -0,0 +1,6 @@
+begin;
+	alter table exports add column kind   varchar not null default 'hits';
+	alter table exports add column format varchar not null default 'csv';
+
+	insert into version values('2020-10-02-1-export-kind');
+commit;
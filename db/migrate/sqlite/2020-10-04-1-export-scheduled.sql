@@ -0,0 +1,5 @@
+begin;
+	alter table exports add column scheduled int not null default 0;
+
+	insert into version values('2020-10-04-1-export-scheduled');
+commit;
@@ -0,0 +1,5 @@
+begin;
+	alter table sites add column first_hit varchar null;
+
+	insert into version values('2020-10-08-1-first-hit');
+commit;
@@ -0,0 +1,5 @@
+begin;
+	alter table exports add column max_hit_id integer null;
+
+	insert into version values('2020-10-14-1-export-progress');
+commit;
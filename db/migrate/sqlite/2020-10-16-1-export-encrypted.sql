@@ -0,0 +1,5 @@
+begin;
+	alter table exports add column encrypted int not null default 0;
+
+	insert into version values('2020-10-16-1-export-encrypted');
+commit;
@@ -0,0 +1,17 @@
+begin;
+	create table path_watches (
+		watch_id        integer        primary key autoincrement,
+		site            integer        not null,
+
+		path            varchar        not null,
+		threshold       integer        not null default 0,
+		seen_at         timestamp      check(seen_at = strftime('%Y-%m-%d %H:%M:%S', seen_at)),
+		alerted_day     varchar,
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "path_watches#site#path" on path_watches(site, path);
+
+	insert into version values('2020-10-18-1-path-watches');
+commit;
@@ -0,0 +1,11 @@
+begin;
+	alter table imports add column job_id     integer;
+	alter table imports add column total      integer not null default 0;
+	alter table imports add column rows_read  integer not null default 0;
+	alter table imports add column errors     integer not null default 0;
+	alter table imports add column start_line integer not null default 0;
+	alter table imports add column started_at timestamp check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at));
+	create index "imports#site#created_at" on imports(site, created_at);
+
+	insert into version values('2020-10-22-1-import-progress');
+commit;
@@ -0,0 +1,51 @@
+begin;
+	create table operations (
+		operation_id    integer        primary key autoincrement,
+		site            integer        not null,
+		user_id         integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		path            varchar        not null default '',
+		match_title     integer        not null default 0,
+		hits            integer        not null default 0,
+		all_stats       integer        not null default 0,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		undone_at       timestamp                  check(undone_at = strftime('%Y-%m-%d %H:%M:%S', undone_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "operations#site#created_at" on operations(site, created_at);
+
+	create table operation_hits (
+		operation_id        integer        not null,
+		id                  integer        not null,
+		site                integer        not null,
+		session             integer        default null,
+		session2            blob           default null,
+		path                varchar        not null,
+		title               varchar        not null default '',
+		event               int            default 0,
+		bot                 int            default 0,
+		ref                 varchar        not null,
+		ref_scheme          varchar        null,
+		browser             varchar        not null,
+		size                varchar        not null default '',
+		location            varchar        not null default '',
+		region              varchar        not null default '',
+		city                varchar        not null default '',
+		host                varchar        not null default '',
+		utm_source          varchar        not null default '',
+		utm_medium          varchar        not null default '',
+		utm_campaign        varchar        not null default '',
+		ua_brands           varchar        not null default '',
+		ua_platform         varchar        not null default '',
+		ua_platform_version varchar        not null default '',
+		first_visit         int            default 0,
+		created_at          timestamp      not null
+	);
+	create index "operation_hits#operation_id" on operation_hits(operation_id);
+
+	insert into version values('2020-10-28-1-operations');
+commit;
@@ -0,0 +1,13 @@
+begin;
+	create table cron_status (
+		task            varchar        not null,
+		last_run        timestamp      not null    check(last_run = strftime('%Y-%m-%d %H:%M:%S', last_run)),
+		duration        integer        not null default 0,
+		processed       integer        not null default 0,
+		error           varchar,
+		failing_since   timestamp                  check(failing_since = strftime('%Y-%m-%d %H:%M:%S', failing_since))
+	);
+	create unique index "cron_status#task" on cron_status(task);
+
+	insert into version values('2020-10-30-1-cron-status');
+commit;
@@ -0,0 +1,10 @@
+begin;
+	create table locks (
+		name            varchar        not null,
+		instance        varchar        not null,
+		expires_at      timestamp      not null    check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at))
+	);
+	create unique index "locks#name" on locks(name);
+
+	insert into version values('2020-11-01-1-locks');
+commit;
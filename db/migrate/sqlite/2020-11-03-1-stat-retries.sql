@@ -0,0 +1,15 @@
+begin;
+	create table stat_retries (
+		stat_retry_id   integer        primary key autoincrement,
+		site            integer        not null,
+		hits            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+	);
+	create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+	insert into version values('2020-11-03-1-stat-retries');
+commit;
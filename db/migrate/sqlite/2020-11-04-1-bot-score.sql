@@ -0,0 +1,9 @@
+begin;
+	alter table hits add column bot_score   integer not null default 0;
+	alter table hits add column bot_reasons integer not null default 0;
+
+	alter table operation_hits add column bot_score   integer not null default 0;
+	alter table operation_hits add column bot_reasons integer not null default 0;
+
+	insert into version values('2020-11-04-1-bot-score');
+commit;
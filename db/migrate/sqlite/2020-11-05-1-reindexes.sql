@@ -0,0 +1,27 @@
+begin;
+	create table reindexes (
+		reindex_id      integer        primary key autoincrement,
+		site            integer        not null,
+		job_id          integer,
+
+		tables          varchar        not null,
+		since_hit       integer,
+		first_day       timestamp      not null    check(first_day = strftime('%Y-%m-%d %H:%M:%S', first_day)),
+		last_day        timestamp      not null    check(last_day = strftime('%Y-%m-%d %H:%M:%S', last_day)),
+		state           varchar        not null,
+		total           integer        not null default 0,
+		done            integer        not null default 0,
+		start_done      integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+		updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "reindexes#state" on reindexes(state);
+	create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+	insert into version values('2020-11-05-1-reindexes');
+commit;
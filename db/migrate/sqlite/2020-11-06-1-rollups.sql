@@ -0,0 +1,95 @@
+begin;
+	create table hit_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_daily#site#path#day" unique(site, path, day) on conflict replace
+	);
+	create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+	create table hit_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		month         date       not null check(month = strftime('%Y-%m-01', month)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_monthly#site#path#month" unique(site, path, month) on conflict replace
+	);
+	create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+	create table ref_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day) on conflict replace
+	);
+	create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+	create table ref_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		month         date       not null check(month = strftime('%Y-%m-01', month)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month) on conflict replace
+	);
+	create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+	-- Days for which the rollups are outdated; n is incremented on every change
+	-- so the cron job can see if the day changed again while it was updating it.
+	create table rollup_dirty (
+		site          int        not null check(site>0),
+		tbl           varchar    not null,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		n             int        not null default 1,
+
+		constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day) on conflict replace
+	);
+
+	create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+	end;
+
+	-- Create the rollups for all existing data on the next cron run.
+	insert or ignore into rollup_dirty (site, tbl, day) select distinct site, 'hit_counts', date(hour) from hit_counts;
+	insert or ignore into rollup_dirty (site, tbl, day) select distinct site, 'ref_counts', date(hour) from ref_counts;
+
+	insert into version values('2020-11-06-1-rollups');
+commit;
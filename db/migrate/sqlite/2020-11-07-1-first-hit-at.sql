@@ -0,0 +1,7 @@
+begin;
+	alter table sites add column first_hit_at timestamp null
+		check(first_hit_at = strftime('%Y-%m-%d %H:%M:%S', first_hit_at));
+	update sites set first_hit_at=(select min(hour) from hit_counts where hit_counts.site=sites.id);
+
+	insert into version values('2020-11-07-1-first-hit-at');
+commit;
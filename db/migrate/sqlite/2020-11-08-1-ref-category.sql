@@ -0,0 +1,25 @@
+begin;
+	alter table ref_counts         add column ref_category varchar null;
+	alter table ref_counts_daily   add column ref_category varchar null;
+	alter table ref_counts_monthly add column ref_category varchar null;
+
+	-- The search, social, and email categories depend on the referrer; these
+	-- are set by a reindex of ref_counts.
+	update ref_counts set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_daily set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_monthly set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+
+	insert into version values('2020-11-08-1-ref-category');
+commit;
@@ -0,0 +1,17 @@
+begin;
+	create table acme_renewals (
+		cname           varchar        not null,
+		site            integer        not null,
+
+		failures        integer        not null default 0,
+		last_error      varchar,
+		last_error_at   timestamp      null        check(last_error_at = strftime('%Y-%m-%d %H:%M:%S', last_error_at)),
+		expires_at      timestamp      null        check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at)),
+		alerted_at      timestamp      null        check(alerted_at = strftime('%Y-%m-%d %H:%M:%S', alerted_at)),
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at))
+	);
+	create unique index "acme_renewals#cname" on acme_renewals(cname);
+	create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+
+	insert into version values('2020-11-09-1-acme-renewals');
+commit;
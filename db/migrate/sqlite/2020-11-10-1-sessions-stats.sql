@@ -0,0 +1,16 @@
+begin;
+	create table sessions_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		pageviews      int            not null,
+		duration       int            not null,
+		entry_path     varchar        not null,
+		exit_path      varchar        not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "sessions_stats#site#day" on sessions_stats(site, day);
+
+	insert into version values('2020-11-10-1-sessions-stats');
+commit;
@@ -0,0 +1,8 @@
+begin;
+	-- The sampling factor when the pageview was recorded; the statistics are
+	-- scaled by this.
+	alter table hits           add column sample integer not null default 1;
+	alter table operation_hits add column sample integer not null default 1;
+
+	insert into version values('2020-11-11-2-hits-sample');
+commit;
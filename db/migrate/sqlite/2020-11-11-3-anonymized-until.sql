@@ -0,0 +1,8 @@
+begin;
+	alter table sites add column anonymized_until timestamp null
+		check(anonymized_until = strftime('%Y-%m-%d %H:%M:%S', anonymized_until));
+	update hits           set session2=null where length(session2) = 0;
+	update operation_hits set session2=null where length(session2) = 0;
+
+	insert into version values('2020-11-11-3-anonymized-until');
+commit;
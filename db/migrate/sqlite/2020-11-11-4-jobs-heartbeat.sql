@@ -0,0 +1,9 @@
+begin;
+	alter table jobs add column instance varchar;
+	alter table jobs add column heartbeat_at timestamp
+		check(heartbeat_at = strftime('%Y-%m-%d %H:%M:%S', heartbeat_at));
+	alter table jobs add column cancel_at timestamp
+		check(cancel_at = strftime('%Y-%m-%d %H:%M:%S', cancel_at));
+
+	insert into version values('2020-11-11-4-jobs-heartbeat');
+commit;
@@ -0,0 +1,6 @@
+begin;
+	alter table import_fingerprints add column path varchar not null default '';
+	create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
+
+	insert into version values('2020-11-11-5-import-fingerprints-path');
+commit;
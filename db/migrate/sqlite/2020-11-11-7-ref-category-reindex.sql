@@ -0,0 +1,26 @@
+begin;
+	-- The previous migration set the ref_category of all referrers that aren't
+	-- direct, campaigns, or generated to "other"; recreate ref_counts from the
+	-- pageviews to classify them.
+	--
+	-- There can only be one running reindex per site, so add ref_counts to
+	-- the size_stats reindexes that haven't started yet.
+	update reindexes set
+		tables    = 'size_stats,ref_counts',
+		first_day = min(first_day, coalesce((
+			select substr(min(hour), 1, 10) || ' 00:00:00' from ref_counts where ref_counts.site=reindexes.site),
+			first_day))
+		where state='running' and tables='size_stats' and done=0 and job_id is null;
+	update reindexes set total = cast(julianday(date(last_day)) - julianday(date(first_day)) as int) + 1
+		where state='running' and tables='size_stats,ref_counts' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'ref_counts', substr(min(hour), 1, 10) || ' 00:00:00', date('now') || ' 00:00:00', 'running',
+			cast(julianday(date('now')) - julianday(date(min(hour))) as int) + 1, datetime(), datetime()
+		from ref_counts
+		where site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-11-7-ref-category-reindex');
+commit;
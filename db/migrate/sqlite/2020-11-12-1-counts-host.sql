@@ -0,0 +1,94 @@
+begin;
+	-- Store hit_counts and ref_counts per host, so the dashboard can be
+	-- filtered on the host. SQLite can't change the unique constraint, so
+	-- recreate the tables.
+	create table hit_counts2 (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		host          varchar    not null default '',
+		title         varchar    not null,
+		event         integer    not null default 0,
+		hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour) on conflict replace
+	);
+	insert into hit_counts2 (site, path, title, event, hour, total, total_unique)
+		select site, path, title, event, hour, total, total_unique from hit_counts;
+	drop table hit_counts;
+	alter table hit_counts2 rename to hit_counts;
+	create index "hit_counts#site#hour" on hit_counts(site, hour);
+	create index "hit_counts#site#lower_path" on hit_counts(site, lower(path));
+
+	create table ref_counts2 (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		host          varchar    not null default '',
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		ref_category  varchar    null,
+		hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour) on conflict replace
+	);
+	insert into ref_counts2 (site, path, ref, ref_scheme, ref_category, hour, total, total_unique)
+		select site, path, ref, ref_scheme, ref_category, hour, total, total_unique from ref_counts;
+	drop table ref_counts;
+	alter table ref_counts2 rename to ref_counts;
+	create index "ref_counts#site#hour" on ref_counts(site, hour);
+
+	create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+	end;
+
+	-- The existing rows are stored without a host; recreate them from the
+	-- pageviews that have a host. There can only be one running reindex per
+	-- site, so add the tables to reindexes that haven't started yet.
+	update reindexes set
+		tables = case
+			when tables = 'all' or tables like '%hit_counts%' and tables like '%ref_counts%' then tables
+			when tables like '%hit_counts%' then tables || ',ref_counts'
+			when tables like '%ref_counts%' then tables || ',hit_counts'
+			else tables || ',hit_counts,ref_counts'
+		end,
+		first_day = min(first_day, coalesce((
+			select substr(min(created_at), 1, 10) || ' 00:00:00' from hits where hits.site=reindexes.site and host <> ''),
+			first_day))
+		where state='running' and done=0 and job_id is null;
+	update reindexes set total = cast(julianday(date(last_day)) - julianday(date(first_day)) as int) + 1
+		where state='running' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'hit_counts,ref_counts', substr(min(created_at), 1, 10) || ' 00:00:00', date('now') || ' 00:00:00', 'running',
+			cast(julianday(date('now')) - julianday(date(min(created_at))) as int) + 1, datetime(), datetime()
+		from hits
+		where host <> '' and site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-12-1-counts-host');
+commit;
@@ -11,6 +11,10 @@ create table sites (
 	billing_amount varchar,
 	settings       varchar        not null,
 	received_data  int            not null default 0,
+	no_data_sent_at timestamp     null check(no_data_sent_at = strftime('%Y-%m-%d %H:%M:%S', no_data_sent_at)),
+	first_hit      varchar        null,
+	first_hit_at   timestamp      null                     check(first_hit_at = strftime('%Y-%m-%d %H:%M:%S', first_hit_at)),
+	anonymized_until timestamp    null                     check(anonymized_until = strftime('%Y-%m-%d %H:%M:%S', anonymized_until)),
 
 	state          varchar        not null default 'a'     check(state in ('a', 'd')),
 	created_at     timestamp      not null                 check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
@@ -73,12 +77,25 @@ create table hits (
 	browser        varchar        not null,
 	size           varchar        not null default '',
 	location       varchar        not null default '',
+	region         varchar        not null default '',
+	city           varchar        not null default '',
+	host           varchar        not null default '',
+	utm_source     varchar        not null default '',
+	utm_medium     varchar        not null default '',
+	utm_campaign   varchar        not null default '',
+	ua_brands      varchar        not null default '',
+	ua_platform    varchar        not null default '',
+	ua_platform_version varchar   not null default '',
+	bot_score      integer        not null default 0,
+	bot_reasons    integer        not null default 0,
 	first_visit    int            default 0,
+	sample         integer        not null default 1,
 
 	created_at     timestamp      not null                 check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
 );
 create index "hits#site#bot#created_at"      on hits(site, bot, created_at);
 create index "hits#site#path"                on hits(site, lower(path));
+create index "hits#site#host#created_at"     on hits(site, host, created_at);
 
 create table hit_stats (
 	site           integer        not null                 check(site > 0),
@@ -96,26 +113,30 @@ create index "hit_stats#site#day" on hit_stats(site, day);
 create table hit_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	title         varchar    not null,
 	event         integer    not null default 0,
 	hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "hit_counts#site#path#hour" unique(site, path, hour) on conflict replace
+	constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour) on conflict replace
 );
 create index "hit_counts#site#hour" on hit_counts(site, hour);
+create index "hit_counts#site#lower_path" on hit_counts(site, lower(path));
 
 create table ref_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	ref           varchar    not null,
 	ref_scheme    varchar    null,
+	ref_category  varchar    null,
 	hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "ref_counts#site#path#ref#hour" unique(site, path, ref, hour) on conflict replace
+	constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour) on conflict replace
 );
 create index "ref_counts#site#hour" on ref_counts(site, hour);
 
@@ -151,24 +172,53 @@ create table location_stats (
 
 	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
 	location       varchar        not null,
+	region         varchar        not null default '',
+	city           varchar        not null default '',
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "location_stats#site#day#location" on location_stats(site, day, location);
+create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
 
 create table size_stats (
 	site           integer        not null                 check(site > 0),
 
 	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
 	width          int            not null,
+	device_class   varchar        not null default '',
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "size_stats#site#day#width" on size_stats(site, day, width);
+create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+
+create table host_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	host           varchar        not null,
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+
+create table campaign_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	source         varchar        not null,
+	medium         varchar        not null,
+	campaign       varchar        not null,
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
 
 create table iso_3166_1 (
 	name   varchar,
@@ -492,11 +542,384 @@ create table exports (
 	size              varchar,
 	hash              varchar,
 	error             varchar,
+	kind              varchar        not null default 'hits',
+	format            varchar        not null default 'csv',
+	scheduled         int            not null default 0,
+	start_date        timestamp      null,
+	end_date          timestamp      null,
+	path_like         varchar        null,
+	paths             varchar        null,
+	max_hit_id        integer        null,
+	encrypted         int            not null default 0,
 
 	foreign key (site_id) references sites(id) on delete restrict on update restrict
 );
 create index "exports#site_id#created_at" on exports(site_id, created_at);
 
+create table audit_log (
+	audit_log_id   integer        primary key autoincrement,
+	site           integer        not null,
+	user_id        integer,
+
+	action         varchar        not null,
+	info           varchar        not null default '',
+	created_at     timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "audit_log#site#created_at" on audit_log(site, created_at);
+
+create table email_queue (
+	email_id        integer        primary key autoincrement,
+	site            integer,
+
+	subject         varchar        not null,
+	from_name       varchar        not null,
+	to_addr         varchar        not null,
+	body            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+	sent_at         timestamp                  check(sent_at is null or sent_at = strftime('%Y-%m-%d %H:%M:%S', sent_at)),
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+);
+create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+create index "email_queue#created_at" on email_queue(created_at);
+
+create table scroll_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	path           varchar        not null,
+	count          int            not null,
+	total          int            not null,
+	reached_25     int            not null,
+	reached_50     int            not null,
+	reached_75     int            not null,
+	reached_100    int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
+
+create table notifications (
+	notification_id integer        primary key autoincrement,
+	site            integer        not null,
+
+	kind            varchar        not null,
+	message         varchar        not null,
+	url             varchar        not null default '',
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	read_at         timestamp,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "notifications#site#created_at" on notifications(site, created_at);
+
+create table jobs (
+	job_id          integer        primary key autoincrement,
+	site            integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	progress        integer        not null default 0,
+	total           integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	finished_at     timestamp                  check(finished_at = strftime('%Y-%m-%d %H:%M:%S', finished_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+	instance        varchar,
+	heartbeat_at    timestamp                  check(heartbeat_at = strftime('%Y-%m-%d %H:%M:%S', heartbeat_at)),
+	cancel_at       timestamp                  check(cancel_at = strftime('%Y-%m-%d %H:%M:%S', cancel_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "jobs#site#created_at" on jobs(site, created_at);
+
+create table path_watches (
+	watch_id        integer        primary key autoincrement,
+	site            integer        not null,
+
+	path            varchar        not null,
+	threshold       integer        not null default 0,
+	seen_at         timestamp      check(seen_at = strftime('%Y-%m-%d %H:%M:%S', seen_at)),
+	alerted_day     varchar,
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "path_watches#site#path" on path_watches(site, path);
+
+create table imports (
+	import_id       integer        primary key autoincrement,
+	site            integer        not null,
+	user_id         integer        not null,
+
+	mode            varchar        not null,
+	email           integer        not null default 0,
+	transform       varchar        not null default '',
+	file            varchar        not null,
+	hash            varchar        not null,
+	state           varchar        not null,
+	job_id          integer,
+	total           integer        not null default 0,
+	rows_read       integer        not null default 0,
+	errors          integer        not null default 0,
+	skipped         integer        not null default 0,
+	dropped         integer        not null default 0,
+	rows_done       integer        not null default 0,
+	last_line       integer        not null default 0,
+	start_line      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict,
+	foreign key (user_id) references users(id) on delete restrict on update restrict
+);
+create index "imports#state" on imports(state);
+create index "imports#site#created_at" on imports(site, created_at);
+
+create table import_fingerprints (
+	site            integer        not null,
+	fingerprint     varchar        not null,
+	import_id       integer        not null,
+	line            integer        not null,
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	path            varchar        not null default '',
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
+create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
+
+create table operations (
+	operation_id    integer        primary key autoincrement,
+	site            integer        not null,
+	user_id         integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	path            varchar        not null default '',
+	match_title     integer        not null default 0,
+	hits            integer        not null default 0,
+	all_stats       integer        not null default 0,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	undone_at       timestamp                  check(undone_at = strftime('%Y-%m-%d %H:%M:%S', undone_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "operations#site#created_at" on operations(site, created_at);
+
+create table operation_hits (
+	operation_id        integer        not null,
+	id                  integer        not null,
+	site                integer        not null,
+	session             integer        default null,
+	session2            blob           default null,
+	path                varchar        not null,
+	title               varchar        not null default '',
+	event               int            default 0,
+	bot                 int            default 0,
+	ref                 varchar        not null,
+	ref_scheme          varchar        null,
+	browser             varchar        not null,
+	size                varchar        not null default '',
+	location            varchar        not null default '',
+	region              varchar        not null default '',
+	city                varchar        not null default '',
+	host                varchar        not null default '',
+	utm_source          varchar        not null default '',
+	utm_medium          varchar        not null default '',
+	utm_campaign        varchar        not null default '',
+	ua_brands           varchar        not null default '',
+	ua_platform         varchar        not null default '',
+	ua_platform_version varchar        not null default '',
+	bot_score           integer        not null default 0,
+	bot_reasons         integer        not null default 0,
+	first_visit         int            default 0,
+	sample              integer        not null default 1,
+	created_at          timestamp      not null
+);
+create index "operation_hits#operation_id" on operation_hits(operation_id);
+
+create table cron_status (
+	task            varchar        not null,
+	last_run        timestamp      not null    check(last_run = strftime('%Y-%m-%d %H:%M:%S', last_run)),
+	duration        integer        not null default 0,
+	processed       integer        not null default 0,
+	error           varchar,
+	failing_since   timestamp                  check(failing_since = strftime('%Y-%m-%d %H:%M:%S', failing_since))
+);
+create unique index "cron_status#task" on cron_status(task);
+
+create table locks (
+	name            varchar        not null,
+	instance        varchar        not null,
+	expires_at      timestamp      not null    check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at))
+);
+create unique index "locks#name" on locks(name);
+
+create table stat_retries (
+	stat_retry_id   integer        primary key autoincrement,
+	site            integer        not null,
+	hits            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+);
+create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+create table reindexes (
+	reindex_id      integer        primary key autoincrement,
+	site            integer        not null,
+	job_id          integer,
+
+	tables          varchar        not null,
+	since_hit       integer,
+	first_day       timestamp      not null    check(first_day = strftime('%Y-%m-%d %H:%M:%S', first_day)),
+	last_day        timestamp      not null    check(last_day = strftime('%Y-%m-%d %H:%M:%S', last_day)),
+	state           varchar        not null,
+	total           integer        not null default 0,
+	done            integer        not null default 0,
+	start_done      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "reindexes#state" on reindexes(state);
+create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+create table hit_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_daily#site#path#day" unique(site, path, day) on conflict replace
+);
+create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+create table hit_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	month         date       not null check(month = strftime('%Y-%m-01', month)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_monthly#site#path#month" unique(site, path, month) on conflict replace
+);
+create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+create table ref_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day) on conflict replace
+);
+create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+create table ref_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	month         date       not null check(month = strftime('%Y-%m-01', month)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month) on conflict replace
+);
+create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+-- Days for which the rollups are outdated; n is incremented on every change
+-- so the cron job can see if the day changed again while it was updating it.
+create table rollup_dirty (
+	site          int        not null check(site>0),
+	tbl           varchar    not null,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	n             int        not null default 1,
+
+	constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day) on conflict replace
+);
+
+create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+		coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+		coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+end;
+
+create table acme_renewals (
+	cname           varchar        not null,
+	site            integer        not null,
+
+	failures        integer        not null default 0,
+	last_error      varchar,
+	last_error_at   timestamp      null        check(last_error_at = strftime('%Y-%m-%d %H:%M:%S', last_error_at)),
+	expires_at      timestamp      null        check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at)),
+	alerted_at      timestamp      null        check(alerted_at = strftime('%Y-%m-%d %H:%M:%S', alerted_at)),
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at))
+);
+create unique index "acme_renewals#cname" on acme_renewals(cname);
+create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+
+create table sessions_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	pageviews      int            not null,
+	duration       int            not null,
+	entry_path     varchar        not null,
+	exit_path      varchar        not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "sessions_stats#site#day" on sessions_stats(site, day);
+
 create table store (
 	key     varchar not null,
 	value   text
@@ -528,4 +951,47 @@ insert into version values
 	('2020-07-21-1-memsess'),
 	('2020-07-22-1-memsess'),
 	('2020-08-01-1-repl'),
-	('2020-08-24-1-iso_unique');
+	('2020-08-24-1-iso_unique'),
+	('2020-09-10-1-geo-region-city'),
+	('2020-09-12-1-path-index'),
+	('2020-09-14-1-host'),
+	('2020-09-16-1-campaign'),
+	('2020-09-18-1-audit-log'),
+	('2020-09-20-1-device-class'),
+	('2020-09-22-1-email-queue'),
+	('2020-09-24-1-client-hints'),
+	('2020-09-26-1-scroll-stats'),
+	('2020-09-28-1-notifications'),
+	('2020-09-30-1-jobs'),
+	('2020-10-02-1-export-kind'),
+	('2020-10-04-1-export-scheduled'),
+	('2020-10-06-1-no-data'),
+	('2020-10-08-1-first-hit'),
+	('2020-10-10-1-export-dates'),
+	('2020-10-12-1-export-paths'),
+	('2020-10-14-1-export-progress'),
+	('2020-10-16-1-export-encrypted'),
+	('2020-10-18-1-path-watches'),
+	('2020-10-20-1-imports'),
+	('2020-10-22-1-import-progress'),
+	('2020-10-24-1-import-fingerprints'),
+	('2020-10-26-1-import-transform'),
+	('2020-10-28-1-operations'),
+	('2020-10-30-1-cron-status'),
+	('2020-11-01-1-locks'),
+	('2020-11-03-1-stat-retries'),
+	('2020-11-04-1-bot-score'),
+	('2020-11-05-1-reindexes'),
+	('2020-11-06-1-rollups'),
+	('2020-11-07-1-first-hit-at'),
+	('2020-11-08-1-ref-category'),
+	('2020-11-09-1-acme-renewals'),
+	('2020-11-10-1-sessions-stats'),
+	('2020-11-11-1-hits-host'),
+	('2020-11-11-2-hits-sample'),
+	('2020-11-11-3-anonymized-until'),
+	('2020-11-11-4-jobs-heartbeat'),
+	('2020-11-11-5-import-fingerprints-path'),
+	('2020-11-11-6-device-class-reindex'),
+	('2020-11-11-7-ref-category-reindex'),
+	('2020-11-12-1-counts-host');
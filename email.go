@@ -0,0 +1,159 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"time"
+
+	"zgo.at/blackmail"
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// EmailMaxAttempts is the maximum number of times we try to send an email.
+const EmailMaxAttempts = 8
+
+// Email is an email in the outgoing queue.
+//
+// Emails are only added to the queue if sending them failed; they're retried
+// with an exponential backoff by the cron task until it succeeds or
+// EmailMaxAttempts is reached.
+type Email struct {
+	ID   int64  `db:"email_id" json:"id"`
+	Site *int64 `db:"site" json:"site"`
+
+	Subject  string `db:"subject" json:"subject"`
+	FromName string `db:"from_name" json:"from_name"`
+	To       string `db:"to_addr" json:"to"`
+	Body     string `db:"body" json:"body"`
+
+	Attempts      int        `db:"attempts" json:"attempts"`
+	Error         *string    `db:"error" json:"error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at" json:"next_attempt_at"`
+	SentAt        *time.Time `db:"sent_at" json:"sent_at"`
+	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
+}
+
+// SendEmail sends a plain-text email from cfg.EmailFrom.
+//
+// If sending fails then the email is added to the queue to be retried later,
+// and the error is returned.
+func SendEmail(ctx context.Context, subject, fromName, to string, body func() ([]byte, error)) error {
+	b, err := body()
+	if err != nil {
+		return errors.Errorf("SendEmail: %w", err)
+	}
+
+	e := Email{Subject: subject, FromName: fromName, To: to, Body: string(b)}
+	sendErr := e.send()
+	if sendErr == nil {
+		return nil
+	}
+
+	if s := GetSite(ctx); s != nil {
+		e.Site = &s.ID
+	}
+	err = e.Insert(ctx, sendErr)
+	if err != nil {
+		zlog.Error(err)
+	}
+	return errors.Errorf("SendEmail: %w", sendErr)
+}
+
+func (e Email) send() error {
+	return blackmail.Send(e.Subject,
+		blackmail.From(e.FromName, cfg.EmailFrom),
+		blackmail.To(e.To),
+		blackmail.BodyText([]byte(e.Body)))
+}
+
+// backoff gets the time to wait before the next attempt: 1 minute after the
+// first attempt, doubled for every attempt after that.
+func (e Email) backoff() time.Duration {
+	return time.Minute << uint(e.Attempts-1)
+}
+
+// Insert a new email in the queue after the first attempt to send it failed.
+func (e *Email) Insert(ctx context.Context, sendErr error) error {
+	errStr := sendErr.Error()
+	e.Attempts = 1
+	e.Error = &errStr
+	e.CreatedAt = Now()
+	e.NextAttemptAt = e.CreatedAt.Add(e.backoff())
+
+	var err error
+	e.ID, err = insertWithID(ctx, "email_id", `insert into email_queue
+		(site, subject, from_name, to_addr, body, attempts, error, next_attempt_at, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		e.Site, e.Subject, e.FromName, e.To, e.Body, e.Attempts, e.Error,
+		e.NextAttemptAt.Format(zdb.Date), e.CreatedAt.Format(zdb.Date))
+	return errors.Wrap(err, "Email.Insert")
+}
+
+// Retry sending the email, and record the result.
+//
+// The attempt is claimed first, so that the email is sent only once if the cron
+// task runs on several instances. This returns false if another instance
+// already claimed it.
+func (e *Email) Retry(ctx context.Context) (bool, error) {
+	db := zdb.MustGet(ctx)
+
+	next := Email{Attempts: e.Attempts + 1}
+	nextAt := Now().Add(next.backoff())
+	res, err := db.ExecContext(ctx, `/* Email.Retry */
+		update email_queue set attempts=$1, next_attempt_at=$2
+		where email_id=$3 and attempts=$4 and sent_at is null`,
+		next.Attempts, nextAt.Format(zdb.Date), e.ID, e.Attempts)
+	if err != nil {
+		return false, errors.Wrap(err, "Email.Retry")
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, errors.Wrap(err, "Email.Retry")
+	}
+	e.Attempts, e.NextAttemptAt = next.Attempts, nextAt
+
+	sendErr := e.send()
+	if sendErr == nil {
+		now := Now()
+		e.SentAt, e.Error = &now, nil
+		_, err := db.ExecContext(ctx,
+			`update email_queue set error=null, sent_at=$1 where email_id=$2`,
+			now.Format(zdb.Date), e.ID)
+		return true, errors.Wrap(err, "Email.Retry")
+	}
+
+	errStr := sendErr.Error()
+	e.Error = &errStr
+	_, err = db.ExecContext(ctx,
+		`update email_queue set error=$1 where email_id=$2`, e.Error, e.ID)
+	return true, errors.Wrap(err, "Email.Retry")
+}
+
+// Failed reports if we gave up on sending this email.
+func (e Email) Failed() bool { return e.SentAt == nil && e.Attempts >= EmailMaxAttempts }
+
+// Emails is a list of emails in the queue.
+type Emails []Email
+
+// ListPending lists all emails that are due for another attempt.
+func (e *Emails) ListPending(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, e, `/* Emails.ListPending */
+		select * from email_queue
+		where sent_at is null and attempts < $1 and next_attempt_at <= $2
+		order by next_attempt_at`,
+		EmailMaxAttempts, Now().Format(zdb.Date))
+	return errors.Wrap(err, "Emails.ListPending")
+}
+
+// ListRecent lists the most recent emails in the queue, for all sites.
+func (e *Emails) ListRecent(ctx context.Context, limit int) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, e, `/* Emails.ListRecent */
+		select * from email_queue order by created_at desc, email_id desc limit $1`,
+		limit)
+	return errors.Wrap(err, "Emails.ListRecent")
+}
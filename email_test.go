@@ -0,0 +1,81 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"errors"
+	"testing"
+
+	"zgo.at/blackmail"
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestEmailQueue(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	blackmail.DefaultMailer = blackmail.NewMailer(blackmail.ConnectWriter)
+	defer gctest.SwapNow(t, "2020-06-18 12:00:00")()
+
+	e := goatcounter.Email{Subject: "Subject", FromName: "Test", To: "test@example.com", Body: "Body"}
+	err := e.Insert(ctx, errors.New("connection refused"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pending goatcounter.Emails
+	err = pending.ListPending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("pending before the next attempt: %d", len(pending))
+	}
+
+	gctest.SwapNow(t, "2020-06-18 12:01:00")
+	err = pending.ListPending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Attempts != 1 || *pending[0].Error != "connection refused" {
+		t.Fatalf("wrong pending: %#v", pending)
+	}
+
+	// Only one instance sends it.
+	other := pending[0]
+	sent, err := pending[0].Retry(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sent {
+		t.Fatal("not sent")
+	}
+	sent, err = other.Retry(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent {
+		t.Fatal("sent twice")
+	}
+
+	var recent goatcounter.Emails
+	err = recent.ListRecent(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 1 || recent[0].SentAt == nil || recent[0].Error != nil || recent[0].Attempts != 2 {
+		t.Errorf("wrong recent: %#v", recent)
+	}
+
+	pending = nil
+	err = pending.ListPending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("still pending after sending: %d", len(pending))
+	}
+}
@@ -7,18 +7,23 @@ package goatcounter
 import (
 	"compress/gzip"
 	"context"
+	"database/sql/driver"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"math"
 	"os"
-	"reflect"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
-	"zgo.at/blackmail"
 	"zgo.at/errors"
+	"zgo.at/gadget"
+	"zgo.at/goatcounter/cache"
 	"zgo.at/goatcounter/cfg"
 	"zgo.at/zdb"
 	"zgo.at/zlog"
@@ -28,24 +33,108 @@ import (
 	"zgo.at/zvalidate"
 )
 
-const ExportVersion = "1"
+// ExportVersion is the current version of the CSV export format.
+//
+// Version 2 added the hit ID as the last column, so that rows can be reliably
+// matched with the exported data after an import or when joining with other
+// data.
+//
+// Version 3 has the same columns, but they're read by the name in the header
+// rather than the position; unknown columns are ignored, so columns can be
+// added or reordered without breaking imports.
+const ExportVersion = "3"
+
+// ExportHeaderVersion gets the version of the export format from the CSV
+// header, and checks that it's a version that can be imported.
+func ExportHeaderVersion(header []string) (string, error) {
+	if len(header) == 0 || header[0] == "" {
+		return "", errors.New("empty CSV header")
+	}
+
+	v := header[0][:1]
+	if v != "1" && v != "2" && v != "3" {
+		return "", errors.Errorf("wrong version of CSV database: %s (expected: %s)", v, ExportVersion)
+	}
+	return v, nil
+}
+
+// ExportRetention is how long exports are kept after they're finished; the
+// files and rows in the exports table are removed by a cron job after this,
+// except for the last ExportKeep exports of every site.
+var (
+	ExportRetention = 24 * time.Hour
+	ExportKeep      = 0
+)
+
+// Export kinds and formats.
+const (
+	ExportHits  = "hits"  // All pageviews.
+	ExportStats = "stats" // Aggregated statistics.
+
+	ExportCSV  = "csv"
+	ExportJSON = "json"
+)
+
+var (
+	ExportKinds   = []string{ExportHits, ExportStats}
+	ExportFormats = []string{ExportCSV, ExportJSON}
+)
 
 type Export struct {
 	ID     int64 `db:"export_id" json:"id,readonly"`
 	SiteID int64 `db:"site_id" json:"site_id,readonly"`
 
+	// What to export: "hits" for all pageviews, or "stats" for the aggregated
+	// statistics.
+	Kind string `db:"kind" json:"kind"`
+
+	// File format: "csv" or "json". Exports of pageviews are always CSV.
+	Format string `db:"format" json:"format"`
+
 	// The hit ID this export was started from.
 	StartFromHitID int64 `db:"start_from_hit_id" json:"start_from_hit_id"`
 
+	// Only export pageviews on or after this time; may be null.
+	StartDate *time.Time `db:"start_date" json:"start_date"`
+
+	// Only export pageviews before this time; may be null.
+	EndDate *time.Time `db:"end_date" json:"end_date"`
+
+	// Only export pageviews with a path that matches this LIKE pattern
+	// (case-insensitive), or that is in paths; for example "/blog/%". May be
+	// null.
+	PathLike *string `db:"path_like" json:"path_like"`
+
+	// Only export pageviews with one of these paths, or that match path_like.
+	Paths ExportPaths `db:"paths" json:"paths"`
+
+	// Started automatically because of the export_schedule setting.
+	Scheduled zdb.Bool `db:"scheduled" json:"scheduled,readonly"`
+
+	// The file is encrypted with a passphrase.
+	Encrypted zdb.Bool `db:"encrypted" json:"encrypted,readonly"`
+
+	// Encrypt the file with this passphrase; this is never stored, and the
+	// export can't be resumed after a restart.
+	Passphrase Passphrase `db:"-" json:"-"`
+
 	// Last hit ID that was exported; can be used as start_from_hit_id.
 	LastHitID *int64 `db:"last_hit_id" json:"last_hit_id,readonly"`
 
+	// Highest hit ID when the export was started; the export is finished
+	// when it gets here.
+	MaxHitID *int64 `db:"max_hit_id" json:"max_hit_id,readonly"`
+
 	Path      string    `db:"path" json:"path,readonly"` // {omitdoc}
 	CreatedAt time.Time `db:"created_at" json:"created_at,readonly"`
 
 	FinishedAt *time.Time `db:"finished_at" json:"finished_at,readonly"`
 	NumRows    *int       `db:"num_rows" json:"num_rows,readonly"`
 
+	// Progress as a percentage, based on the hit IDs; this is updated after
+	// every batch of exported pageviews.
+	Progress float64 `db:"-" json:"progress,readonly"`
+
 	// File size in MB.
 	Size *string `db:"size" json:"size,readonly"`
 
@@ -54,328 +143,1126 @@ type Export struct {
 
 	// Any errors that may have occured.
 	Error *string `db:"error" json:"error,readonly"`
+
+	// The export file was removed because it's older than the retention
+	// period.
+	Expired bool `db:"-" json:"expired,readonly"`
+}
+
+// Passphrase is a passphrase which is never shown in logs or errors.
+type Passphrase string
+
+func (Passphrase) String() string   { return "[redacted]" }
+func (Passphrase) GoString() string { return `"[redacted]"` }
+
+// ExportPaths is a list of paths to export, stored as JSON.
+type ExportPaths []string
+
+// Value implements the SQL Value function to determine what to store in the DB.
+func (p ExportPaths) Value() (driver.Value, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(p)
+}
+
+// Scan converts the data returned from the DB into the struct.
+func (p *ExportPaths) Scan(v interface{}) error {
+	switch vv := v.(type) {
+	case nil:
+		*p = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(vv, p)
+	case string:
+		return json.Unmarshal([]byte(vv), p)
+	default:
+		panic(fmt.Sprintf("unsupported type: %T", v))
+	}
 }
 
 func (e *Export) ByID(ctx context.Context, id int64) error {
-	return errors.Wrapf(zdb.MustGet(ctx).GetContext(ctx, e,
+	err := zdb.MustGet(ctx).GetContext(ctx, e,
 		`/* Export.ByID */ select * from exports where export_id=$1 and site_id=$2`,
-		id, MustGetSite(ctx).ID), "Export.ByID %d", id)
+		id, MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrapf(err, "Export.ByID %d", id)
+	}
+
+	// Need the number of newer exports to know if it's one of the last
+	// ExportKeep exports.
+	var newer int
+	if ExportKeep > 0 {
+		err := zdb.MustGet(ctx).GetContext(ctx, &newer,
+			`/* Export.ByID */ select count(*) from exports where site_id=$1 and created_at > $2`,
+			e.SiteID, e.CreatedAt.UTC().Format(zdb.Date))
+		if err != nil {
+			return errors.Wrapf(err, "Export.ByID %d", id)
+		}
+	}
+
+	// The last scheduled export is always kept.
+	var newerScheduled int
+	if e.Scheduled {
+		err := zdb.MustGet(ctx).GetContext(ctx, &newerScheduled,
+			`/* Export.ByID */ select count(*) from exports where site_id=$1 and scheduled=1 and created_at > $2`,
+			e.SiteID, e.CreatedAt.UTC().Format(zdb.Date))
+		if err != nil {
+			return errors.Wrapf(err, "Export.ByID %d", id)
+		}
+	}
+	e.setExpired(newer, e.Scheduled && newerScheduled == 0)
+	e.setProgress()
+	return nil
 }
 
-// Create a new export.
+// ContentType gets the MIME type of the export file.
+func (e Export) ContentType() string {
+	if e.Encrypted {
+		return "application/octet-stream"
+	}
+	if e.Kind == ExportStats && e.Format == ExportCSV {
+		return "application/zip"
+	}
+	return "application/gzip"
+}
+
+// DateRange describes the StartDate and EndDate as dates in the timezone, with
+// the end date inclusive; for example "2020-03-01 – 2020-03-31". This is an
+// empty string if neither is set.
+func (e Export) DateRange(loc *time.Location) string {
+	if e.StartDate == nil && e.EndDate == nil {
+		return ""
+	}
+
+	start, end := "…", "…"
+	if e.StartDate != nil {
+		start = e.StartDate.In(loc).Format("2006-01-02")
+	}
+	if e.EndDate != nil {
+		end = e.EndDate.Add(-time.Second).In(loc).Format("2006-01-02")
+	}
+	return start + " – " + end
+}
+
+// PathFilter describes the PathLike and Paths; for example "/blog/%, /about".
+// This is an empty string if neither is set.
+func (e Export) PathFilter() string {
+	p := make([]string, 0, len(e.Paths)+1)
+	if e.PathLike != nil && *e.PathLike != "" {
+		p = append(p, *e.PathLike)
+	}
+	return strings.Join(append(p, e.Paths...), ", ")
+}
+
+// hitRange gets the HitRange for the hits to export.
+func (e Export) hitRange() HitRange {
+	r := HitRange{Start: e.StartDate, End: e.EndDate, Paths: e.Paths}
+	if e.PathLike != nil {
+		r.PathLike = *e.PathLike
+	}
+	return r
+}
+
+// setExpired sets Expired from ExportRetention and ExportKeep; newer is the
+// number of exports for the site that were created after this one, and
+// lastScheduled is set if this is the site's last scheduled export, which is
+// never removed. The file is written until the export is finished, so the
+// retention is counted from then.
+func (e *Export) setExpired(newer int, lastScheduled bool) {
+	t := e.CreatedAt
+	if e.FinishedAt != nil {
+		t = *e.FinishedAt
+	}
+	e.Expired = !lastScheduled && newer >= ExportKeep && t.Before(Now().Add(-ExportRetention))
+}
+
+// RetentionDays gets the number of days after which exports are removed; see
+// ExportRetention.
+func (Export) RetentionDays() int { return int(ExportRetention / (24 * time.Hour)) }
+
+// setProgress sets Progress from the hit IDs.
+func (e *Export) setProgress() {
+	switch {
+	case e.FinishedAt != nil:
+		e.Progress = 100
+	case e.LastHitID == nil || e.MaxHitID == nil || *e.MaxHitID <= e.StartFromHitID:
+		e.Progress = 0
+	default:
+		p := float64(*e.LastHitID-e.StartFromHitID) / float64(*e.MaxHitID-e.StartFromHitID) * 100
+		if p > 100 {
+			p = 100
+		}
+		e.Progress = math.Round(p*10) / 10
+	}
+}
+
+// ExportDir gets the directory to write export files to; this is the
+// -export-dir flag, or the system's temporary directory if it's not set.
+//
+// Finished exports are moved to the ExportStorage.
+func ExportDir() string {
+	if cfg.ExportDir != "" {
+		return cfg.ExportDir
+	}
+	return os.TempDir()
+}
+
+// localPath gets the path of the file the export is written to; this is only
+// valid until the export is finished.
+func (e Export) localPath() string { return localExportPath(e.Path) }
+
+// localExportPath gets the path of the export file key in ExportDir().
+//
+// Exports created before -export-dir was added stored the full path; these are
+// returned unchanged.
+func localExportPath(key string) string {
+	if filepath.IsAbs(key) {
+		return key
+	}
+	return filepath.Join(ExportDir(), key)
+}
+
+// Open the export file for reading from the ExportStorage.
+//
+// The error is os.ErrNotExist if the file doesn't exist (yet).
+func (e Export) Open(ctx context.Context) (io.ReadCloser, error) {
+	st, err := storage()
+	if err != nil {
+		return nil, errors.Errorf("Export.Open: %w", err)
+	}
+	fp, err := st.Open(ctx, e.Path)
+	if err != nil {
+		return nil, errors.Errorf("Export.Open: %w", err)
+	}
+	return fp, nil
+}
+
+// Create a new export of all pageviews, or only the pageviews between
+// StartDate and EndDate and matching PathLike or Paths if they're set.
 //
 // Inserts a row in exports table and returns open file pointer to the
 // destination file.
+//
+// The filename includes the export ID, and the file is created with O_EXCL, so
+// exports will never overwrite each other.
 func (e *Export) Create(ctx context.Context, startFrom int64) (*os.File, error) {
-	site := MustGetSite(ctx)
+	if e.StartDate != nil && e.EndDate != nil && !e.EndDate.After(*e.StartDate) {
+		v := zvalidate.New()
+		v.Append("end_date", "must be after start_date")
+		return nil, v
+	}
+	if e.PathLike != nil && *e.PathLike == "" {
+		e.PathLike = nil
+	}
+	if len(e.Paths) > 0 {
+		paths := make(ExportPaths, 0, len(e.Paths))
+		for _, p := range e.Paths {
+			if p = strings.TrimSpace(p); p != "" {
+				paths = append(paths, p)
+			}
+		}
+		e.Paths = paths
+	}
 
-	e.SiteID = site.ID
-	e.CreatedAt = Now()
+	e.Kind, e.Format = ExportHits, ExportCSV
 	e.StartFromHitID = startFrom
-	e.Path = fmt.Sprintf("%s%sgoatcounter-export-%s-%s-%d.csv.gz",
-		os.TempDir(), string(os.PathSeparator), site.Code,
-		e.CreatedAt.Format("20060102T150405Z"), startFrom)
-
-	var err error
-	e.ID, err = insertWithID(ctx, "export_id",
-		`insert into exports (site_id, path, created_at, start_from_hit_id) values ($1, $2, $3, $4)`,
-		e.SiteID, e.Path, e.CreatedAt.Format(zdb.Date), e.StartFromHitID)
-	if err != nil {
-		return nil, errors.Wrap(err, "Export.Create")
+	fp, err := e.create(ctx)
+	return fp, errors.Wrap(err, "Export.Create")
+}
+
+// CreateStats creates a new export of the aggregated statistics, in the given
+// format.
+//
+// This includes the statistics for pageviews that were already removed by the
+// data retention if RetentionKeepStats is set; the statistics are removed with
+// the pageviews otherwise.
+func (e *Export) CreateStats(ctx context.Context, format string) (*os.File, error) {
+	v := zvalidate.New()
+	v.Include("format", format, ExportFormats)
+	if v.HasErrors() {
+		return nil, v
 	}
 
-	fp, err := os.Create(e.Path)
-	return fp, errors.Wrap(err, "Export.Create")
+	e.Kind, e.Format = ExportStats, format
+	e.StartDate, e.EndDate = nil, nil
+	e.PathLike, e.Paths = nil, nil
+	fp, err := e.create(ctx)
+	return fp, errors.Wrap(err, "Export.CreateStats")
 }
 
-// Export all data to a CSV file.
-func (e *Export) Run(ctx context.Context, fp *os.File, mailUser bool) {
-	l := zlog.Module("export").Field("id", e.ID)
-	l.Print("export started")
+func (e *Export) create(ctx context.Context) (*os.File, error) {
+	site := MustGetSite(ctx)
 
-	gzfp := gzip.NewWriter(fp)
-	defer fp.Close() // No need to error-check; just for safety.
-	defer gzfp.Close()
+	if e.Passphrase != "" && len(e.Passphrase) < 8 {
+		v := zvalidate.New()
+		v.Append("passphrase", "must be at least 8 characters")
+		return nil, v
+	}
+	e.Encrypted = e.Passphrase != ""
 
-	c := csv.NewWriter(gzfp)
-	c.Write([]string{ExportVersion + "Path", "Title", "Event", "Bot", "Session",
-		"FirstVisit", "Referrer", "Referrer scheme", "Browser", "Screen size",
-		"Location", "Date"})
-
-	var exportErr error
-	e.LastHitID = &e.StartFromHitID
-	var z int
-	e.NumRows = &z
-	for {
-		var (
-			hits Hits
-			last int64
-		)
-		last, exportErr = hits.List(ctx, 5000, *e.LastHitID)
-		e.LastHitID = &last
-		if len(hits) == 0 {
-			break
+	e.SiteID = site.ID
+	e.CreatedAt = Now()
+
+	var start, end *string
+	if e.StartDate != nil {
+		s := e.StartDate.UTC().Format(zdb.Date)
+		start = &s
+	}
+	if e.EndDate != nil {
+		s := e.EndDate.UTC().Format(zdb.Date)
+		end = &s
+	}
+
+	var (
+		fp  *os.File
+		err error
+	)
+	// The transaction can still fail after the file is created, so don't leave
+	// an open file or a file without an export.
+	defer func() {
+		if err != nil && fp != nil {
+			fp.Close()
+			os.Remove(fp.Name())
 		}
-		if exportErr != nil {
-			break
+	}()
+	err = zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		var err error
+		e.ID, err = insertWithID(ctx, "export_id",
+			`insert into exports (site_id, path, created_at, start_from_hit_id, start_date, end_date, path_like, paths, kind, format, scheduled, encrypted)
+			values ($1, '', $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			e.SiteID, e.CreatedAt.Format(zdb.Date), e.StartFromHitID, start, end, e.PathLike, e.Paths,
+			e.Kind, e.Format, e.Scheduled, e.Encrypted)
+		if err != nil {
+			return err
 		}
 
-		*e.NumRows += len(hits)
+		if e.Kind == ExportStats {
+			ext := ".zip"
+			if e.Format == ExportJSON {
+				ext = ".json.gz"
+			}
+			e.Path = fmt.Sprintf("goatcounter-export-stats-%s-%s-%d%s",
+				site.Code, e.CreatedAt.Format("20060102T150405Z"), e.ID, ext)
+		} else {
+			e.Path = fmt.Sprintf("goatcounter-export-%s-%s-%d-%d.csv.gz",
+				site.Code, e.CreatedAt.Format("20060102T150405Z"), e.StartFromHitID, e.ID)
+		}
+		_, err = tx.ExecContext(ctx, `update exports set path=$1 where export_id=$2`, e.Path, e.ID)
+		if err != nil {
+			return err
+		}
 
-		for _, hit := range hits {
-			s := ""
-			if hit.OldSession != nil {
-				s = strconv.FormatInt(*hit.OldSession, 10)
-			} else {
-				b := hit.Session.Bytes()
-				var u uuid.UUID
-				copy(u[:], b)
-				s = u.String()
+		fp, err = os.OpenFile(e.localPath(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fp, nil
+}
 
-			}
+// ScheduledExportDue reports if a new export should be started for the site in
+// the context because of the export_schedule setting.
+//
+// This compares calendar days in UTC rather than exact durations, so that
+// exports don't drift because of the time it takes to start them.
+func ScheduledExportDue(ctx context.Context) (bool, error) {
+	site := MustGetSite(ctx)
+	if site.Settings.ExportSchedule == ExportScheduleNone {
+		return false, nil
+	}
 
-			rs := ""
-			if hit.RefScheme != nil {
-				rs = *hit.RefScheme
-			}
+	var last time.Time
+	err := zdb.MustGet(ctx).GetContext(ctx, &last, `/* ScheduledExportDue */
+		select created_at from exports where site_id=$1 and scheduled=1
+		order by created_at desc limit 1`, site.ID)
+	if err != nil {
+		if zdb.ErrNoRows(err) {
+			return true, nil
+		}
+		return false, errors.Wrap(err, "ScheduledExportDue")
+	}
 
-			c.Write([]string{hit.Path, hit.Title, fmt.Sprintf("%t", hit.Event),
-				fmt.Sprintf("%d", hit.Bot), s, fmt.Sprintf("%t", hit.FirstVisit),
-				hit.Ref, rs, hit.Browser, zfloat.Join(hit.Size, ","),
-				hit.Location, hit.CreatedAt.Format(time.RFC3339)})
+	var (
+		now   = Now()
+		today = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		day   = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	)
+	switch site.Settings.ExportSchedule {
+	case ExportScheduleDaily:
+		return day.Before(today), nil
+	case ExportScheduleWeekly:
+		return !day.AddDate(0, 0, 7).After(today), nil
+	case ExportScheduleMonthly:
+		return last.Year()*12+int(last.Month()) < now.Year()*12+int(now.Month()), nil
+	}
+	return false, nil
+}
+
+// Run the export.
+//
+// This is intended to be run as a job with StartJob(); the export will fail if
+// the job is cancelled.
+func (e *Export) Run(ctx context.Context, fp *os.File, mailUser bool) error {
+	if e.Kind == ExportStats {
+		return e.runStats(ctx, fp, mailUser)
+	}
+	return e.runHits(ctx, fp, mailUser)
+}
+
+// runHits exports all pageviews to a CSV file.
+//
+// Every batch of hits is written as a separate gzip member, and the progress is
+// recorded in the exports table after every batch. If writing fails then the
+// incomplete batch is removed from the file, so the export can be continued
+// from LastHitID with Resume().
+func (e *Export) runHits(ctx context.Context, fp *os.File, mailUser bool) error {
+	l := zlog.Module("export").Field("id", e.ID)
+	defer fp.Close() // No need to error-check; just for safety.
+
+	// Start from the beginning, rather than resuming.
+	if e.LastHitID == nil {
+		l.Print("export started")
+		e.LastHitID = &e.StartFromHitID
+		var z int
+		e.NumRows = &z
+
+		// Hits added after this are still exported, but the progress is
+		// calculated from this.
+		var max int64
+		err := zdb.MustGet(ctx).GetContext(ctx, &max,
+			`select coalesce(max(id), 0) from hits where site=$1`, e.SiteID)
+		if err != nil {
+			e.LastHitID = nil
+			return e.fail(ctx, l, fp, 0, err)
+		}
+		e.MaxHitID = &max
+		_, err = zdb.MustGet(ctx).ExecContext(ctx,
+			`update exports set max_hit_id=$1 where export_id=$2`, e.MaxHitID, e.ID)
+		if err != nil {
+			l.Error(err)
 		}
 
-		c.Flush()
-		exportErr = c.Error()
+		exportErr := e.writeBatch(fp, func(c *csv.Writer) error {
+			return c.Write(ExportHeader())
+		})
 		if exportErr != nil {
+			e.LastHitID = nil // Can't resume without the header.
+			return e.fail(ctx, l, fp, 0, errors.Errorf("writing header: %w", exportErr))
+		}
+	} else {
+		l.Printf("export resumed from hit %d", *e.LastHitID)
+	}
+
+	for {
+		var hits Hits
+		last, err := hits.ListRange(ctx, int64(cfg.MaxHits), *e.LastHitID, e.hitRange())
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, err)
+		}
+		if len(hits) == 0 {
 			break
 		}
 
-		// Small amount of breathing space.
-		if cfg.Prod {
-			time.Sleep(500 * time.Millisecond)
+		// Get the size before writing, so the incomplete batch can be removed
+		// on errors.
+		st, err := fp.Stat()
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, err)
+		}
+		offset := st.Size()
+
+		err = e.writeBatch(fp, func(c *csv.Writer) error {
+			for _, hit := range hits {
+				err := c.Write(NewExportRow(hit).Values())
+				if err != nil {
+					return errors.Errorf("writing hit %d: %w", hit.ID, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return e.fail(ctx, l, fp, offset, errors.Errorf("batch after hit %d: %w", *e.LastHitID, err))
 		}
-	}
 
-	if exportErr != nil {
-		l.Field("export", e).Error(exportErr)
+		e.LastHitID = &last
+		*e.NumRows += len(hits)
+		e.setProgress()
 
-		_, err := zdb.MustGet(ctx).ExecContext(ctx,
-			`update exports set error=$1 where export_id=$2`,
-			exportErr.Error(), e.ID)
+		// Record progress.
+		_, err = zdb.MustGet(ctx).ExecContext(ctx,
+			`update exports set last_hit_id=$1, num_rows=$2 where export_id=$3`,
+			e.LastHitID, e.NumRows, e.ID)
 		if err != nil {
-			zlog.Error(err)
+			l.Error(err)
 		}
 
-		_ = gzfp.Close()
-		_ = fp.Close()
-		_ = os.Remove(fp.Name())
-		return
+		JobProgress(ctx, int(e.Progress), 100)
+
+		// Small amount of breathing space.
+		err = JobPace(ctx, *e.NumRows)
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, err)
+		}
 	}
 
-	err := gzfp.Close()
+	return e.finish(ctx, l, fp, mailUser)
+}
+
+// finish records the export as finished, and notifies the user.
+func (e *Export) finish(ctx context.Context, l zlog.Log, fp *os.File, mailUser bool) error {
+	err := fp.Sync() // Ensure stat is correct.
 	if err != nil {
 		l.Error(err)
-		return
+		return err
 	}
-	err = fp.Sync() // Ensure stat is correct.
+	err = fp.Close()
 	if err != nil {
 		l.Error(err)
-		return
+		return err
 	}
 
-	stat, err := fp.Stat()
+	if e.Encrypted {
+		if e.Passphrase == "" {
+			return e.fail(ctx, l, fp, -1, errors.New("no passphrase to encrypt the export"))
+		}
+		err = e.encrypt()
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, errors.Errorf("encrypting: %w", err))
+		}
+	}
+
+	stat, err := os.Stat(e.localPath())
 	size := "0"
 	if err == nil {
 		size = fmt.Sprintf("%.1f", float64(stat.Size())/1024/1024)
 	}
 	e.Size = &size
 
-	err = fp.Close()
+	hash, err := zcrypto.HashFile(e.localPath())
+	e.Hash = &hash
 	if err != nil {
 		l.Error(err)
-		return
+		return err
 	}
 
-	hash, err := zcrypto.HashFile(e.Path)
-	e.Hash = &hash
+	err = e.writeManifest(ctx)
 	if err != nil {
-		l.Error(err)
-		return
+		return e.fail(ctx, l, fp, -1, errors.Errorf("writing manifest: %w", err))
 	}
 
+	st, err := storage()
+	if err == nil {
+		err = st.Put(ctx, e.Path)
+	}
+	if err == nil {
+		err = st.Put(ctx, e.ManifestPath())
+	}
+	if err != nil {
+		return e.fail(ctx, l, fp, -1, err)
+	}
+
+	e.Progress = 100
 	now := Now().Format(zdb.Date)
 	_, err = zdb.MustGet(ctx).ExecContext(ctx, `update exports set
-		finished_at=$1, num_rows=$2, size=$3, hash=$4, last_hit_id=$5
-		where export_id=$6`,
-		&now, e.NumRows, e.Size, e.Hash, e.LastHitID, e.ID)
+		finished_at=$1, num_rows=$2, size=$3, hash=$4, last_hit_id=$5, path=$6
+		where export_id=$7`,
+		&now, e.NumRows, e.Size, e.Hash, e.LastHitID, e.Path, e.ID)
 	if err != nil {
 		zlog.Error(err)
 	}
 
+	Notify(ctx, NotifyExport, fmt.Sprintf("Export %d is ready to download; %d rows were exported.",
+		e.ID, *e.NumRows), fmt.Sprintf("/export/%d", e.ID))
+
 	if mailUser {
 		site := MustGetSite(ctx)
 		user := GetUser(ctx)
-		err = blackmail.Send("GoatCounter export ready",
-			blackmail.From("GoatCounter export", cfg.EmailFrom),
-			blackmail.To(user.Email),
-			blackmail.BodyMustText(EmailTemplate("email_export_done.gotxt", struct {
+		err = SendEmail(ctx, "GoatCounter export ready", "GoatCounter export", user.Email,
+			EmailTemplate("email_export_done.gotxt", struct {
 				Site   Site
 				Export Export
-			}{*site, *e})))
+			}{*site, *e}))
 		if err != nil {
 			l.Error(err)
 		}
 	}
+	return nil
 }
 
-type Exports []Export
+// Resume an export that failed, continuing from LastHitID.
+//
+// This returns the file pointer to pass to Run().
+func (e *Export) Resume(ctx context.Context) (*os.File, error) {
+	if e.FinishedAt != nil {
+		return nil, errors.Errorf("Export.Resume: export %d is already finished", e.ID)
+	}
+	if e.Kind == ExportStats || e.Error == nil || e.LastHitID == nil || e.Expired {
+		return nil, errors.Errorf("Export.Resume: export %d can't be resumed", e.ID)
+	}
+	if e.Encrypted && e.Passphrase == "" {
+		return nil, errors.Errorf("Export.Resume: export %d is encrypted; need the passphrase to resume", e.ID)
+	}
+
+	fp, err := os.OpenFile(e.localPath(), os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "Export.Resume")
+	}
 
-func (e *Exports) List(ctx context.Context) error {
-	return errors.Wrap(zdb.MustGet(ctx).SelectContext(ctx, e, `/* Exports.List */
-		select * from exports where site_id=$1 and created_at > `+interval(1),
-		MustGetSite(ctx).ID), "Exports.List")
+	// Claim the export by clearing the error; if another request resumed it
+	// first then the error will already be cleared and nothing gets updated.
+	claimed, err := e.claim(ctx)
+	if err != nil {
+		fp.Close()
+		return nil, errors.Wrap(err, "Export.Resume")
+	}
+	if !claimed {
+		fp.Close()
+		return nil, errors.Errorf("Export.Resume: export %d is already being resumed", e.ID)
+	}
+	e.Error = nil
+	if e.NumRows == nil {
+		var z int
+		e.NumRows = &z
+	}
+	return fp, nil
 }
 
-// Import data from an export.
-func Import(ctx context.Context, fp io.Reader, replace, email bool) {
-	site := MustGetSite(ctx)
-	user := GetUser(ctx)
+// claim clears the error of a failed export, reporting if this updated the
+// row.
+func (e *Export) claim(ctx context.Context) (bool, error) {
+	query := `update exports set error=null
+		where export_id=$1 and error is not null and finished_at is null`
 
-	l := zlog.Module("import").Field("site", site.ID).Field("replace", replace)
-	l.Print("import started")
+	if cfg.PgSQL {
+		var ids []int64
+		err := zdb.MustGet(ctx).SelectContext(ctx, &ids, query+` returning export_id`, e.ID)
+		return len(ids) > 0, err
+	}
 
-	c := csv.NewReader(fp)
-	header, err := c.Read()
+	r, err := zdb.MustGet(ctx).ExecContext(ctx, query, e.ID)
+	if err != nil {
+		return false, err
+	}
+	n, err := r.RowsAffected()
+	return n > 0, err
+}
+
+// writeBatch writes a batch of rows as a single gzip member.
+func (e *Export) writeBatch(fp *os.File, write func(*csv.Writer) error) error {
+	gzfp := gzip.NewWriter(fp)
+	c := csv.NewWriter(gzfp)
+
+	err := write(c)
 	if err != nil {
-		importError(l, *user, err)
-		return
+		return err
 	}
 
-	if len(header) == 0 || !strings.HasPrefix(header[0], ExportVersion) {
-		importError(l, *user, errors.Errorf(
-			"wrong version of CSV database: %s (expected: %s)",
-			header[0][:1], ExportVersion))
-		return
+	c.Flush()
+	err = c.Error()
+	if err != nil {
+		return err
 	}
+	return gzfp.Close()
+}
+
+// fail records the export error. The file is truncated to offset to remove any
+// incomplete batch, so it can be resumed later. If offset is -1 then the file
+// isn't truncated.
+func (e *Export) fail(ctx context.Context, l zlog.Log, fp *os.File, offset int64, exportErr error) error {
+	l.Field("export", e).Error(exportErr)
 
-	if replace {
-		err := site.DeleteAll(ctx)
+	if offset > -1 {
+		err := fp.Truncate(offset)
 		if err != nil {
-			importError(l, *user, err)
 			l.Error(err)
-			return
 		}
 	}
+	_ = fp.Close()
 
-	var (
-		sessions = make(map[string]zint.Uint128)
-		n        = 0
-		errs     = errors.NewGroup(50)
-	)
-	for {
-		line, err := c.Read()
-		if err == io.EOF {
-			break
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update exports set error=$1, last_hit_id=$2, num_rows=$3 where export_id=$4`,
+		exportErr.Error(), e.LastHitID, e.NumRows, e.ID)
+	if err != nil {
+		l.Error(err)
+	}
+
+	Notify(ctx, NotifyExport, fmt.Sprintf("Export %d failed: %s", e.ID, exportErr), "/settings#tab-export")
+	return exportErr
+}
+
+type Exports []Export
+
+// List all exports created in the last days, including exports of which the
+// file has expired.
+func (e *Exports) List(ctx context.Context, days int) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, e, `/* Exports.List */
+		select * from exports where site_id=$1 and created_at > `+interval(days)+`
+		order by created_at desc`,
+		MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "Exports.List")
+	}
+
+	ee := *e
+	seenScheduled := false
+	for i := range ee {
+		ee[i].setExpired(i, ee[i].Scheduled && !seenScheduled)
+		if ee[i].Scheduled {
+			seenScheduled = true
 		}
-		if errs.Append(err) {
+		ee[i].setProgress()
+	}
+	return nil
+}
+
+// CreateAll creates and runs an export for every active site on this instance;
+// this is intended for backups and migrations of an entire instance.
+//
+// Every site gets its own file in the ExportStorage, with a manifest, as if it
+// was exported from the site's settings. kind is "hits" or "stats"; stats are
+// exported as CSV. Only sites in siteIDs are exported if it's not empty.
+//
+// This continues with the next site if an export fails, and returns all the
+// errors; the exports that finished are always added to e.
+func (e *Exports) CreateAll(ctx context.Context, kind string, siteIDs ...int64) error {
+	v := zvalidate.New()
+	v.Include("kind", kind, ExportKinds)
+	if v.HasErrors() {
+		return v
+	}
+
+	var sites Sites
+	err := sites.UnscopedList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Exports.CreateAll")
+	}
+
+	errs := errors.NewGroup(len(sites))
+	for i := range sites {
+		s := sites[i]
+		if len(siteIDs) > 0 && !containsID(siteIDs, s.ID) {
 			continue
 		}
 
-		var row ExportRow
-		err = row.Read(line)
-		if errs.Append(err) {
+		var (
+			siteCtx = WithSite(ctx, &s)
+			export  Export
+			fp      *os.File
+		)
+		if kind == ExportStats {
+			fp, err = export.CreateStats(siteCtx, ExportCSV)
+		} else {
+			fp, err = export.Create(siteCtx, 0)
+		}
+		if err == nil {
+			err = export.Run(siteCtx, fp, false)
+		}
+		if err != nil {
+			errs.Append(errors.Errorf("site %d (%s): %w", s.ID, s.Code, err))
 			continue
 		}
+		*e = append(*e, export)
+	}
+	return errs.ErrorOrNil()
+}
 
-		hit, err := row.Hit(site.ID)
-		if errs.Append(err) {
-			continue
+func containsID(ids []int64, id int64) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
 		}
+	}
+	return false
+}
 
-		// Map session IDs to new session IDs.
-		s, ok := sessions[row.Session]
-		if !ok {
-			sessions[row.Session] = Memstore.SessionID()
+// DeleteExpired deletes the exports of all sites for which ExportRetention has
+// passed, except the last ExportKeep exports of every site. Both the file and
+// the row in the exports table are removed.
+//
+// The last scheduled export of every site is always kept, as
+// ScheduledExportDue() uses it to find when the last one ran.
+//
+// Export files older than ExportRetention which no longer have a row in the
+// exports table are removed as well.
+func (e *Exports) DeleteExpired(ctx context.Context) error {
+	db := zdb.MustGet(ctx)
+	cutoff := Now().Add(-ExportRetention)
+
+	err := db.SelectContext(ctx, e, `/* Exports.DeleteExpired */
+		select * from exports where export_id in (
+			select export_id from (
+				select
+					export_id,
+					coalesce(finished_at, created_at) as t,
+					scheduled,
+					row_number() over (partition by site_id order by created_at desc) as n,
+					row_number() over (partition by site_id, scheduled order by created_at desc) as ns
+				from exports
+			) x
+			where t < $1 and n > $2 and not (scheduled=1 and ns=1)
+		)`, cutoff.UTC().Format(zdb.Date), ExportKeep)
+	if err != nil {
+		return errors.Wrap(err, "Exports.DeleteExpired")
+	}
+
+	for _, ex := range *e {
+		err := ex.Delete(ctx)
+		if err != nil {
+			return errors.Wrap(err, "Exports.DeleteExpired")
 		}
-		hit.Session = s
+	}
 
-		Memstore.Append(hit)
-		n++
+	// Failed exports are never moved to the storage, so also check the
+	// directory they're written to.
+	st, err := storage()
+	if err != nil {
+		return errors.Wrap(err, "Exports.DeleteExpired")
+	}
+	storages := []ExportStorage{st}
+	if cfg.ExportStorage != "" {
+		storages = append(storages, dirStorage(ExportDir()))
+	}
 
-		// Spread out the load a bit.
-		if cfg.Prod && n%5000 == 0 {
-			time.Sleep(10 * time.Second)
+	var paths []string
+	err = db.SelectContext(ctx, &paths, `/* Exports.DeleteExpired */ select path from exports`)
+	if err != nil {
+		return errors.Wrap(err, "Exports.DeleteExpired")
+	}
+	keep := make(map[string]struct{}, len(paths)*2)
+	for _, p := range paths {
+		keep[filepath.Base(p)] = struct{}{}
+		keep[filepath.Base(p)+".age"] = struct{}{}
+		keep[filepath.Base(p)+ExportManifestSuffix] = struct{}{}
+	}
+
+	for _, s := range storages {
+		files, err := s.List(ctx, "goatcounter-export-")
+		if err != nil {
+			return errors.Wrap(err, "Exports.DeleteExpired")
+		}
+		for _, f := range files {
+			if _, ok := keep[f.Key]; ok || !f.ModTime.Before(cutoff) {
+				continue
+			}
+			err := s.Delete(ctx, f.Key)
+			if err != nil {
+				return errors.Wrap(err, "Exports.DeleteExpired")
+			}
 		}
 	}
+	return nil
+}
 
-	l.Debugf("imported %d rows", n)
-	if errs.Len() > 0 {
-		l.Error(errs)
+// Delete the export file and the row in the exports table.
+func (e Export) Delete(ctx context.Context) error {
+	st, err := storage()
+	if err != nil {
+		return errors.Errorf("Export.Delete: %w", err)
+	}
+	if e.FinishedAt != nil {
+		for _, p := range []string{e.Path, e.ManifestPath()} {
+			err = st.Delete(ctx, p)
+			if err != nil {
+				return errors.Errorf("Export.Delete %d: %w", e.ID, err)
+			}
+		}
 	}
 
-	if email {
-		// Send email after 10s delay to make sure the cron task has finished
-		// updating all the rows.
-		time.Sleep(10 * time.Second)
-		err = blackmail.Send("GoatCounter import ready",
-			blackmail.From("GoatCounter import", cfg.EmailFrom),
-			blackmail.To(user.Email),
-			blackmail.BodyMustText(EmailTemplate("email_import_done.gotxt", struct {
-				Site   Site
-				Rows   int
-				Errors *errors.Group
-			}{*site, n, errs})))
+	// Exports that aren't finished are still in ExportDir(), and encrypted
+	// exports may be halfway encrypting.
+	local := dirStorage(ExportDir())
+	for _, p := range []string{e.Path, e.Path + ".age", e.ManifestPath()} {
+		err = local.Delete(ctx, p)
 		if err != nil {
-			l.Error(err)
+			return errors.Errorf("Export.Delete %d: %w", e.ID, err)
 		}
 	}
+
+	_, err = zdb.MustGet(ctx).ExecContext(ctx,
+		`/* Export.Delete */ delete from exports where export_id=$1`, e.ID)
+	return errors.Wrapf(err, "Export.Delete %d", e.ID)
+}
+
+// ReplaceTokenExpiry is how long tokens from NewReplaceToken are valid.
+const ReplaceTokenExpiry = 10 * time.Minute
+
+var replaceTokens = cache.New(ReplaceTokenExpiry, time.Minute)
+
+// NewReplaceToken creates a short-lived token to confirm that existing
+// pageviews of the site in the context should be removed before an import.
+func NewReplaceToken(ctx context.Context) string {
+	t := zcrypto.Secret64()
+	replaceTokens.SetDefault(replaceTokenKey(ctx, t), true)
+	return t
 }
 
-// TODO: would be nice to have generic csv marshal/unmarshaler, so you can do:
+// UseReplaceToken reports if the token was created with NewReplaceToken for
+// the site in the context and hasn't expired yet. Tokens can only be used once.
+func UseReplaceToken(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	k := replaceTokenKey(ctx, token)
+	_, ok := replaceTokens.Get(k)
+	replaceTokens.Delete(k)
+	return ok
+}
+
+func replaceTokenKey(ctx context.Context, token string) string {
+	return strconv.FormatInt(MustGetSite(ctx).ID, 10) + ":" + token
+}
+
+// Import modes.
+const (
+	ImportAdd          = ""              // Add to the existing pageviews.
+	ImportAddNew       = "add-new"       // Add rows that weren't imported before.
+	ImportReplace      = "replace"       // Remove all existing pageviews first.
+	ImportReplaceRange = "replace-range" // Remove existing pageviews in the date range of the import first.
+)
+
+// ImportModes are all valid import modes.
+var ImportModes = []string{ImportAdd, ImportAddNew, ImportReplace, ImportReplaceRange}
+
+// Import data from an export.
+//
+// With ImportReplace all existing pageviews are removed first; callers should
+// confirm this with UseReplaceToken().
 //
-//    Path string `csv:"1"`
+// With ImportReplaceRange the file is read twice: first to get the date range
+// of the import, after which all existing pageviews on the days in that range
+// are removed. Pageviews outside of the range are kept. Callers should confirm
+// this with UseReplaceToken() as well.
 //
-// Or something, or perhaps even get by header:
+// Removing pageviews is recorded in the audit log.
 //
-//    Path string `csv:"path"`
+// With ImportAddNew rows that were imported before are skipped, so it's safe to
+// retry an import that stopped halfway. Rows are identified by a fingerprint of
+// the session, path, and date, which is recorded for all imports.
 //
-// Looks like there's some existing stuff for that already:
+// The transform is applied to every row before it's imported; see
+// ImportTransform.
 //
-// https://github.com/gocarina/gocsv
-// https://github.com/jszwec/csvutil
+// If dryRun isn't nil the file is only read and validated, and the result is
+// stored in dryRun; nothing is written or removed.
+//
+// The file is stored first with NewImportJob(), so that the import can be
+// resumed if it's interrupted.
+//
+// This is intended to be run as a job with StartJob(); the import stops if the
+// job is cancelled, but pageviews that were already imported are kept.
+func Import(ctx context.Context, fp io.Reader, mode string, tr ImportTransform, email bool, dryRun *ImportDryRun) error {
+	site := MustGetSite(ctx)
+	user := GetUser(ctx)
+
+	l := zlog.Module("import").Field("site", site.ID).Field("mode", mode)
+	if dryRun == nil {
+		imp, err := NewImportJob(ctx, fp, mode, tr, email)
+		if err != nil {
+			return importError(ctx, l, *user, err)
+		}
+		return imp.Run(ctx)
+	}
+
+	l = l.Field("dry-run", true)
+	l.Print("import started")
+
+	conv, format, err := ConvertImport(fp, "")
+	if err != nil {
+		return importError(ctx, l, *user, err)
+	}
+	defer conv.Close()
+	if format != ImportFormatGoatCounter {
+		l = l.Field("format", format)
+		l.Print("converted export")
+	}
+
+	c := csv.NewReader(conv)
+	header, err := c.Read()
+	if err != nil {
+		return importError(ctx, l, *user, err)
+	}
 
-type ExportRow struct { // Fields in order!
-	Path       string
-	Title      string
-	Event      string
-	Bot        string
-	Session    string
-	FirstVisit string
-	Ref        string
-	RefScheme  string
-	Browser    string
-	Size       string
-	Location   string
-	CreatedAt  string
+	dec, err := NewExportDecoder(header)
+	if err != nil {
+		return importError(ctx, l, *user, err)
+	}
+
+	*dryRun = ImportDryRun{Mode: mode}
+	err = dryRun.check(ctx, c, dec, tr)
+	if err != nil {
+		l.Error(err)
+		return importError(ctx, l, *user, err)
+	}
+	l.Debugf("dry run: %d rows; %d invalid rows", dryRun.Rows, dryRun.Invalid)
+	Notify(ctx, NotifyImport, "Import check finished: "+dryRun.String(), "")
+	return nil
 }
 
-func (row *ExportRow) Read(line []string) error {
-	values := reflect.ValueOf(row).Elem()
-	if len(line) != values.NumField() {
-		return fmt.Errorf("wrong number of fields: %d (want: %d)", len(line), values.NumField())
+// importRange copies the remaining rows from c to a temporary file, and gets
+// the lowest and highest creation date of the rows.
+//
+// Rows with an invalid date are copied but otherwise ignored; they will give
+// an error on import. Rows that are dropped by the transform are copied but
+// don't count for the range. The dates are zero if there are no valid dates.
+func importRange(c *csv.Reader, dec *ExportDecoder, tr ImportTransform) (tmp *os.File, start, end time.Time, err error) {
+	tmp, err = ioutil.TempFile("", "goatcounter-import-*.csv")
+	if err != nil {
+		return nil, start, end, errors.Errorf("importRange: %w", err)
 	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			tmp, err = nil, errors.Errorf("importRange: %w", err)
+		}
+	}()
 
-	for i := 0; i < len(line); i++ {
-		f := values.Field(i)
+	w := csv.NewWriter(tmp)
+	for {
+		line, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return tmp, start, end, err
+		}
 
-		switch f.Kind() {
-		case reflect.String:
-			f.SetString(line[i])
+		if row, err := dec.Decode(line); err == nil && tr.Apply(&row) {
+			if t, err := time.Parse(time.RFC3339, row.CreatedAt); err == nil {
+				if start.IsZero() || t.Before(start) {
+					start = t
+				}
+				if end.IsZero() || t.After(end) {
+					end = t
+				}
+			}
+		}
+
+		err = w.Write(line)
+		if err != nil {
+			return tmp, start, end, err
 		}
 	}
 
-	return nil
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return tmp, start, end, err
+	}
+	_, err = tmp.Seek(0, io.SeekStart)
+	return tmp, start, end, err
+}
+
+// ExportRow is a row in the CSV export.
+type ExportRow struct {
+	Path       string `csv:"Path"`
+	Title      string `csv:"Title"`
+	Event      string `csv:"Event"`
+	Bot        string `csv:"Bot"`
+	Session    string `csv:"Session"`
+	FirstVisit string `csv:"FirstVisit"`
+	Ref        string `csv:"Referrer"`
+	RefScheme  string `csv:"Referrer scheme"`
+	Browser    string `csv:"Browser"`
+	Size       string `csv:"Screen size"`
+	Location   string `csv:"Location"`
+	CreatedAt  string `csv:"Date"`
+	ID         string `csv:"ID"` // Added in version 2.
+}
+
+// ExportHeader gets the CSV header for the current ExportVersion.
+func ExportHeader() []string {
+	h := csvHeader(ExportRow{})
+	h[0] = ExportVersion + h[0]
+	return h
+}
+
+// NewExportRow creates a new export row from the hit.
+func NewExportRow(hit Hit) ExportRow {
+	row := ExportRow{
+		Path:       hit.Path,
+		Title:      hit.Title,
+		Event:      fmt.Sprintf("%t", hit.Event),
+		Bot:        fmt.Sprintf("%d", hit.Bot),
+		Session:    hit.SessionString(),
+		FirstVisit: fmt.Sprintf("%t", hit.FirstVisit),
+		Ref:        hit.Ref,
+		Browser:    hit.Browser,
+		Size:       zfloat.Join(hit.Size, ","),
+		Location:   hit.Location,
+		CreatedAt:  hit.CreatedAt.Format(time.RFC3339),
+		ID:         strconv.FormatInt(hit.ID, 10),
+	}
+	if hit.RefScheme != nil {
+		row.RefScheme = *hit.RefScheme
+	}
+	return row
+}
+
+// Values gets the CSV values, in the same order as ExportHeader().
+func (row ExportRow) Values() []string { return csvMarshal(row) }
+
+// Read the CSV line for the given export version, by the position of the
+// columns.
+func (row *ExportRow) Read(version string, line []string) error {
+	cols := make([]int, len(csvHeader(row)))
+	for i := range cols {
+		cols[i] = i
+	}
+	if version == "1" {
+		cols = cols[:len(cols)-1] // No ID.
+	}
+	return csvUnmarshal(row, cols, line)
+}
+
+// ExportDecoder decodes the rows of a CSV export.
+type ExportDecoder struct {
+	Version string
+	cols    []int
+}
+
+// NewExportDecoder creates a new decoder for the CSV header.
+//
+// Versions 1 and 2 are read by the position of the columns, and newer versions
+// by the column names in the header. Columns that are not in ExportRow are
+// ignored, but the Path and Date columns are required.
+func NewExportDecoder(header []string) (*ExportDecoder, error) {
+	version, err := ExportHeaderVersion(header)
+	if err != nil {
+		return nil, err
+	}
+	d := &ExportDecoder{Version: version}
+	if version == "1" || version == "2" {
+		return d, nil
+	}
+
+	names := append([]string{header[0][1:]}, header[1:]...)
+	d.cols = csvColumns(ExportRow{}, names)
+	for _, req := range []string{"Path", "Date"} {
+		found := false
+		for _, n := range names {
+			if n == req {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("missing column in CSV header: %q", req)
+		}
+	}
+	return d, nil
+}
+
+// Decode the CSV line.
+func (d ExportDecoder) Decode(line []string) (ExportRow, error) {
+	var row ExportRow
+	if d.cols == nil {
+		err := row.Read(d.Version, line)
+		return row, err
+	}
+	err := csvUnmarshal(&row, d.cols, line)
+	return row, err
 }
 
 func (row ExportRow) Hit(siteID int64) (Hit, error) {
@@ -408,18 +1295,112 @@ func (row ExportRow) Hit(siteID int64) (Hit, error) {
 	return hit, v.ErrorOrNil()
 }
 
-func importError(l zlog.Log, user User, report error) {
+func importError(ctx context.Context, l zlog.Log, user User, report error) error {
+	report = importFailed(ctx, report)
+	err := SendEmail(ctx, "GoatCounter import error", "GoatCounter import", user.Email,
+		EmailTemplate("email_import_error.gotxt", struct {
+			Error error
+		}{report}))
+	if err != nil {
+		l.Error(err)
+	}
+	return report
+}
+
+// importFailed notifies the user that the import failed, and returns the error
+// without the stack trace.
+func importFailed(ctx context.Context, report error) error {
 	if e, ok := report.(*errors.StackErr); ok {
 		report = e.Unwrap()
 	}
+	Notify(ctx, NotifyImport, fmt.Sprintf("Import failed: %s", report), "")
+	return report
+}
 
-	err := blackmail.Send("GoatCounter import error",
-		blackmail.From("GoatCounter import", cfg.EmailFrom),
-		blackmail.To(user.Email),
-		blackmail.BodyMustText(EmailTemplate("email_import_error.gotxt", struct {
-			Error error
-		}{report})))
-	if err != nil {
-		l.Error(err)
+// ImportReport counts imported rows with values that couldn't be mapped, so
+// that users can judge the quality of the imported data.
+type ImportReport struct {
+	// Browsers are User-Agent headers from which no browser could be
+	// detected; these are still stored, but will show up as "(unknown)" in
+	// the browser stats.
+	Browsers ImportUnknown `json:"browsers"`
+
+	// Locations are invalid location codes; these are stored as an unknown
+	// location.
+	Locations ImportUnknown `json:"locations"`
+}
+
+// Check the hit for values that can't be mapped and add them to the report.
+//
+// Invalid location codes are cleared from the hit.
+func (r *ImportReport) Check(hit *Hit) {
+	// Bots aren't shown in the browser stats, and often have odd User-Agents.
+	if hit.Browser != "" && hit.Bot == 0 && gadget.Parse(hit.Browser).BrowserName == "" {
+		r.Browsers.add(hit.Browser)
+	}
+	if hit.Location != "" && !validLocation(hit.Location) {
+		r.Locations.add(hit.Location)
+		hit.Location = ""
+	}
+}
+
+// Total gets the total number of unknown values in the report.
+func (r ImportReport) Total() int { return r.Browsers.Total() + r.Locations.Total() }
+
+// ImportUnknown counts unknown values during an import.
+type ImportUnknown map[string]int
+
+// ImportUnknownValue is a single unknown value with the number of rows it
+// appeared in.
+type ImportUnknownValue struct {
+	Value string
+	Count int
+}
+
+func (u *ImportUnknown) add(v string) {
+	if *u == nil {
+		*u = make(ImportUnknown)
+	}
+	(*u)[v]++
+}
+
+// Total gets the number of rows with an unknown value.
+func (u ImportUnknown) Total() int {
+	var t int
+	for _, c := range u {
+		t += c
+	}
+	return t
+}
+
+// Top gets the n most common unknown values, ordered by count.
+func (u ImportUnknown) Top(n int) []ImportUnknownValue {
+	top := make([]ImportUnknownValue, 0, len(u))
+	for v, c := range u {
+		top = append(top, ImportUnknownValue{Value: v, Count: c})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count == top[j].Count {
+			return top[i].Value < top[j].Value
+		}
+		return top[i].Count > top[j].Count
+	})
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+// validLocation reports if loc looks like an ISO 3166-1 alpha-2 country code,
+// which is what we store as the location.
+func validLocation(loc string) bool {
+	if len(loc) != 2 {
+		return false
+	}
+	for _, c := range loc {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
 	}
+	return true
 }
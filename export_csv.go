@@ -0,0 +1,89 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Simple CSV marshalling for structs with only string fields; the column name
+// is in the csv struct tag (e.g. `csv:"Path"`), and fields without a tag are
+// skipped.
+
+// csvFields gets the indexes of all struct fields with a csv tag, and the
+// column names.
+func csvFields(t reflect.Type) ([]int, []string) {
+	var (
+		idx   = make([]int, 0, t.NumField())
+		names = make([]string, 0, t.NumField())
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("csv")
+		if name == "" || name == "-" {
+			continue
+		}
+		if f.Type.Kind() != reflect.String {
+			panic(fmt.Sprintf("csvFields: %s.%s is not a string", t.Name(), f.Name))
+		}
+		idx = append(idx, i)
+		names = append(names, name)
+	}
+	return idx, names
+}
+
+// csvHeader gets the column names for the struct v.
+func csvHeader(v interface{}) []string {
+	_, names := csvFields(reflect.Indirect(reflect.ValueOf(v)).Type())
+	return names
+}
+
+// csvMarshal gets the values for all columns of the struct v, in the same order
+// as csvHeader().
+func csvMarshal(v interface{}) []string {
+	vals := reflect.Indirect(reflect.ValueOf(v))
+	idx, _ := csvFields(vals.Type())
+
+	line := make([]string, 0, len(idx))
+	for _, i := range idx {
+		line = append(line, vals.Field(i).String())
+	}
+	return line
+}
+
+// csvColumns maps the columns in the header to field indexes in the struct v,
+// for use with csvUnmarshal(). Columns that aren't in the struct are -1.
+func csvColumns(v interface{}, header []string) []int {
+	idx, names := csvFields(reflect.Indirect(reflect.ValueOf(v)).Type())
+
+	cols := make([]int, len(header))
+	for i, h := range header {
+		cols[i] = -1
+		for j, n := range names {
+			if n == h {
+				cols[i] = idx[j]
+				break
+			}
+		}
+	}
+	return cols
+}
+
+// csvUnmarshal sets the fields in the struct pointer v from the line, using the
+// columns from csvColumns().
+func csvUnmarshal(v interface{}, cols []int, line []string) error {
+	if len(line) != len(cols) {
+		return fmt.Errorf("wrong number of fields: %d (want: %d)", len(line), len(cols))
+	}
+
+	vals := reflect.ValueOf(v).Elem()
+	for i, c := range cols {
+		if c >= 0 {
+			vals.Field(c).SetString(line[i])
+		}
+	}
+	return nil
+}
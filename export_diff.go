@@ -0,0 +1,183 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+)
+
+// ExportDay is the number of rows and a checksum of the rows on one day.
+//
+// The checksum doesn't depend on the order of the rows, and doesn't include
+// the ID and session, as these are different after importing an export on
+// another instance.
+type ExportDay struct {
+	Rows int
+	Sum  uint64
+}
+
+// ExportDigest summarizes the pageviews per day (in UTC), to compare exports
+// with DiffExports().
+type ExportDigest struct {
+	Days map[string]ExportDay
+
+	// Rows that couldn't be read; these aren't in Days.
+	Invalid int
+}
+
+func (d *ExportDigest) add(row ExportRow) {
+	t, err := time.Parse(time.RFC3339, row.CreatedAt)
+	if err != nil {
+		d.Invalid++
+		return
+	}
+	t = t.UTC()
+
+	// Normalize the booleans, as older versions wrote "1" and "0".
+	event, _ := strconv.ParseBool(row.Event)
+	first, _ := strconv.ParseBool(row.FirstVisit)
+	h := sha256.Sum256([]byte(strings.Join([]string{
+		row.Path, row.Title, strconv.FormatBool(event), row.Bot,
+		strconv.FormatBool(first), row.Ref, row.RefScheme, row.Browser,
+		row.Size, row.Location, t.Format(time.RFC3339),
+	}, "\x00")))
+
+	if d.Days == nil {
+		d.Days = make(map[string]ExportDay)
+	}
+	day := t.Format("2006-01-02")
+	v := d.Days[day]
+	v.Rows++
+	v.Sum += binary.BigEndian.Uint64(h[:8]) // Sum rather than xor, so duplicates don't cancel out.
+	d.Days[day] = v
+}
+
+// Range gets the first day and the day after the last day; both are zero if
+// there are no days.
+func (d ExportDigest) Range() (start, end time.Time) {
+	for day := range d.Days {
+		t, _ := time.Parse("2006-01-02", day)
+		if start.IsZero() || t.Before(start) {
+			start = t
+		}
+		if end.IsZero() || t.After(end) {
+			end = t
+		}
+	}
+	if !end.IsZero() {
+		end = end.Add(24 * time.Hour)
+	}
+	return start, end
+}
+
+// ReadExportDigest reads the digest from a CSV export; the file may be
+// compressed with gzip.
+func ReadExportDigest(fp io.Reader) (ExportDigest, error) {
+	var d ExportDigest
+
+	br := bufio.NewReader(fp)
+	var r io.Reader = br
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return d, errors.Errorf("ReadExportDigest: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	c := csv.NewReader(r)
+	header, err := c.Read()
+	if err != nil {
+		return d, errors.Errorf("ReadExportDigest: %w", err)
+	}
+	dec, err := NewExportDecoder(header)
+	if err != nil {
+		return d, errors.Errorf("ReadExportDigest: %w", err)
+	}
+
+	for {
+		line, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return d, errors.Errorf("ReadExportDigest: %w", err)
+		}
+
+		row, err := dec.Decode(line)
+		if err != nil {
+			d.Invalid++
+			continue
+		}
+		d.add(row)
+	}
+	return d, nil
+}
+
+// DBExportDigest gets the digest for all pageviews of the site in the context
+// that were created on or after start and before end, as they would be
+// exported.
+func DBExportDigest(ctx context.Context, start, end time.Time) (ExportDigest, error) {
+	var (
+		d    ExportDigest
+		last int64
+		r    = HitRange{Start: &start, End: &end}
+	)
+	for {
+		var hits Hits
+		var err error
+		last, err = hits.ListRange(ctx, int64(cfg.MaxHits), last, r)
+		if err != nil {
+			return d, errors.Errorf("DBExportDigest: %w", err)
+		}
+		if len(hits) == 0 {
+			return d, nil
+		}
+		for _, h := range hits {
+			d.add(NewExportRow(h))
+		}
+	}
+}
+
+// ExportDiff is a day with different rows in two exports.
+type ExportDiff struct {
+	Day  string
+	A, B ExportDay
+}
+
+// DiffExports compares the digests, and returns all days that have different
+// rows, sorted by day.
+func DiffExports(a, b ExportDigest) []ExportDiff {
+	days := make(map[string]struct{}, len(a.Days))
+	for d := range a.Days {
+		days[d] = struct{}{}
+	}
+	for d := range b.Days {
+		days[d] = struct{}{}
+	}
+
+	var diff []ExportDiff
+	for d := range days {
+		if a.Days[d] != b.Days[d] {
+			diff = append(diff, ExportDiff{Day: d, A: a.Days[d], B: b.Days[d]})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Day < diff[j].Day })
+	return diff
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"strconv"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestExportDigest(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/a", CreatedAt: now},
+		{Path: "/a", CreatedAt: now},
+		{Path: "/b", CreatedAt: now.Add(24 * time.Hour)},
+	}...)
+
+	db, err := goatcounter.DBExportDigest(ctx, now.Truncate(24*time.Hour), now.Add(48*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(db.Days) != 2 || db.Days["2020-06-18"].Rows != 2 || db.Days["2020-06-19"].Rows != 1 {
+		t.Fatalf("%+v", db)
+	}
+
+	// Write an export in a different order and with different IDs; this should
+	// be identical.
+	write := func(rows ...goatcounter.ExportRow) goatcounter.ExportDigest {
+		t.Helper()
+		b := new(bytes.Buffer)
+		gz := gzip.NewWriter(b)
+		c := csv.NewWriter(gz)
+		c.Write(goatcounter.ExportHeader())
+		for i, r := range rows {
+			r.ID = strconv.Itoa(i + 100)
+			c.Write(r.Values())
+		}
+		c.Flush()
+		gz.Close()
+
+		d, err := goatcounter.ReadExportDigest(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	var hits goatcounter.Hits
+	_, err = hits.ListRange(ctx, 0, 0, goatcounter.HitRange{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []goatcounter.ExportRow{
+		goatcounter.NewExportRow(hits[2]), goatcounter.NewExportRow(hits[0]), goatcounter.NewExportRow(hits[1]),
+	}
+
+	file := write(rows...)
+	if d := goatcounter.DiffExports(db, file); len(d) != 0 {
+		t.Errorf("%+v", d)
+	}
+	if start, end := file.Range(); !start.Equal(now.Truncate(24*time.Hour)) || !end.Equal(now.Truncate(24*time.Hour).Add(48*time.Hour)) {
+		t.Errorf("wrong range: %s – %s", start, end)
+	}
+
+	// Changed row.
+	rows[0].Path = "/c"
+	d := goatcounter.DiffExports(db, write(rows...))
+	if len(d) != 1 || d[0].Day != "2020-06-19" || d[0].A.Rows != 1 || d[0].B.Rows != 1 {
+		t.Errorf("%+v", d)
+	}
+
+	// Missing row, and a duplicate on another day.
+	d = goatcounter.DiffExports(db, write(rows[1], rows[1], rows[2], rows[2]))
+	if len(d) != 2 || d[0].B.Rows != 4 || d[1].B.Rows != 0 {
+		t.Errorf("%+v", d)
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/scrypt"
+	"zgo.at/errors"
+)
+
+// Exports are encrypted with the passphrase in the age format, so they can be
+// decrypted with the age tool ("age -d export.csv.gz.age"), or with
+// ExportDecrypt().
+//
+// https://age-encryption.org/v1
+const (
+	ageVersion     = "age-encryption.org/v1"
+	ageScryptLabel = "age-encryption.org/v1/scrypt"
+	ageChunkSize   = 64 * 1024
+)
+
+// ExportScryptWorkFactor is the scrypt work factor (log2 of N) for encrypting
+// exports. The age tool uses 18, but this takes 256M of memory; every increase
+// of one doubles the memory and time.
+var ExportScryptWorkFactor = 16
+
+var b64 = base64.RawStdEncoding
+
+// ExportEncrypt encrypts src to dst with the passphrase.
+func ExportEncrypt(dst io.Writer, src io.Reader, passphrase string) error {
+	fileKey := make([]byte, 16)
+	salt := make([]byte, 16)
+	nonce := make([]byte, 16)
+	for _, b := range [][]byte{fileKey, salt, nonce} {
+		if _, err := rand.Read(b); err != nil {
+			return errors.Errorf("ExportEncrypt: %w", err)
+		}
+	}
+
+	wrapKey, err := scrypt.Key([]byte(passphrase), append([]byte(ageScryptLabel), salt...),
+		1<<ExportScryptWorkFactor, 8, 1, chacha20poly1305.KeySize)
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+	body := aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), fileKey, nil)
+
+	header := ageVersion + "\n" +
+		"-> scrypt " + b64.EncodeToString(salt) + " " + strconv.Itoa(ExportScryptWorkFactor) + "\n" +
+		b64.EncodeToString(body) + "\n" +
+		"---"
+	mac, err := ageHeaderMAC(fileKey, header)
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+
+	_, err = io.WriteString(dst, header+" "+b64.EncodeToString(mac)+"\n")
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+	_, err = dst.Write(nonce)
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+
+	aead, err = ageStreamKey(fileKey, nonce)
+	if err != nil {
+		return errors.Errorf("ExportEncrypt: %w", err)
+	}
+
+	// Read one chunk ahead, as the last chunk is marked in the nonce.
+	var (
+		in      = bufio.NewReaderSize(src, ageChunkSize)
+		buf     = make([]byte, ageChunkSize)
+		counter uint64
+	)
+	for {
+		n, err := io.ReadFull(in, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return errors.Errorf("ExportEncrypt: %w", err)
+		}
+		last := err != nil
+		if !last {
+			_, err := in.Peek(1)
+			last = err == io.EOF
+		}
+
+		_, err = dst.Write(aead.Seal(nil, ageNonce(counter, last), buf[:n], nil))
+		if err != nil {
+			return errors.Errorf("ExportEncrypt: %w", err)
+		}
+		if last {
+			return nil
+		}
+		counter++
+	}
+}
+
+// ExportDecrypt decrypts an export that was encrypted with ExportEncrypt().
+func ExportDecrypt(dst io.Writer, src io.Reader, passphrase string) error {
+	in := bufio.NewReaderSize(src, ageChunkSize+chacha20poly1305.Overhead)
+
+	var header []string
+	for {
+		l, err := in.ReadString('\n')
+		if err != nil {
+			return errors.New("ExportDecrypt: not an encrypted export")
+		}
+		header = append(header, strings.TrimSuffix(l, "\n"))
+		if strings.HasPrefix(l, "---") || len(header) > 3 {
+			break
+		}
+	}
+	if len(header) != 4 || header[0] != ageVersion || !strings.HasPrefix(header[3], "--- ") {
+		return errors.New("ExportDecrypt: not an encrypted export")
+	}
+	stanza := strings.Split(header[1], " ")
+	if len(stanza) != 4 || stanza[0] != "->" || stanza[1] != "scrypt" {
+		return errors.New("ExportDecrypt: not encrypted with a passphrase")
+	}
+
+	salt, err := b64.DecodeString(stanza[2])
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: invalid salt: %w", err)
+	}
+	logN, err := strconv.Atoi(stanza[3])
+	if err != nil || logN < 1 || logN > 22 {
+		return errors.Errorf("ExportDecrypt: invalid work factor: %q", stanza[3])
+	}
+	body, err := b64.DecodeString(header[2])
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: invalid header: %w", err)
+	}
+
+	wrapKey, err := scrypt.Key([]byte(passphrase), append([]byte(ageScryptLabel), salt...),
+		1<<logN, 8, 1, chacha20poly1305.KeySize)
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: %w", err)
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: %w", err)
+	}
+	fileKey, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), body, nil)
+	if err != nil {
+		return errors.New("ExportDecrypt: wrong passphrase")
+	}
+
+	mac, err := ageHeaderMAC(fileKey, strings.Join(header[:3], "\n")+"\n---")
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: %w", err)
+	}
+	wantMAC, err := b64.DecodeString(strings.TrimPrefix(header[3], "--- "))
+	if err != nil || !hmac.Equal(mac, wantMAC) {
+		return errors.New("ExportDecrypt: invalid header MAC")
+	}
+
+	nonce := make([]byte, 16)
+	_, err = io.ReadFull(in, nonce)
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: reading nonce: %w", err)
+	}
+	aead, err = ageStreamKey(fileKey, nonce)
+	if err != nil {
+		return errors.Errorf("ExportDecrypt: %w", err)
+	}
+
+	var (
+		buf     = make([]byte, ageChunkSize+chacha20poly1305.Overhead)
+		counter uint64
+	)
+	for {
+		n, err := io.ReadFull(in, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return errors.Errorf("ExportDecrypt: %w", err)
+		}
+		last := err != nil
+		if !last {
+			_, err := in.Peek(1)
+			last = err == io.EOF
+		}
+
+		plain, err := aead.Open(nil, ageNonce(counter, last), buf[:n], nil)
+		if err != nil {
+			return errors.Errorf("ExportDecrypt: chunk %d: %w", counter, err)
+		}
+		_, err = dst.Write(plain)
+		if err != nil {
+			return errors.Errorf("ExportDecrypt: %w", err)
+		}
+		if last {
+			return nil
+		}
+		counter++
+	}
+}
+
+// encrypt the export file with the passphrase; the unencrypted file is
+// removed.
+func (e *Export) encrypt() error {
+	src, err := os.Open(e.localPath())
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(e.localPath()+".age", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	err = ExportEncrypt(dst, src, string(e.Passphrase))
+	if err != nil {
+		dst.Close()
+		os.Remove(dst.Name())
+		return err
+	}
+	err = dst.Close()
+	if err != nil {
+		os.Remove(dst.Name())
+		return err
+	}
+
+	src.Close()
+	err = os.Remove(e.localPath())
+	if err != nil {
+		return err
+	}
+	e.Path += ".age"
+	return nil
+}
+
+func ageHeaderMAC(fileKey []byte, header string) ([]byte, error) {
+	key := make([]byte, 32)
+	_, err := io.ReadFull(hkdf.New(sha256.New, fileKey, nil, []byte("header")), key)
+	if err != nil {
+		return nil, err
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(header))
+	return h.Sum(nil), nil
+}
+
+func ageStreamKey(fileKey, nonce []byte) (cipher.AEAD, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, fileKey, nonce, []byte("payload")), key)
+	if err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+// ageNonce gets the nonce for the chunk: an 11-byte big-endian counter and a
+// flag for the last chunk.
+func ageNonce(counter uint64, last bool) []byte {
+	n := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(n[3:11], counter)
+	if last {
+		n[11] = 1
+	}
+	return n
+}
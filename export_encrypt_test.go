@@ -0,0 +1,135 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestExportEncrypt(t *testing.T) {
+	defer func(f int) { goatcounter.ExportScryptWorkFactor = f }(goatcounter.ExportScryptWorkFactor)
+	goatcounter.ExportScryptWorkFactor = 10
+
+	// Around the chunk size of 64K, as the last chunk is marked.
+	for _, n := range []int{0, 1, 64*1024 - 1, 64 * 1024, 64*1024 + 1, 200 * 1024} {
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			in := make([]byte, n)
+			_, err := rand.Read(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var enc bytes.Buffer
+			err = goatcounter.ExportEncrypt(&enc, bytes.NewReader(in), "correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(enc.String(), "age-encryption.org/v1\n-> scrypt ") {
+				t.Errorf("wrong header: %q", enc.String()[:40])
+			}
+
+			var dec bytes.Buffer
+			err = goatcounter.ExportDecrypt(&dec, bytes.NewReader(enc.Bytes()), "correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(dec.Bytes(), in) {
+				t.Error("decrypted data is different")
+			}
+
+			err = goatcounter.ExportDecrypt(ioutil.Discard, bytes.NewReader(enc.Bytes()), "wrong")
+			if err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+				t.Errorf("wrong error for wrong passphrase: %v", err)
+			}
+
+			b := enc.Bytes()
+			err = goatcounter.ExportDecrypt(ioutil.Discard, bytes.NewReader(b[:len(b)-1]), "correct horse")
+			if err == nil {
+				t.Error("no error for truncated file")
+			}
+		})
+	}
+}
+
+func TestExportEncrypted(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	defer func(f int) { goatcounter.ExportScryptWorkFactor = f }(goatcounter.ExportScryptWorkFactor)
+	goatcounter.ExportScryptWorkFactor = 10
+
+	gctest.StoreHits(ctx, t, false, goatcounter.Hit{Path: "/a"}, goatcounter.Hit{Path: "/b"})
+
+	short := goatcounter.Export{Passphrase: "short"}
+	_, err := short.Create(ctx, 0)
+	if err == nil {
+		t.Error("no error for short passphrase")
+	}
+
+	export := goatcounter.Export{Passphrase: "correct horse"}
+	fp, err := export.Create(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = export.Run(ctx, fp, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+	if !strings.HasSuffix(export.Path, ".csv.gz.age") || !export.Encrypted {
+		t.Errorf("wrong path or not encrypted: %q %t", export.Path, export.Encrypted)
+	}
+	if _, err := os.Stat(filepath.Join(goatcounter.ExportDir(),
+		strings.TrimSuffix(export.Path, ".age"))); !os.IsNotExist(err) {
+		t.Errorf("unencrypted file not removed: %v", err)
+	}
+	if strings.Contains(fmt.Sprintf("%v %+v %#v", export, export, export), "correct horse") {
+		t.Error("passphrase in formatted output")
+	}
+
+	var got goatcounter.Export
+	err = got.ByID(ctx, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Path != export.Path || !got.Encrypted || got.Passphrase != "" {
+		t.Errorf("wrong export: %q %t %q", got.Path, got.Encrypted, got.Passphrase)
+	}
+
+	rd, err := got.Open(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rd.Close()
+
+	var dec bytes.Buffer
+	err = goatcounter.ExportDecrypt(&dec, rd, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gz, err := gzip.NewReader(&dec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csv, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(csv), "/a") || !strings.Contains(string(csv), "/b") {
+		t.Errorf("wrong CSV:\n%s", csv)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"zgo.at/errors"
+)
+
+// ExportManifestSuffix is added to the export filename for the manifest.
+const ExportManifestSuffix = ".manifest.json"
+
+// ExportManifest describes a finished export file, so that a downloaded file
+// can be verified with Verify() before removing data from the source.
+//
+// The manifest is written next to the export file when the export is finished.
+type ExportManifest struct {
+	ExportID  int64      `json:"export_id"`
+	Site      string     `json:"site"`       // Site code.
+	Kind      string     `json:"kind"`       // "hits" or "stats".
+	Format    string     `json:"format"`     // "csv" or "json".
+	Version   string     `json:"version"`    // ExportVersion or ExportStatsVersion.
+	File      string     `json:"file"`       // Filename of the export.
+	Size      int64      `json:"size"`       // File size in bytes.
+	SHA256    string     `json:"sha256"`     // Hex-encoded SHA256 of the file.
+	Rows      int        `json:"rows"`       // Number of exported rows.
+	Encrypted bool       `json:"encrypted"`  // Encrypted with a passphrase.
+	StartDate *time.Time `json:"start_date"` // Only pageviews on or after this; may be null.
+	EndDate   *time.Time `json:"end_date"`   // Only pageviews before this; may be null.
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ManifestPath gets the filename of the manifest.
+func (e Export) ManifestPath() string { return e.Path + ExportManifestSuffix }
+
+// writeManifest writes the manifest for the finished export file in
+// ExportDir().
+func (e Export) writeManifest(ctx context.Context) error {
+	size, hash, err := hashExportFile(e.localPath())
+	if err != nil {
+		return err
+	}
+
+	m := ExportManifest{
+		ExportID:  e.ID,
+		Site:      MustGetSite(ctx).Code,
+		Kind:      e.Kind,
+		Format:    e.Format,
+		Version:   ExportVersion,
+		File:      filepath.Base(e.Path),
+		Size:      size,
+		SHA256:    hash,
+		Encrypted: bool(e.Encrypted),
+		StartDate: e.StartDate,
+		EndDate:   e.EndDate,
+		CreatedAt: e.CreatedAt,
+	}
+	if m.Kind == "" {
+		m.Kind = ExportHits
+	}
+	if m.Kind == ExportStats {
+		m.Version = ExportStatsVersion
+	} else {
+		m.Format = ExportCSV
+	}
+	if e.NumRows != nil {
+		m.Rows = *e.NumRows
+	}
+
+	j, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(localExportPath(e.ManifestPath()), append(j, '\n'), 0600)
+}
+
+// OpenManifest reads the manifest of a finished export from the ExportStorage.
+//
+// The error is os.ErrNotExist if there is no manifest; exports created before
+// manifests were added don't have one.
+func (e Export) OpenManifest(ctx context.Context) (ExportManifest, error) {
+	st, err := storage()
+	if err != nil {
+		return ExportManifest{}, errors.Errorf("Export.OpenManifest: %w", err)
+	}
+	fp, err := st.Open(ctx, e.ManifestPath())
+	if err != nil {
+		return ExportManifest{}, errors.Errorf("Export.OpenManifest: %w", err)
+	}
+	defer fp.Close()
+
+	m, err := ReadExportManifest(fp)
+	if err != nil {
+		return m, errors.Errorf("Export.OpenManifest: %w", err)
+	}
+	return m, nil
+}
+
+// Verify that the export file at path matches the manifest from the
+// ExportStorage.
+func (e Export) Verify(ctx context.Context, path string) error {
+	m, err := e.OpenManifest(ctx)
+	if err != nil {
+		return err
+	}
+	return m.Verify(path)
+}
+
+// ReadExportManifest reads a manifest written by an export.
+func ReadExportManifest(r io.Reader) (ExportManifest, error) {
+	var m ExportManifest
+	err := json.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return m, errors.Errorf("ReadExportManifest: %w", err)
+	}
+	if m.SHA256 == "" || m.File == "" {
+		return m, errors.New("ReadExportManifest: not an export manifest")
+	}
+	return m, nil
+}
+
+// Verify that the export file at path matches the size and hash in the
+// manifest. The number of rows is also checked for unencrypted exports of
+// pageviews.
+func (m ExportManifest) Verify(path string) error {
+	size, hash, err := hashExportFile(path)
+	if err != nil {
+		return errors.Errorf("ExportManifest.Verify: %w", err)
+	}
+	if size != m.Size {
+		return errors.Errorf("ExportManifest.Verify: wrong size: %d bytes (want: %d)", size, m.Size)
+	}
+	if hash != m.SHA256 {
+		return errors.Errorf("ExportManifest.Verify: wrong sha256: %s (want: %s)", hash, m.SHA256)
+	}
+
+	if m.Kind != ExportHits || m.Encrypted {
+		return nil
+	}
+	rows, err := countExportRows(path)
+	if err != nil {
+		return errors.Errorf("ExportManifest.Verify: %w", err)
+	}
+	if rows != m.Rows {
+		return errors.Errorf("ExportManifest.Verify: wrong number of rows: %d (want: %d)", rows, m.Rows)
+	}
+	return nil
+}
+
+// hashExportFile gets the size and hex-encoded SHA256 of the file.
+func hashExportFile(path string) (int64, string, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer fp.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, fp)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// countExportRows counts the number of rows in a CSV export, excluding the
+// header.
+func countExportRows(path string) (int, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer fp.Close()
+
+	gzfp, err := gzip.NewReader(fp)
+	if err != nil {
+		return 0, err
+	}
+	defer gzfp.Close()
+
+	c := csv.NewReader(gzfp)
+	header, err := c.Read()
+	if err != nil {
+		return 0, err
+	}
+	_, err = NewExportDecoder(header)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for {
+		_, err := c.Read()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, errors.Errorf("row %d: %w", n+1, err)
+		}
+		n++
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// ExportStatsVersion is the current version of the statistics export format.
+const ExportStatsVersion = "2"
+
+// ExportStatsTables are the tables in the export of the aggregated statistics,
+// with the columns in the order they're exported.
+var ExportStatsTables = []struct {
+	Table   string
+	Columns []string
+}{
+	{"hit_counts", []string{"hour", "path", "host", "title", "event", "total", "total_unique"}},
+	{"ref_counts", []string{"hour", "path", "host", "ref", "ref_scheme", "ref_category", "total", "total_unique"}},
+	{"hit_stats", []string{"day", "path", "title", "stats", "stats_unique"}},
+	{"browser_stats", []string{"day", "browser", "version", "count", "count_unique"}},
+	{"system_stats", []string{"day", "system", "version", "count", "count_unique"}},
+	{"location_stats", []string{"day", "location", "region", "city", "count", "count_unique"}},
+	{"size_stats", []string{"day", "width", "device_class", "count", "count_unique"}},
+	{"host_stats", []string{"day", "host", "count", "count_unique"}},
+	{"campaign_stats", []string{"day", "source", "medium", "campaign", "count", "count_unique"}},
+	{"scroll_stats", []string{"day", "path", "count", "total", "reached_25", "reached_50", "reached_75", "reached_100"}},
+	{"sessions_stats", []string{"day", "pageviews", "duration", "entry_path", "exit_path"}},
+}
+
+// statsWriter writes the tables for an export of the statistics.
+type statsWriter interface {
+	table(name string, columns []string) error
+	row(values []interface{}) error
+	close() error
+}
+
+// runStats exports the aggregated statistics.
+//
+// The CSV format is a zip file with a CSV file for every table, the JSON format
+// is a single gzip-compressed JSON document.
+func (e *Export) runStats(ctx context.Context, fp *os.File, mailUser bool) error {
+	l := zlog.Module("export").Field("id", e.ID)
+	defer fp.Close() // No need to error-check; just for safety.
+	l.Print("stats export started")
+
+	var z int
+	e.NumRows = &z
+
+	var w statsWriter
+	if e.Format == ExportJSON {
+		var err error
+		w, err = newJSONStatsWriter(fp, MustGetSite(ctx).Code)
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, err)
+		}
+	} else {
+		w = &csvStatsWriter{zip: zip.NewWriter(fp)}
+	}
+
+	for _, t := range ExportStatsTables {
+		err := e.exportTable(ctx, w, t.Table, t.Columns)
+		if err != nil {
+			return e.fail(ctx, l, fp, -1, errors.Errorf("%s: %w", t.Table, err))
+		}
+	}
+
+	err := w.close()
+	if err != nil {
+		return e.fail(ctx, l, fp, -1, err)
+	}
+	return e.finish(ctx, l, fp, mailUser)
+}
+
+func (e *Export) exportTable(ctx context.Context, w statsWriter, table string, columns []string) error {
+	err := w.table(table, columns)
+	if err != nil {
+		return err
+	}
+
+	rows, err := zdb.MustGet(ctx).QueryxContext(ctx, fmt.Sprintf(
+		`/* Export.exportTable */ select %[1]s from %[2]s where site=$1 order by %[1]s`,
+		strings.Join(columns, ", "), table), e.SiteID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		values, err := rows.SliceScan()
+		if err != nil {
+			return err
+		}
+		for i := range values {
+			values[i] = exportStatsValue(columns[i], values[i])
+		}
+
+		err = w.row(values)
+		if err != nil {
+			return err
+		}
+
+		*e.NumRows++
+		JobProgress(ctx, *e.NumRows, 0)
+		err = JobPace(ctx, *e.NumRows)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// exportStatsValue converts a database value to the value that's exported.
+func exportStatsValue(column string, v interface{}) interface{} {
+	switch vv := v.(type) {
+	case []byte:
+		return string(vv)
+	case time.Time:
+		if column == "day" {
+			return vv.Format("2006-01-02")
+		}
+		return vv.UTC().Format(time.RFC3339)
+	default:
+		return v
+	}
+}
+
+type csvStatsWriter struct {
+	zip     *zip.Writer
+	csv     *csv.Writer
+	columns []string
+	rec     []string
+}
+
+func (w *csvStatsWriter) table(name string, columns []string) error {
+	err := w.flush()
+	if err != nil {
+		return err
+	}
+
+	f, err := w.zip.Create(name + ".csv")
+	if err != nil {
+		return err
+	}
+	w.csv, w.columns = csv.NewWriter(f), columns
+	w.rec = make([]string, len(columns))
+
+	// The version is prefixed to the header, like for the export of
+	// pageviews.
+	header := append([]string{ExportStatsVersion + columns[0]}, columns[1:]...)
+	return w.csv.Write(header)
+}
+
+func (w *csvStatsWriter) row(values []interface{}) error {
+	for i, v := range values {
+		if v == nil {
+			w.rec[i] = ""
+		} else {
+			w.rec[i] = fmt.Sprint(v)
+		}
+	}
+	return w.csv.Write(w.rec)
+}
+
+func (w *csvStatsWriter) flush() error {
+	if w.csv == nil {
+		return nil
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *csvStatsWriter) close() error {
+	err := w.flush()
+	if err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// jsonStatsWriter writes a JSON document in the form of:
+//
+//	{"version": "2", "site": "code", "tables": {"hit_counts": [{"hour": .., }]}}
+//
+// The document is written as it goes, rather than creating it in memory.
+type jsonStatsWriter struct {
+	gz      *gzip.Writer
+	buf     bytes.Buffer
+	columns []string
+	ntables int
+	nrows   int
+}
+
+func newJSONStatsWriter(fp io.Writer, siteCode string) (*jsonStatsWriter, error) {
+	w := &jsonStatsWriter{gz: gzip.NewWriter(fp)}
+	code, err := json.Marshal(siteCode)
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(w.gz, `{"version":%q,"site":%s,"tables":{`, ExportStatsVersion, code)
+	return w, err
+}
+
+func (w *jsonStatsWriter) table(name string, columns []string) error {
+	w.columns, w.nrows = columns, 0
+
+	start := `%q:[`
+	if w.ntables > 0 {
+		start = `],` + start
+	}
+	w.ntables++
+	_, err := fmt.Fprintf(w.gz, start, name)
+	return err
+}
+
+func (w *jsonStatsWriter) row(values []interface{}) error {
+	w.buf.Reset()
+	if w.nrows > 0 {
+		w.buf.WriteByte(',')
+	}
+	w.nrows++
+
+	w.buf.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		fmt.Fprintf(&w.buf, "%q:", w.columns[i])
+
+		// The stats for hit_stats are stored as a JSON array.
+		if s, ok := v.(string); ok && strings.HasPrefix(w.columns[i], "stats") && json.Valid([]byte(s)) {
+			w.buf.WriteString(s)
+			continue
+		}
+
+		j, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		w.buf.Write(j)
+	}
+	w.buf.WriteByte('}')
+
+	_, err := w.gz.Write(w.buf.Bytes())
+	return err
+}
+
+func (w *jsonStatsWriter) close() error {
+	end := "}}"
+	if w.ntables > 0 {
+		end = "]" + end
+	}
+	_, err := io.WriteString(w.gz, end)
+	if err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
@@ -0,0 +1,162 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestExportStats(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	d1 := time.Date(2019, 6, 18, 14, 0, 0, 0, time.UTC)
+	d2 := time.Date(2019, 6, 19, 15, 0, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/asd", CreatedAt: d1},
+		{Path: "/zxc", CreatedAt: d1},
+		{Path: "/asd", CreatedAt: d2},
+	}...)
+
+	run := func(t *testing.T, format string) goatcounter.Export {
+		var export goatcounter.Export
+		fp, err := export.CreateStats(ctx, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer fp.Close()
+
+		err = export.Run(ctx, fp, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if export.NumRows == nil || *export.NumRows == 0 {
+			t.Fatalf("NumRows: %v", export.NumRows)
+		}
+		return export
+	}
+
+	t.Run("json", func(t *testing.T) {
+		export := run(t, goatcounter.ExportJSON)
+		path := filepath.Join(goatcounter.ExportDir(), export.Path)
+		defer os.Remove(path)
+		if !strings.HasSuffix(export.Path, ".json.gz") {
+			t.Errorf("wrong path: %q", export.Path)
+		}
+
+		fp, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer fp.Close()
+		gzfp, err := gzip.NewReader(fp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got struct {
+			Version string                              `json:"version"`
+			Site    string                              `json:"site"`
+			Tables  map[string][]map[string]interface{} `json:"tables"`
+		}
+		err = json.NewDecoder(gzfp).Decode(&got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Version != goatcounter.ExportStatsVersion || got.Site != "gctest" {
+			t.Errorf("version %q, site %q", got.Version, got.Site)
+		}
+		if len(got.Tables) != len(goatcounter.ExportStatsTables) {
+			t.Errorf("len(tables) = %d", len(got.Tables))
+		}
+
+		hc := got.Tables["hit_counts"]
+		if len(hc) != 3 {
+			t.Fatalf("hit_counts: %v", hc)
+		}
+		if hc[0]["hour"] != "2019-06-18T14:00:00Z" || hc[0]["path"] != "/asd" {
+			t.Errorf("hit_counts[0]: %v", hc[0])
+		}
+
+		hs := got.Tables["hit_stats"]
+		if len(hs) != 3 {
+			t.Fatalf("hit_stats: %v", hs)
+		}
+		if hs[0]["day"] != "2019-06-18" {
+			t.Errorf("hit_stats[0]: %v", hs[0])
+		}
+		if s, ok := hs[0]["stats"].([]interface{}); !ok || len(s) != 24 {
+			t.Errorf("hit_stats[0].stats: %#v", hs[0]["stats"])
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		export := run(t, goatcounter.ExportCSV)
+		path := filepath.Join(goatcounter.ExportDir(), export.Path)
+		defer os.Remove(path)
+		if export.ContentType() != "application/zip" {
+			t.Errorf("wrong content type: %q", export.ContentType())
+		}
+
+		z, err := zip.OpenReader(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer z.Close()
+
+		if len(z.File) != len(goatcounter.ExportStatsTables) {
+			t.Fatalf("len(files) = %d", len(z.File))
+		}
+		if z.File[0].Name != "hit_counts.csv" {
+			t.Fatalf("wrong name: %q", z.File[0].Name)
+		}
+
+		fp, err := z.File[0].Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer fp.Close()
+		rows, err := csv.NewReader(fp).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]string, 0, len(rows))
+		for _, r := range rows {
+			got = append(got, strings.Join(r, ","))
+		}
+		want := []string{
+			"2hour,path,host,title,event,total,total_unique",
+			"2019-06-18T14:00:00Z,/asd,,,0,1,0",
+			"2019-06-18T14:00:00Z,/zxc,,,0,1,0",
+			"2019-06-19T15:00:00Z,/asd,,,0,1,0",
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("\ngot:\n%s\n\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		export := run(t, goatcounter.ExportJSON)
+		defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+		_, err := export.Resume(ctx)
+		if err == nil {
+			t.Error("no error")
+		}
+	})
+}
@@ -0,0 +1,454 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+)
+
+// ExportStorage stores finished export files.
+//
+// Exports are always written to a file in ExportDir() first, and copied to the
+// storage when they're finished. The key is the filename, without any
+// directory.
+type ExportStorage interface {
+	// Put the file in the storage; the file must exist in ExportDir().
+	Put(ctx context.Context, key string) error
+
+	// Open a file for reading; the error is os.ErrNotExist if the file
+	// doesn't exist.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete a file; this is not an error if the file doesn't exist.
+	Delete(ctx context.Context, key string) error
+
+	// List all files with the given prefix.
+	List(ctx context.Context, prefix string) ([]StoredFile, error)
+}
+
+// StoredFile is a file in the ExportStorage.
+type StoredFile struct {
+	Key     string
+	ModTime time.Time
+}
+
+// NewExportStorage creates a new ExportStorage from the -export-storage flag.
+//
+// This can be:
+//
+//	""                    Store files in ExportDir(); this is the default.
+//	dir:///path           Store files in a local directory.
+//	s3://bucket/prefix    Amazon S3, or any S3-compatible storage.
+//	gs://bucket/prefix    Google Cloud Storage.
+//
+// Parameters for S3 can be added as a query string:
+//
+//	region     Region; default is us-east-1.
+//	endpoint   Endpoint URL for S3-compatible storage, e.g.
+//	           "https://minio.example.com"; the bucket is added to the path
+//	           rather than the hostname if this is set.
+//
+// The credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY,
+// and (optionally) AWS_SESSION_TOKEN environment variables. Google Cloud
+// Storage uses the S3-compatible API, which needs a HMAC key for a service
+// account as credentials.
+func NewExportStorage(storage string) (ExportStorage, error) {
+	if storage == "" {
+		return dirStorage(ExportDir()), nil
+	}
+
+	u, err := url.Parse(storage)
+	if err != nil {
+		return nil, errors.Errorf("NewExportStorage: %w", err)
+	}
+
+	switch u.Scheme {
+	default:
+		return nil, errors.Errorf("NewExportStorage: unknown scheme %q", u.Scheme)
+	case "dir":
+		if u.Path == "" {
+			return nil, errors.New("NewExportStorage: no directory")
+		}
+		st, err := os.Stat(u.Path)
+		if err != nil {
+			return nil, errors.Errorf("NewExportStorage: %w", err)
+		}
+		if !st.IsDir() {
+			return nil, errors.Errorf("NewExportStorage: not a directory: %q", u.Path)
+		}
+		return dirStorage(u.Path), nil
+	case "s3", "gs":
+		if u.Host == "" {
+			return nil, errors.New("NewExportStorage: no bucket")
+		}
+
+		s := &s3Storage{
+			bucket:    u.Host,
+			prefix:    strings.Trim(u.Path, "/"),
+			region:    u.Query().Get("region"),
+			accessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+			secretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			token:     os.Getenv("AWS_SESSION_TOKEN"),
+		}
+		if s.prefix != "" {
+			s.prefix += "/"
+		}
+		if s.region == "" {
+			s.region = "us-east-1"
+			if u.Scheme == "gs" {
+				s.region = "auto"
+			}
+		}
+		if s.accessKey == "" || s.secretKey == "" {
+			return nil, errors.New("NewExportStorage: AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+		}
+
+		switch {
+		case u.Query().Get("endpoint") != "":
+			s.endpoint, err = url.Parse(u.Query().Get("endpoint"))
+			if err != nil {
+				return nil, errors.Errorf("NewExportStorage: endpoint: %w", err)
+			}
+			if s.endpoint.Scheme != "http" && s.endpoint.Scheme != "https" {
+				return nil, errors.Errorf("NewExportStorage: endpoint: invalid URL: %q", u.Query().Get("endpoint"))
+			}
+			s.pathStyle = true
+		case u.Scheme == "gs":
+			s.endpoint, s.pathStyle = &url.URL{Scheme: "https", Host: "storage.googleapis.com"}, true
+		default:
+			s.endpoint = &url.URL{Scheme: "https", Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", s.bucket, s.region)}
+		}
+		return s, nil
+	}
+}
+
+// storage gets the ExportStorage from the -export-storage flag.
+func storage() (ExportStorage, error) {
+	return NewExportStorage(cfg.ExportStorage)
+}
+
+// dirStorage stores exports in a local directory.
+type dirStorage string
+
+func (d dirStorage) path(key string) string {
+	// Exports created before -export-storage was added stored the full path.
+	if filepath.IsAbs(key) {
+		return key
+	}
+	return filepath.Join(string(d), filepath.Base(key))
+}
+
+func (d dirStorage) Put(ctx context.Context, key string) error {
+	src, dst := localExportPath(key), d.path(key)
+	if src == dst {
+		return nil
+	}
+
+	err := os.Rename(src, dst)
+	if err == nil {
+		return nil
+	}
+
+	// Rename doesn't work across filesystems.
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		return err
+	}
+	err = out.Close()
+	if err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+func (d dirStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(d.path(key))
+}
+
+func (d dirStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(d.path(key))
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (d dirStorage) List(ctx context.Context, prefix string) ([]StoredFile, error) {
+	fp, err := os.Open(string(d))
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	files, err := fp.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]StoredFile, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), prefix) {
+			continue
+		}
+		list = append(list, StoredFile{Key: f.Name(), ModTime: f.ModTime()})
+	}
+	return list, nil
+}
+
+var s3Client = http.Client{Timeout: 30 * time.Minute}
+
+// s3Storage stores exports in S3, or a storage service with a S3-compatible
+// API.
+type s3Storage struct {
+	endpoint  *url.URL
+	pathStyle bool
+	bucket    string
+	prefix    string
+	region    string
+	accessKey string
+	secretKey string
+	token     string
+}
+
+func (s *s3Storage) url(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	p := "/"
+	if s.pathStyle {
+		p += s.bucket + "/"
+	}
+	if key != "" {
+		p += s.prefix + key
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + p
+	u.RawPath = s3Escape(u.Path, true)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	return &u
+}
+
+func (s *s3Storage) do(ctx context.Context, method string, u *url.URL, body io.ReadSeeker, payloadHash string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		size, err := body.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, err
+		}
+		_, err = body.Seek(0, io.SeekStart)
+		if err != nil {
+			return nil, err
+		}
+		req.Body, req.ContentLength = ioutil.NopCloser(body), size
+	}
+	s.sign(req, payloadHash, time.Now().UTC())
+
+	resp, err := s3Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound && method == "GET" {
+		resp.Body.Close()
+		return nil, os.ErrNotExist
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 2048))
+		return nil, errors.Errorf("%s %s: %s: %s", method, u.Path, resp.Status, strings.TrimSpace(string(b)))
+	}
+	return resp, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string) error {
+	path := localExportPath(key)
+	fp, err := os.Open(path)
+	if err != nil {
+		return errors.Errorf("s3Storage.Put: %w", err)
+	}
+	defer fp.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, fp)
+	if err != nil {
+		return errors.Errorf("s3Storage.Put: %w", err)
+	}
+
+	resp, err := s.do(ctx, "PUT", s.url(key, nil), fp, hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		return errors.Errorf("s3Storage.Put: %w", err)
+	}
+	resp.Body.Close()
+
+	// The file is only kept in the storage.
+	fp.Close()
+	return os.Remove(path)
+}
+
+func (s *s3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, "GET", s.url(key, nil), nil, emptyHash)
+	if err != nil {
+		return nil, errors.Errorf("s3Storage.Open: %w", err)
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, "DELETE", s.url(key, nil), nil, emptyHash)
+	if err != nil {
+		return errors.Errorf("s3Storage.Delete: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]StoredFile, error) {
+	var (
+		list  []StoredFile
+		query = url.Values{"list-type": {"2"}, "prefix": {s.prefix + prefix}}
+	)
+	for {
+		resp, err := s.do(ctx, "GET", s.url("", query), nil, emptyHash)
+		if err != nil {
+			return nil, errors.Errorf("s3Storage.List: %w", err)
+		}
+
+		var result struct {
+			Contents []struct {
+				Key          string
+				LastModified time.Time
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Errorf("s3Storage.List: %w", err)
+		}
+
+		for _, c := range result.Contents {
+			list = append(list, StoredFile{
+				Key:     strings.TrimPrefix(c.Key, s.prefix),
+				ModTime: c.LastModified,
+			})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return list, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+// SHA256 of an empty payload.
+const emptyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// sign the request with AWS signature version 4.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *s3Storage) sign(req *http.Request, payloadHash string, now time.Time) {
+	var (
+		amzDate = now.Format("20060102T150405Z")
+		day     = now.Format("20060102")
+		scope   = day + "/" + s.region + "/s3/aws4_request"
+	)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.token != "" {
+		req.Header.Set("X-Amz-Security-Token", s.token)
+	}
+
+	// Sign all headers that are set, as well as the Host header (which is
+	// sent from req.Host, rather than req.Header).
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonHeaders strings.Builder
+	for _, k := range names {
+		canonHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signed := strings.Join(names, ";")
+
+	// url.Values.Encode() sorts by key, but escapes spaces as "+".
+	query := strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20")
+
+	canonical := strings.Join([]string{
+		req.Method,
+		s3Escape(req.URL.Path, true),
+		query,
+		canonHeaders.String(),
+		signed,
+		payloadHash,
+	}, "\n")
+
+	hash := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := []byte("AWS4" + s.secretKey)
+	for _, p := range []string{day, s.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, p)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signed, hex.EncodeToString(hmacSHA256(key, toSign))))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3Escape escapes everything except the unreserved characters from RFC 3986,
+// and optionally "/".
+func s3Escape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (keepSlash && c == '/') {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
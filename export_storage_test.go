@@ -0,0 +1,186 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+)
+
+// fakeS3 is a minimal S3-compatible server for the requests that are used.
+func fakeS3(t *testing.T) *httptest.Server {
+	var (
+		mu    sync.Mutex
+		files = make(map[string]string)
+	)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			t.Errorf("wrong Authorization header: %q", r.Header.Get("Authorization"))
+		}
+		if !strings.HasPrefix(r.URL.Path, "/bucket/") {
+			w.WriteHeader(404)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/bucket/")
+
+		switch {
+		case r.Method == "GET" && key == "":
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult>`)
+			for k := range files {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					fmt.Fprintf(w, `<Contents><Key>%s</Key><LastModified>2020-06-15T10:00:00.000Z</LastModified></Contents>`, k)
+				}
+			}
+			fmt.Fprint(w, `<IsTruncated>false</IsTruncated></ListBucketResult>`)
+		case r.Method == "GET":
+			f, ok := files[key]
+			if !ok {
+				w.WriteHeader(404)
+				return
+			}
+			fmt.Fprint(w, f)
+		case r.Method == "PUT":
+			b, _ := ioutil.ReadAll(r.Body)
+			files[key] = string(b)
+		case r.Method == "DELETE":
+			delete(files, key)
+			w.WriteHeader(204)
+		}
+	}))
+}
+
+func TestExportStorage(t *testing.T) {
+	ctx := context.Background()
+
+	s3 := fakeS3(t)
+	defer s3.Close()
+
+	os.Setenv("AWS_ACCESS_KEY_ID", "key")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	defer os.Unsetenv("AWS_ACCESS_KEY_ID")
+	defer os.Unsetenv("AWS_SECRET_ACCESS_KEY")
+
+	dir, err := ioutil.TempDir("", "goatcounter-storage-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []string{
+		"",
+		"dir://" + dir,
+		"s3://bucket/exports?endpoint=" + s3.URL,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			st, err := goatcounter.NewExportStorage(tt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			key := fmt.Sprintf("goatcounter-export-test-%d.csv.gz", time.Now().UnixNano())
+			err = ioutil.WriteFile(filepath.Join(goatcounter.ExportDir(), key), []byte("data"), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(filepath.Join(goatcounter.ExportDir(), key))
+
+			err = st.Put(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fp, err := st.Open(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := ioutil.ReadAll(fp)
+			fp.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != "data" {
+				t.Errorf("wrong data: %q", b)
+			}
+
+			list, err := st.List(ctx, "goatcounter-export-test-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found bool
+			for _, f := range list {
+				found = found || f.Key == key
+			}
+			if !found {
+				t.Errorf("%q not in list: %v", key, list)
+			}
+
+			err = st.Delete(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = st.Open(ctx, key)
+			if !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("wrong error after delete: %v", err)
+			}
+		})
+	}
+
+	// Exports created before -export-dir stored the full path.
+	t.Run("absolute path", func(t *testing.T) {
+		st, err := goatcounter.NewExportStorage("")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		key := filepath.Join(dir, "goatcounter-export-legacy.csv.gz")
+		err = ioutil.WriteFile(key, []byte("data"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = st.Put(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fp, err := st.Open(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(fp)
+		fp.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "data" {
+			t.Errorf("wrong data: %q", b)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"ftp://x", "dir://", "s3://", "s3://bucket?endpoint=x"} {
+			_, err := goatcounter.NewExportStorage(s)
+			if err == nil {
+				t.Errorf("no error for %q", s)
+			}
+		}
+	})
+}
@@ -5,8 +5,15 @@
 package goatcounter_test
 
 import (
+	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +21,7 @@ import (
 	"zgo.at/blackmail"
 	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
 	"zgo.at/zhttp/ztpl"
 	"zgo.at/zstd/zjson"
 	"zgo.at/zstd/ztest"
@@ -36,7 +44,8 @@ func TestExport(t *testing.T) {
 	var export goatcounter.Export
 	defer func() {
 		if export.Path != "" {
-			os.Remove(export.Path)
+			os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+			os.Remove(filepath.Join(goatcounter.ExportDir(), export.ManifestPath()))
 		}
 	}()
 	t.Run("export", func(t *testing.T) {
@@ -48,18 +57,50 @@ func TestExport(t *testing.T) {
 
 		export.Run(ctx, fp, false)
 
+		// The export is written as one gzip member for the header and one for
+		// every batch of pageviews, so hash the file rather than hard-coding
+		// it; the header is checked below.
+		data, err := ioutil.ReadFile(filepath.Join(goatcounter.ExportDir(), export.Path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := fmt.Sprintf("sha256-%x", sha256.Sum256(data))
+
+		gzfp, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		header, err := csv.NewReader(gzfp).Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h := strings.Join(header, ","); h != "3Path,Title,Event,Bot,Session,FirstVisit,Referrer,Referrer scheme,Browser,Screen size,Location,Date,ID" {
+			t.Errorf("wrong header: %s", h)
+		}
+
 		want := strings.ReplaceAll(`{
 			"id": 1,
 			"site_id": 1,
+			"kind": "hits",
+			"format": "csv",
 			"start_from_hit_id": 0,
+			"start_date": null,
+			"end_date": null,
+			"path_like": null,
+			"paths": null,
+			"scheduled": false,
+			"encrypted": false,
 			"last_hit_id": 3,
-			"path": "%(ANY)goatcounter-export-gctest-%(YEAR)%(MONTH)%(DAY)T%(ANY)Z-0.csv.gz",
+			"max_hit_id": 3,
+			"path": "goatcounter-export-gctest-%(YEAR)%(MONTH)%(DAY)T%(ANY)Z-0-1.csv.gz",
 			"created_at": "%(YEAR)-%(MONTH)-%(DAY)T%(ANY)Z",
 			"finished_at": null,
 			"num_rows": 3,
+			"progress": 100,
 			"size": "0.0",
-			"hash": "sha256-5953e790362889927b4d437e8153d763256c6f4f74553e657d29894e1ac275fb",
-			"error": null
+			"hash": "`+hash+`",
+			"error": null,
+			"expired": false
 		}`, "\t", "")
 		got := string(zjson.MustMarshalIndent(export, "", ""))
 		if d := ztest.DiffMatch(got, want); d != "" {
@@ -67,7 +108,7 @@ func TestExport(t *testing.T) {
 		}
 
 		var exports goatcounter.Exports
-		err = exports.List(ctx)
+		err = exports.List(ctx, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -77,7 +118,7 @@ func TestExport(t *testing.T) {
 	})
 
 	t.Run("import", func(t *testing.T) {
-		fp, err := os.Open(export.Path)
+		fp, err := export.Open(ctx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -89,7 +130,7 @@ func TestExport(t *testing.T) {
 		}
 		defer gzfp.Close()
 
-		goatcounter.Import(ctx, gzfp, false, false)
+		goatcounter.Import(ctx, gzfp, goatcounter.ImportAdd, goatcounter.ImportTransform{}, false, nil)
 
 		_, err = goatcounter.Memstore.Persist(ctx)
 		if err != nil {
@@ -106,3 +147,748 @@ func TestExport(t *testing.T) {
 		}
 	})
 }
+
+func TestExportRowRead(t *testing.T) {
+	v1 := []string{"/a", "A", "false", "0", "1", "true", "", "", "Firefox", "", "NL", "2020-06-18T12:00:00Z"}
+	v2 := append(append([]string{}, v1...), "42")
+
+	tests := []struct {
+		version string
+		in      []string
+		wantErr string
+		wantID  string
+	}{
+		{"1", v1, "", ""},
+		{"2", v2, "", "42"},
+		{"1", v2, "wrong number of fields: 13 (want: 12)", ""},
+		{"2", v1, "wrong number of fields: 12 (want: 13)", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			var row goatcounter.ExportRow
+			err := row.Read(tt.version, tt.in)
+			if fmt.Sprintf("%v", err) != tt.wantErr && !(err == nil && tt.wantErr == "") {
+				t.Fatalf("wrong error: %v", err)
+			}
+			if row.ID != tt.wantID {
+				t.Errorf("ID: %q", row.ID)
+			}
+		})
+	}
+}
+
+func TestExportDecoder(t *testing.T) {
+	tests := []struct {
+		header, line []string
+		want         string
+		wantErr      string
+	}{
+		{goatcounter.ExportHeader(), goatcounter.NewExportRow(goatcounter.Hit{
+			ID: 42, Path: "/a", CreatedAt: time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC),
+		}).Values(), "/a 2020-06-18T12:00:00Z 42", ""},
+
+		// Reordered and unknown columns.
+		{[]string{"3Date", "New", "ID", "Path"},
+			[]string{"2020-06-18T12:00:00Z", "x", "42", "/a"},
+			"/a 2020-06-18T12:00:00Z 42", ""},
+		{[]string{"3Date", "ID"}, nil, "", `missing column in CSV header: "Path"`},
+		{[]string{"3Date", "Path"}, []string{"2020-06-18T12:00:00Z"}, "",
+			"wrong number of fields: 1 (want: 2)"},
+
+		// By position.
+		{[]string{"2Foo", "Bar"},
+			[]string{"/a", "A", "false", "0", "1", "true", "", "", "Firefox", "", "NL", "2020-06-18T12:00:00Z", "42"},
+			"/a 2020-06-18T12:00:00Z 42", ""},
+		{[]string{"4Path", "Date"}, nil, "", "wrong version of CSV database: 4 (expected: 3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.header, ","), func(t *testing.T) {
+			dec, err := goatcounter.NewExportDecoder(tt.header)
+			if err == nil {
+				var row goatcounter.ExportRow
+				row, err = dec.Decode(tt.line)
+				if err == nil {
+					got := fmt.Sprintf("%s %s %s", row.Path, row.CreatedAt, row.ID)
+					if got != tt.want {
+						t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+					}
+				}
+			}
+			if (err == nil) != (tt.wantErr == "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("wrong error: %v", err)
+			}
+		})
+	}
+}
+
+func TestImportReport(t *testing.T) {
+	const ff = "Mozilla/5.0 (X11; Linux x86_64; rv:79.0) Gecko/20100101 Firefox/79.0"
+	hits := []goatcounter.Hit{
+		{Browser: ff, Location: "NL"},
+		{Browser: "asdf", Location: "NL"},
+		{Browser: "asdf", Location: "nl"},
+		{Browser: "qwerty", Location: "XXX"},
+		{Browser: "qwerty", Location: "XXX", Bot: 1},
+		{Browser: "asdf"},
+		{},
+	}
+
+	var report goatcounter.ImportReport
+	for i := range hits {
+		report.Check(&hits[i])
+	}
+
+	got := fmt.Sprintf("%d %v\n%d %v\n%q",
+		report.Browsers.Total(), report.Browsers.Top(10),
+		report.Locations.Total(), report.Locations.Top(1),
+		[]string{hits[0].Location, hits[2].Location, hits[3].Location})
+	want := "4 [{asdf 3} {qwerty 1}]\n3 [{XXX 2}]\n[\"NL\" \"\" \"\"]"
+	if got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestReplaceToken(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	if goatcounter.UseReplaceToken(ctx, "") {
+		t.Error("empty token is valid")
+	}
+	if goatcounter.UseReplaceToken(ctx, "nope") {
+		t.Error("unknown token is valid")
+	}
+
+	token := goatcounter.NewReplaceToken(ctx)
+	if !goatcounter.UseReplaceToken(ctx, token) {
+		t.Error("token is not valid")
+	}
+	if goatcounter.UseReplaceToken(ctx, token) {
+		t.Error("token can be used twice")
+	}
+}
+
+func TestImportReplaceAudit(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	fp := strings.NewReader("2Path,Title,Event,Bot,Session,FirstVisit,Referrer,Referrer scheme,Browser,Screen size,Location,Date,ID\n")
+	goatcounter.Import(ctx, fp, goatcounter.ImportReplace, goatcounter.ImportTransform{}, false, nil)
+
+	var logs goatcounter.AuditLogs
+	err := logs.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 || logs[0].Action != goatcounter.AuditImportReplace || logs[0].User == nil {
+		t.Errorf("wrong audit log: %#v", logs)
+	}
+}
+
+func TestImportReplaceRange(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/keep", CreatedAt: time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/old", CreatedAt: time.Date(2020, 6, 10, 8, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/old", CreatedAt: time.Date(2020, 6, 11, 23, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/keep", CreatedAt: time.Date(2020, 6, 12, 0, 0, 0, 0, time.UTC)},
+	)
+
+	fp := strings.NewReader("2Path,Title,Event,Bot,Session,FirstVisit,Referrer,Referrer scheme,Browser,Screen size,Location,Date,ID\n" +
+		"/new,,false,0,1,true,,,,,,2020-06-10T12:00:00Z,1\n" +
+		"/new,,false,0,1,false,,,,,,2020-06-11T12:00:00Z,2\n")
+	goatcounter.Import(ctx, fp, goatcounter.ImportReplaceRange, goatcounter.ImportTransform{}, false, nil)
+	_, err := goatcounter.Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = zdb.MustGet(ctx).SelectContext(ctx, &got, `select path from hits order by created_at`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := strings.Join(got, " "); g != "/keep /new /new /keep" {
+		t.Errorf("wrong hits: %s", g)
+	}
+
+	var logs goatcounter.AuditLogs
+	err = logs.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 || logs[0].Info != "remove pageviews from 2020-06-10 to 2020-06-11 before import" {
+		t.Errorf("wrong audit log: %#v", logs)
+	}
+}
+
+func TestScheduledExportDue(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	due := func(t *testing.T, now string) bool {
+		t.Helper()
+		defer gctest.SwapNow(t, now)()
+		d, err := goatcounter.ScheduledExportDue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	create := func(t *testing.T, now string, scheduled bool) {
+		t.Helper()
+		defer gctest.SwapNow(t, now)()
+		export := goatcounter.Export{Scheduled: zdb.Bool(scheduled)}
+		fp, err := export.Create(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fp.Close()
+		os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+	}
+
+	if due(t, "2020-06-15 10:00:00") {
+		t.Fatal("due without a schedule")
+	}
+	site.Settings.ExportSchedule = goatcounter.ExportScheduleDaily
+	if !due(t, "2020-06-15 10:00:00") {
+		t.Fatal("not due without previous exports")
+	}
+
+	create(t, "2020-06-15 10:00:00", true)
+	create(t, "2020-06-20 10:00:00", false) // Not scheduled, so ignored.
+
+	tests := []struct {
+		schedule, now string
+		want          bool
+	}{
+		{goatcounter.ExportScheduleDaily, "2020-06-15 23:59:00", false},
+		{goatcounter.ExportScheduleDaily, "2020-06-16 00:01:00", true},
+		{goatcounter.ExportScheduleWeekly, "2020-06-21 23:59:00", false},
+		{goatcounter.ExportScheduleWeekly, "2020-06-22 00:01:00", true},
+		{goatcounter.ExportScheduleMonthly, "2020-06-30 23:59:00", false},
+		{goatcounter.ExportScheduleMonthly, "2020-07-01 00:01:00", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule+" "+tt.now, func(t *testing.T) {
+			site.Settings.ExportSchedule = tt.schedule
+			if got := due(t, tt.now); got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/old", CreatedAt: time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)})
+
+	fp := strings.NewReader("2Path,Title,Event,Bot,Session,FirstVisit,Referrer,Referrer scheme,Browser,Screen size,Location,Date,ID\n" +
+		"/new,,false,0,1,true,,,,,,2020-06-10T12:00:00Z,1\n" +
+		"/old,,false,0,1,true,,,,,,2020-06-11T12:00:00Z,2\n" +
+		"/x,,false,0,1,true,,,,,,xx,3\n" +
+		",,false,0,1,true,,,,,,2020-06-11T12:00:00Z,4\n")
+	var dry goatcounter.ImportDryRun
+	err := goatcounter.Import(ctx, fp, goatcounter.ImportReplace, goatcounter.ImportTransform{}, false, &dry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "2 rows would be imported from 2020-06-10 to 2020-06-11, with 2 paths of which " +
+		"about 1 are new; all existing pageviews would be removed. 2 rows have errors and " +
+		"would be skipped (invalid createdAt: 1, invalid path: 1)."
+	if got := dry.String(); got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+	if len(dry.Examples) != 2 || !strings.HasPrefix(dry.Examples[0], "row 4: ") {
+		t.Errorf("wrong examples: %q", dry.Examples)
+	}
+
+	if l := goatcounter.Memstore.Len(); l != 0 {
+		t.Errorf("Memstore.Len() = %d", l)
+	}
+	var n int
+	err = zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from hits`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("%d hits", n)
+	}
+}
+
+func TestExportDateRange(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/feb", CreatedAt: time.Date(2020, 2, 29, 23, 59, 59, 0, time.UTC)},
+		{Path: "/mar", CreatedAt: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Path: "/mar", CreatedAt: time.Date(2020, 3, 31, 23, 0, 0, 0, time.UTC)},
+		{Path: "/apr", CreatedAt: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}...)
+
+	var (
+		start = time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+		end   = time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	)
+	export := goatcounter.Export{StartDate: &start, EndDate: &end}
+	fp, err := export.Create(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+	err = export.Run(ctx, fp, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *export.NumRows != 2 {
+		t.Errorf("NumRows = %d", *export.NumRows)
+	}
+	if r := export.DateRange(time.UTC); r != "2020-03-01 – 2020-03-31" {
+		t.Errorf("DateRange = %q", r)
+	}
+
+	var got goatcounter.Export
+	err = got.ByID(ctx, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.StartDate == nil || !got.StartDate.Equal(start) || got.EndDate == nil || !got.EndDate.Equal(end) {
+		t.Errorf("wrong dates: %v – %v", got.StartDate, got.EndDate)
+	}
+
+	bad := goatcounter.Export{StartDate: &end, EndDate: &start}
+	_, err = bad.Create(ctx, 0)
+	if err == nil {
+		t.Error("no error when end is before start")
+	}
+}
+
+func TestExportPaths(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := goatcounter.Now()
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/blog/a", CreatedAt: now},
+		{Path: "/Blog/B", CreatedAt: now},
+		{Path: "/about", CreatedAt: now},
+		{Path: "/about,me", CreatedAt: now},
+		{Path: "/other", CreatedAt: now},
+	}...)
+
+	like := "/blog/%"
+	export := goatcounter.Export{PathLike: &like, Paths: []string{"/about,me", " "}}
+	fp, err := export.Create(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+	err = export.Run(ctx, fp, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *export.NumRows != 3 {
+		t.Errorf("NumRows = %d", *export.NumRows)
+	}
+	if f := export.PathFilter(); f != "/blog/%, /about,me" {
+		t.Errorf("PathFilter = %q", f)
+	}
+
+	var got goatcounter.Export
+	err = got.ByID(ctx, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.PathLike == nil || *got.PathLike != like || len(got.Paths) != 1 || got.Paths[0] != "/about,me" {
+		t.Errorf("wrong paths: %v %v", got.PathLike, got.Paths)
+	}
+
+	var all goatcounter.Export
+	fp, err = all.Create(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.Close()
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), all.Path))
+	if all.PathFilter() != "" || all.Paths != nil {
+		t.Errorf("wrong paths: %q %v", all.PathFilter(), all.Paths)
+	}
+}
+
+func TestExportProgress(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	var export goatcounter.Export
+	fp, err := export.Create(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.Close()
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+	i := func(n int64) *int64 { return &n }
+	tests := []struct {
+		last, max *int64
+		finished  bool
+		want      float64
+	}{
+		{nil, nil, false, 0},
+		{i(150), nil, false, 0},
+		{i(100), i(100), false, 0},
+		{i(150), i(300), false, 25},
+		{i(166), i(400), false, 22},
+		{i(500), i(300), false, 100},
+		{i(150), i(300), true, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var finished *string
+			if tt.finished {
+				n := goatcounter.Now().Format(zdb.Date)
+				finished = &n
+			}
+			_, err := zdb.MustGet(ctx).ExecContext(ctx,
+				`update exports set last_hit_id=$1, max_hit_id=$2, finished_at=$3 where export_id=$4`,
+				tt.last, tt.max, finished, export.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got goatcounter.Export
+			err = got.ByID(ctx, export.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Progress != tt.want {
+				t.Errorf("got %v; want %v", got.Progress, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportResumeClaim(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	var export goatcounter.Export
+	fp, err := export.Create(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.Close()
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+
+	_, err = zdb.MustGet(ctx).ExecContext(ctx,
+		`update exports set last_hit_id=1, error='oh noes' where export_id=$1`, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two requests loaded the failed export at the same time; only the first
+	// one to resume should get it.
+	var a, b goatcounter.Export
+	err = a.ByID(ctx, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.ByID(ctx, export.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fp, err = a.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp.Close()
+
+	_, err = b.Resume(ctx)
+	if err == nil || !strings.Contains(err.Error(), "already being resumed") {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func TestExportDeleteExpired(t *testing.T) {
+	for _, keep := range []int{0, 1} {
+		t.Run(fmt.Sprintf("%d", keep), func(t *testing.T) {
+			ctx, clean := gctest.DB(t)
+			defer clean()
+			defer func(k int) { goatcounter.ExportKeep = k }(goatcounter.ExportKeep)
+			goatcounter.ExportKeep = keep
+
+			start := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+			defer gctest.SwapNow(t, start)()
+			gctest.StoreHits(ctx, t, false, goatcounter.Hit{Path: "/a"})
+
+			var exports []goatcounter.Export
+			for i := 0; i < 3; i++ {
+				gctest.SwapNow(t, start.Add(time.Duration(i)*time.Hour))
+				var export goatcounter.Export
+				fp, err := export.Create(ctx, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+				err = export.Run(ctx, fp, false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				exports = append(exports, export)
+			}
+
+			orphan := filepath.Join(goatcounter.ExportDir(), "goatcounter-export-test-orphan.csv.gz")
+			err := ioutil.WriteFile(orphan, []byte("x"), 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(orphan)
+			err = os.Chtimes(orphan, start, start)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Nothing has expired yet.
+			gctest.SwapNow(t, start.Add(12*time.Hour))
+			var del goatcounter.Exports
+			err = del.DeleteExpired(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(del) != 0 {
+				t.Errorf("deleted %d exports", len(del))
+			}
+
+			gctest.SwapNow(t, start.Add(72*time.Hour))
+			var list goatcounter.Exports
+			err = list.List(ctx, 365*10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, e := range list {
+				if want := i >= keep; e.Expired != want {
+					t.Errorf("export %d: Expired=%t; want %t", e.ID, e.Expired, want)
+				}
+			}
+
+			del = nil
+			err = del.DeleteExpired(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(del) != 3-keep {
+				t.Errorf("deleted %d exports; want %d", len(del), 3-keep)
+			}
+
+			for i, e := range exports {
+				var got goatcounter.Export
+				err := got.ByID(ctx, e.ID)
+				_, statErr := os.Stat(filepath.Join(goatcounter.ExportDir(), e.Path))
+
+				kept := i >= 3-keep
+				if kept && (err != nil || statErr != nil) {
+					t.Errorf("export %d removed: %v; %v", e.ID, err, statErr)
+				}
+				if !kept && (!zdb.ErrNoRows(err) || !os.IsNotExist(statErr)) {
+					t.Errorf("export %d not removed: %v; %v", e.ID, err, statErr)
+				}
+			}
+			if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+				t.Errorf("orphan not removed: %v", err)
+			}
+		})
+	}
+}
+
+func TestExportDeleteExpiredScheduled(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+	defer func(k int) { goatcounter.ExportKeep = k }(goatcounter.ExportKeep)
+	goatcounter.ExportKeep = 0
+	goatcounter.MustGetSite(ctx).Settings.ExportSchedule = goatcounter.ExportScheduleMonthly
+
+	start := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	defer gctest.SwapNow(t, start)()
+
+	var exports []goatcounter.Export
+	for i, scheduled := range []bool{true, true, false} {
+		gctest.SwapNow(t, start.Add(time.Duration(i)*time.Hour))
+		export := goatcounter.Export{Scheduled: zdb.Bool(scheduled)}
+		fp, err := export.Create(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fp.Close()
+		defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.Path))
+		exports = append(exports, export)
+	}
+
+	gctest.SwapNow(t, start.Add(72*time.Hour))
+	var del goatcounter.Exports
+	err := del.DeleteExpired(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(del) != 2 {
+		t.Errorf("deleted %d exports; want 2", len(del))
+	}
+
+	var got goatcounter.Export
+	err = got.ByID(ctx, exports[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Expired {
+		t.Error("last scheduled export is expired")
+	}
+
+	due, err := goatcounter.ScheduledExportDue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if due {
+		t.Error("scheduled export is due again after removing the expired exports")
+	}
+}
+
+func TestExportManifest(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a"},
+		goatcounter.Hit{Path: "/b"})
+
+	var export goatcounter.Export
+	fp, err := export.Create(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = export.Run(ctx, fp, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(goatcounter.ExportDir(), export.Path)
+	defer os.Remove(path)
+	defer os.Remove(filepath.Join(goatcounter.ExportDir(), export.ManifestPath()))
+
+	m, err := export.OpenManifest(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := fmt.Sprintf("%d %s %s %s %d %s", m.ExportID, m.Site, m.Kind, m.Version, m.Rows, m.File)
+	want := fmt.Sprintf("%d gctest hits %s 2 %s", export.ID, goatcounter.ExportVersion, export.Path)
+	if got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+	if *export.Hash != "sha256-"+m.SHA256 {
+		t.Errorf("hash %q doesn't match manifest %q", *export.Hash, m.SHA256)
+	}
+
+	err = export.Verify(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("rows", func(t *testing.T) {
+		m := m
+		m.Rows = 3
+		err := m.Verify(path)
+		if err == nil || !strings.Contains(err.Error(), "wrong number of rows: 2 (want: 3)") {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmp := path + ".modified"
+		defer os.Remove(tmp)
+		err = ioutil.WriteFile(tmp, append(data, 0), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = m.Verify(tmp)
+		if err == nil || !strings.Contains(err.Error(), "wrong size") {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+}
+
+func TestExportsCreateAll(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	_, site2 := gctest.Site(ctx, t, goatcounter.Site{})
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a"},
+		goatcounter.Hit{Path: "/b"},
+		goatcounter.Hit{Site: site2.ID, Path: "/c"})
+
+	remove := func(exports goatcounter.Exports) {
+		for _, e := range exports {
+			os.Remove(filepath.Join(goatcounter.ExportDir(), e.Path))
+			os.Remove(filepath.Join(goatcounter.ExportDir(), e.ManifestPath()))
+		}
+	}
+
+	t.Run("hits", func(t *testing.T) {
+		var exports goatcounter.Exports
+		err := exports.CreateAll(ctx, goatcounter.ExportHits)
+		defer remove(exports)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, e := range exports {
+			m, err := e.OpenManifest(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, fmt.Sprintf("%d %s %d", e.SiteID, m.Kind, m.Rows))
+		}
+		sort.Strings(got)
+		want := []string{"1 hits 2", fmt.Sprintf("%d hits 1", site2.ID)}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("\ngot:  %s\nwant: %s", got, want)
+		}
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		var exports goatcounter.Exports
+		err := exports.CreateAll(ctx, goatcounter.ExportStats, site2.ID)
+		defer remove(exports)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(exports) != 1 || exports[0].SiteID != site2.ID || exports[0].Kind != goatcounter.ExportStats {
+			t.Errorf("wrong exports: %#v", exports)
+		}
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		var exports goatcounter.Exports
+		err := exports.CreateAll(ctx, "xxx")
+		if err == nil || !strings.Contains(err.Error(), "kind") {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+}
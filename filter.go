@@ -0,0 +1,209 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"strings"
+
+	"zgo.at/goatcounter/cfg"
+)
+
+// Filter modes.
+const (
+	filterContains = iota // Path or title contains the text.
+	filterExact           // "exact:/path"; path is exactly this.
+	filterPrefix          // "prefix:/path"; path starts with this.
+)
+
+// pathFilter filters paths and titles on the filter text from the dashboard.
+//
+// All queries that filter on path or title should use this, so the filter is
+// applied consistently everywhere.
+//
+// The filter text can start with "exact:" or "prefix:" to match only on the
+// path; these can use the index on the path rather than scanning all rows,
+// which is a lot faster on sites with many paths.
+//
+// The filter text without a prefix can use the search index if it was created
+// with CreateSearchIndex.
+type pathFilter struct {
+	site          int64
+	filter        string
+	mode          int
+	caseSensitive bool
+}
+
+func newPathFilter(site *Site, filter string) pathFilter {
+	f := pathFilter{
+		site:          site.ID,
+		filter:        filter,
+		caseSensitive: site.Settings.CaseSensitivePaths,
+	}
+	switch {
+	case strings.HasPrefix(filter, "exact:"):
+		f.mode, f.filter = filterExact, filter[6:]
+	case strings.HasPrefix(filter, "prefix:"):
+		f.mode, f.filter = filterPrefix, filter[7:]
+	}
+	return f
+}
+
+// sql gets the SQL condition, starting with " and", and the parameters for it.
+// This uses "?" for placeholders, so the query needs to be rebound with
+// db.Rebind().
+//
+// The title is always matched case-insensitive; the path is matched
+// case-sensitive if CaseSensitivePaths is set for the site.
+//
+// This returns an empty string and no parameters if the filter is empty.
+func (f pathFilter) sql() (string, []interface{}) {
+	if f.filter == "" {
+		return "", nil
+	}
+
+	switch f.mode {
+	case filterExact:
+		if f.caseSensitive {
+			return ` and path = ? `, []interface{}{f.filter}
+		}
+		return ` and lower(path) = ? `, []interface{}{strings.ToLower(f.filter)}
+
+	case filterPrefix:
+		if !f.caseSensitive {
+			p := strings.ToLower(f.filter)
+			if cfg.PgSQL {
+				return ` and lower(path) like ? escape '\' `, []interface{}{escapeLike(p) + "%"}
+			}
+			// SQLite doesn't use the index for LIKE on an expression, but
+			// does for a range.
+			return ` and lower(path) >= ? and lower(path) < ? `, []interface{}{p, p + "\U0010ffff"}
+		}
+		// LIKE is always case-insensitive in SQLite, but GLOB isn't.
+		if cfg.PgSQL {
+			return ` and path like ? escape '\' `, []interface{}{escapeLike(f.filter) + "%"}
+		}
+		return ` and path glob ? `, []interface{}{escapeGlob(f.filter) + "*"}
+	}
+
+	var (
+		query string
+		args  []interface{}
+	)
+	// The FTS5 index is always case-insensitive, so use it to get the
+	// candidate paths and still check the full condition below.
+	if HasSearchIndex() && !cfg.PgSQL {
+		if q := ftsQuery(f.filter); q != "" {
+			query = ` and path in (select path from hit_paths where site=? and rowid in (
+				select rowid from hit_paths_fts where hit_paths_fts match ?)) `
+			args = []interface{}{f.site, q}
+		}
+	}
+
+	title := "%" + strings.ToLower(f.filter) + "%"
+	if !f.caseSensitive {
+		return query + ` and (lower(path) like ? or lower(title) like ?) `, append(args, title, title)
+	}
+
+	// LIKE is always case-insensitive in SQLite, so use instr() or strpos().
+	// strpos() can't use the trigram index, but LIKE can.
+	if cfg.PgSQL {
+		if HasSearchIndex() {
+			return ` and (path like ? escape '\' or lower(title) like ?) `,
+				[]interface{}{"%" + escapeLike(f.filter) + "%", title}
+		}
+		return ` and (strpos(path, ?) > 0 or lower(title) like ?) `, []interface{}{f.filter, title}
+	}
+	return query + ` and (instr(path, ?) > 0 or lower(title) like ?) `, append(args, f.filter, title)
+}
+
+// add the SQL condition to query, and the parameters to args.
+func (f pathFilter) add(query string, args []interface{}) (string, []interface{}) {
+	q, a := f.sql()
+	return query + q, append(args, a...)
+}
+
+// sqlFilter is an SQL condition with its parameters.
+type sqlFilter struct {
+	query string
+	args  []interface{}
+}
+
+// add the SQL condition to query, and the parameters to args.
+func (f sqlFilter) add(query string, args []interface{}) (string, []interface{}) {
+	return query + f.query, append(args, f.args...)
+}
+
+// hostFilter filters the hit_counts or ref_counts on the host the pageviews
+// were on.
+//
+// The other statistics tables aren't stored per host, and can't be filtered
+// with this.
+func hostFilter(host string) sqlFilter {
+	if host == "" {
+		return sqlFilter{}
+	}
+	return sqlFilter{query: ` and host=? `, args: []interface{}{strings.ToLower(host)}}
+}
+
+// likePath gets the condition to match the path against the SQL LIKE pattern in
+// parameter $n, and the value for the parameter.
+//
+// This is case-sensitive if CaseSensitivePaths is set for the site, like
+// pathFilter.
+func likePath(site *Site, n int, pattern string) (string, interface{}) {
+	if !site.Settings.CaseSensitivePaths {
+		return fmt.Sprintf(` lower(path) like lower($%d) `, n), pattern
+	}
+	// LIKE is always case-insensitive in SQLite, but GLOB isn't.
+	if cfg.PgSQL {
+		return fmt.Sprintf(` path like $%d `, n), pattern
+	}
+	return fmt.Sprintf(` path glob $%d `, n), likeToGlob(pattern)
+}
+
+// eqPath gets the condition to match the path against parameter $n; this is
+// case-sensitive if CaseSensitivePaths is set for the site.
+func eqPath(site *Site, n int) string {
+	if site.Settings.CaseSensitivePaths {
+		return fmt.Sprintf(` path=$%d `, n)
+	}
+	return fmt.Sprintf(` lower(path)=lower($%d) `, n)
+}
+
+// likeToGlob converts an SQL LIKE pattern to an SQLite GLOB pattern.
+func likeToGlob(pattern string) string {
+	var (
+		b   strings.Builder
+		esc bool
+	)
+	for _, c := range pattern {
+		switch {
+		case esc:
+			b.WriteString(escapeGlob(string(c)))
+			esc = false
+		case c == '\\':
+			esc = true
+		case c == '%':
+			b.WriteByte('*')
+		case c == '_':
+			b.WriteByte('?')
+		default:
+			b.WriteString(escapeGlob(string(c)))
+		}
+	}
+	return b.String()
+}
+
+var (
+	likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	globEscaper = strings.NewReplacer(`[`, `[[]`, `*`, `[*]`, `?`, `[?]`)
+)
+
+// escapeLike escapes s for use in a LIKE pattern with "escape '\'".
+func escapeLike(s string) string { return likeEscaper.Replace(s) }
+
+// escapeGlob escapes s for use in a SQLite GLOB pattern.
+func escapeGlob(s string) string { return globEscaper.Replace(s) }
@@ -8,6 +8,7 @@ package gctest
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -26,12 +27,14 @@ import (
 	"zgo.at/zdb"
 	"zgo.at/zstd/zcrypto"
 	"zgo.at/zstd/zstring"
+	"zgo.at/zstd/ztest"
 )
 
 type tester interface {
 	Helper()
 	Fatal(...interface{})
 	Fatalf(string, ...interface{})
+	Errorf(string, ...interface{})
 	Logf(string, ...interface{})
 }
 
@@ -39,6 +42,8 @@ var (
 	dbname = "goatcounter_test_" + zcrypto.Secret64()
 	db     *sqlx.DB
 	tables []string
+
+	updateGolden = flag.Bool("update-golden", false, "update the golden files in testdata/")
 )
 
 func init() {
@@ -203,7 +208,7 @@ func initData(ctx context.Context, t tester) context.Context {
 
 // StoreHits is a convenient helper to store hits in the DB via Memstore and
 // cron.UpdateStats().
-func StoreHits(ctx context.Context, t *testing.T, wantFail bool, hits ...goatcounter.Hit) []goatcounter.Hit {
+func StoreHits(ctx context.Context, t testing.TB, wantFail bool, hits ...goatcounter.Hit) []goatcounter.Hit {
 	t.Helper()
 
 	for i := range hits {
@@ -294,3 +299,38 @@ func SwapNow(t *testing.T, date interface{}) func() {
 		goatcounter.Now = func() time.Time { return time.Now().UTC() }
 	}
 }
+
+// Golden compares got with the golden file testdata/name; the file is written
+// instead if the -update-golden flag is given.
+//
+// The golden files are the same for SQLite and PostgreSQL, so the results of
+// both are verified to be identical.
+func Golden(t tester, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		err := os.MkdirAll("testdata", 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(got), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (use -update-golden to create it): %s", err)
+	}
+	if d := ztest.Diff(got, string(want)); d != "" {
+		dialect := "SQLite"
+		if cfg.PgSQL {
+			dialect = "PostgreSQL"
+		}
+		t.Errorf("%s: output differs from %s on %s (use -update-golden if this is intentional)\n%s",
+			name, path, dialect, d)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arp242/geoip2-golang"
+	"zgo.at/errors"
+	"zgo.at/goatcounter/pack"
+)
+
+// Geo is the GeoIP database used to look up locations.
+var Geo = NewGeoDB()
+
+// GeoDB is a GeoIP database which can be replaced while it's being used.
+//
+// This uses the database embedded in the binary by default, which only has
+// country information. Load() can load a MaxMind or DB-IP database in the mmdb
+// format from the filesystem, and Update() can download a new version.
+type GeoDB struct {
+	mu     sync.RWMutex
+	reader *geoip2.Reader
+	path   string
+}
+
+// NewGeoDB creates a new GeoDB with the embedded database.
+func NewGeoDB() *GeoDB {
+	r, err := geoip2.FromBytes(pack.GeoDB)
+	if err != nil {
+		panic(err)
+	}
+	return &GeoDB{reader: r}
+}
+
+// Load the database from path; it will be replaced if Update() is called.
+func (g *GeoDB) Load(path string) error {
+	r, err := geoip2.Open(path)
+	if err != nil {
+		return errors.Errorf("GeoDB.Load: %w", err)
+	}
+
+	g.mu.Lock()
+	old := g.reader
+	g.reader, g.path = r, path
+	g.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+// Path gets the path the database was loaded from, or "" if the embedded
+// database is used.
+func (g *GeoDB) Path() string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.path
+}
+
+// BuildDate gets the date the database was built.
+func (g *GeoDB) BuildDate() time.Time {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return time.Unix(int64(g.reader.Metadata().BuildEpoch), 0).UTC()
+}
+
+// Lookup the country, region, and city for the IP address.
+//
+// The region and city are only looked up with LocationRegion or LocationCity,
+// and will be blank if the database has no city information. They're stored as
+// the English name.
+func (g *GeoDB) Lookup(ip, detail string) (country, region, city string) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	addr := net.ParseIP(ip)
+	if detail == LocationCountry || detail == "" {
+		loc, err := g.reader.Country(addr)
+		if err != nil {
+			return "", "", ""
+		}
+		return loc.Country.IsoCode, "", ""
+	}
+
+	loc, err := g.reader.City(addr)
+	if err != nil {
+		// Not a city database; just get the country.
+		c, err := g.reader.Country(addr)
+		if err != nil {
+			return "", "", ""
+		}
+		return c.Country.IsoCode, "", ""
+	}
+
+	country = loc.Country.IsoCode
+	if len(loc.Subdivisions) > 0 {
+		region = loc.Subdivisions[0].Names["en"]
+	}
+	if detail == LocationCity {
+		city = loc.City.Names["en"]
+	}
+	return country, region, city
+}
+
+var geoClient = http.Client{Timeout: 5 * time.Minute}
+
+// Update downloads the database from url, writes it to the path the database
+// was loaded from, and starts using it.
+//
+// The url can point to a mmdb file, a gzipped mmdb file (as DB-IP uses), or a
+// tar.gz archive with a mmdb file in it (as MaxMind uses).
+//
+// The database is only replaced if the new version opens without errors and
+// was built after the current version.
+func (g *GeoDB) Update(ctx context.Context, url string) (bool, error) {
+	path := g.Path()
+	if path == "" {
+		return false, errors.New("GeoDB.Update: no path to write database to; use Load() first")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return false, errors.Errorf("GeoDB.Update: %w", err)
+	}
+	resp, err := geoClient.Do(req)
+	if err != nil {
+		return false, errors.Errorf("GeoDB.Update: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("GeoDB.Update: %s returned %s", url, resp.Status)
+	}
+
+	data, err := readMMDB(resp.Body, url)
+	if err != nil {
+		return false, errors.Errorf("GeoDB.Update: %w", err)
+	}
+
+	r, err := geoip2.FromBytes(data)
+	if err != nil {
+		return false, errors.Errorf("GeoDB.Update: new database: %w", err)
+	}
+	if !time.Unix(int64(r.Metadata().BuildEpoch), 0).After(g.BuildDate()) {
+		r.Close()
+		return false, nil
+	}
+	r.Close()
+
+	// Write to a temporary file first and rename it, so we never leave a
+	// partially written database if something goes wrong.
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return false, errors.Errorf("GeoDB.Update: %w", err)
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Close()
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return false, errors.Errorf("GeoDB.Update: %w", err)
+	}
+
+	return true, g.Load(path)
+}
+
+// readMMDB reads the mmdb database from r, extracting it from a gzip or
+// tar.gz file based on the URL's extension.
+func readMMDB(r io.Reader, url string) ([]byte, error) {
+	if i := strings.IndexAny(url, "?#"); i > -1 {
+		url = url[:i]
+	}
+
+	switch {
+	default:
+		return ioutil.ReadAll(r)
+
+	case strings.HasSuffix(url, ".gz") && !strings.HasSuffix(url, ".tar.gz"):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		return ioutil.ReadAll(gz)
+
+	case strings.HasSuffix(url, ".tar.gz") || strings.HasSuffix(url, ".tgz"):
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+
+		t := tar.NewReader(gz)
+		for {
+			h, err := t.Next()
+			if err == io.EOF {
+				return nil, errors.New("no .mmdb file in archive")
+			}
+			if err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(h.Name, ".mmdb") {
+				return ioutil.ReadAll(t)
+			}
+		}
+	}
+}
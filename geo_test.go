@@ -0,0 +1,66 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/pack"
+)
+
+func TestGeoDB(t *testing.T) {
+	g := goatcounter.NewGeoDB()
+	if g.BuildDate().Year() < 2019 {
+		t.Errorf("wrong build date: %s", g.BuildDate())
+	}
+	if c, r, ci := g.Lookup("127.0.0.1", goatcounter.LocationCity); c != "" || r != "" || ci != "" {
+		t.Errorf("%q %q %q", c, r, ci)
+	}
+
+	_, err := g.Update(context.Background(), "http://example.com")
+	if err == nil {
+		t.Error("Update() without Load() should error")
+	}
+
+	dir, err := ioutil.TempDir("", "goatcounter-geodb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "geo.mmdb")
+	err = ioutil.WriteFile(path, pack.GeoDB, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = g.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gz := gzip.NewWriter(w)
+		gz.Write(pack.GeoDB)
+		gz.Close()
+	}))
+	defer srv.Close()
+
+	// Same database, so shouldn't be updated.
+	updated, err := g.Update(context.Background(), srv.URL+"/geo.mmdb.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated {
+		t.Error("updated is true")
+	}
+}
@@ -8,14 +8,17 @@ import (
 	"fmt"
 	"math"
 	"net/http"
-	"net/http/pprof"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-chi/chi"
+	"zgo.at/blackmail"
+	"zgo.at/errors"
 	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/guru"
 	"zgo.at/zdb"
 	"zgo.at/zhttp"
@@ -35,22 +38,14 @@ func (h admin) mount(r chi.Router) {
 	a.Post("/admin/sql/explain", zhttp.Wrap(h.explain))
 
 	a.Get("/admin/botlog", zhttp.Wrap(h.botlog))
+	a.Get("/admin/email", zhttp.Wrap(h.email))
+	a.Get("/admin/email/preview", zhttp.Wrap(h.emailPreview))
+	a.Post("/admin/email/preview", zhttp.Wrap(h.emailPreviewSend))
 	a.Get("/admin/{id}", zhttp.Wrap(h.site))
 	a.Post("/admin/{id}/gh-sponsor", zhttp.Wrap(h.ghSponsor))
 	a.Post("/admin/login/{id}", zhttp.Wrap(h.login))
 
-	//aa.Get("/debug/pprof/*", pprof.Index)
-	a.Get("/debug/*", func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/debug/pprof") {
-			pprof.Index(w, r)
-		}
-		zhttp.SeeOther(w, fmt.Sprintf("/debug/pprof/%s?%s",
-			r.URL.Path[7:], r.URL.Query().Encode()))
-	})
-	a.Get("/debug/pprof/cmdline", pprof.Cmdline)
-	a.Get("/debug/pprof/profile", pprof.Profile)
-	a.Get("/debug/pprof/symbol", pprof.Symbol)
-	a.Get("/debug/pprof/trace", pprof.Trace)
+	mountDiagnostics(a)
 }
 
 func (h admin) index(w http.ResponseWriter, r *http.Request) error {
@@ -61,7 +56,7 @@ func (h admin) index(w http.ResponseWriter, r *http.Request) error {
 	l := zlog.Module("admin")
 
 	var a goatcounter.AdminStats
-	err := a.List(r.Context())
+	err := a.List(r.Context(), r.URL.Query().Get("exact") != "")
 	if err != nil {
 		return err
 	}
@@ -150,6 +145,199 @@ func (h admin) botlog(w http.ResponseWriter, r *http.Request) error {
 	}{newGlobals(w, r), ips})
 }
 
+func (h admin) email(w http.ResponseWriter, r *http.Request) error {
+	if Site(r.Context()).ID != 1 {
+		return guru.New(403, "yeah nah")
+	}
+
+	var emails goatcounter.Emails
+	err := emails.ListRecent(r.Context(), 100)
+	if err != nil {
+		return err
+	}
+
+	return zhttp.Template(w, "admin_email.gohtml", struct {
+		Globals
+		Emails           goatcounter.Emails
+		EmailMaxAttempts int
+	}{newGlobals(w, r), emails, goatcounter.EmailMaxAttempts})
+}
+
+// emailPreviews are the email templates that can be previewed, with a function
+// to create sample data for them.
+var emailPreviews = map[string]func(site goatcounter.Site, user goatcounter.User) interface{}{
+	"email_acme_failing.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		var (
+			e       = "acme/autocert: unable to satisfy http-01 challenge"
+			now     = goatcounter.Now()
+			expires = now.Add(5 * 24 * time.Hour)
+		)
+		return struct {
+			Site    goatcounter.Site
+			Renewal goatcounter.ACMERenewal
+		}{site, goatcounter.ACMERenewal{Cname: "stats.example.com", Site: site.ID,
+			Failures: 3, LastError: &e, LastErrorAt: &now, ExpiresAt: &expires}}
+	},
+	"email_export_done.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		var (
+			id         = int64(42)
+			rows       = 12345
+			size, hash = "1.2", "sha256-sample"
+		)
+		return struct {
+			Site   goatcounter.Site
+			Export goatcounter.Export
+		}{site, goatcounter.Export{ID: 1, SiteID: site.ID, Kind: goatcounter.ExportHits, LastHitID: &id, NumRows: &rows,
+			Size: &size, Hash: &hash, CreatedAt: goatcounter.Now()}}
+	},
+	"email_import_done.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		errs := errors.NewGroup(50)
+		errs.Append(errors.New("line 42: wrong number of fields"))
+		return struct {
+			Site      goatcounter.Site
+			Rows      int
+			Skipped   int
+			Dropped   int
+			Errors    *errors.Group
+			Report    goatcounter.ImportReport
+			Reindexed bool
+		}{site, 12345, 42, 7, errs, goatcounter.ImportReport{
+			Browsers:  goatcounter.ImportUnknown{"Mozilla/5.0 (Unknown)": 12, "curl/7.64.1": 3},
+			Locations: goatcounter.ImportUnknown{"XX": 5},
+		}, true}
+	},
+	"email_import_combined.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		e := "wrong number of fields in header; is this a GoatCounter export?"
+		return struct {
+			Site      goatcounter.Site
+			Imports   goatcounter.ImportJobs
+			Reindexed bool
+		}{site, goatcounter.ImportJobs{
+			{ID: 1, State: goatcounter.ImportDone, RowsDone: 12345, Errors: 3, Skipped: 42},
+			{ID: 2, State: goatcounter.ImportFailed, Error: &e},
+			{ID: 3, State: goatcounter.ImportDone, RowsDone: 678, Dropped: 7},
+		}, true}
+	},
+	"email_import_error.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		return struct {
+			Error error
+		}{errors.New("wrong number of fields in header; is this a GoatCounter export?")}
+	},
+	"email_password_reset.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		req := "sample-login-request"
+		user.LoginRequest = &req
+		return struct {
+			Site goatcounter.Site
+			User goatcounter.User
+		}{site, user}
+	},
+	"email_verify.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		token := "sample-email-token"
+		user.EmailToken = &token
+		return struct {
+			Site goatcounter.Site
+			User goatcounter.User
+		}{site, user}
+	},
+	"email_welcome.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		token := "sample-email-token"
+		user.EmailToken = &token
+		return struct {
+			Site        goatcounter.Site
+			User        goatcounter.User
+			CountDomain string
+		}{site, user, cfg.DomainCount}
+	},
+	"email_no_data.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		site.Settings.NoDataAlert = 48
+		last := goatcounter.Now().Add(-50 * time.Hour).Truncate(time.Hour)
+		return struct {
+			Site goatcounter.Site
+			Last time.Time
+		}{site, last}
+	},
+	"email_no_data_new.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		return struct {
+			Site goatcounter.Site
+		}{site}
+	},
+	"email_forgot_site.gotxt": func(site goatcounter.Site, user goatcounter.User) interface{} {
+		return struct {
+			Sites goatcounter.Sites
+			Email string
+		}{goatcounter.Sites{site}, user.Email}
+	},
+}
+
+// renderEmailPreview renders the email template tpl with sample data for the
+// current site and user.
+func renderEmailPreview(r *http.Request, tpl string) ([]byte, error) {
+	sample, ok := emailPreviews[tpl]
+	if !ok {
+		return nil, guru.Errorf(400, "unknown email template: %q", tpl)
+	}
+	return goatcounter.EmailTemplate(tpl, sample(*Site(r.Context()), *goatcounter.GetUser(r.Context())))()
+}
+
+func (h admin) emailPreview(w http.ResponseWriter, r *http.Request) error {
+	if Site(r.Context()).ID != 1 {
+		return guru.New(403, "yeah nah")
+	}
+
+	templates := make([]string, 0, len(emailPreviews))
+	for k := range emailPreviews {
+		templates = append(templates, k)
+	}
+	sort.Strings(templates)
+
+	tpl := r.URL.Query().Get("template")
+	if tpl == "" {
+		tpl = templates[0]
+	}
+	body, err := renderEmailPreview(r, tpl)
+	if err != nil {
+		return err
+	}
+
+	return zhttp.Template(w, "admin_email_preview.gohtml", struct {
+		Globals
+		Templates []string
+		Template  string
+		Body      string
+	}{newGlobals(w, r), templates, tpl, string(body)})
+}
+
+func (h admin) emailPreviewSend(w http.ResponseWriter, r *http.Request) error {
+	if Site(r.Context()).ID != 1 {
+		return guru.New(403, "yeah nah")
+	}
+
+	var args struct {
+		Template string `json:"template"`
+	}
+	_, err := zhttp.Decode(r, &args)
+	if err != nil {
+		return err
+	}
+
+	body, err := renderEmailPreview(r, args.Template)
+	if err != nil {
+		return err
+	}
+
+	user := goatcounter.GetUser(r.Context())
+	err = blackmail.Send("GoatCounter email preview: "+args.Template,
+		blackmail.From("GoatCounter", cfg.EmailFrom),
+		blackmail.To(user.Email),
+		blackmail.BodyText(body))
+	if err != nil {
+		zhttp.FlashError(w, "Sending email failed: %s", err)
+	} else {
+		zhttp.Flash(w, "Email sent to %q", user.Email)
+	}
+	return zhttp.SeeOther(w, "/admin/email/preview?template="+url.QueryEscape(args.Template))
+}
+
 func (h admin) site(w http.ResponseWriter, r *http.Request) error {
 	if Site(r.Context()).ID != 1 {
 		return guru.New(403, "yeah nah")
@@ -162,12 +350,15 @@ func (h admin) site(w http.ResponseWriter, r *http.Request) error {
 		code = chi.URLParam(r, "id")
 	}
 
-	var a goatcounter.AdminSiteStat
-	var err error
+	var (
+		a     goatcounter.AdminSiteStat
+		err   error
+		exact = r.URL.Query().Get("exact") != ""
+	)
 	if id > 0 {
-		err = a.ByID(r.Context(), id)
+		err = a.ByID(r.Context(), id, exact)
 	} else {
-		err = a.ByCode(r.Context(), code)
+		err = a.ByCode(r.Context(), code, exact)
 	}
 	if err != nil {
 		if zdb.ErrNoRows(err) {
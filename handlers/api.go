@@ -5,7 +5,7 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -16,7 +16,7 @@ import (
 	"github.com/go-chi/chi/middleware"
 	"zgo.at/errors"
 	"zgo.at/goatcounter"
-	"zgo.at/goatcounter/bgrun"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/cron"
 	"zgo.at/guru"
 	"zgo.at/zdb"
@@ -52,13 +52,35 @@ func (h api) mount(r chi.Router, db zdb.DB) {
 	a.Post("/api/v0/test", zhttp.Wrap(h.test))
 
 	a.Get("/api/v0/me", zhttp.Wrap(h.me))
+	a.Get("/api/v0/cron", zhttp.Wrap(h.cronStatus))
 
 	a.Post("/api/v0/export", zhttp.Wrap(h.export))
 	a.Get("/api/v0/export/{id}", zhttp.Wrap(h.exportGet))
 	a.Get("/api/v0/export/{id}/download", zhttp.Wrap(h.exportDownload))
+	a.Get("/api/v0/export/{id}/manifest", zhttp.Wrap(h.exportManifest))
+	a.Post("/api/v0/export/{id}/resume", zhttp.Wrap(h.exportResume))
+
+	a.Get("/api/v0/hits", zhttp.Wrap(h.hits))
+	a.Get("/api/v0/purge/preview", zhttp.Wrap(h.purgePreview))
 
 	a.Post("/api/v0/count", zhttp.Wrap(h.count))
 
+	a.Get("/api/v0/notifications", zhttp.Wrap(h.notificationList))
+	a.Post("/api/v0/notifications/{id}/dismiss", zhttp.Wrap(h.notificationDismiss))
+
+	a.Get("/api/v0/jobs", zhttp.Wrap(h.jobList))
+	a.Get("/api/v0/jobs/{id}", zhttp.Wrap(h.jobGet))
+	a.Post("/api/v0/jobs/{id}/cancel", zhttp.Wrap(h.jobCancel))
+	a.Get("/api/v0/imports", zhttp.Wrap(h.importList))
+	a.Get("/api/v0/imports/{id}", zhttp.Wrap(h.importGet))
+	a.Post("/api/v0/imports/{id}/cancel", zhttp.Wrap(h.importCancel))
+	a.Get("/api/v0/reindex", zhttp.Wrap(h.reindexList))
+	a.Post("/api/v0/reindex", zhttp.Wrap(h.reindexStart))
+	a.Get("/api/v0/reindex/accuracy", zhttp.Wrap(h.reindexAccuracy))
+	a.Get("/api/v0/reindex/{id}", zhttp.Wrap(h.reindexGet))
+	a.Post("/api/v0/reindex/{id}/pause", zhttp.Wrap(h.reindexPause))
+	a.Post("/api/v0/reindex/{id}/resume", zhttp.Wrap(h.reindexResume))
+
 	// Note: DELETE not supported for sites and users intentionally, since it's
 	// such a dangerous operation.
 	a.Get("/api/v0/sites", zhttp.Wrap(h.siteList))
@@ -66,6 +88,7 @@ func (h api) mount(r chi.Router, db zdb.DB) {
 	a.Get("/api/v0/sites/{id}", zhttp.Wrap(h.siteGet))
 	a.Post("/api/v0/sites/{id}", zhttp.Wrap(h.siteUpdate))  // Update all
 	a.Patch("/api/v0/sites/{id}", zhttp.Wrap(h.siteUpdate)) // Update just fields given
+	a.Get("/api/v0/sites/{id}/verify", zhttp.Wrap(h.siteVerify))
 }
 
 func tokenFromHeader(r *http.Request) (string, error) {
@@ -110,16 +133,62 @@ func (h api) auth(r *http.Request, perm goatcounter.APITokenPermissions) error {
 	if perm.Export && !token.Permissions.Export {
 		need = append(need, "export")
 	}
+	if perm.SiteRead && !token.Permissions.SiteRead {
+		need = append(need, "site_read")
+	}
+	if perm.SiteCreate && !token.Permissions.SiteCreate {
+		need = append(need, "site_create")
+	}
+	if perm.SiteUpdate && !token.Permissions.SiteUpdate {
+		need = append(need, "site_update")
+	}
 	if len(need) > 0 {
 		return guru.Errorf(http.StatusForbidden, "requires %s permissions", need)
 	}
 
+	loc, err := apiLocation(r)
+	if err != nil {
+		return err
+	}
+	if loc != nil {
+		*r = *r.WithContext(context.WithValue(r.Context(), apiTZKey{}, loc))
+	}
+
 	return nil
 }
 
 type apiExportRequest struct {
 	// Pagination cursor; only export hits with an ID greater than this.
 	StartFromHitID int64 `json:"start_from_hit_id"`
+
+	// Only export pageviews on or after this time.
+	StartDate *time.Time `json:"start_date"`
+
+	// Only export pageviews before this time.
+	EndDate *time.Time `json:"end_date"`
+
+	// Only export pageviews with a path that matches this LIKE pattern
+	// (case-insensitive), or that is in paths; for example "/blog/%".
+	PathLike *string `json:"path_like"`
+
+	// Only export pageviews with one of these paths, or that match path_like.
+	Paths []string `json:"paths"`
+
+	// What to export: "hits" (the default) for all pageviews, or "stats" for
+	// the aggregated statistics.
+	Kind string `json:"kind"`
+
+	// File format for exports of the statistics: "csv" (the default) or "json".
+	Format string `json:"format"`
+
+	// Encrypt the file with this passphrase in the age format; this is never
+	// stored. See https://age-encryption.org
+	Passphrase string `json:"passphrase"`
+}
+
+type apiExportResumeRequest struct {
+	// Passphrase for encrypted exports.
+	Passphrase string `json:"passphrase"`
 }
 
 // For testing various generic properties about the API.
@@ -163,11 +232,21 @@ func (h api) test(w http.ResponseWriter, r *http.Request) error {
 type meResponse struct {
 	User  goatcounter.User     `json:"user"`
 	Token goatcounter.APIToken `json:"token"`
+
+	// The site this user belongs to, and all its subsites.
+	Sites goatcounter.Sites `json:"sites"`
+
+	// The 20 most recent audit log entries, newest first.
+	Audit goatcounter.AuditLogs `json:"audit"`
 }
 
 // GET /api/v0/me user
 // Get information about the current user and API key.
 //
+// This includes the user's role, the permissions of the API key, the user's
+// sites, and the most recent audit log entries, so that tools can check what
+// they're allowed to do before doing it.
+//
 // Response 200: meResponse
 func (h api) me(w http.ResponseWriter, r *http.Request) error {
 	err := h.auth(r, goatcounter.APITokenPermissions{})
@@ -185,14 +264,495 @@ func (h api) me(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
+	sites := goatcounter.Sites{*goatcounter.MustGetSite(r.Context())}
+	err = sites.ListSubs(r.Context())
+	if err != nil {
+		return err
+	}
+
+	var audit goatcounter.AuditLogs
+	err = audit.ListRecent(r.Context(), 20)
+	if err != nil {
+		return err
+	}
+
 	u := goatcounter.GetUser(r.Context())
-	return zhttp.JSON(w, meResponse{User: *u, Token: token})
+	return h.json(w, r, meResponse{User: *u, Token: token, Sites: sites, Audit: audit})
+}
+
+type apiCronResponse struct {
+	Tasks goatcounter.CronStatuses `json:"tasks"`
+}
+
+// GET /api/v0/cron cron
+// Get the status of the background tasks.
+//
+// This lists when every cron task ran last, how long it took, and the error if
+// it failed. The failing_since is set if the task has been failing since then,
+// which can be used to alert when e.g. PersistAndStat keeps failing.
+//
+// On goatcounter.com this is only available for the admin site.
+//
+// Response 200: apiCronResponse
+func (h api) cronStatus(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+	if cfg.GoatcounterCom && !Site(r.Context()).Admin() {
+		return guru.New(http.StatusForbidden, "not allowed")
+	}
+
+	status, err := cron.Status(r.Context())
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, apiCronResponse{status})
+}
+
+type apiNotificationsResponse struct {
+	Notifications goatcounter.Notifications `json:"notifications"`
+}
+
+// GET /api/v0/notifications notifications
+// List notifications.
+//
+// This lists the 100 most recent notifications about background jobs, such as
+// finished exports and imports, newest first. Use ?unread=true to list only
+// notifications that haven't been dismissed yet.
+//
+// Response 200: apiNotificationsResponse
+func (h api) notificationList(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	var n goatcounter.Notifications
+	err = n.List(r.Context(), r.URL.Query().Get("unread") == "true")
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, apiNotificationsResponse{n})
+}
+
+// POST /api/v0/notifications/{id}/dismiss notifications
+// Dismiss a notification.
+//
+// Response 200: zgo.at/goatcounter.Notification
+func (h api) notificationDismiss(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var n goatcounter.Notification
+	err = n.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = n.Dismiss(r.Context())
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, n)
+}
+
+type apiJobsResponse struct {
+	Jobs goatcounter.Jobs `json:"jobs"`
+}
+
+// GET /api/v0/jobs jobs
+// List background jobs.
+//
+// This lists the 100 most recent background jobs, such as exports and imports,
+// newest first.
+//
+// Response 200: apiJobsResponse
+func (h api) jobList(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	var j goatcounter.Jobs
+	err = j.List(r.Context())
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, apiJobsResponse{j})
+}
+
+// GET /api/v0/jobs/{id} jobs
+// Get details about a background job.
+//
+// Response 200: zgo.at/goatcounter.Job
+func (h api) jobGet(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var j goatcounter.Job
+	err = j.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, j)
+}
+
+// POST /api/v0/jobs/{id}/cancel jobs
+// Cancel a background job.
+//
+// The job will stop at the next convenient point; exports that are cancelled
+// can be resumed later, and pageviews that were already imported are kept.
+//
+// This requires the site_update permission.
+//
+// Response 202: zgo.at/goatcounter.Job
+func (h api) jobCancel(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var j goatcounter.Job
+	err = j.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = j.Cancel(r.Context())
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, j)
+}
+
+type apiImportsResponse struct {
+	Imports goatcounter.ImportJobs `json:"imports"`
+}
+
+// GET /api/v0/imports import
+// List imports.
+//
+// This lists the 100 most recent imports, newest first.
+//
+// Response 200: apiImportsResponse
+func (h api) importList(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	var imps goatcounter.ImportJobs
+	err = imps.List(r.Context())
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, apiImportsResponse{imps})
+}
+
+// GET /api/v0/imports/{id} import
+// Get the progress of an import.
+//
+// The progress and ETA are estimates based on the number of rows that were read
+// so far.
+//
+// Response 200: zgo.at/goatcounter.ImportJob
+func (h api) importGet(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var imp goatcounter.ImportJob
+	err = imp.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, imp)
+}
+
+// POST /api/v0/imports/{id}/cancel import
+// Cancel an import.
+//
+// The import will stop after the current row; pageviews that were already
+// imported are kept. A cancelled import can't be resumed.
+//
+// This requires the site_update permission, as imports change the site's
+// statistics.
+//
+// Response 202: zgo.at/goatcounter.ImportJob
+func (h api) importCancel(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var imp goatcounter.ImportJob
+	err = imp.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = imp.Cancel(r.Context())
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, imp)
+}
+
+type apiReindexesResponse struct {
+	Reindexes goatcounter.ReindexJobs `json:"reindexes"`
+}
+
+type apiReindexRequest struct {
+	// Reindex only statistics on or after this day; the default is the day
+	// of the first pageview.
+	StartDate *time.Time `json:"start_date"`
+
+	// Reindex only statistics up to and including this day; the default is
+	// the current day.
+	EndDate *time.Time `json:"end_date"`
+
+	// Reindex only statistics since the day of the pageview with this ID;
+	// this can't be used with start_date.
+	SinceHitID *int64 `json:"since_hit_id"`
+
+	// Tables to reindex: hit_stats, hit_counts, browser_stats, system_stats,
+	// location_stats, ref_counts, size_stats, host_stats, campaign_stats, or
+	// all (the default).
+	Tables []string `json:"tables"`
+}
+
+// GET /api/v0/reindex reindex
+// List reindexes.
+//
+// This lists the 100 most recent reindexes, newest first.
+//
+// Response 200: apiReindexesResponse
+func (h api) reindexList(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	var rs goatcounter.ReindexJobs
+	err = rs.List(r.Context())
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, apiReindexesResponse{rs})
+}
+
+// POST /api/v0/reindex reindex
+// Start reindexing the statistics in the background.
+//
+// This recreates the statistics from the pageviews, for example after changing
+// the bot threshold. Only one reindex can run at the same time for a site.
+//
+// Request body: apiReindexRequest
+// Response 202: zgo.at/goatcounter.ReindexJob
+func (h api) reindexStart(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var req apiReindexRequest
+	if r.ContentLength != 0 {
+		_, err = zhttp.Decode(r, &req)
+		if err != nil {
+			return err
+		}
+	}
+	if len(req.Tables) == 0 {
+		req.Tables = []string{"all"}
+	}
+
+	rj, err := goatcounter.NewReindexJob(r.Context(), req.StartDate, req.EndDate, req.SinceHitID, req.Tables)
+	if err != nil {
+		return err
+	}
+	_, err = rj.Start(goatcounter.NewContext(r.Context()), cron.ReindexRange)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, rj)
+}
+
+// GET /api/v0/reindex/{id} reindex
+// Get the progress of a reindex.
+//
+// The progress and ETA are estimates based on the number of days that were
+// reindexed so far.
+//
+// Response 200: zgo.at/goatcounter.ReindexJob
+func (h api) reindexGet(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var rj goatcounter.ReindexJob
+	err = rj.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, rj)
+}
+
+// POST /api/v0/reindex/{id}/pause reindex
+// Pause a reindex.
+//
+// The reindex will stop after the current month; it can be continued later
+// with the resume endpoint.
+//
+// Response 202: zgo.at/goatcounter.ReindexJob
+func (h api) reindexPause(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var rj goatcounter.ReindexJob
+	err = rj.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = rj.Pause(r.Context())
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, rj)
+}
+
+// POST /api/v0/reindex/{id}/resume reindex
+// Resume a paused or failed reindex.
+//
+// This continues the reindex in the background from the first day that wasn't
+// reindexed yet.
+//
+// Response 202: zgo.at/goatcounter.ReindexJob
+func (h api) reindexResume(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteUpdate: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var rj goatcounter.ReindexJob
+	err = rj.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	_, err = rj.Start(goatcounter.NewContext(r.Context()), cron.ReindexRange)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, rj)
+}
+
+// GET /api/v0/reindex/accuracy reindex
+// Check the statistics for a day.
+//
+// Recreate the statistics for ?day= (as year-month-day in UTC) from the
+// pageviews, and compare them with the stored statistics; nothing is changed.
+// Pageviews from the last 10 seconds may not be in the statistics yet.
+//
+// Response 200: zgo.at/goatcounter.AccuracyReport
+func (h api) reindexAccuracy(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	day := v.Date("day", r.URL.Query().Get("day"), "2006-01-02")
+	if v.HasErrors() {
+		return v
+	}
+
+	report, err := goatcounter.NewAccuracyReport(r.Context(), day, cron.ReindexRange)
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, report)
 }
 
 // POST /api/v0/export export
 // Start a new export in the background.
 //
-// This starts a new export in the background.
+// This starts a new export in the background; this is an export of all
+// pageviews by default, or of the aggregated statistics with "kind": "stats".
+// The statistics for pageviews that were removed by the data retention are
+// only included if the site keeps the statistics after the retention.
 //
 // Request body: apiExportRequest
 // Response 202: zgo.at/goatcounter.Export
@@ -210,17 +770,56 @@ func (h api) export(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	var export goatcounter.Export
-	fp, err := export.Create(r.Context(), req.StartFromHitID)
+	v := zvalidate.New()
+	if req.Kind != "" {
+		v.Include("kind", req.Kind, goatcounter.ExportKinds)
+	}
+	if req.Format != "" {
+		v.Include("format", req.Format, goatcounter.ExportFormats)
+	}
+	if v.HasErrors() {
+		return v
+	}
+
+	if req.Kind == goatcounter.ExportStats && (req.StartDate != nil || req.EndDate != nil) {
+		v.Append("kind", "start_date and end_date can't be used with stats exports")
+		return v
+	}
+	if req.Kind == goatcounter.ExportStats && (req.PathLike != nil || len(req.Paths) > 0) {
+		v.Append("kind", "path_like and paths can't be used with stats exports")
+		return v
+	}
+
+	var (
+		export = goatcounter.Export{
+			StartDate: req.StartDate, EndDate: req.EndDate,
+			PathLike: req.PathLike, Paths: req.Paths,
+			Passphrase: goatcounter.Passphrase(req.Passphrase),
+		}
+		fp *os.File
+	)
+	if req.Kind == goatcounter.ExportStats {
+		if req.Format == "" {
+			req.Format = goatcounter.ExportCSV
+		}
+		fp, err = export.CreateStats(r.Context(), req.Format)
+	} else {
+		fp, err = export.Create(r.Context(), req.StartFromHitID)
+	}
 	if err != nil {
 		return err
 	}
 
-	ctx := goatcounter.NewContext(r.Context())
-	bgrun.Run(fmt.Sprintf("export api:%d", export.SiteID), func() { export.Run(ctx, fp, false) })
+	_, err = goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobExport,
+		func(ctx context.Context) error { return export.Run(ctx, fp, false) })
+	if err != nil {
+		fp.Close()
+		os.Remove(fp.Name())
+		return err
+	}
 
 	w.WriteHeader(http.StatusAccepted)
-	return zhttp.JSON(w, export)
+	return h.json(w, r, export)
 }
 
 // GET /api/v0/export/{id} export
@@ -247,7 +846,61 @@ func (h api) exportGet(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	return zhttp.JSON(w, export)
+	return h.json(w, r, export)
+}
+
+// POST /api/v0/export/{id}/resume export
+// Resume a failed export.
+//
+// This continues an export that failed (for example because the disk was full)
+// from the last_hit_id in the background. Encrypted exports need the
+// passphrase.
+//
+// Request body: apiExportResumeRequest
+// Response 202: zgo.at/goatcounter.Export
+func (h api) exportResume(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		Export: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var export goatcounter.Export
+	err = export.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var req apiExportResumeRequest
+	if r.ContentLength != 0 {
+		_, err = zhttp.Decode(r, &req)
+		if err != nil {
+			return err
+		}
+	}
+	export.Passphrase = goatcounter.Passphrase(req.Passphrase)
+
+	fp, err := export.Resume(r.Context())
+	if err != nil {
+		return guru.Errorf(400, "%w", err)
+	}
+
+	_, err = goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobExport,
+		func(ctx context.Context) error { return export.Run(ctx, fp, false) })
+	if err != nil {
+		fp.Close()
+		return err
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return h.json(w, r, export)
 }
 
 // GET /api/v0/export/{id}/download export
@@ -273,10 +926,13 @@ func (h api) exportDownload(w http.ResponseWriter, r *http.Request) error {
 	if err != nil {
 		return err
 	}
+	if export.Expired {
+		return guru.Errorf(410, "export %d has expired", id)
+	}
 
-	fp, err := os.Open(export.Path)
+	fp, err := export.Open(r.Context())
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, os.ErrNotExist) {
 			zhttp.FlashError(w, "It looks like there is no export yet.")
 			return zhttp.SeeOther(w, "/settings#tab-export")
 		}
@@ -293,10 +949,176 @@ func (h api) exportDownload(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Type", export.ContentType())
 	return zhttp.Stream(w, fp)
 }
 
+type apiHitsResponse struct {
+	// The pageviews, with only the fields that were selected.
+	Hits []map[string]interface{} `json:"hits"`
+
+	// Cursor to get the next page; this is also set if there are no more
+	// pageviews, so it can be used to get new pageviews later on.
+	Cursor string `json:"cursor"`
+
+	// There are more pageviews after this page.
+	More bool `json:"more"`
+}
+
+// GET /api/v0/hits hits
+// List pageviews.
+//
+// This lists the raw pageviews, oldest first. Use ?start= and ?end= to list
+// only pageviews in this range (as RFC3339), ?fields= to select a
+// comma-separated list of fields (all fields if empty), and ?limit= to set the
+// number of pageviews to list (default 100).
+//
+// Pass the cursor from the response as ?cursor= to get the next page; keep
+// requesting with the last cursor to get new pageviews as they come in.
+//
+// The created_at field is in UTC; use ?tz= to convert it to another timezone.
+//
+// Response 200: apiHitsResponse
+func (h api) hits(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		Export: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		q     = r.URL.Query()
+		v     = zvalidate.New()
+		rng   goatcounter.HitRange
+		limit = int64(100)
+	)
+	after, err := goatcounter.DecodeHitCursor(q.Get("cursor"))
+	if err != nil {
+		v.Append("cursor", err.Error())
+	}
+	if s := q.Get("start"); s != "" {
+		t := v.Date("start", s, time.RFC3339)
+		rng.Start = &t
+	}
+	if e := q.Get("end"); e != "" {
+		t := v.Date("end", e, time.RFC3339)
+		rng.End = &t
+	}
+	var fields []string
+	if f := q.Get("fields"); f != "" {
+		fields = strings.Split(f, ",")
+		for _, ff := range fields {
+			v.Include("fields", ff, goatcounter.HitFields)
+		}
+	}
+	if l := q.Get("limit"); l != "" {
+		limit = v.Integer("limit", l)
+		v.Range("limit", limit, 1, int64(cfg.MaxHits))
+	}
+	if v.HasErrors() {
+		return v
+	}
+
+	var hits goatcounter.Hits
+	last, err := hits.ListRange(r.Context(), limit, after, rng)
+	if err != nil {
+		return err
+	}
+
+	resp := apiHitsResponse{
+		Hits:   make([]map[string]interface{}, 0, len(hits)),
+		Cursor: goatcounter.EncodeHitCursor(last),
+		More:   int64(len(hits)) == limit,
+	}
+	loc := getAPILocation(r.Context())
+	for _, hit := range hits {
+		m := hit.Fields(fields)
+		if _, ok := m["created_at"]; ok && loc != nil {
+			m["created_at"] = hit.CreatedAt.In(loc).Format(time.RFC3339)
+		}
+		resp.Hits = append(resp.Hits, m)
+	}
+	return h.json(w, r, resp)
+}
+
+// GET /api/v0/purge/preview purge
+// Preview a purge.
+//
+// Get the number of pageviews and statistics, and the paths that would be
+// removed by purging the paths matching ?path=, without removing anything. The
+// path is a pattern where % matches any text and _ any single character. With
+// ?match_title=true the title must match the pattern as well.
+//
+// Response 200: zgo.at/goatcounter.PurgePreview
+func (h api) purgePreview(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		Export: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		q    = r.URL.Query()
+		v    = zvalidate.New()
+		path = strings.TrimSpace(q.Get("path"))
+	)
+	if path == "" {
+		v.Append("path", "must be set")
+	}
+	if v.HasErrors() {
+		return v
+	}
+
+	var hits goatcounter.Hits
+	p, err := hits.PurgePreview(r.Context(), path, q.Get("match_title") == "true")
+	if err != nil {
+		return err
+	}
+	return h.json(w, r, p)
+}
+
+// GET /api/v0/export/{id}/manifest export
+// Get the manifest of an export.
+//
+// The manifest has the size, SHA256 hash, and number of rows of the export
+// file; use "goatcounter verify" to verify a downloaded file with it.
+//
+// Response 200: zgo.at/goatcounter.ExportManifest
+func (h api) exportManifest(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		Export: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var export goatcounter.Export
+	err = export.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	if export.Expired {
+		return guru.Errorf(410, "export %d has expired", id)
+	}
+
+	m, err := export.OpenManifest(r.Context())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return guru.Errorf(404, "export %d has no manifest", id)
+		}
+		return err
+	}
+	return h.json(w, r, m)
+}
+
 type APICountRequest struct {
 	// Don't try to count unique visitors; every pageview will be considered a
 	// "visit".
@@ -315,6 +1137,10 @@ type APICountRequestHit struct {
 	// Page title, or some descriptive event title.
 	Title string `json:"title"`
 
+	// Host (domain) the page was served on, for sites served on more than
+	// one domain.
+	Host string `json:"host"`
+
 	// Is this an event?
 	Event zdb.Bool `json:"event"`
 
@@ -357,6 +1183,9 @@ type APICountRequestHit struct {
 	// identifier.
 	//
 	// You can also just disable sessions entirely with NoSessions.
+	//
+	// The session is used instead of the IP and User-Agent if it's set; the IP
+	// is still used to get the location.
 	Session string `json:"session"`
 }
 
@@ -401,15 +1230,18 @@ func (h api) count(w http.ResponseWriter, r *http.Request) error {
 		return zhttp.JSON(w, apiError{Error: "maximum amount of pageviews in one batch is 100"})
 	}
 
+	site := goatcounter.MustGetSite(r.Context())
 	errs := make(map[int]string)
 	for i, a := range args.Hits {
+		var region, city string
 		if a.Location == "" && a.IP != "" {
-			a.Location = geo(a.IP)
+			a.Location, region, city = goatcounter.Geo.Lookup(a.IP, site.Settings.LocationDetail)
 		}
 
 		hit := goatcounter.Hit{
 			Path:       a.Path,
 			Title:      a.Title,
+			Host:       a.Host,
 			Ref:        a.Ref,
 			Event:      a.Event,
 			Size:       a.Size,
@@ -418,14 +1250,16 @@ func (h api) count(w http.ResponseWriter, r *http.Request) error {
 			CreatedAt:  a.CreatedAt,
 			Browser:    a.UserAgent,
 			Location:   a.Location,
+			Region:     region,
+			City:       city,
 			RemoteAddr: a.IP,
 		}
 
 		switch {
-		case hit.Browser != "" && a.IP != "":
-			// Handle as usual in memstore.
 		case a.Session != "":
 			hit.UserSessionID = a.Session
+		case hit.Browser != "" && a.IP != "":
+			// Handle as usual in memstore.
 		case !args.NoSessions:
 			errs[i] = "session or browser/IP not set; use no_sessions if you don't want to track unique visits"
 			continue
@@ -475,7 +1309,7 @@ func (h api) siteList(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	return zhttp.JSON(w, apiSitesResponse{sites})
+	return h.json(w, r, apiSitesResponse{sites})
 }
 
 func (h api) siteFind(r *http.Request) (*goatcounter.Site, error) {
@@ -517,7 +1351,7 @@ func (h api) siteGet(w http.ResponseWriter, r *http.Request) error {
 	if err != nil {
 		return err
 	}
-	return zhttp.JSON(w, site)
+	return h.json(w, r, site)
 }
 
 // PUT /api/v0/sites sites
@@ -546,7 +1380,7 @@ func (h api) siteCreate(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	return zhttp.JSON(w, site)
+	return h.json(w, r, site)
 }
 
 type apiSiteUpdateRequest struct {
@@ -598,5 +1432,67 @@ func (h api) siteUpdate(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	return zhttp.JSON(w, site)
+	return h.json(w, r, site)
+}
+
+type apiSiteVerifyResponse struct {
+	// Pageviews are arriving: a pageview was received in the last hour.
+	Receiving bool `json:"receiving"`
+
+	// The site has ever received a pageview.
+	ReceivedData bool `json:"received_data"`
+
+	// Hour of the most recent pageview that was stored; may be null.
+	LastData *time.Time `json:"last_data"`
+
+	// Details about the first pageview; may be null.
+	FirstHit *goatcounter.SiteFirstHit `json:"first_hit"`
+
+	// How pageviews were processed since GoatCounter was started, including
+	// pageviews that were rejected.
+	Ingest goatcounter.IngestStats `json:"ingest"`
+
+	// Fraction of pageviews that were rejected as invalid, from 0 to 1.
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// GET /api/v0/sites/{id}/verify sites
+// Verify the installation of a site.
+//
+// This reports if pageviews are arriving, from which hostnames, and how many
+// were rejected. The counts in "ingest" are kept in memory; they're reset when
+// GoatCounter is restarted, and are per server if you run more than one.
+//
+// Response 200: apiSiteVerifyResponse
+func (h api) siteVerify(w http.ResponseWriter, r *http.Request) error {
+	err := h.auth(r, goatcounter.APITokenPermissions{
+		SiteRead: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	site, err := h.siteFind(r)
+	if err != nil {
+		return err
+	}
+
+	last, err := site.LastData(r.Context())
+	if err != nil {
+		return err
+	}
+
+	var (
+		ingest = goatcounter.IngestLog.Stats(site.ID)
+		hour   = goatcounter.Now().Add(-1 * time.Hour)
+	)
+	return h.json(w, r, apiSiteVerifyResponse{
+		Receiving: (ingest.Last != nil && ingest.Last.After(hour)) ||
+			(last != nil && !last.Before(hour.Truncate(time.Hour))),
+		ReceivedData: site.ReceivedData,
+		LastData:     last,
+		FirstHit:     site.FirstHit,
+		Ingest:       ingest,
+		ErrorRate:    ingest.ErrorRate(),
+	})
 }
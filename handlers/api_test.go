@@ -244,6 +244,39 @@ func TestAPIBasics(t *testing.T) {
 	})
 }
 
+func TestAPIMe(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	err := (&goatcounter.AuditLog{Action: goatcounter.AuditTimezone, Info: "UTC → Europe/Amsterdam"}).Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, rr := newAPITest(ctx, t, "GET", "/api/v0/me", nil, goatcounter.APITokenPermissions{Export: true})
+	newBackend(zdb.MustGet(ctx)).ServeHTTP(rr, r)
+	ztest.Code(t, rr, 200)
+
+	var resp meResponse
+	err = json.NewDecoder(rr.Body).Decode(&resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.User.ID != goatcounter.GetUser(ctx).ID {
+		t.Errorf("wrong user: %#v", resp.User)
+	}
+	if !resp.Token.Permissions.Export || resp.Token.Permissions.Count {
+		t.Errorf("wrong permissions: %#v", resp.Token.Permissions)
+	}
+	if len(resp.Sites) != 1 || resp.Sites[0].ID != Site(ctx).ID {
+		t.Errorf("wrong sites: %#v", resp.Sites)
+	}
+	if len(resp.Audit) != 1 || resp.Audit[0].Action != goatcounter.AuditTimezone {
+		t.Errorf("wrong audit: %#v", resp.Audit)
+	}
+}
+
 func TestAPICount(t *testing.T) {
 	tests := []struct {
 		body     APICountRequest
@@ -426,3 +459,165 @@ func TestAPISitesUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestAPISiteVerify(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := Site(ctx)
+	perm := goatcounter.APITokenPermissions{SiteRead: true}
+	verify := func(t *testing.T) apiSiteVerifyResponse {
+		t.Helper()
+		r, rr := newAPITest(ctx, t, "GET", fmt.Sprintf("/api/v0/sites/%d/verify", site.ID), nil, perm)
+		newBackend(zdb.MustGet(ctx)).ServeHTTP(rr, r)
+		ztest.Code(t, rr, 200)
+
+		var resp apiSiteVerifyResponse
+		err := json.NewDecoder(rr.Body).Decode(&resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	resp := verify(t)
+	if resp.ReceivedData || resp.FirstHit != nil || resp.LastData != nil {
+		t.Errorf("data before any pageviews: %#v", resp)
+	}
+
+	gctest.StoreHits(ctx, t, false, goatcounter.Hit{
+		Site:      site.ID,
+		Path:      "/first",
+		Host:      "www.example.com",
+		Browser:   "Mozilla/5.0",
+		CreatedAt: goatcounter.Now(),
+	})
+
+	resp = verify(t)
+	if !resp.Receiving || !resp.ReceivedData || resp.LastData == nil {
+		t.Errorf("no data after pageview: %#v", resp)
+	}
+	if resp.FirstHit == nil || resp.FirstHit.Path != "/first" ||
+		resp.FirstHit.Host != "www.example.com" || resp.FirstHit.UserAgent != "Mozilla/5.0" {
+		t.Errorf("wrong first_hit: %#v", resp.FirstHit)
+	}
+	if resp.Ingest.Results[goatcounter.IngestStored] < 1 || resp.Ingest.Hosts["www.example.com"] < 1 {
+		t.Errorf("wrong ingest: %#v", resp.Ingest)
+	}
+}
+
+func TestAPIHits(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: now},
+		goatcounter.Hit{Path: "/b", CreatedAt: now.Add(time.Hour)},
+		goatcounter.Hit{Path: "/c", CreatedAt: now.Add(2 * time.Hour)})
+
+	perm := goatcounter.APITokenPermissions{Export: true}
+	list := func(t *testing.T, query string, wantCode int) apiHitsResponse {
+		t.Helper()
+		r, rr := newAPITest(ctx, t, "GET", "/api/v0/hits?"+query, nil, perm)
+		newBackend(zdb.MustGet(ctx)).ServeHTTP(rr, r)
+		ztest.Code(t, rr, wantCode)
+
+		var resp apiHitsResponse
+		if wantCode == 200 {
+			err := json.NewDecoder(rr.Body).Decode(&resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return resp
+	}
+	paths := func(resp apiHitsResponse) string {
+		var p []string
+		for _, h := range resp.Hits {
+			p = append(p, fmt.Sprintf("%v", h["path"]))
+		}
+		return strings.Join(p, " ")
+	}
+
+	t.Run("cursor", func(t *testing.T) {
+		resp := list(t, "limit=2&fields=path", 200)
+		if got := paths(resp); got != "/a /b" || !resp.More {
+			t.Errorf("got %q, more=%t", got, resp.More)
+		}
+		if len(resp.Hits[0]) != 1 {
+			t.Errorf("wrong fields: %v", resp.Hits[0])
+		}
+
+		resp = list(t, "limit=2&cursor="+resp.Cursor, 200)
+		if got := paths(resp); got != "/c" || resp.More {
+			t.Errorf("got %q, more=%t", got, resp.More)
+		}
+		if len(resp.Hits[0]) != len(goatcounter.HitFields) {
+			t.Errorf("wrong fields: %v", resp.Hits[0])
+		}
+
+		cursor := resp.Cursor
+		resp = list(t, "cursor="+cursor, 200)
+		if len(resp.Hits) != 0 || resp.Cursor != cursor {
+			t.Errorf("got %v, cursor %q; want %q", resp.Hits, resp.Cursor, cursor)
+		}
+	})
+
+	t.Run("range", func(t *testing.T) {
+		resp := list(t, "start=2020-06-18T13:00:00Z&end=2020-06-18T14:00:00Z", 200)
+		if got := paths(resp); got != "/b" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("tz", func(t *testing.T) {
+		for _, tt := range []struct{ query, want string }{
+			{"", "2020-06-18T12:00:00Z"},
+			{"tz=UTC", "2020-06-18T12:00:00Z"},
+			{"tz=Europe/Amsterdam", "2020-06-18T14:00:00+02:00"},
+			{"tz=site", "2020-06-18T12:00:00Z"},
+		} {
+			resp := list(t, "limit=1&fields=created_at&"+tt.query, 200)
+			if got := resp.Hits[0]["created_at"]; got != tt.want {
+				t.Errorf("%q: got %q; want %q", tt.query, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		list(t, "cursor=nope", 400)
+		list(t, "fields=path,nope", 400)
+		list(t, "limit=0", 400)
+		list(t, "start=yesterday", 400)
+		list(t, "tz=nope", 400)
+	})
+}
+
+func TestAPICancel(t *testing.T) {
+	tests := []struct {
+		path string
+		perm goatcounter.APITokenPermissions
+	}{
+		{"/api/v0/jobs/1/cancel", goatcounter.APITokenPermissions{Count: true}},
+		{"/api/v0/jobs/1/cancel", goatcounter.APITokenPermissions{Export: true, SiteRead: true}},
+		{"/api/v0/imports/1/cancel", goatcounter.APITokenPermissions{Count: true}},
+		{"/api/v0/imports/1/cancel", goatcounter.APITokenPermissions{Export: true, SiteRead: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			ctx, clean := gctest.DB(t)
+			defer clean()
+
+			r, rr := newAPITest(ctx, t, "POST", tt.path, nil, tt.perm)
+			newBackend(zdb.MustGet(ctx)).ServeHTTP(rr, r)
+			ztest.Code(t, rr, 403)
+
+			want := `{"error":"requires [site_update] permissions"}`
+			if rr.Body.String() != want {
+				t.Errorf("\nwant: %s\ngot:  %s\n", want, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+
+	"zgo.at/zhttp"
+	"zgo.at/zvalidate"
+)
+
+type apiTZKey struct{}
+
+// apiLocation gets the timezone from ?tz=: "UTC", "site" for the site's
+// timezone, or a timezone name such as "Europe/Amsterdam". This returns nil if
+// it's not set.
+func apiLocation(r *http.Request) (*time.Location, error) {
+	tz := r.URL.Query().Get("tz")
+	switch tz {
+	case "":
+		return nil, nil
+	case "site":
+		if z := Site(r.Context()).Settings.Timezone; z != nil {
+			return z.Loc(), nil
+		}
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		v := zvalidate.New()
+		v.Append("tz", "unknown timezone: "+tz)
+		return nil, v
+	}
+	return loc, nil
+}
+
+// getAPILocation gets the timezone set with ?tz= from the context; this is nil
+// if it's not set.
+func getAPILocation(ctx context.Context) *time.Location {
+	loc, _ := ctx.Value(apiTZKey{}).(*time.Location)
+	return loc
+}
+
+// json writes v as JSON.
+//
+// All timestamps are converted to the timezone from ?tz= and rounded to the
+// second if it's set. They're written as-is if it's not set, which is UTC for
+// nearly everything, so that existing clients keep working.
+func (h api) json(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	if loc := getAPILocation(r.Context()); loc != nil {
+		v = inLocation(reflect.ValueOf(v), loc).Interface()
+	}
+	return zhttp.JSON(w, v)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// inLocation gets a copy of v with all time.Time values in the location.
+//
+// Only exported fields of structs are changed, as the rest aren't included in
+// the JSON anyway.
+func inLocation(v reflect.Value, loc *time.Location) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return v
+		}
+		return reflect.ValueOf(t.In(loc).Truncate(time.Second))
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.New(v.Type().Elem())
+		n.Elem().Set(inLocation(v.Elem(), loc))
+		return n
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.New(v.Type()).Elem()
+		n.Set(inLocation(v.Elem(), loc))
+		return n
+	case reflect.Struct:
+		n := reflect.New(v.Type()).Elem()
+		n.Set(v)
+		for i := 0; i < n.NumField(); i++ {
+			if f := n.Field(i); f.CanSet() {
+				f.Set(inLocation(v.Field(i), loc))
+			}
+		}
+		return n
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			n.Index(i).Set(inLocation(v.Index(i), loc))
+		}
+		return n
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		n := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			n.SetMapIndex(iter.Key(), inLocation(iter.Value(), loc))
+		}
+		return n
+	}
+	return v
+}
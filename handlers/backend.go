@@ -5,11 +5,15 @@
 package handlers
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
-	"net"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
@@ -17,9 +21,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/arp242/geoip2-golang"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/monoculum/formam"
@@ -30,7 +34,6 @@ import (
 	"zgo.at/goatcounter/bgrun"
 	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/cron"
-	"zgo.at/goatcounter/pack"
 	"zgo.at/guru"
 	"zgo.at/isbot"
 	"zgo.at/tz"
@@ -79,7 +82,7 @@ func (h backend) Mount(r chi.Router, db zdb.DB) {
 		rr.Post("/jserr", zhttp.HandlerJSErr())
 		rr.Post("/csp", zhttp.HandlerCSP())
 
-		// 4 pageviews/second should be more than enough.
+		// 4 pageviews/second (the default) should be more than enough.
 		rateLimited := rr.With(zhttp.Ratelimit(zhttp.RatelimitOptions{
 			Client: func(r *http.Request) string {
 				// Add in the User-Agent to reduce the problem of multiple
@@ -97,7 +100,7 @@ func (h backend) Mount(r chi.Router, db zdb.DB) {
 				if r.RemoteAddr == "127.0.0.1" {
 					return 1 << 14, 1
 				}
-				return 4, 1
+				return int(atomic.LoadInt64(&cfg.Ratelimit)), 1
 			},
 		}))
 		countHandler := zhttp.Wrap(h.count)
@@ -158,6 +161,15 @@ func (h backend) Mount(r chi.Router, db zdb.DB) {
 			af.Get("/updates", zhttp.Wrap(h.updates))
 			af.Get("/settings", zhttp.Wrap(h.settings))
 			af.Get("/code", zhttp.Wrap(h.code))
+			af.Get("/ingest-log", zhttp.Wrap(h.ingestLog))
+			af.Get("/live", zhttp.Wrap(h.live))
+			af.Get("/live/stream", zhttp.Wrap(h.liveStream))
+			af.Post("/ingest-log", zhttp.Wrap(h.ingestLogEnable))
+			af.Get("/hosts", zhttp.Wrap(h.hosts))
+			af.Post("/hosts", zhttp.Wrap(h.hostsUpdate))
+			af.Get("/watches", zhttp.Wrap(h.watches))
+			af.Post("/watches", zhttp.Wrap(h.watchAdd))
+			af.Post("/watches/{id}/remove", zhttp.Wrap(h.watchRemove))
 			af.Get("/ip", zhttp.Wrap(h.ip))
 			af.Post("/save-settings", zhttp.Wrap(h.saveSettings))
 			af.With(zhttp.Ratelimit(zhttp.RatelimitOptions{
@@ -166,14 +178,26 @@ func (h backend) Mount(r chi.Router, db zdb.DB) {
 				Limit:   zhttp.RatelimitLimit(1, 3600),
 				Message: "you can request only one export per hour",
 			})).Post("/export", zhttp.Wrap(h.startExport))
+			af.With(zhttp.Ratelimit(zhttp.RatelimitOptions{
+				Client:  zhttp.RatelimitIP,
+				Store:   zhttp.NewRatelimitMemory(),
+				Limit:   zhttp.RatelimitLimit(1, 3600),
+				Message: "you can request only one export of the statistics per hour",
+			})).Post("/export/stats", zhttp.Wrap(h.startExportStats))
 			af.Get("/export/{id}", zhttp.Wrap(h.downloadExport))
+			af.Get("/export/{id}/progress", zhttp.Wrap(h.exportProgress))
+			af.Get("/export/{id}/manifest", zhttp.Wrap(h.downloadExportManifest))
 			af.Post("/import", zhttp.Wrap(h.importFile))
+			af.Get("/import/replace", zhttp.Wrap(h.importReplaceConfirm))
+			af.Post("/import/replace", zhttp.Wrap(h.importReplaceToken))
 			af.Post("/add", zhttp.Wrap(h.addSubsite))
 			af.Get("/remove/{id}", zhttp.Wrap(h.removeSubsiteConfirm))
 			af.Post("/remove/{id}", zhttp.Wrap(h.removeSubsite))
 			af.Get("/purge", zhttp.Wrap(h.purgeConfirm))
 			af.Post("/purge", zhttp.Wrap(h.purge))
+			af.Post("/purge/undo", zhttp.Wrap(h.purgeUndo))
 			af.Post("/delete", zhttp.Wrap(h.delete))
+			af.Post("/notifications/{id}/dismiss", zhttp.Wrap(h.dismissNotification))
 			admin{}.mount(af)
 		}
 	}
@@ -185,19 +209,6 @@ var gif = []byte{0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x1, 0x0, 0x1, 0x0, 0x80,
 	0x1, 0x0, 0x2c, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x1, 0x0, 0x0, 0x2, 0x2, 0x4c,
 	0x1, 0x0, 0x3b}
 
-var geodb = func() *geoip2.Reader {
-	g, err := geoip2.FromBytes(pack.GeoDB)
-	if err != nil {
-		panic(err)
-	}
-	return g
-}()
-
-func geo(ip string) string {
-	loc, _ := geodb.Country(net.ParseIP(ip))
-	return loc.Country.IsoCode
-}
-
 func (h backend) status() func(w http.ResponseWriter, r *http.Request) error {
 	started := goatcounter.Now()
 	return func(w http.ResponseWriter, r *http.Request) error {
@@ -205,12 +216,24 @@ func (h backend) status() func(w http.ResponseWriter, r *http.Request) error {
 			"uptime":            goatcounter.Now().Sub(started).String(),
 			"version":           cfg.Version,
 			"last_persisted_at": cron.LastMemstore.Get().Format(time.RFC3339Nano),
+			"geodb_build_date":  goatcounter.Geo.BuildDate().Format(time.RFC3339),
+			"dedup_dropped":     strconv.FormatInt(goatcounter.Memstore.Dropped(), 10),
 		})
 	}
 }
 
 func (h backend) count(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+	site := Site(r.Context())
+
+	// Only allow the hosts in the allow-list if it's set.
+	if len(site.Settings.AllowedHosts) == 0 {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Add("Vary", "Origin")
+		if o, err := url.Parse(r.Header.Get("Origin")); err == nil && o.Host != "" && site.AcceptHost(o.Host) {
+			w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
+		}
+	}
 	w.Header().Set("Content-Type", "image/gif")
 
 	// Note this works in both HTTP/1.1 and HTTP/2, as the Go HTTP/2 server
@@ -226,37 +249,102 @@ func (h backend) count(w http.ResponseWriter, r *http.Request) error {
 		return zhttp.Bytes(w, gif)
 	}
 
-	site := Site(r.Context())
-	for _, ip := range site.Settings.IgnoreIPs {
-		if ip == r.RemoteAddr {
-			w.Header().Add("X-Goatcounter", fmt.Sprintf("ignored because %q is in the IP ignore list", ip))
-			w.WriteHeader(http.StatusAccepted)
-			return zhttp.Bytes(w, gif)
-		}
+	// Synthetic pageview from the -selfping check; this is never stored.
+	if goatcounter.IsSelfPing(r.Context(), r) {
+		w.Header().Add("X-Goatcounter", "self-ping")
+		w.WriteHeader(http.StatusAccepted)
+		return zhttp.Bytes(w, gif)
+	}
+
+	// navigator.sendBeacon() and fetch() with keepalive send the parameters in
+	// the POST body, rather than the query.
+	query, err := countQuery(r)
+	if err != nil {
+		ingestReject(site, r, query, goatcounter.IngestInvalid, "error reading body: %s", err)
+		w.Header().Add("X-Goatcounter", fmt.Sprintf("error reading body: %s", err))
+		w.WriteHeader(400)
+		return zhttp.Bytes(w, gif)
+	}
+
+	if site.Settings.IsIgnored(r.RemoteAddr) {
+		ingestReject(site, r, query, goatcounter.IngestIgnored, "IP %q is in the ignore list", r.RemoteAddr)
+		w.Header().Add("X-Goatcounter", fmt.Sprintf("ignored because %q is in the IP ignore list", r.RemoteAddr))
+		w.WriteHeader(http.StatusAccepted)
+		return zhttp.Bytes(w, gif)
+	}
+
+	// Scroll depth sent when the visitor leaves the page; this isn't a
+	// pageview.
+	if sd := query.Get("sd"); sd != "" {
+		return h.countScroll(w, r, query, site, isbot.Is(bot), sd)
 	}
 
+	// Hidden link added by count.js with the honeypot setting; visitors never
+	// see it, so it's only requested by bots that follow all links.
+	if query.Get("hp") != "" {
+		return h.countHoneypot(w, r, query, site)
+	}
+
+	// Ask for the platform version in future requests; the brands and
+	// platform are always sent by browsers that support client hints.
+	w.Header().Set("Accept-CH", "Sec-CH-UA-Platform-Version")
+
 	hit := goatcounter.Hit{
-		Site:       site.ID,
-		Browser:    r.UserAgent(),
-		Location:   geo(r.RemoteAddr),
-		CreatedAt:  goatcounter.Now(),
-		RemoteAddr: r.RemoteAddr,
+		Site:              site.ID,
+		Browser:           r.UserAgent(),
+		UABrands:          r.Header.Get("Sec-CH-UA"),
+		UAPlatform:        r.Header.Get("Sec-CH-UA-Platform"),
+		UAPlatformVersion: r.Header.Get("Sec-CH-UA-Platform-Version"),
+		CreatedAt:         goatcounter.Now(),
+		RemoteAddr:        r.RemoteAddr,
 	}
+	hit.Location, hit.Region, hit.City = goatcounter.Geo.Lookup(r.RemoteAddr, site.Settings.LocationDetail)
 
-	err := formam.NewDecoder(&formam.DecoderOptions{TagName: "json"}).Decode(r.URL.Query(), &hit)
+	err = formam.NewDecoder(&formam.DecoderOptions{TagName: "json"}).Decode(query, &hit)
 	if err != nil {
+		ingestReject(site, r, query, goatcounter.IngestInvalid, "error decoding parameters: %s", err)
 		w.Header().Add("X-Goatcounter", fmt.Sprintf("error decoding parameters: %s", err))
 		w.WriteHeader(400)
 		return zhttp.Bytes(w, gif)
 	}
+	goatcounter.IngestLog.Track(&hit)
+	// Older versions of count.js don't send the host; get it from the Referer
+	// header, which is the page the script is on.
+	if hit.Host == "" {
+		if ref, err := url.Parse(r.Referer()); err == nil {
+			hit.Host = ref.Host
+		}
+	}
+	// Image in <noscript> without a path; get it from the Referer header. This
+	// is only the domain with the default Referrer-Policy, unless the image is
+	// on the same domain as GoatCounter.
+	if hit.Path == "" && site.Settings.Noscript {
+		if ref, err := url.Parse(r.Referer()); err == nil && ref.Host != "" {
+			hit.Path = ref.RequestURI()
+			hit.IngestNote("path from Referer header: %q", hit.Path)
+		}
+	}
+	if !site.AcceptHost(hit.Host) {
+		hit.IngestNote("host %q is %s", hit.Host, site.HostStatus(hit.Host))
+		goatcounter.IngestLog.Done(hit, goatcounter.IngestIgnored)
+		w.Header().Add("X-Goatcounter", fmt.Sprintf("ignored because host %q is %s", hit.Host, site.HostStatus(hit.Host)))
+		w.WriteHeader(http.StatusAccepted)
+		return zhttp.Bytes(w, gif)
+	}
 	if hit.Bot > 0 && hit.Bot < 150 {
+		hit.IngestNote("wrong value: b=%d", hit.Bot)
+		goatcounter.IngestLog.Done(hit, goatcounter.IngestInvalid)
 		w.Header().Add("X-Goatcounter", fmt.Sprintf("wrong value: b=%d", hit.Bot))
 		w.WriteHeader(400)
 		return zhttp.Bytes(w, gif)
 	}
+	if hit.Bot > 0 {
+		hit.IngestNote("bot: detected by count.js (b=%d)", hit.Bot)
+	}
 
 	if isbot.Is(bot) { // Prefer the backend detection.
 		hit.Bot = int(bot)
+		hit.IngestNote("bot: detected from the User-Agent and request headers (%d)", hit.Bot)
 	}
 
 	if cfg.GoatcounterCom {
@@ -279,6 +367,8 @@ func (h backend) count(w http.ResponseWriter, r *http.Request) error {
 
 	err = hit.Validate(r.Context())
 	if err != nil {
+		hit.IngestNote("%s", err)
+		goatcounter.IngestLog.Done(hit, goatcounter.IngestInvalid)
 		w.Header().Add("X-Goatcounter", fmt.Sprintf("not valid: %s", err))
 		w.WriteHeader(400)
 		return zhttp.Bytes(w, gif)
@@ -288,16 +378,157 @@ func (h backend) count(w http.ResponseWriter, r *http.Request) error {
 	return zhttp.Bytes(w, gif)
 }
 
+// ingestReject records a pageview that was rejected before we have a Hit in
+// the IngestLog.
+func ingestReject(site *goatcounter.Site, r *http.Request, query url.Values, result, format string, a ...interface{}) {
+	hit := goatcounter.Hit{Site: site.ID, Path: query.Get("p")}
+	if ref, err := url.Parse(r.Referer()); err == nil {
+		hit.Host = ref.Host
+	}
+	if goatcounter.IngestLog.Track(&hit) {
+		hit.IngestNote(format, a...)
+	}
+	goatcounter.IngestLog.Done(hit, result)
+}
+
+// countMaxBody is the maximum size of the POST body for /count.
+const countMaxBody = 16 * 1024
+
+// countQuery gets the parameters for /count.
+//
+// For POST requests the parameters in the body are added to the query, and
+// take precedence. The body can be sent as application/x-www-form-urlencoded,
+// multipart/form-data, or application/json. text/plain is treated as JSON if it
+// looks like a JSON object, and as a query string otherwise; this is what
+// navigator.sendBeacon() sends for a string.
+func countQuery(r *http.Request) (url.Values, error) {
+	query := r.URL.Query()
+	if r.Method != http.MethodPost || r.Body == nil {
+		return query, nil
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, countMaxBody+1))
+	if err != nil {
+		return query, err
+	}
+	if len(b) > countMaxBody {
+		return query, errors.Errorf("body larger than %d bytes", countMaxBody)
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return query, nil
+	}
+
+	var body url.Values
+	ct, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case ct == "application/json" || (ct == "text/plain" && b[0] == '{'):
+		var m map[string]interface{}
+		err = json.Unmarshal(b, &m)
+		if err != nil {
+			return query, err
+		}
+		body = make(url.Values, len(m))
+		for k, v := range m {
+			switch vv := v.(type) {
+			case nil:
+			case []interface{}: // Screen size as [w, h, scale].
+				s := make([]string, 0, len(vv))
+				for _, e := range vv {
+					s = append(s, fmt.Sprint(e))
+				}
+				body.Set(k, strings.Join(s, ","))
+			default:
+				body.Set(k, fmt.Sprint(vv))
+			}
+		}
+	case ct == "multipart/form-data":
+		form, err := multipart.NewReader(bytes.NewReader(b), params["boundary"]).ReadForm(countMaxBody)
+		if err != nil {
+			return query, err
+		}
+		body = form.Value
+	default:
+		body, err = url.ParseQuery(strings.TrimPrefix(string(b), "?"))
+		if err != nil {
+			return query, err
+		}
+	}
+
+	for k, v := range body {
+		query[k] = v
+	}
+	return query, nil
+}
+
+func (h backend) countScroll(w http.ResponseWriter, r *http.Request, query url.Values, site *goatcounter.Site, isBot bool, sd string) error {
+	depth, err := strconv.Atoi(sd)
+	if err != nil || depth < 0 || depth > 100 {
+		w.Header().Add("X-Goatcounter", fmt.Sprintf("wrong value: sd=%q", sd))
+		w.WriteHeader(400)
+		return zhttp.Bytes(w, gif)
+	}
+	path := query.Get("p")
+	if path == "" {
+		w.Header().Add("X-Goatcounter", "not valid: p: must be set")
+		w.WriteHeader(400)
+		return zhttp.Bytes(w, gif)
+	}
+
+	if isBot || query.Get("b") != "" {
+		w.WriteHeader(http.StatusAccepted)
+		return zhttp.Bytes(w, gif)
+	}
+
+	ok := goatcounter.Memstore.AppendScroll(goatcounter.ScrollDepth{
+		Site:       site.ID,
+		Path:       path,
+		Depth:      depth,
+		CreatedAt:  goatcounter.Now(),
+		Browser:    r.UserAgent(),
+		RemoteAddr: r.RemoteAddr,
+	})
+	if !ok {
+		w.Header().Add("X-Goatcounter", "too many scroll depth events; dropped")
+	}
+	w.WriteHeader(http.StatusAccepted)
+	return zhttp.Bytes(w, gif)
+}
+
+func (h backend) countHoneypot(w http.ResponseWriter, r *http.Request, query url.Values, site *goatcounter.Site) error {
+	hit := goatcounter.Hit{
+		Site:       site.ID,
+		Path:       query.Get("p"),
+		Browser:    r.UserAgent(),
+		CreatedAt:  goatcounter.Now(),
+		RemoteAddr: r.RemoteAddr,
+	}
+	goatcounter.IngestLog.Track(&hit)
+	goatcounter.Memstore.Honeypot(hit)
+	w.WriteHeader(http.StatusAccepted)
+	return zhttp.Bytes(w, gif)
+}
+
 func (h backend) pages(w http.ResponseWriter, r *http.Request) error {
 	site := Site(r.Context())
 
 	exclude := r.URL.Query().Get("exclude")
 	filter := r.URL.Query().Get("filter")
+	host := r.URL.Query().Get("host")
 	asText := r.URL.Query().Get("as-text") == "true"
+	r, err := getTimezone(r)
+	if err != nil {
+		return err
+	}
 	start, end, err := getPeriod(w, r, site)
 	if err != nil {
 		return err
 	}
+	r, asOf, err := getAsOf(r)
+	if err != nil {
+		return err
+	}
+	r, _ = getTotalsEvents(r)
 	daily, forcedDaily := getDaily(r, start, end)
 	m, err := strconv.ParseInt(r.URL.Query().Get("max"), 10, 64)
 	if err != nil {
@@ -320,10 +551,8 @@ func (h backend) pages(w http.ResponseWriter, r *http.Request) error {
 		totalErr   error
 
 		maxTotals int
-		maxErr    error
 
 		totalHits, totalUnique int
-		totalCountErr          error
 	)
 	// Filtering instead of paginating: get new "totals" stats as well.
 	// TODO: also re-render the the horizontal bar charts below, but this isn't
@@ -336,7 +565,7 @@ func (h backend) pages(w http.ResponseWriter, r *http.Request) error {
 			defer zlog.Recover(func(l zlog.Log) zlog.Log { return l.FieldsRequest(r) })
 			defer wg.Done()
 
-			maxTotals, totalErr = totalPages.Totals(r.Context(), start, end, filter, daily)
+			maxTotals, totalErr = totalPages.Totals(r.Context(), start, end, filter, host, daily)
 			if totalErr != nil {
 				return
 			}
@@ -349,30 +578,27 @@ func (h backend) pages(w http.ResponseWriter, r *http.Request) error {
 				Max     int
 			}{r.Context(), site, totalPages, daily, maxTotals})
 		}()
-
-		wg.Add(1)
-		go func() {
-			defer zlog.Recover(func(l zlog.Log) zlog.Log { return l.FieldsRequest(r) })
-			defer wg.Done()
-
-			max, maxErr = goatcounter.GetMax(r.Context(), start, end, filter, daily)
-		}()
-
-		wg.Add(1)
-		go func() {
-			defer zlog.Recover(func(l zlog.Log) zlog.Log { return l.FieldsRequest(r) })
-			defer wg.Done()
-
-			totalHits, totalUnique, totalCountErr = goatcounter.GetTotalCount(r.Context(), start, end, filter)
-		}()
 	}
 
 	var pages goatcounter.HitStats
-	totalDisplay, totalUniqueDisplay, more, err := pages.List(
-		r.Context(), start, end, filter, strings.Split(exclude, ","), daily)
+	list, err := pages.ListPages(r.Context(), start, end, filter, host, strings.Split(exclude, ","), daily)
 	if err != nil {
 		return err
 	}
+	if exclude == "" {
+		totalHits, totalUnique, max = list.Total, list.TotalUnique, list.Max
+	}
+	err = pages.LoadScroll(r.Context(), start, end)
+	if err != nil {
+		return err
+	}
+
+	// Days before the first pageview aren't listed if ClampFirstHit is set.
+	var firstDay string
+	zone := goatcounter.GetTimezone(r.Context())
+	if f, ok := site.ClampStart(start, end, zone); ok {
+		firstDay = f.In(zone.Loc()).Format("2006-01-02")
+	}
 
 	t := "_dashboard_pages_rows.gohtml"
 	if asText {
@@ -408,40 +634,51 @@ func (h backend) pages(w http.ResponseWriter, r *http.Request) error {
 	if totalErr != nil {
 		return totalErr
 	}
-	if maxErr != nil {
-		return maxErr
-	}
-	if totalCountErr != nil {
-		return totalCountErr
-	}
 
 	return zhttp.JSON(w, map[string]interface{}{
 		"rows":                 tpl,
 		"totals":               totalTpl,
 		"paths":                paths,
 		"total_hits":           totalHits,
-		"total_display":        totalDisplay,
+		"total_display":        list.Display,
 		"total_unique":         totalUnique,
-		"total_unique_display": totalUniqueDisplay,
+		"total_unique_display": list.UniqueDisplay,
 		"max":                  max,
-		"more":                 more,
+		"more":                 list.More,
+		"other":                list.Other,
+		"as_of":                asOf.Format(time.RFC3339),
+		"estimated":            site.Settings.Estimated(),
+		"first_day":            firstDay,
 	})
 }
 
-// TODO: don't hard-code limit to 10, and allow pagination here too.
+// TODO: allow pagination here too.
 func (h backend) hchartDetail(w http.ResponseWriter, r *http.Request) error {
+	r, err := getTimezone(r)
+	if err != nil {
+		return err
+	}
 	start, end, err := getPeriod(w, r, Site(r.Context()))
 	if err != nil {
 		return err
 	}
+	r, _, err = getAsOf(r)
+	if err != nil {
+		return err
+	}
 
 	v := zvalidate.New()
 	name := r.URL.Query().Get("name")
 	kind := r.URL.Query().Get("kind")
 	v.Required("name", name)
-	v.Include("kind", kind, []string{"browser", "system", "size", "topref"})
+	v.Include("kind", kind, []string{"browser", "system", "size", "topref", "source"})
 	v.Required("kind", kind)
 	total := int(v.Integer("total", r.URL.Query().Get("total")))
+	limit := 10
+	if l := r.URL.Query().Get("limit"); l != "" {
+		limit = int(v.Integer("limit", l))
+		v.Range("limit", int64(limit), 1, int64(cfg.MaxStats))
+	}
 	if v.HasErrors() {
 		return v
 	}
@@ -458,24 +695,35 @@ func (h backend) hchartDetail(w http.ResponseWriter, r *http.Request) error {
 		if name == "(unknown)" {
 			name = ""
 		}
-		err = detail.ByRef(r.Context(), start, end, name)
+		err = detail.ByRef(r.Context(), start, end, name, limit)
+	case "source":
+		err = detail.ListSourceCategory(r.Context(), name, start, end, limit)
 	}
 	if err != nil {
 		return err
 	}
 
 	return zhttp.JSON(w, map[string]interface{}{
-		"html": string(goatcounter.HorizontalChart(r.Context(), detail, total, 10, false, false)),
+		"html":      string(goatcounter.HorizontalChart(r.Context(), detail, total, limit, false, false)),
+		"estimated": detail.Estimated,
 	})
 }
 
 func (h backend) hchartMore(w http.ResponseWriter, r *http.Request) error {
 	site := Site(r.Context())
 
+	r, err := getTimezone(r)
+	if err != nil {
+		return err
+	}
 	start, end, err := getPeriod(w, r, site)
 	if err != nil {
 		return err
 	}
+	r, _, err = getAsOf(r)
+	if err != nil {
+		return err
+	}
 
 	v := zvalidate.New()
 	kind := r.URL.Query().Get("kind")
@@ -508,7 +756,7 @@ func (h backend) hchartMore(w http.ResponseWriter, r *http.Request) error {
 		err = page.ListLocations(r.Context(), start, end, 6, offset)
 		link = false
 	case "ref":
-		err = page.ListRefsByPath(r.Context(), showRefs, start, end, offset)
+		err = page.ListRefsByPath(r.Context(), showRefs, start, end, r.URL.Query().Get("host"), offset)
 		size = site.Settings.Limits.Ref
 		paginate = offset == 0
 		link = false
@@ -520,8 +768,9 @@ func (h backend) hchartMore(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	return zhttp.JSON(w, map[string]interface{}{
-		"html": string(goatcounter.HorizontalChart(r.Context(), page, total, size, link, paginate)),
-		"more": page.More,
+		"html":      string(goatcounter.HorizontalChart(r.Context(), page, total, size, link, paginate)),
+		"more":      page.More,
+		"estimated": page.Estimated,
 	})
 }
 
@@ -558,8 +807,14 @@ func (h backend) settingsTpl(w http.ResponseWriter, r *http.Request, verr *zvali
 		return err
 	}
 
+	// Number of days to list exports for; this includes exports of which
+	// the file has expired.
+	exportDays := 7
+	if d, err := strconv.Atoi(r.URL.Query().Get("export-days")); err == nil && d > 0 {
+		exportDays = d
+	}
 	var exports goatcounter.Exports
-	err = exports.List(r.Context())
+	err = exports.List(r.Context(), exportDays)
 	if err != nil {
 		return err
 	}
@@ -570,6 +825,23 @@ func (h backend) settingsTpl(w http.ResponseWriter, r *http.Request, verr *zvali
 		return err
 	}
 
+	var lastOp *goatcounter.Operation
+	{
+		var op goatcounter.Operation
+		err = op.Last(r.Context())
+		if err != nil && !zdb.ErrNoRows(err) {
+			return err
+		}
+		if err == nil {
+			lastOp = &op
+		}
+	}
+
+	bots, err := goatcounter.GetBotSummary(r.Context(), goatcounter.Now().Add(-30*24*time.Hour))
+	if err != nil {
+		return err
+	}
+
 	del := map[string]interface{}{
 		"ContactMe": r.URL.Query().Get("contact_me") == "true",
 		"Reason":    r.URL.Query().Get("reason"),
@@ -577,13 +849,17 @@ func (h backend) settingsTpl(w http.ResponseWriter, r *http.Request, verr *zvali
 
 	return zhttp.Template(w, "backend_settings.gohtml", struct {
 		Globals
-		SubSites  goatcounter.Sites
-		Validate  *zvalidate.Validator
-		Timezones []*tz.Zone
-		Delete    map[string]interface{}
-		Exports   goatcounter.Exports
-		APITokens goatcounter.APITokens
-	}{newGlobals(w, r), sites, verr, tz.Zones, del, exports, tokens})
+		SubSites      goatcounter.Sites
+		Validate      *zvalidate.Validator
+		Timezones     []*tz.Zone
+		Delete        map[string]interface{}
+		Exports       goatcounter.Exports
+		APITokens     goatcounter.APITokens
+		LastOperation *goatcounter.Operation
+		OperationDays int
+		Bots          goatcounter.BotSummary
+	}{newGlobals(w, r), sites, verr, tz.Zones, del, exports, tokens, lastOp,
+		goatcounter.OperationKeepDays, bots})
 }
 
 func (h backend) code(w http.ResponseWriter, r *http.Request) error {
@@ -659,6 +935,7 @@ func (h backend) saveSettings(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	site := Site(txctx)
+	oldThreshold := site.Settings.BotThresholdScore()
 	site.Settings = args.Settings
 	site.LinkDomain = args.LinkDomain
 	if args.Cname != "" && !site.PlanCustomDomain(txctx) {
@@ -697,10 +974,39 @@ func (h backend) saveSettings(w http.ResponseWriter, r *http.Request) error {
 		sendEmailVerify(site, user)
 	}
 
+	if t := site.Settings.BotThresholdScore(); t != oldThreshold {
+		ctx := goatcounter.NewContext(r.Context())
+		bgrun.Run(fmt.Sprintf("ApplyBotThreshold:%d", site.ID), func() {
+			n, err := site.ApplyBotThreshold(ctx)
+			if err != nil {
+				zlog.Field("site", site.ID).Error(err)
+				return
+			}
+			if n == 0 {
+				return
+			}
+
+			rj, err := goatcounter.NewReindexJob(ctx, nil, nil, nil, []string{"all"})
+			if err == nil {
+				_, err = rj.Start(ctx, cron.ReindexRange)
+			}
+			if err != nil {
+				zlog.Field("site", site.ID).Error(err)
+				goatcounter.Notify(ctx, goatcounter.NotifyBots, fmt.Sprintf(
+					"Applied the new bot threshold of %d to %d pageviews, but the statistics couldn't be reindexed: %s",
+					t, n, err), "")
+				return
+			}
+			goatcounter.Notify(ctx, goatcounter.NotifyBots, fmt.Sprintf(
+				"Applied the new bot threshold of %d to %d pageviews; the statistics are being reindexed.",
+				t, n), "")
+		})
+	}
+
 	if makecert {
 		ctx := goatcounter.NewContext(r.Context())
 		bgrun.Run(fmt.Sprintf("acme.Make:%s", args.Cname), func() {
-			err := acme.Make(args.Cname)
+			_, err := acme.Make(args.Cname)
 			if err != nil {
 				zlog.Field("domain", args.Cname).Error(err)
 				return
@@ -710,6 +1016,9 @@ func (h backend) saveSettings(w http.ResponseWriter, r *http.Request) error {
 			if err != nil {
 				zlog.Field("domain", args.Cname).Error(err)
 			}
+
+			goatcounter.Notify(ctx, goatcounter.NotifyCert,
+				fmt.Sprintf("The TLS certificate for %s is set up.", args.Cname), "")
 		})
 	}
 
@@ -719,13 +1028,55 @@ func (h backend) saveSettings(w http.ResponseWriter, r *http.Request) error {
 
 func (h backend) importFile(w http.ResponseWriter, r *http.Request) error {
 	v := zvalidate.New()
-	replace := v.Boolean("replace", r.Form.Get("replace"))
+	mode := r.Form.Get("mode")
+	dryRun := r.Form.Get("dry_run") != ""
+	v.Include("mode", mode, goatcounter.ImportModes)
+	if (mode == goatcounter.ImportReplace || mode == goatcounter.ImportReplaceRange) &&
+		!dryRun && !goatcounter.UseReplaceToken(r.Context(), strings.TrimSpace(r.Form.Get("replace_token"))) {
+		v.Append("replace_token", "invalid or expired confirmation code; get a new code to replace existing pageviews")
+	}
+	importURL := strings.TrimSpace(r.Form.Get("url"))
+	if importURL != "" {
+		if err := goatcounter.ValidateImportURL(importURL); err != nil {
+			v.Append("url", err.Error())
+		}
+	}
+	tr, err := goatcounter.ParseImportTransform(r.Form.Get("transform"))
+	if err != nil {
+		v.Append("transform", err.Error())
+	}
 	if v.HasErrors() {
 		return v
 	}
 
+	// Download in the background, as exports can be large.
+	if importURL != "" {
+		var check *goatcounter.ImportDryRun
+		if dryRun {
+			check = &goatcounter.ImportDryRun{}
+		}
+		_, err := goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobImport,
+			func(ctx context.Context) error {
+				return goatcounter.ImportURL(ctx, importURL, mode, tr, !dryRun, check)
+			})
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			zhttp.Flash(w, "Downloading and checking the file in the background; nothing will be imported. You’ll get a notification with the result when it’s done.")
+		} else {
+			zhttp.Flash(w, "Downloading and importing the file in the background; you’ll get an email when it’s done.")
+		}
+		return zhttp.SeeOther(w, "/settings#tab-export")
+	}
+
 	file, head, err := r.FormFile("csv")
 	if err != nil {
+		if err == http.ErrMissingFile {
+			v.Append("csv", "select a file or enter a URL")
+			return v
+		}
 		return err
 	}
 	defer file.Close()
@@ -739,37 +1090,164 @@ func (h backend) importFile(w http.ResponseWriter, r *http.Request) error {
 	}
 	defer fp.Close()
 
-	ctx := goatcounter.NewContext(r.Context())
-	bgrun.Run(fmt.Sprintf("import:%d", Site(ctx).ID),
-		func() { goatcounter.Import(ctx, fp, replace, true) })
+	// Copy the file before starting the job, as the upload is removed once the
+	// request is done.
+	if dryRun {
+		tmp, err := ioutil.TempFile("", "goatcounter-import-*.csv")
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(tmp, fp)
+		if err == nil {
+			_, err = tmp.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+
+		check := &goatcounter.ImportDryRun{}
+		_, err = goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobImport,
+			func(ctx context.Context) error {
+				defer func() {
+					tmp.Close()
+					os.Remove(tmp.Name())
+				}()
+				return goatcounter.Import(ctx, tmp, mode, tr, false, check)
+			})
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+		zhttp.Flash(w, "Checking the file in the background; nothing will be imported. You’ll get a notification with the result when it’s done.")
+		return zhttp.SeeOther(w, "/settings#tab-export")
+	}
+
+	imp, err := goatcounter.NewImportJob(r.Context(), fp, mode, tr, true)
+	if err != nil {
+		return guru.Errorf(400, "%w", err)
+	}
+	_, err = imp.Start(goatcounter.NewContext(r.Context()))
+	if err != nil {
+		return err
+	}
 
 	zhttp.Flash(w, "Import started in the background; you’ll get an email when it’s done.")
 	return zhttp.SeeOther(w, "/settings#tab-export")
 }
 
+func (h backend) importReplaceConfirm(w http.ResponseWriter, r *http.Request) error {
+	return zhttp.Template(w, "backend_import_replace.gohtml", struct {
+		Globals
+		Token  string
+		Expiry int
+	}{newGlobals(w, r), "", int(goatcounter.ReplaceTokenExpiry.Minutes())})
+}
+
+func (h backend) importReplaceToken(w http.ResponseWriter, r *http.Request) error {
+	return zhttp.Template(w, "backend_import_replace.gohtml", struct {
+		Globals
+		Token  string
+		Expiry int
+	}{newGlobals(w, r), goatcounter.NewReplaceToken(r.Context()), int(goatcounter.ReplaceTokenExpiry.Minutes())})
+}
+
 func (h backend) startExport(w http.ResponseWriter, r *http.Request) error {
 	r.ParseForm()
 
 	v := zvalidate.New()
 	startFrom := v.Integer("startFrom", r.Form.Get("startFrom"))
+
+	// The end date is inclusive in the form.
+	var (
+		export = goatcounter.Export{}
+		loc    = Site(r.Context()).Settings.Timezone.Loc()
+	)
+	if d := r.Form.Get("startDate"); d != "" {
+		t, err := time.ParseInLocation("2006-01-02", d, loc)
+		if err != nil {
+			v.Append("startDate", "not a valid date")
+		}
+		export.StartDate = &t
+	}
+	if d := r.Form.Get("endDate"); d != "" {
+		t, err := time.ParseInLocation("2006-01-02", d, loc)
+		if err != nil {
+			v.Append("endDate", "not a valid date")
+		}
+		t = t.AddDate(0, 0, 1)
+		export.EndDate = &t
+	}
+	if p := strings.TrimSpace(r.Form.Get("pathLike")); p != "" {
+		export.PathLike = &p
+	}
+	if p := strings.TrimSpace(r.Form.Get("paths")); p != "" {
+		export.Paths = strings.Split(p, "\n")
+	}
+	export.Passphrase = goatcounter.Passphrase(r.Form.Get("passphrase"))
 	if v.HasErrors() {
 		return v
 	}
 
-	var export goatcounter.Export
 	fp, err := export.Create(r.Context(), startFrom)
 	if err != nil {
 		return err
 	}
 
-	ctx := goatcounter.NewContext(r.Context())
-	bgrun.Run(fmt.Sprintf("export web:%d", Site(ctx).ID),
-		func() { export.Run(ctx, fp, true) })
+	_, err = goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobExport,
+		func(ctx context.Context) error { return export.Run(ctx, fp, true) })
+	if err != nil {
+		fp.Close()
+		os.Remove(fp.Name())
+		return err
+	}
 
 	zhttp.Flash(w, "Export started in the background; you’ll get an email with a download link when it’s done.")
 	return zhttp.SeeOther(w, "/settings#tab-export")
 }
 
+func (h backend) startExportStats(w http.ResponseWriter, r *http.Request) error {
+	export := goatcounter.Export{Passphrase: goatcounter.Passphrase(r.Form.Get("passphrase"))}
+	fp, err := export.CreateStats(r.Context(), r.Form.Get("format"))
+	if err != nil {
+		return err
+	}
+
+	_, err = goatcounter.StartJob(goatcounter.NewContext(r.Context()), goatcounter.JobExport,
+		func(ctx context.Context) error { return export.Run(ctx, fp, true) })
+	if err != nil {
+		fp.Close()
+		os.Remove(fp.Name())
+		return err
+	}
+
+	zhttp.Flash(w, "Export started in the background; you’ll get an email with a download link when it’s done.")
+	return zhttp.SeeOther(w, "/settings#tab-export")
+}
+
+func (h backend) exportProgress(w http.ResponseWriter, r *http.Request) error {
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var export goatcounter.Export
+	err := export.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return zhttp.JSON(w, map[string]interface{}{
+		"progress": export.Progress,
+		"num_rows": export.NumRows,
+		"finished": export.FinishedAt != nil,
+		"error":    export.Error,
+	})
+}
+
 func (h backend) downloadExport(w http.ResponseWriter, r *http.Request) error {
 	v := zvalidate.New()
 	id := v.Integer("id", chi.URLParam(r, "id"))
@@ -783,9 +1261,14 @@ func (h backend) downloadExport(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	fp, err := os.Open(export.Path)
+	if export.Expired {
+		zhttp.FlashError(w, "This export has expired; start a new export.")
+		return zhttp.SeeOther(w, "/settings#tab-export")
+	}
+
+	fp, err := export.Open(r.Context())
 	if err != nil {
-		if os.IsNotExist(err) {
+		if errors.Is(err, os.ErrNotExist) {
 			zhttp.FlashError(w, "It looks like there is no export yet.")
 			return zhttp.SeeOther(w, "/settings#tab-export")
 		}
@@ -802,10 +1285,47 @@ func (h backend) downloadExport(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Type", export.ContentType())
 	return zhttp.Stream(w, fp)
 }
 
+func (h backend) downloadExportManifest(w http.ResponseWriter, r *http.Request) error {
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var export goatcounter.Export
+	err := export.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	if export.Expired {
+		zhttp.FlashError(w, "This export has expired; start a new export.")
+		return zhttp.SeeOther(w, "/settings#tab-export")
+	}
+
+	m, err := export.OpenManifest(r.Context())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			zhttp.FlashError(w, "There is no manifest for this export.")
+			return zhttp.SeeOther(w, "/settings#tab-export")
+		}
+		return err
+	}
+
+	err = header.SetContentDisposition(w.Header(), header.DispositionArgs{
+		Type:     header.TypeAttachment,
+		Filename: filepath.Base(export.ManifestPath()),
+	})
+	if err != nil {
+		return err
+	}
+	return zhttp.JSON(w, m)
+}
+
 func (h backend) removeSubsiteConfirm(w http.ResponseWriter, r *http.Request) error {
 	v := zvalidate.New()
 	id := v.Integer("id", chi.URLParam(r, "id"))
@@ -889,12 +1409,203 @@ func (h backend) addSubsite(w http.ResponseWriter, r *http.Request) error {
 	return zhttp.SeeOther(w, "/settings#tab-additional-sites")
 }
 
+func (h backend) ingestLog(w http.ResponseWriter, r *http.Request) error {
+	site := Site(r.Context())
+	return zhttp.Template(w, "backend_ingest_log.gohtml", struct {
+		Globals
+		Remaining int
+		Entries   []goatcounter.IngestLogEntry
+		Max       int
+	}{newGlobals(w, r), goatcounter.IngestLog.Remaining(site.ID),
+		goatcounter.IngestLog.List(site.ID), goatcounter.IngestLogSize})
+}
+
+func (h backend) ingestLogEnable(w http.ResponseWriter, r *http.Request) error {
+	var args struct {
+		N int `json:"n"`
+	}
+	_, err := zhttp.Decode(r, &args)
+	if err != nil {
+		return err
+	}
+
+	v := zvalidate.New()
+	v.Range("n", int64(args.N), 0, goatcounter.IngestLogSize)
+	if v.HasErrors() {
+		zhttp.FlashError(w, v.Error())
+		return zhttp.SeeOther(w, "/ingest-log")
+	}
+
+	goatcounter.IngestLog.Enable(Site(r.Context()).ID, args.N)
+	if args.N == 0 {
+		zhttp.Flash(w, "Stopped recording pageviews.")
+	} else {
+		zhttp.Flash(w, "Recording the next %d pageviews.", args.N)
+	}
+	return zhttp.SeeOther(w, "/ingest-log")
+}
+
+func (h backend) live(w http.ResponseWriter, r *http.Request) error {
+	return zhttp.Template(w, "backend_live.gohtml", struct {
+		Globals
+		Filter string
+		Max    int
+	}{newGlobals(w, r), r.URL.Query().Get("filter"), goatcounter.HitTailBuffer})
+}
+
+// Maximum number of live views that can be open for a site.
+const liveMaxStreams = 10
+
+// The live stream is closed before the server's WriteTimeout; the browser's
+// EventSource reconnects automatically.
+const liveStreamTimeout = 50 * time.Second
+
+// liveStream streams the pageviews for the site as server-sent events, as they
+// arrive.
+func (h backend) liveStream(w http.ResponseWriter, r *http.Request) error {
+	site := Site(r.Context())
+	if goatcounter.HitTail.Subscribers(site.ID) >= liveMaxStreams {
+		return guru.Errorf(429, "can't have more than %d live views open", liveMaxStreams)
+	}
+
+	hits, unsub := goatcounter.HitTail.Subscribe(site.ID, r.URL.Query().Get("filter"))
+	defer unsub()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // Don't buffer in nginx.
+	flush := func() {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+
+	fmt.Fprint(w, "retry: 1000\n\n")
+	flush()
+
+	var (
+		timeout = time.NewTimer(liveStreamTimeout)
+		ping    = time.NewTicker(15 * time.Second)
+	)
+	defer timeout.Stop()
+	defer ping.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-timeout.C:
+			return nil
+		case <-ping.C:
+			fmt.Fprint(w, ": ping\n\n") // Comment, so proxies don't close the connection.
+		case hit := <-hits:
+			j, err := json.Marshal(hit)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "data: %s\n\n", j)
+		}
+		flush()
+	}
+}
+
+func (h backend) hosts(w http.ResponseWriter, r *http.Request) error {
+	var report goatcounter.HostReport
+	err := report.List(r.Context())
+	if err != nil {
+		return err
+	}
+	return zhttp.Template(w, "backend_hosts.gohtml", struct {
+		Globals
+		Report goatcounter.HostReport
+		Days   int
+	}{newGlobals(w, r), report, goatcounter.HostReportDays})
+}
+
+func (h backend) hostsUpdate(w http.ResponseWriter, r *http.Request) error {
+	var args struct {
+		Host   string `json:"host"`
+		Status string `json:"status"`
+	}
+	_, err := zhttp.Decode(r, &args)
+	if err != nil {
+		return err
+	}
+
+	site := Site(r.Context())
+	err = site.SetHostStatus(r.Context(), args.Host, args.Status)
+	if err != nil {
+		zhttp.FlashError(w, err.Error())
+		return zhttp.SeeOther(w, "/hosts")
+	}
+
+	switch args.Status {
+	case goatcounter.HostAllowed:
+		zhttp.Flash(w, "Added ‘%s’ to the allowed hosts.", args.Host)
+	case goatcounter.HostBlocked:
+		zhttp.Flash(w, "Blocked ‘%s’; pageviews from this host will be ignored.", args.Host)
+	default:
+		zhttp.Flash(w, "Removed ‘%s’ from the allowed and blocked hosts.", args.Host)
+	}
+	return zhttp.SeeOther(w, "/hosts")
+}
+
+func (h backend) watches(w http.ResponseWriter, r *http.Request) error {
+	var watches goatcounter.PathWatches
+	err := watches.List(r.Context())
+	if err != nil {
+		return err
+	}
+	return zhttp.Template(w, "backend_watches.gohtml", struct {
+		Globals
+		Watches goatcounter.PathWatches
+		Path    string
+	}{newGlobals(w, r), watches, r.URL.Query().Get("path")})
+}
+
+func (h backend) watchAdd(w http.ResponseWriter, r *http.Request) error {
+	var watch goatcounter.PathWatch
+	_, err := zhttp.Decode(r, &watch)
+	if err != nil {
+		return err
+	}
+
+	err = watch.Insert(r.Context())
+	if err != nil {
+		zhttp.FlashError(w, err.Error())
+		return zhttp.SeeOther(w, "/watches")
+	}
+
+	zhttp.Flash(w, "Watching ‘%s’.", watch.Path)
+	return zhttp.SeeOther(w, "/watches")
+}
+
+func (h backend) watchRemove(w http.ResponseWriter, r *http.Request) error {
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var watch goatcounter.PathWatch
+	err := watch.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = watch.Delete(r.Context())
+	if err != nil {
+		return err
+	}
+
+	zhttp.Flash(w, "No longer watching ‘%s’.", watch.Path)
+	return zhttp.SeeOther(w, "/watches")
+}
+
 func (h backend) purgeConfirm(w http.ResponseWriter, r *http.Request) error {
 	path := strings.TrimSpace(r.URL.Query().Get("path"))
 	title := r.URL.Query().Get("match-title") == "on"
 
-	var list goatcounter.HitStats
-	err := list.ListPathsLike(r.Context(), path, title)
+	var hits goatcounter.Hits
+	preview, err := hits.PurgePreview(r.Context(), path, title)
 	if err != nil {
 		return err
 	}
@@ -902,8 +1613,8 @@ func (h backend) purgeConfirm(w http.ResponseWriter, r *http.Request) error {
 	return zhttp.Template(w, "backend_purge.gohtml", struct {
 		Globals
 		PurgePath string
-		List      goatcounter.HitStats
-	}{newGlobals(w, r), path, list})
+		Preview   goatcounter.PurgePreview
+	}{newGlobals(w, r), path, preview})
 }
 
 func (h backend) purge(w http.ResponseWriter, r *http.Request) error {
@@ -920,6 +1631,35 @@ func (h backend) purge(w http.ResponseWriter, r *http.Request) error {
 	return zhttp.SeeOther(w, "/settings#tab-purge")
 }
 
+func (h backend) purgeUndo(w http.ResponseWriter, r *http.Request) error {
+	var op goatcounter.Operation
+	err := op.Last(r.Context())
+	if err != nil {
+		if zdb.ErrNoRows(err) {
+			zhttp.FlashError(w, "There is nothing to undo.")
+			return zhttp.SeeOther(w, "/settings#tab-purge")
+		}
+		return err
+	}
+
+	ctx := goatcounter.NewContext(r.Context())
+	bgrun.Run(fmt.Sprintf("purge:%d", Site(ctx).ID), func() {
+		hits, err := op.Undo(ctx)
+		if err != nil {
+			zlog.Error(err)
+			return
+		}
+		err = cron.RestoreStats(ctx, *Site(ctx), hits, bool(op.AllStats))
+		if err != nil {
+			zlog.Error(err)
+		}
+	})
+
+	zhttp.Flash(w, "Restoring %d pageviews for ‘%s’ in the background; may take about 10-20 seconds to fully process.",
+		op.Hits, op.Path)
+	return zhttp.SeeOther(w, "/settings#tab-purge")
+}
+
 func hasPlan(site *goatcounter.Site) (bool, error) {
 	if !cfg.GoatcounterCom || site.Plan == goatcounter.PlanChild ||
 		site.Stripe == nil || *site.Stripe == "" || site.FreePlan() || site.PayExternal() != "" {
@@ -1021,19 +1761,43 @@ func (h backend) delete(w http.ResponseWriter, r *http.Request) error {
 	return zhttp.SeeOther(w, "/")
 }
 
+func (h backend) dismissNotification(w http.ResponseWriter, r *http.Request) error {
+	v := zvalidate.New()
+	id := v.Integer("id", chi.URLParam(r, "id"))
+	if v.HasErrors() {
+		return v
+	}
+
+	var n goatcounter.Notification
+	err := n.ByID(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = n.Dismiss(r.Context())
+	if err != nil {
+		return err
+	}
+	return zhttp.SeeOther(w, "/")
+}
+
+// getPeriod gets the period-start and period-end parameters, as days in the
+// timezone from getTimezone().
 func getPeriod(w http.ResponseWriter, r *http.Request, site *goatcounter.Site) (time.Time, time.Time, error) {
-	var start, end time.Time
+	var (
+		start, end time.Time
+		loc        = goatcounter.GetTimezone(r.Context()).Loc()
+	)
 
 	if d := r.URL.Query().Get("period-start"); d != "" {
 		var err error
-		start, err = time.ParseInLocation("2006-01-02", d, site.Settings.Timezone.Loc())
+		start, err = time.ParseInLocation("2006-01-02", d, loc)
 		if err != nil {
 			return start, end, guru.Errorf(400, "Invalid start date: %q", d)
 		}
 	}
 	if d := r.URL.Query().Get("period-end"); d != "" {
 		var err error
-		end, err = time.ParseInLocation("2006-01-02 15:04:05", d+" 23:59:59", site.Settings.Timezone.Loc())
+		end, err = time.ParseInLocation("2006-01-02 15:04:05", d+" 23:59:59", loc)
 		if err != nil {
 			return start, end, guru.Errorf(400, "Invalid end date: %q", d)
 		}
@@ -1042,13 +1806,47 @@ func getPeriod(w http.ResponseWriter, r *http.Request, site *goatcounter.Site) (
 	// Allow viewing a week before the site was created at the most.
 	c := site.CreatedAt.Add(-24 * time.Hour * 7)
 	if start.Before(c) {
-		y, m, d := c.In(site.Settings.Timezone.Loc()).Date()
-		start = time.Date(y, m, d, 0, 0, 0, 0, site.Settings.Timezone.Loc())
+		y, m, d := c.In(loc).Date()
+		start = time.Date(y, m, d, 0, 0, 0, 0, loc)
 	}
 
 	return start.UTC(), end.UTC(), nil
 }
 
+// getAsOf gets the as_of parameter and sets it on the request context, so that
+// the stats listers won't include anything that was persisted after it. This
+// defaults to the current time.
+//
+// The dashboard sends the same as_of value it was rendered with on pagination,
+// so that all pages are consistent with each other.
+func getAsOf(r *http.Request) (*http.Request, time.Time, error) {
+	asOf := goatcounter.Now()
+	if d := r.URL.Query().Get("as_of"); d != "" {
+		var err error
+		asOf, err = time.Parse(time.RFC3339, d)
+		if err != nil {
+			return r, asOf, guru.Errorf(400, "Invalid as_of: %q", d)
+		}
+		asOf = asOf.UTC()
+	}
+	return r.WithContext(goatcounter.WithAsOf(r.Context(), asOf)), asOf, nil
+}
+
+// getTotalsEvents sets if events are counted in the totals from the "events"
+// query parameter, overriding the site setting. The returned value is the
+// parameter, or an empty string if it's not set.
+func getTotalsEvents(r *http.Request) (*http.Request, string) {
+	e := strings.ToLower(r.URL.Query().Get("events"))
+	switch e {
+	case "on", "true":
+		return r.WithContext(goatcounter.WithTotalsEvents(r.Context(), true)), e
+	case "off", "false":
+		return r.WithContext(goatcounter.WithTotalsEvents(r.Context(), false)), e
+	default:
+		return r, ""
+	}
+}
+
 func getDaily(r *http.Request, start, end time.Time) (daily bool, forced bool) {
 	if end.Sub(start).Hours()/24 >= DailyView {
 		return true, true
@@ -1056,3 +1854,23 @@ func getDaily(r *http.Request, start, end time.Time) (daily bool, forced bool) {
 	d := strings.ToLower(r.URL.Query().Get("daily"))
 	return d == "on" || d == "true", false
 }
+
+// getTimezone sets the timezone the stats are shown in from the "tz" query
+// parameter, overriding the site's timezone. This is a timezone name such as
+// "Europe/Amsterdam" or "UTC"; the site's timezone is used if it's empty or
+// "site", like the tz parameter of the API.
+func getTimezone(r *http.Request) (*http.Request, error) {
+	name := r.URL.Query().Get("tz")
+	switch name {
+	case "", "site":
+		return r, nil
+	case "UTC":
+		return r.WithContext(goatcounter.WithTimezone(r.Context(), tz.UTC)), nil
+	}
+
+	zone, err := tz.New("", name)
+	if err != nil {
+		return r, guru.Errorf(400, "Invalid tz: %q", name)
+	}
+	return r.WithContext(goatcounter.WithTimezone(r.Context(), zone)), nil
+}
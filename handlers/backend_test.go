@@ -7,10 +7,12 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -36,6 +38,18 @@ import (
 func TestBackendCount(t *testing.T) {
 	defer gctest.SwapNow(t, "2019-06-18 14:42:00")()
 
+	// POST with the parameters in the body, as navigator.sendBeacon() and
+	// fetch() with keepalive do.
+	post := func(ct, body string) func(r *http.Request) {
+		return func(r *http.Request) {
+			r.Method = "POST"
+			r.Body = ioutil.NopCloser(strings.NewReader(body))
+			if ct != "" {
+				r.Header.Set("Content-Type", ct)
+			}
+		}
+	}
+
 	tests := []struct {
 		name     string
 		query    url.Values
@@ -119,6 +133,38 @@ func TestBackendCount(t *testing.T) {
 		}, 200, goatcounter.Hit{
 			Path: "/foo.html",
 		}},
+
+		{"beacon text", url.Values{}, post("text/plain;charset=UTF-8", "p=/foo.html&t=XX&s=40,50,1"), 200, goatcounter.Hit{
+			Path:  "/foo.html",
+			Title: "XX",
+			Size:  zdb.Floats{40, 50, 1},
+		}},
+		{"beacon no content-type", url.Values{}, post("", "?p=/foo.html"), 200, goatcounter.Hit{
+			Path: "/foo.html",
+		}},
+		{"beacon form", url.Values{}, post("application/x-www-form-urlencoded", "p=%2Ffoo.html&r=https%3A%2F%2Fexample.com"), 200, goatcounter.Hit{
+			Path:      "/foo.html",
+			Ref:       "example.com",
+			RefScheme: ztest.SP("h"),
+		}},
+		{"beacon multipart", url.Values{}, post("multipart/form-data; boundary=XXX",
+			"--XXX\r\nContent-Disposition: form-data; name=\"p\"\r\n\r\n/foo.html\r\n--XXX--\r\n"), 200, goatcounter.Hit{
+			Path: "/foo.html",
+		}},
+		{"keepalive json", url.Values{}, post("application/json", `{"p": "foo.html", "e": true, "s": [40, 50, 1]}`), 200, goatcounter.Hit{
+			Path:  "foo.html",
+			Event: true,
+			Size:  zdb.Floats{40, 50, 1},
+		}},
+		{"beacon text json", url.Values{}, post("text/plain", `{"p": "/foo.html"}`), 200, goatcounter.Hit{
+			Path: "/foo.html",
+		}},
+		{"body overrides query", url.Values{"p": {"/query.html"}, "t": {"XX"}}, post("text/plain", "p=/body.html"), 200, goatcounter.Hit{
+			Path:  "/body.html",
+			Title: "XX",
+		}},
+		{"invalid json", url.Values{}, post("application/json", `{"p": `), 400, goatcounter.Hit{}},
+		{"body too large", url.Values{}, post("text/plain", "p=/"+strings.Repeat("x", 20*1024)), 400, goatcounter.Hit{}},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +228,66 @@ func TestBackendCount(t *testing.T) {
 	}
 }
 
+func TestBackendCountNoscript(t *testing.T) {
+	defer gctest.SwapNow(t, "2019-06-18 14:42:00")()
+
+	tests := []struct {
+		name     string
+		noscript bool
+		query    string
+		referer  string
+		wantCode int
+		wantPath string
+	}{
+		{"disabled", false, "", "https://example.com/page?x=1", 400, ""},
+		{"path", true, "", "https://example.com/page?x=1", 200, "/page?x=1"},
+		{"origin only", true, "", "https://example.com", 200, "/"},
+		{"no referer", true, "", "", 400, ""},
+		{"p is used", true, "?p=/other", "https://example.com/page", 200, "/other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, clean := gctest.DB(t)
+			defer clean()
+
+			s := goatcounter.Site{CreatedAt: time.Date(2019, 01, 01, 0, 0, 0, 0, time.UTC)}
+			s.Settings.Noscript = tt.noscript
+			ctx, site := gctest.Site(ctx, t, s)
+
+			r, rr := newTest(ctx, "GET", "/count"+tt.query, nil)
+			r.Host = site.Code + "." + cfg.Domain
+			if tt.referer != "" {
+				r.Header.Set("Referer", tt.referer)
+			}
+			newBackend(zdb.MustGet(ctx)).ServeHTTP(rr, r)
+			if h := rr.Header().Get("X-Goatcounter"); h != "" {
+				t.Logf("X-Goatcounter: %s", h)
+			}
+			ztest.Code(t, rr, tt.wantCode)
+			if tt.wantCode >= 400 {
+				return
+			}
+
+			_, err := goatcounter.Memstore.Persist(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var hits []goatcounter.Hit
+			err = zdb.MustGet(ctx).SelectContext(ctx, &hits, `select * from hits`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(hits) != 1 {
+				t.Fatalf("len(hits) = %d: %#v", len(hits), hits)
+			}
+			if hits[0].Path != tt.wantPath || hits[0].Host != "example.com" {
+				t.Errorf("path=%q host=%q; want path=%q", hits[0].Path, hits[0].Host, tt.wantPath)
+			}
+		})
+	}
+}
+
 func TestBackendCountSessions(t *testing.T) {
 	now := time.Date(2019, 6, 18, 14, 42, 0, 0, time.UTC)
 	goatcounter.Now = func() time.Time { return now }
@@ -383,6 +489,20 @@ func TestBackendTpl(t *testing.T) {
 			wantCode: 200,
 			wantBody: "Are you sure you want to remove the site",
 		},
+		{
+			router:   newBackend,
+			path:     "/admin/email/preview?template=email_import_done.gotxt",
+			auth:     true,
+			wantCode: 200,
+			wantBody: "12345 pageviews were imported successfully with 1 errors.",
+		},
+		{
+			router:   newBackend,
+			path:     "/debug/vars",
+			auth:     true,
+			wantCode: 200,
+			wantBody: `"goatcounter": {"bgrun":`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -390,6 +510,18 @@ func TestBackendTpl(t *testing.T) {
 	}
 }
 
+func TestEmailPreviews(t *testing.T) {
+	ls, err := filepath.Glob("../tpl/email_*.gotxt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range ls {
+		if _, ok := emailPreviews[filepath.Base(f)]; !ok {
+			t.Errorf("no preview for %q", filepath.Base(f))
+		}
+	}
+}
+
 func TestBackendPurge(t *testing.T) {
 	tests := []handlerTest{
 		{
@@ -724,13 +856,13 @@ func TestBackendBarChart(t *testing.T) {
 		},
 	}
 
-	run := func(t *testing.T, tt testcase, url, want string) {
+	run := func(t *testing.T, tt testcase, zone, url, want string) {
 		ctx, clean := gctest.DB(t)
 		defer clean()
 
 		ctx, site := gctest.Site(ctx, t, goatcounter.Site{
 			CreatedAt: time.Date(2019, 01, 01, 0, 0, 0, 0, time.UTC),
-			Settings:  goatcounter.SiteSettings{Timezone: tz.MustNew("", tt.zone)},
+			Settings:  goatcounter.SiteSettings{Timezone: tz.MustNew("", zone)},
 		})
 		gctest.StoreHits(ctx, t, false, goatcounter.Hit{
 			Site:      site.ID,
@@ -815,10 +947,18 @@ func TestBackendBarChart(t *testing.T) {
 			defer gctest.SwapNow(t, tt.now.UTC())()
 
 			t.Run("hourly", func(t *testing.T) {
-				run(t, tt, "/?period-start=2019-06-17&period-end=2019-06-18", tt.wantHourly)
+				run(t, tt, tt.zone, "/?period-start=2019-06-17&period-end=2019-06-18", tt.wantHourly)
 			})
 			t.Run("daily", func(t *testing.T) {
-				run(t, tt, "/?period-start=2019-06-17&period-end=2019-06-18&daily=true", tt.wantDaily)
+				run(t, tt, tt.zone, "/?period-start=2019-06-17&period-end=2019-06-18&daily=true", tt.wantDaily)
+			})
+
+			// The same for a site in UTC with the timezone in ?tz=
+			t.Run("tz-hourly", func(t *testing.T) {
+				run(t, tt, "UTC", "/?period-start=2019-06-17&period-end=2019-06-18&tz="+tt.zone, tt.wantHourly)
+			})
+			t.Run("tz-daily", func(t *testing.T) {
+				run(t, tt, "UTC", "/?period-start=2019-06-17&period-end=2019-06-18&daily=true&tz="+tt.zone, tt.wantDaily)
 			})
 		})
 	}
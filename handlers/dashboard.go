@@ -14,6 +14,7 @@ import (
 	"zgo.at/goatcounter"
 	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/widgets"
+	"zgo.at/tz"
 	"zgo.at/zhttp"
 	"zgo.at/zhttp/ztpl"
 	"zgo.at/zlog"
@@ -34,20 +35,35 @@ func (h backend) dashboard(w http.ResponseWriter, r *http.Request) error {
 	}
 
 	hlPeriod := r.URL.Query().Get("hl-period")
+	tzName := r.URL.Query().Get("tz")
+	r, err := getTimezone(r)
+	if err != nil {
+		zhttp.FlashError(w, err.Error())
+		tzName = ""
+	}
 	start, end, err := getPeriod(w, r, site)
 	if err != nil {
 		zhttp.FlashError(w, err.Error())
 	}
 	if start.IsZero() || end.IsZero() {
-		y, m, d := goatcounter.Now().In(site.Settings.Timezone.Loc()).Date()
-		now := time.Date(y, m, d, 0, 0, 0, 0, site.Settings.Timezone.Loc())
+		loc := goatcounter.GetTimezone(r.Context()).Loc()
+		y, m, d := goatcounter.Now().In(loc).Date()
+		now := time.Date(y, m, d, 0, 0, 0, 0, loc)
 		start = now.Add(-7 * day).UTC()
 		end = time.Date(y, m, d, 23, 59, 59, 9, now.Location()).UTC().Round(time.Second)
 		hlPeriod = "week"
 	}
 
+	r, asOf, err := getAsOf(r)
+	if err != nil {
+		zhttp.FlashError(w, err.Error())
+		asOf = goatcounter.Now()
+	}
+	r, events := getTotalsEvents(r)
+
 	showRefs := r.URL.Query().Get("showrefs")
 	filter := r.URL.Query().Get("filter")
+	host := r.URL.Query().Get("host")
 	asText := r.URL.Query().Get("as-text") != ""
 	daily, forcedDaily := getDaily(r, start, end)
 
@@ -68,6 +84,7 @@ func (h backend) dashboard(w http.ResponseWriter, r *http.Request) error {
 		Start:       start,
 		End:         end,
 		Filter:      filter,
+		Host:        host,
 		Daily:       daily,
 		ShowRefs:    showRefs,
 		ForcedDaily: forcedDaily,
@@ -76,7 +93,8 @@ func (h backend) dashboard(w http.ResponseWriter, r *http.Request) error {
 
 	wantWidgets := goatcounter.GetUser(r.Context()).Widgets()
 	if zstring.Contains(wantWidgets, "pages") {
-		wantWidgets = append(wantWidgets, "max")
+		// The pages also get the totals and max from the same query.
+		wantWidgets = zstring.Filter(wantWidgets, func(w string) bool { return w != "totals" })
 		if showRefs != "" {
 			wantWidgets = append(wantWidgets, "refs")
 		}
@@ -148,21 +166,49 @@ func (h backend) dashboard(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
+	var notifications goatcounter.Notifications
+	if goatcounter.GetUser(r.Context()).ID > 0 {
+		err = notifications.List(r.Context(), true)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A change after the end of the period also changes how it's displayed.
+	tzChanges, err := goatcounter.TimezoneChanges(r.Context(), start, goatcounter.Now())
+	if err != nil {
+		return err
+	}
+
+	var firstDay time.Time
+	if f, ok := site.ClampStart(start, end, goatcounter.GetTimezone(r.Context())); ok {
+		firstDay = f
+	}
+
 	return zhttp.Template(w, "dashboard.gohtml", struct {
 		Globals
 		CountDomain    string
 		SubSites       []string
 		ShowRefs       string
 		SelectedPeriod string
+		AsOf           time.Time
 		PeriodStart    time.Time
 		PeriodEnd      time.Time
 		Filter         string
+		Host           string
+		Events         string
+		TZ             string
+		Timezone       *tz.Zone
 		Daily          bool
 		ForcedDaily    bool
 		AsText         bool
 		Widgets        widgets.List
+		Notifications  goatcounter.Notifications
+		TZChanges      []goatcounter.TimezoneChange
+		FirstDay       time.Time
 	}{newGlobals(w, r),
-		cd, subs, showRefs, hlPeriod, start, end, filter, daily, forcedDaily,
-		asText, widgetList,
+		cd, subs, showRefs, hlPeriod, asOf, start, end, filter, host, events,
+		tzName, goatcounter.GetTimezone(r.Context()), daily, forcedDaily,
+		asText, widgetList, notifications, tzChanges, firstDay,
 	})
 }
@@ -0,0 +1,59 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package handlers
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+
+	"github.com/go-chi/chi"
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/bgrun"
+)
+
+func init() {
+	// The expvar package already publishes "cmdline" and "memstats" (which
+	// includes the GC stats).
+	expvar.Publish("goatcounter", expvar.Func(func() interface{} {
+		return map[string]interface{}{
+			"goroutines":            runtime.NumGoroutine(),
+			"memstore_hits":         goatcounter.Memstore.Len(),
+			"memstore_dropped":      goatcounter.Memstore.Dropped(),
+			"memstore_persist_time": goatcounter.Memstore.PersistTime().String(),
+			"sessions":              goatcounter.Memstore.Sessions(),
+			"bgrun":                 bgrun.Running(),
+		}
+	}))
+}
+
+// mountDiagnostics mounts the pprof and expvar handlers on /debug/pprof/ and
+// /debug/vars.
+func mountDiagnostics(r chi.Router) {
+	r.Get("/debug/vars", expvar.Handler().ServeHTTP)
+	r.Get("/debug/pprof/cmdline", pprof.Cmdline)
+	r.Get("/debug/pprof/profile", pprof.Profile)
+	r.Get("/debug/pprof/symbol", pprof.Symbol)
+	r.Get("/debug/pprof/trace", pprof.Trace)
+	r.Get("/debug/pprof/*", pprof.Index) // Index and named profiles (heap, goroutine, etc.)
+	r.Get("/debug/pprof", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/debug/pprof/", http.StatusSeeOther)
+	})
+}
+
+// NewDiagnostics creates a new router with only the pprof and expvar handlers,
+// for serving on a separate port.
+//
+// This has no authentication, so it should only listen on a local address.
+func NewDiagnostics() chi.Router {
+	r := chi.NewRouter()
+	mountDiagnostics(r)
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<a href="/debug/pprof/">/debug/pprof/</a><br><a href="/debug/vars">/debug/vars</a>`))
+	})
+	return r
+}
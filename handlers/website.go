@@ -208,7 +208,8 @@ func (h website) doSignup(w http.ResponseWriter, r *http.Request) error {
 	defer tx.Rollback()
 
 	// Create site.
-	tz, err := tz.New(geo(r.RemoteAddr), args.Timezone)
+	country, _, _ := goatcounter.Geo.Lookup(r.RemoteAddr, goatcounter.LocationCountry)
+	tz, err := tz.New(country, args.Timezone)
 	if err != nil {
 		zlog.FieldsRequest(r).Fields(zlog.F{
 			"timezone": args.Timezone,
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"zgo.at/goatcounter/cfg"
+	"zgo.at/tz"
 	"zgo.at/zdb"
 	"zgo.at/zhttp/ctxkey"
 	"zgo.at/zhttp/ztpl"
@@ -60,6 +61,86 @@ func GetUser(ctx context.Context) *User {
 	return u
 }
 
+type ctxkeyAsOf struct{}
+
+// WithAsOf sets a point in time after which the stats listers won't read any
+// data.
+//
+// This allows paginating through stats without getting inconsistent results
+// when new pageviews are persisted in the meanwhile. For the tables which are
+// stored per-day this is the day of asOf.
+func WithAsOf(ctx context.Context, asOf time.Time) context.Context {
+	return context.WithValue(ctx, ctxkeyAsOf{}, asOf.UTC())
+}
+
+// GetAsOf gets the as-of time set with WithAsOf(), or a zero time if it's not
+// set.
+func GetAsOf(ctx context.Context) time.Time {
+	t, _ := ctx.Value(ctxkeyAsOf{}).(time.Time)
+	return t
+}
+
+type ctxkeyTotalsEvents struct{}
+
+// WithTotalsEvents sets if events are counted in the totals, overriding the
+// TotalsExcludeEvents setting of the site.
+func WithTotalsEvents(ctx context.Context, include bool) context.Context {
+	return context.WithValue(ctx, ctxkeyTotalsEvents{}, include)
+}
+
+// GetTotalsEvents reports if events are counted in the totals; this is the
+// value set with WithTotalsEvents(), or the site's TotalsExcludeEvents setting
+// if it's not set.
+func GetTotalsEvents(ctx context.Context) bool {
+	if b, ok := ctx.Value(ctxkeyTotalsEvents{}).(bool); ok {
+		return b
+	}
+	return !MustGetSite(ctx).Settings.TotalsExcludeEvents
+}
+
+// totalsEventsWhere gets the SQL condition to exclude events from the totals,
+// or an empty string if they're included.
+func totalsEventsWhere(ctx context.Context) string {
+	if GetTotalsEvents(ctx) {
+		return ""
+	}
+	return ` and event=0 `
+}
+
+type ctxkeyTimezone struct{}
+
+// WithTimezone sets the timezone the stats are shown in, overriding the
+// Timezone setting of the site.
+func WithTimezone(ctx context.Context, zone *tz.Zone) context.Context {
+	return context.WithValue(ctx, ctxkeyTimezone{}, zone)
+}
+
+// GetTimezone gets the timezone the stats are shown in; this is the timezone
+// set with WithTimezone(), or the site's Timezone setting if it's not set.
+func GetTimezone(ctx context.Context) *tz.Zone {
+	if z, ok := ctx.Value(ctxkeyTimezone{}).(*tz.Zone); ok && z != nil {
+		return z
+	}
+	if s := GetSite(ctx); s != nil && s.Settings.Timezone != nil {
+		return s.Settings.Timezone
+	}
+	return tz.UTC
+}
+
+// clampAsOf returns end, or the as-of time on the context if that's before end.
+//
+// The as-of time is clamped to start, so that the range is never reversed.
+func clampAsOf(ctx context.Context, start, end time.Time) time.Time {
+	asOf := GetAsOf(ctx)
+	if asOf.IsZero() || !asOf.Before(end) {
+		return end
+	}
+	if asOf.Before(start) {
+		return start
+	}
+	return asOf.In(end.Location())
+}
+
 // NewContext creates a new context with the all the request values set.
 //
 // Useful for tests, or for "removing" the timeout on the request context so it
@@ -68,6 +149,12 @@ func NewContext(ctx context.Context) context.Context {
 	n := zdb.With(context.Background(), zdb.MustGet(ctx))
 	n = context.WithValue(n, ctxkey.User, GetUser(ctx))
 	n = context.WithValue(n, ctxkey.Site, GetSite(ctx))
+	if asOf := GetAsOf(ctx); !asOf.IsZero() {
+		n = WithAsOf(n, asOf)
+	}
+	if z, ok := ctx.Value(ctxkeyTimezone{}).(*tz.Zone); ok {
+		n = WithTimezone(n, z)
+	}
 	return n
 }
 
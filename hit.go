@@ -9,11 +9,13 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"time"
 
 	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/zdb"
 	"zgo.at/zlog"
 	"zgo.at/zstd/zint"
@@ -32,6 +34,7 @@ type Hit struct {
 	OldSession *int64 `db:"session" json:"-"`
 
 	Path  string     `db:"path" json:"p,omitempty"`
+	Host  string     `db:"host" json:"h,omitempty"`
 	Title string     `db:"title" json:"t,omitempty"`
 	Ref   string     `db:"ref" json:"r,omitempty"`
 	Event zdb.Bool   `db:"event" json:"e,omitempty"`
@@ -39,18 +42,46 @@ type Hit struct {
 	Query string     `db:"-" json:"q,omitempty"`
 	Bot   int        `db:"bot" json:"b,omitempty"`
 
+	// BotScore is the likelihood that this is a bot from 0 to 100, and
+	// BotReasons are the reasons for it. Bot is set if the score is at or
+	// above the site's BotThreshold.
+	BotScore   int        `db:"bot_score" json:"-"`
+	BotReasons BotReasons `db:"bot_reasons" json:"-"`
+
+	// Sample is the sampling factor of the site when this was recorded; see
+	// Weight().
+	Sample int `db:"sample" json:"-"`
+
 	RefScheme  *string   `db:"ref_scheme" json:"-"`
 	Browser    string    `db:"browser" json:"-"`
 	Location   string    `db:"location" json:"-"`
+	Region     string    `db:"region" json:"-"`
+	City       string    `db:"city" json:"-"`
 	FirstVisit zdb.Bool  `db:"first_visit" json:"-"`
 	CreatedAt  time.Time `db:"created_at" json:"-"`
 
+	// Campaign parameters from the utm_source, utm_medium, and utm_campaign
+	// query parameters.
+	UTMSource   string `db:"utm_source" json:"-"`
+	UTMMedium   string `db:"utm_medium" json:"-"`
+	UTMCampaign string `db:"utm_campaign" json:"-"`
+
+	// User-Agent Client Hints from the Sec-CH-UA, Sec-CH-UA-Platform, and
+	// Sec-CH-UA-Platform-Version headers; these are preferred over the
+	// User-Agent header if set. See BrowserInfo() and SystemInfo().
+	UABrands          string `db:"ua_brands" json:"-"`
+	UAPlatform        string `db:"ua_platform" json:"-"`
+	UAPlatformVersion string `db:"ua_platform_version" json:"-"`
+
 	RefURL *url.URL `db:"-" json:"-"`   // Parsed Ref
 	Random string   `db:"-" json:"rnd"` // Browser cache buster, as they don't always listen to Cache-Control
 
 	// Some values we need to pass from the HTTP handler to memstore
 	RemoteAddr    string `db:"-" json:"-"`
 	UserSessionID string `db:"-" json:"-"`
+	Honeypot      bool   `db:"-" json:"-"` // Request for the honeypot link; see Memstore.Honeypot().
+
+	ingest *ingestTrace // Set if this hit is recorded in the IngestLog.
 }
 
 func (h *Hit) cleanPath(ctx context.Context) {
@@ -69,6 +100,7 @@ func (h *Hit) cleanPath(ctx context.Context) {
 			if s := strings.IndexRune(h.Path, '/'); s > -1 {
 				h.Path = h.Path[s:]
 			}
+			h.IngestNote("path: removed offline reader prefix")
 		}
 
 		// Internet archive.
@@ -80,6 +112,7 @@ func (h *Hit) cleanPath(ctx context.Context) {
 				if q := u.Query().Encode(); q != "" {
 					h.Path += "?" + q
 				}
+				h.IngestNote("path: removed Internet Archive prefix")
 			}
 		}
 	}
@@ -116,11 +149,59 @@ func (h *Hit) cleanPath(ctx context.Context) {
 			q.Del("from")
 		}
 
+		if h.ingest != nil {
+			var removed []string
+			for k := range u.Query() {
+				if _, ok := q[k]; !ok {
+					removed = append(removed, k)
+				}
+			}
+			if len(removed) > 0 {
+				sort.Strings(removed)
+				h.IngestNote("path: removed tracking parameters %s", strings.Join(removed, ", "))
+			}
+		}
+
 		u.RawQuery = q.Encode()
 		h.Path = u.String()
 	}
 }
 
+// Device classes, derived from the screen size.
+const (
+	DeviceClassPhone   = "phone"
+	DeviceClassTablet  = "tablet"
+	DeviceClassDesktop = "desktop"
+	DeviceClassLarge   = "large" // Larger than HD.
+)
+
+// DeviceClass gets the device class for the screen size as sent by count.js:
+// the width, height, and scale, with the width and height in CSS pixels.
+//
+// Phones are classified on the shortest side, so that phones in landscape mode
+// are still counted as phones. This returns an empty string if the size is
+// unknown.
+func DeviceClass(size zdb.Floats) string {
+	if len(size) == 0 || size[0] <= 0 {
+		return ""
+	}
+
+	width, short := size[0], size[0]
+	if len(size) > 1 && size[1] > 0 && size[1] < short {
+		short = size[1]
+	}
+	switch {
+	case short < 600:
+		return DeviceClassPhone
+	case width <= 1024:
+		return DeviceClassTablet
+	case width <= 1920:
+		return DeviceClassDesktop
+	default:
+		return DeviceClassLarge
+	}
+}
+
 func (h Hit) String() string {
 	b := new(bytes.Buffer)
 	t := tabwriter.NewWriter(b, 8, 8, 2, ' ', 0)
@@ -128,6 +209,9 @@ func (h Hit) String() string {
 	fmt.Fprintf(t, "Site\t%d\n", h.Site)
 	fmt.Fprintf(t, "Session\t%s\n", h.Session.Format(16))
 	fmt.Fprintf(t, "Path\t%q\n", h.Path)
+	fmt.Fprintf(t, "Host\t%q\n", h.Host)
+	fmt.Fprintf(t, "UTM\t%q %q %q\n", h.UTMSource, h.UTMMedium, h.UTMCampaign)
+	fmt.Fprintf(t, "UA hints\t%q %q %q\n", h.UABrands, h.UAPlatform, h.UAPlatformVersion)
 	fmt.Fprintf(t, "Title\t%q\n", h.Title)
 	fmt.Fprintf(t, "Ref\t%q\n", h.Ref)
 	fmt.Fprintf(t, "Event\t%t\n", h.Event)
@@ -139,7 +223,10 @@ func (h Hit) String() string {
 	fmt.Fprintf(t, "Browser\t%q\n", h.Browser)
 	fmt.Fprintf(t, "Size\t%q\n", h.Size)
 	fmt.Fprintf(t, "Location\t%q\n", h.Location)
+	fmt.Fprintf(t, "Region\t%q\n", h.Region)
+	fmt.Fprintf(t, "City\t%q\n", h.City)
 	fmt.Fprintf(t, "Bot\t%d\n", h.Bot)
+	fmt.Fprintf(t, "BotScore\t%d %s\n", h.BotScore, h.BotReasons)
 	fmt.Fprintf(t, "CreatedAt\t%s\n", h.CreatedAt)
 	t.Flush()
 	return b.String()
@@ -155,24 +242,29 @@ func (h *Hit) Defaults(ctx context.Context) {
 	}
 
 	h.cleanPath(ctx)
+	h.Host = strings.ToLower(strings.TrimSuffix(h.Host, "."))
 
 	// Set campaign.
 	if !h.Event && h.Query != "" {
 		if h.Query[0] != '?' {
 			h.Query = "?" + h.Query
 		}
-		u, err := url.Parse(h.Query)
-		if err != nil {
-			return
-		}
-		q := u.Query()
-
-		for _, c := range site.Settings.Campaigns {
-			if _, ok := q[c]; ok {
-				h.Ref = q.Get(c)
-				h.RefURL = nil
-				h.RefScheme = RefSchemeCampaign
-				break
+		// Don't return on errors: the bot rules below should always be
+		// checked.
+		if u, err := url.Parse(h.Query); err == nil {
+			q := u.Query()
+
+			h.UTMSource = strings.TrimSpace(q.Get("utm_source"))
+			h.UTMMedium = strings.TrimSpace(q.Get("utm_medium"))
+			h.UTMCampaign = strings.TrimSpace(q.Get("utm_campaign"))
+
+			for _, c := range site.Settings.Campaigns {
+				if _, ok := q[c]; ok {
+					h.Ref = q.Get(c)
+					h.RefURL = nil
+					h.RefScheme = RefSchemeCampaign
+					break
+				}
 			}
 		}
 	}
@@ -194,6 +286,8 @@ func (h *Hit) Defaults(ctx context.Context) {
 	if !h.Event {
 		h.Path = "/" + strings.Trim(h.Path, "/")
 	}
+
+	h.scoreBot(site)
 }
 
 // Validate the object.
@@ -205,6 +299,13 @@ func (h *Hit) Validate(ctx context.Context) error {
 	v.Required("path", h.Path)
 	v.Required("created_at", h.CreatedAt)
 	v.UTF8("path", h.Path)
+	v.Len("host", h.Host, 0, 255)
+	v.Len("utm_source", h.UTMSource, 0, 255)
+	v.Len("utm_medium", h.UTMMedium, 0, 255)
+	v.Len("utm_campaign", h.UTMCampaign, 0, 255)
+	v.Len("ua_brands", h.UABrands, 0, 512)
+	v.Len("ua_platform", h.UAPlatform, 0, 255)
+	v.Len("ua_platform_version", h.UAPlatformVersion, 0, 255)
 	v.UTF8("title", h.Title)
 	v.UTF8("ref", h.Ref)
 	v.UTF8("browser", h.Browser)
@@ -226,13 +327,60 @@ type Hits []Hit
 
 // List all hits for a site, including bot requests.
 func (h *Hits) List(ctx context.Context, limit, paginate int64) (int64, error) {
-	if limit == 0 || limit > 5000 {
-		limit = 5000
+	return h.ListRange(ctx, limit, paginate, HitRange{})
+}
+
+// HitRange limits the hits listed with Hits.ListRange(); the zero value doesn't
+// limit anything.
+type HitRange struct {
+	Start *time.Time // Only hits created on or after this.
+	End   *time.Time // Only hits created before this.
+
+	// Only hits with a path that matches the LIKE pattern (case-insensitive)
+	// or that is in Paths. Both are ignored if they're empty.
+	PathLike string
+	Paths    []string
+}
+
+// ListRange lists all hits like List(), but only hits in the range.
+//
+// The limit is capped to cfg.MaxHits; 0 means no limit other than that.
+func (h *Hits) ListRange(ctx context.Context, limit, paginate int64, r HitRange) (int64, error) {
+	if limit <= 0 || limit > int64(cfg.MaxHits) {
+		limit = int64(cfg.MaxHits)
 	}
 
-	err := zdb.MustGet(ctx).SelectContext(ctx, h,
-		`select * from hits where site=$1 and id>$2 order by id asc limit $3`,
-		MustGetSite(ctx).ID, paginate, limit)
+	query := `select * from hits where site=$1 and id>$2 `
+	args := []interface{}{MustGetSite(ctx).ID, paginate}
+	if r.Start != nil {
+		args = append(args, r.Start.UTC().Format(zdb.Date))
+		query += fmt.Sprintf(` and created_at >= $%d `, len(args))
+	}
+	if r.End != nil {
+		args = append(args, r.End.UTC().Format(zdb.Date))
+		query += fmt.Sprintf(` and created_at < $%d `, len(args))
+	}
+	if r.PathLike != "" || len(r.Paths) > 0 {
+		var or []string
+		if r.PathLike != "" {
+			q, a := likePath(MustGetSite(ctx), len(args)+1, r.PathLike)
+			args = append(args, a)
+			or = append(or, q)
+		}
+		if len(r.Paths) > 0 {
+			in := make([]string, 0, len(r.Paths))
+			for _, p := range r.Paths {
+				args = append(args, p)
+				in = append(in, fmt.Sprintf("$%d", len(args)))
+			}
+			or = append(or, `path in (`+strings.Join(in, ", ")+`)`)
+		}
+		query += ` and (` + strings.Join(or, " or ") + `) `
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(` order by id asc limit $%d`, len(args))
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, h, query, args...)
 
 	last := paginate
 	if len(*h) > 0 {
@@ -240,7 +388,7 @@ func (h *Hits) List(ctx context.Context, limit, paginate int64) (int64, error) {
 		last = hh[len(hh)-1].ID
 	}
 
-	return last, errors.Wrap(err, "Hits.List")
+	return last, errors.Wrap(err, "Hits.ListRange")
 }
 
 // Count the number of pageviews.
@@ -252,35 +400,63 @@ func (h *Hits) Count(ctx context.Context) (int64, error) {
 	return c, errors.Wrap(err, "Hits.Count")
 }
 
-// Purge all paths matching the like pattern.
-func (h *Hits) Purge(ctx context.Context, path string, matchTitle bool) error {
-	query := `/* Hits.Purge */
-		delete from %s where site=$1 and lower(path) like lower($2)`
+// purgeWhere gets the condition for the rows Purge() removes, and the
+// parameters for it from $2 onwards.
+func purgeWhere(site *Site, path string, matchTitle bool) (string, []interface{}) {
+	where, arg := likePath(site, 2, path)
 	if matchTitle {
-		query += ` and lower(title) like lower($2) `
+		return where + ` and lower(title) like lower($3) `, []interface{}{arg, path}
 	}
+	return where, []interface{}{arg}
+}
 
-	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		site := MustGetSite(ctx).ID
+// Purge all paths matching the like pattern.
+//
+// Use PurgePreview() to see what would be removed. The purge is recorded as an
+// Operation, so it can be undone for a while.
+func (h *Hits) Purge(ctx context.Context, path string, matchTitle bool) error {
+	var (
+		s                 = MustGetSite(ctx)
+		site              = s.ID
+		where, whereArgs  = purgeWhere(s, path, matchTitle)
+		refWhere, refArgs = purgeWhere(s, path, false)
+		query             = `/* Hits.Purge */ delete from %s where site=$1 and` + where
+	)
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		op := Operation{Kind: OperationPurge, Path: path, MatchTitle: zdb.Bool(matchTitle)}
+		err := op.journal(ctx, where, whereArgs...)
+		if err != nil {
+			return err
+		}
 
 		for _, t := range []string{"hits", "hit_stats", "hit_counts"} {
-			_, err := tx.ExecContext(ctx, fmt.Sprintf(query, t), site, path)
+			_, err := tx.ExecContext(ctx, fmt.Sprintf(query, t), append([]interface{}{site}, whereArgs...)...)
 			if err != nil {
 				return errors.Wrapf(err, "Hits.Purge %s", t)
 			}
 		}
-		_, err := tx.ExecContext(ctx, `/* Hits.Purge */
-			delete from ref_counts where site=$1 and lower(path) like lower($2)`,
-			site, path)
+		_, err = tx.ExecContext(ctx, `/* Hits.Purge */
+			delete from ref_counts where site=$1 and`+refWhere,
+			append([]interface{}{site}, refArgs...)...)
 		if err != nil {
 			return errors.Wrap(err, "Hits.Purge ref_counts")
 		}
 
+		// The title isn't recorded with the fingerprints of imported rows, so
+		// this removes the fingerprints for all matching paths.
+		_, err = tx.ExecContext(ctx, `/* Hits.Purge */
+			delete from import_fingerprints where site=$1 and`+refWhere,
+			append([]interface{}{site}, refArgs...)...)
+		if err != nil {
+			return errors.Wrap(err, "Hits.Purge import_fingerprints")
+		}
+
 		// Delete all other stats as well if there's nothing left: not much use
 		// for it.
 		var check Hits
 		n, err := check.Count(ctx)
 		if err == nil && n == 0 {
+			op.AllStats = true
 			for _, t := range statTables {
 				_, err := tx.ExecContext(ctx, `delete from `+t+` where site=$1`, site)
 				if err != nil {
@@ -289,8 +465,110 @@ func (h *Hits) Purge(ctx context.Context, path string, matchTitle bool) error {
 			}
 		}
 
-		return nil
+		return op.finish(ctx)
 	})
+	if err != nil {
+		return err
+	}
+
+	// Pageviews that are still in the memstore would be added back on the next
+	// persist; this includes the memstore of other instances.
+	Memstore.DropPath(site, path, matchTitle, s.Settings.CaseSensitivePaths)
+	Broadcast(ctx, BroadcastMessage{Kind: BroadcastPurge, Site: site, Path: path, MatchTitle: matchTitle,
+		CaseSensitive: s.Settings.CaseSensitivePaths})
+	return nil
+}
+
+// PurgePreview is what Hits.Purge() would remove.
+type PurgePreview struct {
+	// Number of pageviews.
+	Hits int `json:"hits"`
+
+	// Number of rows in the statistics tables, by table name.
+	StatRows map[string]int `json:"stat_rows"`
+
+	// Paths and titles with the number of pageviews, most pageviews first.
+	Paths []PurgePath `json:"paths"`
+
+	// All statistics are removed, including those that aren't stored per path
+	// (browsers, locations, etc.), as there are no pageviews left after the
+	// purge. StatRows includes these tables if this is set.
+	All bool `json:"all"`
+}
+
+// PurgePath is a path that would be removed by Hits.Purge().
+type PurgePath struct {
+	Path  string `db:"path" json:"path"`
+	Title string `db:"title" json:"title"`
+	Count int    `db:"count" json:"count"`
+}
+
+// PurgePreview gets what Purge() would remove with the same parameters,
+// without removing anything.
+//
+// Pageviews that are still in the memstore aren't included.
+func (h *Hits) PurgePreview(ctx context.Context, path string, matchTitle bool) (PurgePreview, error) {
+	var (
+		db               = zdb.MustGet(ctx)
+		s                = MustGetSite(ctx)
+		site             = s.ID
+		p                = PurgePreview{StatRows: make(map[string]int)}
+		where, whereArgs = purgeWhere(s, path, matchTitle)
+		args             = append([]interface{}{site}, whereArgs...)
+	)
+
+	err := db.GetContext(ctx, &p.Hits, `/* Hits.PurgePreview */
+		select count(*) from hits where site=$1 and`+where, args...)
+	if err != nil {
+		return p, errors.Wrap(err, "Hits.PurgePreview")
+	}
+
+	for _, t := range []string{"hit_stats", "hit_counts", "ref_counts"} {
+		w, a := where, args
+		if t == "ref_counts" { // Doesn't have a title.
+			var refArgs []interface{}
+			w, refArgs = purgeWhere(s, path, false)
+			a = append([]interface{}{site}, refArgs...)
+		}
+		var n int
+		err := db.GetContext(ctx, &n, `/* Hits.PurgePreview */
+			select count(*) from `+t+` where site=$1 and`+w, a...)
+		if err != nil {
+			return p, errors.Wrapf(err, "Hits.PurgePreview %s", t)
+		}
+		p.StatRows[t] = n
+	}
+
+	err = db.SelectContext(ctx, &p.Paths, `/* Hits.PurgePreview */
+		select path, title, sum(total) as count from hit_counts
+		where site=$1 and`+where+`
+		group by path, title
+		order by count desc, path asc`, args...)
+	if err != nil {
+		return p, errors.Wrap(err, "Hits.PurgePreview")
+	}
+
+	var purged int64
+	for _, pp := range p.Paths {
+		purged += int64(pp.Count)
+	}
+	total, err := h.Count(ctx)
+	if err != nil {
+		return p, errors.Wrap(err, "Hits.PurgePreview")
+	}
+	p.All = purged == total
+	if p.All {
+		for _, t := range statTables {
+			var n int
+			err := db.GetContext(ctx, &n, `/* Hits.PurgePreview */
+				select count(*) from `+t+` where site=$1`, site)
+			if err != nil {
+				return p, errors.Wrapf(err, "Hits.PurgePreview %s", t)
+			}
+			p.StatRows[t] = n
+		}
+	}
+	return p, nil
 }
 
 type Stat struct {
@@ -310,23 +588,29 @@ type HitStat struct {
 	RefScheme   *string  `db:"ref_scheme"`
 	Max         int
 	Stats       []Stat
+
+	// Scroll depth statistics; only set after HitStats.LoadScroll().
+	Scroll *ScrollStat `db:"-"`
 }
 
 type HitStats []HitStat
 
 // ListPathsLike lists all paths matching the like pattern.
 func (h *HitStats) ListPathsLike(ctx context.Context, path string, matchTitle bool) error {
-	t := ""
+	site := MustGetSite(ctx)
+	where, arg := likePath(site, 2, path)
+	args := []interface{}{site.ID, arg}
 	if matchTitle {
-		t = " or lower(title) like lower($2) "
+		where += " or lower(title) like lower($3) "
+		args = append(args, path)
 	}
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, h, `
 		select path, title, sum(total) as count from hit_counts
-		where site=$1 and (lower(path) like lower($2) `+t+`)
+		where site=$1 and (`+where+`)
 		group by path, title
 		order by count desc
-	`, MustGetSite(ctx).ID, path)
+	`, args...)
 	return errors.Wrap(err, "Hits.ListPathsLike")
 }
 
@@ -342,10 +626,19 @@ type StatT struct {
 type Stats struct {
 	More  bool
 	Stats []StatT
+
+	// Estimated is set if the counts are extrapolated from a sample of the
+	// pageviews; see SiteSettings.Sampling.
+	Estimated bool
 }
 
-// ByRef lists all paths by reference.
-func (h *Stats) ByRef(ctx context.Context, start, end time.Time, ref string) error {
+// ByRef lists the top paths for the reference; the limit is capped to
+// cfg.MaxStats.
+func (h *Stats) ByRef(ctx context.Context, start, end time.Time, ref string, limit int) error {
+	if limit <= 0 || limit > cfg.MaxStats {
+		limit = cfg.MaxStats
+	}
+
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ByRef */
 		select
 			path as name,
@@ -358,8 +651,9 @@ func (h *Stats) ByRef(ctx context.Context, start, end time.Time, ref string) err
 			ref = $4
 		group by path
 		order by count desc
-		limit 10`,
-		MustGetSite(ctx).ID, start.Format(zdb.Date), end.Format(zdb.Date), ref)
+		limit $5`,
+		MustGetSite(ctx).ID, start.Format(zdb.Date), clampAsOf(ctx, start, end).Format(zdb.Date), ref, limit)
 
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ByRef")
 }
@@ -0,0 +1,98 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"zgo.at/errors"
+)
+
+// HitFields are the fields that can be selected with Hit.Fields(); these are
+// the same as the columns in a CSV export.
+var HitFields = []string{"id", "path", "title", "event", "bot", "session",
+	"first_visit", "ref", "ref_scheme", "browser", "size", "location",
+	"created_at"}
+
+const hitCursorPrefix = "h1:"
+
+// EncodeHitCursor gets an opaque cursor to list the hits after the hit ID.
+func EncodeHitCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(hitCursorPrefix + strconv.FormatInt(id, 10)))
+}
+
+// DecodeHitCursor gets the hit ID from a cursor created with
+// EncodeHitCursor(). An empty cursor is the start of the list.
+func DecodeHitCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), hitCursorPrefix) {
+		return 0, errors.New("invalid cursor")
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(string(b), hitCursorPrefix), 10, 64)
+	if err != nil || id < 0 {
+		return 0, errors.New("invalid cursor")
+	}
+	return id, nil
+}
+
+// SessionString gets the session as a string: the old incremental ID if it's
+// set, or the UUID.
+func (h Hit) SessionString() string {
+	if h.OldSession != nil {
+		return strconv.FormatInt(*h.OldSession, 10)
+	}
+	var u uuid.UUID
+	copy(u[:], h.Session.Bytes())
+	return u.String()
+}
+
+// Fields gets the values of the fields, which must be in HitFields; all fields
+// are returned if fields is empty.
+func (h Hit) Fields(fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		fields = HitFields
+	}
+
+	m := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "id":
+			m[f] = h.ID
+		case "path":
+			m[f] = h.Path
+		case "title":
+			m[f] = h.Title
+		case "event":
+			m[f] = bool(h.Event)
+		case "bot":
+			m[f] = h.Bot
+		case "session":
+			m[f] = h.SessionString()
+		case "first_visit":
+			m[f] = bool(h.FirstVisit)
+		case "ref":
+			m[f] = h.Ref
+		case "ref_scheme":
+			m[f] = h.RefScheme
+		case "browser":
+			m[f] = h.Browser
+		case "size":
+			m[f] = h.Size
+		case "location":
+			m[f] = h.Location
+		case "created_at":
+			m[f] = h.CreatedAt.UTC().Format(time.RFC3339)
+		}
+	}
+	return m
+}
@@ -14,102 +14,224 @@ import (
 	"github.com/jmoiron/sqlx"
 	"zgo.at/errors"
 	"zgo.at/goatcounter/cfg"
+	"zgo.at/tz"
 	"zgo.at/zdb"
 	"zgo.at/zstd/zint"
 	"zgo.at/zstd/zjson"
 )
 
-var allDays = []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+// OtherPages is the aggregated remainder of all pages that weren't listed by
+// HitStats.List().
+type OtherPages struct {
+	Paths       int `json:"paths"`
+	Count       int `json:"count"`
+	CountUnique int `json:"count_unique"`
+}
+
+// PageList is the list of paths with the totals for the period; see
+// HitStats.ListPages().
+type PageList struct {
+	// Totals of the listed paths.
+	Display, UniqueDisplay int
+
+	// Set if there are more paths after this page; Other is the remainder
+	// for these paths.
+	More  bool
+	Other OtherPages
+
+	// Totals of all paths; events are included unless GetTotalsEvents() is
+	// false.
+	Total, TotalUnique int
+
+	// Max for the charts; this always includes events, as they're listed with
+	// the paths.
+	Max int
+}
 
 // List the top paths for this site in the given time period.
+//
+// This is the same as ListPages(), without the totals of all paths.
 func (h *HitStats) List(
-	ctx context.Context, start, end time.Time, filter string, exclude []string, daily bool,
-) (int, int, bool, error) {
+	ctx context.Context, start, end time.Time, filter, host string, exclude []string, daily bool,
+) (totalDisplay, totalUniqueDisplay int, more bool, other OtherPages, err error) {
+	l, err := h.ListPages(ctx, start, end, filter, host, exclude, daily)
+	return l.Display, l.UniqueDisplay, l.More, l.Other, err
+}
+
+// ListPages lists the top paths for this site in the given time period, and
+// gets the totals and max of all paths for the dashboard. These are all read
+// from the same filtered hit_counts rows in a single query.
+//
+// If host is set then only the pageviews on that host are counted.
+//
+// If there are more paths than the page limit then More is true and Other is
+// set to the totals for all paths after this page, excluding the paths in
+// exclude. The totals and max include the excluded paths.
+func (h *HitStats) ListPages(
+	ctx context.Context, start, end time.Time, filter, host string, exclude []string, daily bool,
+) (PageList, error) {
 	db := zdb.MustGet(ctx)
 	site := MustGetSite(ctx)
-
-	if filter != "" {
-		filter = "%" + strings.ToLower(filter) + "%"
-	}
+	var pl PageList
 
 	// Select hits.
-	var more bool
 	{
 		// Get one page more so we can detect if there are more pages after this.
 		limit := int(zint.NonZero(int64(site.Settings.Limits.Page), 10)) + 1
 
-		query := `/* HitStats.List: get overview */
-			select path, event from hit_counts
-			where
-				site=? and
-				hour>=? and
-				hour<=? `
-		args := []interface{}{site.ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-
-		if filter != "" {
-			query += ` and (lower(path) like ? or lower(title) like ?) `
-			args = append(args, filter, filter)
+		where, whereArgs := newPathFilter(site, filter).add("", nil)
+		where, whereArgs = hostFilter(host).add(where, whereArgs)
+
+		// The max needs the hours or days in the site's TZ, so the daily
+		// rollups can only be used for the daily view if there's no TZ offset.
+		// The rollups also only store the latest title, which the filter
+		// matches on, and aren't stored per host.
+		parts := rollupParts{hours: [][2]time.Time{{start, clampAsOf(ctx, start, end)}}}
+		if daily && GetTimezone(ctx).Offset() == 0 && filter == "" && host == "" {
+			var err error
+			parts, err = getRollupParts(ctx, "hit_counts", start, end, false)
+			if err != nil {
+				return pl, errors.Wrap(err, "HitStats.ListPages")
+			}
+		}
+		from, args := parts.query("hit_counts", "path, event, total, total_unique", site.ID, where, whereArgs)
+
+		// There's a row for every host, so sum them per path and hour (or
+		// day) before getting the max.
+		max := `select max(m.t) from (select sum(total) as t from x group by path, hour) m`
+		if daily {
+			group := `date(hour, ?)`
+			if cfg.PgSQL {
+				group = `date(timezone(?, hour))`
+			}
+			max = `select max(m.t) from (select sum(total) as t from x group by path, ` + group + `) m`
+			args = append(args, GetTimezone(ctx).OffsetRFC3339())
 		}
 
-		// Quite a bit faster to not check path.
+		// The excluded paths are only excluded from the list, not from the
+		// totals and max.
+		var excludeWhere string
 		if len(exclude) > 0 {
+			excludeWhere = ` where path not in (?) `
 			args = append(args, exclude)
-			query += ` and path not in (?) `
 		}
 
-		query, args, err := sqlx.In(query+`
-			group by path, event
-			order by sum(total_unique) desc, path desc
-			limit ?`, append(args, limit)...)
+		// The window functions get the totals for all paths before the limit
+		// is applied, so we get the remainder without another query. The
+		// totals are joined so there's always a row, even if there are no
+		// paths.
+		query, args, err := sqlx.In(`/* HitStats.ListPages */
+			with x as (`+from+`)
+			select
+				coalesce(l.path, '')             as path,
+				coalesce(l.event, 0)             as event,
+				coalesce(l.count, 0)             as count,
+				coalesce(l.count_unique, 0)      as count_unique,
+				coalesce(l.all_paths, 0)         as all_paths,
+				coalesce(l.all_count, 0)         as all_count,
+				coalesce(l.all_count_unique, 0)  as all_count_unique,
+				t.total, t.total_unique, t.max
+			from (
+				select
+					coalesce((select sum(total) from x where 1=1 `+totalsEventsWhere(ctx)+`), 0)        as total,
+					coalesce((select sum(total_unique) from x where 1=1 `+totalsEventsWhere(ctx)+`), 0) as total_unique,
+					coalesce((`+max+`), 0) as max
+			) t
+			left join (
+				select
+					path, event,
+					sum(total)                     as count,
+					sum(total_unique)              as count_unique,
+					count(*) over ()               as all_paths,
+					sum(sum(total)) over ()        as all_count,
+					sum(sum(total_unique)) over () as all_count_unique
+				from x `+excludeWhere+`
+				group by path, event
+				order by sum(total_unique) desc, path desc
+				limit ?
+			) l on 1=1
+			order by l.count_unique desc, l.path desc`, append(args, limit)...)
 		if err != nil {
-			return 0, 0, false, errors.Wrap(err, "HitStats.List")
+			return pl, errors.Wrap(err, "HitStats.ListPages")
+		}
+
+		var l []struct {
+			Path           string   `db:"path"`
+			Event          zdb.Bool `db:"event"`
+			Count          int      `db:"count"`
+			CountUnique    int      `db:"count_unique"`
+			AllPaths       int      `db:"all_paths"`
+			AllCount       int      `db:"all_count"`
+			AllCountUnique int      `db:"all_count_unique"`
+			Total          int      `db:"total"`
+			TotalUnique    int      `db:"total_unique"`
+			Max            int      `db:"max"`
 		}
-		err = db.SelectContext(ctx, h, db.Rebind(query), args...)
+		err = db.SelectContext(ctx, &l, db.Rebind(query), args...)
 		if err != nil {
-			return 0, 0, false, errors.Wrap(err, "HitStats.List get hit_counts")
+			return pl, errors.Wrap(err, "HitStats.ListPages get hit_counts")
+		}
+
+		pl.Total, pl.TotalUnique, pl.Max = l[0].Total, l[0].TotalUnique, l[0].Max
+		if pl.Max < 10 {
+			pl.Max = 10
+		}
+		if l[0].AllPaths == 0 { // Only the totals.
+			l = nil
 		}
 
 		// Check if there are more entries.
-		if len(*h) == limit {
-			hh := *h
-			hh = hh[:len(hh)-1]
-			*h = hh
-			more = true
+		if len(l) == limit {
+			l = l[:len(l)-1]
+			pl.More = true
+			pl.Other = OtherPages{Paths: l[0].AllPaths, Count: l[0].AllCount, CountUnique: l[0].AllCountUnique}
 		}
-	}
 
-	// Add stats and title.
-	var st []struct {
-		Path        string    `db:"path"`
-		Title       string    `db:"title"`
-		Day         time.Time `db:"day"`
-		Stats       []byte    `db:"stats"`
-		StatsUnique []byte    `db:"stats_unique"`
+		hh := make(HitStats, len(l))
+		for i := range l {
+			hh[i] = HitStat{Path: l[i].Path, Event: l[i].Event}
+			pl.Other.Paths--
+			pl.Other.Count -= l[i].Count
+			pl.Other.CountUnique -= l[i].CountUnique
+		}
+		if !pl.More {
+			pl.Other = OtherPages{}
+		}
+		*h = hh
 	}
-	{
-		query := `/* HitStats.List: get stats */
+
+	hh := *h
+
+	// Add stats and title; hit_stats isn't stored per host, so get them from
+	// hit_counts if there's a host.
+	if host != "" {
+		err := hh.statsFromCounts(ctx, start, end, host)
+		if err != nil {
+			return pl, errors.Wrap(err, "HitStats.ListPages")
+		}
+	} else {
+		var st []struct {
+			Path        string    `db:"path"`
+			Title       string    `db:"title"`
+			Day         time.Time `db:"day"`
+			Stats       []byte    `db:"stats"`
+			StatsUnique []byte    `db:"stats_unique"`
+		}
+		query := `/* HitStats.ListPages: get stats */
 			select path, title, day, stats, stats_unique
 			from hit_stats
 			where
-				site=$1 and
-				day >= $2 and
-				day <= $3 `
-		args := []interface{}{site.ID, start.Format("2006-01-02"), end.Format("2006-01-02")}
-		if filter != "" {
-			query += ` and (lower(path) like $4 or lower(title) like $4) `
-			args = append(args, filter)
-		}
+				site=? and
+				day >= ? and
+				day <= ? `
+		args := []interface{}{site.ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02")}
+		query, args = newPathFilter(site, filter).add(query, args)
 		query += ` order by day asc`
-		err := db.SelectContext(ctx, &st, query, args...)
+		err := db.SelectContext(ctx, &st, db.Rebind(query), args...)
 		if err != nil {
-			return 0, 0, false, errors.Wrap(err, "HitStats.List get hit_stats")
+			return pl, errors.Wrap(err, "HitStats.ListPages get hit_stats")
 		}
-	}
 
-	hh := *h
-
-	// Add the hit_stats.
-	{
 		for i := range hh {
 			for _, s := range st {
 				if s.Path == hh[i].Path {
@@ -127,17 +249,76 @@ func (h *HitStats) List(
 		}
 	}
 
-	// Fill in blank days.
-	fillBlankDays(hh, start, end)
+	// Fill in blank days, from the first pageview if ClampFirstHit is set.
+	first, _ := site.ClampStart(start, end, GetTimezone(ctx))
+	fillBlankDays(hh, first, end)
 
 	// Apply TZ offset.
-	applyOffset(hh, *site)
+	applyOffset(hh, GetTimezone(ctx))
 
 	// Add total and max.
-	var totalDisplay, totalUniqueDisplay int
-	addTotals(hh, daily, &totalDisplay, &totalUniqueDisplay)
+	addTotals(hh, daily, &pl.Display, &pl.UniqueDisplay)
 
-	return totalDisplay, totalUniqueDisplay, more, nil
+	return pl, nil
+}
+
+// statsFromCounts sets the stats and title of the paths from the hit_counts on
+// the host.
+func (h HitStats) statsFromCounts(ctx context.Context, start, end time.Time, host string) error {
+	if len(h) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(h))
+	for _, hh := range h {
+		paths = append(paths, hh.Path)
+	}
+	query, args, err := sqlx.In(`/* HitStats.ListPages: get stats from hit_counts */
+		select path, title, hour, total, total_unique from hit_counts
+		where site=? and hour>=? and hour<=? and path in (?) `+hostFilter(host).query+`
+		order by hour asc`,
+		MustGetSite(ctx).ID, start.Format(zdb.Date), clampAsOf(ctx, start, end).Format(zdb.Date),
+		paths, strings.ToLower(host))
+	if err != nil {
+		return errors.Wrap(err, "get hit_counts")
+	}
+
+	var tc []struct {
+		Path        string    `db:"path"`
+		Title       string    `db:"title"`
+		Hour        time.Time `db:"hour"`
+		Total       int       `db:"total"`
+		TotalUnique int       `db:"total_unique"`
+	}
+	db := zdb.MustGet(ctx)
+	err = db.SelectContext(ctx, &tc, db.Rebind(query), args...)
+	if err != nil {
+		return errors.Wrap(err, "get hit_counts")
+	}
+
+	for i := range h {
+		for _, t := range tc {
+			if t.Path != h[i].Path {
+				continue
+			}
+			if t.Title != "" {
+				h[i].Title = t.Title
+			}
+
+			d := t.Hour.Format("2006-01-02")
+			if n := len(h[i].Stats); n == 0 || h[i].Stats[n-1].Day != d {
+				h[i].Stats = append(h[i].Stats, Stat{
+					Day:          d,
+					Hourly:       make([]int, 24),
+					HourlyUnique: make([]int, 24),
+				})
+			}
+			s := &h[i].Stats[len(h[i].Stats)-1]
+			s.Hourly[t.Hour.Hour()] += t.Total
+			s.HourlyUnique[t.Hour.Hour()] += t.TotalUnique
+		}
+	}
+	return nil
 }
 
 // PathTotals is a special path to indicate this is the "total" overview.
@@ -146,27 +327,60 @@ func (h *HitStats) List(
 const PathTotals = "TOTAL "
 
 // Totals gets the totals overview of all pages.
-func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter string, daily bool) (int, error) {
+//
+// Events are included unless GetTotalsEvents() is false. The host is applied
+// as in HitStats.List().
+func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter, host string, daily bool) (int, error) {
 	db := zdb.MustGet(ctx)
 	site := MustGetSite(ctx)
 
-	query := `/* HitStat.Totals */
-		select hour, total, total_unique from hit_counts
-		where site=$1 and hour>=$2 and hour<=$3 `
-	args := []interface{}{site.ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-	if filter != "" {
-		query += ` and (lower(path) like lower($4) or lower(title) like lower($4)) `
-		args = append(args, "%"+filter+"%")
+	// The daily rollups are stored as UTC days, so can only be used if the
+	// hours aren't needed and don't need to be shifted to the site's TZ. They
+	// also only store the latest title, which the filter matches on, and
+	// aren't stored per host.
+	parts := rollupParts{hours: [][2]time.Time{{start, clampAsOf(ctx, start, end)}}}
+	if daily && GetTimezone(ctx).Offset() == 0 && filter == "" && host == "" {
+		var err error
+		parts, err = getRollupParts(ctx, "hit_counts", start, end, false)
+		if err != nil {
+			return 0, errors.Errorf("HitStat.Totals: %w", err)
+		}
 	}
-	query += ` order by hour asc`
+	where, whereArgs := newPathFilter(site, filter).add(totalsEventsWhere(ctx), nil)
+	where, whereArgs = hostFilter(host).add(where, whereArgs)
+
 	var tc []struct {
 		Hour        time.Time `db:"hour"`
 		Total       int       `db:"total"`
 		TotalUnique int       `db:"total_unique"`
 	}
-	err := db.SelectContext(ctx, &tc, query, args...)
-	if err != nil {
-		return 0, errors.Errorf("HitStat.Totals: %w", err)
+	if len(parts.hours) > 0 {
+		query, args := rangeWhere("hour", zdb.Date, parts.hours)
+		query = `/* HitStat.Totals */
+			select hour, total, total_unique from hit_counts
+			where site=? and ` + query + where + ` order by hour asc`
+		err := db.SelectContext(ctx, &tc, db.Rebind(query),
+			append(append([]interface{}{site.ID}, args...), whereArgs...)...)
+		if err != nil {
+			return 0, errors.Errorf("HitStat.Totals: %w", err)
+		}
+	}
+
+	var td []struct {
+		Day         time.Time `db:"day"`
+		Total       int       `db:"total"`
+		TotalUnique int       `db:"total_unique"`
+	}
+	if len(parts.days) > 0 {
+		query, args := rangeWhere("day", "2006-01-02", parts.days)
+		query = `/* HitStat.Totals: daily */
+			select day, sum(total) as total, sum(total_unique) as total_unique from hit_counts_daily
+			where site=? and ` + query + where + ` group by day`
+		err := db.SelectContext(ctx, &td, db.Rebind(query),
+			append(append([]interface{}{site.ID}, args...), whereArgs...)...)
+		if err != nil {
+			return 0, errors.Errorf("HitStat.Totals: %w", err)
+		}
 	}
 
 	totalst := HitStat{
@@ -174,9 +388,7 @@ func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter strin
 		Title: "",
 	}
 	stats := make(map[string]Stat)
-	for _, t := range tc {
-		d := t.Hour.Format("2006-01-02")
-		hour, _ := strconv.ParseInt(t.Hour.Format("15"), 10, 32)
+	getStat := func(d string) Stat {
 		s, ok := stats[d]
 		if !ok {
 			s = Stat{
@@ -185,6 +397,12 @@ func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter strin
 				HourlyUnique: make([]int, 24),
 			}
 		}
+		return s
+	}
+	for _, t := range tc {
+		d := t.Hour.Format("2006-01-02")
+		hour, _ := strconv.ParseInt(t.Hour.Format("15"), 10, 32)
+		s := getStat(d)
 
 		s.Hourly[hour] += t.Total
 		s.HourlyUnique[hour] += t.TotalUnique
@@ -194,6 +412,20 @@ func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter strin
 		stats[d] = s
 	}
 
+	// Days from the rollups only have the daily totals; these are added to the
+	// totals of the hours below.
+	for _, t := range td {
+		d := t.Day.Format("2006-01-02")
+		s := getStat(d)
+
+		s.Daily += t.Total
+		s.DailyUnique += t.TotalUnique
+		totalst.Count += t.Total
+		totalst.CountUnique += t.TotalUnique
+
+		stats[d] = s
+	}
+
 	max := 0
 	for _, v := range stats {
 		totalst.Stats = append(totalst.Stats, v)
@@ -211,8 +443,9 @@ func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter strin
 	})
 
 	hh := []HitStat{totalst}
-	fillBlankDays(hh, start, end)
-	applyOffset(hh, *site)
+	first, _ := site.ClampStart(start, end, GetTimezone(ctx))
+	fillBlankDays(hh, first, end)
+	applyOffset(hh, GetTimezone(ctx))
 
 	if daily {
 		for i := range hh[0].Stats {
@@ -264,12 +497,12 @@ func (h *HitStat) Totals(ctx context.Context, start, end time.Time, filter strin
 //
 // Offsets that are not whole hours (e.g. 6:30) are treated like 7:00. I don't
 // know how to do that otherwise.
-func applyOffset(hh HitStats, site Site) {
+func applyOffset(hh HitStats, zone *tz.Zone) {
 	if len(hh) == 0 {
 		return
 	}
 
-	offset := site.Settings.Timezone.Offset()
+	offset := zone.Offset()
 	if offset%60 != 0 {
 		offset += 30
 	}
@@ -279,18 +512,15 @@ func applyOffset(hh HitStats, site Site) {
 	case offset > 0:
 		for i := range hh {
 			stats := hh[i].Stats
+			if len(stats) == 0 {
+				continue
+			}
 
 			popped := make([]int, offset)
 			poppedUnique := make([]int, offset)
 			for i := range stats {
-				stats[i].Hourly = append(popped, stats[i].Hourly...)
-				o := len(stats[i].Hourly) - offset
-				popped = stats[i].Hourly[o:]
-				stats[i].Hourly = stats[i].Hourly[:o]
-
-				stats[i].HourlyUnique = append(poppedUnique, stats[i].HourlyUnique...)
-				poppedUnique = stats[i].HourlyUnique[o:]
-				stats[i].HourlyUnique = stats[i].HourlyUnique[:o]
+				stats[i].Hourly, popped = shiftHours(popped, stats[i].Hourly)
+				stats[i].HourlyUnique, poppedUnique = shiftHours(poppedUnique, stats[i].HourlyUnique)
 			}
 			hh[i].Stats = stats[1:] // Overselect a day to get the stats for it, remove it.
 		}
@@ -300,23 +530,52 @@ func applyOffset(hh HitStats, site Site) {
 
 		for i := range hh {
 			stats := hh[i].Stats
+			if len(stats) == 0 {
+				continue
+			}
 
 			popped := make([]int, offset)
 			poppedUnique := make([]int, offset)
 			for i := len(stats) - 1; i >= 0; i-- {
-				stats[i].Hourly = append(stats[i].Hourly, popped...)
-				popped = stats[i].Hourly[:offset]
-				stats[i].Hourly = stats[i].Hourly[offset:]
-
-				stats[i].HourlyUnique = append(stats[i].HourlyUnique, poppedUnique...)
-				poppedUnique = stats[i].HourlyUnique[:offset]
-				stats[i].HourlyUnique = stats[i].HourlyUnique[offset:]
+				popped, stats[i].Hourly = unshiftHours(stats[i].Hourly, popped)
+				poppedUnique, stats[i].HourlyUnique = unshiftHours(stats[i].HourlyUnique, poppedUnique)
 			}
 			hh[i].Stats = stats[:len(stats)-1] // Overselect a day to get the stats for it, remove it.
 		}
 	}
 }
 
+// shiftHours moves all hours to the right by len(carry), with carry as the
+// first hours. It returns the shifted hours and the hours that were moved out
+// at the end, which are the carry for the next day.
+//
+// The returned slices are always new, so they never share memory with the
+// arguments or each other.
+func shiftHours(carry, hours []int) (shifted, newCarry []int) {
+	n := len(hours) - len(carry)
+	shifted = make([]int, len(hours))
+	copy(shifted, carry)
+	copy(shifted[len(carry):], hours[:n])
+	newCarry = make([]int, len(carry))
+	copy(newCarry, hours[n:])
+	return shifted, newCarry
+}
+
+// unshiftHours moves all hours to the left by len(carry), with carry as the
+// last hours. It returns the hours that were moved out at the start, which
+// are the carry for the previous day, and the shifted hours.
+//
+// The returned slices are always new, so they never share memory with the
+// arguments or each other.
+func unshiftHours(hours, carry []int) (newCarry, shifted []int) {
+	newCarry = make([]int, len(carry))
+	copy(newCarry, hours)
+	shifted = make([]int, len(hours))
+	copy(shifted, hours[len(carry):])
+	copy(shifted[len(hours)-len(carry):], carry)
+	return newCarry, shifted
+}
+
 func fillBlankDays(hh HitStats, start, end time.Time) {
 	// Should Never Happen™ but if it does the below loop will never break, so
 	// be safe.
@@ -336,11 +595,20 @@ func fillBlankDays(hh HitStats, start, end time.Time) {
 			day = day.Add(24 * time.Hour)
 			dayFmt := day.Format("2006-01-02")
 
+			// Keep days before the start; this can happen if the start is
+			// clamped to a first pageview that's not up to date.
+			for len(hh[i].Stats)-1 >= j && hh[i].Stats[j].Day < dayFmt {
+				newStat = append(newStat, hh[i].Stats[j])
+				j++
+			}
+
 			if len(hh[i].Stats)-1 >= j && dayFmt == hh[i].Stats[j].Day {
 				newStat = append(newStat, hh[i].Stats[j])
 				j++
 			} else {
-				newStat = append(newStat, Stat{Day: dayFmt, Hourly: allDays, HourlyUnique: allDays})
+				// Every day gets its own slices, so that changing one day
+				// never changes another.
+				newStat = append(newStat, Stat{Day: dayFmt, Hourly: make([]int, 24), HourlyUnique: make([]int, 24)})
 			}
 			if dayFmt == endFmt {
 				break
@@ -386,98 +654,28 @@ func addTotals(hh HitStats, daily bool, totalDisplay, totalUniqueDisplay *int) {
 	sort.Slice(hh, func(i, j int) bool { return hh[i].CountUnique > hh[j].CountUnique })
 }
 
-func GetTotalCount(ctx context.Context, start, end time.Time, filter string) (int, int, error) {
-	query := `/* GetTotalCount */
-		select
-			coalesce(sum(total), 0) as t,
-			coalesce(sum(total_unique), 0) as u
-		from hit_counts where
-			site=$1 and
-			hour>=$2 and
-			hour<=$3 `
-	args := []interface{}{MustGetSite(ctx).ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-	if filter != "" {
-		query += ` and (lower(path) like $4 or lower(title) like $4) `
-		args = append(args, "%"+strings.ToLower(filter)+"%")
-	}
-
-	var t struct{ T, U int }
-	err := zdb.MustGet(ctx).GetContext(ctx, &t, query, args...)
-	return t.T, t.U, errors.Wrap(err, "GetTotalCount")
-}
-
+// GetTotalCountUTC gets the total number of pageviews and visitors.
+//
+// Events are included unless GetTotalsEvents() is false.
 func GetTotalCountUTC(ctx context.Context, start, end time.Time, filter string) (int, int, error) {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	query := `/* GetTotalCountUTC */
 		select
 			coalesce(sum(total), 0) as t,
 			coalesce(sum(total_unique), 0) as u
 		from hit_counts where
-			site=$1 and
-			hour>=$2 and
-			hour<=$3 `
-
-	args := []interface{}{MustGetSite(ctx).ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-	if filter != "" {
-		query += ` and (lower(path) like $4 or lower(title) like $4) `
-		args = append(args, "%"+strings.ToLower(filter)+"%")
-	}
+			site=? and
+			hour>=? and
+			hour<=? `
 
-	var t struct{ T, U int }
-	err := zdb.MustGet(ctx).GetContext(ctx, &t, query, args...)
-	return t.T, t.U, errors.Wrap(err, "GetTotalCount")
-}
-
-func GetMax(ctx context.Context, start, end time.Time, filter string, daily bool) (int, error) {
-	if filter != "" {
-		filter = "%" + filter + "%"
-	}
-
-	site := MustGetSite(ctx)
-	var (
-		max   int
-		query string
-		args  []interface{}
-	)
-	if daily { // Daily
-		query = `/* getMax daily */
-			select coalesce(sum(total), 0) as t
-			from hit_counts
-			where site=? and hour>=? and hour<=? `
-		args = []interface{}{site.ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-		if filter != "" {
-			query += ` and (lower(path) like ? or lower(title) like ?) `
-			args = append(args, filter, filter)
-		}
-
-		if cfg.PgSQL {
-			query += ` group by path, date(timezone(?, hour))`
-		} else {
-			query += ` group by path, date(hour, ?)`
-		}
-		query += ` order by t desc limit 1`
-		args = append(args, site.Settings.Timezone.OffsetRFC3339())
-	} else { // Hourly
-		query = `/* getMax hourly */
-				select coalesce(max(total), 0) from hit_counts
-				where site=? and hour>=? and hour<=? `
-		args = []interface{}{site.ID, start.Format(zdb.Date), end.Format(zdb.Date)}
-		if filter != "" {
-			query += ` and (lower(path) like ? or lower(title) like ?) `
-			args = append(args, filter, filter)
-		}
-	}
+	args := []interface{}{MustGetSite(ctx).ID, start.Format(zdb.Date), clampAsOf(ctx, start, end).Format(zdb.Date)}
+	query, args = newPathFilter(MustGetSite(ctx), filter).add(query, args)
+	query += totalsEventsWhere(ctx)
 
 	db := zdb.MustGet(ctx)
-	err := db.GetContext(ctx, &max, db.Rebind(query), args...)
-	if err != nil && !zdb.ErrNoRows(err) {
-		return 0, errors.Wrap(err, "getMax")
-	}
-
-	if max < 10 {
-		max = 10
-	}
-	return max, nil
+	var t struct{ T, U int }
+	err := db.GetContext(ctx, &t, db.Rebind(query), args...)
+	return t.T, t.U, errors.Wrap(err, "GetTotalCount")
 }
@@ -0,0 +1,142 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"zgo.at/tz"
+)
+
+// Fill in random stats for random periods and timezones, and check that the
+// hours end up in the right place and that no two days share memory.
+func TestFillBlankDaysApplyOffsetFuzz(t *testing.T) {
+	zones := []string{"Africa/Abidjan", "Asia/Tokyo", "Asia/Kolkata",
+		"America/Bogota", "Pacific/Honolulu", "Pacific/Kiritimati"}
+
+	for seed := int64(0); seed < 300; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		site := Site{}
+		site.Settings.Timezone = tz.MustNew("", zones[r.Intn(len(zones))])
+		offset := site.Settings.Timezone.Offset()
+		if offset%60 != 0 {
+			offset += 30
+		}
+		offset /= 60
+
+		var (
+			start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, r.Intn(365))
+			days  = 1 + r.Intn(60)
+			end   = start.AddDate(0, 0, days-1).Add(time.Duration(r.Intn(24)) * time.Hour)
+		)
+		if offset != 0 && days == 1 { // Need to overselect a day.
+			days, end = 2, end.AddDate(0, 0, 1)
+		}
+
+		// All hours of the period, to get the expected values.
+		hh := make(HitStats, 1+r.Intn(3))
+		all := make([][]int, len(hh))
+		for i := range hh {
+			all[i] = make([]int, days*24)
+			for d := 0; d < days; d++ {
+				if r.Intn(3) > 0 {
+					continue
+				}
+				s := Stat{
+					Day:          start.AddDate(0, 0, d).Format("2006-01-02"),
+					Hourly:       make([]int, 24),
+					HourlyUnique: make([]int, 24),
+				}
+				for h := range s.Hourly {
+					s.Hourly[h] = r.Intn(10)
+					s.HourlyUnique[h] = s.Hourly[h]
+					all[i][d*24+h] = s.Hourly[h]
+				}
+				hh[i].Stats = append(hh[i].Stats, s)
+			}
+		}
+
+		t.Run(fmt.Sprintf("%d", seed), func(t *testing.T) {
+			fillBlankDays(hh, start, end)
+			applyOffset(hh, site.Settings.Timezone)
+
+			wantDays := days
+			if offset != 0 {
+				wantDays--
+			}
+			for i := range hh {
+				if len(hh[i].Stats) != wantDays {
+					t.Fatalf("%d days; want %d", len(hh[i].Stats), wantDays)
+				}
+
+				var got []int
+				for _, s := range hh[i].Stats {
+					if len(s.Hourly) != 24 || len(s.HourlyUnique) != 24 {
+						t.Fatalf("%s: %d hours", s.Day, len(s.Hourly))
+					}
+					if !reflect.DeepEqual(s.Hourly, s.HourlyUnique) {
+						t.Fatalf("%s: different:\n%v\n%v", s.Day, s.Hourly, s.HourlyUnique)
+					}
+					got = append(got, s.Hourly...)
+				}
+
+				var want []int
+				switch {
+				case offset > 0:
+					want = all[i][24-offset : len(all[i])-offset]
+				case offset < 0:
+					want = all[i][-offset : len(all[i])-24-offset]
+				default:
+					want = all[i]
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("offset %d\ngot:  %v\nwant: %v", offset, got, want)
+				}
+			}
+
+			// Write a different value to every hour; if two days share memory
+			// one of them will be overwritten.
+			n := 0
+			for i := range hh {
+				for j := range hh[i].Stats {
+					for h := range hh[i].Stats[j].Hourly {
+						n++
+						hh[i].Stats[j].Hourly[h] = n
+						n++
+						hh[i].Stats[j].HourlyUnique[h] = n
+					}
+				}
+			}
+			n = 0
+			for i := range hh {
+				for j, s := range hh[i].Stats {
+					for h := range s.Hourly {
+						n++
+						if s.Hourly[h] != n {
+							t.Fatalf("stat %d day %d hour %d: %d; want %d", i, j, h, s.Hourly[h], n)
+						}
+						n++
+						if s.HourlyUnique[h] != n {
+							t.Fatalf("stat %d day %d hour %d unique: %d; want %d", i, j, h, s.HourlyUnique[h], n)
+						}
+					}
+				}
+			}
+
+			// New blank days are still zero.
+			blank := make(HitStats, 1)
+			fillBlankDays(blank, start, start)
+			for _, s := range blank[0].Stats {
+				if !reflect.DeepEqual(s.Hourly, make([]int, 24)) || !reflect.DeepEqual(s.HourlyUnique, make([]int, 24)) {
+					t.Fatalf("blank day not zero: %v %v", s.Hourly, s.HourlyUnique)
+				}
+			}
+		})
+	}
+}
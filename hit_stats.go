@@ -17,8 +17,8 @@ import (
 
 // ListBrowsers lists all browser statistics for the given time period.
 func (h *Stats) ListBrowsers(ctx context.Context, start, end time.Time, limit, offset int) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ListBrowsers */
 		select
@@ -30,19 +30,20 @@ func (h *Stats) ListBrowsers(ctx context.Context, start, end time.Time, limit, o
 		group by browser
 		order by count_unique desc, name asc
 		limit $4 offset $5
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"), limit+1, offset)
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
 
 	if len(h.Stats) > limit {
 		h.More = true
 		h.Stats = h.Stats[:len(h.Stats)-1]
 	}
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListBrowsers browsers")
 }
 
 // ListBrowser lists all the versions for one browser.
 func (h *Stats) ListBrowser(ctx context.Context, browser string, start, end time.Time) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `
 		select
@@ -53,14 +54,15 @@ func (h *Stats) ListBrowser(ctx context.Context, browser string, start, end time
 		where site=$1 and day>=$2 and day<=$3 and lower(browser)=lower($4)
 		group by browser, version
 		order by count_unique desc, name asc
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"), browser)
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), browser)
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListBrowser")
 }
 
 // ListSystems lists OS statistics for the given time period.
 func (h *Stats) ListSystems(ctx context.Context, start, end time.Time, limit, offset int) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ListSystem */
 		select
@@ -72,19 +74,20 @@ func (h *Stats) ListSystems(ctx context.Context, start, end time.Time, limit, of
 		group by system
 		order by count_unique desc, name asc
 		limit $4 offset $5
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"), limit+1, offset)
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
 
 	if len(h.Stats) > limit {
 		h.More = true
 		h.Stats = h.Stats[:len(h.Stats)-1]
 	}
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListSystems")
 }
 
 // ListSystem lists all the versions for one system.
 func (h *Stats) ListSystem(ctx context.Context, system string, start, end time.Time) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `
 		select
@@ -95,7 +98,8 @@ func (h *Stats) ListSystem(ctx context.Context, system string, start, end time.T
 		where site=$1 and day >= $2 and day <= $3 and lower(system)=lower($4)
 		group by system, version
 		order by count_unique desc, name asc
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"), system)
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), system)
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListSystem")
 }
 
@@ -110,8 +114,8 @@ const (
 
 // ListSizes lists all device sizes.
 func (h *Stats) ListSizes(ctx context.Context, start, end time.Time) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ListSizes */
 		select
@@ -122,7 +126,7 @@ func (h *Stats) ListSizes(ctx context.Context, start, end time.Time) error {
 		where site=$1 and day >= $2 and day <= $3
 		group by width
 		order by count_unique desc, name asc
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"))
 	if err != nil {
 		return errors.Wrap(err, "Stats.ListSize")
 	}
@@ -162,13 +166,35 @@ func (h *Stats) ListSizes(ctx context.Context, start, end time.Time) error {
 	}
 	h.Stats = ns
 
+	h.setEstimated(ctx)
 	return nil
 }
 
+// ByDeviceClass lists the statistics by device class (see DeviceClass()) for
+// the given time period. Pageviews without a screen size are listed with an
+// empty name.
+func (h *Stats) ByDeviceClass(ctx context.Context, start, end time.Time) error {
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ByDeviceClass */
+		select
+			device_class as name,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from size_stats
+		where site=$1 and day >= $2 and day <= $3
+		group by device_class
+		order by count_unique desc, name asc
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"))
+	h.setEstimated(ctx)
+	return errors.Wrap(err, "Stats.ByDeviceClass")
+}
+
 // ListSize lists all sizes for one grouping.
 func (h *Stats) ListSize(ctx context.Context, name string, start, end time.Time) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	var where string
 	switch name {
@@ -198,7 +224,7 @@ func (h *Stats) ListSize(ctx context.Context, name string, start, end time.Time)
 			site=$1 and day >= $2 and day <= $3 and
 			%s
 		group by width
-	`, where), MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	`, where), MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"))
 	if err != nil {
 		return errors.Wrap(err, "Stats.ListSize")
 	}
@@ -223,13 +249,14 @@ func (h *Stats) ListSize(ctx context.Context, name string, start, end time.Time)
 	sort.Slice(ns, func(i int, j int) bool { return ns[i].Count > ns[j].Count })
 	h.Stats = ns
 
+	h.setEstimated(ctx)
 	return nil
 }
 
 // ListLocations lists all location statistics for the given time period.
 func (h *Stats) ListLocations(ctx context.Context, start, end time.Time, limit, offset int) error {
-	start = start.In(MustGetSite(ctx).Settings.Timezone.Location)
-	end = end.In(MustGetSite(ctx).Settings.Timezone.Location)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
 
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ListLocations */
 		select
@@ -242,11 +269,141 @@ func (h *Stats) ListLocations(ctx context.Context, start, end time.Time, limit,
 		group by location, iso_3166_1.name
 		order by count_unique desc, name asc
 		limit $4 offset $5
-	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), end.Format("2006-01-02"), limit+1, offset)
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
 
 	if len(h.Stats) > limit {
 		h.More = true
 		h.Stats = h.Stats[:len(h.Stats)-1]
 	}
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListLocations")
 }
+
+// ByRegion lists the location statistics by region for the given time period.
+//
+// This only includes data for sites with LocationDetail set to region or city.
+func (h *Stats) ByRegion(ctx context.Context, start, end time.Time, limit, offset int) error {
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ByRegion */
+		select
+			region || ', ' || iso_3166_1.name as name,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from location_stats
+		join iso_3166_1 on iso_3166_1.alpha2=location
+		where site=$1 and day >= $2 and day <= $3 and region != ''
+		group by location, region, iso_3166_1.name
+		order by count_unique desc, name asc
+		limit $4 offset $5
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
+
+	if len(h.Stats) > limit {
+		h.More = true
+		h.Stats = h.Stats[:len(h.Stats)-1]
+	}
+	h.setEstimated(ctx)
+	return errors.Wrap(err, "Stats.ByRegion")
+}
+
+// ByCity lists the location statistics by city for the given time period.
+//
+// This only includes data for sites with LocationDetail set to city.
+func (h *Stats) ByCity(ctx context.Context, start, end time.Time, limit, offset int) error {
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ByCity */
+		select
+			city || ', ' || (case when region = '' then iso_3166_1.name else region end) as name,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from location_stats
+		join iso_3166_1 on iso_3166_1.alpha2=location
+		where site=$1 and day >= $2 and day <= $3 and city != ''
+		group by location, region, city, iso_3166_1.name
+		order by count_unique desc, name asc
+		limit $4 offset $5
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
+
+	if len(h.Stats) > limit {
+		h.More = true
+		h.Stats = h.Stats[:len(h.Stats)-1]
+	}
+	h.setEstimated(ctx)
+	return errors.Wrap(err, "Stats.ByCity")
+}
+
+// ByHost lists the statistics by the host the page was served on for the given
+// time period, for sites that are served on more than one domain.
+func (h *Stats) ByHost(ctx context.Context, start, end time.Time, limit, offset int) error {
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ByHost */
+		select
+			host as name,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from host_stats
+		where site=$1 and day >= $2 and day <= $3
+		group by host
+		order by count_unique desc, name asc
+		limit $4 offset $5
+	`, MustGetSite(ctx).ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), limit+1, offset)
+
+	if len(h.Stats) > limit {
+		h.More = true
+		h.Stats = h.Stats[:len(h.Stats)-1]
+	}
+	h.setEstimated(ctx)
+	return errors.Wrap(err, "Stats.ByHost")
+}
+
+// ByCampaign lists the statistics by campaign for the given time period.
+//
+// This lists the statistics by utm_source if source is empty, by utm_medium
+// for this source if medium is empty, and by utm_campaign for this source and
+// medium otherwise.
+func (h *Stats) ByCampaign(
+	ctx context.Context, start, end time.Time, source, medium string, limit, offset int,
+) error {
+	site := MustGetSite(ctx)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	col := "source"
+	args := []interface{}{site.ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02")}
+	where := ""
+	switch {
+	case source != "" && medium != "":
+		col = "campaign"
+		where = ` and source=? and medium=? `
+		args = append(args, source, medium)
+	case source != "":
+		col = "medium"
+		where = ` and source=? `
+		args = append(args, source)
+	}
+
+	db := zdb.MustGet(ctx)
+	err := db.SelectContext(ctx, &h.Stats, db.Rebind(`/* Stats.ByCampaign */
+		select
+			`+col+` as name,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from campaign_stats
+		where site=? and day >= ? and day <= ? `+where+`
+		group by `+col+`
+		order by count_unique desc, name asc
+		limit ? offset ?
+	`), append(args, limit+1, offset)...)
+
+	if len(h.Stats) > limit {
+		h.More = true
+		h.Stats = h.Stats[:len(h.Stats)-1]
+	}
+	h.setEstimated(ctx)
+	return errors.Wrap(err, "Stats.ByCampaign")
+}
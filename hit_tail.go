@@ -0,0 +1,121 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// HitTailBuffer is the number of pageviews that are buffered for every
+// subscriber of the HitTail; pageviews are dropped for subscribers that can't
+// keep up.
+const HitTailBuffer = 100
+
+// TailHit is a pageview as it's sent to HitTail subscribers.
+type TailHit struct {
+	Time     time.Time `json:"time"`
+	Path     string    `json:"path"`
+	Title    string    `json:"title"`
+	Event    bool      `json:"event"`
+	Ref      string    `json:"ref"`
+	Location string    `json:"location"`
+	Browser  string    `json:"browser"`
+	Bot      bool      `json:"bot"`
+}
+
+type tailSub struct {
+	site   int64
+	filter string
+	ch     chan TailHit
+}
+
+type hitTail struct {
+	mu   sync.Mutex
+	subs map[*tailSub]struct{}
+}
+
+// HitTail streams pageviews as they're added to the Memstore, for the live
+// view. This is before they're processed, so it includes pageviews that will
+// be ignored later on. The IP address and session are never sent.
+var HitTail hitTail
+
+// Subscribe to the pageviews for the site; if filter isn't empty then only
+// pageviews with a path that contains it (case-insensitive) are sent. The
+// returned function must be called to unsubscribe.
+func (t *hitTail) Subscribe(siteID int64, filter string) (<-chan TailHit, func()) {
+	s := &tailSub{
+		site:   siteID,
+		filter: strings.ToLower(filter),
+		ch:     make(chan TailHit, HitTailBuffer),
+	}
+
+	t.mu.Lock()
+	if t.subs == nil {
+		t.subs = make(map[*tailSub]struct{})
+	}
+	t.subs[s] = struct{}{}
+	t.mu.Unlock()
+
+	var once sync.Once
+	return s.ch, func() {
+		once.Do(func() {
+			t.mu.Lock()
+			delete(t.subs, s)
+			t.mu.Unlock()
+		})
+	}
+}
+
+// Subscribers gets the number of subscribers for the site.
+func (t *hitTail) Subscribers(siteID int64) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := 0
+	for s := range t.subs {
+		if s.site == siteID {
+			n++
+		}
+	}
+	return n
+}
+
+// publish the hits to all subscribers of the site; this never blocks.
+func (t *hitTail) publish(hits []Hit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.subs) == 0 {
+		return
+	}
+
+	for _, h := range hits {
+		var (
+			th   *TailHit
+			path = strings.ToLower(h.Path)
+		)
+		for s := range t.subs {
+			if s.site != h.Site || (s.filter != "" && !strings.Contains(path, s.filter)) {
+				continue
+			}
+			if th == nil {
+				th = &TailHit{
+					Time:     h.CreatedAt,
+					Path:     h.Path,
+					Title:    h.Title,
+					Event:    bool(h.Event),
+					Ref:      h.Ref,
+					Location: h.Location,
+					Browser:  h.Browser,
+					Bot:      h.Bot > 0,
+				}
+			}
+			select {
+			case s.ch <- *th:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestHitTail(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	all, unsubAll := goatcounter.HitTail.Subscribe(site.ID, "")
+	defer unsubAll()
+	filtered, unsubFiltered := goatcounter.HitTail.Subscribe(site.ID, "/BLOG")
+	defer unsubFiltered()
+	if n := goatcounter.HitTail.Subscribers(site.ID); n != 2 {
+		t.Errorf("Subscribers: %d", n)
+	}
+
+	goatcounter.Memstore.Append(
+		goatcounter.Hit{Site: site.ID, Path: "/blog/a", RemoteAddr: "127.0.0.1"},
+		goatcounter.Hit{Site: site.ID, Path: "/about"},
+		goatcounter.Hit{Site: site.ID + 1, Path: "/blog/other-site"})
+
+	read := func(ch <-chan goatcounter.TailHit) string {
+		var p []string
+		for {
+			select {
+			case h := <-ch:
+				p = append(p, h.Path)
+			default:
+				return strings.Join(p, " ")
+			}
+		}
+	}
+	if got := read(all); got != "/blog/a /about" {
+		t.Errorf("all: %q", got)
+	}
+	if got := read(filtered); got != "/blog/a" {
+		t.Errorf("filtered: %q", got)
+	}
+
+	// Never blocks if the subscriber doesn't read.
+	for i := 0; i < goatcounter.HitTailBuffer+10; i++ {
+		goatcounter.Memstore.Append(goatcounter.Hit{Site: site.ID, Path: "/blog"})
+	}
+	if got := len(filtered); got != goatcounter.HitTailBuffer {
+		t.Errorf("buffered %d", got)
+	}
+
+	unsubAll()
+	unsubAll()
+	if n := goatcounter.HitTail.Subscribers(site.ID); n != 1 {
+		t.Errorf("Subscribers after unsubscribe: %d", n)
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
 	"zgo.at/zstd/ztest"
 )
 
@@ -43,7 +46,7 @@ func TestHitStatsList(t *testing.T) {
 				{CreatedAt: hit.Add(40 * time.Hour), Path: "/asd/"},
 				{CreatedAt: hit.Add(100 * time.Hour), Path: "/zxc"},
 			},
-			wantReturn: "3 0 false <nil>",
+			wantReturn: "3 0 false {0 0 0} <nil>",
 			wantStats: goatcounter.HitStats{
 				goatcounter.HitStat{Count: 2, Path: "/asd", RefScheme: nil, Stats: []goatcounter.Stat{
 					{Day: "2019-08-10", Hourly: dayStat(map[int]int{14: 1})},
@@ -73,7 +76,7 @@ func TestHitStatsList(t *testing.T) {
 				{CreatedAt: hit, Path: "/zxc"},
 			},
 			inFilter:   "x",
-			wantReturn: "1 0 false <nil>",
+			wantReturn: "1 0 false {0 0 0} <nil>",
 			wantStats: goatcounter.HitStats{
 				goatcounter.HitStat{Count: 1, Path: "/zxc", RefScheme: nil, Stats: []goatcounter.Stat{
 					{Day: "2019-08-10", Hourly: dayStat(map[int]int{14: 1})},
@@ -95,7 +98,7 @@ func TestHitStatsList(t *testing.T) {
 				{CreatedAt: hit, Path: "/aaaa"},
 			},
 			inFilter:   "a",
-			wantReturn: "2 0 true <nil>",
+			wantReturn: "2 0 true {2 2 0} <nil>",
 			wantStats: goatcounter.HitStats{
 				goatcounter.HitStat{Count: 1, Path: "/aaaa", RefScheme: nil, Stats: []goatcounter.Stat{
 					{Day: "2019-08-10", Hourly: dayStat(map[int]int{14: 1})},
@@ -128,7 +131,7 @@ func TestHitStatsList(t *testing.T) {
 			},
 			inFilter:   "a",
 			inExclude:  []string{"/aaaa", "/aaa"},
-			wantReturn: "2 0 false <nil>",
+			wantReturn: "2 0 false {0 0 0} <nil>",
 			wantStats: goatcounter.HitStats{
 				goatcounter.HitStat{Count: 1, Path: "/aa", RefScheme: nil, Stats: []goatcounter.Stat{
 					{Day: "2019-08-10", Hourly: dayStat(map[int]int{14: 1})},
@@ -170,9 +173,9 @@ func TestHitStatsList(t *testing.T) {
 			gctest.StoreHits(ctx, t, false, tt.in...)
 
 			var stats goatcounter.HitStats
-			totalDisplay, uniqueDisplay, more, err := stats.List(ctx, start, end, tt.inFilter, tt.inExclude, false)
+			totalDisplay, uniqueDisplay, more, other, err := stats.List(ctx, start, end, tt.inFilter, "", tt.inExclude, false)
 
-			got := fmt.Sprintf("%d %d %t %v", totalDisplay, uniqueDisplay, more, err)
+			got := fmt.Sprintf("%d %d %t %v %v", totalDisplay, uniqueDisplay, more, other, err)
 			if got != tt.wantReturn {
 				t.Errorf("wrong return\nout:  %s\nwant: %s\n", got, tt.wantReturn)
 			}
@@ -186,6 +189,452 @@ func TestHitStatsList(t *testing.T) {
 	}
 }
 
+func TestGetTotalCountAsOf(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{CreatedAt: start.Add(1 * time.Hour), Path: "/a"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "/a"},
+		goatcounter.Hit{CreatedAt: start.Add(50 * time.Hour), Path: "/a"})
+
+	tests := []struct {
+		asOf time.Time
+		want string
+	}{
+		{time.Time{}, "3 <nil>"},
+		{start.Add(2 * time.Hour), "2 <nil>"},
+		{start.Add(90 * time.Minute), "1 <nil>"},
+		{start, "0 <nil>"},
+
+		// Clamped to the start and end.
+		{start.Add(-48 * time.Hour), "0 <nil>"},
+		{end, "3 <nil>"},
+		{end.Add(48 * time.Hour), "3 <nil>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.asOf.Format(time.RFC3339), func(t *testing.T) {
+			ctx := ctx
+			if !tt.asOf.IsZero() {
+				ctx = goatcounter.WithAsOf(ctx, tt.asOf)
+			}
+
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, "", "", nil, false)
+			got := fmt.Sprintf("%d %v", l.Total, err)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+
+	// The hit_stats are per day, so only the clamped as-of times give the same
+	// totals in HitStats.List().
+	for _, tt := range tests[len(tests)-3:] {
+		t.Run("list "+tt.asOf.Format(time.RFC3339), func(t *testing.T) {
+			var stats goatcounter.HitStats
+			total, _, _, _, err := stats.List(goatcounter.WithAsOf(ctx, tt.asOf), start, end, "", "", nil, true)
+			got := fmt.Sprintf("%d %v", total, err)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTotalCountCaseSensitive(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{CreatedAt: start.Add(1 * time.Hour), Path: "/About"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "/about"},
+		goatcounter.Hit{CreatedAt: start.Add(3 * time.Hour), Path: "/about"})
+
+	tests := []struct {
+		caseSensitive bool
+		filter        string
+		want          string
+	}{
+		{false, "about", "3 <nil>"},
+		{false, "About", "3 <nil>"},
+		{true, "about", "2 <nil>"},
+		{true, "About", "1 <nil>"},
+		{true, "ABOUT", "0 <nil>"},
+
+		{false, "exact:/about", "3 <nil>"},
+		{false, "exact:/abou", "0 <nil>"},
+		{true, "exact:/About", "1 <nil>"},
+		{false, "prefix:/ab", "3 <nil>"},
+		{false, "prefix:ab", "0 <nil>"},
+		{true, "prefix:/Ab", "1 <nil>"},
+		{true, "prefix:/ab", "2 <nil>"},
+		{true, "prefix:/a%", "0 <nil>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%t %s", tt.caseSensitive, tt.filter), func(t *testing.T) {
+			goatcounter.MustGetSite(ctx).Settings.CaseSensitivePaths = tt.caseSensitive
+
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, tt.filter, "", nil, false)
+			got := fmt.Sprintf("%d %v", l.Total, err)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCaseSensitivePaths(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{CreatedAt: start.Add(1 * time.Hour), Path: "/About", Ref: "https://example.com/x"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "/about", Ref: "https://example.org/x"},
+		goatcounter.Hit{CreatedAt: start.Add(3 * time.Hour), Path: "/about", Ref: "https://example.org/x"})
+
+	tests := []struct {
+		caseSensitive bool
+		want          string
+	}{
+		{false, "refs=2 like=2 purge=3 range=3"},
+		{true, "refs=1 like=1 purge=2 range=2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%t", tt.caseSensitive), func(t *testing.T) {
+			goatcounter.MustGetSite(ctx).Settings.CaseSensitivePaths = tt.caseSensitive
+
+			var refs goatcounter.Stats
+			err := refs.ListRefsByPath(ctx, "/about", start, end, "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var like goatcounter.HitStats
+			err = like.ListPathsLike(ctx, "/about%", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var hits goatcounter.Hits
+			preview, err := hits.PurgePreview(ctx, "/about", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = hits.ListRange(ctx, 0, 0, goatcounter.HitRange{PathLike: "/about"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := fmt.Sprintf("refs=%d like=%d purge=%d range=%d",
+				len(refs.Stats), len(like), preview.Hits, len(hits))
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPages(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+
+	var hits []goatcounter.Hit
+	for i := 0; i < 60; i++ {
+		hits = append(hits, goatcounter.Hit{CreatedAt: start.Add(time.Duration(i%4) * time.Hour), Path: "/a"})
+	}
+	hits = append(hits, goatcounter.Hit{CreatedAt: start.Add(50 * time.Hour), Path: "/b"})
+	gctest.StoreHits(ctx, t, false, hits...)
+
+	tests := []struct {
+		filter  string
+		exclude []string
+		daily   bool
+		want    string
+	}{
+		{"", nil, false, "61 15 61 [/a /b]"},
+		{"", nil, true, "61 60 61 [/a /b]"},
+		{"a", nil, false, "60 15 60 [/a]"},
+		{"a", nil, true, "60 60 60 [/a]"},
+		{"b", nil, true, "1 10 1 [/b]"},
+		{"nomatch", nil, false, "0 10 0 []"},
+		{"nomatch", nil, true, "0 10 0 []"},
+
+		// The totals and max include the excluded paths.
+		{"", []string{"/a"}, false, "61 15 1 [/b]"},
+		{"", []string{"/a", "/b"}, true, "61 60 0 []"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %v %t", tt.filter, tt.exclude, tt.daily), func(t *testing.T) {
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, tt.filter, "", tt.exclude, tt.daily)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paths := make([]string, 0, len(stats))
+			for _, s := range stats {
+				paths = append(paths, s.Path)
+			}
+			sort.Strings(paths)
+
+			got := fmt.Sprintf("%d %d %d %v", l.Total, l.Max, l.Display, paths)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHitStatsHost(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{CreatedAt: start.Add(1 * time.Hour), Path: "/a", Host: "example.com"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "/a", Host: "example.com"},
+		goatcounter.Hit{CreatedAt: start.Add(3 * time.Hour), Path: "/b", Host: "example.org"},
+		goatcounter.Hit{CreatedAt: start.Add(4 * time.Hour), Path: "/a", Host: "example.org"},
+		goatcounter.Hit{CreatedAt: start.Add(50 * time.Hour), Path: "/c"})
+
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"", "3 5 5 10 [/a /b /c] /a=3"},
+		{"example.com", "1 2 2 10 [/a] /a=2"},
+		{"EXAMPLE.ORG", "2 2 2 10 [/a /b] /a=1"},
+		{"example.net", "0 0 0 10 [] /a=0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, "", tt.host, nil, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var countA int
+			paths := make([]string, 0, len(stats))
+			for _, s := range stats {
+				paths = append(paths, s.Path)
+				if s.Path == "/a" {
+					countA = s.Count
+				}
+			}
+			sort.Strings(paths)
+
+			var totals goatcounter.HitStat
+			_, err = totals.Totals(ctx, start, end, "", tt.host, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := fmt.Sprintf("%d %d %d %d %v /a=%d", len(stats), l.Total, totals.Count, l.Max, paths, countA)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// The max for the hourly view should be for the path on all hosts.
+func TestListPagesMaxHosts(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+
+	var hits []goatcounter.Hit
+	for i := 0; i < 16; i++ {
+		host := "example.com"
+		if i%2 == 0 {
+			host = "example.org"
+		}
+		hits = append(hits, goatcounter.Hit{CreatedAt: start.Add(time.Hour), Path: "/a", Host: host})
+	}
+	gctest.StoreHits(ctx, t, false, hits...)
+
+	tests := []struct {
+		host  string
+		daily bool
+		want  string
+	}{
+		{"", false, "16 16"},
+		{"", true, "16 16"},
+		{"example.com", false, "8 10"},
+		{"example.com", true, "8 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %t", tt.host, tt.daily), func(t *testing.T) {
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, "", tt.host, nil, tt.daily)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := fmt.Sprintf("%d %d", l.Total, l.Max)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTotalCountEvents(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{CreatedAt: start.Add(1 * time.Hour), Path: "/a"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "/a"},
+		goatcounter.Hit{CreatedAt: start.Add(2 * time.Hour), Path: "click", Event: true},
+		goatcounter.Hit{CreatedAt: start.Add(3 * time.Hour), Path: "click", Event: true},
+		goatcounter.Hit{CreatedAt: start.Add(3 * time.Hour), Path: "click", Event: true})
+
+	b := func(v bool) *bool { return &v }
+	tests := []struct {
+		exclude bool
+		ctx     *bool
+		want    string
+	}{
+		{false, nil, "5 5 5"},
+		{true, nil, "2 2 2"},
+		{true, b(true), "5 5 5"},
+		{false, b(false), "2 2 2"},
+	}
+
+	for _, tt := range tests {
+		name := fmt.Sprintf("%t", tt.exclude)
+		if tt.ctx != nil {
+			name += fmt.Sprintf(" ctx=%t", *tt.ctx)
+		}
+		t.Run(name, func(t *testing.T) {
+			goatcounter.MustGetSite(ctx).Settings.TotalsExcludeEvents = tt.exclude
+			ctx := ctx
+			if tt.ctx != nil {
+				ctx = goatcounter.WithTotalsEvents(ctx, *tt.ctx)
+			}
+
+			var stats goatcounter.HitStats
+			l, err := stats.ListPages(ctx, start, end, "", "", nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var totals goatcounter.HitStat
+			_, err = totals.Totals(ctx, start, end, "", "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			totalUTC, _, err := goatcounter.GetTotalCountUTC(ctx, start, end, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := fmt.Sprintf("%d %d %d", l.Total, totals.Count, totalUTC)
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatsByRefLimit(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	var hits []goatcounter.Hit
+	for i := 0; i < 5; i++ {
+		hits = append(hits, goatcounter.Hit{CreatedAt: start.Add(time.Hour),
+			Path: fmt.Sprintf("/%d", i), Ref: "http://example.org"})
+	}
+	gctest.StoreHits(ctx, t, false, hits...)
+
+	defer func(m int) { cfg.MaxStats = m }(cfg.MaxStats)
+	cfg.MaxStats = 3
+
+	tests := []struct {
+		limit, want int
+	}{
+		{1, 1},
+		{2, 2},
+		{0, 3},
+		{10, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d", tt.limit), func(t *testing.T) {
+			var stats goatcounter.Stats
+			err := stats.ByRef(ctx, start, end, "example.org", tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stats.Stats) != tt.want {
+				t.Errorf("got %d; want %d", len(stats.Stats), tt.want)
+			}
+		})
+	}
+}
+
+func TestStatsBySourceCategory(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	start := time.Date(2019, 8, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 8, 17, 23, 59, 59, 0, time.UTC)
+	var hits []goatcounter.Hit
+	for _, r := range []string{"https://www.bing.com/search?q=x", "https://www.google.com/",
+		"https://twitter.com/a", "https://twitter.com/b", "", "http://example.org/x"} {
+		hits = append(hits, goatcounter.Hit{CreatedAt: start.Add(time.Hour),
+			Path: "/", Ref: r, FirstVisit: true})
+	}
+	gctest.StoreHits(ctx, t, false, hits...)
+
+	var stats goatcounter.Stats
+	err := stats.BySourceCategory(ctx, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, s := range stats.Stats {
+		got = append(got, fmt.Sprintf("%s=%d", s.Name, s.CountUnique))
+	}
+	if have, want := strings.Join(got, " "), "search=2 social=2 direct=1 other=1"; have != want {
+		t.Errorf("\nhave: %s\nwant: %s", have, want)
+	}
+
+	stats = goatcounter.Stats{}
+	err = stats.ListSourceCategory(ctx, goatcounter.RefCategorySocial, start, end, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Stats) != 1 || stats.Stats[0].Name != "twitter.com" || stats.Stats[0].CountUnique != 2 {
+		t.Errorf("%#v", stats.Stats)
+	}
+}
+
 func TestHitDefaultsRef(t *testing.T) {
 	a := "arp242.net"
 	set := ztest.SP("_")
@@ -297,3 +746,170 @@ func PSP(s *string) string {
 	}
 	return *s
 }
+
+func TestDeviceClass(t *testing.T) {
+	tests := []struct {
+		in   zdb.Floats
+		want string
+	}{
+		{nil, ""},
+		{zdb.Floats{0, 0, 0}, ""},
+		{zdb.Floats{375, 812, 3}, goatcounter.DeviceClassPhone},
+		{zdb.Floats{812, 375, 3}, goatcounter.DeviceClassPhone},
+		{zdb.Floats{500}, goatcounter.DeviceClassPhone},
+		{zdb.Floats{768, 1024, 2}, goatcounter.DeviceClassTablet},
+		{zdb.Floats{1024, 768, 2}, goatcounter.DeviceClassTablet},
+		{zdb.Floats{1366, 768, 1}, goatcounter.DeviceClassDesktop},
+		{zdb.Floats{1920, 1080, 1}, goatcounter.DeviceClassDesktop},
+		{zdb.Floats{2560, 1440, 1}, goatcounter.DeviceClassLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v", tt.in), func(t *testing.T) {
+			got := goatcounter.DeviceClass(tt.in)
+			if got != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHitClientHints(t *testing.T) {
+	tests := []struct {
+		brands, platform, platformVersion string
+		wantBrowser, wantSystem           string
+	}{
+		{`" Not A;Brand";v="99", "Chromium";v="88", "Google Chrome";v="88"`, `"Windows"`, `"13.0.0"`,
+			"Chrome 88", "Windows 11"},
+		{`"Chromium";v="92", " Not A;Brand";v="99", "Microsoft Edge";v="92"`, `"Windows"`, `"0.3.0"`,
+			"Edge 92", "Windows "},
+		{`"Chromium";v="88", "Not=A?Brand";v="99"`, `"macOS"`, `"11.2.0"`,
+			"Chromium 88", "macOS 11.2"},
+		{`"Brave";v="90", "Chromium";v="90"`, `"Linux"`, ``,
+			"Brave 90", "Linux "},
+		{`" Not A;Brand";v="99"`, ``, ``,
+			" ", " "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantBrowser, func(t *testing.T) {
+			h := goatcounter.Hit{UABrands: tt.brands, UAPlatform: tt.platform, UAPlatformVersion: tt.platformVersion}
+
+			b, bv := h.BrowserInfo()
+			s, sv := h.SystemInfo()
+			if got := b + " " + bv; got != tt.wantBrowser {
+				t.Errorf("browser\ngot:  %q\nwant: %q", got, tt.wantBrowser)
+			}
+			if got := s + " " + sv; got != tt.wantSystem {
+				t.Errorf("system\ngot:  %q\nwant: %q", got, tt.wantSystem)
+			}
+		})
+	}
+}
+
+func BenchmarkHitDefaults(b *testing.B) {
+	ctx, clean := gctest.DB(b)
+	defer clean()
+
+	h := goatcounter.Hit{
+		Path:    "/blog/post.html?utm_source=x&fbclid=y",
+		Ref:     "https://news.ycombinator.com/item?id=123",
+		Browser: "Mozilla/5.0 (X11; Linux x86_64; rv:81.0) Gecko/20100101 Firefox/81.0",
+	}
+	h.RefURL, _ = url.Parse(h.Ref)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		hh := h
+		hh.Defaults(ctx)
+	}
+}
+
+func BenchmarkHitStatsList(b *testing.B) {
+	for _, paths := range []int{10, 1000, 10000} {
+		b.Run(fmt.Sprintf("%d paths", paths), func(b *testing.B) {
+			ctx, clean := gctest.DB(b)
+			defer clean()
+
+			var (
+				end   = time.Date(2020, 6, 30, 23, 59, 59, 0, time.UTC)
+				start = end.Add(-30*24*time.Hour + time.Second)
+				hits  = make([]goatcounter.Hit, 0, paths*3)
+			)
+			for i := 0; i < paths*3; i++ {
+				hits = append(hits, goatcounter.Hit{
+					Path:       fmt.Sprintf("/path-%d", i%paths),
+					CreatedAt:  start.Add(time.Duration(i%(30*24)) * time.Hour),
+					FirstVisit: i%2 == 0,
+				})
+			}
+			gctest.StoreHits(ctx, b, false, hits...)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				var stats goatcounter.HitStats
+				_, _, _, _, err := stats.List(ctx, start, end, "", "", nil, false)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestHitsPurgePreview(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/asd", Title: "Hello", CreatedAt: now},
+		{Path: "/asd", Title: "Hello", CreatedAt: now},
+		{Path: "/zxc", CreatedAt: now},
+	}...)
+
+	var hits goatcounter.Hits
+	p, err := hits.PurgePreview(ctx, "/a%", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Hits != 2 || p.All || p.StatRows["hit_counts"] != 1 || p.StatRows["hit_stats"] != 1 {
+		t.Errorf("%+v", p)
+	}
+	if len(p.Paths) != 1 || p.Paths[0] != (goatcounter.PurgePath{Path: "/asd", Title: "Hello", Count: 2}) {
+		t.Errorf("%+v", p.Paths)
+	}
+
+	// Title must match as well.
+	p, err = hits.PurgePreview(ctx, "/a%", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Hits != 0 || len(p.Paths) != 0 {
+		t.Errorf("%+v", p)
+	}
+
+	p, err = hits.PurgePreview(ctx, "%", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.StatRows["browser_stats"]; p.Hits != 3 || !p.All || !ok {
+		t.Errorf("%+v", p)
+	}
+
+	// Nothing was removed, and the preview matches what's purged.
+	err = hits.Purge(ctx, "/a%", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	err = zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from hits`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("%d hits left", n)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zvalidate"
+)
+
+// Status of a host in the HostReport.
+const (
+	HostExpected   = "expected"   // The site's LinkDomain.
+	HostAllowed    = "allowed"    // In the AllowedHosts setting.
+	HostBlocked    = "blocked"    // In the BlockedHosts setting.
+	HostUnexpected = "unexpected" // Anything else.
+)
+
+// HostReportDays is the number of days the HostReport covers.
+const HostReportDays = 7
+
+// HostReportEntry is a host that sent pageviews to a site.
+type HostReportEntry struct {
+	Host        string `db:"host" json:"host"`
+	Count       int    `db:"count" json:"count"`
+	CountUnique int    `db:"count_unique" json:"count_unique"`
+	Status      string `db:"-" json:"status"`
+}
+
+// HostReport lists the hosts that sent pageviews to a site, to verify that the
+// tracking code is only used on the expected domains. A host that isn't
+// expected usually means someone copied the site code to their own site.
+type HostReport []HostReportEntry
+
+// List the hosts that sent pageviews in the last HostReportDays days, sorted by
+// number of pageviews.
+func (h *HostReport) List(ctx context.Context) error {
+	site := MustGetSite(ctx)
+	start := Now().Add(-HostReportDays * 24 * time.Hour)
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, h, `/* HostReport.List */
+		select
+			host,
+			sum(count) as count,
+			sum(count_unique) as count_unique
+		from host_stats
+		where site=$1 and day >= $2 and host != ''
+		group by host
+		order by count desc, host asc
+		limit 100
+	`, site.ID, start.Format("2006-01-02"))
+	if err != nil {
+		return errors.Wrap(err, "HostReport.List")
+	}
+
+	hh := *h
+	for i := range hh {
+		hh[i].Status = site.HostStatus(hh[i].Host)
+	}
+	return nil
+}
+
+// Unexpected reports if there are any unexpected hosts in the report.
+func (h HostReport) Unexpected() bool {
+	for _, e := range h {
+		if e.Status == HostUnexpected {
+			return true
+		}
+	}
+	return false
+}
+
+// HostStatus gets the status of the host; see the Host* constants.
+func (s Site) HostStatus(host string) string {
+	host = hostname(host)
+	switch {
+	case matchHost(s.Settings.BlockedHosts, host):
+		return HostBlocked
+	case s.LinkDomain != "" && (host == hostname(s.LinkDomain) || host == "www."+hostname(s.LinkDomain)):
+		return HostExpected
+	case matchHost(s.Settings.AllowedHosts, host):
+		return HostAllowed
+	default:
+		return HostUnexpected
+	}
+}
+
+// AcceptHost reports if pageviews from this host should be counted.
+//
+// Pageviews from hosts in BlockedHosts are never accepted. If AllowedHosts is
+// set then only pageviews from the LinkDomain and those hosts are accepted. A
+// pageview without a host is always accepted, as it's not known where it came
+// from.
+func (s Site) AcceptHost(host string) bool {
+	if host == "" {
+		return true
+	}
+	switch s.HostStatus(host) {
+	case HostBlocked:
+		return false
+	case HostUnexpected:
+		return len(s.Settings.AllowedHosts) == 0
+	default:
+		return true
+	}
+}
+
+// SetHostStatus adds the host to the AllowedHosts or BlockedHosts setting, or
+// removes it from both if status is empty.
+func (s *Site) SetHostStatus(ctx context.Context, host, status string) error {
+	host = hostname(host)
+	if host == "" {
+		return errors.New("Site.SetHostStatus: host is empty")
+	}
+	if status != "" && status != HostAllowed && status != HostBlocked {
+		return errors.Errorf("Site.SetHostStatus: invalid status: %q", status)
+	}
+
+	del := func(list []string) []string {
+		n := make([]string, 0, len(list))
+		for _, h := range list {
+			if h != host {
+				n = append(n, h)
+			}
+		}
+		return n
+	}
+	s.Settings.AllowedHosts = del(s.Settings.AllowedHosts)
+	s.Settings.BlockedHosts = del(s.Settings.BlockedHosts)
+
+	switch status {
+	case HostAllowed:
+		s.Settings.AllowedHosts = append(s.Settings.AllowedHosts, host)
+	case HostBlocked:
+		s.Settings.BlockedHosts = append(s.Settings.BlockedHosts, host)
+	}
+
+	return s.Update(ctx)
+}
+
+// hostname gets the lower-cased host without port.
+func hostname(host string) string {
+	host = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), "."))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return host
+}
+
+// matchHost reports if host matches any of the rules. A rule starting with
+// "*." matches all subdomains.
+func matchHost(rules []string, host string) bool {
+	for _, r := range rules {
+		r = strings.ToLower(r)
+		if r == host || (strings.HasPrefix(r, "*.") && strings.HasSuffix(host, r[1:])) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHosts validates that all entries in hosts are a hostname, optionally
+// prefixed with "*.".
+func validateHosts(v *zvalidate.Validator, key string, hosts []string) {
+	for _, h := range hosts {
+		if h == "" {
+			continue
+		}
+		v.Hostname(key, strings.TrimPrefix(h, "*."))
+	}
+}
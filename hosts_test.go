@@ -0,0 +1,125 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestSiteAcceptHost(t *testing.T) {
+	tests := []struct {
+		allow, block []string
+		host         string
+		wantStatus   string
+		wantAccept   bool
+	}{
+		{nil, nil, "", goatcounter.HostUnexpected, true},
+		{nil, nil, "example.com", goatcounter.HostExpected, true},
+		{nil, nil, "WWW.example.com:8080", goatcounter.HostExpected, true},
+		{nil, nil, "copied.org", goatcounter.HostUnexpected, true},
+
+		{[]string{"staging.example.net"}, nil, "staging.example.net", goatcounter.HostAllowed, true},
+		{[]string{"staging.example.net"}, nil, "example.com", goatcounter.HostExpected, true},
+		{[]string{"staging.example.net"}, nil, "copied.org", goatcounter.HostUnexpected, false},
+		{[]string{"*.example.net"}, nil, "a.b.example.net", goatcounter.HostAllowed, true},
+		{[]string{"*.example.net"}, nil, "example.net", goatcounter.HostUnexpected, false},
+
+		{nil, []string{"copied.org"}, "copied.org", goatcounter.HostBlocked, false},
+		{nil, []string{"copied.org"}, "other.org", goatcounter.HostUnexpected, true},
+		{nil, []string{"*.example.com"}, "www.example.com", goatcounter.HostBlocked, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %v %v", tt.host, tt.allow, tt.block), func(t *testing.T) {
+			s := goatcounter.Site{LinkDomain: "example.com"}
+			s.Settings.AllowedHosts = tt.allow
+			s.Settings.BlockedHosts = tt.block
+
+			if got := s.HostStatus(tt.host); got != tt.wantStatus {
+				t.Errorf("HostStatus: got %q; want %q", got, tt.wantStatus)
+			}
+			if got := s.AcceptHost(tt.host); got != tt.wantAccept {
+				t.Errorf("AcceptHost: got %t; want %t", got, tt.wantAccept)
+			}
+		})
+	}
+}
+
+func TestHostReport(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+	defer gctest.SwapNow(t, "2020-06-18 12:00:00")()
+
+	site := goatcounter.MustGetSite(ctx)
+	site.LinkDomain = "example.com"
+	err := site.Update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := goatcounter.Now()
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", Host: "example.com", CreatedAt: now},
+		goatcounter.Hit{Path: "/b", Host: "example.com", CreatedAt: now},
+		goatcounter.Hit{Path: "/a", Host: "copied.org", CreatedAt: now},
+		goatcounter.Hit{Path: "/a", Host: "old.org", CreatedAt: now.Add(-30 * 24 * time.Hour)})
+
+	list := func() string {
+		var report goatcounter.HostReport
+		err := report.List(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s string
+		for _, e := range report {
+			s += fmt.Sprintf("%s %d %s\n", e.Host, e.Count, e.Status)
+		}
+		return s
+	}
+
+	want := "example.com 2 expected\ncopied.org 1 unexpected\n"
+	if got := list(); got != want {
+		t.Errorf("\ngot:  %q\nwant: %q", got, want)
+	}
+
+	err = site.SetHostStatus(ctx, "copied.org", goatcounter.HostBlocked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "example.com 2 expected\ncopied.org 1 blocked\n"
+	if got := list(); got != want {
+		t.Errorf("\ngot:  %q\nwant: %q", got, want)
+	}
+
+	err = site.SetHostStatus(ctx, "copied.org", goatcounter.HostAllowed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(site.Settings.BlockedHosts) != 0 ||
+		len(site.Settings.AllowedHosts) != 1 || site.Settings.AllowedHosts[0] != "copied.org" {
+		t.Errorf("wrong settings: allowed=%v; blocked=%v",
+			site.Settings.AllowedHosts, site.Settings.BlockedHosts)
+	}
+
+	var settings goatcounter.SiteSettings
+	err = zdb.MustGet(ctx).GetContext(ctx, &settings, `select settings from sites where id=$1`, site.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.AllowedHosts) != 1 {
+		t.Errorf("not stored: %v", settings)
+	}
+
+	err = site.SetHostStatus(ctx, "copied.org", "wrong")
+	if err == nil {
+		t.Error("no error for invalid status")
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+)
+
+// Import formats for ConvertImport().
+const (
+	ImportFormatGoatCounter = "csv"       // GoatCounter CSV export.
+	ImportFormatPlausible   = "plausible" // Plausible CSV export (imported_pages).
+	ImportFormatFathom      = "fathom"    // Fathom CSV export of pages.
+	ImportFormatMatomo      = "matomo"    // Matomo JSON from Live.getLastVisitsDetails.
+)
+
+// ImportFormats are all the formats ConvertImport() accepts.
+var ImportFormats = []string{ImportFormatGoatCounter, ImportFormatPlausible,
+	ImportFormatFathom, ImportFormatMatomo}
+
+// Column names for the aggregated per-day exports from Plausible and Fathom;
+// the first name that's in the header is used.
+var (
+	importColDate      = []string{"date", "timestamp", "day"}
+	importColPath      = []string{"page", "pathname", "path"}
+	importColPageviews = []string{"pageviews", "views"}
+	importColVisitors  = []string{"visitors", "uniques"}
+)
+
+// DetectImportFormat detects the format of an export from the start of the
+// file. It returns ImportFormatGoatCounter if the format isn't recognized.
+func DetectImportFormat(head []byte) string {
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	head = bytes.TrimLeft(head, " \t\r\n")
+	if len(head) > 0 && (head[0] == '[' || head[0] == '{') {
+		return ImportFormatMatomo
+	}
+
+	if i := bytes.IndexAny(head, "\r\n"); i > -1 {
+		head = head[:i]
+	}
+	header, err := csv.NewReader(bytes.NewReader(head)).Read()
+	if err != nil {
+		return ImportFormatGoatCounter
+	}
+	cols := importColumns(header)
+	if _, ok := cols["pathname"]; ok {
+		return ImportFormatFathom
+	}
+	_, page := cols["page"]
+	_, views := cols["pageviews"]
+	if page && views {
+		return ImportFormatPlausible
+	}
+	return ImportFormatGoatCounter
+}
+
+// ConvertImport converts an export from Plausible, Fathom, or Matomo to a
+// GoatCounter CSV export, so it can be imported with Import().
+//
+// The format is detected with DetectImportFormat() if format is empty. The
+// returned reader is the original data if it's already a GoatCounter export,
+// or a temporary file that's removed on Close().
+func ConvertImport(fp io.Reader, format string) (io.ReadCloser, string, error) {
+	br := bufio.NewReaderSize(fp, 64*1024)
+	if format == "" {
+		head, err := br.Peek(64 * 1024)
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return nil, "", errors.Errorf("ConvertImport: %w", err)
+		}
+		format = DetectImportFormat(head)
+	}
+
+	var conv func(io.Reader, func(ExportRow) error) error
+	switch format {
+	default:
+		return nil, "", errors.Errorf("ConvertImport: unknown format: %q", format)
+	case ImportFormatGoatCounter:
+		return ioutil.NopCloser(br), format, nil
+	case ImportFormatPlausible, ImportFormatFathom:
+		conv = convertAggregated
+	case ImportFormatMatomo:
+		conv = convertMatomo
+	}
+
+	tmp, err := ioutil.TempFile("", "goatcounter-import-*.csv")
+	if err != nil {
+		return nil, "", errors.Errorf("ConvertImport: %w", err)
+	}
+	w := csv.NewWriter(tmp)
+	err = w.Write(ExportHeader())
+	if err == nil {
+		err = conv(br, func(row ExportRow) error { return w.Write(row.Values()) })
+	}
+	if err == nil {
+		w.Flush()
+		err = w.Error()
+	}
+	if err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, "", errors.Errorf("ConvertImport %s: %w", format, err)
+	}
+	return tmpFile{tmp}, format, nil
+}
+
+// tmpFile is a temporary file that's removed on Close().
+type tmpFile struct{ *os.File }
+
+func (f tmpFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.File.Name())
+	return err
+}
+
+// importColumns gets the position of every column in the header, by the
+// lower-case name.
+func importColumns(header []string) map[string]int {
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\xef\xbb\xbf")))
+		if _, ok := cols[h]; !ok {
+			cols[h] = i
+		}
+	}
+	return cols
+}
+
+func importColumn(cols map[string]int, names []string) int {
+	for _, n := range names {
+		if i, ok := cols[n]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// convertAggregated converts exports with the number of pageviews and visitors
+// per path per day, as exported by Plausible and Fathom.
+//
+// The individual pageviews aren't in these exports, so a pageview is created
+// for every counted pageview, and the first pageview of every visitor is
+// marked as the first visit in a session. The time of day isn't known either,
+// so they're all created at 12:00 UTC, which keeps them on the same day for
+// all but the most extreme timezones.
+func convertAggregated(fp io.Reader, fn func(ExportRow) error) error {
+	c := csv.NewReader(fp)
+	c.FieldsPerRecord = -1
+	header, err := c.Read()
+	if err != nil {
+		return err
+	}
+
+	var (
+		cols      = importColumns(header)
+		colDate   = importColumn(cols, importColDate)
+		colPath   = importColumn(cols, importColPath)
+		colViews  = importColumn(cols, importColPageviews)
+		colUnique = importColumn(cols, importColVisitors)
+		colHost   = importColumn(cols, []string{"hostname"})
+	)
+	for _, col := range []struct {
+		i    int
+		want []string
+	}{{colDate, importColDate}, {colPath, importColPath}, {colViews, importColPageviews}} {
+		if col.i == -1 {
+			return errors.Errorf("missing column in CSV header: one of %q", col.want)
+		}
+	}
+
+	get := func(line []string, i int) string {
+		if i == -1 || i >= len(line) {
+			return ""
+		}
+		return strings.TrimSpace(line[i])
+	}
+
+	for n := 2; ; n++ {
+		line, err := c.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		day, err := importParseDay(get(line, colDate))
+		if err != nil {
+			return errors.Errorf("line %d: %w", n, err)
+		}
+		views, err := importParseInt(get(line, colViews))
+		if err != nil {
+			return errors.Errorf("line %d: pageviews: %w", n, err)
+		}
+		visitors, err := importParseInt(get(line, colUnique))
+		if err != nil {
+			return errors.Errorf("line %d: visitors: %w", n, err)
+		}
+		if visitors > views {
+			views = visitors
+		}
+
+		path := get(line, colPath)
+		if path == "" {
+			return errors.Errorf("line %d: path is empty", n)
+		}
+		if u, err := url.Parse(path); err == nil && u.Host != "" {
+			path = u.Path
+		}
+		if path == "" || path[0] != '/' {
+			path = "/" + path
+		}
+
+		var (
+			host    = get(line, colHost)
+			created = day.Add(12 * time.Hour).Format(time.RFC3339)
+		)
+		for i := 0; i < views; i++ {
+			// Every visitor gets a session, and the pageviews are spread out
+			// over those sessions.
+			s := i
+			if visitors > 0 {
+				s = i % visitors
+			}
+			err := fn(ExportRow{
+				Path:       path,
+				Event:      "false",
+				Bot:        "0",
+				Session:    fmt.Sprintf("%s|%s|%s|%d", host, day.Format("2006-01-02"), path, s),
+				FirstVisit: strconv.FormatBool(i < visitors),
+				CreatedAt:  created,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func importParseDay(s string) (time.Time, error) {
+	for _, f := range []string{"2006-01-02", "2006-01-02 15:04:05", time.RFC3339} {
+		t, err := time.Parse(f, s)
+		if err == nil {
+			y, m, d := t.Date()
+			return time.Date(y, m, d, 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid date: %q", s)
+}
+
+func importParseInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, errors.Errorf("not a positive number: %q", s)
+	}
+	return int(f), nil
+}
+
+// matomoVisit is a visit from the Matomo API; only the fields that are used
+// are listed.
+type matomoVisit struct {
+	ID           json.RawMessage `json:"idVisit"` // Number or string.
+	ReferrerType string          `json:"referrerType"`
+	ReferrerName string          `json:"referrerName"`
+	ReferrerURL  string          `json:"referrerUrl"`
+	CountryCode  string          `json:"countryCode"`
+	Resolution   string          `json:"resolution"`
+	Actions      []struct {
+		Type          string      `json:"type"`
+		URL           string      `json:"url"`
+		PageTitle     string      `json:"pageTitle"`
+		EventCategory string      `json:"eventCategory"`
+		EventAction   string      `json:"eventAction"`
+		Timestamp     json.Number `json:"timestamp"`
+	} `json:"actionDetails"`
+}
+
+// convertMatomo converts the list of visits from the Matomo
+// Live.getLastVisitsDetails API in the JSON format. Every visit is a session,
+// page views are imported as pageviews and events as events. Other actions
+// (downloads, goals, etc.) are skipped.
+func convertMatomo(fp io.Reader, fn func(ExportRow) error) error {
+	dec := json.NewDecoder(fp)
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		// Matomo returns an object on errors.
+		return errors.New("not a list of visits; make sure to use the Live.getLastVisitsDetails API with format=JSON")
+	}
+
+	for n := 1; dec.More(); n++ {
+		var v matomoVisit
+		err := dec.Decode(&v)
+		if err != nil {
+			return errors.Errorf("visit %d: %w", n, err)
+		}
+
+		row := ExportRow{
+			Bot:     "0",
+			Session: "matomo-" + strings.Trim(string(v.ID), `"`),
+		}
+		switch {
+		case v.ReferrerURL != "":
+			row.Ref, row.RefScheme = v.ReferrerURL, *RefSchemeHTTP
+		case v.ReferrerType == "campaign" && v.ReferrerName != "":
+			row.Ref, row.RefScheme = v.ReferrerName, *RefSchemeCampaign
+		}
+		if cc := strings.ToUpper(v.CountryCode); len(cc) == 2 && cc != "XX" {
+			row.Location = cc
+		}
+		if x := strings.Split(v.Resolution, "x"); len(x) == 2 {
+			if _, err := strconv.Atoi(x[0]); err == nil {
+				if _, err := strconv.Atoi(x[1]); err == nil {
+					row.Size = x[0] + "," + x[1] + ",1"
+				}
+			}
+		}
+
+		seen := make(map[string]struct{})
+		for _, a := range v.Actions {
+			r := row
+			switch a.Type {
+			default:
+				continue
+			case "action":
+				u, err := url.Parse(a.URL)
+				if err != nil {
+					return errors.Errorf("visit %d: %w", n, err)
+				}
+				r.Path, r.Title, r.Event = u.Path, a.PageTitle, "false"
+				if r.Path == "" {
+					r.Path = "/"
+				}
+			case "event":
+				r.Path, r.Title, r.Event = a.EventAction, a.EventCategory, "true"
+				if r.Path == "" {
+					continue
+				}
+			}
+
+			ts, err := a.Timestamp.Int64()
+			if err != nil {
+				return errors.Errorf("visit %d: invalid timestamp: %q", n, a.Timestamp)
+			}
+			r.CreatedAt = time.Unix(ts, 0).UTC().Format(time.RFC3339)
+
+			_, ok := seen[r.Path]
+			seen[r.Path] = struct{}{}
+			r.FirstVisit = strconv.FormatBool(!ok)
+
+			// The referrer is only for the first pageview in the session.
+			row.Ref, row.RefScheme = "", ""
+
+			err = fn(r)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
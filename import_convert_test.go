@@ -0,0 +1,138 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestDetectImportFormat(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", goatcounter.ImportFormatGoatCounter},
+		{"3Path,Title,Event\n/a,,false\n", goatcounter.ImportFormatGoatCounter},
+		{"date,hostname,page,visits,visitors,pageviews,exits\n", goatcounter.ImportFormatPlausible},
+		{"\xef\xbb\xbfDate,Hostname,Pathname,Views,Uniques\n", goatcounter.ImportFormatFathom},
+		{"  \n[{\"idVisit\": 1}]", goatcounter.ImportFormatMatomo},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := goatcounter.DetectImportFormat([]byte(tt.in))
+			if got != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertImport(t *testing.T) {
+	tests := []struct {
+		name, in, wantFormat string
+		want                 []string
+	}{
+		{"plausible", `date,hostname,page,visits,visitors,pageviews,exits
+2020-10-18,example.com,/a,2,2,3,1
+2020-10-19,example.com,b,1,0,1,1
+`, goatcounter.ImportFormatPlausible, []string{
+			"/a false example.com|2020-10-18|/a|0 true  2020-10-18T12:00:00Z",
+			"/a false example.com|2020-10-18|/a|1 true  2020-10-18T12:00:00Z",
+			"/a false example.com|2020-10-18|/a|0 false  2020-10-18T12:00:00Z",
+			"/b false example.com|2020-10-19|/b|0 false  2020-10-19T12:00:00Z",
+		}},
+
+		{"fathom", `Timestamp,Hostname,Pathname,Views,Uniques
+2020-10-18 00:00:00,https://example.com,/x,1,1
+`, goatcounter.ImportFormatFathom, []string{
+			"/x false https://example.com|2020-10-18|/x|0 true  2020-10-18T12:00:00Z",
+		}},
+
+		{"matomo", `[{
+			"idVisit": "42", "referrerType": "website", "referrerUrl": "https://ref.example.com",
+			"countryCode": "nl", "resolution": "1920x1080",
+			"actionDetails": [
+				{"type": "action", "url": "https://example.com/a?x=1", "pageTitle": "A", "timestamp": 1603029336},
+				{"type": "goal", "timestamp": 1603029337},
+				{"type": "event", "eventCategory": "c", "eventAction": "click", "timestamp": 1603029338},
+				{"type": "action", "url": "https://example.com/a", "pageTitle": "A", "timestamp": 1603029339}
+			]
+		}]`, goatcounter.ImportFormatMatomo, []string{
+			"/a false matomo-42 true https://ref.example.com 2020-10-18T13:55:36Z",
+			"click true matomo-42 true  2020-10-18T13:55:38Z",
+			"/a false matomo-42 false  2020-10-18T13:55:39Z",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fp, format, err := goatcounter.ConvertImport(strings.NewReader(tt.in), "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer fp.Close()
+			if format != tt.wantFormat {
+				t.Errorf("format: %q", format)
+			}
+
+			c := csv.NewReader(fp)
+			header, err := c.Read()
+			if err != nil {
+				t.Fatal(err)
+			}
+			dec, err := goatcounter.NewExportDecoder(header)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for {
+				line, err := c.Read()
+				if err != nil {
+					break
+				}
+				row, err := dec.Decode(line)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := row.Hit(1); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, fmt.Sprintf("%s %s %s %s %s %s",
+					row.Path, row.Event, row.Session, row.FirstVisit, row.Ref, row.CreatedAt))
+			}
+
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+
+	t.Run("not converted", func(t *testing.T) {
+		in := "3Path,Date\n/a,2020-10-18T12:00:00Z\n"
+		fp, format, err := goatcounter.ConvertImport(strings.NewReader(in), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer fp.Close()
+		b, _ := ioutil.ReadAll(fp)
+		if format != goatcounter.ImportFormatGoatCounter || string(b) != in {
+			t.Errorf("%q: %q", format, b)
+		}
+	})
+
+	t.Run("matomo error", func(t *testing.T) {
+		_, _, err := goatcounter.ConvertImport(strings.NewReader(`{"result":"error"}`), "")
+		if err == nil || !strings.Contains(err.Error(), "not a list of visits") {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+}
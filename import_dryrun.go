@@ -0,0 +1,211 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zvalidate"
+)
+
+// importDryRunExamples is the number of error messages kept in
+// ImportDryRun.Examples.
+const importDryRunExamples = 10
+
+// ImportDryRun is the result of checking a file with Import() without
+// importing anything.
+type ImportDryRun struct {
+	Mode    string `json:"mode"`    // Import mode that was checked.
+	Rows    int    `json:"rows"`    // Rows that would be imported.
+	Invalid int    `json:"invalid"` // Rows with errors; these would be skipped.
+	Dropped int    `json:"dropped"` // Rows that would be dropped by the transform.
+
+	// Number of rows with errors by the kind of error, such as "invalid
+	// createdAt" or "CSV syntax". A row can have more than one error.
+	Errors map[string]int `json:"errors"`
+
+	// The first few error messages, with the row number.
+	Examples []string `json:"examples"`
+
+	// Lowest and highest date of the valid rows; zero if there are none.
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Number of distinct paths in the file, and an estimate of how many of
+	// those don't have any pageviews yet.
+	Paths    int `json:"paths"`
+	NewPaths int `json:"new_paths"`
+
+	// Values that can't be mapped; the same as with an import.
+	Report ImportReport `json:"report"`
+}
+
+// String gets a short human-readable summary.
+func (d ImportDryRun) String() string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "%d rows would be imported", d.Rows)
+	if !d.Start.IsZero() {
+		fmt.Fprintf(b, " from %s to %s", d.Start.Format("2006-01-02"), d.End.Format("2006-01-02"))
+	}
+	fmt.Fprintf(b, ", with %d paths of which about %d are new", d.Paths, d.NewPaths)
+	if d.Dropped > 0 {
+		fmt.Fprintf(b, "; %d rows would be dropped by the transform", d.Dropped)
+	}
+
+	switch {
+	case d.Mode == ImportReplace:
+		b.WriteString("; all existing pageviews would be removed")
+	case d.Mode == ImportReplaceRange && !d.Start.IsZero():
+		fmt.Fprintf(b, "; existing pageviews from %s to %s would be removed",
+			d.Start.Format("2006-01-02"), d.End.Format("2006-01-02"))
+	}
+	b.WriteString(". ")
+
+	if d.Invalid == 0 {
+		b.WriteString("There are no errors.")
+		return b.String()
+	}
+
+	kinds := make([]string, 0, len(d.Errors))
+	for k := range d.Errors {
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if d.Errors[kinds[i]] == d.Errors[kinds[j]] {
+			return kinds[i] < kinds[j]
+		}
+		return d.Errors[kinds[i]] > d.Errors[kinds[j]]
+	})
+	for i := range kinds {
+		kinds[i] = fmt.Sprintf("%s: %d", kinds[i], d.Errors[kinds[i]])
+	}
+	fmt.Fprintf(b, "%d rows have errors and would be skipped (%s).", d.Invalid, strings.Join(kinds, ", "))
+	return b.String()
+}
+
+func (d *ImportDryRun) addError(row int, err error) {
+	var (
+		vErr   *zvalidate.Validator
+		csvErr *csv.ParseError
+		kinds  []string
+	)
+	switch {
+	case errors.As(err, &vErr):
+		for k := range vErr.Errors {
+			kinds = append(kinds, "invalid "+k)
+		}
+	case errors.As(err, &csvErr):
+		kinds = []string{"CSV syntax"}
+	}
+	if len(kinds) == 0 {
+		kinds = []string{"other"}
+	}
+
+	if d.Errors == nil {
+		d.Errors = make(map[string]int)
+	}
+	for _, k := range kinds {
+		d.Errors[k]++
+	}
+	d.Invalid++
+	if len(d.Examples) < importDryRunExamples {
+		d.Examples = append(d.Examples, fmt.Sprintf("row %d: %s", row, err))
+	}
+}
+
+// check reads and validates all rows from c, after applying the transform.
+func (d *ImportDryRun) check(ctx context.Context, c *csv.Reader, dec *ExportDecoder, tr ImportTransform) error {
+	var (
+		site  = MustGetSite(ctx)
+		paths = make(map[string]struct{})
+	)
+	for row := 2; ; row++ { // Row 1 is the header.
+		if row%10000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			JobProgress(ctx, row, 0)
+		}
+
+		line, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			d.addError(row, err)
+			continue
+		}
+
+		exp, err := dec.Decode(line)
+		if err != nil {
+			d.addError(row, err)
+			continue
+		}
+		if !tr.Apply(&exp) {
+			d.Dropped++
+			continue
+		}
+		hit, err := exp.Hit(site.ID)
+		if err != nil {
+			d.addError(row, err)
+			continue
+		}
+
+		// Same as what happens on import and when the Memstore stores it, so
+		// the path is normalized and invalid pageviews are reported.
+		d.Report.Check(&hit)
+		hit.Defaults(ctx)
+		err = hit.Validate(ctx)
+		if err != nil {
+			d.addError(row, err)
+			continue
+		}
+
+		d.Rows++
+		paths[hit.Path] = struct{}{}
+		if d.Start.IsZero() || hit.CreatedAt.Before(d.Start) {
+			d.Start = hit.CreatedAt
+		}
+		if hit.CreatedAt.After(d.End) {
+			d.End = hit.CreatedAt
+		}
+	}
+
+	list := make([]string, 0, len(paths))
+	for p := range paths {
+		list = append(list, p)
+	}
+	d.Paths, d.NewPaths = len(list), len(list)
+
+	db := zdb.MustGet(ctx)
+	for i := 0; i < len(list); i += 500 {
+		j := i + 500
+		if j > len(list) {
+			j = len(list)
+		}
+		query, args, err := sqlx.In(`/* ImportDryRun.check */
+			select count(distinct path) from hit_counts where site=? and path in (?)`,
+			site.ID, list[i:j])
+		if err != nil {
+			return errors.Wrap(err, "ImportDryRun.check")
+		}
+		var n int
+		err = db.GetContext(ctx, &n, db.Rebind(query), args...)
+		if err != nil {
+			return errors.Wrap(err, "ImportDryRun.check")
+		}
+		d.NewPaths -= n
+	}
+	return nil
+}
@@ -0,0 +1,834 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/guru"
+	"zgo.at/zdb"
+	"zgo.at/zdb/bulk"
+	"zgo.at/zlog"
+	"zgo.at/zstd/zint"
+)
+
+// Import states.
+const (
+	ImportRunning   = "running"   // Not finished yet; resumed after a restart.
+	ImportDone      = "done"      // Finished.
+	ImportFailed    = "failed"    // Stopped with an error.
+	ImportCancelled = "cancelled" // Cancelled; rows that were already imported are kept.
+)
+
+// importCheckpointEvery is how often the progress of an import is recorded.
+const importCheckpointEvery = 1000
+
+// importPersistRetries is how often an import is resumed from the last
+// checkpoint if the pageviews after it weren't written to the database.
+const importPersistRetries = 3
+
+// importFingerprintBatch is how many rows are checked for duplicates at a time;
+// this must be a divisor of importCheckpointEvery.
+const importFingerprintBatch = 500
+
+// ImportFingerprintDays is for how many days the fingerprints of imported rows
+// are kept, by the date of the pageview. Rows older than this aren't detected
+// as duplicates with ImportAddNew.
+var ImportFingerprintDays = 400
+
+// ImportJob is an import of a file, which is stored so that the import can be
+// resumed after a restart or crash.
+//
+// The file is copied to ExportDir() and removed once the import is finished.
+// The progress is only recorded for rows that are written to the database, so
+// a resumed import continues from the last row that was stored. Rows that were
+// written after the last checkpoint are imported twice with ImportAdd and
+// ImportAddNew; the other modes remove them again if the import didn't get to
+// a checkpoint yet.
+type ImportJob struct {
+	ID     int64    `db:"import_id" json:"id,readonly"`
+	Site   int64    `db:"site" json:"site,readonly"`
+	UserID int64    `db:"user_id" json:"user_id,readonly"`
+	JobID  *int64   `db:"job_id" json:"job_id,readonly"`
+	Mode   string   `db:"mode" json:"mode,readonly"`
+	Email  zdb.Bool `db:"email" json:"-"` // Cleared once the email is sent.
+
+	// Configuration of the ImportTransform that's applied to every row; empty
+	// if rows are imported as-is.
+	Transform string `db:"transform" json:"transform,readonly"`
+
+	// Converted GoatCounter CSV file, and the SHA-256 hash of it.
+	File string `db:"file" json:"-"`
+	Hash string `db:"hash" json:"hash,readonly"`
+
+	// running, done, failed, or cancelled.
+	State string `db:"state" json:"state,readonly"`
+
+	// Number of rows in the file (without the header), the number of rows
+	// that were read so far, the number of rows that couldn't be imported,
+	// the number of rows that were skipped as they were already imported
+	// (only with ImportAddNew), and the number of rows that were dropped by
+	// the transform.
+	Total    int `db:"total" json:"total,readonly"`
+	RowsRead int `db:"rows_read" json:"rows_read,readonly"`
+	Errors   int `db:"errors" json:"errors,readonly"`
+	Skipped  int `db:"skipped" json:"skipped,readonly"`
+	Dropped  int `db:"dropped" json:"dropped,readonly"`
+
+	// Number of imported rows, and the last line of the file that was read
+	// (without the header). Both are only updated once the rows are written
+	// to the database.
+	RowsDone int `db:"rows_done" json:"rows_done,readonly"`
+	LastLine int `db:"last_line" json:"-"`
+
+	// Line the import was started or resumed from.
+	StartLine int `db:"start_line" json:"-"`
+
+	// Error if the state is failed.
+	Error *string `db:"error" json:"error,readonly"`
+
+	// Progress as a percentage, and the estimated time the import finishes;
+	// these are set by ByID() and List().
+	Progress float64    `db:"-" json:"progress,readonly"`
+	ETA      *time.Time `db:"-" json:"eta,readonly"`
+
+	CreatedAt time.Time  `db:"created_at" json:"created_at,readonly"`
+	StartedAt *time.Time `db:"started_at" json:"started_at,readonly"`
+	UpdatedAt time.Time  `db:"updated_at" json:"updated_at,readonly"`
+
+	pending      []importCheckpoint
+	persistSince MemstoreCheckpoint // Memstore checkpoint of the last recorded checkpoint.
+	lastProgress time.Time
+
+	// Set by run(), for the email and reindex once it's finished.
+	errs              *errors.Group
+	report            ImportReport
+	firstHit, lastHit time.Time
+	reindexed         bool
+	replacedRange     bool
+}
+
+type importCheckpoint struct {
+	line, rows int
+	cp         MemstoreCheckpoint
+}
+
+// NewImportJob converts the data in fp to a GoatCounter CSV export if needed
+// (see ConvertImport()), stores it, and creates a new import for the site and
+// user in the context. The transform is applied to every row when it's
+// imported.
+//
+// Use Run() to import the file.
+func NewImportJob(ctx context.Context, fp io.Reader, mode string, tr ImportTransform, email bool) (*ImportJob, error) {
+	site, user := MustGetSite(ctx), GetUser(ctx)
+	if user == nil {
+		return nil, errors.New("NewImportJob: no user in context")
+	}
+
+	conv, _, err := ConvertImport(fp, "")
+	if err != nil {
+		return nil, errors.Errorf("NewImportJob: %w", err)
+	}
+	defer conv.Close()
+
+	tmp, err := ioutil.TempFile(ExportDir(), fmt.Sprintf("goatcounter-import-%d-*.csv", site.ID))
+	if err != nil {
+		return nil, errors.Errorf("NewImportJob: %w", err)
+	}
+
+	// Count the rows while copying, so we can report the progress.
+	var (
+		h     = sha256.New()
+		c     = csv.NewReader(io.TeeReader(conv, io.MultiWriter(tmp, h)))
+		total = -1 // Don't count the header.
+	)
+	c.FieldsPerRecord, c.ReuseRecord = -1, true
+	for {
+		_, err = c.Read()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		var pErr *csv.ParseError
+		if err != nil && !errors.As(err, &pErr) {
+			break
+		}
+		total++
+	}
+	if err == nil {
+		err = tmp.Close()
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, errors.Errorf("NewImportJob: %w", err)
+	}
+	if total < 0 {
+		total = 0
+	}
+
+	imp := &ImportJob{
+		Site:      site.ID,
+		UserID:    user.ID,
+		Mode:      mode,
+		Email:     zdb.Bool(email),
+		Transform: tr.String(),
+		File:      tmp.Name(),
+		Hash:      hex.EncodeToString(h.Sum(nil)),
+		State:     ImportRunning,
+		Total:     total,
+		CreatedAt: Now(),
+		UpdatedAt: Now(),
+	}
+	imp.ID, err = insertWithID(ctx, "import_id", `insert into imports
+		(site, user_id, mode, email, transform, file, hash, state, total, created_at, updated_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		imp.Site, imp.UserID, imp.Mode, imp.Email, imp.Transform, imp.File, imp.Hash, imp.State, imp.Total,
+		imp.CreatedAt.Format(zdb.Date), imp.UpdatedAt.Format(zdb.Date))
+	if err != nil {
+		os.Remove(tmp.Name())
+		return nil, errors.Wrap(err, "NewImportJob")
+	}
+	return imp, nil
+}
+
+// update the import; imports that are finished are never changed.
+func (imp *ImportJob) update(ctx context.Context) error {
+	imp.UpdatedAt = Now()
+	var started *string
+	if imp.StartedAt != nil {
+		s := imp.StartedAt.Format(zdb.Date)
+		started = &s
+	}
+
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update imports set
+		state=$1, job_id=$2, rows_read=$3, errors=$4, skipped=$5, dropped=$6,
+		rows_done=$7, last_line=$8, start_line=$9, error=$10, started_at=$11,
+		updated_at=$12
+		where import_id=$13 and state=$14`,
+		imp.State, imp.JobID, imp.RowsRead, imp.Errors, imp.Skipped, imp.Dropped,
+		imp.RowsDone, imp.LastLine, imp.StartLine, imp.Error, started,
+		imp.UpdatedAt.Format(zdb.Date), imp.ID, ImportRunning)
+	return errors.Wrapf(err, "ImportJob.update %d", imp.ID)
+}
+
+// progress records the number of rows that were read; this is written to the
+// database at most once a second.
+func (imp *ImportJob) progress(ctx context.Context) {
+	if Now().Sub(imp.lastProgress) < time.Second {
+		return
+	}
+	imp.lastProgress = Now()
+
+	err := imp.update(ctx)
+	if err != nil {
+		zlog.Module("import").Field("import", imp.ID).Error(err)
+	}
+}
+
+// setProgress sets Progress and ETA.
+func (imp *ImportJob) setProgress() {
+	imp.Progress, imp.ETA = 0, nil
+	switch {
+	case imp.State == ImportDone:
+		imp.Progress = 100
+		return
+	case imp.Total == 0:
+		return
+	}
+	imp.Progress = math.Round(float64(imp.RowsRead)/float64(imp.Total)*1000) / 10
+
+	// Estimate from the rate since it was (re)started.
+	read := imp.RowsRead - imp.StartLine
+	if imp.State != ImportRunning || imp.StartedAt == nil || read <= 0 {
+		return
+	}
+	took := imp.UpdatedAt.Sub(*imp.StartedAt)
+	left := time.Duration(float64(took) / float64(read) * float64(imp.Total-imp.RowsRead))
+	eta := imp.UpdatedAt.Add(left).Truncate(time.Second)
+	imp.ETA = &eta
+}
+
+// checkpoint records that all rows up to line were added to the memstore, and
+// records the progress of the last checkpoint that was written to the
+// database.
+//
+// ErrPersistFailed is returned if some rows after the last recorded checkpoint
+// weren't written to the database; the import should be resumed from LastLine.
+func (imp *ImportJob) checkpoint(ctx context.Context, line, rows int) error {
+	imp.pending = append(imp.pending, importCheckpoint{line: line, rows: rows, cp: Memstore.Checkpoint()})
+
+	i := -1
+	for j, c := range imp.pending {
+		ok, err := Memstore.Persisted(imp.persistSince, c.cp)
+		if err != nil {
+			return errors.Errorf("ImportJob.checkpoint: after line %d: %w", imp.LastLine, err)
+		}
+		if !ok {
+			break
+		}
+		i = j
+	}
+	if i == -1 {
+		return nil
+	}
+
+	imp.LastLine, imp.RowsDone = imp.pending[i].line, imp.pending[i].rows
+	imp.persistSince = imp.pending[i].cp
+	imp.pending = imp.pending[i+1:]
+	return imp.update(ctx)
+}
+
+// fingerprint gets the fingerprint for the row, from the session, path, and
+// date. Identical rows are counted in seen, so that the nth identical row in a
+// file always gets the same fingerprint; exports from Plausible and Fathom have
+// many identical rows.
+func (row ExportRow) fingerprint(seen map[[16]byte]int) string {
+	h := sha256.Sum256([]byte(row.Session + "\x00" + row.Path + "\x00" + row.CreatedAt))
+	var k [16]byte
+	copy(k[:], h[:])
+	seen[k]++
+	if c := seen[k]; c > 1 {
+		h = sha256.Sum256(append(h[:], strconv.Itoa(c)...))
+	}
+	return hex.EncodeToString(h[:16])
+}
+
+// importRow is a row that was read from the file, but not yet added to the
+// memstore.
+type importRow struct {
+	line        int
+	hit         Hit
+	session     string
+	fingerprint string
+}
+
+// addFingerprints records the fingerprints of the rows, and reports which of
+// them are new.
+func (imp *ImportJob) addFingerprints(ctx context.Context, rows []importRow) (map[string]bool, error) {
+	var (
+		db    = zdb.MustGet(ctx)
+		fps   = make([]string, 0, len(rows))
+		isNew = make(map[string]bool, len(rows))
+	)
+	for _, r := range rows {
+		fps = append(fps, r.fingerprint)
+		isNew[r.fingerprint] = true
+	}
+
+	query, args, err := sqlx.In(`/* ImportJob.addFingerprints */
+		select fingerprint from import_fingerprints where site=? and fingerprint in (?)`,
+		imp.Site, fps)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImportJob.addFingerprints")
+	}
+	var existing []string
+	err = db.SelectContext(ctx, &existing, db.Rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImportJob.addFingerprints")
+	}
+	for _, f := range existing {
+		isNew[f] = false
+	}
+
+	ins := bulk.NewInsert(ctx, "import_fingerprints", []string{"site", "fingerprint",
+		"import_id", "line", "path", "created_at"})
+	for _, r := range rows {
+		if isNew[r.fingerprint] {
+			ins.Values(imp.Site, r.fingerprint, imp.ID, r.line, r.hit.Path, r.hit.CreatedAt.Format(zdb.Date))
+		}
+	}
+	return isNew, errors.Wrap(ins.Finish(), "ImportJob.addFingerprints")
+}
+
+// finish the import; the file is removed as it can't be resumed.
+func (imp *ImportJob) finish(ctx context.Context, line, rows int, importErr error) {
+	switch {
+	case importErr == nil:
+		imp.State = ImportDone
+		// The last rows may not be written to the database yet; this is
+		// rarely more than a few seconds.
+		imp.LastLine, imp.RowsDone = line, rows
+	case errors.Is(importErr, ErrJobCancelled):
+		imp.State = ImportCancelled
+		imp.LastLine, imp.RowsDone = line, rows
+	default:
+		imp.State = ImportFailed
+		e := importErr.Error()
+		imp.Error = &e
+	}
+
+	err := imp.update(ctx)
+	if err != nil {
+		zlog.Module("import").Field("import", imp.ID).Error(err)
+	}
+	os.Remove(imp.File)
+}
+
+// Start the import in the background with StartJob().
+//
+// The ctx should be detached from the request, and the site and user of the
+// import must be in it.
+func (imp *ImportJob) Start(ctx context.Context) (*Job, error) {
+	j, err := StartJob(ctx, JobImport, imp.Run)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImportJob.Start")
+	}
+
+	// Record the job right away so that it can be cancelled while it's
+	// queued; Run() sets JobID once it starts.
+	_, err = zdb.MustGet(ctx).ExecContext(ctx,
+		`update imports set job_id=$1 where import_id=$2`, j.ID, imp.ID)
+	return j, errors.Wrap(err, "ImportJob.Start")
+}
+
+// Run the import, continuing from the last recorded line if this import was
+// interrupted.
+//
+// The site and user of the import must be in the context.
+func (imp *ImportJob) Run(ctx context.Context) error {
+	var (
+		site = MustGetSite(ctx)
+		user = GetUser(ctx)
+		l    = zlog.Module("import").Fields(zlog.F{"site": site.ID, "mode": imp.Mode, "import": imp.ID})
+	)
+	if imp.LastLine > 0 {
+		l = l.Field("resume", imp.LastLine)
+	}
+	l.Print("import started")
+
+	now := Now()
+	imp.StartedAt, imp.StartLine, imp.RowsRead = &now, imp.LastLine, imp.LastLine
+	if j := GetJob(ctx); j != nil {
+		imp.JobID = &j.ID
+	}
+	err := imp.update(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+
+	line, n, err := imp.run(ctx, l)
+	for i := 0; errors.Is(err, ErrPersistFailed) && i < importPersistRetries; i++ {
+		l.Printf("resuming from line %d: %s", imp.LastLine, err)
+		line, n, err = imp.run(ctx, l)
+	}
+	cp := Memstore.Checkpoint()
+	imp.finish(ctx, line, n, err)
+	if errors.Is(err, ErrJobCancelled) {
+		Notify(ctx, NotifyImport, fmt.Sprintf(
+			"Import cancelled; %d pageviews were imported before it was cancelled.", n), "")
+		imp.email(ctx, l, *user, nil)
+		return err
+	}
+	if err != nil {
+		err = importFailed(ctx, err)
+		imp.email(ctx, l, *user, err)
+		return err
+	}
+
+	err = imp.reindex(ctx, cp)
+	if err != nil {
+		l.Error(err)
+	}
+	imp.notifyDone(ctx, err)
+	imp.email(ctx, l, *user, nil)
+	return nil
+}
+
+func (imp *ImportJob) run(ctx context.Context, l zlog.Log) (int, int, error) {
+	site := MustGetSite(ctx)
+	imp.pending, imp.persistSince = nil, Memstore.Checkpoint()
+
+	fp, err := os.Open(imp.File)
+	if err != nil {
+		return 0, 0, errors.Errorf("ImportJob.run: %w", err)
+	}
+	defer fp.Close()
+
+	// Make sure it's still the same file, as it's not useful to resume with a
+	// different one.
+	h := sha256.New()
+	if _, err := io.Copy(h, fp); err != nil {
+		return 0, 0, errors.Errorf("ImportJob.run: %w", err)
+	}
+	if hex.EncodeToString(h.Sum(nil)) != imp.Hash {
+		return 0, 0, errors.Errorf("ImportJob.run: file %q was modified", imp.File)
+	}
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, errors.Errorf("ImportJob.run: %w", err)
+	}
+
+	c := csv.NewReader(fp)
+	header, err := c.Read()
+	if err != nil {
+		return 0, 0, err
+	}
+	dec, err := NewExportDecoder(header)
+	if err != nil {
+		return 0, 0, err
+	}
+	tr, err := ParseImportTransform(imp.Transform)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Rows after the last checkpoint may not be stored, so don't skip them as
+	// duplicates.
+	if imp.LastLine > 0 {
+		_, err := zdb.MustGet(ctx).ExecContext(ctx,
+			`delete from import_fingerprints where import_id=$1 and line > $2`,
+			imp.ID, imp.LastLine)
+		if err != nil {
+			return 0, 0, errors.Errorf("ImportJob.run: %w", err)
+		}
+	}
+
+	// Remove the existing pageviews unless we're resuming. Pageviews that were
+	// stored after the last checkpoint are removed again if it was interrupted
+	// before that, so it doesn't matter if this runs twice.
+	if imp.LastLine == 0 {
+		switch imp.Mode {
+		case ImportReplace:
+			err := (&AuditLog{Action: AuditImportReplace, Info: "remove all pageviews before import"}).Insert(ctx)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			err = site.DeleteAll(ctx)
+			if err != nil {
+				return 0, 0, err
+			}
+
+		case ImportReplaceRange:
+			tmp, start, end, err := importRange(c, dec, tr)
+			if err != nil {
+				return 0, 0, err
+			}
+			defer func() {
+				tmp.Close()
+				os.Remove(tmp.Name())
+			}()
+			c = csv.NewReader(tmp)
+
+			if !start.IsZero() {
+				loc := site.Settings.Timezone.Loc()
+				err := (&AuditLog{Action: AuditImportReplaceRange, Info: fmt.Sprintf(
+					"remove pageviews from %s to %s before import",
+					start.In(loc).Format("2006-01-02"), end.In(loc).Format("2006-01-02"))}).Insert(ctx)
+				if err != nil {
+					return 0, 0, err
+				}
+
+				// The statistics of the first and last UTC day may include
+				// pageviews outside the range, so always recreate them.
+				first, last, err := site.DeleteRange(ctx, start, end)
+				if err != nil {
+					return 0, 0, err
+				}
+				imp.extend(first)
+				imp.extend(last)
+				imp.replacedRange = true
+			}
+		}
+	}
+
+	var (
+		// Sessions from before a restart get a new ID, which is fine as the
+		// first visits are in the export.
+		sessions = make(map[string]zint.Uint128)
+		line     = 0
+		n        = imp.RowsDone
+		errs     = errors.NewGroup(cfg.MaxImportErrors)
+		report   ImportReport
+		seen     = make(map[[16]byte]int)
+		pending  = make([]importRow, 0, importFingerprintBatch)
+		failed   = func(err error) bool {
+			if errs.Append(err) {
+				imp.Errors++
+				return true
+			}
+			return false
+		}
+	)
+
+	// Add the pending rows to the memstore, skipping rows that were already
+	// imported for ImportAddNew.
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		isNew, err := imp.addFingerprints(ctx, pending)
+		if err != nil {
+			return err
+		}
+		for _, r := range pending {
+			if !isNew[r.fingerprint] && imp.Mode == ImportAddNew {
+				imp.Skipped++
+				continue
+			}
+
+			// Map session IDs to new session IDs.
+			s, ok := sessions[r.session]
+			if !ok {
+				s = Memstore.SessionID()
+				sessions[r.session] = s
+			}
+			r.hit.Session = s
+
+			report.Check(&r.hit)
+			Memstore.Append(r.hit)
+			imp.extend(r.hit.CreatedAt)
+			n++
+		}
+		pending = pending[:0]
+		return nil
+	}
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if line <= imp.LastLine {
+			// Still count identical rows for the fingerprints.
+			if err == nil {
+				if row, err := dec.Decode(record); err == nil && tr.Apply(&row) {
+					row.fingerprint(seen)
+					if hit, err := row.Hit(site.ID); err == nil {
+						imp.extend(hit.CreatedAt)
+					}
+				}
+			}
+			continue
+		}
+		imp.RowsRead = line
+		imp.progress(ctx)
+		if failed(err) {
+			continue
+		}
+
+		row, err := dec.Decode(record)
+		if failed(err) {
+			continue
+		}
+		if !tr.Apply(&row) {
+			imp.Dropped++
+			continue
+		}
+
+		hit, err := row.Hit(site.ID)
+		if failed(err) {
+			continue
+		}
+
+		pending = append(pending, importRow{line: line, hit: hit, session: row.Session,
+			fingerprint: row.fingerprint(seen)})
+		if len(pending) == importFingerprintBatch || line%importCheckpointEvery == 0 {
+			err := flush()
+			if err != nil {
+				return line, n, err
+			}
+		}
+
+		JobProgress(ctx, n+imp.Skipped, 0)
+
+		if line%importCheckpointEvery == 0 {
+			err := imp.checkpoint(ctx, line, n)
+			if errors.Is(err, ErrPersistFailed) {
+				return line, n, err
+			}
+			if err != nil {
+				l.Error(err)
+			}
+		}
+
+		// Spread out the load a bit.
+		err = JobPace(ctx, line)
+		if err != nil {
+			if fErr := flush(); fErr != nil {
+				l.Error(fErr)
+			}
+			l.Printf("import stopped after %d rows: %s", n, err)
+			return line, n, err
+		}
+	}
+	err = flush()
+	if err != nil {
+		return line, n, err
+	}
+
+	l.Debugf("imported %d rows; %d unknown browsers and %d unknown locations",
+		n, report.Browsers.Total(), report.Locations.Total())
+	if errs.Len() > 0 {
+		l.Error(errs)
+	}
+
+	imp.errs, imp.report = errs, report
+	return line, n, nil
+}
+
+// notifyDone sends the notification for a finished import; reindexErr is the
+// error from reindex().
+func (imp *ImportJob) notifyDone(ctx context.Context, reindexErr error) {
+	msg := fmt.Sprintf("Import finished; %d pageviews were imported with %d errors.", imp.RowsDone, imp.errs.Len())
+	if imp.Skipped > 0 {
+		msg += fmt.Sprintf(" %d rows were skipped as they were already imported.", imp.Skipped)
+	}
+	if imp.Dropped > 0 {
+		msg += fmt.Sprintf(" %d rows were dropped by the transform.", imp.Dropped)
+	}
+	switch {
+	case reindexErr != nil:
+		msg += fmt.Sprintf(" The statistics for the imported days couldn't be recreated: %s", reindexErr)
+	case imp.reindexed:
+		msg += fmt.Sprintf(" The statistics from %s to %s were recreated.",
+			imp.firstHit.Format("2006-01-02"), imp.lastHit.Format("2006-01-02"))
+	}
+	Notify(ctx, NotifyImport, msg, "")
+}
+
+// extend the range of days of the imported pageviews with t.
+func (imp *ImportJob) extend(t time.Time) {
+	if imp.firstHit.IsZero() || t.Before(imp.firstHit) {
+		imp.firstHit = t
+	}
+	if t.After(imp.lastHit) {
+		imp.lastHit = t
+	}
+}
+
+// ByID gets an import by ID, for the site in the context.
+func (imp *ImportJob) ByID(ctx context.Context, id int64) error {
+	err := zdb.MustGet(ctx).GetContext(ctx, imp,
+		`/* ImportJob.ByID */ select * from imports where import_id=$1 and site=$2`,
+		id, MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "ImportJob.ByID")
+	}
+	imp.setProgress()
+	return nil
+}
+
+// Cancel the import; the pageviews that were already imported are kept.
+//
+// See Job.Cancel() for imports running on another instance.
+func (imp *ImportJob) Cancel(ctx context.Context) error {
+	if imp.State != ImportRunning || imp.JobID == nil {
+		return guru.Errorf(400, "import %d is %s", imp.ID, imp.State)
+	}
+
+	var j Job
+	err := j.ByID(ctx, *imp.JobID)
+	if err != nil {
+		return errors.Wrap(err, "ImportJob.Cancel")
+	}
+	err = j.Cancel(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The import never runs if the job was still waiting for other imports.
+	if j.State == JobQueued {
+		imp.finish(ctx, imp.LastLine, imp.RowsDone, ErrJobCancelled)
+	}
+	return nil
+}
+
+// ImportJobs is a list of imports.
+type ImportJobs []ImportJob
+
+// List the 100 most recent imports for the site in the context, newest first.
+func (imps *ImportJobs) List(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, imps, `/* ImportJobs.List */
+		select * from imports where site=$1 order by created_at desc, import_id desc limit 100`,
+		MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "ImportJobs.List")
+	}
+	for i := range *imps {
+		(*imps)[i].setProgress()
+	}
+	return nil
+}
+
+// Resume all imports that are still running but for which the job is gone,
+// for example because the instance that ran it was restarted; this is run on
+// startup and from cron, after Jobs.Interrupted().
+//
+// Only one instance resumes imports at the same time, and the new job is
+// recorded before the lock is released, so an import is never resumed twice.
+//
+// Imports for sites or users that no longer exist are marked as failed.
+func (imps *ImportJobs) Resume(ctx context.Context) error {
+	_, err := WithLock(ctx, "resume-imports", func() error {
+		// Imports without a job are still being started, unless that was a
+		// while ago.
+		err := zdb.MustGet(ctx).SelectContext(ctx, imps, `/* ImportJobs.Resume */
+			select * from imports where state=$1 and (
+				(job_id is null and updated_at < $2) or
+				job_id not in (select job_id from jobs where state in ($3, $4))
+			) order by import_id`,
+			ImportRunning, Now().Add(-JobDead).Format(zdb.Date), JobQueued, JobRunning)
+		if err != nil {
+			return err
+		}
+
+		l := zlog.Module("import")
+		for i := range *imps {
+			imp := &(*imps)[i]
+
+			var (
+				site Site
+				user User
+			)
+			err := site.ByID(ctx, imp.Site)
+			if err == nil {
+				err = user.ByID(ctx, imp.UserID)
+			}
+			if err != nil {
+				l.Field("import", imp.ID).Error(err)
+				imp.finish(ctx, 0, 0, errors.Errorf("can't resume: %w", err))
+				continue
+			}
+
+			_, err = imp.Start(WithUser(WithSite(NewContext(ctx), &site), &user))
+			if err != nil {
+				return err
+			}
+			l.Fields(zlog.F{"import": imp.ID, "line": imp.LastLine}).Print("resuming import")
+		}
+		return nil
+	})
+	return errors.Wrap(err, "ImportJobs.Resume")
+}
+
+// DeleteOldFingerprints deletes the fingerprints of imported rows for
+// pageviews older than ImportFingerprintDays.
+func (imps *ImportJobs) DeleteOldFingerprints(ctx context.Context) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `delete from import_fingerprints
+		where created_at < `+interval(ImportFingerprintDays))
+	return errors.Wrap(err, "ImportJobs.DeleteOldFingerprints")
+}
+
+// DeleteOlderThan deletes all finished imports older than the given number of
+// days.
+func (imps *ImportJobs) DeleteOlderThan(ctx context.Context, days int) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `delete from imports
+		where state != $1 and created_at < `+interval(days), ImportRunning)
+	return errors.Wrap(err, "ImportJobs.DeleteOlderThan")
+}
@@ -0,0 +1,376 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/bgrun"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+const importJobCSV = "2Path,Title,Event,Bot,Session,FirstVisit,Referrer,Referrer scheme,Browser,Screen size,Location,Date,ID\n" +
+	"/a,,false,0,1,true,,,,,,2020-06-10T12:00:00Z,1\n" +
+	"/b,,false,0,1,true,,,,,,2020-06-10T13:00:00Z,2\n" +
+	"/c,,false,0,1,true,,,,,,2020-06-10T14:00:00Z,3\n"
+
+func importedPaths(ctx context.Context, t *testing.T) string {
+	t.Helper()
+	_, err := goatcounter.Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	err = zdb.MustGet(ctx).SelectContext(ctx, &got, `select path from hits order by created_at`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Join(got, " ")
+}
+
+func TestImportJobRun(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Interrupted after the second line.
+	imp.LastLine, imp.RowsDone = 2, 2
+	err = imp.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := importedPaths(ctx, t); got != "/c" {
+		t.Errorf("imported: %q", got)
+	}
+	if _, err := os.Stat(imp.File); !os.IsNotExist(err) {
+		t.Errorf("file not removed: %v", err)
+	}
+
+	var got goatcounter.ImportJob
+	err = zdb.MustGet(ctx).GetContext(ctx, &got, `select * from imports where import_id=$1`, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != goatcounter.ImportDone || got.RowsDone != 3 || got.LastLine != 3 {
+		t.Errorf("state=%s rows_done=%d last_line=%d", got.State, got.RowsDone, got.LastLine)
+	}
+}
+
+func TestImportJobsResume(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := zdb.MustGet(ctx)
+
+	// Still running on another instance.
+	_, err = db.ExecContext(ctx, `insert into jobs
+		(site, kind, state, created_at, updated_at, instance, heartbeat_at)
+		values (1, $1, $2, $3, $3, 'other', $3)`,
+		goatcounter.JobImport, goatcounter.JobRunning, goatcounter.Now().Format(zdb.Date))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.ExecContext(ctx, `update imports set last_line=1, rows_done=1,
+		job_id=(select max(job_id) from jobs) where import_id=$1`, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var imports goatcounter.ImportJobs
+	err = imports.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imports) != 0 {
+		t.Fatalf("resumed %d imports while the job is running", len(imports))
+	}
+
+	// The instance is gone.
+	_, err = db.ExecContext(ctx, `update jobs set state=$1`, goatcounter.JobFailed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imports = nil
+	err = imports.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bgrun.Wait()
+
+	if len(imports) != 1 {
+		t.Fatalf("resumed %d imports", len(imports))
+	}
+	if got := importedPaths(ctx, t); got != "/b /c" {
+		t.Errorf("imported: %q", got)
+	}
+
+	// Nothing left to resume.
+	imports = nil
+	err = imports.Resume(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("resumed %d imports", len(imports))
+	}
+}
+
+func TestMemstoreCheckpoint(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	// Nothing in the memstore: everything is already persisted.
+	since := goatcounter.Memstore.Checkpoint()
+	if ok, err := goatcounter.Memstore.Persisted(since, since); !ok || err != nil {
+		t.Fatalf("empty memstore not persisted: %t %v", ok, err)
+	}
+
+	goatcounter.Memstore.Append(goatcounter.Hit{Site: 1, Path: "/a", Session: goatcounter.TestSession})
+	cp := goatcounter.Memstore.Checkpoint()
+	if ok, err := goatcounter.Memstore.Persisted(since, cp); ok || err != nil {
+		t.Fatalf("persisted before Persist(): %t %v", ok, err)
+	}
+
+	_, err := goatcounter.Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := goatcounter.Memstore.Persisted(since, cp); !ok || err != nil {
+		t.Fatalf("not persisted after Persist(): %t %v", ok, err)
+	}
+
+	// A checkpoint after Persist() doesn't need another Persist().
+	cp = goatcounter.Memstore.Checkpoint()
+	if ok, err := goatcounter.Memstore.Persisted(since, cp); !ok || err != nil {
+		t.Fatalf("not persisted without new pageviews: %t %v", ok, err)
+	}
+}
+
+func TestImportJobProgress(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imp.Total != 3 {
+		t.Errorf("total is %d", imp.Total)
+	}
+	err = imp.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got goatcounter.ImportJob
+	err = got.ByID(ctx, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != goatcounter.ImportDone || got.RowsRead != 3 || got.Errors != 0 ||
+		got.Progress != 100 || got.StartedAt == nil || got.ETA != nil {
+		t.Errorf("wrong import: %#v", got)
+	}
+
+	var list goatcounter.ImportJobs
+	err = list.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != imp.ID {
+		t.Errorf("wrong list: %#v", list)
+	}
+
+	err = got.Cancel(ctx)
+	if err == nil {
+		t.Error("no error when cancelling a finished import")
+	}
+}
+
+func TestImportJobCancel(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	// Keep the import class busy, so the import is queued.
+	var (
+		started = make(chan struct{})
+		done    = make(chan struct{})
+	)
+	_, err := goatcounter.StartJob(ctx, goatcounter.JobImport, func(context.Context) error {
+		close(started)
+		<-done
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-started
+
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = imp.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got goatcounter.ImportJob
+	err = got.ByID(ctx, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = got.Cancel(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(done)
+	bgrun.Wait()
+
+	err = got.ByID(ctx, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != goatcounter.ImportCancelled {
+		t.Errorf("state is %s", got.State)
+	}
+	if got := importedPaths(ctx, t); got != "" {
+		t.Errorf("imported: %q", got)
+	}
+	if _, err := os.Stat(imp.File); !os.IsNotExist(err) {
+		t.Errorf("file not removed: %v", err)
+	}
+}
+
+func TestImportJobAddNew(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	run := func(csv, mode string) *goatcounter.ImportJob {
+		t.Helper()
+		imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(csv), mode, goatcounter.ImportTransform{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = imp.Run(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return imp
+	}
+
+	run(importJobCSV, goatcounter.ImportAdd)
+
+	imp := run(importJobCSV, goatcounter.ImportAddNew)
+	if imp.Skipped != 3 {
+		t.Errorf("skipped %d rows", imp.Skipped)
+	}
+	if got := importedPaths(ctx, t); got != "/a /b /c" {
+		t.Errorf("imported: %q", got)
+	}
+
+	// Identical rows in the same file are all imported.
+	imp = run(importJobCSV+
+		"/d,,false,0,1,true,,,,,,2020-06-10T15:00:00Z,4\n"+
+		"/d,,false,0,1,true,,,,,,2020-06-10T15:00:00Z,4\n",
+		goatcounter.ImportAddNew)
+	if imp.Skipped != 3 {
+		t.Errorf("skipped %d rows", imp.Skipped)
+	}
+	if got := importedPaths(ctx, t); got != "/a /b /c /d /d" {
+		t.Errorf("imported: %q", got)
+	}
+
+	// Everything is imported again after clearing the pageviews.
+	err := goatcounter.MustGetSite(ctx).DeleteAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp = run(importJobCSV, goatcounter.ImportAddNew)
+	if imp.Skipped != 0 {
+		t.Errorf("skipped %d rows", imp.Skipped)
+	}
+	if got := importedPaths(ctx, t); got != "/a /b /c" {
+		t.Errorf("imported: %q", got)
+	}
+
+	// Purged paths are imported again.
+	var hits goatcounter.Hits
+	err = hits.Purge(ctx, "/a", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp = run(importJobCSV, goatcounter.ImportAddNew)
+	if imp.Skipped != 2 {
+		t.Errorf("skipped %d rows", imp.Skipped)
+	}
+	if got := importedPaths(ctx, t); got != "/a /b /c" {
+		t.Errorf("imported: %q", got)
+	}
+
+	// Expired fingerprints.
+	defer func(d int) { goatcounter.ImportFingerprintDays = d }(goatcounter.ImportFingerprintDays)
+	goatcounter.ImportFingerprintDays = 0
+	var imports goatcounter.ImportJobs
+	err = imports.DeleteOldFingerprints(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp = run(importJobCSV, goatcounter.ImportAddNew)
+	if imp.Skipped != 0 {
+		t.Errorf("skipped %d rows", imp.Skipped)
+	}
+	if got := importedPaths(ctx, t); got != "/a /a /b /b /c /c" {
+		t.Errorf("imported: %q", got)
+	}
+}
+
+func TestImportJobTransform(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	tr, err := goatcounter.ParseImportTransform("drop /b\nprefix / /old/\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, tr, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imp.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imp.Dropped != 1 {
+		t.Errorf("dropped %d rows", imp.Dropped)
+	}
+	if got := importedPaths(ctx, t); got != "/old/a /old/c" {
+		t.Errorf("imported: %q", got)
+	}
+
+	var got goatcounter.ImportJob
+	err = got.ByID(ctx, imp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Transform != "drop /b\nprefix / /old/\n" || got.Dropped != 1 {
+		t.Errorf("transform=%q dropped=%d", got.Transform, got.Dropped)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// Import throttle profiles.
+const (
+	ImportThrottleNormal = "normal" // Back off if the database is busy, and stay below cfg.ImportRate.
+	ImportThrottleFast   = "fast"   // Only back off if the database is busy.
+	ImportThrottleOff    = "off"    // Never pause.
+)
+
+// When to email about finished imports.
+const (
+	ImportEmailEach     = "each"     // After every import.
+	ImportEmailCombined = "combined" // Once all imports for the site are finished.
+	ImportEmailNever    = "never"    // Never; there's still a notification.
+)
+
+// ImportPolicy controls how imports run on this instance.
+type ImportPolicy struct {
+	Throttle string // How much imports pause; see the ImportThrottle* constants.
+	Email    string // When to email; see the ImportEmail* constants.
+
+	// Recreate the statistics for the days of the imported pageviews once
+	// the import is finished. Imports of at least ImportReindexMin pageviews
+	// are always reindexed.
+	Reindex bool
+}
+
+// ImportReindexMin is the number of imported pageviews from which the
+// statistics are always recreated after an import.
+var ImportReindexMin = 10000
+
+var (
+	importPolicy  = ImportPolicy{Throttle: ImportThrottleNormal, Email: ImportEmailEach}
+	importReindex ReindexFunc
+)
+
+// ParseImportPolicy parses a policy as a comma-separated list of key=value
+// pairs, such as "throttle=off,email=combined,reindex=true". Keys that aren't
+// given are set to the default.
+func ParseImportPolicy(s string) (ImportPolicy, error) {
+	p := ImportPolicy{Throttle: ImportThrottleNormal, Email: ImportEmailEach}
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		i := strings.IndexByte(kv, '=')
+		if i == -1 {
+			return p, fmt.Errorf("%q is not in the form key=value", kv)
+		}
+		k, v := strings.TrimSpace(kv[:i]), strings.TrimSpace(kv[i+1:])
+
+		switch k {
+		default:
+			return p, fmt.Errorf("unknown key %q", k)
+		case "throttle":
+			switch v {
+			case ImportThrottleNormal, ImportThrottleFast, ImportThrottleOff:
+				p.Throttle = v
+			default:
+				return p, fmt.Errorf("throttle: must be normal, fast, or off")
+			}
+		case "email":
+			switch v {
+			case ImportEmailEach, ImportEmailCombined, ImportEmailNever:
+				p.Email = v
+			default:
+				return p, fmt.Errorf("email: must be each, combined, or never")
+			}
+		case "reindex":
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, fmt.Errorf("reindex: must be true or false")
+			}
+			p.Reindex = b
+		}
+	}
+	return p, nil
+}
+
+func (p ImportPolicy) String() string {
+	return fmt.Sprintf("throttle=%s,email=%s,reindex=%t", p.Throttle, p.Email, p.Reindex)
+}
+
+// pacing gets the JobPacing for the throttle profile.
+func (p ImportPolicy) pacing() JobPacing {
+	switch p.Throttle {
+	case ImportThrottleOff:
+		return JobPacing{}
+	case ImportThrottleFast:
+		return JobPacing{Every: 5000, Max: 30 * time.Second}
+	default:
+		return JobPacing{Every: 5000, Max: 30 * time.Second,
+			Rate: func() int { return int(atomic.LoadInt64(&cfg.ImportRate)) }}
+	}
+}
+
+// SetImportPolicy sets the policy for imports; reindex is used to recreate the
+// statistics if the policy has Reindex set.
+//
+// This only applies to imports that are started after this.
+func SetImportPolicy(p ImportPolicy, reindex ReindexFunc) {
+	importPolicy, importReindex = p, reindex
+	RegisterJob(JobKind{Name: JobImport, Class: JobClassImport, Pacing: p.pacing()})
+}
+
+// GetImportPolicy gets the policy for imports.
+func GetImportPolicy() ImportPolicy { return importPolicy }
+
+// reindex recreates the statistics for the days of the pageviews in the
+// import, if the import has at least ImportReindexMin pageviews, replaced a
+// date range, or if this is enabled in the ImportPolicy.
+//
+// The pageviews are added to the memstore, so this waits until they're
+// written to the database first; this stops waiting if the job is cancelled
+// or the context is done. The reindex is run as a ReindexJob, so it's
+// queued behind other reindexes, and can be resumed if it's interrupted.
+func (imp *ImportJob) reindex(ctx context.Context, cp MemstoreCheckpoint) error {
+	if importReindex == nil || imp.firstHit.IsZero() ||
+		(!importPolicy.Reindex && !imp.replacedRange && imp.RowsDone < ImportReindexMin) {
+		return nil
+	}
+
+	var cancel <-chan struct{}
+	if j := GetJob(ctx); j != nil {
+		cancel = j.cancel
+	}
+	var (
+		tick    = time.NewTicker(time.Second)
+		timeout = time.NewTimer(10 * time.Minute)
+	)
+	defer tick.Stop()
+	defer timeout.Stop()
+	for {
+		ok, err := Memstore.Persisted(imp.persistSince, cp)
+		if err != nil {
+			return errors.Wrap(err, "ImportJob.reindex")
+		}
+		if ok {
+			break
+		}
+
+		select {
+		case <-tick.C:
+		case <-cancel:
+			return ErrJobCancelled
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "ImportJob.reindex")
+		case <-timeout.C:
+			return errors.New("ImportJob.reindex: pageviews not written to the database after 10 minutes")
+		}
+	}
+
+	first, last := truncDay(imp.firstHit), truncDay(imp.lastHit)
+	rj, err := NewReindexJob(ctx, &first, &last, nil, []string{"all"})
+	if err != nil {
+		return errors.Wrap(err, "ImportJob.reindex")
+	}
+	err = RunJob(ctx, JobReindex, func(ctx context.Context) error {
+		return rj.Run(ctx, importReindex)
+	})
+	if err != nil {
+		return errors.Wrap(err, "ImportJob.reindex")
+	}
+	imp.reindexed = true
+	return nil
+}
+
+// email the user that the import is finished, depending on the ImportPolicy.
+//
+// Imports that have Email set to false are only emailed if they failed and the
+// policy is to email every import. Email is set to false once the email is sent
+// (or if it's never sent).
+func (imp *ImportJob) email(ctx context.Context, l zlog.Log, user User, importErr error) {
+	if !imp.Email && (importErr == nil || importPolicy.Email != ImportEmailEach) {
+		return
+	}
+
+	var (
+		err  error
+		sent []int64
+	)
+	switch importPolicy.Email {
+	case ImportEmailNever:
+		sent = []int64{imp.ID}
+	case ImportEmailCombined:
+		sent, err = imp.emailCombined(ctx, user)
+	default:
+		err = imp.emailDone(ctx, user, importErr)
+		sent = []int64{imp.ID}
+	}
+	if err != nil {
+		l.Error(err)
+	}
+	if len(sent) == 0 {
+		return
+	}
+
+	db := zdb.MustGet(ctx)
+	query, args, err := sqlx.In(`update imports set email=0 where import_id in (?)`, sent)
+	if err == nil {
+		_, err = db.ExecContext(ctx, db.Rebind(query), args...)
+	}
+	if err != nil {
+		l.Error(err)
+	}
+}
+
+// emailDone sends the email for a single import.
+func (imp *ImportJob) emailDone(ctx context.Context, user User, importErr error) error {
+	if importErr != nil {
+		return SendEmail(ctx, "GoatCounter import error", "GoatCounter import", user.Email,
+			EmailTemplate("email_import_error.gotxt", struct {
+				Error error
+			}{importErr}))
+	}
+	if imp.State != ImportDone {
+		return nil
+	}
+
+	// Send email after 10s delay to make sure the cron task has finished
+	// updating all the rows.
+	if !imp.reindexed {
+		time.Sleep(10 * time.Second)
+	}
+	return SendEmail(ctx, "GoatCounter import ready", "GoatCounter import", user.Email,
+		EmailTemplate("email_import_done.gotxt", struct {
+			Site      Site
+			Rows      int
+			Skipped   int
+			Dropped   int
+			Errors    *errors.Group
+			Report    ImportReport
+			Reindexed bool
+		}{*MustGetSite(ctx), imp.RowsDone, imp.Skipped, imp.Dropped, imp.errs, imp.report, imp.reindexed}))
+}
+
+// emailCombined sends one email for all finished imports of the site that
+// still need to be emailed, once no other imports are running for the site.
+//
+// The imports are claimed before sending, so only one email is sent if several
+// imports finish at the same time. It returns the IDs of the imports that were
+// included.
+func (imp *ImportJob) emailCombined(ctx context.Context, user User) ([]int64, error) {
+	db := zdb.MustGet(ctx)
+	var running int
+	err := db.GetContext(ctx, &running,
+		`select count(*) from imports where site=$1 and state=$2 and import_id != $3`,
+		imp.Site, ImportRunning, imp.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImportJob.emailCombined")
+	}
+	if running > 0 { // The last import sends the email.
+		return nil, nil
+	}
+
+	var imps ImportJobs
+	err = db.SelectContext(ctx, &imps, `/* ImportJob.emailCombined */
+		select * from imports where site=$1 and email=1 and state != $2 order by import_id`,
+		imp.Site, ImportRunning)
+	if err != nil {
+		return nil, errors.Wrap(err, "ImportJob.emailCombined")
+	}
+	if len(imps) == 0 {
+		return nil, nil
+	}
+
+	// Claim the imports by clearing email, so that imports finishing at the
+	// same time on other instances don't send them again.
+	var (
+		ids     = make([]int64, 0, len(imps))
+		claimed = make(ImportJobs, 0, len(imps))
+	)
+	for _, i := range imps {
+		res, err := db.ExecContext(ctx, `/* ImportJob.emailCombined */
+			update imports set email=0 where import_id=$1 and email=1`, i.ID)
+		if err != nil {
+			return ids, errors.Wrap(err, "ImportJob.emailCombined")
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			continue
+		}
+		ids = append(ids, i.ID)
+		claimed = append(claimed, i)
+	}
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+	imps = claimed
+
+	if !imp.reindexed {
+		time.Sleep(10 * time.Second)
+	}
+	return ids, SendEmail(ctx, "GoatCounter imports ready", "GoatCounter import", user.Email,
+		EmailTemplate("email_import_combined.gotxt", struct {
+			Site      Site
+			Imports   ImportJobs
+			Reindexed bool
+		}{*MustGetSite(ctx), imps, imp.reindexed}))
+}
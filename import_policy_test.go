@@ -0,0 +1,129 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestParseImportPolicy(t *testing.T) {
+	tests := []struct {
+		in, want, wantErr string
+	}{
+		{"", "throttle=normal,email=each,reindex=false", ""},
+		{"throttle=off, email=combined,reindex=true", "throttle=off,email=combined,reindex=true", ""},
+		{"reindex=1", "throttle=normal,email=each,reindex=true", ""},
+		{"throttle=slow", "", "throttle: must be"},
+		{"email", "", "not in the form"},
+		{"emails=never", "", "unknown key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			p, err := goatcounter.ParseImportPolicy(tt.in)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("wrong error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.String() != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportPolicyEmail(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	def := goatcounter.GetImportPolicy()
+	defer goatcounter.SetImportPolicy(def, nil)
+
+	emailed := func(id int64) bool {
+		t.Helper()
+		var e zdb.Bool
+		err := zdb.MustGet(ctx).GetContext(ctx, &e, `select email from imports where import_id=$1`, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return !bool(e)
+	}
+
+	imp1, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp2, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAddNew, goatcounter.ImportTransform{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Not sent while the other import is still running.
+	goatcounter.SetImportPolicy(goatcounter.ImportPolicy{
+		Throttle: goatcounter.ImportThrottleOff, Email: goatcounter.ImportEmailCombined}, nil)
+	err = imp1.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emailed(imp1.ID) || emailed(imp2.ID) {
+		t.Fatal("emailed while another import is running")
+	}
+
+	goatcounter.SetImportPolicy(goatcounter.ImportPolicy{
+		Throttle: goatcounter.ImportThrottleOff, Email: goatcounter.ImportEmailNever}, nil)
+	err = imp2.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emailed(imp1.ID) || !emailed(imp2.ID) {
+		t.Errorf("emailed: %t %t", emailed(imp1.ID), emailed(imp2.ID))
+	}
+}
+
+func TestImportPolicyReindex(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	def := goatcounter.GetImportPolicy()
+	defer goatcounter.SetImportPolicy(def, nil)
+
+	// Small imports are left to the regular statistics updates.
+	goatcounter.SetImportPolicy(goatcounter.ImportPolicy{
+		Throttle: goatcounter.ImportThrottleOff, Email: goatcounter.ImportEmailEach},
+		func(context.Context, goatcounter.Site, time.Time, time.Time, []string) error {
+			t.Error("reindexed an import with fewer than ImportReindexMin pageviews")
+			return nil
+		})
+
+	imp, err := goatcounter.NewImportJob(ctx, strings.NewReader(importJobCSV), goatcounter.ImportAdd, goatcounter.ImportTransform{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = imp.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reindexes goatcounter.ReindexJobs
+	err = reindexes.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reindexes) != 0 {
+		t.Errorf("%d reindexes", len(reindexes))
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zgo.at/errors"
+)
+
+// ImportTransform changes rows before they're imported, for example to move
+// the pages of an old site to a subdirectory when combining several sites in
+// one.
+//
+// It's parsed from a small configuration with one command per line; blank
+// lines and lines starting with # are ignored:
+//
+//	strip-domain             Remove the domain from paths, so that
+//	                         "example.com/page" or "https://example.com/page"
+//	                         becomes "/page".
+//	drop <pattern>           Don't import rows with a path that matches the
+//	                         pattern; % matches any number of characters and _
+//	                         a single character.
+//	prefix <from> <to>       Replace the path prefix <from> with <to>. Only the
+//	                         first matching prefix is replaced.
+//	title <title>            Use this title for all rows.
+//
+// The commands are applied in this order, regardless of the order in the
+// configuration, so drop patterns match the path before it's rewritten.
+// Events are never changed by strip-domain or prefix, as their "path" is the
+// event name.
+type ImportTransform struct {
+	StripDomain bool
+	Drop        []string
+	Prefixes    []ImportPrefix
+	Title       string
+}
+
+// ImportPrefix is a path prefix to replace.
+type ImportPrefix struct {
+	From, To string
+}
+
+// ParseImportTransform parses the configuration for an ImportTransform.
+func ParseImportTransform(conf string) (ImportTransform, error) {
+	var t ImportTransform
+	for i, line := range strings.Split(conf, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		cmd, args := line, ""
+		if j := strings.IndexAny(line, " \t"); j > -1 {
+			cmd, args = line[:j], strings.TrimSpace(line[j:])
+		}
+		f := strings.Fields(args)
+
+		var err error
+		switch cmd {
+		case "strip-domain":
+			if len(f) != 0 {
+				err = errors.New("strip-domain doesn't accept arguments")
+			}
+			t.StripDomain = true
+		case "drop":
+			if len(f) != 1 {
+				err = errors.New("drop needs one pattern")
+				break
+			}
+			t.Drop = append(t.Drop, f[0])
+		case "prefix":
+			if len(f) != 2 {
+				err = errors.New("prefix needs a prefix to replace and the new prefix")
+				break
+			}
+			if f[0][0] != '/' || f[1][0] != '/' {
+				err = errors.New("prefix: both prefixes must start with a /")
+				break
+			}
+			t.Prefixes = append(t.Prefixes, ImportPrefix{From: f[0], To: f[1]})
+		case "title":
+			if args == "" {
+				err = errors.New("title needs a title")
+			}
+			t.Title = args
+		default:
+			err = errors.Errorf("unknown command %q", cmd)
+		}
+		if err != nil {
+			return ImportTransform{}, errors.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	return t, nil
+}
+
+// String gets the configuration; this can be parsed again with
+// ParseImportTransform().
+func (t ImportTransform) String() string {
+	b := new(strings.Builder)
+	if t.StripDomain {
+		b.WriteString("strip-domain\n")
+	}
+	for _, d := range t.Drop {
+		fmt.Fprintf(b, "drop %s\n", d)
+	}
+	for _, p := range t.Prefixes {
+		fmt.Fprintf(b, "prefix %s %s\n", p.From, p.To)
+	}
+	if t.Title != "" {
+		fmt.Fprintf(b, "title %s\n", t.Title)
+	}
+	return b.String()
+}
+
+// Apply the transformation to the row; this returns false if the row should be
+// dropped.
+func (t ImportTransform) Apply(row *ExportRow) bool {
+	event, _ := strconv.ParseBool(row.Event)
+	if t.StripDomain && !event {
+		row.Path = stripDomain(row.Path)
+	}
+	for _, d := range t.Drop {
+		if likeMatch(d, row.Path, false) {
+			return false
+		}
+	}
+	if !event {
+		for _, p := range t.Prefixes {
+			if strings.HasPrefix(row.Path, p.From) {
+				row.Path = p.To + row.Path[len(p.From):]
+				break
+			}
+		}
+	}
+	if t.Title != "" {
+		row.Title = t.Title
+	}
+	return true
+}
+
+// stripDomain removes the scheme and domain from a path; paths that already
+// start with a / are returned as-is.
+func stripDomain(path string) string {
+	if strings.HasPrefix(path, "/") {
+		return path
+	}
+	if i := strings.Index(path, "://"); i > -1 {
+		path = path[i+3:]
+	}
+
+	host, rest := path, "/"
+	if i := strings.IndexByte(path, '/'); i > -1 {
+		host, rest = path[:i], path[i:]
+	}
+	if !strings.Contains(host, ".") && !strings.HasPrefix(host, "localhost") {
+		return path
+	}
+	return rest
+}
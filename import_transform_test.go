@@ -0,0 +1,89 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+)
+
+func TestParseImportTransform(t *testing.T) {
+	tests := []struct {
+		in, want, wantErr string
+	}{
+		{"", "", ""},
+		{"# Comment\n\n  strip-domain  \n", "strip-domain\n", ""},
+		{"title Old blog\nprefix / /blog/\ndrop /wp-%\n",
+			"drop /wp-%\nprefix / /blog/\ntitle Old blog\n", ""},
+
+		{"strip-domain yes", "", "line 1: strip-domain doesn't accept arguments"},
+		{"\ndrop", "", "line 2: drop needs one pattern"},
+		{"prefix /a", "", "line 1: prefix needs"},
+		{"prefix a b", "", "line 1: prefix: both prefixes must start with a /"},
+		{"title", "", "line 1: title needs a title"},
+		{"rename /a /b", "", `line 1: unknown command "rename"`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			tr, err := goatcounter.ParseImportTransform(tt.in)
+			if (err == nil) != (tt.wantErr == "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("wrong error\ngot:  %v\nwant: %s", err, tt.wantErr)
+			}
+			if got := tr.String(); got != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportTransformApply(t *testing.T) {
+	tr, err := goatcounter.ParseImportTransform(strings.Join([]string{
+		"strip-domain",
+		"drop /wp-admin/%",
+		"prefix /2019/ /blog/2019/",
+		"prefix / /old/",
+		"title Old site",
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path, event string
+		wantPath    string
+		wantKeep    bool
+	}{
+		{"/page", "false", "/old/page", true},
+		{"/2019/post", "false", "/blog/2019/post", true},
+		{"example.com/page", "false", "/old/page", true},
+		{"https://example.com/page", "false", "/old/page", true},
+		{"example.com", "false", "/old/", true},
+		{"/wp-admin/edit.php", "false", "", false},
+		{"https://example.com/WP-ADMIN/", "false", "", false},
+		{"click.button", "true", "click.button", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			row := goatcounter.ExportRow{Path: tt.path, Title: "Title", Event: tt.event}
+			keep := tr.Apply(&row)
+			if keep != tt.wantKeep {
+				t.Fatalf("keep is %t", keep)
+			}
+			if !keep {
+				return
+			}
+			if row.Path != tt.wantPath {
+				t.Errorf("path\ngot:  %q\nwant: %q", row.Path, tt.wantPath)
+			}
+			if row.Title != "Old site" {
+				t.Errorf("title: %q", row.Title)
+			}
+		})
+	}
+}
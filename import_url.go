@@ -0,0 +1,174 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zlog"
+)
+
+// There is no overall timeout, as exports can be several GB; the import job
+// can be cancelled instead.
+var importURLClient = http.Client{
+	Transport: &http.Transport{
+		Proxy: importURLProxy,
+		DialContext: (&net.Dialer{
+			Timeout: 30 * time.Second,
+			Control: importURLControl,
+		}).DialContext,
+		TLSHandshakeTimeout:   30 * time.Second,
+		ResponseHeaderTimeout: time.Minute,
+	},
+}
+
+// importURLProxy uses the proxy from the environment, except on
+// goatcounter.com.
+//
+// The dialer would connect to the proxy rather than the target, so
+// importURLControl() could only check the proxy's address. Without a proxy the
+// dialer connects to the resolved address of the target, which is checked.
+func importURLProxy(r *http.Request) (*url.URL, error) {
+	if cfg.GoatcounterCom {
+		return nil, nil
+	}
+	return http.ProxyFromEnvironment(r)
+}
+
+// importURLControl refuses to connect to local and private addresses on
+// goatcounter.com, so the import can't be used to read internal services.
+//
+// This is called with the resolved IP address for every connection, so it also
+// applies to redirects and to DNS names that resolve to a private address.
+func importURLControl(network, address string, c syscall.RawConn) error {
+	if !cfg.GoatcounterCom {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !publicIP(ip) {
+		return errors.Errorf("not allowed to connect to %s", host)
+	}
+	return nil
+}
+
+var privateNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, n := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10", "fc00::/7"} {
+		_, ipnet, _ := net.ParseCIDR(n)
+		nets = append(nets, ipnet)
+	}
+	return nets
+}()
+
+func publicIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() {
+		return false
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateImportURL checks if u can be used with ImportURL(); it must be an
+// absolute https URL.
+func ValidateImportURL(u string) error {
+	p, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if p.Scheme != "https" {
+		return errors.New("must be an https URL")
+	}
+	if p.Host == "" {
+		return errors.New("no host")
+	}
+	return nil
+}
+
+// ImportURL downloads the file at the URL and imports it with Import(); the
+// file may be compressed with gzip.
+//
+// The file is streamed to disk, so it's never fully read in memory; with
+// dryRun it's not stored at all.
+//
+// This is intended to be run as a job with StartJob().
+func ImportURL(ctx context.Context, u, mode string, tr ImportTransform, email bool, dryRun *ImportDryRun) error {
+	var (
+		site = MustGetSite(ctx)
+		user = GetUser(ctx)
+		l    = zlog.Module("import").Fields(zlog.F{"site": site.ID, "mode": mode, "url": u})
+	)
+
+	fp, err := importURLOpen(ctx, u)
+	if err != nil {
+		return importError(ctx, l, *user, err)
+	}
+	defer fp.Close()
+	l.Print("downloading import")
+
+	return Import(ctx, fp, mode, tr, email, dryRun)
+}
+
+type importURLBody struct {
+	io.Reader
+	body io.Closer
+	gz   *gzip.Reader
+}
+
+func (b importURLBody) Close() error {
+	if b.gz != nil {
+		b.gz.Close()
+	}
+	return b.body.Close()
+}
+
+// importURLOpen opens the URL, and decompresses it if it's a gzip file.
+//
+// This looks at the content rather than the extension or Content-Type, as
+// neither is always set correctly for exports.
+func importURLOpen(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, errors.Errorf("ImportURL: %w", err)
+	}
+	resp, err := importURLClient.Do(req)
+	if err != nil {
+		return nil, errors.Errorf("ImportURL: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("ImportURL: %s returned %s", u, resp.Status)
+	}
+
+	br := bufio.NewReader(resp.Body)
+	b := importURLBody{Reader: br, body: resp.Body}
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		b.gz, err = gzip.NewReader(br)
+		if err != nil {
+			resp.Body.Close()
+			return nil, errors.Errorf("ImportURL: could not read as gzip: %w", err)
+		}
+		b.Reader = b.gz
+	}
+	return b, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestImportURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/export.csv":
+			w.Write([]byte(importJobCSV))
+		case "/export.csv.gz":
+			gz := gzip.NewWriter(w)
+			gz.Write([]byte(importJobCSV))
+			gz.Close()
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		path, want string
+		wantErr    bool
+	}{
+		{"/export.csv", "/a /b /c", false},
+		{"/export.csv.gz", "/a /b /c", false},
+		{"/nope", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			ctx, clean := gctest.DB(t)
+			defer clean()
+
+			err := goatcounter.ImportURL(ctx, srv.URL+tt.path, goatcounter.ImportAdd, goatcounter.ImportTransform{}, false, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wrong error: %v", err)
+			}
+			if got := importedPaths(ctx, t); got != tt.want {
+				t.Errorf("imported: %q", got)
+			}
+		})
+	}
+}
+
+func TestImportURLPrivate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(importJobCSV))
+	}))
+	defer srv.Close()
+
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	cfg.GoatcounterCom = true
+	defer func() { cfg.GoatcounterCom = false }()
+
+	err := goatcounter.ImportURL(ctx, srv.URL+"/export.csv", goatcounter.ImportAdd, goatcounter.ImportTransform{}, false, nil)
+	if err == nil || !strings.Contains(err.Error(), "not allowed to connect") {
+		t.Fatalf("wrong error: %v", err)
+	}
+	if got := importedPaths(ctx, t); got != "" {
+		t.Errorf("imported: %q", got)
+	}
+}
+
+func TestValidateImportURL(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"https://example.com/export.csv.gz", false},
+		{"http://example.com/export.csv.gz", true},
+		{"https:///export.csv.gz", true},
+		{"/export.csv.gz", true},
+		{"file:///etc/passwd", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			err := goatcounter.ValidateImportURL(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("wrong error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// IngestLogSize is the number of entries that are kept per site in the
+// IngestLog; older entries are overwritten.
+const IngestLogSize = 100
+
+// Results for IngestLogEntry.Result.
+const (
+	IngestStored    = "stored"    // Stored and counted in the stats.
+	IngestBot       = "bot"       // Stored, but not counted in the stats.
+	IngestIgnored   = "ignored"   // Not stored because of the site settings (e.g. ignored IP).
+	IngestInvalid   = "invalid"   // Not stored because the data wasn't valid.
+	IngestDuplicate = "duplicate" // Not stored because it's a duplicate of the previous pageview.
+	IngestRefspam   = "refspam"   // Not stored because the referrer is a known spammer.
+)
+
+// IngestLogEntry describes how a single pageview was processed.
+type IngestLogEntry struct {
+	Time   time.Time `json:"time"`
+	Path   string    `json:"path"`   // Path as it was sent.
+	Stored string    `json:"stored"` // Path as it was stored, after cleaning.
+	Result string    `json:"result"`
+	Notes  []string  `json:"notes"` // Rules that were applied, in order.
+}
+
+type ingestTrace struct {
+	path  string
+	notes []string
+}
+
+type ingestRing struct {
+	remaining int
+	entries   []IngestLogEntry
+	next      int
+}
+
+type ingestLog struct {
+	mu    sync.Mutex
+	sites map[int64]*ingestRing
+	stats map[int64]*IngestStats
+}
+
+// ingestMaxHosts is the maximum number of hostnames that are counted in
+// IngestStats; pageviews from other hosts are counted as "(other)".
+const ingestMaxHosts = 25
+
+// IngestStats are the number of pageviews that were received for a site, and
+// how they were processed.
+//
+// This is always recorded for all sites, but kept in memory only: it's reset
+// on restart.
+type IngestStats struct {
+	Since   time.Time      `json:"since"`   // Start of the counts.
+	Last    *time.Time     `json:"last"`    // Last pageview that was received; may be null.
+	Results map[string]int `json:"results"` // Number of pageviews for every result (stored, bot, ignored, etc.)
+	Hosts   map[string]int `json:"hosts"`   // Number of pageviews for every hostname.
+}
+
+// Total gets the total number of pageviews.
+func (s IngestStats) Total() int {
+	var t int
+	for _, n := range s.Results {
+		t += n
+	}
+	return t
+}
+
+// ErrorRate gets the fraction of pageviews that were rejected as invalid, as a
+// number from 0 to 1.
+func (s IngestStats) ErrorRate() float64 {
+	t := s.Total()
+	if t == 0 {
+		return 0
+	}
+	return float64(s.Results[IngestInvalid]) / float64(t)
+}
+
+// IngestLog records how pageviews are processed for sites that have enabled
+// it, so people can find out why their pageview isn't showing up: it records
+// why a pageview was marked as a bot, wasn't counted as unique, or how the path
+// was modified.
+//
+// This is kept in memory only, and is lost on restart.
+var IngestLog ingestLog
+
+// Enable recording the next n pageviews for the site; existing entries are
+// kept. Use 0 to stop recording.
+func (l *ingestLog) Enable(siteID int64, n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.sites == nil {
+		l.sites = make(map[int64]*ingestRing)
+	}
+	r, ok := l.sites[siteID]
+	if !ok {
+		r = &ingestRing{entries: make([]IngestLogEntry, 0, IngestLogSize)}
+		l.sites[siteID] = r
+	}
+	if n < 0 {
+		n = 0
+	}
+	r.remaining = n
+}
+
+// Remaining gets the number of pageviews that will still be recorded for the
+// site.
+func (l *ingestLog) Remaining(siteID int64) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if r, ok := l.sites[siteID]; ok {
+		return r.remaining
+	}
+	return 0
+}
+
+// List all recorded entries for the site, oldest first.
+func (l *ingestLog) List(siteID int64) []IngestLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.sites[siteID]
+	if !ok {
+		return nil
+	}
+
+	list := make([]IngestLogEntry, 0, len(r.entries))
+	list = append(list, r.entries[r.next:]...)
+	list = append(list, r.entries[:r.next]...)
+	return list
+}
+
+// Track starts recording the processing of this hit, if this is enabled for
+// the site and there are pageviews remaining.
+//
+// The hit will be recorded once it's processed by the memstore, or with Done()
+// if it's rejected before that.
+func (l *ingestLog) Track(h *Hit) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.sites[h.Site]
+	if !ok || r.remaining <= 0 {
+		return false
+	}
+	r.remaining--
+	h.ingest = &ingestTrace{path: h.Path}
+	return true
+}
+
+// Done records the result for a hit in the IngestStats, and in the log if it
+// was marked with Track().
+func (l *ingestLog) Done(h Hit, result string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.count(h, result)
+	if h.ingest == nil {
+		return
+	}
+
+	r, ok := l.sites[h.Site]
+	if !ok {
+		return
+	}
+
+	e := IngestLogEntry{
+		Time:   Now(),
+		Path:   h.ingest.path,
+		Stored: h.Path,
+		Result: result,
+		Notes:  h.ingest.notes,
+	}
+	if len(r.entries) < IngestLogSize {
+		r.entries = append(r.entries, e)
+		return
+	}
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % IngestLogSize
+}
+
+func (l *ingestLog) count(h Hit, result string) {
+	if l.stats == nil {
+		l.stats = make(map[int64]*IngestStats)
+	}
+	s, ok := l.stats[h.Site]
+	if !ok {
+		s = &IngestStats{Since: Now(), Results: make(map[string]int), Hosts: make(map[string]int)}
+		l.stats[h.Site] = s
+	}
+
+	now := Now()
+	s.Last = &now
+	s.Results[result]++
+
+	host := h.Host
+	if host == "" {
+		host = "(unknown)"
+	}
+	if _, ok := s.Hosts[host]; !ok && len(s.Hosts) >= ingestMaxHosts {
+		host = "(other)"
+	}
+	s.Hosts[host]++
+}
+
+// Stats gets the IngestStats for the site.
+func (l *ingestLog) Stats(siteID int64) IngestStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, ok := l.stats[siteID]
+	if !ok {
+		return IngestStats{Results: map[string]int{}, Hosts: map[string]int{}}
+	}
+
+	cp := IngestStats{
+		Since:   s.Since,
+		Last:    s.Last,
+		Results: make(map[string]int, len(s.Results)),
+		Hosts:   make(map[string]int, len(s.Hosts)),
+	}
+	for k, v := range s.Results {
+		cp.Results[k] = v
+	}
+	for k, v := range s.Hosts {
+		cp.Hosts[k] = v
+	}
+	return cp
+}
+
+// IngestNote adds a note to the IngestLog entry for this hit, if it's tracked.
+func (h *Hit) IngestNote(format string, a ...interface{}) {
+	if h.ingest == nil {
+		return
+	}
+	h.ingest.notes = append(h.ingest.notes, fmt.Sprintf(format, a...))
+}
@@ -0,0 +1,55 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestIngestLog(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	goatcounter.IngestLog.Enable(site.ID, 3)
+	defer goatcounter.IngestLog.Enable(site.ID, 0)
+
+	for _, h := range []goatcounter.Hit{
+		{Site: site.ID, Path: "/a?utm_source=x&fbclid=y&q=1", Browser: "xxx"},
+		{Site: site.ID, Path: "/a?utm_source=x&fbclid=y&q=1", Browser: "xxx"},
+		{Site: site.ID, Path: "/bot", Browser: "xxx", Bot: 150},
+		{Site: site.ID, Path: "/not-tracked", Browser: "xxx"},
+	} {
+		h := h
+		goatcounter.IngestLog.Track(&h)
+		goatcounter.Memstore.Append(h)
+	}
+	if r := goatcounter.IngestLog.Remaining(site.ID); r != 0 {
+		t.Errorf("remaining: %d", r)
+	}
+
+	_, err := goatcounter.Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range goatcounter.IngestLog.List(site.ID) {
+		got = append(got, fmt.Sprintf("%s → %s: %s: %s", e.Path, e.Stored, e.Result, strings.Join(e.Notes, "; ")))
+	}
+	want := []string{
+		"/a?utm_source=x&fbclid=y&q=1 → /a?q=1: stored: unique: first visit to this path in the session; path: removed tracking parameters fbclid, utm_source",
+		"/a?utm_source=x&fbclid=y&q=1 → /a?q=1: stored: not unique: path was already visited in this session; path: removed tracking parameters fbclid, utm_source",
+		"/bot → /bot: bot: unique: first visit to this path in the session",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("\ngot:\n%s\n\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"net"
+)
+
+// DefaultIPv6Prefix is the default network prefix length for IPv6 addresses;
+// see SiteSettings.IPv6Prefix.
+const DefaultIPv6Prefix = 64
+
+// IPv6PrefixLen gets the network prefix length to use for IPv6 addresses.
+func (ss SiteSettings) IPv6PrefixLen() int {
+	if ss.IPv6Prefix < 1 {
+		return DefaultIPv6Prefix
+	}
+	return ss.IPv6Prefix
+}
+
+// SessionAddr gets the address to identify the session with; for IPv6 this is
+// the network prefix, so that the same visitor keeps the same session when the
+// privacy extensions pick a new address.
+func (ss SiteSettings) SessionAddr(addr string) string {
+	ip := parseAddr(addr)
+	if ip == nil || ip.To4() != nil {
+		return addr
+	}
+	return maskIPv6(ip, ss.IPv6PrefixLen()).String()
+}
+
+// parseAddr parses an IP address, which may contain a port.
+func parseAddr(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
+
+// maskIPv6 gets the network prefix of prefix bits if ip is an IPv6 address.
+// IPv4 addresses are returned as-is.
+func maskIPv6(ip net.IP, prefix int) net.IP {
+	if ip.To4() != nil || prefix >= 128 {
+		return ip
+	}
+	return ip.Mask(net.CIDRMask(prefix, 128))
+}
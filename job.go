@@ -0,0 +1,454 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/bgrun"
+	"zgo.at/guru"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// Job states.
+const (
+	JobQueued    = "queued"    // Waiting for other jobs in the same class.
+	JobRunning   = "running"   // Running.
+	JobDone      = "done"      // Finished successfully.
+	JobFailed    = "failed"    // Finished with an error.
+	JobCancelled = "cancelled" // Cancelled before it finished.
+)
+
+// Job kinds.
+const (
+	JobExport  = "export"
+	JobImport  = "import"
+	JobReindex = "reindex"
+	JobACME    = "acme"
+)
+
+// Job classes; jobs in the same class share a concurrency limit.
+const (
+	JobClassExport      = "export"
+	JobClassImport      = "import"
+	JobClassMaintenance = "maintenance"
+)
+
+// JobConcurrency is the maximum number of jobs that run at the same time for
+// every class; other jobs are queued until one finishes.
+var JobConcurrency = map[string]int{
+	JobClassExport:      2,
+	JobClassImport:      1,
+	JobClassMaintenance: 1,
+}
+
+// JobHeartbeat is how often a job records that it's still alive, and checks if
+// it was cancelled on another instance. Jobs without a heartbeat for JobDead
+// are considered lost, as the instance that ran them is gone.
+var (
+	JobHeartbeat = 30 * time.Second
+	JobDead      = 2 * time.Minute
+)
+
+// JobPacing is how often a job pauses to give the server some breathing room;
+// see JobPace().
+type JobPacing struct {
+	Every int           // Pause after every n items; 0 to never pause.
+	Sleep time.Duration // Always pause for this long.
+	Max   time.Duration // Maximum pause when the database is busy; 0 to never pause for this.
+	Rate  func() int    // Maximum number of items per second; nil or 0 for no limit.
+}
+
+// JobKind describes a kind of background job.
+type JobKind struct {
+	Name   string
+	Class  string
+	Pacing JobPacing
+}
+
+// ErrJobCancelled is returned from JobPace() if the job was cancelled.
+var ErrJobCancelled = errors.New("job was cancelled")
+
+var jobs = struct {
+	sync.Mutex
+	kinds   map[string]JobKind
+	sem     map[string]chan struct{}
+	running map[int64]*Job
+}{
+	kinds:   make(map[string]JobKind),
+	sem:     make(map[string]chan struct{}),
+	running: make(map[int64]*Job),
+}
+
+// RegisterJob registers a new kind of job, replacing any existing kind with
+// the same name.
+func RegisterJob(k JobKind) {
+	jobs.Lock()
+	defer jobs.Unlock()
+	jobs.kinds[k.Name] = k
+}
+
+func init() {
+	RegisterJob(JobKind{Name: JobExport, Class: JobClassExport,
+		Pacing: JobPacing{Every: 5000, Max: 10 * time.Second}})
+	RegisterJob(JobKind{Name: JobImport, Class: JobClassImport, Pacing: importPolicy.pacing()})
+	RegisterJob(JobKind{Name: JobReindex, Class: JobClassMaintenance,
+		Pacing: JobPacing{Every: 1, Max: 30 * time.Second}})
+	RegisterJob(JobKind{Name: JobACME, Class: JobClassMaintenance})
+}
+
+func jobKind(name string) (JobKind, chan struct{}) {
+	jobs.Lock()
+	defer jobs.Unlock()
+
+	k, ok := jobs.kinds[name]
+	if !ok {
+		panic(fmt.Sprintf("jobKind: unknown job kind %q", name))
+	}
+	sem, ok := jobs.sem[k.Class]
+	if !ok {
+		n := JobConcurrency[k.Class]
+		if n < 1 {
+			n = 1
+		}
+		sem = make(chan struct{}, n)
+		jobs.sem[k.Class] = sem
+	}
+	return k, sem
+}
+
+// Job is a long-running task that runs in the background, such as an export
+// or import.
+type Job struct {
+	ID   int64  `db:"job_id" json:"id,readonly"`
+	Site *int64 `db:"site" json:"site,readonly"`
+	Kind string `db:"kind" json:"kind,readonly"`
+
+	// queued, running, done, failed, or cancelled.
+	State string `db:"state" json:"state,readonly"`
+
+	// Number of items processed, and the total number of items if it's known
+	// in advance (0 otherwise).
+	Progress int `db:"progress" json:"progress,readonly"`
+	Total    int `db:"total" json:"total,readonly"`
+
+	// Error if the state is failed.
+	Error *string `db:"error" json:"error,readonly"`
+
+	CreatedAt  time.Time  `db:"created_at" json:"created_at,readonly"`
+	StartedAt  *time.Time `db:"started_at" json:"started_at,readonly"`
+	FinishedAt *time.Time `db:"finished_at" json:"finished_at,readonly"`
+	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at,readonly"`
+
+	// Instance that runs the job, the last time it recorded that it's still
+	// running, and when it was cancelled from another instance.
+	Instance    *string    `db:"instance" json:"-"`
+	HeartbeatAt *time.Time `db:"heartbeat_at" json:"-"`
+	CancelAt    *time.Time `db:"cancel_at" json:"-"`
+
+	kind         JobKind
+	cancel       chan struct{}
+	cancelled    bool // Protected by the jobs lock.
+	lastProgress time.Time
+	pause        time.Duration // Current pause for adaptive pacing.
+	rate         int           // Rate limit that rateStart and rateN are for.
+	rateStart    time.Time     // Start of the current rate limit window.
+	rateN        int           // Number of items at rateStart.
+}
+
+type ctxkeyJob struct{}
+
+// GetJob gets the currently running job, or nil if there isn't one.
+func GetJob(ctx context.Context) *Job {
+	j, _ := ctx.Value(ctxkeyJob{}).(*Job)
+	return j
+}
+
+func (j *Job) insert(ctx context.Context, kind JobKind) error {
+	j.Kind, j.kind = kind.Name, kind
+	j.cancel = make(chan struct{})
+	j.State = JobQueued
+	j.CreatedAt = Now()
+	j.UpdatedAt = j.CreatedAt
+	j.Instance, j.HeartbeatAt = &instanceID, &j.CreatedAt
+	if s := GetSite(ctx); s != nil {
+		j.Site = &s.ID
+	}
+
+	var err error
+	j.ID, err = insertWithID(ctx, "job_id",
+		`insert into jobs (site, kind, state, progress, total, created_at, updated_at, instance, heartbeat_at)
+		values ($1, $2, $3, 0, 0, $4, $5, $6, $4)`,
+		j.Site, j.Kind, j.State, j.CreatedAt.Format(zdb.Date), j.UpdatedAt.Format(zdb.Date), instanceID)
+	if err != nil {
+		return errors.Wrap(err, "Job.insert")
+	}
+
+	jobs.Lock()
+	jobs.running[j.ID] = j
+	jobs.Unlock()
+	return nil
+}
+
+func (j *Job) update(ctx context.Context) error {
+	j.UpdatedAt = Now()
+	j.HeartbeatAt = &j.UpdatedAt
+	var started, finished *string
+	if j.StartedAt != nil {
+		s := j.StartedAt.Format(zdb.Date)
+		started = &s
+	}
+	if j.FinishedAt != nil {
+		s := j.FinishedAt.Format(zdb.Date)
+		finished = &s
+	}
+
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update jobs set
+		state=$1, progress=$2, total=$3, error=$4, started_at=$5, finished_at=$6, updated_at=$7, heartbeat_at=$7
+		where job_id=$8`,
+		j.State, j.Progress, j.Total, j.Error, started, finished, j.UpdatedAt.Format(zdb.Date), j.ID)
+	return errors.Wrap(err, "Job.update")
+}
+
+// StartJob creates a new job and runs f in the background.
+//
+// The function should use JobProgress() to record the progress, and JobPace()
+// to pause; JobPace() also checks if the job was cancelled. The context itself
+// isn't cancelled, so that f can still record what it did.
+//
+// The ctx should be detached from the request; see NewContext().
+func StartJob(ctx context.Context, kind string, f func(context.Context) error) (*Job, error) {
+	k, sem := jobKind(kind)
+
+	j := new(Job)
+	err := j.insert(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a copy, as the job will be modified while it runs.
+	jobs.Lock()
+	cp := *j
+	jobs.Unlock()
+	bgrun.Run(fmt.Sprintf("job:%s:%d", k.Name, j.ID), func() {
+		j.run(ctx, sem, f)
+	})
+	return &cp, nil
+}
+
+// RunJob creates a new job and runs f in the foreground, returning the error
+// from f.
+func RunJob(ctx context.Context, kind string, f func(context.Context) error) error {
+	k, sem := jobKind(kind)
+
+	j := new(Job)
+	err := j.insert(ctx, k)
+	if err != nil {
+		return err
+	}
+
+	return j.run(ctx, sem, f)
+}
+
+func (j *Job) run(ctx context.Context, sem chan struct{}, f func(context.Context) error) error {
+	l := zlog.Module("job").Fields(zlog.F{"id": j.ID, "kind": j.Kind})
+	ctx = context.WithValue(ctx, ctxkeyJob{}, j)
+	defer func() {
+		jobs.Lock()
+		delete(jobs.running, j.ID)
+		jobs.Unlock()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go j.heartbeat(ctx, done)
+
+	select {
+	case sem <- struct{}{}:
+		defer func() { <-sem }()
+	case <-j.cancel:
+		j.finish(ctx, l, ErrJobCancelled)
+		return ErrJobCancelled
+	}
+
+	now := Now()
+	j.State, j.StartedAt = JobRunning, &now
+	err := j.update(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+
+	err = f(ctx)
+	j.finish(ctx, l, err)
+	return err
+}
+
+// heartbeat records that the job is still running every JobHeartbeat, until
+// done is closed, and stops the job if it was cancelled on another instance.
+func (j *Job) heartbeat(ctx context.Context, done chan struct{}) {
+	defer zlog.Recover()
+	l := zlog.Module("job").Field("id", j.ID)
+	t := time.NewTicker(JobHeartbeat)
+	defer t.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-t.C:
+			db := zdb.MustGet(ctx)
+			_, err := db.ExecContext(ctx, `update jobs set heartbeat_at=$1 where job_id=$2`,
+				Now().Format(zdb.Date), j.ID)
+			if err != nil {
+				l.Error(err)
+				continue
+			}
+
+			var cancel int
+			err = db.GetContext(ctx, &cancel,
+				`select count(*) from jobs where job_id=$1 and cancel_at is not null`, j.ID)
+			if err != nil {
+				l.Error(err)
+				continue
+			}
+			if cancel > 0 {
+				j.stop()
+			}
+		}
+	}
+}
+
+// stop the job if it wasn't stopped already.
+func (j *Job) stop() {
+	jobs.Lock()
+	defer jobs.Unlock()
+	if !j.cancelled {
+		j.cancelled = true
+		close(j.cancel)
+	}
+}
+
+func (j *Job) finish(ctx context.Context, l zlog.Log, jobErr error) {
+	now := Now()
+	j.FinishedAt = &now
+	switch {
+	case jobErr == nil:
+		j.State = JobDone
+	case errors.Is(jobErr, ErrJobCancelled):
+		j.State = JobCancelled
+	default:
+		j.State = JobFailed
+		e := jobErr.Error()
+		j.Error = &e
+	}
+
+	err := j.update(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+}
+
+// JobProgress records the progress of the job in the context; this is a no-op
+// if there is no job.
+//
+// The progress is written to the database at most once a second.
+func JobProgress(ctx context.Context, progress, total int) {
+	j := GetJob(ctx)
+	if j == nil {
+		return
+	}
+
+	j.Progress, j.Total = progress, total
+	if Now().Sub(j.lastProgress) < time.Second {
+		return
+	}
+	j.lastProgress = Now()
+
+	err := j.update(ctx)
+	if err != nil {
+		zlog.Module("job").Field("id", j.ID).Error(err)
+	}
+}
+
+// Cancel the job.
+//
+// Jobs running on another instance are stopped on their next heartbeat, and
+// jobs for which the instance is gone are marked as cancelled right away.
+func (j *Job) Cancel(ctx context.Context) error {
+	if j.State != JobQueued && j.State != JobRunning {
+		return guru.Errorf(400, "job %d is already %s", j.ID, j.State)
+	}
+
+	jobs.Lock()
+	running, ok := jobs.running[j.ID]
+	jobs.Unlock()
+	if ok {
+		running.stop()
+		return nil
+	}
+
+	var (
+		db   = zdb.MustGet(ctx)
+		now  = Now()
+		dead = now.Add(-JobDead).Format(zdb.Date)
+	)
+	_, err := db.ExecContext(ctx, `update jobs set
+		state=$1, finished_at=$2, updated_at=$2, cancel_at=$2
+		where job_id=$3 and state in ($4, $5) and (heartbeat_at is null or heartbeat_at < $6)`,
+		JobCancelled, now.Format(zdb.Date), j.ID, JobQueued, JobRunning, dead)
+	if err != nil {
+		return errors.Wrap(err, "Job.Cancel")
+	}
+	_, err = db.ExecContext(ctx, `update jobs set cancel_at=$1
+		where job_id=$2 and state in ($3, $4) and cancel_at is null`,
+		now.Format(zdb.Date), j.ID, JobQueued, JobRunning)
+	return errors.Wrap(err, "Job.Cancel")
+}
+
+// ByID gets a job by ID, for the site in the context.
+func (j *Job) ByID(ctx context.Context, id int64) error {
+	return errors.Wrap(zdb.MustGet(ctx).GetContext(ctx, j,
+		`/* Job.ByID */ select * from jobs where job_id=$1 and site=$2`,
+		id, MustGetSite(ctx).ID), "Job.ByID")
+}
+
+// Jobs is a list of jobs.
+type Jobs []Job
+
+// List the 100 most recent jobs for the site in the context, newest first.
+func (j *Jobs) List(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, j, `/* Jobs.List */
+		select * from jobs where site=$1 order by created_at desc, job_id desc limit 100`,
+		MustGetSite(ctx).ID)
+	return errors.Wrap(err, "Jobs.List")
+}
+
+// Interrupted marks all jobs that are still queued or running as failed if the
+// instance that ran them is gone, which is assumed if there was no heartbeat
+// for JobDead.
+//
+// Jobs running on other instances that use the same database are left alone.
+func (j *Jobs) Interrupted(ctx context.Context) error {
+	now := Now()
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update jobs set
+		state=$1, error=$2, finished_at=$3, updated_at=$3
+		where state in ($4, $5) and (heartbeat_at is null or heartbeat_at < $6)`,
+		JobFailed, "interrupted by restart", now.Format(zdb.Date), JobQueued, JobRunning,
+		now.Add(-JobDead).Format(zdb.Date))
+	return errors.Wrap(err, "Jobs.Interrupted")
+}
+
+// DeleteOlderThan deletes all finished jobs older than the given number of
+// days.
+func (j *Jobs) DeleteOlderThan(ctx context.Context, days int) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `delete from jobs
+		where state not in ($1, $2) and created_at < `+interval(days),
+		JobQueued, JobRunning)
+	return errors.Wrap(err, "Jobs.DeleteOlderThan")
+}
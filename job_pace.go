@@ -0,0 +1,195 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// Thresholds for when the database is considered busy, and jobs are paused.
+var (
+	// Maximum time a trivial query may take.
+	JobMaxLatency = 50 * time.Millisecond
+
+	// Maximum replication lag of PostgreSQL replicas.
+	JobMaxLag = 5 * time.Second
+
+	// Maximum number of pageviews waiting in the memstore; imports add to the
+	// memstore faster than it's written if the database is slow, and live
+	// pageviews are delayed too.
+	JobMaxQueue = 50000
+
+	// Maximum time writing the memstore to the database may take.
+	JobMaxWrite = 5 * time.Second
+
+	// Initial pause when the database is busy; this is doubled for every
+	// check the database is still busy, up to JobPacing.Max.
+	JobMinPause = 250 * time.Millisecond
+)
+
+// DBLoad is the measured load of the database.
+type DBLoad struct {
+	Latency time.Duration // Round-trip time of a trivial query.
+	Lag     time.Duration // Replication lag; always 0 for SQLite.
+	Queue   int           // Number of pageviews in the memstore.
+	Write   time.Duration // Time the last memstore persist took.
+}
+
+// Busy reports if the load is above JobMaxLatency, JobMaxLag, JobMaxQueue, or
+// JobMaxWrite.
+func (l DBLoad) Busy() bool {
+	return l.Latency > JobMaxLatency || l.Lag > JobMaxLag ||
+		l.Queue > JobMaxQueue || l.Write > JobMaxWrite
+}
+
+// Replication lag isn't available on all PostgreSQL versions and needs
+// permissions on pg_stat_replication; don't keep trying if it fails.
+var noLag struct {
+	sync.Mutex
+	set bool
+}
+
+// MeasureDBLoad measures the current load of the database.
+func MeasureDBLoad(ctx context.Context) (DBLoad, error) {
+	db := zdb.MustGet(ctx)
+
+	var (
+		load  = DBLoad{Queue: Memstore.Len(), Write: Memstore.PersistTime()}
+		one   int
+		start = time.Now()
+	)
+	err := db.GetContext(ctx, &one, `/* MeasureDBLoad */ select 1`)
+	if err != nil {
+		return load, err
+	}
+	load.Latency = time.Since(start)
+
+	if !cfg.PgSQL {
+		return load, nil
+	}
+	noLag.Lock()
+	defer noLag.Unlock()
+	if noLag.set {
+		return load, nil
+	}
+
+	var lag float64
+	err = db.GetContext(ctx, &lag, `/* MeasureDBLoad */
+		select coalesce(extract(epoch from max(replay_lag)), 0) from pg_stat_replication`)
+	if err != nil {
+		noLag.set = true
+		zlog.Module("job").Printf("can't get replication lag; ignoring it for pacing jobs: %s", err)
+		return load, nil
+	}
+	load.Lag = time.Duration(lag * float64(time.Second))
+	return load, nil
+}
+
+// JobPace pauses the job in the context after every JobPacing.Every items,
+// where n is the number of items processed so far. It returns ErrJobCancelled
+// if the job was cancelled.
+//
+// The pause is adaptive if JobPacing.Max is set: jobs don't pause if the
+// database is idle, and back off exponentially while it's busy.
+//
+// This is a no-op if there is no job.
+func JobPace(ctx context.Context, n int) error {
+	j := GetJob(ctx)
+	if j == nil {
+		return nil
+	}
+
+	p := j.kind.Pacing
+	var wait time.Duration
+	if p.Rate != nil {
+		wait = j.throttle(n, p.Rate())
+	}
+	if p.Every > 0 && n%p.Every == 0 {
+		wait += p.Sleep
+		if p.Max > 0 {
+			wait += j.backoff(ctx, p.Max)
+		}
+	}
+
+	if wait == 0 {
+		select {
+		case <-j.cancel:
+			return ErrJobCancelled
+		default:
+			return nil
+		}
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-j.cancel:
+		return ErrJobCancelled
+	}
+}
+
+// backoff gets the adaptive pause: this doubles every time the database is
+// busy, and halves every time it's not.
+func (j *Job) backoff(ctx context.Context, max time.Duration) time.Duration {
+	load, err := MeasureDBLoad(ctx)
+	if err != nil {
+		zlog.Module("job").Field("id", j.ID).Error(err)
+		return j.pause
+	}
+
+	if load.Busy() {
+		if j.pause == 0 {
+			j.pause = JobMinPause
+		} else {
+			j.pause *= 2
+		}
+		if j.pause > max {
+			j.pause = max
+		}
+		zlog.Module("job").Fields(zlog.F{"id": j.ID, "latency": load.Latency, "lag": load.Lag,
+			"queue": load.Queue, "write": load.Write}).
+			Debugf("database is busy; pausing for %s", j.pause)
+		return j.pause
+	}
+
+	j.pause /= 2
+	if j.pause < JobMinPause {
+		j.pause = 0
+	}
+	return j.pause
+}
+
+// throttle gets the pause to stay below rate items per second; a rate of 0 or
+// lower is no limit.
+func (j *Job) throttle(n, rate int) time.Duration {
+	now := time.Now()
+	if rate != j.rate || j.rateStart.IsZero() || n < j.rateN {
+		j.rate, j.rateStart, j.rateN = rate, now, n
+		return 0
+	}
+	if rate <= 0 {
+		return 0
+	}
+
+	// Start counting again if we're well behind (e.g. because we paused for a
+	// busy database), so that it doesn't try to catch up with a burst.
+	due := j.rateStart.Add(time.Duration(n-j.rateN) * time.Second / time.Duration(rate))
+	if now.Sub(due) > time.Second {
+		j.rateStart, j.rateN = now, n
+		return 0
+	}
+	if due.After(now) {
+		return due.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,317 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/bgrun"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestRunJob(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	err := goatcounter.RunJob(ctx, goatcounter.JobReindex, func(ctx context.Context) error {
+		if goatcounter.GetJob(ctx) == nil {
+			t.Error("no job in context")
+		}
+		goatcounter.JobProgress(ctx, 3, 4)
+		return goatcounter.JobPace(ctx, 3)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = goatcounter.RunJob(ctx, goatcounter.JobReindex, func(ctx context.Context) error {
+		return errors.New("oh noes")
+	})
+	if err == nil || err.Error() != "oh noes" {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	var list goatcounter.Jobs
+	err = list.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("len(list) = %d", len(list))
+	}
+
+	failed, done := list[0], list[1]
+	if done.State != goatcounter.JobDone || done.Progress != 3 || done.Total != 4 ||
+		done.StartedAt == nil || done.FinishedAt == nil || done.Error != nil {
+		t.Errorf("wrong done job: %#v", done)
+	}
+	if failed.State != goatcounter.JobFailed || failed.Error == nil || *failed.Error != "oh noes" {
+		t.Errorf("wrong failed job: %#v", failed)
+	}
+}
+
+func TestStartJob(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	goatcounter.RegisterJob(goatcounter.JobKind{
+		Name:   "test",
+		Class:  "test",
+		Pacing: goatcounter.JobPacing{Every: 1, Sleep: time.Hour},
+	})
+
+	// Blocks until it's cancelled.
+	wait := func(ctx context.Context) error { return goatcounter.JobPace(ctx, 1) }
+
+	first, err := goatcounter.StartJob(ctx, "test", wait)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := goatcounter.StartJob(ctx, "test", wait)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only one job in the class can run at the same time.
+	time.Sleep(50 * time.Millisecond)
+	states := make(map[string]int)
+	for _, id := range []int64{first.ID, second.ID} {
+		var j goatcounter.Job
+		err := j.ByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		states[j.State]++
+	}
+	if states[goatcounter.JobRunning] != 1 || states[goatcounter.JobQueued] != 1 {
+		t.Errorf("wrong states: %v", states)
+	}
+
+	for _, id := range []int64{second.ID, first.ID} {
+		var j goatcounter.Job
+		err := j.ByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = j.Cancel(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	bgrun.Wait()
+
+	for _, id := range []int64{first.ID, second.ID} {
+		var j goatcounter.Job
+		err := j.ByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j.State != goatcounter.JobCancelled || j.FinishedAt == nil {
+			t.Errorf("job %d not cancelled: %#v", id, j)
+		}
+
+		err = j.Cancel(ctx)
+		if err == nil {
+			t.Errorf("job %d: no error when cancelling a finished job", id)
+		}
+	}
+}
+
+func TestJobPace(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	defer func(l time.Duration) { goatcounter.JobMaxLatency = l }(goatcounter.JobMaxLatency)
+	goatcounter.RegisterJob(goatcounter.JobKind{
+		Name:   "test-pace",
+		Class:  "test-pace",
+		Pacing: goatcounter.JobPacing{Every: 2, Max: 50 * time.Millisecond},
+	})
+
+	pace := func(ctx context.Context, n int) time.Duration {
+		start := time.Now()
+		err := goatcounter.JobPace(ctx, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return time.Since(start)
+	}
+
+	err := goatcounter.RunJob(ctx, "test-pace", func(ctx context.Context) error {
+		// Busy: pauses for JobPacing.Max, as that's lower than JobMinPause.
+		goatcounter.JobMaxLatency = 0
+		if d := pace(ctx, 1); d >= 50*time.Millisecond {
+			t.Errorf("paused for %s with n=1", d)
+		}
+		if d := pace(ctx, 2); d < 50*time.Millisecond {
+			t.Errorf("paused for only %s while busy", d)
+		}
+
+		// Idle: halved to below JobMinPause, so no pause.
+		goatcounter.JobMaxLatency = time.Hour
+		if d := pace(ctx, 4); d >= 50*time.Millisecond {
+			t.Errorf("paused for %s while idle", d)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJobPaceRate(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	rate := 100
+	goatcounter.RegisterJob(goatcounter.JobKind{
+		Name:   "test-rate",
+		Class:  "test-rate",
+		Pacing: goatcounter.JobPacing{Rate: func() int { return rate }},
+	})
+
+	err := goatcounter.RunJob(ctx, "test-rate", func(ctx context.Context) error {
+		// 20 items at 100/s should take at least 200ms.
+		start := time.Now()
+		for i := 1; i <= 21; i++ {
+			err := goatcounter.JobPace(ctx, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if d := time.Since(start); d < 190*time.Millisecond {
+			t.Errorf("20 items took only %s with a rate of 100/s", d)
+		}
+
+		// No limit.
+		rate = 0
+		start = time.Now()
+		for i := 22; i <= 1000; i++ {
+			err := goatcounter.JobPace(ctx, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if d := time.Since(start); d > 100*time.Millisecond {
+			t.Errorf("took %s without a rate limit", d)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDBLoadBusy(t *testing.T) {
+	tests := []struct {
+		in   goatcounter.DBLoad
+		want bool
+	}{
+		{goatcounter.DBLoad{}, false},
+		{goatcounter.DBLoad{Latency: time.Second}, true},
+		{goatcounter.DBLoad{Lag: time.Minute}, true},
+		{goatcounter.DBLoad{Queue: 100}, false},
+		{goatcounter.DBLoad{Queue: 1000000}, true},
+		{goatcounter.DBLoad{Write: time.Minute}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := tt.in.Busy(); got != tt.want {
+				t.Errorf("%+v: got %t; want %t", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobHeartbeat(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	// Job running on another instance.
+	insert := func(heartbeat time.Time) goatcounter.Job {
+		t.Helper()
+		db := zdb.MustGet(ctx)
+		_, err := db.ExecContext(ctx, `insert into jobs
+			(site, kind, state, created_at, updated_at, instance, heartbeat_at)
+			values (1, $1, $2, $3, $3, 'other', $4)`,
+			goatcounter.JobReindex, goatcounter.JobRunning,
+			heartbeat.Format(zdb.Date), heartbeat.Format(zdb.Date))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var j goatcounter.Job
+		err = db.GetContext(ctx, &j, `select * from jobs order by job_id desc limit 1`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return j
+	}
+	state := func(id int64) string {
+		t.Helper()
+		var j goatcounter.Job
+		err := j.ByID(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Sprintf("%s %t", j.State, j.CancelAt != nil)
+	}
+
+	alive := insert(goatcounter.Now())
+	dead := insert(goatcounter.Now().Add(-goatcounter.JobDead - time.Minute))
+	deadCancel := insert(goatcounter.Now().Add(-goatcounter.JobDead - time.Minute))
+
+	for _, j := range []goatcounter.Job{alive, deadCancel} {
+		err := j.Cancel(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	var jobs goatcounter.Jobs
+	err := jobs.Interrupted(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := state(alive.ID); s != "running true" {
+		t.Errorf("alive: %s", s)
+	}
+	if s := state(dead.ID); s != "failed false" {
+		t.Errorf("dead: %s", s)
+	}
+	if s := state(deadCancel.ID); s != "cancelled true" {
+		t.Errorf("deadCancel: %s", s)
+	}
+
+	// Cancelled from another instance.
+	defer func(h time.Duration) { goatcounter.JobHeartbeat = h }(goatcounter.JobHeartbeat)
+	goatcounter.JobHeartbeat = 10 * time.Millisecond
+	goatcounter.RegisterJob(goatcounter.JobKind{
+		Name:   "test-heartbeat",
+		Class:  "test-heartbeat",
+		Pacing: goatcounter.JobPacing{Every: 1, Sleep: time.Hour},
+	})
+	local, err := goatcounter.StartJob(ctx, "test-heartbeat", func(ctx context.Context) error {
+		return goatcounter.JobPace(ctx, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = zdb.MustGet(ctx).ExecContext(ctx, `update jobs set cancel_at=$1 where job_id=$2`,
+		goatcounter.Now().Format(zdb.Date), local.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bgrun.Wait()
+	if s := state(local.ID); s != "cancelled true" {
+		t.Errorf("local: %s", s)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// LockLease is how long a lock on SQLite is valid for if it's not refreshed;
+// this is how long other instances wait if an instance stops while holding a
+// lock.
+var LockLease = 15 * time.Minute
+
+// WithLock runs f only if no other instance using the same database holds the
+// lock with this name, and reports if f was run. If another instance holds
+// the lock, f isn't run and this doesn't wait for it.
+//
+// PostgreSQL uses an advisory lock, which is held by a transaction for as long
+// as f runs (f doesn't run in this transaction). SQLite uses the locks table,
+// which is refreshed while f runs and expires after LockLease if the instance
+// stops without releasing it.
+func WithLock(ctx context.Context, name string, f func() error) (bool, error) {
+	if cfg.PgSQL {
+		return withLockPg(ctx, name, f)
+	}
+	return withLockTable(ctx, name, f)
+}
+
+// lockKey gets the key for a PostgreSQL advisory lock.
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("goatcounter:" + name))
+	return int64(h.Sum64())
+}
+
+func withLockPg(ctx context.Context, name string, f func() error) (bool, error) {
+	key := lockKey(name)
+
+	var (
+		locked bool
+		runErr error
+	)
+	err := zdb.TX(ctx, func(txctx context.Context, tx zdb.DB) error {
+		err := tx.GetContext(txctx, &locked, `select pg_try_advisory_xact_lock($1)`, key)
+		if err != nil || !locked {
+			return err
+		}
+		runErr = f()
+		return nil
+	})
+	if err != nil {
+		return locked, errors.Wrapf(err, "WithLock %s", name)
+	}
+	return locked, runErr
+}
+
+func withLockTable(ctx context.Context, name string, f func() error) (bool, error) {
+	db := zdb.MustGet(ctx)
+	expires := func() string { return Now().Add(LockLease).Format(zdb.Date) }
+
+	r, err := db.ExecContext(ctx, `/* WithLock */
+		insert into locks (name, instance, expires_at) values ($1, $2, $3)
+		on conflict (name) do update set instance=excluded.instance, expires_at=excluded.expires_at
+		where locks.expires_at < $4`,
+		name, instanceID, expires(), Now().Format(zdb.Date))
+	if err != nil {
+		return false, errors.Wrapf(err, "WithLock %s", name)
+	}
+	n, err := r.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "WithLock %s", name)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer zlog.Recover()
+		t := time.NewTicker(LockLease / 3)
+		defer t.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				_, err := db.ExecContext(ctx,
+					`update locks set expires_at=$1 where name=$2 and instance=$3`,
+					expires(), name, instanceID)
+				if err != nil {
+					zlog.Module("lock").Field("name", name).Error(err)
+				}
+			}
+		}
+	}()
+
+	runErr := f()
+	close(done)
+
+	_, err = db.ExecContext(ctx, `delete from locks where name=$1 and instance=$2`, name, instanceID)
+	if err != nil {
+		zlog.Module("lock").Field("name", name).Error(err)
+	}
+	return true, runErr
+}
+
+// LockStats waits for the lock on the statistics of the site, which is held
+// until the transaction in the context ends. Everything that reads and then
+// rewrites statistics rows should hold it, as two instances doing this for the
+// same rows at the same time would lose one of the updates.
+//
+// This is a no-op on SQLite, which doesn't allow concurrent writes.
+func LockStats(ctx context.Context, siteID int64) error {
+	if !cfg.PgSQL {
+		return nil
+	}
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `select pg_advisory_xact_lock($1)`,
+		lockKey("stats:"+strconv.FormatInt(siteID, 10)))
+	return errors.Wrap(err, "LockStats")
+}
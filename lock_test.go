@@ -0,0 +1,47 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"errors"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestWithLock(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	var inner, other bool
+	ran, err := goatcounter.WithLock(ctx, "test", func() error {
+		// Locked while it's running, but other names aren't.
+		var err error
+		inner, err = goatcounter.WithLock(ctx, "test", func() error { return nil })
+		if err != nil {
+			return err
+		}
+		other, err = goatcounter.WithLock(ctx, "other", func() error { return nil })
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ran || inner || !other {
+		t.Errorf("ran=%t inner=%t other=%t", ran, inner, other)
+	}
+
+	// Released after it's done, also if there's an error.
+	want := errors.New("oh noes")
+	ran, err = goatcounter.WithLock(ctx, "test", func() error { return want })
+	if !ran || err != want {
+		t.Errorf("ran=%t err=%v", ran, err)
+	}
+	ran, err = goatcounter.WithLock(ctx, "test", func() error { return nil })
+	if !ran || err != nil {
+		t.Errorf("ran=%t err=%v", ran, err)
+	}
+}
@@ -13,9 +13,12 @@ import (
 	"net/url"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/json"
 	"zgo.at/zdb"
 	"zgo.at/zdb/bulk"
@@ -53,21 +56,55 @@ func (h *hash) UnmarshalText(v []byte) error {
 }
 
 type ms struct {
-	hitMu sync.RWMutex
-	hits  []Hit
+	hitMu   sync.RWMutex
+	hits    []Hit
+	scrolls []ScrollDepth
+
+	// persistSeq is incremented every time Persist() takes the hits; see
+	// Checkpoint().
+	persistSeq int64 // Protected by hitMu.
+
+	// persistedSeq is the last batch for which it and all batches before it
+	// are finished, and persistFailed are the batches that couldn't be written
+	// to the database. Batches that finished out of order are in persistDone.
+	persistMu     sync.Mutex
+	persistedSeq  int64
+	persistDone   map[int64]struct{}
+	persistFailed []int64
+
+	// How long the last Persist() took, in nanoseconds; this is used as the
+	// write latency for pacing jobs.
+	persistTime int64 // Atomic.
 
 	sessionMu     sync.RWMutex
 	sessions      map[hash]zint.Uint128                // Hash → sessionID
 	sessionHashes map[zint.Uint128]hash                // sessionID → hash
 	sessionPaths  map[zint.Uint128]map[string]struct{} // SessionID → Path
 	sessionSeen   map[zint.Uint128]int64               // SessionID → lastseen
+	sessionStart  map[zint.Uint128]int64               // SessionID → started
+	sessionCount  map[zint.Uint128]int                 // SessionID → number of pageviews
+	honeypot      map[zint.Uint128]struct{}            // SessionIDs that requested the honeypot link
+	honeypotNew   []HoneypotSession                    // Sessions marked since the last PersistHoneypot()
+	sessionInfo   map[zint.Uint128]sessionInfo         // SessionID → summary for the sessions_stats
+	ended         []SessionStat                        // Evicted sessions that aren't persisted yet
 	curSalt       []byte
 	prevSalt      []byte
 	saltRotated   time.Time
+	dedup         map[dedupKey]time.Time // Session+path → last hit
+	dropped       int64                  // Number of hits dropped as duplicate.
 
 	testHook bool
 }
 
+// DedupWindow is the time in which a hit to the same path in the same session
+// is considered a duplicate, for sites with the Dedup setting enabled.
+const DedupWindow = time.Second
+
+type dedupKey struct {
+	session zint.Uint128
+	path    string
+}
+
 var Memstore ms
 
 type storedSession struct {
@@ -75,6 +112,11 @@ type storedSession struct {
 	Hashes      map[zint.Uint128]hash                `json:"hashes"`
 	Paths       map[zint.Uint128]map[string]struct{} `json:"paths"`
 	Seen        map[zint.Uint128]int64               `json:"seen"`
+	Start       map[zint.Uint128]int64               `json:"start"`
+	Count       map[zint.Uint128]int                 `json:"count"`
+	Honeypot    map[zint.Uint128]struct{}            `json:"honeypot"`
+	Info        map[zint.Uint128]sessionInfo         `json:"info"`
+	Ended       []SessionStat                        `json:"ended"`
 	CurSalt     []byte                               `json:"cur_salt"`
 	PrevSalt    []byte                               `json:"prev_salt"`
 	SaltRotated time.Time                            `json:"salt_rotated"`
@@ -88,6 +130,13 @@ func (m *ms) Reset() {
 	m.sessionHashes = make(map[zint.Uint128]hash)
 	m.sessionPaths = make(map[zint.Uint128]map[string]struct{})
 	m.sessionSeen = make(map[zint.Uint128]int64)
+	m.sessionStart = make(map[zint.Uint128]int64)
+	m.sessionCount = make(map[zint.Uint128]int)
+	m.honeypot = make(map[zint.Uint128]struct{})
+	m.sessionInfo = make(map[zint.Uint128]sessionInfo)
+	m.ended = nil
+	m.dedup = make(map[dedupKey]time.Time)
+	atomic.StoreInt64(&m.dropped, 0)
 	m.curSalt = []byte(zcrypto.Secret256())
 	m.prevSalt = []byte(zcrypto.Secret256())
 	m.saltRotated = Now()
@@ -137,6 +186,19 @@ func (m *ms) Init(db zdb.DB) error {
 	if stored.Seen != nil {
 		m.sessionSeen = stored.Seen
 	}
+	if stored.Start != nil {
+		m.sessionStart = stored.Start
+	}
+	if stored.Count != nil {
+		m.sessionCount = stored.Count
+	}
+	if stored.Honeypot != nil {
+		m.honeypot = stored.Honeypot
+	}
+	if stored.Info != nil {
+		m.sessionInfo = stored.Info
+	}
+	m.ended = stored.Ended
 	if len(stored.CurSalt) > 0 {
 		m.curSalt = stored.CurSalt
 	}
@@ -163,6 +225,11 @@ func (m *ms) StoreSessions(db zdb.DB) {
 		Sessions:    m.sessions,
 		Paths:       m.sessionPaths,
 		Seen:        m.sessionSeen,
+		Start:       m.sessionStart,
+		Count:       m.sessionCount,
+		Honeypot:    m.honeypot,
+		Info:        m.sessionInfo,
+		Ended:       m.ended,
 		Hashes:      m.sessionHashes,
 		CurSalt:     m.curSalt,
 		PrevSalt:    m.prevSalt,
@@ -184,6 +251,21 @@ func (m *ms) Append(hits ...Hit) {
 	m.hitMu.Lock()
 	m.hits = append(m.hits, hits...)
 	m.hitMu.Unlock()
+
+	HitTail.publish(hits)
+}
+
+// Honeypot adds a request for the honeypot link added by count.js; this isn't
+// stored as a pageview, but marks all pageviews in the same session that are
+// still in the memstore, and all later pageviews, with BotReasonHoneypot.
+//
+// Pageviews that were already stored are marked from cron, with
+// PersistHoneypot() and HoneypotSession.MarkStored().
+func (m *ms) Honeypot(h Hit) {
+	h.Honeypot = true
+	m.hitMu.Lock()
+	m.hits = append(m.hits, h)
+	m.hitMu.Unlock()
 }
 
 func (m *ms) Len() int {
@@ -198,25 +280,37 @@ func (m *ms) Persist(ctx context.Context) ([]Hit, error) {
 		return nil, nil
 	}
 
+	start := time.Now()
 	m.hitMu.Lock()
 	hits := make([]Hit, len(m.hits))
 	copy(hits, m.hits)
 	m.hits = []Hit{}
+	m.persistSeq++
+	seq := m.persistSeq
 	m.hitMu.Unlock()
 
-	sites := make(map[int64]*Site)
+	m.evictDedup()
+
+	var (
+		sites     = make(map[int64]*Site)
+		persisted = make([]Hit, 0, len(hits))
+	)
 
 	l := zlog.Module("memstore")
 
 	ins := bulk.NewInsert(ctx, "hits", []string{"site", "path", "ref",
-		"ref_scheme", "browser", "size", "location", "created_at", "bot",
-		"title", "event", "session2", "first_visit"})
-	for i, h := range hits {
+		"ref_scheme", "browser", "size", "location", "region", "city", "host",
+		"utm_source", "utm_medium", "utm_campaign",
+		"ua_brands", "ua_platform", "ua_platform_version",
+		"created_at", "bot", "bot_score", "bot_reasons", "title", "event", "session2", "first_visit", "sample"})
+	for _, h := range hits {
 		// Ignore spammers.
 		h.RefURL, _ = url.Parse(h.Ref)
 		if h.RefURL != nil {
 			if _, ok := refspam[h.RefURL.Host]; ok {
 				l.Debugf("refspam ignored: %q", h.RefURL.Host)
+				h.IngestNote("referrer %q is in the refspam list", h.RefURL.Host)
+				IngestLog.Done(h, IngestRefspam)
 				continue
 			}
 		}
@@ -233,29 +327,224 @@ func (m *ms) Persist(ctx context.Context) ([]Hit, error) {
 		}
 		ctx = WithSite(ctx, site)
 
+		if site.Settings.IsIgnored(h.RemoteAddr) {
+			l.Debugf("IP ignored: %q", h.RemoteAddr)
+			h.IngestNote("IP %q is in the ignore list", h.RemoteAddr)
+			IngestLog.Done(h, IngestIgnored)
+			continue
+		}
+
+		if h.Honeypot {
+			if id, ok := m.findSession(site.ID, h.Browser, site.Settings.SessionAddr(h.RemoteAddr)); ok {
+				m.markHoneypot(site.ID, id)
+				h.IngestNote("bot: requested the honeypot link; marking the session as a bot")
+			} else {
+				h.IngestNote("bot: requested the honeypot link without a session")
+			}
+			IngestLog.Done(h, IngestBot)
+			continue
+		}
+
 		if h.Session.IsZero() {
-			h.Session, h.FirstVisit = m.session(ctx, site.ID, h.UserSessionID, h.Path, h.Browser, h.RemoteAddr)
+			h.Session, h.FirstVisit = m.session(ctx, site.ID, h.UserSessionID, h.Path, h.Browser,
+				site.Settings.SessionAddr(h.RemoteAddr))
+			if h.UserSessionID != "" {
+				h.IngestNote("session: using the session ID sent by the client")
+			}
+			if h.FirstVisit {
+				h.IngestNote("unique: first visit to this path in the session")
+			} else {
+				h.IngestNote("not unique: path was already visited in this session")
+			}
+
+			if m.tooFast(h.Session) {
+				h.BotReasons |= BotReasonRate
+				h.IngestNote("bot: more than %d pageviews per minute in this session", BotRate)
+			}
+
+			if site.Settings.Dedup && m.isDuplicate(h) {
+				l.Debugf("duplicate ignored: %q", h.Path)
+				h.IngestNote("same path in the same session less than %s ago", DedupWindow)
+				IngestLog.Done(h, IngestDuplicate)
+				continue
+			}
+			if !site.Settings.inSample(h.Session) {
+				h.IngestNote("session not in the sample of 1 in %d sessions", site.Settings.SamplingFactor())
+				IngestLog.Done(h, IngestIgnored)
+				continue
+			}
+			h.Sample = site.Settings.SamplingFactor()
+		} else {
+			h.IngestNote("session: set by the import")
 		}
 
 		// Persist.
 		h.Defaults(ctx)
+		if h.BotScore > 0 {
+			h.IngestNote("bot score %d (%s); the threshold is %d", h.BotScore, h.BotReasons, site.Settings.BotThresholdScore())
+		}
 		err := h.Validate(ctx)
 		if err != nil {
 			l.Field("hit", h).Error(err)
+			h.IngestNote("%s", err)
+			IngestLog.Done(h, IngestInvalid)
 			continue
 		}
 
 		// Some values are sanitized in Hit.Defaults(), make sure this is
 		// reflected in the hits object too, which matters for the hit_stats
-		// generation later.
-		hits[i] = h
+		// generation later. Ignored hits aren't included, so they're not
+		// counted in the stats.
+		persisted = append(persisted, h)
+	}
+
+	// The honeypot link may be requested after the pageviews in the same
+	// batch, so check this once all sessions are known.
+	for i := range persisted {
+		h := &persisted[i]
+		if !h.BotReasons.Has(BotReasonHoneypot) && m.isHoneypot(h.Session) {
+			h.addBotReason(sites[h.Site], BotReasonHoneypot)
+			h.IngestNote("bot: the session requested the honeypot link; bot score is now %d", h.BotScore)
+		}
+
+		if h.Bot > 0 {
+			IngestLog.Done(*h, IngestBot)
+		} else {
+			IngestLog.Done(*h, IngestStored)
+		}
+		m.sessionPageview(*h)
 
 		ins.Values(h.Site, h.Path, h.Ref, h.RefScheme, h.Browser, h.Size,
-			h.Location, h.CreatedAt.Format(zdb.Date), h.Bot, h.Title, h.Event,
-			h.Session, h.FirstVisit)
+			h.Location, h.Region, h.City, h.Host,
+			h.UTMSource, h.UTMMedium, h.UTMCampaign,
+			h.UABrands, h.UAPlatform, h.UAPlatformVersion, h.CreatedAt.Format(zdb.Date), h.Bot,
+			h.BotScore, h.BotReasons, h.Title, h.Event, h.Session, h.FirstVisit, h.Weight())
+	}
+
+	err := ins.Finish()
+	m.persistFinished(seq, err)
+	atomic.StoreInt64(&m.persistTime, int64(time.Since(start)))
+	return persisted, err
+}
+
+// maxPersistFailed is the number of failed batches that are remembered.
+const maxPersistFailed = 100
+
+// persistFinished records that Persist() finished writing the batch seq.
+func (m *ms) persistFinished(seq int64, err error) {
+	m.persistMu.Lock()
+	defer m.persistMu.Unlock()
+
+	if err != nil {
+		m.persistFailed = append(m.persistFailed, seq)
+		if len(m.persistFailed) > maxPersistFailed {
+			m.persistFailed = m.persistFailed[1:]
+		}
+	}
+
+	if m.persistDone == nil {
+		m.persistDone = make(map[int64]struct{})
+	}
+	m.persistDone[seq] = struct{}{}
+	for {
+		if _, ok := m.persistDone[m.persistedSeq+1]; !ok {
+			break
+		}
+		delete(m.persistDone, m.persistedSeq+1)
+		m.persistedSeq++
+	}
+}
+
+// PersistTime gets how long the last Persist() took.
+func (m *ms) PersistTime() time.Duration {
+	return time.Duration(atomic.LoadInt64(&m.persistTime))
+}
+
+// ErrPersistFailed is returned by Memstore.Persisted() if some of the hits
+// couldn't be written to the database.
+var ErrPersistFailed = errors.New("pageviews weren't written to the database")
+
+// MemstoreCheckpoint is a point in the hits added to the memstore; see
+// Memstore.Checkpoint().
+type MemstoreCheckpoint struct {
+	last  int64 // Last batch with hits added before the checkpoint.
+	first int64 // First batch with hits added after the checkpoint.
+}
+
+// Checkpoint gets a value to pass to Persisted() to check if all the hits that
+// were added before this are written to the database.
+func (m *ms) Checkpoint() MemstoreCheckpoint {
+	m.hitMu.Lock()
+	defer m.hitMu.Unlock()
+
+	cp := MemstoreCheckpoint{last: m.persistSeq + 1, first: m.persistSeq + 1}
+	if len(m.hits) == 0 { // Everything was already taken by Persist().
+		cp.last = m.persistSeq
+	}
+	return cp
+}
+
+// Persisted reports if all hits that were added after the checkpoint since and
+// before the checkpoint cp have been written to the database.
+//
+// ErrPersistFailed is returned if some of these hits may not have been
+// written; they're not retried, so they need to be added again.
+func (m *ms) Persisted(since, cp MemstoreCheckpoint) (bool, error) {
+	m.persistMu.Lock()
+	defer m.persistMu.Unlock()
+
+	for _, f := range m.persistFailed {
+		if f >= since.first && f <= cp.last {
+			return false, ErrPersistFailed
+		}
+	}
+	return m.persistedSeq >= cp.last, nil
+}
+
+// isDuplicate reports if the same path was recorded in the same session less
+// than DedupWindow ago; browsers with broken cache busting sometimes send the
+// same pageview twice.
+func (m *ms) isDuplicate(h Hit) bool {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	k := dedupKey{h.Session, h.Path}
+	prev, ok := m.dedup[k]
+	m.dedup[k] = h.CreatedAt
+	if !ok {
+		return false
 	}
 
-	return hits, ins.Finish()
+	d := h.CreatedAt.Sub(prev)
+	if d < DedupWindow && d > -DedupWindow {
+		atomic.AddInt64(&m.dropped, 1)
+		return true
+	}
+	return false
+}
+
+// evictDedup removes old entries used for isDuplicate().
+func (m *ms) evictDedup() {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	ev := Now().Add(-time.Minute)
+	for k, t := range m.dedup {
+		if t.Before(ev) {
+			delete(m.dedup, k)
+		}
+	}
+}
+
+// Dropped gets the number of hits that were dropped as a duplicate since the
+// memstore was started.
+func (m *ms) Dropped() int64 { return atomic.LoadInt64(&m.dropped) }
+
+// Sessions gets the number of sessions that are currently kept in memory.
+func (m *ms) Sessions() int {
+	m.sessionMu.RLock()
+	defer m.sessionMu.RUnlock()
+	return len(m.sessions)
 }
 
 func (m *ms) GetSalt() (cur []byte, prev []byte) {
@@ -264,16 +553,50 @@ func (m *ms) GetSalt() (cur []byte, prev []byte) {
 	return m.curSalt, m.prevSalt
 }
 
-func (m *ms) RefreshSalt() {
+// RefreshSalt rotates the salt if it's older than the session idle time, and
+// reports if it was rotated.
+func (m *ms) RefreshSalt() bool {
 	m.sessionMu.Lock()
 	defer m.sessionMu.Unlock()
 
-	if m.saltRotated.Add(4 * time.Hour).After(Now()) {
-		return
+	// Sessions are looked up with the current and previous salt, so rotating
+	// after the session inactivity window ensures that sessions are kept for
+	// at least that long.
+	if m.saltRotated.Add(cfg.SessionIdle).After(Now()) {
+		return false
 	}
 
 	m.prevSalt = m.curSalt[:]
 	m.curSalt = []byte(zcrypto.Secret256())
+	m.saltRotated = Now()
+	return true
+}
+
+// SetSalt sets the salts, for salts that were rotated on another instance.
+func (m *ms) SetSalt(cur, prev []byte) {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	m.curSalt, m.prevSalt = cur, prev
+	m.saltRotated = Now()
+}
+
+// DropPath removes all hits for the site that match the SQL LIKE pattern from
+// the memstore, for pageviews that were purged; see Hits.Purge().
+func (m *ms) DropPath(siteID int64, path string, matchTitle, caseSensitive bool) int {
+	m.hitMu.Lock()
+	defer m.hitMu.Unlock()
+
+	keep := m.hits[:0]
+	for _, h := range m.hits {
+		if h.Site == siteID && likeMatch(path, h.Path, caseSensitive) && (!matchTitle || likeMatch(path, h.Title, false)) {
+			continue
+		}
+		keep = append(keep, h)
+	}
+	n := len(m.hits) - len(keep)
+	m.hits = keep
+	return n
 }
 
 // For 10k sessions this takes about 5ms on my laptop; that's a small enough
@@ -283,18 +606,55 @@ func (m *ms) EvictSessions() {
 	m.sessionMu.Lock()
 	defer m.sessionMu.Unlock()
 
-	ev := Now().Add(-4 * time.Hour).Unix()
+	var (
+		now = Now()
+		ev  = now.Add(-cfg.SessionIdle).Unix()
+		max = int64(0)
+	)
+	if cfg.SessionMax > 0 {
+		max = now.Add(-cfg.SessionMax).Unix()
+	}
 	for sID, seen := range m.sessionSeen {
-		if seen > ev {
+		if seen > ev && (max == 0 || m.sessionStart[sID] == 0 || m.sessionStart[sID] > max) {
 			continue
 		}
+		m.evict(sID)
+	}
+}
 
-		hash := m.sessionHashes[sID]
-		delete(m.sessions, hash)
-		delete(m.sessionPaths, sID)
-		delete(m.sessionSeen, sID)
-		delete(m.sessionHashes, sID)
+// evict the session; the caller must hold the lock.
+func (m *ms) evict(sID zint.Uint128) {
+	m.endSession(sID)
+
+	hash := m.sessionHashes[sID]
+	delete(m.sessions, hash)
+	delete(m.sessionPaths, sID)
+	delete(m.sessionSeen, sID)
+	delete(m.sessionStart, sID)
+	delete(m.sessionCount, sID)
+	delete(m.sessionHashes, sID)
+	delete(m.honeypot, sID)
+	delete(m.sessionInfo, sID)
+}
+
+// SessionWindow gets the inactivity window and maximum length of sessions for
+// the site. The site settings can only make these shorter than the -session-idle
+// and -session-max flags, as sessions are evicted from the memstore after that.
+//
+// The max is 0 if sessions have no maximum length.
+func SessionWindow(site *Site) (idle, max time.Duration) {
+	idle, max = cfg.SessionIdle, cfg.SessionMax
+	if site == nil {
+		return idle, max
+	}
+
+	if i := time.Duration(site.Settings.Session.Idle) * time.Minute; i > 0 && i < idle {
+		idle = i
 	}
+	if m := time.Duration(site.Settings.Session.Max) * time.Minute; m > 0 && (max == 0 || m < max) {
+		max = m
+	}
+	return idle, max
 }
 
 // SessionID gets a new UUID4 session ID.
@@ -341,8 +701,21 @@ func (m *ms) session(ctx context.Context, siteID int64, userSessionID, path, ua,
 		}
 	}
 
+	// Start a new session if the session was inactive for too long or is
+	// longer than the maximum length.
+	now := Now()
+	if ok {
+		idle, max := SessionWindow(GetSite(ctx))
+		seen, start := m.sessionSeen[id], m.sessionStart[id]
+		if seen <= now.Add(-idle).Unix() || (max > 0 && start > 0 && start <= now.Add(-max).Unix()) {
+			m.evict(id)
+			ok = false
+		}
+	}
+
 	if ok { // Existing session
-		m.sessionSeen[id] = Now().Unix()
+		m.sessionSeen[id] = now.Unix()
+		m.sessionCount[id]++
 		_, seenPath := m.sessionPaths[id][path]
 		if !seenPath {
 			m.sessionPaths[id][path] = struct{}{}
@@ -354,7 +727,76 @@ func (m *ms) session(ctx context.Context, siteID int64, userSessionID, path, ua,
 	id = m.SessionID()
 	m.sessions[sessionHash] = id
 	m.sessionPaths[id] = map[string]struct{}{path: struct{}{}}
-	m.sessionSeen[id] = Now().Unix()
+	m.sessionSeen[id] = now.Unix()
+	m.sessionStart[id] = now.Unix()
+	m.sessionCount[id] = 1
 	m.sessionHashes[id] = sessionHash
 	return id, true
 }
+
+// tooFast reports if the session has more than BotRate pageviews per minute,
+// after it has at least BotRate pageviews.
+func (m *ms) tooFast(id zint.Uint128) bool {
+	m.sessionMu.RLock()
+	defer m.sessionMu.RUnlock()
+
+	n := m.sessionCount[id]
+	if n < BotRate {
+		return false
+	}
+	mins := (Now().Unix() - m.sessionStart[id]) / 60
+	if mins < 1 {
+		mins = 1
+	}
+	return int64(n)/mins >= BotRate
+}
+
+// findSession gets the ID of an existing session, without starting a new
+// session or recording a pageview.
+func (m *ms) findSession(siteID int64, ua, remoteAddr string) (zint.Uint128, bool) {
+	m.sessionMu.RLock()
+	defer m.sessionMu.RUnlock()
+
+	for _, salt := range [][]byte{m.curSalt, m.prevSalt} {
+		h := sha256.New()
+		h.Write(salt)
+		h.Write([]byte(ua))
+		h.Write([]byte(remoteAddr))
+		h.Write([]byte(strconv.FormatInt(siteID, 10)))
+		if id, ok := m.sessions[hash{string(h.Sum(nil))}]; ok {
+			return id, true
+		}
+	}
+	return zint.Uint128{}, false
+}
+
+// markHoneypot marks the session as having requested the honeypot link.
+func (m *ms) markHoneypot(siteID int64, id zint.Uint128) {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+	if _, ok := m.honeypot[id]; ok {
+		return
+	}
+	m.honeypot[id] = struct{}{}
+	m.honeypotNew = append(m.honeypotNew, HoneypotSession{Site: siteID, Session: id})
+}
+
+// PersistHoneypot gets all sessions that requested the honeypot link since the
+// last call, so the pageviews that were already stored can be marked with
+// HoneypotSession.MarkStored().
+func (m *ms) PersistHoneypot() []HoneypotSession {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	marked := m.honeypotNew
+	m.honeypotNew = nil
+	return marked
+}
+
+// isHoneypot reports if the session requested the honeypot link.
+func (m *ms) isHoneypot(id zint.Uint128) bool {
+	m.sessionMu.RLock()
+	defer m.sessionMu.RUnlock()
+	_, ok := m.honeypot[id]
+	return ok
+}
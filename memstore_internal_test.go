@@ -0,0 +1,35 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"testing"
+
+	"zgo.at/errors"
+)
+
+func TestMemstorePersisted(t *testing.T) {
+	var (
+		m     ms
+		since = MemstoreCheckpoint{first: 1}
+		cp    = MemstoreCheckpoint{last: 2, first: 3}
+	)
+
+	// Batch 2 finished before batch 1.
+	m.persistFinished(2, nil)
+	if ok, err := m.Persisted(since, cp); ok || err != nil {
+		t.Fatalf("persisted before batch 1 finished: %t %v", ok, err)
+	}
+
+	m.persistFinished(1, errors.New("oh noes"))
+	if _, err := m.Persisted(since, cp); !errors.Is(err, ErrPersistFailed) {
+		t.Fatalf("wrong error: %v", err)
+	}
+
+	// Checkpoints after the failed batch aren't affected.
+	if ok, err := m.Persisted(MemstoreCheckpoint{first: 2}, cp); !ok || err != nil {
+		t.Fatalf("not persisted after failed batch: %t %v", ok, err)
+	}
+}
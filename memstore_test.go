@@ -6,9 +6,12 @@ package goatcounter_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	. "zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/goatcounter/gctest"
 	"zgo.at/zdb"
 )
@@ -79,3 +82,155 @@ func TestNextUUID(t *testing.T) {
 		}
 	}()
 }
+
+func TestSessionWindow(t *testing.T) {
+	defer func(i, m time.Duration) { cfg.SessionIdle, cfg.SessionMax = i, m }(cfg.SessionIdle, cfg.SessionMax)
+	cfg.SessionIdle, cfg.SessionMax = 4*time.Hour, 0
+
+	tests := []struct {
+		idle, max         int
+		wantIdle, wantMax time.Duration
+	}{
+		{0, 0, 4 * time.Hour, 0},
+		{30, 0, 30 * time.Minute, 0},
+		{60 * 8, 0, 4 * time.Hour, 0},
+		{0, 60, 4 * time.Hour, time.Hour},
+		{30, 60, 30 * time.Minute, time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var s Site
+			s.Settings.Session.Idle, s.Settings.Session.Max = tt.idle, tt.max
+
+			idle, max := SessionWindow(&s)
+			if idle != tt.wantIdle || max != tt.wantMax {
+				t.Errorf("\nwant: %s %s\ngot:  %s %s", tt.wantIdle, tt.wantMax, idle, max)
+			}
+		})
+	}
+
+	cfg.SessionMax = 2 * time.Hour
+	var s Site
+	s.Settings.Session.Max = 60 * 3
+	if _, max := SessionWindow(&s); max != 2*time.Hour {
+		t.Errorf("site max longer than -session-max: %s", max)
+	}
+	if idle, max := SessionWindow(nil); idle != 4*time.Hour || max != 2*time.Hour {
+		t.Errorf("nil site: %s %s", idle, max)
+	}
+}
+
+func TestMemstoreDedup(t *testing.T) {
+	for _, dedup := range []bool{false, true} {
+		t.Run(fmt.Sprintf("%t", dedup), func(t *testing.T) {
+			ctx, clean := gctest.DB(t)
+			defer clean()
+
+			site := MustGetSite(ctx)
+			site.Settings.Dedup = dedup
+			err := site.Update(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			now := Now()
+			hit := Hit{Site: site.ID, Path: "/test", Browser: "test", RemoteAddr: "127.0.0.1", CreatedAt: now}
+			Memstore.Append(hit, hit)
+			hit.CreatedAt = now.Add(DedupWindow)
+			Memstore.Append(hit)
+			hit.Path = "/other"
+			Memstore.Append(hit)
+
+			hits, err := Memstore.Persist(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, wantDropped := 4, int64(0)
+			if dedup {
+				want, wantDropped = 3, 1
+			}
+			if len(hits) != want {
+				t.Errorf("wrong number of hits: %d (want: %d)", len(hits), want)
+			}
+			if d := Memstore.Dropped(); d != wantDropped {
+				t.Errorf("wrong number of dropped: %d (want: %d)", d, wantDropped)
+			}
+		})
+	}
+}
+
+func BenchmarkMemstoreAppend(b *testing.B) {
+	ctx, clean := gctest.DB(b)
+	defer clean()
+
+	h := gen(ctx)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		Memstore.Append(h)
+	}
+}
+
+func BenchmarkMemstorePersist(b *testing.B) {
+	ctx, clean := gctest.DB(b)
+	defer clean()
+
+	hits := make([]Hit, 1000)
+	for i := range hits {
+		hits[i] = gen(ctx)
+		hits[i].Path = fmt.Sprintf("/test-%d", i%50)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		Memstore.Append(hits...)
+		b.StartTimer()
+
+		_, err := Memstore.Persist(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMemstoreHoneypot(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := MustGetSite(ctx)
+	now := Now()
+	bot := Hit{Site: site.ID, Path: "/a", Browser: "test", RemoteAddr: "127.0.0.1", CreatedAt: now}
+	human := Hit{Site: site.ID, Path: "/a", Browser: "test", RemoteAddr: "127.0.0.2", CreatedAt: now}
+
+	// The pageview before the honeypot in the same batch is marked too.
+	Memstore.Append(bot, human)
+	Memstore.Honeypot(bot)
+	hits, err := Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("wrong number of hits: %d", len(hits))
+	}
+	if h := hits[0]; h.Bot != BotScored || !h.BotReasons.Has(BotReasonHoneypot) || h.BotScore != 100 {
+		t.Errorf("bot=%d reasons=%s score=%d", h.Bot, h.BotReasons, h.BotScore)
+	}
+	if h := hits[1]; h.Bot != 0 || h.BotReasons != 0 {
+		t.Errorf("bot=%d reasons=%s", h.Bot, h.BotReasons)
+	}
+
+	// Later pageviews in the session.
+	bot.Path = "/b"
+	Memstore.Append(bot)
+	hits, err = Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := hits[0]; h.Bot != BotScored || !h.BotReasons.Has(BotReasonHoneypot) {
+		t.Errorf("bot=%d reasons=%s", h.Bot, h.BotReasons)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// Notification kinds.
+const (
+	NotifyExport  = "export"  // Export is ready to download.
+	NotifyImport  = "import"  // Import finished (or failed).
+	NotifyAlert   = "alert"   // Alert was triggered.
+	NotifyCert    = "cert"    // TLS certificate for the custom domain was set up.
+	NotifyBots    = "bots"    // New bot threshold was applied to the pageviews.
+	NotifyReindex = "reindex" // Reindex finished (or failed).
+)
+
+// Notification is a message about something that happened in the background,
+// shown on the dashboard until it's dismissed.
+//
+// These are created in addition to any emails, so that people who don't read
+// (or get) the emails still know when an export or import is finished.
+type Notification struct {
+	ID        int64      `db:"notification_id" json:"id,readonly"`
+	Site      int64      `db:"site" json:"site,readonly"`
+	Kind      string     `db:"kind" json:"kind,readonly"`
+	Message   string     `db:"message" json:"message,readonly"`
+	URL       string     `db:"url" json:"url,readonly"` // Link to more details, if any.
+	CreatedAt time.Time  `db:"created_at" json:"created_at,readonly"`
+	ReadAt    *time.Time `db:"read_at" json:"read_at,readonly"` // Set when dismissed.
+}
+
+// Notify adds a new notification for the site in the context.
+//
+// Errors are logged and not returned, as this is always done after the actual
+// work has finished.
+func Notify(ctx context.Context, kind, message, url string) {
+	n := Notification{Kind: kind, Message: message, URL: url}
+	err := n.Insert(ctx)
+	if err != nil {
+		zlog.Error(err)
+	}
+}
+
+// Insert a new notification for the site in the context.
+func (n *Notification) Insert(ctx context.Context) error {
+	n.Site = MustGetSite(ctx).ID
+	n.CreatedAt = Now()
+
+	var err error
+	n.ID, err = insertWithID(ctx, "notification_id",
+		`insert into notifications (site, kind, message, url, created_at) values ($1, $2, $3, $4, $5)`,
+		n.Site, n.Kind, n.Message, n.URL, n.CreatedAt.Format(zdb.Date))
+	return errors.Wrap(err, "Notification.Insert")
+}
+
+// ByID gets a notification by ID, for the site in the context.
+func (n *Notification) ByID(ctx context.Context, id int64) error {
+	return errors.Wrap(zdb.MustGet(ctx).GetContext(ctx, n,
+		`/* Notification.ByID */ select * from notifications where notification_id=$1 and site=$2`,
+		id, MustGetSite(ctx).ID), "Notification.ByID")
+}
+
+// Dismiss this notification.
+func (n *Notification) Dismiss(ctx context.Context) error {
+	if n.ReadAt != nil {
+		return nil
+	}
+
+	now := Now()
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update notifications set read_at=$1 where notification_id=$2 and site=$3`,
+		now.Format(zdb.Date), n.ID, MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "Notification.Dismiss")
+	}
+	n.ReadAt = &now
+	return nil
+}
+
+// Notifications is a list of notifications.
+type Notifications []Notification
+
+// List the 100 most recent notifications for the site in the context, newest
+// first.
+func (n *Notifications) List(ctx context.Context, unreadOnly bool) error {
+	query := `/* Notifications.List */ select * from notifications where site=$1 `
+	if unreadOnly {
+		query += ` and read_at is null `
+	}
+	query += ` order by created_at desc, notification_id desc limit 100`
+
+	err := zdb.MustGet(ctx).SelectContext(ctx, n, query, MustGetSite(ctx).ID)
+	return errors.Wrap(err, "Notifications.List")
+}
+
+// DismissAll dismisses all unread notifications for the site in the context.
+func (n *Notifications) DismissAll(ctx context.Context) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update notifications set read_at=$1 where site=$2 and read_at is null`,
+		Now().Format(zdb.Date), MustGetSite(ctx).ID)
+	return errors.Wrap(err, "Notifications.DismissAll")
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestNotifications(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	goatcounter.Notify(ctx, goatcounter.NotifyExport, "Export 1 is ready", "/export/1")
+	goatcounter.Notify(ctx, goatcounter.NotifyImport, "Import finished", "")
+
+	var list goatcounter.Notifications
+	err := list.List(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("len(list) = %d", len(list))
+	}
+
+	var n goatcounter.Notification
+	err = n.ByID(ctx, list[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Kind != goatcounter.NotifyExport || n.URL != "/export/1" {
+		t.Errorf("wrong notification: %#v", n)
+	}
+
+	err = n.Dismiss(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.ReadAt == nil {
+		t.Error("ReadAt not set")
+	}
+
+	var unread goatcounter.Notifications
+	err = unread.List(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unread) != 1 || unread[0].Kind != goatcounter.NotifyImport {
+		t.Errorf("unread after dismiss: %#v", unread)
+	}
+
+	err = unread.DismissAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unread = nil
+	err = unread.List(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unread) != 0 {
+		t.Errorf("unread after DismissAll: %#v", unread)
+	}
+
+	var all goatcounter.Notifications
+	err = all.List(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Errorf("all: len = %d", len(all))
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+)
+
+// Operation kinds.
+const (
+	OperationPurge = "purge" // Hits.Purge()
+)
+
+// Operation states.
+const (
+	OperationDone   = "done"   // Finished; can be undone.
+	OperationUndone = "undone" // Undone with Undo().
+)
+
+// OperationKeepDays is the number of days an operation can be undone.
+const OperationKeepDays = 7
+
+// operationHitColumns are the columns of hits that are stored in the journal.
+const operationHitColumns = `id, site, session, session2, path, title, event,
+	bot, ref, ref_scheme, browser, size, location, region, city, host,
+	utm_source, utm_medium, utm_campaign, ua_brands, ua_platform,
+	ua_platform_version, first_visit, bot_score, bot_reasons, sample, created_at`
+
+// Operation is a journal of a bulk change to the pageviews, so that it can be
+// undone for OperationKeepDays days.
+//
+// The pageviews that were removed are copied to the operation_hits table; the
+// statistics are re-created from them when undoing, as they can't be reliably
+// stored: new pageviews may be added to the same hours and days after the
+// operation.
+type Operation struct {
+	ID     int64  `db:"operation_id" json:"id"`
+	Site   int64  `db:"site" json:"site"`
+	UserID *int64 `db:"user_id" json:"user_id"`
+	Kind   string `db:"kind" json:"kind"`
+	State  string `db:"state" json:"state"`
+
+	// Path pattern and if the title was matched as well, for purges.
+	Path       string   `db:"path" json:"path"`
+	MatchTitle zdb.Bool `db:"match_title" json:"match_title"`
+
+	// Number of pageviews that were removed, and if all statistics were
+	// removed (rather than just those for the paths).
+	Hits     int      `db:"hits" json:"hits"`
+	AllStats zdb.Bool `db:"all_stats" json:"all_stats"`
+
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+	UndoneAt  *time.Time `db:"undone_at" json:"undone_at"`
+}
+
+// journal inserts the operation and copies the pageviews that match the where
+// clause to the journal; args are the arguments for the where clause, where $1
+// is the site.
+//
+// This must be run in the same transaction as the change.
+func (o *Operation) journal(ctx context.Context, where string, args ...interface{}) error {
+	o.Site = MustGetSite(ctx).ID
+	if u := GetUser(ctx); u != nil && u.ID > 0 {
+		o.UserID = &u.ID
+	}
+	o.State = OperationDone
+	o.CreatedAt = Now()
+
+	var err error
+	o.ID, err = insertWithID(ctx, "operation_id", `insert into operations
+		(site, user_id, kind, state, path, match_title, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7)`,
+		o.Site, o.UserID, o.Kind, o.State, o.Path, o.MatchTitle, o.CreatedAt.Format(zdb.Date))
+	if err != nil {
+		return errors.Wrap(err, "Operation.journal")
+	}
+
+	r, err := zdb.MustGet(ctx).ExecContext(ctx, fmt.Sprintf(`/* Operation.journal */
+		insert into operation_hits (operation_id, %[1]s)
+		select %[2]d, %[1]s from hits where site=$1 and %[3]s`,
+		operationHitColumns, o.ID, where), append([]interface{}{o.Site}, args...)...)
+	if err != nil {
+		return errors.Wrap(err, "Operation.journal")
+	}
+	n, err := r.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "Operation.journal")
+	}
+	o.Hits = int(n)
+	return nil
+}
+
+// finish records the number of pageviews and if all statistics were removed.
+func (o *Operation) finish(ctx context.Context) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update operations set hits=$1, all_stats=$2 where operation_id=$3`,
+		o.Hits, o.AllStats, o.ID)
+	return errors.Wrap(err, "Operation.finish")
+}
+
+// Last gets the last operation that can be undone, for the site in the
+// context.
+func (o *Operation) Last(ctx context.Context) error {
+	err := zdb.MustGet(ctx).GetContext(ctx, o, `/* Operation.Last */
+		select * from operations where site=$1 and state=$2
+		order by created_at desc, operation_id desc limit 1`,
+		MustGetSite(ctx).ID, OperationDone)
+	return errors.Wrap(err, "Operation.Last")
+}
+
+// Undo the operation by restoring the pageviews.
+//
+// This returns the pageviews that were restored; the caller must re-create the
+// statistics for them: only for the paths unless AllStats is set.
+func (o *Operation) Undo(ctx context.Context) ([]Hit, error) {
+	if o.State != OperationDone {
+		return nil, errors.Errorf("Operation.Undo: operation %d is %s", o.ID, o.State)
+	}
+
+	var hits []Hit
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		_, err := tx.ExecContext(ctx, fmt.Sprintf(`/* Operation.Undo */
+			insert into hits (%[1]s)
+			select %[1]s from operation_hits where operation_id=$1`, operationHitColumns),
+			o.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.SelectContext(ctx, &hits, `/* Operation.Undo */
+			select * from hits where site=$1 and id in (
+				select id from operation_hits where operation_id=$2
+			) order by id`, o.Site, o.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `delete from operation_hits where operation_id=$1`, o.ID)
+		if err != nil {
+			return err
+		}
+
+		now := Now()
+		o.State, o.UndoneAt = OperationUndone, &now
+		_, err = tx.ExecContext(ctx,
+			`update operations set state=$1, undone_at=$2 where operation_id=$3`,
+			o.State, o.UndoneAt.Format(zdb.Date), o.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Operation.Undo %d", o.ID)
+	}
+	return hits, nil
+}
+
+// Operations is a list of operations.
+type Operations []Operation
+
+// DeleteOlderThan removes all operations older than the given number of days
+// for all sites; they can no longer be undone after this.
+func (ops *Operations) DeleteOlderThan(ctx context.Context, days int) error {
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		_, err := tx.ExecContext(ctx, `delete from operation_hits where operation_id in (
+			select operation_id from operations where created_at < `+interval(days)+`)`)
+		if err != nil {
+			return errors.Wrap(err, "Operations.DeleteOlderThan")
+		}
+		_, err = tx.ExecContext(ctx, `delete from operations where created_at < `+interval(days))
+		return errors.Wrap(err, "Operations.DeleteOlderThan")
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestOperationUndo(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2019, 8, 31, 14, 42, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false, []goatcounter.Hit{
+		{Path: "/asd", Title: "Hello", CreatedAt: now},
+		{Path: "/asd", Title: "Hello", CreatedAt: now.Add(time.Hour)},
+		{Path: "/zxc", CreatedAt: now},
+	}...)
+
+	countHits := func() int {
+		t.Helper()
+		var n int
+		err := zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from hits`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	var op goatcounter.Operation
+	err := op.Last(ctx)
+	if !zdb.ErrNoRows(err) {
+		t.Fatalf("wrong error for no operations: %v", err)
+	}
+
+	var hits goatcounter.Hits
+	err = hits.Purge(ctx, "/a%", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := countHits(); n != 1 {
+		t.Fatalf("%d hits after purge", n)
+	}
+
+	err = op.Last(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Kind != goatcounter.OperationPurge || op.Path != "/a%" || op.Hits != 2 || op.AllStats {
+		t.Errorf("%+v", op)
+	}
+
+	restored, err := op.Undo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != 2 || restored[0].Path != "/asd" || !restored[1].CreatedAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("%+v", restored)
+	}
+	if n := countHits(); n != 3 {
+		t.Errorf("%d hits after undo", n)
+	}
+
+	// Can't undo twice.
+	_, err = op.Undo(ctx)
+	if err == nil {
+		t.Error("no error undoing twice")
+	}
+	err = op.Last(ctx)
+	if !zdb.ErrNoRows(err) {
+		t.Errorf("wrong error after undo: %v", err)
+	}
+}
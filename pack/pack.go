@@ -701,6 +701,725 @@ commit;
 
 	insert into version values('2020-08-24-1-iso_unique');
 commit;
+`),
+	"db/migrate/pgsql/2020-09-10-1-geo-region-city.sql": []byte(`begin;
+	alter table hits add column region varchar not null default '';
+	alter table hits add column city   varchar not null default '';
+
+	alter table location_stats add column region varchar not null default '';
+	alter table location_stats add column city   varchar not null default '';
+	drop index "location_stats#site#day#location";
+	create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
+	alter table location_stats replica identity using index "location_stats#site#day#location";
+
+	insert into version values('2020-09-10-1-geo-region-city');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-12-1-path-index.sql": []byte(`begin;
+	create index "hit_counts#site#lower_path" on hit_counts(site, lower(path) varchar_pattern_ops);
+
+	insert into version values('2020-09-12-1-path-index');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-14-1-host.sql": []byte(`begin;
+	alter table hits add column host varchar not null default '';
+
+	create table host_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null,
+		host           varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+	alter table host_stats replica identity using index "host_stats#site#day#host";
+
+	insert into version values('2020-09-14-1-host');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-16-1-campaign.sql": []byte(`begin;
+	alter table hits add column utm_source   varchar not null default '';
+	alter table hits add column utm_medium   varchar not null default '';
+	alter table hits add column utm_campaign varchar not null default '';
+
+	create table campaign_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null,
+		source         varchar        not null,
+		medium         varchar        not null,
+		campaign       varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
+	alter table campaign_stats replica identity using index "campaign_stats#site#day#source#medium#campaign";
+
+	insert into version values('2020-09-16-1-campaign');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-18-1-audit-log.sql": []byte(`begin;
+	create table audit_log (
+		audit_log_id   serial         primary key,
+		site           integer        not null,
+		user_id        integer,
+
+		action         varchar        not null,
+		info           varchar        not null default '',
+		created_at     timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "audit_log#site#created_at" on audit_log(site, created_at);
+
+	insert into version values('2020-09-18-1-audit-log');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-20-1-device-class.sql": []byte(`begin;
+	alter table size_stats add column device_class varchar not null default '';
+	update size_stats set device_class = case
+		when width = 0     then ''
+		when width < 600   then 'phone'
+		when width <= 1024 then 'tablet'
+		when width <= 1920 then 'desktop'
+		else 'large'
+	end;
+
+	drop index "size_stats#site#day#width";
+	create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+	alter table size_stats replica identity using index "size_stats#site#day#width#device_class";
+
+	insert into version values('2020-09-20-1-device-class');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-22-1-email-queue.sql": []byte(`begin;
+	create table email_queue (
+		email_id        serial         primary key,
+		site            integer,
+
+		subject         varchar        not null,
+		from_name       varchar        not null,
+		to_addr         varchar        not null,
+		body            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null,
+		sent_at         timestamp,
+		created_at      timestamp      not null
+	);
+	create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+	create index "email_queue#created_at" on email_queue(created_at);
+
+	insert into version values('2020-09-22-1-email-queue');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-24-1-client-hints.sql": []byte(`begin;
+	alter table hits add column ua_brands           varchar not null default '';
+	alter table hits add column ua_platform         varchar not null default '';
+	alter table hits add column ua_platform_version varchar not null default '';
+
+	insert into version values('2020-09-24-1-client-hints');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-26-1-scroll-stats.sql": []byte(`begin;
+	create table scroll_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null,
+		path           varchar        not null,
+		count          int            not null,
+		total          int            not null,
+		reached_25     int            not null,
+		reached_50     int            not null,
+		reached_75     int            not null,
+		reached_100    int            not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
+	alter table scroll_stats replica identity using index "scroll_stats#site#day#path";
+
+	insert into version values('2020-09-26-1-scroll-stats');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-28-1-notifications.sql": []byte(`begin;
+	create table notifications (
+		notification_id serial         primary key,
+		site            integer        not null,
+
+		kind            varchar        not null,
+		message         varchar        not null,
+		url             varchar        not null default '',
+		created_at      timestamp      not null,
+		read_at         timestamp,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "notifications#site#created_at" on notifications(site, created_at);
+
+	insert into version values('2020-09-28-1-notifications');
+commit;
+`),
+	"db/migrate/pgsql/2020-09-30-1-jobs.sql": []byte(`begin;
+	create table jobs (
+		job_id          serial         primary key,
+		site            integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		progress        integer        not null default 0,
+		total           integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		started_at      timestamp,
+		finished_at     timestamp,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "jobs#site#created_at" on jobs(site, created_at);
+
+	insert into version values('2020-09-30-1-jobs');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-02-1-export-kind.sql": []byte(`begin;
+	alter table exports add column kind   varchar not null default 'hits';
+	alter table exports add column format varchar not null default 'csv';
+
+	insert into version values('2020-10-02-1-export-kind');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-04-1-export-scheduled.sql": []byte(`begin;
+	alter table exports add column scheduled int not null default 0;
+
+	insert into version values('2020-10-04-1-export-scheduled');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-06-1-no-data.sql": []byte(`begin;
+	alter table sites add column no_data_sent_at timestamp null;
+
+	insert into version values('2020-10-06-1-no-data');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-08-1-first-hit.sql": []byte(`begin;
+	alter table sites add column first_hit json null;
+
+	insert into version values('2020-10-08-1-first-hit');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-10-1-export-dates.sql": []byte(`begin;
+	alter table exports add column start_date timestamp null;
+	alter table exports add column end_date timestamp null;
+
+	insert into version values('2020-10-10-1-export-dates');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-12-1-export-paths.sql": []byte(`begin;
+	alter table exports add column path_like varchar null;
+	alter table exports add column paths varchar null;
+
+	insert into version values('2020-10-12-1-export-paths');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-14-1-export-progress.sql": []byte(`begin;
+	alter table exports add column max_hit_id integer null;
+
+	insert into version values('2020-10-14-1-export-progress');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-16-1-export-encrypted.sql": []byte(`begin;
+	alter table exports add column encrypted int not null default 0;
+
+	insert into version values('2020-10-16-1-export-encrypted');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-18-1-path-watches.sql": []byte(`begin;
+	create table path_watches (
+		watch_id        serial         primary key,
+		site            integer        not null,
+
+		path            varchar        not null,
+		threshold       integer        not null default 0,
+		seen_at         timestamp,
+		alerted_day     varchar,
+		created_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "path_watches#site#path" on path_watches(site, path);
+
+	insert into version values('2020-10-18-1-path-watches');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-20-1-imports.sql": []byte(`begin;
+	create table imports (
+		import_id       serial         primary key,
+		site            integer        not null,
+		user_id         integer        not null,
+
+		mode            varchar        not null,
+		email           integer        not null default 0,
+		file            varchar        not null,
+		hash            varchar        not null,
+		state           varchar        not null,
+		rows_done       integer        not null default 0,
+		last_line       integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict,
+		foreign key (user_id) references users(id) on delete restrict on update restrict
+	);
+	create index "imports#state" on imports(state);
+
+	insert into version values('2020-10-20-1-imports');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-22-1-import-progress.sql": []byte(`begin;
+	alter table imports add column job_id     integer;
+	alter table imports add column total      integer not null default 0;
+	alter table imports add column rows_read  integer not null default 0;
+	alter table imports add column errors     integer not null default 0;
+	alter table imports add column start_line integer not null default 0;
+	alter table imports add column started_at timestamp;
+	create index "imports#site#created_at" on imports(site, created_at);
+
+	insert into version values('2020-10-22-1-import-progress');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-24-1-import-fingerprints.sql": []byte(`begin;
+	create table import_fingerprints (
+		site            integer        not null,
+		fingerprint     varchar        not null,
+		import_id       integer        not null,
+		line            integer        not null,
+		created_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+	create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
+
+	alter table imports add column skipped integer not null default 0;
+
+	insert into version values('2020-10-24-1-import-fingerprints');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-26-1-import-transform.sql": []byte(`begin;
+	alter table imports add column transform varchar not null default '';
+	alter table imports add column dropped   integer not null default 0;
+
+	insert into version values('2020-10-26-1-import-transform');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-28-1-operations.sql": []byte(`begin;
+	create table operations (
+		operation_id    serial         primary key,
+		site            integer        not null,
+		user_id         integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		path            varchar        not null default '',
+		match_title     integer        not null default 0,
+		hits            integer        not null default 0,
+		all_stats       integer        not null default 0,
+
+		created_at      timestamp      not null,
+		undone_at       timestamp,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "operations#site#created_at" on operations(site, created_at);
+
+	create table operation_hits (
+		operation_id        integer        not null,
+		id                  integer        not null,
+		site                integer        not null,
+		session             integer        default null,
+		session2            bytea          default null,
+		path                varchar        not null,
+		title               varchar        not null default '',
+		event               integer        default 0,
+		bot                 integer        default 0,
+		ref                 varchar        not null,
+		ref_scheme          varchar        null,
+		browser             varchar        not null,
+		size                varchar        not null default '',
+		location            varchar        not null default '',
+		region              varchar        not null default '',
+		city                varchar        not null default '',
+		host                varchar        not null default '',
+		utm_source          varchar        not null default '',
+		utm_medium          varchar        not null default '',
+		utm_campaign        varchar        not null default '',
+		ua_brands           varchar        not null default '',
+		ua_platform         varchar        not null default '',
+		ua_platform_version varchar        not null default '',
+		first_visit         integer        default 0,
+		created_at          timestamp      not null
+	);
+	create index "operation_hits#operation_id" on operation_hits(operation_id);
+
+	insert into version values('2020-10-28-1-operations');
+commit;
+`),
+	"db/migrate/pgsql/2020-10-30-1-cron-status.sql": []byte(`begin;
+	create table cron_status (
+		task            varchar        not null,
+		last_run        timestamp      not null,
+		duration        integer        not null default 0,
+		processed       integer        not null default 0,
+		error           varchar,
+		failing_since   timestamp
+	);
+	create unique index "cron_status#task" on cron_status(task);
+
+	insert into version values('2020-10-30-1-cron-status');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-03-1-stat-retries.sql": []byte(`begin;
+	create table stat_retries (
+		stat_retry_id   serial         primary key,
+		site            integer        not null,
+		hits            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null,
+		created_at      timestamp      not null
+	);
+	create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+	insert into version values('2020-11-03-1-stat-retries');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-04-1-bot-score.sql": []byte(`begin;
+	alter table hits add column bot_score   integer not null default 0;
+	alter table hits add column bot_reasons integer not null default 0;
+
+	alter table operation_hits add column bot_score   integer not null default 0;
+	alter table operation_hits add column bot_reasons integer not null default 0;
+
+	insert into version values('2020-11-04-1-bot-score');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-05-1-reindexes.sql": []byte(`begin;
+	create table reindexes (
+		reindex_id      serial         primary key,
+		site            integer        not null,
+		job_id          integer,
+
+		tables          varchar        not null,
+		since_hit       integer,
+		first_day       timestamp      not null,
+		last_day        timestamp      not null,
+		state           varchar        not null,
+		total           integer        not null default 0,
+		done            integer        not null default 0,
+		start_done      integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null,
+		started_at      timestamp,
+		updated_at      timestamp      not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "reindexes#state" on reindexes(state);
+	create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+	insert into version values('2020-11-05-1-reindexes');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-06-1-rollups.sql": []byte(`begin;
+	create table hit_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		day           date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_daily#site#path#day" unique(site, path, day)
+	);
+	create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+	create table hit_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		month         date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "hit_counts_monthly#site#path#month" unique(site, path, month)
+	);
+	create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+	create table ref_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		day           date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day)
+	);
+	create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+	create table ref_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		month         date       not null,
+		total         int        not null,
+		total_unique  int        not null,
+
+		constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month)
+	);
+	create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+	-- Days for which the rollups are outdated; n is incremented on every change
+	-- so the cron job can see if the day changed again while it was updating it.
+	create table rollup_dirty (
+		site          int        not null check(site>0),
+		tbl           varchar    not null,
+		day           date       not null,
+		n             int        not null default 1,
+
+		constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day)
+	);
+
+	create function rollup_dirty() returns trigger as $$
+	begin
+		if tg_op = 'DELETE' then
+			insert into rollup_dirty (site, tbl, day) values (old.site, tg_table_name, old.hour::date)
+				on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+			return old;
+		end if;
+		insert into rollup_dirty (site, tbl, day) values (new.site, tg_table_name, new.hour::date)
+			on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+		return new;
+	end;
+	$$ language plpgsql;
+	create trigger "hit_counts#rollup_dirty" after insert or update or delete on hit_counts
+		for each row execute procedure rollup_dirty();
+	create trigger "ref_counts#rollup_dirty" after insert or update or delete on ref_counts
+		for each row execute procedure rollup_dirty();
+
+	-- Create the rollups for all existing data on the next cron run.
+	insert into rollup_dirty (site, tbl, day) select distinct site, 'hit_counts', hour::date from hit_counts
+		on conflict do nothing;
+	insert into rollup_dirty (site, tbl, day) select distinct site, 'ref_counts', hour::date from ref_counts
+		on conflict do nothing;
+
+	insert into version values('2020-11-06-1-rollups');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-07-1-first-hit-at.sql": []byte(`begin;
+	alter table sites add column first_hit_at timestamp null;
+	update sites set first_hit_at=(select min(hour) from hit_counts where hit_counts.site=sites.id);
+
+	insert into version values('2020-11-07-1-first-hit-at');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-08-1-ref-category.sql": []byte(`begin;
+	alter table ref_counts         add column ref_category varchar null;
+	alter table ref_counts_daily   add column ref_category varchar null;
+	alter table ref_counts_monthly add column ref_category varchar null;
+
+	-- The search, social, and email categories depend on the referrer; these
+	-- are set by a reindex of ref_counts.
+	update ref_counts set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_daily set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_monthly set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+
+	insert into version values('2020-11-08-1-ref-category');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-09-1-acme-renewals.sql": []byte(`begin;
+	create table acme_renewals (
+		cname           varchar        not null,
+		site            integer        not null,
+
+		failures        integer        not null default 0,
+		last_error      varchar,
+		last_error_at   timestamp      null,
+		expires_at      timestamp      null,
+		alerted_at      timestamp      null,
+		next_attempt_at timestamp      not null
+	);
+	create unique index "acme_renewals#cname" on acme_renewals(cname);
+	create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+	alter table acme_renewals replica identity using index "acme_renewals#cname";
+
+	insert into version values('2020-11-09-1-acme-renewals');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-10-1-sessions-stats.sql": []byte(`begin;
+	create table sessions_stats (
+		site           integer        not null                 check(site > 0),
+
+		day            date           not null,
+		pageviews      int            not null,
+		duration       int            not null,
+		entry_path     varchar        not null,
+		exit_path      varchar        not null,
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "sessions_stats#site#day" on sessions_stats(site, day);
+	alter table sessions_stats replica identity full;
+
+	insert into version values('2020-11-10-1-sessions-stats');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-1-hits-host.sql": []byte(`begin;
+	create index "hits#site#host#created_at" on hits(site, host, created_at);
+
+	insert into version values('2020-11-11-1-hits-host');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-2-hits-sample.sql": []byte(`begin;
+	-- The sampling factor when the pageview was recorded; the statistics are
+	-- scaled by this.
+	alter table hits           add column sample integer not null default 1;
+	alter table operation_hits add column sample integer not null default 1;
+
+	insert into version values('2020-11-11-2-hits-sample');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-3-anonymized-until.sql": []byte(`begin;
+	alter table sites add column anonymized_until timestamp null;
+	update hits           set session2=null where length(session2) = 0;
+	update operation_hits set session2=null where length(session2) = 0;
+
+	insert into version values('2020-11-11-3-anonymized-until');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-4-jobs-heartbeat.sql": []byte(`begin;
+	alter table jobs add column instance     varchar;
+	alter table jobs add column heartbeat_at timestamp;
+	alter table jobs add column cancel_at    timestamp;
+
+	insert into version values('2020-11-11-4-jobs-heartbeat');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-5-import-fingerprints-path.sql": []byte(`begin;
+	alter table import_fingerprints add column path varchar not null default '';
+	create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
+
+	insert into version values('2020-11-11-5-import-fingerprints-path');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-6-device-class-reindex.sql": []byte(`begin;
+	-- The device class was set from the width only, but phones are classified
+	-- on the shortest side; recreate size_stats from the pageviews.
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'size_stats', min(day)::timestamp, current_date::timestamp, 'running',
+			current_date - min(day) + 1, now(), now()
+		from size_stats
+		where width >= 600 and site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-11-6-device-class-reindex');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-11-7-ref-category-reindex.sql": []byte(`begin;
+	-- The previous migration set the ref_category of all referrers that aren't
+	-- direct, campaigns, or generated to "other"; recreate ref_counts from the
+	-- pageviews to classify them.
+	--
+	-- There can only be one running reindex per site, so add ref_counts to
+	-- the size_stats reindexes that haven't started yet.
+	update reindexes set
+		tables    = 'size_stats,ref_counts',
+		first_day = least(first_day, (
+			select min(hour)::date::timestamp from ref_counts where ref_counts.site=reindexes.site))
+		where state='running' and tables='size_stats' and done=0 and job_id is null;
+	update reindexes set total = last_day::date - first_day::date + 1
+		where state='running' and tables='size_stats,ref_counts' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'ref_counts', min(hour)::date::timestamp, current_date::timestamp, 'running',
+			current_date - min(hour)::date + 1, now(), now()
+		from ref_counts
+		where site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-11-7-ref-category-reindex');
+commit;
+`),
+	"db/migrate/pgsql/2020-11-12-1-counts-host.sql": []byte(`begin;
+	-- Store hit_counts and ref_counts per host, so the dashboard can be
+	-- filtered on the host.
+	alter table hit_counts add column host varchar not null default '';
+	alter table hit_counts replica identity default;
+	alter table hit_counts drop constraint "hit_counts#site#path#hour";
+	alter table hit_counts add constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour);
+	alter table hit_counts replica identity using index "hit_counts#site#path#host#hour";
+
+	alter table ref_counts add column host varchar not null default '';
+	alter table ref_counts replica identity default;
+	alter table ref_counts drop constraint "ref_counts#site#path#ref#hour";
+	alter table ref_counts add constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour);
+	alter table ref_counts replica identity using index "ref_counts#site#path#ref#host#hour";
+
+	-- The existing rows are stored without a host; recreate them from the
+	-- pageviews that have a host. There can only be one running reindex per
+	-- site, so add the tables to reindexes that haven't started yet.
+	update reindexes set
+		tables = case
+			when tables = 'all' or tables like '%hit_counts%' and tables like '%ref_counts%' then tables
+			when tables like '%hit_counts%' then tables || ',ref_counts'
+			when tables like '%ref_counts%' then tables || ',hit_counts'
+			else tables || ',hit_counts,ref_counts'
+		end,
+		first_day = least(first_day, (
+			select min(created_at)::date::timestamp from hits where hits.site=reindexes.site and host <> ''))
+		where state='running' and done=0 and job_id is null;
+	update reindexes set total = last_day::date - first_day::date + 1
+		where state='running' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'hit_counts,ref_counts', min(created_at)::date::timestamp, current_date::timestamp, 'running',
+			current_date - min(created_at)::date + 1, now(), now()
+		from hits
+		where host <> '' and site not in (select site from reindexes where state='running')
+		group by site;
+
+	insert into version values('2020-11-12-1-counts-host');
+commit;
 `),
 }
 
@@ -1606,1179 +2325,2019 @@ commit;
 	insert into version values('2020-08-24-1-iso_unique');
 commit;
 `),
-}
+	"db/migrate/sqlite/2020-09-10-1-geo-region-city.sql": []byte(`begin;
+	alter table hits add column region varchar not null default '';
+	alter table hits add column city   varchar not null default '';
 
-var Public = map[string][]byte{
-	"public/all.min.css": []byte(`
-/* FILE: ./aside.css */
-aside {
-  hyphens: auto;
-}
-aside {
-  position: absolute;
-  left: -15em;
-  width: 14em;
-  text-align: right;
-  font-size: .9em;
-}
-@media (max-width: 83em) {
-  aside {
-    position: static;
-    width: auto;
-    text-align: left;
-    background-color: #f7f7f7;
-    padding: .4em 2em;
-    border: 1px solid #aaa;
-    hyphens: none;
-  }
-}
+	alter table location_stats add column region varchar not null default '';
+	alter table location_stats add column city   varchar not null default '';
+	drop index "location_stats#site#day#location";
+	create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
 
-/* FILE: ./base.css */
-@font-face {
-	font-family: 'Lato';
-	font-style: normal;
-	font-weight: 400;
-	font-display: fallback;
-	src: local('Lato'), local('Lato Regular'),
-		url('/fonts/latolatin.woff2') format('woff2'),
-		url('./fonts/latolatin.woff2') format('woff2');
-}
-@font-face {
-	font-family: 'Lato';
-	font-style: normal;
-	font-weight: 700;
-	font-display: fallback;
-	src: local('Lato Bold'), local('Lato Bold'),
-		url('/fonts/latolatin-bold.woff2') format('woff2'),
-		url('./fonts/latolatin-bold.woff2') format('woff2');
-}
-@font-face {
-	font-family: 'Lato';
-	font-style: italic;
-	font-weight: 400;
-	font-display: fallback;
-	src: local('Lato Italic'), local('Lato Italic'),
-		url('/fonts/latolatin-italic.woff2') format('woff2'),
-		url('./fonts/latolatin-italic.woff2') format('woff2');
-}
-html {
-  font: 16px/150% 'Lato', sans-serif;
-          text-size-adjust: none;
-  -webkit-text-size-adjust: none;
-}
-@media (max-width: 54em) {
-  html { font-size: 14px; line-height: 160%; }
-}
-@media (max-width: 26em) {
-  html { font-size: 12px; line-height: 150%; }
-}
-pre, code {
-  font-family: 'DejaVu Sans Mono', 'Bitstream Vera Sans Mono', 'Consolas', monospace;
-}
-pre {
-  font-size: 14px;
-  line-height: 130%;
-}
-h1, h2, h3, h4, h5, h6 {
-  font-variant-ligatures: common-ligatures discretionary-ligatures;
-  font-feature-settings: 'liga' on, 'dlig' on;
-}
-* {
-  box-sizing: border-box;
-}
-*:target {
-  background-color: #ff6;
-}
-html {
-  background-color: #eee;
-  color: #252525;
-       tab-size: 4;
-  -moz-tab-size: 4;
-}
-html, body {
-  margin: 0;
-}
-.center, .page {
-  max-width: 54rem;
-  margin: .5rem auto;
-  position: relative;
-}
-.page {
-  padding: 1rem 4rem;
-  background-color: #fff;
-  box-shadow: 0 0 6px rgba(0,0,0,.2);
-}
-@media (max-width: 54em) {
-  .page, .center {
-    max-width: 100%;
-    padding: .5rem 1rem;
-  }
-}
-@page {
-  margin: 0;
-}
-@media print {
-  body {
-    background-color: #fff;
-  }
-  .page, .center {
-    box-shadow: none;
-    padding: 0 1cm;
-    margin: 0;
-    max-width: none;
-  }
-  .page:first-child, .center:first-child {
-    padding-top: 5mm;
-  }
-}
-.page-a4, .page.page-a4 {
-  width: 21cm;
-  height: 29.7cm;
-}
-.page-letter, .page.page-letter {
-  width: 8.5in;
-  height: 11in;
-}
-a,
-a code,
-.link {
-  color: #00f;
-  text-decoration: none;
-  transition: color .2s;
-}
-a:hover, .link:hover {
-  text-decoration: underline;
-  color: #6491ff;
-}
-a:hover code  {
-  color: #6491ff;
-}
-form {
-  display: inline;
-  margin: 0;
-  padding: 0;
-}
-.link {
-  background: transparent;
-  border: none;
-  outline: none;
-  margin: 0;
-  padding: 0;
-  cursor: pointer;
-  font: inherit;
-  line-height: 1rem;
-  display: inline;
-  -moz-user-select: inherit;
-  -webkit-appearance: none;
-}
-h1 {
-  text-align: center;
-  padding: .5em 0;
-  font-size: 1.7em;
-}
-h2 {
-  border-bottom: 1px solid #252525;
-  padding-bottom: .2em;
-  font-size: 1.5em;
-}
-h3 {
-  font-size: 1.3em;
-}
-h4 {
-  font-size: 1.1em;
-}
-h5, h6 {
-  font-size: 1em;
-}
-blockquote {
-  font-style: italic;
-}
-img {
-  max-width: 100%;
-}
-figure {
-  text-align: center;
-  margin: 1rem 0;
-}
-figure.border {
-  border: 1px solid #aaa;
-  padding: 5px;
-  margin: -5px;
-}
-@media (min-width: 54em) {
-  figure.full {
-    margin-left: -4rem;
-    width: calc(100% + 8rem);
-  }
-  figure.full.border {
-    border-left: none;
-    border-right: none;
-  }
-}
-code, pre, kbd, samp {
-  background-color: #f5f5f5;
-  color: #000;
-}
-pre {
-  overflow: auto;
-  max-height: 500px;
-  padding: .5em 1em;
-  border-radius: 2px;
-  border-top: 1px solid #d5d5d5;
-  border-bottom: 1px solid #d5d5d5;
-}
-@media (min-width: 54em) {
-  pre.full {
-    margin-left: -4rem;
-    width: calc(100% + 8rem);
-  }
-}
-code, kbd, samp {
-  padding: 1px 2px;
-}
-pre > code {
-  padding: 0;
-  box-shadow: none;
-  border: none;
-}
-h1 code, h2 code, h3 code, h4 code, h5 code, h6 code {
-  background-color: transparent;
-  box-shadow: none;
-  color: inherit;
-}
-table {
-  border-collapse: collapse;
-  width: 100%;
-}
-table + table {
-  margin-top: 2em;
-}
-@media (min-width: 54em) {
-  table.full {
-    margin-left: -3.5rem;
-    width: calc(100% + 7rem);
-  }
-}
-caption {
-  font-weight: bold;
-}
-tr {
-  border-bottom: 1px solid #ddd;
-}
-table > tr:last-child, tbody tr:last-child, tfoot tr:last-child {
-  border-bottom: none;
-}
-tfoot tr:first-child {
-  border-top: 1px solid #ddd;
-}
-td, th {
-  padding: .2em .5em;
-  hyphens: none;
-}
-td.left, th.left  {
-  text-align: left;
-}
-td.right, th.right {
-  text-align: right;
-}
-table td.right {
-    font-feature-settings: 'tnum' on;
-    font-variant-numeric: tabular-nums;
-    font-family: sans-serif;
-}
-sup, sub {
-  height: 0;
-  line-height: 1;
-  vertical-align: baseline;
-  position: relative;
-}
-sup {
-  bottom: 1ex;
-}
-sub {
-  top: .5ex;
-}
-hr {
-  border: none;
-  text-align: center;
-  font-size: 60px;
-  color: #252525;
-  opacity: .9;
-}
-hr:before {
-  content: url(data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCAzMC4yIDkuMiIgaGVpZ2h0PSIzNC44IiB3aWR0aD0iMTE0LjMiPjxwYXRoIGQ9Ik0xMy4yIDBxMS4xIDAgMS4zIDEuMiAwIDEtLjcgMS4yLTEuOC40LTIgLjggMi42LjMgMy4yIDMuMWguMmwuMi44LS40LjFxLS4yIDEuOC0xLjggMS45LTEgMC0xLjMtMSAwLS44LjItLjguMi40LjguNC44IDAgMS0uOFExMSA2LjEgMTEgMy43cS0xLjQgMC0yLjEgMSAxLjQuNyAxLjQgMS44LS4xIDIuNS0zLjYgMi43US41IDkuMiAwIDUuNXEwLS42LjctLjcuNSAwIC42LjV2LjJsLS4xLjRxMCAxIDEuMSAxIDEgMCAxLS43UTQgMS4yIDcgMXEyIC4xIDIgMS43di4xcTAgLjQtLjQgMS41IDEtLjggMi0xIC42IDAgLjYtLjQuOC0xIDEuNi0xLjMuNi0uNC42LS44bC0uMi0uNHEtLjItLjMgMC0uNHptLTEuNiAzLjd2LjFxMCAxLjQgMi4zIDIuMi0uMy0yLTIuMy0yLjN6Ii8+PHBhdGggZD0iTTE3IDBxLTEgMC0xLjMgMS4yIDAgMSAuOCAxLjIgMS44LjQgMiAuOC0yLjcuMy0zLjIgMy4xSDE1bC0uMi44LjUuMXEuMiAxLjggMS44IDEuOSAxIDAgMS4yLTEgMC0uOC0uMi0uOC0uMS40LS43LjQtLjkgMC0xLS44IDIuNy0uOCAyLjgtMy4yIDEuNCAwIDIgMS0xLjMuNy0xLjMgMS44IDAgMi41IDMuNSAyLjcgNi40IDAgNi44LTMuNyAwLS42LS42LS43LS42IDAtLjcuNXYuMmwuMi40cTAgMS0xLjIgMS0uOSAwLTEtLjctLjYtNS0zLjctNS4yLTIgLjEtMiAxLjd2LjFxMCAuNC40IDEuNS0xLS44LTItMS0uNiAwLS42LS40LS43LTEtMS41LTEuMy0uNi0uNC0uNi0uOGwuMi0uNHEuMi0uMyAwLS40em0xLjcgMy43di4xcTAgMS40LTIuNCAyLjIuMy0yIDIuNC0yLjN6Ii8+PC9zdmc+);
-}
+	insert into version values('2020-09-10-1-geo-region-city');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-12-1-path-index.sql": []byte(`begin;
+	create index "hit_counts#site#lower_path" on hit_counts(site, lower(path));
 
-/* FILE: ./blockquote.css */
-blockquote {
-  font-style: italic;
-  position: relative;
-}
-blockquote:before  {
-  position: absolute;
-  color: #dbdbdb;
-  font-size: 5em;
-}
-blockquote:before {
-  content: "“";
-  left: -.6em;
-  top: .25em;
-}
+	insert into version values('2020-09-12-1-path-index');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-14-1-host.sql": []byte(`begin;
+	alter table hits add column host varchar not null default '';
 
-/* FILE: ./form.css */
-input[type="text"],
-input[type="search"],
-input[type="tel"],
-input[type="url"],
-input[type="email"],
-input[type="password"],
-input[type="date"],
-input[type="month"],
-input[type="week"],
-input[type="time"],
-input[type="datetime-local"],
-input[type="range"],
-input[type="number"],
-textarea,
-select {
-  font: 1em/100% sans-serif;
-}
-textarea,
-button:not(.link),
-select,
-input:not([type="checkbox"]):not([type="file"]):not([type="image"]):not([type="radio"]):not([type="range"]) {
-  padding: .6em;
-  border: 1px solid #bbb;
-  border-radius: 3px;
-}
-textarea {
-  width: 100%;
-  height: 10rem;
-  line-height: 130%;
-}
-button:not(.link), input[type="submit"]:not(.link) {
-  color: #000;
-  cursor: pointer;
-  margin-left: .5em;
-  padding: .6em 1.5em;
-  background-color: #f6f5f4;
-  background: linear-gradient(#f6f5f4, #edebe9);
-  border: 1px outset #e9e9e9;
-  border-radius: 3px;
-  border-top-color: #ccc;
-  border-left-color: #ccc;
-}
-select {
-  background-color: inherit;
-}
-input:not(.link):focus,
-button:not(.link):focus,
-textarea:focus,
-select:focus {
-  outline: 1px solid #00f;
-  box-shadow: 0 0 .2em #00f;
-  color: #000;
-  cursor: pointer;
-}
-button:not(.link):hover,
-input[type="submit"]:not(.link):hover {
-  background-color: #f0f0f0;
-  background: linear-gradient(#f0f0f0, #d0cecc);
-}
-button:not(.link):active,
-input[type="submit"]:not(.link):active {
-  background-color: #d0cecc;
-  background: linear-gradient(#d0cecc, #f0f0f0);
-  border-style: inset;
-}
-form > strong, fieldset > strong {
-  display: inline-block;
-  margin-top: .5em;
-}
-form span {
-  margin-left: .2em;
-}
-fieldset {
-  border: 1px solid #aaa;
-}
-.vertical input[type="text"],
-.vertical input[type="search"],
-.vertical input[type="tel"],
-.vertical input[type="url"],
-.vertical input[type="email"],
-.vertical input[type="password"],
-.vertical input[type="date"],
-.vertical input[type="month"],
-.vertical input[type="week"],
-.vertical input[type="time"],
-.vertical input[type="datetime-local"],
-.vertical input[type="range"],
-.vertical input[type="number"],
-.vertical textarea,
-.vertical select {
-  display: block;
-  min-width: 20rem;
-}
-.vertical input[type="number"] {
-  min-width: 10rem;
-  width: 10rem;
-}
-.vertical label {
-  display: block;
-  margin-top: .5em;
-}
-form.horizontal {
-  display: flex;
-  flex-wrap: wrap;
-  justify-content: space-between;
-}
-form.horizontal label {
-  width: 30%;
-}
-form.horizontal > div {
-  width: 65%;
-  margin-bottom: 1em;
-}
-form.horizontal input[type=submit],
-form.horizontal button[type=submit] {
-  margin-left: 35%;
-}
-form.horizontal span {
-  display: block;
-  margin-left: .2em;
-}
+	create table host_stats (
+		site           integer        not null                 check(site > 0),
 
-/* FILE: ./permalink.css */
-h1 a, h2 a, h3 a, h4 a, h5 a, h6 a {
-  font-size: .7em;
-  line-height: 0;
-  color: #999;
-}
-h1 a:after, h2 a:after, h3 a:after, h4 a:after, h5 a:after, h6 a:after {
-  content: "§";
-}
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		host           varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
 
-/* FILE: ./postscript.css */
-.postscript {
-  margin-top: 3em;
-  border-top: 1px solid #aaa;
-  font-size: .9em;
-  position: relative;
-}
-.postscript + .postscript {
-  margin-top: 2em;
-}
-.postscript > strong {
-  font-style: normal;
-  position: absolute;
-  top: -1em;
-  left: 1em;
-  background-color: #fff;
-  padding: 0 .5em;
-}
-.postscript > p {
-  margin: 1em 2em
-}
-@media (min-width: 54rem) {
-  .postscript {
-    margin-left: -4em;
-    width: calc(100% + 8em);
-    padding: 0 4em;
-  }
-  .postscript > strong {
-    left: 6em;
-  }
-}
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+
+	insert into version values('2020-09-14-1-host');
+commit;
 `),
-	"public/avatar.jpg": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("/9j/4AAQSkZJRgABAQIAdgB2AAD/4SBKRXhpZgAASUkqAAgAAAAFABoBBQABAAAASgAAABsBBQABAAAAUgAAACgBAwABAAAAAwAAADEBAgANAAAAWgAAADIBAgAUAAAAaAAAAHwAAAB2AAAAAQAAAHYAAAABAAAAR0lNUCAyLjEwLjEyAAAyMDIwOjAxOjAyIDAyOjIyOjU1AAgAAAEEAAEAAADhAAAAAQEEAAEAAAAAAQAAAgEDAAMAAADiAAAAAwEDAAEAAAAGAAAABgEDAAEAAAAGAAAAFQEDAAEAAAADAAAAAQIEAAEAAADoAAAAAgIEAAEAAABaHwAAAAAAAAgACAAIAP/Y/+AAEEpGSUYAAQEAAAEAAQAA/9sAQwAIBgYHBgUIBwcHCQkICgwUDQwLCwwZEhMPFB0aHx4dGhwcICQuJyAiLCMcHCg3KSwwMTQ0NB8nOT04MjwuMzQy/9sAQwEJCQkMCwwYDQ0YMiEcITIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy/8AAEQgBAADhAwEiAAIRAQMRAf/EAB8AAAEFAQEBAQEBAAAAAAAAAAABAgMEBQYHCAkKC//EALUQAAIBAwMCBAMFBQQEAAABfQECAwAEEQUSITFBBhNRYQcicRQygZGhCCNCscEVUtHwJDNicoIJChYXGBkaJSYnKCkqNDU2Nzg5OkNERUZHSElKU1RVVldYWVpjZGVmZ2hpanN0dXZ3eHl6g4SFhoeIiYqSk5SVlpeYmZqio6Slpqeoqaqys7S1tre4ubrCw8TFxsfIycrS09TV1tfY2drh4uPk5ebn6Onq8fLz9PX29/j5+v/EAB8BAAMBAQEBAQEBAQEAAAAAAAABAgMEBQYHCAkKC//EALURAAIBAgQEAwQHBQQEAAECdwABAgMRBAUhMQYSQVEHYXETIjKBCBRCkaGxwQkjM1LwFWJy0QoWJDThJfEXGBkaJicoKSo1Njc4OTpDREVGR0hJSlNUVVZXWFlaY2RlZmdoaWpzdHV2d3h5eoKDhIWGh4iJipKTlJWWl5iZmqKjpKWmp6ipqrKztLW2t7i5usLDxMXGx8jJytLT1NXW19jZ2uLj5OXm5+jp6vLz9PX29/j5+v/aAAwDAQACEQMRAD8A9ozS5pKWtzIAaXPFFGKADNGaKDQAmaN3tSGkoAcD7Uu72popaAF3UbqbRQIfu9qN1MozQA/dSFqbmkzQA/dRuplGaAuP3Uoao80oNAXH5ozTaM0CuLmk3UmaSgY7dRuptFADt1IW9qbQaAF3e1FNooGPpabmloAcKcKaKcKBAaYaeaYaYCUUySVI8bmxn2qs+o2y4/e/+On/AAos2JyS3LoINBx61zzeI7WNG3XWGxkfuz/hVQ+LLXcFN7yf+mR/wq/ZS7GP1mn3OrzSZrDt9ftpNrG5ypz/AMsz/hWlBf29wF8uTcW6fKRUuElui41oS2ZZzzS00HJpak0CiikpgLmikooAWlFNozSAdmlpoNLQIKKKSgBaSkzSUDFpKDSZoAWijIooEPoFNzTgaZQ4dadyKQGgmgBc1FNKsSBiD1xxTia5LxDrawxtGrTqyTbSVOOmfenFXdjOpNQVzO1vxW8N40SPKAkjr9xexrLXxBNL/wAtH4/2FrkNQ1A3N/cEPLxK33j6n61Il6Is7i/PpXVFxR5NT2ktbnQTXXmZ69MdBVKRv4h1A4qKO6SeNggYMflBPrTDa3GOZR/30a1dRWOVQkmWrfVLiKRVMh2DPAUV0Wn6zPHFHKsjBRnjaue9cRLFLA5kZ8qOwJrTsLxWt40+fPP8zWDqp7m/s5LWLO6XxQ0agyNKRjsi1r6Zr1vqEnlIkocJuJZQB2HY+9ecxXIeVkO449ant5bmzmadZ3VXGBscg4PP9KiSi1ob0q9SLtI9WpDUNvcrNu2huMdamNYnqJ3EooooGFGaSkzQA+jNJmigBaM0maSgBc0maDTaAHE02igUAFFLRQA4GlpDRQUOzS54puaM0CIbyYwwhhnlsdcV5H4mknS5uJTcSFWuWwm44GSa9avF8yELnHzZryzxaN4kj6bbk8/99UnLlJlTU9GcaVIkdyclzmpWhL4+cjFaun6Z5qsfOxwD93/69WBYY/5af+O1LrIj6rLoc+vmwyKRO+AQcAkZrRgvZHxkt1xy1aQ0veQfOx/wH/69WodK2kfvv4v7n/16yddmywKa1MtplJw8YYd80kfMwKfIOyjtxXTR6f0/e/8Ajv8A9erUVhsYN5mcdttZuuzojgIWOahjKuW3k5FXW1BUiVDAG24GSev6V0Ig4+9+lY2t6Z59sB52397n7uex96SrSYPAwWtjstFvGn8/gjbt/iz610IOa8V8I332T7Z+737tn8WMY3V7Ojbs8dK6ou5zOPKONFLSVYhDSUpptIBaM02g0AOzSZppNJQA/NJmm5pc0AOzRSUtABRRRQAbvpSbqZmjNMY/dRmo80uaAJM8V5jr6CW+ul54uHPH1NemDkV5zqqA6nd9f9e//oRrGrsaUtyTT4FS3Xryi9fpVhgPWo7diIlC84UU8rXI2ejCIlOANNAwakBHqKi5uoiox4FO3HNRlgDkEU4ONuSRSHYdvPoKYW38HFNLg9xTC2OlNEyRzItDbdFcbv7wr2SBAu7FebXsCtszu716ZGMZrsonl11Zj6KbmjdgVuc4GmNSlvpTSfegApKTNGaQCkU007NGKYDaUUuKAKAF7UlNkniiBLyIoHUswGKjW6t3PyzxN34cGkBNmio/Oi/56J/30KKLgM3e9G73ptFMY8GnZqMU4UCJAa851By2r3q5zid//QjXoEsyQqGdtoJx0zXA3Aim1e8Kckyue/8AerKs9Daim5E0ciQRIWO3co96z59WSPbifGc/wf8A1qi1C6dDGgfG3Ixj6VhzXdq23e/04Nce53q6Rupr9mR81zznj92f8Kf/AGtDJ/q589vuHr+VcLLcKXBgbjHp3/Gp7W5uS6gPxvHYU3EcajOzF+3mcy8f7v8A9al/tEbsGXj/AHf/AK1VbK2ea3jd03Mc5OcdzWZq7y2jzbDs27cdDjOKhK7N22lc6Jb+ADJl/wDHT/hUq3lu3/LT9DXnLa5cqSDcYAOPuD/CtOy1hpMbp8/Jn7n/ANar5WjD2t2dxdMp2YPrXoYb3ryyC5afdufdt9sV6cjhs4NdNHY4cRuSlveml+OtMLe9MLe9bHMPLe9N3U3NGaAHg04VGGHrTt3vTGSUmabu96Td70APzTJZNkZbOMUjOACc8CsTU764Pmx28nPG0bR7etJuwJXMzWdTkk1Ce0WbIO3C7fYHrio4Lho1GxsSbcHis+4huRdteTr6ZfI9MdBU9uGZt3UEZrJspF/7bcf89P8Ax0UVBtb0opXKOuxS03NG6tyLDwKeOlRhqcGphYq6oQtqpP8AfH8jXFKANRuGA6s38667W3xZJyf9YP5GuEV3TUJ2Z2KlmwM+9cld6ndho+62XLizFwQQsfGc7hWVPpKDbmKD/vn/AOtW9bSrtywJyBTZGjbHyfmBXLc74RTRybaOpPyRwD/gP/1qmtdJMZXcsJw2eB/9atx2hU48sZ/3RT4lV13BRgH0p8zD2aRLawhLdAAoxnp9ayNXtBM8wKoc7eo+lbqSIqBcHP0qtcIsm47RzjqKWxpJJo4ptJRXYvFAQT02/wD1quQWcHCrDECF5OwVvCKIkgxIcf7IqxFbQg58mPkf3RVc7MVSRWsLV4vM3lTnGMfjXoGnsx83cxPTqfrXGTER7cDGfSuo0WUv5+Sxxt6/jW9B6nHioaaGsaaaCaburqPPsKaKYWpN31phYfmjd9ajzRmkOxLu+tGaYDTgaLhYz7+9EEojJflM8dO9Zst1EULbW3euBmna8G+2ptOB5Q/max5mZbZjuP5+9ZSepSLdzPHNatEFO445I465rNilLSGJGZWQc84HHFZ8txJ55Akcf8CPpSgyR/vN5y3cHmpBmrif/nqf++jRWZ9ok/56P/31RQFz0bNANZUuuafEBm8tTn/puoqA+JdPDAG5tuf+nha05kBvBqcG9qxE8QacRn7Za/8Af9auQalZzorJdQHPTEoNHMAur/NZoOn7wfyNcZqQ2KT1/ef412szQ3EYUTxjBznINcvrGlhEMi3AbdL0C/X3rCrBvVHZh6sYx5WYUlxsVflzketUJdR24/df+Pf/AFqs3A5CZ+7kVmTQvFtwrNn0HSuY61JpG3bQ7Yid3Q+lZ+p6p9jcxeTv3R5zux6+3tVOO6iQeXI6IzHgMwB/KnSIjHiVelNIfNfYqLrPAPkf+P8A/wBapbfXsXKr9m9f+Wnt9KDbxnrOoP8An3pogiDf8fCfp/jV2RN5HQlftFtHJnbvAbHXGRVdbzy5Gj8vO3jOeuKyjdRxqFR0kYcEBhmlSVpztMZUdc1Fh+0N37Xu/gxj3rutEXYJ+c52/wBa88s7Z5t+xWbGM7VzXpNjCbcSZP3sdRj1raine5y4ia5bF4mmlvamlh7U0sPauo4RxakzTCwpN49vzoAkzS5qLePb86USD2/OgCYGnA1BvHt+dKHHt+dAGXrPN0v/AFz/AKmsW4by4WOM4xW5qzKsckpYfJETjPpmuaV1u4dwYKG989KzluNEcU3m3Ij24z3z7U4226Rhv7ntUT262zG580MV/g6Z7UxpPtAxjb39am4Ms/ZP9v8ASiqnkf7X6UU7iscdPfPKUyE46YqCS5feOF6elPe0ZSpAOB15FRTQSscxLuXHUkVkrF2HreMqHGwnNaVj4iu7SONUjgO3ONwPfPvWVb6fcyoWEfOcfeFTGykj4dSCOvIovYVjfXxrqCcCG0/75b/4qnSeML+6QRyRWoUc8K3/AMVXPrbHOWyF7HNRyfueT93OAap1BpanRJc/aMuxQE8nB9akJWTqRx6VjiRraCJyBiVQRn6f/XqeO9BzyPyNc7R6EJaFm80GCWZbgGcvGvygEYJBz6UyKxYkb0kXn0xW9A6yRlgehxTJmRTnPampdzVRiY76ZGZTkyY/z7VWm03a52LK3pxn+larT/McYqSFgxBJ5p3Bxj3Mu20KJX81vPUsvOcAZP4VLLEluMKxODt5Na1xMI4VJxjOK5+7u9zuBj757Gpu2zKVkdN4fcJ9o3EDO3r+Ndi2pwL1ngH1cf415xZagLfzN5UbsY4J9atXt1MrRKVT5iR/L3rpg+VWOCo+aVzuH1e0X713bDjvIP8AGov7ZsycLeWpPoJR/jXmGqX9xDdLHsTBQHke596rRXsqyBwqZHsap1LGdmetHU4dm/z4NvrvGP51Vn8QWMPBvrMNnkNMv+Necya9ci1MGyHH+6c9c+tYt1cfaJWaTAY4yAPaj2gWZ69B4gs53Kre2bEDOFlB/rV1b9CARJEQeh3V47pDxw3TOW6xkdPcV1w1mG3t4QzqMqMfKfSmprqFmdt9tj/56Rf99Ufb0H/LSL8687uPFIXb5LRN1zlGqFPGAZDuaEN2HltT50KzO71S5WazuBvTmFhwfY1zNlK8caRquUGeSKoReJUuozCzR75DsACNznirtpKqlIpTtHOcVEmmUkxLq5cyPHhccUsMuMcjpVW6bOousfKcYJ+lNVpUYkqMdqkZpecf9mis/wA5/QUUWEQxR2oyLkcHpyfx6VZS30hoXCr85yF5frWL9pd+GJJ7cCnxXJSVIyTuZhggcUKKRZtW1pbpCQEw27jk1E9pC10Q6ZU9eT6UsEsi3Cq7ZU5OAParMyAxtKvDetHKgKs2nwGMeVF3/vH/ABrn5ohNPJAq5KMflz0wcVv3N+La1Tdu3bsEgD3qPRdL+06jLcS7GjlQuBkg8kGs5qyuXCLk7D5tLElhZZhziIfxew965qKRkzvOM9K9CuEVEjjA4QbRXJ32lA+X5AVeucsfas1I7XCyRtaa4ePbnOXx/KtM2SSDmPPb71cjYaqbcqrFyd4PCj2rfj1wFT/rOv8AdFS1Y0jJW1LLaYm7Ah/8e/8Ar0n2ERc+XjH+1UQ1clur/wDfIqvea2I4nP7zIx/CKNQvEqatceVDgPjEmOn1rDCySSMzDKnkVOs0uqXUkZbK5LgMMd/b61J+7j/dlTleDj2rSKsctWV1oS7APvj6VcuLlHALvllyU471VY+d93jHrUbnKkvzgcVscpWu8XEweT5mAwD0qFowqnA5p0jnd8pwMVHudj14rJt3HYjdeCSKh8hWcsVyD71ZkGIyTTM4jBFHMxWGRRlHJUY4xU1zLNIkShs7RjoOOlRq+GOelSblboDS5mFis8ErY2L9eRUK2MqEbo8DOfvCr4nRezVIsiyoTg+nNHMwsVbaPy7yEgYw6n9a6mEPJIr9Qe9c6gCzI2OAwP610sEco09btWURc8d+uKuLAZcGJCxHEwx6/wD6ulNSaJ1AZsnHPBqGTdNOWz19fpUy2u1Q3HI9asQ7MP8AnNFJ5P0oouBlWlub4SeTtXZjO7jr9PpTZIWtbuKOXDOSCCOcc1f8K30UjXKG1T5igySOOvtW1f2sBbzzDGTGmcbB2yetZyqcsrM1ULq5gmRoLlLh2YxIMFQeT1H9amm1u2a1MapKGPfA9frVyC4t7j9y1nF8x6kA+/pVLUrCNBLMgRVGMIEAHYU1V6MPZ9Tm9Y1ZHTykMoZXBJ7dPr71Y8K620OpSedLcPGICAu7IHK9iarahapMvAVW3A7tvtXPO8ltO4ikdCCVypxkZrTScbCi3B3PZHlE8UUi5w67hnrzWVMcbc81Q8Nau97ZeU6NmCONdzPndwef0rQkHTNcjXK7HoxlzRuYdzb/ADZjCrhe3HNUJDcoeJ3AxnhzW+8IbuOnpVaS1QnnaeP7tWmZSiY63FzgD7RLn/fNOVbqZwWnZlPZnJrQNog7L/3zU1tbL5y9Mc8Ypmepd0+1WFUcomTGASByelZV7OsNxKzA48wjj610mBFAmAOgH6V53rt873VxGu5Ns7chvc06auxVUlE61SOccVWvkkHl7WxnOcHr0p2j3ovvOzEF2bepz1z7e1aKWqyI5Yg7RxkZqnK25z8rMMRsFO4g03eqDkVfukWJwgUcr1xUccSlSSoPPcVlJk3sUZHDggZ5qJkcRg7uM+taRSNG3eWpA7Yp8YjmO3ykAHPQUuYZnwQtIcZHTPNSvbOuMFR9DWkfKjUYhTPTIAFSIUYcxKfqKLgYi2sndl/OoIo5UIUv1PYmug3xH/lgg/AU1lh3D9xH+Qo5yNTPjhbbkkda6bTgZdJigzyc9en3iax2ZAwAjAFXra7MKrtTgZ4BxThK7HcbdwPDO4yBjH3T7U+2uVRv3m5htxjrS3Ev2iNn24LY75qnFzIV9BWwzU+2W/8AzyP/AHyKKo7aKYHD2k/2a8gl27tkitjOM4Oa77TdV/tG2ZvJ8vLlPvZ7Dnp715qZieOKnsrgw31uw24EqnJ+oqqtNTRcJWO31RfIuVfO7CDjp3NSWl15tuibMZzzn3qu1yL6wkO5G5A+Q59DWZGfJvgp4A9fpXKldWZtcs6nFtmaTPUgYx7VzWpw5jzu6yen1rrJwJrRDnPzdvxrEuUBZl54atISsRJGFY3/ANgMo8rzN+P4sYxn/GvTY5PPzxtx75ryq7QpcPweWPX61taDrDWf2jc0K79v3zjpn3961qQ5ldFUanK7M7wL2zSmHPO79KejRy8xuHGcZU5qdVwp61zWOp6lBo+2adFF+8B3fpVpl706MgEUEWKWsSeTYRnGfnA/Q15jfNuv7g4xmVj+prt/Fd2BYqoZCRPjGfZq4Nm3TOfUk100lZHPWfQvaFc7PtHyZzt7/Wur14faLNm+75cbn1zx/wDWrgo3MGduOfWu1sZlkguYty/Ou3g88g9KmorPmIg9LHL2t35GF2bstnOcVtWmrYHleR945zv9vpWXrFp9nu1VVfHlg8j3NZyuVPSrcIzVyNmdnFdZlD7PwzW7ZRfaoFOduQT0z3rz2zucypG5VU5y3St+3hieFWV9wOeQQe9c8o8jFKVjrP7Lyc+d/wCO/wD16U6HvwftGP8AgH/165f7LEvzBmJPUZFOC9ueKnmj2I9ojZuNF8rb/pGc5/g/+vVRbXy1Kb8574psO4buKvwEAdR1qW0WmpGe8PlqV3Z4z0q7pkXmyRRZxnPOPrVmR9wPTGMcVVUrBOJQwyPU8dKcHqNqxpahB9n0+Q7t23HbHcVhb9vzY61q3Ny0mms3ynOOn1qG0tftBG4PgrngV0IRn+f/ALP60Vt/2Wn/AE1/z+FFO4Hneq6a2neTujmTfn/WLjOMdOPes7dhgfSvQfiHaqI7BkT7olJ5/wB2vPCa0hLmjcuceWVjqfDt0WtzExQbpCcd+gqe+TbcSSDPb6dBWDokxTUYlLYUliRj/ZNdJdgPaO45zjn8a56itMuLuia0PmWUeffp9TWXcDE8g/2z/OtDTjiBV9j/ADqldriVzjq5qY7jexzuqR4dCAeS39KzyK2NSGTH+P8ASseuqGxjLc6nR/E88SrG/wBmVWlGS2Rgce9ddaa3ZTgB720DFsACVf8AGvJ6VJHidWQ4KnI+tKVNM1hWcT2N7i12krcREeu8VVkv7aIlmuYQB3Lj/GvMhrWoqu0XHH+4v+FRS6peTArJNkHr8o/wqFRLdddjV13UjdTTRq0TIJ2IKHORz71jKfmNR5JJPc04HFbJWRzyld3Bl3YzW5ol2XuDuKD5k/maxalsJTBcoAdpZ17ZzzSmroE7M6XXYfOZplDNshPK8jjJrll+Y/NxXc28LXelXOV3sQyjnH8NcbqVs9lcrG6bCUDYznuf8KzpPoVPuR7QBwc10GizFo1hfaqKpIPTnP8A9euZWQ5xnitbTJWY7FbkKTjHvTrRvEzex1kcEZ5LHBHXNSC2j7M351mRXnAQycqORirIuzgfP+lcPKyFHuWlZV6MPzqRJUyPmXr61nLKvPzfpTHmUA7W5xxxT5TRGw02OFKkGoZ+YmPesyK5lx9/nPoKf9onL7S3H0FNKzByNSN82AiJH9etTw30lqBtCcDb81ZqORECTzT94dQCc10rYDU/tuf+7D+R/wAaKytq+lFAFrx9MDBaAt1WXt7LXm5612vjufetiAW6Sf8AstcSTzWlJWiaVXeRYsZfKvI3zjGecexrq4pPP0oNncD36fxVxTMRyCQfaur0aTdo8Ckkn5uv+8aistmEH0L9kdp2+gP86r3vc/7dSwHbcsP9n/Cob0/Jn/a/xrBblvYw9R6x/j/SsetXUmwY/wAf6VlV1w2MZbiGkpc0lWIbzRjnmlJqaC1kuCoQqN3TJouluBEDilzWtB4du5DnzIMEZ5J/wrYtdCEYHmx274XB+XPP5VlKrFFKDZzEMEs27YucdeRWpp+lOt3G93DhVdTnd2zz0NdBJHZ22MWsa7v7sYFR6hdw20W4xn7rH5QO1R7VvRIvkS3NaFraO0kW3OAcnv1x71x/iNQ1ysjjJEYGfxNMGuK0yBGnVSQCM4zz9aTVn+1WjzrnCgL83Xr/APXppNNXJ6GONuOK0dIbFy3P8B/mKykbovetDTW2XDE/3D/MVpP4WZvY6CCB/MLsvysODmpDuBwO1WI5F+yxHH8I/lSsgYAgAZrhb1M+ZlQPjvVeaVt42HjHpVoxEelNa0cgj5M4pplJsjhmAX5m+bPHFSGVt24N+lMW0dPvFSetTLCdo6U7oLl62zLbr3Y5/nTosGZk7jORRYusDR7xlRnIH41dujCYVeKMIzHJIUAmto7FkOwelFQ7m/vH86KdgMPxPdC4Fry527/vfhXOk1JNL5mPlxj3qAnmtoqysNu7uDH5a6bQ3/0OBOf4v5muYPSt/Qmx9nXH97+tRW+EcNzZj/4/5B/s/wCFR33EY/3v8alkl8uQnGe3WrVrd7mxs/h9a50jRs4zVH+aPr1b+lUUQvnBHHrXeSN5kjcYwTTc7fetFVsrWJ5L6nIR6TPKPlePrjkn/CrUPh+6LAl4CAeeT/hW3Lf+Vn91nAz96s+48ReWdn2XOV6+Z/8AWo56kth8sUXLfR402+ZDbtjOflz/AEq2ILS2X/j2iBX+7GK5a41X7QrDydu7H8Wf6Vnu29y2MZpqlJ7sXOlsdhPrlnbDHkyjB2/Ko/xrNuNeWQHymuE+bPXHH51gZpCatUYoTmzV025uJ/N82eR9uMb3Jx1qC7e5wolndwc8Fyafo7bfO4z93+tTazHt8nnP3v6UbTsH2TLAA5AwRV0MW0WfJJ+cdfqKojrV22fMZix945zVy2JRlA4lq5ay7HJ56dqhul23rjPp/Kmj0p7ok7m1+azgY8gxqefpU6iodMGbG2/64r/IVYc7SBXnSWpHKR7PXFIzgHaQcnoaNme9Q7PMYc47UhWaJAMfe5qdWj8oHZ+lV/suGA39fap1t9g+9nHtQOzF3qTwMU8lioyxI7DNIItw+9+lKRxt9K6KexcdhKKNvvRVlHAE03uKDR15roJF6sBW3oxxNAP97+RrDB+YGtnSDm4h/wCBfyNZ1fhLjual7F5sh5xyO3tWd/agtJGXyt+35fvY/pV+/laNcgD7wHP0rmrli0jk92JqKSutRzLsur+axP2fHJ/j/wDrVQlk8zHGMe9MFJWqilsTcKKKKoQlFLSUAJRRRigCSKbys/LnPvXRTBbm3lCMCQh4HOeK5nFa2mXLlpBheSP61nUXVFRfQoSRPCwVlYHGeRirekyCLUoZTj5d3BOOxrRuLOO5RpXZgyqQADx61lqghuUC5PGefxoUuZA1ZkuszJNczuGXJ28Zz2FZC8mpL2Qm8ccdv5VGh+b8KqKsiXqdVp8q+REuRkRjv7CtSN1x94fnWBpXzuAf+ef+Fau0CuKorSIdi2QD0NNU4U5qsbl06BeaiF3IykELzx0qLCvc0FdR0YHn1oe5yShTA/vZqgrlRxj1p3ms5wQOfSiw0zU09v8ATY2X5jzwPoat3KEliwK5buKoaQxXUYcf7X8jW7qECi2WTJyzDP5GtqexaMnYP71FP2D3orQZ/9n/4gKwSUNDX1BST0ZJTEUAAQEAAAKgbGNtcwQwAABtbnRyUkdCIFhZWiAH5AABAAEAEgAWABNhY3NwQVBQTAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA9tYAAQAAAADTLWxjbXMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA1kZXNjAAABIAAAAEBjcHJ0AAABYAAAADZ3dHB0AAABmAAAABRjaGFkAAABrAAAACxyWFlaAAAB2AAAABRiWFlaAAAB7AAAABRnWFlaAAACAAAAABRyVFJDAAACFAAAACBnVFJDAAACFAAAACBiVFJDAAACFAAAACBjaHJtAAACNAAAACRkbW5kAAACWAAAACRkbWRkAAACfAAAACRtbHVjAAAAAAAAAAEAAAAMZW5VUwAAACQAAAAcAEcASQBNAFAAIABiAHUAaQBsAHQALQBpAG4AIABzAFIARwBCbWx1YwAAAAAAAAABAAAADGVuVVMAAAAaAAAAHABQAHUAYgBsAGkAYwAgAEQAbwBtAGEAaQBuAABYWVogAAAAAAAA9tYAAQAAAADTLXNmMzIAAAAAAAEMQgAABd7///MlAAAHkwAA/ZD///uh///9ogAAA9wAAMBuWFlaIAAAAAAAAG+gAAA49QAAA5BYWVogAAAAAAAAJJ8AAA+EAAC2xFhZWiAAAAAAAABilwAAt4cAABjZcGFyYQAAAAAAAwAAAAJmZgAA8qcAAA1ZAAAT0AAACltjaHJtAAAAAAADAAAAAKPXAABUfAAATM0AAJmaAAAmZwAAD1xtbHVjAAAAAAAAAAEAAAAMZW5VUwAAAAgAAAAcAEcASQBNAFBtbHVjAAAAAAAAAAEAAAAMZW5VUwAAAAgAAAAcAHMAUgBHAEL/2wBDAAMCAgMCAgMDAwMEAwMEBQgFBQQEBQoHBwYIDAoMDAsKCwsNDhIQDQ4RDgsLEBYQERMUFRUVDA8XGBYUGBIUFRT/2wBDAQMEBAUEBQkFBQkUDQsNFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBT/wgARCABEADwDAREAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAABQECBAYHAwAI/8QAGgEAAgMBAQAAAAAAAAAAAAAAAwQAAQIFBv/aAAwDAQACEAMQAAAB+jGl/XOUy+Wkp1RsjZbtXmZEs3LzNBU6en22ktJTZOU1REnwCHYdoev9nzfKUkgcepZLoPM7NMX6HOxbn1/OevAce6KG6uPSCcQHU5bWuTvHi3lmbGZsYJmDlmSBqGZOPhVuKm6ySZlFh240Xs9VOsmcWtABTBZL1ZibdhVLWWR9KnrowmsSBCzxsoahgOwbGElGlySlwGlc2dw3/8QAIxAAAQQCAwABBQAAAAAAAAAAAwABAgQFEQYSExAUFSIxMv/aAAgBAQABBQJk69Irstra2tpnXL80anJrhbEsVmzQOtrfx6LNtB8jC1XZGmO2PszN22v0o5cEmaTLOShK4EVKmatTrDPAvYXospYcYonmybN5nvazFm47U2mLpCqY3LmCSvzJiSJyQeUTWH1Xi8IU8nu6xbVRrB/EhW2SNWWqceh4yhFVJkq28t+UKfJwOLMZJsjauTecKVx5ga3Feonctr0yl8MzV/t3VQ+jFK1cmYeJ0SmwG7d1D+8kSTWPgz6Hh5OwmPJ1KLdP/8QAJBEAAQMDBAIDAQAAAAAAAAAAAQACEQMSIQQQMVEgIgUTMkH/2gAIAQMBAT8B2uHfnqGveLWpnx1UntUdNqaP748tMAeU23+KtBbHhChaVgsBKa1jDKNNmSnNhxChAbQtOSDCAVV1gUFQUAdvrlaeGm0olzMBVsxK4x4N9Tan9puoBHsqr7zhO7WZ5U4ztMulOzgKzteqKcDKB2Cfzue0VO3/xAAiEQACAQMEAwEBAAAAAAAAAAAAAQIDERIQEyExBCBBIjL/2gAIAQIBAT8B0t7wlGPLJeZGw68J9e1d8juQun6ZIueRL9F3IyfQnwXJvTORVlmJi/TNxI3EOaem4k7NFRZLKJwyH1om/pcjLnSqskplLuxKi/hThiuSC7gyeUZWFdPRr8WKbxd2bt/5Q9xq74IxtZnkcTMhD6KSWOsfsTye0WI9H//EAC8QAAIBAwIEAwYHAAAAAAAAAAECAAMRIRIxBBNBcRAiYRQgI0JRgSRSYoKRocH/2gAIAQEABj8C8Lahfv79Lh6N1LZLCavMtQSkvENppOdNn95Ga3lpde88rp9oU1A9fcuCbdvB1qkhNIzec5qm+2rrOctXfIzvEJ6jwA3Bve8xTsDnE1ajbfKi0/E0kGkXJVc2gcFmU9LXtKQYl+pHpNHs50jqDH5lLlqNs3vERLrY3NxMpEqrUWori4F9/SVuHroA1RbIf8mmifh/QyhznvUrnJjDWpI6A7TLgQFnB6YhBXI9ZX4Jz1I/cIlYYfDXmniVZX/MouDA9O4RBZbyjxa+XV5WPqIp5zrjYMZ+sZvCWp6yTe941YfNV1f3NKDUdt58atTpfe5gCh67E7tgSpSsERMqo6TsbT5ptaL3jAEgenitcYdlN/4j9/Ckfqgn/8QAJRAAAgECBQQDAQAAAAAAAAAAAREAITFBUWFxgRChscGR0fDh/9oACAEBAAE/IapRFHhOg/SY3dPdDHhl4io24l8iE4ABDibIwymHpqxg3y+Eyl8nGDJhFagioMRDSIUJDElATRwji1jUJgYgGSvuDiNbG+DhIWcMS2YRkqhPaDJByAHRogYsIQKh2gyCfMm0SSjQCoLwUuWE+CAucTQoDYIJUxoRaZuvOXBRUzYZEW9iGM47W0gjeyCDVbK/1BivB9WBeUT1MAaju5GWAw2H3EpdnctYARyNgxPeQWGv76ggMSJ0Ro+kVhiDeOQKAAuDBIFiv8AibE2FbuEAKUP1bxH/ALkjzA0jVRMYClnLHGP1T4RhEYCUTOErQyPYRLqAxfF4Y5jo419xkxfbv7hp11RkFZrrCkTVdQSe/TGGoxCWMrE2aZUKqkz2KM//2gAMAwEAAgADAAAAEFNK+jFun+IQSa6lwqfV3Z01o7nzl+BRZcY6tf/EACIRAQEBAAICAQQDAAAAAAAAAAEAESExEEFRIGGBobHR8P/aAAgBAwEBPxDbblztbNz42y2ih7T+LRgh/wB7k80/f9O27DnIG6nzt8D+wW5anAvcXxC+BEuOW5cnSBNX9bbXPPqWdb4onLJCAj3NI8vX9XwhbKXLYrRc+i1eywtq70HdhDzCL0Jbhktjh4P5q4I4dgjLhzLVMkaJI5NuxLOPgup7s85haR1f/8QAIhEBAAICAgIBBQAAAAAAAAAAAQARITEQQVFh8CBxkbHB/9oACAECAQE/EAlS264riuUNrCUMRuhTL5uXGBU8koWDj6KkRqRNUqpdQ0MpMZU3Bi7fxAoVmCY7lcZiNQqHdCaJb5iwrHy4Q9W4GRhwDBMp5T7ZjgS1gC/BjpPWoJvWYPtA9D9QqLiEKzUpb8QLHE6FZaID1lgZt3/Zaw7IKKwZvjCpxuZq6IM0TFjP/8QAIRABAAICAgIDAQEAAAAAAAAAAQARITFBUXGRYYGxocH/2gAIAQEAAT8Qybl8LlLkmsV+pY0se5zBpuKZti129wBzDnqmKQuz/T5+GPbytv3Bp6p+43XRxYb+kcUuR7i5NQ5GC6Zd5uWVEFirFOAFX1UulUdNV5DUNg4rNnQN7JYjdAmghQoLV4I2BsiXpwKdOy5zEd0iwTJBWsn3GUYT+htyKfOoBYbYDvSvDhxZLwBve7QzKWr7jR7GYaCfWeYqZQEBzQ2x1XFQfQy6DANGFlJrvuXvKxNDdbLT+9MDGQaHiwXTe73ANrSSnIAZe95iK3gKAoArOh3pI000XuTVYfjSxtd3BFQ95THw5qZsJxjQ6HFaripgg2VEZwA1vaijcssLFkbLrdrrYiuJXKKTU9COvMprN4dID5GvMZBHTPAJdZZGASENFQbRMf7DJwTsEafI17RUAUM9diMQDRdIi11UHziB1N7qzSj5B+puRPBnNDaeqT5gYJGLIbU4z+E6ZV6FWe1/SHUMDoGMXQLP04gFaiuyWw2fMVauOO01VRnOwHza9NS3ISTQzNpwLFbp1v8AL9+4yyztZaumVdMTrDVMAC7EVgNQDCW3PQP1G0S8MFFCIU6pUFtq6bpStY55jjc0/YqcWVD7A5cxgoEURwjEUsR2Atdo0zD6BSsKjf4Q1LFwFOfMwttbOaTnwT//2Q==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/count.js": []byte(`// GoatCounter: https://www.goatcounter.com
-// This file (and *only* this file) is released under the ISC license:
-// https://opensource.org/licenses/ISC
-(function() {
-	'use strict';
+	"db/migrate/sqlite/2020-09-16-1-campaign.sql": []byte(`begin;
+	alter table hits add column utm_source   varchar not null default '';
+	alter table hits add column utm_medium   varchar not null default '';
+	alter table hits add column utm_campaign varchar not null default '';
 
-	if (window.goatcounter && window.goatcounter.vars)  // Compatibility
-		window.goatcounter = window.goatcounter.vars
-	else
-		window.goatcounter = window.goatcounter || {}
+	create table campaign_stats (
+		site           integer        not null                 check(site > 0),
 
-	// Get all data we're going to send off to the counter endpoint.
-	var get_data = function(vars) {
-		var data = {
-			p: (vars.path     === undefined ? goatcounter.path     : vars.path),
-			r: (vars.referrer === undefined ? goatcounter.referrer : vars.referrer),
-			t: (vars.title    === undefined ? goatcounter.title    : vars.title),
-			e: !!(vars.event || goatcounter.event),
-			s: [window.screen.width, window.screen.height, (window.devicePixelRatio || 1)],
-			b: is_bot(),
-			q: location.search,
-		}
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		source         varchar        not null,
+		medium         varchar        not null,
+		campaign       varchar        not null,
+		count          int            not null,
+		count_unique   int            not null,
 
-		var rcb, pcb, tcb  // Save callbacks to apply later.
-		if (typeof(data.r) === 'function') rcb = data.r
-		if (typeof(data.t) === 'function') tcb = data.t
-		if (typeof(data.p) === 'function') pcb = data.p
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
 
-		if (is_empty(data.r)) data.r = document.referrer
-		if (is_empty(data.t)) data.t = document.title
-		if (is_empty(data.p)) {
-			var loc = location,
-			    c = document.querySelector('link[rel="canonical"][href]')
-			if (c) {  // May be relative or point to different domain.
-				var a = document.createElement('a')
-				a.href = c.href
-				if (a.hostname.replace(/^www\./, '') === location.hostname.replace(/^www\./, ''))
-					loc = a
-			}
-			data.p = (loc.pathname + loc.search) || '/'
-		}
+	insert into version values('2020-09-16-1-campaign');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-18-1-audit-log.sql": []byte(`begin;
+	create table audit_log (
+		audit_log_id   integer        primary key autoincrement,
+		site           integer        not null,
+		user_id        integer,
+
+		action         varchar        not null,
+		info           varchar        not null default '',
+		created_at     timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
 
-		if (rcb) data.r = rcb(data.r)
-		if (tcb) data.t = tcb(data.t)
-		if (pcb) data.p = pcb(data.p)
-		return data
-	}
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "audit_log#site#created_at" on audit_log(site, created_at);
 
-	// Check if a value is "empty" for the purpose of get_data().
-	var is_empty = function(v) { return v === null || v === undefined || typeof(v) === 'function' }
+	insert into version values('2020-09-18-1-audit-log');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-20-1-device-class.sql": []byte(`begin;
+	alter table size_stats add column device_class varchar not null default '';
+	update size_stats set device_class = case
+		when width = 0     then ''
+		when width < 600   then 'phone'
+		when width <= 1024 then 'tablet'
+		when width <= 1920 then 'desktop'
+		else 'large'
+	end;
+
+	drop index "size_stats#site#day#width";
+	create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+
+	insert into version values('2020-09-20-1-device-class');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-22-1-email-queue.sql": []byte(`begin;
+	create table email_queue (
+		email_id        integer        primary key autoincrement,
+		site            integer,
+
+		subject         varchar        not null,
+		from_name       varchar        not null,
+		to_addr         varchar        not null,
+		body            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+		sent_at         timestamp                  check(sent_at is null or sent_at = strftime('%Y-%m-%d %H:%M:%S', sent_at)),
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+	);
+	create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+	create index "email_queue#created_at" on email_queue(created_at);
 
-	// See if this looks like a bot; there is some additional filtering on the
-	// backend, but these properties can't be fetched from there.
-	var is_bot = function() {
-		// Headless browsers are probably a bot.
-		var w = window, d = document
-		if (w.callPhantom || w._phantom || w.phantom)
-			return 150
-		if (w.__nightmare)
-			return 151
-		if (d.__selenium_unwrapped || d.__webdriver_evaluate || d.__driver_evaluate)
-			return 152
-		if (navigator.webdriver)
-			return 153
-		return 0
-	}
+	insert into version values('2020-09-22-1-email-queue');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-24-1-client-hints.sql": []byte(`begin;
+	alter table hits add column ua_brands           varchar not null default '';
+	alter table hits add column ua_platform         varchar not null default '';
+	alter table hits add column ua_platform_version varchar not null default '';
 
-	// Object to urlencoded string, starting with a ?.
-	var urlencode = function(obj) {
-		var p = []
-		for (var k in obj)
-			if (obj[k] !== '' && obj[k] !== null && obj[k] !== undefined && obj[k] !== false)
-				p.push(encodeURIComponent(k) + '=' + encodeURIComponent(obj[k]))
-		return '?' + p.join('&')
-	}
+	insert into version values('2020-09-24-1-client-hints');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-26-1-scroll-stats.sql": []byte(`begin;
+	create table scroll_stats (
+		site           integer        not null                 check(site > 0),
 
-	// Get the endpoint to send requests to.
-	var get_endpoint = function() {
-		var s = document.querySelector('script[data-goatcounter]');
-		if (s && s.dataset.goatcounter)
-			return s.dataset.goatcounter
-		return (goatcounter.endpoint || window.counter)  // counter is for compat; don't use.
-	}
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		path           varchar        not null,
+		count          int            not null,
+		total          int            not null,
+		reached_25     int            not null,
+		reached_50     int            not null,
+		reached_75     int            not null,
+		reached_100    int            not null,
 
-	// Filter some requests that we (probably) don't want to count.
-	goatcounter.filter = function() {
-		if ('visibilityState' in document && (document.visibilityState === 'prerender' || document.visibilityState === 'hidden'))
-			return 'visibilityState'
-		if (!goatcounter.allow_frame && location !== parent.location)
-			return 'frame'
-		if (!goatcounter.allow_local && location.hostname.match(/(localhost$|^127\.|^10\.|^172\.(1[6-9]|2[0-9]|3[0-1])\.|^192\.168\.)/))
-			return 'local'
-		if (localStorage && localStorage.getItem('skipgc') === 't')
-			return 'disabled with #toggle-goatcounter'
-		return false
-	}
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
 
-	// Get URL to send to GoatCounter.
-	window.goatcounter.url = function(vars) {
-		var data = get_data(vars || {})
-		if (data.p === null)  // null from user callback.
-			return
-		data.rnd = Math.random().toString(36).substr(2, 5)  // Browsers don't always listen to Cache-Control.
+	insert into version values('2020-09-26-1-scroll-stats');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-28-1-notifications.sql": []byte(`begin;
+	create table notifications (
+		notification_id integer        primary key autoincrement,
+		site            integer        not null,
 
-		var endpoint = get_endpoint()
-		if (!endpoint) {
-			if (console && 'warn' in console)
-				console.warn('goatcounter: no endpoint found')
-			return
-		}
+		kind            varchar        not null,
+		message         varchar        not null,
+		url             varchar        not null default '',
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		read_at         timestamp,
 
-		return endpoint + urlencode(data)
-	}
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "notifications#site#created_at" on notifications(site, created_at);
 
-	// Count a hit.
-	window.goatcounter.count = function(vars) {
-		var f = goatcounter.filter()
-		if (f) {
-			if (console && 'log' in console)
-				console.warn('goatcounter: not counting because of: ' + f)
-			return
-		}
+	insert into version values('2020-09-28-1-notifications');
+commit;
+`),
+	"db/migrate/sqlite/2020-09-30-1-jobs.sql": []byte(`begin;
+	create table jobs (
+		job_id          integer        primary key autoincrement,
+		site            integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		progress        integer        not null default 0,
+		total           integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+		finished_at     timestamp                  check(finished_at = strftime('%Y-%m-%d %H:%M:%S', finished_at)),
+		updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
 
-		var url = goatcounter.url(vars)
-		if (!url) {
-			if (console && 'log' in console)
-				console.warn('goatcounter: not counting because path callback returned null')
-			return
-		}
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "jobs#site#created_at" on jobs(site, created_at);
 
-		var img = document.createElement('img')
-		img.src = url
-		img.style.position = 'absolute'  // Affect layout less.
-		img.setAttribute('alt', '')
-		img.setAttribute('aria-hidden', 'true')
+	insert into version values('2020-09-30-1-jobs');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-02-1-export-kind.sql": []byte(`begin;
+	alter table exports add column kind   varchar not null default 'hits';
+	alter table exports add column format varchar not null default 'csv';
 
-		var rm = function() { if (img && img.parentNode) img.parentNode.removeChild(img) }
-		setTimeout(rm, 3000)  // In case the onload isn't triggered.
-		img.addEventListener('load', rm, false)
-		document.body.appendChild(img)
-	}
+	insert into version values('2020-10-02-1-export-kind');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-04-1-export-scheduled.sql": []byte(`begin;
+	alter table exports add column scheduled int not null default 0;
 
-	// Get a query parameter.
-	window.goatcounter.get_query = function(name) {
-		var s = location.search.substr(1).split('&')
-		for (var i = 0; i < s.length; i++)
-			if (s[i].toLowerCase().indexOf(name.toLowerCase() + '=') === 0)
-				return s[i].substr(name.length + 1)
-	}
+	insert into version values('2020-10-04-1-export-scheduled');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-06-1-no-data.sql": []byte(`begin;
+	alter table sites add column no_data_sent_at timestamp null;
 
-	// Track click events.
-	window.goatcounter.bind_events = function() {
-		if (!document.querySelectorAll)  // Just in case someone uses an ancient browser.
-			return
+	insert into version values('2020-10-06-1-no-data');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-08-1-first-hit.sql": []byte(`begin;
+	alter table sites add column first_hit varchar null;
 
-		var send = function(elem) {
-			return function() {
-				goatcounter.count({
-					event:    true,
-					path:     (elem.dataset.goatcounterClick || elem.name || elem.id || ''),
-					title:    (elem.dataset.goatcounterTitle || elem.title || (elem.innerHTML || '').substr(0, 200) || ''),
-					referrer: (elem.dataset.goatcounterReferrer || elem.dataset.goatcounterReferral || ''),
-				})
-			}
-		}
+	insert into version values('2020-10-08-1-first-hit');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-10-1-export-dates.sql": []byte(`begin;
+	alter table exports add column start_date timestamp null;
+	alter table exports add column end_date timestamp null;
 
-		Array.prototype.slice.call(document.querySelectorAll("*[data-goatcounter-click]")).forEach(function(elem) {
-			if (elem.dataset.goatcounterBound)
-				return
-			var f = send(elem)
-			elem.addEventListener('click', f, false)
-			elem.addEventListener('auxclick', f, false)  // Middle click.
-			elem.dataset.goatcounterBound = 'true'
-		})
-	}
+	insert into version values('2020-10-10-1-export-dates');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-12-1-export-paths.sql": []byte(`begin;
+	alter table exports add column path_like varchar null;
+	alter table exports add column paths varchar null;
 
-	// Make it easy to skip your own views.
-	if (location.hash === '#toggle-goatcounter')
-		if (localStorage.getItem('skipgc') === 't') {
-			localStorage.removeItem('skipgc', 't')
-			alert('GoatCounter tracking is now ENABLED in this browser.')
-		}
-		else {
-			localStorage.setItem('skipgc', 't')
-			alert('GoatCounter tracking is now DISABLED in this browser until ' + location + ' is loaded again.')
-		}
+	insert into version values('2020-10-12-1-export-paths');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-14-1-export-progress.sql": []byte(`begin;
+	alter table exports add column max_hit_id integer null;
 
-	if (!goatcounter.no_onload) {
-		var go = function() {
-			goatcounter.count()
-			if (!goatcounter.no_events)
-				goatcounter.bind_events()
-		}
+	insert into version values('2020-10-14-1-export-progress');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-16-1-export-encrypted.sql": []byte(`begin;
+	alter table exports add column encrypted int not null default 0;
 
-		if (document.body === null)
-			document.addEventListener('DOMContentLoaded', function() { go() }, false)
-		else
-			go()
-	}
-})();
+	insert into version values('2020-10-16-1-export-encrypted');
+commit;
 `),
-	"public/favicon/android-chrome-192x192.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAMAAAADACAMAAABlApw1AAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAC91BMVEUAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///+6n5FbAAAA+3RSTlMAAAEMHz1ZdJKwxdLf6/b5+vv87OLZy7acg2hJKxcIBx1DcJi6+P7x482xi185GwMagbPb9P3wfEggBiif1d2saS4KHlqm6Ld2Nw5CldjuwoI6GV5gHAJ3z4kJInrW7bJdFcpruXLTeCG4cdBlERjOu0xN9/KjNn3moKEsk/PX3OoPb71YJyYtO09kf74478hqYY3kdRC00VX1rU4qR8fhkDU8lBKdQVer6cZFhTMNBVwUedrAJYgvBIqZlxYp5aUL5z4wVIZizI4T1ErDqoDJY8RWUlMjP56WZnM0mrXej4eiW4xGm6+kkSSEbERuQFB7MeDBvL+oS6kyfoqce0QAAAABYktHRPw8DqN/AAAAB3RJTUUH5AEYEwUuVg1BVwAADj9JREFUGBnVwXuczXX+wPH360zkOnPOQSpmRpdzxrUzMUcZl5iRaoqQOcy0RDe3hhaJikxEJbOYKLmlJJSZFJmKsKuLpLKllKL69Wu7/VZbtm1/739+v8fqNvP9fma+Z875Tp3nU6qHJ+mkevVPbtCwUeMmTZNTvD5/crPmLU5peeppp7dqnZoG8vsF6W3OOPOss1s0CwTVyp/Rtl37Dh07nZMO8vtDKLPVuZ27ZGkNwl1bNDzv/G7ZIL8jZHfv2KNnsjqV3OuC3n1yQH4XCOX2vbC5X6MT7nfRxZfkgfzGIOnSy/p7tTaCAy4fOCgH5LeDZ/AVLTM0BgM6D8mPIL8NPN2GDvNqjHw9CzoVgtQ5PFf+YXhY42HEVSNTQeoUjDq5f1jjxXv1NZkgdQf6XOvXePINuy7Xg9QV+rTTePNfP3qMB6kTjBqrLgj3Py8XxH0wzq+uCI+/oQjEbUyYqG5JufGPhSDuYtJkdc+Um6aGEFdxs7pqWsEYEBdxqrorOL5vIYhbYLq6LeuWWz2IS+A2rVEwMGPAtF4zh/ccfnvbWTNSwhqtLr2LQdzBHVqj8Ow5fe4ck39OZma3ufPuuvue+T3ubZHh1ygEGi6IIK5gUInWKPCnhYNBfgSRnPxOixbfW+pVx0rvWwLiApKWqgP++0/u5kF+BU9S9wcuu3+ZOuR9sA+IC1jeVZ3wzzx3RQiphEjqyoJVXYPqSIuRaUj8kd3Br44EVz+0JhukEjxFDw+cGFAnZjzSBiTuWPuoXx3q12NdIUhleFIfW78hqDULbnw8hMQda58oUadKHlyeB1IZpG0aON6rNdtwcRkSd5TfvcqvTmWd/eRmkCrwDL6hUYrWKGX6SSDxBplPPR1UpwKrFuZ7kCpgc8ctAa1JsNHDHiTu8Gx9YrU65mv81NYIUgUU1b8xWWuyelE6En9E5j00TR0L3/7MtgqkCiie08inNZjx7GaQ+KNizXP91LFg6fOD0kEqg+3j+ge1ev72O0BcQPnUR15Q55rtXLkLpDI8Wzvs1hq0+zOIG6j4y+LVQXVsSuc9RSCVUbFgZ5ZWr8ljIcQVhFq/2CuojiVveSkVpBIorD8+qNUacV0h4g4ic+f3D6tj3pfvmABSCYx5qESrlfzKXsQleK4c/apfHfNvPD+CVEb5vtf8Wh3//jEgLsGTO26iTx3rNySCVAYTXt+g1doyD8QtkLnwDa861W8SSBWEzmgU1Oq06wTiGtj+5oEUdejqfKQqyG8wQ6vTfyWIe2DvyNnJ6kjwZBAL0vvO1Oo0/2sEcREseat9hjpxoAixglv3p2g1Zt1QjrgJdj1+U1OtWbNNiB2KhzbVamTcl464CrIXPNJWa+J7G7FFaHlPrcaymwsRl1Ex78Wnw1qt8CLEHsxbGlaz5NNyELcROTh0vE+r4e+LmDBqcZaaBQqSENdB5umNAmqU9Q5iRPqbzdUs5ZU8xH2wt/7YFDXoeQ5ihmfdMDXzPrIEqQPwQIYaNIgg1YDWY4Nq5DurCHEdTB2uBqVrkGpB5rteNfIfKkbcxtxVauAdGkFqQN7AKWrka1CGuAoyl6pBsEceUiOy3+unRt5XkhA3UdwjqAZLMxEHiNzdS41Snt2FuIfCZ31qsGouiBOw7no1CryYg7iF0PvJajBzKogzcHiVGk2en464A88VJWpQej6IU9D9bDVa9n4F4gZoVaoGA0Z6EOcgd31YTTLeiyDxB5fMVIPk60JINGB7D7+aNO3rQeIN7vyTGvieSUOixN4PfGrywnKQ+ILMD9Ug+G4xEjWWPOJVkyNngMQVS25Rk86DkVog72iKmvRcAxJHFHbwqUGjrSC1QVJBQE02zgWJGyruS1aD4WtAaoddTwTUZGkmSJzg6ViiBm1XgtQWOR+lqMnHRUh8wL4X1GDEJyC1x64OXjXwLc5B4gEW9FeDZeNCSCwoO+pVg8mjK5DYQfc31MD7URoSG/Ju86lB15EgsYJzWqpB8NFiJFYsed6vBqvXgcSIoo/VpP0EkJixdnpQDdqtAIkJhf/lU4MDW0FiB6kXqEnnVCQWVFw8WQ16HgaJB8i9SA2Ch3YhtYdnUVc1aP4pSHzA3MvVIDA0hNQW/HGaGozoCxIv0OdlNRhQH6R2oFMLNVh2RwiJH1gwUw2O1AOpDdhxtRp4n0hH4glWtlWDN+aC1AL5Y9Ug+N95SHxB3xFq8NkoJHqs/ZuaNEwFiTNCd2SpvfDnhUi0yHnFrwavfQESd6R/6VV7yV95kOhQMXqyGry6CcQFlD0XVHsvfA0SDTwvdVWD1V+DuAFSO6vB1QeRKMCk3WrQbA6IO2BHOzXYmYc4Bnc9rQZZ/xNB3AJ/X632vH8IIQ7BilPUwPtlOuIeeLKr2mt2DMQZ2lyrBsEPyhA3UfFNitrrOQ/ECdbuVJPPUhF3kfRBUO1dNQpxgJyjfjW4/CDiNgZfpPbCBdlIjSh/KqAG1/8DxHXcOl7tTbkCpAZ43sxQg2/PAHEffN1W7fU6DFItuHu3GjR7DKQuwKIMtbd0M1IdeLiLGmR9FUHqBuUD/WrLP7ACMYPWL6uBd2A6UlcoWq/2RiwHMSL3RjUIn1WG1B26D1N7E7ciJuy9UE0e3I7UIdi3Qe3dsguxR87nfjX47kqkThF5P6C2Al+B2KH8m4Aa3P8XkLpF0rtqr/ldIFZ4bshQg28vBalrnHRA7c2egFjAsQ1q0PQBkDoH9ZqrrfCLFUgVUO92NZhxTQT5DcA9WWprxD6QytjRTg1Szs1GfhOkHw2qrVVtkEoo3q8G4eeTEKeg+JKR466rf7gMJHYMnq32jpYjvwKLUtTgglGIQ7C393cDwqrhZseHLEFiBnc1V1td3wb5BUu2qME/xyAOwZqzffqjlPa3gsQKvgqorYkHkV8wqETtjd8G4gy8M1x/ZdhhkFix6xa193w68jNOV3tN1oE4xJ3ttJIDuUjM2DpRbU3ZA/ITTlNbs/aAOET5WVpFQQiJFSwfobZOyUV+wjNqZ0bvCOIUnZpqFc1bIzGjYqBfbXWoQH7ExWojZX424hS8rhajQWLG5g/VVrOVICewPKAW4cuSEMdIG6sWG9ciMYM1TdTWllTkBMa0UIv1oxDn2N5YLbImIbGDawJqJ3yeB/kPPIu1qnu7gThHmxZq1TAJiR1l36utI62RE1gxUysb1gckCmxvrFbLPgGJHX1aqK2bQf4D+g7QX+vyDkg0SPtQbVzfHYkdvJmsdr7LQ04g9N4G/cXMr0GiAgPVzvq9SOzIma52mrRBfkTo/NdS9ISs/dtAosSfS9RGuEESEju6v6o2Sr9AfgKjOq7v3zRjw/hH/5UEEi3yjqudlMVlILGCIclqVboV+QVk5/c53Hp7CCR6cGZQ7XgPbQeJFd3bqtXqk5C44c5eaivceQVIbOAlv1o1KkbiBk+BGjR+OxuJCYXt1UYBSPywopcaZBw9CSQGrJmlVhl/R+IIzlWT8PgheSC1Ba+rjdl5SDwxZrwaTR67PAekdhh1tVoFzwSJJ/gkS81m/HBGDkhtsDxZrZqvQOKLtOe0Ol0bvrUWJGqETlUbN5UjccbBdlqt5Eajt6WDRIetR9QqsAeJN2i1W6sX3L3+yfwIEgVYGFarYROQuMPz3hStiXf4Q/86JwTiEElj1caLIPFH+espWrPk4dOH7CgEcYK7RqjVgEGIGyhr4FMnfKUfDr20TTZIDeAjtXHRLsQVFD/kVWeCWU+3nz/pixwPUg0GD1OrcG8Qd1D2bECdC6w+/vnplx4si4DYYk9ArY5sRdzCrvkZGpVw1urX3r3v7TXdikMglVF+k9o4NYS4huwrVmv0Ukp6bdxf0PvYgrkTdoVATmBFW7VKnoS4CM87L2stBQMZL1y/Zf1t87/q22pB6y9yRz0VVKs3RiFugm80Zv7krk1LuzRupjbmg7iJou/UTbMOI26CY8nqpvaFiJtI/17d5H8JxE1MnaVuajEGcRN8pK66LIK4ifxX1U0zViJugo4+ddOBIsRN5FylrhoN4iYGjVAr3/HGXo2LadsQN0EHtdH/4OC+P0wLauz2ZyNuIne42igA0vu8v7TUp7HxfoK4CV7yq9WsqYgIpHXv2KNnssbg1XzETRRepTYuSENOIJS575nX+vm0lhZ7EDdxeINapcwB+Rme4nlzOhxvHtDobbgEcRN8ozYmTkAqg11bl89v33OET6NyWzniJooaqY3XQawgffCmx+Z/f8ruyerQxm6Im+DxGWrV79+ICYTW7ti3sMMFG5uUeLUGp2wCcROez9XG9+lI9SC0pNsle8589m9nT1w9YJlf7SzbuQPEVeT3VKvAAyCOQKRw1JhNK+cs/N/Pd7b85xuNu5Q2LZmRHPD6UrKO/HAsCXEX1Peq1bBUJFoQyU4qSm1zsPXUdfuO7Znz1qVj0kFcRvmFauMjkMTA1iZqVVIPJCHASJ9azS5DEgOh6WrjOpDEQG4LtSqdhyQGeDtFrX7IRhIDnkNq5X0SJDEwobFaPd0NSRC0WqZWt4SQxAAd1Mp/BUhiYEkjtfp2LpIg+McstVqfjSQGeDOsFsHTQRIDkVPVato8JEGwuZ1afViIJAj+3Uyt7kMSBZ/41CKjHpIgoECt7k9FEgRpLdVqegRJEGQOV6trkETBtqZqMeUdJFHQarJadGmDJAp6B9XiokIkQcCXanUIJEHguUytTkYSBaGP1SL8VyRRkP2ZWkw+H0kUpF2rFhn1kERB9mdq0W8ekijwHFKLLvlIooB7wlpV+zQkYbDjW60ifA2SOIi8olWMz0USCF8M00pmjARJIPDpt/orgdPSkYQCnw7TnzX7JgdJMHBwcRO//r9gyVWfViCJh4o+49YfP96yYGUZUjf+DydfdURXdgyEAAAAJXRFWHRkYXRlOmNyZWF0ZQAyMDIwLTAxLTI0VDE5OjA1OjQ2KzAxOjAweOZGlQAAACV0RVh0ZGF0ZTptb2RpZnkAMjAyMC0wMS0yNFQxOTowNTo0NiswMTowMAm7/ikAAABXelRYdFJhdyBwcm9maWxlIHR5cGUgaXB0YwAAeJzj8gwIcVYoKMpPy8xJ5VIAAyMLLmMLEyMTS5MUAxMgRIA0w2QDI7NUIMvY1MjEzMQcxAfLgEigSi4A6hcRdPJCNZUAAAAASUVORK5CYII=")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/android-chrome-512x512.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAYAAAD0eNT6AAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAAB3RJTUUH5AEYEwUvIQpxwQAAAAZiS0dEAP8A/wD/oL2nkwAARrRJREFUeNrtnQe4lNXVtjmcQ29SRUXsgogKGnuNBXuvscSuscRoNBbsPXb97CUaI9bYG/aCvSsWVEBERClK7yD/vxZ5BmeYOXDOYebM++5939f1XPH6vhh03r3XXnvtVRo0gLJxW8d+rgpTlam5qY2po2lp03Km7qaNTTuZDjAdazrddKnpJtO9pqdNA0yfmYaZRpnGmMaafjGNM403TTBNMk02Tc2S/3eGm740vWd6xfSk6X7T7aZrTBfpzz3atKtpPdOq+mdcxtTJ1M7UytRU/z7+78VHBgCA6A/6Kh3wfmCubOppWtfUx/RnHbDXmR4yvWEaogN7hmmmaZY0W5oj/ZaluQvR/6tG2f+dzP/OnCzNztIs/bPMlEMx1PSOHIY75JicZDrQtLVpbVM307Km9qZmOAYAABDqQd9At+DOptVMG5l2Nh1jutL0qOld0/e6gf+/gOWRh4GKUtxs6ms6yLSdaUNTD1MXRQ5wDAAAIFUHfnPd6D1Uv7vpLwqZ32N6TWH5GYEf9LXVNEU4/OniQdPVpr+Z9tLvuIKiBSwyAABI1IHfU4f9qabbTP110x2jEDqHfO3lTxC/KpfhGdMtihh47sMmihQ0xCkAAID6OvBbmHrpXdvfuP9reluJc9zsS6tZSmp0p+BFUz/TBaZ99bzSBIcAAAAW55DPZONX6P3ek9eOMN1oesn0uelnHUgczOXVdNOPpk8VKbjMtI+eYRopSlCR9V1Z5AAAkHfoV+oW6SVsW+nt3t+lv1N53EwO3FQ4BB4l+Nb0shwCf55ZXnkEjbIdAgAAiPfQb2xqrQNiD2Wnf6n352mLKJlDyc8jmK7qgxHqaXC2aQP1V6AMEQAgsoO/mZrr+Fv+UaYH9IY/XfXtHPph6jdFcSar9PJC05Zy/lqTVAgAEOah30iZ45uaTjM9p654v3HgRxsdmKvEzQ9Nl6ur4eqmtjgDAADpPvQr1HHOa8mPU8b+GA4/VI3cGfjEdL26Ma6vp4IKdhQAQDoOfs/cX0e96u80fU09PqpDyaH3cvi36UQ9FXQkKgAAkMzb/oqmg023apDNFA4yVCRnYJDaNp9n2iETGcAhAAAo38HvCX3bmP7P9JZpNLd9VOIyw6EqMbxKJYadcAQAAOrnpu/1+h10239Sg3TowofKkTPwoyoKLlLDqMaZBEKcAgCA4hz8DfW2v6pG5X6kWn1u+ygJFQVTVUr6kKoJOsoZ4JkAAKCOB3+lOvNtouzsTM99SvdQEjVHuSfvm/6hEcdtcAQAAGp26Gc69HlS356mh7Nq9jlkUFqiAp48OFJJqZ44uJyiWGx0AIACh7/34l/LdILpdQbuoICSB1/Uul5PUQHyBACAg183/rX1vv+eQqkcHCjEdsTfqGplb0W5KnEEACDGg7+RDv7zdPAzcQ/FojEaYXyGaSNFvzAMABD8wV+lYTyXqhf7NA4EFKnc6f1MXSv/ZFoSRwAAQjv0M1n9a5quUKvVqRwACM2vIPjJ9KqiAitkqgfIFwCANB/8VZq0dqV683PjR6h6+cjiT01na1xxI5wAAEjb4V+pkOaJOvh540eo5pqqfeOOwMpqfY0jAACJv/U3N21nel5lUDTvQajueQJD5Aj0NLXAEQCApB7+PiDlLNNYjDdCRS0jHGw617QujgAAJOnw96SllUw3MKAHoZLqW9PFps1wBACg3A6AH/69NRCFRj4I1Y8GaTSxj8VuiRMAAOW4/XdTYxMOf4TK02EwM3cARwAA6u3w90z/e8jyR6ismm36Tntxx8zTAABAKQ7+BjIyV9DUB6FEOQIj9By3kyICjCQGgKI7AMeQ7Y9QYh2B0abHTVvLWccRAICiHP7e1ncAhhahxLcZdif9Zs3gaIYTAACL4wA0NV1I0h9CqUoWHKZZA6toDDcGDQBqffvfUpnHGFaE0ucIvGM6zLSsqSGOAADU1AFoY7oaQ4pQ6mcNPGray9SWZkIAUJPbfy8NKcGIIpR+jTTdrmZCjXACAKA6B8ANxBEM90EouGeBQYrs9SIaAACFbv+dVVaE0UQozMmDHylRcJmsfY8RBMABmHc7mIChRChoTTa9btpfOT8VOAIAcTsAlaZ9FC7ESCIU/rPARNPDpk0zMwZwAgDidACamy7AAUAoukZCY0zXmNaQHcAoAkTmACxheowEQISidQS+NZ1sWp62wgBxOQAdlSmMMUQoXs0w9TftbGqNEwAQhwPQWcNFMIIIoR9M/zT1UH4QhhIgYAdgKSoAEEJZmqVqgUM0bZAkQYCAHYDJGD2EUIFugnea1vbcACwmQHgOwNI4AAihhSQJfmo6QQnDJAkCBBYBmIShQwhVo7l6JvQhQxuaqngWAAjDAeikeeIYOoTQopoIDTWdosghI4cBUu4AtDMNoA9AYg2u93GfpijNODVv+Vnvs64fszRC+kH/6f//Uaax+nu9A9wU03QlevHNUV1nCzxt2irTQAhHACCdDoDX/PbjMChrNzZvxDLQ9K7pZRnXB03/Ml1nuliDXP6qzOy9TbuZdjXtYtrJtKNpB9P2pu30n7up7/sResM93XS+6XLTjaZ79Ge9YnpHb73fyIFwp2Eq6wItIknwHFN3ogEA6XQAmtEKuGyabfrA9A/T+qZl1Zu9XhKt9P0r5AQuZ+pt2tK0l+koOQzXyEF81vSeabCiCXP4fkjr4AXNE2mLEwCQLgegoW6QszFmZdFU3frdCVg9k2CVoPXhaqwxsmsrunCw6VTTlXIOXlMeyQy+Z7QarWjV2jwJAKTHAXCtrDA0hqx8mqIwvEdj1kx6Fzatm0ZqJd3TtLXpQNOZpruUV/ITkYLocgP8Gesvyi3CyAKkwAlor/dmjFgyHIFP9E7fK/O2moZbVZZT0EnvwhuZ9jD11fjZIXIIyCsIW7/KnvRIy9oFiNkB8NvmnzFcidI0DWm6Tm/zjdLYhCVr5HRnOQXbKKHxMSUbZioSyEEJb7jQ+6bdlWeEEwCQ4GeAHgrfYbySF1b19/Xr9b7aPK3d2LKSDpspRNxVDsHZplezKg94NgingZDnBlykhmN0EQRIqGFurAYfGK7kVgx42dUNqhhom3aDmpWE6muvlXIfTtZY2uHqPoczkH55lOc5PQk1xQkASGYUYB2F7TBayW4O5LeqW5V41zntBjVr/VXIIWiiigjve/CI6XNFB8gdSHc0YKgSBDviBAAkMwpwhjx2jFbyNV7NfPZWOD2oEGtWfkoP9SXw6oK31AkRZyCd8m6Ut5nWSnqlC0CMTsCKpicwVKnSJA1qOUolncEZ1ixnoJvpANNVpuf1LEICYfqiAe7I7aenH4wvQIJCsduoJSzGKl3yN/MX1aRn9VBvWFnlhiupHbInET6ueQhEBtIjz/O4TF0oSRAESIhxrVJnuvEYqdRGBLyp0CWqHKgIfL020iGytXrTv0dny1SVu/bXpYMnAYCEGNW2avU6BSOVauP6hQb/bJDdSyDQJ4JMz4HuCi8/ZPpFUQEiA8l+EvCI42mmNkQDAJJhUDvq8JiKkUp9L4HvTXebNtPAoYahNmfJimJ1kONzsQ6YaeQLJP4J637TGkwXBEiGIfW2rnfgBATTS2CsbsZ9dEBWBu4IZBoPdTEdqnr00QwuSnSZq7fC3lfRHDoIApQ5rLqkWtL+goEKZoTrZFV77KkxxI1CNbRZ69idnRaKgtyg55EJPA8kUu6oXmpagWgAQHkNZwPNjD9eUwMxmGE9D3g53WEqsWsSgSOQ6UDoJa8nmp5Ru2USB5OXG/CUafOQHVSAtBjPKmXrvkCzoCCHt/gI37+rciCKlq1a10uYdjL9n+ZhsLaTpc9MByt6gzEGKPMNqpvG1Y7EOAUZEfB20BeYNlF3yFjWdkONYPYS2FeUNMiaSIZGa012wQkAKL+xbKMmLE/o0MBIhZcwOFBv5X1CfhqoJtK1mulY00s4AokaKnSf6Q84AQDJMJb+jnqSaQi5AcFmZQ+W4d1VJYQNQs/Ozmow5K2VD5EjwNNA+TVLbYR3D7mCBSBNhtLfi9fTkI9fqbUOtnLgJ9PTpn3UKKphRI6AD1vaX62Wp7LGy54c+L0uHm1wAgDKbyQrtBn/aHrYNA4jGawj4C2iXzX9WeOIG0XkCCyp6YsvqOXyHNZE2eSlrNerVJDugQAJMJINNeFrF5WXEREI92lgqrLmj5ERbh6BE5DpJ9BWPRT6qz8GjkD5clX6q+NjFdEAgGQYyUxE4GDdFn8hRyDYcOwslWp59vyamTyBiNb4XqYn9USCI1AeZ/QrdQ9kvDBAwoxlJzURelWd1zBa4RriQabzVULYKoYbWVajLI8IPKj3aaJe9S+/ZJylzpYYX4AEGUi/LS1vOlkNZ5gtELa8cuBa0w5qtBOLI9BKGer3KCLAWqj/CZh3q5kVzwEACTOQ/n7aw3SqGs7MwmgFre9kkPfT8KFYnsDaqmrgSUZql6VU8A3TzrQQBkimkWyirmt99X5HyDTsPIEfTY+bjlDlQAxr3BNilzP9Rc4ua7x+NdT0t0wECgCSZyS9v3dv04WmHzCSwecIjFUuyIlq61oRcmOhLGfXo15nm4aTDFuv8iqkG9XHoQHPAgDJNJIt9W53jW6LTGYLOyIw0fSRIkArZGYOBD6JsIXa2N6mXgo4u/WXF9BfEcdKHACAZBrICtWSryVH4DtNqcOIhW2cv9Wglx7ZrYYDXuNeMbCtDqWJRATqrV/Ax6YtYxlwBZBmI+nJO2uYrlKOAANZwk/cGmG6wrS+3m2D6+62QA+BdqYjTe+RKFhvkadhSs5siRMAkGwjmTGU7gj8UyFjDGX4+jlrCmGnzMyBQNd3A5XHegTkcyJe9aIxalrVEScAIB0G0w+BnqZzNQ1sMoYseI0y/VvDh7qG5ggUWN/rKWHtO/IDSq4J6lOxPE4AQHoMZZXeit2Df4WIQDQd3h4xHaXxvCGvb8+B2UmNhMbx7Uuef/KgIoxUBwCkzBHobjpWk9mY1R6+Jmoc72n69qEmC1Zo6uDB6prJty/tdEu/SGxOhQBAOh0BvxUeoqzqmRi14DVFUwgv1rNQcE8DWWs7k/8ymu9eUg3UUCcqBABSaCwr9U68jxyBabyjBq+pGj50nXpINA2pciArSbClngVepnV2STVcnQNb8CQAkE5jWanw6W5ZddY4AmFrukoI7zBtrIE8lYE5ApWacnee/l3pHVC6fJPLTe1xAgDS6wg0VC35rqZnFULFEQi/2Yu3Gr5PJYRtQ3keyFrXHqLeIsu55buX5onJkzBXDLEXBUBMjkCFQqgeEXhM8wa4PYXf8MXLvP6j5K5gQrpZzm17JUMOUiIb3734zmR/tW4mORAgAMPZTBGBfpoUhiMQx7vuRcoRaBTgs8AGpntJEiyZPNl0B+WXYEgBAnEEPKnqVtMQngaikLfbPUCT+UJbz201cvg9kgRLIm9FfhjtgwHCMpyt9FZ8tWkwjkDw+lHTB9sG6ARUaqz2dWp1y/curjzx8izlFZEcCBCQ8Wxj2kx15d/yNBC0JpluCrEFrNay5wbsbXqVaEDRNV4OVgccAIDwjGdrJf34vIFviAgEK28Wdbdp6dBuc1lJgqtqHY/GoS16hYDPp1iGCgGA8JyACmWNr2460/S1blIY0fD6wF+j6E+DQKMB/sS1relNogFFlU9sfFQdSHECAAJ1BDxhbBW9G3+pQwNHIKzZAmcoKTTENZxZxytrvPIEvnlRZwg8Z1oLJwAgbEfAe7KvoMPiEx0cOALhjBzeP1QjnuUIeDTgcNMXqnHn2xdHr6v7JL0CAAJ2BDJvqz5v4FTTW4xrDUaeMLdawNMFs7Wh6WHNUeDbF0fvm7ZnkBBAPFEBdwRO1HhaHIH0dw48K9MxMIL1u5TpHDXDItG1eNME96VhEEB8joA3YXmC3uyp1vcqBa2IxAlopPbYz9FKuGjyXiJH4QQAxPc84I7An03/NU3GGKZSt2WqAiJZu/6k1cN0pabgsQaK02zKnwib4wQAxOcM+MjWPUz3ExFIZULgGrF0estyXt3pOdD0EWugaCOFL4nJmQSA3KeBzqZtTHdpRC1vrcnXLFV6RJXMlfUksI4GZU1jLRSlxPRGUydaBwPE6Qg01C1gI9PN6idOU6FkJwN+rHa6sTqu3ub2H8qJYJ0unqara2BXnACAuB0BTwzqZbpK8wYow0qmJmj8a0WkazUTDdja9Jq63rEu6q7ZygvqTsMgAJ4GqmQMLtBtk+5sybu1ne2NXVir826u1ys3gmjA4ulpzRlpiBMAgDNQoe6Cp6oRza8YycS0ePU+71Ws03nrtKnKXL9gbRSl4dTmugRgCAEwsPMrB44xPaaEQYxlefVFZu47azTnSeBF2ggvtt4x9cEJAIAFDe0y6kvvo2p/JuxaNo1QuJaFmRux6q5eCTxbLV6iqZdb7hTzMxMAVG9sl1Qi2vXKxsZw1n8/gB1wAAquzU6qEhjBOlksJ+Az9QupYGUBQKEbV1tNGrtYfdsxnvWjMTLOLMbCa7OlaXclsRKlqrsT8JVpv0x1AOsNAAo5Am5w1zSdafraNBPDW/JObgdgkBe6Lv0Nu7fpSVVOsG7q5gR8o7VGdQAAVGtwMxnZXjnwd9Onpkk4AiWRT3c8HINcI+d0Kc0SoIql7hqiOSKNWHMAsChHoJFaDR9relM3VhyB4skPs0MxxrV6EjhSz1Ssw7ppmOkQUxPWHQDUxBHwG1g7HVbeaGQkMweKlgS4HYa4VmvRnwS2Mg3QExXrqG7VJ0eYmrH2AKA2Rtjr1vfVMJfvmPG+WBqemQoItXYEemgA1njWUZ00Uj1BmrL+AKAujsCOphtMXxIRqJMGxzgQqIhr0PMC+pp+ZC3VSd4D5EScAACoqxFuqbajPnPgE4xqrfQWCVmLvf5amPZRqSBOaN1KUU/jOQAAFick21Rz3r1y4H2eBhapGcpqp0vb4q8/L23bwPQULYTrXI7qg6ma4wQAwOIYY88uXlXZ2n7DnUbGdrXjgLelQ1vR1p4nqq5suoXRwnUuSb1AET2aBQHAYjkCjU1Lq/nIyxjlvMYsg0wdMLRFj0R5e+tzTBNZZ3VySi8ytcEJAIBiGOVKDXf5kEjAfHmY+kaSr0rmBLQyHU1yYJ002XSJyn5xAgBgsQxyOw0boo1rbrh1K9qyltQJ8AjUnqaBJAfWWtPkBLTHCQCAuhriloRjC+oelVGyUErvCPxRT1A0Daq9E3ChBoXhBABArYxvE7UQHo0xzcu43jozmQ3qZS2uZbpXhxprsOaaYjo/kxMAAFATg9tQ40eHYUTzdDvJf2VZk8ur7HIya7BWmiQnoCVrFgBqEnbto+6AGNBc+Xv0JoRUy7Y226tPBVGp2lcHnEefAABY2MHvWs/0LkazYOj/SDr/lX2d+iH2J9M3rMlaJ66ek+kYyBoGgAUdgG6m5yn3y5N3RbyWt9TErNUq5WG8zVqttRN7RqZ8lbUMgEHNqIvpPlqxFtQzplUwmolas56E2dv0BG2ra6WxplOYHQAA2W+r11FqVVD+HLIRh39iHdcVlJg5lbVaqwFCJ+AEAGBEvevaWZRYFWz3+5VpJw7/xDsB7sBerBA3a7dmGmU6BicAIF7j2Vi1/uMxiHkaYfozxjE1a7mZQtsjWbs11k+mo2hpDRCfwfQ31P3pt15txrSHSKtYLala016hcYRpCMmBNdaPqm5pjBMAEE/YdAdNtMMI5rdQPYea6dSuba8Q2Fs9G1jPNXvq+kGOUyWrCCD8w39jav0LyofOXM0QlSCcgG1N77Cma+wEeNfPw2hxDRD24d/T1J8QaUHdberK4R/EWq9U9caLrOsaa6jpYJwAgDAP/+U0yY666Xw9bVqdwz+oNV+hQUJPMFK4xhqsTos4AQABHf4dFd6m0U++3qLWP+i1v4qaXNHnomby8tddNRSMhQSQcgPotf59TdMxbnn6klr/KPZAFxoG1UofmrbCCQBIt/FrooYfEzFqefohU+uPkYtiL3RQFGwCa79GekORMZwAgBQavUyt/88Yszz9qlr/SoxbVE5Aa5V5jmEP1EgvmHqREwCQPmO3I2NTqfWHvH3RTM7fD+yFGpUIPqVJoTgBACkxcpua3qPcL0+eBHmNqR3GLOr90VQlbzjINRuH/bCqiFhAAAk2bK41TM9R+lTwNnO3EsJ492eveH7MbqZP2BuL1CxTP1Nn9g5Acg//5VXyRK1/vjyU2R0DBll7pkrZ7nTGXLRmmG6jUyZAMg9/r/W/jlr/gnrbtB6GCwrsHX/b3kT9INgrC9dUVVK0YR8BJOfw9+zms6j1r7bWf1sOf1jEHtpApW88nS1cXlJ8nqkl+wmg/MbLE5qOpb65oIZntzbFYMEinIB1Ta/whLZI/WI62dSCPQVQPqNVpQPuJ4xSnrzW+3j9RiwYqMmeqlDdO0m0i5b3FzlaZZUsHoB6NlbeoWsHlTJR7pcfpjyHGwrUwamuUCXNk+yjRep7ddNswj4DqF9DtbHpA24qBRv9XJvJVgaooxPQQ/Xv7KmF61vTnkTaAOrPQPXUrHPeKvMb/dyTqfUHWEwnwDvgPUCEbZH9Nb4wbedRSVYPQOmMUgN15HqIcr88/aa3Wx//WsGKgSLtt5VM9+JsL9IJ+FhRSVoGA5TIGPlEs5uZbV7QAHnr47UxQFAip/sedcRjv1XvgA9QdJKKG4AiG6JWpguYaV5Qg0xbcPhDCZ2AZdRKegb7baFOwBPqSIoTAFAkA5SZYDYOI1Ow1n/PzGhfjA6U0AnwXvh3KtGUvVf93IA7M3MDAGDxDE9j0wGmHzEueRqlWmTKkKC+9mMn0y1E4hZZiXMFUzcBFs/YVKnWfxBGJU/j1f6YlqRQ3/uyg+ZuTGEfLnR/nqGnSxYOQC2NjDf62VDJbRiUXE1RrX8HjAuUYW82UJ+Jy02T2Y8L7RZ4FN0CAWpnZDKNSF6m0U+eZmo2+bIYFUiAE3AZzwEL1XemvUyN2K8ANTMsfrg9Sq1/nuao1n9VjAkkZK96FOpKEgMXOZFzS0U1WTwACzEonjjzL8qNCpYYvW9ai0Y/kLA929H0fzgBC+3T8SF9OgAWbkxaKKRIclG+fOjRptwiIMFOwC04AQt14F9Rp07KdQEWMCJeynaKZm1jMHL1g2mXzMARjAck1AnopOjddPZstbM6HmZWB0Cu8fAEmYN10GEocjXGdLipKYc/pMAJ6KyOgbTrLix3jm5VxISFA9EbDe9gt5PpK4wDtcQQxJ5eyvQfZgdUq0mmi01LsK8hZkPh79kbmd7FKBSs9b+KmwKkNBKwrJwApghWH9k7SXlPLByIzkhkav1fxBgUrPV349kV4wApdgKW1xTBuezpanN7DlK7cxYORGUguqjWn0Y/+dnCz5q6YRQgACdgJdN97Otq9bVpa6p7ICaj4LX+d/FGWLBe2J9DemEMIKD97qVvDxEJqFa+53vSIwBiMAgt1DmMWv/8w/9r1fpjCCA0J8AjWo8T8au2w+dTpmXY9xCyIfC3rtOo9S+oEdm1/gABOgHdTc+QGFhteeDNVAZAqAbAD7ZDddCx4QvX+jdh80PgTsDqpudxAgpqgul0pgdCaBu/oW63g9jkBTf9aZlyIDY+ROAErKm2uDwHFB4hfKD6o7BoIIgNv4npPTZ3nqap1r89mx0isgue47Ku6U0SAwvqK1UGcCGA1B/+a5heYlMX7At+F33BIVLb4DfczTXhEnuQr9dNvbENkObD3xvZPMJmLqgnMpPBACK1EZ4XtL1pIPagYGXAA6YVsBGQxsPfw9p3UutfUG9kvHs2N0RuK3wQ2J6mb7ELBSsDrsw8EWIrIC2buqXpCmr9C+pzhT7Z0IC9+L082KeBDsc+5Gmi6QRTc2wGpGEz+9jaf5jGsXnz9L1pd9p+AhR0Ao43jcJOFKwM2CPTIwTbAUkO5x2iBcvGzZUbtiP1G7FgAPLtRxONv/4Ve1FwZsBGXB4gqZvXF+bOpiFs1oK1/mcqOsKCAShsQxoo1H2xQt/YjtwhYQNMq3kZJSsGkrZxvYf9x2zUgrX+V5taE74DqJE9aa09Mw37kaNZqgxgZgAk6vBfS5292KT5XrtXQizJ4Q9QK7vSwXST+mVgS37XVCVYt8GeQBIOf5/3/SgdvQrqUf0+HP4Atbcty8iBxrbkyoepnUQ+EZR7k3Y23UGtf0F5RKQXhz/AYjkBK5rux57kyUsm98O+QLk2po+t/KdCUmzIXH1k2kI9z1k0AItna3qocya2JVefyM7gBEC9bkrP1P27stvZiLkarM5mlOsAFM8JWJuZIgXVXyOWcQKgXjajN+w4yDSWzZenn0x/YZQnQEmcAIYH5WuunmGXxgmAUm9Cv9XuSMvOghpvOksOEgsGoDROwG5qioPNyS0PPI9SYyj15ttUvezZdPm1/teYWrABAUpqg1yHmUZgd/JmBhzFBQRKtfl6qxMVJTn5YzvvUt0yiwWg9LaoSjlIzBvJ1Q+mXT1Sy0qBYm64bqbHddix0XL1sGl5bv4A9WqTPBH5fLoF5uUDfKyZASwUKMpG66Ikk5lssDy9qC6IlPsB1L9taqenNy4muRHJ53RpY6FAnTdXZoNdaprCxsrTB8pKptwPoHw2qoue4LBJv2umfpNORCahrhurpd7ZmMqVr29Mu1PuB5AIW9WdRkF5mqKpis1xAqC2G6oJtf7VaqTpSCUisWgAkmGz1lWSMjbqd7n9PoaLCtRmM/li2YEym4LyrOO+lNoAJNIJ2MY0EDuVF63cgSgA1GQjeTLbJqYvKfcrOIbzaj2NsJkAkucAuPY1fYe9yktW7ondgkVtIq/1f0tz7Nk4uUk1Ppa0IxsIINE2rKHacY/CbuXotoz9woZBoY2zqulJ02w2S15ZzaOZWn8ASLwt8xym0xlWlqPpptNMzbBjsOCG6aIb7nQ2Sl5jjZdNa1LrD5Aqm9ZGJcw0CspNYN6DpEDI3ihe638Ftf4F9aHmH1DrD5A+27akmpgR1cztFOhPvRWskrg3RwMNrzlVk+zYILkabNqZcj+A1No4j9qtbHqWpOacJ83H5RyRDxDx4e+lbIeaxrAp8vSz6RBTIzYIQOqdgPVMn2DXcqaXXqVcCRZKhJvC34B2MQ1nM+TJE4dOMTVlcwAEYe/8CW8nTcvDxv1+yTmS3KY4N4O/a3/BJijYPvOfptaExwCCing2UXngr9i5+frMtAW2Lp7N4N5eL9ObvInlaYYShhigARCmE+CVARepqRc27396RnkSLJQINsEqqvVnfGauZqnWf0U2AkDQNtBLnv+tPY/t+1+FxHWm9ti+sBf+0lr4M1n0eVmxr5jWYgMARGELe6o9Lh1Pf59xchx5T+Eu+CXUx55a//y62E80/4C6WIB4bOJmps+xgTllz33oeRLeQvfWj2dS619QQ0070hkLIDq7WKGueCOxg/P1hqkbOVBhLPAGamJztGk0iztP/pscQK0/QLQ2solC35Owh/OfQ+9Rd1gWScoXt4dy9jQNY2HnyTf8CZnBGCx2gGjtpFcGXMYclPmabOpLPkD6w1t/NA1kQRfsgnWhNj6HPwCR0qVM91EdNV+j9DxCPkBKD/+1TQNYyHnyCoibTZ05/AEgywlYzfQqNnK+PtU5wiJJ2eHvtf6PsYAL1rv+17QSixoACjgBmxE1zckH8HNkWexlehaxh7LuJJRVcDG/rDGYLBYAKGQ/vRpoX9P32Myc1uitsJvJX7xtVOs/g4VbcAb2ZixiAFiEHfXE4JNNv2A75+kn02GUSid70Xo5yzmUsxQ8/L9TrT+NfgCgJvbUy+CuZWbAfH3O0KBkLtRMud/x1PoX1BjTn9QPgUUDADW1rf72/Yhyh7ClHfs9q/wynICEOQB/0i2XRZpf639sptYfAKCW9rWH6W0mp85Por4mMzQIm5qMw7+PZjpz4OfKm3qcS60/ACyGja1Q7tBgnID5SYFH6ckZu1rmw39d02ssyoK1/jdQ6w8ARbC1jRRlHYVtnacfTFvRJKi8h/+q1PpXW+73oGlFDn8AKJLN9WfEU00TsbHzRii/pXwAEqvLcPgvrVp/Dvz8jP+XTGtx8ANAkW1vW9P1plnY2nlR1lsyT6xQf4swU+tPZmq+PjRtyoIEgBJdvrqqmyj29n/j5Y8l0lp/C7Cpav0ns/jyNFi1/ixGACilE9CbOSvz9ZWGzrFASrzwGmp87RgWXZ5+Nu1PpyoAqAdb7JUB25m+wPbOywd4wrQctre0C+4A0zAWXJ48Kec4ZlcDQD3a5Cq1x/0RGzxvvPrFmX4r2OHih5z6MKGqoLxN53mm1iw6AKhn2+y18BfSfn2eRpr2wwEo/uHvtf5vssAKZqHeZOrAogOAMtlo74r3AEnZ8/SGaW3scfEO/26mJ1lYBWv9H1JGLosNAMppp71d8Ht0CpwnLw3siF1e/EW1jOnfLKiCetG0OosMABJgsz1BewfT9zgB855lyclaTAegnYYu0HAiX++bNuLwB4AE2ezGphPJB5inISoNpFVwHRZSC9PZ1PoX1NfytDn8ASBpUds2mkFCR9aO/Z7PPNFC7bzI46n1L6gRKoWsYlEBQEKdgJVNz2Cv50Wvr+EpoOaLp1JlFMN5R8rTL2qCxGICgCTb8QqFvz/Hbs+7yB6i34QFsohFs41pEId/niap1rYliwgAUmDPPUp5uGks9rvfZ6Z1eLZdeNhoHZWRcODnarrpNiVFsmAAIC123S8sl6lfSewl2w9nSgMh//Bf1fQcN/88eWONRzT6mAUDAGmz7d4f/1Fs+7worie2N8KW5y6SLqZ75SVx6OfqJTlHhI4AIK1OwMYaU8601o79dsWe/744PCTyf6YZLI48+XPIeiwWAAjA1h9o+gm7Pu9St1rUNj2rXvQcmkYUlI/Y3JYmEgAQiL1vLHs/ldHB/a7T/IRoF4OPTDzWNJrDPk8+7nh/3ooAIDC77xHfexga1O9XjVFuHJWN1yLwg20f1fpz4OdqNLX+ABCo/a/Q/JK3SQrs96Vp/WieeLMa/Wxp+obDPk8TTRdR6w8AgZ8B/rz5Aza/3xOmzsE7AVmNfnqbPsL7q7bWvy2HPwAEfhY0MR1DPsA8u3+OnsSD/+irmF5REgSHfm6tv9fJLsXhDwAROACuDqZrOQ/6jTL18QtyyB/ca/0fYrRvwYzQlzU8g17RABCTE9Dd9CxnQL8BQU4NzMr8vNE0jQO/YK3/Ohz+ABCpE7CVEuJiPgf8bLwqqKeArFr/80wTOOwL1vpvo6QYLAIAxOgEeK+TIxUKj/k8GKnquPQnBGbV+h/Hhy2o70z7RlcHCgCQf1a0Ml1hmhL5uTBAZZKp/6CNdMB9z2FfsNb/eFNzDn8AwAmYd2Ysq7K4mGfCzFSXwNapOxuy3nQq9a7zNYd9wVr/81L5gQEASnt+/ME0MPIy8VGam5Cup4AsB6Cb6V0O+4I1nzdG2wMaAGDh54fnA+xhGhP5WfGWeuakxwnQP6w3srmLXs8Fa/29DHJpDv8oDVuFnsWaqM1zMz0B+V9XMfERYP5e8X1xri5MMZ8Z8wcGJd42ZE17+ivT/QrWeb6kRkgY+jiMWKWeedzhW9W0uekI099Np8vAXWzqq3DfJhoRupwqZ5gCCbHunQZqivZ45OfGJNmGqrQ4AJuT9Ffw8H9fb1vU+odvvJrpCWwfZTU/p57ns7UWqtNsTQh7X93Rdlc28BKsG4jQAXCtZ/oq8vPjE1OvRF8c9Q/XwvTfyDM4F9RcNbjwWv+GbO+gDVZbDbk6S5POphchG/hj0zWmnRUKrOAXh4j2lUeUDzWNi/wMuTPRM2L0sXYxjeDQz9H3KoVsxA0uWCNVpYP/FtOwEmYF327aXo42Pz7Esr/a6y085oul90b4i9uapH4kH197LxP+cjTWdGzwU57iNk4r6C3/83pY+/5MMNR0g56TyCWBWPbZasqhijkKMEhPIon8QDursx0H/+8e21nqbsUuDs8g+Zv8bqbnyzDfwodpvaNSqSrWF0Sw3zIR5sGRV5E9kqgS8qyPczWHfo6BvoZa/2ANUWNl839bxoiX/7nDTScqSZBoAIS+9xrrUjUx8iZypycmKVgfZjnGOeaEae8xLYNRDvLw96euk0w/JahMyJ3vzqw3iGAPdiTRfF5VxFaJ2O/6h9jONITDf96trL9KwDDG4R3+rXUDSdpUyxmmS03tWHcQwT5cW62CYz5rHit7Q7msD/J33XxjP/z9XXZ9jHCQhse79x2d4PDjVNMpilDw0SDkvegNsg6OvFXwZNOpZR0jr4/RRuVP1Pr/LxLCLg3P4FTq2yY9yXW8ZqpTcgqh78mmyrOaFfG5M1CN98pz4dQf3FWZiTEf/p6M9Sca/QRpaDzZZg3TmynqO7EFDgBEsDeXVRVOzKXnd2fyf8r1EXqYXo34A/yiWn9uXWEamZbaZGlJOpqj90EqUCCGp4AtIs8/m6SKpPo/f7J6NX8a6Y8/VYNcmvPuH6SRydT6p60Nqf/znsCahEieAo6XLY7VCfDzd8163+/6A7dUCDzGpgxXkHkdtHHx3tsvpDTB9X1lS7M2IfR92oE8tH4313s/EP1hW0fa/9+HM3TBwAZrVBootJbWISSeHHUB6xMi2au9TQMidgDGa/poVX07AJuqI1pMP/YTpu4Y16CNSrsAclu8d/oqrFGIxAnYz/RjxMnoH2s2Sb3+8OtpXnEsP/Qbpg04/IM3KDsH8LT1q+kg1ipEsmc9H+DiIozhTqu8Idi19TZ8Tj/6Wpp9HsMP/LnGsWJQw79NXG6aGcCavSOTpwIQwb7tqghtrKWBPjZ81/p0AFaOZA6A11fvz+EfhSHpHNDo0WFK1GXdQiz794/qlx/rU8Brms9TLz+290e/KZJa/4YY0SgMyE6moQGt39M1SY0PDLFEAo5TYlyso+gvKXlvgKwf+6SAZwF4fekZ9fauAkkwICdqE4Wyhl80rcT6hYj2cGtVasXaKtibI+1QX1GAXQLtBTBbb8FtMZ5R3R4uC9CJ3YFnAIhoL1eoS+0nEecDPKqciJIbzR56dwjtLcUTqJbBcEblAHgm8b8CNAZXaXAXaxli2cuVqo2fEKkDkJkQWrreAPqh/Q+4IbAf73FTNwxmdEaji7KIQwwJrs56hsiiee70Xp2iWR7F1pfq1VOafZ/1Q++cgnGptan1XxdjGaXRCDGalRkSdEFmbgVARE6AN217K+IugdeV9BlbP3IrvTmEUOvfh8M/WoOxWsDTLYdrtHEFXxsi2tNevbW7aUzEvQG2LumZpv/xQ0yjU14zvR+Hf9TGYhVlzYdoCGbpNtCE9Q2R7es2Ko2bHakT8B8NTSrpj9zJ9ExKSwLdOzxGiSPsmngNhffS7h+wIfBe6esTBYAI93Z3TfeM0QGYqIZgFaV2ArZJYUmg/zh9qfXHSJiWNT0Z+Bjr+zKjQwEi2tsN9BQwLEIHwC/lV5pa1EdFQN8UDWSYrh+GWn+MRCaX5T8RjA49gacuiHB/ZwYGTY3QCRiUKWsv9Y+8VEqeAvw2dJdpaQwhZN0SLonAGAw0bYITABHu8eUifQqYZtrDn7lL/QM31JjgjxPchcnLop7SMKMKjCBkrd8jNEo39KEh9ytvBycAYnPyt9WAt5gcAL/w3uxJwPXxI7sTsIXK6pJ4+HuiV08OfyiwdrdUuCyGxKDSdgoDSOYe90E555omRzYp8M2S9wLJ8rIaytNK0mjGWery14ObD1SzdrsG3AtgQXnzrr2ZdAkR7vOOugj+FpET8EOmJXh9hVoaqkvghwmYzOQJfw+q2UsDHABYyLq9JaIhIr43NycaBpHtcz+bNoqsKuBnVTrVu0FdV+VH5Qq5jFITlJUxclCDNbu3auZjMQwvqEsgTjHEtM+9KdbfIqoKGK1+CGX5sb0E4SwlX8ytx5C/93Y/SCVerHyoaXjwjciShO7XMCScAIgp2tdRl9NYHICeZdnfWXWYO5nu1K28lAkPQ01XmHph0KAOa/WSyOqFPUv4VlNn9gtE5gRsmLBctVJ2vF2zrPtbb41efrSj6fYSzA/wyoPzVYXQGmMGdTQM3jJ3RGRRgBmmf9EbAyLb65mngBkROADlvxBnJWF0UALSmXqHHKcyvd+kuQu54c/Vf3eWkhueNh2nt8wWhDJhMddnsxTPtlhcJ+CeTLIQewgi2e++3h/CAaj/H75CtYmdFZ44Qg0LvCf7B7qFTZT8X2CwMpfdOF+oKgOf4rYkk86giGvTHdSjTVMi7Bo2U++iy+MEQCQOQANFpYeQBFjeD9FIN/jW6tHfUbXZXfXX7fV/b61bGhP8oFRrcblIh4dkGmY9YFqVEkGIZL+vGPg00J91jqbSO+MmAvW97popMW5OpE7AXEXaNqRjIEQQAdhOzbFC3c8/6dmdjw5QA6PgzwB9TJMidQAy+si0l6p4WBwQ4l6vUnvgkPfx16aW7GGAmhsG95ifj9wBcA03nZQZnY0RgcD2ub+Nvxx4me/d7sTzxQFqdzM4QBsodifAIyHX6q2UBQKh7PFM98+QE36nqyFeJV8doHbGwbPhB+AAzO+u+YRpKyIBEMge96jW9RF0AVyF/QpQtyjAYQkYaJWkCoEvTSdnPwlgXCClDn6vwMv/5qqsfgn2KEDdjMRKgZcI1fVJwGcI9M6UCmJgIGV7u7HpqMCnf87RBaYR+xOg7k7AoZE2BlpUctHnel9sgxMAKdvT3oDuqcD36JBMAyD2JkDdjYXnAjzOoV8wxDhJ76ir61bFwoE07OkNIyjzvY7wP0DxogC/cOhXG2r8XCHVrhgcSPh+9kZflwbe6GuaJvBy+wcogtHwkOGjHPaLHCj0qAxPWwwPJHQvL2P6IvD3/5eUv8RHByiS4ThAbTU57Bfde9z7BmxAAhIkbB/7DJl9TFMD34On8SQHUFwHoI2m5c3hkK9RfsCnCrWuqfbKLCQo9x5uoihVyHvYB5n9kfA/QPENyKamoRzwtWog9L5GeC/DhEEo8/5dS6PmQ95z/zF1Yp8BFN+AVOkwm8rhXiv57/WO6e/KD6B/ANT33vU1d3bge9f/3f7C3gIonSFZ2vSK6TcO9lo/C0w0va4e7G14GoB63LdeEvd24HvsXdPa7CmA0hkTP7S2ICGwznLHaYLpadOOmrxYya0FSuwA7BbBnr1RUUo+OkAJjYnXEp+haVsc6nV3BKao1fK+phXIXIYS7VcP/98W+HTPH7WPcKQBSmxQXEtpQh6HeXFaC79mOsa0hqk5RgyKuF9XM30Y+B56Sc+TfHSAenICvCpgMAd4UR2BD5SstYmpNQYNirBX/2oaH3jnv4u4/QPUr2FpajqRqoCSOAJfmW7SwKGVM3kCALXco55s+mDg+2Ww5hvw0QHq2cB4ffu9HNol0xjTy6Z/mrY1tcTQQS3259amrwPPpXlcTY746AD1bGBc65ve47AueVMhH3H6jPoJrJYpIyT0CQvZm+cG3vffnzaOZP0DlM/QVGhWwHAO6nrpJzBBTwTe9WxXU3uVP9FgCLL35cqqMgl5PwxSFJKPDlBGY+MhuHN0OHFQ119U4FclDp6ruQOtqYVmP0p7mcYFvP5nmq5nvQMkw+j44XMz/QHKEhXwccS/mB5RNMb7CrRi/kDUe/GqwNf9OA3+qeCrAyTj1uH9AR4OvOlI0p2BmQqNeuLgNgoFt8YZiGof9tJEypDX+QBVOfDhARJigPyQ6c68gMRosulN02XqlPYHU0eMZtAOgCeHHhJ48p8/fx1vasRaBkieAdrY9DEHcOKMpt8K7zT9TdGBLvQYCG7/dY6g9n+EKmFIegVIoBGqUob6UA7exDoD3kDlSdOlig6sSkJVEOH/DUxjA1+/d2jCIR8eIKHGqKlqdMdw4CZac0wj9VRwl+lYjVWluUr69pwP6uob+Hr1HJddGKcNkHyD1MJ0SuDlSKElV/2qPgPPai7BZkoibCjRbyC5t/+lI3h6e9u0EmsQIB2GqRU9AlLbZnWyogPvqcTzUE0sbK0ITxXVBYnZZ5W6GU8LfF2excRMgHTdTFqqWc2vHKypLi+cpPflT/UOe6SeC5ZUSVZjHIKy7TM/FO/Wk06o63CUaUtu/wDpcwKaK6Q8lgM1CIdgtpwCjxJ8npU/sLF6D3RSlIBNUPo95k7XKqYfA193D9D6FyC9ToAfCKfLk+cgDc8pyEQKvjD1U/7HDqbeKjlswu2tJPurUkOipgaerHos6wcg3Y5AYxmrkRyaUWiGkgof0jPQ/qYtTD00yIhs7sXfU55n81rgzX8+0uRRPjpAAE6Ae/M/cEBGp+nqQfCK3qwvMh1j2kktbDviFNR6P21t+jnwdXMTyX8A4Rgtfw443DSMQxGnQOvgHdPjqjg4XQ2K1jN1ILlwoU9rN+rpJdT1Mdp0IN8fIDwnwDf2txyCaAFNVVLbQNPram97selg00ZECubvoRUUHg95LbxsWh4HACA8A+aJYduZ3uLQQ4tIMpymzpLeYvoz0/MKDf/DtHfWsKOmemZqpAS5hqElj2Xd/o8KvLJmptpVk/wHEKgT4EZ6TdOjgYcyUWn6E0xWjwl/Bx9uel8lY5eoV8FW6h7XTk2MWsjxrEzrwZLlPIc++GewchwwlgABOwH+xruU6QZaB6MiOAZz5BxMk4MwXoeJRw1uMZ2qqMG6phVVX+69C9rKQUh0UyPtmc1Uchnyt3xaMw4wlACBOwGZ+QFunL8LvKwJlc85+E0OwmyVKXpfig80HfE203mmv2ii5SbqY7Ca3qE7axJdk3I5CFl75Sz984f6rcZrdDXhf4CIHIEq3c4+4MBCCWhAM0o5By+qwdHVptM0G2FXPTFsoDkJKyua0E4316I7Cdojy5qeCvy3/0pjqjGMAJE5AZUyqk9pfj2HEUqqg+BJeIM0qe5Z5R/carrcdKbpBDkL+6gzoofu1zF1k7OwhJ4carM/9jD9FPDvOkvtpSn/BIj4SWAlDZ6ZzmGDUp7N/quSFP3d/l3TS6bHTPfIYbhOTsP5ijCcoCx/L5Xd07SjuihuIycj5N/Lf6vt3QHAGgLE7QTsgwOAItNs9UIYrycI75o5xPSl+maE3Pd/rppCteX2DxD34b+E3lw5FBCKZ37EGd7HAUsIELcDsK7KtzCMCMUhb/a0OuF/gLgdgKYaKYtRRCieMs371bAJQwgQ8e1/Rb0FYhgRiidZch+VAmMMASJ1ANwA7KdkKAwjQnFooBx/mv8ARHz790Yqz9ANEKGodKGpJYc/QLwOgE9v29I0EYOIUDQap33P7R8gYgfAk//+rS5rGEaE4tDjpq4c/gDxOgDe+nMt0wgMIkJR6fjMiGYAiPP27wbgYjr/IRSVvD3yhoT/AeJ2ALooExijiFA8ukVdPzGEABE7AH9V/3OMIkJxaILpYG7/AHEf/l7+0x+DiFBUetW0Goc/QNwOwE6m7zGICEXV+vdybv8AcR/+Xvt/A6V/CEWloXL8MYQAETsAa5rewyAiFJWeNrXFAQCI9/B3nWSagkFEKBp5p8/TOPwB4nYAljI9jEFEKCp9ZVoDBwAg7tv/LnT+Qygqea5PPzr/AcTtADQ3XYZBRCgqjTXty+EPEPftv7fpQwwiQlHpE1MHHACAeB0AL/07wvQbBhGhaDTDdBHhf4C4HQDv+/8YBhGhqDTG9Aef/IklBIg3/L9VBH3/vdPZJP0nxh/FLo/2PaO23xhDgEgdgFamKyIweB/omcP/XT8yzeYQQBFrpukgUxUOAEC8t/9V1QY09Nv/caYmuvH00tQzL38azWGAItQ3phXp/Q8QrwPQ2HRMBLfhQaYeWU5PA918ljStry5o75qmkQiJItHViv5hDAEidQC89/drEbyLX1KdsdPv0My0tGl70+2mH3AGUMCaprwfbv8AkToAXvq3tfqAh2zsRpk2X5SxyyqHbK7Q6PGml/T3T8EZQAHpeVNXHACAuMP//4og/H+XQv2LNHYLPBE0VL7AxqZzTS+avjWNo5IApVwnKR8GYwgQqQPQzTQscEM32fSnxb3p6O93g7m26a9KHvScgZ+oJkApk+/5Dbn9A8TtAJwZwdjfZ00rFdPQZSUQevXE/qYr9ecMMc3igEEJl+e4dOTwB4j38G9veiNwQ+eH8amlvOlkPRV4J8XtTP8w/cf0meqsOXBQkjTVdAi3f4C4HYA9TCMDN3af6v2+vn/bTiotPNB0lektdSDkAELl1pumtTj8AeI9/BsoDDgncGN3W7kSnfQbV5jaKNeij+kMU38lEf5GIiEqg7wLZiMcAIB4HYDV1BY3ZEM3MpP8lyCnK9NrwMcuH2W61/S1wrIz5JDhFKBSabhpN8L/AHHf/r3z36+BG7uXFYpP6ndorOhAZ9N6alN8t+lL01hVL5BQiIqph0xLcPgDxOsANFcJW+ilf2cl/aaT5ZBVqKqgqZyCdUxHm+5QpMbLtn4hqRAtZkTsAG7/AHE7AJuaBkbQ9z91iU4LOAQVWV0J/cngMNMN6kz4uelnHAJUCz2jtt8YQoCIw/+nBH5wzFYZXsPQjF1WBGctVRhcanrY9I5mF8zgoEMFNFrrBUMIELEDsIzpv4EbO38/3zF0Y7dAYmFPlXWermcD7/P+BS2LkZz9u/S0hCEEiPj2v13gtf9+2A2INdFJ37iFaRXTZqZ9TCearjE9ZvrENIFDMRrNVbvqHhz+AHE7AJ5gdnYEI07/Zqrkq+f0IvAxyMtpjsE2poNNF5juN32oSAEHZpih/539OYzdABD37d/71r8SwZCTFbjt1Mgp8EjBUloXf1B9+GkKF7+rMtFZyqmgP0E6neHT9ETEwgeI3AHwm8D0gA2eH1LXK0mOD183p6CZMsWXlmOwjZ4QvKPi62okM0FlltP0vjyHwzZx8gFf55jaUfYHgHH3N/FrI6j930wHGR++OOumoRoWuVPV2tRBXSR31Ejkq02Pa/DRcJUmjtMBNIuoQVk0UVM+23L4A2DEXd1VGx96nfOSGL2Sr6VMf4JK9ZRvrMhBFw1eOkBNmO5QzwJPPPzGNELNjKbjGJT0zf/0TMY/+wAAo10loxyy0f1NjXIYcpK8ZwW/ia6pqIG3oL5EnSif0zjqj+Ug/GgaT/vjOpf6vaPkzhbsAQDIGOG2ahYTsgPwRabUCeOXmnXZRH0pvKnRVqb9NA/hPHU8vMf0hOk1RRGG0g65oPw3ucXUi7UPAAvewNzAjgncCF6sUjc+fBjrNjMsaUk9X21o2kGRrONVzur5B/82PakExYyTMDaSjojj9e9+CAN+AKCQIW2sUqCQw6qjTH24/UfpIHRQ2WdPTVT8o54a9tbBeILpXDVCukvNkF6VszBMFQ1pq2L4Sf8u+5pWDLHlNQAUx1B6MtB7gYf/H1ACGh8dCkXAmmgfeDShq8ob1zCta9pEpY67mw5SjsIpchouN92kEc2PKGfhTTkP3+ognqQ+Cb9laW4dlN25b0HN1pwHr7boa9pSY645+AGgWgNYIeMWcpc3N47HcvuHIjgLDZVE2lSJdK1VR9/R1Fn5Csvp1r2KniZ66u19I0Wh9lQi3nGKvF2o8tvb5Ejcp1kcj6tq5QVFJN5UEt+rilLcrZ4WffX00V1Nm5pR5goANTFoXqZ1Y+BJUx+Z1scgQkKiDdmlkU3VP6GlnIk20hJKzG0ntddTRgf9dVv991rqwOemDwC1Nkh+Y/ky8ESoWyl7AgAAyHUADlM/95DH/h5C+B8AAOD3w9/DkA8pKSlUB2CA3mL56AAAAHIA1lBznJAH/1zH7R8AACDXATgu8PD/96ZdOPwBAAAa5PT+vyfw5L9X6H4GAACQ6wCsoeY/Ic86P5fDHwAAoEFOi9RDlSEfqgPgLVz/gAMAAADQIKf5z/WBj/19So1W+OgAAAByAHqonWioDoD3Xj/cu67xxQEAgMP/9/D/XoGP/h2igS58dAAAgKzmPxcGPvjnFk1346MDAADIAVjZ1D9gB2CqphsyHAUAACAr/N8n4Oz/uSpt7ET3PwAAgN8dAA+Lnxp49v8ZGrHKRwcAAJAD4IlxTwbsAHhb43W5/QMAADTICf9vYPolYAfgYdOSHP4AAAANcsL/xwXe+/9wzTjgowMAAMgB8MS4xwI+/L8xrU34HwAAoEFO+L+naVTADsCNpnYc/gAAAA1ymv8criY5IR7+M0wHcvsHAADIdQBaKUHut0AdgA+Z/AcAAJDvAKxkGhlw+P82UxscAAAAgN8dAB/9e7BpWqCH/3TTsYT/AQAAcm//Xv73gGlOoA7AZ6ZNOPwBAAByHQDv/jcs4PC/OzdL4AAAAADkOgAHmcYHHP7vS/gfAAAg3wG4yzQrUAfga9OWHP4AAAC5h39b00cBh//7E/4HAADIdwD+aPo+0MPfqxou5vAHAADIdwBOM00M1AFwx6YPDgAAAEDu4e96MODw/1s0/wEAAMh3AFY0vRtw9v9VHP4AAAD5DsDuAb//jzZtjwMAAACQe/i7ztWUvBAdgIGqcOCDAwAAZDkAzU33B3r4zzRdrxHHfHAAAIAsB6BXwO//k0w7mhrytQEAAHIdgP1MowI8/OeavjF15vYPAACQe/i7zgv09u8TDW/WEwcfHAAAIMsBWNJ0X6AOgM802NVUiQMAAACQ6wCsY3o/UAfgW9MKTP8DAADIPfxdO5vGBOoA3M7wHwAAgMIOwLEBt/890FSFAwAAAJDrALQ2XR3o4T9WzxuE/wEAABZwAPx9/OlAHYDnTcvhAAAAAOQ7AOuaBgfqAFxkasnhDwAAkHv4u3YyzQ60/n8fbv8AAAD5DkAT04kBl/+tz+EPAACQ7wB0MN0RqAPwX9OyOAAAAAC5h38mATDUAUDnUP4HAABQ2AHobZoY4OE/UcONeP8HAABYwAHw3vh7alpeaA7Ah6b1OPwBAADyHYBmptMCdQAe1IAjPjYAAMACDoB3ALwl0Pf/ywn/AwAAFHYA2pueC/Dwn6zZBnxoAACAAg7AUqZvAq3/3xYHAAAAIP/wd60aaAXA66ZVcAAAAADyHYCGpq1M0wNNAGyMAwAAAJDvAHiDnCNNMwM7/GeZLiUBEAAAoLAD0EQHZWhDgEaZDubwBwAAKOwANDc9oIl5ITkAX5k2wAEAAAAo7AC0NH0QYBOgt00dcQAAAAAKOwDeA2BkgAmAz/L+DwAAUNgBqFAJ4C+BHf6ez3Arhz8AAED1DsAmpvGBOQCTTH1xAAAAAKp3AHYyTQjMARhjOhAHAAAAoLAD4E2A/qQbc0gOwHBFNvjIAAAA1TgAR5mmBOYA+FyDrjgAAAAA1TsAJ5umBuYAfEQLYAAAgOodgErT+YHNAfB+Bi/KueEjAwAAVOMAXG2aEZAD4B0NH3UHgC8MAABQvQNwuwbnhNQD4G4cAAAAgOodAJ8EeH9gg4B8quENOAAAAAALdwCeMf0WkAMwXdMNcQAAAAAW4gC8ENggIK9oOMObHPGFAQAAqncAHgtsFPBE06E4AAAAANU7ACEmAY4z7YgDAAAAsHAH4NLA+gCMNW2AAwAAAFC9A+DNck4JrBPgKNMqNAECAABYuAOwb2DDgIaY2uMAAAAAVO8A+DjgbqbRAXUBfMTUlK8LAABQvQPgaml6PZBeAN7S+G+mRnxdAACAhTsATUwXBlIJMNn0BxIAAQAAFu0AeB7ANqZpAUwB/NjUifd/AACARTsArqVMbwfw/n+mqRkOAAAAQM2cgMamo1M+FGiYqWfGqQEAAICaRQG8dv6jFDsAl5ha4QAAAADUzgloajo5pYOBfjBtxOEPAABQtyhAd9NrKTv8Z6mKoTWHPwAAQN2cAG8MtKdu1GlxAPrT+hcAAGDxnYAWpotSMiDoW03+I/QPAABQBCdgedNTCT/8J6rsrzGHPwAAQHEcANfapgEJTQr0jn/Xmtpx+AMAABTXCaiQE/BGwuYE+OF/vakthz8AAEBpnABvE9xbTsCcBBz+E7IPfxwAAACA0joBa5r+axpXxsN/qOl80xIc/gAAAPXjBLjam043fV3PB79XI7xk2pmEPwAAgPI4Aj46uE89RQM8+fA70+Wmlbn1AwAAlD8a0MX0Z9P9pvFFPvg94fAb0xUaU9yGgx8AACBZjsDSCs3fqqeBxakWmGJ6y3SWaQMOfgAAgOQ7Ax1MPUzbm84zvWD6yTTNNKMaTdZN/z7TcaaNTSupEyE/LAAAQIocAe8d0NLU2bSCevR3l3OwhuR/3U3/v+XkPDTR38sPCQAl5f8DqvcM65kW7JsAAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDcrMDE6MDDekU0hAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ3KzAxOjAwr8z1nQAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/apple-touch-icon.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAALQAAAC0CAMAAAAKE/YAAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAC3FBMVEX////9+f305PXoyurZp93Jgs69ZsOyTLmpN7GkLK2fIamcGqabGKWbF6WgIqmjKqyxS7m7YsLIf83Xodvkwefx3/P79fv//v/+/P705vXivOXOjdO6X8GqO7OhJqqaFaScGaWoNbC1UrzFecvZqN3s0+758fry4PO+acWpObKdHKeiJquxSbjjv+b26/f+/f747/ngt+PAbsenM7CeHqisP7TEd8rftuLy4fPTmdexSrmdG6atQrXNitLNjNKpOLKaFqS8ZcPes+H47vnRlNWoNrHXo9v37Pi+acSvRrfVntn16Pb9+/7Hfs2tQbX37vjoy+uiJ6uzTrrftOL79vvMitGeH6i8Y8LoyeqiKKvIgM79+v24Wr+uRLbesuH8+f3AbcbGe8zQktXTmtjVn9rPj9PJgc63V76kK62fIKjCccjw2/HWoNqhJaq/asXmxen37fjv2fDhuuTQk9W8ZMOeHafaqd38+Pzy4vSrPLOgI6m7YcLhueT68/riveWvR7fEdcnz4/Tbq9+2Vb3brN/nx+nLhtCcG6b15/b16fbcrd/OjtPmxOi1VL3pzevx3vLYpNyvRbfKg8/t1u/HfcylMK7lwuf26verPbSlLq7pzOvu1+/GesvkwObGfMz05fWuQ7a4XL+wR7izULvNi9Lqz+zZptyyTbqkLa3huOTTmNfaqt758vq3WL60Urz69Pv79/zlw+i0UbvfteK3Wb7DdMnu2PDMiNHBb8fSltbs1O7jvubCcsi+aMTKhdC9Z8S/a8bUnNjMidGgJKrr0O26YMHRlda5XcCsPrTdseHBcMemMa+lL66jKazKhM/cruDYpdzw3fKzT7q4W7/t1e+nMq/Sl9fbq97EdsrqzuyqOrKwSLjUm9itQLXmxundr+Dqzez58Pnw3PGnNLDs0u7FeMvr0e3XotvnyOrAbMa6XsHPkNTCc8jiu+Xv2vHkwufPkdTdsODVndm1U7zt60pRAAAAAWJLR0QAiAUdSAAAAAd0SU1FB+QBGBMFLc8EEO0AAAiiSURBVBgZ7cGJX5RlAgfw36ilMjOCGLzj+Rs8sAF1QMFSNEcxdXWSJQkjrMG0VlKLrJHDTPEIzFozJTV1Lc1A0/BILWvLpMBCLe3Y2uyw046tbfsHdtsteZ6XGZiLd5zPvt8vdDqdTqfT6XQ6nU6n0+l0Og0Z2rXvcNnlHTt1jjKazMYu0Z1iusZ2uyIuXsElytK9R89evfsYqWLtktC3X/8BiQouNZaBHa60JdErU/KgywYPseMSkpI6dJiJrUlLH37VEAWXBOXqESOT6JuMUaMHGBB2Stw1Y8z0nSN67LhMhFfK+Gsd9FPGyAlDEEYTJ5kYAOMfJk9BuDivczAwpqmTsxAef8xmwKzXT8tBONzAYOROv9EJzVm6Mjh5N7WD1vJnMEiOm29xQVtKAT2zzbx11uTZI267veBP6XMy2JLCO+ZCW/Mc9Gj+nXcZ8CvFVXT3gnvu7e2md7aFxdBSSSm9KFt032L8zjnl/puWRNGbtKXl0NKyMnqTtnxFFi5SUlY+kG6kZ44lgy3QjnNeNL2qGDSvEk2UVQ/2SqZn2asToR37Qw8b6ZU1/dY4O5oU/3l4Z3pkHbsGGkp5ZK2JXpkTHl1nQRPLgPVV9OjmxxRoaMOsjWZ6t2np4wY0sWzekkdPOs8uhpbajd9oonfJk7bmoInzxum59MD9l0xoatuIJ6z0LurJaYlokjMtxsTmzF2vhrZWzdtupHfuHR2K0GTIU3PoQcxOaKzo6eoMemesvkLBRfb2M4xszrZAgcZSVvR107uaXRAk7t7E5uY8Y4HW9ozbm0avNsZBoLR/1sxm5s8yQHO1u3qV0ZvVkAx5tIzN5D6VA+3t21+QR88OxEPiPNibzWSsT0QY5O+cWeWgB7ZKqKx7zkw166EUhIPl8BGbmc3YKqE2cX0a1UzPZyEslJIXnjBS5UA8mnFN6Ew1851DECZHO1GlJzxQXiylmuPyDQiLxS+ZKStdA48G7KCa468TEQaul62UJY+DF+WvmKjiOJYFzdlnpVGW+6od3qRsqaCK+fkUaO3BPpQZjxjgXfzxNKqYDu2BtrZWUeY4VIuWuHbXUcV6XQ609FopVV6fiJZZns6mSsUNLmgnLoYq11+N1thXRFMlrd4JrWxooMqJAWid8kYNVbpMUKCNPW86KGvcD5+knqTKqYPQhOu0lbLsN+CjXSepMuwuaMCy201Z7mQ7fHWwhirpR9H2VsynzHjcAN+9EU2VHeVoa4NPUmY+VAs/KG/lUWVSItrWzt5UWVQEvyhv11FmfdmAtnRmJFXOlsBPlhG5lOV2U9B2Vr1DlfR34TdDzwzK5tyPNrNnqIOy9x5CAPbFWikrPYo24rrMSln2OAQkscBBWd/uaBOW992UFc62IzCrnqRKwWK0hb/VUWYcnY9ArYmhzHirHaH34ibKzB/EI3A7x1CWvAsh9+HfqdK1CMFIzaNs42GE2JmPqHKgHEFRzrkpa8hESE2ZQZWP1yFIrlgzJebT+QihxAIHZcM+QdAyp1MWtQyhU3y7lbK8aQiBgYMoS3gNoWKpd1NWOE9BKDyeQNneIoTIM3WUVVyTj9D4tIwS8xELQqJHDWXm4fEIEedxEyXzUxEKn9mocm8WQub8IsqmnkHwPt9OlWfLEUJzP6bsWDyCVbmXKlM3I6R65FGSMRlBOn+MKl+sRGgpL1RQ0rgTQXE+YKLs1FVoiWXD5ncrnfBLfAFlDecRjMf6UBbVTYF3ypdfbczLHlOw0gJ/DHyCEms9gmC/g7KKnvnwzr6skf+Vt9sAfyxIpqSmPQKXNYoSc78ctGBBHn+TOwH+sIw2UbI3BQErf4+SsZloQVFHXmSLgz8y36HE+j4CNsVGUd92aElqBpvshl++TKCk8WsEyvUOBaMOo0VHKDibCL88kkTJvfEI1DdWXpRwAS0bTkHaOPhl37eUuD9FoLL28nfRqWjFaYo6ToFf5pZS8p0LgTpczf+pWaGgFW9ZKXDMLIZfVuRS1KkSASu/PSGDprxFF+xoTUknipK+N8Afrh8oaixB4Cwl/1h4y9F9aJ2ylJK00bXwx2O5FAxrB03sL6OkYmg5/DCeorO10ETxK5Q51u4ywFfnO1K0Ghr5JJsqZS8dtcM3WwspOPU1NGKJZTObHv3RDh8oX1HUywWtdB/JZhyNW26MR6vKbRRYF0I7P3WmB3XLJ59xomUdTBTYyqGht6Poibnx2MIfi+FdcQNF/RRoyPm9m56ZNs0Yf2GVE569lkdB4U/QlOu2NHrjiOrd9Z+D4xbb0cw1FFUnQluGF+rYEndVTME9qV92j7ejSdYSiuqhNcvPX7A1xj6dqied/te4C4e3ZeY4FSxwU1CzGdr7OYm+sRZGJ0w98N2VSz+i6PJ8aK8ng2L8FNqrTGdQTlRCex2sDMpN0F7OcwxK2Upo70Idg/JLLbS3nqLkRjP94jgH7ZXbKDoeN3t5Hwd91zgX2vvGTMGmdUD8zt0NVVb66FsnNJfzHEXfOvErw5oHf1ibbGLr3KnQ3mfJFLjvw+/sKQOe2XL9STdb9nAitPcURSMzIVJqz2x99YO+18430ovGu6C9zO0U1aM5Zd+2r1PPrX99pG1OodFBkeNED4TB/WkUVN0Nr5yLV9194eAj98S+OXbGjiUnxgxrvPbs6oEIA2U4RXda4AOLIWfPxO7lJdtqFYRDZSkFFbcgEuxKouDEFESCmRTFIhJMHEVBl/2IBD/lUhCTiEhwmqIjiASLqyko3I9I8O4pCtZmIRL0N1NwHSLCUgqSFiAS1FZTMGYbIsGZkxRMdyISDE6ioB4RoRsF7sGICKcpaByISKAco2D7HkSC/O8oeN2JSFD8CwVvIiIUL6cgFhFBGUrBOUSGDlZelP0hIsOGQbzoeQMixNYq/mb754gYW7db+R9JDYcRQdrVP1ta2tA/BTqdTqfT6XQ6nU6n0+l0uv8X/walffD6WKgjVAAAACV0RVh0ZGF0ZTpjcmVhdGUAMjAyMC0wMS0yNFQxOTowNTo0NSswMTowMEkOXAgAAAAldEVYdGRhdGU6bW9kaWZ5ADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDA4U+S0AAAAV3pUWHRSYXcgcHJvZmlsZSB0eXBlIGlwdGMAAHic4/IMCHFWKCjKT8vMSeVSAAMjCy5jCxMjE0uTFAMTIESANMNkAyOzVCDL2NTIxMzEHMQHy4BIoEouAOoXEXTyQjWVAAAAAElFTkSuQmCC")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/browserconfig.xml": []byte(`<?xml version="1.0" encoding="utf-8"?>
-<browserconfig>
-    <msapplication>
-        <tile>
-            <square150x150logo src="/mstile-150x150.png"/>
-            <TileColor>#9f00a7</TileColor>
-        </tile>
-    </msapplication>
-</browserconfig>
+	"db/migrate/sqlite/2020-10-18-1-path-watches.sql": []byte(`begin;
+	create table path_watches (
+		watch_id        integer        primary key autoincrement,
+		site            integer        not null,
+
+		path            varchar        not null,
+		threshold       integer        not null default 0,
+		seen_at         timestamp      check(seen_at = strftime('%Y-%m-%d %H:%M:%S', seen_at)),
+		alerted_day     varchar,
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "path_watches#site#path" on path_watches(site, path);
+
+	insert into version values('2020-10-18-1-path-watches');
+commit;
 `),
-	"public/favicon/favicon-16x16.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAABQVBMVEWaFaQAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///+n9ZLQAAAAaXRSTlMAADWd5P382o4wAqzgttP5fRQ5pvD0sWJgw7ouBz+h9VQQg/PUPEvv2C8E69k6M+ZFAVfs0Sskx1pkuBYbs/dwjAaP+oYKgrDyXk1xDhLN3D6+6Z4xGWOE9vHh7fhlq3kFkwzGzxG5oAPeGXiwAAAAAWJLR0RqJWKVDgAAAAd0SU1FB+QBGBMFLc8EEO0AAADGSURBVBgZBcExSwIBGIDh922ptMncukWQGwyEWyyyqTgIIfAXCPWrgsYmwbGlaAqCQqjghoJou2sqnTqq5et5XFFVrQXA5oQL9XvDJQK2VNWvthWCJCqq+oZokKovvcWmFij0/1Z9hkx1jgx8794jka19bHnnvjqvsyeM3k+5pwf6WuZ+PmJ0UnWklxyr1zWMi74Cwe5y2yrRqyMFiOFv19lhy+lYgSAdlO31KrnJVSA4uW0U0Nh5qBWIU/VcABSIvKNnAvAPyX44/jy+D64AAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDBJDlwIAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ1KzAxOjAwOFPktAAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/favicon-32x32.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAACAAAAAgCAMAAABEpIrGAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAB+1BMVEWaFaQAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///9u4oXyAAAAp3RSTlMAAAEcYarb9f766MSJQg0MbdD577oU9v37xlcJaeqQZHmr3/yjKBOL7OedPh5nxdZRAwQ4nPGvTAgR1+52NJXm94QCJZ/4jw8thuXHDukSI897SxWaOtGXM4cHBsIhNdRs0zBA607t4UFUQ+JVcrMx1WuZgRu/giBgw0dK9JixPx+8phebdXcYN/B92SxJ3oVYqZPSWRkaUytoocunECZufnAkfAWyLqSwqTQAAAABYktHRKhQCDaSAAAAB3RJTUUH5AEYEwUtzwQQ7QAAAhZJREFUOMt9k/tfy3EUxp/Pir6btFK2aUoaKSRdCammVYrEUjHlEoVIyCVyL3LNZe736/vf9EPb6rsfOj+/X+c553nOkRwpqYsWp1lO15L0pRmSSSq5M7OWMVtWdk7q8mREqR4Ar88CwLci129HtNIJkJe/qmB1YQDwrFlrI1S0rhiwStZvcG8s3eQEyjaXzyOkisqqPIDqmi1ba0u3Adt3+OcIGaOKup31AA2NQe1qaoBQc0tCRsYYo/LW3W1A+x7Jn7kXAlUdcUIxoX2d4NvvkJHjwGLwNtXagXANWF1uGWOkg93g7cnQPAl3lwU14Xi73k7wHTqsOKBIow+O9CVk1X8UPMccim0RzEyD4yfm/JEGCuHkKckYGSl3EIZOy+bOmUEoa5WMpLPDUHwuKYHg+RCMdMhIF0Yh7WIwOUT/JfBeDkpjV8B5NSJjpGvXK4OJQcfGYfCGdHMC69asAe7bDPUmLNadEhhP0d17E/fDkjFGD8pg0j9HTAUIPJQe9ceSUbAHQvljCTumH2M9se3W+hS8WVPP/JIxUgG01dmOR89fAJYrZ+bldF/RWDu8iti31+vY/Qaqh990hvBFk+x52w3DLot4jb6z36eiPjzvP3z89Lne4wWYkR2o+ALttVLw67eB6Pcf6T/DMjbg1xD8TvocGxANkD2dHMr8EZqhvWUB4M8ITGoBIPIXChYCFHVl/Uv+//8tzq+CNmFieAAAACV0RVh0ZGF0ZTpjcmVhdGUAMjAyMC0wMS0yNFQxOTowNTo0NSswMTowMEkOXAgAAAAldEVYdGRhdGU6bW9kaWZ5ADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDA4U+S0AAAAV3pUWHRSYXcgcHJvZmlsZSB0eXBlIGlwdGMAAHic4/IMCHFWKCjKT8vMSeVSAAMjCy5jCxMjE0uTFAMTIESANMNkAyOzVCDL2NTIxMzEHMQHy4BIoEouAOoXEXTyQjWVAAAAAElFTkSuQmCC")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/mstile-150x150.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAQ4AAAEOCAMAAABPbwmXAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAAB3RJTUUH5AEYEwUuVg1BVwAAAvFQTFRFAAAA////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////rkHhmgAAAPp0Uk5TAAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+P0BBQkNERUZHSEpLTE1OT1BRUlNUVVZYWVpbXF1eX2BhYmNkZWZnaGlqa2xtbm9wcXJzdHZ3eHl6fH1+f4CBgoOEhYaHiImKi4yNjo+QkZKTlJWWl5iZmpucnZ6foKGio6Wmp6ipqqusra6vsLGys7S1tre4ubq7vL2+v8DBwsPExcbHyMnKy8zNzs/Q0dLT1NXW19jZ2tvc3d7f4OHi4+Tl5ufo6err7O3u7/Dx8vP09fb3+Pn6+/z9/swVw8AAAAABYktHRPrVbQZKAAAIyUlEQVQYGe3BeVzUdR4H4PdvYABBkOTKTE3bKN1Q0KytzCvdUjPvpHs37bTV3S3XPMq2a9PUTNM2d7vWIyq3VjuklNSyNLTIypQ4FOMIREZgBmbef+2+1mx+32EGGOCPme98ngdCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCKE7S9f+E+e9+F5ecWV9o7PBVp6/Z/OKWWMuijMQemIHzlz7ybFaF1WNNQU5a2YM7moghMQN+2tOhYu+OKs+XzKmmwUhIbzfgzkn2ZK6vLVTUgzoLmrE2mIXW8WeuygjAjqLGr3xBFvPVbpxylnQVt9Xqumn+h23JkJP6fvYBvbdM5Khofj/sG0aPrutC7QzrZ5tVb/12kjoxVjLdjjxjwwLdBL1Adul+MEEaCTqA3rlrLNVlpWVn6htZPMas0eGQxvGi/Sq6plR6WlpAy4bOW3WkjcPVDTSt4onukMbNzroVcVro6JwmjUp4+aVe2voi2v3KAs0kZBNH06+PTkeZxgJIx75+AR9KJsXD01cdpC+1G2/PRlucUOXHWqkVw2bB0ATg7c10hfH3tk9DfzC0mvmtlp6dTjTCj0kPXDYRV+c3yy8yAK3uInv2OhN9eJ46MG44LEi+uQqWDrICreYsW+dohcNr6dCE5a05SX07ccXh0XBLXpyTgO9yB1lQBNh6UuL6FvVpnGxcEucddDFpo7dZoUuLP0ez3fRJ9t7mQlw67Osik1VPxQDbVhSF37npE/2XTdH4xfWMTudbMK+JgX6MHrPzXPSp7rn4uGWsqiUTbj+fT500mNObgN9cT4aBjfL1btcbCInDVrpds8eB30ovRRm3VfUsIkvroBekm7JrqV3i6CIyDzEJg5dA810mfBWNb3JCocq/X0nPRVNgG46Xf1yOZvaGgkPKStq6enYVAO6ibjs2WIXPbxkwFPU3cfp6cdMC7QTlpZDlXMmmjKuPUhP5bdaoJ3xx6nady68ydhOTxW3WKCZoflUVUyAdz3/1UgPZZkGtJKWS9Wp+yzw4axldnr4cZoBjfTMpsqxOAI+xSw6RQ8lE6GPhI1UudbEohkRf6iih6JroIuYlU6q3khGs8JuLaOHQ0OgB+sCO1U5vdEC44bj9HBgIHRg3FVD1VfpaJEx6Sg9fHohNDCpjKrCkWiN6wrp4d3uCHrDfqCqYipaZ2wRPbx6FoJc//1U2e61oJXGH6XKuSwaQa3XR1Q5Ho5Aq00qoap+bhiCWOImqlzPd4YfppVSVZWJ4BWzyklVVhL8YdxWSVXBUAQr60IHVdvPg3/CZtVQ9XkqgpPlbhtVBwbAX9Z5dVRtTkJQmlJOVcFw+C/qyQYqXM92QhAaXkBV+RS0Rdw6FxX1fw5D0BlwgCrb3Ra0Scpmqn6ajGBz3naqHIusaKPzd1L1bTqCS1IWVc5VMWizwd9RtSUJwaTzahdVmxLRDteXUeFaEoHgEfGwg6qPeqE9LLNqqbD9DkHDcq+Nqv390T5RS5xUFF6JYDG1gqofhqG9um6i6uMeCA4jCqgqm4T267ObquejEAzSv6Sq5i4DHeDyfCpq70AQ6L2DKvsCKzrETSepyL8EAS/pDaqcK2PQMayPO6nYkogAF7vGRdXGBHSUhM1UOBeHIaBFLHZQ9WEvdJxf51FReT0CmeU+G1W5aehI4yuo2NcbAWxqBVX5Q9EsiwG/WOY6qFgViYDV71uqyiaiGXFjn3j5hTlpYfBD3AYqaqYjYD1FVc1MA75d8k4tSdexhfHwQ988KvIuRIDqupcK+3wrfBv8NU9rXBUDP0ytpmJdFAJT6jGaOZ+NgW/x7/IM+wz4wfqUi2a26QhMF5bQbH0CmjGpnr/4JAF+SNlGRW5vBKTE/TTZ1hPNWU63uvHwxxVHqVhuRSAyltPti4vRnPAsmrwaCX/8sYFmVaMRkDIKeMaRq9CssA00OTEO/ujyJhWvWRGQbirnaYXj0IJHaLanD/wxMJ9m35+LgGQZu7Oe5Kl3rzLQgpEnaZaVAn/cY6dJSSoCVMK4h56eOzoOLeqSQzPXhnPhh66f0KTkAgS92S6aubIHofXOP0yTA0kIej0OUHVkRgxa604nTVYaCH73N1JVlzUkHK0SvYUmtt9CA4nb6KlidUY4WuE3P9FkZzx0MOw4mzj+wohYtOhRmj0ILRh/srOpkx/O7mtFs1K+oMnx/tBD9EonvXAWv3p7v07wbVI9TTZGQBOJG+hdY8nWuUO7WeFV+Es0cWRCG93Wu+hLzTdZ8679VZwFni4qosnX3aGP5HUONqOuePdLcycO6hEbBrf7abYEOoldcIItcFQd2bX+b3OmjxjQOzk2MqxLNk2qh0Mr1nVsncba8sK8Xe9lbbbRJDsWWknZy/aYDb3c5GA7FPWFVqLfYXu8Eg6tDKlkO9gnQy9L2R65KdBKr29pUr2l0El/PAa93OGkydtxqbPeL3WytSovh1ZittLEcSOA6P53rj9sZ6tsjYFWrqikyf5u+D9rr4lPvl9Yz5Y03gG9PEqzxXDr1GfM/NdzS+1sRk4ytJK0lyalg6AKT7h4/APrsg+W1bnoxXdDoJframmyKRJeGJ3Ovvia3y9Y/dbug8UVpxxO/sz29mDoxbKGJo4b0AzDGnt2n4zh102fMWf+UytWPzd/dCw0c973NMk7B6HthgaaPIPQZvk7TWquRmg75yBNPo5HaBtfT5O/IMQtp8lPlyK0Je6jyY5YhLYrq2myECFuNk1ODkNoC19Pkz1dEdqSv6TJUoS4Syrp1jAVIe5GJ92OpiLELaTJjs4IbcY/abIKIS7qA5rcjxDX5VO6Oa5HiEv6im62qxDikr+mW0U6Qlzn7XQ70hOh7gm6ZUUi1A0s5hk1ExDyjPtsPK3x6UiIiLt+cPF/yh6OgwAsGU9/9k3uC8OtEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEJ0jP8CyvaxQtFirAQAAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDYrMDE6MDB45kaVAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ2KzAxOjAwCbv+KQAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/favicon/safari-pinned-tab.svg": []byte(`<?xml version="1.0" standalone="no"?>
-<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 20010904//EN"
- "http://www.w3.org/TR/2001/REC-SVG-20010904/DTD/svg10.dtd">
-<svg version="1.0" xmlns="http://www.w3.org/2000/svg"
- width="572.000000pt" height="572.000000pt" viewBox="0 0 572.000000 572.000000"
- preserveAspectRatio="xMidYMid meet">
-<metadata>
-Created by potrace 1.11, written by Peter Selinger 2001-2013
-</metadata>
-<g transform="translate(0.000000,572.000000) scale(0.100000,-0.100000)"
-fill="#000000" stroke="none">
-<path d="M1170 5713 c-41 -5 -211 -30 -255 -38 -257 -50 -639 -202 -728 -291
--54 -54 -80 -117 -83 -199 -5 -133 53 -221 199 -307 80 -46 856 -428 871 -428
-2 0 64 -29 139 -64 74 -36 152 -72 172 -80 24 -10 34 -20 29 -28 -6 -9 -462
--531 -605 -693 -15 -16 -64 -73 -109 -125 -45 -52 -97 -111 -114 -130 -17 -19
--121 -138 -231 -264 -110 -126 -215 -246 -233 -265 -89 -98 -113 -233 -59
--344 30 -60 74 -104 140 -139 34 -19 58 -23 127 -23 76 0 90 3 135 29 59 35
-71 47 284 292 91 104 172 196 180 205 9 9 27 29 41 45 72 84 217 250 230 264
-18 20 38 42 210 240 74 85 139 160 145 166 11 11 14 15 311 354 191 218 212
-242 259 295 80 90 101 226 51 329 -46 94 -78 116 -381 256 -33 15 -104 49
--158 74 -55 25 -100 46 -102 46 -1 0 -50 22 -107 49 -263 124 -358 170 -358
-174 0 11 313 14 400 3 415 -52 809 -209 1240 -492 108 -71 325 -226 340 -244
-3 -3 25 -21 50 -40 50 -38 214 -178 300 -255 69 -63 417 -410 490 -490 31 -33
-89 -98 130 -145 41 -46 80 -90 85 -96 6 -6 26 -30 45 -54 19 -24 71 -87 115
--139 44 -53 102 -126 130 -161 27 -36 52 -67 55 -70 21 -20 362 -491 403 -557
-9 -15 26 -41 36 -58 l19 -30 -97 -255 c-53 -140 -100 -260 -104 -266 -4 -6
--21 -10 -39 -9 -18 2 -76 6 -128 10 -52 3 -106 8 -120 10 -14 2 -52 6 -85 10
--71 7 -130 15 -166 21 -15 2 -47 6 -70 9 -40 5 -117 18 -179 30 -14 3 -41 8
--60 11 -92 16 -339 80 -458 120 -235 77 -402 158 -540 262 -120 90 -169 154
--245 322 -40 89 -82 134 -157 167 -197 89 -416 -59 -411 -277 4 -175 191 -477
-397 -641 44 -35 64 -57 61 -68 -3 -9 -35 -88 -72 -176 -164 -395 -293 -798
--330 -1025 -3 -19 -8 -46 -10 -60 -3 -14 -7 -69 -10 -123 -6 -127 15 -188 89
--262 129 -129 343 -110 445 39 34 50 48 93 56 171 14 142 18 167 46 280 37
-147 171 541 228 670 5 11 26 62 47 113 20 51 41 95 45 97 4 3 43 -8 86 -24
-143 -53 395 -123 563 -157 363 -73 765 -119 1131 -129 124 -3 140 -1 194 20
-60 25 113 68 145 120 10 17 96 233 190 480 224 587 223 576 89 786 -119 186
--416 605 -521 734 -9 11 -48 61 -88 110 -70 88 -125 157 -145 179 -5 6 -44 52
--85 101 -41 50 -77 92 -80 95 -3 3 -34 39 -70 80 -110 128 -168 188 -385 405
--216 215 -308 301 -469 436 -16 13 -34 29 -40 35 -51 48 -400 311 -464 349
--10 6 -57 36 -105 68 -260 170 -645 356 -884 428 -35 11 -93 28 -130 39 -70
-21 -219 55 -285 64 -21 4 -53 8 -70 11 -18 3 -51 7 -73 11 -47 6 -423 12 -475
-7z"/>
-</g>
-</svg>
+	"db/migrate/sqlite/2020-10-20-1-imports.sql": []byte(`begin;
+	create table imports (
+		import_id       integer        primary key autoincrement,
+		site            integer        not null,
+		user_id         integer        not null,
+
+		mode            varchar        not null,
+		email           integer        not null default 0,
+		file            varchar        not null,
+		hash            varchar        not null,
+		state           varchar        not null,
+		rows_done       integer        not null default 0,
+		last_line       integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+		foreign key (site) references sites(id) on delete restrict on update restrict,
+		foreign key (user_id) references users(id) on delete restrict on update restrict
+	);
+	create index "imports#state" on imports(state);
+
+	insert into version values('2020-10-20-1-imports');
+commit;
 `),
-	"public/favicon/site.webmanifest": []byte(`{
-    "name": "",
-    "short_name": "",
-    "icons": [
-        {
-            "src": "/android-chrome-192x192.png",
-            "sizes": "192x192",
-            "type": "image/png"
-        },
-        {
-            "src": "/android-chrome-512x512.png",
-            "sizes": "512x512",
-            "type": "image/png"
-        }
-    ],
-    "theme_color": "#ffffff",
-    "background_color": "#ffffff",
-    "display": "standalone"
-}
+	"db/migrate/sqlite/2020-10-22-1-import-progress.sql": []byte(`begin;
+	alter table imports add column job_id     integer;
+	alter table imports add column total      integer not null default 0;
+	alter table imports add column rows_read  integer not null default 0;
+	alter table imports add column errors     integer not null default 0;
+	alter table imports add column start_line integer not null default 0;
+	alter table imports add column started_at timestamp check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at));
+	create index "imports#site#created_at" on imports(site, created_at);
+
+	insert into version values('2020-10-22-1-import-progress');
+commit;
 `),
-	"public/fonts/latolatin-bold.woff2": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAAK1cABEAAAACOuwAAKz3AAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HXEByFXAZgAI0ACDoJlxcRCAqClwiB6xgBNgIkA45ME4SAAguHKAAEIAWuVQeTRgyBClspINInPL05bb6akUOyw+P0tXIB+ASER9hVG7JORtKktDyb6tdLg5ojctZEOBrLyyn96hpiAxIJiJ3d/StOROPwXlqMnuImFIbH+WX//////////////////////////////////30nk9jsP5nYIgUK9Ex5LvJVyFFEJHL3LFGknArKKFE5SplTVZNHTd5Qno2z3JuS2i5LMO8lmcOJB3eHIZpSantQT3tAJwcTR5F5cCfHM02AxGY9aI7Nj6Av0OJSScsrq3y0lCjja7RegIbCqR+cItWpYk7JJWsbXJZG0F2a442isoRTc7RJVOC+R4O8ko3wZpC2UO+gwC3KKJCrFJKtPBY2b3OeFErkOgUaBNuVElXIyZGLMrx9B3KQUy7IoQtcnuVsp2wX7XJQ+O7tbcUXge+iPSCoRA72ojHeR7v3s3wHPNCjYYBDWkKREipFDdw08AmVa3BzA5PDCBThCgWtLbO5xGu2P4AfrA6xRBU533t4QomtNOxIgpWzo7war7MO7LW/eUAFHdvvC2wFr67CmEGTSChEY7Zoe+04neDVwub5k5HqcbeDhqKn+sT2HYunWEEVWAwKEC0tgkAZDfmRxdMlRUmbzxw+foTNkNMJX6DFDE4c1SnBqYRc0grGdYXOMj9BmwSVlosGeHIGjfn2g2wi7x1ONDLaWvKB72D5HHTxyKE7dMkiXjvHz9OYdqIS9qPAO1FEQoGdKtrt6AKap4NzlFiTaS9I+hJd3OXk6Aw5D+7cuR9kuymjhC/ojPlEO5nZQ5uQ02budsY6p/ei49FfAqPDJwWFzkZ2mc/TleE4Hwa4OoeixK7Q9FcdzMDRYegjvA4DVyHfIdhC685Sr9fqH4S9emhcxjvnaP0a66o97CBLeDIlbTR2HTpA+8F1ag+BG2hOoVK9go7jg3Y3bL2JrqNSb1rj7LFbB+DuCuyyu34bnpLWsHHoKl21C51O5xu4iZfyQfU2unPX8R2796zP2AeV7fv2H9inh/YfHXfZLOxMXMGNfeqWH6PrwmlBaeCJkpu6yiaqYeKp4KnODguXKJf1qCDHJ2ConNu5QfAMz6vWqs/xWHdEtXwvPyLbQi8MX0YH2UveyCvVTvrK/mv7b+zftHsRr6PJmtg1r1EF98K3V13Ymnxn/7799+aPfvho7p58/3v7b+1vt30MLVv+ZJ/V9FlnTvWmwhf7X+1/s//d/hnjPxj+CEZ0ln502bJ+KJyzf7Inl01Zugx/Epew0k2bxOfMBvymlWkGZYeEP1u+gR7ZyyV34EutVrER/2L6PPh1o4NOYaJ4Qfwbe+A6tZ3f7f9h/0/7f5megL/53/a2w+vwH9DLb52GrvGM3pdwHv2rXerc2yj8z/QultF99WV5xU400hVa0c0VH78XBgVyCtplZI06sMacfdkrWNDLNSu2BfwFw/+XutojBuP6YSAiUTnUXGIsu9FmHeJyHn7LQ+hf8H8mySR3d5/wwQdVH2ALqbIqiBVYuhI6sZbvDE9z+3exvO3WwUaMGlU2UYJKSakj+tMGikmPrRmCNYxRFlZi1n5V8Q//y+Z9f4ZFYnGlexCKg6QrMA4JVtY0H12NSYSK2x2CbXYzi1AkSkRQERQUsGhJQZAUCxMrQJ3Vm9OlutRtuigX9b/Iz4Urt3309v/bx+rf7RMKuCEQVFxtG+g9AP3D2eMpT5q3u04sYm34j04QugD4Jbe+9wtMi/mYFZUCK6okMGq4Xc7jdMx+k66TBm6jva6baTaJkKJnhO+Dtv2WpA9dzLYPpoeatmHimqwLkg0/67yiwRYDtM3//cpX6fayl69DV85FGmyyCnubFc+FrJibWM+awHSK0QicHBwccAF3R6RBeLQFDunUtrk4Bg6IJMft48QRADtgY36In9ctA8dV3id1XdYug31BTjw1h9xbkrVZkRzBPHDiVmHuftNXyd7SnUd+AQsgZeBvd+2saYvcUYn3BXUjUIAzp9bSu5mqXftw0HKYddEHdIwDFv4JAvdIeSBaCKj0T//+S9qcme0fgA86WNApyy8oyV2hnqBQtJlGOJnT+0DAUxDv4X3xJfFVLfutyod0A3i8/6qS6h/33kXHSLRFWBIFkvKswz/Qof47kQhP8lXjkpVqMDdWIM27WtMkPaGugONZyFZ6INo9oBKFlHhCaX5iLU25TsNCx3eqEpZN+s9HRBFLdMfCLqK4CbeyJK+dV5lZxmy+BbanvPP4SmvAJbgUsK+26ydWtOrxn/8BAAXwD4ldBOondCqFjzeZ/A2s0KYJEPBPQMxtiEduGfwxT51KFAuVETLXyXiom2ori9lk4sRZ43SSmoUVJcq5IFZwHmNiMlJQm9+50ifYOeEXqqu5V/dJnzz9l8fvCiDgY+JxPUYiK4URO6hsTwknfNLAvFJitLY9OLzSx83NOoClgO3tca+7l590zy0HCIsxdQohG21D67AkKLSKFnaAHhxe7n1XnlAIS97W9RJ+ncvAQLtljqOue+hcIDKV8QQ+Ken7ItKevVOjToTkYpSRFyrmm3+J1ihpm52BaPX7LVPISxGWOehJpK8Yd13ZVfUnin1zpTowjJ/utQog3OUOurErms9LTMyQx5Ks+OpTb7ZEV7LxQMYPj+MAk1zO7WZlAN8fCUTnd3rL2GmVctUBANn/S3m5AwAEcBdWVsA2n95e3+X3S5UG2ubJf9myTKLDd2cr9ABT4AHeE7bjElr+PSa4UBmQysszGvq2IOU2TZqKvKPLxedoiAcWKpvMaIPb1DxcKjaTqcDoC5iqTYLgCIVtyPn8jk1RG1BmZiDPk7uypbbdDUcmSYokZrH81DxhkURDRfb9mhoBaO8fzFcF3qnr1BIIx+7GR1l+QVp9SdwWS2EBLOcG3QYTo8IDtDcI/89svVaUidNc8iLGdPGJsRYViMLu/l/UKWq66jDxoAHBZpObQhhaQq5z4wnjVR6DUBYQ8STdAOQtShCWPLGplxGVA22yAU758B4UJEQ8eGlkBT0u+FhGWUPbn5hsTEDvdndcXDa113fHrw796svuxL0KIUA5UeKV0FyipLa4covLK8YCLdTKai3YCGOndK/yhRtwrPXbqlvrtK2l7WFh4uJxHA/xUjAxIRMzcVRNThklNRVVRVUVXfUFFmEhFmRRFmmptuZacHEFGFiZmrEZneEZnwUaGqIxSgGdSZq3JalYEi78KrOXqqmo7fsLHGxVHcNxuq8bcXFcPC+yEje43Y6DmP/SadcZSfNAtrOL0iokT6nlDmyr9z3ucW97mv9nJPjzJcRoAEcaMJaUNshtJHAsSp40I8lCgBt2HiZxesFpRSNwkUmRXLJu+x6ks72m1639Xko77eG4p2Op173eluj7Zf9JJ/dFr5eDYltCa1ALoPUQ9+s3Mmc7eg2c/+TcXT/yLW8AcgAJiRnX8iM/8sO+AUiACgqJISUgECTg9y5TaiGnVJwOoAEOQI0B3+x4rvzMU0qX3VGe0ouk1Z216QWwsADUOoC4xgIQ1CwT6zroVzNTA7ic7Ur5NJ1Xg2wnP9oyugpyGQAUqHC/1+6bEgvn2qSUXCn5roBoPRBSYKuUelQGxDJCBswUrmf9t588/yriq4OHAthRGhwYBoMTPxxI9okG4o0GPTIgsk7OH6hlSlmLWKdY/JRAgUnAkgmzfE6oBeFMV8wa/Keps6U/x1rXeqrcfyjk4VySHjtfMcZ2lSz5g0qrTiIREvTYEikgMi4cP5AWzLKZShLrEz3P///+/Wm5b7pgslxthGu9gxfFXoqzFdC/y6iQeFko5eTPybQZmDRnnwIOaARrM3MfioTWwCW0zVogFajo5D+eXxZKAQbEko1cZmzbHthFILlABovEIvB04vc/Z4YtvF+MGlfUioqKqqqqlff/mRP9r3UCf0vL3727XAuPEEwwRgghBjEYkW3vWo0ARrqte30sSBKEQ3ngcaZ+kh4u7emv4c5ljBDCCCEeUTOZswf6KpjHIVJ8liIhKIjwODM/ngS/pzn6pEMIwhhhhDDhk9lLZ1tKIGWaPtg6CYsnCU2/pYdt5YqeOpL4xAxo/fFsfX64RXhjmxGJ0Qk+j3+MrYrqd0MEVAxAZYjynuj3E2P7xajKpyqOUEKoKCFEVKjzW4HGcAnAUZ4+6D3upcwG4se3NlQB3yc3lFSC8GcUNtWABVCvhFquC8vFoeIwgQQ9/YkNNUA86pV6XzEIWK4CbLOuMOBEelU5c4n3JRJtmFZphRZPMEbtIgBddUFz7ffGovizBbWGtrVdSuQXqRo6vIv6mu7uxz1kaVbiyl5toN13gPxNrNkaNunWJgP4Aj/Afym6hb8oHhiZizLwaZAmqDdYGjwYfDB4KfhtCEHkopBMyA9Qj2eeJejbYLaAcWHt2tthu2HH4GHwTfBH8P+eAQYMjQnlhm4NCw+zhvXe0RjDFshPyEf4u0VSe9gvYa8cTvCg9h9ESMmLjEJm9qRtF8h+5HbkpSm/QheEPULvdA/0bdgvjSxxEyD7MS2YTZgTmLuYH7AuWC42DavBlmL7caO4j/AeBBohWlFLEjsJ/cjXkD4iLZFfEPoXT9AaxxAuI8/zgBJAw27cOJyYQTcjPzO+aTxx8xLmlMLKcdUK24UpDwUJGBgChcERfQGoZLxSZanxV6tOfRr4SeT+3vnuwLvrnvt5QNFDj66lVzZxiCEaEbgLCNPqqzVeRi/HMGgL60zbI4cnQM+YlUu+oD5cfpF0YaBLhafv6laSmMZrN9wACWxNCCEzItyZjI2DWVjmehfWVpEMzRW6Dhlo8GIEBQlZZjKrEkZwCzZcSERn6mKTkXlozrPwytLYiqKSxCUBL5mvuYYHzoowi4yhGrWQGAptWMHzDuH2FizcyBYlRQiYXG8KxiukUwSIvMPBaYeuRa3tR7Yx/ozNCDicQM+hl+7ux40QwJibha7J4kQwZLk0LJH+DOWDGYk+/QYMv8jIzYqywd53cHDCuOKm5eE9b7bHB+MnATR4AEIwgmI4QVI0w3K8IEqyomq6YVq243L3HyM+dngCg6BJhC2SzUGZw4VMwbRbY16+OmmttXV6G1QkL6NoMYQ4EpJS0jKycvIpsCgeXyKtjF+FsCpFtZbQxhFbwk3HIyQiJiHty1vy7ytzABAEhkBhcAQShcZgcXG6XR7/fA8b8YgAwBgAatSsVRvE6dI9/SBVIiqNHuOkyRp9ELpyolzl8fsBZjoisUQqk6eIlSq1RqvTZ+gYTZnrF6stewIQgBCMoBhOkBTNsBwvJFqSrKiabmTSg8QfvkCYKMWxJClLTzwdkOjleCAnG4TregQRCiav5yRyEHVMPN6MSRgfD1xI6ZHOk48Mm3Lw1QuKJdnKqwlCiBAQ9WtrXBP9lN/PM3Ws7tHsIho/66MGqqRxeXDurMF9Rs3MOhZKp7c4p46e1UC+3a0d7ghrJC+qllcdXkcjrebaOroRO5I28kW35Ot8bGg4EwoMf18fUYiJXv2XEPiCCQpZRP4FtYlmP8e9uSz88163fKVsHDCu95UERkWNZZwaXobScUYOJiPMmlkQrCJssHP43g9+lFwCRNgiNmUOF9J4ZMmaSAqiilWharHePIcsd3TCsdZFrkuWVFJLEGPVnl+y5kObSGzty0dThlNR86Gh5cuvv0zQ62hFVitOibpSZalxVKtOfRqak8hZI2rWktZIbSoohcZgcXgKlUZnMFlsDpfHFwhFYolUJjcYTfnUrqJJVlRNN5wu93f2rycTIb5I7QKvS7YXajN9a9gddTUsgdACISH61m+RcmYXyklxi89EESJvMPs2ktwhxte2e1Wh11R6J+pzv7ZfKxesp5BfRbQiZzl4x2E2aq57Ug86PPJ6kj4bd0wv7/m1V7aVSi4dWyal7Efvflvxkw8///jVT1d/dt3n135br6hBzb8DeONpQT/77LnAArE0tOJe+3bk839+8+9v3T3Q9rJ2sntwwb8hxDhPxbWgbDASffoNGI7VL2zscdrKFbd4Ht7RjOazlZ8ENIAGIAQj28Pa5/CbBCRFjwaWjeZu8CqIkqyo2mh0Da2pVttzDnXhfvNDUu08hTaFaRZz5rNgq0VLtTxtq0qtRVuXbENH15ZRDWJuiSMhKSUtIysnn4Jzin9PabbyPJUnVGeqZZy+gbU+V4tcv+YmPF9SK/202S/6KZfC/Nn8sg6jKWiItSUcqak2qL4+1y4IZVyJIYlETtT/n3wUVNESJWHjUFLJpKaRRUs3wviNG/6XX8hqKb259X/dxaz2PMJZnfFpF7R6p9Xn+n9dCHQ27W7Yy7m0bXjnEZ/X5W1AZhAQeIT5Er31QhceI9RFUpSjL5nMS14bffN28mWvQ27Ht6px4CAEIyiGEyRFMyzHC4nnkqyomm5kypst1myZu+PKyQjeU3c896/vKEz8DrOPCTGJ2N9PJlENmZuyQIcmThdC8OZPmt7fVSWiG/m0g7jDDzVOzUAT1UoZU0/tVN0TeiD1yOZJry2b5fVbGXlZ+XkFBUeqz2kwo4mVp8EsjHO5lsKrSy+tfPsXx93Bpl5oX9MTE3pD+Am4yYTmNMJFwaSFtIjoQ77t4KTDdlR+5h7uYROXqlc+9Jm/WW3GKTtwr4/MOs7RdBFRBIEQl0WASLRHBk++ZNMOvnvaaLw49aXulTdzXgsejSWEBF9jCdloWkDEDeREB/kO578RNeXQtKkxSqwflaazeu63tFKavvptRtlfy52udKbK7uqP1rjhyz/Uy6p87EAx952TtRDcS46V4ODjITdHfO/fl7RVmWnqd+2PdfWhXAOklwPpDIvfKaRZ0xmPwp6EM/GOvVko5gYWzMs8nMrwWnf86l0arZqV6M1a9BiANoUODBtnRVC4b6tIOs89PFiNlGYIvU2LBmNcG6BDjSUnqL5cq1GreQzVAW+uCWCYmPx/KYFFmNFsRx/RYtiShUMUZDB9a6PNhIxLR5sCKYMEDkIwgmLhWIKk6GasZ4MrjyBKsqJqumFatuNyJ20pe59CjeYNOoPJiu19Dtch3nrLem2CjoauLHvG+N4UpArv1oGPxJ6XyKQmW+TaCj95dA+CKISoANi3T/Wt7V+LtNxRX46xja9vKkzXDGbN1fW9G7ftyRsBcDS1eJRQkjo/5L2M97Nw8Rr5AmGisWLJlewNEG61dmGVY5Y7wYfew6K4M9ufMfnvAgNACEZQDCdIimZYjhdESVZUTTdMy3ZcbpoZ/WtKAyotqn1qg5rnPzHzgXREC/6hxhqKtRh3OJ4SEhGTkOrVpz8P+HroUR4L9mTz9P9gyEIQCDJkBHVcK2FMstqJYrOT+3cmuVkIWy1ok51ddIfknOS93lj8ULQz9hhQdvDc+lB1E/CfDftTNhAkC/p3qzlxiOgFKHYlrdQoQ6QSpKqaeE8bWihPZXPt2nEPoGYmbPFmVrPZ7MiuN4eETu83IqBLjB6aEt9rKwU9imJRUqWplWEqp60BWWsfuA7EgLLqwAAKCKVPaRiziQNkqfV6DXFIR0EZOPaI2NNEvRiYSrUqI1Hu29VuknxcwyRNshrqZmXRr+OZXXl9v91DhZmkZLEtK2O24rF2XTl05tSb1zcwpXIyTdYtYloZsmnJPvvXOGzPKekRutOwvLafQUd9BZMQQ+DeASU4tSxsM36L17OayWZ7drtyiO/0biPPy+VG33dKXK9SPOv4+fGb/G4DQrqQLqTz49f58eMnpNtIzGnQKhso+PHjhwv56JTp7MZGnNQ90Q8Tqd55zbo6tNQwZ0KaeegTA1WCBi36pInhd6wZfV0RJOgYhlZbCGuq+KHqOP1uoJt9NBLBj25/MjlRD1LJ7TKcS783L8R908KLqj6suXN0IRAeJHAQghEUCycQJEU3g2ODK48gSrKiarphWrbjciddJPuSQo3mczqDyYrtSw7XIV61rB23xQmcdMppZ5x1znkXrl2bpGNWl6jnVr5I4DWhlwBfi30gcVVqsvfOvaDwp1FPlSyh/yB/qYoTfqO3hlBoFpvBXGxfcovIS1toRCWGhLRt1l5vSZ/+NmwmE8zb3VnvxvYwY2aeGc2ZOzTXGEPT5pslWp0BBkIwgmI4QVI0w3K8kEnX9PK+QJiod7fYl26H5szkhFqlgVpig4Rk+HUA8Qr6BenkrDNXXVG6ZXYH3AB1aWYHukTLHAakTVNsaYWFwBBBQno+KleLFkNeTJu3/tCaDeiHwUOMqbNYO8wvCeECpoADByEYQTGcICmaYTleECVZUTXdMC3bcbk/+iEeAevCNvR9f0rMtDgSklLSMrJy8j+/1KzcpdKtqv/PWDGI8KdxT8VTQiJiElK9+vRnYKBBQ4w1uaymMG0ms6erMAAhGEExnCApmmE5Xsjkmi3WbMZ9c/TSomtohuhb8eyGDHURx5zXtF15+TO7P2d90qgmScjqUYpRoxHpDCYrNonDdYh3Ws8qvR4U2Cc0DpDF7pBwpchOy40pXFTqgS5DfJUHYU8j9Km5SCs5feZw2bOTJ3biLNobInESJbuMlnWuP/SQdt5MNOJN8l2FFu6Tmuc4s81omFYq9OPPaM8xQQU4G82kSokTDa1TD5noizrQqkZhlCrYETWQAQFPpA1dAoN4HUG7zu+J806znfsB+hXVcCB64k23qtzpAW7bVafuXkK3FQ/xakYnen3Xb5frgKFdpdz4SLreTxf84hhKK3vi66FwGPwlJ9Gn34DhjMuGR4ekP93gNKPbzJKU9inXqO508uVOv9JZOH/PrXe7c4/n4wXQCRQtRizG75KsqJpumHnOBgMYIrNFbMocnridSucmjZemNKEvECa6PNucn2SGqpE+Ckqzs7ihOgrasb0pOzs7Lu7E3fDB2HjY2A/FxsObeDcfipmJyP1jjIfj8nokiIiIiIiIiMiDxRhjjDHGOI7juFwuAA9kDOV/qtYG/Ng7bS9FXodWjbzGMXjzmR6BT0N9zxRuplN+zFAwYeRXola5p/Mg1ALCBazzB1E8v0GDP/OznqWx4Fm4KG2wXmhv2G0c5bje5zkI5YCRNCWWvqh+DBiOIVHmWLWzsceplitudTy8fUIvn1r+BNiAAxCCERTDCZKiGZbjBVGSFVXTDdOyHZe7/xCzVlPEZs2Zz4Jai5aWy8RW1Vmjrbtvg36UTYxZXEJSSlpGVk4+BaDi6ZV2tajtl+002g3YC6PqwB5gC0QsIVWpvgapPGPU1JWV127c5s4BAEFgCBTW/rJwigCJQmOwuASEoiS3p6RlZOXkFVJcW1JWUVVT19DU0tYJarFNIkfZqtLoV0zzTMUBj1KJJcUpMnmKtaVKrdHq9MuheIxM1ub3F6y27M1wAIRgBMVwgqRohuV4IRFMsqJqupETvdyn/TpZGeJuzUGUkMc4LJxY+pBKjyxwElIyynVNXdRWBd8y8JM3+leGmuynxJWBhwEgBCMohhMkRTMsxwuiJCuqphumZTsuN1VEE4OIS0hKScvIyskfLuUrl6judrreudx16667e/RtQzxCImIS0vjXl4MAEASGQGFwBBKFxmBxEV5UadEbFCqNzmCy2J9FDaVIGcQ39PTzj1QJAEIwgmI4QVI0w3K8kEkyW6zZuvfNcSGzmj98wbkQVdaAKreFYnNtRi+yDYPTegAfccQuU2b1O+YCo0suG3U1vWLM/HHjoDMqoYCNuEfN+WPQySJrq8+I1w79p+wVAaoKg6uFUEGmlWzrhCqmw5SyuFFhmlAsEqnJBwAIwQiK4QRJ0QzL8YIoyYqq6YZp2Y7L3X9q+M2w3/NnZ9uxOdx44QsqnLbYTzxaQkntp48MsnLyCopKyiqqqVVd43CT2q6FHXtuiz37Dhw66p/suwSAIDAECmu/xikCJAqNweISuIeiJH9T0jKycvIKKc6SsoqqmrqGppa2TrpnT3/rCZHN4hzcGCVycZqZiYiIiJgZSQIAAAAAAACqqqqqJOm663e95WurbSuK9lipf3mrGwRo+2TIfRQGXC1nh0mKPkemVdLWCXVQw8PGK0oE1bra8wAQghEUwwmSohmW4wVRkhVV0w3Tsh2Xu//kMU37PeqeApk1Zz4LBi1aqmWyathaWye2QT9KLUYuLiEpJS0jKyefAqni8aWAclilVjWuVmM7aUfWdyvtheoo1IGIJaQq1ddSojJGTV1DUyttpQ988tkXL169eT+MpYgY9T1mZwm5CqqmG2au4beHj/xjS8gUFkmCJCklLSMrJ5+CwUUlZRVVNXUNTS3tdKq7es9M9D13sI8JWwpaKfKlDBACAYGDHIzC4AgkCr1kQuuGTbRt8Q57hxxXo3IAQjCCYjhBUjTDcryQKE2yomq6kQmeLdZs+O6YztB74F77VzhR/8YRwpXHeL34W6EwSNaV5mmE9UH1iwHDn9J8uvtBQY0xIi6Xy1uEJEmSJEmSJEmSJEnSCh8fAIgIXHAcqqqqqqq63cZjfT3WD0qSJN3WWmuttQAAAKQkKGs9/h8gQKLQGCzuszQgFdVgYIwxxhgjIiIiIiJCEtltzJeaBwEAEREREQCO4ziqqqqqqqqqqqp4bRgGAJ1ZAJAlAF6xRuH1nn+hfuF5ZJaRG2dKZvYZGoqUbDCtkxW7LzJzbdVurNzXZryXDzeCroQUDIHC4Ii+IKuCqtLoDCaLHYesy+MLhCKxRCqTp6Bb6vn+D2kJC+WcZcG31M8P9nt/L2sf9jvAbcJBhz5TDvoiR3McbPsV5Hej3nWPysTR3KvG5/EutrQs384cpzfj+sRLUNyYvsbdhuMxaGE0ylQgs99Z6q40rMJmLNtpj3O965bS3T1SevNcPGLUmHETJk31u7YG4xGFzRfK4oaHTK/1+wafaogWIJhjPWbl9QXPo6DyaQWb/KudB4TshlvuuOepZ557ATojt+dYu1CkJbanYf6U/hu/LY3ILWRDbQ1nANp1hs8UD45+oVz1REgcuXLlIpFIJA7OklMsLcVB4/o6j0eXIBQIk+LnJoASRokoMZEASSODkpeCKLmoqKMB0+quHvS2PqB+N5BBQUM1rEbaqIAx1sftgTtDB6CX4w3KGNUwoZkxWfhYIWzC3ncIcBJyfYYWM0I4SEAIHAAhGEExnCApmmE5XhAlWVE13TAt23G583HAE7MAER4ZTqPcay5V5nAhU4TazWiGgJWtugnQDCpiy6iQGFIcCUkpaRlZOfkUwIrHl6qVEyqDqsNq/aFNILaEOxSPkIiYhHQnGzQwGo0eYk0zoNFoIBA4tKDB+tePeh/1RdOVYVrZIJT4MPhrCMEIiuEE2VRXrQyW4wUxKTgrqqYbZtbg7eQaeHuc/7pNxa6a98CAXr0BcI2AQDNln3AjogDiLQQAABwUcUgixxgwWWwOl8dvgWAoVVSEWCKVyVMUliq1RqvTZxgYTZlrFqstexIBACEYQTGcICmaYTleSISTrKiabmQiZos1W3h35PRf7mf+PRvwO+do7/8HAEIwgmI4QVI0w3K8IEqyomq6YVq243LrDNMysnLyCopKyipPVNHGMSpa0LioGUC+4+hdxyxP+pz2Ogvn7eJNl4xR4DZ+BBCtgTr8bgXdaZ9lirvKRR88e/HqzbsPn758AyAEIyiGEyRFMyzHCzkXrs39mvzXPpedgZ5GMUpaKQdDbDM/NDQ0NIO/tx2zGafFq1lNZtOd3Y5Ob//tRmi63OgIMk2J7hVHr8wp5cKG1LpTjFaOrDzZVq7aJdchu05bWq+8DTa3kXoXyc266lbbNtzbtbfD8nbS31U7MsNOZWohs8wsESBrHLZ82AvhKDlnNGhMfeM49ypvP2oH8pe627ImaB60tCM+76raruOeF9cNH7wptoee+0h83lh5H/zEW1g8TkEcVDihrXwibOoJBxq0x7QzHpd13cPtedVQ2f8hNFBLMCfRp9+A4YxLhEdnnv7nGbQxEjLLwErNyc1F3Gge3vi4+RMA/yUAQjCCYjhBUjTDcrwgSrKiarphWrbjcn/REFX7a7e/8c9rQdWb/xcvGlUto6bF+MVVQlJKWkZWTj4Fs4pHl2qV06pR9QGNXs1hX1jZuM1g49KATtOwcHM/AUGsxj0dzwiJiElI+/Kz+V8q+wEgCAyBwuAIJAqNweIiTFRp0c8rVBqdwWSx4xS6PH6CGc85lDPdJWUVVTV1DU0tbZ10p3v6X1TU1QbuIUbGJqZm5p+Gvtp/lfYDQAhGUAwnSIpmWI4XEmeTrKiabmSiZ4s1W5axQj/0CNHR9a4iianpANvfehBeFCJDDIiNi0dIRExCSkZOSUXNh4aWL7+g+Qt/BdzX3gX5sJQ5UM2O+ki0xMXS2j7kA3l6pb/UcPrSptG7P6elmnSDL73nUW26H0aN/b+4KD5OcD8mxs4RvyzRTTy3Fbur4E89oe+3RbpL/wepmREKhJsUK/zf6wLcTVK3GdxVTnbjiGd/zWOOcQBWvySM6dc+lPTUgkHhH3WAON2HAh7l7kL6pgBI78lWjhOb4rMmkjAaac1kaz912wgaFxIQ7pZnZupEeXcs8SbDFE/ebJtn5lvz7eI7iuTTn7aky7AubZHb1u3mQXRoOLTHZ4fRwzdH23H4RMQih00yT+X6wYLDp3PxQFYR3IkJpnh5O8/X6wdRn1bsKI7O8vU6gNa+iF6gYleilGFr+AUOtkl/gYNdPQrzjRymcqvthgsU+PMDY05W7BMcN3HSpcS7UMWmRCnD9gBCu1ChQuHzHdRiKYNy+/Y5sVFopE/KKveWrvv7wfgypvpsPv/TUvnVrwQCgUAgEAiDw771Qv1iAxKSNGTy31V4Vuk50CmIp3vtqTsR71NO0oqInq/0VMbKKvef63ro0GjZNjtpTXfj/W8cHOfbKOAIbQNsF1MluaVUWbwxt0UM1naq9MHuRuzNFMdytifwOnazcLOmumJTojRlNtIZTFZsdZWqNGl7phcnOAkjWDy2eLN//x7Qg1AsK7thAmJ96r6kxUjzZGMAMXw8uZJaSukY9XbjLk5UTSnFRrVYYmOpBtCOptrfZJuabFPbEholKZ7ujE4sUnDrlamZ2QmLxaqpnVbD5FutTwPAi3ES66U4WVRhx5V2gXjIsMrNMAaRS6pZmePGGHU3YAxhDBmxl2Svfhzu7MD+Dk2DZKVQ2RyuQ7zjfXV9dKGNgE1ij0hskIZsyk9zjVHZCrsV0T7FRlo+0W1FGRzrNMJFi2VipGdxAA+KK1BF0uSp9qsZg5SoO+m5n99XuFlpAHQz5AbYBkREapg3JJOzMjYiKRTFLjFypAJJIWmY1mDiHN6GceITpI40k1bK3BU7rrAWKKikNhwgecMKt8YMIKP8cLDsqspdccgBSRaut6IMHk0LljQ1DCa+kkDOFNwU8/a4a5uO0NHRGet0dHRwur9PF434MYwWZqIY93YTjuE5fZL2P2wjXWxyk7u9EnXKq284HL6/QRmVct06MGeqNxrgB4XP+OXBg8cw1bzSjdHDMY9wNRsMweE/Dw+vTBSlyjjaG8f1rcxopy3rpvBKo6THVOxKBvvrlBEoZ3tanaBjYnZMzMGAoBuXrtswm3G5mAOTv2vOgthHETdRwquRuck3LEm9DbYYGD2u0oM1ldfLRBGNcezUU4APSEtLy9lpaWlRaGmxcL1mat56U8TWO9DS0oqZch/gp8FP7aSd9JFKSb80mwcyz9ba0fblvdO3wyuFCu4r6GGKfSq5lV9LOYjsMFiHwR4K02WAqXPBYDyvJKYIFV+Q6qj8CeufFJKniwG2xImx6CmVcTAYFK1xCxBCoC9dpjFkMKT1guduKDA/vRT7z8fXPKO+H3lWAJbtOwHrNNQNzO7dPebiA8X54r7ndk097RccvnF6y+7KjTezm56+I38MPrsZMD2zE5TpoTPuzTPN3gYojx4GghrqAeihLWOuflCigH/hEzvX7L2rjCbzacmJTTN79AVrQ2RqSW58Blh6EZem2EG/Y6zOBipXLDJnczGayJOA5v75MNBcUxbjqYdj59PedwE1l92QYfTMe084HlBNRHprX0xYRA+sN0Wk9+64IgdHMQiKtucAKK5nD7ibPlKj8MiK9RWbh6J5dxum5mDerZPo2zz5jYCjT++OXYWixel9SrCtzu5BhTEV+ipstElCn1mXXJ4pS1iYIqY7ifdNABzakUsWAOwZEJbbhMjX7DFTmDNr7Ju1x9/8b6/XOwasD8SeA+iGCs5N38NoWcLcs2zxAzWwUqRv98GK1kb+Z0tT7jPw+LJdI6QATwXAprXQMo2LTKJswSmB1jB21dNQ1Qee3nD1KO4GyE388e+Az9zM64CHDqzBNwgwrhUr+Sc2j6J8F7yXZHsIlBB2ZR0ScQlsBEVkqDMb60k+IBxLUJDMR476nbFtfWrl8P7gWB7s4fWOnn87UPUPr8Mh18fAwLbjfygXeNSZX/brHXq5CueaPfWBjxy5+MTBxRkUbJFon428qAdq/ZH1q8T5qdGF9tfqNx8pLnfYyGpANPvLtnPMpzxlWv/E0wDGlR9798Cpc878LHDj3qnHjGQe7igwBcjm3RgfrvJpEHLqYgXaZddd8cmxi+5s5KoGcVpkelKS/N61wGhKdBZpZClYuqh780EJANzCPMSiKRFuI16dJbXnluMKfgliUF7hV2IC6xz+VRtUHSN1xom2n7Z0+QXskssGANgSJzmoJ3cAVo86HGjlZe+Jf1b0zxXUX3+GIJADbiSI+ONVwX5gOoSPmiJiyg1CQgajoEJgaFB0MRHg+yRiYCPhmMi4uVHwCFAJiZFISGFkYkLJTTRKE0aVG52aD5yGFoMvP0z+YmIJMLHpTByBJq4gxNvaWgUGFyQ4pBBRoUWLi1i8JUjdW3UgueRoopRSKmppZZZumqxWwcqrAL3CiuGVVIZRedWYa6rH3nDn4mAv1YIHthoCJTIAS7RjllLM0hjMJyHDUoFZ8s3U0BEUF8A4fpVgoSmRHmvCECYcT4QoTDFi8cQpQrZa6oSKP5LBpjI6JoYKkaryV6zQrlaLqk4DnkYclKpJhpdkar6katFKrE2G9v8j69CNYKttKLaLHDvk2qnULol6LuVmHaV0TLbjYk7E/rV73mLmnKJwGtXO6OOsauf0cTntz3VJe9eZ3OF/hP7KGv7pQS5e5ISkY7d8HImSkl8iZ43lZmt1Lyv3My5Sq7LzywwNHcHhUbb+qq1tJLupHry99SM3kJHwcE5QIx9/ATctWrvbg9reDlW5DufJQzPZDPHZriB0tdv+1Z3ue/yDHvl7j3tqZe/Q8G/wGjMU+Ia5i+05XEqLILfGTUg/tuYPEoS8csfwzPGR1zWy8cf59o4Pbju/Kp4XgVhg8daXgIrd+c2vrjkKcv9Yt5CRi3wn7GsDX5MRxyzjK8LEUTxnSRCZdT/L+uT5u8ZO2jEgj4urhCoqQskmuunBrQJRvSKgZdBikim+fXsDPNmrdnE45T238GkjsUi7yAejsH7qb1/VR/bojNQRzmpaYigt1gf2jMtotK9yFQqUq6AoQRne7Tn9thqvfOmfG1PwTZzb7Tr3TuYLuXp66rRFiE4LgP+QZTKTz+6vcauOScD8JU2CFSdtumPM9uX/rj2r0VHNdoJrQrDZdw7ZNnni6AkH6CSSde/2VpL/4G2t7ZFXcvoFYH3R5bi63r140fgHlTOaMPzGcWNRgxgavz5SAWpc6cjtK/jfLHhfJQ5sQgzN6CorZ7+k6c+6ycKukvG5Cq4ctkUHp1K3Yr93HmdvbyTaN9t9fo57H3yqMP1E3xR6ciVwb91gj3/Fl/3FeVYmaMj5BjN7HaiqC5igCs+m2FEAKO1d6PSiXpZYI6VjNvrYVJaEAfWrXnqvd6gFva3x9FN37W6x5/pWFgec1gsP96a4r26uXzhPgNncYCnr3tCFigLcWh6SQhW0qrUK16HHAs6TOe4wK+4IEKyk/YrkRWsMyo/hGFERU/FUK12JKY9YoSnGhA5HEBgy0MVVgngJIIn/T5BkAYqFViJZJQ9ZoSL0AYqIMkhbBfLQHJSnentqsJ+oRgXBYX/SdFiPbgNXCTbahKTrgm7zMcEW3UhjFI6BiYUdxwAkJPSB/6sdHN3RD43e6EMaWIhIkUcVCips0Paj6HEMJDgmNs4Z5bPMLBM/yyEPZKMjJ5qQ/IY8eJsYOd0cI+QUyBknJK0ho15noaHAEWMknSQTAREBKGl+SWB8IstT5BRQbFPRwbjnpDWhkMOgMChZwmySMJUQE0fwJSiJ/IBCNm5sTvjHSAQFCzmP7EjEhzDPeeeFOBTMbBKSKpFRr7NQEGMkFBICUFJnE3iaIoPE9hFMxkDqFywSCridJUlcbRFK9/GPGq0yMUAOv4eP4ixtnhXJoAmRVIQRi3Wx9xJDQSP93wDHX1CgXdQMSHyUXGQNxeqzH17wV8O/Iutv2vhLuOs3W39iUbvhng+u+l1vyt0898xzjuB3TRFQSjf6I13rPYtNs6Nnq9UJ2D8AyJLGfgD/xvP/SOzcLWugN+axMTmLBYDXuPiVNPbCqmdJzd19T14+RtDmN+b+d6XLkUAuOZLeXYIDzXEno9XfaWecdc5lV8MkwqghpCMofiBwyCUBCoEiIByIYFmkCoE0YonBqBh0isKlGESKQSE8/FPhlYsYiyhSgb4TRsmCIuhqlXI0/FaqGwqXjZQIlMF0okhOfzLMU/ZFiuCnxOGUmlTIVLhUuOjRTIKhwVBRbwdKCmqlGCXJCiQGr2KQKgqjYnAJ75R/ecIiQoJSBI0eSihl8Jx86JBhE5AMJQj7KxxcZV/sMBjPwlfkHTjwaVJnLeZwEDi6ae/HsIEqX8GFWAi3Ow/x0CVPhBH3eMjAgwY3CqAjHjSI/aAKPwQWiho5tJjjEQaHwWGoMDgMDkNGOSV8NAAJ8ZVeJhZYOBAwBIwbG4jY/t8OKxgaHAaHocLgMGQUBCQQA71fEOBogVrw78WF8d9/q6S47RH0gPspcIB9Y4dft4Qgz3hqWSHgfq2XTqUGNL/DlgPaTIQR4Z9TsTPXtjoQKQj5QCsNkn30iFNmIXi86SCQONCIDEatkp9cRdaq1WKj7QZY7eY29WsNL/e8CTAMxWKKR8BCoAX08YgVFZLT0NGLlmhJaS0vT0InUW/B+gMolqNcrjVWWhvPOnkMiqJDQUZBOlN9zDLaksdKW0lX2GSzW37JIR5MLxEsThJYsRSwcqlga6wAWxs32DqrwAyJglUpBOtIGqxTPjgULBrlmeo1W2yxxGqpzTK7ZEdCZ/2uxKNJCL5nCejFWiTN3DFzGh/G46hTw3bNbaBsiA5xz67KbFGuO9Z0LjN1nrkLLF1m7SJbl9i7sozbYQNY3GPO22hDNunS67hGGZ3QSZ3WnE4NxtQZAsjOlemAzqZxBQHIpzVuIDbXlP2bihEeaBsE+L4kFIERN/CZG6C8Bt1Wz36+ueAG/jsvMpZZ1881sw28FWAMggdwI5gctVU7DvJfDwLatiUOKgCEXbVl3mNrfndoPJ6haCOB5xTGCAFmtLhs1nnvMjAyMbNEAB9HBJ9oM+1HHj7NXvjLgN2BAkMnWcFcPEKiu7rkSipqGpD/syHTCRTkln63yQ3rF0wvTIQYcVYrVmYtQz4kqtWo0+glzVp02Gq7HXbapccdve6CrIh9fpELlVFuhtpqrb3OOtoo/Na629aOzJmyZGu8fe1tf+4OdK2rXS/SWigdAmjEI33zvkwNUcbue38j9Se/gCCyFxZERfzhb0X6eu599TEgMvjNUsCf+pFicjkKTslHDpkGV1x1QgY75rhvLUNr8iU887y7LZTvgVEdTsWkpqcSLkSiJAslyG55HFmtaW3l1jCycnLZa7f9xh1gAoy5APAIi6S+ewNn2tq27EOymN0bD8a7k3Oanq5M83VAi7tEIEmaDDVpTFt6dFda2ycCmcma2dDcaKGt7pjASKNNz7xqh/bG7hYHjU3Oj3JxeMtr7utTTIrI5qFy+luSsx4YnvkU3uBDrWcd/D/yReL/LCxNnezB73WL5/fy1zSiK9W+OP2/7cbO37YfqWFOijwr4Pt0HR8G2NDyfTn42GJj4sHvzvy8VcMj+8jPZ4K/5wfzk/nF1vZXFOeq+VosG3z/9Z/22/CIiEsPMvr7fM3bLnrInrrXWb+57GVOr152EQWigsHMj8zbHxjocy5oEB3Cb2E/P+OayAjIERJxQY/4SAY4CDUyQgnxztWh8Q0pg3KALSopRBU1DKhpoKFQNuqITmQaALxo0N4B7Vfe7YedgHLXnr7HZUftgWM9Smt9vjZAhfS3qQBdBdqSzuaCihxc1Ag7i7SrKD1oYofZXrSBYgwWa6g4xuLvTUrTAtYWZW/xz9MYVHlLrGlpwy3LWfLHmm78lFylNkqgKhl2fJWltbvM9pTeWF7Pqm1FE63sYKs6xICPivI6XEGe8jtSYZMVNcVHa6ubrqyjleiktb51yVV0o8puVtWtqrvtdduq616NPaypl3pcS09r7okzFTdTabOMTFQY/ACr8Wq6U213q+8+fyB78ByFAk+5T2rkdUgQJDAM/vO14X8IhxN5dKJpCixoT8RVOJw8+d3hEs9e6Ms6tyfkqaJ324eUdzeHcPAzDxAYpEWWnv7DUecpst6nROWexKQcql3HMF//K6i+C+UbWzvvwQSYzScwWYkBAoFwGGDQVnzz7NOUYJUtNos3n5M379rDcWgnz2DiRON9QmOS1Sk8dUuib+m4RGHnpMBEfJEMCPlEh6qdyoALoDnVu65G1CpLVi4O0pwNtNNBzgEJgsiB+7oVwl4lhERkEk0PzVAIcJhNgdp2kaKQrGL4HAqFxQap7FEHmlqT/7P2wmbUJa3Xqbxy9U1OlgmuQS+dOOeQRetAAQHkdmXCWliQ7OvaSDQBiRWWDqEcCohyQA4Z2Extm3+ckoS9DELGC2W+WZICk3yfbBEv+0DPQYnA21QAyyI1YHBftGjhmAQmSk7cR9JF4N5A674Rkrc6l98ZNS9PRGAW3LsVcUFooDM30qTeW68l78znyz6gRWdrNX7jPKADFCQ09oQXvDVIzGg7ZJfUBgf0EIZwIHmPcp03Gzorak+HTqIFDHj6LnX+Nc9n0X7bj4ART1+np29HeOfPevPOTeg4tvfl+rFsKEb0moHBfOyaJ1IrQVcmH9yvUg+cBPexpRaqnKrsDYqfNkeC9ALzVyh7gVa2oxVCIU3RJG/QDjpic8jA60TMl+8CZux0WKu9S+glaoFaIiqBQlSB4m/DyS6O6DO2579pK82fujM5YEHH+ZWAFZ1eH1A2mOxIcsDlRMiFTG7k8qCQF+UDSWiAFoHUXj9laf0eax928Gpfgue/pwvYHClICpHCpAgpSoqR4qQE+TJjOx4lKTMiG1AwBM3U41kqvBTI1NojJgeySrYcyENaKQteqzdtDoueCMqoPlBIjRZdkS93wZeLNAdB59oVo6KC85Wga7YuRBk7YE3BUUOqGKK8uPqFu0fO/J7d7CW2Xg5UoFErWx9NwOrNQBXDiTFQo/NAtqFdRh2d0dU5DVoBqerDHrdPQ5qrKs3pOWZI4lRjujx6OdAAuIL7BwavidKuHLScSGODSWjiBA3HBsElMrjFc6pPO+ikn8ztB9wYJNt35RhNKEwtzNhHWxa4tdGEIkhneg1mZWtIVrc4D7PqPrmy/Ciyh1aT4Jkt84cM3LOE+RdGQ7dFO87WyJCovHoQvj4qUgAw6A32BoBn26sFLkyPNwda/pdLdYhsHWTjzUB7zINCIrp8CVQEM4U74YLGW6IZin4aNJp3A+sZSAoLNy/znXaPv/OySVKtXktWgH11ga72CksLV741K7pnTWNDD6YhYte3fJM05qHn2HIl0B8r8SrJgFGdfOZD0FCJshFoalMKCwVYqrJZAtwGchoYV5c1srCwmppGDXZT1kV7gq7Y8LEM/yBWlQdNh0sLP9r8EZ7rap/t46aBsPqum1V721t8KHOXnNsuG/PZkSdMwUs5MlApBxaog0t08MURqp8TK1B/0fogrhamQctmQcchb1CKifXVhi0mNES9FU2qZTwI7Wh56rQQHbqonrHQ52nQQngYokbGwpinSQuRYYqaGQtznhYtxIQlamUsBJ5iCxEhoXJjoRQr5s2VxCxwv3EsfaWFtKesrEi38n+pbRc82fuTD8YKnlU+HaviLlVXpcSa41ltOVbHUX1Vamw4njWWY02cXc0xx73dXEJvOT0jiF7wKllwjcht2ZzcEes8AALVsg2hHD+JV/dPfB7PAq68CwC3XgCc7gPM62ZtUGvhiDTk01NjIRb5FTpWC2jp6yxsG4cUrqjy3bVcmWp+Ti2barktIIvvYlVkC9Kmdls3QG5jczeE91S356xi7X4bcA1vmT3IUqpftWatYLt8gBZdsX2YqV3U7KlEjiY69Cayk/ZxVsUOgbJezRlIIZfiXqh066hEk9+M5Db+IvwGGxv/prBn8yFdy0OHRzBWskvtvG+VMH6zxELwZnnMGZhOdN5rRs0QFV7HneVYAmlFHv+LMjnz2ypweI8/2Pwnp3fwKEJFinGpUdrT1vl2/Iv3jJgKIN2l6x2w/FoyXo964uw/Xeg21AyAR+meQrsD2QMvFRugSRPKA9YoOf6+SGJvj1Pj9AfLJWb4QxW5vAteDocI4fu2IoSCGAsBXirrMiClzUrlfWddRhspPAhRZsAoaM8aKWXbT08T6r0rQ4iq8IP72UNJK5SqqJuTZf21JNwWhtsaWwLe5JKTD3yPerNDXpQ546xNEoiEyNJeI5G3MmsItL1BO+a+mx95lzwWMgUXPcWVI0IbKVpAEwp+9Mw8Qph9r03ZHvjF4fzIAsN1NVFAKwcLGY7CMhnJ95cjbMwBrUxIQIVW/S1VG6y5GGJCMNOIL4HWUvjs8nhpdVkuiS9G/Gnk9KigOcF3YW1mAIlc6tKnEVGCIxXV1nqEfwmvlwlZaA6tFpoc29Io2RLwyuA2F6Griewsz1U4Mnpu4UjODHFJnbDlVBPsXVoC/Z9YfdIIGrPszx+i/803HN+s/2CY0ZATqDvVMibKEQUo4AIw6imLOs7r5O61ABX80ygpFBa0WF4l0mwkXPwzTAZtFrXnaa0ztJGL9q6/3tT5vMQVnsS9eLLhAUrcciaCtyHWoJyQcxkD3vaQyxAndKFjb5DKUQJDV5M5olMyo8NdpdW3ClqulM6SNd/WZHoAi6K6c6H+5KzN5QK076HqhdSEjB7aR549LuFjvtSyhAOh7k9JY4p6LA+0ahTWDpdeiLUTit0G/rJcPNFNvQFNCW+rLUL6kLjHy9XzmufUEadFs8/eS2GjwykqlPEoOAxI1/oT90xBEJltNpdcrWBXiiYw6nE4IMNpNTXX/xfXJElwKaohzlZxIGPdYNwysVYIEi02SQq5tL15ORyOpJbvYmoY5uuSlXBaQ4SGwgBagXGJnOYyOJ+kl9A0llZnVTiymnB1XU/Z61q+QAklTr6h62XYszVM12X+BErSfF25yDaIVZGHUDIGubavmspIWAu1KmRn0XQCLXDDQRk3tN9jKkU0wLirlznAu1ocqVPRCs4VfdRiXdyUGeyuXO1w1iTyzOUOffrVBrGuL31hGvMFRpzpI1qyxU0VrKBn7frHZqnb5ZEgTMSyvFrHvhzNoJWH/UnJ76aJG+cGSpFzrJmvaUYOGgG0+iDuCneZXI/rWrT3VjYb/8YSlVJ0+S9oK8M5qrc+KqMsXbSF4EXYQd2qxAs4O/sj9woKVlT3/VyGuWzSjOXzTjkjveNE7kBguR1CBI/jzVSdl6J7XcHBlRo6XNbB5xY+KimB0ug02Z+HxjPIeeDD0HBfwxrfUA5n0kng80qhgsPYagfGIsV9FqdxO0yIhCTmkGo1ZLtjxRE1EXVJRVZP56jMOQRWR7i6OWZOn7mabiqaWbqFat/YAcjIsAw4RfL7Vdi6/ck0srCv18E/wymPWafFPx5xGyyi+KuZYAOmCdR5YfyZY/fWfhCgxLnxAsbYWiBSQhsul8UUi4x4BvpHQzpJAooWEAIPrpCs2Sd9ZBiSEEA5Vi8NpEp/9ErCRI4JeJZpA3bFG/y4IWpWXIMykrPh9ZDdTUicX8+p26U7Tq7EJkdVPzDRHsXj9nBHxcApBvo0x/8gFGKuIL5lbFIKZYfmoHYFjyhCssY4lAYiP9Q52B/0OjoeduEEMgazlZabsjLsSPDFApr4L23RxSgeIoLNG0fl2+kRDzX50JHiUvydiT5ycQCL1YZIhbaq5e6La2FhOaDVhx9Zo9XFo2izjA2kYHOIfisN+cP8TuFfA5MJzcmF1BezA0qWmwoDcSSm2p4ARkaQTYRzAAEDzfJEqFAGTFO4QhHMoSBoajnbF1ryXE0q0LoSB8sDOfHQy4h/rRidco01uGLPoL4dw9y0IZTFj/Rcyyi4cHqAgq+rQo8wfi6Fn3SEEdsJTjOh6QhCuXPOkjkwS7/MiHRbgvDWIdnC+Bwcj8+ZyTmqPy+qJOc/mcAz3JvsvebKMwJUOhB5i4KyGkrnRVrmFp17+FpCmTp6hkKbATjjmu0Ts8dy5Bgzstbujz1RXydc7kJ7HxwegB2xVSXQUSjXgeFejPtkUDH78J0d+CW6+eHlLAeBspfU+uwmkYgCWrklYUFNGXYS6RSWPFa0z6JyALE5L43zlc67OdsnlJfiuNPVKjtjJ9SuQnrR0PP0J5Rj1y41T4JrRBsHK8lRmtX1yc3sFYXstMbxwZ0m2dRg7eUASXIeSed6MKO2R7vUSdDSwwviRB0sMKHLDCs8+6hS+Xnzr+MY7rXCXVBJxiEVAoRfYqWiUjHc2+XPJAE6tVIRvd0guBMuzQ4SGDnxlPoSXiKrRudEqs7qvHKMVFVMYZevYqj0PgaoVNqQVLqKMVfoFGnOOjCxAFxEAzOUP1EZo5mJbRZa8Xuo49qcMw80sbm96vvmyBUTTFC9ZZJvTUiZdisN/71T+rkt9iCYd1xLHBzrPNafzsbRCTcDXr/P1Mk8Ybexj1yTN7XsH4L8AYNmAtBQeVcD97wVEUNEC8pey9DdphDfubGXdTXjDC4Zt6roUzKhCaldeOBMJATNkyO+sAEukSuIJqRu3QqILX4JyRoZ63y8Dw2bsNy69PahpwytkAGu59M2JLWU7XBYkv2HQaBxeWDbrUXJWVgtj2qVIuq4fU5sNt+OBxp6JuiCaM43pgGNPMuta9XamwOaYWiiHaG5O3tupD3WPQXkaG+c02k6P0bw4a7L6z7XvMUNUzm/PY1i8Bb3g1Ql88yMU1sOZl8TrD1cf9kWMmmnZxDUDgLDp6owgCg5M0I2x5dxLNG1wr5qjznKNj/e7KGblhNbfG1o33x2b7dDHvW+R6XSZyMHdng7Jt/4WBgb36EsnX7y/vKUjIk3U/8imDAexUQXImRAkz5FDhfHnI3mWGTDBTqC+F/xrmVcUxTwsHN60sL+di2iNIERkI5wveCgDeIJQxD6mAgeMhiDjMFOaY/7/lk9NBB9srsbgkUJMBo9SytAXAFRWMAdXcf/5zufo/pbUCeZAxvtxqhwjt+ZuX1eKn9QJN9HZTQQiIjAV47UbbuoK/+feRcTrGCSlZHSNRXThPJXK0V5xGDUKm1qmdqqdVqp3E1Qn2NghfsZak4U+HfSell8mBfWdFHg1cdp0VlDhCRFgcVJ95CCkme/EMOuCCpql9NpX1+G7jVK0zhPENt1moDoztyQviU80V7YgF7nlFGD/oYIFnbpddyf5yf6K48ZjB6lOOBoqiedUWy8SZVYloGobtqQAR/dGPOQBcRe6+XXNGBWZg7Kn+iu4oEiB6ltTi9oa01sToSdQMw/UMhQ5yZcEaDFEbEex+GAjHQe68dFk721Yz+p3W6QugRd1zIlH52Y2GWUF92KBAOkPKcMOScoF3N/8ntcJGvikidZZAgmLuqZFgydYx4RJWFsciUFT5qWWOAreYeCZ2pA+aSLDn/mKwjORC+qCr2Hgn98mPmUm968+LxyS7enDbVumStbRRNbpwtvyo7ZfI0F7/lc0AX37lCKY+lsVrFoj7lhhOeE0qUcsBdQt+D7l2sGOZNTQ64gi6FZTxQpUlqHSec8nuE0uc+8ac9WdfZ9yWW047ft2hJxrXx0fCnmz7Wst2bhPlSs6F2zWjsV3yOKRYC428DqyzQg0ngwCdcemOJxykoxfk0M3EH6Ce8TOZIBpmmhBeOGCpY0k0AF618Rh4fW59NnGGWT2oJFyyGatzBlOR9cKWnQ5Uc2WlI1QslgiMVZ8EVhxCVfJJDqthxxmCY7lM1YGHdJGSqmSUlclK48NUDMy5yU2wUBrP7JsophXkcyn71CC2VNEuMXw1Fv2lhATzR8Gg+3pFZ9J+oVB3pUOum5wQZR5pabI9RQIhitE5ucZEie0s6E02V3pzopKqKLu7G7uWHCjfybDaDwsrtlaUW/HJC/37LxVoRPINqsZwrb1tXBiKfeo1vAwIOj66hohewUUcN7nEPd4Fc7nFhn4Od/uWYzF4JQiFezsk4slZJQwIS9NtyyFfM/brtehvhMcA6drsWrua5UIThtBQGVT1FoF7C7w6nxG5fSlecgo9Shx2fGErN58yB6vxfW5PPSAO8Blv1KYT1QOVlEP6zjHUIDqP+HIAOsUiXTD6k+8JoMxIpOTz5PIat2VPorvdGUUodyGX/QfNRuY3kVUomibdyhHplXfk8thquXerDirDUaeUMqnN09Blah2K5ZYgDw1eJYXUKsC28sPsWgWYJpAk3x6PVlCE4gl1yzQnKGSAqZmnznXSmGoDV1Cpvf55pIMyDiRApBLlgbHItD5YRLMEfR8n9ncs8OiNuZgYxIqeqeMiJ0JDnkdJe/6e0aBRckOzJcDDRZqIOEXR5f7W+c4czEFs9V1Z1eyspJlJbq8Lo4a/MOyy1zRalqC+tdNtcLLmc/Wr3fSmqQBBdSSdKU4oiPokfuvwcInfsMxOBNlrVGb4BI7SIj3pQTr3nTSl8PV/pEr8J6en939Nka6OhDEaKh/Fn7isrg5rAC9Cv3QQOZExCoOljJyp0BGoBoZWdGxu3+/jB15dK+Zh+mf26UdBZ8dtvyihS8EyhdYwxMd8/92SztKvpZHJhOkLoBf59ub7t+owWDLcFiEXv6ViRS0QN7mbx10F9fVgmm4hRUt/cruyKlBNo4Dho91LBeVFP2DCqUzcuSrwT5FC1q0OlbRRHiDGF5IVkvLjYvFLcuZwZLDNDMoflhcnaTrq081aSG/schMtuV+NkyTPZcYRyzbicstA5r7ML8qihJEUw4Ud7gn1t3qYi6KrBX6QIYhFegPYxeibA+IYmMjkbu55aAM7l0IZ33a6syMfAzZmH/beMbJO44Snpgpw9KECYknrxKUk0p5urASMgddCkh1z2IU36KJ0weEJMbFx1wbr5nUCGm/s8av3ACvYVmD+DhNC/PsT89nKDg8zmYuJtwpEKxKfC1zrf+XYb1RMVTLdOMCmYs6TVKSgqjG6nx2UG1IoFYTcnTN3rnIsLXoQQgZP5Sc0MLRR4wyqbZO/vpNIWm1rFDkEVG848NZXPRqni/oOzGByXhj40ipq6NUK/j7bsl3kASumCOaaac70C6dKyEDHzdsHFq0zeHS+VoflE0F6aeTenUuMIywygjFKegX6UY49+c5qp97kFz8OeOCxZcdTSP6qOn0Ucuowir/yA6Bx0fntzeGBr+c+vNStNEWpGklqQSRRUkJyEzNyPuE8Ww/H1B9dnFoxEffUck/k2L8E8/Fkj4R/0Gis+EolFuIj4KzRO6qdVzhibwYE2jrwDB84q3zA7cZXWA4yUc+abkl373CtJ06fB73AbCIvHYvspIY95ZxwPCXeLG+RDAOUV+aFP7pO42VJLNnsVPkhYLKZ7UH5zEgXkmN+duKByjJUAan+wihOQAzk2C/v1XBLP7X15px+gT/97+njHFeYiOLvJcQ/6EHnEj9o/5sCVw/oWihPhpuDo2Di1/UyzJCNzrtnNpdLiour4rf3hxzQNWd1HymDkBbU3TFPAFcIrgf2tty0Dd1fROgkM/6w+keD0c2BuMVaDxxCyoPnm1IHB2V1nAuavuVTu2vynL15nbV/bAA1+YZPwwQxCncEbU2Jt+rrVNdL65Z4fImmTylSMEUf5TFpBFv5Np+7Zu2udWLkwhZxJIPJIZkkiR+FD5SkNoQABO5RUiywiT+0RvG/d76pi9rF8xpSWjHxyAqfbejjrvixf97XNkF7Ikz/lXXLxg94bsd6adc/TuFVcg/kJUTPqin13gswfvqBmm0uk0bsa0Oegz7XMyeMMij2PHNvOqk9Mne0H4L4tr/7r91/TOhxOSz3diCQhnI3OHd4zZeymSWrMGRBsdlD/EqAIOmbLXPZBVa97KzUcx1Z9crQ7uCNoDr1yFVR4yTSF76ZXij5WtqK1QFz5JgywOFlQezGgd4L/f1C650t13UlKn6cCf8zgVfgM2BQziebCGgJY9LQGvg9TsqQESN7899/ccvjdJGwvxm9w2+W/Av3sexUlwhGF+8AvJbqwdAfFStPid48MVbclXHSNHZY7EMr8xsqeaTPoEGVxN5Rhx3Ax0g3a/Pwj/WJoeCYWveTteS/3pqWl+yVfe8Mfub+nP3k3xqLA4fbu/1EB/kA6++kr97v4I8PfLGXjc/B7hy43ZZ/15JcdOUlDCPXtBFcyP3H6dj3XnIXWsZ7xI3jPkLjYuHTn/K2rVPw1Zm7GiEwkhBqKW3ruPFgWGn8Z3BmesDfE/3ShuNzsu4Zo7n91Cew1jwb1RQYfiij4iuArRteTPLduM5AlD+SjXxsoOcQuvb78SHBitUsjN+VG01xe5UhYI7u2oDbh43b9mbrc9l67bA8qCD7jUXcbumsvxs9bufvMu2sUQexGRfbC8bH1FVeXo3cIiawLUrnmxrTeLc5bR9t521sa2YTNoF9/IOZnEZ10drS6qg84ruyElRykqsqL8QzWWXHHFt6GoA7+gN/vaS4ysOG7WZVPXU/gI+EeRP5lhH0i64HS60j64x+cXTGUsxO4S7PKBkoNpwgss1b6nz/vFvm7Dq5pj1/JcoF156Hrpx7nuF7s+/73apZ66vfGA45a8OkIqItpSucTcDH41QfGx50dLa18WjrQK9pXYxYdXddwVj338b04XHDiWKHXuZ+T/FpTfDUkyZ8geoWpoyVd0JeLVvZ9jK/y5wb7RKgrJC/Lpym4RHej/ULaQpAJiTlLNRPPGg9+wrvz5OvnTuFveA1GNLKU/rT+7/m7KYHX6lFVG7dHYHWoFfPsZJOBG7jixEwoLEJIsmDS2LnwcKYhKYAtz45ykDqqTnWtgd1hsgv3dnWelLeKB8DZGpXhzZve2VyGIKTWjIkrR2FFVymFn5NKd+JVkZ1KumT1YpE7b6Gw8Im7ofqhWZIDfS4zMzSWtG7LyA36YH+8Dqd3ft6Fr6YX8MgOmkDE/PvHA3+PfbQa0Q97Emeac8z/3jJoGWX8Jnj7KbEvQ5cYM6Gz+th7tZ5v3f2latbrSi96foh6Df6EuZiA7ry0B6J7MG5r4H7RPHy+uD6aGz/wBWyBqSJaZ5I4lpOVt6va4mTe+TXG3XdCRCX8YMA7MRyR6/TjCj4hH98N0DT6Y6oenJaU+sIgGxxMC6M2fR8HmX0OufnF1V9H7NsjrL96g3Oe/JX6zMrCosCtA3tIaIjsSfLWQDam5Q4OPF6qCNH6Z/xP+pphcCUDXDfyk2NpMasss7/+FXxB/f/3jjfM0lHlGGjz1KPaznTboisVjgP4Rne5HV752fEvseez5XQVB8eA6XmHx+G7uLOE3INULaRtpxWG/P1RWQO8fCelH312igcmzFW5rw40UI56vTEpT1iRmsOwLs+8sJm5hefxa43AdMfsITGaTMdVES0hSE3zWqcXRZWIyQskV56YOx55ENbmk1BhtupRM5DFfjJg7C1ajpwXxKsdWlIopCQ7HerboTGMU5/EPhuknFW4TGGNsDl6sTBczstFfEYvEW64wQnMYEjklobyh378FbKCvGx2tFukRXTJYxJJAN27j2SOrA5lN5wpTyh6YFHaGE54qd5J00piqDDJCxZXlpg8zTqKbXIg1RrsZG2OOB/xTNWkuaRxN640kJVu+z9Bo3Cio7kg7XocSnZAGgzrvdTZKEscSlW7AD+btq91kz7Dz/jqcxNukLDSvERf3ss42daR+2jAxqy6iq0L4+IIxH5H5OmIXcH+6DKIclENqKCQRJYIn8AtpPCO2Xa0ldPENuTHJyVJUiDIU81RiHs/nlUZWBSau2SPpGJJe66znXepYfVbd2nhQJhIB7qJpoXq/3OJ1ORcmbznStGWGTzgvD3Nno3fqfMc19v6U043C88Yz63eBfxddzJnDNlPSs/G9ZguqnZWpItBVlel/BTZtd2N4JsNuQG2pkhljZfYqcXF/4tXOhtSrjeOzmhJDK+tfNPjPsM5AFi2KSFPjOwqdrAwlZpCqtGdhjSnjlVOMGxBb2m4po7zirsTznY2pFxpGdiqDaPk79ui76Y8+efvJOIg5yi2kZFijN7jY39D4L7IkTF4hhS/DF3JkkTUChSUSkh3Sk9PO20Q4WrZe8LTHfVHXXn5U0NMhP9Fo5c052vfKyi+2DkDttkk9+1oXTfX0fZM8mh+qgL5e943HEDK7OOi9VF3c4Tq6+sGZbED/yakPz87XRiRVtrUG1bptcM+MbsiQ2yJSUgxIHt43VJGYwhJeTWTDFwINpyVDo6obQ3XCM53Dp9SNhbtSmx2Cg7VsB0HKFZQs3ibT+lMDZ6O7NTV3U5H7PmX7qOSDbgVfT1+RmvyvxyBLppUm5ipb4ViCOeOK20r/xfK7wTGikP/ClByR74KrDIju9VfmgP6l2UFRvAJyugRXIFSxLP3pKLKyt7TVXU4fjdN56L3yPCYQ1xogzJri3Fdr87+TEA9zHxBv8LR4lXjwgcyy4roCnwWXi43MJss66mNERc74AYk8r7fuK1EB+AodcJiMMIP1xs9GHvf0uLgwu4dtKI1ZYxhbHivXl5p4tZzgp8tBnB5B6TxHgWosasBVJW9FfF7qTEfzJUEf1eD1zrML/ohQ2IvXCAjFQkT7u+X0bhmgHbkr1sUkjL1aLaURy6q0KxqIEgHWxiMlLIOcB2emt/mOAHblmrWUrkxjI02BPCEZgviFJjAUZofASLA2TMwFfPJlYdm+bYemi8rOfR544AfYT4D9qR8qVxeBRroJNSi0VuyOlJH0WisKpRFG/ELKjly5MDSKJNYKBig64uFkMvR3kbCJdb5J0hJhlBgkdlVlySMlYfUyS/yUDZ0wBtv9VmaF0Jufv81vbI4pZXV7wYWEPmte2OOttNVoGqTOAZeVhPFYaC1XlxgIwxfqA1pK0SYl2pwCNx1vI4HefcEqnlCMh66TapMctfvV/gnjWsyefu53AZGGGSRTER8joy6LT3ZRQkKz0tQebyGe+dmrH7I5koT55npUVZojIHfv2U/cTCLRGLM6eUUSMP1coaMhbnz/H/dNieK7tHaUM9Pzqf7l1+h1j9z7l77EsJcgJUY22RviypEVp61FEVYUxjRDtDZho71hVlSj3+QjB4r5seHCn4BA4xe7E1ua2xujXy98DXZlNM4wZ5dv1uhJGZx9KsQQWQz9j6jQZ5Xh3r6ftcmc1tFYxeD4poHUWYCK0vzdS08ww4Qn4d3CkIWhgiTsRRs3XYnyd4qO3xhDu1AIG5tR4H9Rq2wzA5T6xTM0Ydin1SSlxZST4w8iZUT1DVGYMAPDuq/1trrZXJ8MBlOQh2dzQ0TiIigv4m5gcbQ1RpYTJEpXprRV6tfR9Jjm3f9EYFfn+jpdOrydYbkstlUmEnR1Fp1JcTQdiKmXJxWypIYCBao/TswJBYFipopiWpVX9Z5bZdgQaxI0hr8DAs4GswFcUFHAad5p6DbVLNfojEPx4XKDdXri6vJVxDQChB52cXnrgtDhJvdN4XHD00M4/Mj0cO/L1L5pEHh7XYHDI8zvc/1MbAapcJPCXXlI0rns8pZAmitj3nkRi5jUGLHX0EK0kHsVUMmfbaNz9mySQCjv1pS+UJ6CUK7LUJmRdAHkHq8xCmo8xWv52KRoHeHLkfjiZX/Q5jJJ/yVQcWhWQFRCaYXt5RhDQ4ZUH1sAY+j7EsxFtPHcIf5qbNDbdJE8BECm08fejtVoczWJVmbH7wDO6f9NxFRGGKFMjkDCqiUC7UbqWkv5WMJn5xDX8Ib2yyE6ZbJBvJQRaEjbe7L+oYyrrW2u7B/c4xsa35et8dKrba2lsr+/3PqeCRXdbQfm59sOdfe2H56baz8ITt49ktM9cnw+OTD/SYGzXts3emLeiVPZeGlC0QUnjVWQdqJyaPeiCzZBLNOLfJH4Uiu1zI0TNZxE+VHZmj/hbSD6uKOecmH9IUdLvWy+7gCv/oR3Vhp9cTVJGuTlv0PRS1Lzga5RK7ngUnlPyX7ZyhERs7mIr7YPHZbZ298v4eQlmk9XdBk3hF8lU5tw2WZKmt4z2VylJDLS8f7y25Em2WGsyh4NR9AVj8J7tWF856GL+d9u4XMrhilKzCUefrY5uzR7VFTcl3j5rNDUkvbkpIsk+90jejl2VvB/viDc1+U7Kbxpdak39NOvgh4++eMbDACP0MTl9JVbMg4HnuHTggL3oSjLMbVeLcsuayM/EwDaLe4Oa3BIn0kEiZNpU+PxByV4c7gVa46SpFGNaXJme5llKr6YVRxixWfGfsbOnbkTGjqSK0WwY37oCQqLOCTBW8LzsBaqhE/NF6Uz6guyJ+LzKw6Jp27uZQJqvdbYxlF734lzn2Mm7y18Wr0jEFV1sXlOWFYS15zAjcw6JIzdV4XGY2WxxgSZnGFX6dO6K41rYjQ0c4gWL4v9jJM7cfQtMY1+F5uulKfE5arpeTR1Gsss5lCyfpfGP9mmw1eEqxBJfYMzWxaXH1VkHB3quSwFfpHJ0A+M8RLe4mL4sEvwTE6qdpDA7cDBNRDd1g49G27bHZ4QqhEAlM/6hGJWaM4YcM/2ri9zhusRU4gV5TgFuYUP6QX1sosx25Xq6spFP60XXtM6uINwSu4t+evcyL7QQFx5Q7JdSCZcOKeper33Dga6zoHf8aJlciNkArwT98YKHTCBz/9cBzvGsmd3Pl2PsiZ9xsxXVWXM2gbHpbGUxN+B8t0Dp9tH7hs1dfYEzk75/KGNRLXsI0fivP+qpHpD78i1d+zjWXUw4ajXi+8g4+qrqwPLhKdzn2QXQdonLi7pFVlQ1u+Ty8t0tNFYtv3q5dXlhj6wg58CMSOD02kXxDkuuzA6nt/kgritEn0TTXUDJdNdJ/4v+HFsW7wbBH5MLOIgQBHTBVrp8gHh09O5Y89Uub+MXcp8n3IBmu1yHWnWrSMeDNJb9ofpv9in1VEQ1eEy3W7SDbhhv192qHlTo3/lA75D+s4+KubdbL8YNfNP2Jf5QVkvfkNun1jSz9IP+mcTv9c8AfwfXAi/ovZRI85c3IJ4ffJNtG/9Y8j+J5Wr4PtvhjTKQgHr9Nbw4N+f9vx5QVgW/N+br4e/P08FKK5wVuF3DRmEkxIj99/IKmkYoGVyzMQ0Hs7I7NaFjc2daLxwT4anCmE+hyl4vEiXNEaqXIGI/iWWcD74SxikfyVAaM/InNF6Da1JJqc59FpntEzWEm3Qxjnksrgmg2ankj4T8rLNZp5OKCkgOTTZANJ5itL2eM1PY1Ia5E83EHhPDL1cnn7occBxtAd9ARbQjpz69DmBGILaMPbq57YowEwVC9GMJNTxxYBtdOdlKKAdFKeOK/A/Hri/Isu+LSNj29Cq2B944EXIN4A2Ml+FzqwPBO5z2GtEBw2Sg6Lymub3AkBcIe5FyE8xwscDJ91NTaLKiIhKkbHRY08gwFYtoj7y866Rk6zojBtdOFOqGWDPqjFB0ZvYGAuLJyfERjzO/RmKdqGOFRBJw0WMifg59zHAvbaLv0I+ETQZH25hSpSU+AQ59n4cMvRl8tqgaQbS6hDUhVVgT6QDGOFwU7++Bo3ai6c7oLcHgHj6kPlCCCr8+4R8JFLDfBKOgok0p8ORaqErUuqakINE8pjuKIm7iAc88q2G7Yb5VyRlwbTGBBDoiKEhRqJCl3EPYY5cCiaTgy8dkR/iosskqsYYgCD6KFpxegGtVHIe82WgJkqdyks5z6MV6yWtEcCXF0wjKq6r0TGxWegHEQpabITsfhYqNkaNukaUDcREfhcT9SqS+iIq5hvgR9wfjZf0yJAUqhLZg5dEU/HCHmU4lSILvzUXOijkOzFRL8lyb+lFgE8+9YHb6zxpScerx6P+//2NQkLQTQuvVsOqU1CAn8Td/I0egmoe+fItkhK74jdGD7r/1JdJKRRA+JWBm9qSJYZHDW/+vLJ/ChDWz2D6p5xYtHG4CYN1DBvRWOfUILicsvk9n+QTowB96dD5/JJ1VSpKl+/c7QZfm6eDdzj1XaRM+3kXzsigAKxz/EFV+BYQ+YovtIJ2F1P46DEm/0HMLjg2U63K/gYoZdgsHPRiCCiY+XJE+x8VQhf5tmRbehL25NdKdrdUzrAsFlc6K/dQ1eYn7LH0tGSLfOkc6n+kkadxhV5/mhTr6+3T3PfsE1WvLs65FG3ahOzC0epXE3XFf62m1jeYsH8WkOOAYuaALUg1OBZBVYXy6AzFFZZuxGJKycWmbfawkF+sjOyPf7acV2asNWjqjHh13/I90f9sUDSO4ioju9KHPC7798EyP06Bx9qgHRsqsAMdRw/GehX6NfmtcjWmuB6Au5AuMlv5GWpkXGDE2wy/ORiOI6BtwP7mfuB2ecCFVhwcDsI+nU/MCT7sX7g36ZkyJ8WlGYMtG3LisM6+PRbnGHK855QCt04iWsPKJVfDecY1cSWmpI5MZXQNPyszWcwkqOFr9wXtzIuU8LjqRDGPpmFH7H0r4JE5mWE1psCIfz2vMbFlTicOV9a6/FIc4fwq2fwGA/fa5Tzw6p/DTbjEDDEOxcvOxkWOZsYQ6Ch7kl1bJAP6oYbW2Pvj2dBM/AHknGlKnqKkpbD2S0lZASXBjRzUX8MgILjChvuR88Eq2sY6UKsXREi+37MUV1391/+r0f5vxCU9B/x3A33k2WMs9jF4TinYchd99Lln9Cv/3TJZAxLsL48l2yMGlt7xg2IqgE+B7yXgWeFKn74jE+WS96WoS8D1yK2KGH7MuxpytpPlsf6g/orHLQ9w+Dj1CBU4DmcvgiMsO1CZH3RDZ8B4rkW0rdmxTWix7BCuUNE2i1m0tbl5R37lNiH0vv2AXktGiQhCFDlKhCKgRWQyWkAQoaLIQhQBJQCYtltFY9bD62kOsLFJIKsve8L/GfN7RwfmBdmV/SCrr+ZvBA7aBuuhojXAre2Wbc2JdsuZ5pr4xA/56BdG9O/kE6Ngbt12WQ/bxkBoVCVw/z80M7xwM/BcOF4YzkSDmwksYVXZrDGvVkpkRlWSb7Ffnm9OHAkqdI5wuMNOPKQ435ziISlOUsqMEm9PGLbLv5qVN0q3mKLblQy/vKBcv9y4VLYyfTy9e1Lj/KyBlcpjKKPbLeZReh6rxn+nL4wEqD5V8nJ1JlO1/66BvCVSozLJp9g/z6e9Ww1zOSO8T04xzRiVdKOd/jV5Zr01NYh45aSYRihx327lFHmFw388NB99xnEaKtYceuCzLYdbtD1HDyNJquw8N3zp/Dz17NYwccH51IC6NN3m4oo7wA+M0IB2ZG6AYb0W9uD8HAYr9pb5ywNE2J8q0GeoSB4g8xd54+RXzk2EacGuaLmXByfaWOyNPXLWezVzfdaD+FeoW630xpiRxpSImHYzoy669YNW8K0/7gDnM5P6aqYBgftdRbWRy2JsLJWYlpOSJe/Vkq+xC8T7Uewex3CNdKgQyVqLMCXqnTqoOKKcUhyvFsflCzTaldkRS5wKZTq9rlA/fkijeVg8DIy7Hy4B96lHUe558tvBjPI9PEcNb70uh7fRUbknubweCLT7ToY+7LnaheW5tZd/fXxb/TLiwup/ZsDzodjUDP6Hqqh2pak2TsoqQBpwCiSuRGoA+XVeCduyMo1K6lzJ/zxw4MtdOQnSXkUSychLMeAYprWJMxmoV5Hfbq0ozVM9N47oZtgdlcqpAlF0i6qsUvsvSx63JkOROFhWNMktMI2ybGZ2o2or7kzayK2GbCKVj7oUyovAnD79yYquNQFko3tG5JbQzaAv/XdRMeDpCH8Q0ipZmTmxDh4vUcIKV78lC6+cF/0Pdh5FUeXMpHxcX2av5vrano8ELf1f23YfsP48dLjk7VHPC92waiCoFpUTwdy+cghvKhPC9MdOJtR/c7+TcbEy8eOShfH1R+w3uHVr3PI+Wtjw30xD2nt1I1OZFrE9HSLhZvSlqtKt0WlKTHkaB9XEqTLkNUnWRZkyq0NJgL8TQD/IMiRJ9ssbi2cl9e2pe4tKxftWts0LbbU75GaeyagOCr635+eEFGskT4ov44sIZSmS7IgExu9zWu43j4c5IdTwDT40BnafsHOh4q/RsOdnGUGi3vSRMbih39nv+tbvuYb8clPSa/121vN5fpd+S/SguqZWNULdZjRSd6hGa+oGNVui9frp6AFNVZ1qlLIDxMOvmbwg/h0c9kLAdlrlioqd7x8a6DDn9Begc58+PhF+MzZo3GPbGkSS+GEPt5jWPLux3Qvq+UdmjyaWDtjtDNy1nlerD98+8GxX7uLqkk00n/xJakRUGMVtMtqngLYpZz2cvdjzn9sE7153z3+LbBhwyyvKYLZbczqZMpk1rTms9gwxa6XV2snKz5JltTJXHhCQdvz2LJL07Lcd2kgE7/fno9nz3xE8MOyOXHkXoclkEboyMqrSgDxCuFooEWR5k2u6EJXIzAufnJpsW7lp42ZUYSIrPG8kpPbWjRv3lSdY9IMGtUnlo2wMfZcGKw7NDMBbi66ND56UV666XiGwGHI3a6ABtaeRP/6/JGZnsdv+VAokuCsAz/ILo+5VhBqwRuSB7XsdUkNkrQTu++dIfaG48mHDuOO9zJFxw6fBHV0jTT5htGDVu0WzumT3r2rIjdzry8dWF444VDhsJf72QfrLqzxHXAZsjFmnE7fn5abudLYfyqz18F3nIUHlBD9oCryZnyFR1G13gfAxb7MCWiMuSCCjrBqTcKhUmTzRVL9PVu3m0xHKQucEXrUHTRuaky1Xj3MD13F5yHIQydF3BLUcWT9o221BvcBL1OcUJR5+60ITmxt63+Q3lVXbn3ZiA/XBfK571Tldq5vf1shIdA7kh0z/LkFWmmGbChLwRZHrMmdGJEHFlkHRb4DGQ+imVv6Sx95b33lA1qTujLKJpXnztjT899u0os2x+LKSwZZfpw1hTozuioBeuiUpwysyozzkyYW2sAtbPL/45VyA+wXwvAUymp6fPpLu+dYzPpT56aOQsO9jbxFvH3jzRxRC0d8cKkc9+nH+DGERRL+cdzMecvOb68GlzW9YxdeNMxbp5/BTPVZMNgR/ndwv4jBucFO73pFrFJONkf800zIvxEOWQuRS1KXwnVvI9cqNN3P1aiIXs1PSgdh273wufM3JK6b6+kumsZNq+PkLathCl/Irr5i+ZaeB/Xd+X+xNZWsRPZFloytaY25u2+dpFK02OiuxiM4PiNibgLdw9izuLEkRH3H7kCzySadJVXHG8envIDX3adQvpA3faBGSklOnywwlnCJMKYeJzixRhtNj5GFbV673zyX3wAPK9Fu7y+eZBcVz3OZK/mSuhjaUudLeuEWs/NUaQAUetyGV3yln2PXFKUNqMaaco9KzsnqiuvZ0VannhuqOcYqrZxnOQt0Y2loVMysPCgzRCU9ocOz03Kg0PkZDYcKyxcm9+JoFC3BiJWykml5ab8+PkYUd7IX/CqpDwYe4x0IvzaUq8Th0a1h/4cNCGyJPgmin3lv/1SBAVEy/M5IpbYeEzc7kmdxGzfnxwWuyJlErsoJmSWEoyuvJiHQByYLlicqiRZmEKp5juH+wrJI0He08/4mdxeerx4BIPF9J564niMpdFpnHCW+yVFL7idDg0D0AeuhN65bOxpRcUjofZ2YmE638dAs+kW2OSOfjLRlv3R7ewzpxAsV4Qm25aHN5Yca+nq6TspraBXF7h+CIXQh3UmqS53DJH3oKcdHfg5TWhiMNq8oFypQzfaJ3rET5T5c3toPwA6hfEXY0J1gB1r95V1+3AEI1VUR83a0HwSRbwDAtOyMpN5zNteB/xmbSKGHCMfPNrp7TplAg9W2hZYuTzOEJHBPuOlZESo83DB1PlSanxZpk3FwkI1EPFdAYylAF5nPqbsuy7W9Y7Esi8p57C7WJan16GUaqIBO79lfdDqtzAQ7E3jBy6sewEyZ/CCNlYsMsIXR3ur8i8+6BR2AuZeoQ2c4k6Zs/2eUdEsEVUf/Wj7fuPN27+ePNPWD4L8d5B+BYP9Fq9dm93XqDWNzT44dNKOzrEYl0OpDz1+3TzzIv7El0tLc4EAxnOs44wpqX4t4b3VTXmc+bny1kqudnUnV7t5xern5nIb7xc/s0YFR0Y8+/iWxBbY2K2MPzwUWVY0pI+Zho3AVoVDlxY3TSY6EvMcqOKY7Iw1CLals6QNx52t7+tOK9CgZ0gbF2e7E1ti1A6ZUgnX2/OH/8ApRR8YOpXHC2kj6EIMdj8fTzVFfyhQH9R/q+M322j2zgIBFxHtHzNOTXXyH5M/8Ucfcy1nYW1cCVe6G6f8AN5mGsWy4q8d/fXmjRP/luyK+/hhRs+0fH3QvS5yh3kXOnnUB5b20Rc9xWOsa2GMe5ZXbOehPGm26LM6ZubmqaF1as+qZK39qfR5O01m5RXo5yHoZXX7ti958pZ1zM2d03uKvgckL1cb/W459afdwmskH8mf+YFVl5a+QXQ2oKyDtzG+28+uRE1NRSZdAoLusFlsAj7GOowj+lI9V5gysLNyVlS+pZWa0xV8HY6zefondOaoLtfiXsa7qF6g09lRtTtMLiGL4KZ08pyj98ZPKPrpF4wDt6BM1wVEVT20v5KCz72f5F/P3P2GkY6Wf7F/P2fybFoPmOdmp0u4Pf6ylwMs15zD61ptArpZMpSLKgFfFpwrk5aFwWdFI10pKvUT8vy98W4Hoy0Raty+dNhlQO4MViOTZBXsWU5hF7MhtKV3o2DXZoaAZMYVBy7lZF7Zq0G73P5LrJlz/0jedMJdfWpG2zNas/GnVc0NXaRkU0niuZbcEPppgNMYmRAsjQ1aCAHQ8BCr3vVyraVFowRNNEaAPwtDJUA/I+gpsJZyCVBi/oQb8y4aaygkFalmUi0Ep1T0j6WgPVpv2E0v2Lv/RsiqxKVBegZLqSxMdBOkVWgGh9WMSNU4AhumsP9wAP3/+j5Az5X6qp23+CnDcPuSuGMnLYubfPFIScWtR+oX24eIptOAOY9sXF47wwxaAjTO526+T9vfHhupFppJZzej/iavRBChO9Aeqrx5MPlhqpE2fJmGujDZePwpJfdHZ4GAAOXWx+/PDxE9IICR4Anxs56mlZAkkffTXVMiULK8Nq1RtI3wFZkMxjMoRdJP55CNedHzlfd14eVoZp6cxLWtNuFvz9oTdj1xR4wRnoqwuoqfimokoaoRWyShFS9Wclvd6Fss3dlWfZK9Vf/du9a7Rvq7L3IquzXDjtYesGocm3zszSfgcHuEPT6A0FcLZ2M6u5KmO2qkS2MNB3TtHQdFrW0y96r6FUu2RoNN0KFeFjvwjN/iXZRpZkkAs4aSSbWFRITE7OJwslpAJ2Cs7GE+dHAuFCag7hV6w+3tnX2tQU2Tvjsfpfcwiu9xVJarw0TEaJhmowWaSUlFgNDFQTTUHI2Gr8Oe8+asdCd4F0S1/1meS6uqAXvbMq6O5Y6KOWR5fPNmgFY87yeW5xcal0lrN0bUP5LKA5pdrEvhxrK0MgJQuTldGnzWYMmXJb46XStnhzLtmc13HhqloRBsNUw1RcNkyuSoiVElbD5dtrbpFxJ4SkntvM8DOm4OxWkPGlxheacuOHLhhgxnzoRlBFrgymAJuXw47uHBhQuCfLg9gbLOiHT3GlTgdY7pK4cif5Tue+mT98Gqtm5kVVFcWW6JPa1sDOl2cmiGMx+fCJfcE7tZFCfrw6WsSiZSQSkr+5CtFhfo3/hX//zS/7gItcOru+59VS0dr+KOg7PyDfuZHN7j2V/znvNem3oM/1epgj0pwg/PZ9T26jYJR4oG6zmDkSfuvlcP7FTw8XREazvF+4QF6F1MPSCrpiVWZsnyGmKPYzp2/Ra5d/DpN378zm9J7I/yz9NfnXwMcGA6ylSYSocDKvkb8qryam66+ivE3naFcWEG+1mRnLtxFVgdBj3ARKpyP0pPR566I5tRvne3TmZQftuK87bph/G/y95csTD8CX8uNV0acu1XxhxPTmQovG6i53VgPtyeDXqeDRI3T+E2Mh/RUIoBjMOvboJ3Rqg7n61YU5V33VFhXT6+t3VUimg0zeaMc2HSM/ezjAIU3f7JHj0TcRhl1k1RgJqoGV5KW7b61JAFyCB+gsEQ64RETeoF0PuJS1+QCaVFLSWdwlXiJ3/ZclPY3jBK9uHcde3TmOUy598YbDdWB0uIkW8wgdxehIHjV1RdEsRft4zOwFBi6zGd/sCFIQGj9azuGMx9SOIR6LrAoWMDM61nzuQnM0t36J0xN7OtLpjDlDT9efVqcvfQbpPRCfg2Pu0+m5jy9HxRq1T1eRdnP9iwnJ5pdDWKLl6mcTjDo2P8evnaNY+GX24zidjfHWNiHourFpkfyT0gfUuluUKeRXH1j6p867tjcO/38626o9DhMSDgRfccgdDl+0oQNHcNSOhd8CqZrrIWL0OGgu7WusKDWKMgnnMNwBb+CQO+wLNKMDR3B0fZ/PVxb8EGjaK46W9t2tsu7IFsYS6nyCBWgGXpf50Jjh93Ebd/ARPlrd997oWx2nNQAuwTkCmhPulvzRZbyV1Xsvxow7XQKXEJ2+bpi45tiMNuuOdpLIwJl6cGjyhD1oQweO4KjZI6rz1g8Q8+l7rDuooq7Sp3CH3eDm6437JQAuoSkWHsO4xXefOhUcitGFoA0dOIKj7lgUyZ4yBYQDrQsccofbkHFI4BHor4nRf2EcElSlvbhmH5X21S/67WBA0/9EeMYHuZFbuZ07uat795OuhAG6Qd3OGxrRZPZSjNvvCA6iEU1oRye6zF5KDQeb6s2dDL4plPzToYhs9aGoF2Tu4ntp4q9lgG5tgwAusaZW6Prr3ODXKFZaud1YV7A2B5+8E+OSdQ3ohBiuvDa+WQqHao8tuKtLSM3x6uOKJrRatwmpOyV9EhxEI1rRjk50mfGs+YLOXfSsXEumC0upEjqctVSoWxs3ctOS+1rvtiSUb/vBJkAPQesx9OsvVzjYtpdzxqrm4C9BJ1qNi7oDwfjf+vXXyQ+Wc0ZtDj55p6HVuGTraenDhoNoRDs60eXu5onxRjvC/nAqDqLR2GXXpvQYaDT2rOek1f2fcVPbXm4F3oUcXUOrUKMXIACboFsB5viNdT08/RN/E0HASx/z3/JiLewIC/pP5uWNwIeB/qd8Lrm8mRO71QHjb3mP+qXhNxusd1EvpZ5KPCPHYgH9X0upG2i/ykWqonulaHfutt39C/XlizEhCpYr50Ihr09Ex/QEWX22XZzCrbHNZ16897RjEaLY1o7pp7RAsnKr7ePtRHok9v62etaL7e1NKyiHzIumXTqQr8pJmUA+nfXLAvLNeaIMwDgRPLqBfsO8RbfvTnzLzvyK1lT7lqHIawL2LZnkFwUUPVFlj+Sh82gpun+XsxUE8vlLHBSO8jL4jJkLGyzkAStkFZ3sJS4NJ2eZAPLjUwA/blqjQGk4g0/1XIBC7pIls+hMZ6GQoAT25pblqVk7n7BwVQTEt5aY5bcfGkuJ3mywoHfspRAE2ht2AQDtIYUWoD03gBOIb0gFFMbnPL6xe9BE6+ZVOc8J9D9JFvaco2PXqhCvaQnsVUsWg63xE0XBKCVFNlCriSdxSGomTgB5bG0/boH0KQopegA2it0InHUoIHyZHBhvnZJytN4IizEXKBiFo7wMPgDW7Eh1VNT2jQVsly8x1tLOff73A3l9CxRO7gP8gfEaY6GUr1+UsKL7YJ6jPNQEtTtZ6Vzbvy0tbz+c5yjNY3pmCfoWBUqbp6aSWWl6mw7IUw+wvVPT5POWWNg+2v+TEAn1LNsZa/db/UQ+KjEQ78lzdgHtMWKs9qJH5XwBRffK2VIV3Taniii6SVoLRzefk5qWt1qRoq6zk2LzlnxFH0Lq3nOiadq0C+j/ynNBebiJWhrnYGuvGvFHEq7UPDVLTSWzzrEtEZ2QBbR9C772/YAduT30jKhQxsCaZFy5in7bF5lCXfQ4mc/E7clzrOSzHr1aP79Rck6JiWmd22hFzhoWVq2QpWOWZsz6snp9ge0WY9alMev52HW1pyU7FdYSNVWaVff3XTx4eQobmDeHmpdPIe6fG3JYqHmaokigP5yFmr9pblcUGC+ZngACtP76Pf9OcfdLU/4OJGsR4Ed/F0EO+3fHf51pUYe1qx6EAvbaD/6BC6YBDj/Z+3/zjOLfA7Kb+MmQ0K3oH4F8ZgWzofwro1xJMpGBsr2/lyYm+v/yjAkhGmV5VOZ0LxWKcFsWYGfVPQc/TROD4Q5FXyuXAaYGFpRbD5BtjbLHkuFn966s9DAxXYj1t0A+PqbLmywCtrNcWzRWpkKSWf5xmX9fFlaWMnUiRq2ufs3S/oGAjJNJVyd1NFs0Yix5srN2U8BS5B/u8amHFBE3TtY4SodxcRC7HrNDRRM5NcirZflajOOjULWAHBKwBaKFEzbKdY3yYnnRTPxj7bKXxH92xT/V1X/VPQfrepKmrecXL3TPVbUWQjssHe3C5qNAdVM4gT02a8909VphhR8nl+WaQ9d+om4/UrUXNNlJnV7AB079Q+UGZYu1is8O6lGuJTUOZSZjDyjiUMhS+6jI9pUVHS+94+RKfs3nhz8SRjFUhMoSCMR588p7iRWCKpUMUsyZ59I7/9zGp5tXnopQv5oR2Yg98+WPs7rG75a1eNv6lNS4r6ChdTZXUB+fK5ie8so4Kyt53R1k3T0vTljcwWoTK24SLX1csfz8lr5bfPMBRxrTVD70TNsYdmIm2tDnpMnO2rStdyTpuotpjXUDr4psxeJerWtVj2ErFv13RXnF4lwV3L0q9qjtoSu5R/qcVDLrtnXOav5sZU+XPIa9JMSWWk8LpxBulyxLkBUqy6q2VZY40c+xImzvLNcgmubSPBZ7jc5vlBxzc9czq+tPWSk+zyge45b1Z8D4KWX2SkmDVHnbyna+ZI2aBDV1vNSNvOXyWSa7oXSuX4kudvZ9KSdvSc+LMvNzWX2N/PIgkt1/LkMPAN2TKvcxmO04pT7Tdvf48HGgKFCZGSkmmGgB+k22b6qTlexDORCbInZOdz5tryKMpaUcAhcAC8oyzZlPCPtX16lB30pplq5NDzDR7mdRXxvTdm/LhZgXQewTiIGC9MI8iI0h0hPz2Ha0r5FfVd+KrBdYVpU4/u22YwCjMoTBe00Mm4uX8q9RWY70sVwnnrVoZPU1oeppG37ik55UpjyAKjPVdM8apTNCO4e0+16tD5URCyOIiQKxUfhhfmLBrFOeUY6Mbyv6xrYVX4Ou+dQfnHnnPwEYUkOjB6AS6oGcAtTUuNoS5/9fYD0FFp/tryQf/N/IB7+LYANeJ9heNg4aQgghhBBCbMM3TzRK097RP5S3hRBCrLgLAgnNIBaEQjhEkXuICvdQqaDQr5OHCqiAykJViIf2XAO1C/ozQVhhCCJMtzAoLC48ooJTkUhIMoVKo9fsqgW7TlA9iV8DEd4T8+vPDrsLjy8QisQSqUyen2vmj+j2MTA0Mu4EU7PZy/L1fY9sJXhIinRR3Dd4Fvd2CXILWqLne1u27Vy7j9y/xObXLua3jv77IxACBAJ5vVmh0AmkTG4B/yCVlcmO5nBMkdN1EjWYEdcTXecWuotQxoVU0yff3vcDY072i4uULKWOaYAAEAAAAAAAQvHJUFVVVVVVVVXV/fPdzPOhqqqG3GoAAAAAAAAAAAAAAAAGdp7l8uexMrbmHG6eFZOprRNKExqDxYV3s4JVJBINmUKl0dNVk0s6cw3fTT1GzaBasNvf43RgcTUUSobAetHxIQ0QJtIvzhiaid5M7chCvGVWEqyzoepxbzNzct+qPQNBZ3B9O9TAECgMjhhhxbeVXYVGZzBZ7HEK7y48vkAoEkukMvkUS99L9ATVKBPpTOOZKfx9LvQscJ/lS/kA614QELhIaFFgcAQShS4z0PXiurHZHt7umr3DHNkTZXvLgScQSWTKOEe7Lrxfn8eW5qi+/8ddLLiSqzrgxg2AGzduAFybAe8gIkp3cYPAxp8j4ITe48Y7CQqNweLwI4Q+36EAAMCnUfLuaqPRW4hSpdZodfrOYrHauhd7/zD5SiXrtKitE96XLnX9gZwJdhIUGoPF4UcZ3lVo9M5BXbBe26PmqkwAgarC4GoodBgSLC68gQoQIwErQ6HS6DWJBattdajnxMdr0ISJQOIk2B2R1M1uxppJ934P9GnJecYZ2JxJ2SlBY7A4vHHR2sbWzt7B0ckZACEYQTGcICk6l37+3mqkFo4XREmespStPFewHXqD0WS29DbQOx6e81rxvrmkxl0/fuaQb2Hl/AhDBKoKg6ulLhbIOVrnQ4hPQ05I34QiR4ehw+LCQyo4FYmkl0yh0ug1u2rBrhNUT+JrbiDCRDBxEpsyci6p33LL2ty2Jnesd9c997PxQJadU93X64H3pzNpePvxcQ97+0jvz310Bmb+MwmCQmOwOLzgvffg0ZNnL169eZ9fx7A+8JnftP7wp7/87R//+s//4yuz/AgITuggVxiRFMgVlZRVVNXUNTS1tCFQGByBRKFz3OxUdq3lwBOIJDIl6jkanRHzNxNFXXsabDJlK/3r1jjZFUQOFCKQVcUwbxymGxJGE9JvQlGp0dZEmUvaogOfAADE5AC4AYipkErXdBlvo1UAAABAKaWUeltYCEWSWCcPARAZV86d8HC2KHYVUU2ModuOrn0LzSIohhMk1X3y8Z4PGH2SL+qeWI/UxgIAAAAAAACA8Lx/xcWDCiycPCLBSUQJiYyCioae2ZUFdk5QnuhXIITbgzAIVVVVcRxXVTWdO/QDesuKpKAD6rEhkaGo0BgsLjx+BasIk8XmZPpLFt9ZZvWj9X3bdD7z9wuLwBAoDI4YIeNtcZcgj8PuwuMLhCKxRCqT5+fE/NHF9jcdYGhkbGJ6zR6VCoWMlQIeQV7uupfdJ4ddTj2FTEcrVLVGXow3P7fhXaCMC6mmz7+97wfGnJP3C/c8KfYpbLi2OP0ABgBgAMX5BgAAAAAAMHuZ8KvqiATQh6AJaQiFDkOOxYXXX8GpCDMWZW2r0xUf2UCEiWjFTTZN52cDn0zOgHOu7e110XiG7hJk4zAAIRhBMZwgKZphOV4QJXlK6q20TdoOvcFoMlsaM1+NytVjVCKRqMgUKo3Oi3+BsMlexvA9hZ6PPo0vY9r7puqXuqkhVVVVVaVDW55v7P1SoVWvKJbutPwjCKgVuVUtDHBqtrxMm46Uh6BlPAaLtefkbU9wElFShayiMFXVlKPb9WiX0nnNum4KPYzMoCyw6+/lzGxguemCzaHWPNV3vgBCIz8Qm9jmSHxjKy3QxHEqy5k7zrFdyGmprRbXGjcOeOxImXHS1nGvuIP4mHR9ouMcmPD3oDOAgEFAwcAhtnfHL80poGFg4eCpDmH+fM6aFxZYZIllVlgdyiL+XvUyNcnqYjWkaPpIy7fZvo6HdH2i5/P8jYFXC83IamPrTKwtlbJGXjGzMnr0XhfzkDJSJ2qdFjjT7VzJuRB0roT6RQxAJ1CBgEU+HzpB7JvhJvEtyCiBjhlwfaCNtC3pbpn77A4bj893arHHGQc8AiISMopqPBo6xmnWyAI7p1f3wsJ49p3XTRcHj1E7BfzqfmyNUwAAABZVneD3wGg7O2Rfc2q3NnoYa9BZgn39vXGGb2DjFh0UT3CM3k65oBFO1Ew8SfaM8mZ8YtLMmTCUdMC2jwBpke01lxkns62cffMdUElP2F7EBZdccc0Nt9x5Iu+EpfIIAIj+VkcAAW4kQBMAIMC9azXaCgHdiZEAAAAgZQIEApYAJ0iAJgFyVIM+ZkCNuq5AiJvtLXdqx677OOARELHtTznjfC7kcckV13Mjk1vu5h7DExag7cd07knEr31r+1ZhT4VqWpDKaOuEh7XrxIUWcp8iz+DwDgGGQGFwxAgRZyV0V4VGZzBZ7GT08hbDLdHzfftOBxgaGZuYms1WgX0bO3ajunvtgfGYNQHAJqo3WjBY3FziJsESZS50kylZwubMRbNpspnr3rSw56WsqwcAAABYcubkNh5fIBSJJVKZfB4bAAAAAPiX1LmOl/dEBZWIirLdZ78nKlPphABqRVpVCwOc2rbWTzu979SSiqgNHSn9YSLlyGSy2qHhpBjZgQgZBRUN3S6Gl1R5zWVu0sPIDMoCu77mzGxguo4F1aHuPNE3UgChETaxCSUjxwBTaic4Tp12BncOc+EsS1cyWbtxw2O9ZcqJWxnujXXAx4Q+IV2fNzgHUvw9qBIQMAgoGDiEJBUUNAwsHDxVBWnNafOwwCJLLLPCqq9RvOEt71TcUpWKhq4hLxMt+dg6EnPRU5CPgSOERRjLKaEUMnILjFEudN6TQsJInHjmNOhMjLkLVS6VuyL8khV4GRAIOGRCKGDgEJBQ0DEDbTi357t7wX1Nh43HI50k9pjjgEdAREJGUVWioWNoqrGwdTi6E3n29nxZYI0K6lC05KQVxbYU0aYTtReNjEpYOHkXE5xElGSTy5BRUNHQ7SrzkhavaeQmPYzMoCyw62vOzAamqyKoDvXliT6gAEIjPOLX9NHJXyOKDzqf0rad6jmZ3s6iusuWFce6Gz/HSDNx0u1A96E9tPtj+PFt2j++13Hh0pVrN27dzY+qR2L1KylS0jKycvItDLSIkrKKqpq6hqaW9vQPZg0wNMoEfz9JV19CspDp0rJSky/xABsLAgIXiS4KDI5AotBlBrphtge8dmRf1IPNUdZJhmVx4AlEEpkSlaLRGUwWe5xjXi68354bifFCZVIIVBUGVwuFjw5jBIsLT1DBqUgko2QKlUav2RULUNvqBNWT+O41aMJGIk6irqO9pGqzIWom2YPfDmdAzmSBnRI0BovDG5etbWzt7B0cnZwHZF8IBCMohhMkRecyzp/VbGrheEGU5CmL2Erbwu3QG4wms6W3gd7x8PSK8VrrfLN8iqH1qVrfrxWeIStiacJyBAJqBbOqhQFOzbPkHNkSQ5uOlJXQMl6ChdM24SUkOIkouYGMgqqGlY5d67xko9fEdRN6GJlBWWDX15yZDUzXq0FtKHj6rhZAaGQLccmmURbHqkrNiVSnEp3hN19uC23ZXGGwTs9He0f+liKP1HhSkE8BUqVW04b8uQbZU6P6TzI7/du/kQOJfhl0eIcaGAKFwREhtaPQGCwOrxoBs3PWeSxYtGTZitW8JvfGW+9GKfnPqhi06FaPsZT9uZ/FVmz4d2Y7OlbmoudMvhnYTViEsaMmNpRuzPxQLhbWWTpGr+/ZPjlbyCPYuc6FFC5lXbkqME7w8SALFpGbQyWsgUt8L1KkfhYtMQNt1O1X3f3O/bEO6vHVTsg+FHe08SAgIiGjqLLQ0DEwsbB1UnUH8uztOU6zGKCgoGWQsHDydAlOIkpgZBRUNPTMriywc4LyRH8FQmgUsQngaJxysP0rS88LiJhQQWAIFAZHIFFoLxV/NAMWhycQSeRRks4qo2X/ChLNDg6Hw+Fw13FYZn7UOOak03qdsZznn483f8uTJ3/L3wrXkVToIhQcwv0tYVVDKklq+VrPukPZgGxh9CZtngWvgHdkS3o1a5S1kucQPUegwRPmAooNnzkPlnx87xWo8DikwlSr1OjS+kAHcQV3R2tjzBZsB6s3BpMmz4hXb++QD9IsVFgrfObauxSfDnMEGsxcQLHhieYBOXrp5n2WweN2gdU4YxUmWvVi7SMZSiPbAbN9Xf9R5zA2R6DBw3MBxYbHzYMlmwnyky/u1auEtyxZluXVq1dJwuOPPE/gCUR0Sm8go6CipfuZYBGRsuE202wNuvaM6XvzCMbVUFfgI2PPm8hKjcxc28JPlQhREWIFiH0LWalrZ2T+jDKeks4AEI5AojK9WXB6DXwCwhHVmMWQvM3eMIriXp6KEcuLcPuKTn0P5zt0AAAAAAAAAAAAQKYjSZIkSZIkSZIkyzUy7XFIOeG3xxBXL91buQuMsIh2GVFZbH0BztvwXEAxnCCp7T77Os4J87xCQPq8y99fdfZMP9tLBy/HeT3fxfJrwpbaSAAAAAAAAAAAAABAq+buCovmXq4ngW9xxvgmRHqnT6J2cBKBmv0UUKx9IIPK61ngIZwhnMhYmolqvXesgG2iaEutZibWu8V7FmP937VDXJLJQcEmJzMshI1iHLxjfk4oum+iPNSsa9SISgAAAAAAAAAApKpSVVVVVVWV/raZuhzd1cooEKgqDK5WitRtA0IYToMypKuhyNFhaLC48CQVnIowWew6XdmQNiM7WPZ0Du+fjL42kygoNAaLwwuxew8ePXn24tWb9/lVb//A5/hK7n4EBCdUew8jkgI57YpKyiqqauoamlraECgMjkCiBt2c9wgBAAAAAAAAAADgNT+kmc71Um0v0332w49dTV9yr68c10xjD7fxMI8kd6WAquZzdAxMrGxwcuEQXq042yQ7Zu0S7blVX2TgNUMvCXxt7AMTV0uNzNwLFv78ulglQv+J/HVUB55ceHtxkbZS/5+QoVlmbNysfQxnn5nAjBUbdhyNDOSCUccYnDY/7lSa3YV73B8P6s0ewqPXJ9rMM971swkAAAAAAAAAgGCqIWktSWstrbWWpLXWWmutJUkCAwAAAKBl05THhwsVv/8X5JweqZKkVqLRoRWjg7ii/tY18enaJ97+Ry1F2cGnNwaTWs+QV0/v/j4IMzeFsyLv44aK3/+RIAA25coMC2GjGAfvKJATiu5bJg+8/sXehxTU1NeaKGqWwiujEAAtqzW1INBSAAAACOi9rKqqqqqqqmIOkWMruaup6tSm9UCNKgAAAECQxQAAAABgt37H6j4LQIMElg3Nkja5I/EUdD6g2de6ch0a3ep9f+4QB6ec5/RjtZJn8Fz+twXXKFpx0roEgARkLjhDYtOEPNApvgsdLF1pY+0W139pPSVfeX+nwYgMCTAIKBg4RAuJjmu5Do0RFQm513Ll91IfrnN1bwAkFNlQLWm9OwP+C/GUeH24us/niH1tINeh0a2x90MOLm65z5seq5U8cKZBEEII4eVyuUAIIYQQQnUew3xMpHyLZijoH8zPPgkiGUduTuE1pbbKFbVn7JIu65OOy+HAZpyxERPo6lWcYW9GoMHOLKBY3luwZGkoKSkp6VVRNknIKKhqRHQMTKxsxcHtEHj6HjSwz9C4gGzsjiPZrqVGZm7MwkVLPaHLIn5VkNjTEVmptfA7kzkzZVsNpZQQQlCfqPNTmRkEBkcgUehhZLbgXoc7rDgEgEqr0gFQVVWpquXQuyoAIYQQAoAQQgjFc0Qwjq+zLH6JJ4gIxhhjfMbfy29Ki8tISwlnE7fJuSpzI3Ov2/f6laci71RZ+rMPktReli/8HCmdrFDf41czswAgTBxI9N2Xa0e712q7UbaBOr28b58Y3Pf1B1SuCANSgjXRIu/ycT9TzUnNpqbJImSXSCrP8gW+Yyzdrqj/bU5/9lbKuJDq9ESd1nYzzo90trAFHd3q5dpWco2O91X3esDGMR5ADSZJVUlRo5OhxeL2Q/a6gnNFmCz2dbpsbsY53XyHZ2wc457ZdtPRrV6ibSUfJ/TtYoDnB2EUJ2mW9wfD0Xgyzbqb/UcXYcBT0LKinzpAew0QhuoRG6mQEglQjy2py9bEJxcF4oNftRg0eMjQYcNHyKXttfoavKu81Mm8a73U+9BNvxggLqLIMEpDhDI0ec7p9tCVEai5Nwso1g44nCOVV74WNh3pigs3lar6uopVoLbo6NinZyhdX9dUxU8eF2BFW1+LVCrhpkTJWyqjXL1ibLHFMAzDMAzDMAzDMAzDMMyYNX8Nk4Cqqqqqqqqqqqqqhtc11anSmKquZqvmGRAa35pI5VUrwF7HqN33P96TZ+vJWtCi+EOk0jpchTcRERERERERERERmWx2ryEgIiIiIiIiIiIiIiIiIiIiIiIiItLX+d3R3d7/7YIW1MsyultBNvUopuSrVGSlBdbWCWUYHYYAi3t/R/zjneBUlHQp8m1EzU0t2HUG4lIXkqlXLCyz8qZ1Nrpk2arKnsjh/pjuDBbYIcAQKAyOGKHkbWXvzKrQ6Awmiz2O7i48vkAoEkukMnmKHqupNHpMa2z38ztP/unaJfC0GGuJSq3R6vSZe4v12ppXx+0VERGxkQVEBAgCRABEBICIiIgIAIiIAAAR/W82EHIhcSqZarVaB13ocUnlGtfOmwzrXTDjbxyGMwrUHJEFFGsPzQHZh9H5CI456dNp+Yw6HyQ1DgAAAAAAAAAAAADSu7+OoGuoFyH4Sju1BycjUDNZQLHx3gkqr/9e9SjslxSq3FDr1BCvD6i3KzLXcDeo6TYzQP4r+y7uGDICNZMFFGuPfj9osSns10zIUnafabnUm9dhyf///wcAAAAsDiKza+nHGF3R7293cTE/xhhjjDHGFfsYY4wxxhhj7LYne01V5RPN78v5Czyr9b37Vxs/8P4MnmaHBgyBwuCIEU58W9nV0OgMJos9zlfvbnh8gVAklkhl8imuaS+jh5VP87yLvC3M+sU2bNrq9uHuxK49+9774GM/JZ+P+JKvv99v8d2PHlxif8Yvv/3x1z//5+JO9ssQ1RUW5kMIIYQQQqGKioq/H6hLICx+/DZHcn/dbKmno9z9+9pPR+d7cJ8HPOQRj3nCDLPMMc8Ciyyx3JA6I1Az750WhMXnMx6rkT2U93ca/fgh+Uhp+o456dNi+Yw6v6ZesRWKJc0qY2qkhuq0CvZd1zFkBGrmfejGxHSPD0y0yrpweQ7GGGOMMcYYY4wxfh37PODt6+MEZS/3OEVq+sNsZw86fjn78b/gmhuxLXdSD8+8KPt2+iFfzfxQOlqtHvwGNDaWhE3SzORmkUut6hrT7DpP7LxjAwoEgSFQGByBRKExWNwka+8U0jKycvIKU2SXUFZRVVPX0NTS1olFfkzNr4H8Hj1/rO92fx9gaGRsYmrWrTV1Gzt2u4f9Hiyxh+LIsROnzpz3YriXuLquG/8OD/EV/x/Upv5TdvMfBKgqDK6WVom2TqiD6DCHsbjyPoEYqbp+iEA528mTl99MYyTVjHWEbMW1cTt7/ZOr7Jplnbgb2qgkRt44EimQKqYUSDmcSm2q8dRqsp200911zN6EM+Rjz99BYnsMAL5Tw6sbP/3ZFy9evXkPlkLEUN+bkBVV0w0zIS1B0iRS0jKycvIpGFRUUlZRVVPX0NTSzkRfFsJZCmalaL6O9jawg4DARRRaDDgCiUKXwSbb4u6wd8ix3KnsgZUDTyCSyJSoMI3OiImz2HE03Mt7tNNyJbcpe4JAVWFwtbRC2jqhjqPDFGNx4csWSXCI9KNYOaS7nry4nrypnrySnpy8kJ7v5Eu+5OTk5OTk5OQecnJycnJydy6Si0dyJrIZkdROXv31Q/Pb3tsvT+LPbowLSDyBSIqfwFwIE+pT00NWVK09hhkTKSAqjc5gsthxjHN5fIFQJJZIZfL82Ne54E9yeUdEiEYCQiH7D8ExVARBEAT5A1zuz+aL2mwLaNuO3fdu4P18cA8fxpFjJ06dOe/CDokfNVr2opwtN/UU0gMAAABEAMbafcYJCSGEEEIIIYQQQgghhBBCCCGEEEIYDJfbtg/djuxmL/sGIYQQ3c+5Uqerk0OOzeOSdLep2XGXjD32PQB32EZOR/DCK299dpl3RfNudWjQpWefnKFjd9dv1H5njBBCCKFFt6X3r98ihBBCCCGETv0zs3f1lj9ztnONhV+uED8UMHAISKiFzopNCgAAAAAAAIAa9OH/KimpLm2W/sq4ZPrP7MjSyGvLx5tDv8HhHQIMgcLgiKlN2OtoTIu9jY7uiNN2iUt+PKkJ1rr39Z1rttfRqLhXiQ/y/vj6sV6fP6nLFaA+BFEayDuJc57WnH1aR2NavN/jftaO6lbsrcQlP754UgZC8ha0qHk/n4Bb3RQTuFrq9gQ4k3MA50MoS0NNSMy0INo6oQjRYYixuPBGKjgVYbLYdbri0hQSHykQNsremCTU9xHiPAU25ylZYJ9SgsbMd7X3Kau48mJXicERSBQag8WNsKBdFiGNzJNnYb7/FQCgOR8AALziZXkwDBkxZsKUGXOl0xYN2+yw6x6S/Q5uqcNwxDEnnHLGeRd322W4Wq4fv+6MCf8AOHXHhbssXbnJ+vYPmlvckfTvXk+J3j4ArnSGJtYlAiS02SCUlGFCawptt7U+B+315aqo4rly6828PXToDvETe2m5KqrUhKuiijtQ3WcDkMCyoVnSpkbXt9+egs4HNPvarlwPjW7tfXuKD2AAH+A5/DzXlHyBnTEkcMFbqr3tsjvl8/8rBIfnckO7QCAQCARS9FBKKaWUMcYYY5xzzjkXQgghhJRSSimVUkopVcM6dY3rpJOZqNjnPbPZbLF02Vit1tfmVmgpk1vAP0hlZbKjORxT5HSdRA1mxPVE19lCtwhlXEg1ffLtvg+MOdkX112kBgAAAAAAAAAAAAAAAAAAeEUuUDDoMCgsLjyiglORSEgyhUqj1+yqBbtOUD2JXwMR3pO9FP0BLASCERTDCZKicylfp/xvNbAc34AoyVMSfa26Gnu9pmnIBwAAAAAA+P//////J06cOGoAAAAAAAA599Hv1kh/XpsP3P5efRTL6AUGAFRVkyRJtr3/RR5Kvxm6z0x925jt23s/lYyOSIvaOuF96VLXH8hJ8HQtddo0TdM036sYZXirjUbvHNQF67VVFgDgU07//uCeuQAAAIDXZqpMAAJVhcHVUOgwJFhceAMVnIpEApIpVBq9ZlcsWG2rE1RP4uM1aMJEIHES7I72krrZzVgz6d7vgT4tOc84A5szKTslaAwW9yywwf89Hjx68uzFqzfvCopKyiqqauoamlramaBZqSqyKQoMjkCi0HNcyn7iuYKdA08gksiUqgPVoDPGXPFucdnz8+8AgwIAAAA1sQIA0qJ4lIO+wWQAAAAAAAAAAAAAAAAAAIDchdwHqDkFAGpWq+69R/J57qMWAAAAAAAAAABqYuUjnwAAAABwnJoc/AKCNd8QFqESAAAAAAAAAAAAAAAAAAAAAACA537VTsOdCSAhCQAAQBKAJAAAQBIASZIEAAAASJL0rxCKkcQ6eQiAyLhy7oSHs0Wxq4hqYgzddnTtFhoRFMMJkuo++dhzwOiTXNQ9B2qp7XsAAACA////P2oAAADA////n2QAAABAVVVVVVVVVfX/////////i76b8EV1BAG9BI2kIShoGXJYOHn9CU4imFqU2ZbTpY8sEEIjWuKSTel8N/CRyTngnNf25nWhsYfOJQDlvSkAESaUcSGVNtb5ENOxm47xz8fU69fGhyfB4QlEEpmSWSOLYfcAunqhglRVVRUAAKCqqgqHnAAAAAAAAAAAAAAAAAAAAADAsfsRVyKlRIWMgoqGjqd/AsKSPcfwmkKfHs6NTjedsZx3yppmOgAA/v/PFEmSJEmSJMk9fLl3f3tfVGoAAAAAAAAAAAAAAAAAAAAAAH53AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAcIqzfl9QVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVFXwAAAAAAAAAAAAAAAAAAAAAAAAAAQPM1K6Ci6AlLhFaobEFo05Gih4aBhZOHJVgidSMyJUuwcXShwCbiFNlCxpKHQdQcUmAQUDBwiCFMe1iB7D+ZQZMmTZo0aSZ6zddLsCEIgiAIgsRPvmb/fDG5UvRcrhuFOKHA4AgkCn2xxc7eIYdchpeIfAMAAACAOEa8ij8AAAAAsRWITUBNUdXqrtYqU1VVVVVV1fMrBAoJAAAFmMwCAAAAOAEAQMwDAAAAAACAOScAAPkGAAAAAAAAuAkDAJBj8ip/AACyFdmEEJoCpDutCFMAAECoAQAAfAAAAAAg1gMAAAAAAOEEAAAAAAAAsGdgBXXKt6KLLTlpRbEtRbTpRO1FI6MSFk7exQQnEaWw52XIKKho6HaVeUmL1zRykx5GZlAW2PU1Z2YD01URVIf68kQfUAChER6xCemIcoyVqpNOp7ddVLfEFYd1G99jpEw4aTvQPrRDWx/Dz79Ne/h73fyCb6VLV67duHXXD8UlFElKScvIyslfGLiIkrKKqpq6hqaWdv1lBoZGTfCmwWZCLWReWis1fYkHbBwEBB6JHgUGRyBR6JmBN7RdYde+6IN1DHxidtFz4AlEEpmSStHoDCaLndMZvxqXdzg3Xqx9hgAAAAAAAAAAAAAAAAAAAAAA4L42AAAAAAAAAAAAAAAAAAAAAADQAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgOvvtdkRmaKlCcsRCKgVzKoWBjg1z5JzZEsMbTpSVkLLeAkWTtuMl5DgJKLkBjIKqhpWOnat85KNXhPXTehhZAZlgV1fc2Y2MF2vBrWh4Om7WgChkS3EJZtGWRyrKjUnUp1KdIbffLkttGVzhcE6Pb9Ce2Kl5ESNUlABtnVoPtbmEzU+Ncqz9XmDcyDRw6DDcwhgEFAwcAhJ7VDQMLBw8FQVMJvT5mGBRZZYZoVVX5Pzhre8G0rJh1Uxcw11q4exlP3C+hNvL+K2o2NlbtNzJt8M7CYswthRExtKN2Z+KBcL6ywdo9f37H+5eL8A/8SXRBJv/xX/R1zxKvxfxIMasuAmcnNoaBjgEt+LVKmfQccMtBG3X3X3O/fHOmw8vtoJ2YfijjYeBEQkZBRVFho6BiYWtk6q7kCevZ7jPnZ/d3BfFgAAAAAAAAAAAAAAAAAAAAAAAAAAAFjtDgAAAAAAAAAAAAAAAAAAAAAAAAAAAMZQDwUNuirCILG48HQVnIpEApMpVBq9Zlct2HWC6kn8NRBhosRJgIzGUYed1d4BEQsVghEUwwmSonOp5EdrYDleED0lUug5Jt2TDMvzZ57MzMy+Ty2zmtIZNevV4rLrMGwoAAAAAAAAAABkAQAAAAAAAABA9j0gLIClPrgnUlhhB91DoK6rICIiYmZmZhEREVFVVVUAAGBmZmbu7u4+J1YKrC5OpLEKE616sfaRf8M9kBGomSygWPtYDirn1d0EIiKi22JkJaX7pL6TQyFJkiQzMzNblkdqnWNmZmZmAEBKAgAAkM49NuPIRl20VcMGlAAyxkgtaoltjqADg/jh/Wx8nf9yH9E54ep7Qh54+fR3Zn7Uf0hnzwIAAAAAAIAkSZIkSZIkSZIkbyoAAAD8//+frKqqqqqqqgoAAADbtm3btm3btm3b0rt3wChFEGGQqN3fP50sYffh54SLu5+BvNQ3RF8dAAAAAAAgSZIkSZKUJEmSJEmatkVNAjKFyuZwHeL5EBMSmYVnLT0ndOpEQsXujLBSzn4x83eE4gUbhsVtxjsJxWC+G7/xG0VBxcbB5RCeAceYSip2N2SluN1oJzERozE6mPg3ztcsPaCF41OCj5NZ2F9p7qug6b1CWSu7xYQIv6TuKwSmDtDAlWKtC0upJUFoFGRFCpsyMUIvqTtLgcYBiq4Y40gt7QqWExmu0jQ1akqYgBbQJZrnl8Ur9Id8Ku1V2AHnXEMW/r/ZI3JJHagFdGOPkIrdupBHw9RlFAgoVTBwatZtA0gYtkEp6aoUObQMDSycPEmCkwgmFnZOlxvSMtzB3NNx2O5Xnv+czDKnpBrQGCwOr4qEGbPmzFuwaMnyQL2FoOGSS6BsvPYK5KzUeP4+QqWUUkoppZRSSimluvpXs7qmj/UCD3EJ4aLE0pKojvdOKuA0Udqh1mQmMqfoYmz+uw6I22QWFA45y1jEpszhE/M3UXpuoh2u10+PGrYJAAAAwP/////Q55zTqv8/gE2L8H/IOT1SJUmtRKNDK0YHcUXtGuUOamPMluLr6p7eGExqPUNePb37+yDM3BTOiuwu1wFJZgGwiTAshI1iHLyjQBKK7lsmA6+/JV+xDGy/epFqVhZ3BwAAAACVBwAABKgwAAAAAICGjhwAAIDOCgAAwDoAAAAAAAAAZGUAAAAAAKiqqqqqqqqqqqqqqqoiBAAAACoPANABAACAtI+OnG40BBIAetUAAAAAYNP7//7TAABxAPEAAGkAQMOS6ixX79RZfexTUM6wsLKxc+Pej1zHI0+45uXMGz79TZc/6j9KHhEAAABArYq+SgAAAABIkiRJkiTJjavnj9ef9b57vQtJkiRJkiTpIKKgQ6caSL0lzZdpk1a6/+G/J67z7PruDAwjRp9ou1VdXE2QJEXj5UDSxRmK9U98ky2squr/07y7CKQlwdu2bdsGgNQGAACAo4vcMJzN4WpG1KCWV/GDvQdBh51HoOx67w0ibwvVNE3TNE3TNE3TtDiYz3A1LDXNajZJkmQGWnOaH3Q9CDrc+wiUHR9+gsj742OO7QcAAABAVVVVVauqqqrq/////1FXVVVVFQAA27Y0bo1G8LZt27ZO/X+TPRQlkmAVLWRXxs10d/KweWoWwS4hKo/5As8Y0+0ZdTeJCIrhBEl1T3TsOWD0D/mFP/77TJcL7n6S5qW+YW2GkSRJkiRJkiRJkiRJkiRJcicLAAAAAICqqqqqqqqqqqqqSv6mFZ+Mb1maTtLetQh7euqfPvYzoHpWlCSgnj2pa3/ik5ZAPvjg3WRz2lYwBAqDI6oXaq3tpnHELG8J8lnL9f7zD7HXeiomAAQSAEgCAAAApKSqVFJVSaqqqqr0ry3EX8hiXLluwx5pvhP6PKPtoysnXH335oGXT3+H86P+0zmUUkrv+4sWR62qqqqqamZmZmZmZmZmFs5D5P01JZIkSZIkSZIkSZIkx8E6+3UNISB+YieSjqn6z7cP9RdrA+Cdj6hfTwZuEDX/gIdZvun/wo8oHfaAmpD98drcBXtuHnD/mlNk+JonPBaaF32ozBthdJgPYmLjIcfRHtCHnAinmPkMomk0n+nWoq7hLOKVYHmbQQEgXruyt2YIe7tnhpG3T82I4u1XM0p6yDAT1B6KEBFFfWomWXv48RAy/ZGVbnUvHZ3rQ6jbZ0foQzD688XMNOHn/22mR98FyBkXeeHp57/vG8ZlspdAnd7AsWy787QaPoQX5PVFK/WM2TzI3zB4E85OlVBPI8azaWr5VnkNiuFdqbb3DdASBRt2tpMzRtoDC2qM2oxCnwyYSeLu43tTPbNBllbbfz9gObgzWrBLXuDA5qIdtmErywmTyQhfUepa2P5++deHGxyQCx5DpP+mNf9kWI7+nsZ+eEFx9/2oF+d6dt9+6XiGZTiG7/+0iTpYtbH8HLAe2LF+Zc8ff8FZJNhdm3YLoLcK8LDzDAbuIJBGmRvZgWILYqfWUaWvbESX0c3UsHuQZU0FAg+opyGKhRxaIxU+VQcidWcTtSs/eKPEiKlJvRCxy945g/fAN8zW2PzElryFZN+DeyZxbjloHMmcHveQ/NYC04HVBpvpVpAxdUdNnHuSB1x1iZd85RIEpAk7pIKS8TarZcsXUqWeGKiNUWpwD6HRpVLtW5UU0neUJpo0a8T+DDyARWfNQOaGrynBqEnTiQbglD2eQBemSrk7slDzBelgEMIZ0EKNI/FR6VIIQBt26VST14uEC+YuyHY2oLyxr7ArDFrJXRKrdmk61EK0+wJ6PSFproHdU1fi8lo5moFqDLZE5e1L5OThQIIj2Da15kkaSMiQkjXtV0rclfeo+FboxiWHDeWS0gCgiafBynxkfBqheiCjg8Zgp2TuHO6Y0ptA0S4nDE1eC7AIgPFudnJ3nXXuBTwQKTtyDcRaqz5A+gU6zaSCZ1uORAvkJve3zuIJjjhIqtd+mXs295dRHUAQM9dsAjzSjraKsoAuUuPhwTBFg5eAmWIaRxf6gbzfiF6lSoQFeivX6Z3dSwr3MoXzV7XX9pepeeinlBhTfJbVN65PKXhLp7MOE2KcXvhWrVPqyQbXUssWJPUaxTEIzKUzNM+lNm/YAB5UJVklD+T6AKaeVyNwthi7aLATSVNQ3yft7Av441L/Mql8E0reXgTi0Fzr686xZZwsfE/w5VeURTuHIkpEFd9qlnoXiDHISVKpBruxAPm5k5ZS7msWbtE+pLalL+u7QB713/57TSYGdhje/a7jMtlLoE5v4Fj2N0Kyd77T8iG8IMc1+6KVesZsHuRvGIhfOK84tdNKI8azmfQar02Kj5Yr1fa++4ppiYINO9vZMhgj7VJzWVBj1GYU+oQNQcGSuJ/zZ6hZZFOAywnX9t/Bj+Woi2ircNq/wOGk6HIswTZcRZ2IyVBphJs2Sl0L22/c98zlXx9uElkwr176b3YcyD/d2Hb9a3j3w1NNrb0/tS9OjTpx9+2XjlMDGI26/u1FP+3zw0jqiwRYaI3O//ENfY0fexnuquihm52l+5utQfa/Du4gkEbyPm5kB5oOGMbbu1b3BXeAqevm2WP3kOizpn3pQwoR1p6KhRwiEBUv5lM58CQHwIriDYM3nxjfXdqkXjAJHmrt6LPHAfQHZkscBfa0tASuZN+V4zKAs9YlBI66Rzo97qEEMCHp1o0dUawB/diIZ1GTYJ8EsrNHxEvEJoIACNJdYS+oyDN5m9W2iZCwYFOBuJ7JKNUQKpaqT6Xat36aUMZeC3OZvtboimeBiJauaE/kDRhsj695yExj6I90HzJdD53QPqzsaLpDPtT8SqWLdIu5lrofInswlDYvlSwXe6spquF7fQnvCyFQd2c9yptmrIFgcEAr4AeaWpsSaq5aNFx35rJmRJ3O1mw5KuleXmvLBJ5ejfGgCT63gB6cR1JzquCIp9h6o+kLwgTp55CuaLKaV1COVd5xwcqBMtpbHFJOyne6IAB/+uaV+Yi5F6r7JtMDXTDlmlEIujAN5EZpjAlPoE3okw1CAzYfzCw5OqJz/19vQrRRleNyvTExqmR9GMQBmZoGL8NEr5oZC2AAnNxRKCd9aHVBbpTO/hoHm+5xoBxEFtgriSc3UO0qzbqgrQJU4B9CDp/hDabNmCoEX/FEvWKCXgFqBjDiLzQ+6NXqpCo7USWBwRMVeRywXjwd5Yy18l4Nw8v6qJI29PVUHUbM+wgrb3yFunCqwbUe0ZKJOsC0OCnHyH0BK7KcTJs+ZGlVYm3pdNccN8XgM0mcA2TuGeWNaCwx70/dJ+1OHFnAP6H9nExzV259nVO4AW2rrVuM3eGYi40++PIrOqZMdCZdgpgBwkGaUowDWvWMhSUWatxHPFVKL93ZOG9pmBtDARlw9hvodWDAzf8DXW0GfooaIXsUsOsXem37SYX1ij9WxAAITQRYafmq5OdLb0RxGS0zAQQlgmAhQn0UiaxjMghnc1KEb60RJdrf1qeA2FQQBwitv0RJloUBt+RRxKX+K+MrBL7yUzhIjwExsbBxmkeX2hufAF9qUoVw8ldLtwWdwDJkgsgjsta518UW2vL0fVxYuVYU3koT5qzynXgMWcv3j7IiKixSlGgxYsWJh2zkq6gk6Ee4BFb/22qf/Za2IFMLe6NFPmxxS7zZUuaWlVyKt0v1bmneL907ZXiPqUxERz57oL/LC8sR5flnnXMe0NeK3SY27LppM9jW+MKXmPi+/Ev0lwt2T1xg0Tdx8XR3dC+vYgMWW4eKgMKH0ZBhqgmjFbxpj14uRNBJIp8A+J6W9MpWlVe+K0z6Hq2XFllMUgQ4OI3AaOQvZLf7p18NNm7MRR6/d99qOcCUMpId8hM/r6CAkGuWXf/PfNevHKu+Fz4SShTDnjwl48GHpuKSAvAkZT4sWQSy/NjjZBgmnnDGk4WVJJgTq5ZGSQ6aTjFj5qzZc/Altcyd5+B8GmjGgXyHFixEtGZc0rxsOduKv0NOWkEYBPqy1FdeXZX12utwufp3vmUgm+NthzemxLHcV7/juGh5hVNOOp3jzOvb12r9ho3/2GR+3rLVOWelbZMOfA1YtyUv//0dO2V8IEumbOfl7NpdsGfvvv0H2A+CVvKMFVZJ1lX8WgrpROnzsK3KQxUuQmUavspwVXVNJBrjiuuvNVmXwHEzyQOezeURGmB2i7RvPPthsjFMQlIf5BTgjDbbt/swT7ArZ9hXe1JNH4FEI93JPPM9PYvwtLxiC/2On2MqByiK4QRJFV2bO+HTQP5IJpJhOEFSrnYqFyjq3FJZw3HJxOTv1UqpaCjruPAf0vF0ooK7CNyjzN+/RJm3vwVOS0DhsdgRJ4otZZS9BFJoAZ42+qlWN0AhNupTxWDQZqyLqrWNQnwxJSMBtO3TScyOLcSLrI1tsHKYba/1V//q3EPJVPYYeDNWjjBULMDT0d7WcoP79MvQZCXjKPThE+AQXAlLltlo2KzwwWi3LxPv1/OIuHDj4ODg4ODgyEljdr0TlZQlcl3p3+7aadgNlX/c9jOQmj5tpmDyp2gBBwVEg3kcdhb9kI+Gy82QttiOATrGdX1JHgprglvAwBQJYKKgI3h0Cz7aj7MXv86QGQnF+Ks9soint6qOODSfS0Ba/tov07Il0rIuVAw39Hyz7yHbTat2q+6RomTHtKG4mA9CZW+92dP+Wbd/y5b6vbaRY6L0y47y2fHf9TESVs6S23Nc5i5UheYSPSEKiBRbGuMOuoDMSGGUxJYbhj2Fp1v91N07xEpk3JukqoEv/oncP8OJt24/czIuRVRuySGvxiyiAovoDe9KCo6O0q9ykrHH8fTaihAPT557ghhOkJRjxjAMw4+1OJWlpOadyiqqSEea6w7N/0ffHd+E97kBW4zanBw76kzY58eXsxyZX+j+nReGzA8LbH6iex5fiHj2ehPQpZbf9skQ+a/ucnGQdPZtHYBAESLX06IDU+X5y0sDt8iESsNjP9m6bzvbQfNrKc8W4SH0v7dDMkTTvf/FYXppYNnLZuU5AA==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/fonts/latolatin-italic.woff2": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAALFMABEAAAACWFgAALDlAAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HMWhyFXAZgAI0ACDoJlxcRCAqC3DSCo3sBNgIkA45AE4SAAguHIgAEIAWubQeTMQyBCltNN9KyP/wfe86UG5MpYszsWdmvviqcxdC1EoB8Xq3d7jYrCWqJrD3qGCD2n4BVh4wFbDDOvVppLsg2p52I67kF5lBLC5X9/////////////////////////////////////+0mG/H3/zVttQe+wsM3QYbcoLPoiaVZSpEbt8yd3DwvyoQSVnndpCklbsassIa3ZMg7IxcmBtw6Q94z55Zz77OBbEgDUFJeD6KsgaNxqMmgAFmMLJINIpskZNM4i7OMuiyjGrijzD2NgxFLU8dzMFgsa7iyCqNBM1Q6NEFdChJaaws2ovUEpKE2GDScUj7e6GAeUcaLWUU9aUsGLYWdQ/MFq3Jy2kSjzXhuLMeVoTJcxSqc05at22hs3NlMHotQEzYvQ2wfBmmluXAo2DiluAPspFYQUUMFchohwx21kjXatVtS4ixHZo68gCba3patfCSbqdiBlmwrWEcNbmBm0MgCVBntYaOV1R61bG8DNtCsg2MD1ThCS2QFczaG+/YzAzM01pTQgZT3YTXtkfW2UAI76yN3aDZDDR5QKzgoONQPsxtmQQ4fOYq6BR3DA96FLkQbBEcOHEfbyURpgEmAE/pZDnaAVZo3W5AXsC2hOTRDLpnTLuNHWLLV2Em8gKVkiCxAjqdwPZ2xvbyNVAGv6RCIERvqCXaDmp/aQlXNc8/KLSypgpkF61ZTcu7YuXHjZqyjSI5PhznCM5yH6oceMefFGYHrGcqrEEsdM9o52AdKcjTDDkszaCbfOt9gLTyr/5zy89TSBVZqXtPPzqK9+i7izbS6V22HJpI1vkVQK0jwJXY56GrY7egKu8oiLhTtuIYbSi+CM+w624HLTch9EzQ4EHUDa/EN5QfxVTSopPsqR+5nA1T45nXTPq4OroArqMEDQRuwwrf00xJ07Dg5c0EWPj1v8LayGOSO4KLau/rvDUxWwkzFGbxL9X2wUf8D/bn+h2yq3ci0G5nyR6FKDQuc0mOD2/RdRvslHjQLNUH79R/Qf/+cwbOyCd4Q9IrgzhO4kKX4QNBz5/FTNGDP+D75SMOjHSaq5DHkDD/Xj3fSZf2H9C/IlO7EQ3oRNGp5qf+V/qH+1/qPKn+jn71VP32nv1K7A0d6K5vDadhz0vf6P+jnJ/Xf0f9R+Sn9p/S/039S/yHFp+CnUG3YwzuFn/Xf1H9C/239X/R/1f9N/3f9P/T/1P9RP/1S3Ee/Q/XDrvbhH7RP/3n9rp+O6P+r/6bW44fYYeb0T2gqvuCf/4WRHBm5/FGw/FG2kSa0ZM+S5Oz2Gl8PdwDXITp2DzyB/82UvGEwrvcDQVpRWlTqwcbBDTnefMIXrf2/p6u7Zvbu+4AWE5YREoAkklHRgEIyKCAdzUKGVbI9BNvsiFZCBBNFCVF0YuXcrEUZhQqIYM54p73NxpiL8ud+7wIDZHNOhq4cg5WwYJWuPtbNj07f9MRDoqkgCWww5mP39Naut2XKlMvVMmbe/gC/zf65MN4DFAEJAxSrEROVaBXrwUMBUcFs9Bm1EtdGLc2FK9e6bpWrf7fddpG72O76n2m2XD8GgC3fg6dN5yqj7YNwqD6puwTA/aZv79Xo2yiD8B0HNgtIYn2N/Lt1hf//v/UgHliYQKaB9rThj9bptlc8joNz2tkLN8WB/zJwEiph2XZEaUdgbwyh0ueJb87+7uP/6eeZMlAxpCrUT3N3VKCi4K1zVCkqBU9Jy8UwiRBdyWaz6wkJHt0YsiQVU8VFMQ59muH7MNVlQSY58qY46vq0qroavk39R8WpiZBM1OszOalFyoNnQKx10mUiknZmHI7T075fteP/XJWsGr1mTLiuJmYXxsIAKTVx413340cTr21XAYP2T4q49/O59Qkzs8AwboZwVca/6dA/6koqmgC7edofILkL+0hLRxwpKO9R0aao3VUjh6GmA4335+hG4IxYP5Wz2GqlLqfWDhz/8Yc2xOSEalRnDZPNcVnc7yEtFHiqi+0rghIQHp5rko3u44wKr3NdtDccJWZ0B8eGRypiv4fsfe8fsAPA8ogKMMBgXHx0XHz0lb/yqRgXRVMAAVSsiXuQhMbolldGdjaX5VWAPPybdGuvrjL4EUxQaYDdfSMwbUtP97MdjZeVTwxhCnc3AwD+8z8AIP6P1laoqLZKC5QglUiIZzNrisv/RJ3vTw+nf850dh0UDD64pwb6dsqlD3poHew6cQ9XIgKaxbJltRHbLJmSRdPTxCJHjY/ZREMFXy4bnUNhgT2QbMdUBGO3xx+qOBj8PUEgYbsXJQVRFFgzJYEn1Nl0wumWQG/s32A8y+ZKfm4SkhTwDbH60NDfAdvGuepYcWYBRuXhWiasax/QVrjDrKEIOxgCkTpGjrTH1r85v9AWBfmqgPru6mLewKiTgDrVmhYvAJSaZR+eAtjmXwoEYsFnFhcXPE/eRHb3YDSYUaJFXReHxWFCBfKBU9k11araHVWOOKUqJJwzIGRxj8J50VE0xbfpqS3uUAPNjnQEFf7kBQcr68EKD8he6ERnHC/zsl01M0E/Q0HKQ4S/wVjhwlRK2sPIxuyr1TUsEuopKv+xeUIhFgosrBgaKla2AANjhCA3TRhCYfRDoFPZqoqjTlCKry/WwiVkmqvL60uUhVmcoYUHpjfIGL5RHGwZV2fXf34lgGM1eAMmWEK5p1RIqEZLvERMEPUSU5OTkIqs0AqxusOLvbK8DM3YDM7oDM8QzVHS0ubt9f0VxLX87Kta9luVD+kGmPE/q6T651l0jERLhCVRICnPOvNvOqudUTDB8DZHXQw99l0uumuvvaLR/xPgz4wQMwKb0QBGAocheBkJvCtg70kjiRVC6wOME3hTwt6YGSQHgdOAfQ6RZVPAu7m7HHJ1ZeqaGLsryuba4+F7XV+mOtulgdBA2b/ZQKQgG0qD15oeeCPLs9/c2yZ6Zi1rgBcwcmiOCDPWci+3ATDKnEZbDiInIBAkUAmk0IAs8kgyrRw2u1l66Ma9EPY3tMvRElTtsnTHIDWDrU1oBqGA/+Lg97xzgY6lWfrc7qydWSlsAqOsmSgr4E+f/ycW+kq/dZiRLktgASuHqoS8j2lRahS481Em0IThYukyDsxXOHf0FFlHhHsHPfR7f1ruLM2iJMKBda0cWn8q8g8urfgnXKRARsZ/f6/TPwhVIascWNe8nNy2nJy8IJvL0M1jTDX/6Hif5QnCjiVUU4UkKIRz+L172+vdCa1eUHTl3kMtbnAouSXUZGgt1KqKWvB5qBxU5EoOQoH8rTj3hfnPzWp87a8JBxK8I711AAOT3///y9TSxULaCtqWBpYCFAvYzL+v5Mx7qfN3uyXYSDpbKMY/EVpQDATfLD/pyrXISAXgAGjOdGyO6QrqRt9Lc+kZBQAY/iFP5NwH6g3MMlrfKo21hOGp/qDkqSfRVMCkp5dmPx4eGCYW6EVWmWWiPNLfzgdUYLRXtMKfgSgSC6Su7eTxaTtBElRzw57eSaAF6Nt9EkRKV0ooIkGkK8U9jvMfxDPT/zdzBLmpq6CVFsYIY4QxxviXOP2l9cszp/k9YxW1KxfLYowRwyCMXtpLe6EKP/DgXml5CTgpWIJk+JRSipQbDhERl1HfifYVZ8uVltmWa/ndDCaYYIwwQjyEMEJXBtm0RnV6d//dvAYkEHEIBLMuAjzfUCbnh7yp402ZMoiUEEoQkY4OYT/sQGNQaV49/qH8nv/p6aHqWWtFjIgxItbq4V4ixyYD93VNSzVbjUuHQ0UAwAqSkQGPuXxRMrC/t65iOWA/WCEtBr6P5VSWgkjg4O7ozvPbskKyQjGYV/H9FaXAPhjpRrbkCSgPAmizoVAA2AOO53LCbCPO5nXuP7L/feqHl3hC/eA3gLA2c+1XIlTp2qOfaZ/hHhOAzbcnR/NtIeEWokSiVs+eOUHM08RKUnTpskM/F1p9xw4D3ocAnJs7IBr3rX113piDlpTv0ImPxBvx7fgJ/G38O3wcaY6+BGsCPRUniN57+TaxnnidtL8m/kj8g5RB6iTNkB4mAAlJfzmtcxI4zZFpZO7OShq5FO/Fh9gTRfJ18mXyTYEED4oek7/WyiirVKCm8jbVnyqkFnLNXuc15F5nYbuI3s4tRjBinRtM9QfY+aoC1W71lnQ1ri5XedXKio6H3G7SHd2l7u2EHnpp1f0TJPuSuZ/DQgtv48HSg7uhRaRADkHNhZkGKyNOQ9ybw03OOwdJgyoLqnJydR41jGpBRZ16DVZaNW4K0ZxPK8ZQPq6L+gRMKONSMyNDs6gxz12stmjy3YifYBQKABEUwwmSohmW4wUxzr6Lq1vc+ZOEPGsaUI+p16xAU8LGIxZEdZ+4sborsBntlZD1N3k0dV6aqmiz0cXRd8eFUBczM5WxPB7VZG6RTCN5L2v7iDZ8fk8h8CPTIZoODYoQSNjUbpTJiENQwwhjcMLEFkGlGz6wIG0euvxMhcu9z+KhCc3KYk/YYpnNEOYw9xHWY0hwIlpBLEFU6GOFCyxsIJ2pGNpQuuhtytajFSuilRMmwm5HjgWNgna9rCKEdapIw9rJBrMaT9Qha5GvzaSIPawKcayW1WjvMpaC3fqEtBemLUDfYtx7EEIO96AaAMKUwoDnwBENHWfa3YVr50VmOd5Nno4P4xt+Kv4CWrMSxAQjQnRCm2EArvZqcrvp9HmA0WS2WG12BwQjKIYTJEUXY5JFdpEXSiRNgc8oZxZZka0nICSSE2KdXBJSefIVRCFTpDiW65SUUlKmlIMHUCa4yHaKcSGVphsxuSGXxmgCcaZpaZ45b0sERGTWKKhsmna5slpZrGR+JdsbaNREMM98Cyy0yGJLLI1N+JttsTW2WbrdjvmRqmIBqAKqBLYGAAAAQGJNmrfk3apte3SZDp2rxKiPJVKZXKFUrbtGq9MbjCebLVab3eF8dbfny+eXo9ncXgCIoBhOkBTNsBwviAUHFyJMKMcvrWq7+WFedz+5rOXrghGyGojd0yps3sRuXS9hvKIgFyJdzEcyQQ8KmhQ/xA2OLAQ0YXCZSOY3lzdJnY+mfEf/D+vb6EKdLtbB1BYu2Zl5kVk55tTOvT7cWRRwbLZ3rOg4ZSlGUC0K1lXDFIYuMfYlhYcPpsufAT8k+krlyY8C7QurohNcKJAkNUGPrbGZ/d4JmZ80N2n24DbShVUVHW3P3WH+/tmGZYFqa9lA+40npc3P2NPQMVbKwGLBq0lOwrUoRmauzLI5JxP33DwaxK2RZxiv3poVvINXVABQR5hQxg3TElLZHm8he2lvlYmWPNqm5fkNpDJUv2FjRTOUuYwMVdnbkH02n8pLG01XkD6OKStz0XwERGQTJpRxqZklP6I/ffnOccyFGrP7YrVFa9yN2LGHc/7c1UT1K4AIExp8dbFoSCJDMIJiFCqNg5OL2xCdN9729jvvTxo+MZa0KntD7MsFWSztWH4DFFTgO54gy6LMp3vz5VYgRUVfExHMiRhcJWhQDNOEVi2ip2f3QlxlEiqXtN88pUJZ8No6paLLBsoyWJ3x6+lzYc4ndyGfi9k0wS+xwpRfzaGaNWIftONhCY9a95jtj9vW4p/e3saQ16SUe3CH0Ie5jcCPsh3DWl+b6705mKCv3O/K9Ag0FKzIcIHKCQ3PgSMaenhVBo/DEUEKUEH/gsG2Fy68gGAsipTtqsm13Cxt24jS6zWk0WS2lFW7LctO5ADBCIrhRJHaKVkPE9ZLLns5eHusaXIaXLSG2Sx2OKLLG/uNsBBlib1E25FxdqwcqtTUNTS1tHV0ozfsTw8Ww9WoOV5vnZidnfpfl7ftfTziuf7mf741Zz/WjuPxUwlqVlwaqWap9dc7GiPN6XZzd7K/ofIVBSaUcSUhBM4fPn0BoETqgGAExXBi/p1U3xgAAABMQEYSEJIXkJKXseCCC957kdavcveSqMV1wWv+HSJtGcuZMDWLgCAUiVtS53RyJudWczkkz0tvX7lE9pyj21V2rUUDiKAYTpAUzbAcL4ghBXiACBPK8RGUREmO4knVrODs/B3s/ODx38+pjXJgxWjlGMJmDAQYz7u7cKn5aBCfFUbTPMMX2FNZrAlrNNBlg80Zr5QGhh/OKKT3WZyHhKF0XkbUWiXrPbtK8W7xk5rZgzKEOsxrhNNo6WOI06MPNXi6ycZ0rF+vWUBgL2UhaKvPQCUGCxpP7FweJpbLKBAKn5TznY3URZyKuzYkTY861IjC6n9cUYQLsS7G7uazXDRlwwu00XYkuyKlTlnQ2kojOb6kFdUb6C+DDRrv7qVxlG10mHAn7lS6LrHOlNbv0OBa6Q+7/Jibjzv5ZKdazotl/d6UavhauLpZ24a8ZzrL6+nnfR050O56hkNVP9KNb2prrBtOEobHJAtCW7O7uU1qJWIxjD4CM8pCCK8ajQfThtBF0qvI884KpZ39K6uHJRTVXoAsG3kWmsZUI26UTfQBpQ+CY81BFkae1ngcdevTNGAqPW1qugz0KZmuGmIIuckKK2ZWo0TxAA56munDmUSih0GEySzHgr+EEROe+fgtU1ZkW0RAeL2InDNFbD5Jkir50zTBOgXtDAyrWDKh9gght4BCFKUkI6h+NbUCGs2mlNIqpCOlt4xBKqOmyZ/M9oxfiRZVlqVsq8tIxo2g86cdTDF/Klp+ts/PeeHl/Otv7zVv9n4nDpLtmz9DYlIKD1ceIpEvqdQarU5vMJrMFqvN7oBgBMVwgqRohuV4weka/9PNtqwlu9aZbmuRvCBFAUpGqGjeOhVtKqyj6JtmaiphjB9LgkRJkqU47QxLvGr5a2/ibYvfVe//gsigeQUGGwgyHkIN4w68J7D8iHRwnvWeGdqhWtgOuDkc1g+PxxiLGVwKce0DmAszQ5xyINWu/GfFkGemgWBBUI8Ox8MBIMMgAiYbKWOIZDy2+C2zZGkknpCA2JxLVObd4zvJgvGXFZssHpE0ym7M5m1iOxXtqc6yniEYTrMAoqrxOUuXp4T4meyno8wQAqNZsM0jWMYybeTWw1HeY/xmIGfw494nVqFUuaRuQUtP4dbXEWnFqdTJaENihj/9kxDpoxLG2ExHmiGgDTsxajHgqBEGxdNvo9ZPop1vkNWMHgiZ1TBSNrzWXt8Goh1FDshE+EF/aglkMs6QzGEFI8pGSca4TkebD3KGuRzxUPHJXGbJ3pYuBBYFr+O8VydqzahBZZafnzyRy6DQkwjUq1Fv4TBjCurUorV1t+0WiCteEak88815EQt2E96gpMnsh8weNm8kFhz0TJTp8DP4QRKaUGd8lp5ruokjuEcpdrNknDZvNUfD8j02xDzdpFlwtp0mH1MhQle4d2tH/ONDIQLSC+YViw0n4FmI3zJlRbYUAsIikiVnithCEqRKxk1wTsE4Y2wVRybMHtNyiyjMpZRsBNWN1SpodJ86MKWGdj+ts5wen0EaYzVNdjPbN/6mB+NMlFJhlmbB4UkiJUEXyUILk2kn+/ks2jOYPW8vvIxXcHvtzcO/Ew/LmpUsWAoX5sVHZKYyyCCjodOqrCPFZ5pWwFs5zaja3IX78SG0gBjb9kMDvTYuuL2Vf5+JbkKF4s1NE6Q/W16CmRzThEpd9ChbpdZodXqD0WS2WG12BwQjKIYTJEUzLMcLzWjl5HHFHy+8U8aFVJpuTG++dA7NcVrita6iLESlpeV6bSRdLH0BpjwJEJFZo6Cy0aJVW7S30sauK87nrTRCZa99Ru035oBxagdpTJh0yOHQxz3plNNxJsez1XQDUTYHggWL9HRu4lD3CbSE0Dxb+dyUPUMSy0F9uxz2+pupOdGwMoVOi2CUhWdv2XDMxl6ZWI9tR/raWSHg/aNSC6qdGnDkPvO/1tH2LkCxfYWVtwOFNOFHFBs44ZC1Op0d1C3mwkz20kKnuTlEOxtYzdM86qlpxzgAb6AVckVZBlXn0dM8pqmpzEQ2h1barOcVfVkMGJcQg3qELPevrgUdayFdLWCsYqKQQ8crHq6BqgcafBDzoGaHlR2hoUfpe8uCxN6Yz+mvc6qFflOIlUsqtUar0xuMJrPFarM7IBhBMZwgKZphOV5wuu6kQWKGN02tRerA2mWco/Hu9ucSXUj8nm3QxXIyuVaM9IQQjKAYTpAUzbAcH6HcVAOM7jhhQhk3zFh6QqrYxMcNXnW6wDMsL5DhpaWlTRJS0l9NioxZNVZ9OMHc8+D2hrIwlS1DHONe01XPwA8JT6k8+VHgzcKqqGEtVP3Q8EHMiZodVnaEhh7MMrCCnYrb2ZzOc+8Frh32P4F26hJJkiQd2KEvq+edQD/nnHMuSRwAAICZkSRJ0kukSlziCgkAAJIkSSBJ4HDs8bQ5S7GFULWAd3YmT/1dstt8Pnvt8YibwM70mMl7E8dgJQgLZ+BGtpOxn94szmB+4z1zLsT5kA5xN3sXywyt5sywLaufhrJkgSiqcb+uqOd2F1AKVjN4F0R3kc8LB1qFqEpoFu/wSc43/OrmL6AZmE5QcsHNjAbkhsqTryAXUpSL5eWiNKGyUp7CH2J1hMbn35JNWy79NdqVxe7mjf9fuwgKBoGbQSAwBAqDN4kbR9m66NL88VWSJEmCweLwISwXSWQKlUZnMFnscAy4PP4t9wt+IyfrZuh0DD1hahYBeSgSS6QyecyhF5axElyxO5wBBCJMKMdH5xrm1OL5LQvGjMSKzHnL7jPmpiTiKifvpOFmHXKUmahsGTIz7jUdOQOfhFSe/CigXKioVWIvkAG6HMNGMqGioQueA0c09GlmhViVYefiFus5FXCvC7dMlc2qCtVq1KpTr8FKq9Y3Nag5QGsqyqqpYstQDeNeWdHZ3HD48Rcg0HwLLLTIYksstSwC1/QAjO4ZYUIZN8xYlCFVbFXHjb9n/+U1gAiK4QRJ0QzL8YIYZ+Di6hZ31YMAY/e0A4wVxP6mXuQgqODcUAvgr3ZrNWBIBwW1bhoTekGuve0NS3atbJ7iDssVqZ/Ca/DW2YlrWwopkYrEOUdamDktj6uY1nHr+8ufRfHyMgvv8JHwDT85fwEtvJYgiWCrzCh2ac4RuWZ58hVEoUSR4vFys1IvZZPL+SMqNbRNGRdSaboRE2WVzeLY+vEt3LU0mT9LdRd0F3QJZRKLwxdhQ7FJZAqVRmcwWeyn2i/0jWhvBrkVwVIqY8GCUhU1w1Aklkhl8vlPtn3j7iHHE6kscncBIIJiOEFSNMNyvCCGoEAhwoRyvCBKchS+qk0t3qK3DoLFgUcTkQkqDBr+uKsqO6zsmGjo2fWYGYIDqyKqUpvFmw9ffvwFlMCMgggeZ5pHxEWOqwgdNXWtrtWgJFdWrqJajVp16jVYaVWsDtb48E2BmkO05NU66z+f1p/NzoICOYW1C06L65Sc0blwxcAMVlg2Nxx+/AUI3CyU5ybgbiLHTULYPNjyCAWMoBhOhPG3HB9KjAJddGn+datJkiQJBovDE4gkMoVKozOYLPaj7Rf9Rm5vlnMlG/G+xmJLTU1NTU1NfSg1CAQaQjlaJIAIiuEESdEMy/GCGMICjAgTyvERIFGSo/BULTp6mF+48NRkvifCi8e9C1fkx2XX7/DKwzEAANDDlwAROrq11gKwsEqRJEmSWjt5+LkyvNynKjSR7wDHJuaYKB5YzLmJ53kea9asAQAAAAAAAOBANhK6tdYCAABYa5VSiiRJkiRJktRaa621NsYY13VF5EX/LOEXBQq6QxpoBbmqg5YYcRETU1Lq16+fivJDSteDU+7y/CXd1tMPaaWXXuXX+6Iu9m7KaIsPecPH79lLg1iQYEIZl96Z1Xf1XpUkSZJgsDh8CCuLJDKFSqMzmCx2OMAuj99OUxbKjPcQa2ypSKUqasqhSCyRyuQxt3RzD77AcJaDhgxPmUBEiW0JNVqjebq/+wZKNF6dc5cP3402GWOI1fB0auyEB6YCvFYIZVOktxbWZZOQTAE8eONWx/PiAxkomlyvxdHBLcpGqHrLkIJxrzTcZcuRK0++AoXNaWG2VtcM/JAASuXJjwJ1CxVFceTtsjusKLOx67qUaCMWChvBclNOTtyGpp2VanCtkRnwo8DQwqroNwIR4b6jjjnuBD0Do3POg1z3K8ZO3SzQPLLLoSvjtv/OwscnsOIARUGFo6qhWBwMHVyuNpnZJtBMv6UNserASc89F26vpiuY0fgh4SqVJz8XXOVCiva2AIHNgE8jskHGQYVCx5mYONhIuAvPsZzGhyLEIQYgVbZSyBGsoWTqGRu22Q774hDKsdCyU3Iu9FAuWVzzYuQ0sz+xysPOxC2mOdVwT8qjGQ1oZAcPmFk/h7ckCd1b7mr36ruG874DSTqwerQJTUL4KeNCKk03YiKtH7VRDr8vt92CO13z76o6JAPPBBMEhkBh8CiOLimrTB+be3zyibknwymnnXHWOeejOrqmrhHvz/1AqRZKlVqj1embA3HkTmaL1WaPA41gBMVwIiRX0WG8LcdHIBsRwOhMhAll3DBjORBSxVZ/3Ph6+njnP1HlsUmSJAnChIat70IqTTdMy3a6BmQ6odwTy3bcT+XZES5Jkg4VlI7jOCIiIiIiIiIiIiZJjDEmIUc+An+U5CiCeQX7Z/53OTr7KSVVrJalUmu0Or3BaDJbrDa7A4IRFMMJkqIZluMFeM8JkqIBZFiOF1bRdTHD9tb7pUC9S1MmcUNan9E4n1AYlFH1obqu8cauhu0Ei45+CtIZ9bCBm554/3NmFUqqauoammbmFnIFgAiK4QRJ0QzL8YJ4aTeOuUjuNL+EqlU9rSeHXw7Z7yhbIgEvZpHLZEaSn9XavPZcuewYv3Y8m51Emihx0LkKkibrBnfyt30mHdm0db2c52G3PsTp8veQ457kfmffcJ2h22lwv4rTE5495i5B4SyEx6sD/YTbYTDt2XtoDtVACzwHjmjoweSxgq3itjD2REPfy2IWeDd5Hj5avuFn5i8ggrSCIwRCF9Ei1AAAAAAAAABATc2MwvfIhCzZBIREcojlkpDKk68gCrWKFMdyj5IPlVqU+R574kRERERERJqqGeluNtYaN2s3INMqx1J4oPRkxFGNNSQTph3iph4bVGNc+Oli0+Q0P0UoUHrj3oNHT57j1f3dh09fACiROiAYQTGciFBu+ACju0+YUMYNM5bRkCq2wuPOe1d5FBIAESY0bE8XUmm6YVq2E1A9fEFEDySRIRhBMQqVxsHJxR2edrZYbXaHM65H543zym0FgAiK4QRJ0QzL8YIYa/fG1s7ewdEpzmMXV7e4dz88P9n/iO+lnbvqpanPH04lHS53a/mOCYwimG8pnr/kIjiei+Fil7DEJS15KUtd2jKWtezlLHd5y1/BCkEu64MD3rL9hh8by/PMoY3ZqFAkYXP/Uq7fttk2RmansXwbfRDN1ca5Y/07MwsN+1Ovyu+sgGkYgdeQo6Es/Ovi1LxozaLj8Qh3xdkpDDvWn60Sb8tFHTSoRZlCpY2/2DoR4bcmjJ19nxQJaqIwwndYLOD7Huf/WyIAt6LtCth1peCAr5n2ebE+3xQr39K5hmqJtiM99BnZqlRN+Dq05TmElijvVFON109XHBSXumcdczzv3nj39R/i0Tb2jBOCZmgtvk4oygJVNE8tgYtoGVluLY+Xp7vI3bY1u+Ot1euxZL5h+Ji+Z/WdEXygnx07rhpOMFO4w2yfHtYIg2qGGR3T0kQxC6U1fUfxWFrPpVHYoFnnAvpZJtXYXLIeqhhiOyx4hNuoorCcirZOR5shHAUPET9kyiJeFENMKvNckqji2IfBdl4iSRARvzv6fNAzRFlWV/UND9PwYKxvGzTrtMlmu+MCG6qmmhcNrqlpLT863gzsGKNp4sEsHD9/4PaUyWrSVjq7ExAUAAAAwBOttwmwB9Z5PEpoF4dq+hZnelhvuTHqTK2l+phTEBDGBNmyIbWmtv9EO0sdkKywbNC8bClYuoBshKVLyNToNNimMGi5iVfRozBgbJp4TYQcJJGh9tRMJtOsWEMZHqz1NmiOFlQCwiJCk6OIYW1is93rrSzZeJDjUnA3ErdUc6FhI18U2Qxt6fEYtGykpokfs2h8iKiDJPIi2tXjWBZ1SJ0CO5Uqba0oNmRyLApEI+6WahbuwP0rGawqq5sgM7EhxiqeyPUNvQmKlw0FnAtIRuBciguXa83E6lzuviR1fkXuLcu731N9+jupXXttm4Tbg0QO7bwgSgQXI9XD3cKmgfUELqRTDLwY5e0m1h7/BRlOePiv1W9/JwRG7mQP+WVZh1fcO84pPq1GpkEyBcYvRl9pIO6+qZltvyqrXb2bE11ninM8WFQXOgenuXrugcx61I+/Utv7Safv4r5rNBB6qBx0dnGTGfpQKV+ZDRGsfbKEVoKLOXzumWybnK1zmw9+73jv3uOMmQeyOsyir68I9tQJ92fjWnho+CKzmUUiG4dgYdTMeG/tRDsJLWjMujkLOKJxFFmlul8Z4hlKpqBNFdrKEMUlHwkngnUODhcNKRsufC6ieNTSMfEzcIM2CDWhph5I6N0iRvKV3j03zVAFp1kgLy0Gs94qo4eI2tb0aamNL82skw51tKfYYpJYsWrryVaorViFFTOT49HXzMpEdJ4XyYqWVaHdVMeYD7aeIRUPGR8yb5mlnBi5IHJSmedIHk1CnKxna4mCOH3cHHbYtk3IU4l207MIM1Dev5/sejv3ZRYBs2bWnrUdu7DDTMEOs5/dVlKVKroV0pxkEbNjx+50WOCLV7+kl4rr5ea+iA1JKGyNrUktEXpBwpRAQIrPqT2niyxwpOG1CNsCyQTSuHT2BLz/yGDCNUYP7vSgwVNBAQRSEucABPAFvj8oFhG/+XzSZAK5DTgAo+l7esb/07H9SU0Jc3JV5vnCZAUkXHFZEuLmsyxTOC8b1qS55N68LW6T+azEbHrKi6s4Klp5zNY56ewTmR92ZjXj85VFG0iJWCrcNIy3qL8R272uUDgB1aXj12cXLzuLGvgh4tZTjzNyGFUSUAhyMBPR5tHY6TqeJDC8kNZUCRc9yeQyaRO/mBmRGSCJMzJSo6CZNKmzSXRMdmhUFV+gU5uwDiftOibro9l9Qwe6Yu977dWKtROTsOYaWa4AzqKwBSF+Kz3zm+pwmOe2jAxo7GyQ3LznZMKSz8681YkLX3IAl7PylOdx2l9j7UHy44vFIE5k2Aq6rtUXPy3Ai2CzOQg0ouXRzYDKyHOrIy7NpORkj6w2PrI6qgv4jY2sf7IGJGlb+k1PHUMatN0peXpvVBdzV+zIdGJpHU0W7YI9OlrGJclPVGgLAjcadbgDaWsg92J1iQIwFMcpnuapqix2nXatojQxWJQida3aOaWOVt2AZEn4TosrqMU2c13FTqwJHGvW3GzjGR6qhGRiCZrbg6uNjXXnRJGgIbf72pCmRQnBsEdGS3TwTIjyaI6oq/fHQZ8pd+7RG5ysPI/3NSRnXfRVA6hQVj9GvvVOSHU8+6XLzFW5VEzzNm4l64yNPYW0ytZTBMprE+BkAXOw85qjATPKPGCprtjQEWNHmb09ntcUBZdduZN0NR46b5i0vJTqSkQ0XFljkqsTq243XIWoNAnGxak2Z5CljozQXRaGyBUqHUmby6Ya16+tx+TIGw0smlvdSmxs+IAEeLIrreqMXj4n0tCznbqlsfdv8dbTpF6zzbfB5maz2xLsUyEvqf4g6JCt3qZ6EkIxDpFYxxMxpjLOwPQUOFkEP2C5keci1LA5+hc8LcrjqxHSmK/stYomqLl8D2I/A1yACkU8sQQSZubwyQ9GRQW1IOC+D2VaIm0lrqNSWgWqSTTEej8T+gakoRFlbEKbOlJzMOZizcOZb9baC190fvuNt4CFw7EWiS1qifi4eAXj+7RwAsKiiUi1fyeTVrx0mYnBkOTUslPTMKQbFWUWK80tU1GGOs2DygYoWHX16YtOX/HfLR1d+hLT9eNigBIy8ufAAot4FmtPyNclfO0ss4KyymrOGqfjzRm/8Lea9C54JO+qNvWa/2hc//cAPr/ptsYd9zj38dR4gNxDPI/+0njsiY6nngk89yH0kY+FPnEYn2J9Rtfn+L54rL36vu81qR+I/Ejqp8fIz7/0/MrQb4z8ztAfjPxL4v9LPE5mFzGz/2oB8Z2vvZWDYISRQw518xLOdZ6Uk3zpPlorh1dptla/RncKFwOCZKP9B5zbnu3rc/t3ivVOrw161nUiMOFz5BTtDSYt2zm2DX2e7coShybfts7whjns/A6D7+F0MHu208h7tWmIvd05TjO2N2cdBB0ogggcAIvHBNbQ2a7CpkcjbNgOI1pNkRk1S8cm29ecojgmFJegFUlp39y9vlMW7LNIKCJaHormJ75vnaXKdH9QuZJP6HOu49hPVvHeWkkERpA7Tg+u9t5ccLGCmvCIuRDzIiyyrEMXkQ65PAZ04W3bwr5VNRSt97Wufl4e7Q/Wb4qZn9Us8Du06B/4qQCbEMtD1YL4KByolQBWPwRy8Ip09WqWe1ES4j6EudVi5VIJUgUWHi/IPJXTuRUGKPgzdUy+ALOpSPiA8ceqSQU+KeRA1gteP1YFanLjBeugGJEbTBs8s/VD24CV7kt2Ed4E4ftTuYVw1am8A2WVN/iFhUfiqCGDS0HLgM2lS5OtbLSAiZJIpFbZK9lygqJ72W0Wd+CssAH3aXpn361evw8fh1tVZJfbx85L/Ly0Sm4dVC4McyK1NvQkBa+v65C6EClOngpxbV5qZfPRM5EQB7YKt5sWgTl1ZQa42Cos7uV/rrJSCQ99nh8SyzARJocXL3qAULVioM3GjyZbRiH2bYKPF2TGuKN11iYwLGwahVqddTIQnqgd+epOjaDs0UT+K+put3iBVSsw0rVACKRsltFkncpjXnrtPVv28eA8ee0Nx+ejhEVWIGSV47hTrhm56YmlnvtiI8/jh5wER2bcDkfZfjDHN+px4oaKawe/f8Ku2GuX1Rb5vVMoiwDlDdyx2bfWOmD9//s22Ci0yVHcMccFTjlNSCcmHHy6JpDLES7uityjF3QfgBT/mHjuLcLb2Ox7x7u49zaE9z/1feBDXEZJipYy2QbgODHkv5qja9EF1/0MLlgYXiBVQpF4JIsLxA4DJ2mGXQrv7IOY2hAnHDAJIcoE4CYcOMofO7pAbYZOGx9gmhAjxBK+gFeEOJUdVKjWC6ensdedFLhUNL6y5c1gClfeVFbGkGlAUeMojmrVQZM6UKKrW9qXHIg8u5TtMGl/CvNbuBBHFrCocbc9KFng+KTYQ5MAJ4UYIebzigBFqeBSna3oaTMIUt5HGSlouUYthwhZayuCN23vzj5CUNneA+Dl53DzVyOQ9QBdh5wcsVTKR5riXeEto4ctgAh98DBi7//YhrTM90L9Fa1FNyNWY5t6cYKcO7yhjXP1ehaNO/IbCnEBxMk+tjTwumaRf6TQSbTB11rkkyn6MLeAopQoNCyrYpSTYh3LbNFbEYFw8cb4XE2/ip1nfCYWqe+Id6TBZ5v5Ofs5+mT651WG53ZKZXpWQgawAGuhAAM/7GOwqqt2zIjEOxfFxZ8D/Q3oM+BxKNu+3KcT5QLVoL03ar8xB0w4NFpT8z1R0UgGwwjQPM0AeZC/4k/UQd7Kvx2kEsYq4aoUSx1oyRJm0lfpmpHraWtEuACHgdJKyMW6MN3DNVaCq4qEgshjLBgTBFpLwalfqJc+yV5iAx0DHVm2VQzLSKWVTAZDeUknfgmHlbBXirdKGEtfpSvX1ohwGIgSgRN572JyRCvU6BCnt6eJkE/k/m/ktg+z311y9tZA3bN6d/2lD8kOafkWOeGkUm7WaVevBpwo9lzw3cdgFgy8dgPmyQIy3gC1hHpMqXgSFEEREoIi5sromEcmAY7Nw/UgpQnCoXzIh6CMGmWkYNk8WSMYiqAICTFXVsk8Ut/41vB9DRAS2eg2+r/KJP8nme3lnxH4cNuSeXRBf/kVeD/+X7Vz7qvqqz5CKCm6qQU43FKMnL2I3u/OBSogZ+VXPrWbZ40LNqHhXwJgDTgiUNGMTejrZJl0IgXK1WrSaYNtdlEYc8gJ0y654Z7Hnnrto+8D3JVcFBe/VG/lVvlq57mJLbqzxVtZ2EmOJ3IH31IILINQIkSLRY5kI7aWilAptdtsT9/gWiFZYGRwKwKyCNhiQ1jR/fq/qFBQtcJBjRMBar04UK3iQbVJANW+GBCgQxKoziWC2iwN1N6lgNonBdSYzaCVbQlI5iCbCxINFgMRCx0ZtjbUUdBGBTLnZ+dvB4syr7mX62yxfb4cstf4/KfjTjFqALNXyG7uyLYSAqu6hbvVN7iDkKaBvENAmwDeJMgOV1M1oMbtYudNbRuoztRf11y64jK4N25f3FjcaNx+g3fYAQ6QdsqV4BdTkggBXPH7OxHYrXuP5z9kHMXAcVNwwPvCgwIDAgeBv78L/Z1lGH79B6+jZuA/l5D5/wP0qomN3AvAXwDOf9sDWKBFwPm54HgUuyrvBMMdL1PkEQEAA7KZ3+3rHemgM7rp6qDgPYNjpuj9a8CEIeNuue2Ou+65PwD+NQj4PAeDXnrmFbnzYEKEChfJeYOLEYfMGoUtOw6c0LlI7+9fNg53Hrg8HaehddZhHbzw+PATJEQGvmwiYmY35Su0XKky5Sqt1qRZi1ZtTlDTmQbUCSO8jJMu6Pkd6RoKg8YXXqXQGA7e//AbTw8Pjk6Of0gX2JEd25STLrnmutOOOKPCRReYdM3Rc3N3eD967Y23buyiT775arfP2sl2gbeiRXCB4C9f/PBi5wFHzzDospa7hdEUm3TIiOtPQemp73uzov5xBYc8Z0txylGWHubhuJxVLXs1q9i4jd/EmTd/CxbNzVvzWrYhoTXW2mCTbSPTf6+/9AAul9UBXxZV6x0buMHxnZhyOBym4/VhOO6fW07leeysdF5yoXKNa8qmbvrNuFncrG22NwNy5Dqo1++Newv7NJbK9LN+2a/78/q7+6K+dOS2Nl5j0TKbASm8A51dctk6s/OWpv0JDArDhcEQczi5wzupMwkYTQ4QSwCgHwZsF4L/v5eFrj//fTv78ysAvw2BzfVb80M2oF2kL+0hfFA/2PdQ+GBi5sPnMzPPAPjli1vOjZlzM/KZZwD8yEMTwHgo7wxrgC7BdI288boB7xk91D96euSeJtV3+bqVqAN75evP9kvDI0EhMOo/8mVwAxzkNMSx53bGeMcEF8EGLsUDQeAQBOgqPQOOQgsJZBwIFAQNwSx+wYSlMUEAtI51wkmnnPEBgb360AUtEld29foKJiGVnb8b6flBfQ2JQmPwhaAq1HFF+zMAXyRQMKOI5KSv3WAefnen+43376JYGK51HRcJSkQVsjLJsZjHY59lOsf6PDtMPk1X5VzjXufd4P++m1VuOc+w6Gf3pF3uP759ts6oXdduHEypiuibj1xWOkdt88Oxt16/7WQv+9DCJft/Trb7/l0ccDubcPej8of3PD7w5KCC9dRDP1WO9sChoN32x7e1Z0+c6vhcz3tqf/ZY3OA3WY1Ho7pph6cKEpkV+u+BOJ/XX568Pc0/kHvYyyzkfcXzmxd/UTrmJ7/4xn/MfmE4NpyPTnAeqWzofY+tgeKk7SP5CbC9hprY+Cvca/lIwczvj1ux9XceZL4gJ+I4SVv/iLzbgj18iiw/ZvqWL9WdeIVa+z9IdTtqzmxWOQc5A9lmo55AYg4eAE156aHQuXgDLD71U1ni+Gx8+Dk6c1V3+oLDt9Gx+a0Sq4P6qlr9llQAXO0503PqRBSLNwKLobA3SAz4fNdeEN3GfME0/kz0ZViJrczLSXoqwFMyyOF4kZLHg3DoQGvPhc/9iK8BpoF13wSUHoTx5Knz4tdBviRh+OYLlOMzvLnzK6Q6K3+f97J4aCJpHcS8X7WHKVoKETc/xHumEoiimsjjA84XPaFqQex4fTSYIIPQQutLoAFK/LmHQwzWi3n2D1Ot2G42lynYkUerxQXo+XLiODx3im4nhOK5CENf4AbZBlrWuBtURiC0JJCOJkWaYwbpbS08s3aEPpY4Ks8wRivSESvgcXUjkTC/1MdL/RxdZS49dx6BQnuZ69XIIwGUEgk3ozY/9zQkxEk4GsRBj4LQ6jBJbw3NP0Myq7t8gdRgHo2t41iwHaWHiM5+eFJsHcStoyulcg8tttGgEMWTMTXfBqqA8gTZBrVQktcgdoE9kwxz+7Eobhe00JlWJI/NnQHVz3gVJvAZas+WpLAp7zb1ucRMVzHeu4UQayOAkI09iDz9A4u5gubjiNKgMbEGIgXKoAxUv/XGfFpLN6G7/G9GzguvBsnjUIh94clQ4AM5KVr00CaioI+SDB1yVAzQZXjPHKgGvjxzt/htmYe/a11jjht8RPsLM6EOjYr1CNYn2IBgQ4KNCDYm2IRgU8LYfN4oks6wYF9GlxqS96Ep2MQNJfNt7RVmIVjgLJh7FkGaKhHc1r41FxZ6KEhHEzyLTaMFeyyaF192x9EFQeGaLdFKkKCypdArXs/FMsyxTKBrwAlDPzfJf5F/rzjbl+1tWSq7zz3LoUZTXl+ZINvat54VqO82npVgLoBnoH2sQqFp75FqTSEDq56y0X03VYutokvXmQAN/UqR3VvPPasByUH7nwjWJOSLi26hSk2HJ2JNkEP1vkKgBginuJzXD7v5Lv6kF4sX1eynWDxKIZgIqG4AeQ5tkkFmo6oswB29BmU+Nzy250Euyrb6YXLxowrv25oEFjZJPmTYXROovRMUZpi1YcxD5ovyhwvf2oMqAMAGpWGNAfDG7RdwfnTcybNW/hypGL/wvKx561l3zUWgwT9nAiQEE4pdry7IPKVNX3I81mrMVRf0A0Dkp26e2UmzjYqXNFJQ66USBfBX7NlAPZdmYXLZrCoebcaKjTDVGlvR5DCqSXVZKDsvPJuujbEfZTNk8PD5bwGrHaFiK1jjHVGYUgCnQVaLAVmz0D3brIvWLExZdU2NanuL6oK9HSUYWEiHPxFTy4Osw6KFb8x9+Cd1gUv8MTsUQctu2FngbEvJkC0uvovLMqbQkzx4mdysWT737Pbz3YNCYOcmK35ce8Gqd2yfL8nHplqTjgNqJ+xHWuTZ96w4gB565k9WhokxR2FJF1gHs9gWGWN3diScBjPYmXFxdk24DWayO+Ph7JnwGsxqb8bH2TfhNxgXutjEhCDXISZJ21LPsdBWeBznvEfKici1JT/M9oJRalKIQuFUWGYuQoIXr4Ivhx6gpCM8lz5M8DIWvBwC/uiIyBUPE3wFC14J4ViVpxoDh77i9MRc7hha+q7HvLH25cktXgACIGYzUODu8YnSeq9UQzwGXPRP5zWf71+K2hVHXsHlcxal9a9VgsxNzT1PxPexlOHmWvMLSDvp3cDRzqOVLQybsK0qp5MywlaDvTn4pk7ASj8KHSI2jY3zCbY6FNV59U2KVQ//6CQ5O2J6XWWXVnaqObsDzRnlxKNDCmI9nSVTU1IyVBPdSqqQaziW+TTuUszAJTaMmsPFUYHLS0M7a3nWpPm4zDDdOFABr8e+lpEGPUcjhjKcMhvH2eJ9QqRpMo6WJ+iwLmpmJBvmE+eSZOi6QsWpq+Ji45lAl8vsvK7TpjQrpNhpywW701JmHdKBW6jmqVaCg+bm55trTXrmrt265YZushpXfjWvvjsJ3x+ubVxdu9bQjbVNu4+udujcGKpaS5xbLtAujhqnVtjClrbSa08cawvkgtV73d7Fxx7t5XhF34Wdlk6mTkb3Ho0tG+cQc+0Sw13/zlWVxABXj1dLN3Glo93TNEIoCPf/EbgutZSbNi3tL9G7nKniZIM+Mq9qi7xdBTNwPQ23xPGacOH6AS6vHu2SLSxdbEyjpqt3YZSgeWMwDEFVZRaTDA1y0RNLnJu6udN2YjVbYrYzXqDjCTzkREHSn/i0Sm6X7SWHvAdYouVQ7ikNpVlslxXCKrzUJHZ5D4FBU5g8ym3e5NI5Exd2o9loAzsszTzjjLZ/chaMUqvmmSCrQgBd4Uxptb/PWrPW7l5sjoeLC03rrMcQK/4nX7QbeuSJxQKZV/SZBOhcvvpw6RgFBlV7F648uaK26loF++6eV4B9tOauF5D5wqF5oPPo3Hmw73kLvtkhE486oDljq24Ty5TiZrDvMC0bpx45D4IodO3i55bBvlWrLgsStk7N4NTS0gmg8xJknp0dhswkdP3ECd/ipjX2MOZQhFuLAZqCh6vMFkEmpmLUqBo6siG8WocN/nCCGBSs/pNFFWQeJCmXSaLVvfSx5bVgPcbDA1Ng3+XVVbBvWTzQeT5TFauhzSFsAhxKR7j6EK3ozRr3STI9DZmvWHWtqtDOD5ZfkmewSFxw1+oqr4IJu6N5Z1b3VVgYo0XZJmA99CAC6dFrLrU97CAp/aOqGrtShSwOAqhH0IJfJ4TVPlyWs9uR094zj6UQLXEaA1SQUROoj7kQSGuvR3GwvQE8U4IIpIHU9gcewgbFhqUPnotMdcs0xUhkWDfS9Jkz2AeEoBAKmRcDyo4sQlD9UvT0JWqJ0eXSsYsg+OSCUBgXEbxtpB5Gxn0QPaQ1wCOeuWrUPAh0jpxYhkemQFDTDJHcBKLj+5xNR0Gw7yKtZWSCcgboaFKbR/dQx487o0dmoXCAGviPIgLoYhR14Wz3WGR8Fu7aIMzIEAAgJxJARW+k4VR0VmK4tGJ1E/iqxyHjeKgRFX2rKnjuLtN+WJBSJ8eenYWgpM9GmJ3YkYjZGEuEY2nwcBPwBFBbZuOE6mx1/mo7TD0BUpex6kcpaoNVkNnlXBaAfff9m/CI8KGgpMoN3rBobsj62L5CEch7NsjwLGS+5JG5ibMIEmrMULPRTCKrSL0xJhDFIAQdKrWgHoNgNcdkMTEVQSHTcn4gjsAzi57HBFtz69RVOCoVK1ET2AKZD6W0R1lx3WcJQMcX92oZ2kuj/A88QN234Ewfm8YZtCiscCodIRanZq2mUsg8TdINj5OLpOKhJn6JuJ66k+7jCwFlGxkdGCGa0gNiAnijOASPzpzVGdyT3LRSX83ljOUfeDkXMhPYd8wKLhadlJd64caLuJkxKwQNVxebW9kyRdgWAlsslhtWXau6p+bmTnwQUeojTMsq2Lf8cElMLtwsfuVI1fIyPNCJKf/BleIz33ANL2lzBO58zQ3KBDCBtowPj8yVH82f8gcRE875qRy+eJ49uYIzU1Ffhidm7MK0FmBV9uvRqTRAKLc1siwyAdUOT19YTa/iSKpgMygDt3AHy8sGznQIgWDI8DjX3tXnBQhZ8KjpZ4mrqyo4fGicjFxcFc/sdWbVtEokbHDEEzUlZpnP3ov3dU8TnzbZU3SORKPGsjdnmPBjIt4uKlTSL8UETyyBIKqaOeyMQEjkVyAZPNIH9i0/XYZhCEbThO2szZwgDMEghhNYUUImCwsT1S5YGBMDhrGJAwNTM/vxWETcltmUmhxRwBUbirka1oJ6C8Ijq5Ub8hJWvCNGyE5RexO8iZlzRrmkv9hyMCAW2YEdmtr1DKNg34yTamjIyReeK1EDoc1J7HSDs4f3WnXtO7LYxOw0r3Picqb2VCON0lxDCZtRnBFgmWEy7h8WrvaCUQS7JsWgQv6kw/awRn1BLp+VaprziprABuF3uU4k1LQ/b5fe9kaGdQZMQ2YLkgZiQrkJFVl4zMWG5tBBmyDzrHPR+BEIMh71HTxELQD7ZmiFYzPNOP88Holmv+kBxWiq3N0iqpaJKyLIhEqAGeRpv6xQkxHwwmqGj0BQc7fmxP6RAaDzGg3OHZmFzFTlUo3iQtU1vm+SrkEC2mZBn46Agrd1kdmRXyTQuVLQtZe2WlVrooyJCPgk0Lm8fPVqLig9OX/p5CfKclkqrijHclwnlpDzFXMsMQVzYwgdmAJBBFJm6QVxGhggmqBaKvPD1A4QaSK4GbtAMP/0oek8unQe7Lt4+ZhV1/Er+X7eL81DXTkGDY4CRIFYguamoJiDwsSOplYeVYc8fgFOoNNTPgm26mojqtS1EphQyAShkBmAq3TiLUAdkcBPR7RlemxlBvb58DLrEyOR8fHOYhQEsD2GIVU0U5qVqvsUGx5BEMU01eOHEmXTte6SoOK2Ecch16NUKxOPHIEadlEs3UP/Btdxj12+b2xO0Q1x1ZpfABdWtQQ3tWlX4u+xai00QbN8J/XIkn9r40isdEwqZsbGyGWnkgxetdKGkBlABwbAvosN9dSHJ5o9a1Ea+emHLuEvmj3fxZ4eEBMXZRgdzXYS0MYrv3w1g0x4tPKMHWPGLhcu3Uu5DhVVcOryPsDRUOha3/LyVByR0NVVSMHGfUcRxKUR/P7TIbsXi1ajMY/cBSHwj4GxMAiTyzr3Clpfp6PN44MFNnIqd2fBTYh1YULUqrUFRkWQAy2zRxhm6a9RoVBImOhLQHPE6k5Kr/X3B6OwidT8SomWWVcl8EnwxT3RBqaxR01gn9VQGHT2tmWn0jE9XtIYZoCJQUM4tOIk6fYM2/AhZejhfSK3nLEx2oRL8QQI9r147FmhjlvoQTQkdcw0DVj25pQ9QjEOzIFgWXbfjSJr3FkzDiGBnUZOg5vshSS8tYRHjhLrsTAOGd5HbsIg6PLy3aOg8wJktiQWvrDUClaurbiowb4hmsfc+fM3h0/S6JPM+eH1Yw85oWYnSLmXNjpzeYNQjokaQq1s1DxNzfpi1TUH2OPEG6dWjKZPlO4agEYE9eJQgxJ4KgcHScPDZHe23qgZgSaWn94F++5fPgL2weA+y4sHkPnepcOoefHIPucAbHn7x48vu8/PTVAeMEv5o0eXGiYAhIuHbcG/raF6idAhauIARUnChCYOzN+DzA+uqOnQ/i3wsSE70YJApyRuqCRhYjnXJbt/+QYycQ0yv6Dvuw72PQ0Tnlh4A57p6pjjlBKKjkDYL+zIOBRWTD429xJbDFp3CebFmFdhtIHyhtPmssQp7ZPJc9XYhDH09QcFNRCCbxk76LYc2OyIQigWHlkCMRrLkPDFitSYvmPOCHY4iq6+nCg8Qkt6vupRixD2LFtS8WU6muop60co3nxwP7LyeQ0ERSDTWRFm+YMqXOfMzBAZgcwWy8cIiAyPH5mF8A3aiVUpA5y0aAJdb5LfL9e3gy5WkGf51AUGkX2NBeHhefttqTkarxxSaPkNC32ADRDimD+AYEYcBDLo5ROjSomurlosmTG69xggzi0vrwx6bY+srPBpsfQVw+QjFsvqwJhOH/0IcZCaNTrhKc12LpJN7/EtoxjlfWUD0oyTh2jZY/t8kpQjD0EQHX8CQRNVMgpZg2sOS0Ih8yVqM9j3mKbHwhg9NXB6AuxYsdpB9KYiMm+ZvmvhSmYCHQXHiaU8JoxrjOylHJ0jwqurnI/1AIhycNR3WfwA7XquUo0dISP1lpwPLgRn4NKxcbIF4WO0yEGmjScFxqDYAkwqt0IUow4sWi/w6FuGJzpXck751YcRt5goAzpXIHg/dwLvGcSl5BwUcRYBOR0IysN97/kHDpiHzExWnjMLsq9rtct5n+XGk6j7ODTAvhlnBJ067TntVgCj0mGnUZvQdh6Y84RVyOw5n7P+KuH6P0TaS0cgeGSCMnzEB1Gi5zTleYnCwKhNUwMUfXmjGgzzryvQrRWlZhEyd5I4SVgvaixj2xJ4e1WlBnpexGqWl4V9l52ZWA1wft2DrGST79qgIzVB80p78KVG5lwXHq3U6Yh9K+RyD6wDBfsjWoFoLduBbygZvMaO0SYC1Q+HgprMFgRKcmgEO2gLPKouF6xUHqMhmjK+2Gd5FXZ05p457NR8b4Zk+WdmwFMztLFTl++3IaQCiyUZTrW8DKNn+49TH7zoBllePnl45Cf3gz5wIOLk6UGS+ySXWm9tdhci8rp/dzHoMCm9YyGkw03rdg7f72Gf88FiPbbIlZYC/MchSvfrVHCreydqtowtw2ZzGbhGY04UYfpQaeaiZQ/NXHYK2jTDUwZsEaVBcd0vioVBNagBUS2oQdRzYNXohtWgvv4hZo1Sp1XmpxkehtB8akOSBFoB2cfe1dPlsktHjao7XxELq5BjJFGIZbOL6nIjSnge2WkClShkmaygx796g+0Z2+ZA3MSyC/aTw+ik2ZWp6kYqEIEsCIi2q6pPPlthaxUgP16NRFe6N2huc8jjVbH6XqlADGL7TZgd8mxFqb+fmkgHQaLyXlGiKyu8tAR8ji/ejzTdsTxZxJlJvPgqUSCDqwgvL3Nm70D28MDI3BTokHbuwZWbdI1DsGuSfNoweOqs/+HrQhapsU1tC4RJCBcGwSIHqrEFJ8e4kMkuH1ANFcEj58EPpfmQCwNUgxWfSwOqJ86R5sdJZ0JE8EX4etFqSAOZ5ylqdMQj9+Ouu7rFe1o+RmEcgh09BgbwmRlyUhVBJjRuXdiBOX7cp0il1GhKdy4T5yNVoxh5xnOotQubSa0FFGyd3KKOjrg2RxBiR+s16ccnnevxsIsAeqjE9R2mqUa/z+HEQxCjvmOBJf7IEXfkl+4lqUrfsGRNLW9+IRHB8TojaOdIbzcFR24EPMyu2WIkH7kLggYYg0AaOjw14xSOTLkTpQiiZwcXcuTFjYx4UY/BvuXAkSlvVYu/VjR8B9JP4kl9dHI8fI/P3p6vuiXNnqEOjh66UJ9D+59IyQEKw0O+CFG7YMQ6DBmEQQSTDZflUQSpVXPnQRA98gCC0JQxIA2kxurxsENTAVxoVBUVIgVFylOuqOIEvf6U1/5z7QWEQZpDAhQygaj5zuJRcivDqkZg383zh4iqGyqINcsfoSlf8Mwtv5Kd8cqbHiXCtUzIuYFiQiopZHiufzs2f2bMGalBaETADDtReCBO+U3PWzEb5PyP0QJwjHiORckGIxtM0PRzIHi0KCsB0lr3zCmLsTk+X/1omt6qNkrHAaBzuFp/zhJlGWsjple5lQGnVixlH6Sn1dRyBLpvteLwvvt0OXB+5Vq9W7hZXlW4Q46tXr+KllJ92colQ1P2yV026vjgow6RIjTts5Wg4xaLZQLSdD0dXs6uE3YCL38QpUK5b9LZ8he/O26Glo8Yv1ay6TQ9SQ97DB2zs252fwQ1gTmui3QmtnPlzhpdAcbToUdXwkoQIaAOJYwcxTsiaxEtHdJvDmExY+PyTkpxSYsM23eICWxBoZZ5m5HTuyBzQ2xIrwyxrIZTF1oIPPbEw3oIAZmu7vFCWHgXG8NVxkHAMrMFwag12PpSSQ24PJujCkQgDdaQ57aTCEEoZFoq0q1+bsn3gZsgnXSXReMPlpdwz4YO6ZoGVSBF08WNhhQSUVDEdKGa3fgA6QSuBEtda/kMMQ1MgSC0mKXf98Yy23xduWhbGu1tK85jESwv6ooFE8ulIIECVlQtUuN9h/IcJozpsG2Zy5wVFQcRnzfqF25BSsA1nY8g86yP8i6K52CtygpBsADOZKnbFAeTnzX1iYlT5ljjV6HRSMXpqeAImky7HragZfkOjmEGejnZE1MB6DTGmUQ0QJj72EGKuopMlYGXztRWdFniNaOxa709S9TZcdoCRaMFUHDWYnE2r7kQK4cP8UWLgEB2GWOxKsTGmoG2aNFEUKOFhRc0fWw4zMqWFANdSrXGMQpMfUsSyxeLWwRjz/6z6sTQWw4IDKSIC1pVfWEgNEEb9nfCfXqVONQZhNA2RGYDGvm6RwsAAURwSSCUSGyeIOgVlltipxkV9UArLUkDfCjhAUwlOwfAQNi+zwSiBh9QlQII4ti2UScSewTbRNHnJ2+tIiMqjegCTwePofdExln9S3NEe3t/dZwRiJTalBlOR5RJNAKc0ISYpYqLR8IQUGhsDFllbYSEn9IvyMQJyrYjq8HbkWU/1bBhg8BF4RCcWOghTfTNjo5yTb2f/d4z0qG4BaoP23W/6N8/v2ZJ42L8ahpOQlSNNQsR8iDUqfqRLdnG5lG1U+inpHdTj4H1o49JhpkT2O8LI30J3WlyrFj6jatokZgavoawgXo+M0UnmdR1ycm7Ar4YPR7+so14zf5N7RKqyBDFJsLlQj8c8O9Q0vtRx6SrMTUuDCWDeNb33HoMB7AfE3548RmmtAz5/g2A3/Tjzx6E4dvmrw+zD5RSdrJvjR7BjwCS3WlNfooUnwqRvr26mxr/l+2pWs4vXyWY/NOSfcp4RWhht8Ouqv2BRRlhjbJEz3ppXmWSzKnTpXN7jUE8taHpKq/ZAq0fBaZ28AtIKfTC7Wcgu7MR3DRdwbvqya77mv4p+estR+vY9z/Ft+xNRqkFYex/iCqlmWPvWCMMLvdBGu5qdx+F349WxRwvbNnKAzKw9bbn89sPCj7qjhJFSfmGUOo+qdxu05bkIEwI9rQ7l04M9eY5zDfmOVrfpgwRfAddPcQuXFsg6XXYuerAO6uODQd/FGAo3vZezRo4mPr5VfNy9nyhaTdXx7K9WcpqupW99bDiHTAcMt388k55N14eOV8QFC/+dy2mQpuw1uXTmnii4vPNyNbx9iq+4cDb9LEFTMVC2nbAWQPEPv7batdfnJ8bHIfDiHQW6jjiE02QbG3JPFNHtEc4fPwvCmGYnFSydD6S2dBj4wIPVW1Mu/dWx4zuF9rBmbSv+g7Xht//xKl5Gt7oXBmmFkjiUt5sydZKkzxLaEnoQ92u+bT3wzWxC2Vtw3xdRBaUSpS4sXwEdx0j3AC4YBQi9S2lbl0MJFC6pOheZBmA2IhbdP19sfb3Edt1uJAebN7hNmCqdnrQlsAWsUsvBMOA2zHw/egSYCUMEwIADtsSUP0RrlF+trF1b6IhUov9AVMeTCJCvdZTrhEad2lKeBuosTFcXwTkJx585nXxW7r8mdu+uLK2tGbud8eHDngtH6yJ+7o+ID1t50VMPotApGiv456fOfZctFv+GwjLTt7jBQFLOfYdtPEpJfJ4cVFd5RVcq6JPeSbuG4Cdcg1/DAQriv11TOmZ78kcrcCWzYgs9Br0sA6Ttp4M5vYsj183jAU7O603kiY32LiRbYnsaZjV6aNqn5MWNhiGhQNRC0zBhrXjVPX1riOfuOY9JSU40m2JzL9/pPKomgXv0xInP7wt0RqQ7Q+W2aaFb1DmmFjpQSKHPGd5QzkGo/BI1hRjygq9B2KtOWSbd+AAtH4kl1p2w3uZZxvMtHmzbgqcLgXUN8LPVYfd/cSx5WDvc93AjOKLLZM14ff+54ge6H2es3tW8eWVnoqoE4XogEDXeDNn23HFH2MVkceL0N18XdMt3dZjij+A3Ufvq72lJ4saU3vsokqygrpCU2EXmee/pz+6Z1GYKf20l9CzkTcZxg8u39w/55z03DYZTv+qn+S2XXwsVshWVx11DIAdcoGezRWn87oXJP9MlAYfy0K7BJkVZ/K7T0v/HS8LOZqFdvNZN5tiX/9B3PVn3E/VjsNk58+zan9rG2pc1HQfFH2+6XAR+9zLv1btGl7dHdpOX0MupK7CxH3KLhaiDepMjnXXCLhFHqKnMiTg29pZdrmBbVbVyJb6Nz1O3vCUR00HJQB9ADR5/abu/qdSOOCr2hl2RT67Twm2+9bNj5LS3R3O0SIDAlwKFRNtTHELkvO2zMOk5TvElrpwi/b8ht3pLPfNw0xmFBD0UNkVolYFNkoqNtduuSzwdDxXOxNSro5oVyCswbyGLRkqwntxRNwMywe79i7Bq1em5nebi/m4OJ+AwyfOZXokcg0+QiFTw44IEaeEG72LfI1hKbKQInmh6HT3pgup9Z13jVwlT93ShiMcE0QaApNElQHJKX7l/KgQaUqYwbPIyxAC/MpelqpOPNCEHpWVVx7PjJaiv7xyE47Wb5iBi22d5xWYXV9hdyw3JoWFtZX0/jNj9+afI3Y3/1HgJyBgVsw/EXN+mAo/zZuL/DdB2xnEmruqw3EHULcrfUN8QTl3GMyyXihZPAwI12J4Pr79EYK2nEcaxoKf41qsJz3tYjDLeVhW0RgPZLBHYV7AsKWKxZaBSWMewRG9jOLkBeebrM6oa8eCnuKaUTRLJzoFgDeMBmYEHnKaHh2i3vOwXwc0YpNlH7om27ttAaqTKAD6xSjz1qgS47mULiIYVvSunqlzuFujm6kfEOwWfqNy6PuTDtFu/FYeRwmg3hVArM+yrJDt1C3U/T+0XDMFCGldT5avnyOqtMya3PnjGTfeOl6M/id1/BDRte2/l3RnVtn7Yyn214B9HDFnURxLnsyZpp4zfB/ve/DCTQwavx+k/AVIBnWut1ojTw+8Igx/2uJ6Bo1cHjQ6jtwBQsjN8EESp8o7T5SaG11H7xb9PBam48DlkgFnNcUxMaNIKy30ieLkBQuzvXpV2YGHM4br2rdpJ8OzeS3ECza7l7XMHhoQwlh3DHFV8Aupe+vZAxE2SwTHh1T+Px5Q+mnTumlmYVxgerg0RciL0HrKZaxKW9mHv4TnBUozwhogaZf1HDM/vMMzRi747S4jN6kgtJLMLd5X413zr1pliCqhhG3Y3miSJHZuz/+Gc3gdimtKMmVxYMbXP1Ed09sKVWknUosrTHoSHJd5VFfFKfCU8/zUrJjIss3wN5En19gRcOPNY+WhSYSj17WvHcA/moA1/4NLAyMjNAFJ8lijXy2xhdMEo4rQvDhNqWKXi4phr1MZ9OIi78ju7YtnMtwnEwbUneU5HWxxepfgGJbb3EzPgbx0xwCLfjZal6QLNOJj1RvjssuDR7Ro8qst+y4X1RRsEocLovhFdhBu7jKXwhI1yzVdEScLE5yNUeI070hBts+nsxTCSyB8MxvgheTME6UEDlQ7ObZn3+c2arXMrpqfXOq9FWZCFxCaS1GW+keltunUAyHnsprg1lRRsWxs58AdWScg5TUds47muOzFyyrJTyr1jerevnRaamxw+wpd+8UltcROC4OAVFL1uv0nlMDZv3kO68sgNNo6s9SfndqGqPtDTuJ4hjoKN9gum1a2Vf5N78RCZYm8OXrK9bcxr+2pbR/SPjqW3+q94KaJVxmOlMcfsm8c8067lOkZndQYl17s358ucC/hSDO8KbAoLVP30/S0CLfp2p3S7swt0YbSqCF9ufBq6/Z5uCRvPDtEY7Up6WaS8PKWP1pLZjtzNuV4tX94+vAQYn8wURQ+j94tC7QDGE96Zpew0rNjWuzFo44XQg3RdJ6n428h9T/fYebJjWGVTlxkS4y+lDWco2CMJZizm+pV/WHpKVUBEoFHuhefXbld/TfvFgFaB22s728MlduomPyrQ7oIDWsJcHM6J76ojJUUnFNtjtWXhg+hPqdnFyT3Zac51us+mQQUdZ51Jjf48eSQt8jWiBgwxGiirWQHlAequBvzte0h8niWg4YVzAyQUpq8s2y4oPZfhw4g3updjlkPkL1gEMH5huf7ypKDy635IPI7rvEX6xW8IFLv8MW2A+p8KCKRYCPfDSJ2sGCwumqMm190Fi+wcsD0vs6wRx3IyyeeOn8oi99jq+3s29ZaG/jB1doh60ze0dD1ZhkwrCSCjXg3mAmqEjfmattCpAlMB62fJf0q3l9MyvRU2XAh9R4MNKMXx8pfn2Wdut6B9PB044w+taSFxMtfQfat66wNAsHnqNcNHi22V5OPCZcQbEW77TLoAqVne0aDG5nAlCeJIQ+whWmwDDlAQmnyelk5ID6apGi4ajLy8sI5eaRtYHZS76kkxzXF1KUG6UPVWYoOFwXX6eSf7YcyscY8kw3+M6UENOVhwlG2zaqkPHmIQN4khV90GOur9+UC1KRXUTa7Xx1HTuZ2Wxu4yAV1ngiYzVurCNTKRk0Vc5wyx3yj9018FvWrmp9Jmc3MTEloha087sE91pmJAXv1qgyYO9EgDiVM7znZSdz9DsqmnyNz5SXYitCfWJWgT3d+j2ex55quHg9y7bc2I0Dkv3Yk2MUqEjH14q8kp/eLXCIpd3G9J4X06/2lJJL+yhU2jUTiPnj/K4He8QHsDS86YseEwM9XYsZ438zJFTS9DnyAXILRetJ49DCp19og5GAW5wyl7Ii93KbiazNOO1yS6cnt+AFjFK6VTK6AtVmsG/G4/WIc0EHa/QbcgcKV8fatBGb0NtCk6Tr2rnoSaScSKS3IlZ/v3y6yR+kgZkoH/dcCgR8zMa4TgKN+ZUv0bA0sTkopDhQwqc14ve/Iq1y8WCADU21Y+zut6yQs0uVDlMKyfJjSwxLN14Y5hgaWJKWUUByb3ewb69HryQFQruYVtkNHsJ40uJHGXqaqnv5is3dj//NXf5fuDvl11OlxD2Tv6QkXj9jXJ7AF8kG4lAt051MBau7Lvc4SG71iRBau19lkrPeAIcNU0cIKblMdLsKHRwUZjGPahiMuVURZuQp2Fdpl8gbLq4a5edJO6KUJ8hqaB0nMGzq7wKodu+w3qn6Uws14uS8fxlDxwClmjDAfIhnWpwZUK3EbEci+bT1zND+TtSPWgrJFztZDrNxVLNs90rtBqLmvTi6MVfAdcvy1ILYVw/kkTQ+C1rIe+tHaryXY2ud6wn+1hibmVZVYGa3XMDNyEv4/ZXSKQFm9Ng/you2qORVSP8iDfvEE4je/PL0wjsBjBOgw9m1Q/AfT285tN0m3rf6ZqyTnMGoGjop6s/Nketw7TaLTQuoxbAU3KnUGrbN3IzOCo5Nd9WHqivub/JHpAdEyFx0rW6VzeuU8UddDvAK2hsQlBUpNSVX8co/kGP8UkpBYKghoJzETw51S3QUordLLmfE45FawNyDmtTfnzUdXINuYRl6kNprLztOIm4M2k7YDQrvuOQrdkZeVpXPhxBq9kmQBlXJpRF9B+SAvPzKPWgIQT9YrQEfsXZpTAPMbecMMpvvd0xHd0x7cpvqi3HClu9EdEBiie0r/UTRebS4AM+QWyqpOhcc1eisZnBOala3odEmN//ek7VTTGK56lPrBKbR/31nNvgw4PXmPkr8/fnhj8UsCLdnjBoW8sIZjT4t3PE2m3HBUAB8Pndq8IzrXsdSgUWjR/THD77NCS7jlQcXy4gffXF8ikIJptjD3B9J7L1Aok+fbFIWy4JEQ46QC1OvXsj/oxvUGOOW+Dxtyw1+wUgA9wp6e/uu/vBDswkcM0FT3uA1GvX4P4Nanhe4XhwXaP49nnj0kK3HuPyhNbj4ujAT8NN1HY9sO09+TlfTBaxBbd/xwgwBxqMnf1PEc7IK89VRnflFef4+8b6m3pLvsDulsUu+J5F5so39dUgDsn5ohbKGlWnG9TiRkskavuqRQdYgiW9DtnJ5g3yt26LQNDzYv5+YmN3YSw/ZRqwjNZ2n0io3OGTFWp8i0z+EA6ufF2KXYZbA2qHQtrIzAC1pcpuglGZIzk5o88y+zSxPKkQwwHgJOtcoaU+HeoHl9f2V/sbxRkdnz467YhvR1LBdaFjVu7Ex9ZVYs5VWcpho6NqhPeSkfSOhvTZG1JKt7QxeKgjDyKzx9q0LenHSnkUXllbXA/makI+JAlEv5Fa1ZnFDdnKug93S9/1Z1Z/Q72FJeZasmARP3iZENzJkUDaKMtuDj1iVr+fiyoOQkP7aiNQkxB99oDiEog3gyn4jd2DhpcCoS2Q6l7cCfCSuK8eK6U/yrTxU+G68dq+ggpmtfF5DzemNutP11XjXC8kem7vTm2DSjb490Cv5n59HL6rKy7QUe2QnZ5wpMvGIPaYKPmu3nlBTF18SYQy95tmEGWnYqCYyS4oy+rSCD0hmbXdKyVxL/8uJllRra851Ed2/rsvWS1Bw7zb4wDypTTNT1mk+VOLA4LLVM6Y7Ttv3a94p8XHhL7GBws1+BWznWQjne65U1fktMClNyqC4us1Syy1nl6eGflqMW5DHZnLxg0ZCWuR5r3miQza12bL0Z5wpE3/GBta0F4jJcE/1wF+v2QsS3UcvHrPdLNIDA1kz0Lwx+/bcCp9AgGSXpvnuIRedb5qWLic+SiiM7CnN3xGTnDQi/CBD0p+Ad/v5lr3N0mMI1z4XvzfpY51PmoYuMR2Tc8Ea9ZnOkStPHIQD+o1AxPqF6efFCGpGc9gSFTU/SfZHdoLBp5m4D7Hd/5qTJmenpmJTr1O3s1AwvY4gwWC+UpLOF4V95M4/9Iff/xx/hqeI3F+f0hyuytwjXh8wxV+DxLs/+4fmL3bycpf6hnwqCUn0ErCBJdEIoIhMqY2SJoanRLrGfSV0jEsr5cF3MiB3cV0uXYteguhwjNIRbiwH7dwJQFb9Rr2kLkTP6ttgjVEveNby/kCplZtvwbNe5Qu9cry7Ttwfou2xNYJTkxP9Vg1tDQInHiUnRZSvSVd/VuE6I887r1de58lmCjd2maC8YvOumsgQIlvZtYQHHBH16DSJuNwfPT9r/Gh2EBDvJHuKR2JchUQXgqv9Tyzhk7tqXEK4Rs2smP5i91jywY2ohwb1GlfYEKxvBKKH20mmA7loDZPTX6embvKoTPi1QFqodO7nqWD/hc1yb9WS+fdJVWa0ro9CddDZ6dj0uwPlLtUtIctl1RVZW8H3DrRfyP+RG4a8DuDfyMdvn44bT4FH1TkwvsOWyoNLx5Dj9Il0Ddd7sOB80S+Gd1/h/DhE/YUBzml97I9SGwCRs1bZuCAOYhiYWYhORemxKZTHHVggvkXwVKCS8NMtRWMjj1isQ7+kn+w4rMxQ+b7KcfDm5wFHSTWl6woOf7lqA86F+FE/N4+LOp3bd7S3i1vRqbIU0ST0nU4htjOYFLzgvLEuj6HZOTcACkQo7//naaonfY38U6CS5KcD9k++d89+XLQ6G9zuIDQmDZqWNzGuKKrOOXtNx2hHQ1L2n7X6/iZLecswh2GH/Veb8M+duiPlpA7EuVGt2XMuzP07lPSduVyzg5YRMt5rtfBsuYzuRdwowp97EA7HOGHo/0VB5abD3JKFqvs5xfITxy4ud1jTdIe308pvgv2n7gQTYe/9bzKkbTj0f7yLWXdjtFJF6sE2YReylDSS2d1y3B/yj39stdFwzL/nwV0L3PVsZ5tfHCz79zsEM0GNSeVbZ+G2jNI89jGCxVzi+KL0pSBgm9fzUMUMb3BqL/zaX2/vZ+wV0brpQ6OspV8Vt9SqxYs/zEkQyf464Zo/7UQq4/jsXqvVMFHrkcjJCemBtU5g0TufJFXrkcdJDelRaU5jdumxkc0NSIswNF3r58wm66CRuJuBcdcjt9FD5zzpC+bELVoB9B8ftw5WTpEaicf7/bkDYp0O/Ws1MkPMP/vwl3A3E5nPkrtgNxPpf8aQ/3YDQazuHYyYIm1n7wnDN6NIaPW5TC/nFFG7yLAnYOsLeTUYZCLA1QQJIaVTQFeQfvRjJz/opAhApTGUoKP/3dOe8dQO/zx0kh/7X+AK5mwLDZ/r6PY18SiXhHx6gSE5PdeGtnf9L76eA60QHmfxvJ9mp+gcymUJ5W+NE7vpPULLyfuhsofN/V7b/rMvmd9NbCuCAEDpg6vGA4+e9AFRsBTj794s1/uFSXJ6qIVQ96+TjUxjlG1qv8szjSwv8Aff/bHs6u3Ab2yM2W2D/THmajawd2753C0A8zYzxEtQIaV5eXNozkkAm9RR0iGneXt8+IQlyfZNmA/02JSXf8QtoAijIA2ImL4VL9fC8N0/mRUaG6HhUT+ah+VSVd9K5AJi0c/7Xh2/AoRdWT45YC8d+Xv3otO1vq5mjZMWVn9dfpwP+NbQP3r3qsVYceL280ek68O4XSM66+Zp4QIwWWS2bLv0nRlocM9Ob1LQ4G/EBI/Dh84x3sR77vuaGw1mY4cf/AIzpHY+DUA3bI2Vw+l9KQponwNyycVcjtRi4NQjDGR6rZrlUXqU70fBzkGj4EBBF39sO5tJqXJ9GnMjEb5MVN8XNVmdHbMnN2RiSUTDP6+iQLLak+JmS1CUx/7JOPHO95g/mdhBPJZEaMzMaAnbBO0t+Pn/kT/32ljtJWzelLFXXcm80jk6XF1AAXlqrjcSb5x3jIyBzGUkeXGRAr+6Iy7Bel8pzd1J7SwR2K8CCosOmMLVendGbF45kHDiV/bltCkcSB4hZwz6Ai+yoo8G+yrbfKIFUHSFkL1tJoMgtPEfT0hnviLrsZB5zqJSRj0TYxJxI7OKnSBjemB7pJVusieDCSxbM8e1d9Hdt5eBt5hH8KYD272hsvBYLOygJmoX6tWs065TWsFYUj1kfYB9kdcOZdgMfT3Pm4K/f28J1q0Bg9wUZI2epfAxO0eJqP4XMzxgHBzdIVMoEReyTw8zRJnCaHiGVc1XxcmlodrwiJys9Ot11sgKL/bDMKkKMbu8XNNqLPZ7TaM/fL3J/fkDEvB/wEZaG2kVECSNDuLxIt5Uvf3EKouSG5oiybgGskjjBfToCoW2i4MXA8G9zmN1/uAnXbA7uzjb9+uMw3rbtSFagmAoDuflV30YBd78LwNVm5dC89//tH9FclfXQndKLZ3u9nHpi5ZHWwC5nErFXLvf58gTyq+8ebNqv/JelY8AI5b+dx7Ig5Z3v8QwZAN36txuwgXuEQmf+NdPMdLOzGQCVR2SMA61OG+5IXPAdQNe+e/id9wCL+SGu9SXQbr5v33oPuDuLr1vB1gGPMlJiNxmMG2OTUzbFGo2xm1JSYjcaDZtiU5I3xhoMpwegOI1etEniRm/SFLpbg6nxvmHFmwuA788vwO6l7fMbpfHnQ/5MC/JTxp71OodfyOyn28LY3W71B8TB9654ympqPk9567sOID0Jk2H3uezzBdY4Sv8zI9hXFXvG8w6HsUzc9QYJMo6HqvZKa2q+UrzzXQO4sS4QviEOY1TRo8RSgg0fwf7urAI8nRAbPuEj0ghGHD3ilOW3rasB78F/bBcR3oL4hfradSUJWVYqIMMniJZ8bxatT9AkclxrPGpd6xPFfDjBEepytEsOFxb4CMTuebF8epNXLb06LiKKx8YUyaWNrU6e3p5OeUHD6uLuSBWAE/0DZuRjbmk1btuzeZEPIZM1tpGIiZDinihlOJjseBJDfUDx2VbKTB9X5+R7w+i0RE1inGstc3NTi/jKBDxmO9M2GfA8dcHO2crq2293SMMkOwzf1LVNiCi3lZRb4u+Oqu3mDHTprNHfP4z/Zoc0XLrD+E1j+86IsErCjh2XVrY3IhCQawmXgVJQZEP3cOOUlLIX1/VYx36n0g6DNgLryKOlJYGf+j/dZiu2Zl4piGCxgYDNFon1IOUlZPIyn2QfTmlW/GP/Z9ttxNaMy5F0sEmpzyTWQ+RX/rigujIvjm9y9B0f+7/cw+PKBr/fCLEXjvoo2WFsAcwuDMGIPKJSI4Jj+FmR5eGETJsvyPbW3/KcQkPkzrpHHsECbkFUY1SBTGCQapBtet/QQDhGzu4s0O+KzMkbEbwP4fWX4R0hK6lzTLjCJd9F6Odn5oXAPogfHMJL8E2PVuf2F3jzgpAEHqtBn9UXgWjM8V8ASHw/gQruTeCU90iHO9Qe5G6ddjCsJCu6LSVbMtFTd45f7Q/w6gjd+Lq2G/rbtPrzW6/q2+qImws31AK/S3gSGBDtFHhWCVMLArhsIz3HW8nw4kkzAfEOnwcxBvIiChj3GYBMGWYy3Mfan7nR8tzWjHkygMdXKuJrT5VGe6geZLqF6eeUQtGC0wDpDSq4lNOco3pT0J2xKTQvm92VnhrRr61v1UjcvHmeHYkZ0TuKS3bHZ8vqg1PTfSvtjY7GCmVS08SSHyvdKw3+xZcdUZPbzhv25UX2Y0RhJEITrlbzP7EeSCBH+/rJusS5NdHbZDFhEpbzwq4olRN/I462CMxln3KJyBXJ24OOl9QLjjc3zMUVbPql/uyzMvqRrakfbtt0K6lxqyVBFvxHt/U+3+ulknXp+3e9zTlyKuf/+zvivi69OziwUP8gsaLhsWJsMMvS1yhcbZgYVyPidPnapATFBpE0VOzxHSk9lENvS6jPLmpI2R4IJ2oIdYDw2kcvj5iiedPSuvwDgtrmhFljgXCu2TTDz68Y4m8K4or+xuC/AJOdYiXVgYpM/6akFH9TUnqJf0KEyF58hOPw/wLWxZyDG3oP6C+wCgoush7rUeQcZF0sMPoZDuAXTRe+sHuAdcFgzAqYTN9WU2fOOBCoVh8M7M+orUvfFjiZtTH/28OjXxl7e78wHjqW9y3Agr6JSiZ+JfP2Ik+K8RG9oT2z81OtLTkl3SUUsG3yTcqg+9f3gtomaGn5462Op7l4IjfSO6rrN2hZjCwQ6V4CQOw/r2KMU3Ffm2K/npqfViBOKQ2scZA+ygBdSbj48BaFlTCwplSSUuiw2loT3piUcqd1Kfzobw6AnWeBILxBpWoIE4oawxBVeIOAH16PqBrDRcKGcJUqvL6V6+8qVUrd/P27UOkqXUWuMtXJyqhcZUDhvmCPtIfpzOCQDOZDZhpXLTP9UYZHSHC6xyOPdOF3w39eKnSPjTEyjo6OqLOHhj92L4qJYRTcH96WlTU8fNlfLGBcWlnBh6z87cowPhjuBdZOdm1ltDc+2L/2ZlL1hpP5sSo+zwTiHBB7tF7JKRtZVQWZA1R9qJjxEcUmw1pC+qjA4TvyhPWDmRs7EysDZeyHjna7Ea4gw/hJ9baao+mNA/HP1nTshPI3rZFB4h7Ar8pRsr40UMx3V4b4/8iVeG6n3JRZ14alpvoWcQLduftSPC7b7fYogLal+/2nLXGydBnDWvvtu1Wh+bL4slS96HBP72JGvbX7AfzfRK7/q0bwYl6CnJPStwfnqPlJGyywKXB+3hCqk0dXZmTyxtCmk/LKdbT1Hp+QuIBfUnvW+s1CbUPIQUNv3f4SIr6uIw1sTXgZ0PcHzuLZ7OSMHQv2a7bbqzGC0zqUL7omL7d2H+M/pKEz3XajUF8dMZbbnry6s/+JpmeNuzr8IcJtPXJ80OF78pzbf7ab7JI/V4ac5m2kLvcLHuFvYapitu115uuYQZM2Ivx35BqGLaRTTHU1HxdVZLZ4IbyYbKI8YHxyPRRKEhMieKX+aYrwKhshw+5D4FxVJJSvwhSr3qbx4R715qqOR2okweCzg3Jb8IKz81zA+GYgh7OfStkFhHyo/btU/vfbsyQxmuW1rVR+1lk//NvbjuFU2tjxGu3ftQWT/7qmbJkdcE6b+fJRqfwbghgt8T7QUDrxxFWxZfavrFOK8/WJqiJAcKPklS5l7ycvyhrUuySl22JXdzzSPh3wvLwR2a0rznJCVuO1j2dUB4fL+tLKTvHcntztzjUgQTuYFeU1gdioHofuO3yloNpMfkYoYf/3sYMxf7ED5Geqt4LrqSBaNOlyNQYqqi/FR4V8/JjPK6uX1fuYJGw3BSyjBYYp6eIYdurbV0MsBEfJ0Q62FYywVIrOYJ2a1Z6ujRzWbmxpF/J3bB7LxHoD7IBT+DbYHpSVGdogS/NukWgK4l9VuVcNVVSkH9vcssDPL94arTGyNmEUKW7/JG0ZoypYMoQlkdVGKzR+dZxgV4UopoGhxqHH185ObGG5pvBl1MAwFRtYxG8Pq0Yv4NoaWZbTLk3olRAonw477g1uGQpqERMbHKyREOa+AzaEXoB3awQjZyVGDZW1JevAgOz+aK0xeFNqh+rRwMabisq4IgriK2Uf5cgkLoRjN2G3+JS2GHVRyDZltJsmgZvDTAwrwx8sclsaAyReI7bJqbHtAcTT3/zCRgOM0y89DuNlDs2434pOuTdq6jtKxTXs1Gz/Jl4MXRvHz2Cy+FVhaVmBbbxnZbW+zV2BYkF9CJId3p1Zmnxuw6YzihJkS2xOcfgORO7Sxm5UNIbxWv9+SnXbC/yotdOaQe0Ae2HcpAn8Rv3+15GSlFbg8xqiJ95W/m+W8+L9P+8+R0wlbkF6/xBuGO3kxC4+5FwwWFKYW2bIDHJLCufzEhIXDwX5A2mYFxGpEpaK1iwYcVTOJc/+zYHVkcIoTog2JSGfERGTSsl1F2/bhEjS/I7vOcP0UAoTaWGiUJWdOtsqg5MQmBwY9p/tT3MtYCC4/uDYWuQkumItpf2IhUknSrPWLDwdStn50BR1A3gdUXnLVRJzS8NxY0gAfnjOaMSxrbWEYfuu4Yij/ayjo8DkZ5rXR2k2MLc3Mr9AG23YWZCq3VEQklWoDc3uVqdpgbrPLhO6Lg8NuqSLSC6Umke/27jmZno6h84jo+epRJeegO2cLPvurJgnatwckUB4W1sbc/eVViD8CeLS+qv6MFjW0RDEYee75/hkuwe5bX0nG3co7m4M5UcYGDrfbMbaNJfWd8gYWN5eHxgfkc/I8dEwgtz630nH7Yu6G0L5bAMAZEQACRIE1+4QHfO/17K3A+3H/Kc+p35Id+h4vjPVyhEkbNqGZ9Xssu+g+8k++Vz+6aA9a+o19a4Drn15B4i8cpnQfYnX/eYqHQus6c8DDuG6h4Nb+tcG4NbvwDXTkw6/eFzsNqXJ3/e05Ts6rnm1xdHdfYgT1zvI1gORSeuLWDx03dvFZ0+ft2i69j7uu7spN0Vc595PeZ/DpO8KfwenT5/MuiIDfw5FXdhfC2UnpR2dkmNF2Gsch5/00lNbttzObJv4uTG7JVcUImwpH01a9Kmed6q+caMYHB0vw1y/ha0+WHudtSenuTdzb+AZq9xjQIjUndOoKzyQ8hG+rczvZGFng7hbJIgR/31+OM2xFCph31GcMO4ylW6KlXPU/tES59xIg/bE3PDbjl1hWBq+2nHQM0vp6Sly5yZEq4t2MSnSzK6q3M1RybyiCInJ+8Y25z+4ODIQXU/V20sSELr7fH2kcMbJU3g6yJ0x+3+G5NW2c5yNMU3xSA/Ws0IyYR+bGypE3FDbJG9WApqlrgqJZSkYX4fJpF8LZCnRCIMVmxciUNMDt0cqQrOk/k5RMYDh9tjqSEMVPEevDSBnvJV6s5Na4jXlMQOgyobrUBAoEnmu6b1YKvNLcc7AhiZ3yNLMLvfsOyj3ytOmf/mfrCutIxouZQ5DOdha/b3tm88pi5ZEB3mt3uRbhvuVPnyCejcWdP4DoEg+hgp4O/N1nYFJTBn0EpQH9HtZUcOUtHtMrQ3PNuLoLj0vpw855jMwybmf/RMtVkW7fzfcgHLoZMjtkxvmRk44J0pUCtKGeONU1Tqee4v3Ua8z6R3LBEC1sv4SZqPuJKZR98+Hta//JYedHwCKaUBw4qZBd5OHH8mw4bo+POz+Yt1nf/cku1pzM4DouZfIosaVdN7KRrKIINy4wktfaZwTUuoiWimGiMRhtwI4o9BOFb9hfEUrgYVTik95+4vJhT3be/JAnV28zHC7dLu0iF4kB721ZY90mrw+NZ6RoAhbhqmyZrHzoaV6moD7x/90dY3jn2sYVqVnaZRnyFJc96H8Bze69B1AGgbikibxOyaN+I/JNYTxkniXzuYTo6K3Z5AkBi7O2gTHiY8w+R/id3xcwPiYPGe/xU4eUfLnrQnaMdsKfidsld+tTTo3Bfy1fnRxk8vG/zFwgX98Qmjd1rq//4PKHocc1XBfyaVoU3/iupM2zJ0olI+GDcQ3HA6tzInoy7Z1QG0AuOPP/9Jat0ua2Kqc0K4UjntuPF/tGSVpiIb1IR3SGLfcWDHizfm/N3WtfY1HorQ1TKOL2IRUKa5s6V9Kq8zeFptfHjGSk0htYjent0cJN3/zKsptFLA2SWqCU2H/RmmCt4oXpaKERpczVHJ2SxaLrlxSe2CWD88xBPFTPIo5/qRkMIUZJTAG81MZpXEB1GTfZAYvutSjeri+NOlQb+O5xHLN7vAibXRP+oGJRwMcxEu9LU8tGG2qmeLmZ/awtFlhJvGG7stA0EeTIWZUX4JG8qK1vvxkz0qxgT2mLW2NEkfrfPkpnlUbJOFESfx6F47YPULmVpseRhBy1rm6iRlssVt1I3z4BLlNokTHrxDb09y3GmLH9MUteIwVmWXo9l5VGw8giOPXuWIsvMYkGmCExK0GCE3juyZjYHslXjNQTFynWd9PNV+e65o3nw3tCJuWHz9ueueTo4WV3mmSgEJeenCtNEMVlxIx2OM5Vm876xLGk8RkRIn4wco4v733z7hEZbguVECUNtwbwt33Cj3I4fszDDFRx4YCNrgoD3rAs2IQkR77zgPetgj4nL6SHNN9Rv8x91evHx0+VipJjd5ZLMGrxSFdHb+PeaR6TBSxhfb4507llQfHUr0D2HY/rSFfJ9SQEnI7glKy6D2qQEPQR02Q4dc1P4/ZTh2EoxFpfZT4q88P9h+qVCTTHSbcfzinjmdOqYRz42KPXHAX2T3bT9IYMJ59YHEoOYOPTfTAvOewZtIFLhx8Ig8b9mF7dyDufsfcmZeKYUQ3ll3fPdaF1+xIpv64x/+pTCfYYw+8HcnUTzv0I3Wg+ztEBTMM3TFbuIX0hTHHSPj13DlyUzOU1/CXMY0+88nP+auXy9nynT8peRTZQB5VTF6hPEa4N0//c3xWbYI+uKHpJ4qJ3lvQfsLXUP1fuM23KE+vx61m3D9HL/HmP3HbFnFN0K39giFiFIGJRHQMPnuP+73yJOuYHSjSuZG5XGGnCAwXE0DhJqmbmMcTXt/hiPDbZgfdVmq/ZHZ8ECDwu+XYx9UsMH4ffIt6YPqSDR1DbtcsO2LXn78ZzWRjGUlv+HP8Tftm5fHrTjaqUeNW25gPO/SU+C245eT/hEicaMxanLyhm3Fd5/RyNu/MqOMEVFc8l4na07hOL0zit++O9yhoUo/eL2wdT7Ih9fe0Dnto0q9sXbtUlsCvb8fj4cwFDNq/KJ1Oa8E02pg388sr0rwJcvxawz5J0iQ2ybqBWfn+hkXx5YNX1ZGZJx7VOx5Inwfu6I8itpQ6hvWp4pTmRkcQ3yQ+eemt6ygLiS3JLXf/l7PEiMSw18tzpWsWuz7ldkVwb7m7f9nolp/EhuWZ5V+cUMwvmRnosBAXnC/oyMwTfdMYlvY5/kXIWsznwEchLfGLOTGZYl79tXUtLvOxao57dcuQ+zrDT0undnLDyrPYw9pJy7cXGlaF3JnItWgfITn0fHgiMqhHeL87OrJep3j6QpZ3FMvuC+CUYsu6PEUVktGT6zy3cnDhO+xS4iWx/PjB/eIBJ/vaJPetONquNIWy3bSM289pjs7/OT3vQjkfNg88/GefkuN3MJftv3zAleL44fnZAB5QSgctO0N76bK73yjj6gi0H9dGJIN7O1/60lelpwXMN2Wx64uSa4Z/DlaNkYeCD7/7fkynu/8cQKPQ3q/HGYrcfUyca+5x9Y+FA+5nTkZ8GztaKcX1TqHbNLQcbkLJ6vouz19WAw7oawL5hm7qSS7XuYItrou8QCccOsWx65wwcsbEVRfFoRMqhuNhxDO+D4KrTDs1ivgKZImeZlkVzuNvO4unloVqij2LBnoXSaS4q13gsHNpnCac9SdXu+g8CWppuIRDAFnHvQ5cTpGFbD469P54sg4tRQtBHM5H8Mk0OFkyZ4vl6ubDNbZxqbQO/7xcn3qpYV1V79vU3PuqYuY/EvaFBS/civifwPHQnAyfZXpWBXcwi8tO3HvhvyEODyzIQRFuG/4GgOit8hwAB/i7aXJYaB15if1KNHPRNYbsq12mKT1LwRXnoTn7jg/gIH5janZ/QEEF9mc6xO9Ey/y6FtCvoUD5Irn1c0surgvieVs/hwDcjM0o9l7iAveLpq+JR9l9RE7tOz0JY3vfJVzse4baMGZxE9ESoLTDiG9B75PPz2pgSQi9/d/m98N7fylAn5re/qlC71MkxQ70vWiZv9B8iqRinHWobF38J8rN0L9UuXdBc+9nqjL/+Q/qAOfi9yfzRPZdYeKe1y5uY3pM9wJZZCAK7Crz4FKBIuGFDCZe/IuElwl6TC88yiIDUbL0S4vA/u7WIf4xWubXtIB+B0klqRln7mpzT2OBWSLiy4QK+pa5cttabeGlpUj+GdumeddTzv199/fBWewltT4ABfm3gbv/5u8nZusmVeUX0W9dvJIHz48b/3le2w+V3W78mly94seX0Tf00dnrshkAbmpUvrQmZtZx85hpNGPwCiYitb2A4dUAt3cf/5V36M1Hsd0PGM6v0vszUnVARy9sv0Oh4CVYSrNO5y4igB/QdzC3S+4/Kc829Q8JLblSOxw6i+RZQ8AmgGGIPioB9RnaVQDbeGdLCMhvJf1FATRjHQlbQ7lZDd/vHkUMyDy8riT1+zR5DdH9LjbmnR4noLbPcGSOp+b3pv7MSaqZiLjf1mcoYDZuQM1NJwJqCXaZO96b+jMnibFhwUwiCCB1a2hkJZGAeFbKJ44eWQSQcTxFyB2zytD6DB3KFEaiuc+CoRA8KyTH1nDmSnG/c0+r/hFYkjC/N/VDEMDsywEVa2EFAFmeFWGBLC3lGx6mVMPXHNDZfjMCI7AmYX5v6gcgYU5U2rMKMI+XBAJALgbUy5QgK2m9gLWk9TxqPrO3b4dSZSWZP+DjnLvwrxtwwTG7t92X2zVDaK6pDORYLeWptGG30w2axOAi675O/TRQK2Zfl0i3tXRL/abBrrmPavWLdhGTm0tuHAuIzVZitxZiRYq7CI4IN+C9WuyYo7LMfYT0eKW3bqTzv9YnV9iOPektwwA5aWWsuuY5b54sOO/qUB7RLkfdVTfUMaY19nGfLfL0g7wOMUXTBTOr8G0y4PtDKn8qOJgf4+12JAv6ba2w2u0YamWmMdbBNmg7/XCMhEd08Y6/3Jc/RksyRkPBTIrcHGTMqIw5f/yc97QaTUnmBnMMnWOYSStY94sBnoCygXmbEvYwJVGzoV8DMgYKw6MmAlAWiAdAAECwm78hwl8QEsL/meBQlwDww1NFnRv4s6ig322rWuQzESjAACgAQADwO925AMv7AzzzUJf43H3kXhaXyu1BYKg+cQ1Qr3v8cwJ2tsfwIOJ6tKS548E3gYf7I8aYaU7Aex5wF0JREGv2Srq0ta4hzslw3gS9F4ypHLgaryaaNZ8gKQniynlcr9Yjzq9YpiXAYyitfJrMp9PpobteUDKrWVX6HsEeiiut/8vf+Ebvfr4AuVwIy7WELvuVK0FTnAjOKZ8gpG/UTMIYJXF5T0JdWQOVjeUtctNtibuto4RRlp1yErGWjvYYOddqf+SwR2rNC6pau8u0XVM8yunWF4OwRtvixnliX9fm/QZjH0l75EFCUv0MmoNv6IkabMKKucA/lAXAIOcTUi6QlodMM1iqvYQlvT8se8GfZqEcX3Ka+U0TzPrOwmrYqmeBHfaaCSwcJ/bVsqAYJP3SeZw4D7Ekd7UytczLZf1a++jWG3r1MBxjm2/yOpVyN3d88doP36v3u8MVgb14K3n3MfeZ7A+mauaPFsNlNh/zu3GtFKyrDgzkGwszlv7QwRUKJMQ1/H07NoxtDMcOejXGsNT0xqZekDKmkWCp4CAiKSxN63r+Mf1CCHBMcHA0IxjUccqrEwNCG+/M2v53/WkuQqGgUAiQIrj5rmKIUWxfVDBzFdJoUlFUJ4dzLM4dsF1GhXzTsjLan/nkpOzf1BkDAtLHairSpAsZTH82VSedgchLA9CenZlInRcmS6C06yZfpR3wQSvyRL8N261VJ3lhKyGJJm8NGjaGY6XuX713FFOVZjDfSfDLF/f06k58RICFdNXrRkSPBI3jcgpVsqXYO4tLrel8X0IQN/DRDspbSzmLWMGmu8tKtz/WusF/I1vuUoggN7SE3iVqeLCM8m49DEiP4RHSHn6GM0dAuPI8YotOAkC8I1oPuX/HhJaIR/dO8LsnlBD3UQCsp1wUIu8mnky4iLqrSLzTBNxZkZNUhPYFPLpo9B0WeJdRIe6zcMBjLgBx39GcGf3/0ed//4UY6j7M9gRM0fcczA0eVYGlqJ+Y+4qAk3AWHpzrfX/BowteS9YyvlfurIASauARRhrXobuMRnCeYcHxFXLgcdaRcZz/XReSp/Egi4l7kAbuQ9pSLe1P3ganpoiueiK+htXRGxCPV098BPbP/n0CBTDzdC0euIjMs0IFEWhERrUhidwXD3bsd2v/uG7C6YXACIrhpJExBWEpoY6GpWP0WtQv/r7+/1pncNzpi3zX55VIMf8bMvMwzH+DzDzM4xxbWVfkmZnIQx7ykNWllLIvbGLhNeJH5l0U9AQjFjeHl3CIY9Vr1KxVm7SILIU6yCRNj1nHPC1oSZ8p2f9+qr2qZ3112zMiCyUVxXCCpKLJBZBhOV4QJVlRNd0wLQIZW64wtQIoQphQjk8I3EiSK3tdTTgWAeJBAoT5LpnNwJLb0J7R1yckfbMgGeCPgs6CFKKo9kXJ0LF1DtR/pXb/R3D+1x0MQJhPx8gpSkH/G/sHNcSQTiLsZq54Ymosi/U4jS2joMO1OVzb3Z2u1SqJHbdqEXHCuuBdAAAAQ/h9CAAAAAAAAAAAAIYWFwkAAABwccozTwAAAAAAAAAAAAAAAAAAoG7W5yvztCUlVIWkKQIhx8QUg0NI6oSS1ggDK6c+vpNAEUidBk1atOus68FSn+ioG3r0kzrJUBpI3VHD1mhE4zihaTraGXPzykKNBF0D0VJWc6VNIFnbrpzoOomXv8hjWEE4cUNkiBlRmWpWIEzAWhcwQMAgoGDgg1h9DraQgYKGgYWDP7w7ISIho6CioWNgYmHraMTFw/cA4VgHE6bMCtYVIiImISUjb45FS602SOAdB+e4ElvWACwEwQiKw1f2W0UbPds1ML8u26JDNgO7W+hzxQX+DodyzwCn3KPco5xyyj35m3KPck/f5juX2v/HFRIKGgYWDn44c2sX3ua/oDrgRq0xAVYX8eGd5eyfLsPDH6vR9Rqbbfv6tDQxGbLkKVCkJAYEh5Akh5Kmg4GV0xvfSaDITJ0GTVq0k8r1aqtP/3v9708/fIOFCVNnRppXFmoAdA3NmqRaWvL3UqCwFPEa+XEzL8zNiyFT20tRVlFVU9fQ0tbR1dM3MLyPUV9GoBhOkNTWywFkWI4XRElW9oupbRrdMC2CGluuBZU1cDaDECaU4xNWX5Ekp6RRVcg/2mziZ/+lyb8+K/34/qxMZOUVFJWCnYS0B1Cu4reqnoY6FqkXFY3EYMcNGt+ZkEQadQ1NLe1JmYyySTFtRrY5izQQPcOpTHmz9t5ziftTB7Z3KGlHzbt8rQt0Kr/QTdHzv77cZ4/rs87tiouudXaj435ry58XQBUN0qekrKKqpq6hpa2jq6dvINR+0iIVgLs7hzChjBtmVoYVpMpOtQ53/9LwAECG5XhBlGRF1XTDtAhsbLmyLSuAQggTyvE7yBMlecppTbXa9A59z5i9a8cCempcPr0w1y6uT7QVAAAAAIBxW3ETcTtxnNdX8LzQ+YDpUNzq/bWF8e8EAAAAGJcPwAJgro+QKq6d4xbkAAAAIMKrRmFJK0UX6A8RaWghY0TUDVmsLwVk1QpNLZtgne7Hf9O3bjv0w2y241ZrxGsXkqTpKCRJkiRp2nGrNs9LV7sXAAAAIC9aAQAAAAAAAAAAAAAAAAAA7sw1A5yyXZJlqjKFiGoMYeRwRCQTKpoWg40zPL4zAYlMmToiYH+2pDlyJfPakBbCbtFbhYCcUg0CgSFQGDxEgLlNk0GhMVgcPoJci0hkCpVGZzBZbIFQJJZIZccbucxw9hyhnD2cPd6zoExzVpxxxtmD+d9Z8aq60/tlu35I8yfT39GvE0wo41IyrQdFTWP2zsjO74d1Tj+aW0qERCb3JYnIygcJB5ObSYhGdyTRzwEKhhXIrqA2SrMxZjhik1S8iRp6MgzsuX7X4XRGCGlkozTq1mZHD/8RvGzZJergzKVSN889oggUwwmSirYCkGE5XhAlWVE13TAtpmXyeXCYecgjjz3x1LM4/uP5f+6WQiUi1DU0tbRNTJshzS0ywFsOulug97u98/vZm8ne+ni3QXRLAHioPcnA6L7g/8j1T7iEfHDJlHKiNERGNkjh8m0FikphPoMjIn2FivY1BhsnbXxngkQN6jW8WLOsteXa6ojpgVM9sX/doqc/KeQpNpibuqMNWyPrNy5NXN50dDPlm7NIc5Ke4Wymiy2lZjnXyvGArNbqsmVycqXz8l3tWO6C4uT5f3H/hAs9BxelS9j9ivx9gfz3R9UIkA8EgSFQGLwiRElZRVVNXeMgFn3eamtHR1dP38Dwda7nJBQag8XhH76+knhs0jsmT1JOmNrSXpf+9oy3zZybVdg5NuaK4fHbD+uAssPQ941Gux3S2P5NmJotGLeQSFxi89K2jHzzFS282TKtrG9NcGxSPd9O2imfVGwdXOQQwoRyfMFFUZKPct2dKq3u+DFwmu2LtGqGwgkm0QIA3m+8zUv5k5hCCwAAAAAAgWm+17py2FZupFw6FoMNZ9ZYsc8ZdK54589duKOLGrF3Sdm7dljv8uCqNTV6w/QZLG54bEbNG0+YmHvXDv4tQgiDS+XW3VsePNf53t/er4PNtZxUbtBdQBAYAoXBe//F58A/SCg0BovDx4l6P2HophjaCmDo3rMqu2U4B49fAACGazAxNYv6vTAiccvQ9rLI33xFbajFeQoM3cQdhHIE2To46SGECeX4BeISueKJqtXNfP55P30gz/bwzxx7pcAlCBiGhiOisRhsHKYOYGq/EcvGBuZmj5uTXJqb954g7BXnZFBoDBaHPxy/cxePf6xo52s2Dw8bcjjMZAAAAADgpP3lgNfySJRqYc0CjciEIyJlo6LdxmDjPB3fmSARqq6hqaVdR3oPNPbES109/UmDJqNsUKbuaMPWyMONyyZDN7XZITdnkaaFnoHKhLc0PGvSijTgcrYrc+RK5eUTdUyzoDlpo7TQ6CK73PJ+hfy88PnRvwfUAILAECgMfhCZTM0PfCaDQmOwOPzDVzZi0iWTW8rPqNOkXzzDTCx2jnQuj99+km4U5FZjL01MzRaMWEgklkhl8s21QFseq2zPUt3TMbizHVc52RyAE0KYUI4vwEVJXrmeVUe/Fl7PRuz7Zvtan886qs9C4KqWIMuStZS7QWpgcESkWlS0Jgx23LjxnQmpo64H+npCrqunPynkKTYoUzfbsDUi3LikGaa3ZGnFEFCSrZSTq5CXTyNoQ1yIuEVvtS84vQPhxTcYEBgChcFDyPU1SBQag8XhI8RrEYlModLoDCaLHWet7eLx6W4/zvN5++EYL+48YWq2YPVCIrFEKpNvrgXK8ljlcBZoT8fIzjY4xWEQJpTj6wyG+c9lD0CuVjrftTJk5RUUlcLgcESkMVQ0FIONMz6+M0EiWF1DU0t70qJkxJQ2dZtNYtpM/+bVIg1Az9CaSZylbX4EC4+xKF1jbzevX8LSfVPbuVVTVlFVU9fQ0tbR1dM3MPwcY9OCQmOwOHzcGCKJTKHS6Awmix1HekF7IZFYIpXJv7u1/nzf9wE+v4RFTU1NTU1taieOl155HdfG24hD/W8UWsJtaEnVZco+0zRkyArJLd8qoEjJu2juifkcHELSZihp62Fg5VyJ7yRQ1E2dBk1bOmurs8gH0nwitW7o0U/qJ0NpIHVHDTmS0Lg4Ud90tDOi5likbZMuY5GmZpcqtDS4kifwl2uD2gQ5dP2Bpy+z4xEFjRM37hq6X5Qur+c6jW3oYywtZSi7XTIHBIEhUBh8F2mUlFVU1dQ1QqTdW23a0dHV0zcw3N9M+9Z3vr/Pu5NQaAwWh3e+3Cwi0uknN5QvRz0N+jf3462q3ZSn1LZzguv3ePH31VBxAN7nkEO/TTSoqSnz1LY6tFkM/Fy4rqgSmzhSGjO7y1vz4oKhJVcSWlc3qttyR9TwMEsoCC84AiMoDm/YTySRLRPU+7187YdxzDfbzyqy/1NK2LwlpsLGzV2GvLwLAMhPOwF5wRcbEVdloYaha8SaiZYzLD+i+6cSD+PD7/6JIgAAAODxC91adzx48uKNYRuX7mrrANw/QQgAwOZ9T7GQyKOEPMtVDodfc+rg9uuZ5ef2E/w8BzzQd4F1QFcsBGpZGk2S2CLmkAVe2ai0Y7aMYtSZ7AB7uiHDT8yAa7QFAo7uvRAYQTGcwRxLbgkkNbbucmz3FZ37lBHB16BatkaroVHJDtd011cIBx8wI625zGK4slFpB2z1ilpnsFPamxoM7zEJ85H41BYIOBaWQFJjCy0Hd3zllsf2lsjDFXIAmYMmCcr7SiHIvpJWolKeXY2AOPOemaqZr+98LsnBYQ5nqhwviJKseGK3XzXdiNvHinigcIxMAgAAAADwHwUYL+DLlFUFs1uEAKGiksppiftJgpQS2+s88VWbs7rqdfe8SxdWd7FwyVve3vHzia9+H0e9fObhW8/eRcN/jHEW3hBVFgkIDIGmLEqF9vXdzakn0fpDf0abS8Xk0Zi0SEmZpWxSxqrdKyF70OweAAAAAExMTAAAAAAAJqbMh2RmJklmkiRJkiQzMzNJkiRJkpk5BD/HFRgtqJZwVVWbVatJGN6e8jWch3yBO1d2ubMr7jdD3rKcTtnkyJUnX4HCYxpzRr9+Yl5VVXX9dtxVaMzsefgKFewoT7uvIwlxRZXg+gwGPESzYrDPqEMAAEREREQEAEBRh5/cda2neNfz+uAAmJ7s5OlnaqtXA9uca9n26xnwCahWoCGRbPKBMTKbgxZYZaPSDimQDs32c11fLh/SDDV+8y7vDd5OZVM53ehGJrmXtYf3iDHGGGOMMcYYY4wxxhhjjDE2XgMAAAAAAAAAAADAz9THpVnJyMorwAg7DWKFSlSlGBQcQpIBKnoSA1bORHwnARIySuqi66MiHm+Pr0K0b9HTU3YJMnVw5lKpm+ceUQSK4QRJRVsByLAcL4iSrKiabpgm3INxXQbluY4QAAAAAAAAwGH+e1zBuSzgcwoCAHCZMK4gAADXUQKNiSk1026lszw9fgZpM3Ymuw+zbWnneB2Mc4ha5yQQjKBTlk5FO6aK5F7PW3B30l4CAACAUNsjAAAAQJmZmZmZmZmZmZmZmZmZOfndU58P4IsVrKywCdDSMRoIKSAxrBm4wXmA9/Q8gXVQrd+VLRWW7NoVa+NQgtq92lopeS99O3b5sAGYLlSCCWXcMLPUG6TKjtHD3V8Gz3pnVj4bANbBAcD6ONDOAAAAAv19IAAAAAAgkC8tAQAAAEAgHQwAAAAAAAAAAKDUj/WrweIqiBUVVvWJSnmf5uCWeT9Dzn8YWv+In8Y/o/dvwUJF3dRz9emqh0PDJzjzDEtoQlLIjeBOCCtIa+pFNbhPLcYIDiGJhbo+gmfIcBu+QYerc7gMCRkldYTAa22iHLq68NwYKETkf6xuofuZrEBPGCNrRcGEMi4lB36KKtS0qNUZ2WPXwXnvt76OQDGcIKloFIAMy/GCKMmKqumGafncm/8ZgpNsQ5AVJsFeX/NekTJZ2eVLK2mh2obosN3Ts3ZKSZuwuf3a4QVRkhVPKrkb3chU6WXtWSQcAFQBVVVVBVRVVVUVACg97KigjfTWlWfFoD7KAkWWiAkl5kltlfFRx/5qSFKJWTIs2VG4yalV90B9Qxp9fysHfmAV2HCjs00vCFifi8AIiuEM5li0FUhqbP46tttSrV1vpWQmk7U9SjIzMzMzMzMJytukaebpkrRNkiRJkrTb+wO6o4r1noi4UNxjffaAlSRJkqRsAwAAAECt7U+m7Hlk5wYKC5VswW4w3TcklNS6Bhv3mfaWsZbR+EuhIJfidxY/Qu+FAiMohpNGlvgUS0Edjb90jLGrLIftfJ2aD7BQKREcBtVMhiSSStcw48HSFWWs19NcH+MedpmYsLMQGEExnDQy/1MsBXU08v0yCsabmb+FDxPzWQY7WkCsGBdVJoYBh5BEQMlB4lsCJGSU1BX6LAUKJyPC9OxOS74HZy5mb557dQkUwwmSirYCyLAcL4iSrKiabpiWz4n5j3aynmJPkdCbJJAMYHuCWPnsrLfxsaxLaVJKxY5Tdou3OOtbSWPBWE3s6NWqpKyiqqYel+Eb8zct0m8omxZ57F5WLaLQ1yrYXhAreq2yiLqzb1HzuLNOkI5hfz3CmMkIE+vMlvcCbjLQXuiF50WnyuJOFucezMW9+pBRDiF5x6lXt93ajxet2rrz1Yv+Yl6/ScBYoAAAMBUoECBAAAAAAAAAPCDDGyCTFmSRAtNoAgAAIFkMyD8w2KHDhmAExXCCpGiG5fgEqB26Q8H0o3cqTCjjhpn1J3uUVOffHaEAuM55X758+8nvefS/Wv+Htx7h05o163rWx1vrpEuFktXIgTG5J8DgnoQQAADA08vwWZKdFW/JKjpJxm62amgCghEUwwmSohmW4+MySKRxKppa2jq6evoGsQdeNWOTOAGZvGCz2WOfiL+Id/DSf8TKfm2/Fy7dgbWvX3J6HZZwk2Qp254n1OswTD04IpIYFa0Gg/3iyvdhfGdC6hif1CajTB3UiHZjAXFsTq4NvHzPOta7oDixKZS7SJeQ29VbfQNhzkEgMAQKgz9PqA+vfZ2TUGgMFodPWEwkkSlUGp3BZLEPZ03nLh6/wwK6kW+381qwdCGRWCKVyVtZbZ7d8XHu3iK03DnnJEkAAkiSJCBJkiRJAgAAkAQg6WeaW9oAGVl5BUUlB/a5N+64KqVcpiqLLMb1H6VOKcOhDa3yrgc0d9wbAEIwgmI4QVI0w3J8XBbhGGCcDsKEMm6YsQdbNanixBw3bi2nI0pynIwavzKnLjUAAAAAAAAAUIVnFg3kRNSoNnadqA4WGz+qKfpLQR0N9/d++PZ8TxmI4KroudpLjTFDWNTxZtTbGYsrqtjf/zFM2elTLAV1zFgWrGy1Th1jvLyXX9tf6+85JFkAAABeAAAAnIz0I7lKMsYYSZIkSZIkSZKMOadm3YUyXs+kCyGEEEKId7czWaFChzGEEEIIIYQQQgghhBDXGJpJppkZhhBCCCGEEEIIIYQg7ElPezaeU4/0So0aNWrU87tSL9RF7VS7qf0gF0IIIYTQaEIVxkLjHRxpdI9gmqAQRNleJphTu0atzIRhEBOARVVWyzvO1WTicfEdOHUIghEUwwmSohmW45NlQ7EKNTNqYbWlSVdn5KZedx6eeYWpX4Tz9/zYcgylDPBsj7Z5dAAGTgiA3GaNvZsVFGdehf7S9ZFDlZ2iSFEVambUwmpLc63OyE6owzpnk7VHREQAABEREQAAfs2xPMYrT10I3cz5vqbAQQwCCwAArqnVmL9xzK1Gu/efnLWKFbDz/VoDALV/LWNlbWNrZ5/Doe8rbgBcG/DX3Na5t4enlzdjGziPwUKYUI4XRIncyKAY7RUAIYTsgt6NZmuGEAAghBAAAAAAO5PSwP+nd1EaQE2IK/RF9jz8/X+vY5zO/hq0YAWBIVAY3Mv2vQpNBIlCZ2Bx+PsAv3k0lQcohhMkRQPIsBwviJKs7JfnWZqwYDn9ucfD08ubsZ0CywdidzjnKuzASQ8GYUI5PiGxQiTJu6ONNY/LMjFqplcxMTFxxBFHHPEljOJiQRO3I1ARRRRRRL2jLVr//tqP/YrxN64rNtLTn9SfjLKBqc2wEfPG2WxHm7NIg9MzoJnIveocr/FewOtGFIvFYrFY/JBRNbMym8OdV/gCoei191/KfwL18AQGi8MTiCQyhUqjM5gsdvuxG6NMTM0W9BcSiSVSmXzzFVuwPFYJnEl+8vLe58D72nTSm1msNrvDefyyev2xsGLxtSuhIJKlHApyNYwYjoikRD0/zv7c9bnr4eFLZXxngjrAkfdqu3ntMyhgGbr/3XsH+Co2CASGQGHwEIpzUJNBoTFYHD4Cv0UkMoVKozOYLHacYO3i8feNvCfoT0gklkhl8pD9sYL7D12ys4O9zkt4HMlSjgW5HmYEjogEo6KpMdg44PjOBHUwk9pklKmDeuF+QOJsHC4v34SgjbpCr6iJcqn+JDXQIBAYAoXBQwSb2zcZFBqDxeEjSLeIRKZQaXQGk8WOs4528fgTdCYkEkukspXPbvDCpm99QtY6lkjNsHCZEXSJ+tQk/zQAiBFsGzWOX9u4NFajMZpGZ0MwgmI4aQQLbSmoY8ayYGUbjXz/VD3jr6fAn09h2cnQFG0pqLW5um38v/9XfH8DBR+IOtT0O3k1IbCgoNbUbeNl8aMoDSXjh8iyzRxACMHn5VMhFCkJ+1NEnntMlK2osUoa2hGjDQchSQ8FDQMrRx0PAQk5ReqIRrtonLQDpNsN1CwU2yyAzirAgMCdgEBh8LFnJMLfEqaYlRYAML8sAAAAAKwjZ/CdZhYAAAAA3iNBFxYVl5SWlYvRx9l4Vq/epidgLsZZWNnYObZzXBfRzvKGMTIZN5jWk5lPWBgqVyrrupry17AWsdUpeh1kZ7SHGb564jMDCn4B1Qot1F0kl28lUUW1TbptNlZr2W5AmVblWBUeqPSEEVRqSNRjDWlteUPtvmrW+6+msKMe4h5NNZFIJBLf8nuslT3+dc+DmkgkEolHAXENqlVo9ElStKhivp7Vt6rvWs0NO+q99/eDSEl5K+fD58/V2369gCpRrxq4k9f31Ki99NJrYNRexssnfM4W3fW+xIe/jWhtld0d/6L+M//4DNjH6LHdiBBCCCGEMMYYY4wxBl6EiAgRIRzlOI5yHKUGzMDAgBkYMMY4Y4wzxjnwdVM3M1M3C4yjGvRoA8FP8Tv+FWgAAAAAIAEAUA8AAAAOtItBq6qqqqoqEpcxp2GIwBGRQNToyRjYcXPjERJh6hqaWtqTFk1GmTpok5g2g06VeSymhZ4ByUQeVnsr7M0IFYusKDQGi8NvYZBIIlOoNDqDyWILhCKxRGp7ibHlClMrgCKECeX4hMCNJHnKGUy12lzk/FKOwiPh4zDCCCMco5edR8zAulvo/3We8PbhYRISiUQiky/50VYuPofI5L+U0ExZSpnSp1Auo/dhtW34dVbtT9o/9/6iDtjXqDwYB7C5iJv35uDk4vGP1ehpzeZjO4wDAICH3veN7f2s+68NAAAA4LJKE5ORlVdQVAoDwhGR5KhoOgw2Tu/4zgSJzOoamlrakxYlg6a0qdtsEtNmRjavFmkAeobmTNIsLfP3ssDCpYt0jbzdvBXm3oohpa5SlFVU1dQ1tLR1dPX0DQzvY9SXESiGEyS19XIAGZbjBVGSlf1iaptGN0yLoMaWa0G1Bs5mEMKEcnzC6htJckoarVaLK0b/AvnzMAAAAEDtpLAAar+pPVU7BgAAAAAAAAAAAAAAAABqiSKrbVdLUk+LCqCWDwBqG9V2+Y1VgLq3QDQAAAAAAAAAALUUyQgfAAAAAGpdIk1e8muJUpgiAAAAAAAAAAAAAAAAAAAAAAAATpwPfc+YPQO5YvJY0iZFF+gPEWloIWNE1A1ZrC8FZNUKTS2bYHfHvL+ur58Oacdx5uy41Rrx2IWuBwAAAAAAF70AAAAAAICqqqqqqlpVVVVVVf3//////wOJqqqqqqoKAAAQ4DUMA6mCCqUJY4YjIql4FzV6MgY27uM7E5Amm9KoabPToyr/Ebxs2SXq4MylUjfPPaIIFMMJkoq2ApBhOV4QJVlRNd1wmX55M7aBwwxCmFBu+bmG2RIRAAAAAAAAAAAKfe4gEOZbDGmCcGYzjxRSqWCFlX2TKqq97jHemtZy3G695tVZNpVJ1dZq49RKkiRJ0rSlJEkAAAAA/ASLKMlHBWzbtm3bth1X3rG/7Ybi7qSEfMlSTpSGyMgGKVy+rUBRKcxncESkr1DRvsZg46SN70yQqEG9hhdrlrW2XFsdMT1wqif2r1v09CeFPMUGc1N3tGFrZP3GpYnLm45upnxzFmlO0jOczXSxpdQs51o5HpDVWl22TE6udF6+qx3LXVCctFFW6PkiXcLuV+RvBfLr6cv/jAHyx8CAwBAoDF4RoqSsoqqmrnEQiz612trR0dXTNzB8nesvT6DQGCwO//Drq9ezpN8Pj+8fyJOUE6a2tNelvz3jbTPnZlV2jo25Ynj89sM6oOww9H2j0W6HNLZ/E6ZmC8YtJBKX2Ly0LSPffEULb7ZMK+tbE7SRu+2knfJJ2ZbBRQ4hTCjHF1wUJbninKrVHW/I3Gy/l/1ws+v797wadq3kY76fS4EjBBRDg0NIY2Fg5TB2AKl9RyyNBeayx+VEl+bDmwhCVixkoKBhYOHgh+O3uPDwx4q2rLE5HLqbzWF4TAAAAAAAAAAAUFVVVVVVVVVVq6qqqqqqqqqq//////////8fKQAAAAD8////H9REEgAAAAAxfu0EKMuU6t3aTOhGM7A6MqerO93T2auvrr8vZifX1dOftGgyygambrahRoQblzTD9C0P0IohoCRb6Ry5Cnn5NII2xIWIW/RWcPoDQHYCgcAQKAwecY1r1EOi0BgsDp9wLZFEplBpdAaTxc5Rx+Xx24/zfM14/WNMzsTUbEERihaTSGXyzVe0QG2ZVoILt6djZGcbnOIwCBPK8XUGw/y9yB+Xv1Xyblg+vdzNsTsKjipvS7LcRxctTSMjGyR3+bYCRaXuot0L8zkcEWkzKtp6DDbOlfGdCRJ1q2to1tK5tnUW9UBaT6SuGz39Sf3JKBuYuqMNNZKwcWmiftPRzYg2ZzFtm+lmLMrU3FJFloZW8gL+am0wm2COXH/w8mXueMSC5qSNu4XuL8our+e/r8gHBWmvV3TlZwC4fQQMCAyBwuAVaZSUVVTV1DUOIu1Tq60dHV09fQPDvpn2re98/zrvX55AoTFYHP7h9+YjoqTTJ7eUL1OnSf9mxidnTrKcmp05cv3O47ffzx2An3fwRq95y79HxlKPXvuW75Fp26wW+HnhukXEJY4sLWV2yzdPC4YttZKwdWmjelvtiDZMWEHB8IJHMKEcX9gvSnJlQq1Nrh+mMb/Zfq+iz1u/989LYmvoy7jLkUwFFY3BYMfNjUdIRKhraGppT1o0GWUTps0gm7NIg9Eb9hlxZpLlCstb9PpDBC4fm6jg0zff/fDTL7/98dc///cXcyvuHp5e3mPYcumWFcD9RwMA63PPxCLJKSHf5ZTLZeYzVzLbvoYAAAAAAAAAACCC68cjFpYgOfLy1tXVZ+6rPeHxDJYCAIaAEJGIiJlZRERVleiyVAXrCmzFJEi7nyGM7Cuiy1FllqrDX4M+A34T1wFMRyeYUMYNM4vZg1TZEv1Yd95S9g4AgFtDHQTE1cYo2PjOHQAAAABQVVWMuaW0WGyhqqqqqqpSFwAAAAAgXAkAAAAAAAAAAAAAADgO8BwtSVBmKZuUsWr3SggFfmVLhR2jZRAjzkQH0NM31Ca+fuJzSMeZc5ZwvCBKsuKJa6LpRlwsK+K90/R8SAMAAAAA/v////9IV1VVVVVVVVVVVQAAAAAApAEAAAAA///////ytzE5fB+AOaXY3dPZ2KwmrOy5HJsb1kECAACAJEmSlCRJkjx17Frzh9w1aHAzVfpBx/vRxGMymQAUeBnBwG2MonWynbZpGiReqkdRnjrFP5j3Rpz+VanUNFrSeWeNrti6G1kMDwpBGUGNfYbXo1ijn5fyd8MBAFCLoWFNJWGnQaxQiar8O+YEDiHJABU9iQF7/oMGTvGdBEjIKKmL9MEFCIn2LXoeS6wTpCOorDPk94j1EivWbNiyYz8OmNzfBTOKoiiKoiiK/uSPzRPPvPDKG+/bR6lef4FQmVce7BMQKwpMFkMi2eQDY2Q2By2wykalHVIgHWoH2JMNht/MmrCaYEflrA2dMSZ5XbbdZ589zhIAAAD8//9/TKmqqqqqqqpCPJ+iIvgSdIUlUEvXaDCkkMS4ZvAG997qA2ykNQ+OwYqhlY1KS1a7TqHWDTuon2so+fLxpT41TagATNcqggll3DCzAlSQKjtMHe7+MnjWO18MJwQAAAAAAAA6AHM2AAAAAAA6AAAAAAAAdAAAAAAAABgAmBsIAAAAAMCCJEmSJEmSJEmSJEmSJEmSJEkSAAAw3oIAYDnTBwAAAAAAAAD8Nld3MNUJEpqSBHIDWEoQKsiyXtmgVANjDB4CEhvq6KHHCLvfuD/X7dSaAhKZMnVEwOtsojlydfHaGFjoFv2hpYGbVO0gEBgChcFDxHv+Q/+Rn/boBhddyuWQ/QpX793V1xIohhMkNdoAyLAcL4iSrKiabpiWb/3zNX6pM44+AAAAAGE81Fryvztf10wRAAAAAADgXSUN2AC2wgjSKjJEkeTlS48S7xrSt8HmnmiHF0RJVjyp3t3oRiatl7WH2Pdo27Z92fY1bSPbff+89wFs27Zt27ZtMAMAuDy8dyoJAAAAAEiSJKnOW5IkSZIkSZIkeTBpyXdrsKQkKFuVauUtfGNS5Qe7BYsb/dbTbcLOPTcOL4iSrHhSad3oRia/l7WHu0ZERERERERERERERERERMSen3GCe6XMzz5lCqJt0S3mP0d+66E22NTRbnQjc7qXtecHcpvZ7P8/cgAAAACgqqqqqlVVVVX1/////zFbVVVVVdu2bdu2bVukrt8cwCKlJHAYppkKSSKVrWHF4NIVy1TrNf1C7kK8Mxx2TgQTyrh0ZP6nOBXq0civn3Zy+//NLnE6yse5X4xjMAQAAAAADBiw7yj/Z5rrtUjJCOyBaVQhYaSuNVNvrVgqlVV3a/qlSNC7Y94teCQIaYIJZVxKVnmOLtQ0ie6M7EJ9WOc0NHCwqQIAAAAAAP3eVlwKpRsS/Kv/fwv83TGHXx55fakG9oJqvRoWyZ3D3ylTd5aBHbW/GjpjzIm1bLvd49HQf3UAAPemLgLKduWYMsR+JQz4///tOMO44DaLS7SCAivL/fe+gL8yTEENgGeEyYPJnIjS/+sr/GtyEwNgZhIWASQ4b0ngzRVLAWJALAb+QUJDM8daJuBFimUBttFu2YGg+NuywlfENcsesB3xln3Abggt+8FJZG3FAWA/wxo/HBwDQMhmClkgwGq5a4ECC/QrCwwIlm8WOKDv0iwIULCrQUgyP7CggGhntqABbxVaXVy6rocFi/5v/WXBAd7R1xYL4HuMYLGEyI5l3Xjgf/zf3xv/qfMyKTWQEjcoF0ul/HZ4XYsa8Avm+lcbjYz5Osg/MPgQzt1TQsqPmK+FglXZZq4JMLSb0P5FGqxExZad7eSMkY7AkjrDmVPoC4G5JB5+/RGSkdlgaqPdb39iNXlAUoGLv8ORzUUHSqRETBcmkxm+obQtsvLF6uc/b/gAWnDQkPFbUJP0NeEdde7H//nmyz226W/lFez//zZfJUVSJrVaoxRuAk2TRMEAdGG+2Myc9Q6IjHQMcdfBB5RZlQY8fQMG5WAwRpk72YFoV2ZM21W1Y1KzfXQzdeyfZFlTRYyXqKchiqUc2iJVPkl7Ig1nE7KrPnmnxPCoS7vgsksZnKGGASpW72ySskV051J+Dh45jKmkC40TmdPzwcn/UnhaMBshE7wRBEzD4cRnXtABUUXxmmx6aMCYsMMoBBnvq3E2ME6NRmKgdkaswSOEri5R6NibpJBREJtorKwT+yuoAFZdLQOZO36XBMMxRhNNjDVHvICtTH7i4ii45gtGQC/wFbCxarvis9FFEGCtKDKoGa8TCY+pX5MVNqB8sG+wrwzRyF0SrHZsOtVCdPgd6LpC0vIA39P+ict7Y216JnOyJUrnZR85+TKR5gi2Xa27kRIJGXyyruMyibNx0UrZBt14YjBjiUY6BsjiqbAxHwl+GK16IKCD2pCnZB4RnB6lTwdFtjl+WHJbgKkAMO+UJ59usK5SoQKusiPUgKet6RNsXBDLDCl447ImGiB3eXx1pR5vl4Okue1ni8e5vARpDwaPuSUmCEZW0EZR72CrNH24MEz9wbvHSrGMtQvlQH5ei96lSagFShtPlw9234COdArdWEE1Jy9h81BO+WNM8ZNt32wqT8G/1ghtshBm/kq7wWGEnmyoFNUwC592zeq48AxlMCy7VM4zLKuKBMiVMhDqE2h6Xi2EKcUjiw0yJ2lqGmVRYb9D7brcv0xaNNW/9cAcllv7EMaW4JDDOPTf7xBXHRyEoCEI/RJuqjcwY7CTpJENIi8GlFMQNS2H5YwBaAe1F34hVQahAX+vr3JCDBiCTz/ovExKDaTEDcrF0r+C/7yH8q9FNeAXTPjbv9poZMzXQf6BgUvC+WKyW+RHzNeCX7HcTFbP85vQ/oX776xExZad7bQ8iZEO1J8sqTOcOYWuJEAnJfFYSxnJw9kCsC6Mdr/NCas5V7GtWU53OC66y3iMEpGGcI4p0fEM58UobYusTGdXP/95QwQwrq6M35ya89bXJHt1Uh/+uTbMtx/P+Easd3T//7d5CSYkZsPa9FSjZLEWtVErIBHsmf+dcCGwfjcBaWkf7dnmznqqKDb6DsrBYMwtz9zJDhjPWM6H3aankr2krXfzI2H/RExZ0735eE8DU1V2OSAYE0/zGZy4iEJECdydvKfEeJ3t0q4fkUkvuUYVwLpUgtHqHtUA17wI2JGfxfWZwWqsNAZPsc87PR88NxjUIN3fuqRSc4IfkngMByG+AILsnnit3BYNgSjuQr4UkGd5Xy23LU5aIFWismcZsSYSEGn6rNCxqwudWWZplENQuPIVkGHpVT2Z38hiJ/yuIToO0u8Nn5g+IiYth85A2wntcc3dDYphz3Xl1o9uPpxB21dI4LJY7VAr3/Ml3JcaUIVsQvmIzK0QEIdigj5Qsi5iarqyavjwDkZkxpyWNTLnH3qS92ZZo2dK5vxTks/vEIfnyGZOao54cvdmj1cqE8XP4V/sWO2rKAeNiy7ceiAP+4hby428dFeA/vx6Yz5qnrTqfRHoUJcyuLIKJRe0gX2I9pr1CW0KU3aDtQHSp0zLRq7reDCFt1zF8VA/Co8NWc+EUJCdZfJCJrRLRQtiAVweKuSrfUL0IBq5d38DwbYzLgUnwyKzsnrCQJZqYN3VlghV4sfAwQreuHLmCmH6GhP5xBa9C9QOYYwvtT4c06iI6O4NU6wcZKxCVRiXut6Y9tw4Ku4aOjxP7SqzCWGnzSTmvsPyW79SKZw0VIr/zGKybTBVkjZmyIUcKPKh2vYtm6tTIfd2V6ibsPhoEneIDM/KyVHZS+j7k2VRIbF3h9qC+mdz4KFMU60JNGhzje2dYzqoueWY6L/fqVPUrcFNV9OYJYVLB5WwD0LUWYtIXSjPx5hKtJdKN87tOIYxVRB6mvj8a9LvgyY2/wHQt06TL3H8NKBxId/O7/9PZ5iN2ufADhCocIGAYmGTcPMMZ0Gevz4XDCoXAqrM4u3xUMcGLgzU22iMn1f8BQj0Q9DCgXkRYJwuNF64CDGLBINinyh+/KI4Hvw0b9Fgngb87OMWvwQnQOAoPVEzU39FK17JSi08G+7yVVhg4SpXZdGq3bR4NZas1pP5WCZh9RLtdkCS15IFksv6qWt+0ta4pgkzOf/QhEjpm0QGZDP/tkWff+sXvcnWbrZbHtmQsjnTNn0zuruY7i+2B4vr3uKb8XDzFIBe/QUuXyGZlERlwqSDNE457fPIoCmNsCL01GxE49Tm6thiMW3lZFEEMun4p+nJdJojBxaBqV1gjW49Wk1tSdW1aNcL6RSUqGz0tFqOW7KlW7blHSto70OH6oD1hjnH22yLrQaWtJVQv8HsMGi7PocN+bJVW91Sy+CmoHm998E7n3z02f8PQft09azZ4kCUk5srd978hsYFaLluBjr/SFm4SK5fI2ZYusw550y/zPiKRdDha5R1TEBQiRdnttjU1CRKcGBt2+XtcBXCTCx152Ybadq2+rIdZj/Z5RQcJAIGXyjt1uuuv+HGm6SV8T0rDe81v9rFdxQrudGO7HPV7zAAN5SrOCH9nv133nX3PeVWVwcPyVSp+qxsQino61J1731H75fzhDx1Gqyy8snj1Q/WnDj5kLmD0kHX8dp7UVexqvpETW1dfUNjwD1f4co8Hwo3RZqjLbxL19DrFhJJTarfwjN0mR4mweqmmAw3N7xq0+QsxdGNPtqe575esvnQIu39TIcCx8KMLnivVZI0J58r6HXIfl39u/P2TpfPKvcIBpNiscPEOop/YtSTfmeuGEyKxRY4u7Z7hB5nOmLn+3eu9Vu9+16L74/LHf/kC9GmpIE7wPr9iwWwQcEN9awlhryw0MliZXNWyMTapdfR5zXioBMkYylXooO5I+WFjoE9WiAeTfMn54olXDnXNbmivNha4MRHzzk/uu59jKIpeFhDQaBhZ45eT3TxpFfqrvrK2vQhDSHWu0/u3LQW4RgVTS4H4DkXUjta/fx4nji1/JUgGUyKxZ6dbf5ylJOm94CUhP+QaDdx91wwsOmrvKz7oFU8y3fPKg8shgYrPDxZc8NoNNxYD0HDzRhDw7hCr6T4YJ8ErTJlRSgeRRvoqxn93UwfdPynlM3F94ZjXLYsLs1Vxec0cq0w0uqvu9VftyilP+7SG2/Bk9vjlO1WL2125F7B2/ZQO/AT+kSwA/d1Bzp8uDgUtbg7UBs+xSLDntAnTv+9OgVFQdW6uejFXIGC9VV7wggQCzdCxw1ilSWTIgFJbqxl4BW9nluv+Yp7/6B49mOqe3ypKyv56hSplzc/STKuTPfcFqbCXq1IRelIfVPfnjIcHWU+5DIG8Rq310tmwote2WEiDzpBMpgUiy1oBu5p+HIDqjilqhVEVaKmq1SNqknWNNQk61R9Q71FvSFi7l9emYMrvbu8bAps6eUFUrDwUzKdmH4AIOvuGgNrbF8vTx0gyLnvtwV43FUfxGgI+hydjXxRcz/3AAiACYbOIFHxYZJLDwyl86b0sc2dp2MY1zgSNAGB/x3XkoMyZmBqgYZYeHwKMbf/XmvfE4h5z5dcCgAAAA==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/fonts/latolatin.woff2": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAAKrwABEAAAACRDwAAKqLAAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HXEByFXAZgAI0ACDoJlxcRCAqCqTSB+X4BNgIkA45ME4SAAguHKAAEIAWueQeTRgyBCluzJ9IqRPhdfVc2HY1yUg7U0xcMnTJMlNIW2K76f3wGwRrDxzsL2lTa3Bhbg0Wz71YcIdf9tK4QUHOs1mm8wXhnquz//////////////////////////////////288qUizspVYzgC3vHsM8AAg9ESVEWeSZyxUkpRJWXgmrLxmJWykLoq8KZgpetuBLO97lYQ1nUJycDhKjsbuimRHccWQidDTVLyqZ5JT9yNVUTkoh/kiQ84lcketK6pcXIhX4OoasFZ8HSYo13tClTtynKTCG2QpVKXdbmM3WZVNm/tMZhsnKmvQFqLxYpEhZ4YcjFUxhRMZ1JS55FvhooGEi6xFXkOGNAHzDV2nqm1qu3BH3aDpANPgKFUDynGSaueuscpkt9oTsF4l3LTIxVET7rrEu5YBVEXwKDwP2lugrWCfrN2mdmmvYEdIxttfoZ1on+zdLjuAC+WAWpca1IjDgzwE5pLBTg6rxtXsiBzZtVsG2SFHj6lNA8wxiQZ3NA1ZP6DpGG1wXwozHs5WDxaSd5LnkolLYtIUp5fHKaklcDnuGxLcD/f1B5FPUO2Q3ILcZ8hDqpBaToA9aB5QIhKyhAw6WZ4iPNnr06qhsWx9l8FjAZsstGHFGbhFdoGzscYR842dg5H9Vu/QjLCXNtaclM16Q0C73k4jHVF1zjr3nRfq7UxCfUFTU3ONamUkR/HFOMf1ttgpdqdXNUMObmFYE+Jk9Ev4Mjo2GV2Bfez1chVdW72+flXfYLsEhcsMZDdrKXW6Fe2w3qtO3tS3le+HOxwy4rp1vD6Gy73me/syMX7H/im5S3CyaO+pPuLOCN2+c4RdBWo0tXnfviTjNx7AOnBzZ2Ftakdwbl8e6kePafYJfmr3mf2DR57T9gO5QrBOvwiZrVoY1EP9ch3t77P+Sla5Ht0H63AToY1wCM7tv7b/xr68Nb9qX7+Bh2Qa1Fjq5Z3hg+B5QA4GvDeABm6vVDT+4P4LVwvhQO3hVcRugd/Lmg+qkCSbqByWASNIhwxZe13e7Ad35RFVqTvN8PsRPxaSoU/ymfBTnI0mPskXiHHHMT7WASevN/hqjGJE2EAGFbIVjGDC4/Ai5jrJ+tDzctH8WasvQ768r+rWUB/luP2v7J+3/7X9b4xuVI/Bt+o73QS3mHFmwccf2X9i/4XZMd55PbCKtSHOErT6+xvKA/L4G+Jcs33VvjopP9j/0f739n+yf8P4z/avBY6t/mL1XazB5DXrN+xf+8XcpvBfzf/2O63/rv6g7T/t/2X/b/v/mLwe/K/hA/C/yFsi7YIJ/T+lzixdlkM7Avfal8vl1Nqf4YX8Z+1itJ+DNhth6CbLVJvAQej8KZTRHiHKYToYfpLCmNTaarXd5KCpSwLvI/zkodqvxz/TMz2zuy/AKqwAVVQksQTm8omMjAs7ImGjSAlWv/7tUV3pQInySFMJNyDtuANRHjig2Bz7jlNI9Va1dyXM6xV12HjqsP4PNLd/d9tg0KNvRNeoUEdFjorxkWhHfyVawQAHbYGBlbEhcFtX5trIUqaACwRFUHGxRWTKdCNDcaM4UXPMXJmWpZXlaFtfmrW/1MbX97vvZ/2o/13fv/W/e/6fumf3vN0PURNoiInFP/yvPft95g2qqz9I2iiBalrFSqREGskbJbg3SZD5cgPgSKdKCd+FeCrQSMPSZCrBv15WgMjKLfbaXxVJpgAqgAUkw9FaZVfzAC7R7DHDM8kIDUg2LkJFqR88J7Iu8g0l/36wDd43I/h/+JPdB6sPgzgsEt+Z+Obs76/nX2qVufJBRQ04qdMzKkDNkNbvPCmkobSlSigNYskLnsAukQ272axYQkLENrZJ0IR7ENgWphODaBsPAfZUp+L/aE1jJaeoqlRdkA54+YhS4wOqyI4c/qsMh8YULWTWczUfsiurbg8a9IoqaMbxI+SdV3g5Ra+qQP8RTAwMwoHcNPA04F3xQFpCLYUGS6HtxIwEHZlTyeasPjjzlwkwQU7pOM/lkh9U8j0rgIAECPgvgP9fm35JgWnVU2rwSnZi49/S3z8dd09TWpIp/Zg6+0+HqUgQYsjHSPytyHJusCX5HwBwOVYWyK1wPwk/2oKP8IVNdPjuKoksALkKjUmeoJ1aaxrS69qLn3TDEvHWXzSjPh1Pwb2+ABkA8B8Bs43db1SDwy7/yY7xAX5vDfC/amOB1q018nO9b1npooPeF5qY1vkokDETGtpN9xckdHlvqYBB9udQbL8+3tTDmziSM3Z5vs+3UzBwMP5/mvNVdmMl9uYUH1KStgcFkoAfjd54cBulIX2o8f186hCqyiAwOAcLnU5oFUK7T28mSB8jGyePzvb9BQ4WYiatpTc17pIFDO2TK42rlgBSO8ck8lAz3sJ4599YWVpbpnSqdNfpfzVJChAPWzsHxl6WrYBrZyMKLCPJsjkED0k6tRUlhbKxIyKGGmd+a+/mPhQI8nJiM0KXpuQQD0j2V7siDqe007cSSOHm3r1gNaHYbXiXy3dUixqekAc4FsX5+T08lg4eZBNZdaC1HpQC9oPzSjkUWkULH1VxmGhyggdpQXsxSCLSLvKDp2F9AKwWFzZp/QFe+seXtJuZXwuseNDm7YFOWX9Qj8EJIQ1OOHEZYNIOegimcEQHkurzfaf/WnamZGf8FMO307FW3iUxlXhP4QS8iPyRsK+x8P/M1mtFmTjNJS9iTBefGGtRgSjs7v9FnaKmqw4Tryeorpqi4rmZqq8vzq/kassjTrpeyXbP3QMmIRE1RbAEaFVOuLjTaKkxspfafAjpP82ek8PksnqY0Ej7U9KzmpySoYEjFsItLOIC/ocXEKymu1ozvweWST86oqReYKKrurxCptja6uasrXiushZmZnCFWaCFWqxFXKBWKSoP7+5fiqz23YKAoUX0zuMbshR4K6lLNoo3U9rlcQlBtrC4REzMBE0qJy8zNthdpI7CKr4KLCzKIi3eOlFx0VFpbqbmJmcbd77BDc/6BK1Q0RiN0jDFgh0gVAy3fv5VNVeAJFRc3pOv+i5NfBe+J6WV3dZdn5Jha3XKhP8/AOrjEyJBSpZBuJHyFUouAikXUFdAkNRRpHQu8uW5pvhSHF/XXU8jKDfaTkJI12Sn2Zdet9L7MCZjq9OWZSt9yzpmzDokPP/9sjLaRxMZGqgBTBcKfsAkD2l1qmSeKlIWtVqW6UetK/mUr1njAXGAGIttrq/1tb5jUYfQoo0hdDFbVOF0tQNiDv/Vstnfj5SNQwi520PqHdLsOWKSITsKqSn04chCUwgF/H+/nJ6HMIcLXSjkCol1n1XsPFpJabZXaSJM9E5UJqpUh49KpI5f7XW6KlSEREiExarMZWdT3rw5SdkUnblUiqq/pNyn+VI9rnkGicIbvEY4Cf8Qy5zNzqOr7KBUhIuQOHmNtj+t0+XOOaIYhDmrEAaJJBZoCYyZhLBWraX/1wqsIlTEwn69g9BA76HaigKWwMIBWRMdq7ZiVPzHpfP9F9ZK6znl/kOo4FzHzn3WCnNYUNOmqFAVebLCItS2AvqnH1WvVNXJWlsfJsEBzOasp7S/y+qx/5G9dxgphBzDoaEhvz9IzizIfcADsXRLmWclLOHiuHtQWbMIg/9/9/UptXp7LPQ2My0AzZmksp3onozvou6fkaVWUEcgGYgEqnQgCwAiSHiar2HzRkl36hCGIBQjjBDCWxBGeEuaub8jvvO1ijPt2pQtJttCMEEYYYQQ4iF8tf9tBCDmEjl4fABUumDWbPYbWp9RWO/3hFxp+aWFkG0iIsNjEK9U/mm/+HFm8lUMLxgXEcNnMQtWkyDC46z84knw6zRHn3QojDFGCGFMEUJ6C+ltCUUGyqkYedgyltIhmJIhaCjFBGGCEeK5wTazYoIlhKgAvb52j9+2QTazY+3aiTMxikWxBAkeh73GoQ/QWyCB2xIEV2zFbBtiGo9w/IHSADjAcX3w9le7ewPywebMZCA9WhuTBLFPFWWnQio8fA6VMkOc1pjWpKyEpIczUwH5zYl1klQyqE8D5NlfE4Aj6DzvfNwDlzk6eoplSYKFzGPbcqV82KRySnPrDxqlfQuNyIzeYH6hXxTL7CLdvjf2wT5juqWwjJb3KgQPzQA1O7JlZO6cd4WH+EP/YU3cl44Gj1ey4378sxNkqMKvy1/k3+e/6P/A/08BvGj5AbLAJxCXKfIA8ieJo4RZQa3WM0ELQUtQOHQS+jH01QTYJowMY8H2wZHwPHjXjKUWfpq+S39Mvq6KtcJ/hv9mcIQrs/7FAnLR4PBgWUs2NBLvCZ4Jvt79EcoA/xg1ZxHIn2I/N+L9VgjuQTehJ9Fn0e+jn2A2YyCYVIwCU4zpwQ5i38C54L3wTMVNX9St+B60o8Q3iA/CcDxHn/Qm1UXGq15OoZuWSRgC5KE3qNUQD1Sz7C3uHHN3wSLUEtoKBMrPepK7UtEdwwmSoltDG4EtF88ziFKy5kVtfxzrTl+zbiObI7dsX+b/K+X2aJLoYpLkcRXOr+CRohItIiwrTgQfa8Nrk28suMFQ8XzKVFX/pi19a2P1nAmMTR3n121wUTCcWXI3em+oZ+hwzD5CNxrezSkmuFKuw78cwwrghGLihWPlqcJFkIIlT+IDElLJYdMIZBQ6xjJOXcjWQSP04ZUmER7Wyr+2wqgpgMFgwTjkjUbars/v60vxn2M3JoGxXIb8P8lr0evC7lYfETy145pkG0BDLTH3CPOoXIyRj9NOGFi6mSzcEFY0Obl4rDxpOFt8rDjh75RGANCyc4DA7ZAELyuf0RYT4McKJMgiJHzabMcYVoREhTgYgBCMoBhOkBTNsBwviJKsqJpumJbtuF7L1HpaY0BTK+28jq6efgYDhkbGJiRylCyVFn2A0c0W1tTtVg6FYvAQUhQMJ7g8vkCYqFd8fNJqwqTWUm2muRtjBQpJIkNFARCCkTbZm3GEyWJzuDy+AMVwgqTozE6YM28h2L+GkQrEt+0eGwBF6uohz2jo2CQ5vahapvkz85F20NXSa6XWNqdDuJOSz0AGLA5PIEZiAxCCEZQcJVClRbc2mCw2h8vjC4QisUQqkyuUKrUmrXenNxhNZkvWk0O5gt2R3P31DUj9bxsFduRim9STexArKc9I7lcR0BSN+heo/44iqctK5fqQDZRNe9UIaQaNj1KPUY0bCUwChOtUME/RXC1n/Pk4eLNtsFZRVhAJVBRDHJEd9HnEfGz3CvueUhkLbXVAZzNdaqDdajvTeQyNtBo8ho5YDLh4FYMoJRMvJ3X/bslURQ0MUTwWwnDSXdxgsgz36tEYVbVgTnCihozVajhfVd3sVBeGcN9D9zqnN0PjxCLgrRWsGnKVIh+F6qJ4vEpRVIunVq3US06jMJqVpCW06QgQKIUuhCVZUTXdMC2bCMfdK7DKUxbevmzcYxPK17oLeKbNc/KNPt8e32TF4LFonChBrD2f7EjbLbUXEYJiOEFS9MOGq88/UUdDMy1dLRePH0QpmXxRM4Gdmj18mSdCDIOgGE6QsqJqpmbmFpZW1ja2iH768g2AEMywXKpUrdHq9AZjsr0OEzOz31ouSTNI/RsjvmU9UJyGr4x2INB4hXHjqN7u+v0Lu+PxCbLsNgWXfGIWTafgnlrar9UVbd+yz2vcLnf6Ykv0kq9x8UMVp32k3FNSTTesgayT2TBoU1ohYG2Kt1/uLSvfseldi9+z+X3TP7DgY990pR3y8RFTj55xLN+9xf2yGyn4Zcg3VLVJZMTAKHiMaRw6YXKV76z9dPKz6fvH/lQvnvnF/0noSV0l0ygNAGjZOUDgeFWKRaHDbg5PII5mlOylaNQLQAhG1t3aLedVApKiRwMr9u6eeBVESVZUbTS6Oq2pVt9+3eUqVBW15+szN6FHzRaLOPFJuE2ipHnybKlPSgs9/T4ZjK7N8SfIBygYTnB5fIEw0aNK/54yhcpdkzmh0mVVsjZf25LKtetuSNy6u/k9kWcVXvUDQyF2JTYXWhX8JKKdLo9p+CeJVj0a9tUsxwuiJKccyGSxOVweX4BiOEFS9AjjV8+8cSArP/d8822DWUVxhLNKfG9AqyKtbtPz63pjWdWvJ7/Lh7b/zPyLT2t4O8gOgeAR5nO6baCL+wh1SUoZfW6yh7w0fd7vG/ZKsRcPHOPy+AKhSCyRyuQKpUqtSXt0rdHq9AZjptc2uyPny3fH03lW8J54+Udv+5rJptxh/j5ccgd6pwpMkz/qfOa/Vlx/Eq3PP0XMs1L0/HIrjvqI21MuTVe1AmLSxGaIS9sarXVGG8I27UYWcu6Wf+co/sCVruuLnTq6aPBi6EgoHnOMgEaNjPGOm5zQuzR95rXjO58DsCXhAUrQC9OHg/sLSS15PXqNihJPRD0V0fTMGpf1s1jSbqNEaGKo4Wsx6wjj5rPxHoKWZuvd1OGUHAq6fg/6iA02m/ZmIf2grUP8R1LGMYZxvvxJNDEZg/vZ9FLJf7D1EPrHKg76iMpT+cGa8v1cV9c0eg64AvY295L18jLkvWv2e75935oPHOg6dMjOo/cdu/DE1b2yqsutO/rfat/MMMo9pnfc3orzV26anPXpJdPAmavqZZfnzLykG44VE/3D9lH/n7y/NNQ3NBHraswOXNwyQVtXBXG/iv+FyjAtdRgDWH04/fgPHn8ozMBSmoo4bnYeAioaVIZoacDIMqsHR4eHEnKHwQo8iizKAE0RyGl16mO4uB4QLqAsrAXEsGFpcWC8BCFdlgzrnmCOgr/NsabVp8nlgYEQjKBYeC1Iiu5GW+1OewRRkhVV0w3Tsh3XS71DzDQCmbjrEpJS0smYlkXJoS/3Fo1Fbkuclrms8Ci4oSiuJKZsVlVWzUN117jYqotaPsN8gH0P9zb+tvacDq3QHJjuzBtNNWlqRVu1o0NnTc1N83p0PQQaTS3iRRXDT7u8UzxdQuWDs48++Zwvnr769nR2HYSr1DOAKoU6b+RNBOuW7U6KnhQYAEIwgmI4QVI0w3K8IEqyomq6YVq243rso7v11ywatcxlxYjHhpTFDmZe20JjUIczSwTB0EbJTapVm3YdOk2Z5uWLkV99A+LvNTiDmu2gT3IIMpZeFSZNGkxAbKq4QyQr5aCxYYYR+WKGCo/xjMMm9CzPUX1sgFMNA1oostur4UTxKHHSewb4bjlBkzUsqJjRRIx0ZsPdWRCFc5xBU2Yjz8HTL+9O5nzKIYZhT9ZsPioWk44bmlBdB2hSqEMzBpcXzRWWopggWlKbZVqJngSleG0alMFXXbECBxStYhHVoMvEfLIoS/KCFBQrjk0NTDMsbx870ohlbglbr3aT+65FjINCDZ0z65L7zt0IRHlYXZGaBvmHkRGLazEds7w21xJS5XlTRaodRIb1jzgadSy2eIi7kjC8FDQNmDaTWzAor6mysItdjeV0iImJkezsl2EM0Q0rr9lc1MWYVNzghOfrYJ806pUzBpbXsHX9J8lB0gd90AdJDpIk6cOusmF7tMC9qoIkSXnce6fX7HDk8o4jmlVY88ylF45sXSlRZ1mucaOOHCqUZGo53m6FDXaVBvOoFvmneFeGr0v4wILXQ303ezsKu2oUsyLf8RvfTnolN5hwUijbBWCyOg8F9qqq+vVlDtBBkwhjBmMOYwFjCWMFYw1DgWAjlEOEkRTdTWedK48gSrKiarphWrbjeqlXickjkIm7KyEpJZ2MvCxKDl23Qr/N4A7uuue+Bx7Ke2Tu8CLMkmHLUCv8CoXHFV1TclnZI1UzaorqrtFXZbUWTjt1JPMh9lPcR/gF7Vd0rBV+Hch35o3umqy1Bm3Vjg6d3T1+Kmea16O7IdD6eefuCgxfIy+/Yrm4py5sW1/FHOHy+AKhSCyRyuQKpUqtycq12R05NVzu89fHX85EsUAyoA4AiM8inStwJmILryVpC2OFQwmswMfVKiIzyAU/ZorBsl0uJ0k81u5I7PQaHhuEV6H1vx3/Q6myOepGTKB1EOace+YgB4MRhGAExXCCpGiG5XhBlGRF1XTDtGzH9d5Oa/1Y9mSOvZ8FEaAYTnB5fIHwcMIhFZIRStk83Sy/MT4iRQaLAgAhGHHg0FGOx5w4dZbzpAuXrnId7MatO/cePHry7MWrN+8+fPry7SfdoXr6Bvlt6+/kv6kX0IuzSqzpOVhZh3fslXDVRS9uOTidlZ8Vhg0jyaqLIkZk4lAJSSnpZGCyKDn0xVYACqKKvEr6lW1TFVSzTx0aF1vl0XIeQ2CTOBIf1T6og1a43AZYztaVN7rz0AGdUL2BTmhD4AwtdiVHqtXjfQH6evLtB6KUgue8rsxyZQC7B4TWSVG/rGuO8Q8oH/PnQY3o+xv1H+3HHGFz2ASJL53uG1FSIhk2KNy88C2D09DB727MzJAesDPmKFXhFTk17m473/H2jtQ0ja61G0YwOogwle3hgpibsDs4LA5byxx+i2PI8ooyhTnJBjsFYIACwUrKKqk6o5Y6XOPiTUe1SCCyJdoMYVJKh2Y6kubYnbz0xDxPuU0QDiEYQTGcICmaYTk+a86QIZwEz4qq6YZpZZMIZXHZ4eQX+/NPyY1srrx1sn0hFyLuoVoxTlyE8L/oXitcvMggSvM68lIU1K/+U6UTJb29itLjuavH59t83QcMBrs9hsHId9c+jXuCYTAYDAaDwRzHcRzHubPDzMzMzG632+1yuZxzd7xN7pq/Yc2OVN4X/lQx8+7M29Ph9QYf1WxtE0x/9oj8fBwhHklWkXZYuruGtY6vAHBNwf+KN6BHz6FI4kyGiPMoJZFr13y9205KRGatXC4hAqDt3f5l93IAAi8PBoTWlVcjnxiNn0wgQZVCwi2u2xiZSKLCHAxACEZQDCdIimZYjhdESVZUTTdMy3Zcr2Vsfa1hvQmJHKWMSis97eZKlna7kqMtHkbyKIYTXB5fIEwUFl88E1ZwmikxF7AAWbK7fzhkuKgAIAQjbTJcuijL8YIoySkjmSw2h8vj95n/1igKhhMkRWcRh2QpydgIEyMohhNkFFnNsBwviJJOb8ho+Ngkee2iauMng69ckdbwpIPcgT6vBGIkGQAhGEHJc0VfxWvedaUBkxWb73B5fIFQJJZIZXKFUqXWpIV3eoPRZLbksULOmdmfP8c/FyKNEDhoWBLUFVPyA6Bl5wCBlxFXefytX8CA1GDaDnkGla++HAwAIRhBMZwgKZphOV4QJVlRNd0wLdtxPZhwSCeK4QSXxxcIDycUUpBcmzOPXcitXxW+bxMYKAqAEIzE6M9ksTlcHl+AYjhBUnRaryYm4aTArp6+gaGRsUmkZ4WyeH44+Tlw6ijHO584deZcTARCkVgilckVSpVakzV0sztybrtO7vvvsx5JfNHmqi9qmvFKQOdJ1+lyLeJik8CHhlUaM6nWtAX1mBZ1Wf5yjWHtuG4Id7QtAOygvavMkYPGUaAs7T7GzDHsLAoRvMRJkCQTFTywPhuLo0U7ugXSi37pGDBsjQphXCCTmJadywAQghEUwwmSohmW4wVRkhVV0w3Tsh3Xa/8wA7pHrvt5WKijqxc9SKWVnnarjuXdbuFoi9uRHIrhBJfHFwgTJcTHJTApVi5kQRBpLyoACMFIm0xkpDJZbA6Xx+/TLnBPpCgMJ0iKziIeyVKSccDIYeT03hDADgQAOFid3lAjxiZrTWZC5JhrTl2VaNyYmRkAAICZOeccSZIkSZIkKSIiIqKq6v3yW+3c//2bFEtmj8jXlxchAGi7SYjdywEIXH3zcpPBDKHxAvmMxq9DIEGdQsIdBxijIiQK5TIAhGAExXCCpGiG5XhBlGRF1XTDtGzH9dr/zBbCqXY/D0MmJHKUHVRa6XRzJ8u7HeRoi6NII4rhBJfHFwgT1Yol2xQie4Kf4QqOm2HMsRaMluAi0aICgBCMtMna0tkysnLyCopKMSKZLDaHy+MfN6XDSps1jEKYeyQqGE6QFJ3aUHUNzSwiSZZy2oMn2sCmLdt27NrL/o6GadkOp8vt8fryo130bb9t/8m0sQT7GHLjYU2k1M5Ui2UAykMgRuoAIAQjKPmwAlXJGlQHG8JkxTZ2uDy+QCgSS6QyuUKpUmvSand6g9FktmSN3OyOnPGX2+P1Lf1vtcP+TypIJq0cSokE0J53i+naZY/lUBC4+ublJoNpQ+Nl5DMaP0AgQX1Cwh0HGaMiJIrmMgCEYATFcIKkaIbleEGUZEXVdMO0bMf12v/AcsIpdT8P4yYkcpQAlVY63dzH8m43cLTFaSSBYjjB5fEFwkQR8XkCVtmXM0E5VoE2i2+ZE7oP52lZ7pF0UQ+AEIy0yUjpwTKycvIKikoxkpksNofL4x8/pclKg7W7KEjsxwTJaIfhBEnRqQ1X19DMIl7JUpLRyOfECIrhBBkFqBmW4wVR0ukNGaWPTV47s/VSXoBTa3mm4HA4HAAAAAAAAGDCKxE4AwMDAwKBQCAQCAQCgUAgEAgEAgGBQCAQCOSLIc8zwGKL9Wg0+tqoSEy89C3IWSgGNsJDgJCh9KIxLcuAA+F/nobyW++ngx5ZuzGt47FeuZrfXfKF+EvvldfeeOud9y04G2FiBMVwgowC1QzL8YIo6fSGylDUavhDC9rS0CtTr4XnH+z6d6F7ODg6ObsMhFwZmiot6HE00MTKlxq1sZo3X49cGNYayC5Oa0iYMsEqFvNDr0MdDjbBjzECbCM46BWqgmLVUEFp1QKoUaZeEhr3/SRUWAUZsQRSJFOf9PUFvbJR0s7UZ2I4QVJ0a0KjipaLBwyilEyyqJl06anZB4Vo5nSEGsUyEyJ0Req24ypPq+Ck/tV2R+KA/ujP/oqvXae11hPfh2uOpU+hdnl0Rb7+Df+L/+/DVR3ndJ7bN1B/MbVdrx4c80KFHjLyqVaoUCEfHx+fatXz6iZJ2chsWc5CgacEAaS6YgcRVF5qSkO0ILroEQxlJN1IW/TERMfMUlbYuh3EYaA48VwFq95tHz/3Gi9LHj090TEyw+xQJxVx8oL51GiL8fFTC1znjumk/v9Wc5Ae24dQZOJ7JSTnKbw0VsJyeg2vqbVibUh0NeOvjL3Ovniou/bHRk999vVg8J+CMDMzM/NjztPOmDlhnvLflxUP391//uxVtZOpSZIkATgEMeSnhjYMFocnECMhU6jRRmcwWWwOl8dvAzLkI7FEKpOngEqVWqPV6WtQY01k9i9WW/ashBKuFKqLnr6BoZGxSSRZoSx+MpzcnC0h/z0IHCMohhNkFHXNsBwviJJOb9gjMH3ae4a5haWVtU27BZH69GWw4bx5AjGSGoAQjKDkKN4qLbqxwWTFblkmACEYQTGcICmaYTle+LRywx4zE7J1b0HtZ88L96G/133bZ/8JDgYCQjCCYjhBUjTDcrwgSrKiarphWrbjeqSJhE5hOEFSNMM+5tDGecJYHFvS8yq3qrZ1vuMSOi2d7FH5IWOxuK4f9QGWjNZeRUCp7WZwMbHUaHV6g9FkzjIVgBCMoBhOkBTNsBwv5Jx4ndwP6F/nFnamI4PAYGepIn2t/MBgMNjqe9vjqjEe0WjwHRe9mgQGg1HcGNXJaACJVlI7aGhWlpdKqJris6dTXYbVNyrfkE2N+dSUWI4Ea4n22nKuPd7KotaZUV3J1l36qhmO1kh3+zeDtreeuEvd6XIlfa6t37V3ovJcmo6n3myaib0B7A2m3WxYc2l1qMGWc+5aa91aRq0m21Pr3e5uAr2ZWe+3rPsQ4ZehHmcQEels7qnEKmymUQpTTiwnR6eBPyeqQ1F7v1SPoVYDpzR2arTsHCBwELtkJ0fcwM8b9NSQbqhLWKn78QIkKCwknDG8SKKcHAxACEZQDCdIimZYjhdESVZUTTdMy3Zc77OGqDrNep4K7UFQ9bw1jKrmXifSjSqGE1weXyCsiIr5axJ9UgNygJLPlENAnp/SONPu3F0sP+nw2eOL8a3P/++GWD3SWRQAhGCkTeYzjjFZbA6XxxegGE6QFJ3WXYkmnBTv6ukbGBoZm0TqLpTFL4WTd77HFwhP++DJ1WolkytS7jx19FlFXRVlRAsoCMEISt5DX71wd+Dy+AKhSCyRyuQKpUqtScvv9AajyWzJeuBmd+QMvNxEG/t/t2pSmDoXYPvrDiHcFoSI8CECIILAISAFC4GChoWDF4qAiCQsZH4RHjikd6dPN/EDFHPmjMNcU/PUvrUDRlyRVJfBKY3gzGLqHH2nfLwZh+PJhCv/6UXxfQT3s2LsItvHTm3gc5y1k8wPnYTO4xScXHPNDmjsvBBBuHm5Ov/xWsBpg6Dj7J1kQ3b7EM/+gscc+wBY/Ywwpl/4UNJnFwpJhS3Qus3wwHkn99ib3kZ0LJ+nLazz6dZFGEvnlTPu+/PT9buvP2B8C2xzYWG2fV6y6b6UmeRJmYVzYd6aP5aIYQO+8lbLefmutZ3Srne3sbffZ+6L2q39f/s3h8gD/YiNy2A7fOdRvLOmbujoSF8gK8/b404GBbkG15cbBlF73YFhGSxfrgdo7dZXZ4VxihkCKbN+8HOu1qI/5+rsloKpoGHaOW2DDgXCyXm6544Mp11lmvJTbDCwmCGQMusLwAMMBgOnV4aFzx475XY1xXBSqZ+XRtLuMr3G+kn0/D0p/5Ze2BNiAYGUkUXJoT/MAo0SsyqLGkydRQNmlUXLIcxh7Cnc8bnX2yEd3insbQN3jvj/RLGXVGqbjqeh1FcyctKaAbo9dAhSoFO0V0m3ss6rdqgxqrvGs6sMwHOpB2/SDuvo84KN5awdPMRuZlAYUCwgkInTSUhKSScDlEXJobcFVkUXECZupnIHvmNwbBSH42FtREdbYTIbgDmJdME1UJmG+DHLx5ln1FBDyk06FUIIpZRSQgghvD2+GBhetuP4ae1PCKWmetRMZUopIX5ZyOfTKwAVOS9ko2GjoeHi1sdJT7/pHczxND2Qg56VnhViBGaEmW1j8zzaLgRjETMEUkYWJYdee9zdqUSnzLxqQo1W3TU2qz6X9Hijtl9HW8GUDSw564FiMWQplSmWyZKe9QF4MP2C6ZT06jNn/bWMIbEG1AByBusWJ0aF1eDM3dbqqEIfb8inO6U7xaBgpCQnB2yAG3ae6k5+hvjEhAc0duLsy3Hiigc7g/GO5g8CpLdFwKgRkA32jSt2IRAIZEAgAwKBrFZepxaw3x4z9BAIxIR+3V/4Ahm8K/r2IN0ATE/xHMIlw1+oZoY34+O9F19OM0/mNpgH8EH7XHEEMU/RhjA9XUFATn1eqt1aHvKHSDvCcPhS8hIbml75KctX5gEwjErMEC1ptzi9xNrXwKk9aLRBo61Wk41yJ5CaHTr0ypOqhUb73VrHN5x6xxLprdi6lNuF/455I0W0PbXG8m5Hno2X3kSp7cy4SrZ4y92lhqFrXDZpaIw2tBWnFcYTo8OBMZZiTN3eDN/DNMbY1Kb+4B789CwM4Z6GTfgX1+GBfGiEOPz8VvvKlxxQQeVAbjauCyLvaxsKRWnR4x5nBBWPi5FRPiEcMng1lgVs6SPG4mTT2EhNBoNqbkMIauKLjRDQGTKQi8N/cd6HV+5BIX5v1YygHJeyI2+jYAxPZx+uuZsnhBPZOkbxHx4rlLFlr9C5aeOEdr5319mGtzBZGEnoxj4wdCSBNJBE/b2GNkNNBY011hHePZi/HeOUCcSr6vqzozsO9uYz8TYeKTU1E4H4CCkiPEoG894OsAPRw85cCJShan/03j/Bw1UkKsuJpFsz8sUEBnu+vIHH/y77fe3Tm26/Bjzd3kA71o6PSriNGi2Iwcmib7TxqQYG77X8oYkCIScpRbhwLiH3SMB8MbLgvZsd6/RQjXaMYbuDbJyUl98IzCEBKaqvm0/QJw04qSZbPY6Rw6nHKxLMtB2Shcbrbn0PU3hPeo1bXL6pYknGsxeaEF1yakuv3X/X6usvvVpMsoh1r1B2suuizs9U1Xnd4+fkueXkNERLKmBOoHVobKgpDZ3QGgZyBW7iSOtwzeCHJG30C8c5o8PiQdkPlfTG+/vSH+ad7WeQlY1jMDJGvOFYS5ut2EkkcQGKOZ9J/2yRva/aJh7T6lguI4G4Iqji+0cSijWJCpKi0hSkbQYChnRdnicZHQ8Ov5eS445uzUI8vQIkL3HRb2vInPHN87xx9sfd7vhJq8XPcGje5NuE5yH8qPo6rJNCkVc5Flglcw2g7TeKdiBj6+NQjEuI3rgtofeLbVdPZqrZIMckvSi5Jc//sXb+Fy/O58Hujr3QoXCO7ISqWMNIih95d4jMjqJ/69fKS1/IzfXqj0tpW+k/jEVvxeeAe2znsMbj1LNrqVy/EVEet3wC8rkoz7v7+B0e3f0HfWQGxOXvlJ42nsWzp7Wed301bQ5kXTlFiYAIvQv+eSIFesx1S1Kp9kBK/X2w2l89zs33nJDnNQSThJCnSPTLVVfd4VxYAXYFxbeBIL/Em6YiNDrKSDDmcc7iEj8wtSj50/MUASGqsCghJk6TGKCS6Dw06ecZMmQyZclmyZHLljfgyMdVgE8hfkVJQNo8ouDIUZCiY6AxS8RjxSXitScTkEpJ2ImaqFvp5yS9aKn7MtINZJY7hFXBWHYlE7mVT+GvaroAbB5BRQzwmHZ3lLqj8XhQTIKjwh35lmWGEaNmWsjwdlqx0g8SQ3zHUq5CQKVqtlp1AurNkeaH+NPd3SWW5Q/KgmLZjD/8r9+m27F3eN+hgKOE6hjLCbbTbdWZ8w9pA1guH5Ou3Mc88JDi0YDPY/yeEPcU7lk06ZNPPZ/h+hzNF7i+jGq++jrlGzJ8S6bvyPA9mX7x7F918ewfOlsQbdEy0Ato5iikIDJ4IS001yhhCxNx1h03g7X4NhJ0zR2/LMsMI2Ya/nq666xGU5I160m4XrURo6tPrGHtIXXoM+KrU1fc7dETZ732kRYXjDzOZ9RE5E5YitCy43F0gj6h7jDG1llIUr2vKXFCV/CHBz8wd2/PJUTG5V838iQIh45HU+d/1lXS3fTkQEWk5duJ0UxNd/17goeRwfCGo/inKGNt65mULSK58c5kKAnvGOaJHQn+EY8f/KEPIVqSHcfkAqmR4weuyGfS8R83janH8u0sZ/7gTyQG+2Km40f+J2XrX/TTp4V6eRVbkr1r8sgP+YUy13HJeRN6w8fQ4l3hXN/FhLnJAi1VR7vwAceSf3gGBQ8iqf3drkIr0kdJM67tu4Oyha4+fOS916fyHUNnEZXwyhvFuqgpFpabYPmqZaDOfmw8mTr1g47izqa53Zun94T9WmQovgcd4QPplEtIBRyE92p7HGXBqZnHWM6SNDdm87LCwGebbNGGJ7CiUnF9Sk8c/ufYQdSkz9aBnSuvcZ1CybVnicmsYJloxiB27LAhdTe1ajfqc7crIfT5jq4zVqv6pYm9d/B3zAVq6h3t+KEfPr6xe6Q73j1/xS7Rqfq9UqKdsRtHqY+BGejd3DeudxX975uSx+AdcwfjuSlJfl4Ch/42eDhzcNedN74HgUQmoNyNUu66aCz8MRQumrbWNr/eiECX52ZY+6niTcf1hTNyN4b/b24OM5SqhlCtKcKsTdl2nKtw5VkL54fRB7jxRwRcmqD9v0xVsMZ09Dpstqdt53Td+/CHOqVD1BwBjAx0wUGmQSPQ9G9MsxaKVhOESVOk2Vey+ziPuIFSBvFpkzTNQZkPqA7dPXUEwKT9qrtyg+EmDjK33CbcGQ13HzP33CdslMlic7j5IkAQjMH8WxFc2yq03moIw8KJpFVRqLRBn6cYnQTBZHP59pRLgypTUOXIgXwySKGJ6Bs5BD5FSbl5TCSlSMWJyBpJPezQKUw8S3SKOSERIVBcXwpFvkjMS2kKyI0qA+Z/ItaCIklDaahEYTUpLCWiorG0BCJyB6Fi6VdO8DGJHIPUkZnEinez8nMgQTes1CQilST1sEPBswRFTAgUT2yhlJZSkNw0wvBl8SWsCoVQgw4Rz+v3vWVuPau8KugD8O7oBvskW2dNAlcXzjAO7UkwBep0+Dfg/r4Q3jlpCZnwE1/TNH7hmptfNXzauR+T8BduOH3p38XjuVs+PPK89KLty780z/NXYlIr4o+QC9g9d3PtdKCkzXCVHLAjMfBssFvgebv458GatFhSPMf/9qUbpz12BRAz9baV6o8aWu0hq5C74qiIptfCsp73TZfBRiufVwY+Eeg+/7K0Rvrm2+++/+W3YlIxtQizY1B+AI5UCSiCYtiWw4ZIFcnSOLIYlUWnFC5lESmLQgKCu+GaS5QjkgB6yqxkgVRQW+VZwxfPvim8PuJhvDCdpErSX4K961ZSQvcx7w5lkIqU5pfmZ6RHiUanUakbQylFrVJWUgJIFq+ySJXCqCwuCewGqyeOCIFKBdbDQ3lhX9J0JLhCYoO3eYWDP/aed7GA/vxtMnv7WWGs2qVTrM23aYm+738GqmkzFfFl+Ece1l/lfcL4r85BOAfhYQuAP52D8KkW/MMWYKW5ZpRSOXskGZIhmOiIjmi0u2UzQK06TljlhdEk8ALvVhcZ0UX6N2YVI4mddMLSSdOW9aXPpge7xwMB1rISD30vLud9/61G8u21kKO/TCwQ+KGDfrmOwF39lxQBoTOTOCMHTN5hq4dMdlFseO9T6fBf3FaDkIAg/iHNGY6pENbQsz+CQJhCJoNQnEWnjMpfuHjp8pWha7bHoDEHLFiz6YJr7njkhXe++BUgK5F2xGvh0Agi0TAl4xKRUWe+0rIOqwsF2qVQVIXxVHhBiuCtSP65bL61toCLRtRoNFffrmMBHyNdQDvaofF2/qaJzKB0WECZsoQK5AgVygkqjAdUeJBQEbygIkOEiuEHlV1IqBw+0PBAOaNBtTfeM7rRlC1ozo6W7GnNgTZitKulE5ku4j75utR3m6Bwju5mo3bdYXPElLnw1IrjTqPByWbayoVCFClUcS6sc7VGzGvUgrZb1Jij2oGpcUvXJ8qDMu1k4j0/DlbghYfrpw4xs/+55gAHmWVutJ/iUKGQeN6QbPg535i5JAQAmZ9CoIldFO1MAaEEgo+A+vbxOBQ3CL64FbDXg+4V9dLrVHe4Dv5ns7luhZrXkTZxNngf0L8mAzgbF2jUbUIF0nU6HBjTs7KhnABo8Mt0/vw3af+WEPDCeXfciB3mbFu34ZRXxiyaNO+ue+574KFHEeBnbPDLhHEfwTufwKiLcAZ7zBJYBivoNVZChInBiJMKiiy58GdJiQpVatRpOIbpuLNW1NKkQ5c+Y6YC5MhnYGx4OK9EqQpVqtnU2apDuQqVqsiFnrDgpHOg3cIipk1MwahWDbt6dZrSa6s27To1atCkRbc+vfoNGrBi2aqSfku66qZbTlt1RqbLLrmiTqv3CCT89NkXX93O3C///DXst2o1XUhbYr4IEb75E8CfzkuMnSloMkLJk5RkybL93Z6mzXiL1bb8XrquPG9J1nxsWTNrLn54NDixoiVLkSaJlEwgiUIGBYWp16xdp1579Os2oAGYZ9/gVYrhrr5lgw1Kajc+8cXv9gwbOJIzfpZ6NU5rUr1YPywEi8SisXgsCUvDsrB87AL2CHYRF4jD40i4aPxmvAveFx+AD8Ij8Wh8FF6EN+AtxLt/sv6JxVp4ChYcpMXoW9Uf0x13HoiFY0OwWDrx2OZpeeSfauo008onEDCuw/974L9H43Y3/vk74f/XA77f1ke+3/g+eIZvOeuz33PfY2/+Lv9msI117sgr8PXf5/HJhtqqv4rAp4NESOUhn+xGnp4712tukKe3SPy6wbnnn/p+wtS3Tu/BT8jLs9/vz4tHRSPm/j1fc9tRp7UWr3bYN4sua3dVGsWBcEJd+TPW9McFNY7EjfAgvBvD8zP2FhYBMS1UNLGSMjghzlxJsrihnKI3JFAuxxmVJ61UcW3AdYEFa+PQNltxQwCQMwLuDbjfxQZ7KxHiSnhzZyilN03TgKQxPK3CNYvgEKVVpBbkDKJkGF0XA90M9cTNpBgdjAwwto2JQaaGmBWEX3CpxlCMe1ztngWExi0snmH2dnJoNZUUmuRkd6JieszWvouITmQPN3u5mHIpsviUZnma42WeAbZSW+DrMB+H+DnC31H/tpVRtmNCLAoqh6LciqCZrPrFml+ty3EjZaWVui3PXfmq3HPS2054q6xyHBfsRPUaqLDnAc26i3PTEbcc8wZ/IHvILIXi52zasvpFF4Kg8M9rT/4Qjljw6AKd+erxw4Gqh+ZkbYLJ7F/BpteRzfd5t8WkZA4CK76J6Utw7BsvgyDQU1pk6ek/NKt8Cn/4lKjaIu+tHKpNv4Kt/pfB3ozKja2d96A3EG0+IQEsMQDAyw4DGNqKP2do+mnKk5KLzeLN5+TNuza3z9ht8rS9OVHXuueCuresjhCpW/KKfE4RZZMgZY2oP7Ek0L1591FvUymJHlhUn44aqiZQsttxgOdsoNt0kHOAQCxsUKtbwfgqwTTCNcquz0AWoDCV4rXtrIgCcpzhVQ9kiw0ketBMqoX2S/ReNpjUyep1Kq9cfZOT5Rql7SEtRKcRL3RbAQYEr0wosQvm2X3RaAJprODiECiBDBYDApIwSePmHw2FiKO0ZRkeKvPRziQmMRW4iJe9pVejwPI+lcHuwA1EqBUtWmbuBeSkINpypIK3JaEw7QExq3P6A9FwZbIIVkJbeqWsZrZ01+aXJm2yd5G8M58v+4CBjpMLtvJ5wASQSeh8gls/zjRmjJzdm3ExYEHY9ggZH6XIP8NkUVvbx9RoARtP36VOq3k+i1Hbj4CDp6/T07cVxfllj2+6i47meO8XrP02kEcszyCCLXb9YsYpsGoyYe1V6pY7hVpsvnWe16I3IH8vujpH72DbRDYiVqrHCvCIn57ivXc7CsHmAEFHE4is/4EPO0sE7xKWRi3QNKIqkEUVIP9tz+/2zIKz2Nb+3eE81+7O5IAfHS1dCQTQ4a4gWCGIwlBFYIrCFUMojlQC5ZV8aAC+AgVTjXoK9fesk9ixwdWzlO2a56NIB/DI8Mj0yPLI9sjxyPXI84WiPJIkzlR8rmBnmvHPM19oEoWMolT7uCkBxU5xDJRCWikIUas3rQ/LvBbE0dxAmW+sYJXS2JmsLFIfBLXrV+6UNLCOCuiaqlegEju64LBhwDlDUCta/QXm4+fint3sFVJdDlRBo1aqfiKBWG8GqjGcGAM1aBGAG9Td1KLjsHpOg1YgTeozHrc3sYZSlfp0mxnQONVI78rRy4E6gFahdkJwSxReOWglKo2NTU49LKRh3imoxgZhsdbVl2+7Sz/x/v6I23tI9znHaEJSLbPhMG1ZoGxHC4oA7+ktMMvWIKxbWASzurdYPj+mzfXVJGhqy/BhYx5Zgu2GBAxZNBFtiA2Iyz0Cs3o2RQDMoDHEJABX36sPnEFMNAcazG+Wz4alwwQbbwYaqyJIjeDmJZATDBRpsheUnnemHj2mQaN5VxKWgJLB3jK3XL/HX17OS2mTlw8KUG92oFl4C/0ilp9mU/0EP3a2wDQ47Pkt3ySNeeglgiuB1qrpXuVpa5i+/sLbQZ1T1HSAem9cYaQARdOMdgLlQIkHOpNLcCyMrCVNowa7Sevq3YUu+xbj8LdilXhQdvhpkUfrP4yhbvNZPbHbEoLpjp42b1PeBK++dK9vpWUGJ9QFnylHBqpioA+k70dHAjlC9TsyAGpv1KAerS5Mg5ZNAX4WHEIsygxuNMOY0AD1ILOKqjOWooEmtVNAR0f1GWNIGqcAj4maM8aStE4BGRu1Z4wj6ZwCJi7qzhhP0jsFRHzUnzF6oDkdW8inWVzbuCnOlNOpz54SS8vWVKoFx9gqtWF/0jvFMggpf4VIBfhEVVYni1XPCKleiNhAVE11ilj7jBD7QqQOtFQ92dQsc+DRb0474KqfJsZ8SRRubSrRuxMLCkDAYE16wyXYLueaPjKa1gsu+APgisvBwROYFhIZicSVaFWhe78xphwl43O1xKD5zc2xG5JJQI8rsyaJZuzLsCYd1iRv2kVKkixBAk9aWQWsPb9W0NSUTnNGxbR6+5kUTjJ3Y3gUSumbpmllkMsZ6Lcbes00U5FGU65lTYrp/S0lKXYS6y82GS8dBosm/yGarK1ZtTEhZZGgpPOSlQRrs6cN4IgyKaHFQC5pUGM0eeN1fsAzJIOVlIYcAO8iw/emnBC5yWfSIGW1eGNoIjVdrigBMdyOpn6snQakGsOgtJIQZXeymDveCPOxsm7OXWrmQjeLV1Zjh7FcTBaHQ1v6UW9uPqZHowXiQdzZX1fCh83198rVm5ZPb3vvxcZebeshAQyfy2FoyM3zPExZjkm3TqW5Ylzd4U9/HJvbxfHE4Yf/v7VdC0uDlVNzUOXhnsgGawZG0EOI2kFVAV4VDRpRyIPpPYMZDMN7CqVQeAajcbXRmNPT9aElf+v3UapD91wYWdYc80E6+zxxp8ndYvlgTGwBfvWc03LACM3uJlQqocQmhhiDfzeH0KR6VK9ChI35mVYFmy77C57XAkOAUs1lQvHoAYRGuF04H5ZfsESibe2Em6NFKibJDfNIEKLO0KHVBB1TS0tUUa7BchN/CtDWvXPCsL8DMWjtaKlhYbCby+v3q3k2E9CLORkobwafhVWVJCMhV4A+fS7+VHShWBWppjDJjtc2mKBx3ig4uBXAQMB1eQiU8EzP1LwoC/yrNBKMW2GS5IBmM1JTFKX2mKyoPlbMumPheeawpA5lWBR961NGIkVZk/CuhQiSRj6tWIn9xvNf918YlsyKAr2zFhRpHYNFQa70rH/FE88Og5kbdKX4QkSGD/RYDJrOZuKJJAUb0IwUeNF/oEOh/TNfMUiiQmxUFJQW7bwzuegB97GmSSWTlGBFJtI+x1vJX1Aeot+sYKVY2CaIDDOn+c/6MLuYW5hb28qUHhQdSzChHIrpCMVYooiNj6Ap6FomCeLHsywJ5n8iRXovkXFLj6CnkYgQUaFdOmGfeS1Aqy/3b9WhfPYqM6va4iSoKNpNfZs1wDw1UAZgcy1XbtQ8LzKleKSsMy1pKpVpc0DwbwPWtZlF5kdlLMaZoSwXyQsUkWBtLxX5mZCPOfA2bDajGQ6zNUw1FNIuGshWXA3FaY2cbXSS10smvvS9uEHft2u/IDMkhevalXjMuwCek2JLsaVC8bKXGfBrLk9Dh2tRdy3fYnguF/fmyEEDGAdSfi76V0oaxAzvE+hVuRE3jGsVRgEApW2tyws5+e0m/HSVknq6pt8IBKZeh3AkaNXq7RYeZvFM9cmUdI2Rdaxs6SblNdxGmtBttAWXkVLI+pyjZ9dSScg6ykB3o+KT4kolkax+Hs25SUQ+ZUcDP5zRFM6M4ax5mkOROiwm2H7e9RrAVLUmFJoQERCaITu+iTVu6nMtPZYM+FUfWszYGxH6HRXK82l10TmiZIO9O0Isr/ePlTEIAgv+YnZB2WOmIj59ivMslNXG0JykYmoJFncmbddeMBfI1AnNxGGklwo0xZJ6G3il57tBhe1+7T9fgPnM4hWpVmS0eR75PnaHgLSAE3RX7RcMFhss1Ra1ht5+kaQdlouthOpqwyGFWHTDGIZlBVq7mpSQ/Go43pjNCsPnFu+mjxvgBnaRKCFmhUeRlpnVjmnF5M2EGBKa5z1VJiFxs8Ep0Fzu36YahzhOsn1T6sKBeZE8XUuNX0gYKWpD3pluWD2OvoMk/DL3iWuAAPnJCjDFXAht4X9GyRasd2txlgTlt94ztweKQs1KLlY5OTrhRQ82HzGcx/C963EpcSDFG+Q6VqBKMZLDFjMzXi4vQRmv9PWP20SELX2hiFnWaOkyZvUNfEfiMg4qmrR43M+XXb/dI2xXWaOIeaQXbNMZ5gGeEbu2tVo8xea0Wt/JhZ3Ho34ip6gsuZTsWnQpPBXNbIumLdDtosTJ3lppPg507omtDkKjaucANyGlYNtCwXcTrIp7utFMzPmgWDh+wzFolFeZxxdMypUmrMQkoXsF/ebx9sKqwnWClviusCgZacZnxgKIGFudZYkL4BNpBGJJIMHzw2ktY30UVlalDku+CCynt58ildKzcHZUKD45BlMeBYycWFY/7rpErJ5JmsylegZH5lGwST7IfV9+l9qy0iKlrDtsVh7GB6/BuJUW0XYj07MvTxTQ9fnNZ8g2WgprEO8fpSWtJpV5BcAzLgDpUnztJgtYSUIW13Mg0RhxjqZDX4+W/Pq7yGQbNsYNQC3TBZIpAHEs3JJOBzNwCNOaUlqOXEMPmsuyWC/qIbXYKB8yq71PmW8P9c8L683Aa9o6EDclNY2aZAJWQO5NWj6trWj/0nJnlz7jVIuvdDINT4b0opx9dzefgqob95Or6ZAK0lBvErKdqTxlbPhcTiA/EntvSpPPpwWrs1GIY1VXxbvd3GabTQgvWsX8sIv0i5jbyYjnpLalTlM4cveSoaOxsdHPEOf0GvH2VhNMNtEZ4DnELKwRgzpN08tg4GiNAT9Wx3BDapcUd0j4uI63qKUDzNM+8W3LL0big8UCGRMGsl9O1ncDKQ9O0fx+ybnAwGM9F6UiZiC5ZTWyB9ORTLdaDrYUIw3bmc+pJvG8SvbMEnxAUJbQIlm1QeG4sfRBAenaZ8VRjdfpkaUwdZRSrNMuJ7O2BR9lrV8qqQSnsU8y9kJlGwRRHWsK3+dbGqIgT2lMTF7pZhThIOFrcdRGuwzLadC1dkeX6ossVzLymPxugjRTbx2dv0UCXsOE4eYMygHz9F7QI4726lOS9nT9p8JwZNgYqSLHvMpFN8+5WskpwNKT/XRI0TTHVl0Dtw4lFU7W7zJIFhfl1qQu0q0o4L1VQuz4cdq8VYZS6GzLaribbKJR6HBiB3MDWNhxmCc+96gtcrw6Q0Swk6WL4c0gdMnpSerjuKI1Xecb5y8APYZMN52wy+P5r7KuBtiMOuL7p1ZkL/Py8YKPjyXgvoEBHEYh4k5Y1pB5Q7lejfxsRg5SwI0zecA3c1SQcl6FjmoXtDQUyTPP8UOeI/AfL6mr+hOBl7NS+tGs4mdx7hm1VRDbf7pnAzyfvVfGNzI5sNGs0sGHNDnvi8OCqJZNC9wXfw6T7ChKNTZ9TJjOat4mhLI2QEc3xsb3plQ6bbKUDDep/XFIa3xM8RJvQEls8wUv1B0T1bWaLCYZ/A4YmgX4F8HLGS5Ccas1J5J3+aI/ILvunlkSo+47AWWPKsEJOww1F/5M3Tzf5yTURqt+3apRWIeZXobUDSh0NPc2Dd5eTmw7mx1mJ53Pj1t/ZguQHUqGyAzZKYqqSRKLC9dnnQYPaVNnUilXn8Ban71he8xb1Nl6mwtuTxnXJUOdL6/6LLprmOX6lcOVNb9Q73jMtttkZJe8yqM3wIjcC8H+PYIV+7BSoN2mmaB32c35Lt4rZsM9Us7mWQ3d4zzincWNYF6/Fna5nBWNUONiaTjHx8CrDHkNrLPKEJ/1WeJfngTfPNvtmbIGqUDW7NjYzanvykRTmCLy3SqDJ3mUVSiYa9sSpBnkwpnf+PB2HQ/EiUAw9CHnP+HEBz3APl5LBt+QWQ8B0zVAXMZm0PLobuOCCjQ0ERYhg0kInXyMiZfIYpY8wzTxfH+tNHcw3+/G5lgSN4vac9R5wdlKYWa1OEtpyxOgR5TeDDs6vMLSeA7tUE9RkhrTpybGNcA18XwAHfVxhAJeuAQk0TwuD36/ybm7oLmd/m/krOrH84E/5SJ0X5l+7aww9PoT5S1Qy4ehERvIgsMxN2G5L4PfJCXs78WgCEEM6lXaxk1smeK19FeeGdK5aKeaTFPGbiyPPRgLM8LJDRohbLo6s6EKsegSIT2a/O9VYdeJIxHH45fCF+F7LeX09RjHvSDgDU/daGUz2/9urY0c4+04PrcjBE02M6Nu4HDxCzUei/U5FMB2x9RaRbVWsp8SmwTYz7JPgID08IHyhq0TFVjgeRdDQVUKz5Ki8h1iicDI4LDXXUAsytO4lBsivhAXmdgbckBMUJG0Xklowb6Y44WexMOvtCfAuH1oi7sF4prX5Dm/gEiLO8ROr+SE3CBd3kPS7MEaLBxBBvGnl/HLmP+L45+6qqp7NL4aWg9Ifvxm3WLt8G52VPua4kMA1GblJu5mgbsVr0dlCw0b2bycIDGRN4leu08PaO3O9lF8ZdmOZg6JzOVLxGtXzQ2fLfzLNAfTUEKL9ahjK6WlzQbN15oNG1q3opPrQ9HA5+GUo2Cg//ebjgf+pgJgnuJyohC5VeJ7Y1lu+az/Ggc44DPm97VxQEuOHIEDqOh2C/VwiCb0mkFT48dx3pLZUx/RoRxAQ4MW3bln4mbLINApZaBU5/GOwKLkFO3GhRYMPLTvusvd/wXscjOjlT0tJRrBsx3bp0m834DYDMMT6/20kH/ObJOGJOOnM3NEmCjmFBRPsXPybJawwjAMtgvXqpm3TxnQmls12oKIOTTsdxtbkpwWCGyIJfgGL+TeVrfih+sAvAr2dvA57p0SSUJr9LLbTXUCi3crC/RNiULUz/QEepPpRue0bEe0k7WwlK2X4b1lGvcfV3nE2GZfXS9ysy6lCmVGNadqLWAgs1/dah7X891qBeyYooqmY93od14FMKwFvHhEg/i0opZEV4fW3SU6u3lHZ7TQs1W+0Ls4hBMDrpblu7iBPTkUbgM36CCHi3WznlJjbVdvrmLQZNmNNeZSuu/JehVuXXXtxkYNZXXOkPJdzZ759vXy/Kf2tjU3S/sCcxQGYBQh0X1GaZVnQAAircrDku1RbFOuMYbDWjRi6M5u/P6ylfgil+rv7zRGliigVkxdhvH2MW1JORGtQ+tXEBtFZbZzDSSaiWs4/UONJFlDpNIrV6AXoa7cwdF+ykYSrdklcVxeQojrWb78IJpDVG87pZao2qPO3fF8TRuv9Pl5XfK9oLTJed0nuDenOgnjSyyzPDijs9AffwsNbSFJWoZ7EPnhLux3FcXoU+vAaQRMgbBKnx1AE4reKZ99rfKU3TGfrgl94RzvZiw9jQcnEZrR74czIYykG3CxMpOZmVmROxqFyRyYpB0dZrbM5j7kE+QkqQHR3GlVeiUp5fmQrj8sBP4AlwcCumomCR57RSJYSnvUYKvXvYbl9+Jx5fBMqTtyfTjBZFlEOXmvYZotGEOoQHf7cDsMXqwRCq3v94v4RoUWv6tNmUK7kNMEL2VmKQX4+/mG2J7H8qqfPSpLuyRH4Da2G/BHRkQaFs/ROmNgPPF9y6iq7B/s8t/vjnrB636Tef6FxA/CTt2LfCffU7uecXXl30nj/+Ll3OOvQbtidKhVfoXS7Gk9GFZNyq2mPDd1lcTA5RyS6HxSwN5AErU/nZI/dnJSLjanM2jzDOxgQ7CBiTsMEDjz2mHPTrCoaXbgBD9K4PwlDIDOCcHYvYwZ4mkuX/7dC9T7W5hvghebvtUWZIZTBk2qLOd5GysDhLOXhkSicjbSQF8I/9sflsnfsaS1YL3R+Q7V9/bahcj8otOK8aK08JyEeJT8bs5LBaaf1eFZFzN+t+S/nyp0UVJiRE39GU9sxUXfmfoFDWp7yw0Gc30rHUp7FPRiaBsQpt4OMgFnclWctBnypXAqiD8JUWi+FLCWIJPIv7J6s7vzPVEGIyZDP5y6Acx+oy78o5mmYj7IC6HrzTJuosyy0hCM33UJrBloAZLkEQl7kHDb2bUWAlhGSMHGlrjgi2STcrYB7Mfr9JOXcyb0yAmqYOpV/xvWZ9F7gpO+B9MMr0aihB9akFi9v6PfaCP2B+CZHypLI+Yw23fXHZyqWtF3FCYO6hhYA0dl5vIg+1EzCzVB3F0dSZdZTX/r9EoDrh7hflckWTrL3xlL078Tz1wv8p6bi/G+sLqpbN/UyyK9Kk2fTfYLYKqobaS86vOSnh0u7/X0ufMjwGOH3ErZxT5ajJjG8QGKB294FHxdvd+VKzhAKWBiNyp3BdAiMvyeuij9XX6P/zYochZJUqEl7uGOUbfnGQvX/J328ijr73cFBAjcm7W4XryMj52BSR+AzTwVXLwS43prsMfpne7ti251mkJN3Ql0j97zwb1e+1PqgYxo1zyXq8X/J6z+tx+HQ2bZUDhr+PSN2hsjwPbADPRlsXyP7DNn7kTvif4B2F3Ehy73MHjzS6AvKGy9YTv/+bpjRmpdQ3pTAt56Z0jl0hfu4yeFy25cT/CeW4j1vnrdt/SoZge8JbqU+wlTtz44WvyRntCEUDWvy7aNu7zb1e9+f3TnGWm7eZqcEZb0RaDFrwqoPcVm59qw8+61+x7anduByNvH+QshZmOIt0rnM2+fh6/Bnc/qAihosA44Kys5YZRZyx+s8JvIwrsm1tXariMOlabi4BB9sB8fGjAbyy0JE6gIXTmn1oBoNzGD9D7p8+DEZewCzhovyzi65JZ4+KjgAutZ6dOEhPtU/dgq3fhVYMWen9d8Rn4HNv90lBGXCvpCHHOae6vZHevduEvmiw9B/LAmto5LBlHh6PWOCmoItQJj6lFTUMerbsx28sVb6I3M86bpae/baFPNuTtIw0sVwX75Wv9+AhwT9B/Sts3eAQ8+L6Dfj0dgoX/Avz6MZxF6ZYWOOC1N6rcUINXkeXv3kTTa4nIGfSedRC7Qi6ZiXhdXt5TNzOASL66OOJuvW3MqD+CTmgWmVff3uscWd9TZK9AzNiCWmt/teYvu9joLdI/ZEoDvUZZg33SHPVvZbySl6xQXJ9R0TXfc7/o+X8TovlVcVB10QtBNKL8cI4829UuPomSvO6UVOTDLIiu1v5GYxVBUsX6XMYPnbfFHpQ3jae/39FSDhomjO62lSuMEO89is3H86I4ctgfYH/yLqvG/qv8bX5YduZ4rPctejqz9kDQ6+7fGq/GXvJnTaXxQQmZVW6O0sqhK7rajjKlnsm++pb+R2m1nz1vMgkN9ebe4Xd/CPbhpIGQcyeG3tVU3QHjTEvl6LPV+o4A0HSTYrX+Taoi91j1os5bwFem+6nZBNOA9UneEa8VRJWkdR8rHrqCY+MeLjAH7/2IUMaHl92MMu+gnmB0lKeO5Emp3jrUrIyuAfwSY8i+O7vT1f48fbsAJOEY8NwmnoCazZIZ4R3hHlINpULGaswvSFhzNS4La4cdmhSY+11Hl440f0PAxOki8oJwo5ocVJnFYWYXxLaT28BZGoYbVUShhjdgqFzjlTTcyxekCPlA0cZOm+nFpvpc/ux98Ng9r6nW6GSXNksO+CP/7hW/r3y7uM4KoF9/2OdYIv1595Fwjmi9VPA/uM7KHkgzlsbtySlZLWmUfjE9f1vX0VGBier7MrSWrxTmxuwzlw8lAOTwVV8Nec+w+HVHtE1n1TRT8czAjmNNvvHtMXr6mzelO0NCU6ztNuP5sDjqgdpgi9E7NnHHPj9sBQvA21sXke/cKy1cR9A0qGQHaq6fEXzJmRM4K5N083oQcel9wqlD2f3IEL6D7SdlbWcZn24ICn6DhBNL/4QpyelL3k4F9uTRg4gknCgH+XRPpa2vPbhyrp+0vhpz9+YsbC6tBKa31KeaPDZ5tCt+Ln74GLpglrSVhPyg4jvx4Pp0c8PmpU0uoI7njH+wEcDJU4lJjz9rpM7ESvxOtsfcbkDNwcOaUw2kRXRFD40WGT924Lk+VBvBDv/rCqlqlWJU3aLrjs02UEc2AHHm6KbCwLjNBRSqAsJJKsBIO2SxI5lRNaJ4yF512NViajFw9lh3+JSxgfIThDHGYG5+NUDqj5OuzzymbPD+kMe+3/zRoNjQcTFWyXEeOTykM50sjql0ER6scWxbx5UyaKFJnkisTjaEZGRGV4tT3VYqz8pqmHRZsNkN30tKUNZJW0px0yhbxL9bglE3pk2RbyRxBbsh6hZ+Pm9/PrlqWkVgaENdwxRZvP6nRlcU3wJMENfgsAblUlMyxj+c+ZS066TVY2lQ9Gs+O+PrvBVhv6HDqGMLtu7feYPbNFOs65ExRb1rGQV1p9ijP1BF33t6SdMc2OrvTYh4Qx/LdcutJduC8ehAYPOY4/uHlM5FK4AmZNXESE2lEnxcxKjFUxPI5KrRFFgA9HcN2UyUaSKX+8YVzgsYe3mprTeq1psHTO+zlc/xnwGRs1ER4KCPfFdPy6J23Ffs9/P0QaOSO9yzTPbxV6zouKetJulCHPbhfuNsFeoFbYrj2ONHI7q3LJtwwJ6cwIkXfZZ7iSTisw4FIJO30LWRLDxVW6ka4pk7G6lZb0vXqkTlqcX5PanwMiMU/w6UZ9Oodt3E0haROiClvZ+PkaFqa7LA7zdTGuHrM1eptc+7vCv+9zXj6ydwnO4FSa3otQ1lJmd+c+reBQpxaREjNrInJ1JIa+ErKVrnGSvbny04bW4TTxAtV21MeOw6c82kyHuW0OdLP2ApSDtvbFjKsltPqNG1gv/1KAft8P/tsDbI4XR92fRO9uJ5S6HM2WUE91Bx+0OYB6LGlqfhirrgwPKVjZzup++It11yWlVgdEF96Wtgx4HSnu4x9vrnvNfeq3OlkW23a0YrIYMShzWHRI6oCe7SIZ0ALQp/5O82UyhwcfahNfHCI4+THWTafELUMCG510nqPYthRu0Qq78iqFxaIrvDt8U5ItRd/AZJx0Kij9eXktVMV/G4nf5hTRLQYpgnRcfIJnWhDgy9gqhz6VE3V2dSOKLmLwkXnwiiI093w9+b6REoqyekyXCVHNS7UEe9G+kYpnAEU9Gmv50jviL36LxvWDXLbC+4tlZWr8Cbs02JlmamNviZVbP5+ZYlqK1NtjhrR9i5XZ1m3lssHMhPZPy6P+swWz64Rmc6ZrlnOz4U5wJ0M3uNSq975OknIT5y2Vy+m1JLk7nfY8txve4CgpQOt7frmr5YNiwYQteuaCY/gNfFrTNC+R30T/fi28SZwzhL5ZcRPN1SfT2G4196Rzok6LXej321kOq+wog/5ltNv7Gj0LDnu6O5P3NzSzYW3PHI9DAwZ7jDYf+WisJqHMC+DeFQDg3W+wjKgMNjTzhdXAZ8X4xki+SaWevY65YV8GQw/4E/PkplXKCyyEgFD5MyumdhSu6HmFGTj0vCS4QYDZ81xmit5u/LUJ1e+F+kgD/Ztcv1u+ndx8LsLLAh+Ii6fZ2WCT/hy0GuqZKAFlGGoXEhAaoMd7uTpDhi05ulj4FkNFLbj2RPK9CFW8Llox0H4h/E8onHIWrkMpfiSk5yCtfKlFWQJLuOYSxh84KuOQBlHpDwRJxnSRFKl6XW8PcLXC1M05PiXadp3vybP1g3PPgp+e71+Y0DUZ1ky8+SeFP3JhydLObkhtfy4jRRzYYT5MA79AZipjp0sqd7LsUpHPW1BMzgfOQOESGWFc0TXYHuNkWvn5k/iLKaazsAhoR2upSnhXUPeXu8bE7vNYGA/pKFG30t1cx/V09WfdtKEjR29JRUfGpa/iNqvwlipXP+cL2O2bso1Vjr9gzABhaO/DGdFK8ROohI1MSHbkrn5Nsepf+ykCZomyDq7ePrOSImqDxZT8m2V5RV3u/Uul08JEdcrUQ1E4n4hIt/D6lzuDG0Wyhj4yPTICrhYVIOQUSM4nmoyF7en1gfHUYgTmkyaIUpW+pcbEjDCQqGvw6nDrTCoMIalE3LYzc0FpxOqqw9FVWYwDYzM/BIlej2Omwh9xfuTJYaqwTzU93+Lepii5taE/ASEnKrWGCCVFpMTenR5EXHZw2dm7QPIichd/MznINg3iXImwQ2/NN4BVXF2g2GtbclWS1XccnEi5/5Sb3fG9JtLbxJriAAy8fLr3NfrlkgJ/jESuR6QvOpSA9aH8xgvAz5PDsdEJMQ6Hs7XrTo4BjU/n1oQB5l/+fXgV8qdlBSMVTDRJmKzXb8G2Q+Km+4D+gOAk4cyNluagJ9CAHFenRJ4laPn52UXyKvuJRAeP4/BHT/oCF/66KysNHh4r4NxCQtGviaRerzi4vlDurzirTXBBi1frqEbYLGK1lhtEWU0r5Wt8kocRrIMfRmfkxz5/FBY1FjArRb+vAGBKuOWjKSsyDwos08g3fQQn71ZYb3KwrY4LU3itxQg0eZ5e82Q1NqS8inEXcj3OKedyWjr515vbOJea80Z52rbkihaf62pkXv9wC+5uDrRJ9s2z660bU1KbHRxEWE05+zOTVA76kAty6yNk5nCRnN20wtFGlF8tcTOeNrZWM5BliG/JYXPuUrwuUu6cIXMjJTbKN7YOocvWGuhbGWT9xFreTnBzrhsu9WSGg66Kkmu1fvo8F3d/Ki+f/NsDm6UV1RCF6SXwi/CFd5zYFNhdcomq3c7SdGjN7YFgk7Hsp/KXbMzG+KqVx3bzPOiuv60huxH2vWG/sMuxd1X8xkV3enqvKQaLDdrKK24maUsIh0PKDHiqA40ET/i0OncmtNkRwor9KqPq2uSbVgOuy4WooSDGeEBHZGaVfQjnFfV1+sLuvnRbyns8GXN6wKc/88hV/nMJ1SHu/QsNWM+p1Tp+j8w0zaBzFO6kwQCTZHUkqE6C/ZklVePHu6Ti6eJgw9HK0I7lr/GojQsQFmWrSB8NWWZ0DhKOjRsARO2nkHMDzFi88MyksjaFGFMQ5F2JCa/7AR/JYLXmu8f0NKpDE6JkyJThvCRtzJIBSFGTEF4Rgo5h8OiluUqeqla80zq5KsPME5kVZbGwZS5eNnOL7ls1x3swoJfisqCUyW7koryoivpyZGaNI445v85L4wnJ+dFywVxFpksodmo7ifLimYYogJe00pxMDNKgEQdICbEC1SxxghVYnwWl0GWcVNlDG4SWUjDwtl2PZbNr4yQqyLb1J8ufWnlH2tvXuYAbwhvbXB0jMUlyP3jjOeC0RnwsOBsTupSQbXnuqKCY3yrwfV3+GVB0g9BwhO2jJDP4Oq1dZC2PDoFsbXFsJowHhTaXK6ofBSkh2+EJ5uVM2MjsoYmig+nG8al3F9BrONnxLXw64gFd2rQR98G1WabaOnlGjwM7Ke0Na69sbOUQ4PC49uufDUvT0RG0G7BxCW8DGvoDTLgUr+Hq2za0vN/1Ibra33KaaC4zKXsEdPWSig+d0Oa2AyIMVjZRiDP6A9SVqow3fBSRpkYMpNHVGiWvOSCrefmH/3KZ7O7Rpc9AHPhvzOkOQosHr/8aorReidzRPP6si2iTmOK7NG3VJNlbVu/8/3oGNrom5s7Ide/9BKpqzYt8CX2wcvBsFiLyIoMDB0iCWprZXw3nIYKzwYCg/xLoe6XDeQdX5xqmBEKZxofn+yU7ns8ELuFb8pWh/zSD3QI8OY+3C7rDpBBJmTvbp8j8WxsH273zSE5quVbk9C+tle7LQcdP+mNpg0PGF3VP5+A/nH7k9bq8Yn1CNt3Qf0YWNtfymuw3Uckei60kEMvTYselvq0/TV97y+2osIbASCu+5jg+iLhk8ihf1y9l64pP8IN/wIk78sEmwv9Ls0JR3LC6RlEvL4Y20zJYKpwe1EqeltyALLmdN0vLoN40saPSVU8katnbMeVbiKmDSWScPzrfj4YPUAIJsljRnwt95J+7Sq//Y136aP4RjENRFdeFDfjudv8XVXdt4+vd21V3XXydQwCbh7DvbjW/musrvgG+AEBPkeScQjnx6QOaM/TeDSIYhz7ftOjqaCyiV+v4bEgRpcsQD1dgXR86+8cgQNkgho4l99Mvw+lCZXqFA3iVDqb99zila1u7+eX8tkuYs72BBQY4qnPYV7lXV6EA82TZ2bzd606ewHqm3WRBS/OcCQmG87Z9RYiNUEIMN9QCKWeFVS47Ds2Ojz0nvJfUdEmXTUNIfvTBh0WPQg/A5lv22HY1uWAyiiY7GdeKJkixN5ShMD8K5YDqqPg8s9f9kzHnlMACNGGYNRWQXCsrZWDgAddUOjTDmiQ4SEUCoM/LAqCdj6Fz0K9+0MbFGZ76LIUt4cZZJZ/CAeuQfzU+tTzxjU5o1UaDRBbhqUlkWxRcFH8VtzHsL6oqD7Yx7it8cFFbJE1EiBc3Br3l9f+p+imu0/JESrEg+37tgF3b2gkhleUjgyPECFrMLzIiKqgXYSMCE9HVmN4pnCyjkopIedLh0LVAW/SbBiaQ+EhSGECRAqaExb2S4oAEUbiISi/6ElkHQ2KoinYQ8f+N4cWbwaJt/16RAh3+rcuPXgQ7fKvX6/KAmw88t6Lu1NBmonH93NxP/z55e0bIcOFx1smsQD70gwpwUmy0/nvGnt4j1iS3zoCvgzU4YioTcvrKln3TwInXdfZy2XMWgDmfaX78jCDh2YEcr3GcElwPAy24MVh8wSeGdrtbyQ0AkDd6JsJRdSAxz218O5rUyLiE4ui4sVI0+LiFjpRaQDxzAoc/5cbEJo+bw6JRbt9SfUZNBSPJ14u26q4PFC8nGo2F9vsBLaWvdlft3wwXOHzJREdG2JGEySu6lz03gbbXNqd2smK35n7f4dt315ssxPozHz/EbTN7c3NRaslrgQgpraKYN8mRkpgX1MkO5Oyp4yGzCpqXukWNQGWTeonAGewFFNb2lOeO1BE0c8uP5bJ07lA9LlL7Dpqwg2JiG10Pe6TA0t/WYQgiVBcND1JH5aoCunJp7ha3dvcJzcH1m4+AXMlXo/tTJamY8O8JqwP3Zsh6BQJ/SjRd8vGbTfPvsAhfwCKMZVMcdI4a91yVE2qxT7sZUFJ3rwShaT4rwf3U7cUZHCy/5q96az5k4FzEOJnMV0fVgxNlvdRilTxjZkSciU/S5GcziDS/Pc4PA8lR3B5LGWCUEDVp0bhBGMyBCVFi1qWeiEeuPMNSMqmD7qcI1j/PhL5/qbvmZ0fc3PdXRs004+fT1Cxrk4GbD6TyuYw0R9++0mKAjfQCgTZN0FqCt44PebPqQpR+2BTmcPfKX8gNx4g5LpbA6sf3xgMA/8Qkun9DUa+zfqe3HTbHA8rZPmjzx/Qy/b+WSWaO+9JHHiwf8uNW3euw7ZEtBttrb5uraUhSIXgndBaESqTuOuTa5tkJMBT8189sOdUgKv3KUMewgwFDwE8Y1WC3XQt224mqmYJFO19tvsZONgo04UF7Y0qLOh6Vgn4VxxqgjSBdz2lrH6TuY8lkUrAbJ4GUimrz2wKSCUETCaKgFQqpqQ/HY1p9DMw6PqmhnULhQMW8CL7QcWEdeXVh5c7qeJa61fsx6hvHc2o79hf67bFdhnth+d/41cqJoAMjH4rU3GvTkYT2+/3/DXqu2YH6lv24161MmrdPUX81rtdoZsDgI1+DHoCIrQBV7RNCDkOBSdlvqknoMdSHccrRt0cmJpyb9YmeMeRjN6kR6xOujeFSiLEWB0RfwklE53OJIN4zSJT6YjOUoXbBXG+kSSjL+mlXSq45VyBnB17jZ5U5BsMBOH2LJWDomTKIWd8ds4EirT0w42+Xyr6uhSQVdSZdAVSplun1ZmP8lwHhoKj6LPcWmyBnAvkv8q60Cjxogm5cvCDyi39TQin/eQujkljlPiESauIEUctmrMOPX/wE811cdrwCMbhkB4gfCLn4KZK91wGo+Hrbga3WWDayXxwEJO5wL5LYPf7v8zlIIIPyO2YZDCA5J2PbfrrwQDoh/oCYUVCd9yj0mgxlfBRioAow6vwdjaKKcL9+KGor0U1GSctE559HkNyk0VbIq0UC1MmpOWmKKVbVURIcpFA+XlAg3Y0Ju9gzKmC0+gyeN5VEiWGo4y1RlREWWOVgpg8tlzTkhcalFqSqYnUyPvqTDMpOi9F8BkQ7vSFovC3t5p866HkOmvqiFKbOlxnPhRvjQRs2wtdpK6qby7tvF2MOfboUv4EZKD7gR38Hc0RqYGgmUeo5sstUew4C6aApMESOCIVEKbUmckcB7Y48D80Jec7LZ9DB6/J+bcGHny9nhBfdqCAQzVKeSZ8nG1JyeW/ED2JShGM7QjJeZE4odjDaC7OmMgXRreqSmtUPFSyNHFMlhHXbioYZWbLO2PzVcyqpKog198TvvPmo6JjFZgBlIQS+j/+lMHT7J1T5FHGXZwDGAGVoNbhc44BcD4bWamZranZlthugYij5WAez8Zo/NjbfAUzNxXcjMXE6OK5FtKoblD3zvTgJb6j7wfLyeXoly0TieDC9E+/7XtecNSxSR8XITjl6X7KxVOqlZ++XRT1eiXzjunUUPNk7K2EirzXwvZJu2oLd8adK69IPlfWu8NNLTGKvSUJ8n6BPEkfnigKMbE07MX2wat+bTwLZB3Yfbz1YPGaCWdBVGc8yKttTj1itvCONjcd5horJ7n9FLbgHy7ep2QJlJVeTZarIhozpZFNmUprZGqcwFd4ItnvVQz9UuFcT9X/YRfpFssluizX2xM6R79kMZtSMNubRl+iL1be0xM2S79oNmdHzStGa+2DWbNkvX6OPJw1PyxRjpLns/uMPx4/9dzco5+lDGbV1itGow4Bvdgn1dkKCTFsXugf103rOjI9VOQoKO0uhXmMzD+W7sJ9f0dzeIQsN+5rDTjLppSbXeqmOvfmVTTX0rCXJHDunXpWeva7tu9WZGOlispK14xzssuYAMyb52VuGZXVijL/7xaPat21ttzeFez7zh8477TyYup12voYgaAhphvE1vO4sXUNGmIFgvrYojF1bexIlEgjQkdG1kwNSnRmoTK0kWbtp1JgHIjGKz5XhFKpWaH3QhXR1FDlW1l4XhIK/EO8UvDT7t3LsThWohm7OLXHP2dy921cSWIi1nJ3z57AbJFX4tLdFuyV3V01k69Z/sXDmt/cPQmIvXtCsK3g7vDoEbcKXg3CQFUKolJ4Vi8fkuBhITFz/Ppt6Wuh8QGLiH9w7MJ+aJ8PTPzvwSaD33r9rXGmEnJtgaVzHG4UC4Tmd2pG6y7KBsZ93vftGo3t85Hyg7g51YJoOsfZROYL8HkJsYT0Fz4EX+4OtnNB2GcocQQNJ1yRh/1SiU+HDtGrlXxHXk7S/trGw+IyF8+ZzUtBXJ8v8z1vhaeLGUpHm0/gS0eqgcJzDQ0QQProZRp2e1FGwmBl+QF+sZN70+ZpKNfrRpzXHuXxSE4K0meg0x3jCsICHZnO9gSpkTSgme1ba4Le9kwSXhCX0/7TE7APyvX9Ish9r8zWnbpSW8251LbtArXByXM2cF8Qz/d7D4/uNPZDx48+Xh9OPe+k//XYCz2X4+cJFwLqAeSGZJ9zKGOh0jEnrJI1k/J5/Gx7OmXfOjNyJJYbSEkriEiTYir5rhdEAW/BejbcEipCzk5KHpL4HBdeDbhhR3z1mwQ/GrcOltPjijWlmhJN0lLSF/bzRlwch5gI34l0s0MY70W0nkNwAhk2T8QuoDlv+4Mi+IO56SWWiEmDbMEyqkM/8FR1WOmd+zgXK8z+w9GofoRSqA+PS3Tom+6xBd97ZUgySTPplvxZtEJ16I+sTI5yloiMgL9xb0kRMLS84ldZdUXXy+0KuHJlV+DQ2ZlXVfm6juLUwAuXh4we5fU3s7jcCuT268KFBaOHudFAXj/vUsD64AAd6Prvkcmvbp1uV0UJ4yXHbTsAAQJSTh+T9A+uuJIS+AfcPVuXX8YpD61KSwsr4Kfko5nxuRhhAlU6s1Rjn+frHaGYdFj2x+Xl7mfYizkTBQrqgLKlrP5yc6dD6UUCboQg7yJ5Nj3zVjpFrypM6Vfx8WVseW5855D88KnKKPH+ztRFlql2NqHalD4Al0swnw/dfVNJyku3xigzbTSxlFhIZaEKlYLRMFtnLuhQVHAIuQmppFxeUh6Kycyryb/h1or54BO8HJmS5n4rr9iPCFlq3tfcCrlF88+Jmtg/0g4i863VsALbCU6tnbU/v1Z2frBi0aEyzQ43R2gY3sxMBAqCTica0LzMRqpGT2mR8kjlssz6CDmzzn+9Glu7/8hO0peJa8CuY4qK/6mKSDh5575SB3FhZvPib29I0/7NwH/5hT2pTJRhJXJEVdGZ0jBLPC+yIjPTGp7GLY+UZkaUHvgjWOVevo0iVY4xKq3sPaYCwXxb80mBteQIr7GZfawkFVoVWZZiLc77Y+Ob9ALQU2t2ayYuuJXr+zZ/OiVf8vBqRR8Qb4BYINL/C/WJy3c+JfFLmfRzZGr7Jzv2tzl6+iRsKy2JU6NWsBJqOJy/P/vh3ELOd0Egm9scJWPHqZAxdGXIHIYX3lFP7iU44iNU/IScEFqiCn49Mv1Zid5qy3h17rgc5vGr78Joj3K2N1WnBULzuNgJGm30fFvwBKjh/KhS+KvzTY7nI27V6YrTsYFpUXu0H7S8DZgG0SsoCZ2YF/IFVgq4tnl7TrXMdNZ2TrZM7oGOR3n9eYDV8bDIaDYXFJpMev1ma6pWW1j4PioqAqZH1/pXIw5pDIVOxhWwy5IUfnApCsh0l4Lb2/u3G/4pz5k8tZKr24n+VzXPyznYH2tBNzQHlX8/bBBWg7ZRUuKKseawAiwFXfEEPhRSga+hcRklWFN4ATZKicoDmWzDOMKnrwRYlYDV1cR3du7aZl3/2vQv/g6aclxcFjva/f80BanGW+LovA7+v0z/vLLM3u6NNF1/bPonqR2Yr73ev2L+9Y/03enjtl0DHfnjTeDCNZd+F5lr1ldDw7wBF96dDvZz0IpaxBrf7PALngabbyM6xtviBPhQ4sKFbJ9DC//iL7wNhcG/w6fsxt1s9h/9rMslJu7RlpbXBJXlZ0QdHU6nSrzXLLDPw0RL2+pOqtr2/5qb4zAIqHxHxVTm5TDbSahtfT3BY2pvktfaCR7bXO0afaaguSdrhryyKfwU0DmES27IL56VfurfVh5xprijXtgl4CUK/1noogaUeZYybstOm8ebyvpZ4mR9ZGJ6sIFpyjt9rOt9+3iMN9LHFrCLkK0hEIQ4dmqCvmRHKEyk7qw2DMRLOCVx6U2k9VHMnxQfGOBkjBG+eyaeTqNVkWffKRAemmP5fX2ytvmh6Nd8AuG7V/nFXz3v9LWy0dS6mBaJRHeh1tuoqQk5uEwWO/POHdFmsnJx8anV45XLe8QaXTUtNT73L0YODmyUcOuZhVXCY4E1+whZ72hJSerOFH0FeaeOg7UkCdQEl4VLiRkR0mCNNz1nIqNkKOFOx90Mxfhvd2p2+o4llJQl7i60Z94ZmFj0sVmndFR1IOCQbw3ebYkSBjZNeHOJzwIp82eeFs4OY347WRKa4fkuhzhqmPErlqRF3gnNc+W4ES5fpxZ0kDOnen3BkTmV+JzMqr1L2Fcg9T2Nnzzd+bNzUqIjIlEOW5HtnB7ktuGID7cGB6Xb+nRZwuAysOkuhNLLb8UuT90GuzpAj8WNuNTsndpv49P6R6ZlN9f/O1Xkw3kNGOOvOot9Hqn22vjcCfG/7zcPpx3C/uWkH2I9rjVk7TIZrSD8pY13d35oJ7rdTeagTzkTIJ3q3U/StJXtsOsbPcMHAUjG/bPxt/8vP6OEQVgnvFHyhg91BhjVfnN+27xR4D1Yd/eK2fQNzZm14rVta7kKdPuezffQCse8I48S0h848K7CV+X0efjS3Ne3m6fktlftnS3cCKOc04hRb/0zpdctVLintXQ5oX7r5vP7m2K3Txq2nY9zWNi7nSN7gJBm1VGJ58J3ArCpFNVRspTkaq4wyqaQVUfwRHaaIiuyIkkQViNRVpGx/yiTX8o1E0W6qYTaCt5MsSn9WEfx2fTKitf4jq3cs7aCrPs5TeXfv0fEzgC7YmEVSSIMNyaLadYsTjk6KUn0WRyaVZwcbpSk29linmUbiGwOVhcbE5ybIjRGCoMiKcLg3JhYrI7NYZfD9F7i9jP1ocLJraVnEsrL6dZeKpgsGHpXUK1oi6g9XCdP2VZjmUk0Fh1IbHZumbyu9jDQJKSp6T3anFoqm2enqnOoPWottVedKwEe3wKaXCra5sMNzvXgIIjEv36J53I5wTl//pAxc+BuwTk9rXtnRTs5va3D14S6VcosNb2bygR+Ld1N9bEk8Xl1VHUurS+YkOe+TTbiL3Iel4PM/pMCv+uywRFfFMVJbfFx5NK5RdQUlLf6i2Cy/5rMXPNPXjNT/NdpvO5PAbEd96fowkoa9UYVKePr76GUn6lgpFOxY5tq9jycGsHm0ZVUYRJVmUzG8Q9Kn6j1B4s8B3/B//OBD7OZNg4qncZonBpUCT5omu1gvymCsJvX1IldK2GfsTcUn/l9ptEENZCy6byvLh8OsXMHQ0/YpgVx25Bv/0r3ufbmKS0piuH+HBX8MLAmKNXQTpFmY7q1ZBPl00ZP0wb6Z4Y4NOedQMjq07QN5ae+n/hqg5qeKH79kVA7Z6i6Slx6SMzccSAUpfw5kS5Uc4CuaPjOB4n4lB3VHYHE+gtnwkZfODYG/mOiwl/BOUriB8f/ao3qj28sX6S7KPZVLWS+HlIGaH894B/Ov/v7WXdrbZQ/xfAZbival6YTh5AP3Px5g/SfcSDJalmvXe6I0GUOfm3uSRed1wkfAjZyhuXAkLMQUk4em7D6bSrpeY66mUvJk95D/+kj2nJ9DZ2VBwxAXnjro9dq4Yi3Ofwz2loXdDivbw06jsMZ9BSO4nAEPJgQPWDXPxGqW4RmXIm0X+kPU7god8+QwTLOos6e1HTWMeTkYIhMjXMx8Cus8yYFEyQXqlmOPfcbnaenlinrXeYNGCnZB5ZuQDcWloSBhaZwAJb0156SPS64AOzS3Hdg4iTf7WA773RL1Jq4eU7DbJ8zk87xtrgmdaxn/894imNjy3QSNj2bmNyY8NZWPJucCZBZn1B/PIt7/kzONG46nYI6lYXxLGiWZt3SwUQfTIUOqUO07bbbrs+fQRdV4Tx3enon7+V0YI306YjFqnuxeCs9eDnNy1Gi9GzxGp09eE6w1H4W4pfm11s+/UhfNlpUjvsY7x71RCdBVn58NIf7nE1MK63p/xeoxBH9ecLFfuNUDM2oCSFFjjEqXWzCG+ys5n4zZfweEKXV1l2TGxyQJ0nLhel7hKEZeYsIOcaoXNWblYW3CzRdV1RxtU0V6aQFCF8NpRMp9uuq8jAXEL33ilPOeczVw+q79cqX0NrLsOm5gPDdAXCJkUmwTbZpZ1xPTNQKkbqkZUPisd9qwdCMWitS5BijUmWR3Vb/KCZyXCd+i1yRP4cZWeLoeUDyMoBzKWSDDahElK7TdwDDMj4VpMgxRmUWm4eU86Qa+5EOhmYUNMVFvQf+dcr4j1BcNIr8i5jIHVd1e/tfawIB3u0w8YATzrjgcqxwh9UlF+gVEKoKGZueQQwQIkOBUlXIyQZPreYzKQ5Gxj1WhyGWBVyJ93LkFXpiQyZ9sv0bC6t9r379UGMpoJbvIbxHROgVKJlFDq/3xvMhF1YWqEvqIBLXJ39IESJRiSR02x8HAyTIUKBUpSxtgOypU6G24Cm5qnTak6UXeJcFDreS035JqFC4+JMCQEdEw9frKfAKWy4Ib0UoggLIJMisuHkwNrVMkzrhOFmEIiiAlGkgJX33+xMMkKFAKYXRriOlPJXHXqsCA8WySkKNKHZd1QAE9obJjhxOAq+nK+BXrdoCbgQKF+4CoAGwgw2ngfH/1K7lyU//EZLkd+4rYaWK9WPkcf3+yir+/nc+r7Tk3z0PfgD9SR7jW/Hk9DvXBWM655kRF2PyUmRkaaFYGwh6TUy1hK5AEfr20ajotxSX0HlUmo1M9bP4frhR86fIfiQKBaS1UVY+eNvA2Y0NzuqM+32vIujv9ko7I+KSwbVXhcuMkd6LwkFwKeUNZxg9kCnZLRBIKjCNVJKDFQf9YjLOqetJrtNdYrGnu7mXSDhEe3Mfh5yhXULO5rhr/+gxVJbQt7MOG4C0XxbuwibPF8wnV//kRVI8FbTEPDWcNoVpBcyDbEkB8lAvMaEJbPL8JPXDkIhAK8muJbbLcQ4A16ba5fgaFKVR1YK2t74ZRIem7CCCFU7u6PmirDhQ6/EAQq9RRUE4ugdBa8fzrZK1k6Eb9CcSQxutV1xrydxdYqH5vJSm4NAtJ497kBJc2OT5gvkk9QeGRMgq3eqJ4xbYuqRbyWBbQufifBoMnJP+cY6TAqMspaQsblkyDFd0BbnUJLgwcA4czCepPzAkxvP6kUmD9WNZ4MJ08xMUwLiX43BhANqQzuJgsMdOgE0JPTzHmTqGxtgzjPu+x7vivS7HGcYUJi98GRMYBkPLZ8+STLpgTOxln4L2Ss1lwWrjW8zBMCsdlLG19+Q8WH7QcnJcLQgxqS0iLJ51pUaBEmhFuYXSvlwxdgJDw9YUf5vrooCIXxZqReaQLtkA+s0cDwyD1JWGbw6CttZBvlZjYOoYGmPvZwR3A4gtnAm+FqwrNtq2BjscLKEL5TilGCwsUwcB1nbbfFpRp2PEJJOZsTkOS22yw3TI56Q152diz6GchLpDfiSjdV6vmTRvTFoFk1bMpJU3aRW1r8R7Tr5xZ2iMvVDivEc/2rosmChamqBN9+8pB4s7CXSXJc1Ad04Gtw6TNBiG+RMCQOCu/zJgLhTyWfwHxNcFwK8/x7ok/aJceFXdcl59rSewBxPAwwb+XyPgB9y9QCGm/3TPnbTLQ5nmmAOAdOVCcObM7lDJ5IFXaSLMRvpofiJg4SvNC5WkycNC83uung06QqdM6IrsO8BT0/zAEZaBTdFl4zwFlYVtFGw0u2rhY1bJ9FwpHE+jask2jNRHajBp5qphGa0rF6Io6RQgNB5A8YcY9kdshl1MV/wRbqQv8u5LAiBaSkXQjWRZQ4bX0CCWaS+Fmn23nPrQ0lRHxygQQ6F851We73XhW9rzHSmBx1uCF1s261EzejSLn3YGrIMvqBU/qxFsZATXC3Mn2gMZy1u2h7rag2rzuGpf/NCQSkSCqHZD20yh8uhTqUeKzHkp4IgUjuAa5bhRHo7EG+VBr7tlJnTjHPU4QzWu0sowUeM8tXGBevpSu17E7EUux5wapJvxEqFlypPtT4gOdmjqdGZxX3RXENplVAqlUdvkKH40D3/y9uwCmvaUhojyQIYCJaJtumQRQ+2Tm4BRIrU+4ccRq2Jtle6CJ1XpmNRwvrfENcgaeyllOykpuMBvXxS3G9LyDpZtSmSblBC3RUosoe0XDIteT3ToK+qBa+IgL0Vn17463H5beDwjnise6A9NfWW9kw36ixMRboSIQHNbR7Rz7tQIxL9XbvfUMuhydf2emCwgBFfRML/tURpPS2Yz/1knfpayDGfJu/y7pYASLApGpHo6dBmyMCR08kW65J4QveLgzKHLNrGtihidKqboqMt+e6eHy47aunj7zFQ29FFyQ+qo4NM/Y8M/ppFxhOlLLOqjiTFU2rCziibSIHb8OdxzxLSjbz8U067YyawScol/TgrMs9cYS6fai7qyUfG+Lmqbaqj7gBubYBOYt47u/QCjAFN0aJjHroXjeZPxeP8mQk05lAkQm1LQ8Lv50KnaGs2Ty05Za+BrKnqZBnPFPL9wCV5umbqMHOKYAIFnKVzjnZdtmQIyLwWIfQIAAwUILMwMkI1GCEzMDL58rsF0IW4ZfbDfQ6D8yGqBAfiIaW1RtEtsY9hR7YYofy2/UjiW6Qh5BkBntlRwTQBimvscjXEBYu421RvjtG05uGY7vN4IL/fSjlQgZGEKIBMFRHsdcz5C7DU7UGYoWwo6NOZJm62NeiBqn6/BsdZ/AhQsVpcanrUYuwUqpwjRRoBR+pjWhOYe3dIE2hTdHPLxWc9r4nGnYDhBUvQxMe/j3cKdzL2gnlXua1i3cTaD71vY/vv/C1cJB6bhnpliuLfhGG4b7nkp/hEHP8YN+C74f8TDSWpbeuHh4eF/JMDWQhFgW2l7UXbY2a5iOBrXGM8EiJMgU6jTu2bAnAUvHy1AWITFJfdJa++GFzB8QYFgBMVwgqRohuV4QZTklfhV1lKsYzSZLVbb7oE9PN5vIfNahbkQWBpLNppzRruXT43rs/iyXFtB1c7DwPWIp8fz/xStqFI8u9bnt1NZWHbHdISoIrpjqFy653WwU08/8XUqHz6CIoxYngZ/GORr4nqnYDhBUvQxwe/j3cKdzB+uDKc2DWfqXAVASgkgAQAAAAAAAACklFLK0F5JAAAAAAAAAAixFQMAAAAAAAAAAAAAAAAAAA7s/8ucgsnC8IDF+hsP2KUFucX3QTaEOg2DR3iJjPICjYlVNq4xngneEyGRKdQ0j2ur7Ra5jq7edKcZMNevzYrcwLLJ5mgu3Tx5Hqq3vf14+29Ki7URbWNNTWwts8asU9udlcObo7cwy7OS13NOr8n67rRgOEFS9Ak5WPE7dGEQFMMJMuUlzbAcL4iSTm84eelXQdWat7Q1fcgCvSAQPDI8CoYTJEXPkG3m5le2IKL0vLi9/Xj7udDVotHq9AbjyUd4nbj+6V0uJUzp3C/99e/x/9u7EVhkFAsaM642njT0dJvBm/nLFc2wHC+Ikk5vONn7XlC17bB3cHRydnlcBz3V3aBt0DboOWy+oDTkyUa9Ob/4+9Udg6AYTpAnD3+/3VNUbQ9Oe8TTIxf++fZBRXIAEKykrBJBgYzSi8bEUo1rjCeBiUgiU6jTNQM002Yxr5GvdUERFoHFJcobaip1r5zOojWtzV5MeV7yOhh7DeQOBYIRFMMJkqIZluNPYcAcFwSCERTDCZKiv/+1nu+eT9XzObObheMFUZJPWdZVOY2rYzSZLVbb2Rd6HThd517x9fB4J5P2nq/na6+iLgAgWElZpap1atX1a9S0q5YrIdZGUCGj6NCYWJ3jGuNJoCaSyBTq9K4ZMGc5zZN81QtEuKixmBKbGjpTan1/mtdf5nZokSPHTso9t9j5WGunt+H5VETb2772emLpeHpe7V4Hkf8y2DUgBCMohhMkRTMsx58EXMdwCVzqlxVV0w3Tal8hlB0/yBs4FRJcBiEYQTGcICmaYTleECW5EtS1ta5jNJktVlu73eF0dQs8j3dzI6+G6sxMtptZMYLYmG6/iylIAIBilYQIWSdA2NVluiufP/YrkQwAYO4IhAsAwLgKhLKcmHAKAAAAAAAAX6rJwmEHfY6ZIioJjQyjy6Cg0GPUZyZINJYYc6wachn0Pan1FBgcgUShywQ/xl7IEnA5HYyTU2cloo2IRkREREREREQ0ItqhfVAFAAAAAAAAgG1Gd6/OMN1D2N1+6xSgGoyatUDaOnVVr9cY8Lybf1SftoEY7mVvntX5Fi6gyEpxqaqOYG+/vyZZ7hQMJ0iKPibv+1fuV3HNdTfcdMttd9x1z/3ngwNVVdVVH9/8XP19ex/UR5989sXXx7dvThaCHVIT0YX+je2cuc+BmWOOOV0vc8wxxxxzWKo/ORO/Oy0YTpAUfUzw+3i3cCdH3gvqmcTfpzxmw7cSGIZhGIZhGB6+WcAwDMMwDMMwDMPw108R5omiYiwJhhrUmrX0a+vU1b5eY4D+BmxoXjaa3lhaYd6S13O71xh8t8KdzL2gnqIEThcEghEUwwmSohmW4wVRktfSrWM0mS3W58X09SqMJRwTSWQKlZf/LBCWXM/b3OfnX/NLqV2V3bs9PN4thRRGRERERERERES0fA3Vj3B/rHbVWdWuSQVbK9IAgEHOD7aVUFYJ9QkMHuFNZJS30ZhY+8c1xpNATExyZbKmKKameaO2Nd0ydR109aY7zYC5fm1W5AaW7eM5muvhPMl3awHCIuniEpkNZTSyo1RWYyuaON1UeQekZc6YdarIWblDzeVtoZat3Gjt9jb0DPTxnOx1EP8vt5+lo6unb2BoFKgBIRhBMbzrzk/oJBTNsBzf2MozOfJ/eWqTZhY3t7mF6S0t2Kp9QvmXI98olrZkd9LDuGdWbmrFM1YOr6qtLprtBrT3l2f/srgfSEewjld1gn+d8nhdiOkHcVEKvAcVgiPvjBL7dFwTn03alNIzA9n7czI/QeGnYvHSruLWyrLqIFej0er0BmPTDLPF2jbL7jj5Qkxw/eSNn/ov297LAwsBEI6TdHlP14yTJEmSJM6Edu3uxtG6KVS6LJIVqgUJuTqdKmin155pO8uenXuOeO1G726vowX2olLilxS+9NrLTrz88iuylKxNpyJAdl0nUY27TqSrcK9FIdN1CXDgCYUAERJhXot3bQWWPgIAELDdSQgQcCcBTQAgYO26Cz0KEFgmhgQAAIDAsjHFNHsloLcENAnIzRXmb2FAjdrtI8RdJ8gDq2JlWbXo1Wi0Or3B2PSa2WJte9nuKM96XluPwdM+/z47+NUrrN0ghlAoDB6BQ6LQmFj4OBnPtAE6g8ma3ZgjTcbKZt5mryF+yx0LgmI4QR7letcwLMcLoqTTG5ZR1DW2w97B0cnZxXUPA3sUT4/nraituKQAAAAAAAAAcCJ7Tr3nxyev0lUSza5JBT9WxACAz/cePzeDbSVRVgmVD4NHOIaMchKNiVU0rjGeBBCRRKZQ0+TWVtotYh1dvelOM2Cur8/3Ko/m0z6fc8wpc6ef551Pv0CERe6LlyxrQxrRlsIae9HE0WVzb0anLjor96C57i3MspWrrfVuw565fjxnfB1E/svtktHR1dM3MDQKZEAIRlAMf77n+ZywSSiaYTm+saD69+Ap7wQ0pnhcE66QKcemybg+S84pfoHioiXWoTeU556y0HuVNWnmoXm8lYXWFttAehAQgxA84jcKhhMkRc8MZOk5mV9c4Vqxc2lXoV9ZVh3hajRand5gbIqaLda2mN1R/qIzsMv+951bxVpdJedaBReC/B1KBIMjkFHuojGx/hrXGE8CikgiU6jT4mur75aaddDVmw6DuX5tFhtYNumckkszzzsfvgBhEYW4Ea4xdRMly4Y3o1OPnZVrs/hYefY64HV7ZfeO6OrpGxganZCFtb6P7hgExXCCpGiG5XhBlHR6Q7lYUbVm9pun21gMEtSAEDziMQqGEyRFz5BluePXegcpdi/ZKQ7mD48kfmqtuOui0er0BuPJh3efuP75vSb3uV6RFgAEKymrRGhERlGjMbGU4xrjSQASSWQKdXpXhp6ZNstpnuRTLCjCRSymRGVD9aWel9NZtIa+bV4Hva/BBHcoEIygGE6QFM2wHH/C7PcIsUQqkyuUKrWmI590AZuAZDcKhhMkRVcolKsmXo1Gq9MbjGda4j1jsZ5trffO45hsjTwN8pCHX5AH0kpICki4CmDfEYhWtVKR14cIH4X6CgaPMA0ZZQYaE+vncY3xJHhEJJFTSKeSZnFtP+wWOrp6051mwFxfZ0VuYNnS5miux/NkviwQYZHd4hJ3NsyyEUt3jZ1o4mBTqg4oy+zfjE7lOJM3J9qCLa2V/NaubCM907jnB9YLwMdL4Osgyx8eikEgEAgEAjnMIDurBYIRFMNzR6SWagqdwqakxDwfIXjME546lufEV0ydme3cty6scelAVg1D0xJh7FASM7WTLDkW77N8Yaqd9avTyL6bH8gezr7koOyPjwZy2nlBjJeCHwoeQEcWLJJNoU5hwBWzIlW1BB0zoAXi0KcZVhnLknYWj1MWq5zUh5oGWjp6BkanUmYWVrd32DnMT3h+mcv+f/9BcX78RVYAiLvGAMNWRCRkFNQNgGEzz60EhdDoidikd5ivdLgNtdeXWgsCAIDmwzmlalqKdBiZmFlY2WqfoB1wcuWeKQ/jTSbQZ1UNugXlRhv4Ee1Q55evmGHtJ+4usGANsDde1QdDzToLDZuO2fKHiM98hLxvHUuD7UZ39rnfh0y1OnVoEb335dokcCXvzlCoNDqDyaqdogUUK8/VwTjPWWoI0Ui8Dd0zsTf2zknzSOhSKMx3C+IMk4MufwBcMBlCszSkGbk5rVqnRp9rBgtUSrk29Pcm0WPsLbhaPchrESRJclx3X3ZB6Eia63MsW6k1mamfMmbPRsRMqMw/cg0KGa5wJGZANe1lwPc40Ftw5cf6AJFU2alfi2JM5IEBAPC1JQQzTQyBFDbuOgmSpEgn004WKHLQrvBYcMOiuCUxy2atylrzMHWj4aqLtnwm84Hse3Jvy9+2PZcOlxBzq/tLWP9B/c7V/8Bf/vPKG+/57M0XfNeWt+9wnHPfRIZmPqUezrStmbCeclMAAAAAAAAAAIC0FKYBAAAAAAAAAAAAAAAAAAAAAAAAAADgfr8K8Hs/S9Vii1y2q1ePSR9GYERLfKU092Sgp8DgCCQKXcb7vz+ox8cJn9TxaXReLj57xq3CwhMVvIGUYRiGYRhGVZWxba2KE5sKeV7IiMVQmRY5+BLO37DWLLjyjRYgkpJ7Uxj1+UvEq1BrLNRliuQqFTal93tjSu+3RyebDX1pebQJXtvpdTERERHRaD1y2YUg54maH89+smzbmm3btm3btm3bto0EAQgAAEDoWzZVRA8ACFZSVlmKqnao1aRZqxZCGkE3kigwGjO2d1xjvNAZzFlSTr8FnWNaw2w+LpCvQeQOviOBERTDCZKiGZbjTzq+5rsIvF2bQNnxua/AqUTBw6wHIRhBMZwgKZphOV4QpSG3bXaEAAAAAAAAAAAAAAAAAAAAAAAAwNHTEeuE/fZV3UPB5JcYBKRxd0mQJEU6mXayQJGDdhHmkmGXoa7wW3DPomuWXLbskVUzp3YZ6kzCQm2htlDbn6mkMh/Kfir3kfyC7VfSKZfw691O0R10j1eUukNAECgMnnggEpTFPepCfZipf4QdLrPWNl/vcHy+fvtcJaJm/TaNfeNWqoMghBAQQgghhBBA4LOrqqqqqqqqqsABAAAAK+dL5f2Qnol4Da2GVY/JjRPnak6Y2hZTM/gOuvoB3KJWiNzSyDKy5e20jgygNagCtbKcze7FJHqHNyFI8ElcGUXVdMO0zs5+CZQdn+MKnHPT687jsX1qNQBsKVvHFgE2vbUAAACRuv8DAAAAIOo96gIAAAAAAAAAAAAAALoaVVVVVVVVVVVVVVV11mEvI2YctNNkJHL1CrvNGNSgy8Bu2NUsuPK9LUAkVR5uhVHz6hQa3q+ylMAWyZHIhMpwusoUKtwC2kDTtIWlTWf/COh4tRtzK30GDPOyS0+DvqYEGHlGbKouR735HXK1/svAbyR1o8DgCCQKXWa+B2wWXOXJWoFakynbFGZ1FATBDSkAYUIZF1JpY50PMbkz8jPDdlWfUbOOo2HTn7Y8FbHCc93+j/ACuD8KmPWq935tuFMQCZxUS4ZCpdEZTFbtsCagWHl8C8bJcSJTYzMzMzMvL/mZwy826VbQOwO2FyIBdRXmItwLhIOksO28s67uIDfCVTudTyHx7/0WJ85heldqd5VcO2MAuveSc8JwuFxERERERESWRUREREREREwuWJLkLFtaTQ/wxMUo9WUoMIJiOFHsmoTa6i9LFJESLyIiERm3YDPDAAAwM8MwDIDAQwxA4VTq8pa3KAAgCMVTrJsvc8R9T/V2T0y03Q3pWnQ6Wasn20XoadXXlaAYURCTmsOiB/YV/GUyiempwuAIJApdJtHrBs2EyZisuyy7UOd6wLJ1zs/TKqaWPmyRmxIZ0D3fmJ5680jNg9Fj1kcSGDERm4LMsWpImZjHpKfA4AgkCl0my2PsLbjKKV4PWZhm+qObTJlmdkEzG7OvtWZx3NkTTBtwmrYwtOnY1Z9eIwB9BgzzsssVOAmXRr6uGGbMZvnIPkAk7Qk4isMs4NqQAhAmlHEhlTbW+RCTkpHzyVRMZYalDlskV2I3VIbUJdvrm24x7At9zqM0TzuCeZoQJ20kH44euAz0nsT0tMDgCCQKXSbRY+wtuL1E5sxnTQL6KWP2/BSxHiqjCK8pXFbDL4O5x3ZvBVe+qw8QSZXH+sKoz8+FpEgzpa7dpRhlvp4z3zTEIF3hSOYps2+XE+OaKUXjlJLqXO5M+rLdc7H5paW/V7wCChC80j7UVluTmrRJ1XTxFuwVJ0mSJEmSJEmSJEmSJEmSZEuM6U3TyvZ/Cnk01Z/F8Lu1K/K+B6r2qz3+IA//5rXWdj8fUNi4T7X7TL58s4owAEBgSpSpeNhkycag0eXr7ur26Tp1JCEjIyMjI0s2TMRACEZQDCdIimZYji8y2WTH8MxzL7z0ymtvvM1+ylX1wcfcPj77kiP565//ZRXKZDt8X/F12K8R011/fkzM/MOPz30ddVUh1ypYFeRcKBgGj6BBRmlGY2Lh4xrjSWOabjNgzuplz6GJNJlZp9KdlWuwaEXU2kubj2fY1+3s9w66evoGhkYnSGC+d+jCICiGE+RRemkYluMFUdLpDScv5SqoWgvrrUy3tbNd7/Y4ODo5u7j2EPfo6XEx9WAdv1rJUgAAQJIkAEACACRJkiQAAAAAAAAAAAAAAMDvRHDSebfdcRcOgaQIKrskf6w47GTu9BPnDwzjyH3D5fHh0OI6CCwcPAL4AygMjkCi0HPdbtlKp2Yz9hzMs1CLqXsJlqubvXvQZ1C/0/Qf4z+trCRVVYm8r24akUuqVKb7EsT3WOstuGRSGPV5GFIrkS11TeaBXJsuvUKOM7BznJ+whkCkBeRTeW/8HmNvwZWnDxBJlb37ArU2A16/XplDD9/5OHxXxl6Sid1PkiRJkiRJlFJKKaWUUkoppZRSSimllFJKKbVWW3q+jku3pdvSk3X8MW1bo7SdYrcGmWYtldo6dXWv1xjQz9f2cL0nnnq2fnsDDPecvXTaK3q9cm+00bBxn5X0FfT9/2u86S84/imdTHCniuEESdEngDDlO3RhEBTDCfKopJeGYTleECWd3nDyaq6Cqj2/AfnzgHLT33kIxEljA4AQjKDkKaTi0+zoq7wbymStXd869AajyfxQ3/iWRVx9/uL4zzGLe5aQ34SiKai5tkKPJ6lQ8Va+xrG7hTve4x4QpZNd7wX1rHrf17Bu42yG3bd4bH87CimNuB1VEdObC03oSyhl7S3z+q33/etO4ONd9+BxprnSaDRjaMbQaDQajbbWeSye1vbKDf7dXuMk89L/038FZX0mAAhWUlYJxcHgEUBkFB0aEwsZ1xgPncGc1ZXNMof5rgsQFiHEJbCPIfZ1sHsNct2hQDCCYjhBUjTDcvwZx98nmP4hnX1fYlUIFIklUplcoVSpNR0hF2HJBgAhGEHJR1/fZcBkzc41h7UrW4feYDSZLVsXvQ3749j2T93YPrb/1ykr2VaE2AYABCspq4QCYfAIHcgonWhMLGBcYzwJUCKJTKH2az+3X8uNboF0dPWm+82Aub7OCt7AZmecU+ZpPuMChEW0YsmGszZClsI3pruY3Vvc7o99PAC/H/zhz/zY2tra2trafm0HEYMFghEUw7uK6Rp6ApKiGZbj/zD9fSKmZuYWllaFOkRiiVQmVyhVak1HhC7Uz4+DfBced/EQiJN6BwAhGEHJUwZSyWk2PfUMZbKyjZy1h7QOvcFoMlu2Lm4bdkdn3OX2eB/ft50MrgCtiAQAwUrKKqGMMHgEABmlD435G+vnXVxjvCSgiSQyhdqv/dB+dTeejzJ/3tVBV2+63wyY69dmBW9gz0fh250Y4nbbdtt223bbj7siiF1xKQFv2FWK3dh23+k80NeB/ssgCYRgBMXwriK7hpiApGhmFo5vzGhiamZuYWlVCBCJJVKZXKFUqTUdYc+PA3gXBnfxEIiTdAAQghGU3MRrid+KRukH0wwwscrm9ctwx25ZdqGfG0YmZhbXNBs7h2c2SZe7p9bL8HUFGJ5/GBERERERERFZFhExufAmbOVJkiRJkiRJkiRJkiRZTlhfv5G3R2E4QVI0ayUN735NzdmrJltyMMP/eON/4xEGfbvMzTyJDQAMu8vBnTsy0BLbUZce+7+2vsMTERGRIcv7rr9tCAAAmP2zqx+WXUR2UTCcIKlBR5lVhgwAAAAAAAAwr/SXrTNJ5mPps1bpjl2n5mr1Bs+vT27deeLRU2BwBBKFLozpLbjy9AEiqXJkXxj1+W+EGnoJXfdovQWXXEvhm/qfxw799dVTh4hCUg9ljJdQ/Tqnxpzacmr7OHU411LKqE84dIzFEyBOehqR88ZFEdJrdLktuSPcBUtkSeVZo6cuI5KTKZx0G8wOuzaoaYb1JQ4w83SauxEQ+gwYrq/vZR4j3vh+hg98zCgmY5ww17T2ODz38+H5oT68AIssuTKRyZ3GGWc7x2SWsp7XCYqBQCAQCASC+MRAIBAIBAKBQCAQCAQCgUAgKFEMBAKBQCAQCAQCgUAgEAgEAp/gcNQSSEExnCApevLHdvkYEKWKQ6qkKl01Gq1ObzA2DTRjkTfW54/K2R/0eir1VREYKSdeP59azBjM71MP/NJs/NNnVcYl74LKfiq/W/GlM9l55qYLGkPtWRrSTIfrHgsmJbXxePHQCJibJyJRgBEREWnxixTprVQQMYMf0IHKSOTqFXbvKacadBnYDbuaBVe+d39z2xoiaVkoy0/kdJG66q8eeaDEjIjCertx4y3B7DJN0zRNy7Isy7K01lprbdu2bdu24ziO4ziu67qu6wIAIhF+/nT+siAsslTLD26WZZmng4hKoluG0qVTaN5X2NSHE5zIJCbfV8+8DPJGXBsFBkcgUegywQ/YLLjKvF5FQG5zbZG6WABSPI/wsJGWizGL4SiuEU8CxCTIFGp6VwbMLHijjwoQjhEWj8k9pbVXwwUYvoQCwQiK4QRJ0QzL8YIoyZX4KrUUdYwms8Vq6x7oYXi7M8eKHRkAAAAAAAAA/v///////x8tWrRoqQIAAAAAAACANv9381hXG8yZcHwRUVU1M/vX1M/awKD7wxcPAFhV2DRkNurN+cXfr+4YBMVwgjx5+F5QtT047RFPj+dBCgAAAODz3y++/BQyB4kAAAAAAAAAAADw9TF3ViQfABCspKwSQYGM0ovGxFKNa4wngYlIIlOo07syQDNtltM8yde6oAiLwOIS5Q01lbpXTmfRmtZmL6Y8L5lGeuRnN+qpuWIgGEExnCApmmE5/oRz3CPEEqlMrlCq1Jrfz+Q38AEAAAAAAAAAAAAAAAB6EwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB6EwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoLcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoLcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD6xldFqrNwsP94bBFRSWhkGF0GBYUeoz4zQaKxxJhj1ZDLoO9JrafA4AgkCl0m+DH2Flxl+rt6jJE63wQAAAAAAAAAqqqqqqqqqirUAAAAAAAAAEBVVVVVVVVVhRoAAAAAAAAAqKqqqqqqqqqoTZIkSZIkSZIkSZKk+xfO9D8N/p9oAQAAAAAAAACAHQAAAAAAAAAAAAAAAAAAEgAAXS4tEQAAAAAAAAAAAAAAAACgBgAAAAAAAAAAAGDegWecEo6JJDKFyuuR/jMBocm1HzjO+cd8K7VWyb3Lw3jzSEhY6QAAAABAVVVVVa2qqqqq1qrLV7F2D/q11lVnx7UKtlakAQCDnB9sK6GsEuoTGDzCm8gob6MxsfaPa4wngZiY5MpkTVFMTfNGbWu6Zeo66OpNd5oBc/3arMgNLNvHczTXw3mS79YChEXSxSUyG8poZEeprMZWNHG6qfIOSMucMetUkbNyh5rL20ItW7nR2u1t6Bno4znZ7w+m135WR1dP38DQKFADQjCCYnjXnZ9gEopmWI5vbGUTnzS1qZnFzW1uYXpLC7ZqF3qzyDfF0pbsSn28TMtNrXjGyuFVurpothvQ3l+2/xs7oCNYx6s6wb9OebwuxPSDuGgA3oMKwZF3Rol9Oq6JzyZtSumZgez9OZmfoPBTsXhpV3FrZVl1kKvRaHV6g7FphtlibZtld5Sv6PzLLsuv296nPl72KgcAAAAAAAAAAAAAAAAAAAAAAAAAAKyuCwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABB/DdYKayeIIRQKg0fgkCg0JhY+TsYzbYDOYLJmN+ZIk7GymbfZa4jfcseCoBhOkCkczbAcL4iSTm9YdlpB1bbD3sHRydnFdU/0DwN7xNPjeSuu42YOAAAAAAAAAPz///////8/2u05AAAAAAAAAPD/////////z7Vo9fnKje+6Sn5cq+BCkL9DiWBwBDLKXTQm1l/jFJ+AmgiJTKGmEddW3y01Orp6051mwFy/NityA8smnaO5NPMkH74AYRGF+Lnoz8bUTZQsG96MTj12Vq7N4mPl2euA390uv3fQ1dM3MDQ6IQtrfR/dMQiK4QRJ0QzL8YIo6fSGcrGias3sN0+3sRg8qAEheMRjFAwnSIqeIctyeekCxe4lq8BXsmqtq9FodXqDsVydB+B0be81e5MuawAAAAAAAAAAAAAAAAAAAF5OAgAAAAAAAAAAAAAAAAAAYH8SAAAAAAAAAAAAAAAAAACA6dWe/2bQu8ZEiWcGAAAAAAAAAAAAAACAHd4nXN8C8lsBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVV1Yr769fWwIayZIUIIhIyCqoA0bpbHU1QCI2eiE16h/lKh9tQu4GxBgUEhkBhcAQShcZgcXgCkUSukq5VqtE6jEzMLKxstU/QDji5cs+Uh/HmteEAqiEeAAAAAAAAAACXvdl6AkOmDq0vHgghoWxvmL+lqn1CSimltNZaa2OMMQYARERErLXWWuecc+5/wSNmQoOS+UeuQSHDFe5Tco/0Flz5rj5AJFUe6wujZtBGAAAAwDQD1drLwK/IGgMAoKpOs6qCUYUFJpEkSZIkJUlSzSpJkiRJ28lPbKBdoq5OJ6VBf/Q5+CZZ0taM7mtr2utxoHOIFuq9v5x6Eri2Xy+gWHlAH4wTZMk9AAAAAAAAAAAsxsyiqJpumNYqOwAAgDMXFuO7296v5sAAAABYTB/v9TQAAACgKq6qqqqqqqqqd41JkiRJkiRJkiRJkiRJAAAAAAAAAACAbdu2bdu2bdu2bdu2bSRLKVAAlFIAQCnA+DsbNExlWuTgSzh/w1qz4Mo3WoBISu5NYdR8YHE9UQAAAAAASJIkSZKkJEmSJEn6a0MNRsMEWw7JHJY9JXc87X8gMR0uYe/rFdAUfqUza6zaQT3tl4pyOj5I6zVmhnl5Y2H/AcBwFuEf7kvxH0ZO+ZXYpPrqe1Iv8liEf1BxN+ZnZmQPezXD2W4c8eGzc62aoG7bPzrPWDkFIItBYEqUqVi1g5pNmrREhFSCLiRSYGiYsL3hJLygY2DGEnP6LNgf2U3XMDbXBfIORKbgDgkYAoXBEUgUGoPF4SsdX/M1As6uRUCx8rlbwKmjMJijIQUgTCjjQiptrPMhJpGRI/+kU755auR1xKtQayzUZYrkKhU2Zfd9BviHk9ngi+XRJnhtp9ftDAAADOuRyy4EOdctlq1zhuzKYSAP/AeAjwYAAACA/v7//yJ4r+qQHhKvQa1hqcvkyol1NStMdYvJGTwHXf4AZlEzRGZpSDOy5G21Dg3ANcgClbLUZncziR6wUxwJXImWoVBpdAaTVTt7E1CsfI4WcOqm7ZNijGc19l9VBU89AAAAAADA//////8/9QAAAAAAAP///////9DbyQJpt+p2gmnRW/3fq6BoKcEWyZHIhMpw85SZp8I8IBtomrawtOnY1ZleIwB9BgzzsktPg76mBBh5RmyqLke99Ts3krFRYHAEEoUuM80DNguu8hytQK3JTG0KsxzFQFgguR4EIyiGEyRFMyzHC6I05L57jgQAAAAAAAAAAHACAACAhXzUR/smWR8SAAAAAJgz9lPVZ9QErONo2PSnLU9FrPAc7gW2Hrfv1gY6BZHASbVkKFQancFk1Q5oAoqVR7ZgnJwnkwLQ9Djwf8IL0Mdwvep9pS6iu/tnUVW1zbRJu0eSEkmSJEmSJEkCALJuAAAAAACgQ+OfHQcq011C/HoQycs7c2q5jJeNMZmMMZlMxoYmfp4ZoPNIyNUr7DZjUIMuA7thV7Pgyve2AJFUebgVRs0vHzE1HwAAAABVVVVVraqqqqr/////l2GttdZaa6211lprrfXee++999577733PsYYgybj/cYYB5stDAaDwWAwGAzGe7UKmpY+bJGbEhlQGVRXvYLULZgesz6SwIiJ2BRkjlVDyvBAGgUGRyBR6DJZHrBZcJVTvL6wwep71cTv/LDxmMxsrF1rAQCwdnetxVURAAAAAKiqqqqqqqqqqmoD+Tq6KupydbtxR+pkJJ9B02qui9Cj1NdOYKSJOGkj+XD0wGWgNxLTaIHBEUgUukyiB2wW3J6PPxXR6BxnzsBJBgAAAAAAAAAAAABp26ZN2rZN0rZt27Ztk//mRVrAIPcPZsxszTj2Hcycf2SW9fayC16nq38QZN2xXoyXHcBmc8zMeXAbUmPbtjHG2LbWmk+aSGtNRKT19OP3KoN+eRKKAgCoqhpPd3Q2ywEI/klTVbj6Py7QZ6lyE0LvdL0zJtvFCCWr+gs8iP8suL1MyXEzjAmlf4ceosx/HsrJycNUBcEcxIg8QlnZHincNo+itlmPOtvf8GjCKzM9ushKk8fwzxV1mqKr0hQ/y+wOENxYFTDyAHf1Fx7MXP2rh1hbzfJQGWsKPczemnLMR8wPHmFtLZdHKltbzsbsk7U9Q4+6+tt1HB5N2bb3Hl3FdmmPUd92tk1VO67z73dAHgt3weDEncJqsVzOLi+pc9iNYOMbiZg8bOse9Qg3RyZ/ZAcnwSw/m88H72hszAgHtnHSz09hYAvwjpTKgTzcSTJ4hT3BZIsmkwa27Chp9T3U5KmAhTO932zhdabUkIfufAqfqChLgmWz5GZLXeFs2ijHRko3f323PWUH6IKzFad31NWIZdVIj58v2rfPq6XZdMLz1fP58G49WzeLZtWcnz9eIjAg8OWoiIA+c7Z4kmtfPAejccRRWkmyQL3NhcDC5zFgBQQr6KnHsgdpLTqgptIsyzl3N5cee/gwYPHiAhB8WFdiLPCK9xLNBTrwjldMSoX53vBMLTqCFnuOI3hS7hJ54OSFztr3VHQlC3pXkP4bJV8nnsWGxWcsisNsh7rn2RQoacIF/UzQEyaFCbk4oQd0VVhD6OxsDKwwKZiAw0JtjYqNbocRkyNQ6QmyGCVjrNylk9RGdsapg1xYkmVcpM+ADTRIjR6UqId/lY1gYkUkzgy7J5vAEIjWqvpoduJHMA0TYFcNBqKenjVHHDkCpp0dJ4zeWw+bEtqPWDoqoHwkbeBDIHARVdk1tc5FshRjSToFsRFjV4dA7aUPrLyL5Mz4mjMVh7He0fGBytuKZEalldKrl7bFWCBg6SWNPglH6pzS0cClrUVtXYpxzwDKuIVGon3Al4sie+hxT0+gA3tKGOEW3SOE1nY2DwNqKsAoANKH6UD5/ZbaBWADL6SQxKCVGGUATCO4WgpVcPV5R/RS6nlWpEZPoidDjpr6Xv18ai7iHYfQEsVgAjgeND2ThSkMgaM7ImpFwOAWV53VQs6FZmAt9sAdRza7QGO1UjWRakyRFKewL2tbT81FKQ/NjHWF0N7e9CYnXQruo4FiliHL7kVOk7W7HKjA2cJ7P4IujjkolOITJ4KB+lWfttg4NozslNIaJBlAfS9LqRGXZFzPwXUky4ipq9iRTuG8OnY9Fo54pvskQIWBYrwH70vAoYIjbN5cQg6SyDhBTyD3os9UKcBCgAfkiCmIWwJoZtCFWM6qRwaoayh9/5fRFyEz5J/3350MIQeAt+5a8li4CwYn7hRWi2V1v5Jm91bnsBvB8vjeSMTkYVv3qEe4GbqY/EQcn1Uwy8/mV86BNCzT5t6Nk37efGwDW4B3pFQOnIlwJ0mqnVfYE0y2aHJPGkGJ7CjVdXmom3vrRkUBQsX2+80hwOtMS/FmVIcpfKo05fUuLJubHHWmztBuNt18rhwbKd3+4Ou77alEESyTcdJqXbWkVFZiPp/bXt/e51ScNTv4566VGf/wbj27cTxo+Orn+9seL1k2gkzFKTEeGmD2PBu5jIvSBH4zNmJrcKyOT6lHV78HVkCwgp6iP9oeuGcM6dU25KmgT+Brl34T4MMgMXhxZW1jV6IZgrTxXgSMcDsdlEMrJRieFa9laoMjuD/ccxzBJGjYmSIhfifCsF37K4mIOBsvBJboPylGM6j1Z50Dn3WvVxxmOw1mhqTLl56SijugnRhxHSYS/ESgONtnDdFrbQwgcHOCvXAijVrwxLbWDrEQU6HQHiTIYohLHsl7naS2HR3Q6KgoLrtMa4pnAmGcFqQl/AwEdoB/dVV6nUDfT5plWjKdGHwoJSiqQ2En/g3NRbdEneKhHbw9yEqLo0qRi46rgzF8L2fTthJfds4G5aNmNIEQcOAI0BU67oUMMZclGpa0F4tmGE37g1iOw5vAu8iZgWeoOe/p4LMp6MFhTSl7I5kd0LZGry8RJnA7gzChl1UcgzKM1OGCpg/Exu/1nZcZ33OKAPTw+ki0j3mIIuWFnnt0QcrpUUg0kW6gC+K7N+0nGEyYsjdoNxDzQWbpWl9q9/xzwXgocizJYqFFlMzBIAlIpxZ4BSb3puixgAbAeIZCXLuPm8zQjVh7f46DRfU4oRwoC+SVxlMYOLjqNpyiTQYq4R9MjtTIDNVmVBWKr3pCJbRwB1DRgFF/IfFBIlOtI1WciKpihvVOpVKcYL2mtIwVOlT5VQ7D4xOr0mXcWSfmSMzdwuJTvkKSsLfA2WLAo91ENDCGROqfkdICOiBPwlpUk3UXRNbGSnemfKMWvpDEFDJZ1kxJR8qWhPd7u4pdIvpTOJ/Ba312vs6bqeYRkoEhrj5OcZ8cKXONY9i8ucydhdBKRLoMYiZyOKFUBDtwFzyUhUcWsl1HU0pHL3ZsnJsky8KQDHmsWADTOiFe/EA1Tz5r3buL6of+wf1/IMAL1KugCXAUcoJmipREU/aSSlt+AK5qtzmDRi6gSYu2R01p+5kb6GlxgL63DBgy8ptx7mCSB5iq7kayYMk+TxjksCLR6dnxY4JXnPMBl3wRfv4CBLZGxF0fFAyJmxcTQeF1eHuJFJUrNwki5b7fXbcjajuj9bjZUXkUm6f/zfLyjjcEbXR/1KWfX3EYmOIlSMRyV3XkX4rf6vUbJFiIPv3sSq0h6260qoetxu1ma2vMnoCw223sbpu63+butKV7HrSVZdVRD/zbt4gUC7Fr0hHzKrexHXfCilXjJggI84I1fqRbl63aqGJFCysUocLxuJm3UIPORgTOXpKDvHr/2a1SEQUtuyrVOicnx1JFiOoV7UiURUVN0xJe732w0NxNRknHtWnXAVo1Vl2t9ug1rBvDUSO+5y/7vxXYEEe0zyefffTVF98c0Cvt9T2zNvkGLFwUB9zEzKJYCWvTtpwyI7JSZUX5W1KsikpVnRFjsxPlnpwNK9SPuHENnXfWuS603UXNHFq0IrF6dygvtq4u+6rj94urWxVLr1Ztcp3/etJfdruxxtg1V1xNVHxjWrRq067DvDby6+61GKNn3S9xm1Ipr7b26i3Vv/oAcrMM6TI7UFYH/W+vfYYMG+lno8aMlyNbbsfL0+uB8Yqcsd8Bs+bKb7lCBWiKFXXWYUfMW3AU02JP65RnRXr0KkorOtqI6uhlwGc26gdDKvVudXYeKrEbN45AonoTHbMbPY/FNaCr2/8fsEry6M8O29BLSevCo7+994SHHanfZOpw65LW5X6bEnwAuT62eaxzenDroSuZp82sOYPfi+jL6Xza3/2jTQwxnCAp2ma4VsFB0H7k1XCEEyRFOzrdNoaYrzrtxM30amr8Vp/BiYY89xP/IWjRx+6ALsb67TtlXv5EeCABo8GiBy7Kkg60QgEjOoXfS8xl2BbAIL4VWl2wtmQOrYd9YZA4K+kxMK3fTrENjCFhNZwrQ8kNjt9Hr/nR/XSZVNYYeDFWjnBbOYXfK5vxMBfoZl9Sk5mMI9G7T4B7ZCXMdLBRedIQa361zO779b4kbm9WrFixYsWKlVZl326vYjWZIqeV/jO6vYSXO+WT6+sAlOoataUg8zdpA28iiG7W8Ta36W/x6Ha7ukUN63sGw/c1cZUwBOcDbAM3ssiGTbIZ3gzcNi/qF8s6+XOmbDFk46y+5VX51JWqAS489DVDev1n+zrkJZHmeaJs+A6dvzuLlPejfvV7fgOGlg+N9g1X4/M0+ai8+kgfP3Qfiyyp/Shs/nOndGaf6bPP/y4/e2HlQ476q1vsN6Sg+uY8QQ6EYe1cPOw2kKQUQiTargh6A7/Pei5js2KcHY03O1/d48Z/kc1TTNy//huSRSWx+EiCVEqVRFCSAqVYogurzF1ub5xy/71uV4asWWvtF4gTJEV7YxzH8UGx3qZVUWcNuRrU6atrcA3TDtXDtKPrWH0s5W5oMqoTbusvZHvffmxqIPNHtHlYnwWk8fYD8I+gKvHcLGKz/TocrHss+EYjIsTpxMBKPbvtzwRAgyVzijgJUXS46Ctns6Lf9t+LCtHnto6cbkxZBsF/hza95zjgMLqDBhHhVvsRYd16MBq+Cjj0FYu+EgA=")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/imgzoom.js": []byte(`// imgzoom is a simple image zoomer. It will animate images to the maximum
-// allowable size by the viewport, but will never make them larger than the
-// image's actual size.
-//
-// This is a simple alternative to "lightbox" and such.
-//
-// The URL for the large version is either 'data-large', or the image's src.
-//
-// Caveat: this may use a lot of CPU if you're using very large images (e.g.
-// 4500×6200) that need to be resized to fit the viewport.
-(function() {
+	"db/migrate/sqlite/2020-10-24-1-import-fingerprints.sql": []byte(`begin;
+	create table import_fingerprints (
+		site            integer        not null,
+		fingerprint     varchar        not null,
+		import_id       integer        not null,
+		line            integer        not null,
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
 
-	// Padding from the window edge.
-	var padding = 25;
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+	create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
 
-	// The larger image must be 120% larger to do anything.
-	var min_size = 1.2;
+	alter table imports add column skipped integer not null default 0;
 
-	// The imgzoom() function zooms the image on click. img is a reference to an
-	// image element as an HTMLElement
-	window.imgzoom = function(img) {
-		var src = img.dataset.large || img.src,
-			existing = document.getElementsByClassName('imgzoom-large');
-		if (existing.length > 0 && existing[0].src === src)
-			return;
+	insert into version values('2020-10-24-1-import-fingerprints');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-26-1-import-transform.sql": []byte(`begin;
+	alter table imports add column transform varchar not null default '';
+	alter table imports add column dropped   integer not null default 0;
 
-		var large = new Image();
-		large.src = src;
-		img.className += ' imgzoom-loading';
+	insert into version values('2020-10-26-1-import-transform');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-28-1-operations.sql": []byte(`begin;
+	create table operations (
+		operation_id    integer        primary key autoincrement,
+		site            integer        not null,
+		user_id         integer,
+
+		kind            varchar        not null,
+		state           varchar        not null,
+		path            varchar        not null default '',
+		match_title     integer        not null default 0,
+		hits            integer        not null default 0,
+		all_stats       integer        not null default 0,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		undone_at       timestamp                  check(undone_at = strftime('%Y-%m-%d %H:%M:%S', undone_at)),
 
-		// We use the load event (rather than just displaying it) to make sure
-		// the image is fully loaded.
-		large.onload = function() {
-			img.className = img.className.replace(/\s?imgzoom-loading\s?/g, '');
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "operations#site#created_at" on operations(site, created_at);
+
+	create table operation_hits (
+		operation_id        integer        not null,
+		id                  integer        not null,
+		site                integer        not null,
+		session             integer        default null,
+		session2            blob           default null,
+		path                varchar        not null,
+		title               varchar        not null default '',
+		event               int            default 0,
+		bot                 int            default 0,
+		ref                 varchar        not null,
+		ref_scheme          varchar        null,
+		browser             varchar        not null,
+		size                varchar        not null default '',
+		location            varchar        not null default '',
+		region              varchar        not null default '',
+		city                varchar        not null default '',
+		host                varchar        not null default '',
+		utm_source          varchar        not null default '',
+		utm_medium          varchar        not null default '',
+		utm_campaign        varchar        not null default '',
+		ua_brands           varchar        not null default '',
+		ua_platform         varchar        not null default '',
+		ua_platform_version varchar        not null default '',
+		first_visit         int            default 0,
+		created_at          timestamp      not null
+	);
+	create index "operation_hits#operation_id" on operation_hits(operation_id);
 
-			// Make the new image as large as possible, but not larger than the
-			// viewport.
-			var width         = large.width,
-				height        = large.height,
-				padding       = 25,
-				window_width  = document.documentElement.clientWidth  - padding,
-				window_height = document.documentElement.clientHeight - padding;
-			if (width > window_width) {
-				height = height / (width / window_width);
-				width  = window_width;
-			}
-			if (height > window_height) {
-				width  = width / (height / window_height);
-				height = window_height;
-			}
+	insert into version values('2020-10-28-1-operations');
+commit;
+`),
+	"db/migrate/sqlite/2020-10-30-1-cron-status.sql": []byte(`begin;
+	create table cron_status (
+		task            varchar        not null,
+		last_run        timestamp      not null    check(last_run = strftime('%Y-%m-%d %H:%M:%S', last_run)),
+		duration        integer        not null default 0,
+		processed       integer        not null default 0,
+		error           varchar,
+		failing_since   timestamp                  check(failing_since = strftime('%Y-%m-%d %H:%M:%S', failing_since))
+	);
+	create unique index "cron_status#task" on cron_status(task);
 
-			// The large image isn't going to be much larger than the original.
-			if (img.width*min_size >= width - padding/2 && img.height*min_size >= height - padding/2)
-				return;
+	insert into version values('2020-10-30-1-cron-status');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-01-1-locks.sql": []byte(`begin;
+	create table locks (
+		name            varchar        not null,
+		instance        varchar        not null,
+		expires_at      timestamp      not null    check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at))
+	);
+	create unique index "locks#name" on locks(name);
 
-			large.className = 'imgzoom-large';
-			large.style.position = 'absolute';
-			large.style.zIndex = '5000';
+	insert into version values('2020-11-01-1-locks');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-03-1-stat-retries.sql": []byte(`begin;
+	create table stat_retries (
+		stat_retry_id   integer        primary key autoincrement,
+		site            integer        not null,
+		hits            text           not null,
+
+		attempts        integer        not null default 0,
+		error           varchar,
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+	);
+	create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
 
-			// Set the position to the same as the original.
-			var offset = get_offset(img);
-			set_geometry(large, {
-				width:  img.width,
-				height: img.height,
-				top:    offset.top,
-				left:   offset.left,
-			});
-			document.body.appendChild(large);
+	insert into version values('2020-11-03-1-stat-retries');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-04-1-bot-score.sql": []byte(`begin;
+	alter table hits add column bot_score   integer not null default 0;
+	alter table hits add column bot_reasons integer not null default 0;
 
-			// Animate it to the new size.
-			set_geometry(large, {
-				width:  width,
-				height: height,
-				top:    (window_height - height + padding) / 2 + get_scroll(),
-				left:   (window_width  - width  + padding) / 2,
-				// TODO: I don't know if this is actually correct.
-				//zoom:   (window.devicePixelRatio < 1 ? (1 + window.devicePixelRatio / 2) : 1),
-			});
+	alter table operation_hits add column bot_score   integer not null default 0;
+	alter table operation_hits add column bot_reasons integer not null default 0;
 
-			var close_key = function(e) {
-				if (e.keyCode === 27)
-					close();
-			};
+	insert into version values('2020-11-04-1-bot-score');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-05-1-reindexes.sql": []byte(`begin;
+	create table reindexes (
+		reindex_id      integer        primary key autoincrement,
+		site            integer        not null,
+		job_id          integer,
+
+		tables          varchar        not null,
+		since_hit       integer,
+		first_day       timestamp      not null    check(first_day = strftime('%Y-%m-%d %H:%M:%S', first_day)),
+		last_day        timestamp      not null    check(last_day = strftime('%Y-%m-%d %H:%M:%S', last_day)),
+		state           varchar        not null,
+		total           integer        not null default 0,
+		done            integer        not null default 0,
+		start_done      integer        not null default 0,
+		error           varchar,
+
+		created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+		started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+		updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
 
-			var html = document.getElementsByTagName('html')[0];
-			var close = function() {
-				html.removeEventListener('click', close);
-				html.removeEventListener('click', close_key);
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "reindexes#state" on reindexes(state);
+	create index "reindexes#site#created_at" on reindexes(site, created_at);
 
-				set_geometry(large, {
-					width:  img.width,
-					height: img.height,
-					top:    offset.top,
-					left:   offset.left,
-					//zoom:   1,
-				});
+	insert into version values('2020-11-05-1-reindexes');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-06-1-rollups.sql": []byte(`begin;
+	create table hit_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		total         int        not null,
+		total_unique  int        not null,
 
-				// Remove the class after a brief timeout, so that the animation
-				// appears fairly smooth in case of added padding and such.
-				//
-				// TODO: Detect position?
-				setTimeout(function() {
-					if (large.parentNode)
-						large.parentNode.removeChild(large);
-				}, 400);
-			};
-			html.addEventListener('click', close);
-			html.addEventListener('keydown', close_key);
-		};
-	};
+		constraint "hit_counts_daily#site#path#day" unique(site, path, day) on conflict replace
+	);
+	create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
 
-	var set_geometry = function(elem, geom) {
-		if (geom.width != null) {
-			elem.style.width = geom.width + 'px';
+	create table hit_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+		event         integer    not null default 0,
+		month         date       not null check(month = strftime('%Y-%m-01', month)),
+		total         int        not null,
+		total_unique  int        not null,
 
-			// Reset as they'll interfere with the width we want to set.
-			elem.style.maxWidth = 'none'
-			elem.style.minWidth = 'none'
-		}
-		if (geom.height != null) {
-			elem.style.height = geom.height + 'px';
-			elem.style.maxHeight = 'none'
-			elem.style.minHeight = 'none'
-		}
-		if (geom.left != null)
-			elem.style.left = geom.left + 'px';
-		if (geom.top != null)
-			elem.style.top = geom.top + 'px';
-		if (geom.zoom != null)
-			elem.style.transform = 'scale(' + geom.zoom + ')';
-	};
+		constraint "hit_counts_monthly#site#path#month" unique(site, path, month) on conflict replace
+	);
+	create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
 
-	var get_offset = function(elem) {
-		var rect = elem.getBoundingClientRect(),
-			doc = elem.ownerDocument,
-			docElem = doc.documentElement,
-			win = doc.defaultView;
-		return {
-			top:  rect.top  + win.pageYOffset - docElem.clientTop,
-			left: rect.left + win.pageXOffset - docElem.clientLeft
-		};
-	};
+	create table ref_counts_daily (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		total         int        not null,
+		total_unique  int        not null,
 
-	var get_scroll = function() {
-		return document.documentElement.scrollTop || document.body.scrollTop;
-	};
-}).call(this);
-`),
-	"public/index.svg": []byte(`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 9.7259998 3.9560001" height="3.9560001mm" width="9.7259998mm"><g transform="translate(0,-293.044)"><path d="m 9.1334164,293.49193 c -1.6422229,-0.24526 -3.2984127,-0.45041 -4.9612577,-0.4448 -0.7571537,0.0118 -1.5243709,0.13886 -2.196424,0.50462 -0.283529,0.093 -0.6795335,0.36991 -0.9174014,0.30035 0,-0.1377 0,-0.27539 0,-0.41309 -0.35277776,0 -0.70555551,0 -1.058333271866608,0 0,1.08303 0,2.16606 0,3.24909 0.352777761866608,0 0.705555511866608,0 1.058333271866608,0 -0.018552,-0.19293 -0.022312,-0.37957 0.2356444,-0.21861 0.8579277,0.22409 1.7037735,0.5857 2.6086264,0.52834 0.42766,0.0146 0.8442444,-0.17513 1.261401,-0.0372 0.4181366,0.0824 0.9829984,-0.0793 1.0546952,-0.56208 0.1879788,-0.21325 0.7989502,-0.20725 0.8059871,-0.65765 -0.1664631,-0.43416 0.5861141,-0.50823 0.4279637,-0.97962 -0.0084,-0.19336 -0.4934002,-0.41887 -0.087643,-0.36828 0.646987,-0.0301 1.3000225,0.0276 1.9423724,-0.071 0.3625361,6.6e-4 0.5967846,-0.5038 0.2363885,-0.70137 -0.1236421,-0.0759 -0.2685667,-0.10797 -0.4103524,-0.12874 z m -1.8838333,1.397 c -0.176739,0.442 -0.7018993,0.61645 -1.1403954,0.6553 -0.4953689,0.0498 -0.932969,-0.2434 -1.3466878,-0.47277 -0.2932219,-0.1 -0.3373301,-0.49062 0.050271,-0.33071 0.3725822,0.18332 0.690558,0.49281 1.1138958,0.57147 0.2970717,0.12298 0.8013696,-0.0254 0.6667497,-0.42333 -0.029576,-0.22526 -0.6591866,-0.47727 -0.1561042,-0.51853 0.2989592,0.0483 0.7347496,0.19172 0.8122708,0.51857 z m 1.8309167,-0.74083 c -1.1304613,0.0861 -2.2682963,0.0489 -3.3972499,-0.0423 -0.3287259,-0.18481 -0.6007776,-0.51495 -1.0062022,-0.53082 -0.4308888,-0.0562 -0.8661645,-0.10631 -1.2996415,-0.11936 -0.2455342,0.24499 0.3755459,0.17073 0.529828,0.21572 0.3986907,0.0276 0.8115017,0.0941 1.1654898,0.28577 0.3883128,0.33273 0.8786734,0.53412 1.2370922,0.8999 0.1976824,0.5022 -0.5573274,0.22033 -0.769276,0.0671 -0.3198247,-0.11952 -0.5518933,-0.47805 -0.9151241,-0.43724 -0.3318914,-0.003 -0.6644063,0.0117 -0.9953328,-0.0212 -0.1902566,0.10419 0.037223,0.28951 -0.1706563,0.42486 -0.288771,0.35012 -0.6718428,0.61598 -1.0761926,0.81344 -0.086199,0.42022 0.576766,-0.0358 0.7398411,-0.16253 0.2883553,-0.23149 0.5717774,-0.49578 0.7186744,-0.84291 0.2502168,-0.15234 0.6171542,0.16475 0.2282031,0.25795 -0.3957968,0.14 0.018171,0.62274 -0.3300673,0.78002 -0.046068,0.32935 0.2303027,0.60466 0.4389606,0.82815 0.4218066,0.24806 -0.326091,0.18401 -0.5234616,0.20373 -0.88564,0.003 -1.6777825,-0.44935 -2.5347083,-0.5978 -0.12899359,-0.0198 -0.032556,-0.26152 -0.062342,-0.37899 0,-0.55839 0,-1.11677 0,-1.67516 0.462385,-0.004 0.8724889,-0.27053 1.285844,-0.44759 0.7806978,-0.41478 1.6884703,-0.44899 2.5506142,-0.37261 1.4358839,0.0801 2.8732136,0.19418 4.2915416,0.43658 0.4289646,0.085 0.2515375,0.43486 -0.105834,0.41527 z m -2.3389166,1.51341 c 0.097699,0.41362 -0.4475862,0.48402 -0.7435102,0.48943 -0.6506355,0.0197 -1.2896683,-0.25607 -1.7841287,-0.66331 -0.3598913,-0.35882 0.2771109,-0.56043 0.4712887,-0.2398 0.527227,0.36941 1.1972106,0.63845 1.8420374,0.43464 0.053553,0.0248 0.2532774,-0.15802 0.2143128,-0.021 z m -0.8149167,0.762 c -0.1307134,0.41795 -0.6622495,0.37963 -0.9997115,0.28554 -0.424531,-0.1036 -0.8099958,-0.41902 -0.9719304,-0.82479 0.1294242,-0.38374 0.5444154,0.0543 0.7651419,0.14236 0.3690338,0.20578 0.7785499,0.3649 1.2065,0.36512 v 0.021 z m -5.12233316,0 c -0.18697222,0 -0.37394443,0 -0.56091665,0 0,-0.91017 0,-1.82033 0,-2.7305 0.18697222,0 0.37394443,0 0.56091665,0 0,0.91017 0,1.82033 0,2.7305 z"/></g></svg>
-`),
-	"public/int-logo/gatsby.svg": []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 28 28" focusable="false"><circle cx="14" cy="14" r="14" fill="#639"/><path fill="#fff" d="M6.2 21.8C4.1 19.7 3 16.9 3 14.2L13.9 25c-2.8-.1-5.6-1.1-7.7-3.2zm10.2 2.9L3.3 11.6C4.4 6.7 8.8 3 14 3c3.7 0 6.9 1.8 8.9 4.5l-1.5 1.3C19.7 6.5 17 5 14 5c-3.9 0-7.2 2.5-8.5 6L17 22.5c2.9-1 5.1-3.5 5.8-6.5H18v-2h7c0 5.2-3.7 9.6-8.6 10.7z"/></svg>
-`),
-	"public/int-logo/schlix.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAC0AAAAoCAYAAABq13MpAAANmklEQVRYhZWXe7BdZXnGf+93WWufe26QECAIKgVMoAyXGOzIVAwKhkuVIh20xVDaoikwkjZguRUEhIFSiAcpxUGNoIRSFWGKbS1YRe05IdypDdfQBJKQnJxz9t5r73X7vv6x1tpnn5Nk2u6Zd9bM/uv3Put5n/dd8vrIJp749I9pCjTF01QQKU+kIFLQUkJbF5VoITaK1GhSq8msJgsMeWDIAosLDXlYPF1oO+UDgwsNPrB4q/HW4K3GGQ1a4UVABLz/AHADsAi4hDx7Bm3o/q0/YgDZMrKJX539GFEFXT4jBc0SvqUg6oKPtZBU8EaTBZrMmqKBsGgi70CbLnhbwpsCPDB4o/FazfdGXeeV+mOgm/I7wJXAO9Ogd4xs4pUzC+hIIKKAbQpElfJS/NfSFTy0VaF6ohWpUaRWk1pTNBAYnFZz8lpwi9fyrbS/5+kOeNmEDwxe60EfmjUutJd5a3q9UXit8Uq6xY2AW4DbgGj9EQPI+Mgm3j7jMdoCLZgOXylevoGoyzou6KfumifnwnMTgZ5IjCIxCieQBPaP0pq9LQvMvDwwkRdOTQd6ns4rpY2uudBe7HqDq3xg57igVL1S3mq8VniluuG3AF8hz9dJc+S/2HnGoyQIbVQHvtUBh1QIIvHnb29vvT/rW0gihA3lv1rXXJ4IaxtGLm1rIRU5vG3VPYnRv1so3/F904t8Iu2vPZ2HhnRWn0Zk2Bv9py4wnTfgA4sLzJTvjcabafAO+CtpjfyG+oofkKNIRZXwRbUEUlhUF77fEJZ5WDSmfNwQftpUfnE5tM7BcbuNPDdp5MJcuK9thESrju8zq8msGXdajkx6w215YGgPhShtv+y1us2FVqYG1nZUd7bTwE5v9X1eZHj9Rw7ZIq2RV2isWA9oPBqHJhVFjBAjRCL3x3BBC4iFS7Yq/w2FvN0Sf0Cz8PpkXbGyLf6RN0xCvwp/mAhndRLHKB8b/UAS6Gtzpd7IgsL3eWCwSz5Gfevo2V7Jgy60PdXQ+rBUPLSjrhbc7a3+ntcq9kbz/aNnI62Rl2isWFcObVWaHEWGpiVqZQrfaIpcG8GtKbhJ4SuJcGNd/PpJxWUpvNtUnkhgh/H7AS+0FQuaRh5tarkK2/NiREJa+j6zhtTqMioNSX/tRJT8yAVmQR7axPUED7me4G4X2l97pUrrFInzwPHzkNbICzRW3AsEJbDtgIMhwRyUi8zJkBfi0jZjwn6ZcOxbvvHPs1T/9LhUnjHtT24L8WD/+369pfUWLS20VBGVsRESrUltaZ3AkFlNPNBziNfqvDy093utduwRl6HBBZbvfmQB0hp5nsaKO0vY7lJzwF5Td+3LBtQsHBqHfChFnRyJurvyfJU4KcxrC8vHlP/etLicsahaZc6nitCJLGzW7JudoS0XVZXzeQUdTGX+tz9+MNIaeZbGiptLpTvQx4J9DOxCkAMgmABzDdjLPaohsKApNokRMuhvIasbwuWR0O/gxF2K0ahrUXXgiyRaEGm5uGG4uK2k5kSWNwP9H4kp1K+WVOH7Arx7UX3zjMOQ1shGGmdcDd52gYd/AObBUvEzoXcOqG9NeV6d4zGPONRnYlH3xKh5lfIxbFRwwnaFa1IonoqnKbK0rvyfTyo+GylvIgUtLbQVdSecMhno0emLqtiyeWDIQkOV8fecdwTSGn2GxtmrwdmivAXC40CPQPh3EF4BwSyQzVNvQj8E9jyw53rkoRxFIoqYInUy+FJduDsq7PPBSFjXEL+0WFCV96es01YymQunTFjZEGtF0vH91H1TWeeulYuR1ugGGueugtwU5bWQ9c8Gfdhc3bthV+7w9CP4zWBnQXg72DtA1aFXg98M+kBfJk6Z9RPA4btF7XhP0AI/b8OyamCrs6AbvqWYcPDx3VY2dO4brUiM7qifBYbbVh1bQv/hRfQ4SyvjaDL793hZSloDbxnPE64e/ylrZ3/+TDBPg941fWCDK0HfVCWOx7yUoocTUetyaMYIu0Xe7+G5ltAfdZ0Khd+7TgTxu52wfMzIMy1F5zgrLsuigRv+4gSkvWGUxsUr8W31PnL1Kqk2eFlAGmzHqaPIg5vwajWu57XCOnbG0PbNA94G+zgEwx7zlCBl1mtSFIkIDdSFOdxXnQctoTzKum8bTySM5cLyMe03RnrqNI61EGvFlVctRZKNo0RrVpI3WOBz3vWxgJNjiM1pZOYmcqNw6nPkwQMdz/sAULNBBdC/HWqDoCanGpm+qByKCTEo+FGKnDkzLqOygab4rC7+yYZiXSysmxaX5YZd9dfLkOz5UeJbVpKN0+NzItfkCdfmAp/KVWRqFamGXN1FZi+dV1vAzsbYYlxwKS48H6+/DuFfzohLpsPbclFpYoK54F9ORM2Pp+6b9xrC4w34cST8SyrUo33c9ZGCz998EuJeHsV/e2Vf3iBLdrK0vtv9e69W5A0u9Sl/6xPBp/KrILcnJYm6mtxcPzW05l3wB5H3u+ngM5voVt6e7pCbEtGPtlFPJKhfZvi9Kr83+DNuPQnJ713yBcb5GjFH+nHG8gbkEWQTnONTHs4jtrqIO7Y0k9sPpOd8cvVdMt2VNuqT5OFPcAFTnq/AVQh2OdhDd+XR2rl6PvPC97OtvRUjxYGWdeKyvC73cdcXHyOe3/mbZUj+yJK3aXIwCfPZzY7tkynzxfYnm5njYk7IW/zApzjXgnySA/GyxacCqaaA1w+S2fNxhnfinIWmdy7enoYPfw/CT4DtK5qQU6D336bfN1XiaDI0qQgxak94pgb2mDuXIfnPl4wRMZuUA4k4hgkuo8FOJjnfN8A1IW8W6re3gTa87mIO8zH4REGmWsB82mG9hP8OzqipRVVZxfwGOBr6071bp4CvFlWyF/gWcOhdH0byV5ZEtNhKm6U0eZ6Ig4gYoc5SmkADfBNco4Svc6+LuShvgWtR923+1adqNZl6g3Y4B9hKbmrkZmrDus4mvRJqX9v30BY1Y1FN+yiZv/bDSP7Wkk+6iCcUQJtNtPkgLV6hwYdoQgVOgnmvlWaz37Gfylucmtd53MU86VNS1wbXBp8IJOab5Gplx/fOlt634G0Tz1HQ9/a+B7b7NC6zvuujZOjry5A8GsVPrERyIOZFEhYTk3mwUmcuTU6nztlMcCrCArbRpNs2pXVcoTx5Q47B81zheQWZmRpaZ8Hpf8zFfUbng/tImr1nfbWoeoZPQlrRBuL6hdTwGOc3SM5xpIwjzGOM20m4tJgEIOFjjPMkjVL9btuU8FnkoSU/cwkf9YlAqrqG1kBuwcvp5LV/KtVn33G550dJOPxRZCzawK76n9CHYxB3UYh/TTl+hsMRs4qUtbSBNpCwhklunWab8umqBiLIxjnHZTzsWuBjcHEJn3US5zVgMUlvjDNdW3ZfOT+lfG34FGRrfQOvTnyRAe3oV44+PL04anhC58/C8UNSIAES/oGI3+8o3+yqxhR88i5KhM15m4NcBHkJ72PBp6powKlrSe315F2e7yTOvm1TGz4NeW38GX753iUMWcegyUt4Xyl/tME/H3iPckDKfztYpCZL5aOuasKbu2MOjcPCNuNc4WJuLq2zI2/yuov4T9dmk4/lTVL9El5eKdTv9nxX6uzFNrXhs5GXdz7DI1suZ8g6hmxewjsGjGOhSmsOaVXKB96jhAOkzTY8BxNzOG1+i4ijaHAkTeYjHM87tHmXAWI+4FpsSid9U5yQR11D2y7V38PzuvC927vyteHzkOe2beSuV9cwFDiGgrx4lg0ssgleq1194ub0i6MXPxbgzurB/0LnDJPxRRIgLpVvASmfZZz1M23jm2XilL7fO3zZQD4zcaaUrw1fgGzYspE1L17F4AzoWUGh+gG17FNDxu3o1W7T7CE94ScT+vD0487R+IeNA7LS8zGQ8DhNVnQ8H02B0wQ/Iy4rz3fgE9U1tBV4lTqW2tqLkdHNz/K50WsZsI4B60r4ooFZQWmZspFBU/peOfaTfH+B7T04QjzagWRAigMWMsH2Yv92DW1X2viygT2yvg0uLhdVqkvlywacoXbHl5GRN5/l1F/cQK9x9BtHv/UMlCpXDczqvIEpzx9sE1Dycq/4o/pw9OAJi6xHPKtpcztJl21mJs7esr6yTdQFPy0uDbVbr0BG3niWpU/diFWeHu3p1Y4+67uUL4FnqD5kc+YG+dpe7VcNaEfheUcvngD/Qpz4YwYFOnFZwbdmROWMuJxmmwq+OhFSRe36awroE5+6EQABjJTwxtNnHP3WMRA4Bm2X5wPHLJuzfy3/dE37RwZtzqB2XVnv0PDbQ7jnrffFiZCWno+74Pdim0r57uuygvdtCNdcNx26+lXwNe3p1Z4+W8LbAr4a2kX92TyB9yrbDFnHgCkaqCl/+xBudRWX1ntUBZ/sA36m72d6PgL7Z/uA7obXAjXl6DW+8H3XwB7aG+OUenHAusWzqvSxjqEi53dkngMOCTPXh2Na1rv/A/yMuKyiUp/7v0B3/wp4T49xhW2MZyBwzA7zO/uMu2RP3+eEyq/YP8wfH6xsU/q+p/A9Omd6XHbDV1HZpb5vAMv/H9Dd8KHy9GhHn/HM68mW9Wj/pX7r9h8MXN+QdXowyAeHrJvVa9xP5obuC0MmZ9AWnh/owHu645KUKd/PhO8Cl+Ov438ASetOrSUpLMwAAAAASUVORK5CYII=")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/int-logo/wp.png": func() []byte {
-		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAACgAAAAoCAMAAAC7IEhfAAAChVBMVEUAAAAAAQEBAgMDBAQODg4ZGRkbJiYtMzkuNDouODcxNDsxODYzJy4zOD0zOD4zOTw0Nz00OD40PEA1OTw1Oj42N0I2OT02OUQ4PUA4QkQ4koc6PD88QERHREJHRENIRUJNRztPRj8yOz8zOTw0OTw5O0AzNz40Nz0zNz0zODwyODwyOD0zNz0zODwzOD0yNzwzOD00OD0yNzwzODwzOD0zNzwyNzwzNzwzNz0yNzwyODwyNzwyNzwyNzwyNzwyNzwyNzwyNz0yODwzNzwyNzwyNzwzNzwzODwyNzwzNzwyNzwyODwzNz0yNzwyNzwzOD0yNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwzOD0yNzwyNzwyNzwyNzwyODwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzyNzrtkAAAA1nRSTlMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQEBAQIEBgYJCQkJCQoKCgsLCwwNDg4PDxESExQVFhYYGBkaGhobGx0dHR4fHyEoLi8wMjM0Nzg5Ojs8P0FCQ0RFSElKSktMT1BQUVJTW1xdXmFjZGZnaGprbG1wcXJ0d3h7fH1/gYWIjI6PkZKTlpeYm6OkpaanrK2usLGytLa3uLm7vL2/wsPJysvMzc/Q0dLT1NXX2Nrb3N3e4OHi5ufo6err7e7v8fL09fb3+Pn6/P3+dkZp4wAAA11JREFUOI2NledbW2UYh2+te4J7gaB1gtRRBxrBVXdQMZWiViW1KA2u2nqspbYxaNBEYxNjG4vgbJF4TI014IpHmzpbSVrz+3v88B4gDL18Pj3v9dzXe9713Acqo6o9mMw4kpNJBtur+LdoDefL6ZgV8PkCVixdzodb58WaoxO25Zkeeyx7Ito8l+spZP110OTfkIjH433+JqjzZws9s7CFkWKolhNWDZfkRmkocCK1oWJkYSXXMOh0smBlTjMi9wR0OoMNFfMN5r0s2qo5kboCb35wes6I46VtbC4npRbhdSJT+yh20vbjPNi+5QCdRXdHzYUQl847X78BQgVzStFsDQPffmmnh/fpj6HP7a++G7PtHVlJHQasyUYBWif87hKOGtGWsybX84ikpwE4BP9EKxC26wG4audeSb9/cS3AxrwkvQfAgyfX22GozlssOxdgh1nYWoDHTd4B8OQqrHw17WUPQysA1pviCMDZv0iSdh4JPDuMp9xOME1jMQlwQ9mQdwEkTP4c8GKpgXSQZIzHtPdKgE9N8TWAFSb/DNgsP7EkGYv10mqAdab4zSnAeXvM4Br4WhuwMji9JKSPAW50v70MYLPJH8ArJeh1kI+4pJsAPjFFC6DL5Evpl+L4NAm+AmCZ4m0A5+yWJN192LgLOgESknadCbT8LUmj3ArwtiTtOeJ+SQkCDhmLPknyAXwkSZtatgE8KknbeF0ym0nG8EtSBOAFSVry0n4PcMzPklYf7kjmeIJpmkqSCucDnrI0yojWAbwlqeUhSfsvJh2kvexhWJJWAnwobbpFGnXfz3belGSusCpvEZCkFMAaaUlQ0r3AcT/p5dN3Swpg5asgbNedmpP019XAdQdGSUsaAIjqTr+k3Gl1dth9uN2StAbgg433SNIPJwHLM7wjqdt9uESztQenJG0HeP6OVyVJDwNHr73gV2kLtaYVaC6EWDwu6XbgwkN3SZISAGf0SLnFU8011a79APeZa/ztIoCtyrdNt6srgHGNHQsMuK36FHDJn7m2SgEYpVyeUgcc/70Lvg888+5lM5ViJHVQtwVLXU4HroebF8ySlNFeDcAbk2CpB6iZrT2MSOuhsasvEY/H+7oaoX4ekfL/1cyU7Ht9vt7/kj1AdeXvo3pG6R/Z+m5rTW1+mAAAAABJRU5ErkJggg==")
-		if err != nil {
-			panic(err)
-		}
-		return s
-	}(),
-	"public/jquery.js": []byte(`/*!
- * jQuery JavaScript Library v4.0.0-pre -ajax/jsonp,-ajax/script,-ajax/parseXML,-css/showHide,-effects,-effects/Tween,-effects/animatedSelector,-deprecated,-deprecated/ajax-event-alias,-deprecated/event,-event/alias,-wrap,-exports/amd,-sizzle
- * https://jquery.com/
- *
- * Copyright OpenJS Foundation and other contributors
- * Released under the MIT license
- * https://jquery.org/license
- *
- * Date: 2020-09-06T03:02Z
- */
-( function( global, factory ) {
-
-	"use strict";
-
-	if ( typeof module === "object" && typeof module.exports === "object" ) {
-
-		// For CommonJS and CommonJS-like environments where a proper ` + "`" + `window` + "`" + `
-		// is present, execute the factory and get jQuery.
-		// For environments that do not have a ` + "`" + `window` + "`" + ` with a ` + "`" + `document` + "`" + `
-		// (such as Node.js), expose a factory as module.exports.
-		// This accentuates the need for the creation of a real ` + "`" + `window` + "`" + `.
-		// e.g. var jQuery = require("jquery")(window);
-		// See ticket #14549 for more info.
-		module.exports = global.document ?
-			factory( global, true ) :
-			function( w ) {
-				if ( !w.document ) {
-					throw new Error( "jQuery requires a window with a document" );
-				}
-				return factory( w );
-			};
-	} else {
-		factory( global );
-	}
+		constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day) on conflict replace
+	);
+	create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
 
-// Pass this if window is not defined yet
-} )( typeof window !== "undefined" ? window : this, function( window, noGlobal ) {
+	create table ref_counts_monthly (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		month         date       not null check(month = strftime('%Y-%m-01', month)),
+		total         int        not null,
+		total_unique  int        not null,
 
-"use strict";
+		constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month) on conflict replace
+	);
+	create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
 
-var arr = [];
+	-- Days for which the rollups are outdated; n is incremented on every change
+	-- so the cron job can see if the day changed again while it was updating it.
+	create table rollup_dirty (
+		site          int        not null check(site>0),
+		tbl           varchar    not null,
+		day           date       not null check(day = strftime('%Y-%m-%d', day)),
+		n             int        not null default 1,
 
-var getProto = Object.getPrototypeOf;
+		constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day) on conflict replace
+	);
 
-var slice = arr.slice;
+	create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+	end;
+
+	-- Create the rollups for all existing data on the next cron run.
+	insert or ignore into rollup_dirty (site, tbl, day) select distinct site, 'hit_counts', date(hour) from hit_counts;
+	insert or ignore into rollup_dirty (site, tbl, day) select distinct site, 'ref_counts', date(hour) from ref_counts;
+
+	insert into version values('2020-11-06-1-rollups');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-07-1-first-hit-at.sql": []byte(`begin;
+	alter table sites add column first_hit_at timestamp null
+		check(first_hit_at = strftime('%Y-%m-%d %H:%M:%S', first_hit_at));
+	update sites set first_hit_at=(select min(hour) from hit_counts where hit_counts.site=sites.id);
 
-// Support: IE 11+, Edge 18+
-// Provide fallback for browsers without Array#flat.
-var flat = arr.flat ? function( array ) {
-	return arr.flat.call( array );
-} : function( array ) {
-	return arr.concat.apply( [], array );
-};
+	insert into version values('2020-11-07-1-first-hit-at');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-08-1-ref-category.sql": []byte(`begin;
+	alter table ref_counts         add column ref_category varchar null;
+	alter table ref_counts_daily   add column ref_category varchar null;
+	alter table ref_counts_monthly add column ref_category varchar null;
+
+	-- The search, social, and email categories depend on the referrer; these
+	-- are set by a reindex of ref_counts.
+	update ref_counts set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_daily set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+	update ref_counts_monthly set ref_category = case
+		when ref=''         then 'direct'
+		when ref_scheme='c' then 'campaign'
+		when ref_scheme='g' then 'generated'
+		else 'other' end;
+
+	insert into version values('2020-11-08-1-ref-category');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-09-1-acme-renewals.sql": []byte(`begin;
+	create table acme_renewals (
+		cname           varchar        not null,
+		site            integer        not null,
+
+		failures        integer        not null default 0,
+		last_error      varchar,
+		last_error_at   timestamp      null        check(last_error_at = strftime('%Y-%m-%d %H:%M:%S', last_error_at)),
+		expires_at      timestamp      null        check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at)),
+		alerted_at      timestamp      null        check(alerted_at = strftime('%Y-%m-%d %H:%M:%S', alerted_at)),
+		next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at))
+	);
+	create unique index "acme_renewals#cname" on acme_renewals(cname);
+	create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
 
-var push = arr.push;
+	insert into version values('2020-11-09-1-acme-renewals');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-10-1-sessions-stats.sql": []byte(`begin;
+	create table sessions_stats (
+		site           integer        not null                 check(site > 0),
 
-var indexOf = arr.indexOf;
+		day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+		pageviews      int            not null,
+		duration       int            not null,
+		entry_path     varchar        not null,
+		exit_path      varchar        not null,
 
-// [[Class]] -> type pairs
-var class2type = {};
+		foreign key (site) references sites(id) on delete restrict on update restrict
+	);
+	create index "sessions_stats#site#day" on sessions_stats(site, day);
 
-var toString = class2type.toString;
+	insert into version values('2020-11-10-1-sessions-stats');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-1-hits-host.sql": []byte(`begin;
+	create index "hits#site#host#created_at" on hits(site, host, created_at);
 
-var hasOwn = class2type.hasOwnProperty;
+	insert into version values('2020-11-11-1-hits-host');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-2-hits-sample.sql": []byte(`begin;
+	-- The sampling factor when the pageview was recorded; the statistics are
+	-- scaled by this.
+	alter table hits           add column sample integer not null default 1;
+	alter table operation_hits add column sample integer not null default 1;
 
-var fnToString = hasOwn.toString;
+	insert into version values('2020-11-11-2-hits-sample');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-3-anonymized-until.sql": []byte(`begin;
+	alter table sites add column anonymized_until timestamp null
+		check(anonymized_until = strftime('%Y-%m-%d %H:%M:%S', anonymized_until));
+	update hits           set session2=null where length(session2) = 0;
+	update operation_hits set session2=null where length(session2) = 0;
 
-var ObjectFunctionString = fnToString.call( Object );
+	insert into version values('2020-11-11-3-anonymized-until');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-4-jobs-heartbeat.sql": []byte(`begin;
+	alter table jobs add column instance varchar;
+	alter table jobs add column heartbeat_at timestamp
+		check(heartbeat_at = strftime('%Y-%m-%d %H:%M:%S', heartbeat_at));
+	alter table jobs add column cancel_at timestamp
+		check(cancel_at = strftime('%Y-%m-%d %H:%M:%S', cancel_at));
+
+	insert into version values('2020-11-11-4-jobs-heartbeat');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-5-import-fingerprints-path.sql": []byte(`begin;
+	alter table import_fingerprints add column path varchar not null default '';
+	create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
 
-// All support tests are defined in their respective modules.
-var support = {};
+	insert into version values('2020-11-11-5-import-fingerprints-path');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-6-device-class-reindex.sql": []byte(`begin;
+	-- The device class was set from the width only, but phones are classified
+	-- on the shortest side; recreate size_stats from the pageviews.
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'size_stats', min(day) || ' 00:00:00', date('now') || ' 00:00:00', 'running',
+			cast(julianday(date('now')) - julianday(min(day)) as int) + 1, datetime(), datetime()
+		from size_stats
+		where width >= 600 and site not in (select site from reindexes where state='running')
+		group by site;
 
-function isWindow( obj ) {
-	return obj != null && obj === obj.window;
-}
+	insert into version values('2020-11-11-6-device-class-reindex');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-11-7-ref-category-reindex.sql": []byte(`begin;
+	-- The previous migration set the ref_category of all referrers that aren't
+	-- direct, campaigns, or generated to "other"; recreate ref_counts from the
+	-- pageviews to classify them.
+	--
+	-- There can only be one running reindex per site, so add ref_counts to
+	-- the size_stats reindexes that haven't started yet.
+	update reindexes set
+		tables    = 'size_stats,ref_counts',
+		first_day = min(first_day, coalesce((
+			select substr(min(hour), 1, 10) || ' 00:00:00' from ref_counts where ref_counts.site=reindexes.site),
+			first_day))
+		where state='running' and tables='size_stats' and done=0 and job_id is null;
+	update reindexes set total = cast(julianday(date(last_day)) - julianday(date(first_day)) as int) + 1
+		where state='running' and tables='size_stats,ref_counts' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'ref_counts', substr(min(hour), 1, 10) || ' 00:00:00', date('now') || ' 00:00:00', 'running',
+			cast(julianday(date('now')) - julianday(date(min(hour))) as int) + 1, datetime(), datetime()
+		from ref_counts
+		where site not in (select site from reindexes where state='running')
+		group by site;
 
-var document = window.document;
+	insert into version values('2020-11-11-7-ref-category-reindex');
+commit;
+`),
+	"db/migrate/sqlite/2020-11-12-1-counts-host.sql": []byte(`begin;
+	-- Store hit_counts and ref_counts per host, so the dashboard can be
+	-- filtered on the host. SQLite can't change the unique constraint, so
+	-- recreate the tables.
+	create table hit_counts2 (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		host          varchar    not null default '',
+		title         varchar    not null,
+		event         integer    not null default 0,
+		hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
+		total         int        not null,
+		total_unique  int        not null,
 
-var preservedScriptAttributes = {
-	type: true,
-	src: true,
-	nonce: true,
-	noModule: true
-};
+		constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour) on conflict replace
+	);
+	insert into hit_counts2 (site, path, title, event, hour, total, total_unique)
+		select site, path, title, event, hour, total, total_unique from hit_counts;
+	drop table hit_counts;
+	alter table hit_counts2 rename to hit_counts;
+	create index "hit_counts#site#hour" on hit_counts(site, hour);
+	create index "hit_counts#site#lower_path" on hit_counts(site, lower(path));
 
-function DOMEval( code, node, doc ) {
-	doc = doc || document;
+	create table ref_counts2 (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		host          varchar    not null default '',
+		ref           varchar    not null,
+		ref_scheme    varchar    null,
+		ref_category  varchar    null,
+		hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
+		total         int        not null,
+		total_unique  int        not null,
 
-	var i, val,
-		script = doc.createElement( "script" );
+		constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour) on conflict replace
+	);
+	insert into ref_counts2 (site, path, ref, ref_scheme, ref_category, hour, total, total_unique)
+		select site, path, ref, ref_scheme, ref_category, hour, total, total_unique from ref_counts;
+	drop table ref_counts;
+	alter table ref_counts2 rename to ref_counts;
+	create index "ref_counts#site#hour" on ref_counts(site, hour);
 
-	script.text = code;
-	if ( node ) {
-		for ( i in preservedScriptAttributes ) {
+	create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+			coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+	end;
+	create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+		insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+			coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+	end;
+
+	-- The existing rows are stored without a host; recreate them from the
+	-- pageviews that have a host. There can only be one running reindex per
+	-- site, so add the tables to reindexes that haven't started yet.
+	update reindexes set
+		tables = case
+			when tables = 'all' or tables like '%hit_counts%' and tables like '%ref_counts%' then tables
+			when tables like '%hit_counts%' then tables || ',ref_counts'
+			when tables like '%ref_counts%' then tables || ',hit_counts'
+			else tables || ',hit_counts,ref_counts'
+		end,
+		first_day = min(first_day, coalesce((
+			select substr(min(created_at), 1, 10) || ' 00:00:00' from hits where hits.site=reindexes.site and host <> ''),
+			first_day))
+		where state='running' and done=0 and job_id is null;
+	update reindexes set total = cast(julianday(date(last_day)) - julianday(date(first_day)) as int) + 1
+		where state='running' and done=0 and job_id is null;
+
+	insert into reindexes (site, tables, first_day, last_day, state, total, created_at, updated_at)
+		select
+			site, 'hit_counts,ref_counts', substr(min(created_at), 1, 10) || ' 00:00:00', date('now') || ' 00:00:00', 'running',
+			cast(julianday(date('now')) - julianday(date(min(created_at))) as int) + 1, datetime(), datetime()
+		from hits
+		where host <> '' and site not in (select site from reindexes where state='running')
+		group by site;
 
-			// Support: Firefox <=64 - 66+, Edge <=18+
-			// Some browsers don't support the "nonce" property on scripts.
-			// On the other hand, just using ` + "`" + `getAttribute` + "`" + ` is not enough as
-			// the ` + "`" + `nonce` + "`" + ` attribute is reset to an empty string whenever it
-			// becomes browsing-context connected.
-			// See https://github.com/whatwg/html/issues/2369
-			// See https://html.spec.whatwg.org/#nonce-attributes
-			// The ` + "`" + `node.getAttribute` + "`" + ` check was added for the sake of
-			// ` + "`" + `jQuery.globalEval` + "`" + ` so that it can fake a nonce-containing node
-			// via an object.
-			val = node[ i ] || node.getAttribute && node.getAttribute( i );
-			if ( val ) {
-				script.setAttribute( i, val );
-			}
-		}
-	}
-	doc.head.appendChild( script ).parentNode.removeChild( script );
+	insert into version values('2020-11-12-1-counts-host');
+commit;
+`),
 }
 
-function toType( obj ) {
-	if ( obj == null ) {
-		return obj + "";
-	}
-
-	return typeof obj === "object" ?
-		class2type[ toString.call( obj ) ] || "object" :
-		typeof obj;
+var Public = map[string][]byte{
+	"public/all.min.css": []byte(`
+/* FILE: ./aside.css */
+aside {
+  hyphens: auto;
+}
+aside {
+  position: absolute;
+  left: -15em;
+  width: 14em;
+  text-align: right;
+  font-size: .9em;
+}
+@media (max-width: 83em) {
+  aside {
+    position: static;
+    width: auto;
+    text-align: left;
+    background-color: #f7f7f7;
+    padding: .4em 2em;
+    border: 1px solid #aaa;
+    hyphens: none;
+  }
 }
 
-var version = "4.0.0-pre -ajax/jsonp,-ajax/script,-ajax/parseXML,-css/showHide,-effects,-effects/Tween,-effects/animatedSelector,-deprecated,-deprecated/ajax-event-alias,-deprecated/event,-event/alias,-wrap,-exports/amd,-sizzle",
-
-	rhtmlSuffix = /HTML$/i,
-
-	// Define a local copy of jQuery
-	jQuery = function( selector, context ) {
-
-		// The jQuery object is actually just the init constructor 'enhanced'
-		// Need init if jQuery is called (just allow error to be thrown if not included)
-		return new jQuery.fn.init( selector, context );
-	};
-
-jQuery.fn = jQuery.prototype = {
-
-	// The current version of jQuery being used
-	jquery: version,
-
-	constructor: jQuery,
-
-	// The default length of a jQuery object is 0
-	length: 0,
-
-	toArray: function() {
-		return slice.call( this );
-	},
-
-	// Get the Nth element in the matched element set OR
-	// Get the whole matched element set as a clean array
-	get: function( num ) {
-
-		// Return all the elements in a clean array
-		if ( num == null ) {
-			return slice.call( this );
-		}
-
-		// Return just the one element from the set
-		return num < 0 ? this[ num + this.length ] : this[ num ];
-	},
-
-	// Take an array of elements and push it onto the stack
-	// (returning the new matched element set)
-	pushStack: function( elems ) {
-
-		// Build a new jQuery matched element set
-		var ret = jQuery.merge( this.constructor(), elems );
-
-		// Add the old object onto the stack (as a reference)
-		ret.prevObject = this;
-
-		// Return the newly-formed element set
-		return ret;
-	},
-
+/* FILE: ./base.css */
+@font-face {
+	font-family: 'Lato';
+	font-style: normal;
+	font-weight: 400;
+	font-display: fallback;
+	src: local('Lato'), local('Lato Regular'),
+		url('/fonts/latolatin.woff2') format('woff2'),
+		url('./fonts/latolatin.woff2') format('woff2');
+}
+@font-face {
+	font-family: 'Lato';
+	font-style: normal;
+	font-weight: 700;
+	font-display: fallback;
+	src: local('Lato Bold'), local('Lato Bold'),
+		url('/fonts/latolatin-bold.woff2') format('woff2'),
+		url('./fonts/latolatin-bold.woff2') format('woff2');
+}
+@font-face {
+	font-family: 'Lato';
+	font-style: italic;
+	font-weight: 400;
+	font-display: fallback;
+	src: local('Lato Italic'), local('Lato Italic'),
+		url('/fonts/latolatin-italic.woff2') format('woff2'),
+		url('./fonts/latolatin-italic.woff2') format('woff2');
+}
+html {
+  font: 16px/150% 'Lato', sans-serif;
+          text-size-adjust: none;
+  -webkit-text-size-adjust: none;
+}
+@media (max-width: 54em) {
+  html { font-size: 14px; line-height: 160%; }
+}
+@media (max-width: 26em) {
+  html { font-size: 12px; line-height: 150%; }
+}
+pre, code {
+  font-family: 'DejaVu Sans Mono', 'Bitstream Vera Sans Mono', 'Consolas', monospace;
+}
+pre {
+  font-size: 14px;
+  line-height: 130%;
+}
+h1, h2, h3, h4, h5, h6 {
+  font-variant-ligatures: common-ligatures discretionary-ligatures;
+  font-feature-settings: 'liga' on, 'dlig' on;
+}
+* {
+  box-sizing: border-box;
+}
+*:target {
+  background-color: #ff6;
+}
+html {
+  background-color: #eee;
+  color: #252525;
+       tab-size: 4;
+  -moz-tab-size: 4;
+}
+html, body {
+  margin: 0;
+}
+.center, .page {
+  max-width: 54rem;
+  margin: .5rem auto;
+  position: relative;
+}
+.page {
+  padding: 1rem 4rem;
+  background-color: #fff;
+  box-shadow: 0 0 6px rgba(0,0,0,.2);
+}
+@media (max-width: 54em) {
+  .page, .center {
+    max-width: 100%;
+    padding: .5rem 1rem;
+  }
+}
+@page {
+  margin: 0;
+}
+@media print {
+  body {
+    background-color: #fff;
+  }
+  .page, .center {
+    box-shadow: none;
+    padding: 0 1cm;
+    margin: 0;
+    max-width: none;
+  }
+  .page:first-child, .center:first-child {
+    padding-top: 5mm;
+  }
+}
+.page-a4, .page.page-a4 {
+  width: 21cm;
+  height: 29.7cm;
+}
+.page-letter, .page.page-letter {
+  width: 8.5in;
+  height: 11in;
+}
+a,
+a code,
+.link {
+  color: #00f;
+  text-decoration: none;
+  transition: color .2s;
+}
+a:hover, .link:hover {
+  text-decoration: underline;
+  color: #6491ff;
+}
+a:hover code  {
+  color: #6491ff;
+}
+form {
+  display: inline;
+  margin: 0;
+  padding: 0;
+}
+.link {
+  background: transparent;
+  border: none;
+  outline: none;
+  margin: 0;
+  padding: 0;
+  cursor: pointer;
+  font: inherit;
+  line-height: 1rem;
+  display: inline;
+  -moz-user-select: inherit;
+  -webkit-appearance: none;
+}
+h1 {
+  text-align: center;
+  padding: .5em 0;
+  font-size: 1.7em;
+}
+h2 {
+  border-bottom: 1px solid #252525;
+  padding-bottom: .2em;
+  font-size: 1.5em;
+}
+h3 {
+  font-size: 1.3em;
+}
+h4 {
+  font-size: 1.1em;
+}
+h5, h6 {
+  font-size: 1em;
+}
+blockquote {
+  font-style: italic;
+}
+img {
+  max-width: 100%;
+}
+figure {
+  text-align: center;
+  margin: 1rem 0;
+}
+figure.border {
+  border: 1px solid #aaa;
+  padding: 5px;
+  margin: -5px;
+}
+@media (min-width: 54em) {
+  figure.full {
+    margin-left: -4rem;
+    width: calc(100% + 8rem);
+  }
+  figure.full.border {
+    border-left: none;
+    border-right: none;
+  }
+}
+code, pre, kbd, samp {
+  background-color: #f5f5f5;
+  color: #000;
+}
+pre {
+  overflow: auto;
+  max-height: 500px;
+  padding: .5em 1em;
+  border-radius: 2px;
+  border-top: 1px solid #d5d5d5;
+  border-bottom: 1px solid #d5d5d5;
+}
+@media (min-width: 54em) {
+  pre.full {
+    margin-left: -4rem;
+    width: calc(100% + 8rem);
+  }
+}
+code, kbd, samp {
+  padding: 1px 2px;
+}
+pre > code {
+  padding: 0;
+  box-shadow: none;
+  border: none;
+}
+h1 code, h2 code, h3 code, h4 code, h5 code, h6 code {
+  background-color: transparent;
+  box-shadow: none;
+  color: inherit;
+}
+table {
+  border-collapse: collapse;
+  width: 100%;
+}
+table + table {
+  margin-top: 2em;
+}
+@media (min-width: 54em) {
+  table.full {
+    margin-left: -3.5rem;
+    width: calc(100% + 7rem);
+  }
+}
+caption {
+  font-weight: bold;
+}
+tr {
+  border-bottom: 1px solid #ddd;
+}
+table > tr:last-child, tbody tr:last-child, tfoot tr:last-child {
+  border-bottom: none;
+}
+tfoot tr:first-child {
+  border-top: 1px solid #ddd;
+}
+td, th {
+  padding: .2em .5em;
+  hyphens: none;
+}
+td.left, th.left  {
+  text-align: left;
+}
+td.right, th.right {
+  text-align: right;
+}
+table td.right {
+    font-feature-settings: 'tnum' on;
+    font-variant-numeric: tabular-nums;
+    font-family: sans-serif;
+}
+sup, sub {
+  height: 0;
+  line-height: 1;
+  vertical-align: baseline;
+  position: relative;
+}
+sup {
+  bottom: 1ex;
+}
+sub {
+  top: .5ex;
+}
+hr {
+  border: none;
+  text-align: center;
+  font-size: 60px;
+  color: #252525;
+  opacity: .9;
+}
+hr:before {
+  content: url(data:image/svg+xml;base64,PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCAzMC4yIDkuMiIgaGVpZ2h0PSIzNC44IiB3aWR0aD0iMTE0LjMiPjxwYXRoIGQ9Ik0xMy4yIDBxMS4xIDAgMS4zIDEuMiAwIDEtLjcgMS4yLTEuOC40LTIgLjggMi42LjMgMy4yIDMuMWguMmwuMi44LS40LjFxLS4yIDEuOC0xLjggMS45LTEgMC0xLjMtMSAwLS44LjItLjguMi40LjguNC44IDAgMS0uOFExMSA2LjEgMTEgMy43cS0xLjQgMC0yLjEgMSAxLjQuNyAxLjQgMS44LS4xIDIuNS0zLjYgMi43US41IDkuMiAwIDUuNXEwLS42LjctLjcuNSAwIC42LjV2LjJsLS4xLjRxMCAxIDEuMSAxIDEgMCAxLS43UTQgMS4yIDcgMXEyIC4xIDIgMS43di4xcTAgLjQtLjQgMS41IDEtLjggMi0xIC42IDAgLjYtLjQuOC0xIDEuNi0xLjMuNi0uNC42LS44bC0uMi0uNHEtLjItLjMgMC0uNHptLTEuNiAzLjd2LjFxMCAxLjQgMi4zIDIuMi0uMy0yLTIuMy0yLjN6Ii8+PHBhdGggZD0iTTE3IDBxLTEgMC0xLjMgMS4yIDAgMSAuOCAxLjIgMS44LjQgMiAuOC0yLjcuMy0zLjIgMy4xSDE1bC0uMi44LjUuMXEuMiAxLjggMS44IDEuOSAxIDAgMS4yLTEgMC0uOC0uMi0uOC0uMS40LS43LjQtLjkgMC0xLS44IDIuNy0uOCAyLjgtMy4yIDEuNCAwIDIgMS0xLjMuNy0xLjMgMS44IDAgMi41IDMuNSAyLjcgNi40IDAgNi44LTMuNyAwLS42LS42LS43LS42IDAtLjcuNXYuMmwuMi40cTAgMS0xLjIgMS0uOSAwLTEtLjctLjYtNS0zLjctNS4yLTIgLjEtMiAxLjd2LjFxMCAuNC40IDEuNS0xLS44LTItMS0uNiAwLS42LS40LS43LTEtMS41LTEuMy0uNi0uNC0uNi0uOGwuMi0uNHEuMi0uMyAwLS40em0xLjcgMy43di4xcTAgMS40LTIuNCAyLjIuMy0yIDIuNC0yLjN6Ii8+PC9zdmc+);
+}
+
+/* FILE: ./blockquote.css */
+blockquote {
+  font-style: italic;
+  position: relative;
+}
+blockquote:before  {
+  position: absolute;
+  color: #dbdbdb;
+  font-size: 5em;
+}
+blockquote:before {
+  content: "“";
+  left: -.6em;
+  top: .25em;
+}
+
+/* FILE: ./form.css */
+input[type="text"],
+input[type="search"],
+input[type="tel"],
+input[type="url"],
+input[type="email"],
+input[type="password"],
+input[type="date"],
+input[type="month"],
+input[type="week"],
+input[type="time"],
+input[type="datetime-local"],
+input[type="range"],
+input[type="number"],
+textarea,
+select {
+  font: 1em/100% sans-serif;
+}
+textarea,
+button:not(.link),
+select,
+input:not([type="checkbox"]):not([type="file"]):not([type="image"]):not([type="radio"]):not([type="range"]) {
+  padding: .6em;
+  border: 1px solid #bbb;
+  border-radius: 3px;
+}
+textarea {
+  width: 100%;
+  height: 10rem;
+  line-height: 130%;
+}
+button:not(.link), input[type="submit"]:not(.link) {
+  color: #000;
+  cursor: pointer;
+  margin-left: .5em;
+  padding: .6em 1.5em;
+  background-color: #f6f5f4;
+  background: linear-gradient(#f6f5f4, #edebe9);
+  border: 1px outset #e9e9e9;
+  border-radius: 3px;
+  border-top-color: #ccc;
+  border-left-color: #ccc;
+}
+select {
+  background-color: inherit;
+}
+input:not(.link):focus,
+button:not(.link):focus,
+textarea:focus,
+select:focus {
+  outline: 1px solid #00f;
+  box-shadow: 0 0 .2em #00f;
+  color: #000;
+  cursor: pointer;
+}
+button:not(.link):hover,
+input[type="submit"]:not(.link):hover {
+  background-color: #f0f0f0;
+  background: linear-gradient(#f0f0f0, #d0cecc);
+}
+button:not(.link):active,
+input[type="submit"]:not(.link):active {
+  background-color: #d0cecc;
+  background: linear-gradient(#d0cecc, #f0f0f0);
+  border-style: inset;
+}
+form > strong, fieldset > strong {
+  display: inline-block;
+  margin-top: .5em;
+}
+form span {
+  margin-left: .2em;
+}
+fieldset {
+  border: 1px solid #aaa;
+}
+.vertical input[type="text"],
+.vertical input[type="search"],
+.vertical input[type="tel"],
+.vertical input[type="url"],
+.vertical input[type="email"],
+.vertical input[type="password"],
+.vertical input[type="date"],
+.vertical input[type="month"],
+.vertical input[type="week"],
+.vertical input[type="time"],
+.vertical input[type="datetime-local"],
+.vertical input[type="range"],
+.vertical input[type="number"],
+.vertical textarea,
+.vertical select {
+  display: block;
+  min-width: 20rem;
+}
+.vertical input[type="number"] {
+  min-width: 10rem;
+  width: 10rem;
+}
+.vertical label {
+  display: block;
+  margin-top: .5em;
+}
+form.horizontal {
+  display: flex;
+  flex-wrap: wrap;
+  justify-content: space-between;
+}
+form.horizontal label {
+  width: 30%;
+}
+form.horizontal > div {
+  width: 65%;
+  margin-bottom: 1em;
+}
+form.horizontal input[type=submit],
+form.horizontal button[type=submit] {
+  margin-left: 35%;
+}
+form.horizontal span {
+  display: block;
+  margin-left: .2em;
+}
+
+/* FILE: ./permalink.css */
+h1 a, h2 a, h3 a, h4 a, h5 a, h6 a {
+  font-size: .7em;
+  line-height: 0;
+  color: #999;
+}
+h1 a:after, h2 a:after, h3 a:after, h4 a:after, h5 a:after, h6 a:after {
+  content: "§";
+}
+
+/* FILE: ./postscript.css */
+.postscript {
+  margin-top: 3em;
+  border-top: 1px solid #aaa;
+  font-size: .9em;
+  position: relative;
+}
+.postscript + .postscript {
+  margin-top: 2em;
+}
+.postscript > strong {
+  font-style: normal;
+  position: absolute;
+  top: -1em;
+  left: 1em;
+  background-color: #fff;
+  padding: 0 .5em;
+}
+.postscript > p {
+  margin: 1em 2em
+}
+@media (min-width: 54rem) {
+  .postscript {
+    margin-left: -4em;
+    width: calc(100% + 8em);
+    padding: 0 4em;
+  }
+  .postscript > strong {
+    left: 6em;
+  }
+}
+`),
+	"public/avatar.jpg": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("/9j/4AAQSkZJRgABAQIAdgB2AAD/4SBKRXhpZgAASUkqAAgAAAAFABoBBQABAAAASgAAABsBBQABAAAAUgAAACgBAwABAAAAAwAAADEBAgANAAAAWgAAADIBAgAUAAAAaAAAAHwAAAB2AAAAAQAAAHYAAAABAAAAR0lNUCAyLjEwLjEyAAAyMDIwOjAxOjAyIDAyOjIyOjU1AAgAAAEEAAEAAADhAAAAAQEEAAEAAAAAAQAAAgEDAAMAAADiAAAAAwEDAAEAAAAGAAAABgEDAAEAAAAGAAAAFQEDAAEAAAADAAAAAQIEAAEAAADoAAAAAgIEAAEAAABaHwAAAAAAAAgACAAIAP/Y/+AAEEpGSUYAAQEAAAEAAQAA/9sAQwAIBgYHBgUIBwcHCQkICgwUDQwLCwwZEhMPFB0aHx4dGhwcICQuJyAiLCMcHCg3KSwwMTQ0NB8nOT04MjwuMzQy/9sAQwEJCQkMCwwYDQ0YMiEcITIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy/8AAEQgBAADhAwEiAAIRAQMRAf/EAB8AAAEFAQEBAQEBAAAAAAAAAAABAgMEBQYHCAkKC//EALUQAAIBAwMCBAMFBQQEAAABfQECAwAEEQUSITFBBhNRYQcicRQygZGhCCNCscEVUtHwJDNicoIJChYXGBkaJSYnKCkqNDU2Nzg5OkNERUZHSElKU1RVVldYWVpjZGVmZ2hpanN0dXZ3eHl6g4SFhoeIiYqSk5SVlpeYmZqio6Slpqeoqaqys7S1tre4ubrCw8TFxsfIycrS09TV1tfY2drh4uPk5ebn6Onq8fLz9PX29/j5+v/EAB8BAAMBAQEBAQEBAQEAAAAAAAABAgMEBQYHCAkKC//EALURAAIBAgQEAwQHBQQEAAECdwABAgMRBAUhMQYSQVEHYXETIjKBCBRCkaGxwQkjM1LwFWJy0QoWJDThJfEXGBkaJicoKSo1Njc4OTpDREVGR0hJSlNUVVZXWFlaY2RlZmdoaWpzdHV2d3h5eoKDhIWGh4iJipKTlJWWl5iZmqKjpKWmp6ipqrKztLW2t7i5usLDxMXGx8jJytLT1NXW19jZ2uLj5OXm5+jp6vLz9PX29/j5+v/aAAwDAQACEQMRAD8A9ozS5pKWtzIAaXPFFGKADNGaKDQAmaN3tSGkoAcD7Uu72popaAF3UbqbRQIfu9qN1MozQA/dSFqbmkzQA/dRuplGaAuP3Uoao80oNAXH5ozTaM0CuLmk3UmaSgY7dRuptFADt1IW9qbQaAF3e1FNooGPpabmloAcKcKaKcKBAaYaeaYaYCUUySVI8bmxn2qs+o2y4/e/+On/AAos2JyS3LoINBx61zzeI7WNG3XWGxkfuz/hVQ+LLXcFN7yf+mR/wq/ZS7GP1mn3OrzSZrDt9ftpNrG5ypz/AMsz/hWlBf29wF8uTcW6fKRUuElui41oS2ZZzzS00HJpak0CiikpgLmikooAWlFNozSAdmlpoNLQIKKKSgBaSkzSUDFpKDSZoAWijIooEPoFNzTgaZQ4dadyKQGgmgBc1FNKsSBiD1xxTia5LxDrawxtGrTqyTbSVOOmfenFXdjOpNQVzO1vxW8N40SPKAkjr9xexrLXxBNL/wAtH4/2FrkNQ1A3N/cEPLxK33j6n61Il6Is7i/PpXVFxR5NT2ktbnQTXXmZ69MdBVKRv4h1A4qKO6SeNggYMflBPrTDa3GOZR/30a1dRWOVQkmWrfVLiKRVMh2DPAUV0Wn6zPHFHKsjBRnjaue9cRLFLA5kZ8qOwJrTsLxWt40+fPP8zWDqp7m/s5LWLO6XxQ0agyNKRjsi1r6Zr1vqEnlIkocJuJZQB2HY+9ecxXIeVkO449ant5bmzmadZ3VXGBscg4PP9KiSi1ob0q9SLtI9WpDUNvcrNu2huMdamNYnqJ3EooooGFGaSkzQA+jNJmigBaM0maSgBc0maDTaAHE02igUAFFLRQA4GlpDRQUOzS54puaM0CIbyYwwhhnlsdcV5H4mknS5uJTcSFWuWwm44GSa9avF8yELnHzZryzxaN4kj6bbk8/99UnLlJlTU9GcaVIkdyclzmpWhL4+cjFaun6Z5qsfOxwD93/69WBYY/5af+O1LrIj6rLoc+vmwyKRO+AQcAkZrRgvZHxkt1xy1aQ0veQfOx/wH/69WodK2kfvv4v7n/16yddmywKa1MtplJw8YYd80kfMwKfIOyjtxXTR6f0/e/8Ajv8A9erUVhsYN5mcdttZuuzojgIWOahjKuW3k5FXW1BUiVDAG24GSev6V0Ig4+9+lY2t6Z59sB52397n7uex96SrSYPAwWtjstFvGn8/gjbt/iz610IOa8V8I332T7Z+737tn8WMY3V7Ojbs8dK6ou5zOPKONFLSVYhDSUpptIBaM02g0AOzSZppNJQA/NJmm5pc0AOzRSUtABRRRQAbvpSbqZmjNMY/dRmo80uaAJM8V5jr6CW+ul54uHPH1NemDkV5zqqA6nd9f9e//oRrGrsaUtyTT4FS3Xryi9fpVhgPWo7diIlC84UU8rXI2ejCIlOANNAwakBHqKi5uoiox4FO3HNRlgDkEU4ONuSRSHYdvPoKYW38HFNLg9xTC2OlNEyRzItDbdFcbv7wr2SBAu7FebXsCtszu716ZGMZrsonl11Zj6KbmjdgVuc4GmNSlvpTSfegApKTNGaQCkU007NGKYDaUUuKAKAF7UlNkniiBLyIoHUswGKjW6t3PyzxN34cGkBNmio/Oi/56J/30KKLgM3e9G73ptFMY8GnZqMU4UCJAa851By2r3q5zid//QjXoEsyQqGdtoJx0zXA3Aim1e8Kckyue/8AerKs9Daim5E0ciQRIWO3co96z59WSPbifGc/wf8A1qi1C6dDGgfG3Ixj6VhzXdq23e/04Nce53q6Rupr9mR81zznj92f8Kf/AGtDJ/q589vuHr+VcLLcKXBgbjHp3/Gp7W5uS6gPxvHYU3EcajOzF+3mcy8f7v8A9al/tEbsGXj/AHf/AK1VbK2ea3jd03Mc5OcdzWZq7y2jzbDs27cdDjOKhK7N22lc6Jb+ADJl/wDHT/hUq3lu3/LT9DXnLa5cqSDcYAOPuD/CtOy1hpMbp8/Jn7n/ANar5WjD2t2dxdMp2YPrXoYb3ryyC5afdufdt9sV6cjhs4NdNHY4cRuSlveml+OtMLe9MLe9bHMPLe9N3U3NGaAHg04VGGHrTt3vTGSUmabu96Td70APzTJZNkZbOMUjOACc8CsTU764Pmx28nPG0bR7etJuwJXMzWdTkk1Ce0WbIO3C7fYHrio4Lho1GxsSbcHis+4huRdteTr6ZfI9MdBU9uGZt3UEZrJspF/7bcf89P8Ax0UVBtb0opXKOuxS03NG6tyLDwKeOlRhqcGphYq6oQtqpP8AfH8jXFKANRuGA6s38667W3xZJyf9YP5GuEV3TUJ2Z2KlmwM+9cld6ndho+62XLizFwQQsfGc7hWVPpKDbmKD/vn/AOtW9bSrtywJyBTZGjbHyfmBXLc74RTRybaOpPyRwD/gP/1qmtdJMZXcsJw2eB/9atx2hU48sZ/3RT4lV13BRgH0p8zD2aRLawhLdAAoxnp9ayNXtBM8wKoc7eo+lbqSIqBcHP0qtcIsm47RzjqKWxpJJo4ptJRXYvFAQT02/wD1quQWcHCrDECF5OwVvCKIkgxIcf7IqxFbQg58mPkf3RVc7MVSRWsLV4vM3lTnGMfjXoGnsx83cxPTqfrXGTER7cDGfSuo0WUv5+Sxxt6/jW9B6nHioaaGsaaaCaburqPPsKaKYWpN31phYfmjd9ajzRmkOxLu+tGaYDTgaLhYz7+9EEojJflM8dO9Zst1EULbW3euBmna8G+2ptOB5Q/max5mZbZjuP5+9ZSepSLdzPHNatEFO445I465rNilLSGJGZWQc84HHFZ8txJ55Akcf8CPpSgyR/vN5y3cHmpBmrif/nqf++jRWZ9ok/56P/31RQFz0bNANZUuuafEBm8tTn/puoqA+JdPDAG5tuf+nha05kBvBqcG9qxE8QacRn7Za/8Af9auQalZzorJdQHPTEoNHMAur/NZoOn7wfyNcZqQ2KT1/ef412szQ3EYUTxjBznINcvrGlhEMi3AbdL0C/X3rCrBvVHZh6sYx5WYUlxsVflzketUJdR24/df+Pf/AFqs3A5CZ+7kVmTQvFtwrNn0HSuY61JpG3bQ7Yid3Q+lZ+p6p9jcxeTv3R5zux6+3tVOO6iQeXI6IzHgMwB/KnSIjHiVelNIfNfYqLrPAPkf+P8A/wBapbfXsXKr9m9f+Wnt9KDbxnrOoP8An3pogiDf8fCfp/jV2RN5HQlftFtHJnbvAbHXGRVdbzy5Gj8vO3jOeuKyjdRxqFR0kYcEBhmlSVpztMZUdc1Fh+0N37Xu/gxj3rutEXYJ+c52/wBa88s7Z5t+xWbGM7VzXpNjCbcSZP3sdRj1raine5y4ia5bF4mmlvamlh7U0sPauo4RxakzTCwpN49vzoAkzS5qLePb86USD2/OgCYGnA1BvHt+dKHHt+dAGXrPN0v/AFz/AKmsW4by4WOM4xW5qzKsckpYfJETjPpmuaV1u4dwYKG989KzluNEcU3m3Ij24z3z7U4226Rhv7ntUT262zG580MV/g6Z7UxpPtAxjb39am4Ms/ZP9v8ASiqnkf7X6UU7iscdPfPKUyE46YqCS5feOF6elPe0ZSpAOB15FRTQSscxLuXHUkVkrF2HreMqHGwnNaVj4iu7SONUjgO3ONwPfPvWVb6fcyoWEfOcfeFTGykj4dSCOvIovYVjfXxrqCcCG0/75b/4qnSeML+6QRyRWoUc8K3/AMVXPrbHOWyF7HNRyfueT93OAap1BpanRJc/aMuxQE8nB9akJWTqRx6VjiRraCJyBiVQRn6f/XqeO9BzyPyNc7R6EJaFm80GCWZbgGcvGvygEYJBz6UyKxYkb0kXn0xW9A6yRlgehxTJmRTnPampdzVRiY76ZGZTkyY/z7VWm03a52LK3pxn+larT/McYqSFgxBJ5p3Bxj3Mu20KJX81vPUsvOcAZP4VLLEluMKxODt5Na1xMI4VJxjOK5+7u9zuBj757Gpu2zKVkdN4fcJ9o3EDO3r+Ndi2pwL1ngH1cf415xZagLfzN5UbsY4J9atXt1MrRKVT5iR/L3rpg+VWOCo+aVzuH1e0X713bDjvIP8AGov7ZsycLeWpPoJR/jXmGqX9xDdLHsTBQHke596rRXsqyBwqZHsap1LGdmetHU4dm/z4NvrvGP51Vn8QWMPBvrMNnkNMv+Necya9ci1MGyHH+6c9c+tYt1cfaJWaTAY4yAPaj2gWZ69B4gs53Kre2bEDOFlB/rV1b9CARJEQeh3V47pDxw3TOW6xkdPcV1w1mG3t4QzqMqMfKfSmprqFmdt9tj/56Rf99Ufb0H/LSL8687uPFIXb5LRN1zlGqFPGAZDuaEN2HltT50KzO71S5WazuBvTmFhwfY1zNlK8caRquUGeSKoReJUuozCzR75DsACNznirtpKqlIpTtHOcVEmmUkxLq5cyPHhccUsMuMcjpVW6bOousfKcYJ+lNVpUYkqMdqkZpecf9mis/wA5/QUUWEQxR2oyLkcHpyfx6VZS30hoXCr85yF5frWL9pd+GJJ7cCnxXJSVIyTuZhggcUKKRZtW1pbpCQEw27jk1E9pC10Q6ZU9eT6UsEsi3Cq7ZU5OAParMyAxtKvDetHKgKs2nwGMeVF3/vH/ABrn5ohNPJAq5KMflz0wcVv3N+La1Tdu3bsEgD3qPRdL+06jLcS7GjlQuBkg8kGs5qyuXCLk7D5tLElhZZhziIfxew965qKRkzvOM9K9CuEVEjjA4QbRXJ32lA+X5AVeucsfas1I7XCyRtaa4ePbnOXx/KtM2SSDmPPb71cjYaqbcqrFyd4PCj2rfj1wFT/rOv8AdFS1Y0jJW1LLaYm7Ah/8e/8Ar0n2ERc+XjH+1UQ1clur/wDfIqvea2I4nP7zIx/CKNQvEqatceVDgPjEmOn1rDCySSMzDKnkVOs0uqXUkZbK5LgMMd/b61J+7j/dlTleDj2rSKsctWV1oS7APvj6VcuLlHALvllyU471VY+d93jHrUbnKkvzgcVscpWu8XEweT5mAwD0qFowqnA5p0jnd8pwMVHudj14rJt3HYjdeCSKh8hWcsVyD71ZkGIyTTM4jBFHMxWGRRlHJUY4xU1zLNIkShs7RjoOOlRq+GOelSblboDS5mFis8ErY2L9eRUK2MqEbo8DOfvCr4nRezVIsiyoTg+nNHMwsVbaPy7yEgYw6n9a6mEPJIr9Qe9c6gCzI2OAwP610sEco09btWURc8d+uKuLAZcGJCxHEwx6/wD6ulNSaJ1AZsnHPBqGTdNOWz19fpUy2u1Q3HI9asQ7MP8AnNFJ5P0oouBlWlub4SeTtXZjO7jr9PpTZIWtbuKOXDOSCCOcc1f8K30UjXKG1T5igySOOvtW1f2sBbzzDGTGmcbB2yetZyqcsrM1ULq5gmRoLlLh2YxIMFQeT1H9amm1u2a1MapKGPfA9frVyC4t7j9y1nF8x6kA+/pVLUrCNBLMgRVGMIEAHYU1V6MPZ9Tm9Y1ZHTykMoZXBJ7dPr71Y8K620OpSedLcPGICAu7IHK9iarahapMvAVW3A7tvtXPO8ltO4ikdCCVypxkZrTScbCi3B3PZHlE8UUi5w67hnrzWVMcbc81Q8Nau97ZeU6NmCONdzPndwef0rQkHTNcjXK7HoxlzRuYdzb/ADZjCrhe3HNUJDcoeJ3AxnhzW+8IbuOnpVaS1QnnaeP7tWmZSiY63FzgD7RLn/fNOVbqZwWnZlPZnJrQNog7L/3zU1tbL5y9Mc8Ypmepd0+1WFUcomTGASByelZV7OsNxKzA48wjj610mBFAmAOgH6V53rt873VxGu5Ns7chvc06auxVUlE61SOccVWvkkHl7WxnOcHr0p2j3ovvOzEF2bepz1z7e1aKWqyI5Yg7RxkZqnK25z8rMMRsFO4g03eqDkVfukWJwgUcr1xUccSlSSoPPcVlJk3sUZHDggZ5qJkcRg7uM+taRSNG3eWpA7Yp8YjmO3ykAHPQUuYZnwQtIcZHTPNSvbOuMFR9DWkfKjUYhTPTIAFSIUYcxKfqKLgYi2sndl/OoIo5UIUv1PYmug3xH/lgg/AU1lh3D9xH+Qo5yNTPjhbbkkda6bTgZdJigzyc9en3iax2ZAwAjAFXra7MKrtTgZ4BxThK7HcbdwPDO4yBjH3T7U+2uVRv3m5htxjrS3Ev2iNn24LY75qnFzIV9BWwzU+2W/8AzyP/AHyKKo7aKYHD2k/2a8gl27tkitjOM4Oa77TdV/tG2ZvJ8vLlPvZ7Dnp715qZieOKnsrgw31uw24EqnJ+oqqtNTRcJWO31RfIuVfO7CDjp3NSWl15tuibMZzzn3qu1yL6wkO5G5A+Q59DWZGfJvgp4A9fpXKldWZtcs6nFtmaTPUgYx7VzWpw5jzu6yen1rrJwJrRDnPzdvxrEuUBZl54atISsRJGFY3/ANgMo8rzN+P4sYxn/GvTY5PPzxtx75ryq7QpcPweWPX61taDrDWf2jc0K79v3zjpn3961qQ5ldFUanK7M7wL2zSmHPO79KejRy8xuHGcZU5qdVwp61zWOp6lBo+2adFF+8B3fpVpl706MgEUEWKWsSeTYRnGfnA/Q15jfNuv7g4xmVj+prt/Fd2BYqoZCRPjGfZq4Nm3TOfUk100lZHPWfQvaFc7PtHyZzt7/Wur14faLNm+75cbn1zx/wDWrgo3MGduOfWu1sZlkguYty/Ou3g88g9KmorPmIg9LHL2t35GF2bstnOcVtWmrYHleR945zv9vpWXrFp9nu1VVfHlg8j3NZyuVPSrcIzVyNmdnFdZlD7PwzW7ZRfaoFOduQT0z3rz2zucypG5VU5y3St+3hieFWV9wOeQQe9c8o8jFKVjrP7Lyc+d/wCO/wD16U6HvwftGP8AgH/165f7LEvzBmJPUZFOC9ueKnmj2I9ojZuNF8rb/pGc5/g/+vVRbXy1Kb8574psO4buKvwEAdR1qW0WmpGe8PlqV3Z4z0q7pkXmyRRZxnPOPrVmR9wPTGMcVVUrBOJQwyPU8dKcHqNqxpahB9n0+Q7t23HbHcVhb9vzY61q3Ny0mms3ynOOn1qG0tftBG4PgrngV0IRn+f/ALP60Vt/2Wn/AE1/z+FFO4Hneq6a2neTujmTfn/WLjOMdOPes7dhgfSvQfiHaqI7BkT7olJ5/wB2vPCa0hLmjcuceWVjqfDt0WtzExQbpCcd+gqe+TbcSSDPb6dBWDokxTUYlLYUliRj/ZNdJdgPaO45zjn8a56itMuLuia0PmWUeffp9TWXcDE8g/2z/OtDTjiBV9j/ADqldriVzjq5qY7jexzuqR4dCAeS39KzyK2NSGTH+P8ASseuqGxjLc6nR/E88SrG/wBmVWlGS2Rgce9ddaa3ZTgB720DFsACVf8AGvJ6VJHidWQ4KnI+tKVNM1hWcT2N7i12krcREeu8VVkv7aIlmuYQB3Lj/GvMhrWoqu0XHH+4v+FRS6peTArJNkHr8o/wqFRLdddjV13UjdTTRq0TIJ2IKHORz71jKfmNR5JJPc04HFbJWRzyld3Bl3YzW5ol2XuDuKD5k/maxalsJTBcoAdpZ17ZzzSmroE7M6XXYfOZplDNshPK8jjJrll+Y/NxXc28LXelXOV3sQyjnH8NcbqVs9lcrG6bCUDYznuf8KzpPoVPuR7QBwc10GizFo1hfaqKpIPTnP8A9euZWQ5xnitbTJWY7FbkKTjHvTrRvEzex1kcEZ5LHBHXNSC2j7M351mRXnAQycqORirIuzgfP+lcPKyFHuWlZV6MPzqRJUyPmXr61nLKvPzfpTHmUA7W5xxxT5TRGw02OFKkGoZ+YmPesyK5lx9/nPoKf9onL7S3H0FNKzByNSN82AiJH9etTw30lqBtCcDb81ZqORECTzT94dQCc10rYDU/tuf+7D+R/wAaKytq+lFAFrx9MDBaAt1WXt7LXm5612vjufetiAW6Sf8AstcSTzWlJWiaVXeRYsZfKvI3zjGecexrq4pPP0oNncD36fxVxTMRyCQfaur0aTdo8Ckkn5uv+8aistmEH0L9kdp2+gP86r3vc/7dSwHbcsP9n/Cob0/Jn/a/xrBblvYw9R6x/j/SsetXUmwY/wAf6VlV1w2MZbiGkpc0lWIbzRjnmlJqaC1kuCoQqN3TJouluBEDilzWtB4du5DnzIMEZ5J/wrYtdCEYHmx274XB+XPP5VlKrFFKDZzEMEs27YucdeRWpp+lOt3G93DhVdTnd2zz0NdBJHZ22MWsa7v7sYFR6hdw20W4xn7rH5QO1R7VvRIvkS3NaFraO0kW3OAcnv1x71x/iNQ1ysjjJEYGfxNMGuK0yBGnVSQCM4zz9aTVn+1WjzrnCgL83Xr/APXppNNXJ6GONuOK0dIbFy3P8B/mKykbovetDTW2XDE/3D/MVpP4WZvY6CCB/MLsvysODmpDuBwO1WI5F+yxHH8I/lSsgYAgAZrhb1M+ZlQPjvVeaVt42HjHpVoxEelNa0cgj5M4pplJsjhmAX5m+bPHFSGVt24N+lMW0dPvFSetTLCdo6U7oLl62zLbr3Y5/nTosGZk7jORRYusDR7xlRnIH41dujCYVeKMIzHJIUAmto7FkOwelFQ7m/vH86KdgMPxPdC4Fry527/vfhXOk1JNL5mPlxj3qAnmtoqysNu7uDH5a6bQ3/0OBOf4v5muYPSt/Qmx9nXH97+tRW+EcNzZj/4/5B/s/wCFR33EY/3v8alkl8uQnGe3WrVrd7mxs/h9a50jRs4zVH+aPr1b+lUUQvnBHHrXeSN5kjcYwTTc7fetFVsrWJ5L6nIR6TPKPlePrjkn/CrUPh+6LAl4CAeeT/hW3Lf+Vn91nAz96s+48ReWdn2XOV6+Z/8AWo56kth8sUXLfR402+ZDbtjOflz/AEq2ILS2X/j2iBX+7GK5a41X7QrDydu7H8Wf6Vnu29y2MZpqlJ7sXOlsdhPrlnbDHkyjB2/Ko/xrNuNeWQHymuE+bPXHH51gZpCatUYoTmzV025uJ/N82eR9uMb3Jx1qC7e5wolndwc8Fyafo7bfO4z93+tTazHt8nnP3v6UbTsH2TLAA5AwRV0MW0WfJJ+cdfqKojrV22fMZix945zVy2JRlA4lq5ay7HJ56dqhul23rjPp/Kmj0p7ok7m1+azgY8gxqefpU6iodMGbG2/64r/IVYc7SBXnSWpHKR7PXFIzgHaQcnoaNme9Q7PMYc47UhWaJAMfe5qdWj8oHZ+lV/suGA39fap1t9g+9nHtQOzF3qTwMU8lioyxI7DNIItw+9+lKRxt9K6KexcdhKKNvvRVlHAE03uKDR15roJF6sBW3oxxNAP97+RrDB+YGtnSDm4h/wCBfyNZ1fhLjual7F5sh5xyO3tWd/agtJGXyt+35fvY/pV+/laNcgD7wHP0rmrli0jk92JqKSutRzLsur+axP2fHJ/j/wDrVQlk8zHGMe9MFJWqilsTcKKKKoQlFLSUAJRRRigCSKbys/LnPvXRTBbm3lCMCQh4HOeK5nFa2mXLlpBheSP61nUXVFRfQoSRPCwVlYHGeRirekyCLUoZTj5d3BOOxrRuLOO5RpXZgyqQADx61lqghuUC5PGefxoUuZA1ZkuszJNczuGXJ28Zz2FZC8mpL2Qm8ccdv5VGh+b8KqKsiXqdVp8q+REuRkRjv7CtSN1x94fnWBpXzuAf+ef+Fau0CuKorSIdi2QD0NNU4U5qsbl06BeaiF3IykELzx0qLCvc0FdR0YHn1oe5yShTA/vZqgrlRxj1p3ms5wQOfSiw0zU09v8ATY2X5jzwPoat3KEliwK5buKoaQxXUYcf7X8jW7qECi2WTJyzDP5GtqexaMnYP71FP2D3orQZ/9n/4gKwSUNDX1BST0ZJTEUAAQEAAAKgbGNtcwQwAABtbnRyUkdCIFhZWiAH5AABAAEAEgAWABNhY3NwQVBQTAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA9tYAAQAAAADTLWxjbXMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA1kZXNjAAABIAAAAEBjcHJ0AAABYAAAADZ3dHB0AAABmAAAABRjaGFkAAABrAAAACxyWFlaAAAB2AAAABRiWFlaAAAB7AAAABRnWFlaAAACAAAAABRyVFJDAAACFAAAACBnVFJDAAACFAAAACBiVFJDAAACFAAAACBjaHJtAAACNAAAACRkbW5kAAACWAAAACRkbWRkAAACfAAAACRtbHVjAAAAAAAAAAEAAAAMZW5VUwAAACQAAAAcAEcASQBNAFAAIABiAHUAaQBsAHQALQBpAG4AIABzAFIARwBCbWx1YwAAAAAAAAABAAAADGVuVVMAAAAaAAAAHABQAHUAYgBsAGkAYwAgAEQAbwBtAGEAaQBuAABYWVogAAAAAAAA9tYAAQAAAADTLXNmMzIAAAAAAAEMQgAABd7///MlAAAHkwAA/ZD///uh///9ogAAA9wAAMBuWFlaIAAAAAAAAG+gAAA49QAAA5BYWVogAAAAAAAAJJ8AAA+EAAC2xFhZWiAAAAAAAABilwAAt4cAABjZcGFyYQAAAAAAAwAAAAJmZgAA8qcAAA1ZAAAT0AAACltjaHJtAAAAAAADAAAAAKPXAABUfAAATM0AAJmaAAAmZwAAD1xtbHVjAAAAAAAAAAEAAAAMZW5VUwAAAAgAAAAcAEcASQBNAFBtbHVjAAAAAAAAAAEAAAAMZW5VUwAAAAgAAAAcAHMAUgBHAEL/2wBDAAMCAgMCAgMDAwMEAwMEBQgFBQQEBQoHBwYIDAoMDAsKCwsNDhIQDQ4RDgsLEBYQERMUFRUVDA8XGBYUGBIUFRT/2wBDAQMEBAUEBQkFBQkUDQsNFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBT/wgARCABEADwDAREAAhEBAxEB/8QAHAAAAQUBAQEAAAAAAAAAAAAABQECBAYHAwAI/8QAGgEAAgMBAQAAAAAAAAAAAAAAAwQAAQIFBv/aAAwDAQACEAMQAAAB+jGl/XOUy+Wkp1RsjZbtXmZEs3LzNBU6en22ktJTZOU1REnwCHYdoev9nzfKUkgcepZLoPM7NMX6HOxbn1/OevAce6KG6uPSCcQHU5bWuTvHi3lmbGZsYJmDlmSBqGZOPhVuKm6ySZlFh240Xs9VOsmcWtABTBZL1ZibdhVLWWR9KnrowmsSBCzxsoahgOwbGElGlySlwGlc2dw3/8QAIxAAAQQCAwABBQAAAAAAAAAAAwABAgQFEQYSExAUFSIxMv/aAAgBAQABBQJk69Irstra2tpnXL80anJrhbEsVmzQOtrfx6LNtB8jC1XZGmO2PszN22v0o5cEmaTLOShK4EVKmatTrDPAvYXospYcYonmybN5nvazFm47U2mLpCqY3LmCSvzJiSJyQeUTWH1Xi8IU8nu6xbVRrB/EhW2SNWWqceh4yhFVJkq28t+UKfJwOLMZJsjauTecKVx5ga3Feonctr0yl8MzV/t3VQ+jFK1cmYeJ0SmwG7d1D+8kSTWPgz6Hh5OwmPJ1KLdP/8QAJBEAAQMDBAIDAQAAAAAAAAAAAQACEQMSIQQQMVEgIgUTMkH/2gAIAQMBAT8B2uHfnqGveLWpnx1UntUdNqaP748tMAeU23+KtBbHhChaVgsBKa1jDKNNmSnNhxChAbQtOSDCAVV1gUFQUAdvrlaeGm0olzMBVsxK4x4N9Tan9puoBHsqr7zhO7WZ5U4ztMulOzgKzteqKcDKB2Cfzue0VO3/xAAiEQACAQMEAwEBAAAAAAAAAAAAAQIDERIQEyExBCBBIjL/2gAIAQIBAT8B0t7wlGPLJeZGw68J9e1d8juQun6ZIueRL9F3IyfQnwXJvTORVlmJi/TNxI3EOaem4k7NFRZLKJwyH1om/pcjLnSqskplLuxKi/hThiuSC7gyeUZWFdPRr8WKbxd2bt/5Q9xq74IxtZnkcTMhD6KSWOsfsTye0WI9H//EAC8QAAIBAwIEAwYHAAAAAAAAAAECAAMRIRIxBBNBcRAiYRQgI0JRgSRSYoKRocH/2gAIAQEABj8C8Lahfv79Lh6N1LZLCavMtQSkvENppOdNn95Ga3lpde88rp9oU1A9fcuCbdvB1qkhNIzec5qm+2rrOctXfIzvEJ6jwA3Bve8xTsDnE1ajbfKi0/E0kGkXJVc2gcFmU9LXtKQYl+pHpNHs50jqDH5lLlqNs3vERLrY3NxMpEqrUWori4F9/SVuHroA1RbIf8mmifh/QyhznvUrnJjDWpI6A7TLgQFnB6YhBXI9ZX4Jz1I/cIlYYfDXmniVZX/MouDA9O4RBZbyjxa+XV5WPqIp5zrjYMZ+sZvCWp6yTe941YfNV1f3NKDUdt58atTpfe5gCh67E7tgSpSsERMqo6TsbT5ptaL3jAEgenitcYdlN/4j9/Ckfqgn/8QAJRAAAgECBQQDAQAAAAAAAAAAAREAITFBUWFxgRChscGR0fDh/9oACAEBAAE/IapRFHhOg/SY3dPdDHhl4io24l8iE4ABDibIwymHpqxg3y+Eyl8nGDJhFagioMRDSIUJDElATRwji1jUJgYgGSvuDiNbG+DhIWcMS2YRkqhPaDJByAHRogYsIQKh2gyCfMm0SSjQCoLwUuWE+CAucTQoDYIJUxoRaZuvOXBRUzYZEW9iGM47W0gjeyCDVbK/1BivB9WBeUT1MAaju5GWAw2H3EpdnctYARyNgxPeQWGv76ggMSJ0Ro+kVhiDeOQKAAuDBIFiv8AibE2FbuEAKUP1bxH/ALkjzA0jVRMYClnLHGP1T4RhEYCUTOErQyPYRLqAxfF4Y5jo419xkxfbv7hp11RkFZrrCkTVdQSe/TGGoxCWMrE2aZUKqkz2KM//2gAMAwEAAgADAAAAEFNK+jFun+IQSa6lwqfV3Z01o7nzl+BRZcY6tf/EACIRAQEBAAICAQQDAAAAAAAAAAEAESExEEFRIGGBobHR8P/aAAgBAwEBPxDbblztbNz42y2ih7T+LRgh/wB7k80/f9O27DnIG6nzt8D+wW5anAvcXxC+BEuOW5cnSBNX9bbXPPqWdb4onLJCAj3NI8vX9XwhbKXLYrRc+i1eywtq70HdhDzCL0Jbhktjh4P5q4I4dgjLhzLVMkaJI5NuxLOPgup7s85haR1f/8QAIhEBAAICAgIBBQAAAAAAAAAAAQARITEQQVFh8CBxkbHB/9oACAECAQE/EAlS264riuUNrCUMRuhTL5uXGBU8koWDj6KkRqRNUqpdQ0MpMZU3Bi7fxAoVmCY7lcZiNQqHdCaJb5iwrHy4Q9W4GRhwDBMp5T7ZjgS1gC/BjpPWoJvWYPtA9D9QqLiEKzUpb8QLHE6FZaID1lgZt3/Zaw7IKKwZvjCpxuZq6IM0TFjP/8QAIRABAAICAgIDAQEAAAAAAAAAAQARITFBUXGRYYGxocH/2gAIAQEAAT8Qybl8LlLkmsV+pY0se5zBpuKZti129wBzDnqmKQuz/T5+GPbytv3Bp6p+43XRxYb+kcUuR7i5NQ5GC6Zd5uWVEFirFOAFX1UulUdNV5DUNg4rNnQN7JYjdAmghQoLV4I2BsiXpwKdOy5zEd0iwTJBWsn3GUYT+htyKfOoBYbYDvSvDhxZLwBve7QzKWr7jR7GYaCfWeYqZQEBzQ2x1XFQfQy6DANGFlJrvuXvKxNDdbLT+9MDGQaHiwXTe73ANrSSnIAZe95iK3gKAoArOh3pI000XuTVYfjSxtd3BFQ95THw5qZsJxjQ6HFaripgg2VEZwA1vaijcssLFkbLrdrrYiuJXKKTU9COvMprN4dID5GvMZBHTPAJdZZGASENFQbRMf7DJwTsEafI17RUAUM9diMQDRdIi11UHziB1N7qzSj5B+puRPBnNDaeqT5gYJGLIbU4z+E6ZV6FWe1/SHUMDoGMXQLP04gFaiuyWw2fMVauOO01VRnOwHza9NS3ISTQzNpwLFbp1v8AL9+4yyztZaumVdMTrDVMAC7EVgNQDCW3PQP1G0S8MFFCIU6pUFtq6bpStY55jjc0/YqcWVD7A5cxgoEURwjEUsR2Atdo0zD6BSsKjf4Q1LFwFOfMwttbOaTnwT//2Q==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/count.js": []byte(`// GoatCounter: https://www.goatcounter.com
+// This file (and *only* this file) is released under the ISC license:
+// https://opensource.org/licenses/ISC
+(function() {
+	'use strict';
+
+	if (window.goatcounter && window.goatcounter.vars)  // Compatibility
+		window.goatcounter = window.goatcounter.vars
+	else
+		window.goatcounter = window.goatcounter || {}
+
+	// Get all data we're going to send off to the counter endpoint.
+	var get_data = function(vars) {
+		var data = {
+			p: (vars.path     === undefined ? goatcounter.path     : vars.path),
+			h: location.host,
+			r: (vars.referrer === undefined ? goatcounter.referrer : vars.referrer),
+			t: (vars.title    === undefined ? goatcounter.title    : vars.title),
+			e: !!(vars.event || goatcounter.event),
+			s: [window.screen.width, window.screen.height, (window.devicePixelRatio || 1)],
+			b: is_bot(),
+			q: location.search,
+		}
+
+		var rcb, pcb, tcb  // Save callbacks to apply later.
+		if (typeof(data.r) === 'function') rcb = data.r
+		if (typeof(data.t) === 'function') tcb = data.t
+		if (typeof(data.p) === 'function') pcb = data.p
+
+		if (is_empty(data.r)) data.r = document.referrer
+		if (is_empty(data.t)) data.t = document.title
+		if (is_empty(data.p)) {
+			var loc = location,
+			    c = document.querySelector('link[rel="canonical"][href]')
+			if (c) {  // May be relative or point to different domain.
+				var a = document.createElement('a')
+				a.href = c.href
+				if (a.hostname.replace(/^www\./, '') === location.hostname.replace(/^www\./, ''))
+					loc = a
+			}
+			data.p = (loc.pathname + loc.search) || '/'
+		}
+
+		if (rcb) data.r = rcb(data.r)
+		if (tcb) data.t = tcb(data.t)
+		if (pcb) data.p = pcb(data.p)
+		return data
+	}
+
+	// Check if a value is "empty" for the purpose of get_data().
+	var is_empty = function(v) { return v === null || v === undefined || typeof(v) === 'function' }
+
+	// See if this looks like a bot; there is some additional filtering on the
+	// backend, but these properties can't be fetched from there.
+	var is_bot = function() {
+		// Headless browsers are probably a bot.
+		var w = window, d = document
+		if (w.callPhantom || w._phantom || w.phantom)
+			return 150
+		if (w.__nightmare)
+			return 151
+		if (d.__selenium_unwrapped || d.__webdriver_evaluate || d.__driver_evaluate)
+			return 152
+		if (navigator.webdriver)
+			return 153
+		return 0
+	}
+
+	// Object to urlencoded string, starting with a ?.
+	var urlencode = function(obj) {
+		var p = []
+		for (var k in obj)
+			if (obj[k] !== '' && obj[k] !== null && obj[k] !== undefined && obj[k] !== false)
+				p.push(encodeURIComponent(k) + '=' + encodeURIComponent(obj[k]))
+		return '?' + p.join('&')
+	}
+
+	// Get the endpoint to send requests to.
+	var get_endpoint = function() {
+		var s = document.querySelector('script[data-goatcounter]');
+		if (s && s.dataset.goatcounter)
+			return s.dataset.goatcounter
+		return (goatcounter.endpoint || window.counter)  // counter is for compat; don't use.
+	}
+
+	// Filter some requests that we (probably) don't want to count.
+	goatcounter.filter = function() {
+		if ('visibilityState' in document && (document.visibilityState === 'prerender' || document.visibilityState === 'hidden'))
+			return 'visibilityState'
+		if (!goatcounter.allow_frame && location !== parent.location)
+			return 'frame'
+		if (!goatcounter.allow_local && location.hostname.match(/(localhost$|^127\.|^10\.|^172\.(1[6-9]|2[0-9]|3[0-1])\.|^192\.168\.)/))
+			return 'local'
+		if (localStorage && localStorage.getItem('skipgc') === 't')
+			return 'disabled with #toggle-goatcounter'
+		return false
+	}
+
+	// Get URL to send to GoatCounter.
+	window.goatcounter.url = function(vars) {
+		var data = get_data(vars || {})
+		if (data.p === null)  // null from user callback.
+			return
+		data.rnd = Math.random().toString(36).substr(2, 5)  // Browsers don't always listen to Cache-Control.
+
+		var endpoint = get_endpoint()
+		if (!endpoint) {
+			if (console && 'warn' in console)
+				console.warn('goatcounter: no endpoint found')
+			return
+		}
+
+		return endpoint + urlencode(data)
+	}
+
+	// Count a hit.
+	window.goatcounter.count = function(vars) {
+		var f = goatcounter.filter()
+		if (f) {
+			if (console && 'log' in console)
+				console.warn('goatcounter: not counting because of: ' + f)
+			return
+		}
+
+		var url = goatcounter.url(vars)
+		if (!url) {
+			if (console && 'log' in console)
+				console.warn('goatcounter: not counting because path callback returned null')
+			return
+		}
+
+		var img = document.createElement('img')
+		img.src = url
+		img.style.position = 'absolute'  // Affect layout less.
+		img.setAttribute('alt', '')
+		img.setAttribute('aria-hidden', 'true')
+
+		var rm = function() { if (img && img.parentNode) img.parentNode.removeChild(img) }
+		setTimeout(rm, 3000)  // In case the onload isn't triggered.
+		img.addEventListener('load', rm, false)
+		document.body.appendChild(img)
+	}
+
+	// Get a query parameter.
+	window.goatcounter.get_query = function(name) {
+		var s = location.search.substr(1).split('&')
+		for (var i = 0; i < s.length; i++)
+			if (s[i].toLowerCase().indexOf(name.toLowerCase() + '=') === 0)
+				return s[i].substr(name.length + 1)
+	}
+
+	// Track click events.
+	window.goatcounter.bind_events = function() {
+		if (!document.querySelectorAll)  // Just in case someone uses an ancient browser.
+			return
+
+		var send = function(elem) {
+			return function() {
+				goatcounter.count({
+					event:    true,
+					path:     (elem.dataset.goatcounterClick || elem.name || elem.id || ''),
+					title:    (elem.dataset.goatcounterTitle || elem.title || (elem.innerHTML || '').substr(0, 200) || ''),
+					referrer: (elem.dataset.goatcounterReferrer || elem.dataset.goatcounterReferral || ''),
+				})
+			}
+		}
+
+		Array.prototype.slice.call(document.querySelectorAll("*[data-goatcounter-click]")).forEach(function(elem) {
+			if (elem.dataset.goatcounterBound)
+				return
+			var f = send(elem)
+			elem.addEventListener('click', f, false)
+			elem.addEventListener('auxclick', f, false)  // Middle click.
+			elem.dataset.goatcounterBound = 'true'
+		})
+	}
+
+	// Track how far the page is scrolled down, and send it once when the page
+	// is hidden (i.e. the visitor navigates away or closes the tab).
+	window.goatcounter.bind_scroll = function() {
+		if (!navigator.sendBeacon || goatcounter.filter())
+			return
+
+		var max = 0, sent = false
+		var update = function() {
+			var d = document.documentElement,
+			    h = Math.max(d.scrollHeight, document.body.scrollHeight) - window.innerHeight
+			var p = h <= 0 ? 100 : Math.round((window.pageYOffset || d.scrollTop) / h * 100)
+			if (p > max)
+				max = Math.min(p, 100)
+		}
+		update()
+
+		window.addEventListener('scroll', update, {passive: true})
+		document.addEventListener('visibilitychange', function() {
+			if (sent || document.visibilityState !== 'hidden')
+				return
+			var data = get_data({}),
+			    endpoint = get_endpoint()
+			if (data.p === null || !endpoint)
+				return
+			sent = true
+			navigator.sendBeacon(endpoint + urlencode({p: data.p, sd: max}))
+		}, false)
+	}
+
+	// Add a hidden link to the endpoint; visitors never see or follow it, but
+	// bots that follow all links do, which marks the session as a bot.
+	window.goatcounter.bind_honeypot = function() {
+		if (goatcounter.filter())
+			return
+		var data = get_data({}),
+		    endpoint = get_endpoint()
+		if (data.p === null || !endpoint)
+			return
+
+		var a = document.createElement('a')
+		a.href = endpoint + urlencode({p: data.p, hp: 1})
+		a.rel = 'nofollow'
+		a.tabIndex = -1
+		a.setAttribute('aria-hidden', 'true')
+		a.style.display = 'none'
+		document.body.appendChild(a)
+	}
+
+	// Make it easy to skip your own views.
+	if (location.hash === '#toggle-goatcounter')
+		if (localStorage.getItem('skipgc') === 't') {
+			localStorage.removeItem('skipgc', 't')
+			alert('GoatCounter tracking is now ENABLED in this browser.')
+		}
+		else {
+			localStorage.setItem('skipgc', 't')
+			alert('GoatCounter tracking is now DISABLED in this browser until ' + location + ' is loaded again.')
+		}
+
+	if (!goatcounter.no_onload) {
+		var go = function() {
+			goatcounter.count()
+			if (!goatcounter.no_events)
+				goatcounter.bind_events()
+			if (goatcounter.scroll_depth)
+				goatcounter.bind_scroll()
+			if (goatcounter.honeypot)
+				goatcounter.bind_honeypot()
+		}
+
+		if (document.body === null)
+			document.addEventListener('DOMContentLoaded', function() { go() }, false)
+		else
+			go()
+	}
+})();
+`),
+	"public/favicon/android-chrome-192x192.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAMAAAADACAMAAABlApw1AAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAC91BMVEUAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///+6n5FbAAAA+3RSTlMAAAEMHz1ZdJKwxdLf6/b5+vv87OLZy7acg2hJKxcIBx1DcJi6+P7x482xi185GwMagbPb9P3wfEggBiif1d2saS4KHlqm6Ld2Nw5CldjuwoI6GV5gHAJ3z4kJInrW7bJdFcpruXLTeCG4cdBlERjOu0xN9/KjNn3moKEsk/PX3OoPb71YJyYtO09kf74478hqYY3kdRC00VX1rU4qR8fhkDU8lBKdQVer6cZFhTMNBVwUedrAJYgvBIqZlxYp5aUL5z4wVIZizI4T1ErDqoDJY8RWUlMjP56WZnM0mrXej4eiW4xGm6+kkSSEbERuQFB7MeDBvL+oS6kyfoqce0QAAAABYktHRPw8DqN/AAAAB3RJTUUH5AEYEwUuVg1BVwAADj9JREFUGBnVwXuczXX+wPH360zkOnPOQSpmRpdzxrUzMUcZl5iRaoqQOcy0RDe3hhaJikxEJbOYKLmlJJSZFJmKsKuLpLKllKL69Wu7/VZbtm1/739+v8fqNvP9fma+Z875Tp3nU6qHJ+mkevVPbtCwUeMmTZNTvD5/crPmLU5peeppp7dqnZoG8vsF6W3OOPOss1s0CwTVyp/Rtl37Dh07nZMO8vtDKLPVuZ27ZGkNwl1bNDzv/G7ZIL8jZHfv2KNnsjqV3OuC3n1yQH4XCOX2vbC5X6MT7nfRxZfkgfzGIOnSy/p7tTaCAy4fOCgH5LeDZ/AVLTM0BgM6D8mPIL8NPN2GDvNqjHw9CzoVgtQ5PFf+YXhY42HEVSNTQeoUjDq5f1jjxXv1NZkgdQf6XOvXePINuy7Xg9QV+rTTePNfP3qMB6kTjBqrLgj3Py8XxH0wzq+uCI+/oQjEbUyYqG5JufGPhSDuYtJkdc+Um6aGEFdxs7pqWsEYEBdxqrorOL5vIYhbYLq6LeuWWz2IS+A2rVEwMGPAtF4zh/ccfnvbWTNSwhqtLr2LQdzBHVqj8Ow5fe4ck39OZma3ufPuuvue+T3ubZHh1ygEGi6IIK5gUInWKPCnhYNBfgSRnPxOixbfW+pVx0rvWwLiApKWqgP++0/u5kF+BU9S9wcuu3+ZOuR9sA+IC1jeVZ3wzzx3RQiphEjqyoJVXYPqSIuRaUj8kd3Br44EVz+0JhukEjxFDw+cGFAnZjzSBiTuWPuoXx3q12NdIUhleFIfW78hqDULbnw8hMQda58oUadKHlyeB1IZpG0aON6rNdtwcRkSd5TfvcqvTmWd/eRmkCrwDL6hUYrWKGX6SSDxBplPPR1UpwKrFuZ7kCpgc8ctAa1JsNHDHiTu8Gx9YrU65mv81NYIUgUU1b8xWWuyelE6En9E5j00TR0L3/7MtgqkCiie08inNZjx7GaQ+KNizXP91LFg6fOD0kEqg+3j+ge1ev72O0BcQPnUR15Q55rtXLkLpDI8Wzvs1hq0+zOIG6j4y+LVQXVsSuc9RSCVUbFgZ5ZWr8ljIcQVhFq/2CuojiVveSkVpBIorD8+qNUacV0h4g4ic+f3D6tj3pfvmABSCYx5qESrlfzKXsQleK4c/apfHfNvPD+CVEb5vtf8Wh3//jEgLsGTO26iTx3rNySCVAYTXt+g1doyD8QtkLnwDa861W8SSBWEzmgU1Oq06wTiGtj+5oEUdejqfKQqyG8wQ6vTfyWIe2DvyNnJ6kjwZBAL0vvO1Oo0/2sEcREseat9hjpxoAixglv3p2g1Zt1QjrgJdj1+U1OtWbNNiB2KhzbVamTcl464CrIXPNJWa+J7G7FFaHlPrcaymwsRl1Ex78Wnw1qt8CLEHsxbGlaz5NNyELcROTh0vE+r4e+LmDBqcZaaBQqSENdB5umNAmqU9Q5iRPqbzdUs5ZU8xH2wt/7YFDXoeQ5ihmfdMDXzPrIEqQPwQIYaNIgg1YDWY4Nq5DurCHEdTB2uBqVrkGpB5rteNfIfKkbcxtxVauAdGkFqQN7AKWrka1CGuAoyl6pBsEceUiOy3+unRt5XkhA3UdwjqAZLMxEHiNzdS41Snt2FuIfCZ31qsGouiBOw7no1CryYg7iF0PvJajBzKogzcHiVGk2en464A88VJWpQej6IU9D9bDVa9n4F4gZoVaoGA0Z6EOcgd31YTTLeiyDxB5fMVIPk60JINGB7D7+aNO3rQeIN7vyTGvieSUOixN4PfGrywnKQ+ILMD9Ug+G4xEjWWPOJVkyNngMQVS25Rk86DkVog72iKmvRcAxJHFHbwqUGjrSC1QVJBQE02zgWJGyruS1aD4WtAaoddTwTUZGkmSJzg6ViiBm1XgtQWOR+lqMnHRUh8wL4X1GDEJyC1x64OXjXwLc5B4gEW9FeDZeNCSCwoO+pVg8mjK5DYQfc31MD7URoSG/Ju86lB15EgsYJzWqpB8NFiJFYsed6vBqvXgcSIoo/VpP0EkJixdnpQDdqtAIkJhf/lU4MDW0FiB6kXqEnnVCQWVFw8WQ16HgaJB8i9SA2Ch3YhtYdnUVc1aP4pSHzA3MvVIDA0hNQW/HGaGozoCxIv0OdlNRhQH6R2oFMLNVh2RwiJH1gwUw2O1AOpDdhxtRp4n0hH4glWtlWDN+aC1AL5Y9Ug+N95SHxB3xFq8NkoJHqs/ZuaNEwFiTNCd2SpvfDnhUi0yHnFrwavfQESd6R/6VV7yV95kOhQMXqyGry6CcQFlD0XVHsvfA0SDTwvdVWD1V+DuAFSO6vB1QeRKMCk3WrQbA6IO2BHOzXYmYc4Bnc9rQZZ/xNB3AJ/X632vH8IIQ7BilPUwPtlOuIeeLKr2mt2DMQZ2lyrBsEPyhA3UfFNitrrOQ/ECdbuVJPPUhF3kfRBUO1dNQpxgJyjfjW4/CDiNgZfpPbCBdlIjSh/KqAG1/8DxHXcOl7tTbkCpAZ43sxQg2/PAHEffN1W7fU6DFItuHu3GjR7DKQuwKIMtbd0M1IdeLiLGmR9FUHqBuUD/WrLP7ACMYPWL6uBd2A6UlcoWq/2RiwHMSL3RjUIn1WG1B26D1N7E7ciJuy9UE0e3I7UIdi3Qe3dsguxR87nfjX47kqkThF5P6C2Al+B2KH8m4Aa3P8XkLpF0rtqr/ldIFZ4bshQg28vBalrnHRA7c2egFjAsQ1q0PQBkDoH9ZqrrfCLFUgVUO92NZhxTQT5DcA9WWprxD6QytjRTg1Szs1GfhOkHw2qrVVtkEoo3q8G4eeTEKeg+JKR466rf7gMJHYMnq32jpYjvwKLUtTgglGIQ7C393cDwqrhZseHLEFiBnc1V1td3wb5BUu2qME/xyAOwZqzffqjlPa3gsQKvgqorYkHkV8wqETtjd8G4gy8M1x/ZdhhkFix6xa193w68jNOV3tN1oE4xJ3ttJIDuUjM2DpRbU3ZA/ITTlNbs/aAOET5WVpFQQiJFSwfobZOyUV+wjNqZ0bvCOIUnZpqFc1bIzGjYqBfbXWoQH7ExWojZX424hS8rhajQWLG5g/VVrOVICewPKAW4cuSEMdIG6sWG9ciMYM1TdTWllTkBMa0UIv1oxDn2N5YLbImIbGDawJqJ3yeB/kPPIu1qnu7gThHmxZq1TAJiR1l36utI62RE1gxUysb1gckCmxvrFbLPgGJHX1aqK2bQf4D+g7QX+vyDkg0SPtQbVzfHYkdvJmsdr7LQ04g9N4G/cXMr0GiAgPVzvq9SOzIma52mrRBfkTo/NdS9ISs/dtAosSfS9RGuEESEju6v6o2Sr9AfgKjOq7v3zRjw/hH/5UEEi3yjqudlMVlILGCIclqVboV+QVk5/c53Hp7CCR6cGZQ7XgPbQeJFd3bqtXqk5C44c5eaivceQVIbOAlv1o1KkbiBk+BGjR+OxuJCYXt1UYBSPywopcaZBw9CSQGrJmlVhl/R+IIzlWT8PgheSC1Ba+rjdl5SDwxZrwaTR67PAekdhh1tVoFzwSJJ/gkS81m/HBGDkhtsDxZrZqvQOKLtOe0Ol0bvrUWJGqETlUbN5UjccbBdlqt5Eajt6WDRIetR9QqsAeJN2i1W6sX3L3+yfwIEgVYGFarYROQuMPz3hStiXf4Q/86JwTiEElj1caLIPFH+espWrPk4dOH7CgEcYK7RqjVgEGIGyhr4FMnfKUfDr20TTZIDeAjtXHRLsQVFD/kVWeCWU+3nz/pixwPUg0GD1OrcG8Qd1D2bECdC6w+/vnplx4si4DYYk9ArY5sRdzCrvkZGpVw1urX3r3v7TXdikMglVF+k9o4NYS4huwrVmv0Ukp6bdxf0PvYgrkTdoVATmBFW7VKnoS4CM87L2stBQMZL1y/Zf1t87/q22pB6y9yRz0VVKs3RiFugm80Zv7krk1LuzRupjbmg7iJou/UTbMOI26CY8nqpvaFiJtI/17d5H8JxE1MnaVuajEGcRN8pK66LIK4ifxX1U0zViJugo4+ddOBIsRN5FylrhoN4iYGjVAr3/HGXo2LadsQN0EHtdH/4OC+P0wLauz2ZyNuIne42igA0vu8v7TUp7HxfoK4CV7yq9WsqYgIpHXv2KNnssbg1XzETRRepTYuSENOIJS575nX+vm0lhZ7EDdxeINapcwB+Rme4nlzOhxvHtDobbgEcRN8ozYmTkAqg11bl89v33OET6NyWzniJooaqY3XQawgffCmx+Z/f8ruyerQxm6Im+DxGWrV79+ICYTW7ti3sMMFG5uUeLUGp2wCcROez9XG9+lI9SC0pNsle8589m9nT1w9YJlf7SzbuQPEVeT3VKvAAyCOQKRw1JhNK+cs/N/Pd7b85xuNu5Q2LZmRHPD6UrKO/HAsCXEX1Peq1bBUJFoQyU4qSm1zsPXUdfuO7Znz1qVj0kFcRvmFauMjkMTA1iZqVVIPJCHASJ9azS5DEgOh6WrjOpDEQG4LtSqdhyQGeDtFrX7IRhIDnkNq5X0SJDEwobFaPd0NSRC0WqZWt4SQxAAd1Mp/BUhiYEkjtfp2LpIg+McstVqfjSQGeDOsFsHTQRIDkVPVato8JEGwuZ1afViIJAj+3Uyt7kMSBZ/41CKjHpIgoECt7k9FEgRpLdVqegRJEGQOV6trkETBtqZqMeUdJFHQarJadGmDJAp6B9XiokIkQcCXanUIJEHguUytTkYSBaGP1SL8VyRRkP2ZWkw+H0kUpF2rFhn1kERB9mdq0W8ekijwHFKLLvlIooB7wlpV+zQkYbDjW60ifA2SOIi8olWMz0USCF8M00pmjARJIPDpt/orgdPSkYQCnw7TnzX7JgdJMHBwcRO//r9gyVWfViCJh4o+49YfP96yYGUZUjf+DydfdURXdgyEAAAAJXRFWHRkYXRlOmNyZWF0ZQAyMDIwLTAxLTI0VDE5OjA1OjQ2KzAxOjAweOZGlQAAACV0RVh0ZGF0ZTptb2RpZnkAMjAyMC0wMS0yNFQxOTowNTo0NiswMTowMAm7/ikAAABXelRYdFJhdyBwcm9maWxlIHR5cGUgaXB0YwAAeJzj8gwIcVYoKMpPy8xJ5VIAAyMLLmMLEyMTS5MUAxMgRIA0w2QDI7NUIMvY1MjEzMQcxAfLgEigSi4A6hcRdPJCNZUAAAAASUVORK5CYII=")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/android-chrome-512x512.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAgAAAAIACAYAAAD0eNT6AAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAAB3RJTUUH5AEYEwUvIQpxwQAAAAZiS0dEAP8A/wD/oL2nkwAARrRJREFUeNrtnQe4lNXVtjmcQ29SRUXsgogKGnuNBXuvscSuscRoNBbsPXb97CUaI9bYG/aCvSsWVEBERClK7yD/vxZ5BmeYOXDOYebM++5939f1XPH6vhh03r3XXnvtVRo0gLJxW8d+rgpTlam5qY2po2lp03Km7qaNTTuZDjAdazrddKnpJtO9pqdNA0yfmYaZRpnGmMaafjGNM403TTBNMk02Tc2S/3eGm740vWd6xfSk6X7T7aZrTBfpzz3atKtpPdOq+mdcxtTJ1M7UytRU/z7+78VHBgCA6A/6Kh3wfmCubOppWtfUx/RnHbDXmR4yvWEaogN7hmmmaZY0W5oj/ZaluQvR/6tG2f+dzP/OnCzNztIs/bPMlEMx1PSOHIY75JicZDrQtLVpbVM307Km9qZmOAYAABDqQd9At+DOptVMG5l2Nh1jutL0qOld0/e6gf+/gOWRh4GKUtxs6ms6yLSdaUNTD1MXRQ5wDAAAIFUHfnPd6D1Uv7vpLwqZ32N6TWH5GYEf9LXVNEU4/OniQdPVpr+Z9tLvuIKiBSwyAABI1IHfU4f9qabbTP110x2jEDqHfO3lTxC/KpfhGdMtihh47sMmihQ0xCkAAID6OvBbmHrpXdvfuP9reluJc9zsS6tZSmp0p+BFUz/TBaZ99bzSBIcAAAAW55DPZONX6P3ek9eOMN1oesn0uelnHUgczOXVdNOPpk8VKbjMtI+eYRopSlCR9V1Z5AAAkHfoV+oW6SVsW+nt3t+lv1N53EwO3FQ4BB4l+Nb0shwCf55ZXnkEjbIdAgAAiPfQb2xqrQNiD2Wnf6n352mLKJlDyc8jmK7qgxHqaXC2aQP1V6AMEQAgsoO/mZrr+Fv+UaYH9IY/XfXtHPph6jdFcSar9PJC05Zy/lqTVAgAEOah30iZ45uaTjM9p654v3HgRxsdmKvEzQ9Nl6ur4eqmtjgDAADpPvQr1HHOa8mPU8b+GA4/VI3cGfjEdL26Ma6vp4IKdhQAQDoOfs/cX0e96u80fU09PqpDyaH3cvi36UQ9FXQkKgAAkMzb/oqmg023apDNFA4yVCRnYJDaNp9n2iETGcAhAAAo38HvCX3bmP7P9JZpNLd9VOIyw6EqMbxKJYadcAQAAOrnpu/1+h10239Sg3TowofKkTPwoyoKLlLDqMaZBEKcAgCA4hz8DfW2v6pG5X6kWn1u+ygJFQVTVUr6kKoJOsoZ4JkAAKCOB3+lOvNtouzsTM99SvdQEjVHuSfvm/6hEcdtcAQAAGp26Gc69HlS356mh7Nq9jlkUFqiAp48OFJJqZ44uJyiWGx0AIACh7/34l/LdILpdQbuoICSB1/Uul5PUQHyBACAg183/rX1vv+eQqkcHCjEdsTfqGplb0W5KnEEACDGg7+RDv7zdPAzcQ/FojEaYXyGaSNFvzAMABD8wV+lYTyXqhf7NA4EFKnc6f1MXSv/ZFoSRwAAQjv0M1n9a5quUKvVqRwACM2vIPjJ9KqiAitkqgfIFwCANB/8VZq0dqV683PjR6h6+cjiT01na1xxI5wAAEjb4V+pkOaJOvh540eo5pqqfeOOwMpqfY0jAACJv/U3N21nel5lUDTvQajueQJD5Aj0NLXAEQCApB7+PiDlLNNYjDdCRS0jHGw617QujgAAJOnw96SllUw3MKAHoZLqW9PFps1wBACg3A6AH/69NRCFRj4I1Y8GaTSxj8VuiRMAAOW4/XdTYxMOf4TK02EwM3cARwAA6u3w90z/e8jyR6ismm36Tntxx8zTAABAKQ7+BjIyV9DUB6FEOQIj9By3kyICjCQGgKI7AMeQ7Y9QYh2B0abHTVvLWccRAICiHP7e1ncAhhahxLcZdif9Zs3gaIYTAACL4wA0NV1I0h9CqUoWHKZZA6toDDcGDQBqffvfUpnHGFaE0ucIvGM6zLSsqSGOAADU1AFoY7oaQ4pQ6mcNPGray9SWZkIAUJPbfy8NKcGIIpR+jTTdrmZCjXACAKA6B8ANxBEM90EouGeBQYrs9SIaAACFbv+dVVaE0UQozMmDHylRcJmsfY8RBMABmHc7mIChRChoTTa9btpfOT8VOAIAcTsAlaZ9FC7ESCIU/rPARNPDpk0zMwZwAgDidACamy7AAUAoukZCY0zXmNaQHcAoAkTmACxheowEQISidQS+NZ1sWp62wgBxOQAdlSmMMUQoXs0w9TftbGqNEwAQhwPQWcNFMIIIoR9M/zT1UH4QhhIgYAdgKSoAEEJZmqVqgUM0bZAkQYCAHYDJGD2EUIFugnea1vbcACwmQHgOwNI4AAihhSQJfmo6QQnDJAkCBBYBmIShQwhVo7l6JvQhQxuaqngWAAjDAeikeeIYOoTQopoIDTWdosghI4cBUu4AtDMNoA9AYg2u93GfpijNODVv+Vnvs64fszRC+kH/6f//Uaax+nu9A9wU03QlevHNUV1nCzxt2irTQAhHACCdDoDX/PbjMChrNzZvxDLQ9K7pZRnXB03/Ml1nuliDXP6qzOy9TbuZdjXtYtrJtKNpB9P2pu30n7up7/sResM93XS+6XLTjaZ79Ge9YnpHb73fyIFwp2Eq6wItIknwHFN3ogEA6XQAmtEKuGyabfrA9A/T+qZl1Zu9XhKt9P0r5AQuZ+pt2tK0l+koOQzXyEF81vSeabCiCXP4fkjr4AXNE2mLEwCQLgegoW6QszFmZdFU3frdCVg9k2CVoPXhaqwxsmsrunCw6VTTlXIOXlMeyQy+Z7QarWjV2jwJAKTHAXCtrDA0hqx8mqIwvEdj1kx6Fzatm0ZqJd3TtLXpQNOZpruUV/ITkYLocgP8Gesvyi3CyAKkwAlor/dmjFgyHIFP9E7fK/O2moZbVZZT0EnvwhuZ9jD11fjZIXIIyCsIW7/KnvRIy9oFiNkB8NvmnzFcidI0DWm6Tm/zjdLYhCVr5HRnOQXbKKHxMSUbZioSyEEJb7jQ+6bdlWeEEwCQ4GeAHgrfYbySF1b19/Xr9b7aPK3d2LKSDpspRNxVDsHZplezKg94NgingZDnBlykhmN0EQRIqGFurAYfGK7kVgx42dUNqhhom3aDmpWE6muvlXIfTtZY2uHqPoczkH55lOc5PQk1xQkASGYUYB2F7TBayW4O5LeqW5V41zntBjVr/VXIIWiiigjve/CI6XNFB8gdSHc0YKgSBDviBAAkMwpwhjx2jFbyNV7NfPZWOD2oEGtWfkoP9SXw6oK31AkRZyCd8m6Ut5nWSnqlC0CMTsCKpicwVKnSJA1qOUolncEZ1ixnoJvpANNVpuf1LEICYfqiAe7I7aenH4wvQIJCsduoJSzGKl3yN/MX1aRn9VBvWFnlhiupHbInET6ueQhEBtIjz/O4TF0oSRAESIhxrVJnuvEYqdRGBLyp0CWqHKgIfL020iGytXrTv0dny1SVu/bXpYMnAYCEGNW2avU6BSOVauP6hQb/bJDdSyDQJ4JMz4HuCi8/ZPpFUQEiA8l+EvCI42mmNkQDAJJhUDvq8JiKkUp9L4HvTXebNtPAoYahNmfJimJ1kONzsQ6YaeQLJP4J637TGkwXBEiGIfW2rnfgBATTS2CsbsZ9dEBWBu4IZBoPdTEdqnr00QwuSnSZq7fC3lfRHDoIApQ5rLqkWtL+goEKZoTrZFV77KkxxI1CNbRZ69idnRaKgtyg55EJPA8kUu6oXmpagWgAQHkNZwPNjD9eUwMxmGE9D3g53WEqsWsSgSOQ6UDoJa8nmp5Ru2USB5OXG/CUafOQHVSAtBjPKmXrvkCzoCCHt/gI37+rciCKlq1a10uYdjL9n+ZhsLaTpc9MByt6gzEGKPMNqpvG1Y7EOAUZEfB20BeYNlF3yFjWdkONYPYS2FeUNMiaSIZGa012wQkAKL+xbKMmLE/o0MBIhZcwOFBv5X1CfhqoJtK1mulY00s4AokaKnSf6Q84AQDJMJb+jnqSaQi5AcFmZQ+W4d1VJYQNQs/Ozmow5K2VD5EjwNNA+TVLbYR3D7mCBSBNhtLfi9fTkI9fqbUOtnLgJ9PTpn3UKKphRI6AD1vaX62Wp7LGy54c+L0uHm1wAgDKbyQrtBn/aHrYNA4jGawj4C2iXzX9WeOIG0XkCCyp6YsvqOXyHNZE2eSlrNerVJDugQAJMJINNeFrF5WXEREI92lgqrLmj5ERbh6BE5DpJ9BWPRT6qz8GjkD5clX6q+NjFdEAgGQYyUxE4GDdFn8hRyDYcOwslWp59vyamTyBiNb4XqYn9USCI1AeZ/QrdQ9kvDBAwoxlJzURelWd1zBa4RriQabzVULYKoYbWVajLI8IPKj3aaJe9S+/ZJylzpYYX4AEGUi/LS1vOlkNZ5gtELa8cuBa0w5qtBOLI9BKGer3KCLAWqj/CZh3q5kVzwEACTOQ/n7aw3SqGs7MwmgFre9kkPfT8KFYnsDaqmrgSUZql6VU8A3TzrQQBkimkWyirmt99X5HyDTsPIEfTY+bjlDlQAxr3BNilzP9Rc4ua7x+NdT0t0wECgCSZyS9v3dv04WmHzCSwecIjFUuyIlq61oRcmOhLGfXo15nm4aTDFuv8iqkG9XHoQHPAgDJNJIt9W53jW6LTGYLOyIw0fSRIkArZGYOBD6JsIXa2N6mXgo4u/WXF9BfEcdKHACAZBrICtWSryVH4DtNqcOIhW2cv9Wglx7ZrYYDXuNeMbCtDqWJRATqrV/Ax6YtYxlwBZBmI+nJO2uYrlKOAANZwk/cGmG6wrS+3m2D6+62QA+BdqYjTe+RKFhvkadhSs5siRMAkGwjmTGU7gj8UyFjDGX4+jlrCmGnzMyBQNd3A5XHegTkcyJe9aIxalrVEScAIB0G0w+BnqZzNQ1sMoYseI0y/VvDh7qG5ggUWN/rKWHtO/IDSq4J6lOxPE4AQHoMZZXeit2Df4WIQDQd3h4xHaXxvCGvb8+B2UmNhMbx7Uuef/KgIoxUBwCkzBHobjpWk9mY1R6+Jmoc72n69qEmC1Zo6uDB6prJty/tdEu/SGxOhQBAOh0BvxUeoqzqmRi14DVFUwgv1rNQcE8DWWs7k/8ymu9eUg3UUCcqBABSaCwr9U68jxyBabyjBq+pGj50nXpINA2pciArSbClngVepnV2STVcnQNb8CQAkE5jWanw6W5ZddY4AmFrukoI7zBtrIE8lYE5ApWacnee/l3pHVC6fJPLTe1xAgDS6wg0VC35rqZnFULFEQi/2Yu3Gr5PJYRtQ3keyFrXHqLeIsu55buX5onJkzBXDLEXBUBMjkCFQqgeEXhM8wa4PYXf8MXLvP6j5K5gQrpZzm17JUMOUiIb3734zmR/tW4mORAgAMPZTBGBfpoUhiMQx7vuRcoRaBTgs8AGpntJEiyZPNl0B+WXYEgBAnEEPKnqVtMQngaikLfbPUCT+UJbz201cvg9kgRLIm9FfhjtgwHCMpyt9FZ8tWkwjkDw+lHTB9sG6ARUaqz2dWp1y/curjzx8izlFZEcCBCQ8Wxj2kx15d/yNBC0JpluCrEFrNay5wbsbXqVaEDRNV4OVgccAIDwjGdrJf34vIFviAgEK28Wdbdp6dBuc1lJgqtqHY/GoS16hYDPp1iGCgGA8JyACmWNr2460/S1blIY0fD6wF+j6E+DQKMB/sS1relNogFFlU9sfFQdSHECAAJ1BDxhbBW9G3+pQwNHIKzZAmcoKTTENZxZxytrvPIEvnlRZwg8Z1oLJwAgbEfAe7KvoMPiEx0cOALhjBzeP1QjnuUIeDTgcNMXqnHn2xdHr6v7JL0CAAJ2BDJvqz5v4FTTW4xrDUaeMLdawNMFs7Wh6WHNUeDbF0fvm7ZnkBBAPFEBdwRO1HhaHIH0dw48K9MxMIL1u5TpHDXDItG1eNME96VhEEB8joA3YXmC3uyp1vcqBa2IxAlopPbYz9FKuGjyXiJH4QQAxPc84I7An03/NU3GGKZSt2WqAiJZu/6k1cN0pabgsQaK02zKnwib4wQAxOcM+MjWPUz3ExFIZULgGrF0estyXt3pOdD0EWugaCOFL4nJmQSA3KeBzqZtTHdpRC1vrcnXLFV6RJXMlfUksI4GZU1jLRSlxPRGUydaBwPE6Qg01C1gI9PN6idOU6FkJwN+rHa6sTqu3ub2H8qJYJ0unqara2BXnACAuB0BTwzqZbpK8wYow0qmJmj8a0WkazUTDdja9Jq63rEu6q7ZygvqTsMgAJ4GqmQMLtBtk+5sybu1ne2NXVir826u1ys3gmjA4ulpzRlpiBMAgDNQoe6Cp6oRza8YycS0ePU+71Ws03nrtKnKXL9gbRSl4dTmugRgCAEwsPMrB44xPaaEQYxlefVFZu47azTnSeBF2ggvtt4x9cEJAIAFDe0y6kvvo2p/JuxaNo1QuJaFmRux6q5eCTxbLV6iqZdb7hTzMxMAVG9sl1Qi2vXKxsZw1n8/gB1wAAquzU6qEhjBOlksJ+Az9QupYGUBQKEbV1tNGrtYfdsxnvWjMTLOLMbCa7OlaXclsRKlqrsT8JVpv0x1AOsNAAo5Am5w1zSdafraNBPDW/JObgdgkBe6Lv0Nu7fpSVVOsG7q5gR8o7VGdQAAVGtwMxnZXjnwd9Onpkk4AiWRT3c8HINcI+d0Kc0SoIql7hqiOSKNWHMAsChHoJFaDR9relM3VhyB4skPs0MxxrV6EjhSz1Ssw7ppmOkQUxPWHQDUxBHwG1g7HVbeaGQkMweKlgS4HYa4VmvRnwS2Mg3QExXrqG7VJ0eYmrH2AKA2Rtjr1vfVMJfvmPG+WBqemQoItXYEemgA1njWUZ00Uj1BmrL+AKAujsCOphtMXxIRqJMGxzgQqIhr0PMC+pp+ZC3VSd4D5EScAACoqxFuqbajPnPgE4xqrfQWCVmLvf5amPZRqSBOaN1KUU/jOQAAFick21Rz3r1y4H2eBhapGcpqp0vb4q8/L23bwPQULYTrXI7qg6ma4wQAwOIYY88uXlXZ2n7DnUbGdrXjgLelQ1vR1p4nqq5suoXRwnUuSb1AET2aBQHAYjkCjU1Lq/nIyxjlvMYsg0wdMLRFj0R5e+tzTBNZZ3VySi8ytcEJAIBiGOVKDXf5kEjAfHmY+kaSr0rmBLQyHU1yYJ002XSJyn5xAgBgsQxyOw0boo1rbrh1K9qyltQJ8AjUnqaBJAfWWtPkBLTHCQCAuhriloRjC+oelVGyUErvCPxRT1A0Daq9E3ChBoXhBABArYxvE7UQHo0xzcu43jozmQ3qZS2uZbpXhxprsOaaYjo/kxMAAFATg9tQ40eHYUTzdDvJf2VZk8ur7HIya7BWmiQnoCVrFgBqEnbto+6AGNBc+Xv0JoRUy7Y226tPBVGp2lcHnEefAABY2MHvWs/0LkazYOj/SDr/lX2d+iH2J9M3rMlaJ66ek+kYyBoGgAUdgG6m5yn3y5N3RbyWt9TErNUq5WG8zVqttRN7RqZ8lbUMgEHNqIvpPlqxFtQzplUwmolas56E2dv0BG2ra6WxplOYHQAA2W+r11FqVVD+HLIRh39iHdcVlJg5lbVaqwFCJ+AEAGBEvevaWZRYFWz3+5VpJw7/xDsB7sBerBA3a7dmGmU6BicAIF7j2Vi1/uMxiHkaYfozxjE1a7mZQtsjWbs11k+mo2hpDRCfwfQ31P3pt15txrSHSKtYLala016hcYRpCMmBNdaPqm5pjBMAEE/YdAdNtMMI5rdQPYea6dSuba8Q2Fs9G1jPNXvq+kGOUyWrCCD8w39jav0LyofOXM0QlSCcgG1N77Cma+wEeNfPw2hxDRD24d/T1J8QaUHdberK4R/EWq9U9caLrOsaa6jpYJwAgDAP/+U0yY666Xw9bVqdwz+oNV+hQUJPMFK4xhqsTos4AQABHf4dFd6m0U++3qLWP+i1v4qaXNHnomby8tddNRSMhQSQcgPotf59TdMxbnn6klr/KPZAFxoG1UofmrbCCQBIt/FrooYfEzFqefohU+uPkYtiL3RQFGwCa79GekORMZwAgBQavUyt/88Yszz9qlr/SoxbVE5Aa5V5jmEP1EgvmHqREwCQPmO3I2NTqfWHvH3RTM7fD+yFGpUIPqVJoTgBACkxcpua3qPcL0+eBHmNqR3GLOr90VQlbzjINRuH/bCqiFhAAAk2bK41TM9R+lTwNnO3EsJ492eveH7MbqZP2BuL1CxTP1Nn9g5Acg//5VXyRK1/vjyU2R0DBll7pkrZ7nTGXLRmmG6jUyZAMg9/r/W/jlr/gnrbtB6GCwrsHX/b3kT9INgrC9dUVVK0YR8BJOfw9+zms6j1r7bWf1sOf1jEHtpApW88nS1cXlJ8nqkl+wmg/MbLE5qOpb65oIZntzbFYMEinIB1Ta/whLZI/WI62dSCPQVQPqNVpQPuJ4xSnrzW+3j9RiwYqMmeqlDdO0m0i5b3FzlaZZUsHoB6NlbeoWsHlTJR7pcfpjyHGwrUwamuUCXNk+yjRep7ddNswj4DqF9DtbHpA24qBRv9XJvJVgaooxPQQ/Xv7KmF61vTnkTaAOrPQPXUrHPeKvMb/dyTqfUHWEwnwDvgPUCEbZH9Nb4wbedRSVYPQOmMUgN15HqIcr88/aa3Wx//WsGKgSLtt5VM9+JsL9IJ+FhRSVoGA5TIGPlEs5uZbV7QAHnr47UxQFAip/sedcRjv1XvgA9QdJKKG4AiG6JWpguYaV5Qg0xbcPhDCZ2AZdRKegb7baFOwBPqSIoTAFAkA5SZYDYOI1Ow1n/PzGhfjA6U0AnwXvh3KtGUvVf93IA7M3MDAGDxDE9j0wGmHzEueRqlWmTKkKC+9mMn0y1E4hZZiXMFUzcBFs/YVKnWfxBGJU/j1f6YlqRQ3/uyg+ZuTGEfLnR/nqGnSxYOQC2NjDf62VDJbRiUXE1RrX8HjAuUYW82UJ+Jy02T2Y8L7RZ4FN0CAWpnZDKNSF6m0U+eZmo2+bIYFUiAE3AZzwEL1XemvUyN2K8ANTMsfrg9Sq1/nuao1n9VjAkkZK96FOpKEgMXOZFzS0U1WTwACzEonjjzL8qNCpYYvW9ai0Y/kLA929H0fzgBC+3T8SF9OgAWbkxaKKRIclG+fOjRptwiIMFOwC04AQt14F9Rp07KdQEWMCJeynaKZm1jMHL1g2mXzMARjAck1AnopOjddPZstbM6HmZWB0Cu8fAEmYN10GEocjXGdLipKYc/pMAJ6KyOgbTrLix3jm5VxISFA9EbDe9gt5PpK4wDtcQQxJ5eyvQfZgdUq0mmi01LsK8hZkPh79kbmd7FKBSs9b+KmwKkNBKwrJwApghWH9k7SXlPLByIzkhkav1fxBgUrPV349kV4wApdgKW1xTBuezpanN7DlK7cxYORGUguqjWn0Y/+dnCz5q6YRQgACdgJdN97Otq9bVpa6p7ICaj4LX+d/FGWLBe2J9DemEMIKD97qVvDxEJqFa+53vSIwBiMAgt1DmMWv/8w/9r1fpjCCA0J8AjWo8T8au2w+dTpmXY9xCyIfC3rtOo9S+oEdm1/gABOgHdTc+QGFhteeDNVAZAqAbAD7ZDddCx4QvX+jdh80PgTsDqpudxAgpqgul0pgdCaBu/oW63g9jkBTf9aZlyIDY+ROAErKm2uDwHFB4hfKD6o7BoIIgNv4npPTZ3nqap1r89mx0isgue47Ku6U0SAwvqK1UGcCGA1B/+a5heYlMX7At+F33BIVLb4DfczTXhEnuQr9dNvbENkObD3xvZPMJmLqgnMpPBACK1EZ4XtL1pIPagYGXAA6YVsBGQxsPfw9p3UutfUG9kvHs2N0RuK3wQ2J6mb7ELBSsDrsw8EWIrIC2buqXpCmr9C+pzhT7Z0IC9+L082KeBDsc+5Gmi6QRTc2wGpGEz+9jaf5jGsXnz9L1pd9p+AhR0Ao43jcJOFKwM2CPTIwTbAUkO5x2iBcvGzZUbtiP1G7FgAPLtRxONv/4Ve1FwZsBGXB4gqZvXF+bOpiFs1oK1/mcqOsKCAShsQxoo1H2xQt/YjtwhYQNMq3kZJSsGkrZxvYf9x2zUgrX+V5taE74DqJE9aa09Mw37kaNZqgxgZgAk6vBfS5292KT5XrtXQizJ4Q9QK7vSwXST+mVgS37XVCVYt8GeQBIOf5/3/SgdvQrqUf0+HP4Atbcty8iBxrbkyoepnUQ+EZR7k3Y23UGtf0F5RKQXhz/AYjkBK5rux57kyUsm98O+QLk2po+t/KdCUmzIXH1k2kI9z1k0AItna3qocya2JVefyM7gBEC9bkrP1P27stvZiLkarM5mlOsAFM8JWJuZIgXVXyOWcQKgXjajN+w4yDSWzZenn0x/YZQnQEmcAIYH5WuunmGXxgmAUm9Cv9XuSMvOghpvOksOEgsGoDROwG5qioPNyS0PPI9SYyj15ttUvezZdPm1/teYWrABAUpqg1yHmUZgd/JmBhzFBQRKtfl6qxMVJTn5YzvvUt0yiwWg9LaoSjlIzBvJ1Q+mXT1Sy0qBYm64bqbHddix0XL1sGl5bv4A9WqTPBH5fLoF5uUDfKyZASwUKMpG66Ikk5lssDy9qC6IlPsB1L9taqenNy4muRHJ53RpY6FAnTdXZoNdaprCxsrTB8pKptwPoHw2qoue4LBJv2umfpNORCahrhurpd7ZmMqVr29Mu1PuB5AIW9WdRkF5mqKpis1xAqC2G6oJtf7VaqTpSCUisWgAkmGz1lWSMjbqd7n9PoaLCtRmM/li2YEym4LyrOO+lNoAJNIJ2MY0EDuVF63cgSgA1GQjeTLbJqYvKfcrOIbzaj2NsJkAkucAuPY1fYe9yktW7ondgkVtIq/1f0tz7Nk4uUk1Ppa0IxsIINE2rKHacY/CbuXotoz9woZBoY2zqulJ02w2S15ZzaOZWn8ASLwt8xym0xlWlqPpptNMzbBjsOCG6aIb7nQ2Sl5jjZdNa1LrD5Aqm9ZGJcw0CspNYN6DpEDI3ihe638Ftf4F9aHmH1DrD5A+27akmpgR1cztFOhPvRWskrg3RwMNrzlVk+zYILkabNqZcj+A1No4j9qtbHqWpOacJ83H5RyRDxDx4e+lbIeaxrAp8vSz6RBTIzYIQOqdgPVMn2DXcqaXXqVcCRZKhJvC34B2MQ1nM+TJE4dOMTVlcwAEYe/8CW8nTcvDxv1+yTmS3KY4N4O/a3/BJijYPvOfptaExwCCing2UXngr9i5+frMtAW2Lp7N4N5eL9ObvInlaYYShhigARCmE+CVARepqRc27396RnkSLJQINsEqqvVnfGauZqnWf0U2AkDQNtBLnv+tPY/t+1+FxHWm9ti+sBf+0lr4M1n0eVmxr5jWYgMARGELe6o9Lh1Pf59xchx5T+Eu+CXUx55a//y62E80/4C6WIB4bOJmps+xgTllz33oeRLeQvfWj2dS619QQ0070hkLIDq7WKGueCOxg/P1hqkbOVBhLPAGamJztGk0iztP/pscQK0/QLQ2solC35Owh/OfQ+9Rd1gWScoXt4dy9jQNY2HnyTf8CZnBGCx2gGjtpFcGXMYclPmabOpLPkD6w1t/NA1kQRfsgnWhNj6HPwCR0qVM91EdNV+j9DxCPkBKD/+1TQNYyHnyCoibTZ05/AEgywlYzfQqNnK+PtU5wiJJ2eHvtf6PsYAL1rv+17QSixoACjgBmxE1zckH8HNkWexlehaxh7LuJJRVcDG/rDGYLBYAKGQ/vRpoX9P32Myc1uitsJvJX7xtVOs/g4VbcAb2ZixiAFiEHfXE4JNNv2A75+kn02GUSid70Xo5yzmUsxQ8/L9TrT+NfgCgJvbUy+CuZWbAfH3O0KBkLtRMud/x1PoX1BjTn9QPgUUDADW1rf72/Yhyh7ClHfs9q/wynICEOQB/0i2XRZpf639sptYfAKCW9rWH6W0mp85Por4mMzQIm5qMw7+PZjpz4OfKm3qcS60/ACyGja1Q7tBgnID5SYFH6ckZu1rmw39d02ssyoK1/jdQ6w8ARbC1jRRlHYVtnacfTFvRJKi8h/+q1PpXW+73oGlFDn8AKJLN9WfEU00TsbHzRii/pXwAEqvLcPgvrVp/Dvz8jP+XTGtx8ANAkW1vW9P1plnY2nlR1lsyT6xQf4swU+tPZmq+PjRtyoIEgBJdvrqqmyj29n/j5Y8l0lp/C7Cpav0ns/jyNFi1/ixGACilE9CbOSvz9ZWGzrFASrzwGmp87RgWXZ5+Nu1PpyoAqAdb7JUB25m+wPbOywd4wrQctre0C+4A0zAWXJ48Kec4ZlcDQD3a5Cq1x/0RGzxvvPrFmX4r2OHih5z6MKGqoLxN53mm1iw6AKhn2+y18BfSfn2eRpr2wwEo/uHvtf5vssAKZqHeZOrAogOAMtlo74r3AEnZ8/SGaW3scfEO/26mJ1lYBWv9H1JGLosNAMppp71d8Ht0CpwnLw3siF1e/EW1jOnfLKiCetG0OosMABJgsz1BewfT9zgB855lyclaTAegnYYu0HAiX++bNuLwB4AE2ezGphPJB5inISoNpFVwHRZSC9PZ1PoX1NfytDn8ASBpUds2mkFCR9aO/Z7PPNFC7bzI46n1L6gRKoWsYlEBQEKdgJVNz2Cv50Wvr+EpoOaLp1JlFMN5R8rTL2qCxGICgCTb8QqFvz/Hbs+7yB6i34QFsohFs41pEId/niap1rYliwgAUmDPPUp5uGks9rvfZ6Z1eLZdeNhoHZWRcODnarrpNiVFsmAAIC123S8sl6lfSewl2w9nSgMh//Bf1fQcN/88eWONRzT6mAUDAGmz7d4f/1Fs+7worie2N8KW5y6SLqZ75SVx6OfqJTlHhI4AIK1OwMYaU8601o79dsWe/744PCTyf6YZLI48+XPIeiwWAAjA1h9o+gm7Pu9St1rUNj2rXvQcmkYUlI/Y3JYmEgAQiL1vLHs/ldHB/a7T/IRoF4OPTDzWNJrDPk8+7nh/3ooAIDC77xHfexga1O9XjVFuHJWN1yLwg20f1fpz4OdqNLX+ABCo/a/Q/JK3SQrs96Vp/WieeLMa/Wxp+obDPk8TTRdR6w8AgZ8B/rz5Aza/3xOmzsE7AVmNfnqbPsL7q7bWvy2HPwAEfhY0MR1DPsA8u3+OnsSD/+irmF5REgSHfm6tv9fJLsXhDwAROACuDqZrOQ/6jTL18QtyyB/ca/0fYrRvwYzQlzU8g17RABCTE9Dd9CxnQL8BQU4NzMr8vNE0jQO/YK3/Ohz+ABCpE7CVEuJiPgf8bLwqqKeArFr/80wTOOwL1vpvo6QYLAIAxOgEeK+TIxUKj/k8GKnquPQnBGbV+h/Hhy2o70z7RlcHCgCQf1a0Ml1hmhL5uTBAZZKp/6CNdMB9z2FfsNb/eFNzDn8AwAmYd2Ysq7K4mGfCzFSXwNapOxuy3nQq9a7zNYd9wVr/81L5gQEASnt+/ME0MPIy8VGam5Cup4AsB6Cb6V0O+4I1nzdG2wMaAGDh54fnA+xhGhP5WfGWeuakxwnQP6w3srmLXs8Fa/29DHJpDv8oDVuFnsWaqM1zMz0B+V9XMfERYP5e8X1xri5MMZ8Z8wcGJd42ZE17+ivT/QrWeb6kRkgY+jiMWKWeedzhW9W0uekI099Np8vAXWzqq3DfJhoRupwqZ5gCCbHunQZqivZ45OfGJNmGqrQ4AJuT9Ffw8H9fb1vU+odvvJrpCWwfZTU/p57ns7UWqtNsTQh7X93Rdlc28BKsG4jQAXCtZ/oq8vPjE1OvRF8c9Q/XwvTfyDM4F9RcNbjwWv+GbO+gDVZbDbk6S5POphchG/hj0zWmnRUKrOAXh4j2lUeUDzWNi/wMuTPRM2L0sXYxjeDQz9H3KoVsxA0uWCNVpYP/FtOwEmYF327aXo42Pz7Esr/a6y085oul90b4i9uapH4kH197LxP+cjTWdGzwU57iNk4r6C3/83pY+/5MMNR0g56TyCWBWPbZasqhijkKMEhPIon8QDursx0H/+8e21nqbsUuDs8g+Zv8bqbnyzDfwodpvaNSqSrWF0Sw3zIR5sGRV5E9kqgS8qyPczWHfo6BvoZa/2ANUWNl839bxoiX/7nDTScqSZBoAIS+9xrrUjUx8iZypycmKVgfZjnGOeaEae8xLYNRDvLw96euk0w/JahMyJ3vzqw3iGAPdiTRfF5VxFaJ2O/6h9jONITDf96trL9KwDDG4R3+rXUDSdpUyxmmS03tWHcQwT5cW62CYz5rHit7Q7msD/J33XxjP/z9XXZ9jHCQhse79x2d4PDjVNMpilDw0SDkvegNsg6OvFXwZNOpZR0jr4/RRuVP1Pr/LxLCLg3P4FTq2yY9yXW8ZqpTcgqh78mmyrOaFfG5M1CN98pz4dQf3FWZiTEf/p6M9Sca/QRpaDzZZg3TmynqO7EFDgBEsDeXVRVOzKXnd2fyf8r1EXqYXo34A/yiWn9uXWEamZbaZGlJOpqj90EqUCCGp4AtIs8/m6SKpPo/f7J6NX8a6Y8/VYNcmvPuH6SRydT6p60Nqf/znsCahEieAo6XLY7VCfDzd8163+/6A7dUCDzGpgxXkHkdtHHx3tsvpDTB9X1lS7M2IfR92oE8tH4313s/EP1hW0fa/9+HM3TBwAZrVBootJbWISSeHHUB6xMi2au9TQMidgDGa/poVX07AJuqI1pMP/YTpu4Y16CNSrsAclu8d/oqrFGIxAnYz/RjxMnoH2s2Sb3+8OtpXnEsP/Qbpg04/IM3KDsH8LT1q+kg1ipEsmc9H+DiIozhTqu8Idi19TZ8Tj/6Wpp9HsMP/LnGsWJQw79NXG6aGcCavSOTpwIQwb7tqghtrKWBPjZ81/p0AFaOZA6A11fvz+EfhSHpHNDo0WFK1GXdQiz794/qlx/rU8Brms9TLz+290e/KZJa/4YY0SgMyE6moQGt39M1SY0PDLFEAo5TYlyso+gvKXlvgKwf+6SAZwF4fekZ9fauAkkwICdqE4Wyhl80rcT6hYj2cGtVasXaKtibI+1QX1GAXQLtBTBbb8FtMZ5R3R4uC9CJ3YFnAIhoL1eoS+0nEecDPKqciJIbzR56dwjtLcUTqJbBcEblAHgm8b8CNAZXaXAXaxli2cuVqo2fEKkDkJkQWrreAPqh/Q+4IbAf73FTNwxmdEaji7KIQwwJrs56hsiiee70Xp2iWR7F1pfq1VOafZ/1Q++cgnGptan1XxdjGaXRCDGalRkSdEFmbgVARE6AN217K+IugdeV9BlbP3IrvTmEUOvfh8M/WoOxWsDTLYdrtHEFXxsi2tNevbW7aUzEvQG2LumZpv/xQ0yjU14zvR+Hf9TGYhVlzYdoCGbpNtCE9Q2R7es2Ko2bHakT8B8NTSrpj9zJ9ExKSwLdOzxGiSPsmngNhffS7h+wIfBe6esTBYAI93Z3TfeM0QGYqIZgFaV2ArZJYUmg/zh9qfXHSJiWNT0Z+Bjr+zKjQwEi2tsN9BQwLEIHwC/lV5pa1EdFQN8UDWSYrh+GWn+MRCaX5T8RjA49gacuiHB/ZwYGTY3QCRiUKWsv9Y+8VEqeAvw2dJdpaQwhZN0SLonAGAw0bYITABHu8eUifQqYZtrDn7lL/QM31JjgjxPchcnLop7SMKMKjCBkrd8jNEo39KEh9ytvBycAYnPyt9WAt5gcAL/w3uxJwPXxI7sTsIXK6pJ4+HuiV08OfyiwdrdUuCyGxKDSdgoDSOYe90E555omRzYp8M2S9wLJ8rIaytNK0mjGWery14ObD1SzdrsG3AtgQXnzrr2ZdAkR7vOOugj+FpET8EOmJXh9hVoaqkvghwmYzOQJfw+q2UsDHABYyLq9JaIhIr43NycaBpHtcz+bNoqsKuBnVTrVu0FdV+VH5Qq5jFITlJUxclCDNbu3auZjMQwvqEsgTjHEtM+9KdbfIqoKGK1+CGX5sb0E4SwlX8ytx5C/93Y/SCVerHyoaXjwjciShO7XMCScAIgp2tdRl9NYHICeZdnfWXWYO5nu1K28lAkPQ01XmHph0KAOa/WSyOqFPUv4VlNn9gtE5gRsmLBctVJ2vF2zrPtbb41efrSj6fYSzA/wyoPzVYXQGmMGdTQM3jJ3RGRRgBmmf9EbAyLb65mngBkROADlvxBnJWF0UALSmXqHHKcyvd+kuQu54c/Vf3eWkhueNh2nt8wWhDJhMddnsxTPtlhcJ+CeTLIQewgi2e++3h/CAaj/H75CtYmdFZ44Qg0LvCf7B7qFTZT8X2CwMpfdOF+oKgOf4rYkk86giGvTHdSjTVMi7Bo2U++iy+MEQCQOQANFpYeQBFjeD9FIN/jW6tHfUbXZXfXX7fV/b61bGhP8oFRrcblIh4dkGmY9YFqVEkGIZL+vGPg00J91jqbSO+MmAvW97popMW5OpE7AXEXaNqRjIEQQAdhOzbFC3c8/6dmdjw5QA6PgzwB9TJMidQAy+si0l6p4WBwQ4l6vUnvgkPfx16aW7GGAmhsG95ifj9wBcA03nZQZnY0RgcD2ub+Nvxx4me/d7sTzxQFqdzM4QBsodifAIyHX6q2UBQKh7PFM98+QE36nqyFeJV8doHbGwbPhB+AAzO+u+YRpKyIBEMge96jW9RF0AVyF/QpQtyjAYQkYaJWkCoEvTSdnPwlgXCClDn6vwMv/5qqsfgn2KEDdjMRKgZcI1fVJwGcI9M6UCmJgIGV7u7HpqMCnf87RBaYR+xOg7k7AoZE2BlpUctHnel9sgxMAKdvT3oDuqcD36JBMAyD2JkDdjYXnAjzOoV8wxDhJ76ir61bFwoE07OkNIyjzvY7wP0DxogC/cOhXG2r8XCHVrhgcSPh+9kZflwbe6GuaJvBy+wcogtHwkOGjHPaLHCj0qAxPWwwPJHQvL2P6IvD3/5eUv8RHByiS4ThAbTU57Bfde9z7BmxAAhIkbB/7DJl9TFMD34On8SQHUFwHoI2m5c3hkK9RfsCnCrWuqfbKLCQo9x5uoihVyHvYB5n9kfA/QPENyKamoRzwtWog9L5GeC/DhEEo8/5dS6PmQ95z/zF1Yp8BFN+AVOkwm8rhXiv57/WO6e/KD6B/ANT33vU1d3bge9f/3f7C3gIonSFZ2vSK6TcO9lo/C0w0va4e7G14GoB63LdeEvd24HvsXdPa7CmA0hkTP7S2ICGwznLHaYLpadOOmrxYya0FSuwA7BbBnr1RUUo+OkAJjYnXEp+haVsc6nV3BKao1fK+phXIXIYS7VcP/98W+HTPH7WPcKQBSmxQXEtpQh6HeXFaC79mOsa0hqk5RgyKuF9XM30Y+B56Sc+TfHSAenICvCpgMAd4UR2BD5SstYmpNQYNirBX/2oaH3jnv4u4/QPUr2FpajqRqoCSOAJfmW7SwKGVM3kCALXco55s+mDg+2Ww5hvw0QHq2cB4ffu9HNol0xjTy6Z/mrY1tcTQQS3259amrwPPpXlcTY746AD1bGBc65ve47AueVMhH3H6jPoJrJYpIyT0CQvZm+cG3vffnzaOZP0DlM/QVGhWwHAO6nrpJzBBTwTe9WxXU3uVP9FgCLL35cqqMgl5PwxSFJKPDlBGY+MhuHN0OHFQ119U4FclDp6ruQOtqYVmP0p7mcYFvP5nmq5nvQMkw+j44XMz/QHKEhXwccS/mB5RNMb7CrRi/kDUe/GqwNf9OA3+qeCrAyTj1uH9AR4OvOlI0p2BmQqNeuLgNgoFt8YZiGof9tJEypDX+QBVOfDhARJigPyQ6c68gMRosulN02XqlPYHU0eMZtAOgCeHHhJ48p8/fx1vasRaBkieAdrY9DEHcOKMpt8K7zT9TdGBLvQYCG7/dY6g9n+EKmFIegVIoBGqUob6UA7exDoD3kDlSdOlig6sSkJVEOH/DUxjA1+/d2jCIR8eIKHGqKlqdMdw4CZac0wj9VRwl+lYjVWluUr69pwP6uob+Hr1HJddGKcNkHyD1MJ0SuDlSKElV/2qPgPPai7BZkoibCjRbyC5t/+lI3h6e9u0EmsQIB2GqRU9AlLbZnWyogPvqcTzUE0sbK0ITxXVBYnZZ5W6GU8LfF2excRMgHTdTFqqWc2vHKypLi+cpPflT/UOe6SeC5ZUSVZjHIKy7TM/FO/Wk06o63CUaUtu/wDpcwKaK6Q8lgM1CIdgtpwCjxJ8npU/sLF6D3RSlIBNUPo95k7XKqYfA193D9D6FyC9ToAfCKfLk+cgDc8pyEQKvjD1U/7HDqbeKjlswu2tJPurUkOipgaerHos6wcg3Y5AYxmrkRyaUWiGkgof0jPQ/qYtTD00yIhs7sXfU55n81rgzX8+0uRRPjpAAE6Ae/M/cEBGp+nqQfCK3qwvMh1j2kktbDviFNR6P21t+jnwdXMTyX8A4Rgtfw443DSMQxGnQOvgHdPjqjg4XQ2K1jN1ILlwoU9rN+rpJdT1Mdp0IN8fIDwnwDf2txyCaAFNVVLbQNPram97selg00ZECubvoRUUHg95LbxsWh4HACA8A+aJYduZ3uLQQ4tIMpymzpLeYvoz0/MKDf/DtHfWsKOmemZqpAS5hqElj2Xd/o8KvLJmptpVk/wHEKgT4EZ6TdOjgYcyUWn6E0xWjwl/Bx9uel8lY5eoV8FW6h7XTk2MWsjxrEzrwZLlPIc++GewchwwlgABOwH+xruU6QZaB6MiOAZz5BxMk4MwXoeJRw1uMZ2qqMG6phVVX+69C9rKQUh0UyPtmc1Uchnyt3xaMw4wlACBOwGZ+QFunL8LvKwJlc85+E0OwmyVKXpfig80HfE203mmv2ii5SbqY7Ca3qE7axJdk3I5CFl75Sz984f6rcZrdDXhf4CIHIEq3c4+4MBCCWhAM0o5By+qwdHVptM0G2FXPTFsoDkJKyua0E4316I7Cdojy5qeCvy3/0pjqjGMAJE5AZUyqk9pfj2HEUqqg+BJeIM0qe5Z5R/carrcdKbpBDkL+6gzoofu1zF1k7OwhJ4carM/9jD9FPDvOkvtpSn/BIj4SWAlDZ6ZzmGDUp7N/quSFP3d/l3TS6bHTPfIYbhOTsP5ijCcoCx/L5Xd07SjuihuIycj5N/Lf6vt3QHAGgLE7QTsgwOAItNs9UIYrycI75o5xPSl+maE3Pd/rppCteX2DxD34b+E3lw5FBCKZ37EGd7HAUsIELcDsK7KtzCMCMUhb/a0OuF/gLgdgKYaKYtRRCieMs371bAJQwgQ8e1/Rb0FYhgRiidZch+VAmMMASJ1ANwA7KdkKAwjQnFooBx/mv8ARHz790Yqz9ANEKGodKGpJYc/QLwOgE9v29I0EYOIUDQap33P7R8gYgfAk//+rS5rGEaE4tDjpq4c/gDxOgDe+nMt0wgMIkJR6fjMiGYAiPP27wbgYjr/IRSVvD3yhoT/AeJ2ALooExijiFA8ukVdPzGEABE7AH9V/3OMIkJxaILpYG7/AHEf/l7+0x+DiFBUetW0Goc/QNwOwE6m7zGICEXV+vdybv8AcR/+Xvt/A6V/CEWloXL8MYQAETsAa5rewyAiFJWeNrXFAQCI9/B3nWSagkFEKBp5p8/TOPwB4nYAljI9jEFEKCp9ZVoDBwAg7tv/LnT+Qygqea5PPzr/AcTtADQ3XYZBRCgqjTXty+EPEPftv7fpQwwiQlHpE1MHHACAeB0AL/07wvQbBhGhaDTDdBHhf4C4HQDv+/8YBhGhqDTG9Aef/IklBIg3/L9VBH3/vdPZJP0nxh/FLo/2PaO23xhDgEgdgFamKyIweB/omcP/XT8yzeYQQBFrpukgUxUOAEC8t/9V1QY09Nv/caYmuvH00tQzL38azWGAItQ3phXp/Q8QrwPQ2HRMBLfhQaYeWU5PA918ljStry5o75qmkQiJItHViv5hDAEidQC89/drEbyLX1KdsdPv0My0tGl70+2mH3AGUMCaprwfbv8AkToAXvq3tfqAh2zsRpk2X5SxyyqHbK7Q6PGml/T3T8EZQAHpeVNXHACAuMP//4og/H+XQv2LNHYLPBE0VL7AxqZzTS+avjWNo5IApVwnKR8GYwgQqQPQzTQscEM32fSnxb3p6O93g7m26a9KHvScgZ+oJkApk+/5Dbn9A8TtAJwZwdjfZ00rFdPQZSUQevXE/qYr9ecMMc3igEEJl+e4dOTwB4j38G9veiNwQ+eH8amlvOlkPRV4J8XtTP8w/cf0meqsOXBQkjTVdAi3f4C4HYA9TCMDN3af6v2+vn/bTiotPNB0lektdSDkAELl1pumtTj8AeI9/BsoDDgncGN3W7kSnfQbV5jaKNeij+kMU38lEf5GIiEqg7wLZiMcAIB4HYDV1BY3ZEM3MpP8lyCnK9NrwMcuH2W61/S1wrIz5JDhFKBSabhpN8L/AHHf/r3z36+BG7uXFYpP6ndorOhAZ9N6alN8t+lL01hVL5BQiIqph0xLcPgDxOsANFcJW+ilf2cl/aaT5ZBVqKqgqZyCdUxHm+5QpMbLtn4hqRAtZkTsAG7/AHE7AJuaBkbQ9z91iU4LOAQVWV0J/cngMNMN6kz4uelnHAJUCz2jtt8YQoCIw/+nBH5wzFYZXsPQjF1WBGctVRhcanrY9I5mF8zgoEMFNFrrBUMIELEDsIzpv4EbO38/3zF0Y7dAYmFPlXWermcD7/P+BS2LkZz9u/S0hCEEiPj2v13gtf9+2A2INdFJ37iFaRXTZqZ9TCearjE9ZvrENIFDMRrNVbvqHhz+AHE7AJ5gdnYEI07/Zqrkq+f0IvAxyMtpjsE2poNNF5juN32oSAEHZpih/539OYzdABD37d/71r8SwZCTFbjt1Mgp8EjBUloXf1B9+GkKF7+rMtFZyqmgP0E6neHT9ETEwgeI3AHwm8D0gA2eH1LXK0mOD183p6CZMsWXlmOwjZ4QvKPi62okM0FlltP0vjyHwzZx8gFf55jaUfYHgHH3N/FrI6j930wHGR++OOumoRoWuVPV2tRBXSR31Ejkq02Pa/DRcJUmjtMBNIuoQVk0UVM+23L4A2DEXd1VGx96nfOSGL2Sr6VMf4JK9ZRvrMhBFw1eOkBNmO5QzwJPPPzGNELNjKbjGJT0zf/0TMY/+wAAo10loxyy0f1NjXIYcpK8ZwW/ia6pqIG3oL5EnSif0zjqj+Ug/GgaT/vjOpf6vaPkzhbsAQDIGOG2ahYTsgPwRabUCeOXmnXZRH0pvKnRVqb9NA/hPHU8vMf0hOk1RRGG0g65oPw3ucXUi7UPAAvewNzAjgncCF6sUjc+fBjrNjMsaUk9X21o2kGRrONVzur5B/82PakExYyTMDaSjojj9e9+CAN+AKCQIW2sUqCQw6qjTH24/UfpIHRQ2WdPTVT8o54a9tbBeILpXDVCukvNkF6VszBMFQ1pq2L4Sf8u+5pWDLHlNQAUx1B6MtB7gYf/H1ACGh8dCkXAmmgfeDShq8ob1zCta9pEpY67mw5SjsIpchouN92kEc2PKGfhTTkP3+ognqQ+Cb9laW4dlN25b0HN1pwHr7boa9pSY645+AGgWgNYIeMWcpc3N47HcvuHIjgLDZVE2lSJdK1VR9/R1Fn5Csvp1r2KniZ66u19I0Wh9lQi3nGKvF2o8tvb5Ejcp1kcj6tq5QVFJN5UEt+rilLcrZ4WffX00V1Nm5pR5goANTFoXqZ1Y+BJUx+Z1scgQkKiDdmlkU3VP6GlnIk20hJKzG0ntddTRgf9dVv991rqwOemDwC1Nkh+Y/ky8ESoWyl7AgAAyHUADlM/95DH/h5C+B8AAOD3w9/DkA8pKSlUB2CA3mL56AAAAHIA1lBznJAH/1zH7R8AACDXATgu8PD/96ZdOPwBAAAa5PT+vyfw5L9X6H4GAACQ6wCsoeY/Ic86P5fDHwAAoEFOi9RDlSEfqgPgLVz/gAMAAADQIKf5z/WBj/19So1W+OgAAAByAHqonWioDoD3Xj/cu67xxQEAgMP/9/D/XoGP/h2igS58dAAAgKzmPxcGPvjnFk1346MDAADIAVjZ1D9gB2CqphsyHAUAACAr/N8n4Oz/uSpt7ET3PwAAgN8dAA+Lnxp49v8ZGrHKRwcAAJAD4IlxTwbsAHhb43W5/QMAADTICf9vYPolYAfgYdOSHP4AAAANcsL/xwXe+/9wzTjgowMAAMgB8MS4xwI+/L8xrU34HwAAoEFO+L+naVTADsCNpnYc/gAAAA1ymv8criY5IR7+M0wHcvsHAADIdQBaKUHut0AdgA+Z/AcAAJDvAKxkGhlw+P82UxscAAAAgN8dAB/9e7BpWqCH/3TTsYT/AQAAcm//Xv73gGlOoA7AZ6ZNOPwBAAByHQDv/jcs4PC/OzdL4AAAAADkOgAHmcYHHP7vS/gfAAAg3wG4yzQrUAfga9OWHP4AAAC5h39b00cBh//7E/4HAADIdwD+aPo+0MPfqxou5vAHAADIdwBOM00M1AFwx6YPDgAAAEDu4e96MODw/1s0/wEAAMh3AFY0vRtw9v9VHP4AAAD5DsDuAb//jzZtjwMAAACQe/i7ztWUvBAdgIGqcOCDAwAAZDkAzU33B3r4zzRdrxHHfHAAAIAsB6BXwO//k0w7mhrytQEAAHIdgP1MowI8/OeavjF15vYPAACQe/i7zgv09u8TDW/WEwcfHAAAIMsBWNJ0X6AOgM802NVUiQMAAACQ6wCsY3o/UAfgW9MKTP8DAADIPfxdO5vGBOoA3M7wHwAAgMIOwLEBt/890FSFAwAAAJDrALQ2XR3o4T9WzxuE/wEAABZwAPx9/OlAHYDnTcvhAAAAAOQ7AOuaBgfqAFxkasnhDwAAkHv4u3YyzQ60/n8fbv8AAAD5DkAT04kBl/+tz+EPAACQ7wB0MN0RqAPwX9OyOAAAAAC5h38mATDUAUDnUP4HAABQ2AHobZoY4OE/UcONeP8HAABYwAHw3vh7alpeaA7Ah6b1OPwBAADyHYBmptMCdQAe1IAjPjYAAMACDoB3ALwl0Pf/ywn/AwAAFHYA2pueC/Dwn6zZBnxoAACAAg7AUqZvAq3/3xYHAAAAIP/wd60aaAXA66ZVcAAAAADyHYCGpq1M0wNNAGyMAwAAAJDvAHiDnCNNMwM7/GeZLiUBEAAAoLAD0EQHZWhDgEaZDubwBwAAKOwANDc9oIl5ITkAX5k2wAEAAAAo7AC0NH0QYBOgt00dcQAAAAAKOwDeA2BkgAmAz/L+DwAAUNgBqFAJ4C+BHf6ez3Arhz8AAED1DsAmpvGBOQCTTH1xAAAAAKp3AHYyTQjMARhjOhAHAAAAoLAD4E2A/qQbc0gOwHBFNvjIAAAA1TgAR5mmBOYA+FyDrjgAAAAA1TsAJ5umBuYAfEQLYAAAgOodgErT+YHNAfB+Bi/KueEjAwAAVOMAXG2aEZAD4B0NH3UHgC8MAABQvQNwuwbnhNQD4G4cAAAAgOodAJ8EeH9gg4B8quENOAAAAAALdwCeMf0WkAMwXdMNcQAAAAAW4gC8ENggIK9oOMObHPGFAQAAqncAHgtsFPBE06E4AAAAANU7ACEmAY4z7YgDAAAAsHAH4NLA+gCMNW2AAwAAAFC9A+DNck4JrBPgKNMqNAECAABYuAOwb2DDgIaY2uMAAAAAVO8A+DjgbqbRAXUBfMTUlK8LAABQvQPgaml6PZBeAN7S+G+mRnxdAACAhTsATUwXBlIJMNn0BxIAAQAAFu0AeB7ANqZpAUwB/NjUifd/AACARTsArqVMbwfw/n+mqRkOAAAAQM2cgMamo1M+FGiYqWfGqQEAAICaRQG8dv6jFDsAl5ha4QAAAADUzgloajo5pYOBfjBtxOEPAABQtyhAd9NrKTv8Z6mKoTWHPwAAQN2cAG8MtKdu1GlxAPrT+hcAAGDxnYAWpotSMiDoW03+I/QPAABQBCdgedNTCT/8J6rsrzGHPwAAQHEcANfapgEJTQr0jn/Xmtpx+AMAABTXCaiQE/BGwuYE+OF/vakthz8AAEBpnABvE9xbTsCcBBz+E7IPfxwAAACA0joBa5r+axpXxsN/qOl80xIc/gAAAPXjBLjam043fV3PB79XI7xk2pmEPwAAgPI4Aj46uE89RQM8+fA70+Wmlbn1AwAAlD8a0MX0Z9P9pvFFPvg94fAb0xUaU9yGgx8AACBZjsDSCs3fqqeBxakWmGJ6y3SWaQMOfgAAgOQ7Ax1MPUzbm84zvWD6yTTNNKMaTdZN/z7TcaaNTSupEyE/LAAAQIocAe8d0NLU2bSCevR3l3OwhuR/3U3/v+XkPDTR38sPCQAl5f8DqvcM65kW7JsAAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDcrMDE6MDDekU0hAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ3KzAxOjAwr8z1nQAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/apple-touch-icon.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAALQAAAC0CAMAAAAKE/YAAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAC3FBMVEX////9+f305PXoyurZp93Jgs69ZsOyTLmpN7GkLK2fIamcGqabGKWbF6WgIqmjKqyxS7m7YsLIf83Xodvkwefx3/P79fv//v/+/P705vXivOXOjdO6X8GqO7OhJqqaFaScGaWoNbC1UrzFecvZqN3s0+758fry4PO+acWpObKdHKeiJquxSbjjv+b26/f+/f747/ngt+PAbsenM7CeHqisP7TEd8rftuLy4fPTmdexSrmdG6atQrXNitLNjNKpOLKaFqS8ZcPes+H47vnRlNWoNrHXo9v37Pi+acSvRrfVntn16Pb9+/7Hfs2tQbX37vjoy+uiJ6uzTrrftOL79vvMitGeH6i8Y8LoyeqiKKvIgM79+v24Wr+uRLbesuH8+f3AbcbGe8zQktXTmtjVn9rPj9PJgc63V76kK62fIKjCccjw2/HWoNqhJaq/asXmxen37fjv2fDhuuTQk9W8ZMOeHafaqd38+Pzy4vSrPLOgI6m7YcLhueT68/riveWvR7fEdcnz4/Tbq9+2Vb3brN/nx+nLhtCcG6b15/b16fbcrd/OjtPmxOi1VL3pzevx3vLYpNyvRbfKg8/t1u/HfcylMK7lwuf26verPbSlLq7pzOvu1+/GesvkwObGfMz05fWuQ7a4XL+wR7izULvNi9Lqz+zZptyyTbqkLa3huOTTmNfaqt758vq3WL60Urz69Pv79/zlw+i0UbvfteK3Wb7DdMnu2PDMiNHBb8fSltbs1O7jvubCcsi+aMTKhdC9Z8S/a8bUnNjMidGgJKrr0O26YMHRlda5XcCsPrTdseHBcMemMa+lL66jKazKhM/cruDYpdzw3fKzT7q4W7/t1e+nMq/Sl9fbq97EdsrqzuyqOrKwSLjUm9itQLXmxundr+Dqzez58Pnw3PGnNLDs0u7FeMvr0e3XotvnyOrAbMa6XsHPkNTCc8jiu+Xv2vHkwufPkdTdsODVndm1U7zt60pRAAAAAWJLR0QAiAUdSAAAAAd0SU1FB+QBGBMFLc8EEO0AAAiiSURBVBgZ7cGJX5RlAgfw36ilMjOCGLzj+Rs8sAF1QMFSNEcxdXWSJQkjrMG0VlKLrJHDTPEIzFozJTV1Lc1A0/BILWvLpMBCLe3Y2uyw046tbfsHdtsteZ6XGZiLd5zPvt8vdDqdTqfT6XQ6nU6n0+l0Og0Z2rXvcNnlHTt1jjKazMYu0Z1iusZ2uyIuXsElytK9R89evfsYqWLtktC3X/8BiQouNZaBHa60JdErU/KgywYPseMSkpI6dJiJrUlLH37VEAWXBOXqESOT6JuMUaMHGBB2Stw1Y8z0nSN67LhMhFfK+Gsd9FPGyAlDEEYTJ5kYAOMfJk9BuDivczAwpqmTsxAef8xmwKzXT8tBONzAYOROv9EJzVm6Mjh5N7WD1vJnMEiOm29xQVtKAT2zzbx11uTZI267veBP6XMy2JLCO+ZCW/Mc9Gj+nXcZ8CvFVXT3gnvu7e2md7aFxdBSSSm9KFt032L8zjnl/puWRNGbtKXl0NKyMnqTtnxFFi5SUlY+kG6kZ44lgy3QjnNeNL2qGDSvEk2UVQ/2SqZn2asToR37Qw8b6ZU1/dY4O5oU/3l4Z3pkHbsGGkp5ZK2JXpkTHl1nQRPLgPVV9OjmxxRoaMOsjWZ6t2np4wY0sWzekkdPOs8uhpbajd9oonfJk7bmoInzxum59MD9l0xoatuIJ6z0LurJaYlokjMtxsTmzF2vhrZWzdtupHfuHR2K0GTIU3PoQcxOaKzo6eoMemesvkLBRfb2M4xszrZAgcZSVvR107uaXRAk7t7E5uY8Y4HW9ozbm0avNsZBoLR/1sxm5s8yQHO1u3qV0ZvVkAx5tIzN5D6VA+3t21+QR88OxEPiPNibzWSsT0QY5O+cWeWgB7ZKqKx7zkw166EUhIPl8BGbmc3YKqE2cX0a1UzPZyEslJIXnjBS5UA8mnFN6Ew1851DECZHO1GlJzxQXiylmuPyDQiLxS+ZKStdA48G7KCa468TEQaul62UJY+DF+WvmKjiOJYFzdlnpVGW+6od3qRsqaCK+fkUaO3BPpQZjxjgXfzxNKqYDu2BtrZWUeY4VIuWuHbXUcV6XQ609FopVV6fiJZZns6mSsUNLmgnLoYq11+N1thXRFMlrd4JrWxooMqJAWid8kYNVbpMUKCNPW86KGvcD5+knqTKqYPQhOu0lbLsN+CjXSepMuwuaMCy201Z7mQ7fHWwhirpR9H2VsynzHjcAN+9EU2VHeVoa4NPUmY+VAs/KG/lUWVSItrWzt5UWVQEvyhv11FmfdmAtnRmJFXOlsBPlhG5lOV2U9B2Vr1DlfR34TdDzwzK5tyPNrNnqIOy9x5CAPbFWikrPYo24rrMSln2OAQkscBBWd/uaBOW992UFc62IzCrnqRKwWK0hb/VUWYcnY9ArYmhzHirHaH34ibKzB/EI3A7x1CWvAsh9+HfqdK1CMFIzaNs42GE2JmPqHKgHEFRzrkpa8hESE2ZQZWP1yFIrlgzJebT+QihxAIHZcM+QdAyp1MWtQyhU3y7lbK8aQiBgYMoS3gNoWKpd1NWOE9BKDyeQNneIoTIM3WUVVyTj9D4tIwS8xELQqJHDWXm4fEIEedxEyXzUxEKn9mocm8WQub8IsqmnkHwPt9OlWfLEUJzP6bsWDyCVbmXKlM3I6R65FGSMRlBOn+MKl+sRGgpL1RQ0rgTQXE+YKLs1FVoiWXD5ncrnfBLfAFlDecRjMf6UBbVTYF3ypdfbczLHlOw0gJ/DHyCEms9gmC/g7KKnvnwzr6skf+Vt9sAfyxIpqSmPQKXNYoSc78ctGBBHn+TOwH+sIw2UbI3BQErf4+SsZloQVFHXmSLgz8y36HE+j4CNsVGUd92aElqBpvshl++TKCk8WsEyvUOBaMOo0VHKDibCL88kkTJvfEI1DdWXpRwAS0bTkHaOPhl37eUuD9FoLL28nfRqWjFaYo6ToFf5pZS8p0LgTpczf+pWaGgFW9ZKXDMLIZfVuRS1KkSASu/PSGDprxFF+xoTUknipK+N8Afrh8oaixB4Cwl/1h4y9F9aJ2ylJK00bXwx2O5FAxrB03sL6OkYmg5/DCeorO10ETxK5Q51u4ywFfnO1K0Ghr5JJsqZS8dtcM3WwspOPU1NGKJZTObHv3RDh8oX1HUywWtdB/JZhyNW26MR6vKbRRYF0I7P3WmB3XLJ59xomUdTBTYyqGht6Poibnx2MIfi+FdcQNF/RRoyPm9m56ZNs0Yf2GVE569lkdB4U/QlOu2NHrjiOrd9Z+D4xbb0cw1FFUnQluGF+rYEndVTME9qV92j7ejSdYSiuqhNcvPX7A1xj6dqied/te4C4e3ZeY4FSxwU1CzGdr7OYm+sRZGJ0w98N2VSz+i6PJ8aK8ng2L8FNqrTGdQTlRCex2sDMpN0F7OcwxK2Upo70Idg/JLLbS3nqLkRjP94jgH7ZXbKDoeN3t5Hwd91zgX2vvGTMGmdUD8zt0NVVb66FsnNJfzHEXfOvErw5oHf1ibbGLr3KnQ3mfJFLjvw+/sKQOe2XL9STdb9nAitPcURSMzIVJqz2x99YO+18430ovGu6C9zO0U1aM5Zd+2r1PPrX99pG1OodFBkeNED4TB/WkUVN0Nr5yLV9194eAj98S+OXbGjiUnxgxrvPbs6oEIA2U4RXda4AOLIWfPxO7lJdtqFYRDZSkFFbcgEuxKouDEFESCmRTFIhJMHEVBl/2IBD/lUhCTiEhwmqIjiASLqyko3I9I8O4pCtZmIRL0N1NwHSLCUgqSFiAS1FZTMGYbIsGZkxRMdyISDE6ioB4RoRsF7sGICKcpaByISKAco2D7HkSC/O8oeN2JSFD8CwVvIiIUL6cgFhFBGUrBOUSGDlZelP0hIsOGQbzoeQMixNYq/mb754gYW7db+R9JDYcRQdrVP1ta2tA/BTqdTqfT6XQ6nU6n0+l0uv8X/walffD6WKgjVAAAACV0RVh0ZGF0ZTpjcmVhdGUAMjAyMC0wMS0yNFQxOTowNTo0NSswMTowMEkOXAgAAAAldEVYdGRhdGU6bW9kaWZ5ADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDA4U+S0AAAAV3pUWHRSYXcgcHJvZmlsZSB0eXBlIGlwdGMAAHic4/IMCHFWKCjKT8vMSeVSAAMjCy5jCxMjE0uTFAMTIESANMNkAyOzVCDL2NTIxMzEHMQHy4BIoEouAOoXEXTyQjWVAAAAAElFTkSuQmCC")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/browserconfig.xml": []byte(`<?xml version="1.0" encoding="utf-8"?>
+<browserconfig>
+    <msapplication>
+        <tile>
+            <square150x150logo src="/mstile-150x150.png"/>
+            <TileColor>#9f00a7</TileColor>
+        </tile>
+    </msapplication>
+</browserconfig>
+`),
+	"public/favicon/favicon-16x16.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAABAAAAAQCAMAAAAoLQ9TAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAABQVBMVEWaFaQAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///+n9ZLQAAAAaXRSTlMAADWd5P382o4wAqzgttP5fRQ5pvD0sWJgw7ouBz+h9VQQg/PUPEvv2C8E69k6M+ZFAVfs0Sskx1pkuBYbs/dwjAaP+oYKgrDyXk1xDhLN3D6+6Z4xGWOE9vHh7fhlq3kFkwzGzxG5oAPeGXiwAAAAAWJLR0RqJWKVDgAAAAd0SU1FB+QBGBMFLc8EEO0AAADGSURBVBgZBcExSwIBGIDh922ptMncukWQGwyEWyyyqTgIIfAXCPWrgsYmwbGlaAqCQqjghoJou2sqnTqq5et5XFFVrQXA5oQL9XvDJQK2VNWvthWCJCqq+oZokKovvcWmFij0/1Z9hkx1jgx8794jka19bHnnvjqvsyeM3k+5pwf6WuZ+PmJ0UnWklxyr1zWMi74Cwe5y2yrRqyMFiOFv19lhy+lYgSAdlO31KrnJVSA4uW0U0Nh5qBWIU/VcABSIvKNnAvAPyX44/jy+D64AAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDBJDlwIAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ1KzAxOjAwOFPktAAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/favicon-32x32.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAACAAAAAgCAMAAABEpIrGAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAB+1BMVEWaFaQAAACaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaSaFaT///9u4oXyAAAAp3RSTlMAAAEcYarb9f766MSJQg0MbdD577oU9v37xlcJaeqQZHmr3/yjKBOL7OedPh5nxdZRAwQ4nPGvTAgR1+52NJXm94QCJZ/4jw8thuXHDukSI897SxWaOtGXM4cHBsIhNdRs0zBA607t4UFUQ+JVcrMx1WuZgRu/giBgw0dK9JixPx+8phebdXcYN/B92SxJ3oVYqZPSWRkaUytoocunECZufnAkfAWyLqSwqTQAAAABYktHRKhQCDaSAAAAB3RJTUUH5AEYEwUtzwQQ7QAAAhZJREFUOMt9k/tfy3EUxp/Pir6btFK2aUoaKSRdCammVYrEUjHlEoVIyCVyL3LNZe736/vf9EPb6rsfOj+/X+c553nOkRwpqYsWp1lO15L0pRmSSSq5M7OWMVtWdk7q8mREqR4Ar88CwLci129HtNIJkJe/qmB1YQDwrFlrI1S0rhiwStZvcG8s3eQEyjaXzyOkisqqPIDqmi1ba0u3Adt3+OcIGaOKup31AA2NQe1qaoBQc0tCRsYYo/LW3W1A+x7Jn7kXAlUdcUIxoX2d4NvvkJHjwGLwNtXagXANWF1uGWOkg93g7cnQPAl3lwU14Xi73k7wHTqsOKBIow+O9CVk1X8UPMccim0RzEyD4yfm/JEGCuHkKckYGSl3EIZOy+bOmUEoa5WMpLPDUHwuKYHg+RCMdMhIF0Yh7WIwOUT/JfBeDkpjV8B5NSJjpGvXK4OJQcfGYfCGdHMC69asAe7bDPUmLNadEhhP0d17E/fDkjFGD8pg0j9HTAUIPJQe9ceSUbAHQvljCTumH2M9se3W+hS8WVPP/JIxUgG01dmOR89fAJYrZ+bldF/RWDu8iti31+vY/Qaqh990hvBFk+x52w3DLot4jb6z36eiPjzvP3z89Lne4wWYkR2o+ALttVLw67eB6Pcf6T/DMjbg1xD8TvocGxANkD2dHMr8EZqhvWUB4M8ITGoBIPIXChYCFHVl/Uv+//8tzq+CNmFieAAAACV0RVh0ZGF0ZTpjcmVhdGUAMjAyMC0wMS0yNFQxOTowNTo0NSswMTowMEkOXAgAAAAldEVYdGRhdGU6bW9kaWZ5ADIwMjAtMDEtMjRUMTk6MDU6NDUrMDE6MDA4U+S0AAAAV3pUWHRSYXcgcHJvZmlsZSB0eXBlIGlwdGMAAHic4/IMCHFWKCjKT8vMSeVSAAMjCy5jCxMjE0uTFAMTIESANMNkAyOzVCDL2NTIxMzEHMQHy4BIoEouAOoXEXTyQjWVAAAAAElFTkSuQmCC")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/mstile-150x150.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAQ4AAAEOCAMAAABPbwmXAAAABGdBTUEAALGPC/xhBQAAACBjSFJNAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAAB3RJTUUH5AEYEwUuVg1BVwAAAvFQTFRFAAAA////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////rkHhmgAAAPp0Uk5TAAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+P0BBQkNERUZHSEpLTE1OT1BRUlNUVVZYWVpbXF1eX2BhYmNkZWZnaGlqa2xtbm9wcXJzdHZ3eHl6fH1+f4CBgoOEhYaHiImKi4yNjo+QkZKTlJWWl5iZmpucnZ6foKGio6Wmp6ipqqusra6vsLGys7S1tre4ubq7vL2+v8DBwsPExcbHyMnKy8zNzs/Q0dLT1NXW19jZ2tvc3d7f4OHi4+Tl5ufo6err7O3u7/Dx8vP09fb3+Pn6+/z9/swVw8AAAAABYktHRPrVbQZKAAAIyUlEQVQYGe3BeVzUdR4H4PdvYABBkOTKTE3bKN1Q0KytzCvdUjPvpHs37bTV3S3XPMq2a9PUTNM2d7vWIyq3VjuklNSyNLTIypQ4FOMIREZgBmbef+2+1mx+32EGGOCPme98ngdCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCKE7S9f+E+e9+F5ecWV9o7PBVp6/Z/OKWWMuijMQemIHzlz7ybFaF1WNNQU5a2YM7moghMQN+2tOhYu+OKs+XzKmmwUhIbzfgzkn2ZK6vLVTUgzoLmrE2mIXW8WeuygjAjqLGr3xBFvPVbpxylnQVt9Xqumn+h23JkJP6fvYBvbdM5Khofj/sG0aPrutC7QzrZ5tVb/12kjoxVjLdjjxjwwLdBL1Adul+MEEaCTqA3rlrLNVlpWVn6htZPMas0eGQxvGi/Sq6plR6WlpAy4bOW3WkjcPVDTSt4onukMbNzroVcVro6JwmjUp4+aVe2voi2v3KAs0kZBNH06+PTkeZxgJIx75+AR9KJsXD01cdpC+1G2/PRlucUOXHWqkVw2bB0ATg7c10hfH3tk9DfzC0mvmtlp6dTjTCj0kPXDYRV+c3yy8yAK3uInv2OhN9eJ46MG44LEi+uQqWDrICreYsW+dohcNr6dCE5a05SX07ccXh0XBLXpyTgO9yB1lQBNh6UuL6FvVpnGxcEucddDFpo7dZoUuLP0ez3fRJ9t7mQlw67Osik1VPxQDbVhSF37npE/2XTdH4xfWMTudbMK+JgX6MHrPzXPSp7rn4uGWsqiUTbj+fT500mNObgN9cT4aBjfL1btcbCInDVrpds8eB30ovRRm3VfUsIkvroBekm7JrqV3i6CIyDzEJg5dA810mfBWNb3JCocq/X0nPRVNgG46Xf1yOZvaGgkPKStq6enYVAO6ibjs2WIXPbxkwFPU3cfp6cdMC7QTlpZDlXMmmjKuPUhP5bdaoJ3xx6nady68ydhOTxW3WKCZoflUVUyAdz3/1UgPZZkGtJKWS9Wp+yzw4axldnr4cZoBjfTMpsqxOAI+xSw6RQ8lE6GPhI1UudbEohkRf6iih6JroIuYlU6q3khGs8JuLaOHQ0OgB+sCO1U5vdEC44bj9HBgIHRg3FVD1VfpaJEx6Sg9fHohNDCpjKrCkWiN6wrp4d3uCHrDfqCqYipaZ2wRPbx6FoJc//1U2e61oJXGH6XKuSwaQa3XR1Q5Ho5Aq00qoap+bhiCWOImqlzPd4YfppVSVZWJ4BWzyklVVhL8YdxWSVXBUAQr60IHVdvPg3/CZtVQ9XkqgpPlbhtVBwbAX9Z5dVRtTkJQmlJOVcFw+C/qyQYqXM92QhAaXkBV+RS0Rdw6FxX1fw5D0BlwgCrb3Ra0Scpmqn6ajGBz3naqHIusaKPzd1L1bTqCS1IWVc5VMWizwd9RtSUJwaTzahdVmxLRDteXUeFaEoHgEfGwg6qPeqE9LLNqqbD9DkHDcq+Nqv390T5RS5xUFF6JYDG1gqofhqG9um6i6uMeCA4jCqgqm4T267ObquejEAzSv6Sq5i4DHeDyfCpq70AQ6L2DKvsCKzrETSepyL8EAS/pDaqcK2PQMayPO6nYkogAF7vGRdXGBHSUhM1UOBeHIaBFLHZQ9WEvdJxf51FReT0CmeU+G1W5aehI4yuo2NcbAWxqBVX5Q9EsiwG/WOY6qFgViYDV71uqyiaiGXFjn3j5hTlpYfBD3AYqaqYjYD1FVc1MA75d8k4tSdexhfHwQ988KvIuRIDqupcK+3wrfBv8NU9rXBUDP0ytpmJdFAJT6jGaOZ+NgW/x7/IM+wz4wfqUi2a26QhMF5bQbH0CmjGpnr/4JAF+SNlGRW5vBKTE/TTZ1hPNWU63uvHwxxVHqVhuRSAyltPti4vRnPAsmrwaCX/8sYFmVaMRkDIKeMaRq9CssA00OTEO/ujyJhWvWRGQbirnaYXj0IJHaLanD/wxMJ9m35+LgGQZu7Oe5Kl3rzLQgpEnaZaVAn/cY6dJSSoCVMK4h56eOzoOLeqSQzPXhnPhh66f0KTkAgS92S6aubIHofXOP0yTA0kIej0OUHVkRgxa604nTVYaCH73N1JVlzUkHK0SvYUmtt9CA4nb6KlidUY4WuE3P9FkZzx0MOw4mzj+wohYtOhRmj0ILRh/srOpkx/O7mtFs1K+oMnx/tBD9EonvXAWv3p7v07wbVI9TTZGQBOJG+hdY8nWuUO7WeFV+Es0cWRCG93Wu+hLzTdZ8679VZwFni4qosnX3aGP5HUONqOuePdLcycO6hEbBrf7abYEOoldcIItcFQd2bX+b3OmjxjQOzk2MqxLNk2qh0Mr1nVsncba8sK8Xe9lbbbRJDsWWknZy/aYDb3c5GA7FPWFVqLfYXu8Eg6tDKlkO9gnQy9L2R65KdBKr29pUr2l0El/PAa93OGkydtxqbPeL3WytSovh1ZittLEcSOA6P53rj9sZ6tsjYFWrqikyf5u+D9rr4lPvl9Yz5Y03gG9PEqzxXDr1GfM/NdzS+1sRk4ytJK0lyalg6AKT7h4/APrsg+W1bnoxXdDoJframmyKRJeGJ3Ovvia3y9Y/dbug8UVpxxO/sz29mDoxbKGJo4b0AzDGnt2n4zh102fMWf+UytWPzd/dCw0c973NMk7B6HthgaaPIPQZvk7TWquRmg75yBNPo5HaBtfT5O/IMQtp8lPlyK0Je6jyY5YhLYrq2myECFuNk1ODkNoC19Pkz1dEdqSv6TJUoS4Syrp1jAVIe5GJ92OpiLELaTJjs4IbcY/abIKIS7qA5rcjxDX5VO6Oa5HiEv6im62qxDikr+mW0U6Qlzn7XQ70hOh7gm6ZUUi1A0s5hk1ExDyjPtsPK3x6UiIiLt+cPF/yh6OgwAsGU9/9k3uC8OtEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEIIIYQQQgghhBBCCCGEEEJ0jP8CyvaxQtFirAQAAAAldEVYdGRhdGU6Y3JlYXRlADIwMjAtMDEtMjRUMTk6MDU6NDYrMDE6MDB45kaVAAAAJXRFWHRkYXRlOm1vZGlmeQAyMDIwLTAxLTI0VDE5OjA1OjQ2KzAxOjAwCbv+KQAAAFd6VFh0UmF3IHByb2ZpbGUgdHlwZSBpcHRjAAB4nOPyDAhxVigoyk/LzEnlUgADIwsuYwsTIxNLkxQDEyBEgDTDZAMjs1Qgy9jUyMTMxBzEB8uASKBKLgDqFxF08kI1lQAAAABJRU5ErkJggg==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/favicon/safari-pinned-tab.svg": []byte(`<?xml version="1.0" standalone="no"?>
+<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 20010904//EN"
+ "http://www.w3.org/TR/2001/REC-SVG-20010904/DTD/svg10.dtd">
+<svg version="1.0" xmlns="http://www.w3.org/2000/svg"
+ width="572.000000pt" height="572.000000pt" viewBox="0 0 572.000000 572.000000"
+ preserveAspectRatio="xMidYMid meet">
+<metadata>
+Created by potrace 1.11, written by Peter Selinger 2001-2013
+</metadata>
+<g transform="translate(0.000000,572.000000) scale(0.100000,-0.100000)"
+fill="#000000" stroke="none">
+<path d="M1170 5713 c-41 -5 -211 -30 -255 -38 -257 -50 -639 -202 -728 -291
+-54 -54 -80 -117 -83 -199 -5 -133 53 -221 199 -307 80 -46 856 -428 871 -428
+2 0 64 -29 139 -64 74 -36 152 -72 172 -80 24 -10 34 -20 29 -28 -6 -9 -462
+-531 -605 -693 -15 -16 -64 -73 -109 -125 -45 -52 -97 -111 -114 -130 -17 -19
+-121 -138 -231 -264 -110 -126 -215 -246 -233 -265 -89 -98 -113 -233 -59
+-344 30 -60 74 -104 140 -139 34 -19 58 -23 127 -23 76 0 90 3 135 29 59 35
+71 47 284 292 91 104 172 196 180 205 9 9 27 29 41 45 72 84 217 250 230 264
+18 20 38 42 210 240 74 85 139 160 145 166 11 11 14 15 311 354 191 218 212
+242 259 295 80 90 101 226 51 329 -46 94 -78 116 -381 256 -33 15 -104 49
+-158 74 -55 25 -100 46 -102 46 -1 0 -50 22 -107 49 -263 124 -358 170 -358
+174 0 11 313 14 400 3 415 -52 809 -209 1240 -492 108 -71 325 -226 340 -244
+3 -3 25 -21 50 -40 50 -38 214 -178 300 -255 69 -63 417 -410 490 -490 31 -33
+89 -98 130 -145 41 -46 80 -90 85 -96 6 -6 26 -30 45 -54 19 -24 71 -87 115
+-139 44 -53 102 -126 130 -161 27 -36 52 -67 55 -70 21 -20 362 -491 403 -557
+9 -15 26 -41 36 -58 l19 -30 -97 -255 c-53 -140 -100 -260 -104 -266 -4 -6
+-21 -10 -39 -9 -18 2 -76 6 -128 10 -52 3 -106 8 -120 10 -14 2 -52 6 -85 10
+-71 7 -130 15 -166 21 -15 2 -47 6 -70 9 -40 5 -117 18 -179 30 -14 3 -41 8
+-60 11 -92 16 -339 80 -458 120 -235 77 -402 158 -540 262 -120 90 -169 154
+-245 322 -40 89 -82 134 -157 167 -197 89 -416 -59 -411 -277 4 -175 191 -477
+397 -641 44 -35 64 -57 61 -68 -3 -9 -35 -88 -72 -176 -164 -395 -293 -798
+-330 -1025 -3 -19 -8 -46 -10 -60 -3 -14 -7 -69 -10 -123 -6 -127 15 -188 89
+-262 129 -129 343 -110 445 39 34 50 48 93 56 171 14 142 18 167 46 280 37
+147 171 541 228 670 5 11 26 62 47 113 20 51 41 95 45 97 4 3 43 -8 86 -24
+143 -53 395 -123 563 -157 363 -73 765 -119 1131 -129 124 -3 140 -1 194 20
+60 25 113 68 145 120 10 17 96 233 190 480 224 587 223 576 89 786 -119 186
+-416 605 -521 734 -9 11 -48 61 -88 110 -70 88 -125 157 -145 179 -5 6 -44 52
+-85 101 -41 50 -77 92 -80 95 -3 3 -34 39 -70 80 -110 128 -168 188 -385 405
+-216 215 -308 301 -469 436 -16 13 -34 29 -40 35 -51 48 -400 311 -464 349
+-10 6 -57 36 -105 68 -260 170 -645 356 -884 428 -35 11 -93 28 -130 39 -70
+21 -219 55 -285 64 -21 4 -53 8 -70 11 -18 3 -51 7 -73 11 -47 6 -423 12 -475
+7z"/>
+</g>
+</svg>
+`),
+	"public/favicon/site.webmanifest": []byte(`{
+    "name": "",
+    "short_name": "",
+    "icons": [
+        {
+            "src": "/android-chrome-192x192.png",
+            "sizes": "192x192",
+            "type": "image/png"
+        },
+        {
+            "src": "/android-chrome-512x512.png",
+            "sizes": "512x512",
+            "type": "image/png"
+        }
+    ],
+    "theme_color": "#ffffff",
+    "background_color": "#ffffff",
+    "display": "standalone"
+}
+`),
+	"public/fonts/latolatin-bold.woff2": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAAK1cABEAAAACOuwAAKz3AAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HXEByFXAZgAI0ACDoJlxcRCAqClwiB6xgBNgIkA45ME4SAAguHKAAEIAWuVQeTRgyBClspINInPL05bb6akUOyw+P0tXIB+ASER9hVG7JORtKktDyb6tdLg5ojctZEOBrLyyn96hpiAxIJiJ3d/StOROPwXlqMnuImFIbH+WX//////////////////////////////////30nk9jsP5nYIgUK9Ex5LvJVyFFEJHL3LFGknArKKFE5SplTVZNHTd5Qno2z3JuS2i5LMO8lmcOJB3eHIZpSantQT3tAJwcTR5F5cCfHM02AxGY9aI7Nj6Av0OJSScsrq3y0lCjja7RegIbCqR+cItWpYk7JJWsbXJZG0F2a442isoRTc7RJVOC+R4O8ko3wZpC2UO+gwC3KKJCrFJKtPBY2b3OeFErkOgUaBNuVElXIyZGLMrx9B3KQUy7IoQtcnuVsp2wX7XJQ+O7tbcUXge+iPSCoRA72ojHeR7v3s3wHPNCjYYBDWkKREipFDdw08AmVa3BzA5PDCBThCgWtLbO5xGu2P4AfrA6xRBU533t4QomtNOxIgpWzo7war7MO7LW/eUAFHdvvC2wFr67CmEGTSChEY7Zoe+04neDVwub5k5HqcbeDhqKn+sT2HYunWEEVWAwKEC0tgkAZDfmRxdMlRUmbzxw+foTNkNMJX6DFDE4c1SnBqYRc0grGdYXOMj9BmwSVlosGeHIGjfn2g2wi7x1ONDLaWvKB72D5HHTxyKE7dMkiXjvHz9OYdqIS9qPAO1FEQoGdKtrt6AKap4NzlFiTaS9I+hJd3OXk6Aw5D+7cuR9kuymjhC/ojPlEO5nZQ5uQ02budsY6p/ei49FfAqPDJwWFzkZ2mc/TleE4Hwa4OoeixK7Q9FcdzMDRYegjvA4DVyHfIdhC685Sr9fqH4S9emhcxjvnaP0a66o97CBLeDIlbTR2HTpA+8F1ag+BG2hOoVK9go7jg3Y3bL2JrqNSb1rj7LFbB+DuCuyyu34bnpLWsHHoKl21C51O5xu4iZfyQfU2unPX8R2796zP2AeV7fv2H9inh/YfHXfZLOxMXMGNfeqWH6PrwmlBaeCJkpu6yiaqYeKp4KnODguXKJf1qCDHJ2ConNu5QfAMz6vWqs/xWHdEtXwvPyLbQi8MX0YH2UveyCvVTvrK/mv7b+zftHsRr6PJmtg1r1EF98K3V13Ymnxn/7799+aPfvho7p58/3v7b+1vt30MLVv+ZJ/V9FlnTvWmwhf7X+1/s//d/hnjPxj+CEZ0ln502bJ+KJyzf7Inl01Zugx/Epew0k2bxOfMBvymlWkGZYeEP1u+gR7ZyyV34EutVrER/2L6PPh1o4NOYaJ4Qfwbe+A6tZ3f7f9h/0/7f5megL/53/a2w+vwH9DLb52GrvGM3pdwHv2rXerc2yj8z/QultF99WV5xU400hVa0c0VH78XBgVyCtplZI06sMacfdkrWNDLNSu2BfwFw/+XutojBuP6YSAiUTnUXGIsu9FmHeJyHn7LQ+hf8H8mySR3d5/wwQdVH2ALqbIqiBVYuhI6sZbvDE9z+3exvO3WwUaMGlU2UYJKSakj+tMGikmPrRmCNYxRFlZi1n5V8Q//y+Z9f4ZFYnGlexCKg6QrMA4JVtY0H12NSYSK2x2CbXYzi1AkSkRQERQUsGhJQZAUCxMrQJ3Vm9OlutRtuigX9b/Iz4Urt3309v/bx+rf7RMKuCEQVFxtG+g9AP3D2eMpT5q3u04sYm34j04QugD4Jbe+9wtMi/mYFZUCK6okMGq4Xc7jdMx+k66TBm6jva6baTaJkKJnhO+Dtv2WpA9dzLYPpoeatmHimqwLkg0/67yiwRYDtM3//cpX6fayl69DV85FGmyyCnubFc+FrJibWM+awHSK0QicHBwccAF3R6RBeLQFDunUtrk4Bg6IJMft48QRADtgY36In9ctA8dV3id1XdYug31BTjw1h9xbkrVZkRzBPHDiVmHuftNXyd7SnUd+AQsgZeBvd+2saYvcUYn3BXUjUIAzp9bSu5mqXftw0HKYddEHdIwDFv4JAvdIeSBaCKj0T//+S9qcme0fgA86WNApyy8oyV2hnqBQtJlGOJnT+0DAUxDv4X3xJfFVLfutyod0A3i8/6qS6h/33kXHSLRFWBIFkvKswz/Qof47kQhP8lXjkpVqMDdWIM27WtMkPaGugONZyFZ6INo9oBKFlHhCaX5iLU25TsNCx3eqEpZN+s9HRBFLdMfCLqK4CbeyJK+dV5lZxmy+BbanvPP4SmvAJbgUsK+26ydWtOrxn/8BAAXwD4ldBOondCqFjzeZ/A2s0KYJEPBPQMxtiEduGfwxT51KFAuVETLXyXiom2ori9lk4sRZ43SSmoUVJcq5IFZwHmNiMlJQm9+50ifYOeEXqqu5V/dJnzz9l8fvCiDgY+JxPUYiK4URO6hsTwknfNLAvFJitLY9OLzSx83NOoClgO3tca+7l590zy0HCIsxdQohG21D67AkKLSKFnaAHhxe7n1XnlAIS97W9RJ+ncvAQLtljqOue+hcIDKV8QQ+Ken7ItKevVOjToTkYpSRFyrmm3+J1ihpm52BaPX7LVPISxGWOehJpK8Yd13ZVfUnin1zpTowjJ/utQog3OUOurErms9LTMyQx5Ks+OpTb7ZEV7LxQMYPj+MAk1zO7WZlAN8fCUTnd3rL2GmVctUBANn/S3m5AwAEcBdWVsA2n95e3+X3S5UG2ubJf9myTKLDd2cr9ABT4AHeE7bjElr+PSa4UBmQysszGvq2IOU2TZqKvKPLxedoiAcWKpvMaIPb1DxcKjaTqcDoC5iqTYLgCIVtyPn8jk1RG1BmZiDPk7uypbbdDUcmSYokZrH81DxhkURDRfb9mhoBaO8fzFcF3qnr1BIIx+7GR1l+QVp9SdwWS2EBLOcG3QYTo8IDtDcI/89svVaUidNc8iLGdPGJsRYViMLu/l/UKWq66jDxoAHBZpObQhhaQq5z4wnjVR6DUBYQ8STdAOQtShCWPLGplxGVA22yAU758B4UJEQ8eGlkBT0u+FhGWUPbn5hsTEDvdndcXDa113fHrw796svuxL0KIUA5UeKV0FyipLa4covLK8YCLdTKai3YCGOndK/yhRtwrPXbqlvrtK2l7WFh4uJxHA/xUjAxIRMzcVRNThklNRVVRVUVXfUFFmEhFmRRFmmptuZacHEFGFiZmrEZneEZnwUaGqIxSgGdSZq3JalYEi78KrOXqqmo7fsLHGxVHcNxuq8bcXFcPC+yEje43Y6DmP/SadcZSfNAtrOL0iokT6nlDmyr9z3ucW97mv9nJPjzJcRoAEcaMJaUNshtJHAsSp40I8lCgBt2HiZxesFpRSNwkUmRXLJu+x6ks72m1639Xko77eG4p2Op173eluj7Zf9JJ/dFr5eDYltCa1ALoPUQ9+s3Mmc7eg2c/+TcXT/yLW8AcgAJiRnX8iM/8sO+AUiACgqJISUgECTg9y5TaiGnVJwOoAEOQI0B3+x4rvzMU0qX3VGe0ouk1Z216QWwsADUOoC4xgIQ1CwT6zroVzNTA7ic7Ur5NJ1Xg2wnP9oyugpyGQAUqHC/1+6bEgvn2qSUXCn5roBoPRBSYKuUelQGxDJCBswUrmf9t588/yriq4OHAthRGhwYBoMTPxxI9okG4o0GPTIgsk7OH6hlSlmLWKdY/JRAgUnAkgmzfE6oBeFMV8wa/Keps6U/x1rXeqrcfyjk4VySHjtfMcZ2lSz5g0qrTiIREvTYEikgMi4cP5AWzLKZShLrEz3P///+/Wm5b7pgslxthGu9gxfFXoqzFdC/y6iQeFko5eTPybQZmDRnnwIOaARrM3MfioTWwCW0zVogFajo5D+eXxZKAQbEko1cZmzbHthFILlABovEIvB04vc/Z4YtvF+MGlfUioqKqqqqlff/mRP9r3UCf0vL3727XAuPEEwwRgghBjEYkW3vWo0ARrqte30sSBKEQ3ngcaZ+kh4u7emv4c5ljBDCCCEeUTOZswf6KpjHIVJ8liIhKIjwODM/ngS/pzn6pEMIwhhhhDDhk9lLZ1tKIGWaPtg6CYsnCU2/pYdt5YqeOpL4xAxo/fFsfX64RXhjmxGJ0Qk+j3+MrYrqd0MEVAxAZYjynuj3E2P7xajKpyqOUEKoKCFEVKjzW4HGcAnAUZ4+6D3upcwG4se3NlQB3yc3lFSC8GcUNtWABVCvhFquC8vFoeIwgQQ9/YkNNUA86pV6XzEIWK4CbLOuMOBEelU5c4n3JRJtmFZphRZPMEbtIgBddUFz7ffGovizBbWGtrVdSuQXqRo6vIv6mu7uxz1kaVbiyl5toN13gPxNrNkaNunWJgP4Aj/Afym6hb8oHhiZizLwaZAmqDdYGjwYfDB4KfhtCEHkopBMyA9Qj2eeJejbYLaAcWHt2tthu2HH4GHwTfBH8P+eAQYMjQnlhm4NCw+zhvXe0RjDFshPyEf4u0VSe9gvYa8cTvCg9h9ESMmLjEJm9qRtF8h+5HbkpSm/QheEPULvdA/0bdgvjSxxEyD7MS2YTZgTmLuYH7AuWC42DavBlmL7caO4j/AeBBohWlFLEjsJ/cjXkD4iLZFfEPoXT9AaxxAuI8/zgBJAw27cOJyYQTcjPzO+aTxx8xLmlMLKcdUK24UpDwUJGBgChcERfQGoZLxSZanxV6tOfRr4SeT+3vnuwLvrnvt5QNFDj66lVzZxiCEaEbgLCNPqqzVeRi/HMGgL60zbI4cnQM+YlUu+oD5cfpF0YaBLhafv6laSmMZrN9wACWxNCCEzItyZjI2DWVjmehfWVpEMzRW6Dhlo8GIEBQlZZjKrEkZwCzZcSERn6mKTkXlozrPwytLYiqKSxCUBL5mvuYYHzoowi4yhGrWQGAptWMHzDuH2FizcyBYlRQiYXG8KxiukUwSIvMPBaYeuRa3tR7Yx/ozNCDicQM+hl+7ux40QwJibha7J4kQwZLk0LJH+DOWDGYk+/QYMv8jIzYqywd53cHDCuOKm5eE9b7bHB+MnATR4AEIwgmI4QVI0w3K8IEqyomq6YVq243L3HyM+dngCg6BJhC2SzUGZw4VMwbRbY16+OmmttXV6G1QkL6NoMYQ4EpJS0jKycvIpsCgeXyKtjF+FsCpFtZbQxhFbwk3HIyQiJiHty1vy7ytzABAEhkBhcAQShcZgcXG6XR7/fA8b8YgAwBgAatSsVRvE6dI9/SBVIiqNHuOkyRp9ELpyolzl8fsBZjoisUQqk6eIlSq1RqvTZ+gYTZnrF6stewIQgBCMoBhOkBTNsBwvJFqSrKiabmTSg8QfvkCYKMWxJClLTzwdkOjleCAnG4TregQRCiav5yRyEHVMPN6MSRgfD1xI6ZHOk48Mm3Lw1QuKJdnKqwlCiBAQ9WtrXBP9lN/PM3Ws7tHsIho/66MGqqRxeXDurMF9Rs3MOhZKp7c4p46e1UC+3a0d7ghrJC+qllcdXkcjrebaOroRO5I28kW35Ot8bGg4EwoMf18fUYiJXv2XEPiCCQpZRP4FtYlmP8e9uSz88163fKVsHDCu95UERkWNZZwaXobScUYOJiPMmlkQrCJssHP43g9+lFwCRNgiNmUOF9J4ZMmaSAqiilWharHePIcsd3TCsdZFrkuWVFJLEGPVnl+y5kObSGzty0dThlNR86Gh5cuvv0zQ62hFVitOibpSZalxVKtOfRqak8hZI2rWktZIbSoohcZgcXgKlUZnMFlsDpfHFwhFYolUJjcYTfnUrqJJVlRNN5wu93f2rycTIb5I7QKvS7YXajN9a9gddTUsgdACISH61m+RcmYXyklxi89EESJvMPs2ktwhxte2e1Wh11R6J+pzv7ZfKxesp5BfRbQiZzl4x2E2aq57Ug86PPJ6kj4bd0wv7/m1V7aVSi4dWyal7Efvflvxkw8///jVT1d/dt3n135br6hBzb8DeONpQT/77LnAArE0tOJe+3bk839+8+9v3T3Q9rJ2sntwwb8hxDhPxbWgbDASffoNGI7VL2zscdrKFbd4Ht7RjOazlZ8ENIAGIAQj28Pa5/CbBCRFjwaWjeZu8CqIkqyo2mh0Da2pVttzDnXhfvNDUu08hTaFaRZz5rNgq0VLtTxtq0qtRVuXbENH15ZRDWJuiSMhKSUtIysnn4Jzin9PabbyPJUnVGeqZZy+gbU+V4tcv+YmPF9SK/202S/6KZfC/Nn8sg6jKWiItSUcqak2qL4+1y4IZVyJIYlETtT/n3wUVNESJWHjUFLJpKaRRUs3wviNG/6XX8hqKb259X/dxaz2PMJZnfFpF7R6p9Xn+n9dCHQ27W7Yy7m0bXjnEZ/X5W1AZhAQeIT5Er31QhceI9RFUpSjL5nMS14bffN28mWvQ27Ht6px4CAEIyiGEyRFMyzHC4nnkqyomm5kypst1myZu+PKyQjeU3c896/vKEz8DrOPCTGJ2N9PJlENmZuyQIcmThdC8OZPmt7fVSWiG/m0g7jDDzVOzUAT1UoZU0/tVN0TeiD1yOZJry2b5fVbGXlZ+XkFBUeqz2kwo4mVp8EsjHO5lsKrSy+tfPsXx93Bpl5oX9MTE3pD+Am4yYTmNMJFwaSFtIjoQ77t4KTDdlR+5h7uYROXqlc+9Jm/WW3GKTtwr4/MOs7RdBFRBIEQl0WASLRHBk++ZNMOvnvaaLw49aXulTdzXgsejSWEBF9jCdloWkDEDeREB/kO578RNeXQtKkxSqwflaazeu63tFKavvptRtlfy52udKbK7uqP1rjhyz/Uy6p87EAx952TtRDcS46V4ODjITdHfO/fl7RVmWnqd+2PdfWhXAOklwPpDIvfKaRZ0xmPwp6EM/GOvVko5gYWzMs8nMrwWnf86l0arZqV6M1a9BiANoUODBtnRVC4b6tIOs89PFiNlGYIvU2LBmNcG6BDjSUnqL5cq1GreQzVAW+uCWCYmPx/KYFFmNFsRx/RYtiShUMUZDB9a6PNhIxLR5sCKYMEDkIwgmLhWIKk6GasZ4MrjyBKsqJqumFatuNyJ20pe59CjeYNOoPJiu19Dtch3nrLem2CjoauLHvG+N4UpArv1oGPxJ6XyKQmW+TaCj95dA+CKISoANi3T/Wt7V+LtNxRX46xja9vKkzXDGbN1fW9G7ftyRsBcDS1eJRQkjo/5L2M97Nw8Rr5AmGisWLJlewNEG61dmGVY5Y7wYfew6K4M9ufMfnvAgNACEZQDCdIimZYjhdESVZUTTdMy3ZcbpoZ/WtKAyotqn1qg5rnPzHzgXREC/6hxhqKtRh3OJ4SEhGTkOrVpz8P+HroUR4L9mTz9P9gyEIQCDJkBHVcK2FMstqJYrOT+3cmuVkIWy1ok51ddIfknOS93lj8ULQz9hhQdvDc+lB1E/CfDftTNhAkC/p3qzlxiOgFKHYlrdQoQ6QSpKqaeE8bWihPZXPt2nEPoGYmbPFmVrPZ7MiuN4eETu83IqBLjB6aEt9rKwU9imJRUqWplWEqp60BWWsfuA7EgLLqwAAKCKVPaRiziQNkqfV6DXFIR0EZOPaI2NNEvRiYSrUqI1Hu29VuknxcwyRNshrqZmXRr+OZXXl9v91DhZmkZLEtK2O24rF2XTl05tSb1zcwpXIyTdYtYloZsmnJPvvXOGzPKekRutOwvLafQUd9BZMQQ+DeASU4tSxsM36L17OayWZ7drtyiO/0biPPy+VG33dKXK9SPOv4+fGb/G4DQrqQLqTz49f58eMnpNtIzGnQKhso+PHjhwv56JTp7MZGnNQ90Q8Tqd55zbo6tNQwZ0KaeegTA1WCBi36pInhd6wZfV0RJOgYhlZbCGuq+KHqOP1uoJt9NBLBj25/MjlRD1LJ7TKcS783L8R908KLqj6suXN0IRAeJHAQghEUCycQJEU3g2ODK48gSrKiarphWrbjciddJPuSQo3mczqDyYrtSw7XIV61rB23xQmcdMppZ5x1znkXrl2bpGNWl6jnVr5I4DWhlwBfi30gcVVqsvfOvaDwp1FPlSyh/yB/qYoTfqO3hlBoFpvBXGxfcovIS1toRCWGhLRt1l5vSZ/+NmwmE8zb3VnvxvYwY2aeGc2ZOzTXGEPT5pslWp0BBkIwgmI4QVI0w3K8kEnX9PK+QJiod7fYl26H5szkhFqlgVpig4Rk+HUA8Qr6BenkrDNXXVG6ZXYH3AB1aWYHukTLHAakTVNsaYWFwBBBQno+KleLFkNeTJu3/tCaDeiHwUOMqbNYO8wvCeECpoADByEYQTGcICmaYTleECVZUTXdMC3bcbk/+iEeAevCNvR9f0rMtDgSklLSMrJy8j+/1KzcpdKtqv/PWDGI8KdxT8VTQiJiElK9+vRnYKBBQ4w1uaymMG0ms6erMAAhGEExnCApmmE5Xsjkmi3WbMZ9c/TSomtohuhb8eyGDHURx5zXtF15+TO7P2d90qgmScjqUYpRoxHpDCYrNonDdYh3Ws8qvR4U2Cc0DpDF7pBwpchOy40pXFTqgS5DfJUHYU8j9Km5SCs5feZw2bOTJ3biLNobInESJbuMlnWuP/SQdt5MNOJN8l2FFu6Tmuc4s81omFYq9OPPaM8xQQU4G82kSokTDa1TD5noizrQqkZhlCrYETWQAQFPpA1dAoN4HUG7zu+J806znfsB+hXVcCB64k23qtzpAW7bVafuXkK3FQ/xakYnen3Xb5frgKFdpdz4SLreTxf84hhKK3vi66FwGPwlJ9Gn34DhjMuGR4ekP93gNKPbzJKU9inXqO508uVOv9JZOH/PrXe7c4/n4wXQCRQtRizG75KsqJpumHnOBgMYIrNFbMocnridSucmjZemNKEvECa6PNucn2SGqpE+Ckqzs7ihOgrasb0pOzs7Lu7E3fDB2HjY2A/FxsObeDcfipmJyP1jjIfj8nokiIiIiIiIiMiDxRhjjDHGOI7juFwuAA9kDOV/qtYG/Ng7bS9FXodWjbzGMXjzmR6BT0N9zxRuplN+zFAwYeRXola5p/Mg1ALCBazzB1E8v0GDP/OznqWx4Fm4KG2wXmhv2G0c5bje5zkI5YCRNCWWvqh+DBiOIVHmWLWzsceplitudTy8fUIvn1r+BNiAAxCCERTDCZKiGZbjBVGSFVXTDdOyHZe7/xCzVlPEZs2Zz4Jai5aWy8RW1Vmjrbtvg36UTYxZXEJSSlpGVk4+BaDi6ZV2tajtl+002g3YC6PqwB5gC0QsIVWpvgapPGPU1JWV127c5s4BAEFgCBTW/rJwigCJQmOwuASEoiS3p6RlZOXkFVJcW1JWUVVT19DU0tYJarFNIkfZqtLoV0zzTMUBj1KJJcUpMnmKtaVKrdHq9MuheIxM1ub3F6y27M1wAIRgBMVwgqRohuV4IRFMsqJqupETvdyn/TpZGeJuzUGUkMc4LJxY+pBKjyxwElIyynVNXdRWBd8y8JM3+leGmuynxJWBhwEgBCMohhMkRTMsxwuiJCuqphumZTsuN1VEE4OIS0hKScvIyskfLuUrl6judrreudx16667e/RtQzxCImIS0vjXl4MAEASGQGFwBBKFxmBxEV5UadEbFCqNzmCy2J9FDaVIGcQ39PTzj1QJAEIwgmI4QVI0w3K8kEkyW6zZuvfNcSGzmj98wbkQVdaAKreFYnNtRi+yDYPTegAfccQuU2b1O+YCo0suG3U1vWLM/HHjoDMqoYCNuEfN+WPQySJrq8+I1w79p+wVAaoKg6uFUEGmlWzrhCqmw5SyuFFhmlAsEqnJBwAIwQiK4QRJ0QzL8YIoyYqq6YZp2Y7L3X9q+M2w3/NnZ9uxOdx44QsqnLbYTzxaQkntp48MsnLyCopKyiqqqVVd43CT2q6FHXtuiz37Dhw66p/suwSAIDAECmu/xikCJAqNweISuIeiJH9T0jKycvIKKc6SsoqqmrqGppa2TrpnT3/rCZHN4hzcGCVycZqZiYiIiJgZSQIAAAAAAACqqqqqJOm663e95WurbSuK9lipf3mrGwRo+2TIfRQGXC1nh0mKPkemVdLWCXVQw8PGK0oE1bra8wAQghEUwwmSohmW4wVRkhVV0w3Tsh2Xu//kMU37PeqeApk1Zz4LBi1aqmWyathaWye2QT9KLUYuLiEpJS0jKyefAqni8aWAclilVjWuVmM7aUfWdyvtheoo1IGIJaQq1ddSojJGTV1DUyttpQ988tkXL169eT+MpYgY9T1mZwm5CqqmG2au4beHj/xjS8gUFkmCJCklLSMrJ5+CwUUlZRVVNXUNTS3tdKq7es9M9D13sI8JWwpaKfKlDBACAYGDHIzC4AgkCr1kQuuGTbRt8Q57hxxXo3IAQjCCYjhBUjTDcryQKE2yomq6kQmeLdZs+O6YztB74F77VzhR/8YRwpXHeL34W6EwSNaV5mmE9UH1iwHDn9J8uvtBQY0xIi6Xy1uEJEmSJEmSJEmSJEnSCh8fAIgIXHAcqqqqqqq63cZjfT3WD0qSJN3WWmuttQAAAKQkKGs9/h8gQKLQGCzuszQgFdVgYIwxxhgjIiIiIiJCEtltzJeaBwEAEREREQCO4ziqqqqqqqqqqqp4bRgGAJ1ZAJAlAF6xRuH1nn+hfuF5ZJaRG2dKZvYZGoqUbDCtkxW7LzJzbdVurNzXZryXDzeCroQUDIHC4Ii+IKuCqtLoDCaLHYesy+MLhCKxRCqTp6Bb6vn+D2kJC+WcZcG31M8P9nt/L2sf9jvAbcJBhz5TDvoiR3McbPsV5Hej3nWPysTR3KvG5/EutrQs384cpzfj+sRLUNyYvsbdhuMxaGE0ylQgs99Z6q40rMJmLNtpj3O965bS3T1SevNcPGLUmHETJk31u7YG4xGFzRfK4oaHTK/1+wafaogWIJhjPWbl9QXPo6DyaQWb/KudB4TshlvuuOepZ557ATojt+dYu1CkJbanYf6U/hu/LY3ILWRDbQ1nANp1hs8UD45+oVz1REgcuXLlIpFIJA7OklMsLcVB4/o6j0eXIBQIk+LnJoASRokoMZEASSODkpeCKLmoqKMB0+quHvS2PqB+N5BBQUM1rEbaqIAx1sftgTtDB6CX4w3KGNUwoZkxWfhYIWzC3ncIcBJyfYYWM0I4SEAIHAAhGEExnCApmmE5XhAlWVE13TAt23G583HAE7MAER4ZTqPcay5V5nAhU4TazWiGgJWtugnQDCpiy6iQGFIcCUkpaRlZOfkUwIrHl6qVEyqDqsNq/aFNILaEOxSPkIiYhHQnGzQwGo0eYk0zoNFoIBA4tKDB+tePeh/1RdOVYVrZIJT4MPhrCMEIiuEE2VRXrQyW4wUxKTgrqqYbZtbg7eQaeHuc/7pNxa6a98CAXr0BcI2AQDNln3AjogDiLQQAABwUcUgixxgwWWwOl8dvgWAoVVSEWCKVyVMUliq1RqvTZxgYTZlrFqstexIBACEYQTGcICmaYTleSISTrKiabmQiZos1W3h35PRf7mf+PRvwO+do7/8HAEIwgmI4QVI0w3K8IEqyomq6YVq243LrDNMysnLyCopKyipPVNHGMSpa0LioGUC+4+hdxyxP+pz2Ogvn7eJNl4xR4DZ+BBCtgTr8bgXdaZ9lirvKRR88e/HqzbsPn758AyAEIyiGEyRFMyzHCzkXrs39mvzXPpedgZ5GMUpaKQdDbDM/NDQ0NIO/tx2zGafFq1lNZtOd3Y5Ob//tRmi63OgIMk2J7hVHr8wp5cKG1LpTjFaOrDzZVq7aJdchu05bWq+8DTa3kXoXyc266lbbNtzbtbfD8nbS31U7MsNOZWohs8wsESBrHLZ82AvhKDlnNGhMfeM49ypvP2oH8pe627ImaB60tCM+76raruOeF9cNH7wptoee+0h83lh5H/zEW1g8TkEcVDihrXwibOoJBxq0x7QzHpd13cPtedVQ2f8hNFBLMCfRp9+A4YxLhEdnnv7nGbQxEjLLwErNyc1F3Gge3vi4+RMA/yUAQjCCYjhBUjTDcrwgSrKiarphWrbjcn/REFX7a7e/8c9rQdWb/xcvGlUto6bF+MVVQlJKWkZWTj4Fs4pHl2qV06pR9QGNXs1hX1jZuM1g49KATtOwcHM/AUGsxj0dzwiJiElI+/Kz+V8q+wEgCAyBwuAIJAqNweIiTFRp0c8rVBqdwWSx4xS6PH6CGc85lDPdJWUVVTV1DU0tbZ10p3v6X1TU1QbuIUbGJqZm5p+Gvtp/lfYDQAhGUAwnSIpmWI4XEmeTrKiabmSiZ4s1W5axQj/0CNHR9a4iianpANvfehBeFCJDDIiNi0dIRExCSkZOSUXNh4aWL7+g+Qt/BdzX3gX5sJQ5UM2O+ki0xMXS2j7kA3l6pb/UcPrSptG7P6elmnSDL73nUW26H0aN/b+4KD5OcD8mxs4RvyzRTTy3Fbur4E89oe+3RbpL/wepmREKhJsUK/zf6wLcTVK3GdxVTnbjiGd/zWOOcQBWvySM6dc+lPTUgkHhH3WAON2HAh7l7kL6pgBI78lWjhOb4rMmkjAaac1kaz912wgaFxIQ7pZnZupEeXcs8SbDFE/ebJtn5lvz7eI7iuTTn7aky7AubZHb1u3mQXRoOLTHZ4fRwzdH23H4RMQih00yT+X6wYLDp3PxQFYR3IkJpnh5O8/X6wdRn1bsKI7O8vU6gNa+iF6gYleilGFr+AUOtkl/gYNdPQrzjRymcqvthgsU+PMDY05W7BMcN3HSpcS7UMWmRCnD9gBCu1ChQuHzHdRiKYNy+/Y5sVFopE/KKveWrvv7wfgypvpsPv/TUvnVrwQCgUAgEAiDw771Qv1iAxKSNGTy31V4Vuk50CmIp3vtqTsR71NO0oqInq/0VMbKKvef63ro0GjZNjtpTXfj/W8cHOfbKOAIbQNsF1MluaVUWbwxt0UM1naq9MHuRuzNFMdytifwOnazcLOmumJTojRlNtIZTFZsdZWqNGl7phcnOAkjWDy2eLN//x7Qg1AsK7thAmJ96r6kxUjzZGMAMXw8uZJaSukY9XbjLk5UTSnFRrVYYmOpBtCOptrfZJuabFPbEholKZ7ujE4sUnDrlamZ2QmLxaqpnVbD5FutTwPAi3ES66U4WVRhx5V2gXjIsMrNMAaRS6pZmePGGHU3YAxhDBmxl2Svfhzu7MD+Dk2DZKVQ2RyuQ7zjfXV9dKGNgE1ij0hskIZsyk9zjVHZCrsV0T7FRlo+0W1FGRzrNMJFi2VipGdxAA+KK1BF0uSp9qsZg5SoO+m5n99XuFlpAHQz5AbYBkREapg3JJOzMjYiKRTFLjFypAJJIWmY1mDiHN6GceITpI40k1bK3BU7rrAWKKikNhwgecMKt8YMIKP8cLDsqspdccgBSRaut6IMHk0LljQ1DCa+kkDOFNwU8/a4a5uO0NHRGet0dHRwur9PF434MYwWZqIY93YTjuE5fZL2P2wjXWxyk7u9EnXKq284HL6/QRmVct06MGeqNxrgB4XP+OXBg8cw1bzSjdHDMY9wNRsMweE/Dw+vTBSlyjjaG8f1rcxopy3rpvBKo6THVOxKBvvrlBEoZ3tanaBjYnZMzMGAoBuXrtswm3G5mAOTv2vOgthHETdRwquRuck3LEm9DbYYGD2u0oM1ldfLRBGNcezUU4APSEtLy9lpaWlRaGmxcL1mat56U8TWO9DS0oqZch/gp8FP7aSd9JFKSb80mwcyz9ba0fblvdO3wyuFCu4r6GGKfSq5lV9LOYjsMFiHwR4K02WAqXPBYDyvJKYIFV+Q6qj8CeufFJKniwG2xImx6CmVcTAYFK1xCxBCoC9dpjFkMKT1guduKDA/vRT7z8fXPKO+H3lWAJbtOwHrNNQNzO7dPebiA8X54r7ndk097RccvnF6y+7KjTezm56+I38MPrsZMD2zE5TpoTPuzTPN3gYojx4GghrqAeihLWOuflCigH/hEzvX7L2rjCbzacmJTTN79AVrQ2RqSW58Blh6EZem2EG/Y6zOBipXLDJnczGayJOA5v75MNBcUxbjqYdj59PedwE1l92QYfTMe084HlBNRHprX0xYRA+sN0Wk9+64IgdHMQiKtucAKK5nD7ibPlKj8MiK9RWbh6J5dxum5mDerZPo2zz5jYCjT++OXYWixel9SrCtzu5BhTEV+ipstElCn1mXXJ4pS1iYIqY7ifdNABzakUsWAOwZEJbbhMjX7DFTmDNr7Ju1x9/8b6/XOwasD8SeA+iGCs5N38NoWcLcs2zxAzWwUqRv98GK1kb+Z0tT7jPw+LJdI6QATwXAprXQMo2LTKJswSmB1jB21dNQ1Qee3nD1KO4GyE388e+Az9zM64CHDqzBNwgwrhUr+Sc2j6J8F7yXZHsIlBB2ZR0ScQlsBEVkqDMb60k+IBxLUJDMR476nbFtfWrl8P7gWB7s4fWOnn87UPUPr8Mh18fAwLbjfygXeNSZX/brHXq5CueaPfWBjxy5+MTBxRkUbJFon428qAdq/ZH1q8T5qdGF9tfqNx8pLnfYyGpANPvLtnPMpzxlWv/E0wDGlR9798Cpc878LHDj3qnHjGQe7igwBcjm3RgfrvJpEHLqYgXaZddd8cmxi+5s5KoGcVpkelKS/N61wGhKdBZpZClYuqh780EJANzCPMSiKRFuI16dJbXnluMKfgliUF7hV2IC6xz+VRtUHSN1xom2n7Z0+QXskssGANgSJzmoJ3cAVo86HGjlZe+Jf1b0zxXUX3+GIJADbiSI+ONVwX5gOoSPmiJiyg1CQgajoEJgaFB0MRHg+yRiYCPhmMi4uVHwCFAJiZFISGFkYkLJTTRKE0aVG52aD5yGFoMvP0z+YmIJMLHpTByBJq4gxNvaWgUGFyQ4pBBRoUWLi1i8JUjdW3UgueRoopRSKmppZZZumqxWwcqrAL3CiuGVVIZRedWYa6rH3nDn4mAv1YIHthoCJTIAS7RjllLM0hjMJyHDUoFZ8s3U0BEUF8A4fpVgoSmRHmvCECYcT4QoTDFi8cQpQrZa6oSKP5LBpjI6JoYKkaryV6zQrlaLqk4DnkYclKpJhpdkar6katFKrE2G9v8j69CNYKttKLaLHDvk2qnULol6LuVmHaV0TLbjYk7E/rV73mLmnKJwGtXO6OOsauf0cTntz3VJe9eZ3OF/hP7KGv7pQS5e5ISkY7d8HImSkl8iZ43lZmt1Lyv3My5Sq7LzywwNHcHhUbb+qq1tJLupHry99SM3kJHwcE5QIx9/ATctWrvbg9reDlW5DufJQzPZDPHZriB0tdv+1Z3ue/yDHvl7j3tqZe/Q8G/wGjMU+Ia5i+05XEqLILfGTUg/tuYPEoS8csfwzPGR1zWy8cf59o4Pbju/Kp4XgVhg8daXgIrd+c2vrjkKcv9Yt5CRi3wn7GsDX5MRxyzjK8LEUTxnSRCZdT/L+uT5u8ZO2jEgj4urhCoqQskmuunBrQJRvSKgZdBikim+fXsDPNmrdnE45T238GkjsUi7yAejsH7qb1/VR/bojNQRzmpaYigt1gf2jMtotK9yFQqUq6AoQRne7Tn9thqvfOmfG1PwTZzb7Tr3TuYLuXp66rRFiE4LgP+QZTKTz+6vcauOScD8JU2CFSdtumPM9uX/rj2r0VHNdoJrQrDZdw7ZNnni6AkH6CSSde/2VpL/4G2t7ZFXcvoFYH3R5bi63r140fgHlTOaMPzGcWNRgxgavz5SAWpc6cjtK/jfLHhfJQ5sQgzN6CorZ7+k6c+6ycKukvG5Cq4ctkUHp1K3Yr93HmdvbyTaN9t9fo57H3yqMP1E3xR6ciVwb91gj3/Fl/3FeVYmaMj5BjN7HaiqC5igCs+m2FEAKO1d6PSiXpZYI6VjNvrYVJaEAfWrXnqvd6gFva3x9FN37W6x5/pWFgec1gsP96a4r26uXzhPgNncYCnr3tCFigLcWh6SQhW0qrUK16HHAs6TOe4wK+4IEKyk/YrkRWsMyo/hGFERU/FUK12JKY9YoSnGhA5HEBgy0MVVgngJIIn/T5BkAYqFViJZJQ9ZoSL0AYqIMkhbBfLQHJSnentqsJ+oRgXBYX/SdFiPbgNXCTbahKTrgm7zMcEW3UhjFI6BiYUdxwAkJPSB/6sdHN3RD43e6EMaWIhIkUcVCips0Paj6HEMJDgmNs4Z5bPMLBM/yyEPZKMjJ5qQ/IY8eJsYOd0cI+QUyBknJK0ho15noaHAEWMknSQTAREBKGl+SWB8IstT5BRQbFPRwbjnpDWhkMOgMChZwmySMJUQE0fwJSiJ/IBCNm5sTvjHSAQFCzmP7EjEhzDPeeeFOBTMbBKSKpFRr7NQEGMkFBICUFJnE3iaIoPE9hFMxkDqFywSCridJUlcbRFK9/GPGq0yMUAOv4eP4ixtnhXJoAmRVIQRi3Wx9xJDQSP93wDHX1CgXdQMSHyUXGQNxeqzH17wV8O/Iutv2vhLuOs3W39iUbvhng+u+l1vyt0898xzjuB3TRFQSjf6I13rPYtNs6Nnq9UJ2D8AyJLGfgD/xvP/SOzcLWugN+axMTmLBYDXuPiVNPbCqmdJzd19T14+RtDmN+b+d6XLkUAuOZLeXYIDzXEno9XfaWecdc5lV8MkwqghpCMofiBwyCUBCoEiIByIYFmkCoE0YonBqBh0isKlGESKQSE8/FPhlYsYiyhSgb4TRsmCIuhqlXI0/FaqGwqXjZQIlMF0okhOfzLMU/ZFiuCnxOGUmlTIVLhUuOjRTIKhwVBRbwdKCmqlGCXJCiQGr2KQKgqjYnAJ75R/ecIiQoJSBI0eSihl8Jx86JBhE5AMJQj7KxxcZV/sMBjPwlfkHTjwaVJnLeZwEDi6ae/HsIEqX8GFWAi3Ow/x0CVPhBH3eMjAgwY3CqAjHjSI/aAKPwQWiho5tJjjEQaHwWGoMDgMDkNGOSV8NAAJ8ZVeJhZYOBAwBIwbG4jY/t8OKxgaHAaHocLgMGQUBCQQA71fEOBogVrw78WF8d9/q6S47RH0gPspcIB9Y4dft4Qgz3hqWSHgfq2XTqUGNL/DlgPaTIQR4Z9TsTPXtjoQKQj5QCsNkn30iFNmIXi86SCQONCIDEatkp9cRdaq1WKj7QZY7eY29WsNL/e8CTAMxWKKR8BCoAX08YgVFZLT0NGLlmhJaS0vT0InUW/B+gMolqNcrjVWWhvPOnkMiqJDQUZBOlN9zDLaksdKW0lX2GSzW37JIR5MLxEsThJYsRSwcqlga6wAWxs32DqrwAyJglUpBOtIGqxTPjgULBrlmeo1W2yxxGqpzTK7ZEdCZ/2uxKNJCL5nCejFWiTN3DFzGh/G46hTw3bNbaBsiA5xz67KbFGuO9Z0LjN1nrkLLF1m7SJbl9i7sozbYQNY3GPO22hDNunS67hGGZ3QSZ3WnE4NxtQZAsjOlemAzqZxBQHIpzVuIDbXlP2bihEeaBsE+L4kFIERN/CZG6C8Bt1Wz36+ueAG/jsvMpZZ1881sw28FWAMggdwI5gctVU7DvJfDwLatiUOKgCEXbVl3mNrfndoPJ6haCOB5xTGCAFmtLhs1nnvMjAyMbNEAB9HBJ9oM+1HHj7NXvjLgN2BAkMnWcFcPEKiu7rkSipqGpD/syHTCRTkln63yQ3rF0wvTIQYcVYrVmYtQz4kqtWo0+glzVp02Gq7HXbapccdve6CrIh9fpELlVFuhtpqrb3OOtoo/Na629aOzJmyZGu8fe1tf+4OdK2rXS/SWigdAmjEI33zvkwNUcbue38j9Se/gCCyFxZERfzhb0X6eu599TEgMvjNUsCf+pFicjkKTslHDpkGV1x1QgY75rhvLUNr8iU887y7LZTvgVEdTsWkpqcSLkSiJAslyG55HFmtaW3l1jCycnLZa7f9xh1gAoy5APAIi6S+ewNn2tq27EOymN0bD8a7k3Oanq5M83VAi7tEIEmaDDVpTFt6dFda2ycCmcma2dDcaKGt7pjASKNNz7xqh/bG7hYHjU3Oj3JxeMtr7utTTIrI5qFy+luSsx4YnvkU3uBDrWcd/D/yReL/LCxNnezB73WL5/fy1zSiK9W+OP2/7cbO37YfqWFOijwr4Pt0HR8G2NDyfTn42GJj4sHvzvy8VcMj+8jPZ4K/5wfzk/nF1vZXFOeq+VosG3z/9Z/22/CIiEsPMvr7fM3bLnrInrrXWb+57GVOr152EQWigsHMj8zbHxjocy5oEB3Cb2E/P+OayAjIERJxQY/4SAY4CDUyQgnxztWh8Q0pg3KALSopRBU1DKhpoKFQNuqITmQaALxo0N4B7Vfe7YedgHLXnr7HZUftgWM9Smt9vjZAhfS3qQBdBdqSzuaCihxc1Ag7i7SrKD1oYofZXrSBYgwWa6g4xuLvTUrTAtYWZW/xz9MYVHlLrGlpwy3LWfLHmm78lFylNkqgKhl2fJWltbvM9pTeWF7Pqm1FE63sYKs6xICPivI6XEGe8jtSYZMVNcVHa6ubrqyjleiktb51yVV0o8puVtWtqrvtdduq616NPaypl3pcS09r7okzFTdTabOMTFQY/ACr8Wq6U213q+8+fyB78ByFAk+5T2rkdUgQJDAM/vO14X8IhxN5dKJpCixoT8RVOJw8+d3hEs9e6Ms6tyfkqaJ324eUdzeHcPAzDxAYpEWWnv7DUecpst6nROWexKQcql3HMF//K6i+C+UbWzvvwQSYzScwWYkBAoFwGGDQVnzz7NOUYJUtNos3n5M379rDcWgnz2DiRON9QmOS1Sk8dUuib+m4RGHnpMBEfJEMCPlEh6qdyoALoDnVu65G1CpLVi4O0pwNtNNBzgEJgsiB+7oVwl4lhERkEk0PzVAIcJhNgdp2kaKQrGL4HAqFxQap7FEHmlqT/7P2wmbUJa3Xqbxy9U1OlgmuQS+dOOeQRetAAQHkdmXCWliQ7OvaSDQBiRWWDqEcCohyQA4Z2Extm3+ckoS9DELGC2W+WZICk3yfbBEv+0DPQYnA21QAyyI1YHBftGjhmAQmSk7cR9JF4N5A674Rkrc6l98ZNS9PRGAW3LsVcUFooDM30qTeW68l78znyz6gRWdrNX7jPKADFCQ09oQXvDVIzGg7ZJfUBgf0EIZwIHmPcp03Gzorak+HTqIFDHj6LnX+Nc9n0X7bj4ART1+np29HeOfPevPOTeg4tvfl+rFsKEb0moHBfOyaJ1IrQVcmH9yvUg+cBPexpRaqnKrsDYqfNkeC9ALzVyh7gVa2oxVCIU3RJG/QDjpic8jA60TMl+8CZux0WKu9S+glaoFaIiqBQlSB4m/DyS6O6DO2579pK82fujM5YEHH+ZWAFZ1eH1A2mOxIcsDlRMiFTG7k8qCQF+UDSWiAFoHUXj9laf0eax928Gpfgue/pwvYHClICpHCpAgpSoqR4qQE+TJjOx4lKTMiG1AwBM3U41kqvBTI1NojJgeySrYcyENaKQteqzdtDoueCMqoPlBIjRZdkS93wZeLNAdB59oVo6KC85Wga7YuRBk7YE3BUUOqGKK8uPqFu0fO/J7d7CW2Xg5UoFErWx9NwOrNQBXDiTFQo/NAtqFdRh2d0dU5DVoBqerDHrdPQ5qrKs3pOWZI4lRjujx6OdAAuIL7BwavidKuHLScSGODSWjiBA3HBsElMrjFc6pPO+ikn8ztB9wYJNt35RhNKEwtzNhHWxa4tdGEIkhneg1mZWtIVrc4D7PqPrmy/Ciyh1aT4Jkt84cM3LOE+RdGQ7dFO87WyJCovHoQvj4qUgAw6A32BoBn26sFLkyPNwda/pdLdYhsHWTjzUB7zINCIrp8CVQEM4U74YLGW6IZin4aNJp3A+sZSAoLNy/znXaPv/OySVKtXktWgH11ga72CksLV741K7pnTWNDD6YhYte3fJM05qHn2HIl0B8r8SrJgFGdfOZD0FCJshFoalMKCwVYqrJZAtwGchoYV5c1srCwmppGDXZT1kV7gq7Y8LEM/yBWlQdNh0sLP9r8EZ7rap/t46aBsPqum1V721t8KHOXnNsuG/PZkSdMwUs5MlApBxaog0t08MURqp8TK1B/0fogrhamQctmQcchb1CKifXVhi0mNES9FU2qZTwI7Wh56rQQHbqonrHQ52nQQngYokbGwpinSQuRYYqaGQtznhYtxIQlamUsBJ5iCxEhoXJjoRQr5s2VxCxwv3EsfaWFtKesrEi38n+pbRc82fuTD8YKnlU+HaviLlVXpcSa41ltOVbHUX1Vamw4njWWY02cXc0xx73dXEJvOT0jiF7wKllwjcht2ZzcEes8AALVsg2hHD+JV/dPfB7PAq68CwC3XgCc7gPM62ZtUGvhiDTk01NjIRb5FTpWC2jp6yxsG4cUrqjy3bVcmWp+Ti2barktIIvvYlVkC9Kmdls3QG5jczeE91S356xi7X4bcA1vmT3IUqpftWatYLt8gBZdsX2YqV3U7KlEjiY69Cayk/ZxVsUOgbJezRlIIZfiXqh066hEk9+M5Db+IvwGGxv/prBn8yFdy0OHRzBWskvtvG+VMH6zxELwZnnMGZhOdN5rRs0QFV7HneVYAmlFHv+LMjnz2ypweI8/2Pwnp3fwKEJFinGpUdrT1vl2/Iv3jJgKIN2l6x2w/FoyXo964uw/Xeg21AyAR+meQrsD2QMvFRugSRPKA9YoOf6+SGJvj1Pj9AfLJWb4QxW5vAteDocI4fu2IoSCGAsBXirrMiClzUrlfWddRhspPAhRZsAoaM8aKWXbT08T6r0rQ4iq8IP72UNJK5SqqJuTZf21JNwWhtsaWwLe5JKTD3yPerNDXpQ546xNEoiEyNJeI5G3MmsItL1BO+a+mx95lzwWMgUXPcWVI0IbKVpAEwp+9Mw8Qph9r03ZHvjF4fzIAsN1NVFAKwcLGY7CMhnJ95cjbMwBrUxIQIVW/S1VG6y5GGJCMNOIL4HWUvjs8nhpdVkuiS9G/Gnk9KigOcF3YW1mAIlc6tKnEVGCIxXV1nqEfwmvlwlZaA6tFpoc29Io2RLwyuA2F6Griewsz1U4Mnpu4UjODHFJnbDlVBPsXVoC/Z9YfdIIGrPszx+i/803HN+s/2CY0ZATqDvVMibKEQUo4AIw6imLOs7r5O61ABX80ygpFBa0WF4l0mwkXPwzTAZtFrXnaa0ztJGL9q6/3tT5vMQVnsS9eLLhAUrcciaCtyHWoJyQcxkD3vaQyxAndKFjb5DKUQJDV5M5olMyo8NdpdW3ClqulM6SNd/WZHoAi6K6c6H+5KzN5QK076HqhdSEjB7aR549LuFjvtSyhAOh7k9JY4p6LA+0ahTWDpdeiLUTit0G/rJcPNFNvQFNCW+rLUL6kLjHy9XzmufUEadFs8/eS2GjwykqlPEoOAxI1/oT90xBEJltNpdcrWBXiiYw6nE4IMNpNTXX/xfXJElwKaohzlZxIGPdYNwysVYIEi02SQq5tL15ORyOpJbvYmoY5uuSlXBaQ4SGwgBagXGJnOYyOJ+kl9A0llZnVTiymnB1XU/Z61q+QAklTr6h62XYszVM12X+BErSfF25yDaIVZGHUDIGubavmspIWAu1KmRn0XQCLXDDQRk3tN9jKkU0wLirlznAu1ocqVPRCs4VfdRiXdyUGeyuXO1w1iTyzOUOffrVBrGuL31hGvMFRpzpI1qyxU0VrKBn7frHZqnb5ZEgTMSyvFrHvhzNoJWH/UnJ76aJG+cGSpFzrJmvaUYOGgG0+iDuCneZXI/rWrT3VjYb/8YSlVJ0+S9oK8M5qrc+KqMsXbSF4EXYQd2qxAs4O/sj9woKVlT3/VyGuWzSjOXzTjkjveNE7kBguR1CBI/jzVSdl6J7XcHBlRo6XNbB5xY+KimB0ug02Z+HxjPIeeDD0HBfwxrfUA5n0kng80qhgsPYagfGIsV9FqdxO0yIhCTmkGo1ZLtjxRE1EXVJRVZP56jMOQRWR7i6OWZOn7mabiqaWbqFat/YAcjIsAw4RfL7Vdi6/ck0srCv18E/wymPWafFPx5xGyyi+KuZYAOmCdR5YfyZY/fWfhCgxLnxAsbYWiBSQhsul8UUi4x4BvpHQzpJAooWEAIPrpCs2Sd9ZBiSEEA5Vi8NpEp/9ErCRI4JeJZpA3bFG/y4IWpWXIMykrPh9ZDdTUicX8+p26U7Tq7EJkdVPzDRHsXj9nBHxcApBvo0x/8gFGKuIL5lbFIKZYfmoHYFjyhCssY4lAYiP9Q52B/0OjoeduEEMgazlZabsjLsSPDFApr4L23RxSgeIoLNG0fl2+kRDzX50JHiUvydiT5ycQCL1YZIhbaq5e6La2FhOaDVhx9Zo9XFo2izjA2kYHOIfisN+cP8TuFfA5MJzcmF1BezA0qWmwoDcSSm2p4ARkaQTYRzAAEDzfJEqFAGTFO4QhHMoSBoajnbF1ryXE0q0LoSB8sDOfHQy4h/rRidco01uGLPoL4dw9y0IZTFj/Rcyyi4cHqAgq+rQo8wfi6Fn3SEEdsJTjOh6QhCuXPOkjkwS7/MiHRbgvDWIdnC+Bwcj8+ZyTmqPy+qJOc/mcAz3JvsvebKMwJUOhB5i4KyGkrnRVrmFp17+FpCmTp6hkKbATjjmu0Ts8dy5Bgzstbujz1RXydc7kJ7HxwegB2xVSXQUSjXgeFejPtkUDH78J0d+CW6+eHlLAeBspfU+uwmkYgCWrklYUFNGXYS6RSWPFa0z6JyALE5L43zlc67OdsnlJfiuNPVKjtjJ9SuQnrR0PP0J5Rj1y41T4JrRBsHK8lRmtX1yc3sFYXstMbxwZ0m2dRg7eUASXIeSed6MKO2R7vUSdDSwwviRB0sMKHLDCs8+6hS+Xnzr+MY7rXCXVBJxiEVAoRfYqWiUjHc2+XPJAE6tVIRvd0guBMuzQ4SGDnxlPoSXiKrRudEqs7qvHKMVFVMYZevYqj0PgaoVNqQVLqKMVfoFGnOOjCxAFxEAzOUP1EZo5mJbRZa8Xuo49qcMw80sbm96vvmyBUTTFC9ZZJvTUiZdisN/71T+rkt9iCYd1xLHBzrPNafzsbRCTcDXr/P1Mk8Ybexj1yTN7XsH4L8AYNmAtBQeVcD97wVEUNEC8pey9DdphDfubGXdTXjDC4Zt6roUzKhCaldeOBMJATNkyO+sAEukSuIJqRu3QqILX4JyRoZ63y8Dw2bsNy69PahpwytkAGu59M2JLWU7XBYkv2HQaBxeWDbrUXJWVgtj2qVIuq4fU5sNt+OBxp6JuiCaM43pgGNPMuta9XamwOaYWiiHaG5O3tupD3WPQXkaG+c02k6P0bw4a7L6z7XvMUNUzm/PY1i8Bb3g1Ql88yMU1sOZl8TrD1cf9kWMmmnZxDUDgLDp6owgCg5M0I2x5dxLNG1wr5qjznKNj/e7KGblhNbfG1o33x2b7dDHvW+R6XSZyMHdng7Jt/4WBgb36EsnX7y/vKUjIk3U/8imDAexUQXImRAkz5FDhfHnI3mWGTDBTqC+F/xrmVcUxTwsHN60sL+di2iNIERkI5wveCgDeIJQxD6mAgeMhiDjMFOaY/7/lk9NBB9srsbgkUJMBo9SytAXAFRWMAdXcf/5zufo/pbUCeZAxvtxqhwjt+ZuX1eKn9QJN9HZTQQiIjAV47UbbuoK/+feRcTrGCSlZHSNRXThPJXK0V5xGDUKm1qmdqqdVqp3E1Qn2NghfsZak4U+HfSell8mBfWdFHg1cdp0VlDhCRFgcVJ95CCkme/EMOuCCpql9NpX1+G7jVK0zhPENt1moDoztyQviU80V7YgF7nlFGD/oYIFnbpddyf5yf6K48ZjB6lOOBoqiedUWy8SZVYloGobtqQAR/dGPOQBcRe6+XXNGBWZg7Kn+iu4oEiB6ltTi9oa01sToSdQMw/UMhQ5yZcEaDFEbEex+GAjHQe68dFk721Yz+p3W6QugRd1zIlH52Y2GWUF92KBAOkPKcMOScoF3N/8ntcJGvikidZZAgmLuqZFgydYx4RJWFsciUFT5qWWOAreYeCZ2pA+aSLDn/mKwjORC+qCr2Hgn98mPmUm968+LxyS7enDbVumStbRRNbpwtvyo7ZfI0F7/lc0AX37lCKY+lsVrFoj7lhhOeE0qUcsBdQt+D7l2sGOZNTQ64gi6FZTxQpUlqHSec8nuE0uc+8ac9WdfZ9yWW047ft2hJxrXx0fCnmz7Wst2bhPlSs6F2zWjsV3yOKRYC428DqyzQg0ngwCdcemOJxykoxfk0M3EH6Ce8TOZIBpmmhBeOGCpY0k0AF618Rh4fW59NnGGWT2oJFyyGatzBlOR9cKWnQ5Uc2WlI1QslgiMVZ8EVhxCVfJJDqthxxmCY7lM1YGHdJGSqmSUlclK48NUDMy5yU2wUBrP7JsophXkcyn71CC2VNEuMXw1Fv2lhATzR8Gg+3pFZ9J+oVB3pUOum5wQZR5pabI9RQIhitE5ucZEie0s6E02V3pzopKqKLu7G7uWHCjfybDaDwsrtlaUW/HJC/37LxVoRPINqsZwrb1tXBiKfeo1vAwIOj66hohewUUcN7nEPd4Fc7nFhn4Od/uWYzF4JQiFezsk4slZJQwIS9NtyyFfM/brtehvhMcA6drsWrua5UIThtBQGVT1FoF7C7w6nxG5fSlecgo9Shx2fGErN58yB6vxfW5PPSAO8Blv1KYT1QOVlEP6zjHUIDqP+HIAOsUiXTD6k+8JoMxIpOTz5PIat2VPorvdGUUodyGX/QfNRuY3kVUomibdyhHplXfk8thquXerDirDUaeUMqnN09Blah2K5ZYgDw1eJYXUKsC28sPsWgWYJpAk3x6PVlCE4gl1yzQnKGSAqZmnznXSmGoDV1Cpvf55pIMyDiRApBLlgbHItD5YRLMEfR8n9ncs8OiNuZgYxIqeqeMiJ0JDnkdJe/6e0aBRckOzJcDDRZqIOEXR5f7W+c4czEFs9V1Z1eyspJlJbq8Lo4a/MOyy1zRalqC+tdNtcLLmc/Wr3fSmqQBBdSSdKU4oiPokfuvwcInfsMxOBNlrVGb4BI7SIj3pQTr3nTSl8PV/pEr8J6en939Nka6OhDEaKh/Fn7isrg5rAC9Cv3QQOZExCoOljJyp0BGoBoZWdGxu3+/jB15dK+Zh+mf26UdBZ8dtvyihS8EyhdYwxMd8/92SztKvpZHJhOkLoBf59ub7t+owWDLcFiEXv6ViRS0QN7mbx10F9fVgmm4hRUt/cruyKlBNo4Dho91LBeVFP2DCqUzcuSrwT5FC1q0OlbRRHiDGF5IVkvLjYvFLcuZwZLDNDMoflhcnaTrq081aSG/schMtuV+NkyTPZcYRyzbicstA5r7ML8qihJEUw4Ud7gn1t3qYi6KrBX6QIYhFegPYxeibA+IYmMjkbu55aAM7l0IZ33a6syMfAzZmH/beMbJO44Snpgpw9KECYknrxKUk0p5urASMgddCkh1z2IU36KJ0weEJMbFx1wbr5nUCGm/s8av3ACvYVmD+DhNC/PsT89nKDg8zmYuJtwpEKxKfC1zrf+XYb1RMVTLdOMCmYs6TVKSgqjG6nx2UG1IoFYTcnTN3rnIsLXoQQgZP5Sc0MLRR4wyqbZO/vpNIWm1rFDkEVG848NZXPRqni/oOzGByXhj40ipq6NUK/j7bsl3kASumCOaaac70C6dKyEDHzdsHFq0zeHS+VoflE0F6aeTenUuMIywygjFKegX6UY49+c5qp97kFz8OeOCxZcdTSP6qOn0Ucuowir/yA6Bx0fntzeGBr+c+vNStNEWpGklqQSRRUkJyEzNyPuE8Ww/H1B9dnFoxEffUck/k2L8E8/Fkj4R/0Gis+EolFuIj4KzRO6qdVzhibwYE2jrwDB84q3zA7cZXWA4yUc+abkl373CtJ06fB73AbCIvHYvspIY95ZxwPCXeLG+RDAOUV+aFP7pO42VJLNnsVPkhYLKZ7UH5zEgXkmN+duKByjJUAan+wihOQAzk2C/v1XBLP7X15px+gT/97+njHFeYiOLvJcQ/6EHnEj9o/5sCVw/oWihPhpuDo2Di1/UyzJCNzrtnNpdLiour4rf3hxzQNWd1HymDkBbU3TFPAFcIrgf2tty0Dd1fROgkM/6w+keD0c2BuMVaDxxCyoPnm1IHB2V1nAuavuVTu2vynL15nbV/bAA1+YZPwwQxCncEbU2Jt+rrVNdL65Z4fImmTylSMEUf5TFpBFv5Np+7Zu2udWLkwhZxJIPJIZkkiR+FD5SkNoQABO5RUiywiT+0RvG/d76pi9rF8xpSWjHxyAqfbejjrvixf97XNkF7Ikz/lXXLxg94bsd6adc/TuFVcg/kJUTPqin13gswfvqBmm0uk0bsa0Oegz7XMyeMMij2PHNvOqk9Mne0H4L4tr/7r91/TOhxOSz3diCQhnI3OHd4zZeymSWrMGRBsdlD/EqAIOmbLXPZBVa97KzUcx1Z9crQ7uCNoDr1yFVR4yTSF76ZXij5WtqK1QFz5JgywOFlQezGgd4L/f1C650t13UlKn6cCf8zgVfgM2BQziebCGgJY9LQGvg9TsqQESN7899/ccvjdJGwvxm9w2+W/Av3sexUlwhGF+8AvJbqwdAfFStPid48MVbclXHSNHZY7EMr8xsqeaTPoEGVxN5Rhx3Ax0g3a/Pwj/WJoeCYWveTteS/3pqWl+yVfe8Mfub+nP3k3xqLA4fbu/1EB/kA6++kr97v4I8PfLGXjc/B7hy43ZZ/15JcdOUlDCPXtBFcyP3H6dj3XnIXWsZ7xI3jPkLjYuHTn/K2rVPw1Zm7GiEwkhBqKW3ruPFgWGn8Z3BmesDfE/3ShuNzsu4Zo7n91Cew1jwb1RQYfiij4iuArRteTPLduM5AlD+SjXxsoOcQuvb78SHBitUsjN+VG01xe5UhYI7u2oDbh43b9mbrc9l67bA8qCD7jUXcbumsvxs9bufvMu2sUQexGRfbC8bH1FVeXo3cIiawLUrnmxrTeLc5bR9t521sa2YTNoF9/IOZnEZ10drS6qg84ruyElRykqsqL8QzWWXHHFt6GoA7+gN/vaS4ysOG7WZVPXU/gI+EeRP5lhH0i64HS60j64x+cXTGUsxO4S7PKBkoNpwgss1b6nz/vFvm7Dq5pj1/JcoF156Hrpx7nuF7s+/73apZ66vfGA45a8OkIqItpSucTcDH41QfGx50dLa18WjrQK9pXYxYdXddwVj338b04XHDiWKHXuZ+T/FpTfDUkyZ8geoWpoyVd0JeLVvZ9jK/y5wb7RKgrJC/Lpym4RHej/ULaQpAJiTlLNRPPGg9+wrvz5OvnTuFveA1GNLKU/rT+7/m7KYHX6lFVG7dHYHWoFfPsZJOBG7jixEwoLEJIsmDS2LnwcKYhKYAtz45ykDqqTnWtgd1hsgv3dnWelLeKB8DZGpXhzZve2VyGIKTWjIkrR2FFVymFn5NKd+JVkZ1KumT1YpE7b6Gw8Im7ofqhWZIDfS4zMzSWtG7LyA36YH+8Dqd3ft6Fr6YX8MgOmkDE/PvHA3+PfbQa0Q97Emeac8z/3jJoGWX8Jnj7KbEvQ5cYM6Gz+th7tZ5v3f2latbrSi96foh6Df6EuZiA7ry0B6J7MG5r4H7RPHy+uD6aGz/wBWyBqSJaZ5I4lpOVt6va4mTe+TXG3XdCRCX8YMA7MRyR6/TjCj4hH98N0DT6Y6oenJaU+sIgGxxMC6M2fR8HmX0OufnF1V9H7NsjrL96g3Oe/JX6zMrCosCtA3tIaIjsSfLWQDam5Q4OPF6qCNH6Z/xP+pphcCUDXDfyk2NpMasss7/+FXxB/f/3jjfM0lHlGGjz1KPaznTboisVjgP4Rne5HV752fEvseez5XQVB8eA6XmHx+G7uLOE3INULaRtpxWG/P1RWQO8fCelH312igcmzFW5rw40UI56vTEpT1iRmsOwLs+8sJm5hefxa43AdMfsITGaTMdVES0hSE3zWqcXRZWIyQskV56YOx55ENbmk1BhtupRM5DFfjJg7C1ajpwXxKsdWlIopCQ7HerboTGMU5/EPhuknFW4TGGNsDl6sTBczstFfEYvEW64wQnMYEjklobyh378FbKCvGx2tFukRXTJYxJJAN27j2SOrA5lN5wpTyh6YFHaGE54qd5J00piqDDJCxZXlpg8zTqKbXIg1RrsZG2OOB/xTNWkuaRxN640kJVu+z9Bo3Cio7kg7XocSnZAGgzrvdTZKEscSlW7AD+btq91kz7Dz/jqcxNukLDSvERf3ss42daR+2jAxqy6iq0L4+IIxH5H5OmIXcH+6DKIclENqKCQRJYIn8AtpPCO2Xa0ldPENuTHJyVJUiDIU81RiHs/nlUZWBSau2SPpGJJe66znXepYfVbd2nhQJhIB7qJpoXq/3OJ1ORcmbznStGWGTzgvD3Nno3fqfMc19v6U043C88Yz63eBfxddzJnDNlPSs/G9ZguqnZWpItBVlel/BTZtd2N4JsNuQG2pkhljZfYqcXF/4tXOhtSrjeOzmhJDK+tfNPjPsM5AFi2KSFPjOwqdrAwlZpCqtGdhjSnjlVOMGxBb2m4po7zirsTznY2pFxpGdiqDaPk79ui76Y8+efvJOIg5yi2kZFijN7jY39D4L7IkTF4hhS/DF3JkkTUChSUSkh3Sk9PO20Q4WrZe8LTHfVHXXn5U0NMhP9Fo5c052vfKyi+2DkDttkk9+1oXTfX0fZM8mh+qgL5e943HEDK7OOi9VF3c4Tq6+sGZbED/yakPz87XRiRVtrUG1bptcM+MbsiQ2yJSUgxIHt43VJGYwhJeTWTDFwINpyVDo6obQ3XCM53Dp9SNhbtSmx2Cg7VsB0HKFZQs3ibT+lMDZ6O7NTV3U5H7PmX7qOSDbgVfT1+RmvyvxyBLppUm5ipb4ViCOeOK20r/xfK7wTGikP/ClByR74KrDIju9VfmgP6l2UFRvAJyugRXIFSxLP3pKLKyt7TVXU4fjdN56L3yPCYQ1xogzJri3Fdr87+TEA9zHxBv8LR4lXjwgcyy4roCnwWXi43MJss66mNERc74AYk8r7fuK1EB+AodcJiMMIP1xs9GHvf0uLgwu4dtKI1ZYxhbHivXl5p4tZzgp8tBnB5B6TxHgWosasBVJW9FfF7qTEfzJUEf1eD1zrML/ohQ2IvXCAjFQkT7u+X0bhmgHbkr1sUkjL1aLaURy6q0KxqIEgHWxiMlLIOcB2emt/mOAHblmrWUrkxjI02BPCEZgviFJjAUZofASLA2TMwFfPJlYdm+bYemi8rOfR544AfYT4D9qR8qVxeBRroJNSi0VuyOlJH0WisKpRFG/ELKjly5MDSKJNYKBig64uFkMvR3kbCJdb5J0hJhlBgkdlVlySMlYfUyS/yUDZ0wBtv9VmaF0Jufv81vbI4pZXV7wYWEPmte2OOttNVoGqTOAZeVhPFYaC1XlxgIwxfqA1pK0SYl2pwCNx1vI4HefcEqnlCMh66TapMctfvV/gnjWsyefu53AZGGGSRTER8joy6LT3ZRQkKz0tQebyGe+dmrH7I5koT55npUVZojIHfv2U/cTCLRGLM6eUUSMP1coaMhbnz/H/dNieK7tHaUM9Pzqf7l1+h1j9z7l77EsJcgJUY22RviypEVp61FEVYUxjRDtDZho71hVlSj3+QjB4r5seHCn4BA4xe7E1ua2xujXy98DXZlNM4wZ5dv1uhJGZx9KsQQWQz9j6jQZ5Xh3r6ftcmc1tFYxeD4poHUWYCK0vzdS08ww4Qn4d3CkIWhgiTsRRs3XYnyd4qO3xhDu1AIG5tR4H9Rq2wzA5T6xTM0Ydin1SSlxZST4w8iZUT1DVGYMAPDuq/1trrZXJ8MBlOQh2dzQ0TiIigv4m5gcbQ1RpYTJEpXprRV6tfR9Jjm3f9EYFfn+jpdOrydYbkstlUmEnR1Fp1JcTQdiKmXJxWypIYCBao/TswJBYFipopiWpVX9Z5bZdgQaxI0hr8DAs4GswFcUFHAad5p6DbVLNfojEPx4XKDdXri6vJVxDQChB52cXnrgtDhJvdN4XHD00M4/Mj0cO/L1L5pEHh7XYHDI8zvc/1MbAapcJPCXXlI0rns8pZAmitj3nkRi5jUGLHX0EK0kHsVUMmfbaNz9mySQCjv1pS+UJ6CUK7LUJmRdAHkHq8xCmo8xWv52KRoHeHLkfjiZX/Q5jJJ/yVQcWhWQFRCaYXt5RhDQ4ZUH1sAY+j7EsxFtPHcIf5qbNDbdJE8BECm08fejtVoczWJVmbH7wDO6f9NxFRGGKFMjkDCqiUC7UbqWkv5WMJn5xDX8Ib2yyE6ZbJBvJQRaEjbe7L+oYyrrW2u7B/c4xsa35et8dKrba2lsr+/3PqeCRXdbQfm59sOdfe2H56baz8ITt49ktM9cnw+OTD/SYGzXts3emLeiVPZeGlC0QUnjVWQdqJyaPeiCzZBLNOLfJH4Uiu1zI0TNZxE+VHZmj/hbSD6uKOecmH9IUdLvWy+7gCv/oR3Vhp9cTVJGuTlv0PRS1Lzga5RK7ngUnlPyX7ZyhERs7mIr7YPHZbZ298v4eQlmk9XdBk3hF8lU5tw2WZKmt4z2VylJDLS8f7y25Em2WGsyh4NR9AVj8J7tWF856GL+d9u4XMrhilKzCUefrY5uzR7VFTcl3j5rNDUkvbkpIsk+90jejl2VvB/viDc1+U7Kbxpdak39NOvgh4++eMbDACP0MTl9JVbMg4HnuHTggL3oSjLMbVeLcsuayM/EwDaLe4Oa3BIn0kEiZNpU+PxByV4c7gVa46SpFGNaXJme5llKr6YVRxixWfGfsbOnbkTGjqSK0WwY37oCQqLOCTBW8LzsBaqhE/NF6Uz6guyJ+LzKw6Jp27uZQJqvdbYxlF734lzn2Mm7y18Wr0jEFV1sXlOWFYS15zAjcw6JIzdV4XGY2WxxgSZnGFX6dO6K41rYjQ0c4gWL4v9jJM7cfQtMY1+F5uulKfE5arpeTR1Gsss5lCyfpfGP9mmw1eEqxBJfYMzWxaXH1VkHB3quSwFfpHJ0A+M8RLe4mL4sEvwTE6qdpDA7cDBNRDd1g49G27bHZ4QqhEAlM/6hGJWaM4YcM/2ri9zhusRU4gV5TgFuYUP6QX1sosx25Xq6spFP60XXtM6uINwSu4t+evcyL7QQFx5Q7JdSCZcOKeper33Dga6zoHf8aJlciNkArwT98YKHTCBz/9cBzvGsmd3Pl2PsiZ9xsxXVWXM2gbHpbGUxN+B8t0Dp9tH7hs1dfYEzk75/KGNRLXsI0fivP+qpHpD78i1d+zjWXUw4ajXi+8g4+qrqwPLhKdzn2QXQdonLi7pFVlQ1u+Ty8t0tNFYtv3q5dXlhj6wg58CMSOD02kXxDkuuzA6nt/kgritEn0TTXUDJdNdJ/4v+HFsW7wbBH5MLOIgQBHTBVrp8gHh09O5Y89Uub+MXcp8n3IBmu1yHWnWrSMeDNJb9ofpv9in1VEQ1eEy3W7SDbhhv192qHlTo3/lA75D+s4+KubdbL8YNfNP2Jf5QVkvfkNun1jSz9IP+mcTv9c8AfwfXAi/ovZRI85c3IJ4ffJNtG/9Y8j+J5Wr4PtvhjTKQgHr9Nbw4N+f9vx5QVgW/N+br4e/P08FKK5wVuF3DRmEkxIj99/IKmkYoGVyzMQ0Hs7I7NaFjc2daLxwT4anCmE+hyl4vEiXNEaqXIGI/iWWcD74SxikfyVAaM/InNF6Da1JJqc59FpntEzWEm3Qxjnksrgmg2ankj4T8rLNZp5OKCkgOTTZANJ5itL2eM1PY1Ia5E83EHhPDL1cnn7occBxtAd9ARbQjpz69DmBGILaMPbq57YowEwVC9GMJNTxxYBtdOdlKKAdFKeOK/A/Hri/Isu+LSNj29Cq2B944EXIN4A2Ml+FzqwPBO5z2GtEBw2Sg6Lymub3AkBcIe5FyE8xwscDJ91NTaLKiIhKkbHRY08gwFYtoj7y866Rk6zojBtdOFOqGWDPqjFB0ZvYGAuLJyfERjzO/RmKdqGOFRBJw0WMifg59zHAvbaLv0I+ETQZH25hSpSU+AQ59n4cMvRl8tqgaQbS6hDUhVVgT6QDGOFwU7++Bo3ai6c7oLcHgHj6kPlCCCr8+4R8JFLDfBKOgok0p8ORaqErUuqakINE8pjuKIm7iAc88q2G7Yb5VyRlwbTGBBDoiKEhRqJCl3EPYY5cCiaTgy8dkR/iosskqsYYgCD6KFpxegGtVHIe82WgJkqdyks5z6MV6yWtEcCXF0wjKq6r0TGxWegHEQpabITsfhYqNkaNukaUDcREfhcT9SqS+iIq5hvgR9wfjZf0yJAUqhLZg5dEU/HCHmU4lSILvzUXOijkOzFRL8lyb+lFgE8+9YHb6zxpScerx6P+//2NQkLQTQuvVsOqU1CAn8Td/I0egmoe+fItkhK74jdGD7r/1JdJKRRA+JWBm9qSJYZHDW/+vLJ/ChDWz2D6p5xYtHG4CYN1DBvRWOfUILicsvk9n+QTowB96dD5/JJ1VSpKl+/c7QZfm6eDdzj1XaRM+3kXzsigAKxz/EFV+BYQ+YovtIJ2F1P46DEm/0HMLjg2U63K/gYoZdgsHPRiCCiY+XJE+x8VQhf5tmRbehL25NdKdrdUzrAsFlc6K/dQ1eYn7LH0tGSLfOkc6n+kkadxhV5/mhTr6+3T3PfsE1WvLs65FG3ahOzC0epXE3XFf62m1jeYsH8WkOOAYuaALUg1OBZBVYXy6AzFFZZuxGJKycWmbfawkF+sjOyPf7acV2asNWjqjHh13/I90f9sUDSO4ioju9KHPC7798EyP06Bx9qgHRsqsAMdRw/GehX6NfmtcjWmuB6Au5AuMlv5GWpkXGDE2wy/ORiOI6BtwP7mfuB2ecCFVhwcDsI+nU/MCT7sX7g36ZkyJ8WlGYMtG3LisM6+PRbnGHK855QCt04iWsPKJVfDecY1cSWmpI5MZXQNPyszWcwkqOFr9wXtzIuU8LjqRDGPpmFH7H0r4JE5mWE1psCIfz2vMbFlTicOV9a6/FIc4fwq2fwGA/fa5Tzw6p/DTbjEDDEOxcvOxkWOZsYQ6Ch7kl1bJAP6oYbW2Pvj2dBM/AHknGlKnqKkpbD2S0lZASXBjRzUX8MgILjChvuR88Eq2sY6UKsXREi+37MUV1391/+r0f5vxCU9B/x3A33k2WMs9jF4TinYchd99Lln9Cv/3TJZAxLsL48l2yMGlt7xg2IqgE+B7yXgWeFKn74jE+WS96WoS8D1yK2KGH7MuxpytpPlsf6g/orHLQ9w+Dj1CBU4DmcvgiMsO1CZH3RDZ8B4rkW0rdmxTWix7BCuUNE2i1m0tbl5R37lNiH0vv2AXktGiQhCFDlKhCKgRWQyWkAQoaLIQhQBJQCYtltFY9bD62kOsLFJIKsve8L/GfN7RwfmBdmV/SCrr+ZvBA7aBuuhojXAre2Wbc2JdsuZ5pr4xA/56BdG9O/kE6Ngbt12WQ/bxkBoVCVw/z80M7xwM/BcOF4YzkSDmwksYVXZrDGvVkpkRlWSb7Ffnm9OHAkqdI5wuMNOPKQ435ziISlOUsqMEm9PGLbLv5qVN0q3mKLblQy/vKBcv9y4VLYyfTy9e1Lj/KyBlcpjKKPbLeZReh6rxn+nL4wEqD5V8nJ1JlO1/66BvCVSozLJp9g/z6e9Ww1zOSO8T04xzRiVdKOd/jV5Zr01NYh45aSYRihx327lFHmFw388NB99xnEaKtYceuCzLYdbtD1HDyNJquw8N3zp/Dz17NYwccH51IC6NN3m4oo7wA+M0IB2ZG6AYb0W9uD8HAYr9pb5ywNE2J8q0GeoSB4g8xd54+RXzk2EacGuaLmXByfaWOyNPXLWezVzfdaD+FeoW630xpiRxpSImHYzoy669YNW8K0/7gDnM5P6aqYBgftdRbWRy2JsLJWYlpOSJe/Vkq+xC8T7Uewex3CNdKgQyVqLMCXqnTqoOKKcUhyvFsflCzTaldkRS5wKZTq9rlA/fkijeVg8DIy7Hy4B96lHUe558tvBjPI9PEcNb70uh7fRUbknubweCLT7ToY+7LnaheW5tZd/fXxb/TLiwup/ZsDzodjUDP6Hqqh2pak2TsoqQBpwCiSuRGoA+XVeCduyMo1K6lzJ/zxw4MtdOQnSXkUSychLMeAYprWJMxmoV5Hfbq0ozVM9N47oZtgdlcqpAlF0i6qsUvsvSx63JkOROFhWNMktMI2ybGZ2o2or7kzayK2GbCKVj7oUyovAnD79yYquNQFko3tG5JbQzaAv/XdRMeDpCH8Q0ipZmTmxDh4vUcIKV78lC6+cF/0Pdh5FUeXMpHxcX2av5vrano8ELf1f23YfsP48dLjk7VHPC92waiCoFpUTwdy+cghvKhPC9MdOJtR/c7+TcbEy8eOShfH1R+w3uHVr3PI+Wtjw30xD2nt1I1OZFrE9HSLhZvSlqtKt0WlKTHkaB9XEqTLkNUnWRZkyq0NJgL8TQD/IMiRJ9ssbi2cl9e2pe4tKxftWts0LbbU75GaeyagOCr635+eEFGskT4ov44sIZSmS7IgExu9zWu43j4c5IdTwDT40BnafsHOh4q/RsOdnGUGi3vSRMbih39nv+tbvuYb8clPSa/121vN5fpd+S/SguqZWNULdZjRSd6hGa+oGNVui9frp6AFNVZ1qlLIDxMOvmbwg/h0c9kLAdlrlioqd7x8a6DDn9Begc58+PhF+MzZo3GPbGkSS+GEPt5jWPLux3Qvq+UdmjyaWDtjtDNy1nlerD98+8GxX7uLqkk00n/xJakRUGMVtMtqngLYpZz2cvdjzn9sE7153z3+LbBhwyyvKYLZbczqZMpk1rTms9gwxa6XV2snKz5JltTJXHhCQdvz2LJL07Lcd2kgE7/fno9nz3xE8MOyOXHkXoclkEboyMqrSgDxCuFooEWR5k2u6EJXIzAufnJpsW7lp42ZUYSIrPG8kpPbWjRv3lSdY9IMGtUnlo2wMfZcGKw7NDMBbi66ND56UV666XiGwGHI3a6ABtaeRP/6/JGZnsdv+VAokuCsAz/ILo+5VhBqwRuSB7XsdUkNkrQTu++dIfaG48mHDuOO9zJFxw6fBHV0jTT5htGDVu0WzumT3r2rIjdzry8dWF444VDhsJf72QfrLqzxHXAZsjFmnE7fn5abudLYfyqz18F3nIUHlBD9oCryZnyFR1G13gfAxb7MCWiMuSCCjrBqTcKhUmTzRVL9PVu3m0xHKQucEXrUHTRuaky1Xj3MD13F5yHIQydF3BLUcWT9o221BvcBL1OcUJR5+60ITmxt63+Q3lVXbn3ZiA/XBfK571Tldq5vf1shIdA7kh0z/LkFWmmGbChLwRZHrMmdGJEHFlkHRb4DGQ+imVv6Sx95b33lA1qTujLKJpXnztjT899u0os2x+LKSwZZfpw1hTozuioBeuiUpwysyozzkyYW2sAtbPL/45VyA+wXwvAUymp6fPpLu+dYzPpT56aOQsO9jbxFvH3jzRxRC0d8cKkc9+nH+DGERRL+cdzMecvOb68GlzW9YxdeNMxbp5/BTPVZMNgR/ndwv4jBucFO73pFrFJONkf800zIvxEOWQuRS1KXwnVvI9cqNN3P1aiIXs1PSgdh273wufM3JK6b6+kumsZNq+PkLathCl/Irr5i+ZaeB/Xd+X+xNZWsRPZFloytaY25u2+dpFK02OiuxiM4PiNibgLdw9izuLEkRH3H7kCzySadJVXHG8envIDX3adQvpA3faBGSklOnywwlnCJMKYeJzixRhtNj5GFbV673zyX3wAPK9Fu7y+eZBcVz3OZK/mSuhjaUudLeuEWs/NUaQAUetyGV3yln2PXFKUNqMaaco9KzsnqiuvZ0VannhuqOcYqrZxnOQt0Y2loVMysPCgzRCU9ocOz03Kg0PkZDYcKyxcm9+JoFC3BiJWykml5ab8+PkYUd7IX/CqpDwYe4x0IvzaUq8Th0a1h/4cNCGyJPgmin3lv/1SBAVEy/M5IpbYeEzc7kmdxGzfnxwWuyJlErsoJmSWEoyuvJiHQByYLlicqiRZmEKp5juH+wrJI0He08/4mdxeerx4BIPF9J564niMpdFpnHCW+yVFL7idDg0D0AeuhN65bOxpRcUjofZ2YmE638dAs+kW2OSOfjLRlv3R7ewzpxAsV4Qm25aHN5Yca+nq6TspraBXF7h+CIXQh3UmqS53DJH3oKcdHfg5TWhiMNq8oFypQzfaJ3rET5T5c3toPwA6hfEXY0J1gB1r95V1+3AEI1VUR83a0HwSRbwDAtOyMpN5zNteB/xmbSKGHCMfPNrp7TplAg9W2hZYuTzOEJHBPuOlZESo83DB1PlSanxZpk3FwkI1EPFdAYylAF5nPqbsuy7W9Y7Esi8p57C7WJan16GUaqIBO79lfdDqtzAQ7E3jBy6sewEyZ/CCNlYsMsIXR3ur8i8+6BR2AuZeoQ2c4k6Zs/2eUdEsEVUf/Wj7fuPN27+ePNPWD4L8d5B+BYP9Fq9dm93XqDWNzT44dNKOzrEYl0OpDz1+3TzzIv7El0tLc4EAxnOs44wpqX4t4b3VTXmc+bny1kqudnUnV7t5xern5nIb7xc/s0YFR0Y8+/iWxBbY2K2MPzwUWVY0pI+Zho3AVoVDlxY3TSY6EvMcqOKY7Iw1CLals6QNx52t7+tOK9CgZ0gbF2e7E1ti1A6ZUgnX2/OH/8ApRR8YOpXHC2kj6EIMdj8fTzVFfyhQH9R/q+M322j2zgIBFxHtHzNOTXXyH5M/8Ucfcy1nYW1cCVe6G6f8AN5mGsWy4q8d/fXmjRP/luyK+/hhRs+0fH3QvS5yh3kXOnnUB5b20Rc9xWOsa2GMe5ZXbOehPGm26LM6ZubmqaF1as+qZK39qfR5O01m5RXo5yHoZXX7ti958pZ1zM2d03uKvgckL1cb/W459afdwmskH8mf+YFVl5a+QXQ2oKyDtzG+28+uRE1NRSZdAoLusFlsAj7GOowj+lI9V5gysLNyVlS+pZWa0xV8HY6zefondOaoLtfiXsa7qF6g09lRtTtMLiGL4KZ08pyj98ZPKPrpF4wDt6BM1wVEVT20v5KCz72f5F/P3P2GkY6Wf7F/P2fybFoPmOdmp0u4Pf6ylwMs15zD61ptArpZMpSLKgFfFpwrk5aFwWdFI10pKvUT8vy98W4Hoy0Raty+dNhlQO4MViOTZBXsWU5hF7MhtKV3o2DXZoaAZMYVBy7lZF7Zq0G73P5LrJlz/0jedMJdfWpG2zNas/GnVc0NXaRkU0niuZbcEPppgNMYmRAsjQ1aCAHQ8BCr3vVyraVFowRNNEaAPwtDJUA/I+gpsJZyCVBi/oQb8y4aaygkFalmUi0Ep1T0j6WgPVpv2E0v2Lv/RsiqxKVBegZLqSxMdBOkVWgGh9WMSNU4AhumsP9wAP3/+j5Az5X6qp23+CnDcPuSuGMnLYubfPFIScWtR+oX24eIptOAOY9sXF47wwxaAjTO526+T9vfHhupFppJZzej/iavRBChO9Aeqrx5MPlhqpE2fJmGujDZePwpJfdHZ4GAAOXWx+/PDxE9IICR4Anxs56mlZAkkffTXVMiULK8Nq1RtI3wFZkMxjMoRdJP55CNedHzlfd14eVoZp6cxLWtNuFvz9oTdj1xR4wRnoqwuoqfimokoaoRWyShFS9Wclvd6Fss3dlWfZK9Vf/du9a7Rvq7L3IquzXDjtYesGocm3zszSfgcHuEPT6A0FcLZ2M6u5KmO2qkS2MNB3TtHQdFrW0y96r6FUu2RoNN0KFeFjvwjN/iXZRpZkkAs4aSSbWFRITE7OJwslpAJ2Cs7GE+dHAuFCag7hV6w+3tnX2tQU2Tvjsfpfcwiu9xVJarw0TEaJhmowWaSUlFgNDFQTTUHI2Gr8Oe8+asdCd4F0S1/1meS6uqAXvbMq6O5Y6KOWR5fPNmgFY87yeW5xcal0lrN0bUP5LKA5pdrEvhxrK0MgJQuTldGnzWYMmXJb46XStnhzLtmc13HhqloRBsNUw1RcNkyuSoiVElbD5dtrbpFxJ4SkntvM8DOm4OxWkPGlxheacuOHLhhgxnzoRlBFrgymAJuXw47uHBhQuCfLg9gbLOiHT3GlTgdY7pK4cif5Tue+mT98Gqtm5kVVFcWW6JPa1sDOl2cmiGMx+fCJfcE7tZFCfrw6WsSiZSQSkr+5CtFhfo3/hX//zS/7gItcOru+59VS0dr+KOg7PyDfuZHN7j2V/znvNem3oM/1epgj0pwg/PZ9T26jYJR4oG6zmDkSfuvlcP7FTw8XREazvF+4QF6F1MPSCrpiVWZsnyGmKPYzp2/Ra5d/DpN378zm9J7I/yz9NfnXwMcGA6ylSYSocDKvkb8qryam66+ivE3naFcWEG+1mRnLtxFVgdBj3ARKpyP0pPR566I5tRvne3TmZQftuK87bph/G/y95csTD8CX8uNV0acu1XxhxPTmQovG6i53VgPtyeDXqeDRI3T+E2Mh/RUIoBjMOvboJ3Rqg7n61YU5V33VFhXT6+t3VUimg0zeaMc2HSM/ezjAIU3f7JHj0TcRhl1k1RgJqoGV5KW7b61JAFyCB+gsEQ64RETeoF0PuJS1+QCaVFLSWdwlXiJ3/ZclPY3jBK9uHcde3TmOUy598YbDdWB0uIkW8wgdxehIHjV1RdEsRft4zOwFBi6zGd/sCFIQGj9azuGMx9SOIR6LrAoWMDM61nzuQnM0t36J0xN7OtLpjDlDT9efVqcvfQbpPRCfg2Pu0+m5jy9HxRq1T1eRdnP9iwnJ5pdDWKLl6mcTjDo2P8evnaNY+GX24zidjfHWNiHourFpkfyT0gfUuluUKeRXH1j6p867tjcO/38626o9DhMSDgRfccgdDl+0oQNHcNSOhd8CqZrrIWL0OGgu7WusKDWKMgnnMNwBb+CQO+wLNKMDR3B0fZ/PVxb8EGjaK46W9t2tsu7IFsYS6nyCBWgGXpf50Jjh93Ebd/ARPlrd997oWx2nNQAuwTkCmhPulvzRZbyV1Xsvxow7XQKXEJ2+bpi45tiMNuuOdpLIwJl6cGjyhD1oQweO4KjZI6rz1g8Q8+l7rDuooq7Sp3CH3eDm6437JQAuoSkWHsO4xXefOhUcitGFoA0dOIKj7lgUyZ4yBYQDrQsccofbkHFI4BHor4nRf2EcElSlvbhmH5X21S/67WBA0/9EeMYHuZFbuZ07uat795OuhAG6Qd3OGxrRZPZSjNvvCA6iEU1oRye6zF5KDQeb6s2dDL4plPzToYhs9aGoF2Tu4ntp4q9lgG5tgwAusaZW6Prr3ODXKFZaud1YV7A2B5+8E+OSdQ3ohBiuvDa+WQqHao8tuKtLSM3x6uOKJrRatwmpOyV9EhxEI1rRjk50mfGs+YLOXfSsXEumC0upEjqctVSoWxs3ctOS+1rvtiSUb/vBJkAPQesx9OsvVzjYtpdzxqrm4C9BJ1qNi7oDwfjf+vXXyQ+Wc0ZtDj55p6HVuGTraenDhoNoRDs60eXu5onxRjvC/nAqDqLR2GXXpvQYaDT2rOek1f2fcVPbXm4F3oUcXUOrUKMXIACboFsB5viNdT08/RN/E0HASx/z3/JiLewIC/pP5uWNwIeB/qd8Lrm8mRO71QHjb3mP+qXhNxusd1EvpZ5KPCPHYgH9X0upG2i/ykWqonulaHfutt39C/XlizEhCpYr50Ihr09Ex/QEWX22XZzCrbHNZ16897RjEaLY1o7pp7RAsnKr7ePtRHok9v62etaL7e1NKyiHzIumXTqQr8pJmUA+nfXLAvLNeaIMwDgRPLqBfsO8RbfvTnzLzvyK1lT7lqHIawL2LZnkFwUUPVFlj+Sh82gpun+XsxUE8vlLHBSO8jL4jJkLGyzkAStkFZ3sJS4NJ2eZAPLjUwA/blqjQGk4g0/1XIBC7pIls+hMZ6GQoAT25pblqVk7n7BwVQTEt5aY5bcfGkuJ3mywoHfspRAE2ht2AQDtIYUWoD03gBOIb0gFFMbnPL6xe9BE6+ZVOc8J9D9JFvaco2PXqhCvaQnsVUsWg63xE0XBKCVFNlCriSdxSGomTgB5bG0/boH0KQopegA2it0InHUoIHyZHBhvnZJytN4IizEXKBiFo7wMPgDW7Eh1VNT2jQVsly8x1tLOff73A3l9CxRO7gP8gfEaY6GUr1+UsKL7YJ6jPNQEtTtZ6Vzbvy0tbz+c5yjNY3pmCfoWBUqbp6aSWWl6mw7IUw+wvVPT5POWWNg+2v+TEAn1LNsZa/db/UQ+KjEQ78lzdgHtMWKs9qJH5XwBRffK2VIV3Taniii6SVoLRzefk5qWt1qRoq6zk2LzlnxFH0Lq3nOiadq0C+j/ynNBebiJWhrnYGuvGvFHEq7UPDVLTSWzzrEtEZ2QBbR9C772/YAduT30jKhQxsCaZFy5in7bF5lCXfQ4mc/E7clzrOSzHr1aP79Rck6JiWmd22hFzhoWVq2QpWOWZsz6snp9ge0WY9alMev52HW1pyU7FdYSNVWaVff3XTx4eQobmDeHmpdPIe6fG3JYqHmaokigP5yFmr9pblcUGC+ZngACtP76Pf9OcfdLU/4OJGsR4Ed/F0EO+3fHf51pUYe1qx6EAvbaD/6BC6YBDj/Z+3/zjOLfA7Kb+MmQ0K3oH4F8ZgWzofwro1xJMpGBsr2/lyYm+v/yjAkhGmV5VOZ0LxWKcFsWYGfVPQc/TROD4Q5FXyuXAaYGFpRbD5BtjbLHkuFn966s9DAxXYj1t0A+PqbLmywCtrNcWzRWpkKSWf5xmX9fFlaWMnUiRq2ufs3S/oGAjJNJVyd1NFs0Yix5srN2U8BS5B/u8amHFBE3TtY4SodxcRC7HrNDRRM5NcirZflajOOjULWAHBKwBaKFEzbKdY3yYnnRTPxj7bKXxH92xT/V1X/VPQfrepKmrecXL3TPVbUWQjssHe3C5qNAdVM4gT02a8909VphhR8nl+WaQ9d+om4/UrUXNNlJnV7AB079Q+UGZYu1is8O6lGuJTUOZSZjDyjiUMhS+6jI9pUVHS+94+RKfs3nhz8SRjFUhMoSCMR588p7iRWCKpUMUsyZ59I7/9zGp5tXnopQv5oR2Yg98+WPs7rG75a1eNv6lNS4r6ChdTZXUB+fK5ie8so4Kyt53R1k3T0vTljcwWoTK24SLX1csfz8lr5bfPMBRxrTVD70TNsYdmIm2tDnpMnO2rStdyTpuotpjXUDr4psxeJerWtVj2ErFv13RXnF4lwV3L0q9qjtoSu5R/qcVDLrtnXOav5sZU+XPIa9JMSWWk8LpxBulyxLkBUqy6q2VZY40c+xImzvLNcgmubSPBZ7jc5vlBxzc9czq+tPWSk+zyge45b1Z8D4KWX2SkmDVHnbyna+ZI2aBDV1vNSNvOXyWSa7oXSuX4kudvZ9KSdvSc+LMvNzWX2N/PIgkt1/LkMPAN2TKvcxmO04pT7Tdvf48HGgKFCZGSkmmGgB+k22b6qTlexDORCbInZOdz5tryKMpaUcAhcAC8oyzZlPCPtX16lB30pplq5NDzDR7mdRXxvTdm/LhZgXQewTiIGC9MI8iI0h0hPz2Ha0r5FfVd+KrBdYVpU4/u22YwCjMoTBe00Mm4uX8q9RWY70sVwnnrVoZPU1oeppG37ik55UpjyAKjPVdM8apTNCO4e0+16tD5URCyOIiQKxUfhhfmLBrFOeUY6Mbyv6xrYVX4Ou+dQfnHnnPwEYUkOjB6AS6oGcAtTUuNoS5/9fYD0FFp/tryQf/N/IB7+LYANeJ9heNg4aQgghhBBCbMM3TzRK097RP5S3hRBCrLgLAgnNIBaEQjhEkXuICvdQqaDQr5OHCqiAykJViIf2XAO1C/ozQVhhCCJMtzAoLC48ooJTkUhIMoVKo9fsqgW7TlA9iV8DEd4T8+vPDrsLjy8QisQSqUyen2vmj+j2MTA0Mu4EU7PZy/L1fY9sJXhIinRR3Dd4Fvd2CXILWqLne1u27Vy7j9y/xObXLua3jv77IxACBAJ5vVmh0AmkTG4B/yCVlcmO5nBMkdN1EjWYEdcTXecWuotQxoVU0yff3vcDY072i4uULKWOaYAAEAAAAAAAQvHJUFVVVVVVVVXV/fPdzPOhqqqG3GoAAAAAAAAAAAAAAAAGdp7l8uexMrbmHG6eFZOprRNKExqDxYV3s4JVJBINmUKl0dNVk0s6cw3fTT1GzaBasNvf43RgcTUUSobAetHxIQ0QJtIvzhiaid5M7chCvGVWEqyzoepxbzNzct+qPQNBZ3B9O9TAECgMjhhhxbeVXYVGZzBZ7HEK7y48vkAoEkukMvkUS99L9ATVKBPpTOOZKfx9LvQscJ/lS/kA614QELhIaFFgcAQShS4z0PXiurHZHt7umr3DHNkTZXvLgScQSWTKOEe7Lrxfn8eW5qi+/8ddLLiSqzrgxg2AGzduAFybAe8gIkp3cYPAxp8j4ITe48Y7CQqNweLwI4Q+36EAAMCnUfLuaqPRW4hSpdZodfrOYrHauhd7/zD5SiXrtKitE96XLnX9gZwJdhIUGoPF4UcZ3lVo9M5BXbBe26PmqkwAgarC4GoodBgSLC68gQoQIwErQ6HS6DWJBattdajnxMdr0ISJQOIk2B2R1M1uxppJ934P9GnJecYZ2JxJ2SlBY7A4vHHR2sbWzt7B0ckZACEYQTGcICk6l37+3mqkFo4XREmespStPFewHXqD0WS29DbQOx6e81rxvrmkxl0/fuaQb2Hl/AhDBKoKg6ulLhbIOVrnQ4hPQ05I34QiR4ehw+LCQyo4FYmkl0yh0ug1u2rBrhNUT+JrbiDCRDBxEpsyci6p33LL2ty2Jnesd9c997PxQJadU93X64H3pzNpePvxcQ97+0jvz310Bmb+MwmCQmOwOLzgvffg0ZNnL169eZ9fx7A+8JnftP7wp7/87R//+s//4yuz/AgITuggVxiRFMgVlZRVVNXUNTS1tCFQGByBRKFz3OxUdq3lwBOIJDIl6jkanRHzNxNFXXsabDJlK/3r1jjZFUQOFCKQVcUwbxymGxJGE9JvQlGp0dZEmUvaogOfAADE5AC4AYipkErXdBlvo1UAAABAKaWUeltYCEWSWCcPARAZV86d8HC2KHYVUU2ModuOrn0LzSIohhMk1X3y8Z4PGH2SL+qeWI/UxgIAAAAAAACA8Lx/xcWDCiycPCLBSUQJiYyCioae2ZUFdk5QnuhXIITbgzAIVVVVcRxXVTWdO/QDesuKpKAD6rEhkaGo0BgsLjx+BasIk8XmZPpLFt9ZZvWj9X3bdD7z9wuLwBAoDI4YIeNtcZcgj8PuwuMLhCKxRCqT5+fE/NHF9jcdYGhkbGJ6zR6VCoWMlQIeQV7uupfdJ4ddTj2FTEcrVLVGXow3P7fhXaCMC6mmz7+97wfGnJP3C/c8KfYpbLi2OP0ABgBgAMX5BgAAAAAAMHuZ8KvqiATQh6AJaQiFDkOOxYXXX8GpCDMWZW2r0xUf2UCEiWjFTTZN52cDn0zOgHOu7e110XiG7hJk4zAAIRhBMZwgKZphOV4QJXlK6q20TdoOvcFoMlsaM1+NytVjVCKRqMgUKo3Oi3+BsMlexvA9hZ6PPo0vY9r7puqXuqkhVVVVVaVDW55v7P1SoVWvKJbutPwjCKgVuVUtDHBqtrxMm46Uh6BlPAaLtefkbU9wElFShayiMFXVlKPb9WiX0nnNum4KPYzMoCyw6+/lzGxguemCzaHWPNV3vgBCIz8Qm9jmSHxjKy3QxHEqy5k7zrFdyGmprRbXGjcOeOxImXHS1nGvuIP4mHR9ouMcmPD3oDOAgEFAwcAhtnfHL80poGFg4eCpDmH+fM6aFxZYZIllVlgdyiL+XvUyNcnqYjWkaPpIy7fZvo6HdH2i5/P8jYFXC83IamPrTKwtlbJGXjGzMnr0XhfzkDJSJ2qdFjjT7VzJuRB0roT6RQxAJ1CBgEU+HzpB7JvhJvEtyCiBjhlwfaCNtC3pbpn77A4bj893arHHGQc8AiISMopqPBo6xmnWyAI7p1f3wsJ49p3XTRcHj1E7BfzqfmyNUwAAABZVneD3wGg7O2Rfc2q3NnoYa9BZgn39vXGGb2DjFh0UT3CM3k65oBFO1Ew8SfaM8mZ8YtLMmTCUdMC2jwBpke01lxkns62cffMdUElP2F7EBZdccc0Nt9x5Iu+EpfIIAIj+VkcAAW4kQBMAIMC9azXaCgHdiZEAAAAgZQIEApYAJ0iAJgFyVIM+ZkCNuq5AiJvtLXdqx677OOARELHtTznjfC7kcckV13Mjk1vu5h7DExag7cd07knEr31r+1ZhT4VqWpDKaOuEh7XrxIUWcp8iz+DwDgGGQGFwxAgRZyV0V4VGZzBZ7GT08hbDLdHzfftOBxgaGZuYms1WgX0bO3ajunvtgfGYNQHAJqo3WjBY3FziJsESZS50kylZwubMRbNpspnr3rSw56WsqwcAAABYcubkNh5fIBSJJVKZfB4bAAAAAPiX1LmOl/dEBZWIirLdZ78nKlPphABqRVpVCwOc2rbWTzu979SSiqgNHSn9YSLlyGSy2qHhpBjZgQgZBRUN3S6Gl1R5zWVu0sPIDMoCu77mzGxguo4F1aHuPNE3UgChETaxCSUjxwBTaic4Tp12BncOc+EsS1cyWbtxw2O9ZcqJWxnujXXAx4Q+IV2fNzgHUvw9qBIQMAgoGDiEJBUUNAwsHDxVBWnNafOwwCJLLLPCqq9RvOEt71TcUpWKhq4hLxMt+dg6EnPRU5CPgSOERRjLKaEUMnILjFEudN6TQsJInHjmNOhMjLkLVS6VuyL8khV4GRAIOGRCKGDgEJBQ0DEDbTi357t7wX1Nh43HI50k9pjjgEdAREJGUVWioWNoqrGwdTi6E3n29nxZYI0K6lC05KQVxbYU0aYTtReNjEpYOHkXE5xElGSTy5BRUNHQ7SrzkhavaeQmPYzMoCyw62vOzAamqyKoDvXliT6gAEIjPOLX9NHJXyOKDzqf0rad6jmZ3s6iusuWFce6Gz/HSDNx0u1A96E9tPtj+PFt2j++13Hh0pVrN27dzY+qR2L1KylS0jKycvItDLSIkrKKqpq6hqaW9vQPZg0wNMoEfz9JV19CspDp0rJSky/xABsLAgIXiS4KDI5AotBlBrphtge8dmRf1IPNUdZJhmVx4AlEEpkSlaLRGUwWe5xjXi68354bifFCZVIIVBUGVwuFjw5jBIsLT1DBqUgko2QKlUav2RULUNvqBNWT+O41aMJGIk6irqO9pGqzIWom2YPfDmdAzmSBnRI0BovDG5etbWzt7B0cnZwHZF8IBCMohhMkRecyzp/VbGrheEGU5CmL2Erbwu3QG4wms6W3gd7x8PSK8VrrfLN8iqH1qVrfrxWeIStiacJyBAJqBbOqhQFOzbPkHNkSQ5uOlJXQMl6ChdM24SUkOIkouYGMgqqGlY5d67xko9fEdRN6GJlBWWDX15yZDUzXq0FtKHj6rhZAaGQLccmmURbHqkrNiVSnEp3hN19uC23ZXGGwTs9He0f+liKP1HhSkE8BUqVW04b8uQbZU6P6TzI7/du/kQOJfhl0eIcaGAKFwREhtaPQGCwOrxoBs3PWeSxYtGTZitW8JvfGW+9GKfnPqhi06FaPsZT9uZ/FVmz4d2Y7OlbmoudMvhnYTViEsaMmNpRuzPxQLhbWWTpGr+/ZPjlbyCPYuc6FFC5lXbkqME7w8SALFpGbQyWsgUt8L1KkfhYtMQNt1O1X3f3O/bEO6vHVTsg+FHe08SAgIiGjqLLQ0DEwsbB1UnUH8uztOU6zGKCgoGWQsHDydAlOIkpgZBRUNPTMriywc4LyRH8FQmgUsQngaJxysP0rS88LiJhQQWAIFAZHIFFoLxV/NAMWhycQSeRRks4qo2X/ChLNDg6Hw+Fw13FYZn7UOOak03qdsZznn483f8uTJ3/L3wrXkVToIhQcwv0tYVVDKklq+VrPukPZgGxh9CZtngWvgHdkS3o1a5S1kucQPUegwRPmAooNnzkPlnx87xWo8DikwlSr1OjS+kAHcQV3R2tjzBZsB6s3BpMmz4hXb++QD9IsVFgrfObauxSfDnMEGsxcQLHhieYBOXrp5n2WweN2gdU4YxUmWvVi7SMZSiPbAbN9Xf9R5zA2R6DBw3MBxYbHzYMlmwnyky/u1auEtyxZluXVq1dJwuOPPE/gCUR0Sm8go6CipfuZYBGRsuE202wNuvaM6XvzCMbVUFfgI2PPm8hKjcxc28JPlQhREWIFiH0LWalrZ2T+jDKeks4AEI5AojK9WXB6DXwCwhHVmMWQvM3eMIriXp6KEcuLcPuKTn0P5zt0AAAAAAAAAAAAQKYjSZIkSZIkSZIkyzUy7XFIOeG3xxBXL91buQuMsIh2GVFZbH0BztvwXEAxnCCp7T77Os4J87xCQPq8y99fdfZMP9tLBy/HeT3fxfJrwpbaSAAAAAAAAAAAAABAq+buCovmXq4ngW9xxvgmRHqnT6J2cBKBmv0UUKx9IIPK61ngIZwhnMhYmolqvXesgG2iaEutZibWu8V7FmP937VDXJLJQcEmJzMshI1iHLxjfk4oum+iPNSsa9SISgAAAAAAAAAApKpSVVVVVVWV/raZuhzd1cooEKgqDK5WitRtA0IYToMypKuhyNFhaLC48CQVnIowWew6XdmQNiM7WPZ0Du+fjL42kygoNAaLwwuxew8ePXn24tWb9/lVb//A5/hK7n4EBCdUew8jkgI57YpKyiqqauoamlraECgMjkCiBt2c9wgBAAAAAAAAAADgNT+kmc71Um0v0332w49dTV9yr68c10xjD7fxMI8kd6WAquZzdAxMrGxwcuEQXq042yQ7Zu0S7blVX2TgNUMvCXxt7AMTV0uNzNwLFv78ulglQv+J/HVUB55ceHtxkbZS/5+QoVlmbNysfQxnn5nAjBUbdhyNDOSCUccYnDY/7lSa3YV73B8P6s0ewqPXJ9rMM971swkAAAAAAAAAgGCqIWktSWstrbWWpLXWWmutJUkCAwAAAKBl05THhwsVv/8X5JweqZKkVqLRoRWjg7ii/tY18enaJ97+Ry1F2cGnNwaTWs+QV0/v/j4IMzeFsyLv44aK3/+RIAA25coMC2GjGAfvKJATiu5bJg+8/sXehxTU1NeaKGqWwiujEAAtqzW1INBSAAAACOi9rKqqqqqqqmIOkWMruaup6tSm9UCNKgAAAECQxQAAAABgt37H6j4LQIMElg3Nkja5I/EUdD6g2de6ch0a3ep9f+4QB6ec5/RjtZJn8Fz+twXXKFpx0roEgARkLjhDYtOEPNApvgsdLF1pY+0W139pPSVfeX+nwYgMCTAIKBg4RAuJjmu5Do0RFQm513Ll91IfrnN1bwAkFNlQLWm9OwP+C/GUeH24us/niH1tINeh0a2x90MOLm65z5seq5U8cKZBEEII4eVyuUAIIYQQQnUew3xMpHyLZijoH8zPPgkiGUduTuE1pbbKFbVn7JIu65OOy+HAZpyxERPo6lWcYW9GoMHOLKBY3luwZGkoKSkp6VVRNknIKKhqRHQMTKxsxcHtEHj6HjSwz9C4gGzsjiPZrqVGZm7MwkVLPaHLIn5VkNjTEVmptfA7kzkzZVsNpZQQQlCfqPNTmRkEBkcgUehhZLbgXoc7rDgEgEqr0gFQVVWpquXQuyoAIYQQAoAQQgjFc0Qwjq+zLH6JJ4gIxhhjfMbfy29Ki8tISwlnE7fJuSpzI3Ov2/f6laci71RZ+rMPktReli/8HCmdrFDf41czswAgTBxI9N2Xa0e712q7UbaBOr28b58Y3Pf1B1SuCANSgjXRIu/ycT9TzUnNpqbJImSXSCrP8gW+Yyzdrqj/bU5/9lbKuJDq9ESd1nYzzo90trAFHd3q5dpWco2O91X3esDGMR5ADSZJVUlRo5OhxeL2Q/a6gnNFmCz2dbpsbsY53XyHZ2wc457ZdtPRrV6ibSUfJ/TtYoDnB2EUJ2mW9wfD0Xgyzbqb/UcXYcBT0LKinzpAew0QhuoRG6mQEglQjy2py9bEJxcF4oNftRg0eMjQYcNHyKXttfoavKu81Mm8a73U+9BNvxggLqLIMEpDhDI0ec7p9tCVEai5Nwso1g44nCOVV74WNh3pigs3lar6uopVoLbo6NinZyhdX9dUxU8eF2BFW1+LVCrhpkTJWyqjXL1ibLHFMAzDMAzDMAzDMAzDMMyYNX8Nk4Cqqqqqqqqqqqqqhtc11anSmKquZqvmGRAa35pI5VUrwF7HqN33P96TZ+vJWtCi+EOk0jpchTcRERERERERERERmWx2ryEgIiIiIiIiIiIiIiIiIiIiIiIiItLX+d3R3d7/7YIW1MsyultBNvUopuSrVGSlBdbWCWUYHYYAi3t/R/zjneBUlHQp8m1EzU0t2HUG4lIXkqlXLCyz8qZ1Nrpk2arKnsjh/pjuDBbYIcAQKAyOGKHkbWXvzKrQ6Awmiz2O7i48vkAoEkukMnmKHqupNHpMa2z38ztP/unaJfC0GGuJSq3R6vSZe4v12ppXx+0VERGxkQVEBAgCRABEBICIiIgIAIiIAAAR/W82EHIhcSqZarVaB13ocUnlGtfOmwzrXTDjbxyGMwrUHJEFFGsPzQHZh9H5CI456dNp+Yw6HyQ1DgAAAAAAAAAAAADSu7+OoGuoFyH4Sju1BycjUDNZQLHx3gkqr/9e9SjslxSq3FDr1BCvD6i3KzLXcDeo6TYzQP4r+y7uGDICNZMFFGuPfj9osSns10zIUnafabnUm9dhyf///wcAAAAsDiKza+nHGF3R7293cTE/xhhjjDHGFfsYY4wxxhhj7LYne01V5RPN78v5Czyr9b37Vxs/8P4MnmaHBgyBwuCIEU58W9nV0OgMJos9zlfvbnh8gVAklkhl8imuaS+jh5VP87yLvC3M+sU2bNrq9uHuxK49+9774GM/JZ+P+JKvv99v8d2PHlxif8Yvv/3x1z//5+JO9ssQ1RUW5kMIIYQQQqGKioq/H6hLICx+/DZHcn/dbKmno9z9+9pPR+d7cJ8HPOQRj3nCDLPMMc8Ciyyx3JA6I1Az750WhMXnMx6rkT2U93ca/fgh+Uhp+o456dNi+Yw6v6ZesRWKJc0qY2qkhuq0CvZd1zFkBGrmfejGxHSPD0y0yrpweQ7GGGOMMcYYY4wxfh37PODt6+MEZS/3OEVq+sNsZw86fjn78b/gmhuxLXdSD8+8KPt2+iFfzfxQOlqtHvwGNDaWhE3SzORmkUut6hrT7DpP7LxjAwoEgSFQGByBRKExWNwka+8U0jKycvIKU2SXUFZRVVPX0NTS1olFfkzNr4H8Hj1/rO92fx9gaGRsYmrWrTV1Gzt2u4f9Hiyxh+LIsROnzpz3YriXuLquG/8OD/EV/x/Upv5TdvMfBKgqDK6WVom2TqiD6DCHsbjyPoEYqbp+iEA528mTl99MYyTVjHWEbMW1cTt7/ZOr7Jplnbgb2qgkRt44EimQKqYUSDmcSm2q8dRqsp200911zN6EM+Rjz99BYnsMAL5Tw6sbP/3ZFy9evXkPlkLEUN+bkBVV0w0zIS1B0iRS0jKycvIpGFRUUlZRVVPX0NTSzkRfFsJZCmalaL6O9jawg4DARRRaDDgCiUKXwSbb4u6wd8ix3KnsgZUDTyCSyJSoMI3OiImz2HE03Mt7tNNyJbcpe4JAVWFwtbRC2jqhjqPDFGNx4csWSXCI9KNYOaS7nry4nrypnrySnpy8kJ7v5Eu+5OTk5OTk5OQecnJycnJydy6Si0dyJrIZkdROXv31Q/Pb3tsvT+LPbowLSDyBSIqfwFwIE+pT00NWVK09hhkTKSAqjc5gsthxjHN5fIFQJJZIZfL82Ne54E9yeUdEiEYCQiH7D8ExVARBEAT5A1zuz+aL2mwLaNuO3fdu4P18cA8fxpFjJ06dOe/CDokfNVr2opwtN/UU0gMAAABEAMbafcYJCSGEEEIIIYQQQgghhBBCCCGEEEIYDJfbtg/djuxmL/sGIYQQ3c+5Uqerk0OOzeOSdLep2XGXjD32PQB32EZOR/DCK299dpl3RfNudWjQpWefnKFjd9dv1H5njBBCCKFFt6X3r98ihBBCCCGETv0zs3f1lj9ztnONhV+uED8UMHAISKiFzopNCgAAAAAAAIAa9OH/KimpLm2W/sq4ZPrP7MjSyGvLx5tDv8HhHQIMgcLgiKlN2OtoTIu9jY7uiNN2iUt+PKkJ1rr39Z1rttfRqLhXiQ/y/vj6sV6fP6nLFaA+BFEayDuJc57WnH1aR2NavN/jftaO6lbsrcQlP754UgZC8ha0qHk/n4Bb3RQTuFrq9gQ4k3MA50MoS0NNSMy0INo6oQjRYYixuPBGKjgVYbLYdbri0hQSHykQNsremCTU9xHiPAU25ylZYJ9SgsbMd7X3Kau48mJXicERSBQag8WNsKBdFiGNzJNnYb7/FQCgOR8AALziZXkwDBkxZsKUGXOl0xYN2+yw6x6S/Q5uqcNwxDEnnHLGeRd322W4Wq4fv+6MCf8AOHXHhbssXbnJ+vYPmlvckfTvXk+J3j4ArnSGJtYlAiS02SCUlGFCawptt7U+B+315aqo4rly6828PXToDvETe2m5KqrUhKuiijtQ3WcDkMCyoVnSpkbXt9+egs4HNPvarlwPjW7tfXuKD2AAH+A5/DzXlHyBnTEkcMFbqr3tsjvl8/8rBIfnckO7QCAQCARS9FBKKaWUMcYYY5xzzjkXQgghhJRSSimVUkopVcM6dY3rpJOZqNjnPbPZbLF02Vit1tfmVmgpk1vAP0hlZbKjORxT5HSdRA1mxPVE19lCtwhlXEg1ffLtvg+MOdkX112kBgAAAAAAAAAAAAAAAAAAeEUuUDDoMCgsLjyiglORSEgyhUqj1+yqBbtOUD2JXwMR3pO9FP0BLASCERTDCZKicylfp/xvNbAc34AoyVMSfa26Gnu9pmnIBwAAAAAA+P//////J06cOGoAAAAAAAA599Hv1kh/XpsP3P5efRTL6AUGAFRVkyRJtr3/RR5Kvxm6z0x925jt23s/lYyOSIvaOuF96VLXH8hJ8HQtddo0TdM036sYZXirjUbvHNQF67VVFgDgU07//uCeuQAAAIDXZqpMAAJVhcHVUOgwJFhceAMVnIpEApIpVBq9ZlcsWG2rE1RP4uM1aMJEIHES7I72krrZzVgz6d7vgT4tOc84A5szKTslaAwW9yywwf89Hjx68uzFqzfvCopKyiqqauoamlramaBZqSqyKQoMjkCi0HNcyn7iuYKdA08gksiUqgPVoDPGXPFucdnz8+8AgwIAAAA1sQIA0qJ4lIO+wWQAAAAAAAAAAAAAAAAAAIDchdwHqDkFAGpWq+69R/J57qMWAAAAAAAAAABqYuUjnwAAAABwnJoc/AKCNd8QFqESAAAAAAAAAAAAAAAAAAAAAACA537VTsOdCSAhCQAAQBKAJAAAQBIASZIEAAAASJL0rxCKkcQ6eQiAyLhy7oSHs0Wxq4hqYgzddnTtFhoRFMMJkuo++dhzwOiTXNQ9B2qp7XsAAACA////P2oAAADA////n2QAAABAVVVVVVVVVfX/////////i76b8EV1BAG9BI2kIShoGXJYOHn9CU4imFqU2ZbTpY8sEEIjWuKSTel8N/CRyTngnNf25nWhsYfOJQDlvSkAESaUcSGVNtb5ENOxm47xz8fU69fGhyfB4QlEEpmSWSOLYfcAunqhglRVVRUAAKCqqgqHnAAAAAAAAAAAAAAAAAAAAADAsfsRVyKlRIWMgoqGjqd/AsKSPcfwmkKfHs6NTjedsZx3yppmOgAA/v/PFEmSJEmSJMk9fLl3f3tfVGoAAAAAAAAAAAAAAAAAAAAAAH53AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAcIqzfl9QVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVFXwAAAAAAAAAAAAAAAAAAAAAAAAAAQPM1K6Ci6AlLhFaobEFo05Gih4aBhZOHJVgidSMyJUuwcXShwCbiFNlCxpKHQdQcUmAQUDBwiCFMe1iB7D+ZQZMmTZo0aSZ6zddLsCEIgiAIgsRPvmb/fDG5UvRcrhuFOKHA4AgkCn2xxc7eIYdchpeIfAMAAACAOEa8ij8AAAAAsRWITUBNUdXqrtYqU1VVVVVV1fMrBAoJAAAFmMwCAAAAOAEAQMwDAAAAAACAOScAAPkGAAAAAAAAuAkDAJBj8ip/AACyFdmEEJoCpDutCFMAAECoAQAAfAAAAAAg1gMAAAAAAOEEAAAAAAAAsGdgBXXKt6KLLTlpRbEtRbTpRO1FI6MSFk7exQQnEaWw52XIKKho6HaVeUmL1zRykx5GZlAW2PU1Z2YD01URVIf68kQfUAChER6xCemIcoyVqpNOp7ddVLfEFYd1G99jpEw4aTvQPrRDWx/Dz79Ne/h73fyCb6VLV67duHXXD8UlFElKScvIyslfGLiIkrKKqpq6hqaWdv1lBoZGTfCmwWZCLWReWis1fYkHbBwEBB6JHgUGRyBR6JmBN7RdYde+6IN1DHxidtFz4AlEEpmSStHoDCaLndMZvxqXdzg3Xqx9hgAAAAAAAAAAAAAAAAAAAAAA4L42AAAAAAAAAAAAAAAAAAAAAADQAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgOvvtdkRmaKlCcsRCKgVzKoWBjg1z5JzZEsMbTpSVkLLeAkWTtuMl5DgJKLkBjIKqhpWOnat85KNXhPXTehhZAZlgV1fc2Y2MF2vBrWh4Om7WgChkS3EJZtGWRyrKjUnUp1KdIbffLkttGVzhcE6Pb9Ce2Kl5ESNUlABtnVoPtbmEzU+Ncqz9XmDcyDRw6DDcwhgEFAwcAhJ7VDQMLBw8FQVMJvT5mGBRZZYZoVVX5Pzhre8G0rJh1Uxcw11q4exlP3C+hNvL+K2o2NlbtNzJt8M7CYswthRExtKN2Z+KBcL6ywdo9f37H+5eL8A/8SXRBJv/xX/R1zxKvxfxIMasuAmcnNoaBjgEt+LVKmfQccMtBG3X3X3O/fHOmw8vtoJ2YfijjYeBEQkZBRVFho6BiYWtk6q7kCevZ7jPnZ/d3BfFgAAAAAAAAAAAAAAAAAAAAAAAAAAAFjtDgAAAAAAAAAAAAAAAAAAAAAAAAAAAMZQDwUNuirCILG48HQVnIpEApMpVBq9Zlct2HWC6kn8NRBhosRJgIzGUYed1d4BEQsVghEUwwmSonOp5EdrYDleED0lUug5Jt2TDMvzZ57MzMy+Ty2zmtIZNevV4rLrMGwoAAAAAAAAAABkAQAAAAAAAABA9j0gLIClPrgnUlhhB91DoK6rICIiYmZmZhEREVFVVVUAAGBmZmbu7u4+J1YKrC5OpLEKE616sfaRf8M9kBGomSygWPtYDirn1d0EIiKi22JkJaX7pL6TQyFJkiQzMzNblkdqnWNmZmZmAEBKAgAAkM49NuPIRl20VcMGlAAyxkgtaoltjqADg/jh/Wx8nf9yH9E54ep7Qh54+fR3Zn7Uf0hnzwIAAAAAAIAkSZIkSZIkSZIkbyoAAAD8//+frKqqqqqqqgoAAADbtm3btm3btm3b0rt3wChFEGGQqN3fP50sYffh54SLu5+BvNQ3RF8dAAAAAAAgSZIkSZKUJEmSJEmatkVNAjKFyuZwHeL5EBMSmYVnLT0ndOpEQsXujLBSzn4x83eE4gUbhsVtxjsJxWC+G7/xG0VBxcbB5RCeAceYSip2N2SluN1oJzERozE6mPg3ztcsPaCF41OCj5NZ2F9p7qug6b1CWSu7xYQIv6TuKwSmDtDAlWKtC0upJUFoFGRFCpsyMUIvqTtLgcYBiq4Y40gt7QqWExmu0jQ1akqYgBbQJZrnl8Ur9Id8Ku1V2AHnXEMW/r/ZI3JJHagFdGOPkIrdupBHw9RlFAgoVTBwatZtA0gYtkEp6aoUObQMDSycPEmCkwgmFnZOlxvSMtzB3NNx2O5Xnv+czDKnpBrQGCwOr4qEGbPmzFuwaMnyQL2FoOGSS6BsvPYK5KzUeP4+QqWUUkoppZRSSimluvpXs7qmj/UCD3EJ4aLE0pKojvdOKuA0Udqh1mQmMqfoYmz+uw6I22QWFA45y1jEpszhE/M3UXpuoh2u10+PGrYJAAAAwP/////Q55zTqv8/gE2L8H/IOT1SJUmtRKNDK0YHcUXtGuUOamPMluLr6p7eGExqPUNePb37+yDM3BTOiuwu1wFJZgGwiTAshI1iHLyjQBKK7lsmA6+/JV+xDGy/epFqVhZ3BwAAAACVBwAABKgwAAAAAICGjhwAAIDOCgAAwDoAAAAAAAAAZGUAAAAAAKiqqqqqqqqqqqqqqqoiBAAAACoPANABAACAtI+OnG40BBIAetUAAAAAYNP7//7TAABxAPEAAGkAQMOS6ixX79RZfexTUM6wsLKxc+Pej1zHI0+45uXMGz79TZc/6j9KHhEAAABArYq+SgAAAABIkiRJkiTJjavnj9ef9b57vQtJkiRJkiTpIKKgQ6caSL0lzZdpk1a6/+G/J67z7PruDAwjRp9ou1VdXE2QJEXj5UDSxRmK9U98ky2squr/07y7CKQlwdu2bdsGgNQGAACAo4vcMJzN4WpG1KCWV/GDvQdBh51HoOx67w0ibwvVNE3TNE3TNE3TtDiYz3A1LDXNajZJkmQGWnOaH3Q9CDrc+wiUHR9+gsj742OO7QcAAABAVVVVVauqqqrq/////1FXVVVVFQAA27Y0bo1G8LZt27ZO/X+TPRQlkmAVLWRXxs10d/KweWoWwS4hKo/5As8Y0+0ZdTeJCIrhBEl1T3TsOWD0D/mFP/77TJcL7n6S5qW+YW2GkSRJkiRJkiRJkiRJkiRJcicLAAAAAICqqqqqqqqqqqqqSv6mFZ+Mb1maTtLetQh7euqfPvYzoHpWlCSgnj2pa3/ik5ZAPvjg3WRz2lYwBAqDI6oXaq3tpnHELG8J8lnL9f7zD7HXeiomAAQSAEgCAAAApKSqVFJVSaqqqqr0ry3EX8hiXLluwx5pvhP6PKPtoysnXH335oGXT3+H86P+0zmUUkrv+4sWR62qqqqqamZmZmZmZmZmFs5D5P01JZIkSZIkSZIkSZIkx8E6+3UNISB+YieSjqn6z7cP9RdrA+Cdj6hfTwZuEDX/gIdZvun/wo8oHfaAmpD98drcBXtuHnD/mlNk+JonPBaaF32ozBthdJgPYmLjIcfRHtCHnAinmPkMomk0n+nWoq7hLOKVYHmbQQEgXruyt2YIe7tnhpG3T82I4u1XM0p6yDAT1B6KEBFFfWomWXv48RAy/ZGVbnUvHZ3rQ6jbZ0foQzD688XMNOHn/22mR98FyBkXeeHp57/vG8ZlspdAnd7AsWy787QaPoQX5PVFK/WM2TzI3zB4E85OlVBPI8azaWr5VnkNiuFdqbb3DdASBRt2tpMzRtoDC2qM2oxCnwyYSeLu43tTPbNBllbbfz9gObgzWrBLXuDA5qIdtmErywmTyQhfUepa2P5++deHGxyQCx5DpP+mNf9kWI7+nsZ+eEFx9/2oF+d6dt9+6XiGZTiG7/+0iTpYtbH8HLAe2LF+Zc8ff8FZJNhdm3YLoLcK8LDzDAbuIJBGmRvZgWILYqfWUaWvbESX0c3UsHuQZU0FAg+opyGKhRxaIxU+VQcidWcTtSs/eKPEiKlJvRCxy945g/fAN8zW2PzElryFZN+DeyZxbjloHMmcHveQ/NYC04HVBpvpVpAxdUdNnHuSB1x1iZd85RIEpAk7pIKS8TarZcsXUqWeGKiNUWpwD6HRpVLtW5UU0neUJpo0a8T+DDyARWfNQOaGrynBqEnTiQbglD2eQBemSrk7slDzBelgEMIZ0EKNI/FR6VIIQBt26VST14uEC+YuyHY2oLyxr7ArDFrJXRKrdmk61EK0+wJ6PSFproHdU1fi8lo5moFqDLZE5e1L5OThQIIj2Da15kkaSMiQkjXtV0rclfeo+FboxiWHDeWS0gCgiafBynxkfBqheiCjg8Zgp2TuHO6Y0ptA0S4nDE1eC7AIgPFudnJ3nXXuBTwQKTtyDcRaqz5A+gU6zaSCZ1uORAvkJve3zuIJjjhIqtd+mXs295dRHUAQM9dsAjzSjraKsoAuUuPhwTBFg5eAmWIaRxf6gbzfiF6lSoQFeivX6Z3dSwr3MoXzV7XX9pepeeinlBhTfJbVN65PKXhLp7MOE2KcXvhWrVPqyQbXUssWJPUaxTEIzKUzNM+lNm/YAB5UJVklD+T6AKaeVyNwthi7aLATSVNQ3yft7Av441L/Mql8E0reXgTi0Fzr686xZZwsfE/w5VeURTuHIkpEFd9qlnoXiDHISVKpBruxAPm5k5ZS7msWbtE+pLalL+u7QB713/57TSYGdhje/a7jMtlLoE5v4Fj2N0Kyd77T8iG8IMc1+6KVesZsHuRvGIhfOK84tdNKI8azmfQar02Kj5Yr1fa++4ppiYINO9vZMhgj7VJzWVBj1GYU+oQNQcGSuJ/zZ6hZZFOAywnX9t/Bj+Woi2ircNq/wOGk6HIswTZcRZ2IyVBphJs2Sl0L22/c98zlXx9uElkwr176b3YcyD/d2Hb9a3j3w1NNrb0/tS9OjTpx9+2XjlMDGI26/u1FP+3zw0jqiwRYaI3O//ENfY0fexnuquihm52l+5utQfa/Du4gkEbyPm5kB5oOGMbbu1b3BXeAqevm2WP3kOizpn3pQwoR1p6KhRwiEBUv5lM58CQHwIriDYM3nxjfXdqkXjAJHmrt6LPHAfQHZkscBfa0tASuZN+V4zKAs9YlBI66Rzo97qEEMCHp1o0dUawB/diIZ1GTYJ8EsrNHxEvEJoIACNJdYS+oyDN5m9W2iZCwYFOBuJ7JKNUQKpaqT6Xat36aUMZeC3OZvtboimeBiJauaE/kDRhsj695yExj6I90HzJdD53QPqzsaLpDPtT8SqWLdIu5lrofInswlDYvlSwXe6spquF7fQnvCyFQd2c9yptmrIFgcEAr4AeaWpsSaq5aNFx35rJmRJ3O1mw5KuleXmvLBJ5ejfGgCT63gB6cR1JzquCIp9h6o+kLwgTp55CuaLKaV1COVd5xwcqBMtpbHFJOyne6IAB/+uaV+Yi5F6r7JtMDXTDlmlEIujAN5EZpjAlPoE3okw1CAzYfzCw5OqJz/19vQrRRleNyvTExqmR9GMQBmZoGL8NEr5oZC2AAnNxRKCd9aHVBbpTO/hoHm+5xoBxEFtgriSc3UO0qzbqgrQJU4B9CDp/hDabNmCoEX/FEvWKCXgFqBjDiLzQ+6NXqpCo7USWBwRMVeRywXjwd5Yy18l4Nw8v6qJI29PVUHUbM+wgrb3yFunCqwbUe0ZKJOsC0OCnHyH0BK7KcTJs+ZGlVYm3pdNccN8XgM0mcA2TuGeWNaCwx70/dJ+1OHFnAP6H9nExzV259nVO4AW2rrVuM3eGYi40++PIrOqZMdCZdgpgBwkGaUowDWvWMhSUWatxHPFVKL93ZOG9pmBtDARlw9hvodWDAzf8DXW0GfooaIXsUsOsXem37SYX1ij9WxAAITQRYafmq5OdLb0RxGS0zAQQlgmAhQn0UiaxjMghnc1KEb60RJdrf1qeA2FQQBwitv0RJloUBt+RRxKX+K+MrBL7yUzhIjwExsbBxmkeX2hufAF9qUoVw8ldLtwWdwDJkgsgjsta518UW2vL0fVxYuVYU3koT5qzynXgMWcv3j7IiKixSlGgxYsWJh2zkq6gk6Ee4BFb/22qf/Za2IFMLe6NFPmxxS7zZUuaWlVyKt0v1bmneL907ZXiPqUxERz57oL/LC8sR5flnnXMe0NeK3SY27LppM9jW+MKXmPi+/Ev0lwt2T1xg0Tdx8XR3dC+vYgMWW4eKgMKH0ZBhqgmjFbxpj14uRNBJIp8A+J6W9MpWlVe+K0z6Hq2XFllMUgQ4OI3AaOQvZLf7p18NNm7MRR6/d99qOcCUMpId8hM/r6CAkGuWXf/PfNevHKu+Fz4SShTDnjwl48GHpuKSAvAkZT4sWQSy/NjjZBgmnnDGk4WVJJgTq5ZGSQ6aTjFj5qzZc/Altcyd5+B8GmjGgXyHFixEtGZc0rxsOduKv0NOWkEYBPqy1FdeXZX12utwufp3vmUgm+NthzemxLHcV7/juGh5hVNOOp3jzOvb12r9ho3/2GR+3rLVOWelbZMOfA1YtyUv//0dO2V8IEumbOfl7NpdsGfvvv0H2A+CVvKMFVZJ1lX8WgrpROnzsK3KQxUuQmUavspwVXVNJBrjiuuvNVmXwHEzyQOezeURGmB2i7RvPPthsjFMQlIf5BTgjDbbt/swT7ArZ9hXe1JNH4FEI93JPPM9PYvwtLxiC/2On2MqByiK4QRJFV2bO+HTQP5IJpJhOEFSrnYqFyjq3FJZw3HJxOTv1UqpaCjruPAf0vF0ooK7CNyjzN+/RJm3vwVOS0DhsdgRJ4otZZS9BFJoAZ42+qlWN0AhNupTxWDQZqyLqrWNQnwxJSMBtO3TScyOLcSLrI1tsHKYba/1V//q3EPJVPYYeDNWjjBULMDT0d7WcoP79MvQZCXjKPThE+AQXAlLltlo2KzwwWi3LxPv1/OIuHDj4ODg4ODgyEljdr0TlZQlcl3p3+7aadgNlX/c9jOQmj5tpmDyp2gBBwVEg3kcdhb9kI+Gy82QttiOATrGdX1JHgprglvAwBQJYKKgI3h0Cz7aj7MXv86QGQnF+Ks9soint6qOODSfS0Ba/tov07Il0rIuVAw39Hyz7yHbTat2q+6RomTHtKG4mA9CZW+92dP+Wbd/y5b6vbaRY6L0y47y2fHf9TESVs6S23Nc5i5UheYSPSEKiBRbGuMOuoDMSGGUxJYbhj2Fp1v91N07xEpk3JukqoEv/oncP8OJt24/czIuRVRuySGvxiyiAovoDe9KCo6O0q9ykrHH8fTaihAPT557ghhOkJRjxjAMw4+1OJWlpOadyiqqSEea6w7N/0ffHd+E97kBW4zanBw76kzY58eXsxyZX+j+nReGzA8LbH6iex5fiHj2ehPQpZbf9skQ+a/ucnGQdPZtHYBAESLX06IDU+X5y0sDt8iESsNjP9m6bzvbQfNrKc8W4SH0v7dDMkTTvf/FYXppYNnLZuU5AA==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/fonts/latolatin-italic.woff2": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAALFMABEAAAACWFgAALDlAAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HMWhyFXAZgAI0ACDoJlxcRCAqC3DSCo3sBNgIkA45AE4SAAguHIgAEIAWubQeTMQyBCltNN9KyP/wfe86UG5MpYszsWdmvviqcxdC1EoB8Xq3d7jYrCWqJrD3qGCD2n4BVh4wFbDDOvVppLsg2p52I67kF5lBLC5X9/////////////////////////////////////+0mG/H3/zVttQe+wsM3QYbcoLPoiaVZSpEbt8yd3DwvyoQSVnndpCklbsassIa3ZMg7IxcmBtw6Q94z55Zz77OBbEgDUFJeD6KsgaNxqMmgAFmMLJINIpskZNM4i7OMuiyjGrijzD2NgxFLU8dzMFgsa7iyCqNBM1Q6NEFdChJaaws2ovUEpKE2GDScUj7e6GAeUcaLWUU9aUsGLYWdQ/MFq3Jy2kSjzXhuLMeVoTJcxSqc05at22hs3NlMHotQEzYvQ2wfBmmluXAo2DiluAPspFYQUUMFchohwx21kjXatVtS4ixHZo68gCba3patfCSbqdiBlmwrWEcNbmBm0MgCVBntYaOV1R61bG8DNtCsg2MD1ThCS2QFczaG+/YzAzM01pTQgZT3YTXtkfW2UAI76yN3aDZDDR5QKzgoONQPsxtmQQ4fOYq6BR3DA96FLkQbBEcOHEfbyURpgEmAE/pZDnaAVZo3W5AXsC2hOTRDLpnTLuNHWLLV2Em8gKVkiCxAjqdwPZ2xvbyNVAGv6RCIERvqCXaDmp/aQlXNc8/KLSypgpkF61ZTcu7YuXHjZqyjSI5PhznCM5yH6oceMefFGYHrGcqrEEsdM9o52AdKcjTDDkszaCbfOt9gLTyr/5zy89TSBVZqXtPPzqK9+i7izbS6V22HJpI1vkVQK0jwJXY56GrY7egKu8oiLhTtuIYbSi+CM+w624HLTch9EzQ4EHUDa/EN5QfxVTSopPsqR+5nA1T45nXTPq4OroArqMEDQRuwwrf00xJ07Dg5c0EWPj1v8LayGOSO4KLau/rvDUxWwkzFGbxL9X2wUf8D/bn+h2yq3ci0G5nyR6FKDQuc0mOD2/RdRvslHjQLNUH79R/Qf/+cwbOyCd4Q9IrgzhO4kKX4QNBz5/FTNGDP+D75SMOjHSaq5DHkDD/Xj3fSZf2H9C/IlO7EQ3oRNGp5qf+V/qH+1/qPKn+jn71VP32nv1K7A0d6K5vDadhz0vf6P+jnJ/Xf0f9R+Sn9p/S/039S/yHFp+CnUG3YwzuFn/Xf1H9C/239X/R/1f9N/3f9P/T/1P9RP/1S3Ee/Q/XDrvbhH7RP/3n9rp+O6P+r/6bW44fYYeb0T2gqvuCf/4WRHBm5/FGw/FG2kSa0ZM+S5Oz2Gl8PdwDXITp2DzyB/82UvGEwrvcDQVpRWlTqwcbBDTnefMIXrf2/p6u7Zvbu+4AWE5YREoAkklHRgEIyKCAdzUKGVbI9BNvsiFZCBBNFCVF0YuXcrEUZhQqIYM54p73NxpiL8ud+7wIDZHNOhq4cg5WwYJWuPtbNj07f9MRDoqkgCWww5mP39Naut2XKlMvVMmbe/gC/zf65MN4DFAEJAxSrEROVaBXrwUMBUcFs9Bm1EtdGLc2FK9e6bpWrf7fddpG72O76n2m2XD8GgC3fg6dN5yqj7YNwqD6puwTA/aZv79Xo2yiD8B0HNgtIYn2N/Lt1hf//v/UgHliYQKaB9rThj9bptlc8joNz2tkLN8WB/zJwEiph2XZEaUdgbwyh0ueJb87+7uP/6eeZMlAxpCrUT3N3VKCi4K1zVCkqBU9Jy8UwiRBdyWaz6wkJHt0YsiQVU8VFMQ59muH7MNVlQSY58qY46vq0qroavk39R8WpiZBM1OszOalFyoNnQKx10mUiknZmHI7T075fteP/XJWsGr1mTLiuJmYXxsIAKTVx413340cTr21XAYP2T4q49/O59Qkzs8AwboZwVca/6dA/6koqmgC7edofILkL+0hLRxwpKO9R0aao3VUjh6GmA4335+hG4IxYP5Wz2GqlLqfWDhz/8Yc2xOSEalRnDZPNcVnc7yEtFHiqi+0rghIQHp5rko3u44wKr3NdtDccJWZ0B8eGRypiv4fsfe8fsAPA8ogKMMBgXHx0XHz0lb/yqRgXRVMAAVSsiXuQhMbolldGdjaX5VWAPPybdGuvrjL4EUxQaYDdfSMwbUtP97MdjZeVTwxhCnc3AwD+8z8AIP6P1laoqLZKC5QglUiIZzNrisv/RJ3vTw+nf850dh0UDD64pwb6dsqlD3poHew6cQ9XIgKaxbJltRHbLJmSRdPTxCJHjY/ZREMFXy4bnUNhgT2QbMdUBGO3xx+qOBj8PUEgYbsXJQVRFFgzJYEn1Nl0wumWQG/s32A8y+ZKfm4SkhTwDbH60NDfAdvGuepYcWYBRuXhWiasax/QVrjDrKEIOxgCkTpGjrTH1r85v9AWBfmqgPru6mLewKiTgDrVmhYvAJSaZR+eAtjmXwoEYsFnFhcXPE/eRHb3YDSYUaJFXReHxWFCBfKBU9k11araHVWOOKUqJJwzIGRxj8J50VE0xbfpqS3uUAPNjnQEFf7kBQcr68EKD8he6ERnHC/zsl01M0E/Q0HKQ4S/wVjhwlRK2sPIxuyr1TUsEuopKv+xeUIhFgosrBgaKla2AANjhCA3TRhCYfRDoFPZqoqjTlCKry/WwiVkmqvL60uUhVmcoYUHpjfIGL5RHGwZV2fXf34lgGM1eAMmWEK5p1RIqEZLvERMEPUSU5OTkIqs0AqxusOLvbK8DM3YDM7oDM8QzVHS0ubt9f0VxLX87Kta9luVD+kGmPE/q6T651l0jERLhCVRICnPOvNvOqudUTDB8DZHXQw99l0uumuvvaLR/xPgz4wQMwKb0QBGAocheBkJvCtg70kjiRVC6wOME3hTwt6YGSQHgdOAfQ6RZVPAu7m7HHJ1ZeqaGLsryuba4+F7XV+mOtulgdBA2b/ZQKQgG0qD15oeeCPLs9/c2yZ6Zi1rgBcwcmiOCDPWci+3ATDKnEZbDiInIBAkUAmk0IAs8kgyrRw2u1l66Ma9EPY3tMvRElTtsnTHIDWDrU1oBqGA/+Lg97xzgY6lWfrc7qydWSlsAqOsmSgr4E+f/ycW+kq/dZiRLktgASuHqoS8j2lRahS481Em0IThYukyDsxXOHf0FFlHhHsHPfR7f1ruLM2iJMKBda0cWn8q8g8urfgnXKRARsZ/f6/TPwhVIascWNe8nNy2nJy8IJvL0M1jTDX/6Hif5QnCjiVUU4UkKIRz+L172+vdCa1eUHTl3kMtbnAouSXUZGgt1KqKWvB5qBxU5EoOQoH8rTj3hfnPzWp87a8JBxK8I711AAOT3///y9TSxULaCtqWBpYCFAvYzL+v5Mx7qfN3uyXYSDpbKMY/EVpQDATfLD/pyrXISAXgAGjOdGyO6QrqRt9Lc+kZBQAY/iFP5NwH6g3MMlrfKo21hOGp/qDkqSfRVMCkp5dmPx4eGCYW6EVWmWWiPNLfzgdUYLRXtMKfgSgSC6Su7eTxaTtBElRzw57eSaAF6Nt9EkRKV0ooIkGkK8U9jvMfxDPT/zdzBLmpq6CVFsYIY4QxxviXOP2l9cszp/k9YxW1KxfLYowRwyCMXtpLe6EKP/DgXml5CTgpWIJk+JRSipQbDhERl1HfifYVZ8uVltmWa/ndDCaYYIwwQjyEMEJXBtm0RnV6d//dvAYkEHEIBLMuAjzfUCbnh7yp402ZMoiUEEoQkY4OYT/sQGNQaV49/qH8nv/p6aHqWWtFjIgxItbq4V4ixyYD93VNSzVbjUuHQ0UAwAqSkQGPuXxRMrC/t65iOWA/WCEtBr6P5VSWgkjg4O7ozvPbskKyQjGYV/H9FaXAPhjpRrbkCSgPAmizoVAA2AOO53LCbCPO5nXuP7L/feqHl3hC/eA3gLA2c+1XIlTp2qOfaZ/hHhOAzbcnR/NtIeEWokSiVs+eOUHM08RKUnTpskM/F1p9xw4D3ocAnJs7IBr3rX113piDlpTv0ImPxBvx7fgJ/G38O3wcaY6+BGsCPRUniN57+TaxnnidtL8m/kj8g5RB6iTNkB4mAAlJfzmtcxI4zZFpZO7OShq5FO/Fh9gTRfJ18mXyTYEED4oek7/WyiirVKCm8jbVnyqkFnLNXuc15F5nYbuI3s4tRjBinRtM9QfY+aoC1W71lnQ1ri5XedXKio6H3G7SHd2l7u2EHnpp1f0TJPuSuZ/DQgtv48HSg7uhRaRADkHNhZkGKyNOQ9ybw03OOwdJgyoLqnJydR41jGpBRZ16DVZaNW4K0ZxPK8ZQPq6L+gRMKONSMyNDs6gxz12stmjy3YifYBQKABEUwwmSohmW4wUxzr6Lq1vc+ZOEPGsaUI+p16xAU8LGIxZEdZ+4sborsBntlZD1N3k0dV6aqmiz0cXRd8eFUBczM5WxPB7VZG6RTCN5L2v7iDZ8fk8h8CPTIZoODYoQSNjUbpTJiENQwwhjcMLEFkGlGz6wIG0euvxMhcu9z+KhCc3KYk/YYpnNEOYw9xHWY0hwIlpBLEFU6GOFCyxsIJ2pGNpQuuhtytajFSuilRMmwm5HjgWNgna9rCKEdapIw9rJBrMaT9Qha5GvzaSIPawKcayW1WjvMpaC3fqEtBemLUDfYtx7EEIO96AaAMKUwoDnwBENHWfa3YVr50VmOd5Nno4P4xt+Kv4CWrMSxAQjQnRCm2EArvZqcrvp9HmA0WS2WG12BwQjKIYTJEUXY5JFdpEXSiRNgc8oZxZZka0nICSSE2KdXBJSefIVRCFTpDiW65SUUlKmlIMHUCa4yHaKcSGVphsxuSGXxmgCcaZpaZ45b0sERGTWKKhsmna5slpZrGR+JdsbaNREMM98Cyy0yGJLLI1N+JttsTW2WbrdjvmRqmIBqAKqBLYGAAAAQGJNmrfk3apte3SZDp2rxKiPJVKZXKFUrbtGq9MbjCebLVab3eF8dbfny+eXo9ncXgCIoBhOkBTNsBwviAUHFyJMKMcvrWq7+WFedz+5rOXrghGyGojd0yps3sRuXS9hvKIgFyJdzEcyQQ8KmhQ/xA2OLAQ0YXCZSOY3lzdJnY+mfEf/D+vb6EKdLtbB1BYu2Zl5kVk55tTOvT7cWRRwbLZ3rOg4ZSlGUC0K1lXDFIYuMfYlhYcPpsufAT8k+krlyY8C7QurohNcKJAkNUGPrbGZ/d4JmZ80N2n24DbShVUVHW3P3WH+/tmGZYFqa9lA+40npc3P2NPQMVbKwGLBq0lOwrUoRmauzLI5JxP33DwaxK2RZxiv3poVvINXVABQR5hQxg3TElLZHm8he2lvlYmWPNqm5fkNpDJUv2FjRTOUuYwMVdnbkH02n8pLG01XkD6OKStz0XwERGQTJpRxqZklP6I/ffnOccyFGrP7YrVFa9yN2LGHc/7c1UT1K4AIExp8dbFoSCJDMIJiFCqNg5OL2xCdN9729jvvTxo+MZa0KntD7MsFWSztWH4DFFTgO54gy6LMp3vz5VYgRUVfExHMiRhcJWhQDNOEVi2ip2f3QlxlEiqXtN88pUJZ8No6paLLBsoyWJ3x6+lzYc4ndyGfi9k0wS+xwpRfzaGaNWIftONhCY9a95jtj9vW4p/e3saQ16SUe3CH0Ie5jcCPsh3DWl+b6705mKCv3O/K9Ag0FKzIcIHKCQ3PgSMaenhVBo/DEUEKUEH/gsG2Fy68gGAsipTtqsm13Cxt24jS6zWk0WS2lFW7LctO5ADBCIrhRJHaKVkPE9ZLLns5eHusaXIaXLSG2Sx2OKLLG/uNsBBlib1E25FxdqwcqtTUNTS1tHV0ozfsTw8Ww9WoOV5vnZidnfpfl7ftfTziuf7mf741Zz/WjuPxUwlqVlwaqWap9dc7GiPN6XZzd7K/ofIVBSaUcSUhBM4fPn0BoETqgGAExXBi/p1U3xgAAABMQEYSEJIXkJKXseCCC957kdavcveSqMV1wWv+HSJtGcuZMDWLgCAUiVtS53RyJudWczkkz0tvX7lE9pyj21V2rUUDiKAYTpAUzbAcL4ghBXiACBPK8RGUREmO4knVrODs/B3s/ODx38+pjXJgxWjlGMJmDAQYz7u7cKn5aBCfFUbTPMMX2FNZrAlrNNBlg80Zr5QGhh/OKKT3WZyHhKF0XkbUWiXrPbtK8W7xk5rZgzKEOsxrhNNo6WOI06MPNXi6ycZ0rF+vWUBgL2UhaKvPQCUGCxpP7FweJpbLKBAKn5TznY3URZyKuzYkTY861IjC6n9cUYQLsS7G7uazXDRlwwu00XYkuyKlTlnQ2kojOb6kFdUb6C+DDRrv7qVxlG10mHAn7lS6LrHOlNbv0OBa6Q+7/Jibjzv5ZKdazotl/d6UavhauLpZ24a8ZzrL6+nnfR050O56hkNVP9KNb2prrBtOEobHJAtCW7O7uU1qJWIxjD4CM8pCCK8ajQfThtBF0qvI884KpZ39K6uHJRTVXoAsG3kWmsZUI26UTfQBpQ+CY81BFkae1ngcdevTNGAqPW1qugz0KZmuGmIIuckKK2ZWo0TxAA56munDmUSih0GEySzHgr+EEROe+fgtU1ZkW0RAeL2InDNFbD5Jkir50zTBOgXtDAyrWDKh9gght4BCFKUkI6h+NbUCGs2mlNIqpCOlt4xBKqOmyZ/M9oxfiRZVlqVsq8tIxo2g86cdTDF/Klp+ts/PeeHl/Otv7zVv9n4nDpLtmz9DYlIKD1ceIpEvqdQarU5vMJrMFqvN7oBgBMVwgqRohuV4weka/9PNtqwlu9aZbmuRvCBFAUpGqGjeOhVtKqyj6JtmaiphjB9LgkRJkqU47QxLvGr5a2/ibYvfVe//gsigeQUGGwgyHkIN4w68J7D8iHRwnvWeGdqhWtgOuDkc1g+PxxiLGVwKce0DmAszQ5xyINWu/GfFkGemgWBBUI8Ox8MBIMMgAiYbKWOIZDy2+C2zZGkknpCA2JxLVObd4zvJgvGXFZssHpE0ym7M5m1iOxXtqc6yniEYTrMAoqrxOUuXp4T4meyno8wQAqNZsM0jWMYybeTWw1HeY/xmIGfw494nVqFUuaRuQUtP4dbXEWnFqdTJaENihj/9kxDpoxLG2ExHmiGgDTsxajHgqBEGxdNvo9ZPop1vkNWMHgiZ1TBSNrzWXt8Goh1FDshE+EF/aglkMs6QzGEFI8pGSca4TkebD3KGuRzxUPHJXGbJ3pYuBBYFr+O8VydqzahBZZafnzyRy6DQkwjUq1Fv4TBjCurUorV1t+0WiCteEak88815EQt2E96gpMnsh8weNm8kFhz0TJTp8DP4QRKaUGd8lp5ruokjuEcpdrNknDZvNUfD8j02xDzdpFlwtp0mH1MhQle4d2tH/ONDIQLSC+YViw0n4FmI3zJlRbYUAsIikiVnithCEqRKxk1wTsE4Y2wVRybMHtNyiyjMpZRsBNWN1SpodJ86MKWGdj+ts5wen0EaYzVNdjPbN/6mB+NMlFJhlmbB4UkiJUEXyUILk2kn+/ks2jOYPW8vvIxXcHvtzcO/Ew/LmpUsWAoX5sVHZKYyyCCjodOqrCPFZ5pWwFs5zaja3IX78SG0gBjb9kMDvTYuuL2Vf5+JbkKF4s1NE6Q/W16CmRzThEpd9ChbpdZodXqD0WS2WG12BwQjKIYTJEUzLMcLzWjl5HHFHy+8U8aFVJpuTG++dA7NcVrita6iLESlpeV6bSRdLH0BpjwJEJFZo6Cy0aJVW7S30sauK87nrTRCZa99Ru035oBxagdpTJh0yOHQxz3plNNxJsez1XQDUTYHggWL9HRu4lD3CbSE0Dxb+dyUPUMSy0F9uxz2+pupOdGwMoVOi2CUhWdv2XDMxl6ZWI9tR/raWSHg/aNSC6qdGnDkPvO/1tH2LkCxfYWVtwOFNOFHFBs44ZC1Op0d1C3mwkz20kKnuTlEOxtYzdM86qlpxzgAb6AVckVZBlXn0dM8pqmpzEQ2h1barOcVfVkMGJcQg3qELPevrgUdayFdLWCsYqKQQ8crHq6BqgcafBDzoGaHlR2hoUfpe8uCxN6Yz+mvc6qFflOIlUsqtUar0xuMJrPFarM7IBhBMZwgKZphOV5wuu6kQWKGN02tRerA2mWco/Hu9ucSXUj8nm3QxXIyuVaM9IQQjKAYTpAUzbAcH6HcVAOM7jhhQhk3zFh6QqrYxMcNXnW6wDMsL5DhpaWlTRJS0l9NioxZNVZ9OMHc8+D2hrIwlS1DHONe01XPwA8JT6k8+VHgzcKqqGEtVP3Q8EHMiZodVnaEhh7MMrCCnYrb2ZzOc+8Frh32P4F26hJJkiQd2KEvq+edQD/nnHMuSRwAAICZkSRJ0kukSlziCgkAAJIkSSBJ4HDs8bQ5S7GFULWAd3YmT/1dstt8Pnvt8YibwM70mMl7E8dgJQgLZ+BGtpOxn94szmB+4z1zLsT5kA5xN3sXywyt5sywLaufhrJkgSiqcb+uqOd2F1AKVjN4F0R3kc8LB1qFqEpoFu/wSc43/OrmL6AZmE5QcsHNjAbkhsqTryAXUpSL5eWiNKGyUp7CH2J1hMbn35JNWy79NdqVxe7mjf9fuwgKBoGbQSAwBAqDN4kbR9m66NL88VWSJEmCweLwISwXSWQKlUZnMFnscAy4PP4t9wt+IyfrZuh0DD1hahYBeSgSS6QyecyhF5axElyxO5wBBCJMKMdH5xrm1OL5LQvGjMSKzHnL7jPmpiTiKifvpOFmHXKUmahsGTIz7jUdOQOfhFSe/CigXKioVWIvkAG6HMNGMqGioQueA0c09GlmhViVYefiFus5FXCvC7dMlc2qCtVq1KpTr8FKq9Y3Nag5QGsqyqqpYstQDeNeWdHZ3HD48Rcg0HwLLLTIYksstSwC1/QAjO4ZYUIZN8xYlCFVbFXHjb9n/+U1gAiK4QRJ0QzL8YIYZ+Di6hZ31YMAY/e0A4wVxP6mXuQgqODcUAvgr3ZrNWBIBwW1bhoTekGuve0NS3atbJ7iDssVqZ/Ca/DW2YlrWwopkYrEOUdamDktj6uY1nHr+8ufRfHyMgvv8JHwDT85fwEtvJYgiWCrzCh2ac4RuWZ58hVEoUSR4vFys1IvZZPL+SMqNbRNGRdSaboRE2WVzeLY+vEt3LU0mT9LdRd0F3QJZRKLwxdhQ7FJZAqVRmcwWeyn2i/0jWhvBrkVwVIqY8GCUhU1w1Aklkhl8vlPtn3j7iHHE6kscncBIIJiOEFSNMNyvCCGoEAhwoRyvCBKchS+qk0t3qK3DoLFgUcTkQkqDBr+uKsqO6zsmGjo2fWYGYIDqyKqUpvFmw9ffvwFlMCMgggeZ5pHxEWOqwgdNXWtrtWgJFdWrqJajVp16jVYaVWsDtb48E2BmkO05NU66z+f1p/NzoICOYW1C06L65Sc0blwxcAMVlg2Nxx+/AUI3CyU5ybgbiLHTULYPNjyCAWMoBhOhPG3HB9KjAJddGn+datJkiQJBovDE4gkMoVKozOYLPaj7Rf9Rm5vlnMlG/G+xmJLTU1NTU1NfSg1CAQaQjlaJIAIiuEESdEMy/GCGMICjAgTyvERIFGSo/BULTp6mF+48NRkvifCi8e9C1fkx2XX7/DKwzEAANDDlwAROrq11gKwsEqRJEmSWjt5+LkyvNynKjSR7wDHJuaYKB5YzLmJ53kea9asAQAAAAAAAOBANhK6tdYCAABYa5VSiiRJkiRJktRaa621NsYY13VF5EX/LOEXBQq6QxpoBbmqg5YYcRETU1Lq16+fivJDSteDU+7y/CXd1tMPaaWXXuXX+6Iu9m7KaIsPecPH79lLg1iQYEIZl96Z1Xf1XpUkSZJgsDh8CCuLJDKFSqMzmCx2OMAuj99OUxbKjPcQa2ypSKUqasqhSCyRyuQxt3RzD77AcJaDhgxPmUBEiW0JNVqjebq/+wZKNF6dc5cP3402GWOI1fB0auyEB6YCvFYIZVOktxbWZZOQTAE8eONWx/PiAxkomlyvxdHBLcpGqHrLkIJxrzTcZcuRK0++AoXNaWG2VtcM/JAASuXJjwJ1CxVFceTtsjusKLOx67qUaCMWChvBclNOTtyGpp2VanCtkRnwo8DQwqroNwIR4b6jjjnuBD0Do3POg1z3K8ZO3SzQPLLLoSvjtv/OwscnsOIARUGFo6qhWBwMHVyuNpnZJtBMv6UNserASc89F26vpiuY0fgh4SqVJz8XXOVCiva2AIHNgE8jskHGQYVCx5mYONhIuAvPsZzGhyLEIQYgVbZSyBGsoWTqGRu22Q774hDKsdCyU3Iu9FAuWVzzYuQ0sz+xysPOxC2mOdVwT8qjGQ1oZAcPmFk/h7ckCd1b7mr36ruG874DSTqwerQJTUL4KeNCKk03YiKtH7VRDr8vt92CO13z76o6JAPPBBMEhkBh8CiOLimrTB+be3zyibknwymnnXHWOeejOrqmrhHvz/1AqRZKlVqj1embA3HkTmaL1WaPA41gBMVwIiRX0WG8LcdHIBsRwOhMhAll3DBjORBSxVZ/3Ph6+njnP1HlsUmSJAnChIat70IqTTdMy3a6BmQ6odwTy3bcT+XZES5Jkg4VlI7jOCIiIiIiIiIiIiZJjDEmIUc+An+U5CiCeQX7Z/53OTr7KSVVrJalUmu0Or3BaDJbrDa7A4IRFMMJkqIZluMFeM8JkqIBZFiOF1bRdTHD9tb7pUC9S1MmcUNan9E4n1AYlFH1obqu8cauhu0Ei45+CtIZ9bCBm554/3NmFUqqauoammbmFnIFgAiK4QRJ0QzL8YJ4aTeOuUjuNL+EqlU9rSeHXw7Z7yhbIgEvZpHLZEaSn9XavPZcuewYv3Y8m51Emihx0LkKkibrBnfyt30mHdm0db2c52G3PsTp8veQ457kfmffcJ2h22lwv4rTE5495i5B4SyEx6sD/YTbYTDt2XtoDtVACzwHjmjoweSxgq3itjD2REPfy2IWeDd5Hj5avuFn5i8ggrSCIwRCF9Ei1AAAAAAAAABATc2MwvfIhCzZBIREcojlkpDKk68gCrWKFMdyj5IPlVqU+R574kRERERERJqqGeluNtYaN2s3INMqx1J4oPRkxFGNNSQTph3iph4bVGNc+Oli0+Q0P0UoUHrj3oNHT57j1f3dh09fACiROiAYQTGciFBu+ACju0+YUMYNM5bRkCq2wuPOe1d5FBIAESY0bE8XUmm6YVq2E1A9fEFEDySRIRhBMQqVxsHJxR2edrZYbXaHM65H543zym0FgAiK4QRJ0QzL8YIYa/fG1s7ewdEpzmMXV7e4dz88P9n/iO+lnbvqpanPH04lHS53a/mOCYwimG8pnr/kIjiei+Fil7DEJS15KUtd2jKWtezlLHd5y1/BCkEu64MD3rL9hh8by/PMoY3ZqFAkYXP/Uq7fttk2RmansXwbfRDN1ca5Y/07MwsN+1Ovyu+sgGkYgdeQo6Es/Ovi1LxozaLj8Qh3xdkpDDvWn60Sb8tFHTSoRZlCpY2/2DoR4bcmjJ19nxQJaqIwwndYLOD7Huf/WyIAt6LtCth1peCAr5n2ebE+3xQr39K5hmqJtiM99BnZqlRN+Dq05TmElijvVFON109XHBSXumcdczzv3nj39R/i0Tb2jBOCZmgtvk4oygJVNE8tgYtoGVluLY+Xp7vI3bY1u+Ot1euxZL5h+Ji+Z/WdEXygnx07rhpOMFO4w2yfHtYIg2qGGR3T0kQxC6U1fUfxWFrPpVHYoFnnAvpZJtXYXLIeqhhiOyx4hNuoorCcirZOR5shHAUPET9kyiJeFENMKvNckqji2IfBdl4iSRARvzv6fNAzRFlWV/UND9PwYKxvGzTrtMlmu+MCG6qmmhcNrqlpLT863gzsGKNp4sEsHD9/4PaUyWrSVjq7ExAUAAAAwBOttwmwB9Z5PEpoF4dq+hZnelhvuTHqTK2l+phTEBDGBNmyIbWmtv9EO0sdkKywbNC8bClYuoBshKVLyNToNNimMGi5iVfRozBgbJp4TYQcJJGh9tRMJtOsWEMZHqz1NmiOFlQCwiJCk6OIYW1is93rrSzZeJDjUnA3ErdUc6FhI18U2Qxt6fEYtGykpokfs2h8iKiDJPIi2tXjWBZ1SJ0CO5Uqba0oNmRyLApEI+6WahbuwP0rGawqq5sgM7EhxiqeyPUNvQmKlw0FnAtIRuBciguXa83E6lzuviR1fkXuLcu731N9+jupXXttm4Tbg0QO7bwgSgQXI9XD3cKmgfUELqRTDLwY5e0m1h7/BRlOePiv1W9/JwRG7mQP+WVZh1fcO84pPq1GpkEyBcYvRl9pIO6+qZltvyqrXb2bE11ninM8WFQXOgenuXrugcx61I+/Utv7Safv4r5rNBB6qBx0dnGTGfpQKV+ZDRGsfbKEVoKLOXzumWybnK1zmw9+73jv3uOMmQeyOsyir68I9tQJ92fjWnho+CKzmUUiG4dgYdTMeG/tRDsJLWjMujkLOKJxFFmlul8Z4hlKpqBNFdrKEMUlHwkngnUODhcNKRsufC6ieNTSMfEzcIM2CDWhph5I6N0iRvKV3j03zVAFp1kgLy0Gs94qo4eI2tb0aamNL82skw51tKfYYpJYsWrryVaorViFFTOT49HXzMpEdJ4XyYqWVaHdVMeYD7aeIRUPGR8yb5mlnBi5IHJSmedIHk1CnKxna4mCOH3cHHbYtk3IU4l207MIM1Dev5/sejv3ZRYBs2bWnrUdu7DDTMEOs5/dVlKVKroV0pxkEbNjx+50WOCLV7+kl4rr5ea+iA1JKGyNrUktEXpBwpRAQIrPqT2niyxwpOG1CNsCyQTSuHT2BLz/yGDCNUYP7vSgwVNBAQRSEucABPAFvj8oFhG/+XzSZAK5DTgAo+l7esb/07H9SU0Jc3JV5vnCZAUkXHFZEuLmsyxTOC8b1qS55N68LW6T+azEbHrKi6s4Klp5zNY56ewTmR92ZjXj85VFG0iJWCrcNIy3qL8R272uUDgB1aXj12cXLzuLGvgh4tZTjzNyGFUSUAhyMBPR5tHY6TqeJDC8kNZUCRc9yeQyaRO/mBmRGSCJMzJSo6CZNKmzSXRMdmhUFV+gU5uwDiftOibro9l9Qwe6Yu977dWKtROTsOYaWa4AzqKwBSF+Kz3zm+pwmOe2jAxo7GyQ3LznZMKSz8681YkLX3IAl7PylOdx2l9j7UHy44vFIE5k2Aq6rtUXPy3Ai2CzOQg0ouXRzYDKyHOrIy7NpORkj6w2PrI6qgv4jY2sf7IGJGlb+k1PHUMatN0peXpvVBdzV+zIdGJpHU0W7YI9OlrGJclPVGgLAjcadbgDaWsg92J1iQIwFMcpnuapqix2nXatojQxWJQida3aOaWOVt2AZEn4TosrqMU2c13FTqwJHGvW3GzjGR6qhGRiCZrbg6uNjXXnRJGgIbf72pCmRQnBsEdGS3TwTIjyaI6oq/fHQZ8pd+7RG5ysPI/3NSRnXfRVA6hQVj9GvvVOSHU8+6XLzFW5VEzzNm4l64yNPYW0ytZTBMprE+BkAXOw85qjATPKPGCprtjQEWNHmb09ntcUBZdduZN0NR46b5i0vJTqSkQ0XFljkqsTq243XIWoNAnGxak2Z5CljozQXRaGyBUqHUmby6Ya16+tx+TIGw0smlvdSmxs+IAEeLIrreqMXj4n0tCznbqlsfdv8dbTpF6zzbfB5maz2xLsUyEvqf4g6JCt3qZ6EkIxDpFYxxMxpjLOwPQUOFkEP2C5keci1LA5+hc8LcrjqxHSmK/stYomqLl8D2I/A1yACkU8sQQSZubwyQ9GRQW1IOC+D2VaIm0lrqNSWgWqSTTEej8T+gakoRFlbEKbOlJzMOZizcOZb9baC190fvuNt4CFw7EWiS1qifi4eAXj+7RwAsKiiUi1fyeTVrx0mYnBkOTUslPTMKQbFWUWK80tU1GGOs2DygYoWHX16YtOX/HfLR1d+hLT9eNigBIy8ufAAot4FmtPyNclfO0ss4KyymrOGqfjzRm/8Lea9C54JO+qNvWa/2hc//cAPr/ptsYd9zj38dR4gNxDPI/+0njsiY6nngk89yH0kY+FPnEYn2J9Rtfn+L54rL36vu81qR+I/Ejqp8fIz7/0/MrQb4z8ztAfjPxL4v9LPE5mFzGz/2oB8Z2vvZWDYISRQw518xLOdZ6Uk3zpPlorh1dptla/RncKFwOCZKP9B5zbnu3rc/t3ivVOrw161nUiMOFz5BTtDSYt2zm2DX2e7coShybfts7whjns/A6D7+F0MHu208h7tWmIvd05TjO2N2cdBB0ogggcAIvHBNbQ2a7CpkcjbNgOI1pNkRk1S8cm29ecojgmFJegFUlp39y9vlMW7LNIKCJaHormJ75vnaXKdH9QuZJP6HOu49hPVvHeWkkERpA7Tg+u9t5ccLGCmvCIuRDzIiyyrEMXkQ65PAZ04W3bwr5VNRSt97Wufl4e7Q/Wb4qZn9Us8Du06B/4qQCbEMtD1YL4KByolQBWPwRy8Ip09WqWe1ES4j6EudVi5VIJUgUWHi/IPJXTuRUGKPgzdUy+ALOpSPiA8ceqSQU+KeRA1gteP1YFanLjBeugGJEbTBs8s/VD24CV7kt2Ed4E4ftTuYVw1am8A2WVN/iFhUfiqCGDS0HLgM2lS5OtbLSAiZJIpFbZK9lygqJ72W0Wd+CssAH3aXpn361evw8fh1tVZJfbx85L/Ly0Sm4dVC4McyK1NvQkBa+v65C6EClOngpxbV5qZfPRM5EQB7YKt5sWgTl1ZQa42Cos7uV/rrJSCQ99nh8SyzARJocXL3qAULVioM3GjyZbRiH2bYKPF2TGuKN11iYwLGwahVqddTIQnqgd+epOjaDs0UT+K+put3iBVSsw0rVACKRsltFkncpjXnrtPVv28eA8ee0Nx+ejhEVWIGSV47hTrhm56YmlnvtiI8/jh5wER2bcDkfZfjDHN+px4oaKawe/f8Ku2GuX1Rb5vVMoiwDlDdyx2bfWOmD9//s22Ci0yVHcMccFTjlNSCcmHHy6JpDLES7uityjF3QfgBT/mHjuLcLb2Ox7x7u49zaE9z/1feBDXEZJipYy2QbgODHkv5qja9EF1/0MLlgYXiBVQpF4JIsLxA4DJ2mGXQrv7IOY2hAnHDAJIcoE4CYcOMofO7pAbYZOGx9gmhAjxBK+gFeEOJUdVKjWC6ensdedFLhUNL6y5c1gClfeVFbGkGlAUeMojmrVQZM6UKKrW9qXHIg8u5TtMGl/CvNbuBBHFrCocbc9KFng+KTYQ5MAJ4UYIebzigBFqeBSna3oaTMIUt5HGSlouUYthwhZayuCN23vzj5CUNneA+Dl53DzVyOQ9QBdh5wcsVTKR5riXeEto4ctgAh98DBi7//YhrTM90L9Fa1FNyNWY5t6cYKcO7yhjXP1ehaNO/IbCnEBxMk+tjTwumaRf6TQSbTB11rkkyn6MLeAopQoNCyrYpSTYh3LbNFbEYFw8cb4XE2/ip1nfCYWqe+Id6TBZ5v5Ofs5+mT651WG53ZKZXpWQgawAGuhAAM/7GOwqqt2zIjEOxfFxZ8D/Q3oM+BxKNu+3KcT5QLVoL03ar8xB0w4NFpT8z1R0UgGwwjQPM0AeZC/4k/UQd7Kvx2kEsYq4aoUSx1oyRJm0lfpmpHraWtEuACHgdJKyMW6MN3DNVaCq4qEgshjLBgTBFpLwalfqJc+yV5iAx0DHVm2VQzLSKWVTAZDeUknfgmHlbBXirdKGEtfpSvX1ohwGIgSgRN572JyRCvU6BCnt6eJkE/k/m/ktg+z311y9tZA3bN6d/2lD8kOafkWOeGkUm7WaVevBpwo9lzw3cdgFgy8dgPmyQIy3gC1hHpMqXgSFEEREoIi5sromEcmAY7Nw/UgpQnCoXzIh6CMGmWkYNk8WSMYiqAICTFXVsk8Ut/41vB9DRAS2eg2+r/KJP8nme3lnxH4cNuSeXRBf/kVeD/+X7Vz7qvqqz5CKCm6qQU43FKMnL2I3u/OBSogZ+VXPrWbZ40LNqHhXwJgDTgiUNGMTejrZJl0IgXK1WrSaYNtdlEYc8gJ0y654Z7Hnnrto+8D3JVcFBe/VG/lVvlq57mJLbqzxVtZ2EmOJ3IH31IILINQIkSLRY5kI7aWilAptdtsT9/gWiFZYGRwKwKyCNhiQ1jR/fq/qFBQtcJBjRMBar04UK3iQbVJANW+GBCgQxKoziWC2iwN1N6lgNonBdSYzaCVbQlI5iCbCxINFgMRCx0ZtjbUUdBGBTLnZ+dvB4syr7mX62yxfb4cstf4/KfjTjFqALNXyG7uyLYSAqu6hbvVN7iDkKaBvENAmwDeJMgOV1M1oMbtYudNbRuoztRf11y64jK4N25f3FjcaNx+g3fYAQ6QdsqV4BdTkggBXPH7OxHYrXuP5z9kHMXAcVNwwPvCgwIDAgeBv78L/Z1lGH79B6+jZuA/l5D5/wP0qomN3AvAXwDOf9sDWKBFwPm54HgUuyrvBMMdL1PkEQEAA7KZ3+3rHemgM7rp6qDgPYNjpuj9a8CEIeNuue2Ou+65PwD+NQj4PAeDXnrmFbnzYEKEChfJeYOLEYfMGoUtOw6c0LlI7+9fNg53Hrg8HaehddZhHbzw+PATJEQGvmwiYmY35Su0XKky5Sqt1qRZi1ZtTlDTmQbUCSO8jJMu6Pkd6RoKg8YXXqXQGA7e//AbTw8Pjk6Of0gX2JEd25STLrnmutOOOKPCRReYdM3Rc3N3eD967Y23buyiT775arfP2sl2gbeiRXCB4C9f/PBi5wFHzzDospa7hdEUm3TIiOtPQemp73uzov5xBYc8Z0txylGWHubhuJxVLXs1q9i4jd/EmTd/CxbNzVvzWrYhoTXW2mCTbSPTf6+/9AAul9UBXxZV6x0buMHxnZhyOBym4/VhOO6fW07leeysdF5yoXKNa8qmbvrNuFncrG22NwNy5Dqo1++Newv7NJbK9LN+2a/78/q7+6K+dOS2Nl5j0TKbASm8A51dctk6s/OWpv0JDArDhcEQczi5wzupMwkYTQ4QSwCgHwZsF4L/v5eFrj//fTv78ysAvw2BzfVb80M2oF2kL+0hfFA/2PdQ+GBi5sPnMzPPAPjli1vOjZlzM/KZZwD8yEMTwHgo7wxrgC7BdI288boB7xk91D96euSeJtV3+bqVqAN75evP9kvDI0EhMOo/8mVwAxzkNMSx53bGeMcEF8EGLsUDQeAQBOgqPQOOQgsJZBwIFAQNwSx+wYSlMUEAtI51wkmnnPEBgb360AUtEld29foKJiGVnb8b6flBfQ2JQmPwhaAq1HFF+zMAXyRQMKOI5KSv3WAefnen+43376JYGK51HRcJSkQVsjLJsZjHY59lOsf6PDtMPk1X5VzjXufd4P++m1VuOc+w6Gf3pF3uP759ts6oXdduHEypiuibj1xWOkdt88Oxt16/7WQv+9DCJft/Trb7/l0ccDubcPej8of3PD7w5KCC9dRDP1WO9sChoN32x7e1Z0+c6vhcz3tqf/ZY3OA3WY1Ho7pph6cKEpkV+u+BOJ/XX568Pc0/kHvYyyzkfcXzmxd/UTrmJ7/4xn/MfmE4NpyPTnAeqWzofY+tgeKk7SP5CbC9hprY+Cvca/lIwczvj1ux9XceZL4gJ+I4SVv/iLzbgj18iiw/ZvqWL9WdeIVa+z9IdTtqzmxWOQc5A9lmo55AYg4eAE156aHQuXgDLD71U1ni+Gx8+Dk6c1V3+oLDt9Gx+a0Sq4P6qlr9llQAXO0503PqRBSLNwKLobA3SAz4fNdeEN3GfME0/kz0ZViJrczLSXoqwFMyyOF4kZLHg3DoQGvPhc/9iK8BpoF13wSUHoTx5Knz4tdBviRh+OYLlOMzvLnzK6Q6K3+f97J4aCJpHcS8X7WHKVoKETc/xHumEoiimsjjA84XPaFqQex4fTSYIIPQQutLoAFK/LmHQwzWi3n2D1Ot2G42lynYkUerxQXo+XLiODx3im4nhOK5CENf4AbZBlrWuBtURiC0JJCOJkWaYwbpbS08s3aEPpY4Ks8wRivSESvgcXUjkTC/1MdL/RxdZS49dx6BQnuZ69XIIwGUEgk3ozY/9zQkxEk4GsRBj4LQ6jBJbw3NP0Myq7t8gdRgHo2t41iwHaWHiM5+eFJsHcStoyulcg8tttGgEMWTMTXfBqqA8gTZBrVQktcgdoE9kwxz+7Eobhe00JlWJI/NnQHVz3gVJvAZas+WpLAp7zb1ucRMVzHeu4UQayOAkI09iDz9A4u5gubjiNKgMbEGIgXKoAxUv/XGfFpLN6G7/G9GzguvBsnjUIh94clQ4AM5KVr00CaioI+SDB1yVAzQZXjPHKgGvjxzt/htmYe/a11jjht8RPsLM6EOjYr1CNYn2IBgQ4KNCDYm2IRgU8LYfN4oks6wYF9GlxqS96Ep2MQNJfNt7RVmIVjgLJh7FkGaKhHc1r41FxZ6KEhHEzyLTaMFeyyaF192x9EFQeGaLdFKkKCypdArXs/FMsyxTKBrwAlDPzfJf5F/rzjbl+1tWSq7zz3LoUZTXl+ZINvat54VqO82npVgLoBnoH2sQqFp75FqTSEDq56y0X03VYutokvXmQAN/UqR3VvPPasByUH7nwjWJOSLi26hSk2HJ2JNkEP1vkKgBginuJzXD7v5Lv6kF4sX1eynWDxKIZgIqG4AeQ5tkkFmo6oswB29BmU+Nzy250Euyrb6YXLxowrv25oEFjZJPmTYXROovRMUZpi1YcxD5ovyhwvf2oMqAMAGpWGNAfDG7RdwfnTcybNW/hypGL/wvKx561l3zUWgwT9nAiQEE4pdry7IPKVNX3I81mrMVRf0A0Dkp26e2UmzjYqXNFJQ66USBfBX7NlAPZdmYXLZrCoebcaKjTDVGlvR5DCqSXVZKDsvPJuujbEfZTNk8PD5bwGrHaFiK1jjHVGYUgCnQVaLAVmz0D3brIvWLExZdU2NanuL6oK9HSUYWEiHPxFTy4Osw6KFb8x9+Cd1gUv8MTsUQctu2FngbEvJkC0uvovLMqbQkzx4mdysWT737Pbz3YNCYOcmK35ce8Gqd2yfL8nHplqTjgNqJ+xHWuTZ96w4gB565k9WhokxR2FJF1gHs9gWGWN3diScBjPYmXFxdk24DWayO+Ph7JnwGsxqb8bH2TfhNxgXutjEhCDXISZJ21LPsdBWeBznvEfKici1JT/M9oJRalKIQuFUWGYuQoIXr4Ivhx6gpCM8lz5M8DIWvBwC/uiIyBUPE3wFC14J4ViVpxoDh77i9MRc7hha+q7HvLH25cktXgACIGYzUODu8YnSeq9UQzwGXPRP5zWf71+K2hVHXsHlcxal9a9VgsxNzT1PxPexlOHmWvMLSDvp3cDRzqOVLQybsK0qp5MywlaDvTn4pk7ASj8KHSI2jY3zCbY6FNV59U2KVQ//6CQ5O2J6XWWXVnaqObsDzRnlxKNDCmI9nSVTU1IyVBPdSqqQaziW+TTuUszAJTaMmsPFUYHLS0M7a3nWpPm4zDDdOFABr8e+lpEGPUcjhjKcMhvH2eJ9QqRpMo6WJ+iwLmpmJBvmE+eSZOi6QsWpq+Ji45lAl8vsvK7TpjQrpNhpywW701JmHdKBW6jmqVaCg+bm55trTXrmrt265YZushpXfjWvvjsJ3x+ubVxdu9bQjbVNu4+udujcGKpaS5xbLtAujhqnVtjClrbSa08cawvkgtV73d7Fxx7t5XhF34Wdlk6mTkb3Ho0tG+cQc+0Sw13/zlWVxABXj1dLN3Glo93TNEIoCPf/EbgutZSbNi3tL9G7nKniZIM+Mq9qi7xdBTNwPQ23xPGacOH6AS6vHu2SLSxdbEyjpqt3YZSgeWMwDEFVZRaTDA1y0RNLnJu6udN2YjVbYrYzXqDjCTzkREHSn/i0Sm6X7SWHvAdYouVQ7ikNpVlslxXCKrzUJHZ5D4FBU5g8ym3e5NI5Exd2o9loAzsszTzjjLZ/chaMUqvmmSCrQgBd4Uxptb/PWrPW7l5sjoeLC03rrMcQK/4nX7QbeuSJxQKZV/SZBOhcvvpw6RgFBlV7F648uaK26loF++6eV4B9tOauF5D5wqF5oPPo3Hmw73kLvtkhE486oDljq24Ty5TiZrDvMC0bpx45D4IodO3i55bBvlWrLgsStk7N4NTS0gmg8xJknp0dhswkdP3ECd/ipjX2MOZQhFuLAZqCh6vMFkEmpmLUqBo6siG8WocN/nCCGBSs/pNFFWQeJCmXSaLVvfSx5bVgPcbDA1Ng3+XVVbBvWTzQeT5TFauhzSFsAhxKR7j6EK3ozRr3STI9DZmvWHWtqtDOD5ZfkmewSFxw1+oqr4IJu6N5Z1b3VVgYo0XZJmA99CAC6dFrLrU97CAp/aOqGrtShSwOAqhH0IJfJ4TVPlyWs9uR094zj6UQLXEaA1SQUROoj7kQSGuvR3GwvQE8U4IIpIHU9gcewgbFhqUPnotMdcs0xUhkWDfS9Jkz2AeEoBAKmRcDyo4sQlD9UvT0JWqJ0eXSsYsg+OSCUBgXEbxtpB5Gxn0QPaQ1wCOeuWrUPAh0jpxYhkemQFDTDJHcBKLj+5xNR0Gw7yKtZWSCcgboaFKbR/dQx487o0dmoXCAGviPIgLoYhR14Wz3WGR8Fu7aIMzIEAAgJxJARW+k4VR0VmK4tGJ1E/iqxyHjeKgRFX2rKnjuLtN+WJBSJ8eenYWgpM9GmJ3YkYjZGEuEY2nwcBPwBFBbZuOE6mx1/mo7TD0BUpex6kcpaoNVkNnlXBaAfff9m/CI8KGgpMoN3rBobsj62L5CEch7NsjwLGS+5JG5ibMIEmrMULPRTCKrSL0xJhDFIAQdKrWgHoNgNcdkMTEVQSHTcn4gjsAzi57HBFtz69RVOCoVK1ET2AKZD6W0R1lx3WcJQMcX92oZ2kuj/A88QN234Ewfm8YZtCiscCodIRanZq2mUsg8TdINj5OLpOKhJn6JuJ66k+7jCwFlGxkdGCGa0gNiAnijOASPzpzVGdyT3LRSX83ljOUfeDkXMhPYd8wKLhadlJd64caLuJkxKwQNVxebW9kyRdgWAlsslhtWXau6p+bmTnwQUeojTMsq2Lf8cElMLtwsfuVI1fIyPNCJKf/BleIz33ANL2lzBO58zQ3KBDCBtowPj8yVH82f8gcRE875qRy+eJ49uYIzU1Ffhidm7MK0FmBV9uvRqTRAKLc1siwyAdUOT19YTa/iSKpgMygDt3AHy8sGznQIgWDI8DjX3tXnBQhZ8KjpZ4mrqyo4fGicjFxcFc/sdWbVtEokbHDEEzUlZpnP3ov3dU8TnzbZU3SORKPGsjdnmPBjIt4uKlTSL8UETyyBIKqaOeyMQEjkVyAZPNIH9i0/XYZhCEbThO2szZwgDMEghhNYUUImCwsT1S5YGBMDhrGJAwNTM/vxWETcltmUmhxRwBUbirka1oJ6C8Ijq5Ub8hJWvCNGyE5RexO8iZlzRrmkv9hyMCAW2YEdmtr1DKNg34yTamjIyReeK1EDoc1J7HSDs4f3WnXtO7LYxOw0r3Picqb2VCON0lxDCZtRnBFgmWEy7h8WrvaCUQS7JsWgQv6kw/awRn1BLp+VaprziprABuF3uU4k1LQ/b5fe9kaGdQZMQ2YLkgZiQrkJFVl4zMWG5tBBmyDzrHPR+BEIMh71HTxELQD7ZmiFYzPNOP88Holmv+kBxWiq3N0iqpaJKyLIhEqAGeRpv6xQkxHwwmqGj0BQc7fmxP6RAaDzGg3OHZmFzFTlUo3iQtU1vm+SrkEC2mZBn46Agrd1kdmRXyTQuVLQtZe2WlVrooyJCPgk0Lm8fPVqLig9OX/p5CfKclkqrijHclwnlpDzFXMsMQVzYwgdmAJBBFJm6QVxGhggmqBaKvPD1A4QaSK4GbtAMP/0oek8unQe7Lt4+ZhV1/Er+X7eL81DXTkGDY4CRIFYguamoJiDwsSOplYeVYc8fgFOoNNTPgm26mojqtS1EphQyAShkBmAq3TiLUAdkcBPR7RlemxlBvb58DLrEyOR8fHOYhQEsD2GIVU0U5qVqvsUGx5BEMU01eOHEmXTte6SoOK2Ecch16NUKxOPHIEadlEs3UP/Btdxj12+b2xO0Q1x1ZpfABdWtQQ3tWlX4u+xai00QbN8J/XIkn9r40isdEwqZsbGyGWnkgxetdKGkBlABwbAvosN9dSHJ5o9a1Ea+emHLuEvmj3fxZ4eEBMXZRgdzXYS0MYrv3w1g0x4tPKMHWPGLhcu3Uu5DhVVcOryPsDRUOha3/LyVByR0NVVSMHGfUcRxKUR/P7TIbsXi1ajMY/cBSHwj4GxMAiTyzr3Clpfp6PN44MFNnIqd2fBTYh1YULUqrUFRkWQAy2zRxhm6a9RoVBImOhLQHPE6k5Kr/X3B6OwidT8SomWWVcl8EnwxT3RBqaxR01gn9VQGHT2tmWn0jE9XtIYZoCJQUM4tOIk6fYM2/AhZejhfSK3nLEx2oRL8QQI9r147FmhjlvoQTQkdcw0DVj25pQ9QjEOzIFgWXbfjSJr3FkzDiGBnUZOg5vshSS8tYRHjhLrsTAOGd5HbsIg6PLy3aOg8wJktiQWvrDUClaurbiowb4hmsfc+fM3h0/S6JPM+eH1Yw85oWYnSLmXNjpzeYNQjokaQq1s1DxNzfpi1TUH2OPEG6dWjKZPlO4agEYE9eJQgxJ4KgcHScPDZHe23qgZgSaWn94F++5fPgL2weA+y4sHkPnepcOoefHIPucAbHn7x48vu8/PTVAeMEv5o0eXGiYAhIuHbcG/raF6idAhauIARUnChCYOzN+DzA+uqOnQ/i3wsSE70YJApyRuqCRhYjnXJbt/+QYycQ0yv6Dvuw72PQ0Tnlh4A57p6pjjlBKKjkDYL+zIOBRWTD429xJbDFp3CebFmFdhtIHyhtPmssQp7ZPJc9XYhDH09QcFNRCCbxk76LYc2OyIQigWHlkCMRrLkPDFitSYvmPOCHY4iq6+nCg8Qkt6vupRixD2LFtS8WU6muop60co3nxwP7LyeQ0ERSDTWRFm+YMqXOfMzBAZgcwWy8cIiAyPH5mF8A3aiVUpA5y0aAJdb5LfL9e3gy5WkGf51AUGkX2NBeHhefttqTkarxxSaPkNC32ADRDimD+AYEYcBDLo5ROjSomurlosmTG69xggzi0vrwx6bY+srPBpsfQVw+QjFsvqwJhOH/0IcZCaNTrhKc12LpJN7/EtoxjlfWUD0oyTh2jZY/t8kpQjD0EQHX8CQRNVMgpZg2sOS0Ih8yVqM9j3mKbHwhg9NXB6AuxYsdpB9KYiMm+ZvmvhSmYCHQXHiaU8JoxrjOylHJ0jwqurnI/1AIhycNR3WfwA7XquUo0dISP1lpwPLgRn4NKxcbIF4WO0yEGmjScFxqDYAkwqt0IUow4sWi/w6FuGJzpXck751YcRt5goAzpXIHg/dwLvGcSl5BwUcRYBOR0IysN97/kHDpiHzExWnjMLsq9rtct5n+XGk6j7ODTAvhlnBJ067TntVgCj0mGnUZvQdh6Y84RVyOw5n7P+KuH6P0TaS0cgeGSCMnzEB1Gi5zTleYnCwKhNUwMUfXmjGgzzryvQrRWlZhEyd5I4SVgvaixj2xJ4e1WlBnpexGqWl4V9l52ZWA1wft2DrGST79qgIzVB80p78KVG5lwXHq3U6Yh9K+RyD6wDBfsjWoFoLduBbygZvMaO0SYC1Q+HgprMFgRKcmgEO2gLPKouF6xUHqMhmjK+2Gd5FXZ05p457NR8b4Zk+WdmwFMztLFTl++3IaQCiyUZTrW8DKNn+49TH7zoBllePnl45Cf3gz5wIOLk6UGS+ySXWm9tdhci8rp/dzHoMCm9YyGkw03rdg7f72Gf88FiPbbIlZYC/MchSvfrVHCreydqtowtw2ZzGbhGY04UYfpQaeaiZQ/NXHYK2jTDUwZsEaVBcd0vioVBNagBUS2oQdRzYNXohtWgvv4hZo1Sp1XmpxkehtB8akOSBFoB2cfe1dPlsktHjao7XxELq5BjJFGIZbOL6nIjSnge2WkClShkmaygx796g+0Z2+ZA3MSyC/aTw+ik2ZWp6kYqEIEsCIi2q6pPPlthaxUgP16NRFe6N2huc8jjVbH6XqlADGL7TZgd8mxFqb+fmkgHQaLyXlGiKyu8tAR8ji/ejzTdsTxZxJlJvPgqUSCDqwgvL3Nm70D28MDI3BTokHbuwZWbdI1DsGuSfNoweOqs/+HrQhapsU1tC4RJCBcGwSIHqrEFJ8e4kMkuH1ANFcEj58EPpfmQCwNUgxWfSwOqJ86R5sdJZ0JE8EX4etFqSAOZ5ylqdMQj9+Ouu7rFe1o+RmEcgh09BgbwmRlyUhVBJjRuXdiBOX7cp0il1GhKdy4T5yNVoxh5xnOotQubSa0FFGyd3KKOjrg2RxBiR+s16ccnnevxsIsAeqjE9R2mqUa/z+HEQxCjvmOBJf7IEXfkl+4lqUrfsGRNLW9+IRHB8TojaOdIbzcFR24EPMyu2WIkH7kLggYYg0AaOjw14xSOTLkTpQiiZwcXcuTFjYx4UY/BvuXAkSlvVYu/VjR8B9JP4kl9dHI8fI/P3p6vuiXNnqEOjh66UJ9D+59IyQEKw0O+CFG7YMQ6DBmEQQSTDZflUQSpVXPnQRA98gCC0JQxIA2kxurxsENTAVxoVBUVIgVFylOuqOIEvf6U1/5z7QWEQZpDAhQygaj5zuJRcivDqkZg383zh4iqGyqINcsfoSlf8Mwtv5Kd8cqbHiXCtUzIuYFiQiopZHiufzs2f2bMGalBaETADDtReCBO+U3PWzEb5PyP0QJwjHiORckGIxtM0PRzIHi0KCsB0lr3zCmLsTk+X/1omt6qNkrHAaBzuFp/zhJlGWsjple5lQGnVixlH6Sn1dRyBLpvteLwvvt0OXB+5Vq9W7hZXlW4Q46tXr+KllJ92colQ1P2yV026vjgow6RIjTts5Wg4xaLZQLSdD0dXs6uE3YCL38QpUK5b9LZ8he/O26Glo8Yv1ay6TQ9SQ97DB2zs252fwQ1gTmui3QmtnPlzhpdAcbToUdXwkoQIaAOJYwcxTsiaxEtHdJvDmExY+PyTkpxSYsM23eICWxBoZZ5m5HTuyBzQ2xIrwyxrIZTF1oIPPbEw3oIAZmu7vFCWHgXG8NVxkHAMrMFwag12PpSSQ24PJujCkQgDdaQ57aTCEEoZFoq0q1+bsn3gZsgnXSXReMPlpdwz4YO6ZoGVSBF08WNhhQSUVDEdKGa3fgA6QSuBEtda/kMMQ1MgSC0mKXf98Yy23xduWhbGu1tK85jESwv6ooFE8ulIIECVlQtUuN9h/IcJozpsG2Zy5wVFQcRnzfqF25BSsA1nY8g86yP8i6K52CtygpBsADOZKnbFAeTnzX1iYlT5ljjV6HRSMXpqeAImky7HragZfkOjmEGejnZE1MB6DTGmUQ0QJj72EGKuopMlYGXztRWdFniNaOxa709S9TZcdoCRaMFUHDWYnE2r7kQK4cP8UWLgEB2GWOxKsTGmoG2aNFEUKOFhRc0fWw4zMqWFANdSrXGMQpMfUsSyxeLWwRjz/6z6sTQWw4IDKSIC1pVfWEgNEEb9nfCfXqVONQZhNA2RGYDGvm6RwsAAURwSSCUSGyeIOgVlltipxkV9UArLUkDfCjhAUwlOwfAQNi+zwSiBh9QlQII4ti2UScSewTbRNHnJ2+tIiMqjegCTwePofdExln9S3NEe3t/dZwRiJTalBlOR5RJNAKc0ISYpYqLR8IQUGhsDFllbYSEn9IvyMQJyrYjq8HbkWU/1bBhg8BF4RCcWOghTfTNjo5yTb2f/d4z0qG4BaoP23W/6N8/v2ZJ42L8ahpOQlSNNQsR8iDUqfqRLdnG5lG1U+inpHdTj4H1o49JhpkT2O8LI30J3WlyrFj6jatokZgavoawgXo+M0UnmdR1ycm7Ar4YPR7+so14zf5N7RKqyBDFJsLlQj8c8O9Q0vtRx6SrMTUuDCWDeNb33HoMB7AfE3548RmmtAz5/g2A3/Tjzx6E4dvmrw+zD5RSdrJvjR7BjwCS3WlNfooUnwqRvr26mxr/l+2pWs4vXyWY/NOSfcp4RWhht8Ouqv2BRRlhjbJEz3ppXmWSzKnTpXN7jUE8taHpKq/ZAq0fBaZ28AtIKfTC7Wcgu7MR3DRdwbvqya77mv4p+estR+vY9z/Ft+xNRqkFYex/iCqlmWPvWCMMLvdBGu5qdx+F349WxRwvbNnKAzKw9bbn89sPCj7qjhJFSfmGUOo+qdxu05bkIEwI9rQ7l04M9eY5zDfmOVrfpgwRfAddPcQuXFsg6XXYuerAO6uODQd/FGAo3vZezRo4mPr5VfNy9nyhaTdXx7K9WcpqupW99bDiHTAcMt388k55N14eOV8QFC/+dy2mQpuw1uXTmnii4vPNyNbx9iq+4cDb9LEFTMVC2nbAWQPEPv7batdfnJ8bHIfDiHQW6jjiE02QbG3JPFNHtEc4fPwvCmGYnFSydD6S2dBj4wIPVW1Mu/dWx4zuF9rBmbSv+g7Xht//xKl5Gt7oXBmmFkjiUt5sydZKkzxLaEnoQ92u+bT3wzWxC2Vtw3xdRBaUSpS4sXwEdx0j3AC4YBQi9S2lbl0MJFC6pOheZBmA2IhbdP19sfb3Edt1uJAebN7hNmCqdnrQlsAWsUsvBMOA2zHw/egSYCUMEwIADtsSUP0RrlF+trF1b6IhUov9AVMeTCJCvdZTrhEad2lKeBuosTFcXwTkJx585nXxW7r8mdu+uLK2tGbud8eHDngtH6yJ+7o+ID1t50VMPotApGiv456fOfZctFv+GwjLTt7jBQFLOfYdtPEpJfJ4cVFd5RVcq6JPeSbuG4Cdcg1/DAQriv11TOmZ78kcrcCWzYgs9Br0sA6Ttp4M5vYsj183jAU7O603kiY32LiRbYnsaZjV6aNqn5MWNhiGhQNRC0zBhrXjVPX1riOfuOY9JSU40m2JzL9/pPKomgXv0xInP7wt0RqQ7Q+W2aaFb1DmmFjpQSKHPGd5QzkGo/BI1hRjygq9B2KtOWSbd+AAtH4kl1p2w3uZZxvMtHmzbgqcLgXUN8LPVYfd/cSx5WDvc93AjOKLLZM14ff+54ge6H2es3tW8eWVnoqoE4XogEDXeDNn23HFH2MVkceL0N18XdMt3dZjij+A3Ufvq72lJ4saU3vsokqygrpCU2EXmee/pz+6Z1GYKf20l9CzkTcZxg8u39w/55z03DYZTv+qn+S2XXwsVshWVx11DIAdcoGezRWn87oXJP9MlAYfy0K7BJkVZ/K7T0v/HS8LOZqFdvNZN5tiX/9B3PVn3E/VjsNk58+zan9rG2pc1HQfFH2+6XAR+9zLv1btGl7dHdpOX0MupK7CxH3KLhaiDepMjnXXCLhFHqKnMiTg29pZdrmBbVbVyJb6Nz1O3vCUR00HJQB9ADR5/abu/qdSOOCr2hl2RT67Twm2+9bNj5LS3R3O0SIDAlwKFRNtTHELkvO2zMOk5TvElrpwi/b8ht3pLPfNw0xmFBD0UNkVolYFNkoqNtduuSzwdDxXOxNSro5oVyCswbyGLRkqwntxRNwMywe79i7Bq1em5nebi/m4OJ+AwyfOZXokcg0+QiFTw44IEaeEG72LfI1hKbKQInmh6HT3pgup9Z13jVwlT93ShiMcE0QaApNElQHJKX7l/KgQaUqYwbPIyxAC/MpelqpOPNCEHpWVVx7PjJaiv7xyE47Wb5iBi22d5xWYXV9hdyw3JoWFtZX0/jNj9+afI3Y3/1HgJyBgVsw/EXN+mAo/zZuL/DdB2xnEmruqw3EHULcrfUN8QTl3GMyyXihZPAwI12J4Pr79EYK2nEcaxoKf41qsJz3tYjDLeVhW0RgPZLBHYV7AsKWKxZaBSWMewRG9jOLkBeebrM6oa8eCnuKaUTRLJzoFgDeMBmYEHnKaHh2i3vOwXwc0YpNlH7om27ttAaqTKAD6xSjz1qgS47mULiIYVvSunqlzuFujm6kfEOwWfqNy6PuTDtFu/FYeRwmg3hVArM+yrJDt1C3U/T+0XDMFCGldT5avnyOqtMya3PnjGTfeOl6M/id1/BDRte2/l3RnVtn7Yyn214B9HDFnURxLnsyZpp4zfB/ve/DCTQwavx+k/AVIBnWut1ojTw+8Igx/2uJ6Bo1cHjQ6jtwBQsjN8EESp8o7T5SaG11H7xb9PBam48DlkgFnNcUxMaNIKy30ieLkBQuzvXpV2YGHM4br2rdpJ8OzeS3ECza7l7XMHhoQwlh3DHFV8Aupe+vZAxE2SwTHh1T+Px5Q+mnTumlmYVxgerg0RciL0HrKZaxKW9mHv4TnBUozwhogaZf1HDM/vMMzRi747S4jN6kgtJLMLd5X413zr1pliCqhhG3Y3miSJHZuz/+Gc3gdimtKMmVxYMbXP1Ed09sKVWknUosrTHoSHJd5VFfFKfCU8/zUrJjIss3wN5En19gRcOPNY+WhSYSj17WvHcA/moA1/4NLAyMjNAFJ8lijXy2xhdMEo4rQvDhNqWKXi4phr1MZ9OIi78ju7YtnMtwnEwbUneU5HWxxepfgGJbb3EzPgbx0xwCLfjZal6QLNOJj1RvjssuDR7Ro8qst+y4X1RRsEocLovhFdhBu7jKXwhI1yzVdEScLE5yNUeI070hBts+nsxTCSyB8MxvgheTME6UEDlQ7ObZn3+c2arXMrpqfXOq9FWZCFxCaS1GW+keltunUAyHnsprg1lRRsWxs58AdWScg5TUds47muOzFyyrJTyr1jerevnRaamxw+wpd+8UltcROC4OAVFL1uv0nlMDZv3kO68sgNNo6s9SfndqGqPtDTuJ4hjoKN9gum1a2Vf5N78RCZYm8OXrK9bcxr+2pbR/SPjqW3+q94KaJVxmOlMcfsm8c8067lOkZndQYl17s358ucC/hSDO8KbAoLVP30/S0CLfp2p3S7swt0YbSqCF9ufBq6/Z5uCRvPDtEY7Up6WaS8PKWP1pLZjtzNuV4tX94+vAQYn8wURQ+j94tC7QDGE96Zpew0rNjWuzFo44XQg3RdJ6n428h9T/fYebJjWGVTlxkS4y+lDWco2CMJZizm+pV/WHpKVUBEoFHuhefXbld/TfvFgFaB22s728MlduomPyrQ7oIDWsJcHM6J76ojJUUnFNtjtWXhg+hPqdnFyT3Zac51us+mQQUdZ51Jjf48eSQt8jWiBgwxGiirWQHlAequBvzte0h8niWg4YVzAyQUpq8s2y4oPZfhw4g3updjlkPkL1gEMH5huf7ypKDy635IPI7rvEX6xW8IFLv8MW2A+p8KCKRYCPfDSJ2sGCwumqMm190Fi+wcsD0vs6wRx3IyyeeOn8oi99jq+3s29ZaG/jB1doh60ze0dD1ZhkwrCSCjXg3mAmqEjfmattCpAlMB62fJf0q3l9MyvRU2XAh9R4MNKMXx8pfn2Wdut6B9PB044w+taSFxMtfQfat66wNAsHnqNcNHi22V5OPCZcQbEW77TLoAqVne0aDG5nAlCeJIQ+whWmwDDlAQmnyelk5ID6apGi4ajLy8sI5eaRtYHZS76kkxzXF1KUG6UPVWYoOFwXX6eSf7YcyscY8kw3+M6UENOVhwlG2zaqkPHmIQN4khV90GOur9+UC1KRXUTa7Xx1HTuZ2Wxu4yAV1ngiYzVurCNTKRk0Vc5wyx3yj9018FvWrmp9Jmc3MTEloha087sE91pmJAXv1qgyYO9EgDiVM7znZSdz9DsqmnyNz5SXYitCfWJWgT3d+j2ex55quHg9y7bc2I0Dkv3Yk2MUqEjH14q8kp/eLXCIpd3G9J4X06/2lJJL+yhU2jUTiPnj/K4He8QHsDS86YseEwM9XYsZ438zJFTS9DnyAXILRetJ49DCp19og5GAW5wyl7Ii93KbiazNOO1yS6cnt+AFjFK6VTK6AtVmsG/G4/WIc0EHa/QbcgcKV8fatBGb0NtCk6Tr2rnoSaScSKS3IlZ/v3y6yR+kgZkoH/dcCgR8zMa4TgKN+ZUv0bA0sTkopDhQwqc14ve/Iq1y8WCADU21Y+zut6yQs0uVDlMKyfJjSwxLN14Y5hgaWJKWUUByb3ewb69HryQFQruYVtkNHsJ40uJHGXqaqnv5is3dj//NXf5fuDvl11OlxD2Tv6QkXj9jXJ7AF8kG4lAt051MBau7Lvc4SG71iRBau19lkrPeAIcNU0cIKblMdLsKHRwUZjGPahiMuVURZuQp2Fdpl8gbLq4a5edJO6KUJ8hqaB0nMGzq7wKodu+w3qn6Uws14uS8fxlDxwClmjDAfIhnWpwZUK3EbEci+bT1zND+TtSPWgrJFztZDrNxVLNs90rtBqLmvTi6MVfAdcvy1ILYVw/kkTQ+C1rIe+tHaryXY2ud6wn+1hibmVZVYGa3XMDNyEv4/ZXSKQFm9Ng/you2qORVSP8iDfvEE4je/PL0wjsBjBOgw9m1Q/AfT285tN0m3rf6ZqyTnMGoGjop6s/Nketw7TaLTQuoxbAU3KnUGrbN3IzOCo5Nd9WHqivub/JHpAdEyFx0rW6VzeuU8UddDvAK2hsQlBUpNSVX8co/kGP8UkpBYKghoJzETw51S3QUordLLmfE45FawNyDmtTfnzUdXINuYRl6kNprLztOIm4M2k7YDQrvuOQrdkZeVpXPhxBq9kmQBlXJpRF9B+SAvPzKPWgIQT9YrQEfsXZpTAPMbecMMpvvd0xHd0x7cpvqi3HClu9EdEBiie0r/UTRebS4AM+QWyqpOhcc1eisZnBOala3odEmN//ek7VTTGK56lPrBKbR/31nNvgw4PXmPkr8/fnhj8UsCLdnjBoW8sIZjT4t3PE2m3HBUAB8Pndq8IzrXsdSgUWjR/THD77NCS7jlQcXy4gffXF8ikIJptjD3B9J7L1Aok+fbFIWy4JEQ46QC1OvXsj/oxvUGOOW+Dxtyw1+wUgA9wp6e/uu/vBDswkcM0FT3uA1GvX4P4Nanhe4XhwXaP49nnj0kK3HuPyhNbj4ujAT8NN1HY9sO09+TlfTBaxBbd/xwgwBxqMnf1PEc7IK89VRnflFef4+8b6m3pLvsDulsUu+J5F5so39dUgDsn5ohbKGlWnG9TiRkskavuqRQdYgiW9DtnJ5g3yt26LQNDzYv5+YmN3YSw/ZRqwjNZ2n0io3OGTFWp8i0z+EA6ufF2KXYZbA2qHQtrIzAC1pcpuglGZIzk5o88y+zSxPKkQwwHgJOtcoaU+HeoHl9f2V/sbxRkdnz467YhvR1LBdaFjVu7Ex9ZVYs5VWcpho6NqhPeSkfSOhvTZG1JKt7QxeKgjDyKzx9q0LenHSnkUXllbXA/makI+JAlEv5Fa1ZnFDdnKug93S9/1Z1Z/Q72FJeZasmARP3iZENzJkUDaKMtuDj1iVr+fiyoOQkP7aiNQkxB99oDiEog3gyn4jd2DhpcCoS2Q6l7cCfCSuK8eK6U/yrTxU+G68dq+ggpmtfF5DzemNutP11XjXC8kem7vTm2DSjb490Cv5n59HL6rKy7QUe2QnZ5wpMvGIPaYKPmu3nlBTF18SYQy95tmEGWnYqCYyS4oy+rSCD0hmbXdKyVxL/8uJllRra851Ed2/rsvWS1Bw7zb4wDypTTNT1mk+VOLA4LLVM6Y7Ttv3a94p8XHhL7GBws1+BWznWQjne65U1fktMClNyqC4us1Syy1nl6eGflqMW5DHZnLxg0ZCWuR5r3miQza12bL0Z5wpE3/GBta0F4jJcE/1wF+v2QsS3UcvHrPdLNIDA1kz0Lwx+/bcCp9AgGSXpvnuIRedb5qWLic+SiiM7CnN3xGTnDQi/CBD0p+Ad/v5lr3N0mMI1z4XvzfpY51PmoYuMR2Tc8Ea9ZnOkStPHIQD+o1AxPqF6efFCGpGc9gSFTU/SfZHdoLBp5m4D7Hd/5qTJmenpmJTr1O3s1AwvY4gwWC+UpLOF4V95M4/9Iff/xx/hqeI3F+f0hyuytwjXh8wxV+DxLs/+4fmL3bycpf6hnwqCUn0ErCBJdEIoIhMqY2SJoanRLrGfSV0jEsr5cF3MiB3cV0uXYteguhwjNIRbiwH7dwJQFb9Rr2kLkTP6ttgjVEveNby/kCplZtvwbNe5Qu9cry7Ttwfou2xNYJTkxP9Vg1tDQInHiUnRZSvSVd/VuE6I887r1de58lmCjd2maC8YvOumsgQIlvZtYQHHBH16DSJuNwfPT9r/Gh2EBDvJHuKR2JchUQXgqv9Tyzhk7tqXEK4Rs2smP5i91jywY2ohwb1GlfYEKxvBKKH20mmA7loDZPTX6embvKoTPi1QFqodO7nqWD/hc1yb9WS+fdJVWa0ro9CddDZ6dj0uwPlLtUtIctl1RVZW8H3DrRfyP+RG4a8DuDfyMdvn44bT4FH1TkwvsOWyoNLx5Dj9Il0Ddd7sOB80S+Gd1/h/DhE/YUBzml97I9SGwCRs1bZuCAOYhiYWYhORemxKZTHHVggvkXwVKCS8NMtRWMjj1isQ7+kn+w4rMxQ+b7KcfDm5wFHSTWl6woOf7lqA86F+FE/N4+LOp3bd7S3i1vRqbIU0ST0nU4htjOYFLzgvLEuj6HZOTcACkQo7//naaonfY38U6CS5KcD9k++d89+XLQ6G9zuIDQmDZqWNzGuKKrOOXtNx2hHQ1L2n7X6/iZLecswh2GH/Veb8M+duiPlpA7EuVGt2XMuzP07lPSduVyzg5YRMt5rtfBsuYzuRdwowp97EA7HOGHo/0VB5abD3JKFqvs5xfITxy4ud1jTdIe308pvgv2n7gQTYe/9bzKkbTj0f7yLWXdjtFJF6sE2YReylDSS2d1y3B/yj39stdFwzL/nwV0L3PVsZ5tfHCz79zsEM0GNSeVbZ+G2jNI89jGCxVzi+KL0pSBgm9fzUMUMb3BqL/zaX2/vZ+wV0brpQ6OspV8Vt9SqxYs/zEkQyf464Zo/7UQq4/jsXqvVMFHrkcjJCemBtU5g0TufJFXrkcdJDelRaU5jdumxkc0NSIswNF3r58wm66CRuJuBcdcjt9FD5zzpC+bELVoB9B8ftw5WTpEaicf7/bkDYp0O/Ws1MkPMP/vwl3A3E5nPkrtgNxPpf8aQ/3YDQazuHYyYIm1n7wnDN6NIaPW5TC/nFFG7yLAnYOsLeTUYZCLA1QQJIaVTQFeQfvRjJz/opAhApTGUoKP/3dOe8dQO/zx0kh/7X+AK5mwLDZ/r6PY18SiXhHx6gSE5PdeGtnf9L76eA60QHmfxvJ9mp+gcymUJ5W+NE7vpPULLyfuhsofN/V7b/rMvmd9NbCuCAEDpg6vGA4+e9AFRsBTj794s1/uFSXJ6qIVQ96+TjUxjlG1qv8szjSwv8Aff/bHs6u3Ab2yM2W2D/THmajawd2753C0A8zYzxEtQIaV5eXNozkkAm9RR0iGneXt8+IQlyfZNmA/02JSXf8QtoAijIA2ImL4VL9fC8N0/mRUaG6HhUT+ah+VSVd9K5AJi0c/7Xh2/AoRdWT45YC8d+Xv3otO1vq5mjZMWVn9dfpwP+NbQP3r3qsVYceL280ek68O4XSM66+Zp4QIwWWS2bLv0nRlocM9Ob1LQ4G/EBI/Dh84x3sR77vuaGw1mY4cf/AIzpHY+DUA3bI2Vw+l9KQponwNyycVcjtRi4NQjDGR6rZrlUXqU70fBzkGj4EBBF39sO5tJqXJ9GnMjEb5MVN8XNVmdHbMnN2RiSUTDP6+iQLLak+JmS1CUx/7JOPHO95g/mdhBPJZEaMzMaAnbBO0t+Pn/kT/32ljtJWzelLFXXcm80jk6XF1AAXlqrjcSb5x3jIyBzGUkeXGRAr+6Iy7Bel8pzd1J7SwR2K8CCosOmMLVendGbF45kHDiV/bltCkcSB4hZwz6Ai+yoo8G+yrbfKIFUHSFkL1tJoMgtPEfT0hnviLrsZB5zqJSRj0TYxJxI7OKnSBjemB7pJVusieDCSxbM8e1d9Hdt5eBt5hH8KYD272hsvBYLOygJmoX6tWs065TWsFYUj1kfYB9kdcOZdgMfT3Pm4K/f28J1q0Bg9wUZI2epfAxO0eJqP4XMzxgHBzdIVMoEReyTw8zRJnCaHiGVc1XxcmlodrwiJys9Ot11sgKL/bDMKkKMbu8XNNqLPZ7TaM/fL3J/fkDEvB/wEZaG2kVECSNDuLxIt5Uvf3EKouSG5oiybgGskjjBfToCoW2i4MXA8G9zmN1/uAnXbA7uzjb9+uMw3rbtSFagmAoDuflV30YBd78LwNVm5dC89//tH9FclfXQndKLZ3u9nHpi5ZHWwC5nErFXLvf58gTyq+8ebNqv/JelY8AI5b+dx7Ig5Z3v8QwZAN36txuwgXuEQmf+NdPMdLOzGQCVR2SMA61OG+5IXPAdQNe+e/id9wCL+SGu9SXQbr5v33oPuDuLr1vB1gGPMlJiNxmMG2OTUzbFGo2xm1JSYjcaDZtiU5I3xhoMpwegOI1etEniRm/SFLpbg6nxvmHFmwuA788vwO6l7fMbpfHnQ/5MC/JTxp71OodfyOyn28LY3W71B8TB9654ympqPk9567sOID0Jk2H3uezzBdY4Sv8zI9hXFXvG8w6HsUzc9QYJMo6HqvZKa2q+UrzzXQO4sS4QviEOY1TRo8RSgg0fwf7urAI8nRAbPuEj0ghGHD3ilOW3rasB78F/bBcR3oL4hfradSUJWVYqIMMniJZ8bxatT9AkclxrPGpd6xPFfDjBEepytEsOFxb4CMTuebF8epNXLb06LiKKx8YUyaWNrU6e3p5OeUHD6uLuSBWAE/0DZuRjbmk1btuzeZEPIZM1tpGIiZDinihlOJjseBJDfUDx2VbKTB9X5+R7w+i0RE1inGstc3NTi/jKBDxmO9M2GfA8dcHO2crq2293SMMkOwzf1LVNiCi3lZRb4u+Oqu3mDHTprNHfP4z/Zoc0XLrD+E1j+86IsErCjh2XVrY3IhCQawmXgVJQZEP3cOOUlLIX1/VYx36n0g6DNgLryKOlJYGf+j/dZiu2Zl4piGCxgYDNFon1IOUlZPIyn2QfTmlW/GP/Z9ttxNaMy5F0sEmpzyTWQ+RX/rigujIvjm9y9B0f+7/cw+PKBr/fCLEXjvoo2WFsAcwuDMGIPKJSI4Jj+FmR5eGETJsvyPbW3/KcQkPkzrpHHsECbkFUY1SBTGCQapBtet/QQDhGzu4s0O+KzMkbEbwP4fWX4R0hK6lzTLjCJd9F6Odn5oXAPogfHMJL8E2PVuf2F3jzgpAEHqtBn9UXgWjM8V8ASHw/gQruTeCU90iHO9Qe5G6ddjCsJCu6LSVbMtFTd45f7Q/w6gjd+Lq2G/rbtPrzW6/q2+qImws31AK/S3gSGBDtFHhWCVMLArhsIz3HW8nw4kkzAfEOnwcxBvIiChj3GYBMGWYy3Mfan7nR8tzWjHkygMdXKuJrT5VGe6geZLqF6eeUQtGC0wDpDSq4lNOco3pT0J2xKTQvm92VnhrRr61v1UjcvHmeHYkZ0TuKS3bHZ8vqg1PTfSvtjY7GCmVS08SSHyvdKw3+xZcdUZPbzhv25UX2Y0RhJEITrlbzP7EeSCBH+/rJusS5NdHbZDFhEpbzwq4olRN/I462CMxln3KJyBXJ24OOl9QLjjc3zMUVbPql/uyzMvqRrakfbtt0K6lxqyVBFvxHt/U+3+ulknXp+3e9zTlyKuf/+zvivi69OziwUP8gsaLhsWJsMMvS1yhcbZgYVyPidPnapATFBpE0VOzxHSk9lENvS6jPLmpI2R4IJ2oIdYDw2kcvj5iiedPSuvwDgtrmhFljgXCu2TTDz68Y4m8K4or+xuC/AJOdYiXVgYpM/6akFH9TUnqJf0KEyF58hOPw/wLWxZyDG3oP6C+wCgoush7rUeQcZF0sMPoZDuAXTRe+sHuAdcFgzAqYTN9WU2fOOBCoVh8M7M+orUvfFjiZtTH/28OjXxl7e78wHjqW9y3Agr6JSiZ+JfP2Ik+K8RG9oT2z81OtLTkl3SUUsG3yTcqg+9f3gtomaGn5462Op7l4IjfSO6rrN2hZjCwQ6V4CQOw/r2KMU3Ffm2K/npqfViBOKQ2scZA+ygBdSbj48BaFlTCwplSSUuiw2loT3piUcqd1Kfzobw6AnWeBILxBpWoIE4oawxBVeIOAH16PqBrDRcKGcJUqvL6V6+8qVUrd/P27UOkqXUWuMtXJyqhcZUDhvmCPtIfpzOCQDOZDZhpXLTP9UYZHSHC6xyOPdOF3w39eKnSPjTEyjo6OqLOHhj92L4qJYRTcH96WlTU8fNlfLGBcWlnBh6z87cowPhjuBdZOdm1ltDc+2L/2ZlL1hpP5sSo+zwTiHBB7tF7JKRtZVQWZA1R9qJjxEcUmw1pC+qjA4TvyhPWDmRs7EysDZeyHjna7Ea4gw/hJ9baao+mNA/HP1nTshPI3rZFB4h7Ar8pRsr40UMx3V4b4/8iVeG6n3JRZ14alpvoWcQLduftSPC7b7fYogLal+/2nLXGydBnDWvvtu1Wh+bL4slS96HBP72JGvbX7AfzfRK7/q0bwYl6CnJPStwfnqPlJGyywKXB+3hCqk0dXZmTyxtCmk/LKdbT1Hp+QuIBfUnvW+s1CbUPIQUNv3f4SIr6uIw1sTXgZ0PcHzuLZ7OSMHQv2a7bbqzGC0zqUL7omL7d2H+M/pKEz3XajUF8dMZbbnry6s/+JpmeNuzr8IcJtPXJ80OF78pzbf7ab7JI/V4ac5m2kLvcLHuFvYapitu115uuYQZM2Ivx35BqGLaRTTHU1HxdVZLZ4IbyYbKI8YHxyPRRKEhMieKX+aYrwKhshw+5D4FxVJJSvwhSr3qbx4R715qqOR2okweCzg3Jb8IKz81zA+GYgh7OfStkFhHyo/btU/vfbsyQxmuW1rVR+1lk//NvbjuFU2tjxGu3ftQWT/7qmbJkdcE6b+fJRqfwbghgt8T7QUDrxxFWxZfavrFOK8/WJqiJAcKPklS5l7ycvyhrUuySl22JXdzzSPh3wvLwR2a0rznJCVuO1j2dUB4fL+tLKTvHcntztzjUgQTuYFeU1gdioHofuO3yloNpMfkYoYf/3sYMxf7ED5Geqt4LrqSBaNOlyNQYqqi/FR4V8/JjPK6uX1fuYJGw3BSyjBYYp6eIYdurbV0MsBEfJ0Q62FYywVIrOYJ2a1Z6ujRzWbmxpF/J3bB7LxHoD7IBT+DbYHpSVGdogS/NukWgK4l9VuVcNVVSkH9vcssDPL94arTGyNmEUKW7/JG0ZoypYMoQlkdVGKzR+dZxgV4UopoGhxqHH185ObGG5pvBl1MAwFRtYxG8Pq0Yv4NoaWZbTLk3olRAonw477g1uGQpqERMbHKyREOa+AzaEXoB3awQjZyVGDZW1JevAgOz+aK0xeFNqh+rRwMabisq4IgriK2Uf5cgkLoRjN2G3+JS2GHVRyDZltJsmgZvDTAwrwx8sclsaAyReI7bJqbHtAcTT3/zCRgOM0y89DuNlDs2434pOuTdq6jtKxTXs1Gz/Jl4MXRvHz2Cy+FVhaVmBbbxnZbW+zV2BYkF9CJId3p1Zmnxuw6YzihJkS2xOcfgORO7Sxm5UNIbxWv9+SnXbC/yotdOaQe0Ae2HcpAn8Rv3+15GSlFbg8xqiJ95W/m+W8+L9P+8+R0wlbkF6/xBuGO3kxC4+5FwwWFKYW2bIDHJLCufzEhIXDwX5A2mYFxGpEpaK1iwYcVTOJc/+zYHVkcIoTog2JSGfERGTSsl1F2/bhEjS/I7vOcP0UAoTaWGiUJWdOtsqg5MQmBwY9p/tT3MtYCC4/uDYWuQkumItpf2IhUknSrPWLDwdStn50BR1A3gdUXnLVRJzS8NxY0gAfnjOaMSxrbWEYfuu4Yij/ayjo8DkZ5rXR2k2MLc3Mr9AG23YWZCq3VEQklWoDc3uVqdpgbrPLhO6Lg8NuqSLSC6Umke/27jmZno6h84jo+epRJeegO2cLPvurJgnatwckUB4W1sbc/eVViD8CeLS+qv6MFjW0RDEYee75/hkuwe5bX0nG3co7m4M5UcYGDrfbMbaNJfWd8gYWN5eHxgfkc/I8dEwgtz630nH7Yu6G0L5bAMAZEQACRIE1+4QHfO/17K3A+3H/Kc+p35Id+h4vjPVyhEkbNqGZ9Xssu+g+8k++Vz+6aA9a+o19a4Drn15B4i8cpnQfYnX/eYqHQus6c8DDuG6h4Nb+tcG4NbvwDXTkw6/eFzsNqXJ3/e05Ts6rnm1xdHdfYgT1zvI1gORSeuLWDx03dvFZ0+ft2i69j7uu7spN0Vc595PeZ/DpO8KfwenT5/MuiIDfw5FXdhfC2UnpR2dkmNF2Gsch5/00lNbttzObJv4uTG7JVcUImwpH01a9Kmed6q+caMYHB0vw1y/ha0+WHudtSenuTdzb+AZq9xjQIjUndOoKzyQ8hG+rczvZGFng7hbJIgR/31+OM2xFCph31GcMO4ylW6KlXPU/tES59xIg/bE3PDbjl1hWBq+2nHQM0vp6Sly5yZEq4t2MSnSzK6q3M1RybyiCInJ+8Y25z+4ODIQXU/V20sSELr7fH2kcMbJU3g6yJ0x+3+G5NW2c5yNMU3xSA/Ws0IyYR+bGypE3FDbJG9WApqlrgqJZSkYX4fJpF8LZCnRCIMVmxciUNMDt0cqQrOk/k5RMYDh9tjqSEMVPEevDSBnvJV6s5Na4jXlMQOgyobrUBAoEnmu6b1YKvNLcc7AhiZ3yNLMLvfsOyj3ytOmf/mfrCutIxouZQ5DOdha/b3tm88pi5ZEB3mt3uRbhvuVPnyCejcWdP4DoEg+hgp4O/N1nYFJTBn0EpQH9HtZUcOUtHtMrQ3PNuLoLj0vpw855jMwybmf/RMtVkW7fzfcgHLoZMjtkxvmRk44J0pUCtKGeONU1Tqee4v3Ua8z6R3LBEC1sv4SZqPuJKZR98+Hta//JYedHwCKaUBw4qZBd5OHH8mw4bo+POz+Yt1nf/cku1pzM4DouZfIosaVdN7KRrKIINy4wktfaZwTUuoiWimGiMRhtwI4o9BOFb9hfEUrgYVTik95+4vJhT3be/JAnV28zHC7dLu0iF4kB721ZY90mrw+NZ6RoAhbhqmyZrHzoaV6moD7x/90dY3jn2sYVqVnaZRnyFJc96H8Bze69B1AGgbikibxOyaN+I/JNYTxkniXzuYTo6K3Z5AkBi7O2gTHiY8w+R/id3xcwPiYPGe/xU4eUfLnrQnaMdsKfidsld+tTTo3Bfy1fnRxk8vG/zFwgX98Qmjd1rq//4PKHocc1XBfyaVoU3/iupM2zJ0olI+GDcQ3HA6tzInoy7Z1QG0AuOPP/9Jat0ua2Kqc0K4UjntuPF/tGSVpiIb1IR3SGLfcWDHizfm/N3WtfY1HorQ1TKOL2IRUKa5s6V9Kq8zeFptfHjGSk0htYjent0cJN3/zKsptFLA2SWqCU2H/RmmCt4oXpaKERpczVHJ2SxaLrlxSe2CWD88xBPFTPIo5/qRkMIUZJTAG81MZpXEB1GTfZAYvutSjeri+NOlQb+O5xHLN7vAibXRP+oGJRwMcxEu9LU8tGG2qmeLmZ/awtFlhJvGG7stA0EeTIWZUX4JG8qK1vvxkz0qxgT2mLW2NEkfrfPkpnlUbJOFESfx6F47YPULmVpseRhBy1rm6iRlssVt1I3z4BLlNokTHrxDb09y3GmLH9MUteIwVmWXo9l5VGw8giOPXuWIsvMYkGmCExK0GCE3juyZjYHslXjNQTFynWd9PNV+e65o3nw3tCJuWHz9ueueTo4WV3mmSgEJeenCtNEMVlxIx2OM5Vm876xLGk8RkRIn4wco4v733z7hEZbguVECUNtwbwt33Cj3I4fszDDFRx4YCNrgoD3rAs2IQkR77zgPetgj4nL6SHNN9Rv8x91evHx0+VipJjd5ZLMGrxSFdHb+PeaR6TBSxhfb4507llQfHUr0D2HY/rSFfJ9SQEnI7glKy6D2qQEPQR02Q4dc1P4/ZTh2EoxFpfZT4q88P9h+qVCTTHSbcfzinjmdOqYRz42KPXHAX2T3bT9IYMJ59YHEoOYOPTfTAvOewZtIFLhx8Ig8b9mF7dyDufsfcmZeKYUQ3ll3fPdaF1+xIpv64x/+pTCfYYw+8HcnUTzv0I3Wg+ztEBTMM3TFbuIX0hTHHSPj13DlyUzOU1/CXMY0+88nP+auXy9nynT8peRTZQB5VTF6hPEa4N0//c3xWbYI+uKHpJ4qJ3lvQfsLXUP1fuM23KE+vx61m3D9HL/HmP3HbFnFN0K39giFiFIGJRHQMPnuP+73yJOuYHSjSuZG5XGGnCAwXE0DhJqmbmMcTXt/hiPDbZgfdVmq/ZHZ8ECDwu+XYx9UsMH4ffIt6YPqSDR1DbtcsO2LXn78ZzWRjGUlv+HP8Tftm5fHrTjaqUeNW25gPO/SU+C245eT/hEicaMxanLyhm3Fd5/RyNu/MqOMEVFc8l4na07hOL0zit++O9yhoUo/eL2wdT7Ih9fe0Dnto0q9sXbtUlsCvb8fj4cwFDNq/KJ1Oa8E02pg388sr0rwJcvxawz5J0iQ2ybqBWfn+hkXx5YNX1ZGZJx7VOx5Inwfu6I8itpQ6hvWp4pTmRkcQ3yQ+eemt6ygLiS3JLXf/l7PEiMSw18tzpWsWuz7ldkVwb7m7f9nolp/EhuWZ5V+cUMwvmRnosBAXnC/oyMwTfdMYlvY5/kXIWsznwEchLfGLOTGZYl79tXUtLvOxao57dcuQ+zrDT0undnLDyrPYw9pJy7cXGlaF3JnItWgfITn0fHgiMqhHeL87OrJep3j6QpZ3FMvuC+CUYsu6PEUVktGT6zy3cnDhO+xS4iWx/PjB/eIBJ/vaJPetONquNIWy3bSM289pjs7/OT3vQjkfNg88/GefkuN3MJftv3zAleL44fnZAB5QSgctO0N76bK73yjj6gi0H9dGJIN7O1/60lelpwXMN2Wx64uSa4Z/DlaNkYeCD7/7fkynu/8cQKPQ3q/HGYrcfUyca+5x9Y+FA+5nTkZ8GztaKcX1TqHbNLQcbkLJ6vouz19WAw7oawL5hm7qSS7XuYItrou8QCccOsWx65wwcsbEVRfFoRMqhuNhxDO+D4KrTDs1ivgKZImeZlkVzuNvO4unloVqij2LBnoXSaS4q13gsHNpnCac9SdXu+g8CWppuIRDAFnHvQ5cTpGFbD469P54sg4tRQtBHM5H8Mk0OFkyZ4vl6ubDNbZxqbQO/7xcn3qpYV1V79vU3PuqYuY/EvaFBS/civifwPHQnAyfZXpWBXcwi8tO3HvhvyEODyzIQRFuG/4GgOit8hwAB/i7aXJYaB15if1KNHPRNYbsq12mKT1LwRXnoTn7jg/gIH5janZ/QEEF9mc6xO9Ey/y6FtCvoUD5Irn1c0surgvieVs/hwDcjM0o9l7iAveLpq+JR9l9RE7tOz0JY3vfJVzse4baMGZxE9ESoLTDiG9B75PPz2pgSQi9/d/m98N7fylAn5re/qlC71MkxQ70vWiZv9B8iqRinHWobF38J8rN0L9UuXdBc+9nqjL/+Q/qAOfi9yfzRPZdYeKe1y5uY3pM9wJZZCAK7Crz4FKBIuGFDCZe/IuElwl6TC88yiIDUbL0S4vA/u7WIf4xWubXtIB+B0klqRln7mpzT2OBWSLiy4QK+pa5cttabeGlpUj+GdumeddTzv199/fBWewltT4ABfm3gbv/5u8nZusmVeUX0W9dvJIHz48b/3le2w+V3W78mly94seX0Tf00dnrshkAbmpUvrQmZtZx85hpNGPwCiYitb2A4dUAt3cf/5V36M1Hsd0PGM6v0vszUnVARy9sv0Oh4CVYSrNO5y4igB/QdzC3S+4/Kc829Q8JLblSOxw6i+RZQ8AmgGGIPioB9RnaVQDbeGdLCMhvJf1FATRjHQlbQ7lZDd/vHkUMyDy8riT1+zR5DdH9LjbmnR4noLbPcGSOp+b3pv7MSaqZiLjf1mcoYDZuQM1NJwJqCXaZO96b+jMnibFhwUwiCCB1a2hkJZGAeFbKJ44eWQSQcTxFyB2zytD6DB3KFEaiuc+CoRA8KyTH1nDmSnG/c0+r/hFYkjC/N/VDEMDsywEVa2EFAFmeFWGBLC3lGx6mVMPXHNDZfjMCI7AmYX5v6gcgYU5U2rMKMI+XBAJALgbUy5QgK2m9gLWk9TxqPrO3b4dSZSWZP+DjnLvwrxtwwTG7t92X2zVDaK6pDORYLeWptGG30w2axOAi675O/TRQK2Zfl0i3tXRL/abBrrmPavWLdhGTm0tuHAuIzVZitxZiRYq7CI4IN+C9WuyYo7LMfYT0eKW3bqTzv9YnV9iOPektwwA5aWWsuuY5b54sOO/qUB7RLkfdVTfUMaY19nGfLfL0g7wOMUXTBTOr8G0y4PtDKn8qOJgf4+12JAv6ba2w2u0YamWmMdbBNmg7/XCMhEd08Y6/3Jc/RksyRkPBTIrcHGTMqIw5f/yc97QaTUnmBnMMnWOYSStY94sBnoCygXmbEvYwJVGzoV8DMgYKw6MmAlAWiAdAAECwm78hwl8QEsL/meBQlwDww1NFnRv4s6ig322rWuQzESjAACgAQADwO925AMv7AzzzUJf43H3kXhaXyu1BYKg+cQ1Qr3v8cwJ2tsfwIOJ6tKS548E3gYf7I8aYaU7Aex5wF0JREGv2Srq0ta4hzslw3gS9F4ypHLgaryaaNZ8gKQniynlcr9Yjzq9YpiXAYyitfJrMp9PpobteUDKrWVX6HsEeiiut/8vf+Ebvfr4AuVwIy7WELvuVK0FTnAjOKZ8gpG/UTMIYJXF5T0JdWQOVjeUtctNtibuto4RRlp1yErGWjvYYOddqf+SwR2rNC6pau8u0XVM8yunWF4OwRtvixnliX9fm/QZjH0l75EFCUv0MmoNv6IkabMKKucA/lAXAIOcTUi6QlodMM1iqvYQlvT8se8GfZqEcX3Ka+U0TzPrOwmrYqmeBHfaaCSwcJ/bVsqAYJP3SeZw4D7Ekd7UytczLZf1a++jWG3r1MBxjm2/yOpVyN3d88doP36v3u8MVgb14K3n3MfeZ7A+mauaPFsNlNh/zu3GtFKyrDgzkGwszlv7QwRUKJMQ1/H07NoxtDMcOejXGsNT0xqZekDKmkWCp4CAiKSxN63r+Mf1CCHBMcHA0IxjUccqrEwNCG+/M2v53/WkuQqGgUAiQIrj5rmKIUWxfVDBzFdJoUlFUJ4dzLM4dsF1GhXzTsjLan/nkpOzf1BkDAtLHairSpAsZTH82VSedgchLA9CenZlInRcmS6C06yZfpR3wQSvyRL8N261VJ3lhKyGJJm8NGjaGY6XuX713FFOVZjDfSfDLF/f06k58RICFdNXrRkSPBI3jcgpVsqXYO4tLrel8X0IQN/DRDspbSzmLWMGmu8tKtz/WusF/I1vuUoggN7SE3iVqeLCM8m49DEiP4RHSHn6GM0dAuPI8YotOAkC8I1oPuX/HhJaIR/dO8LsnlBD3UQCsp1wUIu8mnky4iLqrSLzTBNxZkZNUhPYFPLpo9B0WeJdRIe6zcMBjLgBx39GcGf3/0ed//4UY6j7M9gRM0fcczA0eVYGlqJ+Y+4qAk3AWHpzrfX/BowteS9YyvlfurIASauARRhrXobuMRnCeYcHxFXLgcdaRcZz/XReSp/Egi4l7kAbuQ9pSLe1P3ganpoiueiK+htXRGxCPV098BPbP/n0CBTDzdC0euIjMs0IFEWhERrUhidwXD3bsd2v/uG7C6YXACIrhpJExBWEpoY6GpWP0WtQv/r7+/1pncNzpi3zX55VIMf8bMvMwzH+DzDzM4xxbWVfkmZnIQx7ykNWllLIvbGLhNeJH5l0U9AQjFjeHl3CIY9Vr1KxVm7SILIU6yCRNj1nHPC1oSZ8p2f9+qr2qZ3112zMiCyUVxXCCpKLJBZBhOV4QJVlRNd0wLQIZW64wtQIoQphQjk8I3EiSK3tdTTgWAeJBAoT5LpnNwJLb0J7R1yckfbMgGeCPgs6CFKKo9kXJ0LF1DtR/pXb/R3D+1x0MQJhPx8gpSkH/G/sHNcSQTiLsZq54Ymosi/U4jS2joMO1OVzb3Z2u1SqJHbdqEXHCuuBdAAAAQ/h9CAAAAAAAAAAAAIYWFwkAAABwccozTwAAAAAAAAAAAAAAAAAAoG7W5yvztCUlVIWkKQIhx8QUg0NI6oSS1ggDK6c+vpNAEUidBk1atOus68FSn+ioG3r0kzrJUBpI3VHD1mhE4zihaTraGXPzykKNBF0D0VJWc6VNIFnbrpzoOomXv8hjWEE4cUNkiBlRmWpWIEzAWhcwQMAgoGDgg1h9DraQgYKGgYWDP7w7ISIho6CioWNgYmHraMTFw/cA4VgHE6bMCtYVIiImISUjb45FS602SOAdB+e4ElvWACwEwQiKw1f2W0UbPds1ML8u26JDNgO7W+hzxQX+DodyzwCn3KPco5xyyj35m3KPck/f5juX2v/HFRIKGgYWDn44c2sX3ua/oDrgRq0xAVYX8eGd5eyfLsPDH6vR9Rqbbfv6tDQxGbLkKVCkJAYEh5Akh5Kmg4GV0xvfSaDITJ0GTVq0k8r1aqtP/3v9708/fIOFCVNnRppXFmoAdA3NmqRaWvL3UqCwFPEa+XEzL8zNiyFT20tRVlFVU9fQ0tbR1dM3MLyPUV9GoBhOkNTWywFkWI4XRElW9oupbRrdMC2CGluuBZU1cDaDECaU4xNWX5Ekp6RRVcg/2mziZ/+lyb8+K/34/qxMZOUVFJWCnYS0B1Cu4reqnoY6FqkXFY3EYMcNGt+ZkEQadQ1NLe1JmYyySTFtRrY5izQQPcOpTHmz9t5ziftTB7Z3KGlHzbt8rQt0Kr/QTdHzv77cZ4/rs87tiouudXaj435ry58XQBUN0qekrKKqpq6hpa2jq6dvINR+0iIVgLs7hzChjBtmVoYVpMpOtQ53/9LwAECG5XhBlGRF1XTDtAhsbLmyLSuAQggTyvE7yBMlecppTbXa9A59z5i9a8cCempcPr0w1y6uT7QVAAAAAIBxW3ETcTtxnNdX8LzQ+YDpUNzq/bWF8e8EAAAAGJcPwAJgro+QKq6d4xbkAAAAIMKrRmFJK0UX6A8RaWghY0TUDVmsLwVk1QpNLZtgne7Hf9O3bjv0w2y241ZrxGsXkqTpKCRJkiRp2nGrNs9LV7sXAAAAIC9aAQAAAAAAAAAAAAAAAAAA7sw1A5yyXZJlqjKFiGoMYeRwRCQTKpoWg40zPL4zAYlMmToiYH+2pDlyJfPakBbCbtFbhYCcUg0CgSFQGDxEgLlNk0GhMVgcPoJci0hkCpVGZzBZbIFQJJZIZccbucxw9hyhnD2cPd6zoExzVpxxxtmD+d9Z8aq60/tlu35I8yfT39GvE0wo41IyrQdFTWP2zsjO74d1Tj+aW0qERCb3JYnIygcJB5ObSYhGdyTRzwEKhhXIrqA2SrMxZjhik1S8iRp6MgzsuX7X4XRGCGlkozTq1mZHD/8RvGzZJergzKVSN889oggUwwmSirYCkGE5XhAlWVE13TAtpmXyeXCYecgjjz3x1LM4/uP5f+6WQiUi1DU0tbRNTJshzS0ywFsOulug97u98/vZm8ne+ni3QXRLAHioPcnA6L7g/8j1T7iEfHDJlHKiNERGNkjh8m0FikphPoMjIn2FivY1BhsnbXxngkQN6jW8WLOsteXa6ojpgVM9sX/doqc/KeQpNpibuqMNWyPrNy5NXN50dDPlm7NIc5Ke4Wymiy2lZjnXyvGArNbqsmVycqXz8l3tWO6C4uT5f3H/hAs9BxelS9j9ivx9gfz3R9UIkA8EgSFQGLwiRElZRVVNXeMgFn3eamtHR1dP38Dwda7nJBQag8XhH76+knhs0jsmT1JOmNrSXpf+9oy3zZybVdg5NuaK4fHbD+uAssPQ941Gux3S2P5NmJotGLeQSFxi89K2jHzzFS282TKtrG9NcGxSPd9O2imfVGwdXOQQwoRyfMFFUZKPct2dKq3u+DFwmu2LtGqGwgkm0QIA3m+8zUv5k5hCCwAAAAAAgWm+17py2FZupFw6FoMNZ9ZYsc8ZdK54589duKOLGrF3Sdm7dljv8uCqNTV6w/QZLG54bEbNG0+YmHvXDv4tQgiDS+XW3VsePNf53t/er4PNtZxUbtBdQBAYAoXBe//F58A/SCg0BovDx4l6P2HophjaCmDo3rMqu2U4B49fAACGazAxNYv6vTAiccvQ9rLI33xFbajFeQoM3cQdhHIE2To46SGECeX4BeISueKJqtXNfP55P30gz/bwzxx7pcAlCBiGhiOisRhsHKYOYGq/EcvGBuZmj5uTXJqb954g7BXnZFBoDBaHPxy/cxePf6xo52s2Dw8bcjjMZAAAAADgpP3lgNfySJRqYc0CjciEIyJlo6LdxmDjPB3fmSARqq6hqaVdR3oPNPbES109/UmDJqNsUKbuaMPWyMONyyZDN7XZITdnkaaFnoHKhLc0PGvSijTgcrYrc+RK5eUTdUyzoDlpo7TQ6CK73PJ+hfy88PnRvwfUAILAECgMfhCZTM0PfCaDQmOwOPzDVzZi0iWTW8rPqNOkXzzDTCx2jnQuj99+km4U5FZjL01MzRaMWEgklkhl8s21QFseq2zPUt3TMbizHVc52RyAE0KYUI4vwEVJXrmeVUe/Fl7PRuz7Zvtan886qs9C4KqWIMuStZS7QWpgcESkWlS0Jgx23LjxnQmpo64H+npCrqunPynkKTYoUzfbsDUi3LikGaa3ZGnFEFCSrZSTq5CXTyNoQ1yIuEVvtS84vQPhxTcYEBgChcFDyPU1SBQag8XhI8RrEYlModLoDCaLHWet7eLx6W4/zvN5++EYL+48YWq2YPVCIrFEKpNvrgXK8ljlcBZoT8fIzjY4xWEQJpTj6wyG+c9lD0CuVjrftTJk5RUUlcLgcESkMVQ0FIONMz6+M0EiWF1DU0t70qJkxJQ2dZtNYtpM/+bVIg1Az9CaSZylbX4EC4+xKF1jbzevX8LSfVPbuVVTVlFVU9fQ0tbR1dM3MPwcY9OCQmOwOHzcGCKJTKHS6Awmix1HekF7IZFYIpXJv7u1/nzf9wE+v4RFTU1NTU1taieOl155HdfG24hD/W8UWsJtaEnVZco+0zRkyArJLd8qoEjJu2juifkcHELSZihp62Fg5VyJ7yRQ1E2dBk1bOmurs8gH0nwitW7o0U/qJ0NpIHVHDTmS0Lg4Ud90tDOi5likbZMuY5GmZpcqtDS4kifwl2uD2gQ5dP2Bpy+z4xEFjRM37hq6X5Qur+c6jW3oYywtZSi7XTIHBIEhUBh8F2mUlFVU1dQ1QqTdW23a0dHV0zcw3N9M+9Z3vr/Pu5NQaAwWh3e+3Cwi0uknN5QvRz0N+jf3462q3ZSn1LZzguv3ePH31VBxAN7nkEO/TTSoqSnz1LY6tFkM/Fy4rqgSmzhSGjO7y1vz4oKhJVcSWlc3qttyR9TwMEsoCC84AiMoDm/YTySRLRPU+7187YdxzDfbzyqy/1NK2LwlpsLGzV2GvLwLAMhPOwF5wRcbEVdloYaha8SaiZYzLD+i+6cSD+PD7/6JIgAAAODxC91adzx48uKNYRuX7mrrANw/QQgAwOZ9T7GQyKOEPMtVDodfc+rg9uuZ5ef2E/w8BzzQd4F1QFcsBGpZGk2S2CLmkAVe2ai0Y7aMYtSZ7AB7uiHDT8yAa7QFAo7uvRAYQTGcwRxLbgkkNbbucmz3FZ37lBHB16BatkaroVHJDtd011cIBx8wI625zGK4slFpB2z1ilpnsFPamxoM7zEJ85H41BYIOBaWQFJjCy0Hd3zllsf2lsjDFXIAmYMmCcr7SiHIvpJWolKeXY2AOPOemaqZr+98LsnBYQ5nqhwviJKseGK3XzXdiNvHinigcIxMAgAAAADwHwUYL+DLlFUFs1uEAKGiksppiftJgpQS2+s88VWbs7rqdfe8SxdWd7FwyVve3vHzia9+H0e9fObhW8/eRcN/jHEW3hBVFgkIDIGmLEqF9vXdzakn0fpDf0abS8Xk0Zi0SEmZpWxSxqrdKyF70OweAAAAAExMTAAAAAAAJqbMh2RmJklmkiRJkiQzMzNJkiRJkpk5BD/HFRgtqJZwVVWbVatJGN6e8jWch3yBO1d2ubMr7jdD3rKcTtnkyJUnX4HCYxpzRr9+Yl5VVXX9dtxVaMzsefgKFewoT7uvIwlxRZXg+gwGPESzYrDPqEMAAEREREQEAEBRh5/cda2neNfz+uAAmJ7s5OlnaqtXA9uca9n26xnwCahWoCGRbPKBMTKbgxZYZaPSDimQDs32c11fLh/SDDV+8y7vDd5OZVM53ehGJrmXtYf3iDHGGGOMMcYYY4wxxhhjjDE2XgMAAAAAAAAAAADAz9THpVnJyMorwAg7DWKFSlSlGBQcQpIBKnoSA1bORHwnARIySuqi66MiHm+Pr0K0b9HTU3YJMnVw5lKpm+ceUQSK4QRJRVsByLAcL4iSrKiabpgm3INxXQbluY4QAAAAAAAAwGH+e1zBuSzgcwoCAHCZMK4gAADXUQKNiSk1026lszw9fgZpM3Ymuw+zbWnneB2Mc4ha5yQQjKBTlk5FO6aK5F7PW3B30l4CAACAUNsjAAAAQJmZmZmZmZmZmZmZmZmZOfndU58P4IsVrKywCdDSMRoIKSAxrBm4wXmA9/Q8gXVQrd+VLRWW7NoVa+NQgtq92lopeS99O3b5sAGYLlSCCWXcMLPUG6TKjtHD3V8Gz3pnVj4bANbBAcD6ONDOAAAAAv19IAAAAAAgkC8tAQAAAEAgHQwAAAAAAAAAAKDUj/WrweIqiBUVVvWJSnmf5uCWeT9Dzn8YWv+In8Y/o/dvwUJF3dRz9emqh0PDJzjzDEtoQlLIjeBOCCtIa+pFNbhPLcYIDiGJhbo+gmfIcBu+QYerc7gMCRkldYTAa22iHLq68NwYKETkf6xuofuZrEBPGCNrRcGEMi4lB36KKtS0qNUZ2WPXwXnvt76OQDGcIKloFIAMy/GCKMmKqumGafncm/8ZgpNsQ5AVJsFeX/NekTJZ2eVLK2mh2obosN3Ts3ZKSZuwuf3a4QVRkhVPKrkb3chU6WXtWSQcAFQBVVVVBVRVVVUVACg97KigjfTWlWfFoD7KAkWWiAkl5kltlfFRx/5qSFKJWTIs2VG4yalV90B9Qxp9fysHfmAV2HCjs00vCFifi8AIiuEM5li0FUhqbP46tttSrV1vpWQmk7U9SjIzMzMzMzMJytukaebpkrRNkiRJkrTb+wO6o4r1noi4UNxjffaAlSRJkqRsAwAAAECt7U+m7Hlk5wYKC5VswW4w3TcklNS6Bhv3mfaWsZbR+EuhIJfidxY/Qu+FAiMohpNGlvgUS0Edjb90jLGrLIftfJ2aD7BQKREcBtVMhiSSStcw48HSFWWs19NcH+MedpmYsLMQGEExnDQy/1MsBXU08v0yCsabmb+FDxPzWQY7WkCsGBdVJoYBh5BEQMlB4lsCJGSU1BX6LAUKJyPC9OxOS74HZy5mb557dQkUwwmSirYCyLAcL4iSrKiabpiWz4n5j3aynmJPkdCbJJAMYHuCWPnsrLfxsaxLaVJKxY5Tdou3OOtbSWPBWE3s6NWqpKyiqqYel+Eb8zct0m8omxZ57F5WLaLQ1yrYXhAreq2yiLqzb1HzuLNOkI5hfz3CmMkIE+vMlvcCbjLQXuiF50WnyuJOFucezMW9+pBRDiF5x6lXt93ajxet2rrz1Yv+Yl6/ScBYoAAAMBUoECBAAAAAAAAAPCDDGyCTFmSRAtNoAgAAIFkMyD8w2KHDhmAExXCCpGiG5fgEqB26Q8H0o3cqTCjjhpn1J3uUVOffHaEAuM55X758+8nvefS/Wv+Htx7h05o163rWx1vrpEuFktXIgTG5J8DgnoQQAADA08vwWZKdFW/JKjpJxm62amgCghEUwwmSohmW4+MySKRxKppa2jq6evoGsQdeNWOTOAGZvGCz2WOfiL+Id/DSf8TKfm2/Fy7dgbWvX3J6HZZwk2Qp254n1OswTD04IpIYFa0Gg/3iyvdhfGdC6hif1CajTB3UiHZjAXFsTq4NvHzPOta7oDixKZS7SJeQ29VbfQNhzkEgMAQKgz9PqA+vfZ2TUGgMFodPWEwkkSlUGp3BZLEPZ03nLh6/wwK6kW+381qwdCGRWCKVyVtZbZ7d8XHu3iK03DnnJEkAAkiSJCBJkiRJAgAAkAQg6WeaW9oAGVl5BUUlB/a5N+64KqVcpiqLLMb1H6VOKcOhDa3yrgc0d9wbAEIwgmI4QVI0w3J8XBbhGGCcDsKEMm6YsQdbNanixBw3bi2nI0pynIwavzKnLjUAAAAAAAAAUIVnFg3kRNSoNnadqA4WGz+qKfpLQR0N9/d++PZ8TxmI4KroudpLjTFDWNTxZtTbGYsrqtjf/zFM2elTLAV1zFgWrGy1Th1jvLyXX9tf6+85JFkAAABeAAAAnIz0I7lKMsYYSZIkSZIkSZKMOadm3YUyXs+kCyGEEEKId7czWaFChzGEEEIIIYQQQgghhBDXGJpJppkZhhBCCCGEEEIIIYQg7ElPezaeU4/0So0aNWrU87tSL9RF7VS7qf0gF0IIIYTQaEIVxkLjHRxpdI9gmqAQRNleJphTu0atzIRhEBOARVVWyzvO1WTicfEdOHUIghEUwwmSohmW45NlQ7EKNTNqYbWlSVdn5KZedx6eeYWpX4Tz9/zYcgylDPBsj7Z5dAAGTgiA3GaNvZsVFGdehf7S9ZFDlZ2iSFEVambUwmpLc63OyE6owzpnk7VHREQAABEREQAAfs2xPMYrT10I3cz5vqbAQQwCCwAArqnVmL9xzK1Gu/efnLWKFbDz/VoDALV/LWNlbWNrZ5/Doe8rbgBcG/DX3Na5t4enlzdjGziPwUKYUI4XRIncyKAY7RUAIYTsgt6NZmuGEAAghBAAAAAAO5PSwP+nd1EaQE2IK/RF9jz8/X+vY5zO/hq0YAWBIVAY3Mv2vQpNBIlCZ2Bx+PsAv3k0lQcohhMkRQPIsBwviJKs7JfnWZqwYDn9ucfD08ubsZ0CywdidzjnKuzASQ8GYUI5PiGxQiTJu6ONNY/LMjFqplcxMTFxxBFHHPEljOJiQRO3I1ARRRRRRL2jLVr//tqP/YrxN64rNtLTn9SfjLKBqc2wEfPG2WxHm7NIg9MzoJnIveocr/FewOtGFIvFYrFY/JBRNbMym8OdV/gCoei191/KfwL18AQGi8MTiCQyhUqjM5gsdvuxG6NMTM0W9BcSiSVSmXzzFVuwPFYJnEl+8vLe58D72nTSm1msNrvDefyyev2xsGLxtSuhIJKlHApyNYwYjoikRD0/zv7c9bnr4eFLZXxngjrAkfdqu3ntMyhgGbr/3XsH+Co2CASGQGHwEIpzUJNBoTFYHD4Cv0UkMoVKozOYLHacYO3i8feNvCfoT0gklkhl8pD9sYL7D12ys4O9zkt4HMlSjgW5HmYEjogEo6KpMdg44PjOBHUwk9pklKmDeuF+QOJsHC4v34SgjbpCr6iJcqn+JDXQIBAYAoXBQwSb2zcZFBqDxeEjSLeIRKZQaXQGk8WOs4528fgTdCYkEkukspXPbvDCpm99QtY6lkjNsHCZEXSJ+tQk/zQAiBFsGzWOX9u4NFajMZpGZ0MwgmI4aQQLbSmoY8ayYGUbjXz/VD3jr6fAn09h2cnQFG0pqLW5um38v/9XfH8DBR+IOtT0O3k1IbCgoNbUbeNl8aMoDSXjh8iyzRxACMHn5VMhFCkJ+1NEnntMlK2osUoa2hGjDQchSQ8FDQMrRx0PAQk5ReqIRrtonLQDpNsN1CwU2yyAzirAgMCdgEBh8LFnJMLfEqaYlRYAML8sAAAAAKwjZ/CdZhYAAAAA3iNBFxYVl5SWlYvRx9l4Vq/epidgLsZZWNnYObZzXBfRzvKGMTIZN5jWk5lPWBgqVyrrupry17AWsdUpeh1kZ7SHGb564jMDCn4B1Qot1F0kl28lUUW1TbptNlZr2W5AmVblWBUeqPSEEVRqSNRjDWlteUPtvmrW+6+msKMe4h5NNZFIJBLf8nuslT3+dc+DmkgkEolHAXENqlVo9ElStKhivp7Vt6rvWs0NO+q99/eDSEl5K+fD58/V2369gCpRrxq4k9f31Ki99NJrYNRexssnfM4W3fW+xIe/jWhtld0d/6L+M//4DNjH6LHdiBBCCCGEMMYYY4wxBl6EiAgRIRzlOI5yHKUGzMDAgBkYMMY4Y4wzxjnwdVM3M1M3C4yjGvRoA8FP8Tv+FWgAAAAAIAEAUA8AAAAOtItBq6qqqqoqEpcxp2GIwBGRQNToyRjYcXPjERJh6hqaWtqTFk1GmTpok5g2g06VeSymhZ4ByUQeVnsr7M0IFYusKDQGi8NvYZBIIlOoNDqDyWILhCKxRGp7ibHlClMrgCKECeX4hMCNJHnKGUy12lzk/FKOwiPh4zDCCCMco5edR8zAulvo/3We8PbhYRISiUQiky/50VYuPofI5L+U0ExZSpnSp1Auo/dhtW34dVbtT9o/9/6iDtjXqDwYB7C5iJv35uDk4vGP1ehpzeZjO4wDAICH3veN7f2s+68NAAAA4LJKE5ORlVdQVAoDwhGR5KhoOgw2Tu/4zgSJzOoamlrakxYlg6a0qdtsEtNmRjavFmkAeobmTNIsLfP3ssDCpYt0jbzdvBXm3oohpa5SlFVU1dQ1tLR1dPX0DQzvY9SXESiGEyS19XIAGZbjBVGSlf1iaptGN0yLoMaWa0G1Bs5mEMKEcnzC6htJckoarVaLK0b/AvnzMAAAAEDtpLAAar+pPVU7BgAAAAAAAAAAAAAAAABqiSKrbVdLUk+LCqCWDwBqG9V2+Y1VgLq3QDQAAAAAAAAAALUUyQgfAAAAAGpdIk1e8muJUpgiAAAAAAAAAAAAAAAAAAAAAAAATpwPfc+YPQO5YvJY0iZFF+gPEWloIWNE1A1ZrC8FZNUKTS2bYHfHvL+ur58Oacdx5uy41Rrx2IWuBwAAAAAAF70AAAAAAICqqqqqqlpVVVVVVf3//////wOJqqqqqqoKAAAQ4DUMA6mCCqUJY4YjIql4FzV6MgY27uM7E5Amm9KoabPToyr/Ebxs2SXq4MylUjfPPaIIFMMJkoq2ApBhOV4QJVlRNd1wmX55M7aBwwxCmFBu+bmG2RIRAAAAAAAAAAAKfe4gEOZbDGmCcGYzjxRSqWCFlX2TKqq97jHemtZy3G695tVZNpVJ1dZq49RKkiRJ0rSlJEkAAAAA/ASLKMlHBWzbtm3bth1X3rG/7Ybi7qSEfMlSTpSGyMgGKVy+rUBRKcxncESkr1DRvsZg46SN70yQqEG9hhdrlrW2XFsdMT1wqif2r1v09CeFPMUGc1N3tGFrZP3GpYnLm45upnxzFmlO0jOczXSxpdQs51o5HpDVWl22TE6udF6+qx3LXVCctFFW6PkiXcLuV+RvBfLr6cv/jAHyx8CAwBAoDF4RoqSsoqqmrnEQiz612trR0dXTNzB8nesvT6DQGCwO//Drq9ezpN8Pj+8fyJOUE6a2tNelvz3jbTPnZlV2jo25Ynj89sM6oOww9H2j0W6HNLZ/E6ZmC8YtJBKX2Ly0LSPffEULb7ZMK+tbE7SRu+2knfJJ2ZbBRQ4hTCjHF1wUJbninKrVHW/I3Gy/l/1ws+v797wadq3kY76fS4EjBBRDg0NIY2Fg5TB2AKl9RyyNBeayx+VEl+bDmwhCVixkoKBhYOHgh+O3uPDwx4q2rLE5HLqbzWF4TAAAAAAAAAAAUFVVVVVVVVVVq6qqqqqqqqqq//////////8fKQAAAAD8////H9REEgAAAAAxfu0EKMuU6t3aTOhGM7A6MqerO93T2auvrr8vZifX1dOftGgyygambrahRoQblzTD9C0P0IohoCRb6Ry5Cnn5NII2xIWIW/RWcPoDQHYCgcAQKAwecY1r1EOi0BgsDp9wLZFEplBpdAaTxc5Rx+Xx24/zfM14/WNMzsTUbEERihaTSGXyzVe0QG2ZVoILt6djZGcbnOIwCBPK8XUGw/y9yB+Xv1Xyblg+vdzNsTsKjipvS7LcRxctTSMjGyR3+bYCRaXuot0L8zkcEWkzKtp6DDbOlfGdCRJ1q2to1tK5tnUW9UBaT6SuGz39Sf3JKBuYuqMNNZKwcWmiftPRzYg2ZzFtm+lmLMrU3FJFloZW8gL+am0wm2COXH/w8mXueMSC5qSNu4XuL8our+e/r8gHBWmvV3TlZwC4fQQMCAyBwuAVaZSUVVTV1DUOIu1Tq60dHV09fQPDvpn2re98/zrvX55AoTFYHP7h9+YjoqTTJ7eUL1OnSf9mxidnTrKcmp05cv3O47ffzx2An3fwRq95y79HxlKPXvuW75Fp26wW+HnhukXEJY4sLWV2yzdPC4YttZKwdWmjelvtiDZMWEHB8IJHMKEcX9gvSnJlQq1Nrh+mMb/Zfq+iz1u/989LYmvoy7jLkUwFFY3BYMfNjUdIRKhraGppT1o0GWUTps0gm7NIg9Eb9hlxZpLlCstb9PpDBC4fm6jg0zff/fDTL7/98dc///cXcyvuHp5e3mPYcumWFcD9RwMA63PPxCLJKSHf5ZTLZeYzVzLbvoYAAAAAAAAAACCC68cjFpYgOfLy1tXVZ+6rPeHxDJYCAIaAEJGIiJlZRERVleiyVAXrCmzFJEi7nyGM7Cuiy1FllqrDX4M+A34T1wFMRyeYUMYNM4vZg1TZEv1Yd95S9g4AgFtDHQTE1cYo2PjOHQAAAABQVVWMuaW0WGyhqqqqqqpSFwAAAAAgXAkAAAAAAAAAAAAAADgO8BwtSVBmKZuUsWr3SggFfmVLhR2jZRAjzkQH0NM31Ca+fuJzSMeZc5ZwvCBKsuKJa6LpRlwsK+K90/R8SAMAAAAA/v////9IV1VVVVVVVVVVVQAAAAAApAEAAAAA///////ytzE5fB+AOaXY3dPZ2KwmrOy5HJsb1kECAACAJEmSlCRJkjx17Frzh9w1aHAzVfpBx/vRxGMymQAUeBnBwG2MonWynbZpGiReqkdRnjrFP5j3Rpz+VanUNFrSeWeNrti6G1kMDwpBGUGNfYbXo1ijn5fyd8MBAFCLoWFNJWGnQaxQiar8O+YEDiHJABU9iQF7/oMGTvGdBEjIKKmL9MEFCIn2LXoeS6wTpCOorDPk94j1EivWbNiyYz8OmNzfBTOKoiiKoiiK/uSPzRPPvPDKG+/bR6lef4FQmVce7BMQKwpMFkMi2eQDY2Q2By2wykalHVIgHWoH2JMNht/MmrCaYEflrA2dMSZ5XbbdZ589zhIAAAD8//9/TKmqqqqqqqpCPJ+iIvgSdIUlUEvXaDCkkMS4ZvAG997qA2ykNQ+OwYqhlY1KS1a7TqHWDTuon2so+fLxpT41TagATNcqggll3DCzAlSQKjtMHe7+MnjWO18MJwQAAAAAAAA6AHM2AAAAAAA6AAAAAAAAdAAAAAAAABgAmBsIAAAAAMCCJEmSJEmSJEmSJEmSJEmSJEkSAAAw3oIAYDnTBwAAAAAAAAD8Nld3MNUJEpqSBHIDWEoQKsiyXtmgVANjDB4CEhvq6KHHCLvfuD/X7dSaAhKZMnVEwOtsojlydfHaGFjoFv2hpYGbVO0gEBgChcFDxHv+Q/+Rn/boBhddyuWQ/QpX793V1xIohhMkNdoAyLAcL4iSrKiabpiWb/3zNX6pM44+AAAAAGE81Fryvztf10wRAAAAAADgXSUN2AC2wgjSKjJEkeTlS48S7xrSt8HmnmiHF0RJVjyp3t3oRiatl7WH2Pdo27Z92fY1bSPbff+89wFs27Zt27ZtMAMAuDy8dyoJAAAAAEiSJKnOW5IkSZIkSZIkeTBpyXdrsKQkKFuVauUtfGNS5Qe7BYsb/dbTbcLOPTcOL4iSrHhSad3oRia/l7WHu0ZERERERERERERERERERMSen3GCe6XMzz5lCqJt0S3mP0d+66E22NTRbnQjc7qXtecHcpvZ7P8/cgAAAACgqqqqqlVVVVX1/////zFbVVVVVdu2bdu2bVukrt8cwCKlJHAYppkKSSKVrWHF4NIVy1TrNf1C7kK8Mxx2TgQTyrh0ZP6nOBXq0civn3Zy+//NLnE6yse5X4xjMAQAAAAADBiw7yj/Z5rrtUjJCOyBaVQhYaSuNVNvrVgqlVV3a/qlSNC7Y94teCQIaYIJZVxKVnmOLtQ0ie6M7EJ9WOc0NHCwqQIAAAAAAP3eVlwKpRsS/Kv/fwv83TGHXx55fakG9oJqvRoWyZ3D3ylTd5aBHbW/GjpjzIm1bLvd49HQf3UAAPemLgLKduWYMsR+JQz4///tOMO44DaLS7SCAivL/fe+gL8yTEENgGeEyYPJnIjS/+sr/GtyEwNgZhIWASQ4b0ngzRVLAWJALAb+QUJDM8daJuBFimUBttFu2YGg+NuywlfENcsesB3xln3Abggt+8FJZG3FAWA/wxo/HBwDQMhmClkgwGq5a4ECC/QrCwwIlm8WOKDv0iwIULCrQUgyP7CggGhntqABbxVaXVy6rocFi/5v/WXBAd7R1xYL4HuMYLGEyI5l3Xjgf/zf3xv/qfMyKTWQEjcoF0ul/HZ4XYsa8Avm+lcbjYz5Osg/MPgQzt1TQsqPmK+FglXZZq4JMLSb0P5FGqxExZad7eSMkY7AkjrDmVPoC4G5JB5+/RGSkdlgaqPdb39iNXlAUoGLv8ORzUUHSqRETBcmkxm+obQtsvLF6uc/b/gAWnDQkPFbUJP0NeEdde7H//nmyz226W/lFez//zZfJUVSJrVaoxRuAk2TRMEAdGG+2Myc9Q6IjHQMcdfBB5RZlQY8fQMG5WAwRpk72YFoV2ZM21W1Y1KzfXQzdeyfZFlTRYyXqKchiqUc2iJVPkl7Ig1nE7KrPnmnxPCoS7vgsksZnKGGASpW72ySskV051J+Dh45jKmkC40TmdPzwcn/UnhaMBshE7wRBEzD4cRnXtABUUXxmmx6aMCYsMMoBBnvq3E2ME6NRmKgdkaswSOEri5R6NibpJBREJtorKwT+yuoAFZdLQOZO36XBMMxRhNNjDVHvICtTH7i4ii45gtGQC/wFbCxarvis9FFEGCtKDKoGa8TCY+pX5MVNqB8sG+wrwzRyF0SrHZsOtVCdPgd6LpC0vIA39P+ict7Y216JnOyJUrnZR85+TKR5gi2Xa27kRIJGXyyruMyibNx0UrZBt14YjBjiUY6BsjiqbAxHwl+GK16IKCD2pCnZB4RnB6lTwdFtjl+WHJbgKkAMO+UJ59usK5SoQKusiPUgKet6RNsXBDLDCl447ImGiB3eXx1pR5vl4Okue1ni8e5vARpDwaPuSUmCEZW0EZR72CrNH24MEz9wbvHSrGMtQvlQH5ei96lSagFShtPlw9234COdArdWEE1Jy9h81BO+WNM8ZNt32wqT8G/1ghtshBm/kq7wWGEnmyoFNUwC592zeq48AxlMCy7VM4zLKuKBMiVMhDqE2h6Xi2EKcUjiw0yJ2lqGmVRYb9D7brcv0xaNNW/9cAcllv7EMaW4JDDOPTf7xBXHRyEoCEI/RJuqjcwY7CTpJENIi8GlFMQNS2H5YwBaAe1F34hVQahAX+vr3JCDBiCTz/ovExKDaTEDcrF0r+C/7yH8q9FNeAXTPjbv9poZMzXQf6BgUvC+WKyW+RHzNeCX7HcTFbP85vQ/oX776xExZad7bQ8iZEO1J8sqTOcOYWuJEAnJfFYSxnJw9kCsC6Mdr/NCas5V7GtWU53OC66y3iMEpGGcI4p0fEM58UobYusTGdXP/95QwQwrq6M35ya89bXJHt1Uh/+uTbMtx/P+Easd3T//7d5CSYkZsPa9FSjZLEWtVErIBHsmf+dcCGwfjcBaWkf7dnmznqqKDb6DsrBYMwtz9zJDhjPWM6H3aankr2krXfzI2H/RExZ0735eE8DU1V2OSAYE0/zGZy4iEJECdydvKfEeJ3t0q4fkUkvuUYVwLpUgtHqHtUA17wI2JGfxfWZwWqsNAZPsc87PR88NxjUIN3fuqRSc4IfkngMByG+AILsnnit3BYNgSjuQr4UkGd5Xy23LU5aIFWismcZsSYSEGn6rNCxqwudWWZplENQuPIVkGHpVT2Z38hiJ/yuIToO0u8Nn5g+IiYth85A2wntcc3dDYphz3Xl1o9uPpxB21dI4LJY7VAr3/Ml3JcaUIVsQvmIzK0QEIdigj5Qsi5iarqyavjwDkZkxpyWNTLnH3qS92ZZo2dK5vxTks/vEIfnyGZOao54cvdmj1cqE8XP4V/sWO2rKAeNiy7ceiAP+4hby428dFeA/vx6Yz5qnrTqfRHoUJcyuLIKJRe0gX2I9pr1CW0KU3aDtQHSp0zLRq7reDCFt1zF8VA/Co8NWc+EUJCdZfJCJrRLRQtiAVweKuSrfUL0IBq5d38DwbYzLgUnwyKzsnrCQJZqYN3VlghV4sfAwQreuHLmCmH6GhP5xBa9C9QOYYwvtT4c06iI6O4NU6wcZKxCVRiXut6Y9tw4Ku4aOjxP7SqzCWGnzSTmvsPyW79SKZw0VIr/zGKybTBVkjZmyIUcKPKh2vYtm6tTIfd2V6ibsPhoEneIDM/KyVHZS+j7k2VRIbF3h9qC+mdz4KFMU60JNGhzje2dYzqoueWY6L/fqVPUrcFNV9OYJYVLB5WwD0LUWYtIXSjPx5hKtJdKN87tOIYxVRB6mvj8a9LvgyY2/wHQt06TL3H8NKBxId/O7/9PZ5iN2ufADhCocIGAYmGTcPMMZ0Gevz4XDCoXAqrM4u3xUMcGLgzU22iMn1f8BQj0Q9DCgXkRYJwuNF64CDGLBINinyh+/KI4Hvw0b9Fgngb87OMWvwQnQOAoPVEzU39FK17JSi08G+7yVVhg4SpXZdGq3bR4NZas1pP5WCZh9RLtdkCS15IFksv6qWt+0ta4pgkzOf/QhEjpm0QGZDP/tkWff+sXvcnWbrZbHtmQsjnTNn0zuruY7i+2B4vr3uKb8XDzFIBe/QUuXyGZlERlwqSDNE457fPIoCmNsCL01GxE49Tm6thiMW3lZFEEMun4p+nJdJojBxaBqV1gjW49Wk1tSdW1aNcL6RSUqGz0tFqOW7KlW7blHSto70OH6oD1hjnH22yLrQaWtJVQv8HsMGi7PocN+bJVW91Sy+CmoHm998E7n3z02f8PQft09azZ4kCUk5srd978hsYFaLluBjr/SFm4SK5fI2ZYusw550y/zPiKRdDha5R1TEBQiRdnttjU1CRKcGBt2+XtcBXCTCx152Ybadq2+rIdZj/Z5RQcJAIGXyjt1uuuv+HGm6SV8T0rDe81v9rFdxQrudGO7HPV7zAAN5SrOCH9nv133nX3PeVWVwcPyVSp+qxsQino61J1731H75fzhDx1Gqyy8snj1Q/WnDj5kLmD0kHX8dp7UVexqvpETW1dfUNjwD1f4co8Hwo3RZqjLbxL19DrFhJJTarfwjN0mR4mweqmmAw3N7xq0+QsxdGNPtqe575esvnQIu39TIcCx8KMLnivVZI0J58r6HXIfl39u/P2TpfPKvcIBpNiscPEOop/YtSTfmeuGEyKxRY4u7Z7hB5nOmLn+3eu9Vu9+16L74/LHf/kC9GmpIE7wPr9iwWwQcEN9awlhryw0MliZXNWyMTapdfR5zXioBMkYylXooO5I+WFjoE9WiAeTfMn54olXDnXNbmivNha4MRHzzk/uu59jKIpeFhDQaBhZ45eT3TxpFfqrvrK2vQhDSHWu0/u3LQW4RgVTS4H4DkXUjta/fx4nji1/JUgGUyKxZ6dbf5ylJOm94CUhP+QaDdx91wwsOmrvKz7oFU8y3fPKg8shgYrPDxZc8NoNNxYD0HDzRhDw7hCr6T4YJ8ErTJlRSgeRRvoqxn93UwfdPynlM3F94ZjXLYsLs1Vxec0cq0w0uqvu9VftyilP+7SG2/Bk9vjlO1WL2125F7B2/ZQO/AT+kSwA/d1Bzp8uDgUtbg7UBs+xSLDntAnTv+9OgVFQdW6uejFXIGC9VV7wggQCzdCxw1ilSWTIgFJbqxl4BW9nluv+Yp7/6B49mOqe3ypKyv56hSplzc/STKuTPfcFqbCXq1IRelIfVPfnjIcHWU+5DIG8Rq310tmwote2WEiDzpBMpgUiy1oBu5p+HIDqjilqhVEVaKmq1SNqknWNNQk61R9Q71FvSFi7l9emYMrvbu8bAps6eUFUrDwUzKdmH4AIOvuGgNrbF8vTx0gyLnvtwV43FUfxGgI+hydjXxRcz/3AAiACYbOIFHxYZJLDwyl86b0sc2dp2MY1zgSNAGB/x3XkoMyZmBqgYZYeHwKMbf/XmvfE4h5z5dcCgAAAA==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/fonts/latolatin.woff2": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("d09GMgABAAAAAKrwABEAAAACRDwAAKqLAAID1wAAAAAAAAAAAAAAAAAAAAAAAAAAG4HXEByFXAZgAI0ACDoJlxcRCAqCqTSB+X4BNgIkA45ME4SAAguHKAAEIAWueQeTRgyBCluzJ9IqRPhdfVc2HY1yUg7U0xcMnTJMlNIW2K76f3wGwRrDxzsL2lTa3Bhbg0Wz71YcIdf9tK4QUHOs1mm8wXhnquz//////////////////////////////////288qUizspVYzgC3vHsM8AAg9ESVEWeSZyxUkpRJWXgmrLxmJWykLoq8KZgpetuBLO97lYQ1nUJycDhKjsbuimRHccWQidDTVLyqZ5JT9yNVUTkoh/kiQ84lcketK6pcXIhX4OoasFZ8HSYo13tClTtynKTCG2QpVKXdbmM3WZVNm/tMZhsnKmvQFqLxYpEhZ4YcjFUxhRMZ1JS55FvhooGEi6xFXkOGNAHzDV2nqm1qu3BH3aDpANPgKFUDynGSaueuscpkt9oTsF4l3LTIxVET7rrEu5YBVEXwKDwP2lugrWCfrN2mdmmvYEdIxttfoZ1on+zdLjuAC+WAWpca1IjDgzwE5pLBTg6rxtXsiBzZtVsG2SFHj6lNA8wxiQZ3NA1ZP6DpGG1wXwozHs5WDxaSd5LnkolLYtIUp5fHKaklcDnuGxLcD/f1B5FPUO2Q3ILcZ8hDqpBaToA9aB5QIhKyhAw6WZ4iPNnr06qhsWx9l8FjAZsstGHFGbhFdoGzscYR842dg5H9Vu/QjLCXNtaclM16Q0C73k4jHVF1zjr3nRfq7UxCfUFTU3ONamUkR/HFOMf1ttgpdqdXNUMObmFYE+Jk9Ev4Mjo2GV2Bfez1chVdW72+flXfYLsEhcsMZDdrKXW6Fe2w3qtO3tS3le+HOxwy4rp1vD6Gy73me/syMX7H/im5S3CyaO+pPuLOCN2+c4RdBWo0tXnfviTjNx7AOnBzZ2Ftakdwbl8e6kePafYJfmr3mf2DR57T9gO5QrBOvwiZrVoY1EP9ch3t77P+Sla5Ht0H63AToY1wCM7tv7b/xr68Nb9qX7+Bh2Qa1Fjq5Z3hg+B5QA4GvDeABm6vVDT+4P4LVwvhQO3hVcRugd/Lmg+qkCSbqByWASNIhwxZe13e7Ad35RFVqTvN8PsRPxaSoU/ymfBTnI0mPskXiHHHMT7WASevN/hqjGJE2EAGFbIVjGDC4/Ai5jrJ+tDzctH8WasvQ768r+rWUB/luP2v7J+3/7X9b4xuVI/Bt+o73QS3mHFmwccf2X9i/4XZMd55PbCKtSHOErT6+xvKA/L4G+Jcs33VvjopP9j/0f739n+yf8P4z/avBY6t/mL1XazB5DXrN+xf+8XcpvBfzf/2O63/rv6g7T/t/2X/b/v/mLwe/K/hA/C/yFsi7YIJ/T+lzixdlkM7Avfal8vl1Nqf4YX8Z+1itJ+DNhth6CbLVJvAQej8KZTRHiHKYToYfpLCmNTaarXd5KCpSwLvI/zkodqvxz/TMz2zuy/AKqwAVVQksQTm8omMjAs7ImGjSAlWv/7tUV3pQInySFMJNyDtuANRHjig2Bz7jlNI9Va1dyXM6xV12HjqsP4PNLd/d9tg0KNvRNeoUEdFjorxkWhHfyVawQAHbYGBlbEhcFtX5trIUqaACwRFUHGxRWTKdCNDcaM4UXPMXJmWpZXlaFtfmrW/1MbX97vvZ/2o/13fv/W/e/6fumf3vN0PURNoiInFP/yvPft95g2qqz9I2iiBalrFSqREGskbJbg3SZD5cgPgSKdKCd+FeCrQSMPSZCrBv15WgMjKLfbaXxVJpgAqgAUkw9FaZVfzAC7R7DHDM8kIDUg2LkJFqR88J7Iu8g0l/36wDd43I/h/+JPdB6sPgzgsEt+Z+Obs76/nX2qVufJBRQ04qdMzKkDNkNbvPCmkobSlSigNYskLnsAukQ272axYQkLENrZJ0IR7ENgWphODaBsPAfZUp+L/aE1jJaeoqlRdkA54+YhS4wOqyI4c/qsMh8YULWTWczUfsiurbg8a9IoqaMbxI+SdV3g5Ra+qQP8RTAwMwoHcNPA04F3xQFpCLYUGS6HtxIwEHZlTyeasPjjzlwkwQU7pOM/lkh9U8j0rgIAECPgvgP9fm35JgWnVU2rwSnZi49/S3z8dd09TWpIp/Zg6+0+HqUgQYsjHSPytyHJusCX5HwBwOVYWyK1wPwk/2oKP8IVNdPjuKoksALkKjUmeoJ1aaxrS69qLn3TDEvHWXzSjPh1Pwb2+ABkA8B8Bs43db1SDwy7/yY7xAX5vDfC/amOB1q018nO9b1npooPeF5qY1vkokDETGtpN9xckdHlvqYBB9udQbL8+3tTDmziSM3Z5vs+3UzBwMP5/mvNVdmMl9uYUH1KStgcFkoAfjd54cBulIX2o8f186hCqyiAwOAcLnU5oFUK7T28mSB8jGyePzvb9BQ4WYiatpTc17pIFDO2TK42rlgBSO8ck8lAz3sJ4599YWVpbpnSqdNfpfzVJChAPWzsHxl6WrYBrZyMKLCPJsjkED0k6tRUlhbKxIyKGGmd+a+/mPhQI8nJiM0KXpuQQD0j2V7siDqe007cSSOHm3r1gNaHYbXiXy3dUixqekAc4FsX5+T08lg4eZBNZdaC1HpQC9oPzSjkUWkULH1VxmGhyggdpQXsxSCLSLvKDp2F9AKwWFzZp/QFe+seXtJuZXwuseNDm7YFOWX9Qj8EJIQ1OOHEZYNIOegimcEQHkurzfaf/WnamZGf8FMO307FW3iUxlXhP4QS8iPyRsK+x8P/M1mtFmTjNJS9iTBefGGtRgSjs7v9FnaKmqw4Tryeorpqi4rmZqq8vzq/kassjTrpeyXbP3QMmIRE1RbAEaFVOuLjTaKkxspfafAjpP82ek8PksnqY0Ej7U9KzmpySoYEjFsItLOIC/ocXEKymu1ozvweWST86oqReYKKrurxCptja6uasrXiushZmZnCFWaCFWqxFXKBWKSoP7+5fiqz23YKAoUX0zuMbshR4K6lLNoo3U9rlcQlBtrC4REzMBE0qJy8zNthdpI7CKr4KLCzKIi3eOlFx0VFpbqbmJmcbd77BDc/6BK1Q0RiN0jDFgh0gVAy3fv5VNVeAJFRc3pOv+i5NfBe+J6WV3dZdn5Jha3XKhP8/AOrjEyJBSpZBuJHyFUouAikXUFdAkNRRpHQu8uW5pvhSHF/XXU8jKDfaTkJI12Sn2Zdet9L7MCZjq9OWZSt9yzpmzDokPP/9sjLaRxMZGqgBTBcKfsAkD2l1qmSeKlIWtVqW6UetK/mUr1njAXGAGIttrq/1tb5jUYfQoo0hdDFbVOF0tQNiDv/Vstnfj5SNQwi520PqHdLsOWKSITsKqSn04chCUwgF/H+/nJ6HMIcLXSjkCol1n1XsPFpJabZXaSJM9E5UJqpUh49KpI5f7XW6KlSEREiExarMZWdT3rw5SdkUnblUiqq/pNyn+VI9rnkGicIbvEY4Cf8Qy5zNzqOr7KBUhIuQOHmNtj+t0+XOOaIYhDmrEAaJJBZoCYyZhLBWraX/1wqsIlTEwn69g9BA76HaigKWwMIBWRMdq7ZiVPzHpfP9F9ZK6znl/kOo4FzHzn3WCnNYUNOmqFAVebLCItS2AvqnH1WvVNXJWlsfJsEBzOasp7S/y+qx/5G9dxgphBzDoaEhvz9IzizIfcADsXRLmWclLOHiuHtQWbMIg/9/9/UptXp7LPQ2My0AzZmksp3onozvou6fkaVWUEcgGYgEqnQgCwAiSHiar2HzRkl36hCGIBQjjBDCWxBGeEuaub8jvvO1ijPt2pQtJttCMEEYYYQQ4iF8tf9tBCDmEjl4fABUumDWbPYbWp9RWO/3hFxp+aWFkG0iIsNjEK9U/mm/+HFm8lUMLxgXEcNnMQtWkyDC46z84knw6zRHn3QojDFGCGFMEUJ6C+ltCUUGyqkYedgyltIhmJIhaCjFBGGCEeK5wTazYoIlhKgAvb52j9+2QTazY+3aiTMxikWxBAkeh73GoQ/QWyCB2xIEV2zFbBtiGo9w/IHSADjAcX3w9le7ewPywebMZCA9WhuTBLFPFWWnQio8fA6VMkOc1pjWpKyEpIczUwH5zYl1klQyqE8D5NlfE4Aj6DzvfNwDlzk6eoplSYKFzGPbcqV82KRySnPrDxqlfQuNyIzeYH6hXxTL7CLdvjf2wT5juqWwjJb3KgQPzQA1O7JlZO6cd4WH+EP/YU3cl44Gj1ey4378sxNkqMKvy1/k3+e/6P/A/08BvGj5AbLAJxCXKfIA8ieJo4RZQa3WM0ELQUtQOHQS+jH01QTYJowMY8H2wZHwPHjXjKUWfpq+S39Mvq6KtcJ/hv9mcIQrs/7FAnLR4PBgWUs2NBLvCZ4Jvt79EcoA/xg1ZxHIn2I/N+L9VgjuQTehJ9Fn0e+jn2A2YyCYVIwCU4zpwQ5i38C54L3wTMVNX9St+B60o8Q3iA/CcDxHn/Qm1UXGq15OoZuWSRgC5KE3qNUQD1Sz7C3uHHN3wSLUEtoKBMrPepK7UtEdwwmSoltDG4EtF88ziFKy5kVtfxzrTl+zbiObI7dsX+b/K+X2aJLoYpLkcRXOr+CRohItIiwrTgQfa8Nrk28suMFQ8XzKVFX/pi19a2P1nAmMTR3n121wUTCcWXI3em+oZ+hwzD5CNxrezSkmuFKuw78cwwrghGLihWPlqcJFkIIlT+IDElLJYdMIZBQ6xjJOXcjWQSP04ZUmER7Wyr+2wqgpgMFgwTjkjUbars/v60vxn2M3JoGxXIb8P8lr0evC7lYfETy145pkG0BDLTH3CPOoXIyRj9NOGFi6mSzcEFY0Obl4rDxpOFt8rDjh75RGANCyc4DA7ZAELyuf0RYT4McKJMgiJHzabMcYVoREhTgYgBCMoBhOkBTNsBwviJKsqJpumJbtuF7L1HpaY0BTK+28jq6efgYDhkbGJiRylCyVFn2A0c0W1tTtVg6FYvAQUhQMJ7g8vkCYqFd8fNJqwqTWUm2muRtjBQpJIkNFARCCkTbZm3GEyWJzuDy+AMVwgqTozE6YM28h2L+GkQrEt+0eGwBF6uohz2jo2CQ5vahapvkz85F20NXSa6XWNqdDuJOSz0AGLA5PIEZiAxCCEZQcJVClRbc2mCw2h8vjC4QisUQqkyuUKrUmrXenNxhNZkvWk0O5gt2R3P31DUj9bxsFduRim9STexArKc9I7lcR0BSN+heo/44iqctK5fqQDZRNe9UIaQaNj1KPUY0bCUwChOtUME/RXC1n/Pk4eLNtsFZRVhAJVBRDHJEd9HnEfGz3CvueUhkLbXVAZzNdaqDdajvTeQyNtBo8ho5YDLh4FYMoJRMvJ3X/bslURQ0MUTwWwnDSXdxgsgz36tEYVbVgTnCihozVajhfVd3sVBeGcN9D9zqnN0PjxCLgrRWsGnKVIh+F6qJ4vEpRVIunVq3US06jMJqVpCW06QgQKIUuhCVZUTXdMC2bCMfdK7DKUxbevmzcYxPK17oLeKbNc/KNPt8e32TF4LFonChBrD2f7EjbLbUXEYJiOEFS9MOGq88/UUdDMy1dLRePH0QpmXxRM4Gdmj18mSdCDIOgGE6QsqJqpmbmFpZW1ja2iH768g2AEMywXKpUrdHq9AZjsr0OEzOz31ouSTNI/RsjvmU9UJyGr4x2INB4hXHjqN7u+v0Lu+PxCbLsNgWXfGIWTafgnlrar9UVbd+yz2vcLnf6Ykv0kq9x8UMVp32k3FNSTTesgayT2TBoU1ohYG2Kt1/uLSvfseldi9+z+X3TP7DgY990pR3y8RFTj55xLN+9xf2yGyn4Zcg3VLVJZMTAKHiMaRw6YXKV76z9dPKz6fvH/lQvnvnF/0noSV0l0ygNAGjZOUDgeFWKRaHDbg5PII5mlOylaNQLQAhG1t3aLedVApKiRwMr9u6eeBVESVZUbTS6Oq2pVt9+3eUqVBW15+szN6FHzRaLOPFJuE2ipHnybKlPSgs9/T4ZjK7N8SfIBygYTnB5fIEw0aNK/54yhcpdkzmh0mVVsjZf25LKtetuSNy6u/k9kWcVXvUDQyF2JTYXWhX8JKKdLo9p+CeJVj0a9tUsxwuiJKccyGSxOVweX4BiOEFS9AjjV8+8cSArP/d8822DWUVxhLNKfG9AqyKtbtPz63pjWdWvJ7/Lh7b/zPyLT2t4O8gOgeAR5nO6baCL+wh1SUoZfW6yh7w0fd7vG/ZKsRcPHOPy+AKhSCyRyuQKpUqtSXt0rdHq9AZjptc2uyPny3fH03lW8J54+Udv+5rJptxh/j5ccgd6pwpMkz/qfOa/Vlx/Eq3PP0XMs1L0/HIrjvqI21MuTVe1AmLSxGaIS9sarXVGG8I27UYWcu6Wf+co/sCVruuLnTq6aPBi6EgoHnOMgEaNjPGOm5zQuzR95rXjO58DsCXhAUrQC9OHg/sLSS15PXqNihJPRD0V0fTMGpf1s1jSbqNEaGKo4Wsx6wjj5rPxHoKWZuvd1OGUHAq6fg/6iA02m/ZmIf2grUP8R1LGMYZxvvxJNDEZg/vZ9FLJf7D1EPrHKg76iMpT+cGa8v1cV9c0eg64AvY295L18jLkvWv2e75935oPHOg6dMjOo/cdu/DE1b2yqsutO/rfat/MMMo9pnfc3orzV26anPXpJdPAmavqZZfnzLykG44VE/3D9lH/n7y/NNQ3NBHraswOXNwyQVtXBXG/iv+FyjAtdRgDWH04/fgPHn8ozMBSmoo4bnYeAioaVIZoacDIMqsHR4eHEnKHwQo8iizKAE0RyGl16mO4uB4QLqAsrAXEsGFpcWC8BCFdlgzrnmCOgr/NsabVp8nlgYEQjKBYeC1Iiu5GW+1OewRRkhVV0w3Tsh3XS71DzDQCmbjrEpJS0smYlkXJoS/3Fo1Fbkuclrms8Ci4oSiuJKZsVlVWzUN117jYqotaPsN8gH0P9zb+tvacDq3QHJjuzBtNNWlqRVu1o0NnTc1N83p0PQQaTS3iRRXDT7u8UzxdQuWDs48++Zwvnr769nR2HYSr1DOAKoU6b+RNBOuW7U6KnhQYAEIwgmI4QVI0w3K8IEqyomq6YVq243rso7v11ywatcxlxYjHhpTFDmZe20JjUIczSwTB0EbJTapVm3YdOk2Z5uWLkV99A+LvNTiDmu2gT3IIMpZeFSZNGkxAbKq4QyQr5aCxYYYR+WKGCo/xjMMm9CzPUX1sgFMNA1oostur4UTxKHHSewb4bjlBkzUsqJjRRIx0ZsPdWRCFc5xBU2Yjz8HTL+9O5nzKIYZhT9ZsPioWk44bmlBdB2hSqEMzBpcXzRWWopggWlKbZVqJngSleG0alMFXXbECBxStYhHVoMvEfLIoS/KCFBQrjk0NTDMsbx870ohlbglbr3aT+65FjINCDZ0z65L7zt0IRHlYXZGaBvmHkRGLazEds7w21xJS5XlTRaodRIb1jzgadSy2eIi7kjC8FDQNmDaTWzAor6mysItdjeV0iImJkezsl2EM0Q0rr9lc1MWYVNzghOfrYJ806pUzBpbXsHX9J8lB0gd90AdJDpIk6cOusmF7tMC9qoIkSXnce6fX7HDk8o4jmlVY88ylF45sXSlRZ1mucaOOHCqUZGo53m6FDXaVBvOoFvmneFeGr0v4wILXQ303ezsKu2oUsyLf8RvfTnolN5hwUijbBWCyOg8F9qqq+vVlDtBBkwhjBmMOYwFjCWMFYw1DgWAjlEOEkRTdTWedK48gSrKiarphWrbjeqlXickjkIm7KyEpJZ2MvCxKDl23Qr/N4A7uuue+Bx7Ke2Tu8CLMkmHLUCv8CoXHFV1TclnZI1UzaorqrtFXZbUWTjt1JPMh9lPcR/gF7Vd0rBV+Hch35o3umqy1Bm3Vjg6d3T1+Kmea16O7IdD6eefuCgxfIy+/Yrm4py5sW1/FHOHy+AKhSCyRyuQKpUqtycq12R05NVzu89fHX85EsUAyoA4AiM8inStwJmILryVpC2OFQwmswMfVKiIzyAU/ZorBsl0uJ0k81u5I7PQaHhuEV6H1vx3/Q6myOepGTKB1EOace+YgB4MRhGAExXCCpGiG5XhBlGRF1XTDtGzH9d5Oa/1Y9mSOvZ8FEaAYTnB5fIHwcMIhFZIRStk83Sy/MT4iRQaLAgAhGHHg0FGOx5w4dZbzpAuXrnId7MatO/cePHry7MWrN+8+fPry7SfdoXr6Bvlt6+/kv6kX0IuzSqzpOVhZh3fslXDVRS9uOTidlZ8Vhg0jyaqLIkZk4lAJSSnpZGCyKDn0xVYACqKKvEr6lW1TFVSzTx0aF1vl0XIeQ2CTOBIf1T6og1a43AZYztaVN7rz0AGdUL2BTmhD4AwtdiVHqtXjfQH6evLtB6KUgue8rsxyZQC7B4TWSVG/rGuO8Q8oH/PnQY3o+xv1H+3HHGFz2ASJL53uG1FSIhk2KNy88C2D09DB727MzJAesDPmKFXhFTk17m473/H2jtQ0ja61G0YwOogwle3hgpibsDs4LA5byxx+i2PI8ooyhTnJBjsFYIACwUrKKqk6o5Y6XOPiTUe1SCCyJdoMYVJKh2Y6kubYnbz0xDxPuU0QDiEYQTGcICmaYTk+a86QIZwEz4qq6YZpZZMIZXHZ4eQX+/NPyY1srrx1sn0hFyLuoVoxTlyE8L/oXitcvMggSvM68lIU1K/+U6UTJb29itLjuavH59t83QcMBrs9hsHId9c+jXuCYTAYDAaDwRzHcRzHubPDzMzMzG632+1yuZxzd7xN7pq/Yc2OVN4X/lQx8+7M29Ph9QYf1WxtE0x/9oj8fBwhHklWkXZYuruGtY6vAHBNwf+KN6BHz6FI4kyGiPMoJZFr13y9205KRGatXC4hAqDt3f5l93IAAi8PBoTWlVcjnxiNn0wgQZVCwi2u2xiZSKLCHAxACEZQDCdIimZYjhdESVZUTTdMy3Zcr2Vsfa1hvQmJHKWMSis97eZKlna7kqMtHkbyKIYTXB5fIEwUFl88E1ZwmikxF7AAWbK7fzhkuKgAIAQjbTJcuijL8YIoySkjmSw2h8vj95n/1igKhhMkRWcRh2QpydgIEyMohhNkFFnNsBwviJJOb8ho+Ngkee2iauMng69ckdbwpIPcgT6vBGIkGQAhGEHJc0VfxWvedaUBkxWb73B5fIFQJJZIZXKFUqXWpIV3eoPRZLbksULOmdmfP8c/FyKNEDhoWBLUFVPyA6Bl5wCBlxFXefytX8CA1GDaDnkGla++HAwAIRhBMZwgKZphOV4QJVlRNd0wLdtxPZhwSCeK4QSXxxcIDycUUpBcmzOPXcitXxW+bxMYKAqAEIzE6M9ksTlcHl+AYjhBUnRaryYm4aTArp6+gaGRsUmkZ4WyeH44+Tlw6ijHO584deZcTARCkVgilckVSpVakzV0sztybrtO7vvvsx5JfNHmqi9qmvFKQOdJ1+lyLeJik8CHhlUaM6nWtAX1mBZ1Wf5yjWHtuG4Id7QtAOygvavMkYPGUaAs7T7GzDHsLAoRvMRJkCQTFTywPhuLo0U7ugXSi37pGDBsjQphXCCTmJadywAQghEUwwmSohmW4wVRkhVV0w3Tsh3Xa/8wA7pHrvt5WKijqxc9SKWVnnarjuXdbuFoi9uRHIrhBJfHFwgTJcTHJTApVi5kQRBpLyoACMFIm0xkpDJZbA6Xx+/TLnBPpCgMJ0iKziIeyVKSccDIYeT03hDADgQAOFid3lAjxiZrTWZC5JhrTl2VaNyYmRkAAICZOeccSZIkSZIkKSIiIqKq6v3yW+3c//2bFEtmj8jXlxchAGi7SYjdywEIXH3zcpPBDKHxAvmMxq9DIEGdQsIdBxijIiQK5TIAhGAExXCCpGiG5XhBlGRF1XTDtGzH9dr/zBbCqXY/D0MmJHKUHVRa6XRzJ8u7HeRoi6NII4rhBJfHFwgT1Yol2xQie4Kf4QqOm2HMsRaMluAi0aICgBCMtMna0tkysnLyCopKMSKZLDaHy+MfN6XDSps1jEKYeyQqGE6QFJ3aUHUNzSwiSZZy2oMn2sCmLdt27NrL/o6GadkOp8vt8fryo130bb9t/8m0sQT7GHLjYU2k1M5Ui2UAykMgRuoAIAQjKPmwAlXJGlQHG8JkxTZ2uDy+QCgSS6QyuUKpUmvSand6g9FktmSN3OyOnPGX2+P1Lf1vtcP+TypIJq0cSokE0J53i+naZY/lUBC4+ublJoNpQ+Nl5DMaP0AgQX1Cwh0HGaMiJIrmMgCEYATFcIKkaIbleEGUZEXVdMO0bMf12v/AcsIpdT8P4yYkcpQAlVY63dzH8m43cLTFaSSBYjjB5fEFwkQR8XkCVtmXM0E5VoE2i2+ZE7oP52lZ7pF0UQ+AEIy0yUjpwTKycvIKikoxkpksNofL4x8/pclKg7W7KEjsxwTJaIfhBEnRqQ1X19DMIl7JUpLRyOfECIrhBBkFqBmW4wVR0ukNGaWPTV47s/VSXoBTa3mm4HA4HAAAAAAAAGDCKxE4AwMDAwKBQCAQCAQCgUAgEAgEAgGBQCAQCOSLIc8zwGKL9Wg0+tqoSEy89C3IWSgGNsJDgJCh9KIxLcuAA+F/nobyW++ngx5ZuzGt47FeuZrfXfKF+EvvldfeeOud9y04G2FiBMVwgowC1QzL8YIo6fSGylDUavhDC9rS0CtTr4XnH+z6d6F7ODg6ObsMhFwZmiot6HE00MTKlxq1sZo3X49cGNYayC5Oa0iYMsEqFvNDr0MdDjbBjzECbCM46BWqgmLVUEFp1QKoUaZeEhr3/SRUWAUZsQRSJFOf9PUFvbJR0s7UZ2I4QVJ0a0KjipaLBwyilEyyqJl06anZB4Vo5nSEGsUyEyJ0Req24ypPq+Ck/tV2R+KA/ujP/oqvXae11hPfh2uOpU+hdnl0Rb7+Df+L/+/DVR3ndJ7bN1B/MbVdrx4c80KFHjLyqVaoUCEfHx+fatXz6iZJ2chsWc5CgacEAaS6YgcRVF5qSkO0ILroEQxlJN1IW/TERMfMUlbYuh3EYaA48VwFq95tHz/3Gi9LHj090TEyw+xQJxVx8oL51GiL8fFTC1znjumk/v9Wc5Ae24dQZOJ7JSTnKbw0VsJyeg2vqbVibUh0NeOvjL3Ovniou/bHRk999vVg8J+CMDMzM/NjztPOmDlhnvLflxUP391//uxVtZOpSZIkATgEMeSnhjYMFocnECMhU6jRRmcwWWwOl8dvAzLkI7FEKpOngEqVWqPV6WtQY01k9i9WW/ashBKuFKqLnr6BoZGxSSRZoSx+MpzcnC0h/z0IHCMohhNkFHXNsBwviJJOb9gjMH3ae4a5haWVtU27BZH69GWw4bx5AjGSGoAQjKDkKN4qLbqxwWTFblkmACEYQTGcICmaYTle+LRywx4zE7J1b0HtZ88L96G/133bZ/8JDgYCQjCCYjhBUjTDcrwgSrKiarphWrbjeqSJhE5hOEFSNMM+5tDGecJYHFvS8yq3qrZ1vuMSOi2d7FH5IWOxuK4f9QGWjNZeRUCp7WZwMbHUaHV6g9FkzjIVgBCMoBhOkBTNsBwv5Jx4ndwP6F/nFnamI4PAYGepIn2t/MBgMNjqe9vjqjEe0WjwHRe9mgQGg1HcGNXJaACJVlI7aGhWlpdKqJris6dTXYbVNyrfkE2N+dSUWI4Ea4n22nKuPd7KotaZUV3J1l36qhmO1kh3+zeDtreeuEvd6XIlfa6t37V3ovJcmo6n3myaib0B7A2m3WxYc2l1qMGWc+5aa91aRq0m21Pr3e5uAr2ZWe+3rPsQ4ZehHmcQEels7qnEKmymUQpTTiwnR6eBPyeqQ1F7v1SPoVYDpzR2arTsHCBwELtkJ0fcwM8b9NSQbqhLWKn78QIkKCwknDG8SKKcHAxACEZQDCdIimZYjhdESVZUTTdMy3Zc77OGqDrNep4K7UFQ9bw1jKrmXifSjSqGE1weXyCsiIr5axJ9UgNygJLPlENAnp/SONPu3F0sP+nw2eOL8a3P/++GWD3SWRQAhGCkTeYzjjFZbA6XxxegGE6QFJ3WXYkmnBTv6ukbGBoZm0TqLpTFL4WTd77HFwhP++DJ1WolkytS7jx19FlFXRVlRAsoCMEISt5DX71wd+Dy+AKhSCyRyuQKpUqtScvv9AajyWzJeuBmd+QMvNxEG/t/t2pSmDoXYPvrDiHcFoSI8CECIILAISAFC4GChoWDF4qAiCQsZH4RHjikd6dPN/EDFHPmjMNcU/PUvrUDRlyRVJfBKY3gzGLqHH2nfLwZh+PJhCv/6UXxfQT3s2LsItvHTm3gc5y1k8wPnYTO4xScXHPNDmjsvBBBuHm5Ov/xWsBpg6Dj7J1kQ3b7EM/+gscc+wBY/Ywwpl/4UNJnFwpJhS3Qus3wwHkn99ib3kZ0LJ+nLazz6dZFGEvnlTPu+/PT9buvP2B8C2xzYWG2fV6y6b6UmeRJmYVzYd6aP5aIYQO+8lbLefmutZ3Srne3sbffZ+6L2q39f/s3h8gD/YiNy2A7fOdRvLOmbujoSF8gK8/b404GBbkG15cbBlF73YFhGSxfrgdo7dZXZ4VxihkCKbN+8HOu1qI/5+rsloKpoGHaOW2DDgXCyXm6544Mp11lmvJTbDCwmCGQMusLwAMMBgOnV4aFzx475XY1xXBSqZ+XRtLuMr3G+kn0/D0p/5Ze2BNiAYGUkUXJoT/MAo0SsyqLGkydRQNmlUXLIcxh7Cnc8bnX2yEd3insbQN3jvj/RLGXVGqbjqeh1FcyctKaAbo9dAhSoFO0V0m3ss6rdqgxqrvGs6sMwHOpB2/SDuvo84KN5awdPMRuZlAYUCwgkInTSUhKSScDlEXJobcFVkUXECZupnIHvmNwbBSH42FtREdbYTIbgDmJdME1UJmG+DHLx5ln1FBDyk06FUIIpZRSQgghvD2+GBhetuP4ae1PCKWmetRMZUopIX5ZyOfTKwAVOS9ko2GjoeHi1sdJT7/pHczxND2Qg56VnhViBGaEmW1j8zzaLgRjETMEUkYWJYdee9zdqUSnzLxqQo1W3TU2qz6X9Hijtl9HW8GUDSw564FiMWQplSmWyZKe9QF4MP2C6ZT06jNn/bWMIbEG1AByBusWJ0aF1eDM3dbqqEIfb8inO6U7xaBgpCQnB2yAG3ae6k5+hvjEhAc0duLsy3Hiigc7g/GO5g8CpLdFwKgRkA32jSt2IRAIZEAgAwKBrFZepxaw3x4z9BAIxIR+3V/4Ahm8K/r2IN0ATE/xHMIlw1+oZoY34+O9F19OM0/mNpgH8EH7XHEEMU/RhjA9XUFATn1eqt1aHvKHSDvCcPhS8hIbml75KctX5gEwjErMEC1ptzi9xNrXwKk9aLRBo61Wk41yJ5CaHTr0ypOqhUb73VrHN5x6xxLprdi6lNuF/455I0W0PbXG8m5Hno2X3kSp7cy4SrZ4y92lhqFrXDZpaIw2tBWnFcYTo8OBMZZiTN3eDN/DNMbY1Kb+4B789CwM4Z6GTfgX1+GBfGiEOPz8VvvKlxxQQeVAbjauCyLvaxsKRWnR4x5nBBWPi5FRPiEcMng1lgVs6SPG4mTT2EhNBoNqbkMIauKLjRDQGTKQi8N/cd6HV+5BIX5v1YygHJeyI2+jYAxPZx+uuZsnhBPZOkbxHx4rlLFlr9C5aeOEdr5319mGtzBZGEnoxj4wdCSBNJBE/b2GNkNNBY011hHePZi/HeOUCcSr6vqzozsO9uYz8TYeKTU1E4H4CCkiPEoG894OsAPRw85cCJShan/03j/Bw1UkKsuJpFsz8sUEBnu+vIHH/y77fe3Tm26/Bjzd3kA71o6PSriNGi2Iwcmib7TxqQYG77X8oYkCIScpRbhwLiH3SMB8MbLgvZsd6/RQjXaMYbuDbJyUl98IzCEBKaqvm0/QJw04qSZbPY6Rw6nHKxLMtB2Shcbrbn0PU3hPeo1bXL6pYknGsxeaEF1yakuv3X/X6usvvVpMsoh1r1B2suuizs9U1Xnd4+fkueXkNERLKmBOoHVobKgpDZ3QGgZyBW7iSOtwzeCHJG30C8c5o8PiQdkPlfTG+/vSH+ad7WeQlY1jMDJGvOFYS5ut2EkkcQGKOZ9J/2yRva/aJh7T6lguI4G4Iqji+0cSijWJCpKi0hSkbQYChnRdnicZHQ8Ov5eS445uzUI8vQIkL3HRb2vInPHN87xx9sfd7vhJq8XPcGje5NuE5yH8qPo6rJNCkVc5Flglcw2g7TeKdiBj6+NQjEuI3rgtofeLbVdPZqrZIMckvSi5Jc//sXb+Fy/O58Hujr3QoXCO7ISqWMNIih95d4jMjqJ/69fKS1/IzfXqj0tpW+k/jEVvxeeAe2znsMbj1LNrqVy/EVEet3wC8rkoz7v7+B0e3f0HfWQGxOXvlJ42nsWzp7Wed301bQ5kXTlFiYAIvQv+eSIFesx1S1Kp9kBK/X2w2l89zs33nJDnNQSThJCnSPTLVVfd4VxYAXYFxbeBIL/Em6YiNDrKSDDmcc7iEj8wtSj50/MUASGqsCghJk6TGKCS6Dw06ecZMmQyZclmyZHLljfgyMdVgE8hfkVJQNo8ouDIUZCiY6AxS8RjxSXitScTkEpJ2ImaqFvp5yS9aKn7MtINZJY7hFXBWHYlE7mVT+GvaroAbB5BRQzwmHZ3lLqj8XhQTIKjwh35lmWGEaNmWsjwdlqx0g8SQ3zHUq5CQKVqtlp1AurNkeaH+NPd3SWW5Q/KgmLZjD/8r9+m27F3eN+hgKOE6hjLCbbTbdWZ8w9pA1guH5Ou3Mc88JDi0YDPY/yeEPcU7lk06ZNPPZ/h+hzNF7i+jGq++jrlGzJ8S6bvyPA9mX7x7F918ewfOlsQbdEy0Ato5iikIDJ4IS001yhhCxNx1h03g7X4NhJ0zR2/LMsMI2Ya/nq666xGU5I160m4XrURo6tPrGHtIXXoM+KrU1fc7dETZ732kRYXjDzOZ9RE5E5YitCy43F0gj6h7jDG1llIUr2vKXFCV/CHBz8wd2/PJUTG5V838iQIh45HU+d/1lXS3fTkQEWk5duJ0UxNd/17goeRwfCGo/inKGNt65mULSK58c5kKAnvGOaJHQn+EY8f/KEPIVqSHcfkAqmR4weuyGfS8R83janH8u0sZ/7gTyQG+2Km40f+J2XrX/TTp4V6eRVbkr1r8sgP+YUy13HJeRN6w8fQ4l3hXN/FhLnJAi1VR7vwAceSf3gGBQ8iqf3drkIr0kdJM67tu4Oyha4+fOS916fyHUNnEZXwyhvFuqgpFpabYPmqZaDOfmw8mTr1g47izqa53Zun94T9WmQovgcd4QPplEtIBRyE92p7HGXBqZnHWM6SNDdm87LCwGebbNGGJ7CiUnF9Sk8c/ufYQdSkz9aBnSuvcZ1CybVnicmsYJloxiB27LAhdTe1ajfqc7crIfT5jq4zVqv6pYm9d/B3zAVq6h3t+KEfPr6xe6Q73j1/xS7Rqfq9UqKdsRtHqY+BGejd3DeudxX975uSx+AdcwfjuSlJfl4Ch/42eDhzcNedN74HgUQmoNyNUu66aCz8MRQumrbWNr/eiECX52ZY+6niTcf1hTNyN4b/b24OM5SqhlCtKcKsTdl2nKtw5VkL54fRB7jxRwRcmqD9v0xVsMZ09Dpstqdt53Td+/CHOqVD1BwBjAx0wUGmQSPQ9G9MsxaKVhOESVOk2Vey+ziPuIFSBvFpkzTNQZkPqA7dPXUEwKT9qrtyg+EmDjK33CbcGQ13HzP33CdslMlic7j5IkAQjMH8WxFc2yq03moIw8KJpFVRqLRBn6cYnQTBZHP59pRLgypTUOXIgXwySKGJ6Bs5BD5FSbl5TCSlSMWJyBpJPezQKUw8S3SKOSERIVBcXwpFvkjMS2kKyI0qA+Z/ItaCIklDaahEYTUpLCWiorG0BCJyB6Fi6VdO8DGJHIPUkZnEinez8nMgQTes1CQilST1sEPBswRFTAgUT2yhlJZSkNw0wvBl8SWsCoVQgw4Rz+v3vWVuPau8KugD8O7oBvskW2dNAlcXzjAO7UkwBep0+Dfg/r4Q3jlpCZnwE1/TNH7hmptfNXzauR+T8BduOH3p38XjuVs+PPK89KLty780z/NXYlIr4o+QC9g9d3PtdKCkzXCVHLAjMfBssFvgebv458GatFhSPMf/9qUbpz12BRAz9baV6o8aWu0hq5C74qiIptfCsp73TZfBRiufVwY+Eeg+/7K0Rvrm2+++/+W3YlIxtQizY1B+AI5UCSiCYtiWw4ZIFcnSOLIYlUWnFC5lESmLQgKCu+GaS5QjkgB6yqxkgVRQW+VZwxfPvim8PuJhvDCdpErSX4K961ZSQvcx7w5lkIqU5pfmZ6RHiUanUakbQylFrVJWUgJIFq+ySJXCqCwuCewGqyeOCIFKBdbDQ3lhX9J0JLhCYoO3eYWDP/aed7GA/vxtMnv7WWGs2qVTrM23aYm+738GqmkzFfFl+Ece1l/lfcL4r85BOAfhYQuAP52D8KkW/MMWYKW5ZpRSOXskGZIhmOiIjmi0u2UzQK06TljlhdEk8ALvVhcZ0UX6N2YVI4mddMLSSdOW9aXPpge7xwMB1rISD30vLud9/61G8u21kKO/TCwQ+KGDfrmOwF39lxQBoTOTOCMHTN5hq4dMdlFseO9T6fBf3FaDkIAg/iHNGY6pENbQsz+CQJhCJoNQnEWnjMpfuHjp8pWha7bHoDEHLFiz6YJr7njkhXe++BUgK5F2xGvh0Agi0TAl4xKRUWe+0rIOqwsF2qVQVIXxVHhBiuCtSP65bL61toCLRtRoNFffrmMBHyNdQDvaofF2/qaJzKB0WECZsoQK5AgVygkqjAdUeJBQEbygIkOEiuEHlV1IqBw+0PBAOaNBtTfeM7rRlC1ozo6W7GnNgTZitKulE5ku4j75utR3m6Bwju5mo3bdYXPElLnw1IrjTqPByWbayoVCFClUcS6sc7VGzGvUgrZb1Jij2oGpcUvXJ8qDMu1k4j0/DlbghYfrpw4xs/+55gAHmWVutJ/iUKGQeN6QbPg535i5JAQAmZ9CoIldFO1MAaEEgo+A+vbxOBQ3CL64FbDXg+4V9dLrVHe4Dv5ns7luhZrXkTZxNngf0L8mAzgbF2jUbUIF0nU6HBjTs7KhnABo8Mt0/vw3af+WEPDCeXfciB3mbFu34ZRXxiyaNO+ue+574KFHEeBnbPDLhHEfwTufwKiLcAZ7zBJYBivoNVZChInBiJMKiiy58GdJiQpVatRpOIbpuLNW1NKkQ5c+Y6YC5MhnYGx4OK9EqQpVqtnU2apDuQqVqsiFnrDgpHOg3cIipk1MwahWDbt6dZrSa6s27To1atCkRbc+vfoNGrBi2aqSfku66qZbTlt1RqbLLrmiTqv3CCT89NkXX93O3C///DXst2o1XUhbYr4IEb75E8CfzkuMnSloMkLJk5RkybL93Z6mzXiL1bb8XrquPG9J1nxsWTNrLn54NDixoiVLkSaJlEwgiUIGBYWp16xdp1579Os2oAGYZ9/gVYrhrr5lgw1Kajc+8cXv9gwbOJIzfpZ6NU5rUr1YPywEi8SisXgsCUvDsrB87AL2CHYRF4jD40i4aPxmvAveFx+AD8Ij8Wh8FF6EN+AtxLt/sv6JxVp4ChYcpMXoW9Uf0x13HoiFY0OwWDrx2OZpeeSfauo008onEDCuw/974L9H43Y3/vk74f/XA77f1ke+3/g+eIZvOeuz33PfY2/+Lv9msI117sgr8PXf5/HJhtqqv4rAp4NESOUhn+xGnp4712tukKe3SPy6wbnnn/p+wtS3Tu/BT8jLs9/vz4tHRSPm/j1fc9tRp7UWr3bYN4sua3dVGsWBcEJd+TPW9McFNY7EjfAgvBvD8zP2FhYBMS1UNLGSMjghzlxJsrihnKI3JFAuxxmVJ61UcW3AdYEFa+PQNltxQwCQMwLuDbjfxQZ7KxHiSnhzZyilN03TgKQxPK3CNYvgEKVVpBbkDKJkGF0XA90M9cTNpBgdjAwwto2JQaaGmBWEX3CpxlCMe1ztngWExi0snmH2dnJoNZUUmuRkd6JieszWvouITmQPN3u5mHIpsviUZnma42WeAbZSW+DrMB+H+DnC31H/tpVRtmNCLAoqh6LciqCZrPrFml+ty3EjZaWVui3PXfmq3HPS2054q6xyHBfsRPUaqLDnAc26i3PTEbcc8wZ/IHvILIXi52zasvpFF4Kg8M9rT/4Qjljw6AKd+erxw4Gqh+ZkbYLJ7F/BpteRzfd5t8WkZA4CK76J6Utw7BsvgyDQU1pk6ek/NKt8Cn/4lKjaIu+tHKpNv4Kt/pfB3ozKja2d96A3EG0+IQEsMQDAyw4DGNqKP2do+mnKk5KLzeLN5+TNuza3z9ht8rS9OVHXuueCuresjhCpW/KKfE4RZZMgZY2oP7Ek0L1591FvUymJHlhUn44aqiZQsttxgOdsoNt0kHOAQCxsUKtbwfgqwTTCNcquz0AWoDCV4rXtrIgCcpzhVQ9kiw0ketBMqoX2S/ReNpjUyep1Kq9cfZOT5Rql7SEtRKcRL3RbAQYEr0wosQvm2X3RaAJprODiECiBDBYDApIwSePmHw2FiKO0ZRkeKvPRziQmMRW4iJe9pVejwPI+lcHuwA1EqBUtWmbuBeSkINpypIK3JaEw7QExq3P6A9FwZbIIVkJbeqWsZrZ01+aXJm2yd5G8M58v+4CBjpMLtvJ5wASQSeh8gls/zjRmjJzdm3ExYEHY9ggZH6XIP8NkUVvbx9RoARtP36VOq3k+i1Hbj4CDp6/T07cVxfllj2+6i47meO8XrP02kEcszyCCLXb9YsYpsGoyYe1V6pY7hVpsvnWe16I3IH8vujpH72DbRDYiVqrHCvCIn57ivXc7CsHmAEFHE4is/4EPO0sE7xKWRi3QNKIqkEUVIP9tz+/2zIKz2Nb+3eE81+7O5IAfHS1dCQTQ4a4gWCGIwlBFYIrCFUMojlQC5ZV8aAC+AgVTjXoK9fesk9ixwdWzlO2a56NIB/DI8Mj0yPLI9sjxyPXI84WiPJIkzlR8rmBnmvHPM19oEoWMolT7uCkBxU5xDJRCWikIUas3rQ/LvBbE0dxAmW+sYJXS2JmsLFIfBLXrV+6UNLCOCuiaqlegEju64LBhwDlDUCta/QXm4+fint3sFVJdDlRBo1aqfiKBWG8GqjGcGAM1aBGAG9Td1KLjsHpOg1YgTeozHrc3sYZSlfp0mxnQONVI78rRy4E6gFahdkJwSxReOWglKo2NTU49LKRh3imoxgZhsdbVl2+7Sz/x/v6I23tI9znHaEJSLbPhMG1ZoGxHC4oA7+ktMMvWIKxbWASzurdYPj+mzfXVJGhqy/BhYx5Zgu2GBAxZNBFtiA2Iyz0Cs3o2RQDMoDHEJABX36sPnEFMNAcazG+Wz4alwwQbbwYaqyJIjeDmJZATDBRpsheUnnemHj2mQaN5VxKWgJLB3jK3XL/HX17OS2mTlw8KUG92oFl4C/0ilp9mU/0EP3a2wDQ47Pkt3ySNeeglgiuB1qrpXuVpa5i+/sLbQZ1T1HSAem9cYaQARdOMdgLlQIkHOpNLcCyMrCVNowa7Sevq3YUu+xbj8LdilXhQdvhpkUfrP4yhbvNZPbHbEoLpjp42b1PeBK++dK9vpWUGJ9QFnylHBqpioA+k70dHAjlC9TsyAGpv1KAerS5Mg5ZNAX4WHEIsygxuNMOY0AD1ILOKqjOWooEmtVNAR0f1GWNIGqcAj4maM8aStE4BGRu1Z4wj6ZwCJi7qzhhP0jsFRHzUnzF6oDkdW8inWVzbuCnOlNOpz54SS8vWVKoFx9gqtWF/0jvFMggpf4VIBfhEVVYni1XPCKleiNhAVE11ilj7jBD7QqQOtFQ92dQsc+DRb0474KqfJsZ8SRRubSrRuxMLCkDAYE16wyXYLueaPjKa1gsu+APgisvBwROYFhIZicSVaFWhe78xphwl43O1xKD5zc2xG5JJQI8rsyaJZuzLsCYd1iRv2kVKkixBAk9aWQWsPb9W0NSUTnNGxbR6+5kUTjJ3Y3gUSumbpmllkMsZ6Lcbes00U5FGU65lTYrp/S0lKXYS6y82GS8dBosm/yGarK1ZtTEhZZGgpPOSlQRrs6cN4IgyKaHFQC5pUGM0eeN1fsAzJIOVlIYcAO8iw/emnBC5yWfSIGW1eGNoIjVdrigBMdyOpn6snQakGsOgtJIQZXeymDveCPOxsm7OXWrmQjeLV1Zjh7FcTBaHQ1v6UW9uPqZHowXiQdzZX1fCh83198rVm5ZPb3vvxcZebeshAQyfy2FoyM3zPExZjkm3TqW5Ylzd4U9/HJvbxfHE4Yf/v7VdC0uDlVNzUOXhnsgGawZG0EOI2kFVAV4VDRpRyIPpPYMZDMN7CqVQeAajcbXRmNPT9aElf+v3UapD91wYWdYc80E6+zxxp8ndYvlgTGwBfvWc03LACM3uJlQqocQmhhiDfzeH0KR6VK9ChI35mVYFmy77C57XAkOAUs1lQvHoAYRGuF04H5ZfsESibe2Em6NFKibJDfNIEKLO0KHVBB1TS0tUUa7BchN/CtDWvXPCsL8DMWjtaKlhYbCby+v3q3k2E9CLORkobwafhVWVJCMhV4A+fS7+VHShWBWppjDJjtc2mKBx3ig4uBXAQMB1eQiU8EzP1LwoC/yrNBKMW2GS5IBmM1JTFKX2mKyoPlbMumPheeawpA5lWBR961NGIkVZk/CuhQiSRj6tWIn9xvNf918YlsyKAr2zFhRpHYNFQa70rH/FE88Og5kbdKX4QkSGD/RYDJrOZuKJJAUb0IwUeNF/oEOh/TNfMUiiQmxUFJQW7bwzuegB97GmSSWTlGBFJtI+x1vJX1Aeot+sYKVY2CaIDDOn+c/6MLuYW5hb28qUHhQdSzChHIrpCMVYooiNj6Ap6FomCeLHsywJ5n8iRXovkXFLj6CnkYgQUaFdOmGfeS1Aqy/3b9WhfPYqM6va4iSoKNpNfZs1wDw1UAZgcy1XbtQ8LzKleKSsMy1pKpVpc0DwbwPWtZlF5kdlLMaZoSwXyQsUkWBtLxX5mZCPOfA2bDajGQ6zNUw1FNIuGshWXA3FaY2cbXSS10smvvS9uEHft2u/IDMkhevalXjMuwCek2JLsaVC8bKXGfBrLk9Dh2tRdy3fYnguF/fmyEEDGAdSfi76V0oaxAzvE+hVuRE3jGsVRgEApW2tyws5+e0m/HSVknq6pt8IBKZeh3AkaNXq7RYeZvFM9cmUdI2Rdaxs6SblNdxGmtBttAWXkVLI+pyjZ9dSScg6ykB3o+KT4kolkax+Hs25SUQ+ZUcDP5zRFM6M4ax5mkOROiwm2H7e9RrAVLUmFJoQERCaITu+iTVu6nMtPZYM+FUfWszYGxH6HRXK82l10TmiZIO9O0Isr/ePlTEIAgv+YnZB2WOmIj59ivMslNXG0JykYmoJFncmbddeMBfI1AnNxGGklwo0xZJ6G3il57tBhe1+7T9fgPnM4hWpVmS0eR75PnaHgLSAE3RX7RcMFhss1Ra1ht5+kaQdlouthOpqwyGFWHTDGIZlBVq7mpSQ/Go43pjNCsPnFu+mjxvgBnaRKCFmhUeRlpnVjmnF5M2EGBKa5z1VJiFxs8Ep0Fzu36YahzhOsn1T6sKBeZE8XUuNX0gYKWpD3pluWD2OvoMk/DL3iWuAAPnJCjDFXAht4X9GyRasd2txlgTlt94ztweKQs1KLlY5OTrhRQ82HzGcx/C963EpcSDFG+Q6VqBKMZLDFjMzXi4vQRmv9PWP20SELX2hiFnWaOkyZvUNfEfiMg4qmrR43M+XXb/dI2xXWaOIeaQXbNMZ5gGeEbu2tVo8xea0Wt/JhZ3Ho34ip6gsuZTsWnQpPBXNbIumLdDtosTJ3lppPg507omtDkKjaucANyGlYNtCwXcTrIp7utFMzPmgWDh+wzFolFeZxxdMypUmrMQkoXsF/ebx9sKqwnWClviusCgZacZnxgKIGFudZYkL4BNpBGJJIMHzw2ktY30UVlalDku+CCynt58ildKzcHZUKD45BlMeBYycWFY/7rpErJ5JmsylegZH5lGwST7IfV9+l9qy0iKlrDtsVh7GB6/BuJUW0XYj07MvTxTQ9fnNZ8g2WgprEO8fpSWtJpV5BcAzLgDpUnztJgtYSUIW13Mg0RhxjqZDX4+W/Pq7yGQbNsYNQC3TBZIpAHEs3JJOBzNwCNOaUlqOXEMPmsuyWC/qIbXYKB8yq71PmW8P9c8L683Aa9o6EDclNY2aZAJWQO5NWj6trWj/0nJnlz7jVIuvdDINT4b0opx9dzefgqob95Or6ZAK0lBvErKdqTxlbPhcTiA/EntvSpPPpwWrs1GIY1VXxbvd3GabTQgvWsX8sIv0i5jbyYjnpLalTlM4cveSoaOxsdHPEOf0GvH2VhNMNtEZ4DnELKwRgzpN08tg4GiNAT9Wx3BDapcUd0j4uI63qKUDzNM+8W3LL0big8UCGRMGsl9O1ncDKQ9O0fx+ybnAwGM9F6UiZiC5ZTWyB9ORTLdaDrYUIw3bmc+pJvG8SvbMEnxAUJbQIlm1QeG4sfRBAenaZ8VRjdfpkaUwdZRSrNMuJ7O2BR9lrV8qqQSnsU8y9kJlGwRRHWsK3+dbGqIgT2lMTF7pZhThIOFrcdRGuwzLadC1dkeX6ossVzLymPxugjRTbx2dv0UCXsOE4eYMygHz9F7QI4726lOS9nT9p8JwZNgYqSLHvMpFN8+5WskpwNKT/XRI0TTHVl0Dtw4lFU7W7zJIFhfl1qQu0q0o4L1VQuz4cdq8VYZS6GzLaribbKJR6HBiB3MDWNhxmCc+96gtcrw6Q0Swk6WL4c0gdMnpSerjuKI1Xecb5y8APYZMN52wy+P5r7KuBtiMOuL7p1ZkL/Py8YKPjyXgvoEBHEYh4k5Y1pB5Q7lejfxsRg5SwI0zecA3c1SQcl6FjmoXtDQUyTPP8UOeI/AfL6mr+hOBl7NS+tGs4mdx7hm1VRDbf7pnAzyfvVfGNzI5sNGs0sGHNDnvi8OCqJZNC9wXfw6T7ChKNTZ9TJjOat4mhLI2QEc3xsb3plQ6bbKUDDep/XFIa3xM8RJvQEls8wUv1B0T1bWaLCYZ/A4YmgX4F8HLGS5Ccas1J5J3+aI/ILvunlkSo+47AWWPKsEJOww1F/5M3Tzf5yTURqt+3apRWIeZXobUDSh0NPc2Dd5eTmw7mx1mJ53Pj1t/ZguQHUqGyAzZKYqqSRKLC9dnnQYPaVNnUilXn8Ban71he8xb1Nl6mwtuTxnXJUOdL6/6LLprmOX6lcOVNb9Q73jMtttkZJe8yqM3wIjcC8H+PYIV+7BSoN2mmaB32c35Lt4rZsM9Us7mWQ3d4zzincWNYF6/Fna5nBWNUONiaTjHx8CrDHkNrLPKEJ/1WeJfngTfPNvtmbIGqUDW7NjYzanvykRTmCLy3SqDJ3mUVSiYa9sSpBnkwpnf+PB2HQ/EiUAw9CHnP+HEBz3APl5LBt+QWQ8B0zVAXMZm0PLobuOCCjQ0ERYhg0kInXyMiZfIYpY8wzTxfH+tNHcw3+/G5lgSN4vac9R5wdlKYWa1OEtpyxOgR5TeDDs6vMLSeA7tUE9RkhrTpybGNcA18XwAHfVxhAJeuAQk0TwuD36/ybm7oLmd/m/krOrH84E/5SJ0X5l+7aww9PoT5S1Qy4ehERvIgsMxN2G5L4PfJCXs78WgCEEM6lXaxk1smeK19FeeGdK5aKeaTFPGbiyPPRgLM8LJDRohbLo6s6EKsegSIT2a/O9VYdeJIxHH45fCF+F7LeX09RjHvSDgDU/daGUz2/9urY0c4+04PrcjBE02M6Nu4HDxCzUei/U5FMB2x9RaRbVWsp8SmwTYz7JPgID08IHyhq0TFVjgeRdDQVUKz5Ki8h1iicDI4LDXXUAsytO4lBsivhAXmdgbckBMUJG0Xklowb6Y44WexMOvtCfAuH1oi7sF4prX5Dm/gEiLO8ROr+SE3CBd3kPS7MEaLBxBBvGnl/HLmP+L45+6qqp7NL4aWg9Ifvxm3WLt8G52VPua4kMA1GblJu5mgbsVr0dlCw0b2bycIDGRN4leu08PaO3O9lF8ZdmOZg6JzOVLxGtXzQ2fLfzLNAfTUEKL9ahjK6WlzQbN15oNG1q3opPrQ9HA5+GUo2Cg//ebjgf+pgJgnuJyohC5VeJ7Y1lu+az/Ggc44DPm97VxQEuOHIEDqOh2C/VwiCb0mkFT48dx3pLZUx/RoRxAQ4MW3bln4mbLINApZaBU5/GOwKLkFO3GhRYMPLTvusvd/wXscjOjlT0tJRrBsx3bp0m834DYDMMT6/20kH/ObJOGJOOnM3NEmCjmFBRPsXPybJawwjAMtgvXqpm3TxnQmls12oKIOTTsdxtbkpwWCGyIJfgGL+TeVrfih+sAvAr2dvA57p0SSUJr9LLbTXUCi3crC/RNiULUz/QEepPpRue0bEe0k7WwlK2X4b1lGvcfV3nE2GZfXS9ysy6lCmVGNadqLWAgs1/dah7X891qBeyYooqmY93od14FMKwFvHhEg/i0opZEV4fW3SU6u3lHZ7TQs1W+0Ls4hBMDrpblu7iBPTkUbgM36CCHi3WznlJjbVdvrmLQZNmNNeZSuu/JehVuXXXtxkYNZXXOkPJdzZ759vXy/Kf2tjU3S/sCcxQGYBQh0X1GaZVnQAAircrDku1RbFOuMYbDWjRi6M5u/P6ylfgil+rv7zRGliigVkxdhvH2MW1JORGtQ+tXEBtFZbZzDSSaiWs4/UONJFlDpNIrV6AXoa7cwdF+ykYSrdklcVxeQojrWb78IJpDVG87pZao2qPO3fF8TRuv9Pl5XfK9oLTJed0nuDenOgnjSyyzPDijs9AffwsNbSFJWoZ7EPnhLux3FcXoU+vAaQRMgbBKnx1AE4reKZ99rfKU3TGfrgl94RzvZiw9jQcnEZrR74czIYykG3CxMpOZmVmROxqFyRyYpB0dZrbM5j7kE+QkqQHR3GlVeiUp5fmQrj8sBP4AlwcCumomCR57RSJYSnvUYKvXvYbl9+Jx5fBMqTtyfTjBZFlEOXmvYZotGEOoQHf7cDsMXqwRCq3v94v4RoUWv6tNmUK7kNMEL2VmKQX4+/mG2J7H8qqfPSpLuyRH4Da2G/BHRkQaFs/ROmNgPPF9y6iq7B/s8t/vjnrB636Tef6FxA/CTt2LfCffU7uecXXl30nj/+Ll3OOvQbtidKhVfoXS7Gk9GFZNyq2mPDd1lcTA5RyS6HxSwN5AErU/nZI/dnJSLjanM2jzDOxgQ7CBiTsMEDjz2mHPTrCoaXbgBD9K4PwlDIDOCcHYvYwZ4mkuX/7dC9T7W5hvghebvtUWZIZTBk2qLOd5GysDhLOXhkSicjbSQF8I/9sflsnfsaS1YL3R+Q7V9/bahcj8otOK8aK08JyEeJT8bs5LBaaf1eFZFzN+t+S/nyp0UVJiRE39GU9sxUXfmfoFDWp7yw0Gc30rHUp7FPRiaBsQpt4OMgFnclWctBnypXAqiD8JUWi+FLCWIJPIv7J6s7vzPVEGIyZDP5y6Acx+oy78o5mmYj7IC6HrzTJuosyy0hCM33UJrBloAZLkEQl7kHDb2bUWAlhGSMHGlrjgi2STcrYB7Mfr9JOXcyb0yAmqYOpV/xvWZ9F7gpO+B9MMr0aihB9akFi9v6PfaCP2B+CZHypLI+Yw23fXHZyqWtF3FCYO6hhYA0dl5vIg+1EzCzVB3F0dSZdZTX/r9EoDrh7hflckWTrL3xlL078Tz1wv8p6bi/G+sLqpbN/UyyK9Kk2fTfYLYKqobaS86vOSnh0u7/X0ufMjwGOH3ErZxT5ajJjG8QGKB294FHxdvd+VKzhAKWBiNyp3BdAiMvyeuij9XX6P/zYochZJUqEl7uGOUbfnGQvX/J328ijr73cFBAjcm7W4XryMj52BSR+AzTwVXLwS43prsMfpne7ti251mkJN3Ql0j97zwb1e+1PqgYxo1zyXq8X/J6z+tx+HQ2bZUDhr+PSN2hsjwPbADPRlsXyP7DNn7kTvif4B2F3Ehy73MHjzS6AvKGy9YTv/+bpjRmpdQ3pTAt56Z0jl0hfu4yeFy25cT/CeW4j1vnrdt/SoZge8JbqU+wlTtz44WvyRntCEUDWvy7aNu7zb1e9+f3TnGWm7eZqcEZb0RaDFrwqoPcVm59qw8+61+x7anduByNvH+QshZmOIt0rnM2+fh6/Bnc/qAihosA44Kys5YZRZyx+s8JvIwrsm1tXariMOlabi4BB9sB8fGjAbyy0JE6gIXTmn1oBoNzGD9D7p8+DEZewCzhovyzi65JZ4+KjgAutZ6dOEhPtU/dgq3fhVYMWen9d8Rn4HNv90lBGXCvpCHHOae6vZHevduEvmiw9B/LAmto5LBlHh6PWOCmoItQJj6lFTUMerbsx28sVb6I3M86bpae/baFPNuTtIw0sVwX75Wv9+AhwT9B/Sts3eAQ8+L6Dfj0dgoX/Avz6MZxF6ZYWOOC1N6rcUINXkeXv3kTTa4nIGfSedRC7Qi6ZiXhdXt5TNzOASL66OOJuvW3MqD+CTmgWmVff3uscWd9TZK9AzNiCWmt/teYvu9joLdI/ZEoDvUZZg33SHPVvZbySl6xQXJ9R0TXfc7/o+X8TovlVcVB10QtBNKL8cI4829UuPomSvO6UVOTDLIiu1v5GYxVBUsX6XMYPnbfFHpQ3jae/39FSDhomjO62lSuMEO89is3H86I4ctgfYH/yLqvG/qv8bX5YduZ4rPctejqz9kDQ6+7fGq/GXvJnTaXxQQmZVW6O0sqhK7rajjKlnsm++pb+R2m1nz1vMgkN9ebe4Xd/CPbhpIGQcyeG3tVU3QHjTEvl6LPV+o4A0HSTYrX+Taoi91j1os5bwFem+6nZBNOA9UneEa8VRJWkdR8rHrqCY+MeLjAH7/2IUMaHl92MMu+gnmB0lKeO5Emp3jrUrIyuAfwSY8i+O7vT1f48fbsAJOEY8NwmnoCazZIZ4R3hHlINpULGaswvSFhzNS4La4cdmhSY+11Hl440f0PAxOki8oJwo5ocVJnFYWYXxLaT28BZGoYbVUShhjdgqFzjlTTcyxekCPlA0cZOm+nFpvpc/ux98Ng9r6nW6GSXNksO+CP/7hW/r3y7uM4KoF9/2OdYIv1595Fwjmi9VPA/uM7KHkgzlsbtySlZLWmUfjE9f1vX0VGBier7MrSWrxTmxuwzlw8lAOTwVV8Nec+w+HVHtE1n1TRT8czAjmNNvvHtMXr6mzelO0NCU6ztNuP5sDjqgdpgi9E7NnHHPj9sBQvA21sXke/cKy1cR9A0qGQHaq6fEXzJmRM4K5N083oQcel9wqlD2f3IEL6D7SdlbWcZn24ICn6DhBNL/4QpyelL3k4F9uTRg4gknCgH+XRPpa2vPbhyrp+0vhpz9+YsbC6tBKa31KeaPDZ5tCt+Ln74GLpglrSVhPyg4jvx4Pp0c8PmpU0uoI7njH+wEcDJU4lJjz9rpM7ESvxOtsfcbkDNwcOaUw2kRXRFD40WGT924Lk+VBvBDv/rCqlqlWJU3aLrjs02UEc2AHHm6KbCwLjNBRSqAsJJKsBIO2SxI5lRNaJ4yF512NViajFw9lh3+JSxgfIThDHGYG5+NUDqj5OuzzymbPD+kMe+3/zRoNjQcTFWyXEeOTykM50sjql0ER6scWxbx5UyaKFJnkisTjaEZGRGV4tT3VYqz8pqmHRZsNkN30tKUNZJW0px0yhbxL9bglE3pk2RbyRxBbsh6hZ+Pm9/PrlqWkVgaENdwxRZvP6nRlcU3wJMENfgsAblUlMyxj+c+ZS066TVY2lQ9Gs+O+PrvBVhv6HDqGMLtu7feYPbNFOs65ExRb1rGQV1p9ijP1BF33t6SdMc2OrvTYh4Qx/LdcutJduC8ehAYPOY4/uHlM5FK4AmZNXESE2lEnxcxKjFUxPI5KrRFFgA9HcN2UyUaSKX+8YVzgsYe3mprTeq1psHTO+zlc/xnwGRs1ER4KCPfFdPy6J23Ffs9/P0QaOSO9yzTPbxV6zouKetJulCHPbhfuNsFeoFbYrj2ONHI7q3LJtwwJ6cwIkXfZZ7iSTisw4FIJO30LWRLDxVW6ka4pk7G6lZb0vXqkTlqcX5PanwMiMU/w6UZ9Oodt3E0haROiClvZ+PkaFqa7LA7zdTGuHrM1eptc+7vCv+9zXj6ydwnO4FSa3otQ1lJmd+c+reBQpxaREjNrInJ1JIa+ErKVrnGSvbny04bW4TTxAtV21MeOw6c82kyHuW0OdLP2ApSDtvbFjKsltPqNG1gv/1KAft8P/tsDbI4XR92fRO9uJ5S6HM2WUE91Bx+0OYB6LGlqfhirrgwPKVjZzup++It11yWlVgdEF96Wtgx4HSnu4x9vrnvNfeq3OlkW23a0YrIYMShzWHRI6oCe7SIZ0ALQp/5O82UyhwcfahNfHCI4+THWTafELUMCG510nqPYthRu0Qq78iqFxaIrvDt8U5ItRd/AZJx0Kij9eXktVMV/G4nf5hTRLQYpgnRcfIJnWhDgy9gqhz6VE3V2dSOKLmLwkXnwiiI093w9+b6REoqyekyXCVHNS7UEe9G+kYpnAEU9Gmv50jviL36LxvWDXLbC+4tlZWr8Cbs02JlmamNviZVbP5+ZYlqK1NtjhrR9i5XZ1m3lssHMhPZPy6P+swWz64Rmc6ZrlnOz4U5wJ0M3uNSq975OknIT5y2Vy+m1JLk7nfY8txve4CgpQOt7frmr5YNiwYQteuaCY/gNfFrTNC+R30T/fi28SZwzhL5ZcRPN1SfT2G4196Rzok6LXej321kOq+wog/5ltNv7Gj0LDnu6O5P3NzSzYW3PHI9DAwZ7jDYf+WisJqHMC+DeFQDg3W+wjKgMNjTzhdXAZ8X4xki+SaWevY65YV8GQw/4E/PkplXKCyyEgFD5MyumdhSu6HmFGTj0vCS4QYDZ81xmit5u/LUJ1e+F+kgD/Ztcv1u+ndx8LsLLAh+Ii6fZ2WCT/hy0GuqZKAFlGGoXEhAaoMd7uTpDhi05ulj4FkNFLbj2RPK9CFW8Llox0H4h/E8onHIWrkMpfiSk5yCtfKlFWQJLuOYSxh84KuOQBlHpDwRJxnSRFKl6XW8PcLXC1M05PiXadp3vybP1g3PPgp+e71+Y0DUZ1ky8+SeFP3JhydLObkhtfy4jRRzYYT5MA79AZipjp0sqd7LsUpHPW1BMzgfOQOESGWFc0TXYHuNkWvn5k/iLKaazsAhoR2upSnhXUPeXu8bE7vNYGA/pKFG30t1cx/V09WfdtKEjR29JRUfGpa/iNqvwlipXP+cL2O2bso1Vjr9gzABhaO/DGdFK8ROohI1MSHbkrn5Nsepf+ykCZomyDq7ePrOSImqDxZT8m2V5RV3u/Uul08JEdcrUQ1E4n4hIt/D6lzuDG0Wyhj4yPTICrhYVIOQUSM4nmoyF7en1gfHUYgTmkyaIUpW+pcbEjDCQqGvw6nDrTCoMIalE3LYzc0FpxOqqw9FVWYwDYzM/BIlej2Omwh9xfuTJYaqwTzU93+Lepii5taE/ASEnKrWGCCVFpMTenR5EXHZw2dm7QPIichd/MznINg3iXImwQ2/NN4BVXF2g2GtbclWS1XccnEi5/5Sb3fG9JtLbxJriAAy8fLr3NfrlkgJ/jESuR6QvOpSA9aH8xgvAz5PDsdEJMQ6Hs7XrTo4BjU/n1oQB5l/+fXgV8qdlBSMVTDRJmKzXb8G2Q+Km+4D+gOAk4cyNluagJ9CAHFenRJ4laPn52UXyKvuJRAeP4/BHT/oCF/66KysNHh4r4NxCQtGviaRerzi4vlDurzirTXBBi1frqEbYLGK1lhtEWU0r5Wt8kocRrIMfRmfkxz5/FBY1FjArRb+vAGBKuOWjKSsyDwos08g3fQQn71ZYb3KwrY4LU3itxQg0eZ5e82Q1NqS8inEXcj3OKedyWjr515vbOJea80Z52rbkihaf62pkXv9wC+5uDrRJ9s2z660bU1KbHRxEWE05+zOTVA76kAty6yNk5nCRnN20wtFGlF8tcTOeNrZWM5BliG/JYXPuUrwuUu6cIXMjJTbKN7YOocvWGuhbGWT9xFreTnBzrhsu9WSGg66Kkmu1fvo8F3d/Ki+f/NsDm6UV1RCF6SXwi/CFd5zYFNhdcomq3c7SdGjN7YFgk7Hsp/KXbMzG+KqVx3bzPOiuv60huxH2vWG/sMuxd1X8xkV3enqvKQaLDdrKK24maUsIh0PKDHiqA40ET/i0OncmtNkRwor9KqPq2uSbVgOuy4WooSDGeEBHZGaVfQjnFfV1+sLuvnRbyns8GXN6wKc/88hV/nMJ1SHu/QsNWM+p1Tp+j8w0zaBzFO6kwQCTZHUkqE6C/ZklVePHu6Ti6eJgw9HK0I7lr/GojQsQFmWrSB8NWWZ0DhKOjRsARO2nkHMDzFi88MyksjaFGFMQ5F2JCa/7AR/JYLXmu8f0NKpDE6JkyJThvCRtzJIBSFGTEF4Rgo5h8OiluUqeqla80zq5KsPME5kVZbGwZS5eNnOL7ls1x3swoJfisqCUyW7koryoivpyZGaNI445v85L4wnJ+dFywVxFpksodmo7ifLimYYogJe00pxMDNKgEQdICbEC1SxxghVYnwWl0GWcVNlDG4SWUjDwtl2PZbNr4yQqyLb1J8ufWnlH2tvXuYAbwhvbXB0jMUlyP3jjOeC0RnwsOBsTupSQbXnuqKCY3yrwfV3+GVB0g9BwhO2jJDP4Oq1dZC2PDoFsbXFsJowHhTaXK6ofBSkh2+EJ5uVM2MjsoYmig+nG8al3F9BrONnxLXw64gFd2rQR98G1WabaOnlGjwM7Ke0Na69sbOUQ4PC49uufDUvT0RG0G7BxCW8DGvoDTLgUr+Hq2za0vN/1Ibra33KaaC4zKXsEdPWSig+d0Oa2AyIMVjZRiDP6A9SVqow3fBSRpkYMpNHVGiWvOSCrefmH/3KZ7O7Rpc9AHPhvzOkOQosHr/8aorReidzRPP6si2iTmOK7NG3VJNlbVu/8/3oGNrom5s7Ide/9BKpqzYt8CX2wcvBsFiLyIoMDB0iCWprZXw3nIYKzwYCg/xLoe6XDeQdX5xqmBEKZxofn+yU7ns8ELuFb8pWh/zSD3QI8OY+3C7rDpBBJmTvbp8j8WxsH273zSE5quVbk9C+tle7LQcdP+mNpg0PGF3VP5+A/nH7k9bq8Yn1CNt3Qf0YWNtfymuw3Uckei60kEMvTYselvq0/TV97y+2osIbASCu+5jg+iLhk8ihf1y9l64pP8IN/wIk78sEmwv9Ls0JR3LC6RlEvL4Y20zJYKpwe1EqeltyALLmdN0vLoN40saPSVU8katnbMeVbiKmDSWScPzrfj4YPUAIJsljRnwt95J+7Sq//Y136aP4RjENRFdeFDfjudv8XVXdt4+vd21V3XXydQwCbh7DvbjW/musrvgG+AEBPkeScQjnx6QOaM/TeDSIYhz7ftOjqaCyiV+v4bEgRpcsQD1dgXR86+8cgQNkgho4l99Mvw+lCZXqFA3iVDqb99zila1u7+eX8tkuYs72BBQY4qnPYV7lXV6EA82TZ2bzd606ewHqm3WRBS/OcCQmG87Z9RYiNUEIMN9QCKWeFVS47Ds2Ojz0nvJfUdEmXTUNIfvTBh0WPQg/A5lv22HY1uWAyiiY7GdeKJkixN5ShMD8K5YDqqPg8s9f9kzHnlMACNGGYNRWQXCsrZWDgAddUOjTDmiQ4SEUCoM/LAqCdj6Fz0K9+0MbFGZ76LIUt4cZZJZ/CAeuQfzU+tTzxjU5o1UaDRBbhqUlkWxRcFH8VtzHsL6oqD7Yx7it8cFFbJE1EiBc3Br3l9f+p+imu0/JESrEg+37tgF3b2gkhleUjgyPECFrMLzIiKqgXYSMCE9HVmN4pnCyjkopIedLh0LVAW/SbBiaQ+EhSGECRAqaExb2S4oAEUbiISi/6ElkHQ2KoinYQ8f+N4cWbwaJt/16RAh3+rcuPXgQ7fKvX6/KAmw88t6Lu1NBmonH93NxP/z55e0bIcOFx1smsQD70gwpwUmy0/nvGnt4j1iS3zoCvgzU4YioTcvrKln3TwInXdfZy2XMWgDmfaX78jCDh2YEcr3GcElwPAy24MVh8wSeGdrtbyQ0AkDd6JsJRdSAxz218O5rUyLiE4ui4sVI0+LiFjpRaQDxzAoc/5cbEJo+bw6JRbt9SfUZNBSPJ14u26q4PFC8nGo2F9vsBLaWvdlft3wwXOHzJREdG2JGEySu6lz03gbbXNqd2smK35n7f4dt315ssxPozHz/EbTN7c3NRaslrgQgpraKYN8mRkpgX1MkO5Oyp4yGzCpqXukWNQGWTeonAGewFFNb2lOeO1BE0c8uP5bJ07lA9LlL7Dpqwg2JiG10Pe6TA0t/WYQgiVBcND1JH5aoCunJp7ha3dvcJzcH1m4+AXMlXo/tTJamY8O8JqwP3Zsh6BQJ/SjRd8vGbTfPvsAhfwCKMZVMcdI4a91yVE2qxT7sZUFJ3rwShaT4rwf3U7cUZHCy/5q96az5k4FzEOJnMV0fVgxNlvdRilTxjZkSciU/S5GcziDS/Pc4PA8lR3B5LGWCUEDVp0bhBGMyBCVFi1qWeiEeuPMNSMqmD7qcI1j/PhL5/qbvmZ0fc3PdXRs004+fT1Cxrk4GbD6TyuYw0R9++0mKAjfQCgTZN0FqCt44PebPqQpR+2BTmcPfKX8gNx4g5LpbA6sf3xgMA/8Qkun9DUa+zfqe3HTbHA8rZPmjzx/Qy/b+WSWaO+9JHHiwf8uNW3euw7ZEtBttrb5uraUhSIXgndBaESqTuOuTa5tkJMBT8189sOdUgKv3KUMewgwFDwE8Y1WC3XQt224mqmYJFO19tvsZONgo04UF7Y0qLOh6Vgn4VxxqgjSBdz2lrH6TuY8lkUrAbJ4GUimrz2wKSCUETCaKgFQqpqQ/HY1p9DMw6PqmhnULhQMW8CL7QcWEdeXVh5c7qeJa61fsx6hvHc2o79hf67bFdhnth+d/41cqJoAMjH4rU3GvTkYT2+/3/DXqu2YH6lv24161MmrdPUX81rtdoZsDgI1+DHoCIrQBV7RNCDkOBSdlvqknoMdSHccrRt0cmJpyb9YmeMeRjN6kR6xOujeFSiLEWB0RfwklE53OJIN4zSJT6YjOUoXbBXG+kSSjL+mlXSq45VyBnB17jZ5U5BsMBOH2LJWDomTKIWd8ds4EirT0w42+Xyr6uhSQVdSZdAVSplun1ZmP8lwHhoKj6LPcWmyBnAvkv8q60Cjxogm5cvCDyi39TQin/eQujkljlPiESauIEUctmrMOPX/wE811cdrwCMbhkB4gfCLn4KZK91wGo+Hrbga3WWDayXxwEJO5wL5LYPf7v8zlIIIPyO2YZDCA5J2PbfrrwQDoh/oCYUVCd9yj0mgxlfBRioAow6vwdjaKKcL9+KGor0U1GSctE559HkNyk0VbIq0UC1MmpOWmKKVbVURIcpFA+XlAg3Y0Ju9gzKmC0+gyeN5VEiWGo4y1RlREWWOVgpg8tlzTkhcalFqSqYnUyPvqTDMpOi9F8BkQ7vSFovC3t5p866HkOmvqiFKbOlxnPhRvjQRs2wtdpK6qby7tvF2MOfboUv4EZKD7gR38Hc0RqYGgmUeo5sstUew4C6aApMESOCIVEKbUmckcB7Y48D80Jec7LZ9DB6/J+bcGHny9nhBfdqCAQzVKeSZ8nG1JyeW/ED2JShGM7QjJeZE4odjDaC7OmMgXRreqSmtUPFSyNHFMlhHXbioYZWbLO2PzVcyqpKog198TvvPmo6JjFZgBlIQS+j/+lMHT7J1T5FHGXZwDGAGVoNbhc44BcD4bWamZranZlthugYij5WAez8Zo/NjbfAUzNxXcjMXE6OK5FtKoblD3zvTgJb6j7wfLyeXoly0TieDC9E+/7XtecNSxSR8XITjl6X7KxVOqlZ++XRT1eiXzjunUUPNk7K2EirzXwvZJu2oLd8adK69IPlfWu8NNLTGKvSUJ8n6BPEkfnigKMbE07MX2wat+bTwLZB3Yfbz1YPGaCWdBVGc8yKttTj1itvCONjcd5horJ7n9FLbgHy7ep2QJlJVeTZarIhozpZFNmUprZGqcwFd4ItnvVQz9UuFcT9X/YRfpFssluizX2xM6R79kMZtSMNubRl+iL1be0xM2S79oNmdHzStGa+2DWbNkvX6OPJw1PyxRjpLns/uMPx4/9dzco5+lDGbV1itGow4Bvdgn1dkKCTFsXugf103rOjI9VOQoKO0uhXmMzD+W7sJ9f0dzeIQsN+5rDTjLppSbXeqmOvfmVTTX0rCXJHDunXpWeva7tu9WZGOlispK14xzssuYAMyb52VuGZXVijL/7xaPat21ttzeFez7zh8477TyYup12voYgaAhphvE1vO4sXUNGmIFgvrYojF1bexIlEgjQkdG1kwNSnRmoTK0kWbtp1JgHIjGKz5XhFKpWaH3QhXR1FDlW1l4XhIK/EO8UvDT7t3LsThWohm7OLXHP2dy921cSWIi1nJ3z57AbJFX4tLdFuyV3V01k69Z/sXDmt/cPQmIvXtCsK3g7vDoEbcKXg3CQFUKolJ4Vi8fkuBhITFz/Ppt6Wuh8QGLiH9w7MJ+aJ8PTPzvwSaD33r9rXGmEnJtgaVzHG4UC4Tmd2pG6y7KBsZ93vftGo3t85Hyg7g51YJoOsfZROYL8HkJsYT0Fz4EX+4OtnNB2GcocQQNJ1yRh/1SiU+HDtGrlXxHXk7S/trGw+IyF8+ZzUtBXJ8v8z1vhaeLGUpHm0/gS0eqgcJzDQ0QQProZRp2e1FGwmBl+QF+sZN70+ZpKNfrRpzXHuXxSE4K0meg0x3jCsICHZnO9gSpkTSgme1ba4Le9kwSXhCX0/7TE7APyvX9Ish9r8zWnbpSW8251LbtArXByXM2cF8Qz/d7D4/uNPZDx48+Xh9OPe+k//XYCz2X4+cJFwLqAeSGZJ9zKGOh0jEnrJI1k/J5/Gx7OmXfOjNyJJYbSEkriEiTYir5rhdEAW/BejbcEipCzk5KHpL4HBdeDbhhR3z1mwQ/GrcOltPjijWlmhJN0lLSF/bzRlwch5gI34l0s0MY70W0nkNwAhk2T8QuoDlv+4Mi+IO56SWWiEmDbMEyqkM/8FR1WOmd+zgXK8z+w9GofoRSqA+PS3Tom+6xBd97ZUgySTPplvxZtEJ16I+sTI5yloiMgL9xb0kRMLS84ldZdUXXy+0KuHJlV+DQ2ZlXVfm6juLUwAuXh4we5fU3s7jcCuT268KFBaOHudFAXj/vUsD64AAd6Prvkcmvbp1uV0UJ4yXHbTsAAQJSTh+T9A+uuJIS+AfcPVuXX8YpD61KSwsr4Kfko5nxuRhhAlU6s1Rjn+frHaGYdFj2x+Xl7mfYizkTBQrqgLKlrP5yc6dD6UUCboQg7yJ5Nj3zVjpFrypM6Vfx8WVseW5855D88KnKKPH+ztRFlql2NqHalD4Al0swnw/dfVNJyku3xigzbTSxlFhIZaEKlYLRMFtnLuhQVHAIuQmppFxeUh6Kycyryb/h1or54BO8HJmS5n4rr9iPCFlq3tfcCrlF88+Jmtg/0g4i863VsALbCU6tnbU/v1Z2frBi0aEyzQ43R2gY3sxMBAqCTica0LzMRqpGT2mR8kjlssz6CDmzzn+9Glu7/8hO0peJa8CuY4qK/6mKSDh5575SB3FhZvPib29I0/7NwH/5hT2pTJRhJXJEVdGZ0jBLPC+yIjPTGp7GLY+UZkaUHvgjWOVevo0iVY4xKq3sPaYCwXxb80mBteQIr7GZfawkFVoVWZZiLc77Y+Ob9ALQU2t2ayYuuJXr+zZ/OiVf8vBqRR8Qb4BYINL/C/WJy3c+JfFLmfRzZGr7Jzv2tzl6+iRsKy2JU6NWsBJqOJy/P/vh3ELOd0Egm9scJWPHqZAxdGXIHIYX3lFP7iU44iNU/IScEFqiCn49Mv1Zid5qy3h17rgc5vGr78Joj3K2N1WnBULzuNgJGm30fFvwBKjh/KhS+KvzTY7nI27V6YrTsYFpUXu0H7S8DZgG0SsoCZ2YF/IFVgq4tnl7TrXMdNZ2TrZM7oGOR3n9eYDV8bDIaDYXFJpMev1ma6pWW1j4PioqAqZH1/pXIw5pDIVOxhWwy5IUfnApCsh0l4Lb2/u3G/4pz5k8tZKr24n+VzXPyznYH2tBNzQHlX8/bBBWg7ZRUuKKseawAiwFXfEEPhRSga+hcRklWFN4ATZKicoDmWzDOMKnrwRYlYDV1cR3du7aZl3/2vQv/g6aclxcFjva/f80BanGW+LovA7+v0z/vLLM3u6NNF1/bPonqR2Yr73ev2L+9Y/03enjtl0DHfnjTeDCNZd+F5lr1ldDw7wBF96dDvZz0IpaxBrf7PALngabbyM6xtviBPhQ4sKFbJ9DC//iL7wNhcG/w6fsxt1s9h/9rMslJu7RlpbXBJXlZ0QdHU6nSrzXLLDPw0RL2+pOqtr2/5qb4zAIqHxHxVTm5TDbSahtfT3BY2pvktfaCR7bXO0afaaguSdrhryyKfwU0DmES27IL56VfurfVh5xprijXtgl4CUK/1noogaUeZYybstOm8ebyvpZ4mR9ZGJ6sIFpyjt9rOt9+3iMN9LHFrCLkK0hEIQ4dmqCvmRHKEyk7qw2DMRLOCVx6U2k9VHMnxQfGOBkjBG+eyaeTqNVkWffKRAemmP5fX2ytvmh6Nd8AuG7V/nFXz3v9LWy0dS6mBaJRHeh1tuoqQk5uEwWO/POHdFmsnJx8anV45XLe8QaXTUtNT73L0YODmyUcOuZhVXCY4E1+whZ72hJSerOFH0FeaeOg7UkCdQEl4VLiRkR0mCNNz1nIqNkKOFOx90Mxfhvd2p2+o4llJQl7i60Z94ZmFj0sVmndFR1IOCQbw3ebYkSBjZNeHOJzwIp82eeFs4OY347WRKa4fkuhzhqmPErlqRF3gnNc+W4ES5fpxZ0kDOnen3BkTmV+JzMqr1L2Fcg9T2Nnzzd+bNzUqIjIlEOW5HtnB7ktuGID7cGB6Xb+nRZwuAysOkuhNLLb8UuT90GuzpAj8WNuNTsndpv49P6R6ZlN9f/O1Xkw3kNGOOvOot9Hqn22vjcCfG/7zcPpx3C/uWkH2I9rjVk7TIZrSD8pY13d35oJ7rdTeagTzkTIJ3q3U/StJXtsOsbPcMHAUjG/bPxt/8vP6OEQVgnvFHyhg91BhjVfnN+27xR4D1Yd/eK2fQNzZm14rVta7kKdPuezffQCse8I48S0h848K7CV+X0efjS3Ne3m6fktlftnS3cCKOc04hRb/0zpdctVLintXQ5oX7r5vP7m2K3Txq2nY9zWNi7nSN7gJBm1VGJ58J3ArCpFNVRspTkaq4wyqaQVUfwRHaaIiuyIkkQViNRVpGx/yiTX8o1E0W6qYTaCt5MsSn9WEfx2fTKitf4jq3cs7aCrPs5TeXfv0fEzgC7YmEVSSIMNyaLadYsTjk6KUn0WRyaVZwcbpSk29linmUbiGwOVhcbE5ybIjRGCoMiKcLg3JhYrI7NYZfD9F7i9jP1ocLJraVnEsrL6dZeKpgsGHpXUK1oi6g9XCdP2VZjmUk0Fh1IbHZumbyu9jDQJKSp6T3anFoqm2enqnOoPWottVedKwEe3wKaXCra5sMNzvXgIIjEv36J53I5wTl//pAxc+BuwTk9rXtnRTs5va3D14S6VcosNb2bygR+Ld1N9bEk8Xl1VHUurS+YkOe+TTbiL3Iel4PM/pMCv+uywRFfFMVJbfFx5NK5RdQUlLf6i2Cy/5rMXPNPXjNT/NdpvO5PAbEd96fowkoa9UYVKePr76GUn6lgpFOxY5tq9jycGsHm0ZVUYRJVmUzG8Q9Kn6j1B4s8B3/B//OBD7OZNg4qncZonBpUCT5omu1gvymCsJvX1IldK2GfsTcUn/l9ptEENZCy6byvLh8OsXMHQ0/YpgVx25Bv/0r3ufbmKS0piuH+HBX8MLAmKNXQTpFmY7q1ZBPl00ZP0wb6Z4Y4NOedQMjq07QN5ae+n/hqg5qeKH79kVA7Z6i6Slx6SMzccSAUpfw5kS5Uc4CuaPjOB4n4lB3VHYHE+gtnwkZfODYG/mOiwl/BOUriB8f/ao3qj28sX6S7KPZVLWS+HlIGaH894B/Ov/v7WXdrbZQ/xfAZbival6YTh5AP3Px5g/SfcSDJalmvXe6I0GUOfm3uSRed1wkfAjZyhuXAkLMQUk4em7D6bSrpeY66mUvJk95D/+kj2nJ9DZ2VBwxAXnjro9dq4Yi3Ofwz2loXdDivbw06jsMZ9BSO4nAEPJgQPWDXPxGqW4RmXIm0X+kPU7god8+QwTLOos6e1HTWMeTkYIhMjXMx8Cus8yYFEyQXqlmOPfcbnaenlinrXeYNGCnZB5ZuQDcWloSBhaZwAJb0156SPS64AOzS3Hdg4iTf7WA773RL1Jq4eU7DbJ8zk87xtrgmdaxn/894imNjy3QSNj2bmNyY8NZWPJucCZBZn1B/PIt7/kzONG46nYI6lYXxLGiWZt3SwUQfTIUOqUO07bbbrs+fQRdV4Tx3enon7+V0YI306YjFqnuxeCs9eDnNy1Gi9GzxGp09eE6w1H4W4pfm11s+/UhfNlpUjvsY7x71RCdBVn58NIf7nE1MK63p/xeoxBH9ecLFfuNUDM2oCSFFjjEqXWzCG+ys5n4zZfweEKXV1l2TGxyQJ0nLhel7hKEZeYsIOcaoXNWblYW3CzRdV1RxtU0V6aQFCF8NpRMp9uuq8jAXEL33ilPOeczVw+q79cqX0NrLsOm5gPDdAXCJkUmwTbZpZ1xPTNQKkbqkZUPisd9qwdCMWitS5BijUmWR3Vb/KCZyXCd+i1yRP4cZWeLoeUDyMoBzKWSDDahElK7TdwDDMj4VpMgxRmUWm4eU86Qa+5EOhmYUNMVFvQf+dcr4j1BcNIr8i5jIHVd1e/tfawIB3u0w8YATzrjgcqxwh9UlF+gVEKoKGZueQQwQIkOBUlXIyQZPreYzKQ5Gxj1WhyGWBVyJ93LkFXpiQyZ9sv0bC6t9r379UGMpoJbvIbxHROgVKJlFDq/3xvMhF1YWqEvqIBLXJ39IESJRiSR02x8HAyTIUKBUpSxtgOypU6G24Cm5qnTak6UXeJcFDreS035JqFC4+JMCQEdEw9frKfAKWy4Ib0UoggLIJMisuHkwNrVMkzrhOFmEIiiAlGkgJX33+xMMkKFAKYXRriOlPJXHXqsCA8WySkKNKHZd1QAE9obJjhxOAq+nK+BXrdoCbgQKF+4CoAGwgw2ngfH/1K7lyU//EZLkd+4rYaWK9WPkcf3+yir+/nc+r7Tk3z0PfgD9SR7jW/Hk9DvXBWM655kRF2PyUmRkaaFYGwh6TUy1hK5AEfr20ajotxSX0HlUmo1M9bP4frhR86fIfiQKBaS1UVY+eNvA2Y0NzuqM+32vIujv9ko7I+KSwbVXhcuMkd6LwkFwKeUNZxg9kCnZLRBIKjCNVJKDFQf9YjLOqetJrtNdYrGnu7mXSDhEe3Mfh5yhXULO5rhr/+gxVJbQt7MOG4C0XxbuwibPF8wnV//kRVI8FbTEPDWcNoVpBcyDbEkB8lAvMaEJbPL8JPXDkIhAK8muJbbLcQ4A16ba5fgaFKVR1YK2t74ZRIem7CCCFU7u6PmirDhQ6/EAQq9RRUE4ugdBa8fzrZK1k6Eb9CcSQxutV1xrydxdYqH5vJSm4NAtJ497kBJc2OT5gvkk9QeGRMgq3eqJ4xbYuqRbyWBbQufifBoMnJP+cY6TAqMspaQsblkyDFd0BbnUJLgwcA4czCepPzAkxvP6kUmD9WNZ4MJ08xMUwLiX43BhANqQzuJgsMdOgE0JPTzHmTqGxtgzjPu+x7vivS7HGcYUJi98GRMYBkPLZ8+STLpgTOxln4L2Ss1lwWrjW8zBMCsdlLG19+Q8WH7QcnJcLQgxqS0iLJ51pUaBEmhFuYXSvlwxdgJDw9YUf5vrooCIXxZqReaQLtkA+s0cDwyD1JWGbw6CttZBvlZjYOoYGmPvZwR3A4gtnAm+FqwrNtq2BjscLKEL5TilGCwsUwcB1nbbfFpRp2PEJJOZsTkOS22yw3TI56Q152diz6GchLpDfiSjdV6vmTRvTFoFk1bMpJU3aRW1r8R7Tr5xZ2iMvVDivEc/2rosmChamqBN9+8pB4s7CXSXJc1Ad04Gtw6TNBiG+RMCQOCu/zJgLhTyWfwHxNcFwK8/x7ok/aJceFXdcl59rSewBxPAwwb+XyPgB9y9QCGm/3TPnbTLQ5nmmAOAdOVCcObM7lDJ5IFXaSLMRvpofiJg4SvNC5WkycNC83uung06QqdM6IrsO8BT0/zAEZaBTdFl4zwFlYVtFGw0u2rhY1bJ9FwpHE+jask2jNRHajBp5qphGa0rF6Io6RQgNB5A8YcY9kdshl1MV/wRbqQv8u5LAiBaSkXQjWRZQ4bX0CCWaS+Fmn23nPrQ0lRHxygQQ6F851We73XhW9rzHSmBx1uCF1s261EzejSLn3YGrIMvqBU/qxFsZATXC3Mn2gMZy1u2h7rag2rzuGpf/NCQSkSCqHZD20yh8uhTqUeKzHkp4IgUjuAa5bhRHo7EG+VBr7tlJnTjHPU4QzWu0sowUeM8tXGBevpSu17E7EUux5wapJvxEqFlypPtT4gOdmjqdGZxX3RXENplVAqlUdvkKH40D3/y9uwCmvaUhojyQIYCJaJtumQRQ+2Tm4BRIrU+4ccRq2Jtle6CJ1XpmNRwvrfENcgaeyllOykpuMBvXxS3G9LyDpZtSmSblBC3RUosoe0XDIteT3ToK+qBa+IgL0Vn17463H5beDwjnise6A9NfWW9kw36ixMRboSIQHNbR7Rz7tQIxL9XbvfUMuhydf2emCwgBFfRML/tURpPS2Yz/1knfpayDGfJu/y7pYASLApGpHo6dBmyMCR08kW65J4QveLgzKHLNrGtihidKqboqMt+e6eHy47aunj7zFQ29FFyQ+qo4NM/Y8M/ppFxhOlLLOqjiTFU2rCziibSIHb8OdxzxLSjbz8U067YyawScol/TgrMs9cYS6fai7qyUfG+Lmqbaqj7gBubYBOYt47u/QCjAFN0aJjHroXjeZPxeP8mQk05lAkQm1LQ8Lv50KnaGs2Ty05Za+BrKnqZBnPFPL9wCV5umbqMHOKYAIFnKVzjnZdtmQIyLwWIfQIAAwUILMwMkI1GCEzMDL58rsF0IW4ZfbDfQ6D8yGqBAfiIaW1RtEtsY9hR7YYofy2/UjiW6Qh5BkBntlRwTQBimvscjXEBYu421RvjtG05uGY7vN4IL/fSjlQgZGEKIBMFRHsdcz5C7DU7UGYoWwo6NOZJm62NeiBqn6/BsdZ/AhQsVpcanrUYuwUqpwjRRoBR+pjWhOYe3dIE2hTdHPLxWc9r4nGnYDhBUvQxMe/j3cKdzL2gnlXua1i3cTaD71vY/vv/C1cJB6bhnpliuLfhGG4b7nkp/hEHP8YN+C74f8TDSWpbeuHh4eF/JMDWQhFgW2l7UXbY2a5iOBrXGM8EiJMgU6jTu2bAnAUvHy1AWITFJfdJa++GFzB8QYFgBMVwgqRohuV4QZTklfhV1lKsYzSZLVbb7oE9PN5vIfNahbkQWBpLNppzRruXT43rs/iyXFtB1c7DwPWIp8fz/xStqFI8u9bnt1NZWHbHdISoIrpjqFy653WwU08/8XUqHz6CIoxYngZ/GORr4nqnYDhBUvQxwe/j3cKdzB+uDKc2DWfqXAVASgkgAQAAAAAAAACklFLK0F5JAAAAAAAAAAixFQMAAAAAAAAAAAAAAAAAAA7s/8ucgsnC8IDF+hsP2KUFucX3QTaEOg2DR3iJjPICjYlVNq4xngneEyGRKdQ0j2ur7Ra5jq7edKcZMNevzYrcwLLJ5mgu3Tx5Hqq3vf14+29Ki7URbWNNTWwts8asU9udlcObo7cwy7OS13NOr8n67rRgOEFS9Ak5WPE7dGEQFMMJMuUlzbAcL4iSTm84eelXQdWat7Q1fcgCvSAQPDI8CoYTJEXPkG3m5le2IKL0vLi9/Xj7udDVotHq9AbjyUd4nbj+6V0uJUzp3C/99e/x/9u7EVhkFAsaM642njT0dJvBm/nLFc2wHC+Ikk5vONn7XlC17bB3cHRydnlcBz3V3aBt0DboOWy+oDTkyUa9Ob/4+9Udg6AYTpAnD3+/3VNUbQ9Oe8TTIxf++fZBRXIAEKykrBJBgYzSi8bEUo1rjCeBiUgiU6jTNQM002Yxr5GvdUERFoHFJcobaip1r5zOojWtzV5MeV7yOhh7DeQOBYIRFMMJkqIZluNPYcAcFwSCERTDCZKiv/+1nu+eT9XzObObheMFUZJPWdZVOY2rYzSZLVbb2Rd6HThd517x9fB4J5P2nq/na6+iLgAgWElZpap1atX1a9S0q5YrIdZGUCGj6NCYWJ3jGuNJoCaSyBTq9K4ZMGc5zZN81QtEuKixmBKbGjpTan1/mtdf5nZokSPHTso9t9j5WGunt+H5VETb2772emLpeHpe7V4Hkf8y2DUgBCMohhMkRTMsx58EXMdwCVzqlxVV0w3Tal8hlB0/yBs4FRJcBiEYQTGcICmaYTleECW5EtS1ta5jNJktVlu73eF0dQs8j3dzI6+G6sxMtptZMYLYmG6/iylIAIBilYQIWSdA2NVluiufP/YrkQwAYO4IhAsAwLgKhLKcmHAKAAAAAAAAX6rJwmEHfY6ZIioJjQyjy6Cg0GPUZyZINJYYc6wachn0Pan1FBgcgUShywQ/xl7IEnA5HYyTU2cloo2IRkREREREREQ0ItqhfVAFAAAAAAAAgG1Gd6/OMN1D2N1+6xSgGoyatUDaOnVVr9cY8Lybf1SftoEY7mVvntX5Fi6gyEpxqaqOYG+/vyZZ7hQMJ0iKPibv+1fuV3HNdTfcdMttd9x1z/3ngwNVVdVVH9/8XP19ex/UR5989sXXx7dvThaCHVIT0YX+je2cuc+BmWOOOV0vc8wxxxxzWKo/ORO/Oy0YTpAUfUzw+3i3cCdH3gvqmcTfpzxmw7cSGIZhGIZhGB6+WcAwDMMwDMMwDMPw108R5omiYiwJhhrUmrX0a+vU1b5eY4D+BmxoXjaa3lhaYd6S13O71xh8t8KdzL2gnqIEThcEghEUwwmSohmW4wVRktfSrWM0mS3W58X09SqMJRwTSWQKlZf/LBCWXM/b3OfnX/NLqV2V3bs9PN4thRRGRERERERERES0fA3Vj3B/rHbVWdWuSQVbK9IAgEHOD7aVUFYJ9QkMHuFNZJS30ZhY+8c1xpNATExyZbKmKKameaO2Nd0ydR109aY7zYC5fm1W5AaW7eM5muvhPMl3awHCIuniEpkNZTSyo1RWYyuaON1UeQekZc6YdarIWblDzeVtoZat3Gjt9jb0DPTxnOx1EP8vt5+lo6unb2BoFKgBIRhBMbzrzk/oJBTNsBzf2MozOfJ/eWqTZhY3t7mF6S0t2Kp9QvmXI98olrZkd9LDuGdWbmrFM1YOr6qtLprtBrT3l2f/srgfSEewjld1gn+d8nhdiOkHcVEKvAcVgiPvjBL7dFwTn03alNIzA9n7czI/QeGnYvHSruLWyrLqIFej0er0BmPTDLPF2jbL7jj5Qkxw/eSNn/ov297LAwsBEI6TdHlP14yTJEmSJM6Edu3uxtG6KVS6LJIVqgUJuTqdKmin155pO8uenXuOeO1G726vowX2olLilxS+9NrLTrz88iuylKxNpyJAdl0nUY27TqSrcK9FIdN1CXDgCYUAERJhXot3bQWWPgIAELDdSQgQcCcBTQAgYO26Cz0KEFgmhgQAAIDAsjHFNHsloLcENAnIzRXmb2FAjdrtI8RdJ8gDq2JlWbXo1Wi0Or3B2PSa2WJte9nuKM96XluPwdM+/z47+NUrrN0ghlAoDB6BQ6LQmFj4OBnPtAE6g8ma3ZgjTcbKZt5mryF+yx0LgmI4QR7letcwLMcLoqTTG5ZR1DW2w97B0cnZxXUPA3sUT4/nraituKQAAAAAAAAAcCJ7Tr3nxyev0lUSza5JBT9WxACAz/cePzeDbSVRVgmVD4NHOIaMchKNiVU0rjGeBBCRRKZQ0+TWVtotYh1dvelOM2Cur8/3Ko/m0z6fc8wpc6ef551Pv0CERe6LlyxrQxrRlsIae9HE0WVzb0anLjor96C57i3MspWrrfVuw565fjxnfB1E/svtktHR1dM3MDQKZEAIRlAMf77n+ZywSSiaYTm+saD69+Ap7wQ0pnhcE66QKcemybg+S84pfoHioiXWoTeU556y0HuVNWnmoXm8lYXWFttAehAQgxA84jcKhhMkRc8MZOk5mV9c4Vqxc2lXoV9ZVh3hajRand5gbIqaLda2mN1R/qIzsMv+951bxVpdJedaBReC/B1KBIMjkFHuojGx/hrXGE8CikgiU6jT4mur75aaddDVmw6DuX5tFhtYNumckkszzzsfvgBhEYW4Ea4xdRMly4Y3o1OPnZVrs/hYefY64HV7ZfeO6OrpGxganZCFtb6P7hgExXCCpGiG5XhBlHR6Q7lYUbVm9pun21gMEtSAEDziMQqGEyRFz5BluePXegcpdi/ZKQ7mD48kfmqtuOui0er0BuPJh3efuP75vSb3uV6RFgAEKymrRGhERlGjMbGU4xrjSQASSWQKdXpXhp6ZNstpnuRTLCjCRSymRGVD9aWel9NZtIa+bV4Hva/BBHcoEIygGE6QFM2wHH/C7PcIsUQqkyuUKrWmI590AZuAZDcKhhMkRVcolKsmXo1Gq9MbjGda4j1jsZ5trffO45hsjTwN8pCHX5AH0kpICki4CmDfEYhWtVKR14cIH4X6CgaPMA0ZZQYaE+vncY3xJHhEJJFTSKeSZnFtP+wWOrp6051mwFxfZ0VuYNnS5miux/NkviwQYZHd4hJ3NsyyEUt3jZ1o4mBTqg4oy+zfjE7lOJM3J9qCLa2V/NaubCM907jnB9YLwMdL4Osgyx8eikEgEAgEAjnMIDurBYIRFMNzR6SWagqdwqakxDwfIXjME546lufEV0ydme3cty6scelAVg1D0xJh7FASM7WTLDkW77N8Yaqd9avTyL6bH8gezr7koOyPjwZy2nlBjJeCHwoeQEcWLJJNoU5hwBWzIlW1BB0zoAXi0KcZVhnLknYWj1MWq5zUh5oGWjp6BkanUmYWVrd32DnMT3h+mcv+f/9BcX78RVYAiLvGAMNWRCRkFNQNgGEzz60EhdDoidikd5ivdLgNtdeXWgsCAIDmwzmlalqKdBiZmFlY2WqfoB1wcuWeKQ/jTSbQZ1UNugXlRhv4Ee1Q55evmGHtJ+4usGANsDde1QdDzToLDZuO2fKHiM98hLxvHUuD7UZ39rnfh0y1OnVoEb335dokcCXvzlCoNDqDyaqdogUUK8/VwTjPWWoI0Ui8Dd0zsTf2zknzSOhSKMx3C+IMk4MufwBcMBlCszSkGbk5rVqnRp9rBgtUSrk29Pcm0WPsLbhaPchrESRJclx3X3ZB6Eia63MsW6k1mamfMmbPRsRMqMw/cg0KGa5wJGZANe1lwPc40Ftw5cf6AJFU2alfi2JM5IEBAPC1JQQzTQyBFDbuOgmSpEgn004WKHLQrvBYcMOiuCUxy2atylrzMHWj4aqLtnwm84Hse3Jvy9+2PZcOlxBzq/tLWP9B/c7V/8Bf/vPKG+/57M0XfNeWt+9wnHPfRIZmPqUezrStmbCeclMAAAAAAAAAAIC0FKYBAAAAAAAAAAAAAAAAAAAAAAAAAADgfr8K8Hs/S9Vii1y2q1ePSR9GYERLfKU092Sgp8DgCCQKXcb7vz+ox8cJn9TxaXReLj57xq3CwhMVvIGUYRiGYRhGVZWxba2KE5sKeV7IiMVQmRY5+BLO37DWLLjyjRYgkpJ7Uxj1+UvEq1BrLNRliuQqFTal93tjSu+3RyebDX1pebQJXtvpdTERERHRaD1y2YUg54maH89+smzbmm3btm3btm3bto0EAQgAAEDoWzZVRA8ACFZSVlmKqnao1aRZqxZCGkE3kigwGjO2d1xjvNAZzFlSTr8FnWNaw2w+LpCvQeQOviOBERTDCZKiGZbjTzq+5rsIvF2bQNnxua/AqUTBw6wHIRhBMZwgKZphOV4QpSG3bXaEAAAAAAAAAAAAAAAAAAAAAAAAwNHTEeuE/fZV3UPB5JcYBKRxd0mQJEU6mXayQJGDdhHmkmGXoa7wW3DPomuWXLbskVUzp3YZ6kzCQm2htlDbn6mkMh/Kfir3kfyC7VfSKZfw691O0R10j1eUukNAECgMnnggEpTFPepCfZipf4QdLrPWNl/vcHy+fvtcJaJm/TaNfeNWqoMghBAQQgghhBBA4LOrqqqqqqqqqsABAAAAK+dL5f2Qnol4Da2GVY/JjRPnak6Y2hZTM/gOuvoB3KJWiNzSyDKy5e20jgygNagCtbKcze7FJHqHNyFI8ElcGUXVdMO0zs5+CZQdn+MKnHPT687jsX1qNQBsKVvHFgE2vbUAAACRuv8DAAAAIOo96gIAAAAAAAAAAAAAALoaVVVVVVVVVVVVVVV11mEvI2YctNNkJHL1CrvNGNSgy8Bu2NUsuPK9LUAkVR5uhVHz6hQa3q+ylMAWyZHIhMpwusoUKtwC2kDTtIWlTWf/COh4tRtzK30GDPOyS0+DvqYEGHlGbKouR735HXK1/svAbyR1o8DgCCQKXWa+B2wWXOXJWoFakynbFGZ1FATBDSkAYUIZF1JpY50PMbkz8jPDdlWfUbOOo2HTn7Y8FbHCc93+j/ACuD8KmPWq935tuFMQCZxUS4ZCpdEZTFbtsCagWHl8C8bJcSJTYzMzMzMvL/mZwy826VbQOwO2FyIBdRXmItwLhIOksO28s67uIDfCVTudTyHx7/0WJ85heldqd5VcO2MAuveSc8JwuFxERERERESWRUREREREREwuWJLkLFtaTQ/wxMUo9WUoMIJiOFHsmoTa6i9LFJESLyIiERm3YDPDAAAwM8MwDIDAQwxA4VTq8pa3KAAgCMVTrJsvc8R9T/V2T0y03Q3pWnQ6Wasn20XoadXXlaAYURCTmsOiB/YV/GUyiempwuAIJApdJtHrBs2EyZisuyy7UOd6wLJ1zs/TKqaWPmyRmxIZ0D3fmJ5680jNg9Fj1kcSGDERm4LMsWpImZjHpKfA4AgkCl0my2PsLbjKKV4PWZhm+qObTJlmdkEzG7OvtWZx3NkTTBtwmrYwtOnY1Z9eIwB9BgzzsssVOAmXRr6uGGbMZvnIPkAk7Qk4isMs4NqQAhAmlHEhlTbW+RCTkpHzyVRMZYalDlskV2I3VIbUJdvrm24x7At9zqM0TzuCeZoQJ20kH44euAz0nsT0tMDgCCQKXSbRY+wtuL1E5sxnTQL6KWP2/BSxHiqjCK8pXFbDL4O5x3ZvBVe+qw8QSZXH+sKoz8+FpEgzpa7dpRhlvp4z3zTEIF3hSOYps2+XE+OaKUXjlJLqXO5M+rLdc7H5paW/V7wCChC80j7UVluTmrRJ1XTxFuwVJ0mSJEmSJEmSJEmSJEmSZEuM6U3TyvZ/Cnk01Z/F8Lu1K/K+B6r2qz3+IA//5rXWdj8fUNi4T7X7TL58s4owAEBgSpSpeNhkycag0eXr7ur26Tp1JCEjIyMjI0s2TMRACEZQDCdIimZYji8y2WTH8MxzL7z0ymtvvM1+ylX1wcfcPj77kiP565//ZRXKZDt8X/F12K8R011/fkzM/MOPz30ddVUh1ypYFeRcKBgGj6BBRmlGY2Lh4xrjSWOabjNgzuplz6GJNJlZp9KdlWuwaEXU2kubj2fY1+3s9w66evoGhkYnSGC+d+jCICiGE+RRemkYluMFUdLpDScv5SqoWgvrrUy3tbNd7/Y4ODo5u7j2EPfo6XEx9WAdv1rJUgAAQJIkAEACACRJkiQAAAAAAAAAAAAAAMDvRHDSebfdcRcOgaQIKrskf6w47GTu9BPnDwzjyH3D5fHh0OI6CCwcPAL4AygMjkCi0HPdbtlKp2Yz9hzMs1CLqXsJlqubvXvQZ1C/0/Qf4z+trCRVVYm8r24akUuqVKb7EsT3WOstuGRSGPV5GFIrkS11TeaBXJsuvUKOM7BznJ+whkCkBeRTeW/8HmNvwZWnDxBJlb37ArU2A16/XplDD9/5OHxXxl6Sid1PkiRJkiRJlFJKKaWUUkoppZRSSimllFJKKbVWW3q+jku3pdvSk3X8MW1bo7SdYrcGmWYtldo6dXWv1xjQz9f2cL0nnnq2fnsDDPecvXTaK3q9cm+00bBxn5X0FfT9/2u86S84/imdTHCniuEESdEngDDlO3RhEBTDCfKopJeGYTleECWd3nDyaq6Cqj2/AfnzgHLT33kIxEljA4AQjKDkKaTi0+zoq7wbymStXd869AajyfxQ3/iWRVx9/uL4zzGLe5aQ34SiKai5tkKPJ6lQ8Va+xrG7hTve4x4QpZNd7wX1rHrf17Bu42yG3bd4bH87CimNuB1VEdObC03oSyhl7S3z+q33/etO4ONd9+BxprnSaDRjaMbQaDQajbbWeSye1vbKDf7dXuMk89L/038FZX0mAAhWUlYJxcHgEUBkFB0aEwsZ1xgPncGc1ZXNMof5rgsQFiHEJbCPIfZ1sHsNct2hQDCCYjhBUjTDcvwZx98nmP4hnX1fYlUIFIklUplcoVSpNR0hF2HJBgAhGEHJR1/fZcBkzc41h7UrW4feYDSZLVsXvQ3749j2T93YPrb/1ykr2VaE2AYABCspq4QCYfAIHcgonWhMLGBcYzwJUCKJTKH2az+3X8uNboF0dPWm+82Aub7OCt7AZmecU+ZpPuMChEW0YsmGszZClsI3pruY3Vvc7o99PAC/H/zhz/zY2tra2trafm0HEYMFghEUw7uK6Rp6ApKiGZbj/zD9fSKmZuYWllaFOkRiiVQmVyhVak1HhC7Uz4+DfBced/EQiJN6BwAhGEHJUwZSyWk2PfUMZbKyjZy1h7QOvcFoMlu2Lm4bdkdn3OX2eB/ft50MrgCtiAQAwUrKKqGMMHgEABmlD435G+vnXVxjvCSgiSQyhdqv/dB+dTeejzJ/3tVBV2+63wyY69dmBW9gz0fh250Y4nbbdtt223bbj7siiF1xKQFv2FWK3dh23+k80NeB/ssgCYRgBMXwriK7hpiApGhmFo5vzGhiamZuYWlVCBCJJVKZXKFUqTUdYc+PA3gXBnfxEIiTdAAQghGU3MRrid+KRukH0wwwscrm9ctwx25ZdqGfG0YmZhbXNBs7h2c2SZe7p9bL8HUFGJ5/GBERERERERFZFhExufAmbOVJkiRJkiRJkiRJkiRZTlhfv5G3R2E4QVI0ayUN735NzdmrJltyMMP/eON/4xEGfbvMzTyJDQAMu8vBnTsy0BLbUZce+7+2vsMTERGRIcv7rr9tCAAAmP2zqx+WXUR2UTCcIKlBR5lVhgwAAAAAAAAwr/SXrTNJ5mPps1bpjl2n5mr1Bs+vT27deeLRU2BwBBKFLozpLbjy9AEiqXJkXxj1+W+EGnoJXfdovQWXXEvhm/qfxw799dVTh4hCUg9ljJdQ/Tqnxpzacmr7OHU411LKqE84dIzFEyBOehqR88ZFEdJrdLktuSPcBUtkSeVZo6cuI5KTKZx0G8wOuzaoaYb1JQ4w83SauxEQ+gwYrq/vZR4j3vh+hg98zCgmY5ww17T2ODz38+H5oT68AIssuTKRyZ3GGWc7x2SWsp7XCYqBQCAQCASC+MRAIBAIBAKBQCAQCAQCgUAgKFEMBAKBQCAQCAQCgUAgEAgEAp/gcNQSSEExnCApevLHdvkYEKWKQ6qkKl01Gq1ObzA2DTRjkTfW54/K2R/0eir1VREYKSdeP59azBjM71MP/NJs/NNnVcYl74LKfiq/W/GlM9l55qYLGkPtWRrSTIfrHgsmJbXxePHQCJibJyJRgBEREWnxixTprVQQMYMf0IHKSOTqFXbvKacadBnYDbuaBVe+d39z2xoiaVkoy0/kdJG66q8eeaDEjIjCertx4y3B7DJN0zRNy7Isy7K01lprbdu2bdu24ziO4ziu67qu6wIAIhF+/nT+siAsslTLD26WZZmng4hKoluG0qVTaN5X2NSHE5zIJCbfV8+8DPJGXBsFBkcgUegywQ/YLLjKvF5FQG5zbZG6WABSPI/wsJGWizGL4SiuEU8CxCTIFGp6VwbMLHijjwoQjhEWj8k9pbVXwwUYvoQCwQiK4QRJ0QzL8YIoyZX4KrUUdYwms8Vq6x7oYXi7M8eKHRkAAAAAAAAA/v///////x8tWrRoqQIAAAAAAACANv9381hXG8yZcHwRUVU1M/vX1M/awKD7wxcPAFhV2DRkNurN+cXfr+4YBMVwgjx5+F5QtT047RFPj+dBCgAAAODz3y++/BQyB4kAAAAAAAAAAADw9TF3ViQfABCspKwSQYGM0ovGxFKNa4wngYlIIlOo07syQDNtltM8yde6oAiLwOIS5Q01lbpXTmfRmtZmL6Y8L5lGeuRnN+qpuWIgGEExnCApmmE5/oRz3CPEEqlMrlCq1Jrfz+Q38AEAAAAAAAAAAAAAAAB6EwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAB6EwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoLcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoLcAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAD6xldFqrNwsP94bBFRSWhkGF0GBYUeoz4zQaKxxJhj1ZDLoO9JrafA4AgkCl0m+DH2Flxl+rt6jJE63wQAAAAAAAAAqqqqqqqqqirUAAAAAAAAAEBVVVVVVVVVhRoAAAAAAAAAqKqqqqqqqqqoTZIkSZIkSZIkSZKk+xfO9D8N/p9oAQAAAAAAAACAHQAAAAAAAAAAAAAAAAAAEgAAXS4tEQAAAAAAAAAAAAAAAACgBgAAAAAAAAAAAGDegWecEo6JJDKFyuuR/jMBocm1HzjO+cd8K7VWyb3Lw3jzSEhY6QAAAABAVVVVVa2qqqqq1qrLV7F2D/q11lVnx7UKtlakAQCDnB9sK6GsEuoTGDzCm8gob6MxsfaPa4wngZiY5MpkTVFMTfNGbWu6Zeo66OpNd5oBc/3arMgNLNvHczTXw3mS79YChEXSxSUyG8poZEeprMZWNHG6qfIOSMucMetUkbNyh5rL20ItW7nR2u1t6Bno4znZ7w+m135WR1dP38DQKFADQjCCYnjXnZ9gEopmWI5vbGUTnzS1qZnFzW1uYXpLC7ZqF3qzyDfF0pbsSn28TMtNrXjGyuFVurpothvQ3l+2/xs7oCNYx6s6wb9OebwuxPSDuGgA3oMKwZF3Rol9Oq6JzyZtSumZgez9OZmfoPBTsXhpV3FrZVl1kKvRaHV6g7FphtlibZtld5Sv6PzLLsuv296nPl72KgcAAAAAAAAAAAAAAAAAAAAAAAAAAKyuCwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABB/DdYKayeIIRQKg0fgkCg0JhY+TsYzbYDOYLJmN+ZIk7GymbfZa4jfcseCoBhOkCkczbAcL4iSTm9YdlpB1bbD3sHRydnFdU/0DwN7xNPjeSuu42YOAAAAAAAAAPz///////8/2u05AAAAAAAAAPD/////////z7Vo9fnKje+6Sn5cq+BCkL9DiWBwBDLKXTQm1l/jFJ+AmgiJTKGmEddW3y01Orp6051mwFy/NityA8smnaO5NPMkH74AYRGF+Lnoz8bUTZQsG96MTj12Vq7N4mPl2euA390uv3fQ1dM3MDQ6IQtrfR/dMQiK4QRJ0QzL8YIo6fSGcrGias3sN0+3sRg8qAEheMRjFAwnSIqeIctyeekCxe4lq8BXsmqtq9FodXqDsVydB+B0be81e5MuawAAAAAAAAAAAAAAAAAAAF5OAgAAAAAAAAAAAAAAAAAAYH8SAAAAAAAAAAAAAAAAAACA6dWe/2bQu8ZEiWcGAAAAAAAAAAAAAACAHd4nXN8C8lsBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVVV1Yr769fWwIayZIUIIhIyCqoA0bpbHU1QCI2eiE16h/lKh9tQu4GxBgUEhkBhcAQShcZgcXgCkUSukq5VqtE6jEzMLKxstU/QDji5cs+Uh/HmteEAqiEeAAAAAAAAAACXvdl6AkOmDq0vHgghoWxvmL+lqn1CSimltNZaa2OMMQYARERErLXWWuecc+5/wSNmQoOS+UeuQSHDFe5Tco/0Flz5rj5AJFUe6wujZtBGAAAAwDQD1drLwK/IGgMAoKpOs6qCUYUFJpEkSZIkJUlSzSpJkiRJ28lPbKBdoq5OJ6VBf/Q5+CZZ0taM7mtr2utxoHOIFuq9v5x6Eri2Xy+gWHlAH4wTZMk9AAAAAAAAAAAsxsyiqJpumNYqOwAAgDMXFuO7296v5sAAAABYTB/v9TQAAACgKq6qqqqqqqqqd41JkiRJkiRJkiRJkiRJAAAAAAAAAACAbdu2bdu2bdu2bdu2bSRLKVAAlFIAQCnA+DsbNExlWuTgSzh/w1qz4Mo3WoBISu5NYdR8YHE9UQAAAAAASJIkSZKkJEmSJEn6a0MNRsMEWw7JHJY9JXc87X8gMR0uYe/rFdAUfqUza6zaQT3tl4pyOj5I6zVmhnl5Y2H/AcBwFuEf7kvxH0ZO+ZXYpPrqe1Iv8liEf1BxN+ZnZmQPezXD2W4c8eGzc62aoG7bPzrPWDkFIItBYEqUqVi1g5pNmrREhFSCLiRSYGiYsL3hJLygY2DGEnP6LNgf2U3XMDbXBfIORKbgDgkYAoXBEUgUGoPF4SsdX/M1As6uRUCx8rlbwKmjMJijIQUgTCjjQiptrPMhJpGRI/+kU755auR1xKtQayzUZYrkKhU2Zfd9BviHk9ngi+XRJnhtp9ftDAAADOuRyy4EOdctlq1zhuzKYSAP/AeAjwYAAACA/v7//yJ4r+qQHhKvQa1hqcvkyol1NStMdYvJGTwHXf4AZlEzRGZpSDOy5G21Dg3ANcgClbLUZncziR6wUxwJXImWoVBpdAaTVTt7E1CsfI4WcOqm7ZNijGc19l9VBU89AAAAAADA//////8/9QAAAAAAAP///////9DbyQJpt+p2gmnRW/3fq6BoKcEWyZHIhMpw85SZp8I8IBtomrawtOnY1ZleIwB9BgzzsktPg76mBBh5RmyqLke99Ts3krFRYHAEEoUuM80DNguu8hytQK3JTG0KsxzFQFgguR4EIyiGEyRFMyzHC6I05L57jgQAAAAAAAAAAHACAACAhXzUR/smWR8SAAAAAJgz9lPVZ9QErONo2PSnLU9FrPAc7gW2Hrfv1gY6BZHASbVkKFQancFk1Q5oAoqVR7ZgnJwnkwLQ9Djwf8IL0Mdwvep9pS6iu/tnUVW1zbRJu0eSEkmSJEmSJEkCALJuAAAAAACgQ+OfHQcq011C/HoQycs7c2q5jJeNMZmMMZlMxoYmfp4ZoPNIyNUr7DZjUIMuA7thV7Pgyve2AJFUebgVRs0vHzE1HwAAAABVVVVVraqqqqr/////l2GttdZaa6211lprrfXee++999577733PsYYgybj/cYYB5stDAaDwWAwGAzGe7UKmpY+bJGbEhlQGVRXvYLULZgesz6SwIiJ2BRkjlVDyvBAGgUGRyBR6DJZHrBZcJVTvL6wwep71cTv/LDxmMxsrF1rAQCwdnetxVURAAAAAKiqqqqqqqqqqmoD+Tq6KupydbtxR+pkJJ9B02qui9Cj1NdOYKSJOGkj+XD0wGWgNxLTaIHBEUgUukyiB2wW3J6PPxXR6BxnzsBJBgAAAAAAAAAAAABp26ZN2rZN0rZt27Ztk//mRVrAIPcPZsxszTj2Hcycf2SW9fayC16nq38QZN2xXoyXHcBmc8zMeXAbUmPbtjHG2LbWmk+aSGtNRKT19OP3KoN+eRKKAgCoqhpPd3Q2ywEI/klTVbj6Py7QZ6lyE0LvdL0zJtvFCCWr+gs8iP8suL1MyXEzjAmlf4ceosx/HsrJycNUBcEcxIg8QlnZHincNo+itlmPOtvf8GjCKzM9ushKk8fwzxV1mqKr0hQ/y+wOENxYFTDyAHf1Fx7MXP2rh1hbzfJQGWsKPczemnLMR8wPHmFtLZdHKltbzsbsk7U9Q4+6+tt1HB5N2bb3Hl3FdmmPUd92tk1VO67z73dAHgt3weDEncJqsVzOLi+pc9iNYOMbiZg8bOse9Qg3RyZ/ZAcnwSw/m88H72hszAgHtnHSz09hYAvwjpTKgTzcSTJ4hT3BZIsmkwa27Chp9T3U5KmAhTO932zhdabUkIfufAqfqChLgmWz5GZLXeFs2ijHRko3f323PWUH6IKzFad31NWIZdVIj58v2rfPq6XZdMLz1fP58G49WzeLZtWcnz9eIjAg8OWoiIA+c7Z4kmtfPAejccRRWkmyQL3NhcDC5zFgBQQr6KnHsgdpLTqgptIsyzl3N5cee/gwYPHiAhB8WFdiLPCK9xLNBTrwjldMSoX53vBMLTqCFnuOI3hS7hJ54OSFztr3VHQlC3pXkP4bJV8nnsWGxWcsisNsh7rn2RQoacIF/UzQEyaFCbk4oQd0VVhD6OxsDKwwKZiAw0JtjYqNbocRkyNQ6QmyGCVjrNylk9RGdsapg1xYkmVcpM+ADTRIjR6UqId/lY1gYkUkzgy7J5vAEIjWqvpoduJHMA0TYFcNBqKenjVHHDkCpp0dJ4zeWw+bEtqPWDoqoHwkbeBDIHARVdk1tc5FshRjSToFsRFjV4dA7aUPrLyL5Mz4mjMVh7He0fGBytuKZEalldKrl7bFWCBg6SWNPglH6pzS0cClrUVtXYpxzwDKuIVGon3Al4sie+hxT0+gA3tKGOEW3SOE1nY2DwNqKsAoANKH6UD5/ZbaBWADL6SQxKCVGGUATCO4WgpVcPV5R/RS6nlWpEZPoidDjpr6Xv18ai7iHYfQEsVgAjgeND2ThSkMgaM7ImpFwOAWV53VQs6FZmAt9sAdRza7QGO1UjWRakyRFKewL2tbT81FKQ/NjHWF0N7e9CYnXQruo4FiliHL7kVOk7W7HKjA2cJ7P4IujjkolOITJ4KB+lWfttg4NozslNIaJBlAfS9LqRGXZFzPwXUky4ipq9iRTuG8OnY9Fo54pvskQIWBYrwH70vAoYIjbN5cQg6SyDhBTyD3os9UKcBCgAfkiCmIWwJoZtCFWM6qRwaoayh9/5fRFyEz5J/3350MIQeAt+5a8li4CwYn7hRWi2V1v5Jm91bnsBvB8vjeSMTkYVv3qEe4GbqY/EQcn1Uwy8/mV86BNCzT5t6Nk37efGwDW4B3pFQOnIlwJ0mqnVfYE0y2aHJPGkGJ7CjVdXmom3vrRkUBQsX2+80hwOtMS/FmVIcpfKo05fUuLJubHHWmztBuNt18rhwbKd3+4Ou77alEESyTcdJqXbWkVFZiPp/bXt/e51ScNTv4566VGf/wbj27cTxo+Orn+9seL1k2gkzFKTEeGmD2PBu5jIvSBH4zNmJrcKyOT6lHV78HVkCwgp6iP9oeuGcM6dU25KmgT+Brl34T4MMgMXhxZW1jV6IZgrTxXgSMcDsdlEMrJRieFa9laoMjuD/ccxzBJGjYmSIhfifCsF37K4mIOBsvBJboPylGM6j1Z50Dn3WvVxxmOw1mhqTLl56SijugnRhxHSYS/ESgONtnDdFrbQwgcHOCvXAijVrwxLbWDrEQU6HQHiTIYohLHsl7naS2HR3Q6KgoLrtMa4pnAmGcFqQl/AwEdoB/dVV6nUDfT5plWjKdGHwoJSiqQ2En/g3NRbdEneKhHbw9yEqLo0qRi46rgzF8L2fTthJfds4G5aNmNIEQcOAI0BU67oUMMZclGpa0F4tmGE37g1iOw5vAu8iZgWeoOe/p4LMp6MFhTSl7I5kd0LZGry8RJnA7gzChl1UcgzKM1OGCpg/Exu/1nZcZ33OKAPTw+ki0j3mIIuWFnnt0QcrpUUg0kW6gC+K7N+0nGEyYsjdoNxDzQWbpWl9q9/xzwXgocizJYqFFlMzBIAlIpxZ4BSb3puixgAbAeIZCXLuPm8zQjVh7f46DRfU4oRwoC+SVxlMYOLjqNpyiTQYq4R9MjtTIDNVmVBWKr3pCJbRwB1DRgFF/IfFBIlOtI1WciKpihvVOpVKcYL2mtIwVOlT5VQ7D4xOr0mXcWSfmSMzdwuJTvkKSsLfA2WLAo91ENDCGROqfkdICOiBPwlpUk3UXRNbGSnemfKMWvpDEFDJZ1kxJR8qWhPd7u4pdIvpTOJ/Ba312vs6bqeYRkoEhrj5OcZ8cKXONY9i8ucydhdBKRLoMYiZyOKFUBDtwFzyUhUcWsl1HU0pHL3ZsnJsky8KQDHmsWADTOiFe/EA1Tz5r3buL6of+wf1/IMAL1KugCXAUcoJmipREU/aSSlt+AK5qtzmDRi6gSYu2R01p+5kb6GlxgL63DBgy8ptx7mCSB5iq7kayYMk+TxjksCLR6dnxY4JXnPMBl3wRfv4CBLZGxF0fFAyJmxcTQeF1eHuJFJUrNwki5b7fXbcjajuj9bjZUXkUm6f/zfLyjjcEbXR/1KWfX3EYmOIlSMRyV3XkX4rf6vUbJFiIPv3sSq0h6260qoetxu1ma2vMnoCw223sbpu63+butKV7HrSVZdVRD/zbt4gUC7Fr0hHzKrexHXfCilXjJggI84I1fqRbl63aqGJFCysUocLxuJm3UIPORgTOXpKDvHr/2a1SEQUtuyrVOicnx1JFiOoV7UiURUVN0xJe732w0NxNRknHtWnXAVo1Vl2t9ug1rBvDUSO+5y/7vxXYEEe0zyefffTVF98c0Cvt9T2zNvkGLFwUB9zEzKJYCWvTtpwyI7JSZUX5W1KsikpVnRFjsxPlnpwNK9SPuHENnXfWuS603UXNHFq0IrF6dygvtq4u+6rj94urWxVLr1Ztcp3/etJfdruxxtg1V1xNVHxjWrRq067DvDby6+61GKNn3S9xm1Ipr7b26i3Vv/oAcrMM6TI7UFYH/W+vfYYMG+lno8aMlyNbbsfL0+uB8Yqcsd8Bs+bKb7lCBWiKFXXWYUfMW3AU02JP65RnRXr0KkorOtqI6uhlwGc26gdDKvVudXYeKrEbN45AonoTHbMbPY/FNaCr2/8fsEry6M8O29BLSevCo7+994SHHanfZOpw65LW5X6bEnwAuT62eaxzenDroSuZp82sOYPfi+jL6Xza3/2jTQwxnCAp2ma4VsFB0H7k1XCEEyRFOzrdNoaYrzrtxM30amr8Vp/BiYY89xP/IWjRx+6ALsb67TtlXv5EeCABo8GiBy7Kkg60QgEjOoXfS8xl2BbAIL4VWl2wtmQOrYd9YZA4K+kxMK3fTrENjCFhNZwrQ8kNjt9Hr/nR/XSZVNYYeDFWjnBbOYXfK5vxMBfoZl9Sk5mMI9G7T4B7ZCXMdLBRedIQa361zO779b4kbm9WrFixYsWKlVZl326vYjWZIqeV/jO6vYSXO+WT6+sAlOoataUg8zdpA28iiG7W8Ta36W/x6Ha7ukUN63sGw/c1cZUwBOcDbAM3ssiGTbIZ3gzcNi/qF8s6+XOmbDFk46y+5VX51JWqAS489DVDev1n+zrkJZHmeaJs+A6dvzuLlPejfvV7fgOGlg+N9g1X4/M0+ai8+kgfP3Qfiyyp/Shs/nOndGaf6bPP/y4/e2HlQ476q1vsN6Sg+uY8QQ6EYe1cPOw2kKQUQiTargh6A7/Pei5js2KcHY03O1/d48Z/kc1TTNy//huSRSWx+EiCVEqVRFCSAqVYogurzF1ub5xy/71uV4asWWvtF4gTJEV7YxzH8UGx3qZVUWcNuRrU6atrcA3TDtXDtKPrWH0s5W5oMqoTbusvZHvffmxqIPNHtHlYnwWk8fYD8I+gKvHcLGKz/TocrHss+EYjIsTpxMBKPbvtzwRAgyVzijgJUXS46Ctns6Lf9t+LCtHnto6cbkxZBsF/hza95zjgMLqDBhHhVvsRYd16MBq+Cjj0FYu+EgA=")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/imgzoom.js": []byte(`// imgzoom is a simple image zoomer. It will animate images to the maximum
+// allowable size by the viewport, but will never make them larger than the
+// image's actual size.
+//
+// This is a simple alternative to "lightbox" and such.
+//
+// The URL for the large version is either 'data-large', or the image's src.
+//
+// Caveat: this may use a lot of CPU if you're using very large images (e.g.
+// 4500×6200) that need to be resized to fit the viewport.
+(function() {
+
+	// Padding from the window edge.
+	var padding = 25;
+
+	// The larger image must be 120% larger to do anything.
+	var min_size = 1.2;
+
+	// The imgzoom() function zooms the image on click. img is a reference to an
+	// image element as an HTMLElement
+	window.imgzoom = function(img) {
+		var src = img.dataset.large || img.src,
+			existing = document.getElementsByClassName('imgzoom-large');
+		if (existing.length > 0 && existing[0].src === src)
+			return;
+
+		var large = new Image();
+		large.src = src;
+		img.className += ' imgzoom-loading';
+
+		// We use the load event (rather than just displaying it) to make sure
+		// the image is fully loaded.
+		large.onload = function() {
+			img.className = img.className.replace(/\s?imgzoom-loading\s?/g, '');
+
+			// Make the new image as large as possible, but not larger than the
+			// viewport.
+			var width         = large.width,
+				height        = large.height,
+				padding       = 25,
+				window_width  = document.documentElement.clientWidth  - padding,
+				window_height = document.documentElement.clientHeight - padding;
+			if (width > window_width) {
+				height = height / (width / window_width);
+				width  = window_width;
+			}
+			if (height > window_height) {
+				width  = width / (height / window_height);
+				height = window_height;
+			}
+
+			// The large image isn't going to be much larger than the original.
+			if (img.width*min_size >= width - padding/2 && img.height*min_size >= height - padding/2)
+				return;
+
+			large.className = 'imgzoom-large';
+			large.style.position = 'absolute';
+			large.style.zIndex = '5000';
+
+			// Set the position to the same as the original.
+			var offset = get_offset(img);
+			set_geometry(large, {
+				width:  img.width,
+				height: img.height,
+				top:    offset.top,
+				left:   offset.left,
+			});
+			document.body.appendChild(large);
+
+			// Animate it to the new size.
+			set_geometry(large, {
+				width:  width,
+				height: height,
+				top:    (window_height - height + padding) / 2 + get_scroll(),
+				left:   (window_width  - width  + padding) / 2,
+				// TODO: I don't know if this is actually correct.
+				//zoom:   (window.devicePixelRatio < 1 ? (1 + window.devicePixelRatio / 2) : 1),
+			});
+
+			var close_key = function(e) {
+				if (e.keyCode === 27)
+					close();
+			};
+
+			var html = document.getElementsByTagName('html')[0];
+			var close = function() {
+				html.removeEventListener('click', close);
+				html.removeEventListener('click', close_key);
+
+				set_geometry(large, {
+					width:  img.width,
+					height: img.height,
+					top:    offset.top,
+					left:   offset.left,
+					//zoom:   1,
+				});
+
+				// Remove the class after a brief timeout, so that the animation
+				// appears fairly smooth in case of added padding and such.
+				//
+				// TODO: Detect position?
+				setTimeout(function() {
+					if (large.parentNode)
+						large.parentNode.removeChild(large);
+				}, 400);
+			};
+			html.addEventListener('click', close);
+			html.addEventListener('keydown', close_key);
+		};
+	};
+
+	var set_geometry = function(elem, geom) {
+		if (geom.width != null) {
+			elem.style.width = geom.width + 'px';
+
+			// Reset as they'll interfere with the width we want to set.
+			elem.style.maxWidth = 'none'
+			elem.style.minWidth = 'none'
+		}
+		if (geom.height != null) {
+			elem.style.height = geom.height + 'px';
+			elem.style.maxHeight = 'none'
+			elem.style.minHeight = 'none'
+		}
+		if (geom.left != null)
+			elem.style.left = geom.left + 'px';
+		if (geom.top != null)
+			elem.style.top = geom.top + 'px';
+		if (geom.zoom != null)
+			elem.style.transform = 'scale(' + geom.zoom + ')';
+	};
+
+	var get_offset = function(elem) {
+		var rect = elem.getBoundingClientRect(),
+			doc = elem.ownerDocument,
+			docElem = doc.documentElement,
+			win = doc.defaultView;
+		return {
+			top:  rect.top  + win.pageYOffset - docElem.clientTop,
+			left: rect.left + win.pageXOffset - docElem.clientLeft
+		};
+	};
+
+	var get_scroll = function() {
+		return document.documentElement.scrollTop || document.body.scrollTop;
+	};
+}).call(this);
+`),
+	"public/index.svg": []byte(`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 9.7259998 3.9560001" height="3.9560001mm" width="9.7259998mm"><g transform="translate(0,-293.044)"><path d="m 9.1334164,293.49193 c -1.6422229,-0.24526 -3.2984127,-0.45041 -4.9612577,-0.4448 -0.7571537,0.0118 -1.5243709,0.13886 -2.196424,0.50462 -0.283529,0.093 -0.6795335,0.36991 -0.9174014,0.30035 0,-0.1377 0,-0.27539 0,-0.41309 -0.35277776,0 -0.70555551,0 -1.058333271866608,0 0,1.08303 0,2.16606 0,3.24909 0.352777761866608,0 0.705555511866608,0 1.058333271866608,0 -0.018552,-0.19293 -0.022312,-0.37957 0.2356444,-0.21861 0.8579277,0.22409 1.7037735,0.5857 2.6086264,0.52834 0.42766,0.0146 0.8442444,-0.17513 1.261401,-0.0372 0.4181366,0.0824 0.9829984,-0.0793 1.0546952,-0.56208 0.1879788,-0.21325 0.7989502,-0.20725 0.8059871,-0.65765 -0.1664631,-0.43416 0.5861141,-0.50823 0.4279637,-0.97962 -0.0084,-0.19336 -0.4934002,-0.41887 -0.087643,-0.36828 0.646987,-0.0301 1.3000225,0.0276 1.9423724,-0.071 0.3625361,6.6e-4 0.5967846,-0.5038 0.2363885,-0.70137 -0.1236421,-0.0759 -0.2685667,-0.10797 -0.4103524,-0.12874 z m -1.8838333,1.397 c -0.176739,0.442 -0.7018993,0.61645 -1.1403954,0.6553 -0.4953689,0.0498 -0.932969,-0.2434 -1.3466878,-0.47277 -0.2932219,-0.1 -0.3373301,-0.49062 0.050271,-0.33071 0.3725822,0.18332 0.690558,0.49281 1.1138958,0.57147 0.2970717,0.12298 0.8013696,-0.0254 0.6667497,-0.42333 -0.029576,-0.22526 -0.6591866,-0.47727 -0.1561042,-0.51853 0.2989592,0.0483 0.7347496,0.19172 0.8122708,0.51857 z m 1.8309167,-0.74083 c -1.1304613,0.0861 -2.2682963,0.0489 -3.3972499,-0.0423 -0.3287259,-0.18481 -0.6007776,-0.51495 -1.0062022,-0.53082 -0.4308888,-0.0562 -0.8661645,-0.10631 -1.2996415,-0.11936 -0.2455342,0.24499 0.3755459,0.17073 0.529828,0.21572 0.3986907,0.0276 0.8115017,0.0941 1.1654898,0.28577 0.3883128,0.33273 0.8786734,0.53412 1.2370922,0.8999 0.1976824,0.5022 -0.5573274,0.22033 -0.769276,0.0671 -0.3198247,-0.11952 -0.5518933,-0.47805 -0.9151241,-0.43724 -0.3318914,-0.003 -0.6644063,0.0117 -0.9953328,-0.0212 -0.1902566,0.10419 0.037223,0.28951 -0.1706563,0.42486 -0.288771,0.35012 -0.6718428,0.61598 -1.0761926,0.81344 -0.086199,0.42022 0.576766,-0.0358 0.7398411,-0.16253 0.2883553,-0.23149 0.5717774,-0.49578 0.7186744,-0.84291 0.2502168,-0.15234 0.6171542,0.16475 0.2282031,0.25795 -0.3957968,0.14 0.018171,0.62274 -0.3300673,0.78002 -0.046068,0.32935 0.2303027,0.60466 0.4389606,0.82815 0.4218066,0.24806 -0.326091,0.18401 -0.5234616,0.20373 -0.88564,0.003 -1.6777825,-0.44935 -2.5347083,-0.5978 -0.12899359,-0.0198 -0.032556,-0.26152 -0.062342,-0.37899 0,-0.55839 0,-1.11677 0,-1.67516 0.462385,-0.004 0.8724889,-0.27053 1.285844,-0.44759 0.7806978,-0.41478 1.6884703,-0.44899 2.5506142,-0.37261 1.4358839,0.0801 2.8732136,0.19418 4.2915416,0.43658 0.4289646,0.085 0.2515375,0.43486 -0.105834,0.41527 z m -2.3389166,1.51341 c 0.097699,0.41362 -0.4475862,0.48402 -0.7435102,0.48943 -0.6506355,0.0197 -1.2896683,-0.25607 -1.7841287,-0.66331 -0.3598913,-0.35882 0.2771109,-0.56043 0.4712887,-0.2398 0.527227,0.36941 1.1972106,0.63845 1.8420374,0.43464 0.053553,0.0248 0.2532774,-0.15802 0.2143128,-0.021 z m -0.8149167,0.762 c -0.1307134,0.41795 -0.6622495,0.37963 -0.9997115,0.28554 -0.424531,-0.1036 -0.8099958,-0.41902 -0.9719304,-0.82479 0.1294242,-0.38374 0.5444154,0.0543 0.7651419,0.14236 0.3690338,0.20578 0.7785499,0.3649 1.2065,0.36512 v 0.021 z m -5.12233316,0 c -0.18697222,0 -0.37394443,0 -0.56091665,0 0,-0.91017 0,-1.82033 0,-2.7305 0.18697222,0 0.37394443,0 0.56091665,0 0,0.91017 0,1.82033 0,2.7305 z"/></g></svg>
+`),
+	"public/int-logo/gatsby.svg": []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 28 28" focusable="false"><circle cx="14" cy="14" r="14" fill="#639"/><path fill="#fff" d="M6.2 21.8C4.1 19.7 3 16.9 3 14.2L13.9 25c-2.8-.1-5.6-1.1-7.7-3.2zm10.2 2.9L3.3 11.6C4.4 6.7 8.8 3 14 3c3.7 0 6.9 1.8 8.9 4.5l-1.5 1.3C19.7 6.5 17 5 14 5c-3.9 0-7.2 2.5-8.5 6L17 22.5c2.9-1 5.1-3.5 5.8-6.5H18v-2h7c0 5.2-3.7 9.6-8.6 10.7z"/></svg>
+`),
+	"public/int-logo/schlix.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAC0AAAAoCAYAAABq13MpAAANmklEQVRYhZWXe7BdZXnGf+93WWufe26QECAIKgVMoAyXGOzIVAwKhkuVIh20xVDaoikwkjZguRUEhIFSiAcpxUGNoIRSFWGKbS1YRe05IdypDdfQBJKQnJxz9t5r73X7vv6x1tpnn5Nk2u6Zd9bM/uv3Put5n/dd8vrIJp749I9pCjTF01QQKU+kIFLQUkJbF5VoITaK1GhSq8msJgsMeWDIAosLDXlYPF1oO+UDgwsNPrB4q/HW4K3GGQ1a4UVABLz/AHADsAi4hDx7Bm3o/q0/YgDZMrKJX539GFEFXT4jBc0SvqUg6oKPtZBU8EaTBZrMmqKBsGgi70CbLnhbwpsCPDB4o/FazfdGXeeV+mOgm/I7wJXAO9Ogd4xs4pUzC+hIIKKAbQpElfJS/NfSFTy0VaF6ohWpUaRWk1pTNBAYnFZz8lpwi9fyrbS/5+kOeNmEDwxe60EfmjUutJd5a3q9UXit8Uq6xY2AW4DbgGj9EQPI+Mgm3j7jMdoCLZgOXylevoGoyzou6KfumifnwnMTgZ5IjCIxCieQBPaP0pq9LQvMvDwwkRdOTQd6ns4rpY2uudBe7HqDq3xg57igVL1S3mq8VniluuG3AF8hz9dJc+S/2HnGoyQIbVQHvtUBh1QIIvHnb29vvT/rW0gihA3lv1rXXJ4IaxtGLm1rIRU5vG3VPYnRv1so3/F904t8Iu2vPZ2HhnRWn0Zk2Bv9py4wnTfgA4sLzJTvjcabafAO+CtpjfyG+oofkKNIRZXwRbUEUlhUF77fEJZ5WDSmfNwQftpUfnE5tM7BcbuNPDdp5MJcuK9thESrju8zq8msGXdajkx6w215YGgPhShtv+y1us2FVqYG1nZUd7bTwE5v9X1eZHj9Rw7ZIq2RV2isWA9oPBqHJhVFjBAjRCL3x3BBC4iFS7Yq/w2FvN0Sf0Cz8PpkXbGyLf6RN0xCvwp/mAhndRLHKB8b/UAS6Gtzpd7IgsL3eWCwSz5Gfevo2V7Jgy60PdXQ+rBUPLSjrhbc7a3+ntcq9kbz/aNnI62Rl2isWFcObVWaHEWGpiVqZQrfaIpcG8GtKbhJ4SuJcGNd/PpJxWUpvNtUnkhgh/H7AS+0FQuaRh5tarkK2/NiREJa+j6zhtTqMioNSX/tRJT8yAVmQR7axPUED7me4G4X2l97pUrrFInzwPHzkNbICzRW3AsEJbDtgIMhwRyUi8zJkBfi0jZjwn6ZcOxbvvHPs1T/9LhUnjHtT24L8WD/+369pfUWLS20VBGVsRESrUltaZ3AkFlNPNBziNfqvDy093utduwRl6HBBZbvfmQB0hp5nsaKO0vY7lJzwF5Td+3LBtQsHBqHfChFnRyJurvyfJU4KcxrC8vHlP/etLicsahaZc6nitCJLGzW7JudoS0XVZXzeQUdTGX+tz9+MNIaeZbGiptLpTvQx4J9DOxCkAMgmABzDdjLPaohsKApNokRMuhvIasbwuWR0O/gxF2K0ahrUXXgiyRaEGm5uGG4uK2k5kSWNwP9H4kp1K+WVOH7Arx7UX3zjMOQ1shGGmdcDd52gYd/AObBUvEzoXcOqG9NeV6d4zGPONRnYlH3xKh5lfIxbFRwwnaFa1IonoqnKbK0rvyfTyo+GylvIgUtLbQVdSecMhno0emLqtiyeWDIQkOV8fecdwTSGn2GxtmrwdmivAXC40CPQPh3EF4BwSyQzVNvQj8E9jyw53rkoRxFIoqYInUy+FJduDsq7PPBSFjXEL+0WFCV96es01YymQunTFjZEGtF0vH91H1TWeeulYuR1ugGGueugtwU5bWQ9c8Gfdhc3bthV+7w9CP4zWBnQXg72DtA1aFXg98M+kBfJk6Z9RPA4btF7XhP0AI/b8OyamCrs6AbvqWYcPDx3VY2dO4brUiM7qifBYbbVh1bQv/hRfQ4SyvjaDL793hZSloDbxnPE64e/ylrZ3/+TDBPg941fWCDK0HfVCWOx7yUoocTUetyaMYIu0Xe7+G5ltAfdZ0Khd+7TgTxu52wfMzIMy1F5zgrLsuigRv+4gSkvWGUxsUr8W31PnL1Kqk2eFlAGmzHqaPIg5vwajWu57XCOnbG0PbNA94G+zgEwx7zlCBl1mtSFIkIDdSFOdxXnQctoTzKum8bTySM5cLyMe03RnrqNI61EGvFlVctRZKNo0RrVpI3WOBz3vWxgJNjiM1pZOYmcqNw6nPkwQMdz/sAULNBBdC/HWqDoCanGpm+qByKCTEo+FGKnDkzLqOygab4rC7+yYZiXSysmxaX5YZd9dfLkOz5UeJbVpKN0+NzItfkCdfmAp/KVWRqFamGXN1FZi+dV1vAzsbYYlxwKS48H6+/DuFfzohLpsPbclFpYoK54F9ORM2Pp+6b9xrC4w34cST8SyrUo33c9ZGCz998EuJeHsV/e2Vf3iBLdrK0vtv9e69W5A0u9Sl/6xPBp/KrILcnJYm6mtxcPzW05l3wB5H3u+ngM5voVt6e7pCbEtGPtlFPJKhfZvi9Kr83+DNuPQnJ713yBcb5GjFH+nHG8gbkEWQTnONTHs4jtrqIO7Y0k9sPpOd8cvVdMt2VNuqT5OFPcAFTnq/AVQh2OdhDd+XR2rl6PvPC97OtvRUjxYGWdeKyvC73cdcXHyOe3/mbZUj+yJK3aXIwCfPZzY7tkynzxfYnm5njYk7IW/zApzjXgnySA/GyxacCqaaA1w+S2fNxhnfinIWmdy7enoYPfw/CT4DtK5qQU6D336bfN1XiaDI0qQgxak94pgb2mDuXIfnPl4wRMZuUA4k4hgkuo8FOJjnfN8A1IW8W6re3gTa87mIO8zH4REGmWsB82mG9hP8OzqipRVVZxfwGOBr6071bp4CvFlWyF/gWcOhdH0byV5ZEtNhKm6U0eZ6Ig4gYoc5SmkADfBNco4Svc6+LuShvgWtR923+1adqNZl6g3Y4B9hKbmrkZmrDus4mvRJqX9v30BY1Y1FN+yiZv/bDSP7Wkk+6iCcUQJtNtPkgLV6hwYdoQgVOgnmvlWaz37Gfylucmtd53MU86VNS1wbXBp8IJOab5Gplx/fOlt634G0Tz1HQ9/a+B7b7NC6zvuujZOjry5A8GsVPrERyIOZFEhYTk3mwUmcuTU6nztlMcCrCArbRpNs2pXVcoTx5Q47B81zheQWZmRpaZ8Hpf8zFfUbng/tImr1nfbWoeoZPQlrRBuL6hdTwGOc3SM5xpIwjzGOM20m4tJgEIOFjjPMkjVL9btuU8FnkoSU/cwkf9YlAqrqG1kBuwcvp5LV/KtVn33G550dJOPxRZCzawK76n9CHYxB3UYh/TTl+hsMRs4qUtbSBNpCwhklunWab8umqBiLIxjnHZTzsWuBjcHEJn3US5zVgMUlvjDNdW3ZfOT+lfG34FGRrfQOvTnyRAe3oV44+PL04anhC58/C8UNSIAES/oGI3+8o3+yqxhR88i5KhM15m4NcBHkJ72PBp6powKlrSe315F2e7yTOvm1TGz4NeW38GX753iUMWcegyUt4Xyl/tME/H3iPckDKfztYpCZL5aOuasKbu2MOjcPCNuNc4WJuLq2zI2/yuov4T9dmk4/lTVL9El5eKdTv9nxX6uzFNrXhs5GXdz7DI1suZ8g6hmxewjsGjGOhSmsOaVXKB96jhAOkzTY8BxNzOG1+i4ijaHAkTeYjHM87tHmXAWI+4FpsSid9U5yQR11D2y7V38PzuvC927vyteHzkOe2beSuV9cwFDiGgrx4lg0ssgleq1194ub0i6MXPxbgzurB/0LnDJPxRRIgLpVvASmfZZz1M23jm2XilL7fO3zZQD4zcaaUrw1fgGzYspE1L17F4AzoWUGh+gG17FNDxu3o1W7T7CE94ScT+vD0487R+IeNA7LS8zGQ8DhNVnQ8H02B0wQ/Iy4rz3fgE9U1tBV4lTqW2tqLkdHNz/K50WsZsI4B60r4ooFZQWmZspFBU/peOfaTfH+B7T04QjzagWRAigMWMsH2Yv92DW1X2viygT2yvg0uLhdVqkvlywacoXbHl5GRN5/l1F/cQK9x9BtHv/UMlCpXDczqvIEpzx9sE1Dycq/4o/pw9OAJi6xHPKtpcztJl21mJs7esr6yTdQFPy0uDbVbr0BG3niWpU/diFWeHu3p1Y4+67uUL4FnqD5kc+YG+dpe7VcNaEfheUcvngD/Qpz4YwYFOnFZwbdmROWMuJxmmwq+OhFSRe36awroE5+6EQABjJTwxtNnHP3WMRA4Bm2X5wPHLJuzfy3/dE37RwZtzqB2XVnv0PDbQ7jnrffFiZCWno+74Pdim0r57uuygvdtCNdcNx26+lXwNe3p1Z4+W8LbAr4a2kX92TyB9yrbDFnHgCkaqCl/+xBudRWX1ntUBZ/sA36m72d6PgL7Z/uA7obXAjXl6DW+8H3XwB7aG+OUenHAusWzqvSxjqEi53dkngMOCTPXh2Na1rv/A/yMuKyiUp/7v0B3/wp4T49xhW2MZyBwzA7zO/uMu2RP3+eEyq/YP8wfH6xsU/q+p/A9Omd6XHbDV1HZpb5vAMv/H9Dd8KHy9GhHn/HM68mW9Wj/pX7r9h8MXN+QdXowyAeHrJvVa9xP5obuC0MmZ9AWnh/owHu645KUKd/PhO8Cl+Ov438ASetOrSUpLMwAAAAASUVORK5CYII=")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/int-logo/wp.png": func() []byte {
+		s, err := base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAACgAAAAoCAMAAAC7IEhfAAAChVBMVEUAAAAAAQEBAgMDBAQODg4ZGRkbJiYtMzkuNDouODcxNDsxODYzJy4zOD0zOD4zOTw0Nz00OD40PEA1OTw1Oj42N0I2OT02OUQ4PUA4QkQ4koc6PD88QERHREJHRENIRUJNRztPRj8yOz8zOTw0OTw5O0AzNz40Nz0zNz0zODwyODwyOD0zNz0zODwzOD0yNzwzOD00OD0yNzwzODwzOD0zNzwyNzwzNzwzNz0yNzwyODwyNzwyNzwyNzwyNzwyNzwyNzwyNz0yODwzNzwyNzwyNzwzNzwzODwyNzwzNzwyNzwyODwzNz0yNzwyNzwzOD0yNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwzOD0yNzwyNzwyNzwyNzwyODwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzwyNzyNzrtkAAAA1nRSTlMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQEBAQIEBgYJCQkJCQoKCgsLCwwNDg4PDxESExQVFhYYGBkaGhobGx0dHR4fHyEoLi8wMjM0Nzg5Ojs8P0FCQ0RFSElKSktMT1BQUVJTW1xdXmFjZGZnaGprbG1wcXJ0d3h7fH1/gYWIjI6PkZKTlpeYm6OkpaanrK2usLGytLa3uLm7vL2/wsPJysvMzc/Q0dLT1NXX2Nrb3N3e4OHi5ufo6err7e7v8fL09fb3+Pn6/P3+dkZp4wAAA11JREFUOI2NledbW2UYh2+te4J7gaB1gtRRBxrBVXdQMZWiViW1KA2u2nqspbYxaNBEYxNjG4vgbJF4TI014IpHmzpbSVrz+3v88B4gDL18Pj3v9dzXe9713Acqo6o9mMw4kpNJBtur+LdoDefL6ZgV8PkCVixdzodb58WaoxO25Zkeeyx7Ito8l+spZP110OTfkIjH433+JqjzZws9s7CFkWKolhNWDZfkRmkocCK1oWJkYSXXMOh0smBlTjMi9wR0OoMNFfMN5r0s2qo5kboCb35wes6I46VtbC4npRbhdSJT+yh20vbjPNi+5QCdRXdHzYUQl847X78BQgVzStFsDQPffmmnh/fpj6HP7a++G7PtHVlJHQasyUYBWif87hKOGtGWsybX84ikpwE4BP9EKxC26wG4audeSb9/cS3AxrwkvQfAgyfX22GozlssOxdgh1nYWoDHTd4B8OQqrHw17WUPQysA1pviCMDZv0iSdh4JPDuMp9xOME1jMQlwQ9mQdwEkTP4c8GKpgXSQZIzHtPdKgE9N8TWAFSb/DNgsP7EkGYv10mqAdab4zSnAeXvM4Br4WhuwMji9JKSPAW50v70MYLPJH8ArJeh1kI+4pJsAPjFFC6DL5Evpl+L4NAm+AmCZ4m0A5+yWJN192LgLOgESknadCbT8LUmj3ArwtiTtOeJ+SQkCDhmLPknyAXwkSZtatgE8KknbeF0ym0nG8EtSBOAFSVry0n4PcMzPklYf7kjmeIJpmkqSCucDnrI0yojWAbwlqeUhSfsvJh2kvexhWJJWAnwobbpFGnXfz3belGSusCpvEZCkFMAaaUlQ0r3AcT/p5dN3Swpg5asgbNedmpP019XAdQdGSUsaAIjqTr+k3Gl1dth9uN2StAbgg433SNIPJwHLM7wjqdt9uESztQenJG0HeP6OVyVJDwNHr73gV2kLtaYVaC6EWDwu6XbgwkN3SZISAGf0SLnFU8011a79APeZa/ztIoCtyrdNt6srgHGNHQsMuK36FHDJn7m2SgEYpVyeUgcc/70Lvg888+5lM5ViJHVQtwVLXU4HroebF8ySlNFeDcAbk2CpB6iZrT2MSOuhsasvEY/H+7oaoX4ekfL/1cyU7Ht9vt7/kj1AdeXvo3pG6R/Z+m5rTW1+mAAAAABJRU5ErkJggg==")
+		if err != nil {
+			panic(err)
+		}
+		return s
+	}(),
+	"public/jquery.js": []byte(`/*!
+ * jQuery JavaScript Library v4.0.0-pre -ajax/jsonp,-ajax/script,-ajax/parseXML,-css/showHide,-effects,-effects/Tween,-effects/animatedSelector,-deprecated,-deprecated/ajax-event-alias,-deprecated/event,-event/alias,-wrap,-exports/amd,-sizzle
+ * https://jquery.com/
+ *
+ * Copyright OpenJS Foundation and other contributors
+ * Released under the MIT license
+ * https://jquery.org/license
+ *
+ * Date: 2020-09-06T03:02Z
+ */
+( function( global, factory ) {
+
+	"use strict";
+
+	if ( typeof module === "object" && typeof module.exports === "object" ) {
+
+		// For CommonJS and CommonJS-like environments where a proper ` + "`" + `window` + "`" + `
+		// is present, execute the factory and get jQuery.
+		// For environments that do not have a ` + "`" + `window` + "`" + ` with a ` + "`" + `document` + "`" + `
+		// (such as Node.js), expose a factory as module.exports.
+		// This accentuates the need for the creation of a real ` + "`" + `window` + "`" + `.
+		// e.g. var jQuery = require("jquery")(window);
+		// See ticket #14549 for more info.
+		module.exports = global.document ?
+			factory( global, true ) :
+			function( w ) {
+				if ( !w.document ) {
+					throw new Error( "jQuery requires a window with a document" );
+				}
+				return factory( w );
+			};
+	} else {
+		factory( global );
+	}
+
+// Pass this if window is not defined yet
+} )( typeof window !== "undefined" ? window : this, function( window, noGlobal ) {
+
+"use strict";
+
+var arr = [];
+
+var getProto = Object.getPrototypeOf;
+
+var slice = arr.slice;
+
+// Support: IE 11+, Edge 18+
+// Provide fallback for browsers without Array#flat.
+var flat = arr.flat ? function( array ) {
+	return arr.flat.call( array );
+} : function( array ) {
+	return arr.concat.apply( [], array );
+};
+
+var push = arr.push;
+
+var indexOf = arr.indexOf;
+
+// [[Class]] -> type pairs
+var class2type = {};
+
+var toString = class2type.toString;
+
+var hasOwn = class2type.hasOwnProperty;
+
+var fnToString = hasOwn.toString;
+
+var ObjectFunctionString = fnToString.call( Object );
+
+// All support tests are defined in their respective modules.
+var support = {};
+
+function isWindow( obj ) {
+	return obj != null && obj === obj.window;
+}
+
+var document = window.document;
+
+var preservedScriptAttributes = {
+	type: true,
+	src: true,
+	nonce: true,
+	noModule: true
+};
+
+function DOMEval( code, node, doc ) {
+	doc = doc || document;
+
+	var i, val,
+		script = doc.createElement( "script" );
+
+	script.text = code;
+	if ( node ) {
+		for ( i in preservedScriptAttributes ) {
+
+			// Support: Firefox <=64 - 66+, Edge <=18+
+			// Some browsers don't support the "nonce" property on scripts.
+			// On the other hand, just using ` + "`" + `getAttribute` + "`" + ` is not enough as
+			// the ` + "`" + `nonce` + "`" + ` attribute is reset to an empty string whenever it
+			// becomes browsing-context connected.
+			// See https://github.com/whatwg/html/issues/2369
+			// See https://html.spec.whatwg.org/#nonce-attributes
+			// The ` + "`" + `node.getAttribute` + "`" + ` check was added for the sake of
+			// ` + "`" + `jQuery.globalEval` + "`" + ` so that it can fake a nonce-containing node
+			// via an object.
+			val = node[ i ] || node.getAttribute && node.getAttribute( i );
+			if ( val ) {
+				script.setAttribute( i, val );
+			}
+		}
+	}
+	doc.head.appendChild( script ).parentNode.removeChild( script );
+}
+
+function toType( obj ) {
+	if ( obj == null ) {
+		return obj + "";
+	}
+
+	return typeof obj === "object" ?
+		class2type[ toString.call( obj ) ] || "object" :
+		typeof obj;
+}
+
+var version = "4.0.0-pre -ajax/jsonp,-ajax/script,-ajax/parseXML,-css/showHide,-effects,-effects/Tween,-effects/animatedSelector,-deprecated,-deprecated/ajax-event-alias,-deprecated/event,-event/alias,-wrap,-exports/amd,-sizzle",
+
+	rhtmlSuffix = /HTML$/i,
+
+	// Define a local copy of jQuery
+	jQuery = function( selector, context ) {
+
+		// The jQuery object is actually just the init constructor 'enhanced'
+		// Need init if jQuery is called (just allow error to be thrown if not included)
+		return new jQuery.fn.init( selector, context );
+	};
+
+jQuery.fn = jQuery.prototype = {
+
+	// The current version of jQuery being used
+	jquery: version,
+
+	constructor: jQuery,
+
+	// The default length of a jQuery object is 0
+	length: 0,
+
+	toArray: function() {
+		return slice.call( this );
+	},
+
+	// Get the Nth element in the matched element set OR
+	// Get the whole matched element set as a clean array
+	get: function( num ) {
+
+		// Return all the elements in a clean array
+		if ( num == null ) {
+			return slice.call( this );
+		}
+
+		// Return just the one element from the set
+		return num < 0 ? this[ num + this.length ] : this[ num ];
+	},
+
+	// Take an array of elements and push it onto the stack
+	// (returning the new matched element set)
+	pushStack: function( elems ) {
+
+		// Build a new jQuery matched element set
+		var ret = jQuery.merge( this.constructor(), elems );
+
+		// Add the old object onto the stack (as a reference)
+		ret.prevObject = this;
+
+		// Return the newly-formed element set
+		return ret;
+	},
+
 	// Execute a callback for every element in the matched set.
 	each: function( callback ) {
 		return jQuery.each( this, callback );
@@ -11917,13 +13476,13 @@ http://nicolasgallagher.com/micro-clearfix-hack/
 	$(document).ready(function() {
 		SETTINGS     = JSON.parse($('#js-settings').text())
 		CSRF         = $('#js-settings').attr('data-csrf')
-		TZ_OFFSET    = parseInt($('#js-settings').attr('data-offset'), 10) || 0
+		TZ_OFFSET    = parseInt($('#tz').attr('data-offset') || $('#js-settings').attr('data-offset'), 10) || 0
 		SITE_CREATED = $('#js-settings').attr('data-created') * 1000
 
 		;[report_errors, period_select, load_refs, tooltip, paginate_pages,
 			hchart_detail, settings_tabs, billing_subscribe, setup_datepicker,
 			filter_pages, add_ip, fill_tz, draw_chart, bind_scale, pgstat,
-			copy_pre, ref_pages,
+			copy_pre, ref_pages, export_progress, live_view,
 		].forEach(function(f) { f.call() })
 	});
 
@@ -11980,6 +13539,49 @@ http://nicolasgallagher.com/micro-clearfix-hack/
 		})
 	}
 
+	// Update the progress of running exports.
+	var export_progress = function() {
+		$('.export-progress').each(function(_, elem) {
+			var e    = $(elem),
+				poll = function() {
+					jQuery.ajax({
+						url:     '/export/' + e.attr('data-id') + '/progress',
+						success: function(data) {
+							if (data.finished || data.error)
+								return location.reload()
+							e.text('Running… ' + data.progress + '%')
+							setTimeout(poll, 5000)
+						},
+					})
+				}
+			setTimeout(poll, 5000)
+		})
+	}
+
+	// Show pageviews as they arrive on the live view page.
+	var live_view = function() {
+		var tbl = $('#live-hits')
+		if (!tbl.length || !window.EventSource)
+			return
+
+		var max    = parseInt(tbl.attr('data-max'), 10),
+			tbody  = tbl.find('tbody'),
+			stream = new EventSource('/live/stream?filter=' + encodeURIComponent($('#filter').val()))
+		stream.onmessage = function(e) {
+			var hit = JSON.parse(e.data),
+				t   = new Date(hit.time)
+			tbody.find('.live-waiting').remove()
+			tbody.prepend($('<tr>').append(
+				$('<td>').text(t.toLocaleTimeString()),
+				$('<td>').text(hit.path + (hit.event ? ' (event)' : '') + (hit.bot ? ' (bot)' : '')).attr('title', hit.title),
+				$('<td>').text(hit.ref),
+				$('<td>').text(hit.location),
+				$('<td>').text(hit.browser),
+			))
+			tbody.find('tr').slice(max).remove()
+		}
+	}
+
 	// Add copy button to <pre>.
 	var copy_pre = function() {
 		$('.site-code pre').each((_, elem) => {
@@ -12183,6 +13785,9 @@ http://nicolasgallagher.com/micro-clearfix-hack/
 
 		highlight_filter($('#filter-paths').val())
 		$('.pages-list >.load-more').css('display', data.more ? 'inline-block' : 'none')
+		$('.pages-list >.other-pages').css('display', data.more ? 'block' : 'none')
+		$('.pages-list .other-paths').text(format_int(data.other.paths))
+		$('.pages-list .other-count').text(format_int(data.other.count))
 
 		var th = $('.total-hits'),
 		    td = $('.total-display'),
@@ -12716,11 +14321,22 @@ http://nicolasgallagher.com/micro-clearfix-hack/
 		return $('.total-unique').text().replace(/[^0-9]/g, '')
 	}
 
-	// Append period-start and period-end values to the data object.
+	// Append period-start, period-end, as_of, host, events, and tz values to the
+	// data object.
+	//
+	// as_of is the time the dashboard was loaded, so paginating won't include
+	// pageviews that were persisted afterwards.
 	var append_period = function(data) {
 		data = data || {}
 		data['period-start'] = $('#period-start').val()
 		data['period-end']   = $('#period-end').val()
+		data['as_of']        = $('#dash-form').attr('data-as-of')
+		if ($('#host').length)
+			data['host'] = $('#host').val()
+		if ($('#events').length)
+			data['events'] = $('#events').val()
+		if ($('#tz').length)
+			data['tz'] = $('#tz').val()
 		return data
 	}
 
@@ -13144,6 +14760,7 @@ small.go           { display: none; }
 	width: auto;
 }
 .pages-list:not(.pages-list-text) >.load-more { display: block; margin-top: -.7em; width: max-content; }
+.pages-list >.other-pages { color: #666; margin: .5em 0; }
 
 .count-list tr:target,
 .count-list tr.target             { background-color: inherit; }
@@ -13384,6 +15001,10 @@ var SchemaPgSQL = []byte(`create table sites (
 	billing_amount varchar,
 	settings       json           not null,
 	received_data  int            not null default 0,
+	no_data_sent_at timestamp     null,
+	first_hit      json           null,
+	first_hit_at   timestamp      null,
+	anonymized_until timestamp    null,
 
 	state          varchar        not null default 'a'     check(state in ('a', 'd')),
 	created_at     timestamp      not null,
@@ -13447,12 +15068,25 @@ create table hits (
 	browser        varchar        not null,
 	size           varchar        not null default '',
 	location       varchar        not null default '',
+	region         varchar        not null default '',
+	city           varchar        not null default '',
+	host           varchar        not null default '',
+	utm_source     varchar        not null default '',
+	utm_medium     varchar        not null default '',
+	utm_campaign   varchar        not null default '',
+	ua_brands      varchar        not null default '',
+	ua_platform    varchar        not null default '',
+	ua_platform_version varchar   not null default '',
+	bot_score      integer        not null default 0,
+	bot_reasons    integer        not null default 0,
 	first_visit    integer        default 0,
+	sample         integer        not null default 1,
 
 	created_at     timestamp      not null
 );
 create index "hits#site#bot#created_at" on hits(site, bot, created_at);
 create index "hits#site#path"           on hits(site, lower(path));
+create index "hits#site#host#created_at" on hits(site, host, created_at);
 
 create table hit_stats (
 	site           integer        not null                 check(site > 0),
@@ -13471,30 +15105,34 @@ alter table hit_stats replica identity full;
 create table hit_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	title         varchar    not null,
 	event         integer    not null default 0,
 	hour          timestamp  not null,
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "hit_counts#site#path#hour" unique(site, path, hour)
+	constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour)
 );
 create index "hit_counts#site#hour" on hit_counts(site, hour);
-alter table hit_counts replica identity using index "hit_counts#site#path#hour";
+create index "hit_counts#site#lower_path" on hit_counts(site, lower(path) varchar_pattern_ops);
+alter table hit_counts replica identity using index "hit_counts#site#path#host#hour";
 
 create table ref_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	ref           varchar    not null,
 	ref_scheme    varchar    null,
+	ref_category  varchar    null,
 	hour          timestamp  not null,
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "ref_counts#site#path#ref#hour" unique(site, path, ref, hour)
+	constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour)
 );
 create index "ref_counts#site#hour" on ref_counts(site, hour);
-alter table ref_counts replica identity using index "ref_counts#site#path#ref#hour";
+alter table ref_counts replica identity using index "ref_counts#site#path#ref#host#hour";
 
 create table browser_stats (
 	site           integer        not null                 check(site > 0),
@@ -13530,12 +15168,14 @@ create table location_stats (
 
 	day            date           not null,
 	location       varchar        not null,
+	region         varchar        not null default '',
+	city           varchar        not null default '',
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "location_stats#site#day#location" on location_stats(site, day, location);
+create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
 alter table location_stats replica identity using index "location_stats#site#day#location";
 
 create table size_stats (
@@ -13543,13 +15183,42 @@ create table size_stats (
 
 	day            date           not null,
 	width          int            not null,
+	device_class   varchar        not null default '',
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+alter table size_stats replica identity using index "size_stats#site#day#width#device_class";
+
+create table host_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null,
+	host           varchar        not null,
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "size_stats#site#day#width" on size_stats(site, day, width);
-alter table size_stats replica identity using index "size_stats#site#day#width";
+create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+alter table host_stats replica identity using index "host_stats#site#day#host";
+
+create table campaign_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null,
+	source         varchar        not null,
+	medium         varchar        not null,
+	campaign       varchar        not null,
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
+alter table campaign_stats replica identity using index "campaign_stats#site#day#source#medium#campaign";
 
 create table iso_3166_1 (
 	name   varchar,
@@ -13901,11 +15570,372 @@ create table exports (
 	size              varchar,
 	hash              varchar,
 	error             varchar,
+	kind              varchar        not null default 'hits',
+	format            varchar        not null default 'csv',
+	scheduled         int            not null default 0,
+	start_date        timestamp      null,
+	end_date          timestamp      null,
+	path_like         varchar        null,
+	paths             varchar        null,
+	max_hit_id        integer        null,
+	encrypted         int            not null default 0,
 
 	foreign key (site_id) references sites(id) on delete restrict on update restrict
 );
 create index "exports#site_id#created_at" on exports(site_id, created_at);
 
+create table audit_log (
+	audit_log_id   serial         primary key,
+	site           integer        not null,
+	user_id        integer,
+
+	action         varchar        not null,
+	info           varchar        not null default '',
+	created_at     timestamp      not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "audit_log#site#created_at" on audit_log(site, created_at);
+
+create table email_queue (
+	email_id        serial         primary key,
+	site            integer,
+
+	subject         varchar        not null,
+	from_name       varchar        not null,
+	to_addr         varchar        not null,
+	body            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null,
+	sent_at         timestamp,
+	created_at      timestamp      not null
+);
+create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+create index "email_queue#created_at" on email_queue(created_at);
+
+create table scroll_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null,
+	path           varchar        not null,
+	count          int            not null,
+	total          int            not null,
+	reached_25     int            not null,
+	reached_50     int            not null,
+	reached_75     int            not null,
+	reached_100    int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
+alter table scroll_stats replica identity using index "scroll_stats#site#day#path";
+
+create table notifications (
+	notification_id serial         primary key,
+	site            integer        not null,
+
+	kind            varchar        not null,
+	message         varchar        not null,
+	url             varchar        not null default '',
+	created_at      timestamp      not null,
+	read_at         timestamp,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "notifications#site#created_at" on notifications(site, created_at);
+
+create table jobs (
+	job_id          serial         primary key,
+	site            integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	progress        integer        not null default 0,
+	total           integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null,
+	started_at      timestamp,
+	finished_at     timestamp,
+	updated_at      timestamp      not null,
+	instance        varchar,
+	heartbeat_at    timestamp,
+	cancel_at       timestamp,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "jobs#site#created_at" on jobs(site, created_at);
+
+create table path_watches (
+	watch_id        serial         primary key,
+	site            integer        not null,
+
+	path            varchar        not null,
+	threshold       integer        not null default 0,
+	seen_at         timestamp,
+	alerted_day     varchar,
+	created_at      timestamp      not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "path_watches#site#path" on path_watches(site, path);
+
+create table imports (
+	import_id       serial         primary key,
+	site            integer        not null,
+	user_id         integer        not null,
+
+	mode            varchar        not null,
+	email           integer        not null default 0,
+	transform       varchar        not null default '',
+	file            varchar        not null,
+	hash            varchar        not null,
+	state           varchar        not null,
+	job_id          integer,
+	total           integer        not null default 0,
+	rows_read       integer        not null default 0,
+	errors          integer        not null default 0,
+	skipped         integer        not null default 0,
+	dropped         integer        not null default 0,
+	rows_done       integer        not null default 0,
+	last_line       integer        not null default 0,
+	start_line      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null,
+	started_at      timestamp,
+	updated_at      timestamp      not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict,
+	foreign key (user_id) references users(id) on delete restrict on update restrict
+);
+create index "imports#state" on imports(state);
+create index "imports#site#created_at" on imports(site, created_at);
+
+create table import_fingerprints (
+	site            integer        not null,
+	fingerprint     varchar        not null,
+	import_id       integer        not null,
+	line            integer        not null,
+	created_at      timestamp      not null,
+	path            varchar        not null default '',
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
+create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
+
+create table operations (
+	operation_id    serial         primary key,
+	site            integer        not null,
+	user_id         integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	path            varchar        not null default '',
+	match_title     integer        not null default 0,
+	hits            integer        not null default 0,
+	all_stats       integer        not null default 0,
+
+	created_at      timestamp      not null,
+	undone_at       timestamp,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "operations#site#created_at" on operations(site, created_at);
+
+create table operation_hits (
+	operation_id        integer        not null,
+	id                  integer        not null,
+	site                integer        not null,
+	session             integer        default null,
+	session2            bytea          default null,
+	path                varchar        not null,
+	title               varchar        not null default '',
+	event               integer        default 0,
+	bot                 integer        default 0,
+	ref                 varchar        not null,
+	ref_scheme          varchar        null,
+	browser             varchar        not null,
+	size                varchar        not null default '',
+	location            varchar        not null default '',
+	region              varchar        not null default '',
+	city                varchar        not null default '',
+	host                varchar        not null default '',
+	utm_source          varchar        not null default '',
+	utm_medium          varchar        not null default '',
+	utm_campaign        varchar        not null default '',
+	ua_brands           varchar        not null default '',
+	ua_platform         varchar        not null default '',
+	ua_platform_version varchar        not null default '',
+	bot_score           integer        not null default 0,
+	bot_reasons         integer        not null default 0,
+	first_visit         integer        default 0,
+	sample              integer        not null default 1,
+	created_at          timestamp      not null
+);
+create index "operation_hits#operation_id" on operation_hits(operation_id);
+
+create table cron_status (
+	task            varchar        not null,
+	last_run        timestamp      not null,
+	duration        integer        not null default 0,
+	processed       integer        not null default 0,
+	error           varchar,
+	failing_since   timestamp
+);
+create unique index "cron_status#task" on cron_status(task);
+
+create table stat_retries (
+	stat_retry_id   serial         primary key,
+	site            integer        not null,
+	hits            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null,
+	created_at      timestamp      not null
+);
+create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+create table reindexes (
+	reindex_id      serial         primary key,
+	site            integer        not null,
+	job_id          integer,
+
+	tables          varchar        not null,
+	since_hit       integer,
+	first_day       timestamp      not null,
+	last_day        timestamp      not null,
+	state           varchar        not null,
+	total           integer        not null default 0,
+	done            integer        not null default 0,
+	start_done      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null,
+	started_at      timestamp,
+	updated_at      timestamp      not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "reindexes#state" on reindexes(state);
+create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+create table hit_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	day           date       not null,
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_daily#site#path#day" unique(site, path, day)
+);
+create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+create table hit_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	month         date       not null,
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_monthly#site#path#month" unique(site, path, month)
+);
+create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+create table ref_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	day           date       not null,
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day)
+);
+create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+create table ref_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	month         date       not null,
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month)
+);
+create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+-- Days for which the rollups are outdated; n is incremented on every change
+-- so the cron job can see if the day changed again while it was updating it.
+create table rollup_dirty (
+	site          int        not null check(site>0),
+	tbl           varchar    not null,
+	day           date       not null,
+	n             int        not null default 1,
+
+	constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day)
+);
+
+create function rollup_dirty() returns trigger as $$
+begin
+	if tg_op = 'DELETE' then
+		insert into rollup_dirty (site, tbl, day) values (old.site, tg_table_name, old.hour::date)
+			on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+		return old;
+	end if;
+	insert into rollup_dirty (site, tbl, day) values (new.site, tg_table_name, new.hour::date)
+		on conflict (site, tbl, day) do update set n = rollup_dirty.n + 1;
+	return new;
+end;
+$$ language plpgsql;
+create trigger "hit_counts#rollup_dirty" after insert or update or delete on hit_counts
+	for each row execute procedure rollup_dirty();
+create trigger "ref_counts#rollup_dirty" after insert or update or delete on ref_counts
+	for each row execute procedure rollup_dirty();
+
+create table acme_renewals (
+	cname           varchar        not null,
+	site            integer        not null,
+
+	failures        integer        not null default 0,
+	last_error      varchar,
+	last_error_at   timestamp      null,
+	expires_at      timestamp      null,
+	alerted_at      timestamp      null,
+	next_attempt_at timestamp      not null
+);
+create unique index "acme_renewals#cname" on acme_renewals(cname);
+create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+alter table acme_renewals replica identity using index "acme_renewals#cname";
+
+create table sessions_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null,
+	pageviews      int            not null,
+	duration       int            not null,
+	entry_path     varchar        not null,
+	exit_path      varchar        not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "sessions_stats#site#day" on sessions_stats(site, day);
+alter table sessions_stats replica identity full;
+
 create table store (
 	key     varchar not null,
 	value   text
@@ -13942,7 +15972,49 @@ insert into version values
 	('2020-07-21-1-memsess'),
 	('2020-07-22-1-memsess'),
 	('2020-08-01-1-repl'),
-	('2020-08-24-1-iso_unique');
+	('2020-08-24-1-iso_unique'),
+	('2020-09-10-1-geo-region-city'),
+	('2020-09-12-1-path-index'),
+	('2020-09-14-1-host'),
+	('2020-09-16-1-campaign'),
+	('2020-09-18-1-audit-log'),
+	('2020-09-20-1-device-class'),
+	('2020-09-22-1-email-queue'),
+	('2020-09-24-1-client-hints'),
+	('2020-09-26-1-scroll-stats'),
+	('2020-09-28-1-notifications'),
+	('2020-09-30-1-jobs'),
+	('2020-10-02-1-export-kind'),
+	('2020-10-04-1-export-scheduled'),
+	('2020-10-06-1-no-data'),
+	('2020-10-08-1-first-hit'),
+	('2020-10-10-1-export-dates'),
+	('2020-10-12-1-export-paths'),
+	('2020-10-14-1-export-progress'),
+	('2020-10-16-1-export-encrypted'),
+	('2020-10-18-1-path-watches'),
+	('2020-10-20-1-imports'),
+	('2020-10-22-1-import-progress'),
+	('2020-10-24-1-import-fingerprints'),
+	('2020-10-26-1-import-transform'),
+	('2020-10-28-1-operations'),
+	('2020-10-30-1-cron-status'),
+	('2020-11-03-1-stat-retries'),
+	('2020-11-04-1-bot-score'),
+	('2020-11-05-1-reindexes'),
+	('2020-11-06-1-rollups'),
+	('2020-11-07-1-first-hit-at'),
+	('2020-11-08-1-ref-category'),
+	('2020-11-09-1-acme-renewals'),
+	('2020-11-10-1-sessions-stats'),
+	('2020-11-11-1-hits-host'),
+	('2020-11-11-2-hits-sample'),
+	('2020-11-11-3-anonymized-until'),
+	('2020-11-11-4-jobs-heartbeat'),
+	('2020-11-11-5-import-fingerprints-path'),
+	('2020-11-11-6-device-class-reindex'),
+	('2020-11-11-7-ref-category-reindex'),
+	('2020-11-12-1-counts-host');
 
 -- vim:ft=sql
 `)
@@ -13959,6 +16031,10 @@ var SchemaSQLite = []byte(`create table sites (
 	billing_amount varchar,
 	settings       varchar        not null,
 	received_data  int            not null default 0,
+	no_data_sent_at timestamp     null check(no_data_sent_at = strftime('%Y-%m-%d %H:%M:%S', no_data_sent_at)),
+	first_hit      varchar        null,
+	first_hit_at   timestamp      null                     check(first_hit_at = strftime('%Y-%m-%d %H:%M:%S', first_hit_at)),
+	anonymized_until timestamp    null                     check(anonymized_until = strftime('%Y-%m-%d %H:%M:%S', anonymized_until)),
 
 	state          varchar        not null default 'a'     check(state in ('a', 'd')),
 	created_at     timestamp      not null                 check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
@@ -14021,12 +16097,25 @@ create table hits (
 	browser        varchar        not null,
 	size           varchar        not null default '',
 	location       varchar        not null default '',
+	region         varchar        not null default '',
+	city           varchar        not null default '',
+	host           varchar        not null default '',
+	utm_source     varchar        not null default '',
+	utm_medium     varchar        not null default '',
+	utm_campaign   varchar        not null default '',
+	ua_brands      varchar        not null default '',
+	ua_platform    varchar        not null default '',
+	ua_platform_version varchar   not null default '',
+	bot_score      integer        not null default 0,
+	bot_reasons    integer        not null default 0,
 	first_visit    int            default 0,
+	sample         integer        not null default 1,
 
 	created_at     timestamp      not null                 check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
 );
 create index "hits#site#bot#created_at"      on hits(site, bot, created_at);
 create index "hits#site#path"                on hits(site, lower(path));
+create index "hits#site#host#created_at"     on hits(site, host, created_at);
 
 create table hit_stats (
 	site           integer        not null                 check(site > 0),
@@ -14044,26 +16133,30 @@ create index "hit_stats#site#day" on hit_stats(site, day);
 create table hit_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	title         varchar    not null,
 	event         integer    not null default 0,
 	hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "hit_counts#site#path#hour" unique(site, path, hour) on conflict replace
+	constraint "hit_counts#site#path#host#hour" unique(site, path, host, hour) on conflict replace
 );
 create index "hit_counts#site#hour" on hit_counts(site, hour);
+create index "hit_counts#site#lower_path" on hit_counts(site, lower(path));
 
 create table ref_counts (
 	site          int        not null check(site>0),
 	path          varchar    not null,
+	host          varchar    not null default '',
 	ref           varchar    not null,
 	ref_scheme    varchar    null,
+	ref_category  varchar    null,
 	hour          timestamp  not null check(hour = strftime('%Y-%m-%d %H:%M:%S', hour)),
 	total         int        not null,
 	total_unique  int        not null,
 
-	constraint "ref_counts#site#path#ref#hour" unique(site, path, ref, hour) on conflict replace
+	constraint "ref_counts#site#path#ref#host#hour" unique(site, path, ref, host, hour) on conflict replace
 );
 create index "ref_counts#site#hour" on ref_counts(site, hour);
 
@@ -14099,24 +16192,53 @@ create table location_stats (
 
 	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
 	location       varchar        not null,
+	region         varchar        not null default '',
+	city           varchar        not null default '',
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "location_stats#site#day#location" on location_stats(site, day, location);
+create unique index "location_stats#site#day#location" on location_stats(site, day, location, region, city);
 
 create table size_stats (
 	site           integer        not null                 check(site > 0),
 
 	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
 	width          int            not null,
+	device_class   varchar        not null default '',
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "size_stats#site#day#width#device_class" on size_stats(site, day, width, device_class);
+
+create table host_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	host           varchar        not null,
+	count          int            not null,
+	count_unique   int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "host_stats#site#day#host" on host_stats(site, day, host);
+
+create table campaign_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	source         varchar        not null,
+	medium         varchar        not null,
+	campaign       varchar        not null,
 	count          int            not null,
 	count_unique   int            not null,
 
 	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create unique index "size_stats#site#day#width" on size_stats(site, day, width);
+create unique index "campaign_stats#site#day#source#medium#campaign" on campaign_stats(site, day, source, medium, campaign);
 
 create table iso_3166_1 (
 	name   varchar,
@@ -14440,10 +16562,383 @@ create table exports (
 	size              varchar,
 	hash              varchar,
 	error             varchar,
+	kind              varchar        not null default 'hits',
+	format            varchar        not null default 'csv',
+	scheduled         int            not null default 0,
+	start_date        timestamp      null,
+	end_date          timestamp      null,
+	path_like         varchar        null,
+	paths             varchar        null,
+	max_hit_id        integer        null,
+	encrypted         int            not null default 0,
+
+	foreign key (site_id) references sites(id) on delete restrict on update restrict
+);
+create index "exports#site_id#created_at" on exports(site_id, created_at);
+
+create table audit_log (
+	audit_log_id   integer        primary key autoincrement,
+	site           integer        not null,
+	user_id        integer,
+
+	action         varchar        not null,
+	info           varchar        not null default '',
+	created_at     timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "audit_log#site#created_at" on audit_log(site, created_at);
+
+create table email_queue (
+	email_id        integer        primary key autoincrement,
+	site            integer,
+
+	subject         varchar        not null,
+	from_name       varchar        not null,
+	to_addr         varchar        not null,
+	body            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+	sent_at         timestamp                  check(sent_at is null or sent_at = strftime('%Y-%m-%d %H:%M:%S', sent_at)),
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+);
+create index "email_queue#sent_at#next_attempt_at" on email_queue(sent_at, next_attempt_at);
+create index "email_queue#created_at" on email_queue(created_at);
+
+create table scroll_stats (
+	site           integer        not null                 check(site > 0),
+
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	path           varchar        not null,
+	count          int            not null,
+	total          int            not null,
+	reached_25     int            not null,
+	reached_50     int            not null,
+	reached_75     int            not null,
+	reached_100    int            not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "scroll_stats#site#day#path" on scroll_stats(site, day, path);
+
+create table notifications (
+	notification_id integer        primary key autoincrement,
+	site            integer        not null,
+
+	kind            varchar        not null,
+	message         varchar        not null,
+	url             varchar        not null default '',
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	read_at         timestamp,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "notifications#site#created_at" on notifications(site, created_at);
+
+create table jobs (
+	job_id          integer        primary key autoincrement,
+	site            integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	progress        integer        not null default 0,
+	total           integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	finished_at     timestamp                  check(finished_at = strftime('%Y-%m-%d %H:%M:%S', finished_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+	instance        varchar,
+	heartbeat_at    timestamp                  check(heartbeat_at = strftime('%Y-%m-%d %H:%M:%S', heartbeat_at)),
+	cancel_at       timestamp                  check(cancel_at = strftime('%Y-%m-%d %H:%M:%S', cancel_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "jobs#site#created_at" on jobs(site, created_at);
+
+create table path_watches (
+	watch_id        integer        primary key autoincrement,
+	site            integer        not null,
+
+	path            varchar        not null,
+	threshold       integer        not null default 0,
+	seen_at         timestamp      check(seen_at = strftime('%Y-%m-%d %H:%M:%S', seen_at)),
+	alerted_day     varchar,
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "path_watches#site#path" on path_watches(site, path);
+
+create table imports (
+	import_id       integer        primary key autoincrement,
+	site            integer        not null,
+	user_id         integer        not null,
+
+	mode            varchar        not null,
+	email           integer        not null default 0,
+	transform       varchar        not null default '',
+	file            varchar        not null,
+	hash            varchar        not null,
+	state           varchar        not null,
+	job_id          integer,
+	total           integer        not null default 0,
+	rows_read       integer        not null default 0,
+	errors          integer        not null default 0,
+	skipped         integer        not null default 0,
+	dropped         integer        not null default 0,
+	rows_done       integer        not null default 0,
+	last_line       integer        not null default 0,
+	start_line      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict,
+	foreign key (user_id) references users(id) on delete restrict on update restrict
+);
+create index "imports#state" on imports(state);
+create index "imports#site#created_at" on imports(site, created_at);
+
+create table import_fingerprints (
+	site            integer        not null,
+	fingerprint     varchar        not null,
+	import_id       integer        not null,
+	line            integer        not null,
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	path            varchar        not null default '',
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create unique index "import_fingerprints#site#fingerprint" on import_fingerprints(site, fingerprint);
+create index "import_fingerprints#import_id#line" on import_fingerprints(import_id, line);
+create index "import_fingerprints#site#created_at" on import_fingerprints(site, created_at);
+
+create table operations (
+	operation_id    integer        primary key autoincrement,
+	site            integer        not null,
+	user_id         integer,
+
+	kind            varchar        not null,
+	state           varchar        not null,
+	path            varchar        not null default '',
+	match_title     integer        not null default 0,
+	hits            integer        not null default 0,
+	all_stats       integer        not null default 0,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	undone_at       timestamp                  check(undone_at = strftime('%Y-%m-%d %H:%M:%S', undone_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "operations#site#created_at" on operations(site, created_at);
+
+create table operation_hits (
+	operation_id        integer        not null,
+	id                  integer        not null,
+	site                integer        not null,
+	session             integer        default null,
+	session2            blob           default null,
+	path                varchar        not null,
+	title               varchar        not null default '',
+	event               int            default 0,
+	bot                 int            default 0,
+	ref                 varchar        not null,
+	ref_scheme          varchar        null,
+	browser             varchar        not null,
+	size                varchar        not null default '',
+	location            varchar        not null default '',
+	region              varchar        not null default '',
+	city                varchar        not null default '',
+	host                varchar        not null default '',
+	utm_source          varchar        not null default '',
+	utm_medium          varchar        not null default '',
+	utm_campaign        varchar        not null default '',
+	ua_brands           varchar        not null default '',
+	ua_platform         varchar        not null default '',
+	ua_platform_version varchar        not null default '',
+	bot_score           integer        not null default 0,
+	bot_reasons         integer        not null default 0,
+	first_visit         int            default 0,
+	sample              integer        not null default 1,
+	created_at          timestamp      not null
+);
+create index "operation_hits#operation_id" on operation_hits(operation_id);
+
+create table cron_status (
+	task            varchar        not null,
+	last_run        timestamp      not null    check(last_run = strftime('%Y-%m-%d %H:%M:%S', last_run)),
+	duration        integer        not null default 0,
+	processed       integer        not null default 0,
+	error           varchar,
+	failing_since   timestamp                  check(failing_since = strftime('%Y-%m-%d %H:%M:%S', failing_since))
+);
+create unique index "cron_status#task" on cron_status(task);
+
+create table locks (
+	name            varchar        not null,
+	instance        varchar        not null,
+	expires_at      timestamp      not null    check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at))
+);
+create unique index "locks#name" on locks(name);
+
+create table stat_retries (
+	stat_retry_id   integer        primary key autoincrement,
+	site            integer        not null,
+	hits            text           not null,
+
+	attempts        integer        not null default 0,
+	error           varchar,
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at)),
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at))
+);
+create index "stat_retries#next_attempt_at" on stat_retries(next_attempt_at);
+
+create table reindexes (
+	reindex_id      integer        primary key autoincrement,
+	site            integer        not null,
+	job_id          integer,
+
+	tables          varchar        not null,
+	since_hit       integer,
+	first_day       timestamp      not null    check(first_day = strftime('%Y-%m-%d %H:%M:%S', first_day)),
+	last_day        timestamp      not null    check(last_day = strftime('%Y-%m-%d %H:%M:%S', last_day)),
+	state           varchar        not null,
+	total           integer        not null default 0,
+	done            integer        not null default 0,
+	start_done      integer        not null default 0,
+	error           varchar,
+
+	created_at      timestamp      not null    check(created_at = strftime('%Y-%m-%d %H:%M:%S', created_at)),
+	started_at      timestamp                  check(started_at = strftime('%Y-%m-%d %H:%M:%S', started_at)),
+	updated_at      timestamp      not null    check(updated_at = strftime('%Y-%m-%d %H:%M:%S', updated_at)),
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
+);
+create index "reindexes#state" on reindexes(state);
+create index "reindexes#site#created_at" on reindexes(site, created_at);
+
+create table hit_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_daily#site#path#day" unique(site, path, day) on conflict replace
+);
+create index "hit_counts_daily#site#day" on hit_counts_daily(site, day);
+
+create table hit_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	title         varchar    not null,
+	event         integer    not null default 0,
+	month         date       not null check(month = strftime('%Y-%m-01', month)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "hit_counts_monthly#site#path#month" unique(site, path, month) on conflict replace
+);
+create index "hit_counts_monthly#site#month" on hit_counts_monthly(site, month);
+
+create table ref_counts_daily (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_daily#site#path#ref#day" unique(site, path, ref, day) on conflict replace
+);
+create index "ref_counts_daily#site#day" on ref_counts_daily(site, day);
+
+create table ref_counts_monthly (
+	site          int        not null check(site>0),
+	path          varchar    not null,
+	ref           varchar    not null,
+	ref_scheme    varchar    null,
+	ref_category  varchar    null,
+	month         date       not null check(month = strftime('%Y-%m-01', month)),
+	total         int        not null,
+	total_unique  int        not null,
+
+	constraint "ref_counts_monthly#site#path#ref#month" unique(site, path, ref, month) on conflict replace
+);
+create index "ref_counts_monthly#site#month" on ref_counts_monthly(site, month);
+
+-- Days for which the rollups are outdated; n is incremented on every change
+-- so the cron job can see if the day changed again while it was updating it.
+create table rollup_dirty (
+	site          int        not null check(site>0),
+	tbl           varchar    not null,
+	day           date       not null check(day = strftime('%Y-%m-%d', day)),
+	n             int        not null default 1,
+
+	constraint "rollup_dirty#site#tbl#day" unique(site, tbl, day) on conflict replace
+);
+
+create trigger "hit_counts#rollup_dirty#insert" after insert on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "hit_counts#rollup_dirty#update" after update on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'hit_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='hit_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "hit_counts#rollup_dirty#delete" after delete on hit_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (old.site, 'hit_counts', date(old.hour),
+		coalesce((select n from rollup_dirty where site=old.site and tbl='hit_counts' and day=date(old.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#insert" after insert on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#update" after update on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (new.site, 'ref_counts', date(new.hour),
+		coalesce((select n from rollup_dirty where site=new.site and tbl='ref_counts' and day=date(new.hour)), 0) + 1);
+end;
+create trigger "ref_counts#rollup_dirty#delete" after delete on ref_counts begin
+	insert into rollup_dirty (site, tbl, day, n) values (old.site, 'ref_counts', date(old.hour),
+		coalesce((select n from rollup_dirty where site=old.site and tbl='ref_counts' and day=date(old.hour)), 0) + 1);
+end;
+
+create table acme_renewals (
+	cname           varchar        not null,
+	site            integer        not null,
+
+	failures        integer        not null default 0,
+	last_error      varchar,
+	last_error_at   timestamp      null        check(last_error_at = strftime('%Y-%m-%d %H:%M:%S', last_error_at)),
+	expires_at      timestamp      null        check(expires_at = strftime('%Y-%m-%d %H:%M:%S', expires_at)),
+	alerted_at      timestamp      null        check(alerted_at = strftime('%Y-%m-%d %H:%M:%S', alerted_at)),
+	next_attempt_at timestamp      not null    check(next_attempt_at = strftime('%Y-%m-%d %H:%M:%S', next_attempt_at))
+);
+create unique index "acme_renewals#cname" on acme_renewals(cname);
+create index "acme_renewals#next_attempt_at" on acme_renewals(next_attempt_at);
+
+create table sessions_stats (
+	site           integer        not null                 check(site > 0),
 
-	foreign key (site_id) references sites(id) on delete restrict on update restrict
+	day            date           not null                 check(day = strftime('%Y-%m-%d', day)),
+	pageviews      int            not null,
+	duration       int            not null,
+	entry_path     varchar        not null,
+	exit_path      varchar        not null,
+
+	foreign key (site) references sites(id) on delete restrict on update restrict
 );
-create index "exports#site_id#created_at" on exports(site_id, created_at);
+create index "sessions_stats#site#day" on sessions_stats(site, day);
 
 create table store (
 	key     varchar not null,
@@ -14476,7 +16971,50 @@ insert into version values
 	('2020-07-21-1-memsess'),
 	('2020-07-22-1-memsess'),
 	('2020-08-01-1-repl'),
-	('2020-08-24-1-iso_unique');
+	('2020-08-24-1-iso_unique'),
+	('2020-09-10-1-geo-region-city'),
+	('2020-09-12-1-path-index'),
+	('2020-09-14-1-host'),
+	('2020-09-16-1-campaign'),
+	('2020-09-18-1-audit-log'),
+	('2020-09-20-1-device-class'),
+	('2020-09-22-1-email-queue'),
+	('2020-09-24-1-client-hints'),
+	('2020-09-26-1-scroll-stats'),
+	('2020-09-28-1-notifications'),
+	('2020-09-30-1-jobs'),
+	('2020-10-02-1-export-kind'),
+	('2020-10-04-1-export-scheduled'),
+	('2020-10-06-1-no-data'),
+	('2020-10-08-1-first-hit'),
+	('2020-10-10-1-export-dates'),
+	('2020-10-12-1-export-paths'),
+	('2020-10-14-1-export-progress'),
+	('2020-10-16-1-export-encrypted'),
+	('2020-10-18-1-path-watches'),
+	('2020-10-20-1-imports'),
+	('2020-10-22-1-import-progress'),
+	('2020-10-24-1-import-fingerprints'),
+	('2020-10-26-1-import-transform'),
+	('2020-10-28-1-operations'),
+	('2020-10-30-1-cron-status'),
+	('2020-11-01-1-locks'),
+	('2020-11-03-1-stat-retries'),
+	('2020-11-04-1-bot-score'),
+	('2020-11-05-1-reindexes'),
+	('2020-11-06-1-rollups'),
+	('2020-11-07-1-first-hit-at'),
+	('2020-11-08-1-ref-category'),
+	('2020-11-09-1-acme-renewals'),
+	('2020-11-10-1-sessions-stats'),
+	('2020-11-11-1-hits-host'),
+	('2020-11-11-2-hits-sample'),
+	('2020-11-11-3-anonymized-until'),
+	('2020-11-11-4-jobs-heartbeat'),
+	('2020-11-11-5-import-fingerprints-path'),
+	('2020-11-11-6-device-class-reindex'),
+	('2020-11-11-7-ref-category-reindex'),
+	('2020-11-12-1-counts-host');
 `)
 var Templates = map[string][]byte{
 	"tpl/_backend_bottom.gohtml": []byte(`	</div> {{- /* .page */}}
@@ -14686,6 +17224,14 @@ are supported:</p>
       <td style="text-align: left"><code>allow_frame</code></td>
       <td style="text-align: left">Allow requests when the page is loaded in a frame or iframe.</td>
     </tr>
+    <tr>
+      <td style="text-align: left"><code>scroll_depth</code></td>
+      <td style="text-align: left">Record how far the page was scrolled down when the visitor leaves; this is shown on the dashboard as the average scroll depth for every page.</td>
+    </tr>
+    <tr>
+      <td style="text-align: left"><code>honeypot</code></td>
+      <td style="text-align: left">Add a hidden link that visitors never see, but bots that follow all links do; pageviews in the same session are counted as a bot.</td>
+    </tr>
     <tr>
       <td style="text-align: left"><code>endpoint</code></td>
       <td style="text-align: left">Customize the endpoint for sending pageviews to; see <a href="#setting-the-endpoint-in-javascript">Setting the endpoint in JavaScript </a>.</td>
@@ -14947,6 +17493,17 @@ send events when someone closes a page:</p>
 {{template "code" .}}
 </code></pre>
 
+<p>The parameters can also be sent in the POST body instead of the URL, as
+<code>application/x-www-form-urlencoded</code>, <code>multipart/form-data</code>, JSON, or
+<code>text/plain</code>; for example with <code>fetch()</code> and <code>keepalive</code>:</p>
+
+<pre><code>fetch('{{.Site.URL}}/count', {
+    method:    'POST',
+    keepalive: true,
+    body:      new URLSearchParams({p: location.pathname}),
+})
+</code></pre>
+
 <h3 id="custom-events">Custom events <a href="#custom-events"></a></h3>
 <p>You can send an event by setting the <code>event</code> parameter to <code>true</code> in <code>count()</code>.
 For example:</p>
@@ -15034,6 +17591,19 @@ regular browsers).</p>
 
 <p>Wrap in a <code>&lt;noscript&gt;</code> tag to use this only for people without JavaScript.</p>
 
+<p>If you enable <em>Get path from Referer</em> in the settings then you can leave out
+the <code>p</code> parameter, and the path is taken from the <code>Referer</code> header. This way the
+same snippet can be used on every page:</p>
+
+<pre><code>&lt;noscript&gt;
+    &lt;img src="{{.Site.URL}}/count" alt="" referrerpolicy="no-referrer-when-downgrade"&gt;
+&lt;/noscript&gt;
+</code></pre>
+
+<p>The <code>referrerpolicy</code> is needed as browsers send only the domain to other sites
+by default; some browsers may still send only the domain, in which case the
+pageview is counted as <code>/</code>.</p>
+
 <h3 id="tracking-from-backend-middleware">Tracking from backend middleware <a href="#tracking-from-backend-middleware"></a></h3>
 <p>You can use the <code>/api/v0/count</code> API endpoint to send pageviews from essentially
 anywhere, such as you app's middleware.</p>
@@ -15142,6 +17712,8 @@ want to modify that in JavaScript; you can use <code>goatcounter.endpoint</code>
 					<strong id="back"><a href="/settings#tab-purge">←&#xfe0e; Back</a></strong>
 				{{else if has_prefix .Path "/admin/"}}
 					<strong id="back"><a href="/admin">←&#xfe0e; Back</a></strong>
+				{{else if or (has_prefix .Path "/ingest-log") (has_prefix .Path "/hosts") (has_prefix .Path "/live")}}
+					<strong id="back"><a href="/code">←&#xfe0e; Back</a></strong>
 				{{else if has_prefix .Path "/billing/"}}
 					<strong id="back"><a href="/billing">←&#xfe0e; Back</a></strong>
 				{{else}}
@@ -15243,6 +17815,9 @@ want to modify that in JavaScript; you can use <code>goatcounter.endpoint</code>
 	<table class="count-list count-list-pages" data-max="{{.Max}}" data-scale="{{.Max}}">
 		<tbody class="pages">{{template "_dashboard_pages_rows.gohtml" .}}</tbody>
 	</table>
+	<p class="other-pages" {{if not .MorePages}}style="display: none"{{end}}>…and
+		<span class="other-paths">{{nformat .OtherPages.Paths $.Site}}</span> other pages:
+		<span class="other-count">{{nformat .OtherPages.Count $.Site}}</span> views</p>
 	<a href="#" class="load-more" {{if not .MorePages}}style="display: none"{{end}}>Show more</a>
 </div>
 
@@ -15257,6 +17832,7 @@ want to modify that in JavaScript; you can use <code>goatcounter.endpoint</code>
 			<a class="load-refs rlink" title="{{$h.Path}}" href="#">{{$h.Path}}</a><br>
 			<small class="page-title {{if not $h.Title}}no-title{{end}}">{{if $h.Title}}{{$h.Title}}{{else}}<em>(no title)</em>{{end}}</small>
 			{{if $h.Event}}<sup class="label-event">event</sup>{{end}}
+			{{if $h.Scroll}}<br><small class="scroll-depth" title="{{$h.Scroll.Reached 75}}% scrolled to at least 75%; {{$h.Scroll.Reached 100}}% to the end">Scroll depth: {{$h.Scroll.Average}}% on average</small>{{end}}
 
 			{{if and $.Site.LinkDomain (not $h.Event)}}
 				<br><small class="go"><a target="_blank" rel="noopener" href="https://{{$.Site.LinkDomain}}{{$h.Path}}">Go to {{$.Site.LinkDomain}}{{$h.Path}}</a></small>
@@ -15307,6 +17883,9 @@ want to modify that in JavaScript; you can use <code>goatcounter.endpoint</code>
 		</tr></thead>
 		<tbody class="pages">{{template "_dashboard_pages_text_rows.gohtml" .}}</tbody>
 	</table>
+	<p class="other-pages" {{if not .MorePages}}style="display: none"{{end}}>…and
+		<span class="other-paths">{{nformat .OtherPages.Paths $.Site}}</span> other pages:
+		<span class="other-count">{{nformat .OtherPages.Count $.Site}}</span> views</p>
 	<a href="#" class="load-more" {{if not .MorePages}}style="display: none"{{end}}>Show more</a>
 </div>
 `),
@@ -15340,6 +17919,11 @@ want to modify that in JavaScript; you can use <code>goatcounter.endpoint</code>
 	<h2>Screen size</h2>
 	{{horizontal_chart .Context .Stats .TotalUniqueHits 6 true true}}
 </div>
+`),
+	"tpl/_dashboard_sources.gohtml": []byte(`<div class="hchart" data-detail="/hchart-detail?kind=source">
+	<h2>Sources</h2>
+	{{horizontal_chart .Context .Stats .TotalUniqueHits 0 true false}}
+</div>
 `),
 	"tpl/_dashboard_systems.gohtml": []byte(`<div class="hchart" data-detail="/hchart-detail?kind=system" data-more="/hchart-more?kind=system">
 	<h2>Systems</h2>
@@ -15489,7 +18073,8 @@ input    { float: right; padding: .4em !important; }
 <p>
 	<a href="/debug/pprof">pprof</a> |
 	<a href="/admin/sql">PostgreSQL</a> |
-	<a href="/admin/botlog">Botlog</a>
+	<a href="/admin/botlog">Botlog</a> |
+	<a href="/admin/email">Email queue</a>
 </p>
 
 <h2>Signups</h2>
@@ -15500,6 +18085,9 @@ input    { float: right; padding: .4em !important; }
 
 
 <h2>Sites</h2>
+{{if and .Stats (index .Stats 0).Estimated}}
+	<p>The total hits are estimated; <a href="/admin?exact=1">count exactly</a> (slow).</p>
+{{end}}
 <table class="sort">
 <thead><tr>
 	<th class="n">Last month</th>
@@ -15512,7 +18100,7 @@ input    { float: right; padding: .4em !important; }
 <tbody>{{range $s := .Stats}}
 	<tr id="{{$s.ID}}" class="plan-{{$s.Plan}}">
 		<td class="n">{{nformat $s.LastMonth $.Site}}</td>
-		<td class="n">{{nformat $s.Total $.Site}}</td>
+		<td class="n">{{if $s.Estimated}}~{{end}}{{nformat $s.Total $.Site}}</td>
 		<td><a href="/admin/{{$s.ID}}">{{$s.Code}}</a></td>
 		<td>{{$s.LinkDomain}}<br>{{$s.Email}}</td>
 		<td>
@@ -15589,6 +18177,77 @@ input    { float: right; padding: .4em !important; }
 </tbody>
 </table>
 
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/admin_email.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<style>
+table    { max-width: none !important; }
+td       { vertical-align: top; }
+th       { text-align: left; }
+.n       { text-align: right; }
+</style>
+
+<h2>Email queue</h2>
+<p>Emails that failed to send on the first attempt; these are retried with an
+	exponential backoff for up to {{.EmailMaxAttempts}} attempts.</p>
+<p><a href="/admin/email/preview">Preview email templates</a></p>
+
+{{if .Emails}}
+<table>
+<thead><tr>
+	<th>Created</th>
+	<th>Site</th>
+	<th>To</th>
+	<th>Subject</th>
+	<th class="n">Attempts</th>
+	<th>Status</th>
+</tr></thead>
+<tbody>
+	{{range $e := .Emails}}
+	<tr>
+		<td>{{$e.CreatedAt.Format "2006-01-02 15:04"}}</td>
+		<td>{{with $e.Site}}<a href="/admin/{{.}}">{{.}}</a>{{end}}</td>
+		<td>{{$e.To}}</td>
+		<td>{{$e.Subject}}</td>
+		<td class="n">{{$e.Attempts}}</td>
+		<td>{{if $e.SentAt}}Sent {{$e.SentAt.Format "2006-01-02 15:04"}}
+			{{else if $e.Failed}}Failed: {{deref_s $e.Error}}
+			{{else}}Next attempt {{$e.NextAttemptAt.Format "2006-01-02 15:04"}}: {{deref_s $e.Error}}{{end}}</td>
+	</tr>
+	{{end}}
+</tbody>
+</table>
+{{else}}
+	<p>No emails in the queue.</p>
+{{end}}
+
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/admin_email_preview.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h2>Email preview</h2>
+<p>Render an email template with sample data for this site and user, to check
+	changes to the templates without triggering a real export or import.</p>
+
+<form method="get" action="/admin/email/preview">
+	<label for="template">Template</label>
+	<select name="template" id="template">
+		{{range $t := .Templates}}
+			<option {{if eq $t $.Template}}selected{{end}}>{{$t}}</option>
+		{{end}}
+	</select>
+	<button type="submit">Preview</button>
+</form>
+
+<pre>{{.Body}}</pre>
+
+<form method="post" action="/admin/email/preview">
+	<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+	<input type="hidden" name="template" value="{{.Template}}">
+	<button type="submit">Send to {{.User.Email}}</button>
+</form>
+
 {{template "_backend_bottom.gohtml" .}}
 `),
 	"tpl/admin_site.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
@@ -15644,7 +18303,8 @@ input    { float: right; padding: .4em !important; }
 </form>
 
 <table>
-	<tr><td>Total</td><td>{{nformat .Stat.CountTotal $.Site}}</td></tr>
+	<tr><td>Total</td><td>{{if .Stat.Estimated}}~{{nformat .Stat.CountTotal $.Site}}
+		(<a href="/admin/{{.Stat.Site.ID}}?exact=1">count exactly</a>){{else}}{{nformat .Stat.CountTotal $.Site}}{{end}}</td></tr>
 	<tr><td>Last month</td><td>{{nformat .Stat.CountLastMonth $.Site}}</td></tr>
 	<tr><td>Previous month</td><td>{{nformat .Stat.CountPrevMonth $.Site}}</td></tr>
 	<tr><td>Last data received</td><td>{{.Stat.LastData}}</td></tr>
@@ -16769,31 +19429,1143 @@ depending on whether daylight savings time is in use at the time instant.</p>
     {
       "name": "count"
     },
-    {
-      "name": "export"
+    {
+      "name": "cron"
+    },
+    {
+      "name": "export"
+    },
+    {
+      "name": "import"
+    },
+    {
+      "name": "jobs"
+    },
+    {
+      "name": "notifications"
+    },
+    {
+      "name": "reindex"
+    },
+    {
+      "name": "sites"
+    },
+    {
+      "name": "user"
+    }
+  ],
+  "paths": {
+    "/api/v0/count": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "description": "This can count one or more pageviews. Pageviews are not persisted immediatly,\nbut persisted in the background every 10 seconds.\n\nThe maximum amount of pageviews per request is 100.\n\nErrors will have the key set to the index of the pageview. Any pageviews not\nlisted have been processed and shouldn't be sent again.\n\nThe response header has the X-Goatcounter-Memstore header set to the time of\nthe last time pageviews were persisted to the database. This is useful if you\nwant to (roughly) sync up with this.",
+        "operationId": "POST_api_v0_count",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "handlers.APICountRequest",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/handlers.APICountRequest"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted (no data)"
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Count pageviews.",
+        "tags": [
+          "count"
+        ]
+      }
+    },
+    "/api/v0/cron": {
+      "get": {
+        "operationId": "GET_api_v0_cron",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiCronResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get the status of the background tasks.",
+        "description": "This lists when every cron task ran last, how long it took, and the error if\nit failed. The failing_since is set if the task has been failing since then,\nwhich can be used to alert when e.g. PersistAndStat keeps failing.\n\nOn goatcounter.com this is only available for the admin site.",
+        "tags": [
+          "cron"
+        ]
+      }
+    },
+    "/api/v0/export": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "description": "This starts a new export in the background; this is an export of all\npageviews by default, or of the aggregated statistics with \"kind\": \"stats\".\nThe statistics for pageviews that were removed by the data retention are\nonly included if the site keeps the statistics after the retention.",
+        "operationId": "POST_api_v0_export",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "handlers.apiExportRequest",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/handlers.apiExportRequest"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Export"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Start a new export in the background.",
+        "tags": [
+          "export"
+        ]
+      }
+    },
+    "/api/v0/export/{id}": {
+      "get": {
+        "operationId": "GET_api_v0_export_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Export"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get details about an export.",
+        "tags": [
+          "export"
+        ]
+      }
+    },
+    "/api/v0/export/{id}/download": {
+      "get": {
+        "operationId": "GET_api_v0_export_{id}_download",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json",
+          "text/csv"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK (text/csv data)"
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Download an export file.",
+        "tags": [
+          "export"
+        ]
+      }
+    },
+    "/api/v0/export/{id}/manifest": {
+      "get": {
+        "description": "The manifest has the size, SHA256 hash, and number of rows of the export\nfile; use \"goatcounter verify\" to verify a downloaded file with it.",
+        "operationId": "GET_api_v0_export_{id}_manifest",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ExportManifest"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get the manifest of an export.",
+        "tags": [
+          "export"
+        ]
+      }
+    },
+    "/api/v0/export/{id}/resume": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "description": "This continues an export that failed (for example because the disk was full)\nfrom the last_hit_id in the background. Encrypted exports need the\npassphrase.",
+        "operationId": "POST_api_v0_export_{id}_resume",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          },
+          {
+            "in": "body",
+            "name": "handlers.apiExportResumeRequest",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/handlers.apiExportResumeRequest"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Export"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Resume a failed export.",
+        "tags": [
+          "export"
+        ]
+      }
+    },
+    "/api/v0/hits": {
+      "get": {
+        "description": "This lists the raw pageviews, oldest first. Use ?start= and ?end= to list\nonly pageviews in this range (as RFC3339), ?fields= to select a\ncomma-separated list of fields (all fields if empty), and ?limit= to set the\nnumber of pageviews to list (default 100).\n\nPass the cursor from the response as ?cursor= to get the next page; keep\nrequesting with the last cursor to get new pageviews as they come in.\n\nThe created_at field is in UTC; use ?tz= to convert it to another timezone.",
+        "operationId": "GET_api_v0_hits",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiHitsResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List pageviews.",
+        "tags": [
+          "hits"
+        ]
+      }
+    },
+    "/api/v0/imports": {
+      "get": {
+        "description": "This lists the 100 most recent imports, newest first.",
+        "operationId": "GET_api_v0_imports",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiImportsResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List imports.",
+        "tags": [
+          "import"
+        ]
+      }
+    },
+    "/api/v0/imports/{id}": {
+      "get": {
+        "description": "The progress and ETA are estimates based on the number of rows that were read\nso far.",
+        "operationId": "GET_api_v0_imports_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ImportJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get the progress of an import.",
+        "tags": [
+          "import"
+        ]
+      }
+    },
+    "/api/v0/imports/{id}/cancel": {
+      "post": {
+        "description": "The import will stop after the current row; pageviews that were already\nimported are kept. A cancelled import can't be resumed.\n\nThis requires the site_update permission, as imports change the site's\nstatistics.",
+        "operationId": "POST_api_v0_imports_{id}_cancel",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ImportJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Cancel an import.",
+        "tags": [
+          "import"
+        ]
+      }
+    },
+    "/api/v0/jobs": {
+      "get": {
+        "description": "This lists the 100 most recent background jobs, such as exports and imports,\nnewest first.",
+        "operationId": "GET_api_v0_jobs",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiJobsResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List background jobs.",
+        "tags": [
+          "jobs"
+        ]
+      }
+    },
+    "/api/v0/jobs/{id}": {
+      "get": {
+        "operationId": "GET_api_v0_jobs_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Job"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get details about a background job.",
+        "tags": [
+          "jobs"
+        ]
+      }
+    },
+    "/api/v0/jobs/{id}/cancel": {
+      "post": {
+        "description": "The job will stop at the next convenient point; exports that are cancelled\ncan be resumed later, and pageviews that were already imported are kept.\n\nThis requires the site_update permission.",
+        "operationId": "POST_api_v0_jobs_{id}_cancel",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Job"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Cancel a background job.",
+        "tags": [
+          "jobs"
+        ]
+      }
+    },
+    "/api/v0/me": {
+      "get": {
+        "description": "This includes the user's role, the permissions of the API key, the user's\nsites, and the most recent audit log entries, so that tools can check what\nthey're allowed to do before doing it.",
+        "operationId": "GET_api_v0_me",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.meResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get information about the current user and API key.",
+        "tags": [
+          "user"
+        ]
+      }
+    },
+    "/api/v0/notifications": {
+      "get": {
+        "description": "This lists the 100 most recent notifications about background jobs, such as\nfinished exports and imports, newest first. Use ?unread=true to list only\nnotifications that haven't been dismissed yet.",
+        "operationId": "GET_api_v0_notifications",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiNotificationsResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List notifications.",
+        "tags": [
+          "notifications"
+        ]
+      }
+    },
+    "/api/v0/notifications/{id}/dismiss": {
+      "post": {
+        "operationId": "POST_api_v0_notifications_{id}_dismiss",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Notification"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Dismiss a notification.",
+        "tags": [
+          "notifications"
+        ]
+      }
+    },
+    "/api/v0/purge/preview": {
+      "get": {
+        "description": "Get the number of pageviews and statistics, and the paths that would be\nremoved by purging the paths matching ?path=, without removing anything. The\npath is a pattern where % matches any text and _ any single character. With\n?match_title=true the title must match the pattern as well.",
+        "operationId": "GET_api_v0_purge_preview",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.PurgePreview"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Preview a purge.",
+        "tags": [
+          "purge"
+        ]
+      }
+    },
+    "/api/v0/reindex": {
+      "get": {
+        "description": "This lists the 100 most recent reindexes, newest first.",
+        "operationId": "GET_api_v0_reindex",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiReindexesResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List reindexes.",
+        "tags": [
+          "reindex"
+        ]
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "description": "This recreates the statistics from the pageviews, for example after changing\nthe bot threshold. Only one reindex can run at the same time for a site.",
+        "operationId": "POST_api_v0_reindex",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "handlers.apiReindexRequest",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/handlers.apiReindexRequest"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ReindexJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Start reindexing the statistics in the background.",
+        "tags": [
+          "reindex"
+        ]
+      }
+    },
+    "/api/v0/reindex/accuracy": {
+      "get": {
+        "description": "Recreate the statistics for ?day= (as year-month-day in UTC) from the\npageviews, and compare them with the stored statistics; nothing is changed.\nPageviews from the last 10 seconds may not be in the statistics yet.",
+        "operationId": "GET_api_v0_reindex_accuracy",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.AccuracyReport"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Check the statistics for a day.",
+        "tags": [
+          "reindex"
+        ]
+      }
+    },
+    "/api/v0/reindex/{id}": {
+      "get": {
+        "description": "The progress and ETA are estimates based on the number of days that were\nreindexed so far.",
+        "operationId": "GET_api_v0_reindex_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ReindexJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get the progress of a reindex.",
+        "tags": [
+          "reindex"
+        ]
+      }
     },
-    {
-      "name": "sites"
+    "/api/v0/reindex/{id}/pause": {
+      "post": {
+        "description": "The reindex will stop after the current month; it can be continued later\nwith the resume endpoint.",
+        "operationId": "POST_api_v0_reindex_{id}_pause",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ReindexJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Pause a reindex.",
+        "tags": [
+          "reindex"
+        ]
+      }
     },
-    {
-      "name": "user"
-    }
-  ],
-  "paths": {
-    "/api/v0/count": {
+    "/api/v0/reindex/{id}/resume": {
+      "post": {
+        "description": "This continues the reindex in the background from the first day that wasn't\nreindexed yet.",
+        "operationId": "POST_api_v0_reindex_{id}_resume",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "202": {
+            "description": "202 Accepted",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.ReindexJob"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Resume a paused or failed reindex.",
+        "tags": [
+          "reindex"
+        ]
+      }
+    },
+    "/api/v0/sites": {
+      "get": {
+        "operationId": "GET_api_v0_sites",
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiSitesResponse"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "List the current site and all additional sites.",
+        "tags": [
+          "sites"
+        ]
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "operationId": "PUT_api_v0_sites",
+        "parameters": [
+          {
+            "in": "body",
+            "name": "goatcounter.Site",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Site"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Site"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Create a new site.",
+        "tags": [
+          "sites"
+        ]
+      }
+    },
+    "/api/v0/sites/{id}": {
+      "get": {
+        "description": "Get all information about one site.",
+        "operationId": "GET_api_v0_sites_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Site"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Get information about a site.",
+        "tags": [
+          "sites"
+        ]
+      },
       "post": {
         "consumes": [
           "application/json"
         ],
-        "description": "This can count one or more pageviews. Pageviews are not persisted immediatly,\nbut persisted in the background every 10 seconds.\n\nThe maximum amount of pageviews per request is 100.\n\nErrors will have the key set to the index of the pageview. Any pageviews not\nlisted have been processed and shouldn't be sent again.\n\nThe response header has the X-Goatcounter-Memstore header set to the time of\nthe last time pageviews were persisted to the database. This is useful if you\nwant to (roughly) sync up with this.",
-        "operationId": "POST_api_v0_count",
+        "description": "A POST request will *replace* the entire site with what's sent, blanking out\nany existing fields that may exist. A PATCH request will only update the\nfields that are sent.",
+        "operationId": "POST_api_v0_sites_{id}",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          },
+          {
+            "in": "body",
+            "name": "handlers.apiSiteUpdateRequest",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/handlers.apiSiteUpdateRequest"
+            }
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Site"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Update a site.",
+        "tags": [
+          "sites"
+        ]
+      },
+      "patch": {
+        "consumes": [
+          "application/json"
+        ],
+        "description": "A POST request will *replace* the entire site with what's sent, blanking out\nany existing fields that may exist. A PATCH request will only update the\nfields that are sent.",
+        "operationId": "PATCH_api_v0_sites_{id}",
         "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          },
           {
             "in": "body",
-            "name": "handlers.APICountRequest",
+            "name": "handlers.apiSiteUpdateRequest",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/handlers.APICountRequest"
+              "$ref": "#/definitions/handlers.apiSiteUpdateRequest"
             }
           }
         ],
@@ -16801,8 +20573,52 @@ depending on whether daylight savings time is in use at the time instant.</p>
           "application/json"
         ],
         "responses": {
-          "202": {
-            "description": "202 Accepted (no data)"
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/goatcounter.Site"
+            }
+          },
+          "400": {
+            "description": "400 Bad Request",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiError"
+            }
+          },
+          "403": {
+            "description": "403 Forbidden",
+            "schema": {
+              "$ref": "#/definitions/handlers.authError"
+            }
+          }
+        },
+        "summary": "Update a site.",
+        "tags": [
+          "sites"
+        ]
+      }
+    },
+    "/api/v0/sites/{id}/verify": {
+      "get": {
+        "description": "This reports if pageviews are arriving, from which hostnames, and how many\nwere rejected. The counts in \"ingest\" are kept in memory; they're reset when\nGoatCounter is restarted, and are per server if you run more than one.",
+        "operationId": "GET_api_v0_sites_{id}_verify",
+        "parameters": [
+          {
+            "in": "path",
+            "name": "id",
+            "required": true,
+            "type": "integer"
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "responses": {
+          "200": {
+            "description": "200 OK",
+            "schema": {
+              "$ref": "#/definitions/handlers.apiSiteVerifyResponse"
+            }
           },
           "400": {
             "description": "400 Bad Request",
@@ -16817,421 +20633,630 @@ depending on whether daylight savings time is in use at the time instant.</p>
             }
           }
         },
-        "summary": "Count pageviews.",
-        "tags": [
-          "count"
-        ]
-      }
-    },
-    "/api/v0/export": {
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
-        "description": "This starts a new export in the background.",
-        "operationId": "POST_api_v0_export",
-        "parameters": [
-          {
-            "in": "body",
-            "name": "handlers.apiExportRequest",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/handlers.apiExportRequest"
-            }
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "202": {
-            "description": "202 Accepted",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Export"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
+        "summary": "Verify the installation of a site.",
+        "tags": [
+          "sites"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "goatcounter.APIToken": {
+      "title": "APIToken",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "$ref": "#/definitions/goatcounter.APITokenPermissions"
+        }
+      }
+    },
+    "goatcounter.APITokenPermissions": {
+      "title": "APITokenPermissions",
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "boolean"
+        },
+        "export": {
+          "type": "boolean"
+        },
+        "site_create": {
+          "type": "boolean"
+        },
+        "site_read": {
+          "type": "boolean"
+        },
+        "site_update": {
+          "type": "boolean"
+        }
+      }
+    },
+    "goatcounter.AccuracyDiff": {
+      "title": "AccuracyDiff",
+      "description": "AccuracyDiff is a row that's different.",
+      "type": "object",
+      "properties": {
+        "computed": {
+          "type": "string"
+        },
+        "key": {
+          "description": "Path, and the referrer and hour for the tables that have it.",
+          "type": "string"
+        },
+        "stored": {
+          "description": "The stored and recreated values; this is the total and unique count\nseparated by a \"/\", and is empty if there is no row.",
+          "type": "string"
+        }
+      }
+    },
+    "goatcounter.AccuracyReport": {
+      "title": "AccuracyReport",
+      "description": "AccuracyReport compares the statistics stored for a day with the statistics\nrecreated from the pageviews; see NewAccuracyReport().",
+      "type": "object",
+      "properties": {
+        "day": {
+          "description": "Day in UTC, as year-month-day.",
+          "type": "string"
+        },
+        "hits": {
+          "description": "Number of pageviews on this day, including bots.",
+          "type": "integer"
+        },
+        "ok": {
+          "description": "Set if there are no differences in any of the tables.",
+          "type": "boolean"
+        },
+        "unchecked": {
+          "description": "Set if the pageviews for this day were removed while the statistics were\nkept, so the statistics can't be checked; OK is always false and Tables\nis empty.",
+          "type": "boolean"
+        },
+        "tables": {
+          "description": "Differences for every table in AccuracyTables.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.AccuracyTable"
+          }
+        }
+      }
+    },
+    "goatcounter.AccuracyTable": {
+      "title": "AccuracyTable",
+      "description": "AccuracyTable are the differences for a single table.",
+      "type": "object",
+      "properties": {
+        "computed": {
+          "description": "Number of rows recreated from the pageviews.",
+          "type": "integer"
+        },
+        "diffs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.AccuracyDiff"
+          }
+        },
+        "stored": {
+          "description": "Number of stored rows.",
+          "type": "integer"
+        },
+        "table": {
+          "type": "string"
+        }
+      }
+    },
+    "goatcounter.AuditLog": {
+      "title": "AuditLog",
+      "description": "AuditLog is a record of a destructive action on a site.",
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "readOnly": true
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "info": {
+          "type": "string",
+          "readOnly": true
+        },
+        "site": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "user_id": {
+          "type": "integer",
+          "readOnly": true
+        }
+      }
+    },
+    "goatcounter.CronStatus": {
+      "title": "CronStatus",
+      "description": "CronStatus is the result of the last run of a cron task.\n\nThis is shared by all instances using the same database, so it's the status\nof whichever instance ran the task last.",
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "How long the last run took, in milliseconds.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "error": {
+          "description": "Error of the last run, or null if it succeeded.",
+          "type": "string",
+          "readOnly": true
+        },
+        "failing_since": {
+          "description": "When the task started failing; null if the last run succeeded.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "last_run": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "period": {
+          "description": "How often the task runs, in seconds.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "processed": {
+          "description": "Number of rows processed in the last run; what this means depends on the\ntask (e.g. pageviews for PersistAndStat). Tasks that don't report this\nare always 0.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "task": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "goatcounter.Export": {
+      "title": "Export",
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "encrypted": {
+          "description": "The file is encrypted with a passphrase.",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "end_date": {
+          "description": "Only export pageviews before this time; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "description": "Any errors that may have occured.",
+          "type": "string",
+          "readOnly": true
+        },
+        "expired": {
+          "description": "The export file was removed because it's older than the retention\nperiod.",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "finished_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "format": {
+          "description": "File format: \"csv\" or \"json\". Exports of pageviews are always CSV.",
+          "type": "string"
+        },
+        "hash": {
+          "description": "SHA256 hash.",
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "kind": {
+          "description": "What to export: \"hits\" for all pageviews, or \"stats\" for the aggregated\nstatistics.",
+          "type": "string"
+        },
+        "last_hit_id": {
+          "description": "Last hit ID that was exported; can be used as start_from_hit_id.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "max_hit_id": {
+          "description": "Highest hit ID when the export was started; the export is finished\nwhen it gets here.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "num_rows": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "path_like": {
+          "description": "Only export pageviews with a path that matches this LIKE pattern\n(case-insensitive), or that is in paths; for example \"/blog/%\". May be\nnull.",
+          "type": "string"
+        },
+        "paths": {
+          "description": "Only export pageviews with one of these paths, or that match path_like.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "summary": "Start a new export in the background.",
-        "tags": [
-          "export"
-        ]
+        "progress": {
+          "description": "Progress as a percentage, based on the hit IDs; this is updated after\nevery batch of exported pageviews.",
+          "type": "number",
+          "readOnly": true
+        },
+        "scheduled": {
+          "description": "Started automatically because of the export_schedule setting.",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "site_id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "size": {
+          "description": "File size in MB.",
+          "type": "string",
+          "readOnly": true
+        },
+        "start_date": {
+          "description": "Only export pageviews on or after this time; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "start_from_hit_id": {
+          "description": "The hit ID this export was started from.",
+          "type": "integer"
+        }
       }
     },
-    "/api/v0/export/{id}": {
-      "get": {
-        "operationId": "GET_api_v0_export_{id}",
-        "parameters": [
-          {
-            "in": "path",
-            "name": "id",
-            "required": true,
-            "type": "integer"
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Export"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
+    "goatcounter.ExportManifest": {
+      "title": "ExportManifest",
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
         },
-        "summary": "Get details about an export.",
-        "tags": [
-          "export"
-        ]
+        "encrypted": {
+          "description": "Encrypted with a passphrase.",
+          "type": "boolean"
+        },
+        "end_date": {
+          "description": "Only pageviews before this; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "export_id": {
+          "type": "integer"
+        },
+        "file": {
+          "description": "Filename of the export.",
+          "type": "string"
+        },
+        "format": {
+          "description": "\"csv\" or \"json\".",
+          "type": "string"
+        },
+        "kind": {
+          "description": "\"hits\" or \"stats\".",
+          "type": "string"
+        },
+        "rows": {
+          "description": "Number of exported rows.",
+          "type": "integer"
+        },
+        "sha256": {
+          "description": "Hex-encoded SHA256 of the file.",
+          "type": "string"
+        },
+        "site": {
+          "description": "Site code.",
+          "type": "string"
+        },
+        "size": {
+          "description": "File size in bytes.",
+          "type": "integer"
+        },
+        "start_date": {
+          "description": "Only pageviews on or after this; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "version": {
+          "description": "ExportVersion or ExportStatsVersion.",
+          "type": "string"
+        }
       }
     },
-    "/api/v0/export/{id}/download": {
-      "get": {
-        "operationId": "GET_api_v0_export_{id}_download",
-        "parameters": [
-          {
-            "in": "path",
-            "name": "id",
-            "required": true,
-            "type": "integer"
-          }
-        ],
-        "produces": [
-          "application/json",
-          "text/csv"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK (text/csv data)"
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
+    "goatcounter.ImportJob": {
+      "title": "ImportJob",
+      "description": "ImportJob is an import of a file, which is stored so that the import can be\nresumed after a restart or crash.\n\nThe file is copied to ExportDir() and removed once the import is finished.\nThe progress is only recorded for rows that are written to the database, so\na resumed import continues from the last row that was stored. Rows that were\nwritten after the last checkpoint are imported twice with ImportAdd and\nImportAddNew; the other modes remove them again if the import didn't get to\na checkpoint yet.",
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "dropped": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "error": {
+          "description": "Error if the state is failed.",
+          "type": "string",
+          "readOnly": true
+        },
+        "errors": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "eta": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "hash": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "job_id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "mode": {
+          "type": "string",
+          "readOnly": true
+        },
+        "progress": {
+          "description": "Progress as a percentage, and the estimated time the import finishes;\nthese are set by ByID() and List().",
+          "type": "number",
+          "readOnly": true
+        },
+        "rows_done": {
+          "description": "Number of imported rows, and the last line of the file that was read\n(without the header). Both are only updated once the rows are written\nto the database.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "rows_read": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "site": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "skipped": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "state": {
+          "description": "running, done, failed, or cancelled.",
+          "type": "string",
+          "readOnly": true
         },
-        "summary": "Download an export file.",
-        "tags": [
-          "export"
-        ]
-      }
-    },
-    "/api/v0/me": {
-      "get": {
-        "operationId": "GET_api_v0_me",
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/handlers.meResponse"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
+        "total": {
+          "description": "Number of rows in the file (without the header), the number of rows\nthat were read so far, the number of rows that couldn't be imported,\nthe number of rows that were skipped as they were already imported\n(only with ImportAddNew), and the number of rows that were dropped by\nthe transform.",
+          "type": "integer",
+          "readOnly": true
         },
-        "summary": "Get information about the current user and API key.",
-        "tags": [
-          "user"
-        ]
-      }
-    },
-    "/api/v0/sites": {
-      "get": {
-        "operationId": "GET_api_v0_sites",
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiSitesResponse"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
+        "transform": {
+          "description": "Configuration of the ImportTransform that's applied to every row; empty\nif rows are imported as-is.",
+          "type": "string",
+          "readOnly": true
         },
-        "summary": "List the current site and all additional sites.",
-        "tags": [
-          "sites"
-        ]
-      },
-      "put": {
-        "consumes": [
-          "application/json"
-        ],
-        "operationId": "PUT_api_v0_sites",
-        "parameters": [
-          {
-            "in": "body",
-            "name": "goatcounter.Site",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Site"
-            }
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Site"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         },
-        "summary": "Create a new site.",
-        "tags": [
-          "sites"
-        ]
+        "user_id": {
+          "type": "integer",
+          "readOnly": true
+        }
       }
     },
-    "/api/v0/sites/{id}": {
-      "get": {
-        "description": "Get all information about one site.",
-        "operationId": "GET_api_v0_sites_{id}",
-        "parameters": [
-          {
-            "in": "path",
-            "name": "id",
-            "required": true,
-            "type": "integer"
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Site"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
-          }
-        },
-        "summary": "Get information about a site.",
-        "tags": [
-          "sites"
-        ]
-      },
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
-        "description": "A POST request will *replace* the entire site with what's sent, blanking out\nany existing fields that may exist. A PATCH request will only update the\nfields that are sent.",
-        "operationId": "POST_api_v0_sites_{id}",
-        "parameters": [
-          {
-            "in": "path",
-            "name": "id",
-            "required": true,
+    "goatcounter.IngestStats": {
+      "title": "IngestStats",
+      "type": "object",
+      "properties": {
+        "hosts": {
+          "description": "Number of pageviews for every hostname.",
+          "type": "object",
+          "additionalProperties": {
             "type": "integer"
-          },
-          {
-            "in": "body",
-            "name": "handlers.apiSiteUpdateRequest",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/handlers.apiSiteUpdateRequest"
-            }
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Site"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
           }
         },
-        "summary": "Update a site.",
-        "tags": [
-          "sites"
-        ]
-      },
-      "patch": {
-        "consumes": [
-          "application/json"
-        ],
-        "description": "A POST request will *replace* the entire site with what's sent, blanking out\nany existing fields that may exist. A PATCH request will only update the\nfields that are sent.",
-        "operationId": "PATCH_api_v0_sites_{id}",
-        "parameters": [
-          {
-            "in": "path",
-            "name": "id",
-            "required": true,
-            "type": "integer"
-          },
-          {
-            "in": "body",
-            "name": "handlers.apiSiteUpdateRequest",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/handlers.apiSiteUpdateRequest"
-            }
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "responses": {
-          "200": {
-            "description": "200 OK",
-            "schema": {
-              "$ref": "#/definitions/goatcounter.Site"
-            }
-          },
-          "400": {
-            "description": "400 Bad Request",
-            "schema": {
-              "$ref": "#/definitions/handlers.apiError"
-            }
-          },
-          "403": {
-            "description": "403 Forbidden",
-            "schema": {
-              "$ref": "#/definitions/handlers.authError"
-            }
+        "last": {
+          "description": "Last pageview that was received; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "results": {
+          "description": "Number of pageviews for every result (stored, bot, ignored, etc.)",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
           }
         },
-        "summary": "Update a site.",
-        "tags": [
-          "sites"
-        ]
+        "since": {
+          "description": "Start of the counts.",
+          "type": "string",
+          "format": "date-time"
+        }
       }
-    }
-  },
-  "definitions": {
-    "goatcounter.APIToken": {
-      "title": "APIToken",
+    },
+    "goatcounter.Job": {
+      "title": "Job",
+      "description": "Job is a long-running task that runs in the background, such as an export\nor import.",
       "type": "object",
       "properties": {
-        "name": {
-          "type": "string"
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         },
-        "permissions": {
-          "$ref": "#/definitions/goatcounter.APITokenPermissions"
+        "error": {
+          "description": "Error if the state is failed.",
+          "type": "string",
+          "readOnly": true
+        },
+        "finished_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "readOnly": true
+        },
+        "progress": {
+          "description": "Number of items processed, and the total number of items if it's known\nin advance (0 otherwise).",
+          "type": "integer",
+          "readOnly": true
+        },
+        "site": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "started_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "state": {
+          "description": "queued, running, done, failed, or cancelled.",
+          "type": "string",
+          "readOnly": true
+        },
+        "total": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         }
       }
     },
-    "goatcounter.APITokenPermissions": {
-      "title": "APITokenPermissions",
+    "goatcounter.Notification": {
+      "title": "Notification",
+      "description": "Notification is a message about something that happened in the background,\nshown on the dashboard until it's dismissed.",
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "kind": {
+          "type": "string",
+          "readOnly": true
+        },
+        "message": {
+          "type": "string",
+          "readOnly": true
+        },
+        "read_at": {
+          "description": "Set when dismissed.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "site": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "url": {
+          "description": "Link to more details, if any.",
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "goatcounter.PurgePath": {
+      "title": "PurgePath",
+      "description": "PurgePath is a path that would be removed by Hits.Purge().",
       "type": "object",
       "properties": {
         "count": {
-          "type": "boolean"
+          "type": "integer"
         },
-        "export": {
-          "type": "boolean"
+        "path": {
+          "type": "string"
         },
-        "site_create": {
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "goatcounter.PurgePreview": {
+      "title": "PurgePreview",
+      "description": "PurgePreview is what Hits.Purge() would remove.",
+      "type": "object",
+      "properties": {
+        "all": {
+          "description": "All statistics are removed, including those that aren't stored per path\n(browsers, locations, etc.), as there are no pageviews left after the\npurge. StatRows includes these tables if this is set.",
           "type": "boolean"
         },
-        "site_read": {
-          "type": "boolean"
+        "hits": {
+          "description": "Number of pageviews.",
+          "type": "integer"
         },
-        "site_update": {
-          "type": "boolean"
+        "paths": {
+          "description": "Paths and titles with the number of pageviews, most pageviews first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.PurgePath"
+          }
+        },
+        "stat_rows": {
+          "description": "Number of rows in the statistics tables, by table name.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer"
+          }
         }
       }
     },
-    "goatcounter.Export": {
-      "title": "Export",
+    "goatcounter.ReindexJob": {
+      "title": "ReindexJob",
+      "description": "ReindexJob recreates the statistics from the pageviews for a range of days.\n\nThe days are reindexed a month at a time, and the progress is recorded after\nevery month so that it can be paused and resumed, or resumed after a\nrestart.",
       "type": "object",
       "properties": {
         "created_at": {
@@ -17239,46 +21264,77 @@ depending on whether daylight savings time is in use at the time instant.</p>
           "format": "date-time",
           "readOnly": true
         },
+        "done": {
+          "type": "integer",
+          "readOnly": true
+        },
         "error": {
-          "description": "Any errors that may have occured.",
+          "description": "Error if the state is failed.",
           "type": "string",
           "readOnly": true
         },
-        "finished_at": {
+        "eta": {
           "type": "string",
           "format": "date-time",
           "readOnly": true
         },
-        "hash": {
-          "description": "SHA256 hash.",
+        "first_day": {
+          "description": "First and last day to reindex, in UTC.",
           "type": "string",
+          "format": "date-time",
           "readOnly": true
         },
         "id": {
           "type": "integer",
           "readOnly": true
         },
-        "last_hit_id": {
-          "description": "Last hit ID that was exported; can be used as start_from_hit_id.",
+        "job_id": {
           "type": "integer",
           "readOnly": true
         },
-        "num_rows": {
+        "last_day": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "progress": {
+          "description": "Progress as a percentage, and the estimated time the reindex finishes;\nthese are set by ByID() and List().",
+          "type": "number",
+          "readOnly": true
+        },
+        "since_hit": {
+          "description": "Hit ID this reindex was started from; the first day is the day of the\nfirst pageview with this or a higher ID.",
           "type": "integer",
           "readOnly": true
         },
-        "site_id": {
+        "site": {
           "type": "integer",
           "readOnly": true
         },
-        "size": {
-          "description": "File size in MB.",
+        "started_at": {
           "type": "string",
+          "format": "date-time",
           "readOnly": true
         },
-        "start_from_hit_id": {
-          "description": "The hit ID this export was started from.",
-          "type": "integer"
+        "state": {
+          "description": "running, paused, done, or failed.",
+          "type": "string",
+          "readOnly": true
+        },
+        "tables": {
+          "description": "Comma-separated list of tables to reindex; see ReindexTables.",
+          "type": "string",
+          "readOnly": true
+        },
+        "total": {
+          "description": "Number of days to reindex, the number of days that were reindexed, and\nthe number of days that were done when it was started or resumed.",
+          "type": "integer",
+          "readOnly": true
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         }
       }
     },
@@ -17304,6 +21360,15 @@ depending on whether daylight savings time is in use at the time instant.</p>
           "type": "string",
           "format": "date-time"
         },
+        "first_hit": {
+          "$ref": "#/definitions/goatcounter.SiteFirstHit"
+        },
+        "first_hit_at": {
+          "description": "Hour of the earliest pageview in the statistics, including imported\npageviews; this is null if there are no pageviews.",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
         "id": {
           "type": "integer",
           "readOnly": true
@@ -17335,6 +21400,32 @@ depending on whether daylight savings time is in use at the time instant.</p>
         }
       }
     },
+    "goatcounter.SiteFirstHit": {
+      "title": "SiteFirstHit",
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "host": {
+          "description": "Hostname the pageview was sent from.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path of the pageview.",
+          "type": "string"
+        },
+        "ref": {
+          "description": "Referrer",
+          "type": "string"
+        },
+        "user_agent": {
+          "description": "User-Agent header of the browser.",
+          "type": "string"
+        }
+      }
+    },
     "goatcounter.SiteSettings": {
       "title": "SiteSettings",
       "type": "object",
@@ -17477,6 +21568,10 @@ depending on whether daylight savings time is in use at the time instant.</p>
           "description": "Is this an event?",
           "type": "boolean"
         },
+        "host": {
+          "description": "Host the pageview was recorded on (e.g. example.com); only\nuseful if the site is served from several domains.",
+          "type": "string"
+        },
         "ip": {
           "description": "IP to get location from; not used if location is set. Also used for\nsession generation.",
           "type": "string"
@@ -17496,47 +21591,198 @@ depending on whether daylight savings time is in use at the time instant.</p>
         "ref": {
           "description": "Referrer value, can be an URL (i.e. the Referal: header) or any\nstring.",
           "type": "string"
-        },
-        "session": {
-          "description": "Normally a session is based on hash(User-Agent+IP+salt), but if you don't\nsend the IP address then we can't determine the session.\n\nIn those cases, you can store your own session identifiers and send them\nalong. Note these will not be stored in the database as the sessionID\n(just as the hashes aren't), they're just used as a unique grouping\nidentifier.\n\nYou can also just disable sessions entirely with NoSessions.",
+        },
+        "session": {
+          "description": "Normally a session is based on hash(User-Agent+IP+salt), but if you don't\nsend the IP address then we can't determine the session.\n\nIn those cases, you can store your own session identifiers and send them\nalong. Note these will not be stored in the database as the sessionID\n(just as the hashes aren't), they're just used as a unique grouping\nidentifier.\n\nYou can also just disable sessions entirely with NoSessions.\n\nThe session is used instead of the IP and User-Agent if it's set; the IP\nis still used to get the location.",
+          "type": "string"
+        },
+        "size": {
+          "description": "Screen size as \"x,y,scaling\"",
+          "type": "array",
+          "items": {
+            "type": "number"
+          }
+        },
+        "title": {
+          "description": "Page title, or some descriptive event title.",
+          "type": "string"
+        },
+        "user_agent": {
+          "description": "User-Agent header.",
+          "type": "string"
+        }
+      }
+    },
+    "handlers.apiCronResponse": {
+      "title": "apiCronResponse",
+      "type": "object",
+      "properties": {
+        "tasks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.CronStatus"
+          }
+        }
+      }
+    },
+    "handlers.apiError": {
+      "title": "apiError",
+      "type": "object",
+      "properties": {
+        "Error": {
+          "type": "string"
+        },
+        "Errors": {
+          "type": "object"
+        }
+      }
+    },
+    "handlers.apiExportRequest": {
+      "title": "apiExportRequest",
+      "type": "object",
+      "properties": {
+        "end_date": {
+          "description": "Only export pageviews before this time.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "format": {
+          "description": "File format for exports of the statistics: \"csv\" (the default) or \"json\".",
+          "type": "string"
+        },
+        "kind": {
+          "description": "What to export: \"hits\" (the default) for all pageviews, or \"stats\" for\nthe aggregated statistics.",
+          "type": "string"
+        },
+        "passphrase": {
+          "description": "Encrypt the file with this passphrase in the age format; this is never\nstored. See https://age-encryption.org",
+          "type": "string"
+        },
+        "path_like": {
+          "description": "Only export pageviews with a path that matches this LIKE pattern\n(case-insensitive), or that is in paths; for example \"/blog/%\".",
+          "type": "string"
+        },
+        "paths": {
+          "description": "Only export pageviews with one of these paths, or that match path_like.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "start_date": {
+          "description": "Only export pageviews on or after this time.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "start_from_hit_id": {
+          "description": "Pagination cursor; only export hits with an ID greater than this.",
+          "type": "integer"
+        }
+      }
+    },
+    "handlers.apiExportResumeRequest": {
+      "title": "apiExportResumeRequest",
+      "type": "object",
+      "properties": {
+        "passphrase": {
+          "description": "Passphrase for encrypted exports.",
+          "type": "string"
+        }
+      }
+    },
+    "handlers.apiHitsResponse": {
+      "title": "apiHitsResponse",
+      "type": "object",
+      "properties": {
+        "cursor": {
+          "description": "Cursor to get the next page; this is also set if there are no more\npageviews, so it can be used to get new pageviews later on.",
           "type": "string"
         },
-        "size": {
-          "description": "Screen size as \"x,y,scaling\"",
+        "hits": {
+          "description": "The pageviews, with only the fields that were selected.",
           "type": "array",
           "items": {
-            "type": "number"
+            "type": "object"
           }
         },
-        "title": {
-          "description": "Page title, or some descriptive event title.",
-          "type": "string"
-        },
-        "user_agent": {
-          "description": "User-Agent header.",
-          "type": "string"
+        "more": {
+          "description": "There are more pageviews after this page.",
+          "type": "boolean"
         }
       }
     },
-    "handlers.apiError": {
-      "title": "apiError",
+    "handlers.apiImportsResponse": {
+      "title": "apiImportsResponse",
       "type": "object",
       "properties": {
-        "Error": {
-          "type": "string"
-        },
-        "Errors": {
-          "type": "object"
+        "imports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.ImportJob"
+          }
         }
       }
     },
-    "handlers.apiExportRequest": {
-      "title": "apiExportRequest",
+    "handlers.apiJobsResponse": {
+      "title": "apiJobsResponse",
       "type": "object",
       "properties": {
-        "start_from_hit_id": {
-          "description": "Pagination cursor; only export hits with an ID greater than this.",
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.Job"
+          }
+        }
+      }
+    },
+    "handlers.apiNotificationsResponse": {
+      "title": "apiNotificationsResponse",
+      "type": "object",
+      "properties": {
+        "notifications": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.Notification"
+          }
+        }
+      }
+    },
+    "handlers.apiReindexRequest": {
+      "title": "apiReindexRequest",
+      "type": "object",
+      "properties": {
+        "end_date": {
+          "description": "Reindex only statistics up to and including this day; the default is\nthe current day.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "since_hit_id": {
+          "description": "Reindex only statistics since the day of the pageview with this ID;\nthis can't be used with start_date.",
           "type": "integer"
+        },
+        "start_date": {
+          "description": "Reindex only statistics on or after this day; the default is the day\nof the first pageview.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "tables": {
+          "description": "Tables to reindex: hit_stats, hit_counts, browser_stats, system_stats,\nlocation_stats, ref_counts, size_stats, host_stats, campaign_stats, or\nall (the default).",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "handlers.apiReindexesResponse": {
+      "title": "apiReindexesResponse",
+      "type": "object",
+      "properties": {
+        "reindexes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.ReindexJob"
+          }
         }
       }
     },
@@ -17555,6 +21801,35 @@ depending on whether daylight savings time is in use at the time instant.</p>
         }
       }
     },
+    "handlers.apiSiteVerifyResponse": {
+      "title": "apiSiteVerifyResponse",
+      "type": "object",
+      "properties": {
+        "error_rate": {
+          "description": "Fraction of pageviews that were rejected as invalid, from 0 to 1.",
+          "type": "number"
+        },
+        "first_hit": {
+          "$ref": "#/definitions/goatcounter.SiteFirstHit"
+        },
+        "ingest": {
+          "$ref": "#/definitions/goatcounter.IngestStats"
+        },
+        "last_data": {
+          "description": "Hour of the most recent pageview that was stored; may be null.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "received_data": {
+          "description": "The site has ever received a pageview.",
+          "type": "boolean"
+        },
+        "receiving": {
+          "description": "Pageviews are arriving: a pageview was received in the last hour.",
+          "type": "boolean"
+        }
+      }
+    },
     "handlers.apiSitesResponse": {
       "title": "apiSitesResponse",
       "type": "object",
@@ -17580,6 +21855,20 @@ depending on whether daylight savings time is in use at the time instant.</p>
       "title": "meResponse",
       "type": "object",
       "properties": {
+        "audit": {
+          "description": "The 20 most recent audit log entries, newest first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.AuditLog"
+          }
+        },
+        "sites": {
+          "description": "The site this user belongs to, and all its subsites.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/goatcounter.Site"
+          }
+        },
         "token": {
           "$ref": "#/definitions/goatcounter.APIToken"
         },
@@ -17638,25 +21927,193 @@ depending on whether daylight savings time is in use at the time instant.</p>
 	closing <code>&lt;/body&gt;</code> tag (but anywhere, such as in the
 	<code>&lt;head&gt;</code> will work):</p>
 	{{template "_backend_sitecode.gohtml" .}}
+
+	<h2>Debugging</h2>
+	<p>If pageviews aren’t showing up as expected you can <a href="/ingest-log">record
+		how the next pageviews are processed</a>, which shows why a pageview was
+		ignored, marked as a bot, or not counted as unique.</p>
+	<p>You can also see <a href="/hosts">which hosts are sending pageviews</a>,
+		to check that the site code isn’t used on a site you don’t know.</p>
+	<p>The <a href="/live">live view</a> shows pageviews as they arrive, before
+		they’re processed.</p>
 </article>
 
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/backend_hosts.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h1>Hosts sending pageviews</h1>
+<p>The hosts that sent pageviews in the last {{.Days}} days. A host that you
+	don’t recognize usually means someone copied the site code to their own
+	site; you can block these hosts so they’re no longer counted.</p>
+<p>If there are any allowed hosts, then only pageviews from
+	{{if .Site.LinkDomain}}<code>{{.Site.LinkDomain}}</code> and {{end}}the
+	allowed hosts are counted. Both lists can also be edited in the
+	<a href="/settings">settings</a>.</p>
+{{if not .Site.LinkDomain}}
+	<p><strong>Note:</strong> the site domain isn’t set in the
+	<a href="/settings">settings</a>, so it can’t be known which host is
+	expected.</p>
+{{end}}
+
+{{if .Report}}
+<table>
+<thead><tr>
+	<th>Host</th>
+	<th>Pageviews</th>
+	<th>Visitors</th>
+	<th>Status</th>
+	<th></th>
+</tr></thead>
+<tbody>
+	{{range $e := .Report}}
+	<tr>
+		<td>{{$e.Host}}</td>
+		<td>{{nformat $e.Count $.Site}}</td>
+		<td>{{nformat $e.CountUnique $.Site}}</td>
+		<td>{{if eq $e.Status "unexpected"}}<strong>{{$e.Status}}</strong>{{else}}{{$e.Status}}{{end}}</td>
+		<td>{{if ne $e.Status "expected"}}
+			<form method="post" action="/hosts">
+				<input type="hidden" name="csrf" value="{{$.User.CSRFToken}}">
+				<input type="hidden" name="host" value="{{$e.Host}}">
+				{{if eq $e.Status "unexpected"}}
+					<button type="submit" name="status" value="allowed">Allow</button>
+					<button type="submit" name="status" value="blocked">Block</button>
+				{{else}}
+					<button type="submit" name="status" value="">Remove from {{$e.Status}} hosts</button>
+				{{end}}
+			</form>
+		{{end}}</td>
+	</tr>
+	{{end}}
+</tbody>
+</table>
+{{else}}
+	<p><em>No pageviews in the last {{.Days}} days.</em></p>
+{{end}}
+
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/backend_import_replace.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h2>Replace pageviews with an import</h2>
+{{if .Token}}
+	<p>Your confirmation code is:</p>
+	<p><code>{{.Token}}</code></p>
+	<p>Enter this code in the import form to replace or clear existing
+		pageviews before the import. The code can only be used once, and is
+		valid for {{.Expiry}} minutes.</p>
+	<p><a href="/settings#tab-export">Back to the import</a></p>
+{{else}}
+	<p>Importing with “Clear all existing pageviews” will <strong>permanently
+		remove all pageviews and statistics</strong> for {{.Site.Display}} before
+		importing the file, and “Replace existing pageviews on the days in the
+		file” will permanently remove them on those days.</p>
+	<p>You need a confirmation code to do this, which is valid for {{.Expiry}} minutes.</p>
+
+	<form method="post">
+		<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+		<button>Get confirmation code</button>
+		<strong>Removing pageviews cannot be undone!</strong>
+	</form>
+{{end}}
+
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/backend_ingest_log.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h1>Pageview processing log</h1>
+<p>Record how the next pageviews for this site are processed: why a pageview
+	was ignored, marked as a bot, or not counted as unique, and how the path was
+	cleaned. Pageviews are processed every 10 seconds, so it may take a moment
+	for them to show up here.</p>
+<p>The last {{.Max}} entries are kept in memory, and are lost when
+	GoatCounter is restarted.</p>
+
+<form method="post" action="/ingest-log">
+	<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+	{{if .Remaining}}
+		<p>Recording the next {{.Remaining}} pageviews.</p>
+		<input type="hidden" name="n" value="0">
+		<button type="submit">Stop recording</button>
+	{{else}}
+		<label for="n">Number of pageviews to record</label>
+		<input type="number" name="n" id="n" min="1" max="{{.Max}}" value="10">
+		<button type="submit">Start recording</button>
+	{{end}}
+</form>
+
+{{if .Entries}}
+<table>
+<thead><tr>
+	<th>Time</th>
+	<th>Path</th>
+	<th>Result</th>
+	<th>Notes</th>
+</tr></thead>
+<tbody>
+	{{range $e := .Entries}}
+	<tr>
+		<td>{{$e.Time.Format "2006-01-02 15:04:05"}}</td>
+		<td>{{$e.Path}}{{if ne $e.Path $e.Stored}}<br><small>Stored as {{$e.Stored}}</small>{{end}}</td>
+		<td>{{$e.Result}}</td>
+		<td>{{range $n := $e.Notes}}{{$n}}<br>{{end}}</td>
+	</tr>
+	{{end}}
+</tbody>
+</table>
+{{end}}
+
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/backend_live.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h1>Live view</h1>
+<p>Pageviews for this site as they arrive, before they’re processed; this
+	includes pageviews that will be ignored or marked as a bot later on. The IP
+	address is never shown. Only the last {{.Max}} pageviews are shown, and
+	nothing is stored.</p>
+
+<form method="get" action="/live">
+	<label for="filter">Only paths containing</label>
+	<input type="text" name="filter" id="filter" value="{{.Filter}}">
+	<button type="submit">Filter</button>
+</form>
+
+<table id="live-hits" data-max="{{.Max}}">
+<thead><tr>
+	<th>Time</th>
+	<th>Path</th>
+	<th>Referrer</th>
+	<th>Location</th>
+	<th>Browser</th>
+</tr></thead>
+<tbody>
+	<tr class="live-waiting"><td colspan="5"><em>Waiting for pageviews…</em></td></tr>
+</tbody>
+</table>
+
 {{template "_backend_bottom.gohtml" .}}
 `),
 	"tpl/backend_purge.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
 
-{{if eq (len .List) 0}}
+{{if eq (len .Preview.Paths) 0}}
 	<p>Nothing matches <code>{{.PurgePath}}</code>.</p>
 {{else}}
 	<p>The following paths match <code>{{.PurgePath}}</code>:</p>
 	<table>
 		<thead><tr><th style="width: 10em"># of hits</th><th style="text-align: left">Path</th><th>Title</th></tr></thead></thead>
 		<tbody>
-			{{range $s := .List}}
+			{{range $s := .Preview.Paths}}
 				<tr><td>{{nformat $s.Count $.Site}}</td><td>{{$s.Path}}</td><td>{{$s.Title}}</td></tr>
 			{{end}}
 		</tbody>
 	</table>
 
+	<p>This removes {{nformat .Preview.Hits $.Site}} pageviews.
+		{{if .Preview.All}}<strong>There will be no pageviews left, so all other
+		statistics (browsers, locations, etc.) are removed as well.</strong>{{end}}</p>
+
 	<form method="post">
 		<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
 		<button>Yes, purge them all!</button>
@@ -17821,18 +22278,206 @@ depending on whether daylight savings time is in use at the time instant.</p>
 				<label for="data_retention">Data retention in days</label>
 				<input type="number" name="settings.data_retention" id="limits_page" value="{{.Site.Settings.DataRetention}}">
 				{{validate "site.settings.data_retention" .Validate}}
-				<span class="help">Pageviews and all associated data will be permanently removed after this many days. Set to <code>0</code> to never delete.</span>
+				<span class="help">Pageviews and all associated data will be permanently removed after this many days, except the statistics if “Keep statistics after the retention” is enabled. Set to <code>0</code> to never delete.</span>
+
+				<label for="event_retention">Event retention in days</label>
+				<input type="number" name="settings.event_retention" id="event_retention" value="{{.Site.Settings.EventRetention}}">
+				{{validate "site.settings.event_retention" .Validate}}
+				<span class="help">Keep events for a different number of days
+					than pageviews. Set to <code>0</code> to use the data
+					retention, or <code>-1</code> to never delete events. The
+					browser, system, and location statistics are always removed
+					after the data retention, unless the statistics are kept
+					after the retention.</span>
+
+				<label for="path_retention">Retention by path</label>
+				<input type="text" name="settings.path_retention" id="path_retention" value="{{.Site.Settings.PathRetention}}">
+				{{validate "site.settings.path_retention" .Validate}}
+				<span class="help">Use a different retention for paths starting
+					with a prefix, as <code>prefix=days</code>; for example
+					<code>/blog/=-1, /tmp/=30</code>. The longest matching
+					prefix is used, and <code>-1</code> never deletes.
+					Comma-separated.</span>
+
+				<label>{{checkbox .Site.Settings.RetentionKeepStats "settings.retention_keep_stats"}}
+					Keep statistics after the retention</label>
+				<span>Only remove the individual pageviews, and keep the
+					totals on the dashboard forever. The statistics from
+					before the retention can't be recreated by a reindex.</span>
+
+				<label for="anonymize_after">Anonymize pageviews after days</label>
+				<input type="number" name="settings.anonymize_after" id="anonymize_after" value="{{.Site.Settings.AnonymizeAfter}}">
+				{{validate "site.settings.anonymize_after" .Validate}}
+				<span class="help">Remove the session, browser, and location
+					from pageviews after this many days, but keep the pageviews
+					so all totals stay the same. The browser, system, and
+					location statistics from before this can't be recreated by
+					a reindex. Set to <code>0</code> to never anonymize.</span>
+
+				<label for="no_data_alert">Alert when no data is received</label>
+				<input type="number" name="settings.no_data_alert" id="no_data_alert" value="{{.Site.Settings.NoDataAlert}}">
+				{{validate "site.settings.no_data_alert" .Validate}}
+				<span class="help">Send an email if no pageviews were received
+					for this many hours, which may mean the script was removed
+					or broke. Set to <code>0</code> to never send an email. You can
+					also <a href="/watches">watch pages</a> to get a notification
+					when they receive pageviews.</span>
+
+				<label for="export_schedule">Automatic export</label>
+				<select name="settings.export_schedule" id="export_schedule">
+					<option {{option_value .Site.Settings.ExportSchedule ""}}>Never</option>
+					<option {{option_value .Site.Settings.ExportSchedule "daily"}}>Daily</option>
+					<option {{option_value .Site.Settings.ExportSchedule "weekly"}}>Weekly</option>
+					<option {{option_value .Site.Settings.ExportSchedule "monthly"}}>Monthly</option>
+				</select>
+				{{validate "site.settings.export_schedule" .Validate}}
+				<span class="help">Automatically export all pageviews and email a
+					download link, as an off-site backup. The link is valid for 24
+					hours.</span>
 
 				<label>Ignore IPs</label>
 				<input type="text" name="settings.ignore_ips" value="{{.Site.Settings.IgnoreIPs}}">
 				{{validate "site.settings.ignore_ips" .Validate}}
-				<span>Never count requests coming from these IP addresses.
-					Comma-separated. Only supports exact matches.
+				<span>Never count requests coming from these IP addresses or
+					CIDR ranges (e.g. <code>192.168.0.0/16</code>).
+					Comma-separated.
 					<a href="#_" id="add-ip">Add your current IP</a>.
 					{{if .Site.LinkDomain}}<br>
 					Alternatively, <a href="http://{{.Site.LinkDomain}}#toggle-goatcounter">disable for this browser</a> (click again to enable).{{end}}
 				</span>
 
+				<label for="allowed_hosts">Allowed hosts</label>
+				<input type="text" name="settings.allowed_hosts" id="allowed_hosts" value="{{.Site.Settings.AllowedHosts}}">
+				{{validate "site.settings.allowed_hosts" .Validate}}
+				<span>Only count pageviews from the site domain and these hosts;
+					use <code>*.example.com</code> to allow all subdomains.
+					Leave empty to count pageviews from any host.
+					Comma-separated. See <a href="/hosts">which hosts are
+					sending pageviews</a>.</span>
+
+				<label for="blocked_hosts">Blocked hosts</label>
+				<input type="text" name="settings.blocked_hosts" id="blocked_hosts" value="{{.Site.Settings.BlockedHosts}}">
+				{{validate "site.settings.blocked_hosts" .Validate}}
+				<span>Never count pageviews from these hosts, for example if
+					someone copied the site code to their own site.
+					Comma-separated.</span>
+
+				<label for="bot_rules_user_agents">Bot User-Agents</label>
+				<input type="text" name="settings.bot_rules.user_agents" id="bot_rules_user_agents" value="{{.Site.Settings.BotRules.UserAgents}}">
+				{{validate "site.settings.bot_rules.user_agents" .Validate}}
+				<span>Mark pageviews as a bot if the User-Agent contains any
+					of these texts, for example for uptime monitors or internal
+					crawlers. Comma-separated, case-insensitive.</span>
+
+				<label for="bot_rules_ips">Bot IPs</label>
+				<input type="text" name="settings.bot_rules.ips" id="bot_rules_ips" value="{{.Site.Settings.BotRules.IPs}}">
+				{{validate "site.settings.bot_rules.ips" .Validate}}
+				<span>Mark pageviews as a bot if they come from these IP
+					addresses or CIDR ranges (e.g. <code>10.0.0.0/8</code>).
+					Comma-separated.</span>
+
+				<label for="bot_rules_paths">Bot paths</label>
+				<input type="text" name="settings.bot_rules.paths" id="bot_rules_paths" value="{{.Site.Settings.BotRules.Paths}}">
+				{{validate "site.settings.bot_rules.paths" .Validate}}
+				<span>Mark pageviews as a bot if the path matches any of these
+					patterns; <code>*</code> matches any text except
+					<code>/</code>, e.g. <code>/health</code> or
+					<code>/internal/*</code>. Comma-separated.</span>
+
+				<label for="bot_threshold">Bot threshold</label>
+				<input type="number" min="0" max="100" name="settings.bot_threshold" id="bot_threshold" value="{{.Site.Settings.BotThreshold}}">
+				{{validate "site.settings.bot_threshold" .Validate}}
+				<span>Every pageview gets a bot score from 0 to 100 based on
+					the User-Agent, the bot rules above, the number of pageviews
+					per minute, and whether it comes from a datacenter. Pageviews
+					with a score at or above this are counted as a bot. Set to
+					<code>0</code> to use the default of 100. Changing this
+					applies to all existing pageviews.
+					{{if .Bots.Total}}
+						<br>In the last 30 days {{nformat (.Bots.AtThreshold .Site.Settings.BotThresholdScore) $.Site}}
+						of {{nformat .Bots.Total $.Site}} pageviews had a score at
+						or above the threshold{{if .Bots.Reasons}}; reasons:
+						{{range $r, $n := .Bots.Reasons}}<code>{{$r}}</code> ({{nformat $n $.Site}}) {{end}}{{end}}
+					{{end}}</span>
+
+				<label for="location_detail">Location detail</label>
+				<select name="settings.location_detail" id="location_detail">
+					<option {{option_value .Site.Settings.LocationDetail "country"}}>Country</option>
+					<option {{option_value .Site.Settings.LocationDetail "region"}}>Country and region</option>
+					<option {{option_value .Site.Settings.LocationDetail "city"}}>Country, region, and city</option>
+				</select>
+				{{validate "site.settings.location_detail" .Validate}}
+				<span>Level of detail to store visitor locations at. The region
+					and city can reveal a lot more about visitors than just the
+					country, and require a GeoIP database with city information
+					(see the <code>-geodb</code> flag).</span>
+
+				<label for="session_idle">Session inactivity window</label>
+				<input type="number" min="0" name="settings.session.idle" id="session_idle" value="{{.Site.Settings.Session.Idle}}">
+				{{validate "site.settings.session.idle" .Validate}}
+				<span>Start a new visit after a visitor has been inactive for
+					this many minutes. Set to <code>0</code> to use the default
+					of the server; this can’t be longer than the default.</span>
+
+				<label for="session_max">Maximum session length</label>
+				<input type="number" min="0" name="settings.session.max" id="session_max" value="{{.Site.Settings.Session.Max}}">
+				{{validate "site.settings.session.max" .Validate}}
+				<span>Start a new visit after this many minutes, even if the
+					visitor was active. Set to <code>0</code> to use the default
+					of the server.</span>
+
+				<label for="sampling">Sampling</label>
+				<input type="number" min="0" max="1000" name="settings.sampling" id="sampling" value="{{.Site.Settings.Sampling}}">
+				{{validate "site.settings.sampling" .Validate}}
+				<span>Only record 1 in this many visits, to reduce the amount of
+					data for high-traffic sites. Every recorded visit is
+					counted this many times, so the numbers are estimates.
+					Changing this doesn’t affect visits that were already
+					recorded. Set to <code>0</code> to record everything.</span>
+
+				<label for="ipv6_prefix">IPv6 prefix length</label>
+				<input type="number" min="16" max="128" name="settings.ipv6_prefix" id="ipv6_prefix" value="{{.Site.Settings.IPv6PrefixLen}}">
+				{{validate "site.settings.ipv6_prefix" .Validate}}
+				<span>Only use the first this many bits of IPv6 addresses to
+					identify visits and to match addresses in the ignore list,
+					as the rest of the address is often changed by the
+					visitor’s device. The default is 64; set to <code>128</code>
+					to use the full address.</span>
+
+				<label>{{checkbox .Site.Settings.Dedup "settings.dedup"}}
+					Ignore duplicate pageviews</label>
+				<span>Ignore pageviews to the same page in the same visit
+					within a second of each other; some browsers send the same
+					pageview twice.</span>
+
+				<label>{{checkbox .Site.Settings.TotalsExcludeEvents "settings.totals_exclude_events"}}
+					Don’t count events in the totals</label>
+				<span>Only count pageviews in the totals and the totals graph
+					on the dashboard; events are still listed with the pages.
+					This can be changed for a single view by adding
+					<code>?events=on</code> or <code>?events=off</code> to the
+					dashboard URL.</span>
+
+				<label>{{checkbox .Site.Settings.ClampFirstHit "settings.clamp_first_hit"}}
+					Don’t show days before the first pageview</label>
+				<span>Start the charts on the dashboard on the day of the first
+					pageview, instead of showing the days before it as days
+					without pageviews.</span>
+
+				<label>{{checkbox .Site.Settings.Noscript "settings.noscript"}}
+					Get path from Referer</label>
+				<span>Get the path from the <code>Referer</code> header if
+					it’s not sent, so you can use the same image for people
+					without JavaScript on every page; see the
+					<a href="/code#image-based-tracking-without-javascript">site code</a>.</span>
+
+				<label>{{checkbox .Site.Settings.CaseSensitivePaths "settings.case_sensitive_paths"}}
+					Case-sensitive paths</label>
+				<span>Match paths case-sensitive when filtering, for sites where
+					e.g. <code>/About</code> and <code>/about</code> are
+					different pages. Titles are always matched
+					case-insensitive.</span>
+
 				<label>Campaign parameters</label>
 				<input type="text" name="settings.campaigns" value="{{.Site.Settings.Campaigns}}">
 				{{validate "site.settings.campaigns" .Validate}}
@@ -17842,6 +22487,17 @@ depending on whether daylight savings time is in use at the time instant.</p>
 					header.{{/* <a href="/code#campaigns">Details</a>.
 					Comma-separated; first match takes precedence.*/}}
 				</span>
+
+				<label for="ref_categories">Referrer categories</label>
+				<input type="text" name="settings.ref_categories" id="ref_categories" value="{{.Site.Settings.RefCategories}}">
+				{{validate "site.settings.ref_categories" .Validate}}
+				<span>Add or change the category of referrers, as
+					<code>host=category</code>; for example
+					<code>search.example.com=search, *.example.net=social</code>.
+					The categories are <code>search</code>, <code>social</code>,
+					<code>email</code>, <code>generated</code>, and
+					<code>other</code>. Comma-separated. Only applies to new
+					pageviews unless the referrers are reindexed.</span>
 			</fieldset>
 
 			<div class="flex-break"></div>
@@ -17923,6 +22579,18 @@ depending on whether daylight savings time is in use at the time instant.</p>
 		<span>You will see a preview of matches before anything is deleted</span><br>
 		<label><input type="checkbox" name="match-title"> Match title as well</label>
 	</form>
+
+	{{if .LastOperation}}
+		<h3>Undo</h3>
+		<p>The last purge of <code>{{.LastOperation.Path}}</code> on
+			{{.LastOperation.CreatedAt.Format "2006-01-02 15:04"}} removed
+			{{nformat .LastOperation.Hits $.Site}} pageviews. Purges can be undone
+			for {{.OperationDays}} days.</p>
+		<form method="post" action="/purge/undo">
+			<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+			<button type="submit">Undo last purge</button>
+		</form>
+	{{end}}
 </div>
 
 <div class="tab-page">
@@ -17945,7 +22613,77 @@ depending on whether daylight savings time is in use at the time instant.</p>
 				<input type="number" id="startFrom" name="startFrom">
 				<span>There will be a ‘pagination cursor’ in the email, if you fill this
 					in here it will export only pageviews that were recorded
-					after the previous export.</span><br><br>
+					after the previous export.</span>
+
+				<label for="startDate">Date range</label>
+				<input type="date" id="startDate" name="startDate"> –
+				<input type="date" id="endDate" name="endDate">
+				<span>Export only pageviews between these dates (inclusive, in
+					your timezone); leave empty to export everything.</span><br><br>
+
+				<label for="pathLike">Paths</label>
+				<input type="text" id="pathLike" name="pathLike" placeholder="/blog/%">
+				<textarea id="paths" name="paths" rows="2" placeholder="/about"></textarea>
+				<span>Export only pageviews for paths matching the pattern, or
+					one of the paths in the text box (one per line); this uses
+					the same format as purging paths. Leave empty to export all
+					paths.</span><br><br>
+
+				<label for="passphrase">Passphrase</label>
+				<input type="password" id="passphrase" name="passphrase" autocomplete="new-password" minlength="8">
+				<span>Encrypt the export with this passphrase; it’s never
+					stored, so make sure you remember it. Decrypt with
+					<a href="https://age-encryption.org">age</a>:
+					<code>age -d export.age &gt; export</code>. Leave empty
+					to not encrypt.</span><br><br>
+
+				<button type="submit">Start export</button>
+
+				{{if .Exports}}
+				<table class="exports">
+					<thead><tr><th>Started</th><th>Export</th><th>Rows</th><th>Size</th><th></th></tr></thead>
+					<tbody>{{range $e := .Exports}}<tr>
+						<td>{{$e.CreatedAt.Format "2006-01-02 15:04"}}</td>
+						<td>{{if eq $e.Kind "stats"}}Statistics ({{$e.Format}}){{else}}Pageviews{{end}}
+							{{with $e.DateRange $.Site.Settings.Timezone.Loc}}<br><small>{{.}}</small>{{end}}
+							{{with $e.PathFilter}}<br><small>{{.}}</small>{{end}}
+							{{if $e.Encrypted}}<br><small>Encrypted</small>{{end}}</td>
+						<td>{{if $e.NumRows}}{{nformat (deref_i $e.NumRows) $.Site}}{{end}}</td>
+						<td>{{if $e.Size}}{{deref_s $e.Size}}M{{end}}</td>
+						<td>{{if $e.Error}}Error: {{deref_s $e.Error}}
+							{{else if $e.Expired}}Expired
+							{{else if $e.FinishedAt}}<a href="/export/{{$e.ID}}">Download</a>
+								· <a href="/export/{{$e.ID}}/manifest" title="Size, SHA256 hash, and number of rows; verify the download with “goatcounter verify”">Manifest</a>
+							{{else}}<span class="export-progress" data-id="{{$e.ID}}">Running… {{$e.Progress}}%</span>{{end}}</td>
+					</tr>{{end}}</tbody>
+				</table>
+				{{end}}
+			</fieldset>
+		</form>
+
+		<form method="post" action="/export/stats" class="vertical">
+			<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+
+			<fieldset>
+				<legend>Export statistics</legend>
+				<p>Export the aggregated statistics shown on the dashboard,
+				rather than the individual pageviews. This includes the
+				statistics for pageviews that were removed by the data
+				retention only if “Keep statistics after the retention” is
+				enabled; otherwise the statistics are removed with the
+				pageviews.</p>
+
+				<label><input type="radio" name="format" value="csv" checked> CSV files in a zip archive</label>
+				<label><input type="radio" name="format" value="json"> JSON, compressed with gzip</label>
+				<br>
+
+				<label for="passphraseStats">Passphrase</label>
+				<input type="password" id="passphraseStats" name="passphrase" autocomplete="new-password" minlength="8">
+				<span>Encrypt the export with this passphrase; it’s never
+					stored, so make sure you remember it. Decrypt with
+					<a href="https://age-encryption.org">age</a>:
+					<code>age -d export.age &gt; export</code>. Leave empty
+					to not encrypt.</span><br><br>
 
 				<button type="submit">Start export</button>
 			</fieldset>
@@ -17958,9 +22696,44 @@ depending on whether daylight savings time is in use at the time instant.</p>
 				<legend>Import</legend>
 
 				<label for="file">CSV file; may be compressed with gzip</label>
-				<input type="file" name="csv" required accept=".csv,.csv.gz">
-
-				<label><input type="checkbox" name="replace"> Clear all existing pageviews.</label>
+				<input type="file" name="csv" accept=".csv,.csv.gz,.json,.json.gz">
+				<span>Exports from Plausible (the <code>imported_pages</code>
+					CSV file), Fathom (pages CSV), and Matomo (JSON from the
+					<code>Live.getLastVisitsDetails</code> API) are also
+					accepted. Plausible and Fathom only export totals per day,
+					so the pageviews are imported at 12:00 UTC without
+					referrer, browser, or location.</span>
+
+				<label for="url">Or download from URL</label>
+				<input type="url" name="url" id="url" placeholder="https://stats.example.com/export.csv.gz">
+				<span>The file is downloaded in the background, which is
+					easier than downloading and uploading large exports when
+					moving from another instance. Must be an https URL that
+					can be downloaded without logging in, such as a file on
+					object storage.</span>
+
+				<label><input type="radio" name="mode" value="" checked> Add to the existing pageviews.</label>
+				<label><input type="radio" name="mode" value="add-new"> Add to the existing pageviews, skipping rows that were already imported before.</label>
+				<label><input type="radio" name="mode" value="replace-range"> Replace existing pageviews on the days in the file.</label>
+				<label><input type="radio" name="mode" value="replace"> Clear all existing pageviews.</label>
+				<label for="replace_token">Confirmation code for replacing pageviews</label>
+				<input type="text" name="replace_token" id="replace_token" autocomplete="off">
+				<span>Required to replace or clear existing pageviews;
+					<a href="/import/replace">get a confirmation code</a>.</span>
+				<label for="transform">Transform</label>
+				<textarea name="transform" id="transform" rows="4" placeholder="strip-domain&#10;drop /wp-admin/%&#10;prefix / /old-blog/"></textarea>
+				<span>Change rows before they’re imported, for example when
+					combining several sites in one; one command per line:<br>
+					<code>strip-domain</code> – remove the domain from paths
+					(<code>example.com/page</code> becomes <code>/page</code>);<br>
+					<code>drop pattern</code> – don’t import paths matching the
+					pattern, where <code>%</code> is any text;<br>
+					<code>prefix /from/ /to/</code> – replace the start of a path;<br>
+					<code>title text</code> – use this title for all pages.</span>
+				<label><input type="checkbox" name="dry_run"> Only check the file</label>
+				<span>Read and validate the entire file without importing
+					or removing anything; the number of rows, errors, date
+					range, and new paths are sent as a notification.</span>
 				<br>
 
 				<button type="submit">Start import</button>
@@ -17973,7 +22746,7 @@ depending on whether daylight savings time is in use at the time instant.</p>
 	<h3>CSV format</h3>
 	<p>The first line is a header with the field names. The fields, in order, are:</p>
 	<table class="table-left">
-		<tr><th>1,Path</th><td>Path name (e.g. <code>/a.html</code>).
+		<tr><th>3,Path</th><td>Path name (e.g. <code>/a.html</code>).
 			This also doubles as the event name. This header is prefixed
 			with the version export format (see versioning below).</td></tr>
 		<tr><th>Title</th><td>Page title that was sent.</td></tr>
@@ -17996,6 +22769,8 @@ depending on whether daylight savings time is in use at the time instant.</p>
 		<tr><th>Screen size</th><td>Screen size as <code>x,y,scaling</code>.</td></tr>
 		<tr><th>Location</th><td>ISO 3166-1 country code.</td></tr>
 		<tr><th>Date</th><td>Creation date as RFC 3339/ISO 8601.</td></tr>
+		<tr><th>ID</th><td>Numeric ID of the pageview; this is unique for
+			the GoatCounter installation the data was exported from.</td></tr>
 	</table>
 
 	<h3>Versioning</h3>
@@ -18005,6 +22780,35 @@ depending on whether daylight savings time is in use at the time instant.</p>
 	<p>It’s <strong>strongly recommended</strong> to check this number if you're
 	using a script to import/sync data and error out if it changes. Any future
 	incompatibilities will be documented here.</p>
+
+	<ul>
+		<li>Version 2: added the <code>ID</code> column. Exports in version 1
+			can still be imported.</li>
+		<li>Version 3: columns are read by the name in the header rather than
+			the position, and unknown columns are ignored. The columns are the
+			same as version 2.</li>
+	</ul>
+
+	<h3>Statistics format</h3>
+	<p>The export of the statistics has a CSV file for every table, or a JSON
+	document with a list of rows for every table. The version is prefixed to
+	the header of every CSV file, and is in the <code>version</code> key of the
+	JSON document. The tables are:</p>
+	<table class="table-left">
+		<tr><th>hit_counts</th><td>Pageviews per path per hour.</td></tr>
+		<tr><th>ref_counts</th><td>Referrers per path per hour.</td></tr>
+		<tr><th>hit_stats</th><td>Pageviews per path per day, as a list
+			of 24 hourly counts.</td></tr>
+		<tr><th>browser_stats, system_stats, location_stats, size_stats,
+			host_stats, campaign_stats</th><td>Counts for browsers, systems,
+			locations, screen sizes, hostnames, and campaigns per day.</td></tr>
+		<tr><th>scroll_stats</th><td>Scroll depth per path per day.</td></tr>
+		<tr><th>sessions_stats</th><td>Every visit: the day it started, the
+			number of pageviews, the duration in seconds, and the first and
+			last path.</td></tr>
+	</table>
+	<p>Dates are in UTC; days as <code>2006-01-02</code> and hours as RFC
+	3339/ISO 8601. Statistics can’t be imported.</p>
 </div>
 
 <div class="tab-page">
@@ -18180,6 +22984,57 @@ depending on whether daylight savings time is in use at the time instant.</p>
 	</div>
 {{end}}
 
+{{template "_backend_bottom.gohtml" .}}
+`),
+	"tpl/backend_watches.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
+
+<h1>Watched pages</h1>
+<p>Get a notification on the dashboard when a watched page receives its first
+	pageview, and once a day when the number of visitors for the page reaches
+	the threshold; this is useful for launches or new documentation pages.</p>
+
+<form method="post" action="/watches" class="vertical">
+	<input type="hidden" name="csrf" value="{{.User.CSRFToken}}">
+
+	<label for="path">Path</label>
+	<input type="text" name="path" id="path" value="{{.Path}}" placeholder="/blog/launch" required>
+
+	<label for="threshold">Visitors per day</label>
+	<input type="number" name="threshold" id="threshold" value="0" min="0">
+	<span class="help">Set to <code>0</code> to only get a notification for the
+		first pageview.</span>
+
+	<button type="submit">Watch</button>
+</form>
+
+{{if .Watches}}
+<table>
+<thead><tr>
+	<th>Path</th>
+	<th>Visitors per day</th>
+	<th>First pageview</th>
+	<th></th>
+</tr></thead>
+<tbody>
+	{{range $w := .Watches}}
+	<tr>
+		<td><a href="/?filter={{$w.Path}}">{{$w.Path}}</a></td>
+		<td>{{if $w.Threshold}}{{nformat $w.Threshold $.Site}}{{else}}–{{end}}</td>
+		<td>{{if $w.SeenAt}}{{$w.SeenAt.Format "2006-01-02 15:04"}}{{else}}<em>not yet</em>{{end}}</td>
+		<td>
+			<form method="post" action="/watches/{{$w.ID}}/remove">
+				<input type="hidden" name="csrf" value="{{$.User.CSRFToken}}">
+				<button type="submit">Remove</button>
+			</form>
+		</td>
+	</tr>
+	{{end}}
+</tbody>
+</table>
+{{else}}
+	<p><em>No watched pages yet.</em></p>
+{{end}}
+
 {{template "_backend_bottom.gohtml" .}}
 `),
 	"tpl/billing.gohtml": []byte(`{{template "_backend_top.gohtml" .}}
@@ -18339,6 +23194,26 @@ processed by Stripe (you will need a Credit Card).</p>
 	"tpl/dashboard.gohtml": []byte(`{{- template "_backend_top.gohtml" . -}}
 
 {{if .User.ID}}
+	{{range $n := .Notifications}}
+		<div class="flash flash-i notification">
+			{{$n.Message}}
+			{{if $n.URL}}<a href="{{$n.URL}}">Details</a>{{end}}
+			<form method="post" action="/notifications/{{$n.ID}}/dismiss">
+				<input type="hidden" name="csrf" value="{{$.User.CSRFToken}}">
+				<button class="link">Dismiss</button>
+			</form>
+		</div>
+	{{end}}
+
+	{{range $c := .TZChanges}}
+		<div class="flash flash-i">
+			The timezone was changed from {{$c.From}} to {{$c.To}} on
+			{{tformat $.Site $c.ChangedAt ""}}. All statistics are stored in UTC
+			and shown in the current timezone, so pageviews from before the change
+			are also shown in {{$.Site.Settings.Timezone.Loc}}.
+		</div>
+	{{end}}
+
 	{{if not .User.EmailVerified}}
 		<div class="flash flash-i">
 			Please verify your email by clicking the link sent to {{.User.Email}}.
@@ -18367,10 +23242,27 @@ processed by Stripe (you will need a Credit Card).</p>
 	{{end}}
 {{end}} {{/* .User.ID */}}
 
-<form id="dash-form">
+{{if .Site.Settings.Estimated}}
+	<div class="flash flash-i">
+		Only 1 in {{.Site.Settings.SamplingFactor}} visits are recorded; the
+		numbers since sampling was enabled are estimated from this sample.
+	</div>
+{{end}}
+
+{{if not .FirstDay.IsZero}}
+	<div class="flash flash-i">
+		The first pageview was on {{(.FirstDay.In .Timezone.Loc).Format .Site.Settings.DateFormat}};
+		the days before it aren’t shown.
+	</div>
+{{end}}
+
+<form id="dash-form" data-as-of="{{.AsOf.Format "2006-01-02T15:04:05Z07:00"}}">
 	{{/* The first button gets used on the enter key, AFAICT there is no way to change that. */}}
 	<button type="submit" tabindex="-1" class="hide-btn" aria-label="Submit"></button>
 	{{if .ShowRefs}}<input type="hidden" name="showrefs" value="{{.ShowRefs}}">{{end}}
+	{{if .Host}}<input type="hidden" name="host" id="host" value="{{.Host}}">{{end}}
+	{{if .Events}}<input type="hidden" name="events" id="events" value="{{.Events}}">{{end}}
+	{{if .TZ}}<input type="hidden" name="tz" id="tz" value="{{.TZ}}" data-offset="{{.Timezone.Offset}}">{{end}}
 	<input type="hidden" id="hl-period" name="hl-period" disabled>
 
 	{{/*
@@ -18382,8 +23274,8 @@ processed by Stripe (you will need a Credit Card).</p>
 	<div id="dash-main">
 		<div>
 			<span>
-				<input type="text" class="date-input" autocomplete="off" title="Start of date range to display" id="period-start" name="period-start" value="{{tformat .Site .PeriodStart ""}}">–{{- "" -}}
-				<input type="text" class="date-input" autocomplete="off" title="End of date range to display"   id="period-end"   name="period-end" value="{{tformat .Site .PeriodEnd ""}}">{{- "" -}}
+				<input type="text" class="date-input" autocomplete="off" title="Start of date range to display" id="period-start" name="period-start" value="{{(.PeriodStart.In .Timezone.Loc).Format "2006-01-02"}}">–{{- "" -}}
+				<input type="text" class="date-input" autocomplete="off" title="End of date range to display"   id="period-end"   name="period-end" value="{{(.PeriodEnd.In .Timezone.Loc).Format "2006-01-02"}}">{{- "" -}}
 			</span>
 			<span id="dash-select-period" class="period-{{.SelectedPeriod}}">
 				<span>
@@ -18408,7 +23300,7 @@ processed by Stripe (you will need a Credit Card).</p>
 			<div class="filter-wrap">
 				<input
 					type="text" autocomplete="off" name="filter" value="{{.Filter}}" id="filter-paths"
-					placeholder="Filter paths" title="Filter the list of paths; matched case-insensitive on path and title"
+					placeholder="Filter paths" title="Filter the list of paths; matched case-insensitive on path and title. Start with exact: or prefix: to match only the path exactly or from the start"
 					{{if .Filter}}class="value"{{end}}>
 			</div>
 			<label><input type="checkbox" name="as-text" id="as-text" {{if .AsText}}checked{{end}}> View as text table</label>
@@ -18427,7 +23319,7 @@ processed by Stripe (you will need a Credit Card).</p>
 				<button class="link" name="move" value="month-b">month</button>
 			</span>
 		</div>
-		<div id="dash-timerange">{{daterange .Site.Settings.Timezone.Loc .PeriodStart .PeriodEnd}}</div>
+		<div id="dash-timerange">{{daterange .Timezone.Loc .PeriodStart .PeriodEnd}}</div>
 		<div>
 			<span>
 				<button class="link" name="move" value="week-f">week</button> ·
@@ -18552,19 +23444,42 @@ wasn't something I was able to do.</p>
 </ul>
 
 {{template "_bottom.gohtml" .}}
+`),
+	"tpl/email_acme_failing.gotxt": []byte(`Hi there,
+
+The TLS certificate for your custom domain {{.Renewal.Cname}} couldn't be
+renewed; it was tried {{.Renewal.Failures}} times and the certificate expires on
+{{.Renewal.ExpiresAt.Format "Jan 2, 2006"}}.
+
+The last error was: {{.Renewal.LastError}}
+
+This is usually because the domain no longer points to GoatCounter; you can
+check the DNS settings for {{.Renewal.Cname}}, or change the custom domain in
+your site settings: {{.Site.URL}}/settings
+
+GoatCounter will keep retrying the renewal.
+
+{{template "_email_bottom.gotxt" .}}
 `),
 	"tpl/email_export_done.gotxt": []byte(`Hi there,
 
-The GoatCounter export you’ve requested is finished, go here to download it:
+{{if .Export.Scheduled}}Your {{.Site.Settings.ExportSchedule}} GoatCounter export is finished, go here to download it:{{else}}The GoatCounter export you’ve requested is finished, go here to download it:{{end}}
 {{.Site.URL}}/export/{{.Export.ID}}
 
 {{nformat .Export.NumRows .Site}} rows have been exported with a file size of {{.Export.Size}}M.
-
+{{with .Export.DateRange .Site.Settings.Timezone.Loc}}
+Only pageviews from {{.}} were exported.
+{{end}}{{if .Export.Encrypted}}
+The export is encrypted with the passphrase you entered; you can decrypt it
+with age (https://age-encryption.org): age -d {{.Export.Path}} > export
+{{end}}{{with .Export.PathFilter}}
+Only pageviews for these paths were exported: {{.}}
+{{end}}{{if .Export.LastHitID}}
 The pagination cursor is {{.Export.LastHitID}}; you can use this to export pageviews that were recorded after this export.
-
+{{end}}
 The file integrity hash is {{.Export.Hash}}
 
-The export will be removed after 24 hours.
+The export will be removed after {{if eq .Export.RetentionDays 1}}24 hours{{else}}{{.Export.RetentionDays}} days{{end}}.
 
 {{template "_email_bottom.gotxt" .}}
 `),
@@ -18578,16 +23493,43 @@ You requested a list of GoatCounter sites associated with ‘{{.Email}}’:
 There are no GoatCounter domains associated with this email.
 {{end}}
 
+{{template "_email_bottom.gotxt" .}}
+`),
+	"tpl/email_import_combined.gotxt": []byte(`Hi there,
+
+Your imports are finished:
+{{range $i := .Imports}}
+- Import {{$i.ID}}: {{if eq $i.State "done"}}{{$i.RowsDone}} pageviews were imported with {{$i.Errors}} errors.{{else if eq $i.State "cancelled"}}cancelled; {{$i.RowsDone}} pageviews were imported before it was cancelled.{{else}}failed: {{$i.Error}}{{end}}{{if gt $i.Skipped 0}}
+  {{$i.Skipped}} rows were skipped as they were already imported before.{{end}}{{if gt $i.Dropped 0}}
+  {{$i.Dropped}} rows were dropped by the transform.{{end}}
+{{end}}{{if .Reindexed}}
+The statistics for the imported days were recreated.
+{{end}}
 {{template "_email_bottom.gotxt" .}}
 `),
 	"tpl/email_import_done.gotxt": []byte(`Hi there,
 
 Your import is finished; {{.Rows}} pageviews were imported successfully with {{.Errors.Len}} errors.
-{{if gt .Errors.Len 50}}
+{{if gt .Skipped 0}}{{.Skipped}} rows were skipped as they were already imported before.
+{{end}}{{if gt .Dropped 0}}{{.Dropped}} rows were dropped by the transform.
+{{end}}{{if .Reindexed}}The statistics for the imported days were recreated.
+{{end}}{{if gt .Errors.Len 50}}
 First 50 errors:
 {{.Errors}}{{else if gt .Errors.Len 0}}
 List of Errors:
 {{.Errors}}{{end}}
+{{if gt .Report.Browsers.Total 0}}
+{{.Report.Browsers.Total}} pageviews have a User-Agent from which no browser
+could be detected; these were imported, but will show up as "(unknown)" in the
+browser stats. Most common:
+{{range $v := .Report.Browsers.Top 10}}
+    {{$v.Count}}× {{$v.Value}}{{end}}
+{{end}}{{if gt .Report.Locations.Total 0}}
+{{.Report.Locations.Total}} pageviews have an invalid location code; these were
+imported as an unknown location. Most common:
+{{range $v := .Report.Locations.Top 10}}
+    {{$v.Count}}× {{$v.Value}}{{end}}
+{{end}}
 
 {{template "_email_bottom.gotxt" .}}
 `),
@@ -18597,6 +23539,37 @@ There was an error importing your data :-(
 
 The reported error: {{.Error}}
 
+{{template "_email_bottom.gotxt" .}}
+`),
+	"tpl/email_no_data.gotxt": []byte(`Hi there,
+
+Your GoatCounter site {{.Site.Display}} hasn't received any pageviews since {{.Last.Format "Jan 2 15:04"}} UTC, which is more than {{.Site.Settings.NoDataAlert}} hours ago.
+
+This may be expected if your site doesn't get many visitors, but it may also mean that the GoatCounter script was removed or isn't loading. Some things to check:
+
+- Is the script still on the page? You can find the code to add at:
+  {{.Site.URL}}/code
+- Are there any errors in the browser's console?
+- Was the Content-Security-Policy changed?
+
+You won't get this email again until pageviews are received again. You can change or disable this in the settings:
+{{.Site.URL}}/settings#section-tracking
+
+{{template "_email_bottom.gotxt" .}}
+`),
+	"tpl/email_no_data_new.gotxt": []byte(`Hi there,
+
+You signed up for GoatCounter a few days ago, but your site {{.Site.Display}} hasn't received any pageviews yet.
+
+Getting started is pretty easy, just add the JavaScript from the following page anywhere on your site:
+{{.Site.URL}}/code
+
+If you already added this, then some things to check:
+
+- Are there any errors in the browser's console?
+- Is the script blocked by a Content-Security-Policy?
+- Pageviews from localhost aren't counted; is the script on your live site?
+
 {{template "_email_bottom.gotxt" .}}
 `),
 	"tpl/email_password_reset.gotxt": []byte(`Hi there,
@@ -0,0 +1,219 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zvalidate"
+)
+
+// PathWatch is a path for which a notification is sent when it receives its
+// first pageview, or when the number of visitors on a day reaches Threshold.
+type PathWatch struct {
+	ID   int64  `db:"watch_id" json:"id,readonly"`
+	Site int64  `db:"site" json:"site,readonly"`
+	Path string `db:"path" json:"path"`
+
+	// Send a notification once a day when the number of visitors for the path
+	// reaches this, in the site's timezone. 0 to only send a notification for
+	// the first pageview.
+	Threshold int `db:"threshold" json:"threshold"`
+
+	// First pageview for this path; set when the watch is created if there
+	// were already pageviews.
+	SeenAt *time.Time `db:"seen_at" json:"seen_at,readonly"`
+
+	// Day (as year-month-day) a notification was last sent for the Threshold.
+	AlertedDay *string `db:"alerted_day" json:"alerted_day,readonly"`
+
+	CreatedAt time.Time `db:"created_at" json:"created_at,readonly"`
+}
+
+// Validate the object.
+func (w *PathWatch) Validate() error {
+	v := zvalidate.New()
+	v.Required("path", w.Path)
+	v.Len("path", w.Path, 1, 2048)
+	v.Range("threshold", int64(w.Threshold), 0, 1000000000)
+	return v.ErrorOrNil()
+}
+
+// Insert a new watch for the site in the context.
+func (w *PathWatch) Insert(ctx context.Context) error {
+	w.Site = MustGetSite(ctx).ID
+	w.Path = strings.TrimSpace(w.Path)
+	w.CreatedAt = Now()
+	w.SeenAt, w.AlertedDay = nil, nil
+
+	err := w.Validate()
+	if err != nil {
+		return err
+	}
+
+	var n int
+	err = zdb.MustGet(ctx).GetContext(ctx, &n, `/* PathWatch.Insert */
+		select count(*) from path_watches where site=$1 and path=$2`,
+		w.Site, w.Path)
+	if err != nil {
+		return errors.Wrap(err, "PathWatch.Insert")
+	}
+	if n > 0 {
+		v := zvalidate.New()
+		v.Append("path", "is already watched")
+		return v
+	}
+
+	// Don't notify about the first pageview if there already are pageviews.
+	w.SeenAt, err = w.firstHour(ctx)
+	if err != nil {
+		return errors.Wrap(err, "PathWatch.Insert")
+	}
+
+	var seen *string
+	if w.SeenAt != nil {
+		s := w.SeenAt.Format(zdb.Date)
+		seen = &s
+	}
+	w.ID, err = insertWithID(ctx, "watch_id",
+		`insert into path_watches (site, path, threshold, seen_at, created_at) values ($1, $2, $3, $4, $5)`,
+		w.Site, w.Path, w.Threshold, seen, w.CreatedAt.Format(zdb.Date))
+	return errors.Wrap(err, "PathWatch.Insert")
+}
+
+// firstHour gets the hour of the first pageview for the path, or nil if there
+// are no pageviews.
+func (w PathWatch) firstHour(ctx context.Context) (*time.Time, error) {
+	var first time.Time
+	err := zdb.MustGet(ctx).GetContext(ctx, &first, `/* PathWatch.firstHour */
+		select hour from hit_counts where site=$1 and path=$2 order by hour asc limit 1`,
+		w.Site, w.Path)
+	if err != nil {
+		if zdb.ErrNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &first, nil
+}
+
+// ByID gets a watch by ID, for the site in the context.
+func (w *PathWatch) ByID(ctx context.Context, id int64) error {
+	return errors.Wrap(zdb.MustGet(ctx).GetContext(ctx, w,
+		`/* PathWatch.ByID */ select * from path_watches where watch_id=$1 and site=$2`,
+		id, MustGetSite(ctx).ID), "PathWatch.ByID")
+}
+
+// Delete this watch.
+func (w PathWatch) Delete(ctx context.Context) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`delete from path_watches where watch_id=$1 and site=$2`,
+		w.ID, MustGetSite(ctx).ID)
+	return errors.Wrap(err, "PathWatch.Delete")
+}
+
+// check sends a notification if this is the first pageview, or if the number
+// of visitors today reached the Threshold.
+func (w *PathWatch) check(ctx context.Context) error {
+	var (
+		db   = zdb.MustGet(ctx)
+		site = MustGetSite(ctx)
+		link = "/?filter=" + url.QueryEscape(w.Path)
+	)
+
+	if w.SeenAt == nil {
+		first, err := w.firstHour(ctx)
+		if err != nil {
+			return err
+		}
+		if first != nil {
+			now := Now()
+			_, err := db.ExecContext(ctx, `update path_watches set seen_at=$1 where watch_id=$2`,
+				now.Format(zdb.Date), w.ID)
+			if err != nil {
+				return err
+			}
+			w.SeenAt = &now
+			Notify(ctx, NotifyAlert, fmt.Sprintf("%s received its first pageview.", w.Path), link)
+		}
+	}
+
+	if w.Threshold == 0 || w.SeenAt == nil {
+		return nil
+	}
+
+	var (
+		now   = Now().In(site.Settings.Timezone.Loc())
+		today = now.Format("2006-01-02")
+		start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	)
+	if w.AlertedDay != nil && *w.AlertedDay == today {
+		return nil
+	}
+
+	var visitors int
+	err := db.GetContext(ctx, &visitors, `/* PathWatch.check */
+		select coalesce(sum(total_unique), 0) from hit_counts
+		where site=$1 and path=$2 and hour >= $3`,
+		w.Site, w.Path, start.UTC().Format(zdb.Date))
+	if err != nil {
+		return err
+	}
+	if visitors < w.Threshold {
+		return nil
+	}
+
+	_, err = db.ExecContext(ctx, `update path_watches set alerted_day=$1 where watch_id=$2`,
+		today, w.ID)
+	if err != nil {
+		return err
+	}
+	w.AlertedDay = &today
+	Notify(ctx, NotifyAlert, fmt.Sprintf("%s had %d visitors today, reaching the threshold of %d.",
+		w.Path, visitors, w.Threshold), link)
+	return nil
+}
+
+// PathWatches is a list of watches.
+type PathWatches []PathWatch
+
+// List all watches for the site in the context, ordered by path.
+func (w *PathWatches) List(ctx context.Context) error {
+	return errors.Wrap(zdb.MustGet(ctx).SelectContext(ctx, w,
+		`/* PathWatches.List */ select * from path_watches where site=$1 order by path`,
+		MustGetSite(ctx).ID), "PathWatches.List")
+}
+
+// Check all watches for the site in the context, and send notifications for
+// those that are triggered.
+func (w *PathWatches) Check(ctx context.Context) error {
+	err := w.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "PathWatches.Check")
+	}
+
+	ww := *w
+	for i := range ww {
+		err := ww[i].check(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "PathWatches.Check %d", ww[i].ID)
+		}
+	}
+	return nil
+}
+
+// PathWatchSites gets the IDs of all sites with at least one watch.
+func PathWatchSites(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	err := zdb.MustGet(ctx).SelectContext(ctx, &ids,
+		`/* PathWatchSites */ select distinct site from path_watches`)
+	return ids, errors.Wrap(err, "PathWatchSites")
+}
@@ -0,0 +1,106 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"strings"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestPathWatch(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := goatcounter.Now()
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: now, FirstVisit: zdb.Bool(true)})
+
+	for _, w := range []goatcounter.PathWatch{
+		{Path: "/a", Threshold: 2},
+		{Path: " /b "},
+	} {
+		err := w.Insert(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	{
+		w := goatcounter.PathWatch{Path: "/a"}
+		err := w.Insert(ctx)
+		if err == nil || !strings.Contains(err.Error(), "already watched") {
+			t.Errorf("wrong error: %v", err)
+		}
+	}
+
+	check := func(t *testing.T, want ...string) {
+		t.Helper()
+
+		var watches goatcounter.PathWatches
+		err := watches.Check(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var n goatcounter.Notifications
+		err = n.List(ctx, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, nn := range n {
+			got = append(got, nn.Kind+": "+nn.Message)
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("\ngot:  %q\nwant: %q", got, want)
+		}
+
+		err = n.DismissAll(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// /a already had pageviews before it was watched, and is below the
+	// threshold.
+	check(t)
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: now, FirstVisit: zdb.Bool(true)},
+		goatcounter.Hit{Path: "/b", CreatedAt: now, FirstVisit: zdb.Bool(true)})
+	check(t,
+		"alert: /b received its first pageview.",
+		"alert: /a had 2 visitors today, reaching the threshold of 2.")
+
+	// Only sent once.
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: now, FirstVisit: zdb.Bool(true)},
+		goatcounter.Hit{Path: "/b", CreatedAt: now, FirstVisit: zdb.Bool(true)})
+	check(t)
+
+	var watches goatcounter.PathWatches
+	err := watches.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watches) != 2 || watches[1].Path != "/b" || watches[1].SeenAt == nil {
+		t.Fatalf("wrong watches: %#v", watches)
+	}
+	err = watches[1].Delete(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = watches.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watches) != 1 {
+		t.Errorf("len(watches) = %d", len(watches))
+	}
+}
@@ -13,6 +13,7 @@
 	var get_data = function(vars) {
 		var data = {
 			p: (vars.path     === undefined ? goatcounter.path     : vars.path),
+			h: location.host,
 			r: (vars.referrer === undefined ? goatcounter.referrer : vars.referrer),
 			t: (vars.title    === undefined ? goatcounter.title    : vars.title),
 			e: !!(vars.event || goatcounter.event),
@@ -174,6 +175,54 @@
 		})
 	}
 
+	// Track how far the page is scrolled down, and send it once when the page
+	// is hidden (i.e. the visitor navigates away or closes the tab).
+	window.goatcounter.bind_scroll = function() {
+		if (!navigator.sendBeacon || goatcounter.filter())
+			return
+
+		var max = 0, sent = false
+		var update = function() {
+			var d = document.documentElement,
+			    h = Math.max(d.scrollHeight, document.body.scrollHeight) - window.innerHeight
+			var p = h <= 0 ? 100 : Math.round((window.pageYOffset || d.scrollTop) / h * 100)
+			if (p > max)
+				max = Math.min(p, 100)
+		}
+		update()
+
+		window.addEventListener('scroll', update, {passive: true})
+		document.addEventListener('visibilitychange', function() {
+			if (sent || document.visibilityState !== 'hidden')
+				return
+			var data = get_data({}),
+			    endpoint = get_endpoint()
+			if (data.p === null || !endpoint)
+				return
+			sent = true
+			navigator.sendBeacon(endpoint + urlencode({p: data.p, sd: max}))
+		}, false)
+	}
+
+	// Add a hidden link to the endpoint; visitors never see or follow it, but
+	// bots that follow all links do, which marks the session as a bot.
+	window.goatcounter.bind_honeypot = function() {
+		if (goatcounter.filter())
+			return
+		var data = get_data({}),
+		    endpoint = get_endpoint()
+		if (data.p === null || !endpoint)
+			return
+
+		var a = document.createElement('a')
+		a.href = endpoint + urlencode({p: data.p, hp: 1})
+		a.rel = 'nofollow'
+		a.tabIndex = -1
+		a.setAttribute('aria-hidden', 'true')
+		a.style.display = 'none'
+		document.body.appendChild(a)
+	}
+
 	// Make it easy to skip your own views.
 	if (location.hash === '#toggle-goatcounter')
 		if (localStorage.getItem('skipgc') === 't') {
@@ -190,6 +239,10 @@
 			goatcounter.count()
 			if (!goatcounter.no_events)
 				goatcounter.bind_events()
+			if (goatcounter.scroll_depth)
+				goatcounter.bind_scroll()
+			if (goatcounter.honeypot)
+				goatcounter.bind_honeypot()
 		}
 
 		if (document.body === null)
@@ -13,13 +13,13 @@
 	$(document).ready(function() {
 		SETTINGS     = JSON.parse($('#js-settings').text())
 		CSRF         = $('#js-settings').attr('data-csrf')
-		TZ_OFFSET    = parseInt($('#js-settings').attr('data-offset'), 10) || 0
+		TZ_OFFSET    = parseInt($('#tz').attr('data-offset') || $('#js-settings').attr('data-offset'), 10) || 0
 		SITE_CREATED = $('#js-settings').attr('data-created') * 1000
 
 		;[report_errors, period_select, load_refs, tooltip, paginate_pages,
 			hchart_detail, settings_tabs, billing_subscribe, setup_datepicker,
 			filter_pages, add_ip, fill_tz, draw_chart, bind_scale, pgstat,
-			copy_pre, ref_pages,
+			copy_pre, ref_pages, export_progress, live_view,
 		].forEach(function(f) { f.call() })
 	});
 
@@ -76,6 +76,49 @@
 		})
 	}
 
+	// Update the progress of running exports.
+	var export_progress = function() {
+		$('.export-progress').each(function(_, elem) {
+			var e    = $(elem),
+				poll = function() {
+					jQuery.ajax({
+						url:     '/export/' + e.attr('data-id') + '/progress',
+						success: function(data) {
+							if (data.finished || data.error)
+								return location.reload()
+							e.text('Running… ' + data.progress + '%')
+							setTimeout(poll, 5000)
+						},
+					})
+				}
+			setTimeout(poll, 5000)
+		})
+	}
+
+	// Show pageviews as they arrive on the live view page.
+	var live_view = function() {
+		var tbl = $('#live-hits')
+		if (!tbl.length || !window.EventSource)
+			return
+
+		var max    = parseInt(tbl.attr('data-max'), 10),
+			tbody  = tbl.find('tbody'),
+			stream = new EventSource('/live/stream?filter=' + encodeURIComponent($('#filter').val()))
+		stream.onmessage = function(e) {
+			var hit = JSON.parse(e.data),
+				t   = new Date(hit.time)
+			tbody.find('.live-waiting').remove()
+			tbody.prepend($('<tr>').append(
+				$('<td>').text(t.toLocaleTimeString()),
+				$('<td>').text(hit.path + (hit.event ? ' (event)' : '') + (hit.bot ? ' (bot)' : '')).attr('title', hit.title),
+				$('<td>').text(hit.ref),
+				$('<td>').text(hit.location),
+				$('<td>').text(hit.browser),
+			))
+			tbody.find('tr').slice(max).remove()
+		}
+	}
+
 	// Add copy button to <pre>.
 	var copy_pre = function() {
 		$('.site-code pre').each((_, elem) => {
@@ -279,6 +322,9 @@
 
 		highlight_filter($('#filter-paths').val())
 		$('.pages-list >.load-more').css('display', data.more ? 'inline-block' : 'none')
+		$('.pages-list >.other-pages').css('display', data.more ? 'block' : 'none')
+		$('.pages-list .other-paths').text(format_int(data.other.paths))
+		$('.pages-list .other-count').text(format_int(data.other.count))
 
 		var th = $('.total-hits'),
 		    td = $('.total-display'),
@@ -812,11 +858,22 @@
 		return $('.total-unique').text().replace(/[^0-9]/g, '')
 	}
 
-	// Append period-start and period-end values to the data object.
+	// Append period-start, period-end, as_of, host, events, and tz values to the
+	// data object.
+	//
+	// as_of is the time the dashboard was loaded, so paginating won't include
+	// pageviews that were persisted afterwards.
 	var append_period = function(data) {
 		data = data || {}
 		data['period-start'] = $('#period-start').val()
 		data['period-end']   = $('#period-end').val()
+		data['as_of']        = $('#dash-form').attr('data-as-of')
+		if ($('#host').length)
+			data['host'] = $('#host').val()
+		if ($('#events').length)
+			data['events'] = $('#events').val()
+		if ($('#tz').length)
+			data['tz'] = $('#tz').val()
 		return data
 	}
 
@@ -7,10 +7,12 @@ package goatcounter
 import (
 	"context"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
 	"zgo.at/zdb"
 )
 
@@ -175,7 +177,9 @@ func cleanRefURL(ref string, refURL *url.URL) (string, bool) {
 }
 
 // ListRefsByPath lists all references for a path.
-func (h *Stats) ListRefsByPath(ctx context.Context, path string, start, end time.Time, offset int) error {
+//
+// If host is set then only the pageviews on that host are counted.
+func (h *Stats) ListRefsByPath(ctx context.Context, path string, start, end time.Time, host string, offset int) error {
 	site := MustGetSite(ctx)
 
 	limit := site.Settings.Limits.Ref
@@ -183,6 +187,13 @@ func (h *Stats) ListRefsByPath(ctx context.Context, path string, start, end time
 		limit = 10
 	}
 
+	args := []interface{}{site.ID, path, start.Format(zdb.Date), clampAsOf(ctx, start, end).Format(zdb.Date), limit + 1, offset}
+	hostWhere := ""
+	if host != "" {
+		hostWhere = ` and host=$7 `
+		args = append(args, strings.ToLower(host))
+	}
+
 	err := zdb.MustGet(ctx).SelectContext(ctx, &h.Stats, `/* Stats.ListRefsByPath */
 		select
 			coalesce(sum(total), 0) as count,
@@ -192,19 +203,19 @@ func (h *Stats) ListRefsByPath(ctx context.Context, path string, start, end time
 		from ref_counts
 		where
 			site=$1 and
-			lower(path)=lower($2) and
+			`+eqPath(site, 2)+` and
 			hour>=$3 and
-			hour<=$4
+			hour<=$4 `+hostWhere+`
 		group by ref
 		order by count_unique desc, ref desc
-		limit $5 offset $6`,
-		site.ID, path, start.Format(zdb.Date), end.Format(zdb.Date), limit+1, offset)
+		limit $5 offset $6`, args...)
 
 	if len(h.Stats) > limit {
 		h.More = true
 		h.Stats = h.Stats[:len(h.Stats)-1]
 	}
 
+	h.setEstimated(ctx)
 	return errors.Wrap(err, "Stats.ListRefsByPath")
 }
 
@@ -221,22 +232,29 @@ func (h *Stats) ListTopRefs(ctx context.Context, start, end time.Time, offset in
 		limit = 6
 	}
 
-	where := ` where site=? and hour>=? and hour<=?`
-	args := []interface{}{site.ID, start.Format(zdb.Date), end.Format(zdb.Date)}
+	var (
+		where     string
+		whereArgs []interface{}
+	)
 	if site.LinkDomain != "" {
 		where += " and ref not like ? "
-		args = append(args, site.LinkDomain+"%")
+		whereArgs = append(whereArgs, site.LinkDomain+"%")
+	}
+
+	parts, err := getRollupParts(ctx, "ref_counts", start, end, true)
+	if err != nil {
+		return errors.Wrap(err, "Stats.ListAllRefs")
 	}
+	from, args := parts.query("ref_counts", "ref, ref_scheme, total, total_unique", site.ID, where, whereArgs)
 
 	db := zdb.MustGet(ctx)
-	err := db.SelectContext(ctx, &h.Stats, db.Rebind(`/* Stats.ListTopRefs */
+	err = db.SelectContext(ctx, &h.Stats, db.Rebind(`/* Stats.ListTopRefs */
 		select
 			coalesce(sum(total), 0) as count,
 			coalesce(sum(total_unique), 0) as count_unique,
 			max(ref_scheme) as ref_scheme,
 			ref as name
-		from ref_counts`+
-		where+`
+		from (`+from+`) refs
 		group by ref
 		order by count_unique desc
 		limit ? offset ?`), append(args, limit+1, offset)...)
@@ -249,5 +267,120 @@ func (h *Stats) ListTopRefs(ctx context.Context, start, end time.Time, offset in
 		h.Stats = h.Stats[:len(h.Stats)-1]
 	}
 
+	h.setEstimated(ctx)
+	return nil
+}
+
+// BySourceCategory lists the number of visitors for every source category,
+// excluding referrals from the configured LinkDomain; see
+// SiteSettings.RefCategory().
+func (h *Stats) BySourceCategory(ctx context.Context, start, end time.Time) error {
+	site := MustGetSite(ctx)
+
+	var (
+		where     string
+		whereArgs []interface{}
+	)
+	if site.LinkDomain != "" {
+		where += " and ref not like ? "
+		whereArgs = append(whereArgs, site.LinkDomain+"%")
+	}
+
+	parts, err := getRollupParts(ctx, "ref_counts", start, end, true)
+	if err != nil {
+		return errors.Wrap(err, "Stats.BySourceCategory")
+	}
+	from, args := parts.query("ref_counts", "ref_category, total, total_unique", site.ID, where, whereArgs)
+
+	db := zdb.MustGet(ctx)
+	err = db.SelectContext(ctx, &h.Stats, db.Rebind(`/* Stats.BySourceCategory */
+		select
+			coalesce(sum(total), 0) as count,
+			coalesce(sum(total_unique), 0) as count_unique,
+			coalesce(ref_category, 'other') as name
+		from (`+from+`) refs
+		group by coalesce(ref_category, 'other')
+		order by count_unique desc, name asc`), args...)
+	if err != nil {
+		return errors.Wrap(err, "Stats.BySourceCategory")
+	}
+
+	h.setEstimated(ctx)
+	return nil
+}
+
+// ListSourceCategory lists the domains of the referrers in the source
+// category; referrers that aren't a http or https URL, such as "Google" or
+// campaigns, are listed as-is.
+//
+// If there are more than limit domains then More is set; the limit is capped to
+// cfg.MaxStats.
+func (h *Stats) ListSourceCategory(ctx context.Context, category string, start, end time.Time, limit int) error {
+	if limit <= 0 || limit > cfg.MaxStats {
+		limit = cfg.MaxStats
+	}
+	site := MustGetSite(ctx)
+
+	where, whereArgs := " and coalesce(ref_category, 'other') = ? ", []interface{}{category}
+	if site.LinkDomain != "" {
+		where += " and ref not like ? "
+		whereArgs = append(whereArgs, site.LinkDomain+"%")
+	}
+
+	parts, err := getRollupParts(ctx, "ref_counts", start, end, true)
+	if err != nil {
+		return errors.Wrap(err, "Stats.ListSourceCategory")
+	}
+	from, args := parts.query("ref_counts", "ref, ref_scheme, total, total_unique", site.ID, where, whereArgs)
+
+	var refs []StatT
+	db := zdb.MustGet(ctx)
+	err = db.SelectContext(ctx, &refs, db.Rebind(`/* Stats.ListSourceCategory */
+		select
+			coalesce(sum(total), 0) as count,
+			coalesce(sum(total_unique), 0) as count_unique,
+			max(ref_scheme) as ref_scheme,
+			ref as name
+		from (`+from+`) refs
+		group by ref`), args...)
+	if err != nil {
+		return errors.Wrap(err, "Stats.ListSourceCategory")
+	}
+
+	// Group by domain; the paths can't be grouped in SQL as that would need
+	// to parse the URL.
+	var (
+		domains = make(map[string]int, len(refs))
+		stats   = make([]StatT, 0, len(refs))
+	)
+	for _, r := range refs {
+		name := r.Name
+		if r.RefScheme != nil && *r.RefScheme == *RefSchemeHTTP {
+			name = refHost(r.Name)
+		}
+
+		i, ok := domains[name]
+		if !ok {
+			i = len(stats)
+			domains[name] = i
+			stats = append(stats, StatT{Name: name, RefScheme: r.RefScheme})
+		}
+		stats[i].Count += r.Count
+		stats[i].CountUnique += r.CountUnique
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].CountUnique == stats[j].CountUnique {
+			return stats[i].Name < stats[j].Name
+		}
+		return stats[i].CountUnique > stats[j].CountUnique
+	})
+
+	h.Stats = stats
+	if len(h.Stats) > limit {
+		h.More = true
+		h.Stats = h.Stats[:limit]
+	}
+
+	h.setEstimated(ctx)
 	return nil
 }
@@ -0,0 +1,174 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Source categories for the ref_category column.
+const (
+	RefCategoryDirect    = "direct"    // No referrer.
+	RefCategorySearch    = "search"    // Search engines.
+	RefCategorySocial    = "social"    // Social media, forums, and chat.
+	RefCategoryEmail     = "email"     // Webmail and email apps.
+	RefCategoryGenerated = "generated" // Other referrers grouped by GoatCounter, such as RSS readers.
+	RefCategoryCampaign  = "campaign"  // From a campaign parameter.
+	RefCategoryOther     = "other"     // Everything else.
+)
+
+// RefCategoryList are all the source categories, in the order they're
+// displayed.
+var RefCategoryList = []string{RefCategorySearch, RefCategorySocial,
+	RefCategoryEmail, RefCategoryCampaign, RefCategoryGenerated,
+	RefCategoryOther, RefCategoryDirect}
+
+// refCategories classifies referrers by the cleaned referrer, which is either
+// the name of a group from cleanRefURL() or starts with the host. A host
+// starting with "*." matches all subdomains.
+var refCategories = map[string]string{
+	"Google":             RefCategorySearch,
+	"*.bing.com":         RefCategorySearch,
+	"duckduckgo.com":     RefCategorySearch,
+	"*.duckduckgo.com":   RefCategorySearch,
+	"search.yahoo.com":   RefCategorySearch,
+	"*.search.yahoo.com": RefCategorySearch,
+	"yandex.ru":          RefCategorySearch,
+	"yandex.com":         RefCategorySearch,
+	"www.baidu.com":      RefCategorySearch,
+	"www.ecosia.org":     RefCategorySearch,
+	"search.brave.com":   RefCategorySearch,
+	"www.startpage.com":  RefCategorySearch,
+	"www.qwant.com":      RefCategorySearch,
+	"search.seznam.cz":   RefCategorySearch,
+	"www.so.com":         RefCategorySearch,
+	"search.naver.com":   RefCategorySearch,
+	"scholar.google.com": RefCategorySearch,
+	"news.google.com":    RefCategorySearch,
+	"kagi.com":           RefCategorySearch,
+
+	"Hacker News":         RefCategorySocial,
+	"lobste.rs":           RefCategorySocial,
+	"www.reddit.com":      RefCategorySocial,
+	"www.facebook.com":    RefCategorySocial,
+	"twitter.com":         RefCategorySocial,
+	"mobile.twitter.com":  RefCategorySocial,
+	"t.co":                RefCategorySocial,
+	"www.linkedin.com":    RefCategorySocial,
+	"lnkd.in":             RefCategorySocial,
+	"www.instagram.com":   RefCategorySocial,
+	"www.pinterest.com":   RefCategorySocial,
+	"www.youtube.com":     RefCategorySocial,
+	"mastodon.social":     RefCategorySocial,
+	"vk.com":              RefCategorySocial,
+	"weibo.com":           RefCategorySocial,
+	"t.me":                RefCategorySocial,
+	"Telegram Messenger":  RefCategorySocial,
+	"Slack Chat":          RefCategorySocial,
+	"discord.com":         RefCategorySocial,
+	"www.tumblr.com":      RefCategorySocial,
+	"*.stackexchange.com": RefCategorySocial,
+	"stackoverflow.com":   RefCategorySocial,
+	"dev.to":              RefCategorySocial,
+	"habr.com":            RefCategorySocial,
+	"www.producthunt.com": RefCategorySocial,
+	"tildes.net":          RefCategorySocial,
+
+	"Email":                 RefCategoryEmail,
+	"outlook.live.com":      RefCategoryEmail,
+	"outlook.office.com":    RefCategoryEmail,
+	"outlook.office365.com": RefCategoryEmail,
+	"mail.protonmail.com":   RefCategoryEmail,
+	"mail.proton.me":        RefCategoryEmail,
+	"e.mail.ru":             RefCategoryEmail,
+	"mail.yandex.ru":        RefCategoryEmail,
+	"app.fastmail.com":      RefCategoryEmail,
+	"mailchi.mp":            RefCategoryEmail,
+}
+
+// RefCategory gets the source category for a referrer; ref and scheme are the
+// cleaned Ref and RefScheme of a Hit.
+//
+// The site's RefCategories are used before the built-in list.
+func (ss SiteSettings) RefCategory(ref string, scheme *string) string {
+	if ref == "" {
+		return RefCategoryDirect
+	}
+	if scheme != nil && *scheme == *RefSchemeCampaign {
+		return RefCategoryCampaign
+	}
+
+	if len(ss.RefCategories) > 0 {
+		over, _ := ss.refCategories()
+		if c, ok := lookupRefCategory(over, ref); ok {
+			return c
+		}
+	}
+	if c, ok := lookupRefCategory(refCategories, ref); ok {
+		return c
+	}
+
+	if scheme != nil && *scheme == *RefSchemeGenerated {
+		return RefCategoryGenerated
+	}
+	return RefCategoryOther
+}
+
+// refCategories parses the RefCategories setting.
+func (ss SiteSettings) refCategories() (map[string]string, error) {
+	m := make(map[string]string, len(ss.RefCategories))
+	for _, r := range ss.RefCategories {
+		i := strings.LastIndexByte(r, '=')
+		if i == -1 {
+			return nil, fmt.Errorf("%q: must be as host=category", r)
+		}
+		host, cat := strings.TrimSpace(r[:i]), strings.ToLower(strings.TrimSpace(r[i+1:]))
+		if host == "" {
+			return nil, fmt.Errorf("%q: host is empty", r)
+		}
+		switch cat {
+		case RefCategorySearch, RefCategorySocial, RefCategoryEmail, RefCategoryGenerated, RefCategoryOther:
+		default:
+			return nil, fmt.Errorf("%q: category must be one of search, social, email, generated, other", r)
+		}
+		m[host] = cat
+	}
+	return m, nil
+}
+
+// lookupRefCategory finds the category for ref in m, by the entire referrer
+// first (for the group names), and then by the host and all its parent
+// domains.
+func lookupRefCategory(m map[string]string, ref string) (string, bool) {
+	if c, ok := m[ref]; ok {
+		return c, true
+	}
+
+	host := refHost(ref)
+	if c, ok := m[host]; ok {
+		return c, true
+	}
+	for i := strings.IndexByte(host, '.'); i > -1; i = strings.IndexByte(host, '.') {
+		host = host[i+1:]
+		if c, ok := m["*."+host]; ok {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// refHost gets the lower-cased host of the cleaned referrer, without the port.
+func refHost(ref string) string {
+	host := strings.ToLower(ref)
+	if i := strings.IndexAny(host, "/?#"); i > -1 {
+		host = host[:i]
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return host
+}
@@ -0,0 +1,453 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/guru"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+	"zgo.at/zvalidate"
+)
+
+// Reindex states.
+const (
+	ReindexRunning = "running" // Not finished yet; resumed after a restart.
+	ReindexPaused  = "paused"  // Paused; can be resumed with Start().
+	ReindexDone    = "done"    // Finished.
+	ReindexFailed  = "failed"  // Stopped with an error; can be resumed with Start().
+)
+
+// ReindexTables are the tables that can be reindexed; "all" is all of them.
+var ReindexTables = []string{"hit_stats", "hit_counts", "browser_stats",
+	"system_stats", "location_stats", "ref_counts", "size_stats", "host_stats",
+	"campaign_stats", "all"}
+
+// ReindexFunc recreates the statistics in the tables from the pageviews from
+// the start day up to and including the end day; see cron.ReindexRange().
+type ReindexFunc func(ctx context.Context, site Site, start, end time.Time, tables []string) error
+
+// ReindexJob recreates the statistics from the pageviews for a range of days.
+//
+// The days are reindexed a month at a time, and the progress is recorded after
+// every month so that it can be paused and resumed, or resumed after a
+// restart.
+type ReindexJob struct {
+	ID    int64  `db:"reindex_id" json:"id,readonly"`
+	Site  int64  `db:"site" json:"site,readonly"`
+	JobID *int64 `db:"job_id" json:"job_id,readonly"`
+
+	// Comma-separated list of tables to reindex; see ReindexTables.
+	Tables string `db:"tables" json:"tables,readonly"`
+
+	// Hit ID this reindex was started from; the first day is the day of the
+	// first pageview with this or a higher ID.
+	SinceHit *int64 `db:"since_hit" json:"since_hit,readonly"`
+
+	// First and last day to reindex, in UTC.
+	FirstDay time.Time `db:"first_day" json:"first_day,readonly"`
+	LastDay  time.Time `db:"last_day" json:"last_day,readonly"`
+
+	// running, paused, done, or failed.
+	State string `db:"state" json:"state,readonly"`
+
+	// Number of days to reindex, the number of days that were reindexed, and
+	// the number of days that were done when it was started or resumed.
+	Total     int `db:"total" json:"total,readonly"`
+	Done      int `db:"done" json:"done,readonly"`
+	StartDone int `db:"start_done" json:"-"`
+
+	// Error if the state is failed.
+	Error *string `db:"error" json:"error,readonly"`
+
+	// Progress as a percentage, and the estimated time the reindex finishes;
+	// these are set by ByID() and List().
+	Progress float64    `db:"-" json:"progress,readonly"`
+	ETA      *time.Time `db:"-" json:"eta,readonly"`
+
+	CreatedAt time.Time  `db:"created_at" json:"created_at,readonly"`
+	StartedAt *time.Time `db:"started_at" json:"started_at,readonly"`
+	UpdatedAt time.Time  `db:"updated_at" json:"updated_at,readonly"`
+}
+
+// truncDay gets the start of the day in UTC.
+func truncDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// NewReindexJob creates a new reindex of the tables for the site in the
+// context.
+//
+// It reindexes from the firstDay, or from the day of the pageview with the ID
+// sinceHit, or from the first pageview if both are nil. It reindexes up to and
+// including lastDay, or the current day if it's nil.
+//
+// The reindex is created as paused; use Start() or Run() to reindex.
+func NewReindexJob(ctx context.Context, firstDay, lastDay *time.Time, sinceHit *int64, tables []string) (*ReindexJob, error) {
+	site := MustGetSite(ctx)
+
+	v := zvalidate.New()
+	if len(tables) == 0 {
+		v.Append("tables", "must be set")
+	}
+	for _, t := range tables {
+		v.Include("tables", t, ReindexTables)
+	}
+	if firstDay != nil && sinceHit != nil {
+		v.Append("since_hit", "can't be used with a start date")
+	}
+	if firstDay != nil && lastDay != nil && lastDay.Before(*firstDay) {
+		v.Append("end", "must be after the start date")
+	}
+
+	var running int
+	err := zdb.MustGet(ctx).GetContext(ctx, &running,
+		`select count(*) from reindexes where site=$1 and state=$2`, site.ID, ReindexRunning)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewReindexJob")
+	}
+	if running > 0 {
+		v.Append("reindex", "there is already a reindex running for this site")
+	}
+	if v.HasErrors() {
+		return nil, v
+	}
+
+	r := &ReindexJob{
+		Site:      site.ID,
+		Tables:    strings.Join(tables, ","),
+		SinceHit:  sinceHit,
+		State:     ReindexPaused,
+		CreatedAt: Now(),
+		UpdatedAt: Now(),
+	}
+
+	r.LastDay = truncDay(Now())
+	if lastDay != nil {
+		r.LastDay = truncDay(*lastDay)
+	}
+	r.FirstDay = r.LastDay
+	if firstDay != nil {
+		r.FirstDay = truncDay(*firstDay)
+	} else {
+		var (
+			where = ""
+			args  = []interface{}{site.ID}
+		)
+		if sinceHit != nil {
+			where, args = " and id >= $2", append(args, *sinceHit)
+		}
+		var first string
+		err := zdb.MustGet(ctx).GetContext(ctx, &first,
+			`select created_at from hits where site=$1`+where+` order by created_at asc limit 1`,
+			args...)
+		if err != nil && !zdb.ErrNoRows(err) {
+			return nil, errors.Wrap(err, "NewReindexJob")
+		}
+		if err == nil && len(first) >= 10 {
+			t, err := time.Parse("2006-01-02", first[:10])
+			if err != nil {
+				return nil, errors.Wrap(err, "NewReindexJob")
+			}
+			r.FirstDay = t
+		}
+	}
+	if r.FirstDay.After(r.LastDay) {
+		r.FirstDay = r.LastDay
+	}
+	r.Total = int(r.LastDay.Sub(r.FirstDay).Hours()/24) + 1
+
+	r.ID, err = insertWithID(ctx, "reindex_id", `insert into reindexes
+		(site, tables, since_hit, first_day, last_day, state, total, created_at, updated_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		r.Site, r.Tables, r.SinceHit, r.FirstDay.Format(zdb.Date), r.LastDay.Format(zdb.Date),
+		r.State, r.Total, r.CreatedAt.Format(zdb.Date), r.UpdatedAt.Format(zdb.Date))
+	if err != nil {
+		return nil, errors.Wrap(err, "NewReindexJob")
+	}
+	return r, nil
+}
+
+// TableList gets the list of tables to reindex.
+func (r ReindexJob) TableList() []string { return strings.Split(r.Tables, ",") }
+
+// update the reindex; reindexes that are done are never changed.
+func (r *ReindexJob) update(ctx context.Context) error {
+	r.UpdatedAt = Now()
+	var started *string
+	if r.StartedAt != nil {
+		s := r.StartedAt.Format(zdb.Date)
+		started = &s
+	}
+
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update reindexes set
+		state=$1, job_id=$2, done=$3, start_done=$4, error=$5, started_at=$6, updated_at=$7
+		where reindex_id=$8 and state != $9`,
+		r.State, r.JobID, r.Done, r.StartDone, r.Error, started,
+		r.UpdatedAt.Format(zdb.Date), r.ID, ReindexDone)
+	return errors.Wrapf(err, "ReindexJob.update %d", r.ID)
+}
+
+// setProgress sets Progress and ETA.
+func (r *ReindexJob) setProgress() {
+	r.Progress, r.ETA = 0, nil
+	switch {
+	case r.State == ReindexDone:
+		r.Progress = 100
+		return
+	case r.Total == 0:
+		return
+	}
+	r.Progress = math.Round(float64(r.Done)/float64(r.Total)*1000) / 10
+
+	// Estimate from the rate since it was (re)started.
+	done := r.Done - r.StartDone
+	if r.State != ReindexRunning || r.StartedAt == nil || done <= 0 {
+		return
+	}
+	took := r.UpdatedAt.Sub(*r.StartedAt)
+	left := time.Duration(float64(took) / float64(done) * float64(r.Total-r.Done))
+	eta := r.UpdatedAt.Add(left).Truncate(time.Second)
+	r.ETA = &eta
+}
+
+func (r *ReindexJob) finish(ctx context.Context, reindexErr error) {
+	switch {
+	case reindexErr == nil:
+		r.State = ReindexDone
+	case errors.Is(reindexErr, ErrJobCancelled):
+		r.State = ReindexPaused
+	default:
+		r.State = ReindexFailed
+		e := reindexErr.Error()
+		r.Error = &e
+	}
+
+	err := r.update(ctx)
+	if err != nil {
+		zlog.Module("reindex").Field("reindex", r.ID).Error(err)
+	}
+}
+
+// Start the reindex in the background with StartJob(), continuing from where
+// it left off if it was paused or failed.
+//
+// The ctx should be detached from the request, and the site of the reindex
+// must be in it.
+func (r *ReindexJob) Start(ctx context.Context, reindex ReindexFunc) (*Job, error) {
+	if r.State == ReindexDone || r.State == ReindexRunning && r.JobID != nil {
+		return nil, guru.Errorf(400, "reindex %d is already %s", r.ID, r.State)
+	}
+
+	r.State, r.Error = ReindexRunning, nil
+	err := r.update(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "ReindexJob.Start")
+	}
+
+	// Run a copy, as the reindex is modified while it runs.
+	run := *r
+	j, err := StartJob(ctx, JobReindex, func(ctx context.Context) error {
+		return run.Run(ctx, reindex)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ReindexJob.Start")
+	}
+
+	// Record the job right away so that it can be paused while it's queued;
+	// Run() sets JobID once it starts.
+	r.JobID = &j.ID
+	_, err = zdb.MustGet(ctx).ExecContext(ctx,
+		`update reindexes set job_id=$1 where reindex_id=$2`, j.ID, r.ID)
+	return j, errors.Wrap(err, "ReindexJob.Start")
+}
+
+// Run the reindex, continuing from the last day that was reindexed.
+//
+// The site of the reindex must be in the context.
+func (r *ReindexJob) Run(ctx context.Context, reindex ReindexFunc) error {
+	var (
+		site = MustGetSite(ctx)
+		l    = zlog.Module("reindex").Fields(zlog.F{"site": site.ID, "reindex": r.ID})
+	)
+	if r.Done > 0 {
+		l = l.Field("resume", r.Next().Format("2006-01-02"))
+	}
+	l.Debug("reindex started")
+
+	now := Now()
+	r.State, r.StartedAt, r.StartDone = ReindexRunning, &now, r.Done
+	if j := GetJob(ctx); j != nil {
+		r.JobID = &j.ID
+	}
+	err := r.update(ctx)
+	if err != nil {
+		l.Error(err)
+	}
+
+	err = r.run(ctx, *site, reindex)
+	r.finish(ctx, err)
+	switch {
+	case errors.Is(err, ErrJobCancelled):
+		l.Printf("reindex paused at %s", r.Next().Format("2006-01-02"))
+	case err != nil:
+		l.Error(err)
+		Notify(ctx, NotifyReindex, fmt.Sprintf(
+			"Reindexing the statistics failed at %s: %s", r.Next().Format("2006-01-02"), err), "")
+	default:
+		Notify(ctx, NotifyReindex, fmt.Sprintf(
+			"Finished reindexing the statistics from %s to %s.",
+			r.FirstDay.Format("2006-01-02"), r.LastDay.Format("2006-01-02")), "")
+	}
+	return err
+}
+
+func (r *ReindexJob) run(ctx context.Context, site Site, reindex ReindexFunc) error {
+	tables := r.TableList()
+	for i := 1; r.Done < r.Total; i++ {
+		start := r.Next()
+		end := time.Date(start.Year(), start.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		if end.After(r.LastDay) {
+			end = r.LastDay
+		}
+
+		err := reindex(ctx, site, start, end, tables)
+		if err != nil {
+			return err
+		}
+
+		r.Done += int(end.Sub(start).Hours()/24) + 1
+		JobProgress(ctx, r.Done, r.Total)
+		err = r.update(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = JobPace(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Next gets the next day to reindex.
+func (r ReindexJob) Next() time.Time { return r.FirstDay.AddDate(0, 0, r.Done) }
+
+// Pause the reindex after the current month; it can be resumed with Start().
+//
+// See Job.Cancel() for reindexes running on another instance.
+func (r *ReindexJob) Pause(ctx context.Context) error {
+	if r.State != ReindexRunning || r.JobID == nil {
+		return guru.Errorf(400, "reindex %d is %s", r.ID, r.State)
+	}
+
+	var j Job
+	err := j.ByID(ctx, *r.JobID)
+	if err != nil {
+		return errors.Wrap(err, "ReindexJob.Pause")
+	}
+	err = j.Cancel(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The reindex never runs if the job was still waiting for other jobs.
+	if j.State == JobQueued {
+		r.finish(ctx, ErrJobCancelled)
+	}
+	return nil
+}
+
+// ByID gets a reindex by ID, for the site in the context.
+func (r *ReindexJob) ByID(ctx context.Context, id int64) error {
+	err := zdb.MustGet(ctx).GetContext(ctx, r,
+		`/* ReindexJob.ByID */ select * from reindexes where reindex_id=$1 and site=$2`,
+		id, MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "ReindexJob.ByID")
+	}
+	r.setProgress()
+	return nil
+}
+
+// ReindexJobs is a list of reindexes.
+type ReindexJobs []ReindexJob
+
+// List the 100 most recent reindexes for the site in the context, newest
+// first.
+func (rs *ReindexJobs) List(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, rs, `/* ReindexJobs.List */
+		select * from reindexes where site=$1 order by created_at desc, reindex_id desc limit 100`,
+		MustGetSite(ctx).ID)
+	if err != nil {
+		return errors.Wrap(err, "ReindexJobs.List")
+	}
+	for i := range *rs {
+		(*rs)[i].setProgress()
+	}
+	return nil
+}
+
+// Resume all reindexes that are still running but for which the job is gone,
+// for example because the instance that ran it was restarted; this is run on
+// startup and from cron, after Jobs.Interrupted().
+//
+// Only one instance resumes reindexes at the same time, and the new job is
+// recorded before the lock is released, so a reindex is never resumed twice.
+//
+// Reindexes for sites that no longer exist are marked as failed.
+func (rs *ReindexJobs) Resume(ctx context.Context, reindex ReindexFunc) error {
+	_, err := WithLock(ctx, "resume-reindexes", func() error {
+		// Reindexes without a job are still being started, unless that was a
+		// while ago.
+		err := zdb.MustGet(ctx).SelectContext(ctx, rs, `/* ReindexJobs.Resume */
+			select * from reindexes where state=$1 and (
+				(job_id is null and updated_at < $2) or
+				job_id not in (select job_id from jobs where state in ($3, $4))
+			) order by reindex_id`,
+			ReindexRunning, Now().Add(-JobDead).Format(zdb.Date), JobQueued, JobRunning)
+		if err != nil {
+			return err
+		}
+
+		l := zlog.Module("reindex")
+		for i := range *rs {
+			r := &(*rs)[i]
+
+			var site Site
+			err := site.ByID(ctx, r.Site)
+			if err != nil {
+				l.Field("reindex", r.ID).Error(err)
+				r.finish(ctx, errors.Errorf("can't resume: %w", err))
+				continue
+			}
+
+			r.JobID = nil
+			_, err = r.Start(WithSite(NewContext(ctx), &site), reindex)
+			if err != nil {
+				return err
+			}
+			l.Fields(zlog.F{"reindex": r.ID, "day": r.Next().Format("2006-01-02")}).Print("resuming reindex")
+		}
+		return nil
+	})
+	return errors.Wrap(err, "ReindexJobs.Resume")
+}
+
+// DeleteOlderThan deletes all finished reindexes older than the given number
+// of days; reindexes that are paused are kept.
+func (rs *ReindexJobs) DeleteOlderThan(ctx context.Context, days int) error {
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `delete from reindexes
+		where state in ($1, $2) and created_at < `+interval(days), ReindexDone, ReindexFailed)
+	return errors.Wrap(err, "ReindexJobs.DeleteOlderThan")
+}
@@ -0,0 +1,162 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/bgrun"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestReindexJob(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: time.Date(2020, 4, 20, 14, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/b", CreatedAt: time.Date(2020, 6, 2, 14, 0, 0, 0, time.UTC)})
+
+	var ranges []string
+	reindex := func(ctx context.Context, site goatcounter.Site, start, end time.Time, tables []string) error {
+		ranges = append(ranges, start.Format("2006-01-02")+" "+end.Format("2006-01-02"))
+		if len(ranges) == 2 {
+			return goatcounter.ErrJobCancelled
+		}
+		return nil
+	}
+
+	r, err := goatcounter.NewReindexJob(ctx, nil, nil, nil, []string{"all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, l := r.FirstDay.Format("2006-01-02"), r.LastDay.Format("2006-01-02"); f != "2020-04-20" || l != "2020-06-18" || r.Total != 60 {
+		t.Fatalf("first=%s last=%s total=%d", f, l, r.Total)
+	}
+
+	// Paused after the second month.
+	err = r.Run(ctx, reindex)
+	if err != goatcounter.ErrJobCancelled {
+		t.Fatalf("wrong error: %v", err)
+	}
+	var got goatcounter.ReindexJob
+	err = got.ByID(ctx, r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != goatcounter.ReindexPaused || got.Done != 11 || got.Progress != 18.3 {
+		t.Fatalf("state=%s done=%d progress=%f", got.State, got.Done, got.Progress)
+	}
+
+	// Resumed from the start of May.
+	err = got.Run(ctx, reindex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = got.ByID(ctx, r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != goatcounter.ReindexDone || got.Done != 60 || got.Progress != 100 {
+		t.Fatalf("state=%s done=%d progress=%f", got.State, got.Done, got.Progress)
+	}
+	want := []string{"2020-04-20 2020-04-30", "2020-05-01 2020-05-31", "2020-05-01 2020-05-31", "2020-06-01 2020-06-18"}
+	if len(ranges) != len(want) {
+		t.Fatalf("\ngot:  %v\nwant: %v", ranges, want)
+	}
+	for i := range want {
+		if ranges[i] != want[i] {
+			t.Errorf("\ngot:  %v\nwant: %v", ranges, want)
+		}
+	}
+
+	// Since a hit ID.
+	id := int64(2)
+	r, err = goatcounter.NewReindexJob(ctx, nil, nil, &id, []string{"hit_stats"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := r.FirstDay.Format("2006-01-02"); f != "2020-06-02" || r.Total != 17 {
+		t.Fatalf("first=%s total=%d", f, r.Total)
+	}
+
+	_, err = goatcounter.NewReindexJob(ctx, nil, nil, nil, []string{"nope"})
+	if err == nil {
+		t.Fatal("no error for unknown table")
+	}
+}
+
+func TestReindexJobsResume(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	var n int
+	reindex := func(ctx context.Context, site goatcounter.Site, start, end time.Time, tables []string) error {
+		n++
+		return nil
+	}
+
+	r, err := goatcounter.NewReindexJob(ctx, nil, nil, nil, []string{"all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := zdb.MustGet(ctx)
+
+	// Still running on another instance.
+	_, err = db.ExecContext(ctx, `insert into jobs
+		(site, kind, state, created_at, updated_at, instance, heartbeat_at)
+		values (1, $1, $2, $3, $3, 'other', $3)`,
+		goatcounter.JobReindex, goatcounter.JobRunning, goatcounter.Now().Format(zdb.Date))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.ExecContext(ctx, `update reindexes set state=$1,
+		job_id=(select max(job_id) from jobs) where reindex_id=$2`, goatcounter.ReindexRunning, r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reindexes goatcounter.ReindexJobs
+	err = reindexes.Resume(ctx, reindex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reindexes) != 0 {
+		t.Fatalf("resumed %d reindexes while the job is running", len(reindexes))
+	}
+
+	// The instance is gone.
+	_, err = db.ExecContext(ctx, `update jobs set state=$1`, goatcounter.JobFailed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reindexes = nil
+	err = reindexes.Resume(ctx, reindex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bgrun.Wait()
+	if len(reindexes) != 1 || n != 1 {
+		t.Fatalf("resumed %d reindexes; reindexed %d times", len(reindexes), n)
+	}
+
+	// Nothing left to resume.
+	reindexes = nil
+	err = reindexes.Resume(ctx, reindex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reindexes) != 0 {
+		t.Errorf("resumed %d reindexes", len(reindexes))
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+)
+
+// The hit_counts and ref_counts tables are stored per hour, which means that
+// listing a year needs to sum up almost 9,000 rows for every path. The
+// "_daily" and "_monthly" rollups of these tables store the same data per day
+// and month.
+//
+// Every change to the hourly tables marks the day as dirty in rollup_dirty
+// with a database trigger, and UpdateRollups() recreates the rollups for these
+// days. Queries read dirty days from the hourly table, so the results are
+// always the same as when reading only from the hourly tables.
+
+// rollupCols are the columns of the rollup tables, except the site, the
+// day or month, and the totals; the first columns are unique for every
+// day or month, and the rest are taken from the latest hour.
+var rollupCols = map[string]struct{ group, other []string }{
+	"hit_counts": {[]string{"path"}, []string{"title", "event"}},
+	"ref_counts": {[]string{"path", "ref"}, []string{"ref_scheme", "ref_category"}},
+}
+
+// UpdateRollups recreates the daily and monthly rollups for at most limit
+// dirty days, returning the number of days that were updated.
+func UpdateRollups(ctx context.Context, limit int) (int, error) {
+	var dirty []struct {
+		Site int64     `db:"site"`
+		Tbl  string    `db:"tbl"`
+		Day  time.Time `db:"day"`
+		N    int       `db:"n"`
+	}
+	err := zdb.MustGet(ctx).SelectContext(ctx, &dirty, `/* UpdateRollups */
+		select site, tbl, day, n from rollup_dirty order by site, tbl, day limit $1`, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, "UpdateRollups")
+	}
+
+	type month struct {
+		site  int64
+		tbl   string
+		month time.Time
+	}
+	var months []month
+
+	err = zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		for _, d := range dirty {
+			err := updateRollup(ctx, tx, d.Site, d.Tbl, "day", d.Day, d.Day)
+			if err != nil {
+				return err
+			}
+
+			// Leave it dirty if it was changed again after it was selected.
+			_, err = tx.ExecContext(ctx, `delete from rollup_dirty where site=$1 and tbl=$2 and day=$3 and n=$4`,
+				d.Site, d.Tbl, d.Day.Format("2006-01-02"), d.N)
+			if err != nil {
+				return errors.Errorf("%s: %w", d.Tbl, err)
+			}
+
+			m := month{d.Site, d.Tbl, time.Date(d.Day.Year(), d.Day.Month(), 1, 0, 0, 0, 0, time.UTC)}
+			if len(months) == 0 || months[len(months)-1] != m {
+				months = append(months, m)
+			}
+		}
+
+		for _, m := range months {
+			err := updateRollup(ctx, tx, m.site, m.tbl, "month", m.month, m.month.AddDate(0, 1, -1))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "UpdateRollups")
+	}
+	return len(dirty), nil
+}
+
+// updateRollup recreates the rows in the daily (if period is "day") or
+// monthly (if period is "month") rollup of tbl from the first day up to and
+// including the last day.
+//
+// The daily rollup is created from the hourly table, and the monthly rollup
+// from the daily one.
+func updateRollup(ctx context.Context, tx zdb.DB, siteID int64, tbl, period string, first, last time.Time) error {
+	cols, ok := rollupCols[tbl]
+	if !ok {
+		return errors.Errorf("updateRollup: unknown table %q", tbl)
+	}
+
+	var (
+		src, dst = tbl, tbl + "_daily"
+		srcCol   = "hour"
+		start    = first.Format("2006-01-02") + " 00:00:00"
+		end      = last.Format("2006-01-02") + " 23:59:59"
+		expr     = "date(hour)"
+	)
+	if cfg.PgSQL {
+		expr = "cast(hour as date)"
+	}
+	if period == "month" {
+		src, dst = tbl+"_daily", tbl+"_monthly"
+		srcCol = "day"
+		start, end = first.Format("2006-01-02"), last.Format("2006-01-02")
+		expr = "date(day, 'start of month')"
+		if cfg.PgSQL {
+			expr = "cast(date_trunc('month', day) as date)"
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `delete from `+dst+` where site=$1 and `+period+`>=$2 and `+period+`<=$3`,
+		siteID, first.Format("2006-01-02"), last.Format("2006-01-02"))
+	if err != nil {
+		return errors.Errorf("%s: %w", dst, err)
+	}
+
+	group := strings.Join(cols.group, ", ")
+	other := make([]string, 0, len(cols.other))
+	for _, c := range cols.other {
+		other = append(other, "max("+c+")")
+	}
+	_, err = tx.ExecContext(ctx, `/* updateRollup */
+		insert into `+dst+` (site, `+group+`, `+strings.Join(cols.other, ", ")+`, `+period+`, total, total_unique)
+		select site, `+group+`, `+strings.Join(other, ", ")+`, `+expr+`, sum(total), sum(total_unique)
+		from `+src+`
+		where site=$1 and `+srcCol+`>=$2 and `+srcCol+`<=$3
+		group by site, `+group+`, `+expr,
+		siteID, start, end)
+	if err != nil {
+		return errors.Errorf("%s: %w", dst, err)
+	}
+	return nil
+}
+
+// rollupParts are the parts of a period that can be read from the monthly and
+// daily rollups, and the parts that need to be read from the hourly table.
+//
+// All ranges are inclusive; the months are stored as the first day of the
+// month.
+type rollupParts struct {
+	hours  [][2]time.Time
+	days   [][2]time.Time
+	months [][2]time.Time
+}
+
+// getRollupParts splits the period from start to end in the parts that can be
+// read from the rollups of tbl for the site in the context, and the parts that
+// need to be read from the hourly table.
+//
+// Monthly rollups are only used if months is true.
+func getRollupParts(ctx context.Context, tbl string, start, end time.Time, months bool) (rollupParts, error) {
+	start, end = start.UTC(), clampAsOf(ctx, start, end).UTC()
+	all := rollupParts{hours: [][2]time.Time{{start, end}}}
+
+	// Only full days can be read from the rollups.
+	first, last := truncDay(start), truncDay(end)
+	if first.Before(start) {
+		first = first.AddDate(0, 0, 1)
+	}
+	if end.Before(last.Add(23 * time.Hour)) {
+		last = last.AddDate(0, 0, -1)
+	}
+	if last.Before(first) {
+		return all, nil
+	}
+
+	var dirty []time.Time
+	err := zdb.MustGet(ctx).SelectContext(ctx, &dirty, `/* getRollupParts */
+		select day from rollup_dirty where site=$1 and tbl=$2 and day>=$3 and day<=$4`,
+		MustGetSite(ctx).ID, tbl, first.Format("2006-01-02"), last.Format("2006-01-02"))
+	if err != nil {
+		return all, errors.Wrap(err, "getRollupParts")
+	}
+	isDirty := make(map[string]bool, len(dirty))
+	for _, d := range dirty {
+		isDirty[d.Format("2006-01-02")] = true
+	}
+
+	var (
+		p       rollupParts
+		nextDay = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+		nextSec = func(t time.Time) time.Time { return t.Add(time.Second) }
+	)
+	if start.Before(first) {
+		p.hours = appendRange(p.hours, start, first.Add(-time.Second), nextSec)
+	}
+	for d := first; !d.After(last); d = nextDay(d) {
+		if isDirty[d.Format("2006-01-02")] {
+			p.hours = appendRange(p.hours, d, nextDay(d).Add(-time.Second), nextSec)
+		} else {
+			p.days = appendRange(p.days, d, d, nextDay)
+		}
+	}
+	if e := nextDay(last); !e.After(end) {
+		p.hours = appendRange(p.hours, e, end, nextSec)
+	}
+
+	if months {
+		days := p.days
+		p.days = nil
+		for _, r := range days {
+			for d := r[0]; !d.After(r[1]); {
+				eom := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+				if d.Day() == 1 && !eom.After(r[1]) {
+					p.months = appendRange(p.months, d, d, func(t time.Time) time.Time { return t.AddDate(0, 1, 0) })
+					d = nextDay(eom)
+					continue
+				}
+
+				e := eom
+				if e.After(r[1]) {
+					e = r[1]
+				}
+				p.days = appendRange(p.days, d, e, nextDay)
+				d = nextDay(e)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// appendRange appends the range from start to end to r, merging it with the
+// last range if start is directly after it.
+func appendRange(r [][2]time.Time, start, end time.Time, next func(time.Time) time.Time) [][2]time.Time {
+	if len(r) > 0 && next(r[len(r)-1][1]).Equal(start) {
+		r[len(r)-1][1] = end
+		return r
+	}
+	return append(r, [2]time.Time{start, end})
+}
+
+// rangeWhere gets the SQL condition to select all ranges from col.
+func rangeWhere(col, format string, ranges [][2]time.Time) (string, []interface{}) {
+	var (
+		conds = make([]string, 0, len(ranges))
+		args  = make([]interface{}, 0, len(ranges)*2)
+	)
+	for _, r := range ranges {
+		conds = append(conds, col+">=? and "+col+"<=?")
+		args = append(args, r[0].Format(format), r[1].Format(format))
+	}
+	return "(" + strings.Join(conds, " or ") + ")", args
+}
+
+// query gets the SQL to select cols from the hourly table tbl and its rollups
+// for all the parts, for use as a subquery. The where is added to the
+// conditions of every part.
+//
+// The hour, day, or month of the rows is also selected as hour.
+func (p rollupParts) query(tbl, cols string, siteID int64, where string, whereArgs []interface{}) (string, []interface{}) {
+	var (
+		parts []string
+		args  []interface{}
+	)
+	add := func(from, col, format string, ranges [][2]time.Time) {
+		if len(ranges) == 0 {
+			return
+		}
+		w, a := rangeWhere(col, format, ranges)
+		parts = append(parts, `select `+cols+`, `+col+` as hour from `+from+` where site=? and `+w+where)
+		args = append(append(append(args, siteID), a...), whereArgs...)
+	}
+
+	add(tbl, "hour", zdb.Date, p.hours)
+	add(tbl+"_daily", "day", "2006-01-02", p.days)
+	add(tbl+"_monthly", "month", "2006-01-02", p.months)
+	return strings.Join(parts, " union all "), args
+}
@@ -0,0 +1,140 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestRollups(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	ref := "http://example.org"
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", Ref: ref, FirstVisit: true, CreatedAt: time.Date(2020, 4, 20, 14, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/a", Title: "Old title", FirstVisit: true, CreatedAt: time.Date(2020, 5, 2, 10, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/a", Title: "New title", CreatedAt: time.Date(2020, 5, 3, 10, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/b", Ref: ref, FirstVisit: true, CreatedAt: time.Date(2020, 5, 20, 23, 0, 0, 0, time.UTC)},
+		goatcounter.Hit{Path: "/b", CreatedAt: time.Date(2020, 6, 18, 10, 0, 0, 0, time.UTC)})
+
+	var (
+		start = time.Date(2020, 4, 15, 12, 0, 0, 0, time.UTC)
+		end   = time.Date(2020, 6, 18, 23, 59, 59, 0, time.UTC)
+	)
+	list := func() string {
+		t.Helper()
+		var b strings.Builder
+
+		var stats goatcounter.HitStats
+		total, unique, _, _, err := stats.List(ctx, start, end, "", "", nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&b, "list %d/%d\n", total, unique)
+		for _, s := range stats {
+			fmt.Fprintf(&b, "  %s %d/%d\n", s.Path, s.Count, s.CountUnique)
+		}
+
+		var totals goatcounter.HitStat
+		_, err = totals.Totals(ctx, start, end, "", "", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&b, "totals %d/%d\n", totals.Count, totals.CountUnique)
+		for _, s := range totals.Stats {
+			if s.Daily > 0 {
+				fmt.Fprintf(&b, "  %s %d/%d\n", s.Day, s.Daily, s.DailyUnique)
+			}
+		}
+
+		var refs goatcounter.Stats
+		err = refs.ListTopRefs(ctx, start, end, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range refs.Stats {
+			fmt.Fprintf(&b, "ref %s %d/%d\n", s.Name, s.Count, s.CountUnique)
+		}
+		return b.String()
+	}
+	count := func(tbl string) int {
+		t.Helper()
+		var n int
+		err := zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from `+tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	before := list()
+
+	n, err := goatcounter.UpdateRollups(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 || count("rollup_dirty") != 0 {
+		t.Fatalf("updated %d; %d still dirty", n, count("rollup_dirty"))
+	}
+	if d, m := count("hit_counts_daily"), count("hit_counts_monthly"); d != 5 || m != 4 {
+		t.Fatalf("hit_counts_daily=%d hit_counts_monthly=%d", d, m)
+	}
+
+	after := list()
+	if before != after {
+		t.Fatalf("different after rollup\nbefore:\n%s\nafter:\n%s", before, after)
+	}
+
+	// Read from the hourly table until the rollup is updated.
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/b", CreatedAt: time.Date(2020, 5, 20, 10, 0, 0, 0, time.UTC)})
+	if count("rollup_dirty") == 0 {
+		t.Fatal("not marked as dirty")
+	}
+	before = list()
+	if !strings.Contains(before, "2020-05-20 2/1") {
+		t.Fatalf("new pageview not counted:\n%s", before)
+	}
+
+	_, err = goatcounter.UpdateRollups(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after = list()
+	if before != after {
+		t.Fatalf("different after rollup\nbefore:\n%s\nafter:\n%s", before, after)
+	}
+
+	// The rollups only have the latest title, so filtering on an older title
+	// should read from the hourly table.
+	var stats goatcounter.HitStats
+	total, _, _, _, err := stats.List(ctx, start, end, "old title", "", nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(stats) != 1 || stats[0].Path != "/a" {
+		t.Fatalf("filter on old title: total=%d; %v", total, stats)
+	}
+	var totals goatcounter.HitStat
+	_, err = totals.Totals(ctx, start, end, "old title", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totals.Count != 1 {
+		t.Fatalf("filter on old title: totals=%d", totals.Count)
+	}
+}
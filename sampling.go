@@ -0,0 +1,53 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+
+	"zgo.at/zstd/zint"
+)
+
+// SamplingFactor gets the number of pageviews that every recorded pageview
+// counts for in the statistics; this is 1 if sampling is disabled.
+func (ss SiteSettings) SamplingFactor() int {
+	if ss.Sampling < 1 {
+		return 1
+	}
+	return ss.Sampling
+}
+
+// Estimated reports if the counts are estimated from a sample of the
+// pageviews, rather than the exact counts.
+func (ss SiteSettings) Estimated() bool { return ss.SamplingFactor() > 1 }
+
+// inSample reports if pageviews in this session should be recorded.
+//
+// Entire sessions are sampled, rather than individual pageviews, so that the
+// unique counts and referrers of the sessions that are recorded remain
+// accurate.
+func (ss SiteSettings) inSample(session zint.Uint128) bool {
+	f := ss.SamplingFactor()
+	return f == 1 || session[1]%uint64(f) == 0
+}
+
+// Weight gets the number of pageviews this hit counts for in the statistics;
+// this is the sampling factor at the time it was recorded.
+//
+// The statistics are scaled when they're stored rather than when they're
+// read, so that changing the sampling factor doesn't change the statistics
+// that were recorded before it.
+func (h Hit) Weight() int {
+	if h.Sample < 1 {
+		return 1
+	}
+	return h.Sample
+}
+
+// setEstimated marks the stats as Estimated if sampling is enabled for the
+// site in the context.
+func (h *Stats) setEstimated(ctx context.Context) {
+	h.Estimated = MustGetSite(ctx).Settings.Estimated()
+}
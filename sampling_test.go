@@ -0,0 +1,110 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestSampling(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := goatcounter.MustGetSite(ctx)
+	site.Settings.Sampling = 2
+	err := site.Update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every session is a new visitor; only half of them should be recorded.
+	now := goatcounter.Now()
+	for i := 0; i < 4; i++ {
+		goatcounter.Memstore.Append(goatcounter.Hit{Site: site.ID, Path: "/a", Browser: "test",
+			RemoteAddr: fmt.Sprintf("127.0.0.%d", i+1), CreatedAt: now})
+	}
+	hits, err := goatcounter.Memstore.Persist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 2 {
+		t.Errorf("recorded %d hits; want 2", len(hits))
+	}
+	for _, h := range hits {
+		if h.Sample != 2 {
+			t.Errorf("Sample is %d; want 2", h.Sample)
+		}
+	}
+}
+
+func TestSamplingExtrapolate(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := goatcounter.Now()
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/a", CreatedAt: now},
+		goatcounter.Hit{Path: "/a", CreatedAt: now},
+		goatcounter.Hit{Path: "/b", CreatedAt: now})
+
+	start, end := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	list := func() string {
+		var pages goatcounter.HitStats
+		l, err := pages.ListPages(ctx, start, end, "", "", nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total, display := l.Total, l.Display
+
+		var browsers goatcounter.Stats
+		err = browsers.ListBrowsers(ctx, start, end, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var browserTotal int
+		for _, b := range browsers.Stats {
+			browserTotal += b.Count
+		}
+
+		s := fmt.Sprintf("total=%d display=%d browsers=%d estimated=%t",
+			total, display, browserTotal, browsers.Estimated)
+		sort.Slice(pages, func(i, j int) bool { return pages[i].Path < pages[j].Path })
+		for _, p := range pages {
+			s += fmt.Sprintf(" %s=%d", p.Path, p.Count)
+		}
+		return s
+	}
+
+	want := `total=3 display=3 browsers=3 estimated=false /a=2 /b=1`
+	if got := list(); got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+
+	site := goatcounter.MustGetSite(ctx)
+	site.Settings.Sampling = 10
+	err := site.Update(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pageviews recorded before sampling was enabled aren't scaled.
+	want = `total=3 display=3 browsers=3 estimated=true /a=2 /b=1`
+	if got := list(); got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+
+	gctest.StoreHits(ctx, t, false, goatcounter.Hit{Path: "/b", CreatedAt: now, Sample: 10})
+	want = `total=13 display=13 browsers=13 estimated=true /a=2 /b=11`
+	if got := list(); got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+}
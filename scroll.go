@@ -0,0 +1,173 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zlog"
+)
+
+// ScrollDepth is how far a page was scrolled down before the visitor left, as
+// sent by count.js.
+type ScrollDepth struct {
+	Site       int64
+	Path       string
+	Depth      int // Percentage, from 0 to 100.
+	CreatedAt  time.Time
+	Browser    string // User-Agent header; only used to check the BotRules.
+	RemoteAddr string
+}
+
+// MaxScrollDepths is the maximum number of scroll depth events in the
+// memstore; more events are dropped until the memstore is persisted.
+var MaxScrollDepths = 100000
+
+// ScrollStat is the aggregated scroll depth for a path.
+type ScrollStat struct {
+	Path  string `db:"path" json:"path"`
+	Count int    `db:"count" json:"count"` // Number of scroll depth events.
+	Total int    `db:"total" json:"-"`     // Sum of all depths.
+
+	// Number of visitors that scrolled to at least 25%, 50%, 75%, and 100%
+	// of the page.
+	Reached25  int `db:"reached_25" json:"reached_25"`
+	Reached50  int `db:"reached_50" json:"reached_50"`
+	Reached75  int `db:"reached_75" json:"reached_75"`
+	Reached100 int `db:"reached_100" json:"reached_100"`
+}
+
+// Average gets the average scroll depth, as a percentage.
+func (s ScrollStat) Average() int {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / s.Count
+}
+
+// Reached gets the percentage of visitors that scrolled to at least 25%, 50%,
+// 75%, or 100% of the page; any other value for depth returns 0.
+func (s ScrollStat) Reached(depth int) int {
+	if s.Count == 0 {
+		return 0
+	}
+	n := map[int]int{25: s.Reached25, 50: s.Reached50, 75: s.Reached75, 100: s.Reached100}[depth]
+	return n * 100 / s.Count
+}
+
+// AppendScroll adds a scroll depth event to the memstore, and reports if it
+// was added; it's not added if there are already MaxScrollDepths events.
+func (m *ms) AppendScroll(s ScrollDepth) bool {
+	m.hitMu.Lock()
+	defer m.hitMu.Unlock()
+	if len(m.scrolls) >= MaxScrollDepths {
+		return false
+	}
+	m.scrolls = append(m.scrolls, s)
+	return true
+}
+
+// PersistScroll gets all scroll depth events from the memstore, so they can be
+// aggregated in the scroll_stats.
+//
+// The path is cleaned in the same way as for pageviews, so that it matches
+// the path in the hit_stats. Events from ignored IPs and bots are skipped; the
+// settings may have changed since the event was added.
+func (m *ms) PersistScroll(ctx context.Context) []ScrollDepth {
+	m.hitMu.Lock()
+	scrolls := m.scrolls
+	m.scrolls = nil
+	m.hitMu.Unlock()
+
+	var (
+		persist = make([]ScrollDepth, 0, len(scrolls))
+		sites   = make(map[int64]*Site)
+	)
+	for _, s := range scrolls {
+		site, ok := sites[s.Site]
+		if !ok {
+			site = new(Site)
+			err := site.ByID(ctx, s.Site)
+			if err != nil {
+				zlog.Module("memstore").Field("site", s.Site).Error(err)
+				continue
+			}
+			sites[s.Site] = site
+		}
+		if site.Settings.IsIgnored(s.RemoteAddr) {
+			continue
+		}
+
+		h := Hit{Site: s.Site, Path: s.Path, Browser: s.Browser, RemoteAddr: s.RemoteAddr}
+		h.cleanPath(ctx)
+		if h.Path == "" {
+			continue
+		}
+		h.scoreBot(site)
+		if h.Bot > 0 {
+			continue
+		}
+		s.Path = h.Path
+		persist = append(persist, s)
+	}
+	return persist
+}
+
+// LoadScroll loads the scroll depth statistics for all paths in the list.
+//
+// HitStat.Scroll is nil for paths without any scroll depth events.
+func (h HitStats) LoadScroll(ctx context.Context, start, end time.Time) error {
+	if len(h) == 0 {
+		return nil
+	}
+
+	site := MustGetSite(ctx)
+	start = start.In(GetTimezone(ctx).Location)
+	end = end.In(GetTimezone(ctx).Location)
+
+	paths := make([]string, 0, len(h))
+	for _, s := range h {
+		paths = append(paths, s.Path)
+	}
+
+	db := zdb.MustGet(ctx)
+	query, args, err := sqlx.In(`/* HitStats.LoadScroll */
+		select
+			path,
+			sum(count)       as count,
+			sum(total)       as total,
+			sum(reached_25)  as reached_25,
+			sum(reached_50)  as reached_50,
+			sum(reached_75)  as reached_75,
+			sum(reached_100) as reached_100
+		from scroll_stats
+		where site=? and day >= ? and day <= ? and path in (?)
+		group by path`,
+		site.ID, start.Format("2006-01-02"), clampAsOf(ctx, start, end).Format("2006-01-02"), paths)
+	if err != nil {
+		return errors.Wrap(err, "HitStats.LoadScroll")
+	}
+
+	var stats []ScrollStat
+	err = db.SelectContext(ctx, &stats, db.Rebind(query), args...)
+	if err != nil {
+		return errors.Wrap(err, "HitStats.LoadScroll")
+	}
+
+	byPath := make(map[string]ScrollStat, len(stats))
+	for _, s := range stats {
+		byPath[s.Path] = s
+	}
+	for i := range h {
+		if s, ok := byPath[h[i].Path]; ok {
+			h[i].Scroll = &s
+		}
+	}
+	return nil
+}
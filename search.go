@@ -0,0 +1,149 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"zgo.at/errors"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/zdb"
+)
+
+// searchIndex is set to 1 if the optional search index exists.
+var searchIndex int32
+
+// HasSearchIndex reports if the search index was found by LoadSearchIndex or
+// created with CreateSearchIndex.
+func HasSearchIndex() bool { return atomic.LoadInt32(&searchIndex) == 1 }
+
+// LoadSearchIndex checks if the optional search index exists; the path filter
+// will use it if it does.
+func LoadSearchIndex(ctx context.Context) error {
+	query := `select count(*) from sqlite_master where type='table' and name='hit_paths_fts' and sql like '%trigram%'`
+	if cfg.PgSQL {
+		query = `select count(*) from pg_indexes where indexname='hit_counts#lower_path#trgm'`
+	}
+
+	db := zdb.MustGet(ctx)
+	var n int
+	err := db.GetContext(ctx, &n, query)
+	if err != nil {
+		return errors.Errorf("LoadSearchIndex: %w", err)
+	}
+
+	// Migrations that recreate the hit_counts table also remove the trigger.
+	if n > 0 && !cfg.PgSQL {
+		_, err := db.ExecContext(ctx, hitPathsTrigger)
+		if err != nil {
+			return errors.Errorf("LoadSearchIndex: %w", err)
+		}
+	}
+
+	atomic.StoreInt32(&searchIndex, map[bool]int32{true: 1, false: 0}[n > 0])
+	return nil
+}
+
+// CreateSearchIndex creates the search index to speed up filtering on path and
+// title. This is optional, as it takes up a fair bit of extra disk space and
+// makes inserting new hits a bit slower; it's only really useful for sites with
+// a large number of paths.
+//
+// On PostgreSQL this creates pg_trgm indexes on the path and title of hit_counts
+// and hit_stats. This requires the pg_trgm extension to be available.
+//
+// On SQLite this creates a hit_paths table with all unique paths and titles,
+// which is kept up to date by a trigger on hit_counts, and an FTS5 index with
+// the trigram tokenizer for it. This requires SQLite 3.34 or newer compiled
+// with FTS5 support (the sqlite_fts5 build tag for go-sqlite3). The trigram
+// tokenizer can only be used for filters of at least three characters; shorter
+// filters don't use the index.
+func CreateSearchIndex(ctx context.Context) error {
+	err := zdb.TX(ctx, func(ctx context.Context, db zdb.DB) error {
+		query := searchIndexSQLite
+		if cfg.PgSQL {
+			query = searchIndexPgSQL
+		}
+
+		if !cfg.PgSQL {
+			// Indexes created by older versions used the default tokenizer,
+			// which only matches words.
+			var old int
+			err := db.GetContext(ctx, &old, `select count(*) from sqlite_master
+				where type='table' and name='hit_paths_fts' and sql not like '%trigram%'`)
+			if err == nil && old > 0 {
+				_, err = db.ExecContext(ctx, `drop table hit_paths_fts`)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := db.ExecContext(ctx, query)
+		if err != nil && strings.Contains(err.Error(), "no such module: fts5") {
+			return errors.New("SQLite isn't compiled with FTS5 support; compile with the sqlite_fts5 build tag")
+		}
+		if err != nil && strings.Contains(err.Error(), "no such tokenizer: trigram") {
+			return errors.New("the SQLite version doesn't support the FTS5 trigram tokenizer; SQLite 3.34 or newer is required")
+		}
+		return err
+	})
+	if err != nil {
+		return errors.Errorf("CreateSearchIndex: %w", err)
+	}
+
+	atomic.StoreInt32(&searchIndex, 1)
+	return nil
+}
+
+const searchIndexPgSQL = `
+	create extension if not exists pg_trgm;
+	create index if not exists "hit_counts#path#trgm"        on hit_counts using gin(path gin_trgm_ops);
+	create index if not exists "hit_counts#lower_path#trgm"  on hit_counts using gin(lower(path) gin_trgm_ops);
+	create index if not exists "hit_counts#lower_title#trgm" on hit_counts using gin(lower(title) gin_trgm_ops);
+	create index if not exists "hit_stats#path#trgm"         on hit_stats  using gin(path gin_trgm_ops);
+	create index if not exists "hit_stats#lower_path#trgm"   on hit_stats  using gin(lower(path) gin_trgm_ops);
+	create index if not exists "hit_stats#lower_title#trgm"  on hit_stats  using gin(lower(title) gin_trgm_ops);
+`
+
+const searchIndexSQLite = `
+	create table if not exists hit_paths (
+		site          int        not null check(site>0),
+		path          varchar    not null,
+		title         varchar    not null,
+
+		constraint "hit_paths#site#path#title" unique(site, path, title)
+	);
+	create virtual table if not exists hit_paths_fts using fts5(path, title, content='hit_paths', tokenize='trigram');
+
+	create trigger if not exists "hit_paths#fts" after insert on hit_paths begin
+		insert into hit_paths_fts (rowid, path, title) values (new.rowid, new.path, new.title);
+	end;
+	` + hitPathsTrigger + `
+
+	insert or ignore into hit_paths (site, path, title)
+		select distinct site, path, title from hit_counts;
+	insert into hit_paths_fts (hit_paths_fts) values ('rebuild');
+`
+
+const hitPathsTrigger = `
+	create trigger if not exists "hit_counts#hit_paths" after insert on hit_counts begin
+		insert or ignore into hit_paths (site, path, title) values (new.site, new.path, new.title);
+	end;`
+
+// ftsQuery converts the filter text to an FTS5 query, matching the text
+// anywhere in the path or title.
+//
+// This returns an empty string if the text is shorter than three characters,
+// as the trigram tokenizer can't match these.
+func ftsQuery(filter string) string {
+	if utf8.RuneCountInString(filter) < 3 {
+		return ""
+	}
+	return `"` + strings.ReplaceAll(filter, `"`, `""`) + `"`
+}
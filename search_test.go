@@ -0,0 +1,75 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/cfg"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestSearchIndex(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	err := goatcounter.CreateSearchIndex(ctx)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer func() {
+		q := `drop table hit_paths_fts`
+		if cfg.PgSQL {
+			q = `drop index "hit_counts#lower_path#trgm"`
+		}
+		zdb.MustGet(ctx).ExecContext(ctx, q)
+		goatcounter.LoadSearchIndex(ctx)
+	}()
+	if !goatcounter.HasSearchIndex() {
+		t.Fatal("no search index")
+	}
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	gctest.StoreHits(ctx, t, false,
+		goatcounter.Hit{Path: "/barfoo", Title: "Xyz", CreatedAt: now},
+		goatcounter.Hit{Path: "/foo-bar", Title: "Foo", CreatedAt: now},
+		goatcounter.Hit{Path: "/other", Title: "Other", CreatedAt: now})
+
+	// The index doesn't change what the filter matches: substrings inside a
+	// word, and filters too short for the index.
+	tests := []struct {
+		filter, want string
+	}{
+		{"foo", "/barfoo /foo-bar"},
+		{"arfo", "/barfoo"},
+		{"oo", "/barfoo /foo-bar"},
+		{"yz", "/barfoo"},
+		{"the", "/other"},
+		{"nope", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			var stats goatcounter.HitStats
+			_, _, _, _, err := stats.List(ctx, now.Add(-time.Hour), now.Add(time.Hour), tt.filter, "", nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, s := range stats {
+				got = append(got, s.Path)
+			}
+			sort.Strings(got)
+			if g := strings.Join(got, " "); g != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", g, tt.want)
+			}
+		})
+	}
+}
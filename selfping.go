@@ -0,0 +1,127 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/zdb"
+	"zgo.at/zstd/zcrypto"
+)
+
+// SelfPingHeader is the header with the token for the synthetic pageviews sent
+// by SelfPing(); these are never stored.
+const SelfPingHeader = "X-Goatcounter-Selfping"
+
+var selfPing struct {
+	sync.Mutex
+	token string
+}
+
+// SelfPingToken gets the token for the synthetic pageviews sent by SelfPing().
+//
+// The token is stored in the database so that it's the same for all
+// GoatCounter instances using the same database, which may be behind a load
+// balancer.
+func SelfPingToken(ctx context.Context) (string, error) {
+	selfPing.Lock()
+	defer selfPing.Unlock()
+	if selfPing.token != "" {
+		return selfPing.token, nil
+	}
+
+	db := zdb.MustGet(ctx)
+	err := db.GetContext(ctx, &selfPing.token, `select value from store where key='selfping'`)
+	if err == nil {
+		return selfPing.token, nil
+	}
+	if !zdb.ErrNoRows(err) {
+		return "", errors.Wrap(err, "SelfPingToken")
+	}
+
+	token := zcrypto.Secret256()
+	_, err = db.ExecContext(ctx, `insert into store (key, value) values ('selfping', $1)`, token)
+	if err != nil {
+		// Another instance may have inserted it first.
+		err2 := db.GetContext(ctx, &selfPing.token, `select value from store where key='selfping'`)
+		if err2 != nil {
+			return "", errors.Wrap(err, "SelfPingToken")
+		}
+		return selfPing.token, nil
+	}
+	selfPing.token = token
+	return token, nil
+}
+
+// IsSelfPing reports if this is a synthetic pageview sent by SelfPing().
+func IsSelfPing(ctx context.Context, r *http.Request) bool {
+	h := r.Header.Get(SelfPingHeader)
+	if h == "" {
+		return false
+	}
+	token, err := SelfPingToken(ctx)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(h), []byte(token)) == 1
+}
+
+var selfPingClient = http.Client{Timeout: 30 * time.Second}
+
+// SelfPing sends a synthetic pageview to the count endpoint at countURL (e.g.
+// "https://stats.example.com/count"), to check that pageviews arrive at
+// GoatCounter through any reverse proxies, TLS termination, etc.
+//
+// It returns an error if it fails, or if it took longer than the budget.
+func SelfPing(ctx context.Context, countURL string, budget time.Duration) (time.Duration, error) {
+	token, err := SelfPingToken(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	u, err := url.Parse(countURL)
+	if err != nil {
+		return 0, errors.Errorf("SelfPing: %w", err)
+	}
+	q := u.Query()
+	q.Set("p", "/goatcounter-selfping")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return 0, errors.Errorf("SelfPing: %w", err)
+	}
+	req.Header.Set(SelfPingHeader, token)
+	req.Header.Set("User-Agent", "GoatCounter self-ping")
+
+	start := time.Now()
+	resp, err := selfPingClient.Do(req)
+	if err != nil {
+		return 0, errors.Errorf("SelfPing: %w", err)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	took := time.Since(start)
+
+	// Check the header, so it fails if something else responded; for example
+	// a reverse proxy which serves a default page.
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("X-Goatcounter") != "self-ping" {
+		return took, errors.Errorf("SelfPing: %s: unexpected response: %s (X-Goatcounter: %q)",
+			countURL, resp.Status, resp.Header.Get("X-Goatcounter"))
+	}
+	if budget > 0 && took > budget {
+		return took, errors.Errorf("SelfPing: %s: took %s, which is longer than %s",
+			countURL, took.Round(time.Millisecond), budget)
+	}
+	return took, nil
+}
@@ -0,0 +1,47 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+)
+
+func TestIsSelfPing(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	token, err := goatcounter.SelfPingToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) < 32 {
+		t.Fatalf("short token: %q", token)
+	}
+
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{"", false},
+		{"wrong", false},
+		{token, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/count?p=/x", nil)
+			if tt.header != "" {
+				r.Header.Set(goatcounter.SelfPingHeader, tt.header)
+			}
+			if got := goatcounter.IsSelfPing(ctx, r); got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+		})
+	}
+}
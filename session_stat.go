@@ -0,0 +1,97 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"time"
+
+	"zgo.at/zstd/zint"
+)
+
+// SessionStat is the summary of a session, which is stored in the
+// sessions_stats once the session is evicted from the memstore.
+//
+// Only sessions with at least one pageview that aren't bots are recorded.
+type SessionStat struct {
+	Site      int64  `db:"site" json:"site"`
+	Day       string `db:"day" json:"day"`             // Day the session started, in UTC.
+	Pageviews int    `db:"pageviews" json:"pageviews"` // Number of pageviews, excluding events.
+	Duration  int    `db:"duration" json:"duration"`   // Seconds from the first to the last pageview.
+	EntryPath string `db:"entry_path" json:"entry_path"`
+	ExitPath  string `db:"exit_path" json:"exit_path"`
+}
+
+// sessionInfo is kept in the memstore for every session, to create the
+// SessionStat when the session is evicted.
+type sessionInfo struct {
+	Site      int64  `json:"site"`
+	Pageviews int    `json:"pageviews"`
+	Entry     string `json:"entry"`
+	Exit      string `json:"exit"`
+	Bot       bool   `json:"bot"`
+}
+
+// sessionPageview records a persisted hit in the session's sessionInfo.
+//
+// Hits with a session that's not in the memstore (such as imported hits) are
+// ignored.
+func (m *ms) sessionPageview(h Hit) {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	if _, ok := m.sessionSeen[h.Session]; !ok {
+		return
+	}
+
+	s := m.sessionInfo[h.Session]
+	s.Site = h.Site
+	if h.Bot > 0 {
+		s.Bot = true
+	}
+	if !h.Event {
+		s.Pageviews++
+		if s.Entry == "" {
+			s.Entry = h.Path
+		}
+		s.Exit = h.Path
+	}
+	m.sessionInfo[h.Session] = s
+}
+
+// endSession adds the SessionStat for the session to the list of ended
+// sessions; the caller must hold the lock.
+func (m *ms) endSession(sID zint.Uint128) {
+	s, ok := m.sessionInfo[sID]
+	if !ok || s.Pageviews == 0 || s.Bot {
+		return
+	}
+	if _, ok := m.honeypot[sID]; ok {
+		return
+	}
+
+	start, seen := m.sessionStart[sID], m.sessionSeen[sID]
+	if start == 0 {
+		start = seen
+	}
+	m.ended = append(m.ended, SessionStat{
+		Site:      s.Site,
+		Day:       time.Unix(start, 0).UTC().Format("2006-01-02"),
+		Pageviews: s.Pageviews,
+		Duration:  int(seen - start),
+		EntryPath: s.Entry,
+		ExitPath:  s.Exit,
+	})
+}
+
+// PersistSessions gets the summaries of all sessions that were evicted since
+// the last call, so they can be stored in the sessions_stats.
+func (m *ms) PersistSessions() []SessionStat {
+	m.sessionMu.Lock()
+	defer m.sessionMu.Unlock()
+
+	ended := m.ended
+	m.ended = nil
+	return ended
+}
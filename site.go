@@ -9,9 +9,11 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"zgo.at/errors"
 	"zgo.at/goatcounter/cache"
@@ -37,6 +39,25 @@ const (
 
 var Plans = []string{PlanPersonal, PlanPersonalPlus, PlanBusiness, PlanBusinessPlus}
 
+// LocationDetail setting values.
+const (
+	LocationCountry = "country"
+	LocationRegion  = "region"
+	LocationCity    = "city"
+)
+
+var LocationDetails = []string{LocationCountry, LocationRegion, LocationCity}
+
+// ExportSchedule setting values.
+const (
+	ExportScheduleNone    = ""
+	ExportScheduleDaily   = "daily"
+	ExportScheduleWeekly  = "weekly"
+	ExportScheduleMonthly = "monthly"
+)
+
+var ExportSchedules = []string{ExportScheduleNone, ExportScheduleDaily, ExportScheduleWeekly, ExportScheduleMonthly}
+
 var reserved = []string{
 	"www", "mail", "smtp", "imap", "static",
 	"admin", "ns1", "ns2", "m", "mobile", "api",
@@ -45,7 +66,8 @@ var reserved = []string{
 }
 
 var statTables = []string{"hit_stats", "system_stats", "browser_stats",
-	"location_stats", "size_stats"}
+	"location_stats", "size_stats", "host_stats", "campaign_stats",
+	"sessions_stats"}
 
 type Site struct {
 	ID     int64  `db:"id" json:"id,readonly"`
@@ -71,6 +93,20 @@ type Site struct {
 	// pageview.
 	ReceivedData bool `db:"received_data" json:"received_data"`
 
+	// Details about the first pageview that was received; this is null if
+	// there is none yet, or if it was received before this was recorded.
+	FirstHit *SiteFirstHit `db:"first_hit" json:"first_hit,readonly"`
+
+	// Hour of the earliest pageview in the statistics, including imported
+	// pageviews; this is null if there are no pageviews.
+	FirstHitAt *time.Time `db:"first_hit_at" json:"first_hit_at,readonly"`
+
+	// Pageviews before this are anonymized; see AnonymizeOlderThan().
+	AnonymizedUntil *time.Time `db:"anonymized_until" json:"-"`
+
+	// When the last "no data received" email was sent.
+	NoDataSentAt *time.Time `db:"no_data_sent_at" json:"-"`
+
 	State     string     `db:"state" json:"state"`
 	CreatedAt time.Time  `db:"created_at" json:"created_at"`
 	UpdatedAt *time.Time `db:"updated_at" json:"updated_at"`
@@ -83,11 +119,109 @@ type SiteSettings struct {
 	DateFormat       string      `json:"date_format"`
 	NumberFormat     rune        `json:"number_format"`
 	DataRetention    int         `json:"data_retention"`
-	IgnoreIPs        zdb.Strings `json:"ignore_ips"`
+	IgnoreIPs        zdb.Strings `json:"ignore_ips"` // IP addresses or CIDR ranges.
 	Timezone         *tz.Zone    `json:"timezone"`
 	Campaigns        zdb.Strings `json:"campaigns"`
 	AllowAdmin       bool        `json:"allow_admin"`
-	Limits           struct {
+
+	// CaseSensitivePaths matches paths case-sensitive when filtering, so that
+	// e.g. "/About" and "/about" are treated as different pages.
+	CaseSensitivePaths bool `json:"case_sensitive_paths"`
+
+	// LocationDetail is the level of detail to store locations at; see the
+	// Location* constants. Only the country is stored by default, as the
+	// region or city can reveal quite a lot about a visitor.
+	LocationDetail string `json:"location_detail"`
+
+	// BotRules are custom rules to mark pageviews as a bot, in addition to
+	// the built-in detection.
+	BotRules BotRules `json:"bot_rules"`
+
+	// BotThreshold is the BotScore at or above which a pageview is counted
+	// as a bot, from 1 to 100. 0 uses the default of 100, which only counts
+	// pageviews with a reason that's certainly a bot.
+	BotThreshold int `json:"bot_threshold"`
+
+	// Dedup ignores pageviews to the same path in the same session within
+	// DedupWindow of each other.
+	Dedup bool `json:"dedup"`
+
+	// TotalsExcludeEvents doesn't count events in the totals and the totals
+	// graph on the dashboard, so they only include pageviews. Events are
+	// still listed with the paths.
+	TotalsExcludeEvents bool `json:"totals_exclude_events"`
+
+	// ClampFirstHit doesn't show the days before the first pageview on the
+	// dashboard, instead of showing them as days without pageviews.
+	ClampFirstHit bool `json:"clamp_first_hit"`
+
+	// Noscript gets the path from the Referer header for pageviews without a
+	// path, so that an image in <noscript> can be used without setting the
+	// path for every page.
+	Noscript bool `json:"noscript"`
+
+	// Sampling records only the pageviews of 1 in every Sampling sessions,
+	// to reduce the amount of data stored for high-traffic sites. The stats
+	// are multiplied by this to estimate the real counts. 0 or 1 records
+	// everything.
+	Sampling int `json:"sampling"`
+
+	// IPv6Prefix is the network prefix length of IPv6 addresses that's used
+	// to identify sessions and to match single addresses in IgnoreIPs, as
+	// the rest of the address changes frequently with privacy extensions. 0
+	// uses the default of 64; 128 uses the full address.
+	IPv6Prefix int `json:"ipv6_prefix"`
+
+	// ExportSchedule is how often to automatically export all pageviews and
+	// email a download link; see the ExportSchedule* constants. Empty to
+	// never export automatically.
+	ExportSchedule string `json:"export_schedule"`
+
+	// EventRetention is the number of days to keep events for, separate
+	// from the DataRetention for pageviews. 0 uses the DataRetention, and -1
+	// keeps events forever.
+	EventRetention int `json:"event_retention"`
+
+	// PathRetention overrides the DataRetention and EventRetention for paths
+	// starting with a prefix, as "prefix=days"; the longest matching prefix
+	// is used. The days must be at least 14, or -1 to never remove them.
+	PathRetention zdb.Strings `json:"path_retention"`
+
+	// RetentionKeepStats removes only the pageviews after the retention, and
+	// keeps the statistics forever.
+	RetentionKeepStats bool `json:"retention_keep_stats"`
+
+	// AnonymizeAfter removes the session, browser, and location from
+	// pageviews after this many days, without removing the pageviews. 0 to
+	// never anonymize. See Site.AnonymizeOlderThan().
+	AnonymizeAfter int `json:"anonymize_after"`
+
+	// RefCategories overrides the source category of referrers, as
+	// "host=category"; the host can use "*.example.com" to match all
+	// subdomains. See RefCategory().
+	RefCategories zdb.Strings `json:"ref_categories"`
+
+	// NoDataAlert emails the site's user if no pageviews were received for
+	// this many hours, after the site has received pageviews before. 0 to
+	// never send an email.
+	NoDataAlert int `json:"no_data_alert"`
+
+	// AllowedHosts are the hosts, in addition to LinkDomain, that may send
+	// pageviews; pageviews from other hosts are ignored if this is set.
+	// BlockedHosts are never counted. Both can use "*.example.com" to match
+	// all subdomains.
+	AllowedHosts zdb.Strings `json:"allowed_hosts"`
+	BlockedHosts zdb.Strings `json:"blocked_hosts"`
+
+	// Session overrides the session inactivity window and maximum length, in
+	// minutes; 0 uses the -session-idle and -session-max flags. These can only
+	// be shorter than the flags; see SessionWindow().
+	Session struct {
+		Idle int `json:"idle"`
+		Max  int `json:"max"`
+	} `json:"session"`
+
+	Limits struct {
 		Page   int `json:"page"`
 		Ref    int `json:"ref"`
 		Hchart int `json:"hchart"`
@@ -96,6 +230,75 @@ type SiteSettings struct {
 
 func (ss SiteSettings) String() string { return string(zjson.MustMarshal(ss)) }
 
+// EventRetentionDays gets the number of days to keep events for, or 0 to keep
+// them forever.
+func (ss SiteSettings) EventRetentionDays() int {
+	switch ss.EventRetention {
+	case 0:
+		return ss.DataRetention
+	case -1:
+		return 0
+	}
+	return ss.EventRetention
+}
+
+// PathRetention is a retention for paths starting with Prefix; see
+// SiteSettings.PathRetention.
+type PathRetention struct {
+	Prefix string
+	Days   int // -1 to never remove.
+}
+
+// PathRetentions parses the PathRetention setting, sorted by the length of the
+// prefix with the longest first.
+func (ss SiteSettings) PathRetentions() ([]PathRetention, error) {
+	r := make([]PathRetention, 0, len(ss.PathRetention))
+	for _, p := range ss.PathRetention {
+		i := strings.LastIndexByte(p, '=')
+		if i == -1 {
+			return nil, fmt.Errorf("%q: must be as prefix=days", p)
+		}
+		prefix := strings.TrimSpace(p[:i])
+		if prefix == "" {
+			return nil, fmt.Errorf("%q: prefix is empty", p)
+		}
+		days, err := strconv.Atoi(strings.TrimSpace(p[i+1:]))
+		if err != nil || (days != -1 && days < 14) {
+			return nil, fmt.Errorf("%q: days must be at least 14, or -1 to never remove", p)
+		}
+		r = append(r, PathRetention{Prefix: prefix, Days: days})
+	}
+	sort.SliceStable(r, func(i, j int) bool { return len(r[i].Prefix) > len(r[j].Prefix) })
+	return r, nil
+}
+
+// PageviewsRemovedBefore gets the day before which the pageviews were removed
+// by DataRetention while the statistics are kept, because RetentionKeepStats
+// is set.
+//
+// The statistics before this can't be recreated from the pageviews. This is
+// the zero time if RetentionKeepStats or DataRetention isn't set.
+func (ss SiteSettings) PageviewsRemovedBefore() time.Time {
+	if !ss.RetentionKeepStats || ss.DataRetention <= 0 {
+		return time.Time{}
+	}
+	return truncDay(Now().AddDate(0, 0, -ss.DataRetention)).AddDate(0, 0, 1)
+}
+
+// AnonymizedBefore gets the day before which pageviews may be anonymized; see
+// Site.AnonymizeOlderThan(). This is the zero time if AnonymizeAfter isn't set.
+func (ss SiteSettings) AnonymizedBefore() time.Time {
+	if ss.AnonymizeAfter <= 0 {
+		return time.Time{}
+	}
+	return truncDay(Now().AddDate(0, 0, -ss.AnonymizeAfter)).AddDate(0, 0, 1)
+}
+
+// IsIgnored reports if the IP address is in the IgnoreIPs list.
+func (ss SiteSettings) IsIgnored(ip string) bool {
+	return matchIP(ss.IgnoreIPs, ip, ss.IPv6PrefixLen())
+}
+
 // Value implements the SQL Value function to determine what to store in the DB.
 func (ss SiteSettings) Value() (driver.Value, error) { return json.Marshal(ss) }
 
@@ -111,6 +314,31 @@ func (ss *SiteSettings) Scan(v interface{}) error {
 	}
 }
 
+// SiteFirstHit are details about the first pageview a site received, to verify
+// the installation.
+type SiteFirstHit struct {
+	CreatedAt time.Time `json:"created_at"`
+	Host      string    `json:"host"`       // Hostname the pageview was sent from.
+	Path      string    `json:"path"`       // Path of the pageview.
+	Ref       string    `json:"ref"`        // Referrer
+	UserAgent string    `json:"user_agent"` // User-Agent header of the browser.
+}
+
+// Value implements the SQL Value function to determine what to store in the DB.
+func (f SiteFirstHit) Value() (driver.Value, error) { return json.Marshal(f) }
+
+// Scan converts the data returned from the DB into the struct.
+func (f *SiteFirstHit) Scan(v interface{}) error {
+	switch vv := v.(type) {
+	case []byte:
+		return json.Unmarshal(vv, f)
+	case string:
+		return json.Unmarshal([]byte(vv), f)
+	default:
+		panic(fmt.Sprintf("unsupported type: %T", v))
+	}
+}
+
 // Defaults sets fields to default values, unless they're already set.
 func (s *Site) Defaults(ctx context.Context) {
 	// New site: Set default settings.
@@ -137,6 +365,9 @@ func (s *Site) Defaults(ctx context.Context) {
 	if s.Settings.Timezone == nil {
 		s.Settings.Timezone = tz.UTC
 	}
+	if s.Settings.LocationDetail == "" {
+		s.Settings.LocationDetail = LocationCountry
+	}
 
 	s.Code = strings.ToLower(s.Code)
 
@@ -166,17 +397,41 @@ func (s *Site) Validate(ctx context.Context) error {
 
 	v.Range("settings.limits.page", int64(s.Settings.Limits.Page), 1, 25)
 	v.Range("settings.limits.ref", int64(s.Settings.Limits.Ref), 1, 25)
+	v.Include("settings.location_detail", s.Settings.LocationDetail, LocationDetails)
+	v.Include("settings.export_schedule", s.Settings.ExportSchedule, ExportSchedules)
+	v.Range("settings.session.idle", int64(s.Settings.Session.Idle), 0, 60*24*7)
+	if s.Settings.NoDataAlert != 0 {
+		v.Range("settings.no_data_alert", int64(s.Settings.NoDataAlert), 2, 24*31)
+	}
+	v.Range("settings.session.max", int64(s.Settings.Session.Max), 0, 60*24*7)
+	v.Range("settings.sampling", int64(s.Settings.Sampling), 0, 1000)
+	v.Range("settings.bot_threshold", int64(s.Settings.BotThreshold), 0, 100)
+	if s.Settings.IPv6Prefix != 0 {
+		v.Range("settings.ipv6_prefix", int64(s.Settings.IPv6Prefix), 16, 128)
+	}
 
 	if s.Settings.DataRetention > 0 {
 		v.Range("settings.data_retention", int64(s.Settings.DataRetention), 14, 0)
 	}
+	if s.Settings.EventRetention != 0 && s.Settings.EventRetention != -1 {
+		v.Range("settings.event_retention", int64(s.Settings.EventRetention), 14, 0)
+	}
+	if s.Settings.AnonymizeAfter != 0 {
+		v.Range("settings.anonymize_after", int64(s.Settings.AnonymizeAfter), 7, 0)
+	}
+	if _, err := s.Settings.PathRetentions(); err != nil {
+		v.Append("settings.path_retention", err.Error())
+	}
 
-	if len(s.Settings.IgnoreIPs) > 0 {
-		for _, ip := range s.Settings.IgnoreIPs {
-			v.IP("settings.ignore_ips", ip)
-		}
+	if _, err := s.Settings.refCategories(); err != nil {
+		v.Append("settings.ref_categories", err.Error())
 	}
 
+	validateIPs(&v, "settings.ignore_ips", s.Settings.IgnoreIPs)
+	validateHosts(&v, "settings.allowed_hosts", s.Settings.AllowedHosts)
+	validateHosts(&v, "settings.blocked_hosts", s.Settings.BlockedHosts)
+	s.Settings.BotRules.validate(&v)
+
 	v.Domain("link_domain", s.LinkDomain)
 	v.Len("code", s.Code, 2, 50)
 	v.Exclude("code", s.Code, reserved)
@@ -273,6 +528,17 @@ func (s *Site) Update(ctx context.Context) error {
 		return err
 	}
 
+	// The Site is often modified in-place, so get the current timezone from
+	// the database.
+	var old SiteSettings
+	err = zdb.MustGet(ctx).GetContext(ctx, &old, `select settings from sites where id=$1`, s.ID)
+	if err != nil {
+		return errors.Wrap(err, "Site.Update")
+	}
+	if old.Timezone == nil {
+		old.Timezone = tz.UTC
+	}
+
 	_, err = zdb.MustGet(ctx).ExecContext(ctx,
 		`update sites set settings=$1, cname=$2, link_domain=$3, updated_at=$4 where id=$5`,
 		s.Settings, s.Cname, s.LinkDomain, s.UpdatedAt.Format(zdb.Date), s.ID)
@@ -280,7 +546,14 @@ func (s *Site) Update(ctx context.Context) error {
 		return errors.Wrap(err, "Site.Update")
 	}
 
-	sitesCacheByID.Delete(strconv.FormatInt(s.ID, 10))
+	if from, to := old.Timezone.Loc().String(), s.Settings.Timezone.Loc().String(); from != to {
+		err := (&AuditLog{Site: s.ID, Action: AuditTimezone, Info: from + " → " + to}).Insert(ctx)
+		if err != nil {
+			return errors.Wrap(err, "Site.Update")
+		}
+	}
+
+	s.clearCache(ctx)
 	return nil
 }
 
@@ -311,16 +584,98 @@ func (s *Site) UpdateStripe(ctx context.Context, stripeID, plan, amount string)
 		return errors.Wrap(err, "Site.UpdateStripe")
 	}
 
-	sitesCacheByID.Delete(strconv.FormatInt(s.ID, 10))
+	s.clearCache(ctx)
 	return nil
 }
 
-func (s *Site) UpdateReceivedData(ctx context.Context) error {
+// LastData gets the hour of the most recent pageview, or nil if there are no
+// pageviews.
+func (s Site) LastData(ctx context.Context) (*time.Time, error) {
+	var last time.Time
+	err := zdb.MustGet(ctx).GetContext(ctx, &last,
+		`/* Site.LastData */ select hour from hit_counts where site=$1 order by hour desc limit 1`, s.ID)
+	if err != nil {
+		if zdb.ErrNoRows(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "Site.LastData")
+	}
+	return &last, nil
+}
+
+// UpdateNoDataSent records that a "no data received" email was sent.
+func (s *Site) UpdateNoDataSent(ctx context.Context) error {
+	now := Now()
 	_, err := zdb.MustGet(ctx).ExecContext(ctx,
-		`update sites set received_data=1 where id=$1`, s.ID)
+		`update sites set no_data_sent_at=$1 where id=$2`, now.Format(zdb.Date), s.ID)
+	if err != nil {
+		return errors.Wrap(err, "Site.UpdateNoDataSent")
+	}
 
-	sitesCacheByID.Delete(strconv.FormatInt(s.ID, 10))
-	return errors.Wrap(err, "Site.UpdateReceivedData")
+	s.NoDataSentAt = &now
+	s.clearCache(ctx)
+	return nil
+}
+
+// UpdateReceivedData records that the site has received data, with first as
+// the first pageview.
+func (s *Site) UpdateReceivedData(ctx context.Context, first Hit) error {
+	f := SiteFirstHit{
+		CreatedAt: first.CreatedAt,
+		Host:      first.Host,
+		Path:      first.Path,
+		Ref:       first.Ref,
+		UserAgent: first.Browser,
+	}
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update sites set received_data=1, first_hit=$1 where id=$2`, f, s.ID)
+	if err != nil {
+		return errors.Wrap(err, "Site.UpdateReceivedData")
+	}
+
+	s.ReceivedData, s.FirstHit = true, &f
+	s.clearCache(ctx)
+	return nil
+}
+
+// UpdateFirstHitAt sets FirstHitAt to the hour of t, if it's before the
+// current value.
+func (s *Site) UpdateFirstHitAt(ctx context.Context, t time.Time) error {
+	t = t.UTC().Truncate(time.Hour)
+	if s.FirstHitAt != nil && !t.Before(*s.FirstHitAt) {
+		return nil
+	}
+
+	_, err := zdb.MustGet(ctx).ExecContext(ctx, `update sites set first_hit_at=$1
+		where id=$2 and (first_hit_at is null or first_hit_at>$1)`,
+		t.Format(zdb.Date), s.ID)
+	if err != nil {
+		return errors.Wrap(err, "Site.UpdateFirstHitAt")
+	}
+
+	s.FirstHitAt = &t
+	s.clearCache(ctx)
+	return nil
+}
+
+// ClampStart gets the start of the day of the first pageview in the timezone
+// zone, if the ClampFirstHit setting is enabled and that's between start and
+// end. It returns start if it's not clamped.
+//
+// The zone should be the timezone the stats are shown in; see GetTimezone().
+//
+// The second return value is true if start was changed.
+func (s Site) ClampStart(start, end time.Time, zone *tz.Zone) (time.Time, bool) {
+	if !s.Settings.ClampFirstHit || s.FirstHitAt == nil {
+		return start, false
+	}
+
+	y, m, d := s.FirstHitAt.In(zone.Loc()).Date()
+	first := time.Date(y, m, d, 0, 0, 0, 0, zone.Loc()).UTC()
+	if !first.After(start) || first.After(end) {
+		return start, false
+	}
+	return first, true
 }
 
 // UpdateCnameSetupAt confirms the custom domain was setup correct.
@@ -339,11 +694,18 @@ func (s *Site) UpdateCnameSetupAt(ctx context.Context) error {
 		return errors.Wrap(err, "Site.UpdateCnameSetupAt")
 	}
 
-	sitesCacheByID.Delete(strconv.FormatInt(s.ID, 10))
+	s.clearCache(ctx)
 	return nil
 }
 
+// DeleteGrace is how long deleted sites are kept before they're removed with
+// all their data; a site can be restored with Sites.Restore() until then.
+var DeleteGrace = 7 * 24 * time.Hour
+
 // Delete a site.
+//
+// This only marks the site and its child sites as deleted; they're removed by a
+// cron job after DeleteGrace.
 func (s *Site) Delete(ctx context.Context) error {
 	if s.ID == 0 {
 		return errors.New("ID == 0")
@@ -357,7 +719,7 @@ func (s *Site) Delete(ctx context.Context) error {
 		return errors.Wrap(err, "Site.Delete")
 	}
 
-	sitesCacheByID.Delete(strconv.FormatInt(s.ID, 10))
+	s.clearCache(ctx)
 	s.ID = 0
 	s.UpdatedAt = &t
 	s.State = StateDeleted
@@ -369,6 +731,23 @@ var (
 	sitesCacheHostname = cache.New(24*time.Hour, 1*time.Hour)
 )
 
+// clearCache clears the cache for this site, on this and all other instances.
+func (s Site) clearCache(ctx context.Context) {
+	clearSiteCache(s.ID)
+	Broadcast(ctx, BroadcastMessage{Kind: BroadcastSite, Site: s.ID})
+}
+
+// clearSiteCache clears the cache for a site, including all hostnames that
+// point to it, as the custom domain may have changed.
+func clearSiteCache(id int64) {
+	sitesCacheByID.Delete(strconv.FormatInt(id, 10))
+	for host, item := range sitesCacheHostname.Items() {
+		if siteID, ok := item.Object.(int64); ok && siteID == id {
+			sitesCacheHostname.Delete(host)
+		}
+	}
+}
+
 // ByID gets a site by ID.
 func (s *Site) ByID(ctx context.Context, id int64) error {
 	k := strconv.FormatInt(id, 10)
@@ -547,7 +926,9 @@ func (s Site) PayExternal() string {
 
 func (s Site) DeleteAll(ctx context.Context) error {
 	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		for _, t := range append(statTables, "hit_counts", "ref_counts", "hits") {
+		for _, t := range append(statTables, "scroll_stats", "hit_counts", "ref_counts", "hits", "import_fingerprints",
+			"operation_hits", "operations", "stat_retries", "hit_counts_daily", "hit_counts_monthly",
+			"ref_counts_daily", "ref_counts_monthly", "rollup_dirty") {
 			_, err := tx.ExecContext(ctx, `delete from `+t+` where site=$1`, s.ID)
 			if err != nil {
 				return errors.Wrap(err, "Site.DeleteAll: delete "+t)
@@ -558,43 +939,274 @@ func (s Site) DeleteAll(ctx context.Context) error {
 	})
 }
 
-func (s Site) DeleteOlderThan(ctx context.Context, days int) error {
-	if days < 14 {
+// DeleteRange deletes all pageviews and stats on the days from start to end,
+// inclusive. The days are in the site's timezone.
+//
+// The hourly statistics are removed for the same period, but the other
+// statistics are stored per UTC day, so those are removed for every UTC day
+// that overlaps with the range. Use ReindexFunc to recreate the statistics for
+// the returned days from the remaining pageviews.
+func (s Site) DeleteRange(ctx context.Context, start, end time.Time) (time.Time, time.Time, error) {
+	loc := s.Settings.Timezone.Loc()
+	start, end = start.In(loc), end.In(loc)
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc).UTC()
+	end = time.Date(end.Year(), end.Month(), end.Day()+1, 0, 0, 0, 0, loc).UTC()
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.Errorf("Site.DeleteRange: start %s is after end %s", start, end)
+	}
+	firstDay, lastDay := start.Truncate(24*time.Hour), end.Add(-time.Second).Truncate(24*time.Hour)
+
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		for _, t := range []string{"hits", "import_fingerprints", "operation_hits"} {
+			_, err := tx.ExecContext(ctx,
+				`delete from `+t+` where site=$1 and created_at >= $2 and created_at < $3`,
+				s.ID, start.Format(zdb.Date), end.Format(zdb.Date))
+			if err != nil {
+				return errors.Wrap(err, "Site.DeleteRange: delete "+t)
+			}
+		}
+
+		for _, t := range []string{"hit_counts", "ref_counts"} {
+			_, err := tx.ExecContext(ctx,
+				`delete from `+t+` where site=$1 and hour >= $2 and hour < $3`,
+				s.ID, start.Format(zdb.Date), end.Format(zdb.Date))
+			if err != nil {
+				return errors.Wrap(err, "Site.DeleteRange: delete "+t)
+			}
+		}
+
+		for _, t := range statTables {
+			_, err := tx.ExecContext(ctx,
+				`delete from `+t+` where site=$1 and day >= $2 and day <= $3`,
+				s.ID, firstDay.Format("2006-01-02"), lastDay.Format("2006-01-02"))
+			if err != nil {
+				return errors.Wrap(err, "Site.DeleteRange: delete "+t)
+			}
+		}
+
+		return nil
+	})
+	return firstDay, lastDay, err
+}
+
+// DeleteOlderThan deletes all pageviews and statistics older than days, and
+// all events older than eventDays. Either can be 0 to not delete anything.
+//
+// The statistics for browsers, systems, locations, etc. don't distinguish
+// between pageviews and events, so they're removed after days.
+func (s Site) DeleteOlderThan(ctx context.Context, days, eventDays int) error {
+	return s.deleteRetention(ctx, retention{days: days, eventDays: eventDays})
+}
+
+// AnonymizeOlderThan removes the session, browser, and location from all
+// pageviews older than days; the pageviews themselves are kept, so the totals
+// and unique visitors in the statistics stay the same.
+//
+// Only the pageviews after AnonymizedUntil are updated, so this doesn't need
+// to look at all the older pageviews on every run.
+//
+// The browser, system, and location statistics can no longer be recreated for
+// these days; see SiteSettings.AnonymizedBefore().
+func (s *Site) AnonymizeOlderThan(ctx context.Context, days int) error {
+	if days < 7 {
+		return errors.Errorf("Site.AnonymizeOlderThan: days must be at least 7: %d", days)
+	}
+
+	until := Now().UTC().AddDate(0, 0, -days).Truncate(time.Second)
+	if s.AnonymizedUntil != nil && !until.After(*s.AnonymizedUntil) {
+		return nil
+	}
+
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		where, args := `created_at < $2`, []interface{}{s.ID, until.Format(zdb.Date)}
+		if s.AnonymizedUntil != nil {
+			where += ` and created_at >= $3`
+			args = append(args, s.AnonymizedUntil.Format(zdb.Date))
+		}
+
+		for _, t := range []string{"hits", "operation_hits"} {
+			_, err := tx.ExecContext(ctx, `/* Site.AnonymizeOlderThan */
+				update `+t+` set
+					session=null, session2=null, browser='',
+					ua_brands='', ua_platform='', ua_platform_version='',
+					location='', region='', city=''
+				where site=$1 and `+where+` and (
+					session is not null or session2 is not null or browser != '' or
+					ua_brands != '' or ua_platform != '' or ua_platform_version != '' or
+					location != '' or region != '' or city != '')`,
+				args...)
+			if err != nil {
+				return errors.Wrap(err, "Site.AnonymizeOlderThan: "+t)
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `update sites set anonymized_until=$1 where id=$2`,
+			until.Format(zdb.Date), s.ID)
+		return errors.Wrap(err, "Site.AnonymizeOlderThan")
+	})
+	if err != nil {
+		return err
+	}
+
+	s.AnonymizedUntil = &until
+	s.clearCache(ctx)
+	return nil
+}
+
+// ApplyRetention deletes the pageviews and statistics that are older than the
+// site's retention settings: DataRetention, EventRetention, PathRetention, and
+// RetentionKeepStats.
+func (s Site) ApplyRetention(ctx context.Context) error {
+	r := retention{
+		days:      s.Settings.DataRetention,
+		eventDays: s.Settings.EventRetentionDays(),
+		keepStats: s.Settings.RetentionKeepStats,
+	}
+	if r.days < 0 {
+		r.days = 0
+	}
+	if r.eventDays < 0 {
+		r.eventDays = 0
+	}
+
+	var err error
+	r.paths, err = s.Settings.PathRetentions()
+	if err != nil {
+		return errors.Wrap(err, "Site.ApplyRetention")
+	}
+	return s.deleteRetention(ctx, r)
+}
+
+type retention struct {
+	days, eventDays int
+	paths           []PathRetention // Sorted with the longest prefix first.
+	keepStats       bool            // Only delete the pageviews.
+}
+
+func (s Site) deleteRetention(ctx context.Context, r retention) error {
+	days, eventDays := r.days, r.eventDays
+	if days != 0 && days < 14 {
 		return errors.Errorf("days must be at least 14: %d", days)
 	}
+	if eventDays != 0 && eventDays < 14 {
+		return errors.Errorf("eventDays must be at least 14: %d", eventDays)
+	}
 
-	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
-		ival := interval(days)
-		_, err := tx.ExecContext(ctx,
-			`delete from hits where site=$1 and created_at < `+ival,
-			s.ID)
-		if err != nil {
-			return errors.Wrap(err, "Site.DeleteOlderThan: delete sites")
+	// Filters for the events, and the pageviews; ref_counts and hit_stats
+	// don't have the event column, so use the paths from hit_counts.
+	const (
+		eventPaths = ` path in (select path from hit_counts where site=$1 and event=1) `
+		pagePaths  = ` path not in (select path from hit_counts where site=$1 and event=1) `
+	)
+	type filter struct {
+		days  int
+		where [3]string // hits, hit_counts, and tables with a path.
+		args  []interface{}
+	}
+	// prefix gets the condition for paths starting with p, or not starting with
+	// p if not is set.
+	prefix := func(f *filter, p string, not bool) string {
+		f.args = append(f.args, p)
+		op := "="
+		if not {
+			op = "!="
+		}
+		return fmt.Sprintf(` and substr(path, 1, %d) %s $%d `, utf8.RuneCountInString(p), op, len(f.args)+1)
+	}
+	add := func(f *filter, w string) {
+		for i := range f.where {
+			f.where[i] += w
 		}
+	}
 
-		_, err = tx.ExecContext(ctx,
-			`delete from hit_counts where site=$1 and hour < `+ival,
-			s.ID)
-		if err != nil {
-			return errors.Wrap(err, "Site.DeleteOlderThan: delete sites")
+	filters := []filter{{days: days}}
+	if eventDays != days {
+		filters = []filter{
+			{days: days, where: [3]string{` and coalesce(event, 0)=0 `, ` and event=0 `, ` and ` + pagePaths}},
+			{days: eventDays, where: [3]string{` and event=1 `, ` and event=1 `, ` and ` + eventPaths}},
+		}
+	}
+	// Paths with their own retention are excluded from the site-wide
+	// retention, and from the retention of shorter prefixes.
+	for i := range filters {
+		for _, p := range r.paths {
+			add(&filters[i], prefix(&filters[i], p.Prefix, true))
 		}
+	}
+	for i, p := range r.paths {
+		if p.Days == -1 {
+			continue
+		}
+		f := filter{days: p.Days}
+		add(&f, prefix(&f, p.Prefix, false))
+		for _, longer := range r.paths[:i] {
+			if len(longer.Prefix) > len(p.Prefix) && strings.HasPrefix(longer.Prefix, p.Prefix) {
+				add(&f, prefix(&f, longer.Prefix, true))
+			}
+		}
+		filters = append(filters, f)
+	}
 
-		_, err = tx.ExecContext(ctx,
-			`delete from ref_counts where site=$1 and hour < `+ival,
-			s.ID)
-		if err != nil {
-			return errors.Wrap(err, "Site.DeleteOlderThan: delete sites")
+	return zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		for _, f := range filters {
+			if f.days == 0 {
+				continue
+			}
+			ival := interval(f.days)
+
+			// The paths are read from hit_counts, so delete from that last. The
+			// pageviews in the operation journal are removed as well, so that
+			// undoing an operation doesn't bring them back.
+			queries := []string{
+				`delete from hits where site=$1 and created_at < ` + ival + f.where[0],
+				`delete from operation_hits where site=$1 and created_at < ` + ival + f.where[0],
+			}
+			if !r.keepStats {
+				queries = []string{
+					`delete from ref_counts where site=$1 and hour < ` + ival + f.where[2],
+					`delete from hit_stats where site=$1 and day < ` + ival + f.where[2],
+					queries[0],
+					queries[1],
+					`delete from hit_counts where site=$1 and hour < ` + ival + f.where[1],
+				}
+			}
+			for _, q := range queries {
+				_, err := tx.ExecContext(ctx, q, append([]interface{}{s.ID}, f.args...)...)
+				if err != nil {
+					return errors.Wrap(err, "Site.DeleteOlderThan")
+				}
+			}
 		}
 
+		if days == 0 {
+			return nil
+		}
 		for _, t := range statTables {
+			if t == "hit_stats" || r.keepStats {
+				continue
+			}
 			_, err := tx.ExecContext(ctx,
-				`delete from `+t+` where site=$1 and day < `+ival,
+				`delete from `+t+` where site=$1 and day < `+interval(days),
 				s.ID)
 			if err != nil {
 				return errors.Wrap(err, "Site.DeleteOlderThan: delete "+t)
 			}
 		}
 
+		// Keep the fingerprints of imported rows for as long as the events.
+		if eventDays != 0 {
+			keep := days
+			if eventDays > keep {
+				keep = eventDays
+			}
+			_, err := tx.ExecContext(ctx,
+				`delete from import_fingerprints where site=$1 and created_at < `+interval(keep),
+				s.ID)
+			if err != nil {
+				return errors.Wrap(err, "Site.DeleteOlderThan: delete import_fingerprints")
+			}
+		}
+
 		return nil
 	})
 }
@@ -637,10 +1249,61 @@ func (s *Sites) ContainsCNAME(ctx context.Context, cname string) (bool, error) {
 	return ok, errors.Wrap(err, "Sites.ContainsCNAME")
 }
 
-// OldSoftDeleted finds all sites which have been soft-deleted more than a week
-// ago.
+// OldSoftDeleted finds all sites which have been soft-deleted more than
+// DeleteGrace ago.
 func (s *Sites) OldSoftDeleted(ctx context.Context) error {
-	return errors.Wrap(zdb.MustGet(ctx).SelectContext(ctx, s, fmt.Sprintf(`/* Sites.OldSoftDeleted */
-		select * from sites where state=$1 and updated_at < %s`, interval(7)),
-		StateDeleted), "Sites.OldSoftDeleted")
+	return errors.Wrap(zdb.MustGet(ctx).SelectContext(ctx, s, `/* Sites.OldSoftDeleted */
+		select * from sites where state=$1 and updated_at < $2`,
+		StateDeleted, Now().Add(-DeleteGrace).Format(zdb.Date)), "Sites.OldSoftDeleted")
+}
+
+// Restore un-deletes the soft-deleted site with this ID, and the child sites
+// that were deleted together with it. All data is kept for deleted sites, so
+// the sites are restored exactly as they were.
+//
+// This works until the site is removed, which is DeleteGrace after it was
+// deleted. The restored sites are set to s.
+func (s *Sites) Restore(ctx context.Context, id int64) error {
+	err := zdb.TX(ctx, func(ctx context.Context, tx zdb.DB) error {
+		var site Site
+		err := tx.GetContext(ctx, &site, `/* Sites.Restore */
+			select * from sites where id=$1 and state=$2`, id, StateDeleted)
+		if err != nil {
+			if zdb.ErrNoRows(err) {
+				return guru.Errorf(404, "no deleted site with ID %d", id)
+			}
+			return err
+		}
+
+		*s = Sites{site}
+		if site.UpdatedAt != nil {
+			var children Sites
+			err := tx.SelectContext(ctx, &children, `/* Sites.Restore */
+				select * from sites where parent=$1 and state=$2 and updated_at=$3`,
+				id, StateDeleted, site.UpdatedAt.Format(zdb.Date))
+			if err != nil {
+				return err
+			}
+			*s = append(*s, children...)
+		}
+
+		now := Now()
+		for i := range *s {
+			_, err := tx.ExecContext(ctx, `update sites set state=$1, updated_at=$2 where id=$3`,
+				StateActive, now.Format(zdb.Date), (*s)[i].ID)
+			if err != nil {
+				return err
+			}
+			(*s)[i].State, (*s)[i].UpdatedAt = StateActive, &now
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "Sites.Restore")
+	}
+
+	for _, site := range *s {
+		site.clearCache(ctx)
+	}
+	return nil
 }
@@ -9,9 +9,12 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	. "zgo.at/goatcounter"
 	"zgo.at/goatcounter/gctest"
+	"zgo.at/tz"
+	"zgo.at/zdb"
 	"zgo.at/zvalidate"
 )
 
@@ -30,6 +33,254 @@ func TestSiteInsert(t *testing.T) {
 	}
 }
 
+func TestSiteLastData(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := MustGetSite(ctx)
+	last, err := site.LastData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last != nil {
+		t.Fatalf("last is %s", last)
+	}
+
+	gctest.StoreHits(ctx, t, false, []Hit{
+		{Path: "/a", CreatedAt: time.Date(2020, 6, 18, 14, 42, 0, 0, time.UTC)},
+		{Path: "/a", CreatedAt: time.Date(2020, 6, 19, 9, 12, 0, 0, time.UTC)},
+	}...)
+
+	last, err = site.LastData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2020, 6, 19, 9, 0, 0, 0, time.UTC); last == nil || !last.Equal(want) {
+		t.Errorf("last is %v; want %s", last, want)
+	}
+}
+
+func TestSiteClampStart(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	gctest.StoreHits(ctx, t, false, []Hit{
+		{Path: "/a", CreatedAt: time.Date(2020, 6, 18, 14, 42, 0, 0, time.UTC)},
+		{Path: "/a", CreatedAt: time.Date(2020, 6, 10, 9, 12, 0, 0, time.UTC)},
+	}...)
+	gctest.StoreHits(ctx, t, false, Hit{Path: "/a", CreatedAt: time.Date(2020, 6, 20, 9, 12, 0, 0, time.UTC)})
+
+	var site Site
+	err := site.ByID(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2020, 6, 10, 9, 0, 0, 0, time.UTC); site.FirstHitAt == nil || !site.FirstHitAt.Equal(want) {
+		t.Fatalf("first_hit_at is %v; want %s", site.FirstHitAt, want)
+	}
+
+	day := func(d int) time.Time { return time.Date(2020, 6, d, 0, 0, 0, 0, time.UTC) }
+	tokyo := tz.MustNew("", "Asia/Tokyo")
+	tests := []struct {
+		clamp      bool
+		zone       *tz.Zone
+		start, end time.Time
+		want       time.Time
+	}{
+		{false, tz.UTC, day(1), day(30), day(1)},
+		{true, tz.UTC, day(1), day(30), day(10)},
+		{true, tz.UTC, day(15), day(30), day(15)},
+		{true, tz.UTC, day(1), day(5), day(1)},
+
+		// Start of the day in the zone, rather than the site's timezone.
+		{true, tokyo, day(1), day(30), day(9).Add(15 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			site.Settings.ClampFirstHit = tt.clamp
+			got, clamped := site.ClampStart(tt.start, tt.end, tt.zone)
+			if !got.Equal(tt.want) || clamped != !tt.want.Equal(tt.start) {
+				t.Errorf("got %s %t; want %s", got, clamped, tt.want)
+			}
+		})
+	}
+
+	site.Settings.ClampFirstHit = true
+	var totals HitStat
+	_, err = totals.Totals(WithSite(ctx, &site), day(1), day(30).Add(24*time.Hour-time.Second), "", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(totals.Stats) != 21 || totals.Stats[0].Day != "2020-06-10" {
+		t.Errorf("%d days, starting at %s", len(totals.Stats), totals.Stats[0].Day)
+	}
+}
+
+func TestSiteAnonymizeOlderThan(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 30, 12, 0, 0, 0, time.UTC)
+	defer gctest.SwapNow(t, now)()
+
+	gctest.StoreHits(ctx, t, false, []Hit{
+		{Path: "/a", Browser: "Firefox/80.0", Location: "NZ", CreatedAt: now.AddDate(0, 0, -10)},
+		{Path: "/a", Browser: "Firefox/80.0", Location: "NZ", CreatedAt: now.AddDate(0, 0, -1)},
+	}...)
+
+	site := MustGetSite(ctx)
+	anonymized := func(t *testing.T) int {
+		t.Helper()
+		var n int
+		err := zdb.MustGet(ctx).GetContext(ctx, &n, `select count(*) from hits
+			where session2 is null and browser='' and location=''`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	err := site.AnonymizeOlderThan(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := anonymized(t); n != 1 {
+		t.Errorf("%d anonymized pageviews; want 1", n)
+	}
+	if want := now.AddDate(0, 0, -7); site.AnonymizedUntil == nil || !site.AnonymizedUntil.Equal(want) {
+		t.Errorf("anonymized_until is %v; want %s", site.AnonymizedUntil, want)
+	}
+
+	// Only the pageviews since the last run are updated.
+	defer gctest.SwapNow(t, now.AddDate(0, 0, 7))()
+	err = site.AnonymizeOlderThan(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := anonymized(t); n != 2 {
+		t.Errorf("%d anonymized pageviews; want 2", n)
+	}
+
+	var got Site
+	err = got.ByID(ctx, site.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := now; got.AnonymizedUntil == nil || !got.AnonymizedUntil.Equal(want) {
+		t.Errorf("anonymized_until is %v; want %s", got.AnonymizedUntil, want)
+	}
+}
+
+func TestSiteDeleteRange(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	// 2020-06-10 in Asia/Tokyo (UTC+9) is from 2020-06-09 15:00 to 2020-06-10
+	// 15:00 UTC.
+	gctest.StoreHits(ctx, t, false, []Hit{
+		{Path: "/keep", CreatedAt: time.Date(2020, 6, 9, 14, 0, 0, 0, time.UTC)},
+		{Path: "/del", CreatedAt: time.Date(2020, 6, 9, 15, 0, 0, 0, time.UTC)},
+		{Path: "/del", CreatedAt: time.Date(2020, 6, 10, 14, 0, 0, 0, time.UTC)},
+		{Path: "/keep", CreatedAt: time.Date(2020, 6, 10, 15, 0, 0, 0, time.UTC)},
+	}...)
+
+	site := MustGetSite(ctx)
+	site.Settings.Timezone = tz.MustNew("", "Asia/Tokyo")
+
+	day := time.Date(2020, 6, 10, 12, 0, 0, 0, site.Settings.Timezone.Loc())
+	first, last, err := site.DeleteRange(ctx, day, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	err = zdb.MustGet(ctx).SelectContext(ctx, &paths, `select path from hits order by created_at`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := fmt.Sprintf("%v", paths); g != "[/keep /keep]" {
+		t.Errorf("wrong hits: %s", g)
+	}
+
+	if g := first.Format("2006-01-02") + " " + last.Format("2006-01-02"); g != "2020-06-09 2020-06-10" {
+		t.Errorf("wrong days: %s", g)
+	}
+	var days []string
+	err = zdb.MustGet(ctx).SelectContext(ctx, &days, `select distinct day from hit_stats order by day`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 0 {
+		t.Errorf("hit_stats not removed: %v", days)
+	}
+}
+
+func TestSitesRestore(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := Site{Code: "parent", Plan: PlanPersonal}
+	err := site.Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child := Site{Code: "child", Plan: PlanChild, Parent: &site.ID}
+	err = child.Insert(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := site.ID
+
+	err = site.Delete(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Not removed until after DeleteGrace.
+	var old Sites
+	err = old.OldSoftDeleted(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) != 0 {
+		t.Fatalf("len(old) = %d", len(old))
+	}
+
+	var restored Sites
+	err = restored.Restore(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restored) != 2 || restored[0].ID != id || restored[1].ID != child.ID {
+		t.Fatalf("%v", restored)
+	}
+	for _, s := range restored {
+		var got Site
+		err := got.ByID(ctx, s.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.State != StateActive {
+			t.Errorf("state is %q", got.State)
+		}
+	}
+
+	err = restored.Restore(ctx, id)
+	if err == nil {
+		t.Fatal("restored a site that's not deleted")
+	}
+
+	Now = func() time.Time { return time.Now().UTC().Add(DeleteGrace + time.Hour) }
+	defer func() { Now = func() time.Time { return time.Now().UTC() } }()
+	err = old.OldSoftDeleted(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) != 0 {
+		t.Fatalf("restored site is listed: %v", old)
+	}
+}
+
 func TestSiteValidate(t *testing.T) {
 	tests := []struct {
 		in    Site
@@ -90,3 +341,164 @@ func TestSiteValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSiteSettingsIsIgnored(t *testing.T) {
+	ss := SiteSettings{IgnoreIPs: []string{"127.0.0.1", "10.0.0.0/8", "2001:db8::/32"}}
+
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"", false},
+		{"127.0.0.1", true},
+		{"127.0.0.2", false},
+		{"10.42.1.1", true},
+		{"10.42.1.1:8080", true},
+		{"11.42.1.1", false},
+		{"2001:db8::42", true},
+		{"2001:db9::42", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := ss.IsIgnored(tt.in)
+			if got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteSettingsRefCategory(t *testing.T) {
+	ss := SiteSettings{RefCategories: []string{"search.example.com=search", "*.example.net=social", "www.reddit.com=other"}}
+
+	tests := []struct {
+		ref, scheme string
+		want        string
+	}{
+		{"", "", RefCategoryDirect},
+		{"newsletter", "c", RefCategoryCampaign},
+		{"Google", "g", RefCategorySearch},
+		{"www.bing.com/search", "h", RefCategorySearch},
+		{"cn.bing.com/search", "h", RefCategorySearch},
+		{"Hacker News", "g", RefCategorySocial},
+		{"twitter.com/search?q=https%3A%2F%2Ft.co%2Fasd", "h", RefCategorySocial},
+		{"Email", "g", RefCategoryEmail},
+		{"RSS", "g", RefCategoryGenerated},
+		{"arp242.net/foo", "h", RefCategoryOther},
+		{"com.example.android", "o", RefCategoryOther},
+
+		// Site overrides.
+		{"search.example.com/q", "h", RefCategorySearch},
+		{"a.b.example.net", "h", RefCategorySocial},
+		{"example.net", "h", RefCategoryOther},
+		{"www.reddit.com/r/programming", "h", RefCategoryOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got := ss.RefCategory(tt.ref, &tt.scheme)
+			if got != tt.want {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteSettingsPageviewsRemovedBefore(t *testing.T) {
+	defer gctest.SwapNow(t, "2020-06-18 12:00:00")()
+
+	tests := []struct {
+		ss   SiteSettings
+		want string
+	}{
+		{SiteSettings{DataRetention: 31}, "0001-01-01"},
+		{SiteSettings{RetentionKeepStats: true}, "0001-01-01"},
+		{SiteSettings{RetentionKeepStats: true, DataRetention: 31}, "2020-05-19"},
+		// Only some of the pageviews are removed for shorter event and path
+		// retentions.
+		{SiteSettings{RetentionKeepStats: true, DataRetention: 31, EventRetention: 14,
+			PathRetention: []string{"/tmp=14"}}, "2020-05-19"},
+		{SiteSettings{RetentionKeepStats: true, EventRetention: 14}, "0001-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := tt.ss.PageviewsRemovedBefore().Format("2006-01-02")
+			if got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSiteSettingsIPv6Prefix(t *testing.T) {
+	tests := []struct {
+		prefix      int
+		in          string
+		wantIgnored bool
+		wantSession string
+	}{
+		{0, "2001:db8:1:2:3:4:5:6", true, "2001:db8:1:2::"},
+		{0, "[2001:db8:1:2:3:4:5:6]:8080", true, "2001:db8:1:2::"},
+		{0, "2001:db8:1:3::1", false, "2001:db8:1:3::"},
+		{48, "2001:db8:1:3::1", true, "2001:db8:1::"},
+		{128, "2001:db8:1:2:3:4:5:6", false, "2001:db8:1:2:3:4:5:6"},
+		{128, "2001:db8:1:2::1", true, "2001:db8:1:2::1"},
+		{0, "2001:db8:9:9:3:4:5:6", true, "2001:db8:9:9::"},
+		{128, "2001:db8:9:8::1", false, "2001:db8:9:8::1"},
+		{0, "127.0.0.1", true, "127.0.0.1"},
+		{0, "127.0.0.2", false, "127.0.0.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d %s", tt.prefix, tt.in), func(t *testing.T) {
+			ss := SiteSettings{IgnoreIPs: []string{"127.0.0.1", "2001:db8:1:2::1", "2001:db8:9:9::/64"}, IPv6Prefix: tt.prefix}
+			if got := ss.IsIgnored(tt.in); got != tt.wantIgnored {
+				t.Errorf("IsIgnored: got %t; want %t", got, tt.wantIgnored)
+			}
+			if got := ss.SessionAddr(tt.in); got != tt.wantSession {
+				t.Errorf("SessionAddr: got %q; want %q", got, tt.wantSession)
+			}
+		})
+	}
+}
+
+func TestSiteTimezoneChanges(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	site := *MustGetSite(ctx)
+	update := func(f func()) {
+		t.Helper()
+		f()
+		err := site.Update(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	update(func() { site.Settings.Timezone = tz.MustNew("", "Europe/Amsterdam") })
+	update(func() { site.Settings.Public = true })
+	update(func() { site.Settings.Timezone = tz.MustNew("", "Asia/Tokyo") })
+
+	changes, err := TimezoneChanges(ctx, Now().Add(-time.Hour), Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.From+" "+c.To)
+	}
+	want := []string{"UTC Europe/Amsterdam", "Europe/Amsterdam Asia/Tokyo"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("\ngot:  %q\nwant: %q", got, want)
+	}
+
+	changes, err = TimezoneChanges(ctx, Now().Add(time.Hour), Now().Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("changes outside the range: %v", changes)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"zgo.at/errors"
+	"zgo.at/json"
+	"zgo.at/zdb"
+)
+
+// StatRetryMaxAttempts is the maximum number of times we try to update the
+// statistics for a batch of pageviews.
+const StatRetryMaxAttempts = 10
+
+// StatRetry is a batch of pageviews for which updating the statistics failed.
+//
+// The pageviews are already stored in the hits table, but they're not counted
+// in the statistics until this succeeds; they're retried with an exponential
+// backoff by the cron task until it succeeds or StatRetryMaxAttempts is
+// reached. Rows are removed once it succeeds or when we give up.
+type StatRetry struct {
+	ID   int64         `db:"stat_retry_id"`
+	Site int64         `db:"site"`
+	Hits StatRetryHits `db:"hits"`
+
+	Attempts      int       `db:"attempts"`
+	Error         *string   `db:"error"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// StatRetryHits are the pageviews in a StatRetry.
+//
+// This is stored as JSON, with only the fields that are needed to update the
+// statistics.
+type StatRetryHits []Hit
+
+type statRetryHit struct {
+	Path              string     `json:"path"`
+	Host              string     `json:"host,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	Ref               string     `json:"ref,omitempty"`
+	RefScheme         *string    `json:"ref_scheme,omitempty"`
+	Event             bool       `json:"event,omitempty"`
+	Size              zdb.Floats `json:"size,omitempty"`
+	Bot               int        `json:"bot,omitempty"`
+	Browser           string     `json:"browser,omitempty"`
+	Location          string     `json:"location,omitempty"`
+	Region            string     `json:"region,omitempty"`
+	City              string     `json:"city,omitempty"`
+	FirstVisit        bool       `json:"first_visit,omitempty"`
+	Sample            int        `json:"sample,omitempty"`
+	UTMSource         string     `json:"utm_source,omitempty"`
+	UTMMedium         string     `json:"utm_medium,omitempty"`
+	UTMCampaign       string     `json:"utm_campaign,omitempty"`
+	UABrands          string     `json:"ua_brands,omitempty"`
+	UAPlatform        string     `json:"ua_platform,omitempty"`
+	UAPlatformVersion string     `json:"ua_platform_version,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// Value implements the SQL Value function to determine what to store in the DB.
+func (h StatRetryHits) Value() (driver.Value, error) {
+	l := make([]statRetryHit, 0, len(h))
+	for _, hh := range h {
+		l = append(l, statRetryHit{
+			Path: hh.Path, Host: hh.Host, Title: hh.Title, Ref: hh.Ref,
+			RefScheme: hh.RefScheme, Event: bool(hh.Event), Size: hh.Size, Bot: hh.Bot,
+			Browser: hh.Browser, Location: hh.Location, Region: hh.Region, City: hh.City,
+			FirstVisit: bool(hh.FirstVisit), Sample: hh.Sample,
+			UTMSource: hh.UTMSource, UTMMedium: hh.UTMMedium, UTMCampaign: hh.UTMCampaign,
+			UABrands: hh.UABrands, UAPlatform: hh.UAPlatform, UAPlatformVersion: hh.UAPlatformVersion,
+			CreatedAt: hh.CreatedAt,
+		})
+	}
+	j, err := json.Marshal(l)
+	return string(j), err
+}
+
+// Scan converts the data returned from the DB into the struct.
+func (h *StatRetryHits) Scan(v interface{}) error {
+	var b []byte
+	switch vv := v.(type) {
+	case []byte:
+		b = vv
+	case string:
+		b = []byte(vv)
+	default:
+		return fmt.Errorf("StatRetryHits.Scan: unsupported type: %T", v)
+	}
+
+	var l []statRetryHit
+	err := json.Unmarshal(b, &l)
+	if err != nil {
+		return fmt.Errorf("StatRetryHits.Scan: %w", err)
+	}
+
+	*h = make(StatRetryHits, 0, len(l))
+	for _, hh := range l {
+		*h = append(*h, Hit{
+			Path: hh.Path, Host: hh.Host, Title: hh.Title, Ref: hh.Ref,
+			RefScheme: hh.RefScheme, Event: zdb.Bool(hh.Event), Size: hh.Size, Bot: hh.Bot,
+			Browser: hh.Browser, Location: hh.Location, Region: hh.Region, City: hh.City,
+			FirstVisit: zdb.Bool(hh.FirstVisit), Sample: hh.Sample,
+			UTMSource: hh.UTMSource, UTMMedium: hh.UTMMedium, UTMCampaign: hh.UTMCampaign,
+			UABrands: hh.UABrands, UAPlatform: hh.UAPlatform, UAPlatformVersion: hh.UAPlatformVersion,
+			CreatedAt: hh.CreatedAt,
+		})
+	}
+	return nil
+}
+
+// backoff gets the time to wait before the next attempt: 1 minute after the
+// first attempt, doubled for every attempt after that.
+func (r StatRetry) backoff() time.Duration {
+	return time.Minute << uint(r.Attempts-1)
+}
+
+// Insert a new batch in the queue after the first attempt to update the
+// statistics failed.
+func (r *StatRetry) Insert(ctx context.Context, updateErr error) error {
+	errStr := updateErr.Error()
+	r.Attempts = 1
+	r.Error = &errStr
+	r.CreatedAt = Now()
+	r.NextAttemptAt = r.CreatedAt.Add(r.backoff())
+
+	var err error
+	r.ID, err = insertWithID(ctx, "stat_retry_id", `insert into stat_retries
+		(site, hits, attempts, error, next_attempt_at, created_at)
+		values ($1, $2, $3, $4, $5, $6)`,
+		r.Site, r.Hits, r.Attempts, r.Error,
+		r.NextAttemptAt.Format(zdb.Date), r.CreatedAt.Format(zdb.Date))
+	return errors.Wrap(err, "StatRetry.Insert")
+}
+
+// Retry updating the statistics with update, and record the result.
+//
+// The batch is removed from the queue if update succeeds; Error is set if it
+// failed again. It's also removed after StatRetryMaxAttempts, as there's no
+// point keeping it around.
+func (r *StatRetry) Retry(ctx context.Context, update func(ctx context.Context, siteID int64, hits []Hit) error) error {
+	// The site isn't stored for every hit; the statistics are looked up and
+	// stored by the hit's site, so it needs to be set.
+	for i := range r.Hits {
+		r.Hits[i].Site = r.Site
+	}
+
+	r.Attempts++
+	updateErr := update(ctx, r.Site, r.Hits)
+	if updateErr == nil {
+		r.Error = nil
+		_, err := zdb.MustGet(ctx).ExecContext(ctx,
+			`delete from stat_retries where stat_retry_id=$1`, r.ID)
+		return errors.Wrap(err, "StatRetry.Retry")
+	}
+
+	errStr := updateErr.Error()
+	r.Error = &errStr
+	if r.Failed() {
+		_, err := zdb.MustGet(ctx).ExecContext(ctx,
+			`delete from stat_retries where stat_retry_id=$1`, r.ID)
+		return errors.Wrap(err, "StatRetry.Retry")
+	}
+
+	r.NextAttemptAt = Now().Add(r.backoff())
+	_, err := zdb.MustGet(ctx).ExecContext(ctx,
+		`update stat_retries set attempts=$1, error=$2, next_attempt_at=$3 where stat_retry_id=$4`,
+		r.Attempts, r.Error, r.NextAttemptAt.Format(zdb.Date), r.ID)
+	return errors.Wrap(err, "StatRetry.Retry")
+}
+
+// Failed reports if we gave up on this batch.
+func (r StatRetry) Failed() bool { return r.Error != nil && r.Attempts >= StatRetryMaxAttempts }
+
+// StatRetries is a list of batches in the queue.
+type StatRetries []StatRetry
+
+// ListPending lists all batches that are due for another attempt.
+func (r *StatRetries) ListPending(ctx context.Context) error {
+	err := zdb.MustGet(ctx).SelectContext(ctx, r, `/* StatRetries.ListPending */
+		select * from stat_retries
+		where attempts < $1 and next_attempt_at <= $2
+		order by next_attempt_at`,
+		StatRetryMaxAttempts, Now().Format(zdb.Date))
+	return errors.Wrap(err, "StatRetries.ListPending")
+}
@@ -0,0 +1,87 @@
+// Copyright © 2019 Martin Tournoij – This file is part of GoatCounter and
+// published under the terms of a slightly modified EUPL v1.2 license, which can
+// be found in the LICENSE file or at https://license.goatcounter.com
+
+package goatcounter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"zgo.at/goatcounter"
+	"zgo.at/goatcounter/gctest"
+	"zgo.at/zdb"
+)
+
+func TestStatRetry(t *testing.T) {
+	ctx, clean := gctest.DB(t)
+	defer clean()
+
+	now := time.Date(2020, 6, 18, 12, 0, 0, 0, time.UTC)
+	goatcounter.Now = func() time.Time { return now }
+	defer func() { goatcounter.Now = func() time.Time { return time.Now().UTC() } }()
+
+	site := goatcounter.MustGetSite(ctx).ID
+	r := goatcounter.StatRetry{Site: site, Hits: goatcounter.StatRetryHits{
+		{Path: "/a", Title: "A", Browser: "Firefox/80", Size: zdb.Floats{1920, 1080, 1}, FirstVisit: true, CreatedAt: now},
+		{Path: "/b", Event: true, CreatedAt: now},
+	}}
+	err := r.Insert(ctx, errors.New("oh noes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending := func(want int) goatcounter.StatRetries {
+		t.Helper()
+		var l goatcounter.StatRetries
+		err := l.ListPending(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(l) != want {
+			t.Fatalf("len %d; want %d", len(l), want)
+		}
+		return l
+	}
+
+	// Not due yet.
+	pending(0)
+	now = now.Add(time.Minute)
+	l := pending(1)
+	if h := l[0].Hits; len(h) != 2 || h[0].Path != "/a" || h[0].Browser != "Firefox/80" ||
+		!h[0].FirstVisit || len(h[0].Size) != 3 || !h[1].Event || !h[1].CreatedAt.Equal(now.Add(-time.Minute)) {
+		t.Errorf("wrong hits: %#v", h)
+	}
+
+	// Fails again: wait longer.
+	var got []goatcounter.Hit
+	err = l[0].Retry(ctx, func(ctx context.Context, siteID int64, hits []goatcounter.Hit) error {
+		got = hits
+		return errors.New("still broken")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || l[0].Attempts != 2 || l[0].Error == nil || *l[0].Error != "still broken" || l[0].Failed() {
+		t.Errorf("attempts=%d error=%v failed=%t", l[0].Attempts, l[0].Error, l[0].Failed())
+	}
+	now = now.Add(time.Minute)
+	pending(0)
+	now = now.Add(time.Minute)
+	l = pending(1)
+
+	// Succeeds: removed from the queue.
+	err = l[0].Retry(ctx, func(ctx context.Context, siteID int64, hits []goatcounter.Hit) error {
+		if siteID != site {
+			t.Errorf("wrong site: %d", siteID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(24 * time.Hour)
+	pending(0)
+}
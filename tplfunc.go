@@ -149,7 +149,7 @@ func TextChart(ctx context.Context, stats []Stat, max int, daily bool) template.
 
 func BarChart(ctx context.Context, stats []Stat, max int, daily bool) template.HTML {
 	site := MustGetSite(ctx)
-	now := Now().In(site.Settings.Timezone.Loc())
+	now := Now().In(GetTimezone(ctx).Loc())
 	today := now.Format("2006-01-02")
 
 	var (
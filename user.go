@@ -237,6 +237,12 @@ func (u *User) ByEmail(ctx context.Context, email string) error {
 		`, email, MustGetSite(ctx).ID), "User.ByEmail")
 }
 
+// ByID gets a user by ID; this doesn't check the site.
+func (u *User) ByID(ctx context.Context, id int64) error {
+	return errors.Wrap(zdb.MustGet(ctx).GetContext(ctx, u,
+		`select * from users where id=$1`, id), "User.ByID")
+}
+
 // ByResetToken gets a user by login request key.
 func (u *User) ByResetToken(ctx context.Context, key string) error {
 	query := `select * from users
@@ -373,7 +379,7 @@ func (u *User) SeenUpdates(ctx context.Context) error {
 func (u User) Widgets() []string {
 	return []string{
 		"totals", "alltotals", // We always need this.
-		"pages", "totalpages", "toprefs", "browsers", "systems", "sizes", "locations"}
+		"pages", "totalpages", "toprefs", "sources", "browsers", "systems", "sizes", "locations"}
 }
 
 type Users []User
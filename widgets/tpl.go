@@ -20,9 +20,6 @@ func (w Totals) TemplateData(ctx context.Context, shared SharedData) (string, in
 func (w AllTotals) TemplateData(ctx context.Context, shared SharedData) (string, interface{}) {
 	return "", nil
 }
-func (w Max) TemplateData(ctx context.Context, shared SharedData) (string, interface{}) {
-	return "", nil
-}
 
 func (w Pages) TemplateData(ctx context.Context, shared SharedData) (string, interface{}) {
 	t := "_dashboard_pages.gohtml"
@@ -58,6 +55,7 @@ func (w Pages) TemplateData(ctx context.Context, shared SharedData) (string, int
 		TotalHits       int
 		TotalUniqueHits int
 		MorePages       bool
+		OtherPages      goatcounter.OtherPages
 
 		Refs     goatcounter.Stats
 		ShowRefs string
@@ -65,7 +63,7 @@ func (w Pages) TemplateData(ctx context.Context, shared SharedData) (string, int
 		ctx, w.Pages, shared.Site, shared.Args.Start, shared.Args.End, shared.Args.Daily,
 		shared.Args.ForcedDaily, 1, shared.Max, w.Display,
 		w.UniqueDisplay, shared.Total, shared.TotalUnique,
-		w.More, shared.Refs, shared.Args.ShowRefs,
+		w.More, w.Other, shared.Refs, shared.Args.ShowRefs,
 	}
 }
 
@@ -121,3 +119,11 @@ func (w Locations) TemplateData(ctx context.Context, shared SharedData) (string,
 		Stats           goatcounter.Stats
 	}{ctx, shared.AllTotalUniqueUTC, w.LocStat}
 }
+
+func (w Sources) TemplateData(ctx context.Context, shared SharedData) (string, interface{}) {
+	return "_dashboard_sources.gohtml", struct {
+		Context         context.Context
+		TotalUniqueHits int
+		Stats           goatcounter.Stats
+	}{ctx, shared.AllTotalUniqueUTC, w.Sources}
+}
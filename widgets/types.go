@@ -23,14 +23,13 @@ type (
 		html                   template.HTML
 		Display, UniqueDisplay int
 		More                   bool
+		Other                  goatcounter.OtherPages
 		Pages                  goatcounter.HitStats
 		// TODO: on SharedData for now.
 		//Refs                   goatcounter.Stats
-		//Max                    int
-	}
-	Max struct {
-		html template.HTML
-		Max  int
+
+		// Totals and max of all paths; also on SharedData.
+		Total, TotalUnique, Max int
 	}
 	Totalpages struct {
 		html  template.HTML
@@ -61,13 +60,16 @@ type (
 		html    template.HTML
 		LocStat goatcounter.Stats
 	}
+	Sources struct {
+		html    template.HTML
+		Sources goatcounter.Stats
+	}
 )
 
 var list = map[string]Widget{
 	"totals":     &Totals{},
 	"alltotals":  &AllTotals{},
 	"pages":      &Pages{},
-	"max":        &Max{},
 	"totalpages": &Totalpages{},
 	"refs":       &Refs{},
 	"toprefs":    &Toprefs{},
@@ -75,10 +77,10 @@ var list = map[string]Widget{
 	"systems":    &Systems{},
 	"sizes":      &Sizes{},
 	"locations":  &Locations{},
+	"sources":    &Sources{},
 }
 
 func (w AllTotals) Name() string  { return "alltotals" }
-func (w Max) Name() string        { return "max" }
 func (w Refs) Name() string       { return "refs" }
 func (w Totals) Name() string     { return "totals" }
 func (w Pages) Name() string      { return "pages" }
@@ -88,9 +90,9 @@ func (w Browsers) Name() string   { return "browsers" }
 func (w Systems) Name() string    { return "systems" }
 func (w Sizes) Name() string      { return "sizes" }
 func (w Locations) Name() string  { return "locations" }
+func (w Sources) Name() string    { return "sources" }
 
 func (w AllTotals) Type() string  { return "data-only" }
-func (w Max) Type() string        { return "data-only" }
 func (w Refs) Type() string       { return "data-only" }
 func (w Totals) Type() string     { return "data-only" }
 func (w Pages) Type() string      { return "full-width" }
@@ -100,9 +102,9 @@ func (w Browsers) Type() string   { return "hchart" }
 func (w Systems) Type() string    { return "hchart" }
 func (w Sizes) Type() string      { return "hchart" }
 func (w Locations) Type() string  { return "hchart" }
+func (w Sources) Type() string    { return "hchart" }
 
 func (w *AllTotals) SetHTML(h template.HTML)  {}
-func (w *Max) SetHTML(h template.HTML)        {}
 func (w *Refs) SetHTML(h template.HTML)       {}
 func (w *Totals) SetHTML(h template.HTML)     {}
 func (w *Pages) SetHTML(h template.HTML)      { w.html = h }
@@ -112,9 +114,9 @@ func (w *Browsers) SetHTML(h template.HTML)   { w.html = h }
 func (w *Systems) SetHTML(h template.HTML)    { w.html = h }
 func (w *Sizes) SetHTML(h template.HTML)      { w.html = h }
 func (w *Locations) SetHTML(h template.HTML)  { w.html = h }
+func (w *Sources) SetHTML(h template.HTML)    { w.html = h }
 
 func (w AllTotals) HTML() template.HTML  { return w.html }
-func (w Max) HTML() template.HTML        { return w.html }
 func (w Refs) HTML() template.HTML       { return w.html }
 func (w Totals) HTML() template.HTML     { return w.html }
 func (w Pages) HTML() template.HTML      { return w.html }
@@ -124,9 +126,9 @@ func (w Browsers) HTML() template.HTML   { return w.html }
 func (w Systems) HTML() template.HTML    { return w.html }
 func (w Sizes) HTML() template.HTML      { return w.html }
 func (w Locations) HTML() template.HTML  { return w.html }
+func (w Sources) HTML() template.HTML    { return w.html }
 
 func (w AllTotals) Clone() Widget  { return &w }
-func (w Max) Clone() Widget        { return &w }
 func (w Refs) Clone() Widget       { return &w }
 func (w Totals) Clone() Widget     { return &w }
 func (w Pages) Clone() Widget      { return &w }
@@ -136,3 +138,4 @@ func (w Browsers) Clone() Widget   { return &w }
 func (w Systems) Clone() Widget    { return &w }
 func (w Sizes) Clone() Widget      { return &w }
 func (w Locations) Clone() Widget  { return &w }
+func (w Sources) Clone() Widget    { return &w }
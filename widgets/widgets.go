@@ -28,6 +28,7 @@ type (
 	Args struct {
 		Start, End  time.Time
 		Filter      string
+		Host        string
 		Daily       bool
 		ForcedDaily bool
 		ShowRefs    string
@@ -80,9 +81,9 @@ func (l List) Totals() (total, unique, allUnique, max int) {
 			ww := w.(*AllTotals)
 			allUnique = ww.AllTotalUniqueUTC
 		}
-		if w.Name() == "max" {
-			ww := w.(*Max)
-			max = ww.Max
+		if w.Name() == "pages" {
+			ww := w.(*Pages)
+			total, unique, max = ww.Total, ww.TotalUnique, ww.Max
 		}
 	}
 	return
@@ -106,8 +107,10 @@ func New(name string) (Widget, error) {
 	return nil, fmt.Errorf("unknown widget: %q", name)
 }
 
-func (w *Totals) GetData(ctx context.Context, a Args) (err error) {
-	w.Total, w.TotalUnique, err = goatcounter.GetTotalCount(ctx, a.Start, a.End, a.Filter)
+func (w *Totals) GetData(ctx context.Context, a Args) error {
+	var pages goatcounter.HitStats
+	l, err := pages.ListPages(ctx, a.Start, a.End, a.Filter, a.Host, nil, a.Daily)
+	w.Total, w.TotalUnique = l.Total, l.TotalUnique
 	return err
 }
 
@@ -116,24 +119,23 @@ func (w *AllTotals) GetData(ctx context.Context, a Args) (err error) {
 	return err
 }
 
-func (w *Pages) GetData(ctx context.Context, a Args) (err error) {
-	w.Display, w.UniqueDisplay, w.More, err = w.Pages.List(
-		ctx, a.Start, a.End, a.Filter, nil, a.Daily)
-	return err
-}
-
-func (w *Max) GetData(ctx context.Context, a Args) (err error) {
-	w.Max, err = goatcounter.GetMax(ctx, a.Start, a.End, a.Filter, a.Daily)
-	return err
+func (w *Pages) GetData(ctx context.Context, a Args) error {
+	l, err := w.Pages.ListPages(ctx, a.Start, a.End, a.Filter, a.Host, nil, a.Daily)
+	if err != nil {
+		return err
+	}
+	w.Display, w.UniqueDisplay, w.More, w.Other = l.Display, l.UniqueDisplay, l.More, l.Other
+	w.Total, w.TotalUnique, w.Max = l.Total, l.TotalUnique, l.Max
+	return w.Pages.LoadScroll(ctx, a.Start, a.End)
 }
 
 func (w *Totalpages) GetData(ctx context.Context, a Args) (err error) {
-	w.Max, err = w.Total.Totals(ctx, a.Start, a.End, a.Filter, a.Daily)
+	w.Max, err = w.Total.Totals(ctx, a.Start, a.End, a.Filter, a.Host, a.Daily)
 	return err
 }
 
 func (w *Refs) GetData(ctx context.Context, a Args) (err error) {
-	return w.Refs.ListRefsByPath(ctx, a.ShowRefs, a.Start, a.End, 0)
+	return w.Refs.ListRefsByPath(ctx, a.ShowRefs, a.Start, a.End, a.Host, 0)
 }
 func (w *Toprefs) GetData(ctx context.Context, a Args) (err error) {
 	return w.TopRefs.ListTopRefs(ctx, a.Start, a.End, 0)
@@ -150,3 +152,6 @@ func (w *Sizes) GetData(ctx context.Context, a Args) (err error) {
 func (w *Locations) GetData(ctx context.Context, a Args) (err error) {
 	return w.LocStat.ListLocations(ctx, a.Start, a.End, 6, 0)
 }
+func (w *Sources) GetData(ctx context.Context, a Args) (err error) {
+	return w.Sources.BySourceCategory(ctx, a.Start, a.End)
+}